@@ -35,17 +35,20 @@ type MembershipAuthorizer struct {
 	permissionCache PermissionCache
 	spaceFinder     refcache.SpaceFinder
 	publicAccess    publicaccess.Service
+	registryGrants  RegistryAccessGrants
 }
 
 func NewMembershipAuthorizer(
 	permissionCache PermissionCache,
 	spaceFinder refcache.SpaceFinder,
 	publicAccess publicaccess.Service,
+	registryGrants RegistryAccessGrants,
 ) *MembershipAuthorizer {
 	return &MembershipAuthorizer{
 		permissionCache: permissionCache,
 		spaceFinder:     spaceFinder,
 		publicAccess:    publicAccess,
+		registryGrants:  registryGrants,
 	}
 }
 
@@ -152,6 +155,18 @@ func (a *MembershipAuthorizer) Check(
 		return false, fmt.Errorf("session contains unknown metadata that impacts authorization: %T", session.Metadata)
 	}
 
+	// temporary access grants only extend regular sessions, never the scope of restricted tokens
+	if resource.Type == enum.ResourceTypeRegistry && resource.Identifier != "" {
+		granted, err := a.registryGrants.IsGranted(ctx, session.Principal.ID, scope.SpacePath,
+			resource.Identifier, permission)
+		if err != nil {
+			return false, fmt.Errorf("failed to check registry access grants: %w", err)
+		}
+		if granted {
+			return true, nil
+		}
+	}
+
 	return a.permissionCache.Get(
 		ctx, PermissionCacheKey{
 			PrincipalID: session.Principal.ID,
@@ -0,0 +1,33 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authz
+
+import (
+	"context"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+// RegistryAccessGrants reports the temporary permissions granted to principals on registries, on top
+// of the access they have through their space memberships.
+type RegistryAccessGrants interface {
+	IsGranted(
+		ctx context.Context,
+		principalID int64,
+		spacePath string,
+		registryIdentifier string,
+		permission enum.Permission,
+	) (bool, error)
+}
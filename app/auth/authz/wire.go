@@ -34,8 +34,9 @@ func ProvideAuthorizer(
 	pCache PermissionCache,
 	spaceFinder refcache.SpaceFinder,
 	publicAccess publicaccess.Service,
+	registryGrants RegistryAccessGrants,
 ) Authorizer {
-	return NewMembershipAuthorizer(pCache, spaceFinder, publicAccess)
+	return NewMembershipAuthorizer(pCache, spaceFinder, publicAccess, registryGrants)
 }
 
 func ProvidePermissionCache(
@@ -30,10 +30,14 @@ func ProvideServer(config *types.Config, router *router.Router) *Server {
 	return &Server{
 		http.NewServer(
 			http.Config{
-				Host:     config.HTTP.Host,
-				Port:     config.HTTP.Port,
-				Acme:     config.Acme.Enabled,
-				AcmeHost: config.Acme.Host,
+				Host:                      config.HTTP.Host,
+				Port:                      config.HTTP.Port,
+				Acme:                      config.Acme.Enabled,
+				AcmeHost:                  config.Acme.Host,
+				IdleTimeout:               config.HTTP.IdleTimeout,
+				HTTP2MaxConcurrentStreams: config.HTTP.HTTP2.MaxConcurrentStreams,
+				HTTP2MaxReadFrameSize:     config.HTTP.HTTP2.MaxReadFrameSize,
+				RequestClientCert:         config.HTTP.RequestClientCert,
 			},
 			router,
 		),
@@ -30,7 +30,18 @@ import (
 	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/services/webhook"
 	"github.com/harness/gitness/job"
+	registrybackfill "github.com/harness/gitness/registry/services/backfill"
+	registrycacheeviction "github.com/harness/gitness/registry/services/cacheeviction"
+	registrycacheprewarm "github.com/harness/gitness/registry/services/cacheprewarm"
+	registrycleanup "github.com/harness/gitness/registry/services/cleanup"
+	registryconsistency "github.com/harness/gitness/registry/services/consistency"
+	registrycontentindex "github.com/harness/gitness/registry/services/contentindex"
+	registrydownloadstats "github.com/harness/gitness/registry/services/downloadstats"
+	registrymirrorsync "github.com/harness/gitness/registry/services/mirrorsync"
+	registrystoragemigration "github.com/harness/gitness/registry/services/storagemigration"
+	registryupstreamhealth "github.com/harness/gitness/registry/services/upstreamhealth"
 	registrywebhooks "github.com/harness/gitness/registry/services/webhook"
+	registrydrain "github.com/harness/gitness/registry/utils/drain"
 
 	"github.com/google/wire"
 )
@@ -55,6 +66,17 @@ type Services struct {
 	instrumentConsumer      instrument.Consumer
 	instrumentRepoCounter   *instrument.RepositoryCount
 	registryWebhooksService *registrywebhooks.Service
+	RegistryCleanup         *registrycleanup.Service
+	RegistryConsistency     *registryconsistency.Service
+	RegistryStorage         *registrystoragemigration.Service
+	RegistryContentIndex    *registrycontentindex.Service
+	RegistryBackfill        *registrybackfill.Service
+	RegistryDownloadStats   *registrydownloadstats.Recorder
+	RegistryUpstreamHealth  *registryupstreamhealth.Service
+	RegistryCacheEviction   *registrycacheeviction.Service
+	RegistryCachePrewarm    *registrycacheprewarm.Service
+	RegistryMirrorSync      *registrymirrorsync.Service
+	RegistryDrainer         *registrydrain.Drainer
 }
 
 type GitspaceServices struct {
@@ -97,6 +119,17 @@ func ProvideServices(
 	instrumentConsumer instrument.Consumer,
 	instrumentRepoCounter *instrument.RepositoryCount,
 	registryWebhooksService *registrywebhooks.Service,
+	registryCleanupSvc *registrycleanup.Service,
+	registryConsistencySvc *registryconsistency.Service,
+	registryStorageMigrationSvc *registrystoragemigration.Service,
+	registryContentIndexSvc *registrycontentindex.Service,
+	registryBackfillSvc *registrybackfill.Service,
+	registryDownloadStats *registrydownloadstats.Recorder,
+	registryUpstreamHealthSvc *registryupstreamhealth.Service,
+	registryCacheEvictionSvc *registrycacheeviction.Service,
+	registryCachePrewarmSvc *registrycacheprewarm.Service,
+	registryMirrorSyncSvc *registrymirrorsync.Service,
+	registryDrainer *registrydrain.Drainer,
 ) Services {
 	return Services{
 		Webhook:                 webhooksSvc,
@@ -114,5 +147,16 @@ func ProvideServices(
 		instrumentConsumer:      instrumentConsumer,
 		instrumentRepoCounter:   instrumentRepoCounter,
 		registryWebhooksService: registryWebhooksService,
+		RegistryCleanup:         registryCleanupSvc,
+		RegistryConsistency:     registryConsistencySvc,
+		RegistryStorage:         registryStorageMigrationSvc,
+		RegistryContentIndex:    registryContentIndexSvc,
+		RegistryBackfill:        registryBackfillSvc,
+		RegistryDownloadStats:   registryDownloadStats,
+		RegistryUpstreamHealth:  registryUpstreamHealthSvc,
+		RegistryCacheEviction:   registryCacheEvictionSvc,
+		RegistryCachePrewarm:    registryCachePrewarmSvc,
+		RegistryMirrorSync:      registryMirrorSyncSvc,
+		RegistryDrainer:         registryDrainer,
 	}
 }
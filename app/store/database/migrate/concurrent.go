@@ -0,0 +1,122 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// The migrations run in a transaction, which CREATE INDEX CONCURRENTLY can't. The indexes of large
+// tables are built by the files of postgres/concurrent instead, once the migration of the same name
+// is applied, so they don't lock writes to the tables while they are built.
+//
+//go:embed postgres/concurrent/*.sql
+var postgresConcurrent embed.FS
+
+const postgresConcurrentDir = "postgres/concurrent"
+
+// concurrentIndexesLockKey is the advisory lock serializing the index builds of instances
+// migrating at the same time.
+const concurrentIndexesLockKey = 1290129
+
+var concurrentIndexName = regexp.MustCompile(`(?i)CREATE\s+INDEX\s+CONCURRENTLY\s+IF\s+NOT\s+EXISTS\s+(\w+)`)
+
+// createIndexesConcurrently builds the indexes of the applied migrations that aren't built yet.
+// An index left invalid by an interrupted build is dropped and built again.
+func createIndexesConcurrently(ctx context.Context, db *sqlx.DB) error {
+	if db.DriverName() != postgresDriverName {
+		return nil
+	}
+	version, err := Current(ctx, db)
+	if err != nil {
+		return err
+	}
+	names, err := fs.Glob(postgresConcurrent, postgresConcurrentDir+"/*.sql")
+	if err != nil {
+		return fmt.Errorf("failed to list concurrent index files: %w", err)
+	}
+
+	conn, err := db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", concurrentIndexesLockKey); err != nil {
+		return fmt.Errorf("failed to acquire concurrent index lock: %w", err)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)",
+			concurrentIndexesLockKey)
+	}()
+
+	for _, name := range names {
+		migration := strings.TrimSuffix(strings.TrimPrefix(name, postgresConcurrentDir+"/"), ".sql")
+		if migration > version {
+			continue
+		}
+		content, err := fs.ReadFile(postgresConcurrent, name)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		// a multi statement query runs in a transaction, so the statements are executed one by one
+		for _, statement := range strings.Split(string(content), ";") {
+			if err := createIndexConcurrently(ctx, conn, statement); err != nil {
+				return fmt.Errorf("failed to create indexes of %s: %w", migration, err)
+			}
+		}
+	}
+	return nil
+}
+
+func createIndexConcurrently(ctx context.Context, conn *sqlx.Conn, statement string) error {
+	m := concurrentIndexName.FindStringSubmatch(statement)
+	if m == nil {
+		return nil
+	}
+	index := m[1]
+
+	var valid bool
+	err := conn.QueryRowxContext(ctx, `SELECT x.indisvalid FROM pg_index x
+		JOIN pg_class c ON c.oid = x.indexrelid WHERE c.relname = $1`, index).Scan(&valid)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return fmt.Errorf("failed to check index %s: %w", index, err)
+	case valid:
+		return nil
+	default:
+		log.Ctx(ctx).Warn().Str("index", index).Msg("dropping invalid index left by an interrupted build")
+		if _, err = conn.ExecContext(ctx, "DROP INDEX CONCURRENTLY IF EXISTS "+index); err != nil {
+			return fmt.Errorf("failed to drop invalid index %s: %w", index, err)
+		}
+	}
+
+	log.Ctx(ctx).Info().Str("index", index).Msg("creating index concurrently")
+	if _, err = conn.ExecContext(ctx, statement); err != nil {
+		return fmt.Errorf("failed to create index %s: %w", index, err)
+	}
+	return nil
+}
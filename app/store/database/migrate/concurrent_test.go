@@ -0,0 +1,53 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The concurrent index files are only run for an applied migration of the same name, and only their
+// CREATE INDEX CONCURRENTLY IF NOT EXISTS statements are run.
+func TestConcurrentIndexFiles(t *testing.T) {
+	names, err := fs.Glob(postgresConcurrent, postgresConcurrentDir+"/*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, names)
+
+	for _, name := range names {
+		migration := strings.TrimSuffix(strings.TrimPrefix(name, postgresConcurrentDir+"/"), ".sql")
+		_, err := fs.Stat(Postgres, postgresSourceDir+"/"+migration+".up.sql")
+		assert.NoError(t, err, "%s has no migration", name)
+
+		content, err := fs.ReadFile(postgresConcurrent, name)
+		require.NoError(t, err)
+		for _, statement := range strings.Split(string(content), ";") {
+			var lines []string
+			for _, line := range strings.Split(statement, "\n") {
+				if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "--") {
+					lines = append(lines, line)
+				}
+			}
+			if len(lines) == 0 {
+				continue
+			}
+			assert.Regexp(t, concurrentIndexName, statement, "%s runs a statement that isn't a concurrent index", name)
+		}
+	}
+}
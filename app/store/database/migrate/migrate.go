@@ -48,7 +48,10 @@ func Migrate(ctx context.Context, db *sqlx.DB) error {
 	if err != nil {
 		return fmt.Errorf("failed to get migrator: %w", err)
 	}
-	return migrate.New(opts).MigrateUp(ctx)
+	if err = migrate.New(opts).MigrateUp(ctx); err != nil {
+		return err
+	}
+	return createIndexesConcurrently(ctx, db)
 }
 
 // To performs the database migration to the specific version.
@@ -57,7 +60,10 @@ func To(ctx context.Context, db *sqlx.DB, version string) error {
 	if err != nil {
 		return fmt.Errorf("failed to get migrator: %w", err)
 	}
-	return migrate.New(opts).MigrateTo(ctx, version)
+	if err = migrate.New(opts).MigrateTo(ctx, version); err != nil {
+		return err
+	}
+	return createIndexesConcurrently(ctx, db)
 }
 
 // Current returns the current version ID (the latest migration applied) of the database.
@@ -0,0 +1,2 @@
+ALTER TABLE tags DROP COLUMN tag_last_pulled_at;
+ALTER TABLE cleanup_policies DROP COLUMN cp_type;
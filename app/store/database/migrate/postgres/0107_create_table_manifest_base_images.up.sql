@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS manifest_base_images
+(
+    mbi_id              SERIAL PRIMARY KEY,
+    mbi_registry_id     INTEGER NOT NULL,
+    mbi_manifest_id     BIGINT NOT NULL,
+    mbi_image_name      TEXT NOT NULL,
+    mbi_base_repository TEXT NOT NULL,
+    mbi_base_tag        TEXT NOT NULL,
+    mbi_base_digest     TEXT NOT NULL,
+    mbi_created_at      BIGINT NOT NULL,
+    CONSTRAINT unique_mbi_manifest_id
+        UNIQUE (mbi_manifest_id),
+    CONSTRAINT fk_mbi_manifest_id
+        FOREIGN KEY (mbi_manifest_id)
+            REFERENCES manifests(manifest_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_mbi_on_base_repository_and_tag
+    ON manifest_base_images (mbi_base_repository, mbi_base_tag);
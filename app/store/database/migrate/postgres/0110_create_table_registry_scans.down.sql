@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS registry_scan_findings;
+DROP TABLE IF EXISTS registry_scans;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS registry_scans
+(
+    scan_id           SERIAL PRIMARY KEY,
+    scan_registry_id  INTEGER NOT NULL,
+    scan_digest       TEXT NOT NULL,
+    scan_tool         TEXT NOT NULL,
+    scan_tool_version TEXT NOT NULL,
+    scan_started_at   BIGINT NOT NULL,
+    scan_finished_at  BIGINT NOT NULL,
+    scan_created_at   BIGINT NOT NULL,
+    scan_created_by   INTEGER NOT NULL,
+    CONSTRAINT fk_scan_registry_id
+        FOREIGN KEY (scan_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_scan_on_registry_id_and_digest
+    ON registry_scans (scan_registry_id, scan_digest);
+
+CREATE TABLE IF NOT EXISTS registry_scan_findings
+(
+    finding_id              SERIAL PRIMARY KEY,
+    finding_scan_id         INTEGER NOT NULL,
+    finding_identifier      TEXT NOT NULL,
+    finding_severity        TEXT NOT NULL,
+    finding_package_name    TEXT NOT NULL DEFAULT '',
+    finding_package_version TEXT NOT NULL DEFAULT '',
+    finding_fixed_version   TEXT NOT NULL DEFAULT '',
+    finding_title           TEXT NOT NULL DEFAULT '',
+    finding_url             TEXT NOT NULL DEFAULT '',
+    CONSTRAINT fk_finding_scan_id
+        FOREIGN KEY (finding_scan_id)
+            REFERENCES registry_scans(scan_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_finding_on_scan_id
+    ON registry_scan_findings (finding_scan_id);
+
+CREATE INDEX IF NOT EXISTS index_finding_on_identifier
+    ON registry_scan_findings (finding_identifier);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS registry_vex_statements;
+DROP TABLE IF EXISTS registry_vex_documents;
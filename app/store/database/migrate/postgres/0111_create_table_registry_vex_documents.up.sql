@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS registry_vex_documents
+(
+    vexdoc_id          SERIAL PRIMARY KEY,
+    vexdoc_registry_id INTEGER NOT NULL,
+    vexdoc_digest      TEXT NOT NULL,
+    vexdoc_format      TEXT NOT NULL,
+    vexdoc_identifier  TEXT NOT NULL,
+    vexdoc_author      TEXT NOT NULL DEFAULT '',
+    vexdoc_issued_at   BIGINT NOT NULL,
+    vexdoc_content     TEXT NOT NULL,
+    vexdoc_created_at  BIGINT NOT NULL,
+    vexdoc_created_by  INTEGER NOT NULL,
+    CONSTRAINT unique_vexdoc_registry_digest_identifier
+        UNIQUE (vexdoc_registry_id, vexdoc_digest, vexdoc_identifier),
+    CONSTRAINT fk_vexdoc_registry_id
+        FOREIGN KEY (vexdoc_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS registry_vex_statements
+(
+    vexstmt_id               SERIAL PRIMARY KEY,
+    vexstmt_document_id      INTEGER NOT NULL,
+    vexstmt_vulnerability    TEXT NOT NULL,
+    vexstmt_status           TEXT NOT NULL,
+    vexstmt_justification    TEXT NOT NULL DEFAULT '',
+    vexstmt_impact_statement TEXT NOT NULL DEFAULT '',
+    vexstmt_timestamp        BIGINT NOT NULL,
+    CONSTRAINT fk_vexstmt_document_id
+        FOREIGN KEY (vexstmt_document_id)
+            REFERENCES registry_vex_documents(vexdoc_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_vexstmt_on_document_id
+    ON registry_vex_statements (vexstmt_document_id);
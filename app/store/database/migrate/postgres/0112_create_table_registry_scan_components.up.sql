@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS registry_scan_components
+(
+    component_id      SERIAL PRIMARY KEY,
+    component_scan_id INTEGER NOT NULL,
+    component_name    TEXT NOT NULL,
+    component_version TEXT NOT NULL DEFAULT '',
+    component_purl    TEXT NOT NULL DEFAULT '',
+    CONSTRAINT fk_component_scan_id
+        FOREIGN KEY (component_scan_id)
+            REFERENCES registry_scans(scan_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_component_on_scan_id
+    ON registry_scan_components (component_scan_id);
+
+CREATE INDEX IF NOT EXISTS index_component_on_name
+    ON registry_scan_components (LOWER(component_name));
+
+CREATE INDEX IF NOT EXISTS index_finding_on_package_name
+    ON registry_scan_findings (LOWER(finding_package_name));
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS registry_access_grants
+(
+    grant_id           SERIAL PRIMARY KEY,
+    grant_registry_id  INTEGER NOT NULL,
+    grant_principal_id INTEGER NOT NULL,
+    grant_permissions  TEXT NOT NULL,
+    grant_reason       TEXT NOT NULL DEFAULT '',
+    grant_expires_at   BIGINT NOT NULL,
+    grant_created_at   BIGINT NOT NULL,
+    grant_created_by   INTEGER NOT NULL,
+    grant_revoked_at   BIGINT,
+    grant_revoked_by   INTEGER,
+    CONSTRAINT fk_grant_registry_id
+        FOREIGN KEY (grant_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_grant_principal_id
+        FOREIGN KEY (grant_principal_id)
+            REFERENCES principals(principal_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_grant_on_registry_id_principal_id
+    ON registry_access_grants (grant_registry_id, grant_principal_id);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS registry_claim_mappings
+(
+    claimmap_id            SERIAL PRIMARY KEY,
+    claimmap_space_id      INTEGER NOT NULL,
+    claimmap_registry_name TEXT NOT NULL DEFAULT '',
+    claimmap_claim         TEXT NOT NULL,
+    claimmap_value         TEXT NOT NULL,
+    claimmap_role          TEXT NOT NULL,
+    claimmap_created_at    BIGINT NOT NULL,
+    claimmap_created_by    INTEGER NOT NULL,
+    CONSTRAINT unique_claimmap_space_registry_claim_value
+        UNIQUE (claimmap_space_id, claimmap_registry_name, claimmap_claim, claimmap_value),
+    CONSTRAINT fk_claimmap_space_id
+        FOREIGN KEY (claimmap_space_id)
+            REFERENCES spaces(space_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_claimmap_on_claim
+    ON registry_claim_mappings (claimmap_claim);
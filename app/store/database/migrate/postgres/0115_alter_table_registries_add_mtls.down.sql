@@ -0,0 +1,2 @@
+ALTER TABLE registries DROP COLUMN registry_mtls_ca_certificates;
+ALTER TABLE registries DROP COLUMN registry_mtls_mode;
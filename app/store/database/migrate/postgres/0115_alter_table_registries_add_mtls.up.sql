@@ -0,0 +1,2 @@
+ALTER TABLE registries ADD COLUMN registry_mtls_mode TEXT;
+ALTER TABLE registries ADD COLUMN registry_mtls_ca_certificates TEXT;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS index_archive_entry_on_path;
@@ -0,0 +1 @@
+ALTER TABLE download_stats DROP COLUMN download_stat_client_type;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS registry_default_registries
+(
+    defreg_id           SERIAL PRIMARY KEY,
+    defreg_space_id     INTEGER NOT NULL,
+    defreg_package_type TEXT NOT NULL,
+    defreg_registry_id  INTEGER NOT NULL,
+    defreg_created_at   BIGINT NOT NULL,
+    defreg_updated_at   BIGINT NOT NULL,
+    defreg_created_by   INTEGER NOT NULL,
+    defreg_updated_by   INTEGER NOT NULL,
+    CONSTRAINT unique_defreg_space_package_type
+        UNIQUE (defreg_space_id, defreg_package_type),
+    CONSTRAINT fk_defreg_space_id
+        FOREIGN KEY (defreg_space_id)
+            REFERENCES spaces(space_id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_defreg_registry_id
+        FOREIGN KEY (defreg_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS registry_permalinks
+(
+    plink_id          SERIAL PRIMARY KEY,
+    plink_code        TEXT    NOT NULL,
+    plink_registry_id INTEGER NOT NULL,
+    plink_image       TEXT    NOT NULL,
+    plink_version     TEXT    NOT NULL,
+    plink_created_at  BIGINT  NOT NULL,
+    plink_created_by  INTEGER NOT NULL,
+    CONSTRAINT unique_plink_code
+        UNIQUE (plink_code),
+    CONSTRAINT unique_plink_registry_id_image_version
+        UNIQUE (plink_registry_id, plink_image, plink_version),
+    CONSTRAINT fk_plink_registry_id
+        FOREIGN KEY (plink_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
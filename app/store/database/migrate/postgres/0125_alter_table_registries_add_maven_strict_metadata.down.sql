@@ -0,0 +1 @@
+ALTER TABLE registries DROP COLUMN registry_maven_strict_metadata;
@@ -0,0 +1 @@
+ALTER TABLE registries ADD COLUMN registry_maven_strict_metadata BOOLEAN NOT NULL DEFAULT FALSE;
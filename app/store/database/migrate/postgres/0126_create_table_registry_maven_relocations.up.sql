@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS registry_maven_relocations
+(
+    mvnreloc_id                 SERIAL PRIMARY KEY,
+    mvnreloc_registry_id        INTEGER NOT NULL,
+    mvnreloc_group_id           TEXT NOT NULL,
+    mvnreloc_artifact_id        TEXT NOT NULL,
+    mvnreloc_target_group_id    TEXT NOT NULL,
+    mvnreloc_target_artifact_id TEXT NOT NULL,
+    mvnreloc_target_version     TEXT NOT NULL DEFAULT '',
+    mvnreloc_message            TEXT NOT NULL DEFAULT '',
+    mvnreloc_created_at         BIGINT NOT NULL,
+    mvnreloc_updated_at         BIGINT NOT NULL,
+    mvnreloc_created_by         INTEGER NOT NULL,
+    mvnreloc_updated_by         INTEGER NOT NULL,
+    CONSTRAINT unique_mvnreloc_registry_group_artifact
+        UNIQUE (mvnreloc_registry_id, mvnreloc_group_id, mvnreloc_artifact_id),
+    CONSTRAINT fk_mvnreloc_registry_id
+        FOREIGN KEY (mvnreloc_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS registry_upload_sessions
+(
+    upsess_id         TEXT PRIMARY KEY,
+    upsess_path       TEXT NOT NULL,
+    upsess_name       TEXT NOT NULL DEFAULT '',
+    upsess_offset     BIGINT NOT NULL,
+    upsess_created_at BIGINT NOT NULL,
+    upsess_updated_at BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS index_upsess_updated_at ON registry_upload_sessions (upsess_updated_at);
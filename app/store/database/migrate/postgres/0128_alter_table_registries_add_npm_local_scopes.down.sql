@@ -0,0 +1 @@
+ALTER TABLE registries DROP COLUMN registry_npm_local_scopes;
@@ -0,0 +1 @@
+ALTER TABLE registries ADD COLUMN registry_npm_local_scopes TEXT;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS index_images_on_labels_trgm;
+DROP INDEX IF EXISTS index_images_on_image_name_trgm;
+DROP INDEX IF EXISTS index_tags_on_registry_id_image_name_updated_at;
+DROP INDEX IF EXISTS index_artifacts_on_image_id_updated_at;
+DROP INDEX IF EXISTS index_registries_on_parent_id_package_type;
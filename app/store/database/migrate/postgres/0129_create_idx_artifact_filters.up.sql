@@ -0,0 +1,5 @@
+-- the artifact lists filter the registries of a space by package type
+CREATE INDEX IF NOT EXISTS index_registries_on_parent_id_package_type
+    ON registries (registry_parent_id, registry_package_type);
+-- the indexes of the artifacts, tags and images are built without locking writes once the migrations
+-- are applied, see concurrent/0129_create_idx_artifact_filters.sql
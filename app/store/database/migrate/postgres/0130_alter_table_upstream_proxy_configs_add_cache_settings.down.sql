@@ -0,0 +1,3 @@
+ALTER TABLE upstream_proxy_configs DROP COLUMN upstream_proxy_config_offline;
+ALTER TABLE upstream_proxy_configs DROP COLUMN upstream_proxy_config_artifact_ttl;
+ALTER TABLE upstream_proxy_configs DROP COLUMN upstream_proxy_config_metadata_ttl;
@@ -0,0 +1,3 @@
+ALTER TABLE upstream_proxy_configs ADD COLUMN upstream_proxy_config_metadata_ttl INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE upstream_proxy_configs ADD COLUMN upstream_proxy_config_artifact_ttl INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE upstream_proxy_configs ADD COLUMN upstream_proxy_config_offline BOOLEAN NOT NULL DEFAULT FALSE;
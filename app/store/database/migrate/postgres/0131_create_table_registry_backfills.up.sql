@@ -0,0 +1,10 @@
+-- progress of the online backfills, which fill in the columns added by expand migrations in batches
+CREATE TABLE IF NOT EXISTS registry_backfills
+(
+    backfill_name         TEXT PRIMARY KEY,
+    backfill_cursor       BIGINT NOT NULL DEFAULT 0,
+    backfill_rows         BIGINT NOT NULL DEFAULT 0,
+    backfill_started_at   BIGINT NOT NULL,
+    backfill_updated_at   BIGINT NOT NULL,
+    backfill_completed_at BIGINT
+);
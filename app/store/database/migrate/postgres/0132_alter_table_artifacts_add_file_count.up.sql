@@ -0,0 +1,3 @@
+-- expand: a nullable column without a default is added without rewriting or locking the table for long,
+-- the artifacts pushed before are filled in by the artifact_file_count backfill
+ALTER TABLE artifacts ADD COLUMN IF NOT EXISTS artifact_file_count BIGINT;
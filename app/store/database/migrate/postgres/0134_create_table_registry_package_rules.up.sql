@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS registry_package_rules
+(
+    pkgrule_id            SERIAL PRIMARY KEY,
+    pkgrule_registry_id   INTEGER NOT NULL,
+    pkgrule_action        TEXT NOT NULL,
+    pkgrule_group         TEXT NOT NULL DEFAULT '',
+    pkgrule_name          TEXT NOT NULL DEFAULT '',
+    pkgrule_version_range TEXT NOT NULL DEFAULT '',
+    pkgrule_description   TEXT NOT NULL DEFAULT '',
+    pkgrule_created_at    BIGINT NOT NULL,
+    pkgrule_updated_at    BIGINT NOT NULL,
+    pkgrule_created_by    INTEGER NOT NULL,
+    pkgrule_updated_by    INTEGER NOT NULL,
+    CONSTRAINT fk_pkgrule_registry_id
+        FOREIGN KEY (pkgrule_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_pkgrule_registry_id ON registry_package_rules (pkgrule_registry_id);
+
+CREATE TABLE IF NOT EXISTS registry_package_rule_violations
+(
+    pkgviol_id           SERIAL PRIMARY KEY,
+    pkgviol_registry_id  INTEGER NOT NULL,
+    pkgviol_rule_id      INTEGER NOT NULL DEFAULT 0,
+    pkgviol_group        TEXT NOT NULL DEFAULT '',
+    pkgviol_name         TEXT NOT NULL,
+    pkgviol_version      TEXT NOT NULL DEFAULT '',
+    pkgviol_reason       TEXT NOT NULL DEFAULT '',
+    pkgviol_count        BIGINT NOT NULL DEFAULT 1,
+    pkgviol_created_at   BIGINT NOT NULL,
+    pkgviol_last_seen_at BIGINT NOT NULL,
+    CONSTRAINT unique_pkgviol_registry_package
+        UNIQUE (pkgviol_registry_id, pkgviol_group, pkgviol_name, pkgviol_version),
+    CONSTRAINT fk_pkgviol_registry_id
+        FOREIGN KEY (pkgviol_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
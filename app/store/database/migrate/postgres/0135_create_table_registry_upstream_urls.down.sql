@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS registry_upstream_health;
+DROP TABLE IF EXISTS registry_upstream_urls;
@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS registry_upstream_urls
+(
+    upurl_id          SERIAL PRIMARY KEY,
+    upurl_registry_id INTEGER NOT NULL,
+    upurl_position    INTEGER NOT NULL,
+    upurl_url         TEXT NOT NULL,
+    upurl_created_at  BIGINT NOT NULL,
+    upurl_created_by  INTEGER NOT NULL,
+    CONSTRAINT unique_upurl_registry_url
+        UNIQUE (upurl_registry_id, upurl_url),
+    CONSTRAINT fk_upurl_registry_id
+        FOREIGN KEY (upurl_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS registry_upstream_health
+(
+    uphealth_id                   SERIAL PRIMARY KEY,
+    uphealth_registry_id          INTEGER NOT NULL,
+    uphealth_url                  TEXT NOT NULL,
+    uphealth_healthy              BOOLEAN NOT NULL,
+    uphealth_consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    uphealth_last_error           TEXT NOT NULL DEFAULT '',
+    uphealth_latency_ms           BIGINT NOT NULL DEFAULT 0,
+    uphealth_checked_at           BIGINT NOT NULL,
+    CONSTRAINT unique_uphealth_registry_url
+        UNIQUE (uphealth_registry_id, uphealth_url),
+    CONSTRAINT fk_uphealth_registry_id
+        FOREIGN KEY (uphealth_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
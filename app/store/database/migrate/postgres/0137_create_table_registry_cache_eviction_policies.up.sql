@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS registry_cache_eviction_policies
+(
+    cevict_id               SERIAL PRIMARY KEY,
+    cevict_registry_id      INTEGER NOT NULL,
+    cevict_max_size_bytes   BIGINT NOT NULL,
+    cevict_last_run_at      BIGINT NOT NULL DEFAULT 0,
+    cevict_last_evicted     INTEGER NOT NULL DEFAULT 0,
+    cevict_last_freed_bytes BIGINT NOT NULL DEFAULT 0,
+    cevict_created_at       BIGINT NOT NULL,
+    cevict_updated_at       BIGINT NOT NULL,
+    cevict_created_by       INTEGER NOT NULL,
+    cevict_updated_by       INTEGER NOT NULL,
+    CONSTRAINT unique_cevict_registry_id
+        UNIQUE (cevict_registry_id),
+    CONSTRAINT fk_cevict_registry_id
+        FOREIGN KEY (cevict_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS registry_cache_prewarm_artifacts;
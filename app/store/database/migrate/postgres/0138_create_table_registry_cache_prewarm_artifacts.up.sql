@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS registry_cache_prewarm_artifacts
+(
+    cprewarm_id          SERIAL PRIMARY KEY,
+    cprewarm_registry_id INTEGER NOT NULL,
+    cprewarm_batch       TEXT NOT NULL,
+    cprewarm_coordinate  TEXT NOT NULL,
+    cprewarm_status      TEXT NOT NULL,
+    cprewarm_error       TEXT NOT NULL DEFAULT '',
+    cprewarm_created_at  BIGINT NOT NULL,
+    cprewarm_updated_at  BIGINT NOT NULL,
+    cprewarm_created_by  INTEGER NOT NULL,
+    CONSTRAINT fk_cprewarm_registry_id
+        FOREIGN KEY (cprewarm_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_cprewarm_registry_id_batch
+    ON registry_cache_prewarm_artifacts (cprewarm_registry_id, cprewarm_batch);
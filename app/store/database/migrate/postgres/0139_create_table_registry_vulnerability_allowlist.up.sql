@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS registry_vulnerability_allowlist
+(
+    vallow_id            SERIAL PRIMARY KEY,
+    vallow_registry_id   INTEGER NOT NULL,
+    vallow_image_name    TEXT NOT NULL,
+    vallow_digest        TEXT NOT NULL DEFAULT '',
+    vallow_vulnerability TEXT NOT NULL,
+    vallow_justification TEXT NOT NULL,
+    vallow_expires_at    BIGINT NOT NULL,
+    vallow_created_at    BIGINT NOT NULL,
+    vallow_created_by    INTEGER NOT NULL,
+    CONSTRAINT unique_vallow_registry_image_digest_vulnerability
+        UNIQUE (vallow_registry_id, vallow_image_name, vallow_digest, vallow_vulnerability),
+    CONSTRAINT fk_vallow_registry_id
+        FOREIGN KEY (vallow_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS registry_helm_push_policies
+(
+    hpush_id                    SERIAL PRIMARY KEY,
+    hpush_registry_id           INTEGER NOT NULL,
+    hpush_required_values       TEXT,
+    hpush_disallowed_registries TEXT,
+    hpush_check_api_versions    BOOLEAN NOT NULL DEFAULT FALSE,
+    hpush_kube_version          TEXT NOT NULL DEFAULT '',
+    hpush_enforce               BOOLEAN NOT NULL DEFAULT FALSE,
+    hpush_created_at            BIGINT NOT NULL,
+    hpush_updated_at            BIGINT NOT NULL,
+    hpush_created_by            INTEGER NOT NULL,
+    hpush_updated_by            INTEGER NOT NULL,
+    CONSTRAINT unique_hpush_registry_id
+        UNIQUE (hpush_registry_id),
+    CONSTRAINT fk_hpush_registry_id
+        FOREIGN KEY (hpush_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1,69 @@
+CREATE TABLE IF NOT EXISTS registry_mirror_syncs
+(
+    msync_id                   SERIAL PRIMARY KEY,
+    msync_registry_id          INTEGER NOT NULL,
+    msync_upstream_registry_id INTEGER NOT NULL,
+    msync_repository           TEXT NOT NULL,
+    msync_tag_pattern          TEXT NOT NULL DEFAULT '',
+    msync_interval_minutes     INTEGER NOT NULL,
+    msync_enabled              BOOLEAN NOT NULL DEFAULT TRUE,
+    msync_next_run_at          BIGINT NOT NULL,
+    msync_created_at           BIGINT NOT NULL,
+    msync_updated_at           BIGINT NOT NULL,
+    msync_created_by           INTEGER NOT NULL,
+    msync_updated_by           INTEGER NOT NULL,
+    CONSTRAINT unique_msync_registry_id_repository
+        UNIQUE (msync_registry_id, msync_repository),
+    CONSTRAINT fk_msync_registry_id
+        FOREIGN KEY (msync_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_msync_upstream_registry_id
+        FOREIGN KEY (msync_upstream_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_msync_enabled_next_run_at
+    ON registry_mirror_syncs (msync_enabled, msync_next_run_at);
+
+CREATE TABLE IF NOT EXISTS registry_mirror_sync_runs
+(
+    msrun_id          SERIAL PRIMARY KEY,
+    msrun_sync_id     INTEGER NOT NULL,
+    msrun_status      TEXT NOT NULL,
+    msrun_trigger     TEXT NOT NULL,
+    msrun_tags        INTEGER NOT NULL DEFAULT 0,
+    msrun_synced      INTEGER NOT NULL DEFAULT 0,
+    msrun_skipped     INTEGER NOT NULL DEFAULT 0,
+    msrun_failed      INTEGER NOT NULL DEFAULT 0,
+    msrun_error       TEXT NOT NULL DEFAULT '',
+    msrun_started_at  BIGINT NOT NULL DEFAULT 0,
+    msrun_finished_at BIGINT NOT NULL DEFAULT 0,
+    msrun_created_at  BIGINT NOT NULL,
+    msrun_created_by  INTEGER NOT NULL,
+    CONSTRAINT fk_msrun_sync_id
+        FOREIGN KEY (msrun_sync_id)
+            REFERENCES registry_mirror_syncs(msync_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_msrun_sync_id
+    ON registry_mirror_sync_runs (msrun_sync_id);
+
+CREATE TABLE IF NOT EXISTS registry_mirror_sync_artifacts
+(
+    msart_id         SERIAL PRIMARY KEY,
+    msart_run_id     INTEGER NOT NULL,
+    msart_tag        TEXT NOT NULL,
+    msart_digest     TEXT NOT NULL DEFAULT '',
+    msart_status     TEXT NOT NULL,
+    msart_error      TEXT NOT NULL DEFAULT '',
+    msart_updated_at BIGINT NOT NULL,
+    CONSTRAINT unique_msart_run_id_tag
+        UNIQUE (msart_run_id, msart_tag),
+    CONSTRAINT fk_msart_run_id
+        FOREIGN KEY (msart_run_id)
+            REFERENCES registry_mirror_sync_runs(msrun_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1,11 @@
+-- the latest version and tag of an image are ranked by their last update
+CREATE INDEX CONCURRENTLY IF NOT EXISTS index_artifacts_on_image_id_updated_at
+    ON artifacts (artifact_image_id, artifact_updated_at);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS index_tags_on_registry_id_image_name_updated_at
+    ON tags (tag_registry_id, tag_image_name, tag_updated_at);
+-- the name search matches any part of the image name
+CREATE INDEX CONCURRENTLY IF NOT EXISTS index_images_on_image_name_trgm
+    ON images USING gin (image_name gin_trgm_ops);
+-- the label filter matches the labels wrapped in separators, the expression must stay the one of the query
+CREATE INDEX CONCURRENTLY IF NOT EXISTS index_images_on_labels_trgm
+    ON images USING gin (('^_' || image_labels || '^_') gin_trgm_ops);
@@ -0,0 +1,2 @@
+ALTER TABLE registries DROP COLUMN registry_latest_version_pattern;
+ALTER TABLE registries DROP COLUMN registry_latest_version_strategy;
@@ -0,0 +1,2 @@
+ALTER TABLE registries ADD COLUMN registry_latest_version_strategy TEXT;
+ALTER TABLE registries ADD COLUMN registry_latest_version_pattern TEXT;
@@ -0,0 +1,2 @@
+ALTER TABLE cleanup_policies ADD COLUMN cp_type TEXT;
+ALTER TABLE tags ADD COLUMN tag_last_pulled_at BIGINT;
@@ -0,0 +1 @@
+ALTER TABLE registries ADD COLUMN registry_storage_prefix TEXT;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS registry_paused_queues
+(
+    rpq_registry_id INTEGER NOT NULL,
+    rpq_queue       TEXT    NOT NULL,
+    rpq_paused_at   BIGINT  NOT NULL,
+    rpq_paused_by   INTEGER NOT NULL,
+    CONSTRAINT pk_registry_paused_queues
+        PRIMARY KEY (rpq_registry_id, rpq_queue),
+    CONSTRAINT fk_rpq_registry_id
+        FOREIGN KEY (rpq_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS index_finding_on_package_name;
+DROP TABLE IF EXISTS registry_scan_components;
@@ -0,0 +1,51 @@
+CREATE TABLE IF NOT EXISTS archives
+(
+    archive_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    archive_registry_id INTEGER NOT NULL,
+    archive_node_id     TEXT,
+    archive_layer_id    INTEGER,
+    archive_digest      TEXT NOT NULL,
+    archive_image_name  TEXT NOT NULL,
+    archive_version     TEXT NOT NULL,
+    archive_name        TEXT NOT NULL,
+    archive_format      TEXT NOT NULL,
+    archive_entry_count INTEGER NOT NULL,
+    archive_error       TEXT NOT NULL DEFAULT '',
+    archive_indexed_at  BIGINT NOT NULL,
+    CONSTRAINT unique_archive_node_id
+        UNIQUE (archive_node_id),
+    CONSTRAINT unique_archive_layer_id
+        UNIQUE (archive_layer_id),
+    CONSTRAINT fk_archive_registry_id
+        FOREIGN KEY (archive_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_archive_node_id
+        FOREIGN KEY (archive_node_id)
+            REFERENCES nodes(node_id)
+            ON DELETE CASCADE,
+    CONSTRAINT fk_archive_layer_id
+        FOREIGN KEY (archive_layer_id)
+            REFERENCES layers(layer_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_archive_on_registry_id
+    ON archives (archive_registry_id);
+CREATE INDEX IF NOT EXISTS index_archive_on_digest
+    ON archives (archive_digest);
+
+CREATE TABLE IF NOT EXISTS archive_entries
+(
+    archive_entry_id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    archive_entry_archive_id INTEGER NOT NULL,
+    archive_entry_path       TEXT NOT NULL,
+    archive_entry_size       BIGINT NOT NULL,
+    CONSTRAINT fk_archive_entry_archive_id
+        FOREIGN KEY (archive_entry_archive_id)
+            REFERENCES archives(archive_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_archive_entry_on_archive_id
+    ON archive_entries (archive_entry_archive_id);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS archive_classes
+(
+    archive_class_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    archive_class_archive_id  INTEGER NOT NULL,
+    archive_class_name        TEXT NOT NULL,
+    archive_class_simple_name TEXT NOT NULL,
+    CONSTRAINT fk_archive_class_archive_id
+        FOREIGN KEY (archive_class_archive_id)
+            REFERENCES archives(archive_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_archive_class_on_archive_id
+    ON archive_classes (archive_class_archive_id);
+CREATE INDEX IF NOT EXISTS index_archive_class_on_simple_name
+    ON archive_classes (archive_class_simple_name);
+
+-- zip archives were indexed without their classes, dropping them makes the content index pick them up again
+DELETE FROM archives WHERE archive_format = 'zip';
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS index_archive_entry_on_path
+    ON archive_entries (archive_entry_path);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS index_download_stat_on_artifact_client_timestamp;
+ALTER TABLE download_stats DROP COLUMN download_stat_unique;
+ALTER TABLE download_stats DROP COLUMN download_stat_client;
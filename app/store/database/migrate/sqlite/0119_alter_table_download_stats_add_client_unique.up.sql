@@ -0,0 +1,6 @@
+ALTER TABLE download_stats ADD COLUMN download_stat_client TEXT NOT NULL DEFAULT '';
+-- downloads recorded before deduplication are counted as unique, which keeps the counts they showed
+ALTER TABLE download_stats ADD COLUMN download_stat_unique BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE INDEX IF NOT EXISTS index_download_stat_on_artifact_client_timestamp
+    ON download_stats (download_stat_artifact_id, download_stat_client, download_stat_timestamp);
@@ -0,0 +1,2 @@
+-- the client type of downloads recorded before is unknown, they're counted as other clients
+ALTER TABLE download_stats ADD COLUMN download_stat_client_type TEXT NOT NULL DEFAULT 'OTHER';
@@ -0,0 +1,2 @@
+ALTER TABLE download_stats DROP COLUMN download_stat_region;
+ALTER TABLE download_stats DROP COLUMN download_stat_network;
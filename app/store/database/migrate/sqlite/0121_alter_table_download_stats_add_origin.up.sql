@@ -0,0 +1,3 @@
+-- the origin is recorded if enabled, it's unknown for downloads recorded before
+ALTER TABLE download_stats ADD COLUMN download_stat_network TEXT NOT NULL DEFAULT '';
+ALTER TABLE download_stats ADD COLUMN download_stat_region TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS registry_config_revisions
+(
+    rcrev_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    rcrev_registry_id INTEGER NOT NULL,
+    rcrev_revision    INTEGER NOT NULL,
+    rcrev_config      TEXT NOT NULL,
+    rcrev_created_at  BIGINT NOT NULL,
+    rcrev_created_by  INTEGER NOT NULL,
+    CONSTRAINT unique_rcrev_registry_revision
+        UNIQUE (rcrev_registry_id, rcrev_revision),
+    CONSTRAINT fk_rcrev_registry_id
+        FOREIGN KEY (rcrev_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
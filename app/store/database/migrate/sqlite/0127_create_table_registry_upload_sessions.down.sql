@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS index_upsess_updated_at;
+DROP TABLE IF EXISTS registry_upload_sessions;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS index_tags_on_registry_id_image_name_updated_at;
+DROP INDEX IF EXISTS index_artifacts_on_image_id_updated_at;
+DROP INDEX IF EXISTS index_registries_on_parent_id_package_type;
@@ -0,0 +1,8 @@
+-- the artifact lists filter the registries of a space by package type
+CREATE INDEX IF NOT EXISTS index_registries_on_parent_id_package_type
+    ON registries (registry_parent_id, registry_package_type);
+-- the latest version and tag of an image are ranked by their last update
+CREATE INDEX IF NOT EXISTS index_artifacts_on_image_id_updated_at
+    ON artifacts (artifact_image_id, artifact_updated_at);
+CREATE INDEX IF NOT EXISTS index_tags_on_registry_id_image_name_updated_at
+    ON tags (tag_registry_id, tag_image_name, tag_updated_at);
@@ -0,0 +1,2 @@
+-- expand: the artifacts pushed before are filled in by the artifact_file_count backfill
+ALTER TABLE artifacts ADD COLUMN artifact_file_count BIGINT;
@@ -0,0 +1,2 @@
+ALTER TABLE upstream_proxy_configs DROP COLUMN upstream_proxy_config_credentials_updated_at;
+ALTER TABLE upstream_proxy_configs DROP COLUMN upstream_proxy_config_aws_role_arn;
@@ -0,0 +1,4 @@
+ALTER TABLE upstream_proxy_configs ADD COLUMN upstream_proxy_config_aws_role_arn TEXT NOT NULL DEFAULT '';
+-- the credentials of the upstream proxies created before are considered set when they were created
+ALTER TABLE upstream_proxy_configs ADD COLUMN upstream_proxy_config_credentials_updated_at BIGINT NOT NULL DEFAULT 0;
+UPDATE upstream_proxy_configs SET upstream_proxy_config_credentials_updated_at = upstream_proxy_config_created_at;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS registry_package_rule_violations;
+DROP TABLE IF EXISTS registry_package_rules;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS index_delcert_registry_id;
+DROP TABLE IF EXISTS registry_deletion_certificates;
+DROP TABLE IF EXISTS registry_legal_holds;
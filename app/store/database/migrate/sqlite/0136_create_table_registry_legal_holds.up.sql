@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS registry_legal_holds
+(
+    lhold_id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    lhold_registry_id INTEGER NOT NULL,
+    lhold_image_name  TEXT NOT NULL,
+    lhold_version     TEXT NOT NULL DEFAULT '',
+    lhold_reason      TEXT NOT NULL,
+    lhold_created_at  BIGINT NOT NULL,
+    lhold_created_by  INTEGER NOT NULL,
+    CONSTRAINT unique_lhold_registry_image_version
+        UNIQUE (lhold_registry_id, lhold_image_name, lhold_version),
+    CONSTRAINT fk_lhold_registry_id
+        FOREIGN KEY (lhold_registry_id)
+            REFERENCES registries(registry_id)
+            ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS registry_deletion_certificates
+(
+    delcert_id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    delcert_registry_id   INTEGER NOT NULL,
+    delcert_registry_name TEXT NOT NULL,
+    delcert_package_type  TEXT NOT NULL,
+    delcert_image_name    TEXT NOT NULL,
+    delcert_version       TEXT NOT NULL,
+    delcert_reason        TEXT NOT NULL,
+    delcert_details       TEXT NOT NULL,
+    delcert_checksum      TEXT NOT NULL,
+    delcert_deleted_at    BIGINT NOT NULL,
+    delcert_deleted_by    INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS index_delcert_registry_id
+    ON registry_deletion_certificates (delcert_registry_id);
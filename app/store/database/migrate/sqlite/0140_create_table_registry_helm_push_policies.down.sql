@@ -0,0 +1 @@
+DROP TABLE IF EXISTS registry_helm_push_policies;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS registry_mirror_sync_artifacts;
+DROP TABLE IF EXISTS registry_mirror_sync_runs;
+DROP TABLE IF EXISTS registry_mirror_syncs;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS manifest_annotations
+(
+    mann_manifest_id BIGINT NOT NULL,
+    mann_registry_id INTEGER NOT NULL,
+    mann_key         TEXT NOT NULL,
+    mann_value       TEXT NOT NULL,
+    CONSTRAINT pk_mann_manifest_id_key
+        PRIMARY KEY (mann_manifest_id, mann_key),
+    CONSTRAINT fk_mann_manifest_id
+        FOREIGN KEY (mann_manifest_id)
+            REFERENCES manifests(manifest_id)
+            ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_mann_on_registry_id_and_key
+    ON manifest_annotations (mann_registry_id, mann_key);
+
+INSERT INTO manifest_annotations (mann_manifest_id, mann_registry_id, mann_key, mann_value)
+SELECT m.manifest_id, m.manifest_registry_id, a.key, a.value
+FROM manifests m, json_each(CAST(m.manifest_annotations AS TEXT)) a
+WHERE json_type(CAST(m.manifest_annotations AS TEXT)) = 'object';
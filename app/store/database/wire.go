@@ -29,6 +29,7 @@ import (
 // WireSet provides a wire set for this package.
 var WireSet = wire.NewSet(
 	ProvideDatabase,
+	ProvideReadReplica,
 	ProvidePrincipalStore,
 	ProvideUserGroupStore,
 	ProvideUserGroupReviewerStore,
@@ -92,6 +93,20 @@ func ProvideDatabase(ctx context.Context, config database.Config) (*sqlx.DB, err
 	)
 }
 
+// ProvideReadReplica provides the read replica of the database, nil if none is configured.
+func ProvideReadReplica(ctx context.Context, config database.Config) (*database.Replica, error) {
+	if config.ReadReplicaDatasource == "" {
+		return nil, nil
+	}
+	return database.ConnectReplica(
+		ctx,
+		config.Driver,
+		config.ReadReplicaDatasource,
+		config.ReadReplicaMaxLag,
+		config.ReadReplicaLagCheckInterval,
+	)
+}
+
 // ProvidePrincipalStore provides a principal store.
 func ProvidePrincipalStore(db *sqlx.DB, uidTransformation store.PrincipalUIDTransformation) store.PrincipalStore {
 	return NewPrincipalStore(db, uidTransformation)
@@ -85,6 +85,10 @@ type Provider interface {
 
 	// GenerateUIRegistryURL returns the url for the UI screen of a registry.
 	GenerateUIRegistryURL(ctx context.Context, parentSpacePath string, registryName string) string
+
+	// GenerateAPIURL returns the public url of a rest API endpoint, e.g. v1/registry/permalinks/{code}.
+	// NOTE: url is guaranteed to not have any trailing '/'.
+	GenerateAPIURL(ctx context.Context, params ...string) string
 }
 
 // Provider provides the URLs of the Harness system.
@@ -287,6 +291,13 @@ func (p *provider) GenerateUIRegistryURL(_ context.Context, parentSpacePath stri
 	return p.uiURL.String() + "/spaces/" + space + "/registries/" + registryName
 }
 
+func (p *provider) GenerateAPIURL(_ context.Context, params ...string) string {
+	u := *p.apiURL
+	segments := append([]string{u.Path}, params...)
+	u.Path = path.Join(segments...)
+	return strings.TrimRight(u.String(), "/")
+}
+
 func BuildGITCloneSSHURL(user string, sshURL *url.URL, repoPath string) string {
 	repoPath = path.Clean(repoPath)
 	if !strings.HasSuffix(repoPath, GITSuffix) {
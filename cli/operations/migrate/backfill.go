@@ -0,0 +1,76 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/services/backfill"
+
+	"gopkg.in/alecthomas/kingpin.v2"
+)
+
+type commandBackfill struct {
+	envfile string
+}
+
+// run fills in the columns added by the expand migrations of the registry tables, the same way the
+// background backfill job does, for installations that want them filled in before they upgrade to a
+// version with the contract migrations.
+func (c *commandBackfill) run(*kingpin.ParseContext) error {
+	ctx := setupLoggingContext(context.Background())
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt)
+	defer cancel()
+
+	config, err := getConfig(c.envfile)
+	if err != nil {
+		return err
+	}
+
+	db, err := getDB(ctx, c.envfile)
+	if err != nil {
+		return err
+	}
+
+	runner := backfill.NewRunner(database.NewBackfillDao(db), config.Registry.Backfill.BatchSize,
+		config.Registry.Backfill.Pause)
+	for _, b := range backfill.Backfills(database.NewArtifactDao(db)) {
+		progress, err := runner.Run(ctx, b)
+		if err != nil {
+			return err
+		}
+		if !progress.Completed() {
+			return fmt.Errorf("backfill %s interrupted after %d rows", b.Name, progress.Rows)
+		}
+		fmt.Printf("%s: completed, %d rows\n", b.Name, progress.Rows)
+	}
+
+	return nil
+}
+
+func registerBackfill(app *kingpin.CmdClause) {
+	c := &commandBackfill{}
+
+	cmd := app.Command("backfill", "fills in the columns added to the registry tables by expand migrations").
+		Action(c.run)
+
+	cmd.Arg("envfile", "load the environment variable file").
+		Default("").
+		StringVar(&c.envfile)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/harness/gitness/cli/operations/server"
 	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/types"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/joho/godotenv"
@@ -33,14 +34,13 @@ func Register(app *kingpin.Application) {
 	cmd := app.Command("migrate", "database migration tool")
 	registerCurrent(cmd)
 	registerTo(cmd)
+	registerBackfill(cmd)
 }
 
 func getDB(ctx context.Context, envfile string) (*sqlx.DB, error) {
-	_ = godotenv.Load(envfile)
-
-	config, err := server.LoadConfig()
+	config, err := getConfig(envfile)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load configuration: %w", err)
+		return nil, err
 	}
 
 	db, err := database.Connect(ctx, config.Database.Driver, config.Database.Datasource)
@@ -51,6 +51,16 @@ func getDB(ctx context.Context, envfile string) (*sqlx.DB, error) {
 	return db, nil
 }
 
+func getConfig(envfile string) (*types.Config, error) {
+	_ = godotenv.Load(envfile)
+
+	config, err := server.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+	return config, nil
+}
+
 func setupLoggingContext(ctx context.Context) context.Context {
 	zerolog.SetGlobalLevel(zerolog.TraceLevel)
 	log := log.Logger.With().Logger()
@@ -271,8 +271,11 @@ func getSanitizedMachineName() (string, error) {
 // ProvideDatabaseConfig loads the database config from the main config.
 func ProvideDatabaseConfig(config *types.Config) database.Config {
 	return database.Config{
-		Driver:     config.Database.Driver,
-		Datasource: config.Database.Datasource,
+		Driver:                      config.Database.Driver,
+		Datasource:                  config.Database.Datasource,
+		ReadReplicaDatasource:       config.Database.ReadReplica.Datasource,
+		ReadReplicaMaxLag:           config.Database.ReadReplica.MaxLag,
+		ReadReplicaLagCheckInterval: config.Database.ReadReplica.LagCheckInterval,
 	}
 }
 
@@ -35,6 +35,10 @@ import (
 	"gopkg.in/alecthomas/kingpin.v2"
 )
 
+// registryDrainAbortGrace is how long the registry transfers canceled at the end of the drain have to
+// store their resumable state.
+const registryDrainAbortGrace = 10 * time.Second
+
 type command struct {
 	envfile     string
 	enableCI    bool
@@ -105,6 +109,56 @@ func (c *command) run(*kingpin.ParseContext) error {
 			return err
 		}
 
+		if err := system.services.RegistryCleanup.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry cleanup service")
+			return err
+		}
+
+		if err := system.services.RegistryConsistency.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry consistency check service")
+			return err
+		}
+
+		if err := system.services.RegistryStorage.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry storage migration service")
+			return err
+		}
+
+		if err := system.services.RegistryContentIndex.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry content index service")
+			return err
+		}
+
+		if err := system.services.RegistryBackfill.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry backfill service")
+			return err
+		}
+
+		if err := system.services.RegistryDownloadStats.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry download stats recorder")
+			return err
+		}
+
+		if err := system.services.RegistryUpstreamHealth.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry upstream health check service")
+			return err
+		}
+
+		if err := system.services.RegistryCacheEviction.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry cache eviction service")
+			return err
+		}
+
+		if err := system.services.RegistryCachePrewarm.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry cache prewarm service")
+			return err
+		}
+
+		if err := system.services.RegistryMirrorSync.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register registry mirror sync service")
+			return err
+		}
+
 		return system.services.JobScheduler.Run(gCtx)
 	})
 
@@ -156,6 +210,14 @@ func (c *command) run(*kingpin.ParseContext) error {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), config.GracefulShutdownTime)
 	defer cancel()
 
+	// drain the registry transfers before the http server stops accepting connections
+	drainCtx, cancelDrain := context.WithTimeout(shutdownCtx, config.Registry.DrainTimeout)
+	log.Info().Msgf("draining %d in-flight registry transfers", system.services.RegistryDrainer.InFlight())
+	if aborted := system.services.RegistryDrainer.Drain(drainCtx, registryDrainAbortGrace); aborted > 0 {
+		log.Warn().Msgf("canceled %d registry transfers that didn't finish within the drain timeout", aborted)
+	}
+	cancelDrain()
+
 	if sErr := shutdownHTTP(shutdownCtx); sErr != nil {
 		log.Err(sErr).Msg("failed to shutdown http server gracefully")
 	}
@@ -131,6 +131,25 @@ import (
 	"github.com/harness/gitness/pubsub"
 	registryevents "github.com/harness/gitness/registry/app/events"
 	"github.com/harness/gitness/registry/app/pkg/docker"
+	registryaccessgrant "github.com/harness/gitness/registry/services/accessgrant"
+	registrybackfill "github.com/harness/gitness/registry/services/backfill"
+	registrycacheeviction "github.com/harness/gitness/registry/services/cacheeviction"
+	registrycacheprewarm "github.com/harness/gitness/registry/services/cacheprewarm"
+	registryclaimsmapping "github.com/harness/gitness/registry/services/claimsmapping"
+	registrycleanup "github.com/harness/gitness/registry/services/cleanup"
+	registrycompliance "github.com/harness/gitness/registry/services/compliance"
+	registryconsistency "github.com/harness/gitness/registry/services/consistency"
+	registrycontentindex "github.com/harness/gitness/registry/services/contentindex"
+	registrydownloadstats "github.com/harness/gitness/registry/services/downloadstats"
+	registryenrichment "github.com/harness/gitness/registry/services/enrichment"
+	registryevidence "github.com/harness/gitness/registry/services/evidence"
+	registrymirrorsync "github.com/harness/gitness/registry/services/mirrorsync"
+	registryonboarding "github.com/harness/gitness/registry/services/onboarding"
+	registryqueue "github.com/harness/gitness/registry/services/queue"
+	registryresolution "github.com/harness/gitness/registry/services/resolution"
+	registrysecurity "github.com/harness/gitness/registry/services/security"
+	registrystoragemigration "github.com/harness/gitness/registry/services/storagemigration"
+	registryupstreamhealth "github.com/harness/gitness/registry/services/upstreamhealth"
 	registrywebhooks "github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/ssh"
 	"github.com/harness/gitness/store/database/dbtx"
@@ -283,6 +302,25 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		usage.WireSet,
 		registryevents.WireSet,
 		registrywebhooks.WireSet,
+		registrycleanup.WireSet,
+		registryconsistency.WireSet,
+		registryqueue.WireSet,
+		registrysecurity.WireSet,
+		registryevidence.WireSet,
+		registryresolution.WireSet,
+		registryaccessgrant.WireSet,
+		registryclaimsmapping.WireSet,
+		registrycompliance.WireSet,
+		registrycontentindex.WireSet,
+		registrybackfill.WireSet,
+		registrydownloadstats.WireSet,
+		registryupstreamhealth.WireSet,
+		registrycacheeviction.WireSet,
+		registrycacheprewarm.WireSet,
+		registrymirrorsync.WireSet,
+		registryenrichment.WireSet,
+		registryonboarding.WireSet,
+		registrystoragemigration.WireSet,
 	)
 	return &cliserver.System{}, nil
 }
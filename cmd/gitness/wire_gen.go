@@ -125,13 +125,56 @@ import (
 	"github.com/harness/gitness/registry/app/api/router"
 	events9 "github.com/harness/gitness/registry/app/events"
 	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/alpine"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
+	"github.com/harness/gitness/registry/app/pkg/cargo"
+	"github.com/harness/gitness/registry/app/pkg/cocoapods"
+	"github.com/harness/gitness/registry/app/pkg/composer"
+	"github.com/harness/gitness/registry/app/pkg/conan"
+	"github.com/harness/gitness/registry/app/pkg/conda"
+	"github.com/harness/gitness/registry/app/pkg/cran"
+	"github.com/harness/gitness/registry/app/pkg/debian"
 	"github.com/harness/gitness/registry/app/pkg/docker"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/pkg/galaxy"
+	"github.com/harness/gitness/registry/app/pkg/gems"
 	"github.com/harness/gitness/registry/app/pkg/generic"
+	"github.com/harness/gitness/registry/app/pkg/gomodule"
+	"github.com/harness/gitness/registry/app/pkg/hex"
+	"github.com/harness/gitness/registry/app/pkg/homebrew"
+	"github.com/harness/gitness/registry/app/pkg/lease"
 	"github.com/harness/gitness/registry/app/pkg/maven"
+	"github.com/harness/gitness/registry/app/pkg/model"
+	"github.com/harness/gitness/registry/app/pkg/npm"
+	"github.com/harness/gitness/registry/app/pkg/nuget"
+	"github.com/harness/gitness/registry/app/pkg/pub"
+	"github.com/harness/gitness/registry/app/pkg/puppet"
 	"github.com/harness/gitness/registry/app/pkg/pypi"
+	"github.com/harness/gitness/registry/app/pkg/rpm"
+	"github.com/harness/gitness/registry/app/pkg/swift"
+	"github.com/harness/gitness/registry/app/pkg/terraform"
+	"github.com/harness/gitness/registry/app/pkg/vagrant"
 	database2 "github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/services/accessgrant"
+	"github.com/harness/gitness/registry/services/backfill"
+	"github.com/harness/gitness/registry/services/cacheeviction"
+	"github.com/harness/gitness/registry/services/cacheprewarm"
+	"github.com/harness/gitness/registry/services/claimsmapping"
+	cleanup2 "github.com/harness/gitness/registry/services/cleanup"
+	"github.com/harness/gitness/registry/services/compliance"
+	"github.com/harness/gitness/registry/services/consistency"
+	"github.com/harness/gitness/registry/services/contentindex"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/registry/services/enrichment"
+	"github.com/harness/gitness/registry/services/evidence"
+	"github.com/harness/gitness/registry/services/mirrorsync"
+	"github.com/harness/gitness/registry/services/onboarding"
+	"github.com/harness/gitness/registry/services/queue"
+	"github.com/harness/gitness/registry/services/resolution"
+	"github.com/harness/gitness/registry/services/security"
+	"github.com/harness/gitness/registry/services/storagemigration"
+	"github.com/harness/gitness/registry/services/upstreamhealth"
 	webhook3 "github.com/harness/gitness/registry/services/webhook"
 	"github.com/harness/gitness/ssh"
 	"github.com/harness/gitness/store/database/dbtx"
@@ -178,9 +221,12 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	repoRefCache := cache.ProvideRepoRefCache(ctx, repoStore, evictor, cacheEvictor)
 	repoFinder := refcache.ProvideRepoFinder(repoStore, spacePathCache, repoIDCache, repoRefCache, cacheEvictor)
 	publicaccessService := publicaccess.ProvidePublicAccess(config, publicAccessStore, spaceFinder, repoFinder)
-	authorizer := authz.ProvideAuthorizer(permissionCache, spaceFinder, publicaccessService)
+	accessGrantRepository := database2.ProvideAccessGrantDao(db)
 	principalUIDTransformation := store.ProvidePrincipalUIDTransformation()
 	principalStore := database.ProvidePrincipalStore(db, principalUIDTransformation)
+	accessgrantService := accessgrant.ProvideService(accessGrantRepository, principalStore, spaceFinder)
+	registryAccessGrants := accessgrant.ProvideRegistryAccessGrants(accessgrantService)
+	authorizer := authz.ProvideAuthorizer(permissionCache, spaceFinder, publicaccessService, registryAccessGrants)
 	tokenStore := database.ProvideTokenStore(db)
 	publicKeyStore := database.ProvidePublicKeyStore(db)
 	controller := user.ProvideController(transactor, principalUID, authorizer, principalStore, tokenStore, membershipStore, publicKeyStore)
@@ -453,8 +499,10 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	mediaTypesRepository := database2.ProvideMediaTypeDao(db)
 	blobRepository := database2.ProvideBlobDao(db, mediaTypesRepository)
 	storageService := docker.StorageServiceProvider(config, storageDriver)
-	gcService := gc.ServiceProvider()
-	app := docker.NewApp(ctx, storageDeleter, blobRepository, spaceStore, config, storageService, gcService)
+	leaseLocker := lease.ProvideLocker(mutexManager)
+	gcService := gc.ServiceProvider(leaseLocker)
+	uploadSessionRepository := database2.ProvideUploadSessionDao(db)
+	app := docker.NewApp(ctx, storageDeleter, blobRepository, spaceStore, config, storageService, gcService, uploadSessionRepository)
 	registryRepository := database2.ProvideRepoDao(db, mediaTypesRepository)
 	manifestRepository := database2.ProvideManifestDao(db, mediaTypesRepository)
 	manifestReferenceRepository := database2.ProvideManifestRefDao(db)
@@ -468,19 +516,30 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	if err != nil {
 		return nil, err
 	}
-	manifestService := docker.ManifestServiceProvider(registryRepository, manifestRepository, blobRepository, mediaTypesRepository, manifestReferenceRepository, tagRepository, imageRepository, artifactRepository, layerRepository, gcService, transactor, eventReporter, spaceFinder, ociImageIndexMappingRepository, reporter7, provider)
+	baseImageRepository := database2.ProvideBaseImageDao(db)
+	manifestService := docker.ManifestServiceProvider(registryRepository, manifestRepository, blobRepository, mediaTypesRepository, manifestReferenceRepository, tagRepository, imageRepository, artifactRepository, layerRepository, gcService, transactor, eventReporter, spaceFinder, ociImageIndexMappingRepository, reporter7, provider, baseImageRepository)
 	registryBlobRepository := database2.ProvideRegistryBlobDao(db)
 	bandwidthStatRepository := database2.ProvideBandwidthStatDao(db)
-	downloadStatRepository := database2.ProvideDownloadStatDao(db)
-	localRegistry := docker.LocalRegistryProvider(app, manifestService, blobRepository, registryRepository, manifestRepository, registryBlobRepository, mediaTypesRepository, tagRepository, imageRepository, artifactRepository, bandwidthStatRepository, downloadStatRepository, gcService, transactor)
+	downloadStatRepository := database2.ProvideDownloadStatDao(db, config)
+	legalHoldRepository := database2.ProvideLegalHoldDao(db)
+	helmPushPolicyRepository := database2.ProvideHelmPushPolicyDao(db)
+	localRegistry := docker.LocalRegistryProvider(app, manifestService, blobRepository, registryRepository, manifestRepository, registryBlobRepository, mediaTypesRepository, tagRepository, imageRepository, artifactRepository, bandwidthStatRepository, downloadStatRepository, gcService, transactor, legalHoldRepository, helmPushPolicyRepository)
 	upstreamProxyConfigRepository := database2.ProvideUpstreamDao(db, registryRepository, spaceFinder)
 	proxyController := docker.ProvideProxyController(localRegistry, manifestService, secretService, spaceFinder)
-	remoteRegistry := docker.RemoteRegistryProvider(localRegistry, app, upstreamProxyConfigRepository, spaceFinder, secretService, proxyController)
+	packageRuleRepository := database2.ProvidePackageRuleDao(db)
+	upstreamURLRepository := database2.ProvideUpstreamURLDao(db)
+	remoteRegistry := docker.RemoteRegistryProvider(localRegistry, app, upstreamProxyConfigRepository, spaceFinder, secretService, proxyController, packageRuleRepository, upstreamURLRepository)
 	coreController := pkg.CoreControllerProvider(registryRepository)
-	dbStore := docker.DBStoreProvider(blobRepository, imageRepository, artifactRepository, bandwidthStatRepository, downloadStatRepository)
+	recorder, err := downloadstats.ProvideRecorder(config, transactor, downloadStatRepository)
+	if err != nil {
+		return nil, err
+	}
+	dbStore := docker.DBStoreProvider(blobRepository, imageRepository, artifactRepository, bandwidthStatRepository, recorder, tagRepository)
 	dockerController := docker.ControllerProvider(localRegistry, remoteRegistry, coreController, spaceStore, authorizer, dbStore)
-	handler := api2.NewHandlerProvider(dockerController, spaceFinder, spaceStore, tokenStore, controller, authenticator, provider, authorizer, config)
-	registryOCIHandler := router.OCIHandlerProvider(handler)
+	blobserveServer := blobserve.ProvideServer(config)
+	handler := api2.NewHandlerProvider(dockerController, spaceFinder, spaceStore, tokenStore, controller, authenticator, provider, authorizer, config, blobserveServer)
+	ratelimitLimiter := router.RateLimiterProvider(config)
+	registryOCIHandler := router.OCIHandlerProvider(handler, ratelimitLimiter)
 	filemanagerApp := filemanager.NewApp(ctx, config, storageService)
 	genericBlobRepository := database2.ProvideGenericBlobDao(db)
 	nodesRepository := database2.ProvideNodeDao(db)
@@ -496,23 +555,113 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	if err != nil {
 		return nil, err
 	}
-	apiHandler := router.APIHandlerProvider(registryRepository, upstreamProxyConfigRepository, fileManager, tagRepository, manifestRepository, cleanupPolicyRepository, imageRepository, storageDriver, spaceFinder, transactor, authenticator, provider, authorizer, auditService, artifactRepository, webhooksRepository, webhooksExecutionRepository, service2, spacePathStore)
-	mavenDBStore := maven.DBStoreProvider(registryRepository, imageRepository, artifactRepository, spaceStore, bandwidthStatRepository, downloadStatRepository, nodesRepository, upstreamProxyConfigRepository)
+	registryQueueRepository := database2.ProvideRegistryQueueDao(db)
+	storagemigrationService := storagemigration.ProvideService(jobScheduler, executor, registryRepository, blobRepository, genericBlobRepository, registryQueueRepository, spaceFinder, storageDriver)
+	archiveRepository := database2.ProvideArchiveDao(db)
+	contentindexService := contentindex.ProvideService(config, jobScheduler, executor, archiveRepository, registryRepository, spaceFinder, storageDriver)
+	queueService := queue.ProvideService(registryQueueRepository, cleanupPolicyRepository, storagemigrationService, contentindexService)
+	scanRepository := database2.ProvideScanDao(db)
+	vexRepository := database2.ProvideVexDao(db)
+	vulnerabilityAllowlistRepository := database2.ProvideVulnerabilityAllowlistDao(db)
+	securityService := security.ProvideService(registryRepository, manifestRepository, nodesRepository, scanRepository, vexRepository, vulnerabilityAllowlistRepository)
+	evidenceService, err := evidence.ProvideService(config, manifestRepository, imageRepository, artifactRepository, scanRepository, vexRepository, fileManager)
+	if err != nil {
+		return nil, err
+	}
+	resolutionService := resolution.ProvideService(registryRepository, upstreamProxyConfigRepository, manifestRepository, imageRepository, artifactRepository, nodesRepository, spaceFinder, secretService, packageRuleRepository)
+	claimMappingRepository := database2.ProvideClaimMappingDao(db)
+	claimsmappingService, err := claimsmapping.ProvideService(config, transactor, claimMappingRepository, accessGrantRepository, registryRepository, principalStore, tokenStore)
+	if err != nil {
+		return nil, err
+	}
+	enrichmentService := enrichment.ProvideService(config, scanRepository, fileManager)
+	onboardingService := onboarding.ProvideService(config)
+	defaultRegistryRepository := database2.ProvideDefaultRegistryDao(db)
+	registryConfigRevisionRepository := database2.ProvideRegistryConfigRevisionDao(db)
+	permalinkRepository := database2.ProvidePermalinkDao(db)
+	mavenRelocationRepository := database2.ProvideMavenRelocationDao(db)
+	replica, err := database.ProvideReadReplica(ctx, databaseConfig)
+	if err != nil {
+		return nil, err
+	}
+	deletionCertificateRepository := database2.ProvideDeletionCertificateDao(db)
+	cacheEvictionRepository := database2.ProvideCacheEvictionDao(db)
+	complianceService := compliance.ProvideService(transactor, imageRepository, artifactRepository, nodesRepository, genericBlobRepository, manifestRepository, tagRepository, downloadStatRepository, legalHoldRepository, deletionCertificateRepository, gcService, spaceFinder, storageDriver)
+	mavenDBStore := maven.DBStoreProvider(registryRepository, imageRepository, artifactRepository, spaceStore, bandwidthStatRepository, recorder, nodesRepository, upstreamProxyConfigRepository, mavenRelocationRepository, packageRuleRepository, upstreamURLRepository)
 	mavenLocalRegistry := maven.LocalRegistryProvider(mavenDBStore, transactor, fileManager)
 	mavenController := maven.ProvideProxyController(mavenLocalRegistry, secretService, spaceFinder)
 	mavenRemoteRegistry := maven.RemoteRegistryProvider(mavenDBStore, transactor, mavenLocalRegistry, mavenController)
 	controller2 := maven.ControllerProvider(mavenLocalRegistry, mavenRemoteRegistry, authorizer, mavenDBStore)
-	mavenHandler := api2.NewMavenHandlerProvider(controller2, spaceStore, tokenStore, controller, authenticator, authorizer)
-	handler2 := router.MavenHandlerProvider(mavenHandler)
-	genericDBStore := generic.DBStoreProvider(imageRepository, artifactRepository, bandwidthStatRepository, downloadStatRepository, registryRepository)
+	npmController := npm.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, upstreamProxyConfigRepository, spaceFinder, secretService, packageRuleRepository, upstreamURLRepository, recorder)
+	cachePrewarmRepository := database2.ProvideCachePrewarmDao(db)
+	cacheprewarmService := cacheprewarm.ProvideService(jobScheduler, executor, transactor, registryRepository, cachePrewarmRepository, spaceFinder, provider, dockerController, controller2, npmController)
+	mirrorSyncRepository := database2.ProvideMirrorSyncDao(db)
+	mirrorsyncService := mirrorsync.ProvideService(config, jobScheduler, executor, transactor, registryRepository, upstreamProxyConfigRepository, mirrorSyncRepository, manifestRepository, localRegistry, spaceFinder, secretService, provider)
+	apiHandler := router.APIHandlerProvider(registryRepository, upstreamProxyConfigRepository, fileManager, tagRepository, manifestRepository, cleanupPolicyRepository, imageRepository, storageDriver, spaceFinder, transactor, authenticator, provider, authorizer, auditService, artifactRepository, webhooksRepository, webhooksExecutionRepository, service2, spacePathStore, ratelimitLimiter, storagemigrationService, queueService, scanRepository, vexRepository, securityService, evidenceService, resolutionService, accessgrantService, claimsmappingService, contentindexService, enrichmentService, downloadStatRepository, onboardingService, defaultRegistryRepository, registryConfigRevisionRepository, permalinkRepository, mavenRelocationRepository, packageRuleRepository, upstreamURLRepository, legalHoldRepository, deletionCertificateRepository, complianceService, cacheEvictionRepository, cacheprewarmService, vulnerabilityAllowlistRepository, helmPushPolicyRepository, mirrorSyncRepository, mirrorsyncService, replica)
+	mavenHandler := api2.NewMavenHandlerProvider(controller2, spaceStore, tokenStore, controller, authenticator, authorizer, blobserveServer)
+	handler2 := router.MavenHandlerProvider(mavenHandler, ratelimitLimiter)
+	genericDBStore := generic.DBStoreProvider(imageRepository, artifactRepository, bandwidthStatRepository, recorder, registryRepository)
 	genericController := generic.ControllerProvider(spaceStore, authorizer, fileManager, genericDBStore, transactor)
-	genericHandler := api2.NewGenericHandlerProvider(spaceStore, genericController, tokenStore, controller, authenticator, provider, authorizer)
-	handler3 := router.GenericHandlerProvider(genericHandler)
-	packagesHandler := api2.NewPackageHandlerProvider(registryRepository, spaceStore, tokenStore, controller, authenticator, provider, authorizer)
-	pypiController := pypi.ControllerProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider)
+	genericHandler := api2.NewGenericHandlerProvider(spaceStore, genericController, tokenStore, controller, authenticator, provider, authorizer, blobserveServer)
+	handler3 := router.GenericHandlerProvider(genericHandler, ratelimitLimiter)
+	packagesHandler := api2.NewPackageHandlerProvider(registryRepository, spaceStore, tokenStore, controller, authenticator, provider, authorizer, blobserveServer)
+	pypiController := pypi.ControllerProvider(upstreamProxyConfigRepository, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, spaceFinder, secretService, packageRuleRepository, upstreamURLRepository, recorder)
 	pypiHandler := api2.NewPypiHandlerProvider(pypiController, packagesHandler)
-	handler4 := router.PackageHandlerProvider(packagesHandler, mavenHandler, genericHandler, pypiHandler)
-	appRouter := router.AppRouterProvider(registryOCIHandler, apiHandler, handler2, handler3, handler4)
+	npmHandler := api2.NewNpmHandlerProvider(npmController, packagesHandler)
+	nugetController := nuget.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	nugetHandler := api2.NewNugetHandlerProvider(nugetController, packagesHandler)
+	gemsController := gems.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	gemsHandler := api2.NewGemsHandlerProvider(gemsController, packagesHandler)
+	cargoController := cargo.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	cargoHandler := api2.NewCargoHandlerProvider(cargoController, packagesHandler)
+	gomoduleController := gomodule.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	gomoduleHandler := api2.NewGoModuleHandlerProvider(gomoduleController, packagesHandler)
+	debianController, err := debian.ControllerProvider(config, registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	if err != nil {
+		return nil, err
+	}
+	debianHandler := api2.NewDebianHandlerProvider(debianController, packagesHandler)
+	rpmController := rpm.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, leaseLocker, recorder)
+	rpmHandler := api2.NewRpmHandlerProvider(rpmController, packagesHandler)
+	alpineController, err := alpine.ControllerProvider(config, registryRepository, imageRepository, artifactRepository, fileManager, transactor, leaseLocker, recorder)
+	if err != nil {
+		return nil, err
+	}
+	alpineHandler := api2.NewAlpineHandlerProvider(alpineController, packagesHandler)
+	conanController := conan.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	conanHandler := api2.NewConanHandlerProvider(conanController, packagesHandler)
+	composerController := composer.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	composerHandler := api2.NewComposerHandlerProvider(composerController, packagesHandler)
+	condaController := conda.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	condaHandler := api2.NewCondaHandlerProvider(condaController, packagesHandler)
+	terraformController := terraform.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	terraformHandler := api2.NewTerraformHandlerProvider(terraformController, packagesHandler)
+	swiftController := swift.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	swiftHandler := api2.NewSwiftHandlerProvider(swiftController, packagesHandler)
+	cocoapodsController := cocoapods.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	cocoapodsHandler := api2.NewCocoapodsHandlerProvider(cocoapodsController, packagesHandler)
+	hexController, err := hex.ControllerProvider(config, registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	if err != nil {
+		return nil, err
+	}
+	hexHandler := api2.NewHexHandlerProvider(hexController, packagesHandler)
+	pubController := pub.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	pubHandler := api2.NewPubHandlerProvider(pubController, packagesHandler)
+	cranController := cran.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	cranHandler := api2.NewCranHandlerProvider(cranController, packagesHandler)
+	galaxyController := galaxy.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	galaxyHandler := api2.NewGalaxyHandlerProvider(galaxyController, packagesHandler)
+	puppetController := puppet.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	puppetHandler := api2.NewPuppetHandlerProvider(puppetController, packagesHandler)
+	vagrantController := vagrant.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	vagrantHandler := api2.NewVagrantHandlerProvider(vagrantController, packagesHandler)
+	modelController := model.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, recorder)
+	modelHandler := api2.NewModelHandlerProvider(modelController, packagesHandler)
+	homebrewController := homebrew.ControllerProvider(registryRepository, imageRepository, artifactRepository, fileManager, transactor, provider, recorder)
+	homebrewHandler := api2.NewHomebrewHandlerProvider(homebrewController, packagesHandler)
+	handler4 := router.PackageHandlerProvider(packagesHandler, mavenHandler, genericHandler, pypiHandler, npmHandler, nugetHandler, gemsHandler, cargoHandler, gomoduleHandler, debianHandler, rpmHandler, alpineHandler, conanHandler, composerHandler, condaHandler, terraformHandler, swiftHandler, cocoapodsHandler, hexHandler, pubHandler, cranHandler, galaxyHandler, puppetHandler, vagrantHandler, modelHandler, homebrewHandler, ratelimitLimiter)
+	drainer := router.DrainerProvider()
+	appRouter := router.AppRouterProvider(registryOCIHandler, apiHandler, handler2, handler3, handler4, blobserveServer, authenticator, config, auditService, drainer)
 	sender := usage.ProvideMediator(ctx, config, spaceFinder, usageMetricStore)
 	remoteauthService := remoteauth.ProvideRemoteAuth(tokenStore, principalStore)
 	lfsController := lfs.ProvideController(authorizer, repoFinder, principalStore, lfsObjectStore, blobStore, remoteauthService, provider)
@@ -600,7 +749,13 @@ func initSystem(ctx context.Context, config *types.Config) (*server.System, erro
 	if err != nil {
 		return nil, err
 	}
-	servicesServices := services.ProvideServices(webhookService, pullreqService, triggerService, jobScheduler, collector, sizeCalculator, repoService, cleanupService, notificationService, keywordsearchService, gitspaceServices, instrumentService, consumer, repositoryCount, service2)
+	service3 := cleanup2.ProvideService(jobScheduler, executor, cleanupPolicyRepository, tagRepository, registryQueueRepository, uploadSessionRepository, manifestRepository, legalHoldRepository, leaseLocker)
+	consistencyService := consistency.ProvideService(config, jobScheduler, executor, registryRepository, blobRepository, genericBlobRepository, manifestRepository, spaceFinder, storageDriver, leaseLocker)
+	backfillRepository := database2.ProvideBackfillDao(db)
+	backfillService := backfill.ProvideService(config, jobScheduler, executor, backfillRepository, artifactRepository)
+	upstreamhealthService := upstreamhealth.ProvideService(config, jobScheduler, executor, registryRepository, upstreamProxyConfigRepository, upstreamURLRepository)
+	cacheevictionService := cacheeviction.ProvideService(config, jobScheduler, executor, transactor, registryRepository, cacheEvictionRepository, manifestRepository, tagRepository, artifactRepository, nodesRepository, genericBlobRepository, downloadStatRepository, legalHoldRepository, gcService, spaceFinder, storageDriver, leaseLocker)
+	servicesServices := services.ProvideServices(webhookService, pullreqService, triggerService, jobScheduler, collector, sizeCalculator, repoService, cleanupService, notificationService, keywordsearchService, gitspaceServices, instrumentService, consumer, repositoryCount, service2, service3, consistencyService, storagemigrationService, contentindexService, backfillService, recorder, upstreamhealthService, cacheevictionService, cacheprewarmService, mirrorsyncService, drainer)
 	serverSystem := server.NewSystem(bootstrapBootstrap, serverServer, sshServer, poller, resolverManager, servicesServices)
 	return serverSystem, nil
 }
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"golang.org/x/crypto/acme/autocert"
+	"golang.org/x/net/http2"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -43,6 +44,16 @@ type Config struct {
 	Key               string
 	AcmeHost          string
 	ReadHeaderTimeout time.Duration
+	// IdleTimeout is how long keep-alive connections are kept open between requests,
+	// zero falls back to the read timeout of net/http.
+	IdleTimeout time.Duration
+	// HTTP2MaxConcurrentStreams and HTTP2MaxReadFrameSize tune HTTP/2 connections,
+	// zero keeps the defaults of golang.org/x/net/http2.
+	HTTP2MaxConcurrentStreams uint32
+	HTTP2MaxReadFrameSize     uint32
+	// RequestClientCert makes TLS servers ask for client certificates without verifying them,
+	// leaving the verification to the handlers.
+	RequestClientCert bool
 }
 
 // Server is a wrapper around http.Server that exposes different async ListenAndServe methods
@@ -81,6 +92,7 @@ func (s *Server) listenAndServe() (*errgroup.Group, ShutdownFunction) {
 	s1 := &http.Server{
 		Addr:              fmt.Sprintf("%s:%d", s.config.Host, s.config.Port),
 		ReadHeaderTimeout: s.config.ReadHeaderTimeout,
+		IdleTimeout:       s.config.IdleTimeout,
 		Handler:           s.handler,
 	}
 	g.Go(func() error {
@@ -116,13 +128,20 @@ func (s *Server) listenAndServeTLS(enableMtls bool) (*errgroup.Group, ShutdownFu
 			ClientAuth: tls.RequireAndVerifyClientCert,
 			MinVersion: tls.VersionTLS13,
 		}
+	} else if s.config.RequestClientCert {
+		tlsConfig = &tls.Config{
+			ClientAuth: tls.RequestClientCert,
+			MinVersion: tls.VersionTLS12,
+		}
 	}
 	s2 := &http.Server{
 		Addr:              ":https",
 		ReadHeaderTimeout: s.config.ReadHeaderTimeout,
+		IdleTimeout:       s.config.IdleTimeout,
 		Handler:           s.handler,
 		TLSConfig:         tlsConfig,
 	}
+	s.configureHTTP2(s2)
 	g.Go(func() error {
 		return s1.ListenAndServe()
 	})
@@ -157,16 +176,22 @@ func (s Server) listenAndServeAcme() (*errgroup.Group, ShutdownFunction) {
 		ReadHeaderTimeout: s.config.ReadHeaderTimeout,
 		Handler:           m.HTTPHandler(nil),
 	}
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: m.GetCertificate,
+		NextProtos:     []string{"h2", "http/1.1"},
+	}
+	if s.config.RequestClientCert {
+		tlsConfig.ClientAuth = tls.RequestClientCert
+	}
 	s2 := &http.Server{
 		Addr:              ":https",
 		Handler:           s.handler,
 		ReadHeaderTimeout: s.config.ReadHeaderTimeout,
-		TLSConfig: &tls.Config{
-			MinVersion:     tls.VersionTLS12,
-			GetCertificate: m.GetCertificate,
-			NextProtos:     []string{"h2", "http/1.1"},
-		},
+		IdleTimeout:       s.config.IdleTimeout,
+		TLSConfig:         tlsConfig,
 	}
+	s.configureHTTP2(s2)
 	g.Go(func() error {
 		return s1.ListenAndServe()
 	})
@@ -186,6 +211,18 @@ func (s Server) listenAndServeAcme() (*errgroup.Group, ShutdownFunction) {
 	}
 }
 
+// configureHTTP2 applies the HTTP/2 settings to a server that is served over TLS.
+func (s Server) configureHTTP2(srv *http.Server) {
+	err := http2.ConfigureServer(srv, &http2.Server{
+		MaxConcurrentStreams: s.config.HTTP2MaxConcurrentStreams,
+		MaxReadFrameSize:     s.config.HTTP2MaxReadFrameSize,
+		IdleTimeout:          s.config.IdleTimeout,
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
 func redirect(w http.ResponseWriter, req *http.Request) {
 	// TODO: in case of reverse-proxy the host might be not the external host.
 	target := "https://" + req.Host + "/" + strings.TrimPrefix(req.URL.Path, "/")
@@ -72,4 +72,7 @@ type Mutex interface {
 
 	// Unlock releases the lock. It fails with error if the lock is not currently held.
 	Unlock(ctx context.Context) error
+
+	// Extend resets the expiry of the lock. It fails with error if the lock is not currently held.
+	Extend(ctx context.Context) error
 }
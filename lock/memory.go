@@ -127,6 +127,22 @@ func (m *InMemory) release(key, token string) bool {
 	return true
 }
 
+func (m *InMemory) extend(key, token string, ttl time.Duration) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := time.Now()
+
+	entry, ok := m.keys[key]
+	if !ok || entry.token != token || !entry.validUntil.After(now) {
+		return false
+	}
+
+	m.keys[key] = inMemEntry{token, now.Add(ttl)}
+
+	return true
+}
+
 type inMemEntry struct {
 	token      string
 	validUntil time.Time
@@ -216,6 +232,18 @@ func (m *inMemMutex) Unlock(_ context.Context) error {
 	return nil
 }
 
+// Extend resets the expiry of the lock. It fails with error if the lock is not currently held.
+func (m *inMemMutex) Extend(_ context.Context) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.isHeld || !m.provider.extend(m.key, m.token, m.expiry) {
+		return NewError(ErrorKindLockNotHeld, m.key, nil)
+	}
+
+	return nil
+}
+
 func randstr(size int) (string, error) {
 	buffer := make([]byte, size)
 	if _, err := rand.Read(buffer); err != nil {
@@ -146,3 +146,35 @@ func Test_inMemMutex_LockAndWait(t *testing.T) {
 	go fn(3)
 	wg.Wait()
 }
+
+func Test_inMemMutex_Extend(t *testing.T) {
+	manager := NewInMemory(Config{
+		App:        "gitness",
+		Namespace:  "pullreq",
+		Expiry:     200 * time.Millisecond,
+		Tries:      1,
+		RetryDelay: 10 * time.Millisecond,
+	})
+	ctx := context.Background()
+
+	mx, err := manager.NewMutex("key1")
+	require.NoError(t, err)
+	require.NoError(t, mx.Lock(ctx))
+
+	for range 3 {
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, mx.Extend(ctx))
+	}
+
+	other, err := manager.NewMutex("key1")
+	require.NoError(t, err)
+	require.Error(t, other.Lock(ctx), "an extended lock is held past its expiry")
+
+	time.Sleep(250 * time.Millisecond)
+	var errLock *Error
+	require.ErrorAs(t, mx.Extend(ctx), &errLock, "an expired lock can't be extended")
+	require.Equal(t, ErrorKindLockNotHeld, errLock.Kind)
+
+	require.NoError(t, other.Lock(ctx))
+	require.NoError(t, other.Unlock(ctx))
+}
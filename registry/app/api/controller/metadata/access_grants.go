@@ -0,0 +1,218 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/accessgrant"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CreateAccessGrant grants a principal permissions on the registry for a limited time.
+func (c *APIController) CreateAccessGrant(
+	ctx context.Context,
+	r artifact.CreateAccessGrantRequestObject,
+) (artifact.CreateAccessGrantResponseObject, error) {
+	if r.Body == nil {
+		return throwCreateAccessGrant400Error(fmt.Errorf("request body is required")), nil
+	}
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwCreateAccessGrant400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwCreateAccessGrant400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.CreateAccessGrant403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	permissions := make([]enum.Permission, 0, len(r.Body.Permissions))
+	for _, p := range r.Body.Permissions {
+		permissions = append(permissions, enum.Permission(p))
+	}
+	var reason string
+	if r.Body.Reason != nil {
+		reason = *r.Body.Reason
+	}
+	grant, err := c.AccessGrantService.Grant(ctx, regInfo.RegistryID, r.Body.Principal, permissions,
+		time.Duration(r.Body.DurationMinutes)*time.Minute, reason, session.Principal.ID)
+	if errors.Is(err, accessgrant.ErrInvalidGrant) {
+		return throwCreateAccessGrant400Error(err), nil
+	}
+	if err != nil {
+		return artifact.CreateAccessGrant500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	return artifact.CreateAccessGrant201JSONResponse{
+		AccessGrantResponseJSONResponse: artifact.AccessGrantResponseJSONResponse{
+			Data:   mapToAPIAccessGrant(grant),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListAccessGrants lists the active temporary access grants of the registry.
+func (c *APIController) ListAccessGrants(
+	ctx context.Context,
+	r artifact.ListAccessGrantsRequestObject,
+) (artifact.ListAccessGrantsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return artifact.ListAccessGrants400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.ListAccessGrants400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ListAccessGrants403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	grants, err := c.AccessGrantService.ListActive(ctx, regInfo.RegistryID)
+	if err != nil {
+		return artifact.ListAccessGrants500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	data := make([]artifact.AccessGrant, 0, len(grants))
+	for _, grant := range grants {
+		data = append(data, mapToAPIAccessGrant(grant))
+	}
+	return artifact.ListAccessGrants200JSONResponse{
+		ListAccessGrantsResponseJSONResponse: artifact.ListAccessGrantsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// RevokeAccessGrant ends an active temporary access grant of the registry.
+func (c *APIController) RevokeAccessGrant(
+	ctx context.Context,
+	r artifact.RevokeAccessGrantRequestObject,
+) (artifact.RevokeAccessGrantResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return artifact.RevokeAccessGrant400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.RevokeAccessGrant400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.RevokeAccessGrant403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	err = c.AccessGrantService.Revoke(ctx, regInfo.RegistryID, int64(r.AccessGrantId), session.Principal.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.RevokeAccessGrant404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "active access grant not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return artifact.RevokeAccessGrant500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	return artifact.RevokeAccessGrant200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+func mapToAPIAccessGrant(grant *registrytypes.AccessGrant) artifact.AccessGrant {
+	permissions := make([]artifact.GrantablePermission, 0, len(grant.Permissions))
+	for _, p := range grant.Permissions {
+		permissions = append(permissions, artifact.GrantablePermission(p))
+	}
+	return artifact.AccessGrant{
+		Id:          grant.ID,
+		Principal:   grant.PrincipalUID,
+		Permissions: permissions,
+		Reason:      optionalString(grant.Reason),
+		ExpiresAt:   grant.ExpiresAt.UnixMilli(),
+		CreatedAt:   grant.CreatedAt.UnixMilli(),
+	}
+}
+
+func throwCreateAccessGrant400Error(err error) artifact.CreateAccessGrant400JSONResponse {
+	return artifact.CreateAccessGrant400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/badge"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	// defaultBadgeMaxAge is the number of seconds badges are cached for unless requested otherwise.
+	defaultBadgeMaxAge = 300
+	// maxBadgeFiles limits the files of a version whose scans are counted by the scan badge.
+	maxBadgeFiles = 100
+)
+
+// GetArtifactBadge renders a badge of an artifact, revalidated by clients with its ETag.
+func (c *APIController) GetArtifactBadge(
+	ctx context.Context,
+	r artifact.GetArtifactBadgeRequestObject,
+) (artifact.GetArtifactBadgeResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwGetArtifactBadge400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwGetArtifactBadge400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.GetArtifactBadge403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	maxAge := defaultBadgeMaxAge
+	if r.Params.MaxAge != nil {
+		maxAge = int(*r.Params.MaxAge)
+		if maxAge < 0 || maxAge > 86400 {
+			return throwGetArtifactBadge400Error(fmt.Errorf("maxAge must be between 0 and 86400")), nil
+		}
+	}
+
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return throwGetArtifactBadge500Error(err), nil
+	}
+	b, err := c.artifactBadge(ctx, registry, string(r.Artifact), artifact.BadgeType(r.Badge))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.GetArtifactBadge404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwGetArtifactBadge500Error(err), nil
+	}
+	applyBadgeOptions(&b, r.Params)
+
+	svg, err := badge.Render(b)
+	if err != nil {
+		return throwGetArtifactBadge400Error(err), nil
+	}
+	sum := sha256.Sum256(svg)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	// Badges of private registries must not be kept by shared caches.
+	cacheControl := fmt.Sprintf("private, max-age=%d", maxAge)
+	if session.Principal == auth.AnonymousPrincipal {
+		cacheControl = fmt.Sprintf("public, max-age=%d", maxAge)
+	}
+	if r.Params.IfNoneMatch != nil && etagMatches(string(*r.Params.IfNoneMatch), etag) {
+		return artifact.GetArtifactBadge304Response{
+			Headers: artifact.NotModifiedResponseHeaders{CacheControl: cacheControl, ETag: etag},
+		}, nil
+	}
+	return artifact.GetArtifactBadge200ImagesvgXmlResponse{
+		ArtifactBadgeResponseImagesvgXmlResponse: artifact.ArtifactBadgeResponseImagesvgXmlResponse{
+			Body:          bytes.NewReader(svg),
+			Headers:       artifact.ArtifactBadgeResponseResponseHeaders{CacheControl: cacheControl, ETag: etag},
+			ContentLength: int64(len(svg)),
+		},
+	}, nil
+}
+
+// artifactBadge returns the badge of the latest version of an artifact, picked by the latest
+// version strategy of the registry.
+func (c *APIController) artifactBadge(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	image string,
+	badgeType artifact.BadgeType,
+) (badge.Badge, error) {
+	var metadata *registrytypes.ArtifactMetadata
+	var err error
+	if isOCIPackageType(registry.PackageType) {
+		metadata, err = c.TagStore.GetLatestTagMetadata(ctx, registry.ParentID, registry.Name, image)
+	} else {
+		metadata, err = c.ArtifactStore.GetLatestArtifactMetadata(ctx, registry.ParentID, registry.Name, image)
+	}
+	if err != nil {
+		return badge.Badge{}, err
+	}
+	artifacts := []registrytypes.ArtifactMetadata{*metadata}
+	if err = c.applyLatestVersionStrategy(ctx, registry, &artifacts); err != nil {
+		return badge.Badge{}, err
+	}
+	metadata = &artifacts[0]
+
+	switch badgeType {
+	case artifact.BadgeTypeVersion:
+		return badge.Badge{Label: "version", Message: metadata.LatestVersion, Color: badge.ColorBlue}, nil
+	case artifact.BadgeTypeDownloads:
+		return badge.Badge{
+			Label: "downloads", Message: badge.FormatCount(metadata.DownloadCount), Color: badge.ColorBrightGreen,
+		}, nil
+	case artifact.BadgeTypeScan:
+		counts, scanned, err := c.latestScanCounts(ctx, registry, image, metadata.LatestVersion)
+		if err != nil {
+			return badge.Badge{}, err
+		}
+		return scanBadge(counts, scanned), nil
+	default:
+		return badge.Badge{}, fmt.Errorf("invalid badge %q", badgeType)
+	}
+}
+
+// latestScanCounts counts the findings of the latest scan of each tool against the digests of a
+// version, the manifest of a tag or the files of the other package types. The findings of the
+// scans of a digest are counted once per severity, as tools report the same vulnerabilities. Findings
+// suppressed by VEX statements or allowlisted aren't counted.
+func (c *APIController) latestScanCounts(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	image string,
+	version string,
+) (registrytypes.ScanSeverityCounts, bool, error) {
+	digests, err := c.versionDigests(ctx, registry, image, version)
+	if err != nil {
+		return nil, false, err
+	}
+	counts := registrytypes.ScanSeverityCounts{}
+	scanned := false
+	for _, dgst := range digests {
+		scans, err := c.ScanStore.ListByDigest(ctx, registry.ID, dgst)
+		if err != nil {
+			return nil, false, err
+		}
+		tools := make(map[string]struct{}, len(scans))
+		scanIDs := make([]int64, 0, len(scans))
+		for _, scan := range scans {
+			if _, ok := tools[scan.Tool]; !ok {
+				tools[scan.Tool] = struct{}{}
+				scanIDs = append(scanIDs, scan.ID)
+			}
+		}
+		if len(scanIDs) == 0 {
+			continue
+		}
+		scanned = true
+
+		suppressedIdentifiers, allowlistedIdentifiers, err := c.ignoredVulnerabilities(ctx, registry.ID, dgst)
+		if err != nil {
+			return nil, false, err
+		}
+		scanCounts, err := c.ScanStore.CountFindingsBySeverity(ctx, scanIDs,
+			append(suppressedIdentifiers, allowlistedIdentifiers...))
+		if err != nil {
+			return nil, false, err
+		}
+		digestCounts := registrytypes.ScanSeverityCounts{}
+		for _, scanCount := range scanCounts {
+			for severity, count := range scanCount {
+				digestCounts[severity] = max(digestCounts[severity], count)
+			}
+		}
+		for severity, count := range digestCounts {
+			counts[severity] += count
+		}
+	}
+	return counts, scanned, nil
+}
+
+func (c *APIController) versionDigests(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	image string,
+	version string,
+) ([]string, error) {
+	if isOCIPackageType(registry.PackageType) {
+		m, err := c.ManifestStore.FindManifestByTagName(ctx, registry.ID, image, version)
+		if err != nil {
+			return nil, err
+		}
+		return []string{m.Digest.String()}, nil
+	}
+
+	name := image
+	if registry.PackageType == artifact.PackageTypeMAVEN {
+		name = strings.ReplaceAll(strings.ReplaceAll(name, ".", "/"), ":", "/")
+	}
+	files, err := c.fileManager.GetFilesMetadata(ctx, "/"+name+"/"+version+"%", registry.ID,
+		"name", "ASC", maxBadgeFiles, 0, "")
+	if err != nil {
+		return nil, err
+	}
+	var digests []string
+	if files != nil {
+		for _, f := range *files {
+			if f.Sha256 != "" {
+				digests = append(digests, "sha256:"+f.Sha256)
+			}
+		}
+	}
+	return digests, nil
+}
+
+// scanBadge shows the counts of the two most severe severities found, colored by the most severe.
+func scanBadge(counts registrytypes.ScanSeverityCounts, scanned bool) badge.Badge {
+	b := badge.Badge{Label: "scan"}
+	if !scanned {
+		b.Message, b.Color = "not scanned", badge.ColorLightGrey
+		return b
+	}
+	colors := map[registrytypes.ScanSeverity]string{
+		registrytypes.ScanSeverityCritical: badge.ColorRed,
+		registrytypes.ScanSeverityHigh:     badge.ColorOrange,
+		registrytypes.ScanSeverityMedium:   badge.ColorYellow,
+		registrytypes.ScanSeverityLow:      badge.ColorGreen,
+	}
+	var parts []string
+	for _, severity := range registrytypes.ScanSeverities {
+		color, ok := colors[severity]
+		if !ok || counts[severity] == 0 {
+			continue
+		}
+		if len(parts) == 0 {
+			b.Color = color
+		}
+		if len(parts) < 2 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[severity], strings.ToLower(string(severity))))
+		}
+	}
+	if len(parts) == 0 {
+		b.Message, b.Color = "passing", badge.ColorBrightGreen
+		return b
+	}
+	b.Message = strings.Join(parts, " | ")
+	return b
+}
+
+func applyBadgeOptions(b *badge.Badge, params artifact.GetArtifactBadgeParams) {
+	if params.Style != nil {
+		b.Style = badge.Style(*params.Style)
+	}
+	if params.Label != nil {
+		b.Label = string(*params.Label)
+	}
+	if params.Color != nil {
+		b.Color = string(*params.Color)
+	}
+	if params.LabelColor != nil {
+		b.LabelColor = string(*params.LabelColor)
+	}
+}
+
+// etagMatches reports whether the If-None-Match header lists the ETag, or is a wildcard.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func throwGetArtifactBadge400Error(err error) artifact.GetArtifactBadge400JSONResponse {
+	return artifact.GetArtifactBadge400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwGetArtifactBadge500Error(err error) artifact.GetArtifactBadge500JSONResponse {
+	return artifact.GetArtifactBadge500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
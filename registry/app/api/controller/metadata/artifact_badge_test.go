@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/badge"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanBadge(t *testing.T) {
+	assert.Equal(t, badge.Badge{Label: "scan", Message: "not scanned", Color: badge.ColorLightGrey},
+		scanBadge(nil, false))
+	assert.Equal(t, badge.Badge{Label: "scan", Message: "passing", Color: badge.ColorBrightGreen},
+		scanBadge(registrytypes.ScanSeverityCounts{registrytypes.ScanSeverityUnknown: 2}, true))
+	assert.Equal(t, badge.Badge{Label: "scan", Message: "1 high | 4 low", Color: badge.ColorOrange},
+		scanBadge(registrytypes.ScanSeverityCounts{
+			registrytypes.ScanSeverityHigh: 1, registrytypes.ScanSeverityLow: 4,
+		}, true))
+	assert.Equal(t, badge.Badge{Label: "scan", Message: "2 critical | 3 high", Color: badge.ColorRed},
+		scanBadge(registrytypes.ScanSeverityCounts{
+			registrytypes.ScanSeverityCritical: 2, registrytypes.ScanSeverityHigh: 3,
+			registrytypes.ScanSeverityMedium: 5,
+		}, true))
+}
+
+func TestApplyBadgeOptions(t *testing.T) {
+	style := artifact.BadgeStyleParam(artifact.BadgeStyleFlatSquare)
+	label := artifact.BadgeLabelParam("")
+	color := artifact.BadgeColorParam("ff69b4")
+	b := badge.Badge{Label: "version", Message: "1.0.0", Color: badge.ColorBlue}
+	applyBadgeOptions(&b, artifact.GetArtifactBadgeParams{Style: &style, Label: &label, Color: &color})
+	assert.Equal(t, badge.Badge{Message: "1.0.0", Color: "ff69b4", Style: badge.StyleFlatSquare}, b)
+}
+
+func TestETagMatches(t *testing.T) {
+	assert.True(t, etagMatches(`"a", "b"`, `"b"`))
+	assert.True(t, etagMatches(`W/"b"`, `"b"`))
+	assert.True(t, etagMatches(`*`, `"b"`))
+	assert.False(t, etagMatches(`"a"`, `"b"`))
+}
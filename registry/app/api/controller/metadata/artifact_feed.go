@@ -0,0 +1,296 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/feed"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	// defaultFeedMaxAge is the number of seconds feeds are cached for by feed readers.
+	defaultFeedMaxAge = 300
+	// maxFeedEntries limits the versions listed by a feed.
+	maxFeedEntries = 100
+)
+
+// GetRegistryFeed renders an Atom feed of the versions most recently pushed to a registry.
+func (c *APIController) GetRegistryFeed(
+	ctx context.Context,
+	r artifact.GetRegistryFeedRequestObject,
+) (artifact.GetRegistryFeedResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwGetRegistryFeed400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwGetRegistryFeed400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.GetRegistryFeed403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	limit, err := feedLimit(r.Params.Size)
+	if err != nil {
+		return throwGetRegistryFeed400Error(err), nil
+	}
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return throwGetRegistryFeed500Error(err), nil
+	}
+	versions, err := c.TagStore.GetAllArtifactsByParentID(ctx, registry.ParentID, &[]string{registry.Name},
+		"updated_at", "DESC", limit, 0, "", false, nil)
+	if err != nil {
+		return throwGetRegistryFeed500Error(err), nil
+	}
+
+	registryURL := c.URLProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name)
+	f := feed.Feed{
+		ID:      registryURL,
+		Title:   fmt.Sprintf("%s registry", registry.Name),
+		Link:    registryURL,
+		Updated: registry.UpdatedAt,
+	}
+	for _, v := range *versions {
+		f.Entries = append(f.Entries, versionFeedEntry(registryURL, registry, v.Name, v.Version, v.ModifiedAt))
+	}
+
+	body, etag, cacheControl, err := renderFeed(f, session)
+	if err != nil {
+		return throwGetRegistryFeed500Error(err), nil
+	}
+	if r.Params.IfNoneMatch != nil && etagMatches(string(*r.Params.IfNoneMatch), etag) {
+		return artifact.GetRegistryFeed304Response{
+			Headers: artifact.NotModifiedResponseHeaders{CacheControl: cacheControl, ETag: etag},
+		}, nil
+	}
+	return artifact.GetRegistryFeed200ApplicationatomXmlResponse{
+		FeedResponseApplicationatomXmlResponse: artifact.FeedResponseApplicationatomXmlResponse{
+			Body:          bytes.NewReader(body),
+			Headers:       artifact.FeedResponseResponseHeaders{CacheControl: cacheControl, ETag: etag},
+			ContentLength: int64(len(body)),
+		},
+	}, nil
+}
+
+// GetArtifactFeed renders an Atom feed of the versions most recently pushed to an artifact.
+func (c *APIController) GetArtifactFeed(
+	ctx context.Context,
+	r artifact.GetArtifactFeedRequestObject,
+) (artifact.GetArtifactFeedResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwGetArtifactFeed400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwGetArtifactFeed400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.GetArtifactFeed403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	limit, err := feedLimit(r.Params.Size)
+	if err != nil {
+		return throwGetArtifactFeed400Error(err), nil
+	}
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return throwGetArtifactFeed500Error(err), nil
+	}
+	image, err := c.ImageStore.GetByName(ctx, registry.ID, string(r.Artifact))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.GetArtifactFeed404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwGetArtifactFeed500Error(err), nil
+	}
+
+	registryURL := c.URLProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name)
+	artifactURL := registryURL + "/artifacts/" + url.PathEscape(image.Name)
+	f := feed.Feed{
+		ID:      artifactURL,
+		Title:   fmt.Sprintf("%s in %s", image.Name, registry.Name),
+		Link:    artifactURL,
+		Updated: image.UpdatedAt,
+	}
+	entries, err := c.artifactFeedEntries(ctx, registryURL, registry, image.Name, limit)
+	if err != nil {
+		return throwGetArtifactFeed500Error(err), nil
+	}
+	f.Entries = entries
+
+	body, etag, cacheControl, err := renderFeed(f, session)
+	if err != nil {
+		return throwGetArtifactFeed500Error(err), nil
+	}
+	if r.Params.IfNoneMatch != nil && etagMatches(string(*r.Params.IfNoneMatch), etag) {
+		return artifact.GetArtifactFeed304Response{
+			Headers: artifact.NotModifiedResponseHeaders{CacheControl: cacheControl, ETag: etag},
+		}, nil
+	}
+	return artifact.GetArtifactFeed200ApplicationatomXmlResponse{
+		FeedResponseApplicationatomXmlResponse: artifact.FeedResponseApplicationatomXmlResponse{
+			Body:          bytes.NewReader(body),
+			Headers:       artifact.FeedResponseResponseHeaders{CacheControl: cacheControl, ETag: etag},
+			ContentLength: int64(len(body)),
+		},
+	}, nil
+}
+
+// artifactFeedEntries lists the most recently updated tags of OCI artifacts, and the most recently
+// created versions of the other package types.
+func (c *APIController) artifactFeedEntries(
+	ctx context.Context,
+	registryURL string,
+	registry *registrytypes.Registry,
+	image string,
+	limit int,
+) ([]feed.Entry, error) {
+	var entries []feed.Entry
+	if isOCIPackageType(registry.PackageType) {
+		tags, err := c.TagStore.GetAllTagsByRepoAndImage(ctx, registry.ParentID, registry.Name, image,
+			"updated_at", "DESC", limit, 0, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range *tags {
+			entries = append(entries, versionFeedEntry(registryURL, registry, image, tag.Name, tag.ModifiedAt))
+		}
+		return entries, nil
+	}
+
+	artifacts, err := c.ArtifactStore.GetByRegistryIDAndImage(ctx, registry.ID, image)
+	if err != nil {
+		return nil, err
+	}
+	for i, a := range *artifacts {
+		if i == limit {
+			break
+		}
+		entries = append(entries, versionFeedEntry(registryURL, registry, image, a.Version, a.UpdatedAt))
+	}
+	return entries, nil
+}
+
+func versionFeedEntry(
+	registryURL string,
+	registry *registrytypes.Registry,
+	image string,
+	version string,
+	updated time.Time,
+) feed.Entry {
+	link := registryURL + "/artifacts/" + url.PathEscape(image) + "/versions/" + url.PathEscape(version)
+	return feed.Entry{
+		ID:      link,
+		Title:   fmt.Sprintf("%s %s", image, version),
+		Link:    link,
+		Summary: fmt.Sprintf("Version %s of %s was pushed to the %s registry.", version, image, registry.Name),
+		Updated: updated,
+	}
+}
+
+// renderFeed renders a feed along with its ETag and the caching allowed to the requester. Feeds of
+// private registries must not be kept by shared caches.
+func renderFeed(f feed.Feed, session *auth.Session) ([]byte, string, string, error) {
+	body, err := feed.Render(f)
+	if err != nil {
+		return nil, "", "", err
+	}
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	cacheControl := fmt.Sprintf("private, max-age=%d", defaultFeedMaxAge)
+	if session != nil && session.Principal == auth.AnonymousPrincipal {
+		cacheControl = fmt.Sprintf("public, max-age=%d", defaultFeedMaxAge)
+	}
+	return body, etag, cacheControl, nil
+}
+
+func feedLimit(size *artifact.PageSize) (int, error) {
+	limit := GetPageLimit(size)
+	if limit < 1 || limit > maxFeedEntries {
+		return 0, fmt.Errorf("size must be between 1 and %d", maxFeedEntries)
+	}
+	return limit, nil
+}
+
+func throwGetRegistryFeed400Error(err error) artifact.GetRegistryFeed400JSONResponse {
+	return artifact.GetRegistryFeed400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwGetRegistryFeed500Error(err error) artifact.GetRegistryFeed500JSONResponse {
+	return artifact.GetRegistryFeed500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwGetArtifactFeed400Error(err error) artifact.GetArtifactFeed400JSONResponse {
+	return artifact.GetArtifactFeed400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwGetArtifactFeed500Error(err error) artifact.GetArtifactFeed500JSONResponse {
+	return artifact.GetArtifactFeed500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/feed"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionFeedEntry(t *testing.T) {
+	updated := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	entry := versionFeedEntry("https://app/spaces/acme/registries/libs", &registrytypes.Registry{Name: "libs"},
+		"@acme/ui", "1.0.0+build", updated)
+	assert.Equal(t, feed.Entry{
+		ID:      "https://app/spaces/acme/registries/libs/artifacts/@acme%2Fui/versions/1.0.0+build",
+		Title:   "@acme/ui 1.0.0+build",
+		Link:    "https://app/spaces/acme/registries/libs/artifacts/@acme%2Fui/versions/1.0.0+build",
+		Summary: "Version 1.0.0+build of @acme/ui was pushed to the libs registry.",
+		Updated: updated,
+	}, entry)
+}
+
+func TestRenderFeed(t *testing.T) {
+	f := feed.Feed{ID: "urn:libs", Title: "libs registry"}
+	body, etag, cacheControl, err := renderFeed(f, &auth.Session{Principal: types.Principal{ID: 7}})
+	require.NoError(t, err)
+	assert.NotEmpty(t, body)
+	assert.Equal(t, "private, max-age=300", cacheControl)
+
+	_, anonymousETag, cacheControl, err := renderFeed(f, &auth.Session{Principal: auth.AnonymousPrincipal})
+	require.NoError(t, err)
+	assert.Equal(t, "public, max-age=300", cacheControl)
+	assert.Equal(t, etag, anonymousETag)
+}
+
+func TestFeedLimit(t *testing.T) {
+	limit, err := feedLimit(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 10, limit)
+
+	size := artifact.PageSize(101)
+	_, err = feedLimit(&size)
+	assert.Error(t, err)
+}
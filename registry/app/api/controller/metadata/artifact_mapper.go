@@ -19,10 +19,15 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/harness/gitness/app/url"
 	artifactapi "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/metadata/cran"
+	"github.com/harness/gitness/registry/app/metadata/rpm"
+	conanpkg "github.com/harness/gitness/registry/app/pkg/conan"
 	"github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/registry/types"
 
@@ -40,6 +45,52 @@ func GetArtifactMetadata(
 		registryURL := urlProvider.RegistryURL(ctx, rootIdentifier, artifact.RepoName)
 		if artifact.PackageType == artifactapi.PackageTypeGENERIC {
 			registryURL = urlProvider.RegistryURL(ctx, rootIdentifier, "generic", artifact.RepoName)
+		} else if artifact.PackageType == artifactapi.PackageTypeNPM {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "npm")
+		} else if artifact.PackageType == artifactapi.PackageTypePYTHON {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "python")
+		} else if artifact.PackageType == artifactapi.PackageTypeNUGET {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "nuget")
+		} else if artifact.PackageType == artifactapi.PackageTypeGEMS {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "rubygems")
+		} else if artifact.PackageType == artifactapi.PackageTypeCRATE {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "cargo")
+		} else if artifact.PackageType == artifactapi.PackageTypeGO {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "go")
+		} else if artifact.PackageType == artifactapi.PackageTypeDEB {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "debian")
+		} else if artifact.PackageType == artifactapi.PackageTypeRPM {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "rpm")
+		} else if artifact.PackageType == artifactapi.PackageTypeALPINE {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "alpine")
+		} else if artifact.PackageType == artifactapi.PackageTypeCONAN {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "conan")
+		} else if artifact.PackageType == artifactapi.PackageTypeCOMPOSER {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "composer")
+		} else if artifact.PackageType == artifactapi.PackageTypeCONDA {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "conda")
+		} else if artifact.PackageType == artifactapi.PackageTypeTERRAFORM {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "terraform")
+		} else if artifact.PackageType == artifactapi.PackageTypeSWIFT {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "swift")
+		} else if artifact.PackageType == artifactapi.PackageTypeCOCOAPODS {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "cocoapods")
+		} else if artifact.PackageType == artifactapi.PackageTypeHEX {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "hex")
+		} else if artifact.PackageType == artifactapi.PackageTypePUB {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "pub")
+		} else if artifact.PackageType == artifactapi.PackageTypeCRAN {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "cran")
+		} else if artifact.PackageType == artifactapi.PackageTypeGALAXY {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "galaxy")
+		} else if artifact.PackageType == artifactapi.PackageTypePUPPET {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "puppet")
+		} else if artifact.PackageType == artifactapi.PackageTypeVAGRANT {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "vagrant")
+		} else if artifact.PackageType == artifactapi.PackageTypeMODEL {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "model")
+		} else if artifact.PackageType == artifactapi.PackageTypeHOMEBREW {
+			registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+artifact.RepoName, "homebrew")
 		}
 		artifactMetadata := mapToArtifactMetadata(artifact, registryURL)
 		artifactMetadataList = append(artifactMetadataList, *artifactMetadata)
@@ -73,6 +124,7 @@ func GetMavenArtifactDetail(
 		Name:       &image.Name,
 		Version:    artifact.Version,
 		Size:       &sizeVal,
+		SizeBytes:  &size,
 	}
 	return *artifactDetail
 }
@@ -123,6 +175,50 @@ func toPackageType(packageTypeStr string) (artifactapi.PackageType, error) {
 		return artifactapi.PackageTypeMAVEN, nil
 	case string(artifactapi.PackageTypePYTHON):
 		return artifactapi.PackageTypePYTHON, nil
+	case string(artifactapi.PackageTypeNPM):
+		return artifactapi.PackageTypeNPM, nil
+	case string(artifactapi.PackageTypeNUGET):
+		return artifactapi.PackageTypeNUGET, nil
+	case string(artifactapi.PackageTypeGEMS):
+		return artifactapi.PackageTypeGEMS, nil
+	case string(artifactapi.PackageTypeCRATE):
+		return artifactapi.PackageTypeCRATE, nil
+	case string(artifactapi.PackageTypeGO):
+		return artifactapi.PackageTypeGO, nil
+	case string(artifactapi.PackageTypeDEB):
+		return artifactapi.PackageTypeDEB, nil
+	case string(artifactapi.PackageTypeRPM):
+		return artifactapi.PackageTypeRPM, nil
+	case string(artifactapi.PackageTypeALPINE):
+		return artifactapi.PackageTypeALPINE, nil
+	case string(artifactapi.PackageTypeCONAN):
+		return artifactapi.PackageTypeCONAN, nil
+	case string(artifactapi.PackageTypeCOMPOSER):
+		return artifactapi.PackageTypeCOMPOSER, nil
+	case string(artifactapi.PackageTypeCONDA):
+		return artifactapi.PackageTypeCONDA, nil
+	case string(artifactapi.PackageTypeTERRAFORM):
+		return artifactapi.PackageTypeTERRAFORM, nil
+	case string(artifactapi.PackageTypeSWIFT):
+		return artifactapi.PackageTypeSWIFT, nil
+	case string(artifactapi.PackageTypeCOCOAPODS):
+		return artifactapi.PackageTypeCOCOAPODS, nil
+	case string(artifactapi.PackageTypeHEX):
+		return artifactapi.PackageTypeHEX, nil
+	case string(artifactapi.PackageTypePUB):
+		return artifactapi.PackageTypePUB, nil
+	case string(artifactapi.PackageTypeCRAN):
+		return artifactapi.PackageTypeCRAN, nil
+	case string(artifactapi.PackageTypeGALAXY):
+		return artifactapi.PackageTypeGALAXY, nil
+	case string(artifactapi.PackageTypePUPPET):
+		return artifactapi.PackageTypePUPPET, nil
+	case string(artifactapi.PackageTypeVAGRANT):
+		return artifactapi.PackageTypeVAGRANT, nil
+	case string(artifactapi.PackageTypeMODEL):
+		return artifactapi.PackageTypeMODEL, nil
+	case string(artifactapi.PackageTypeHOMEBREW):
+		return artifactapi.PackageTypeHOMEBREW, nil
 	default:
 		return "", errors.New("invalid package type")
 	}
@@ -138,22 +234,30 @@ func GetTagMetadata(
 	for _, tag := range *tags {
 		modifiedAt := GetTimeInMs(tag.ModifiedAt)
 		size := GetImageSize(tag.Size)
+		sizeBytes := GetImageSizeBytes(tag.Size)
 		digestCount := tag.DigestCount
 		command := GetPullCommand(image, tag.Name, string(tag.PackageType), registryURL)
 		packageType, err := toPackageType(string(tag.PackageType))
 		downloadCount := tag.DownloadCount
+		uniqueDownloadCount := tag.UniqueDownloadCount
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msgf("Error converting package type %s", tag.PackageType)
 			continue
 		}
 		artifactVersionMetadata := &artifactapi.ArtifactVersionMetadata{
-			PackageType:    &packageType,
-			Name:           tag.Name,
-			Size:           &size,
-			LastModified:   &modifiedAt,
-			DigestCount:    &digestCount,
-			PullCommand:    &command,
-			DownloadsCount: &downloadCount,
+			PackageType:          &packageType,
+			Name:                 tag.Name,
+			Size:                 &size,
+			SizeBytes:            &sizeBytes,
+			LastModified:         &modifiedAt,
+			DigestCount:          &digestCount,
+			PullCommand:          &command,
+			DownloadsCount:       &downloadCount,
+			UniqueDownloadsCount: &uniqueDownloadCount,
+		}
+		if tag.Platforms != nil {
+			platforms := tag.Platforms
+			artifactVersionMetadata.Platforms = &platforms
 		}
 		artifactVersionMetadataList = append(artifactVersionMetadataList, *artifactVersionMetadata)
 	}
@@ -163,7 +267,7 @@ func GetTagMetadata(
 func GetAllArtifactResponse(
 	ctx context.Context,
 	artifacts *[]types.ArtifactMetadata,
-	count int64,
+	count Count,
 	pageNumber int64,
 	pageSize int,
 	rootIdentifier string,
@@ -175,13 +279,16 @@ func GetAllArtifactResponse(
 	} else {
 		artifactMetadataList = GetArtifactMetadata(ctx, *artifacts, rootIdentifier, urlProvider)
 	}
-	pageCount := GetPageCount(count, pageSize)
+	pageCount := GetPageCount(count.Items, pageSize)
+	countExact, countedAt := countFields(count)
 	listArtifact := &artifactapi.ListArtifact{
-		ItemCount: &count,
-		PageCount: &pageCount,
-		PageIndex: &pageNumber,
-		PageSize:  &pageSize,
-		Artifacts: artifactMetadataList,
+		ItemCount:  &count.Items,
+		CountExact: countExact,
+		CountedAt:  countedAt,
+		PageCount:  &pageCount,
+		PageIndex:  &pageNumber,
+		PageSize:   &pageSize,
+		Artifacts:  artifactMetadataList,
 	}
 	response := &artifactapi.ListArtifactResponseJSONResponse{
 		Data:   *listArtifact,
@@ -237,10 +344,58 @@ func GetArtifactFilesMetadata(
 			filePathPrefix = "/" + artifactName + "/" + version + "/"
 			filename = strings.Replace(file.Path, filePathPrefix, "", 1)
 			downloadCommand = GetMavenArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeNPM == packageType {
+			downloadCommand = GetNpmArtifactFileDownloadCommand(registryURL, artifactName, filename)
+		} else if artifactapi.PackageTypePYTHON == packageType {
+			downloadCommand = GetPythonArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeNUGET == packageType {
+			downloadCommand = GetNugetArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeGEMS == packageType {
+			downloadCommand = GetGemsArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypeCRATE == packageType {
+			downloadCommand = GetCrateArtifactFileDownloadCommand(registryURL, artifactName, version)
+		} else if artifactapi.PackageTypeGO == packageType {
+			downloadCommand = GetGoModuleArtifactFileDownloadCommand(registryURL, artifactName, filename)
+		} else if artifactapi.PackageTypeDEB == packageType {
+			downloadCommand = GetDebArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypeRPM == packageType {
+			downloadCommand = GetRpmArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypeALPINE == packageType {
+			downloadCommand = GetAlpineArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypeCONAN == packageType {
+			downloadCommand = GetConanArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeCOMPOSER == packageType {
+			downloadCommand = GetComposerArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeCONDA == packageType {
+			downloadCommand = GetCondaArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypeTERRAFORM == packageType {
+			downloadCommand = GetTerraformArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeSWIFT == packageType {
+			downloadCommand = GetSwiftArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeCOCOAPODS == packageType {
+			downloadCommand = GetCocoapodsArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeHEX == packageType {
+			downloadCommand = GetHexArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypePUB == packageType {
+			downloadCommand = GetPubArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeCRAN == packageType {
+			downloadCommand = GetCranArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypeGALAXY == packageType {
+			downloadCommand = GetGalaxyArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypePUPPET == packageType {
+			downloadCommand = GetPuppetArtifactFileDownloadCommand(registryURL, filename)
+		} else if artifactapi.PackageTypeVAGRANT == packageType {
+			downloadCommand = GetVagrantArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeMODEL == packageType {
+			downloadCommand = GetModelArtifactFileDownloadCommand(registryURL, artifactName, version, filename)
+		} else if artifactapi.PackageTypeHOMEBREW == packageType {
+			downloadCommand = GetHomebrewArtifactFileDownloadCommand(registryURL, filename)
 		}
+		sizeBytes := file.Size
 		files = append(files, artifactapi.FileDetail{
 			Checksums:       getCheckSums(file),
 			Size:            GetSize(file.Size),
+			SizeBytes:       &sizeBytes,
 			CreatedAt:       fmt.Sprint(file.CreatedAt),
 			Name:            filename,
 			DownloadCommand: downloadCommand,
@@ -260,7 +415,7 @@ func getCheckSums(file types.FileNodeMetadata) []string {
 
 func GetAllArtifactByRegistryResponse(
 	artifacts *[]types.ArtifactMetadata,
-	count int64,
+	count Count,
 	pageNumber int64,
 	pageSize int,
 ) *artifactapi.ListRegistryArtifactResponseJSONResponse {
@@ -270,13 +425,16 @@ func GetAllArtifactByRegistryResponse(
 	} else {
 		artifactMetadataList = GetRegistryArtifactMetadata(*artifacts)
 	}
-	pageCount := GetPageCount(count, pageSize)
+	pageCount := GetPageCount(count.Items, pageSize)
+	countExact, countedAt := countFields(count)
 	listArtifact := &artifactapi.ListRegistryArtifact{
-		ItemCount: &count,
-		PageCount: &pageCount,
-		PageIndex: &pageNumber,
-		PageSize:  &pageSize,
-		Artifacts: artifactMetadataList,
+		ItemCount:  &count.Items,
+		CountExact: countExact,
+		CountedAt:  countedAt,
+		PageCount:  &pageCount,
+		PageIndex:  &pageNumber,
+		PageSize:   &pageSize,
+		Artifacts:  artifactMetadataList,
 	}
 	response := &artifactapi.ListRegistryArtifactResponseJSONResponse{
 		Data:   *listArtifact,
@@ -310,7 +468,7 @@ func GetAllArtifactVersionResponse(
 	ctx context.Context,
 	tags *[]types.TagMetadata,
 	image string,
-	count int64,
+	count Count,
 	pageNumber int64,
 	pageSize int,
 	registryURL string,
@@ -318,9 +476,12 @@ func GetAllArtifactVersionResponse(
 	artifactVersionMetadataList := GetTagMetadata(
 		ctx, tags, image, registryURL,
 	)
-	pageCount := GetPageCount(count, pageSize)
+	pageCount := GetPageCount(count.Items, pageSize)
+	countExact, countedAt := countFields(count)
 	listArtifactVersions := &artifactapi.ListArtifactVersion{
-		ItemCount:        &count,
+		ItemCount:        &count.Items,
+		CountExact:       countExact,
+		CountedAt:        countedAt,
 		PageCount:        &pageCount,
 		PageIndex:        &pageNumber,
 		PageSize:         &pageSize,
@@ -337,7 +498,7 @@ func GetNonOCIAllArtifactVersionResponse(
 	ctx context.Context,
 	artifacts *[]types.NonOCIArtifactMetadata,
 	image string,
-	count int64,
+	count Count,
 	pageNumber int64,
 	pageSize int,
 	registryURL string,
@@ -345,9 +506,12 @@ func GetNonOCIAllArtifactVersionResponse(
 	artifactVersionMetadataList := GetNonOCIArtifactMetadata(
 		ctx, artifacts, image, registryURL,
 	)
-	pageCount := GetPageCount(count, pageSize)
+	pageCount := GetPageCount(count.Items, pageSize)
+	countExact, countedAt := countFields(count)
 	listArtifactVersions := &artifactapi.ListArtifactVersion{
-		ItemCount:        &count,
+		ItemCount:        &count.Items,
+		CountExact:       countExact,
+		CountedAt:        countedAt,
 		PageCount:        &pageCount,
 		PageIndex:        &pageNumber,
 		PageSize:         &pageSize,
@@ -370,22 +534,26 @@ func GetNonOCIArtifactMetadata(
 	for _, tag := range *tags {
 		modifiedAt := GetTimeInMs(tag.ModifiedAt)
 		size := GetImageSize(tag.Size)
+		sizeBytes := GetImageSizeBytes(tag.Size)
 		command := GetPullCommand(image, tag.Name, string(tag.PackageType), registryURL)
 		packageType, err := toPackageType(string(tag.PackageType))
 		downloadCount := tag.DownloadCount
+		uniqueDownloadCount := tag.UniqueDownloadCount
 		if err != nil {
 			log.Ctx(ctx).Error().Err(err).Msgf("Error converting package type %s", tag.PackageType)
 			continue
 		}
 		fileCount := tag.FileCount
 		artifactVersionMetadata := &artifactapi.ArtifactVersionMetadata{
-			PackageType:    &packageType,
-			FileCount:      &fileCount,
-			Name:           tag.Name,
-			Size:           &size,
-			LastModified:   &modifiedAt,
-			PullCommand:    &command,
-			DownloadsCount: &downloadCount,
+			PackageType:          &packageType,
+			FileCount:            &fileCount,
+			Name:                 tag.Name,
+			Size:                 &size,
+			SizeBytes:            &sizeBytes,
+			LastModified:         &modifiedAt,
+			PullCommand:          &command,
+			DownloadsCount:       &downloadCount,
+			UniqueDownloadsCount: &uniqueDownloadCount,
 		}
 		artifactVersionMetadataList = append(artifactVersionMetadataList, *artifactVersionMetadata)
 	}
@@ -413,7 +581,9 @@ func GetDockerArtifactDetails(
 		PullCommand:    &pullCommand,
 		Url:            GetTagURL(tag.ImageName, tag.Name, registryURL),
 		Size:           &size,
+		SizeBytes:      &manifest.TotalSize,
 		DownloadsCount: &tag.DownloadCount,
+		Annotations:    getAnnotationsRef(manifest.Annotations),
 	}
 
 	response := &artifactapi.DockerArtifactDetailResponseJSONResponse{
@@ -445,6 +615,7 @@ func GetHelmArtifactDetails(
 		PullCommand:    &pullCommand,
 		Url:            GetTagURL(tag.ImageName, tag.Name, registryURL),
 		Size:           &size,
+		SizeBytes:      &manifest.TotalSize,
 		DownloadsCount: &downloadCount,
 	}
 
@@ -476,39 +647,1290 @@ func GetGenericArtifactDetail(
 	return *artifactDetail
 }
 
-func GetArtifactSummary(artifact types.ArtifactMetadata) *artifactapi.ArtifactSummaryResponseJSONResponse {
+func GetNpmArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.NpmMetadata,
+) artifactapi.ArtifactDetail {
 	createdAt := GetTimeInMs(artifact.CreatedAt)
-	modifiedAt := GetTimeInMs(artifact.ModifiedAt)
-	artifactVersionSummary := &artifactapi.ArtifactSummary{
-		CreatedAt:      &createdAt,
-		ModifiedAt:     &modifiedAt,
-		DownloadsCount: &artifact.DownloadCount,
-		ImageName:      artifact.Name,
-		Labels:         &artifact.Labels,
-		PackageType:    artifact.PackageType,
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
 	}
-	response := &artifactapi.ArtifactSummaryResponseJSONResponse{
-		Data:   *artifactVersionSummary,
-		Status: artifactapi.StatusSUCCESS,
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
 	}
-	return response
+	config := artifactapi.NpmArtifactDetailConfig{
+		Description: optionalString(metadata.Description),
+		License:     optionalString(metadata.License),
+		Homepage:    optionalString(metadata.Homepage),
+	}
+	if len(metadata.DistTags) > 0 {
+		config.DistTags = &metadata.DistTags
+	}
+	if len(metadata.Dependencies) > 0 {
+		config.Dependencies = &metadata.Dependencies
+	}
+	if err := artifactDetail.FromNpmArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
 }
 
-func GetArtifactVersionSummary(
-	artifactName string,
-	packageType artifactapi.PackageType,
-	version string,
-) *artifactapi.ArtifactVersionSummaryResponseJSONResponse {
-	artifactVersionSummary := &artifactapi.ArtifactVersionSummary{
-		ImageName:   artifactName,
-		PackageType: packageType,
-		Version:     version,
+func GetPythonArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.PyPiMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
 	}
-	response := &artifactapi.ArtifactVersionSummaryResponseJSONResponse{
-		Data:   *artifactVersionSummary,
-		Status: artifactapi.StatusSUCCESS,
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
 	}
-	return response
+	pullCommand := GetPythonInstallCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.PythonArtifactDetailConfig{
+		PullCommand:    &pullCommand,
+		Summary:        optionalString(metadata.Summary),
+		License:        optionalString(metadata.License),
+		Homepage:       optionalString(metadata.HomePage),
+		Author:         optionalString(metadata.Author),
+		RequiresPython: optionalString(metadata.RequiresPython),
+	}
+	if len(metadata.RequiresDist) > 0 {
+		config.RequiresDist = &metadata.RequiresDist
+	}
+	if len(metadata.Distributions) > 0 {
+		distributions := make([]artifactapi.PythonDistribution, 0, len(metadata.Distributions))
+		for _, d := range metadata.Distributions {
+			distributions = append(distributions, artifactapi.PythonDistribution{
+				FileName:       d.Filename,
+				FileType:       d.FileType,
+				PythonVersion:  optionalString(d.PyVersion),
+				RequiresPython: optionalString(d.RequiresPython),
+				Sha256:         optionalString(d.Sha256),
+			})
+		}
+		config.Distributions = &distributions
+	}
+	if err := artifactDetail.FromPythonArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetNugetArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.NugetMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetNugetInstallCommand(metadata.ID, artifact.Version, registryURL)
+	license := metadata.LicenseExpression
+	if license == "" {
+		license = metadata.LicenseURL
+	}
+	config := artifactapi.NugetArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Title:       optionalString(metadata.Title),
+		Description: optionalString(metadata.Description),
+		Authors:     optionalString(metadata.Authors),
+		ProjectUrl:  optionalString(metadata.ProjectURL),
+		License:     optionalString(license),
+	}
+	if len(metadata.Tags) > 0 {
+		config.Tags = &metadata.Tags
+	}
+	if len(metadata.DependencyGroups) > 0 {
+		groups := make([]artifactapi.NugetDependencyGroup, 0, len(metadata.DependencyGroups))
+		for _, g := range metadata.DependencyGroups {
+			dependencies := make([]artifactapi.NugetDependency, 0, len(g.Dependencies))
+			for _, d := range g.Dependencies {
+				dependencies = append(dependencies, artifactapi.NugetDependency{
+					Id:    d.ID,
+					Range: optionalString(d.Range),
+				})
+			}
+			groups = append(groups, artifactapi.NugetDependencyGroup{
+				TargetFramework: optionalString(g.TargetFramework),
+				Dependencies:    dependencies,
+			})
+		}
+		config.DependencyGroups = &groups
+	}
+	if err := artifactDetail.FromNugetArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetGemsArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.GemsMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetGemsInstallCommand(image.Name, metadata.Version, registryURL)
+	config := artifactapi.GemsArtifactDetailConfig{
+		PullCommand:         &pullCommand,
+		Summary:             optionalString(metadata.Summary),
+		Description:         optionalString(metadata.Description),
+		Homepage:            optionalString(metadata.Homepage),
+		Platform:            optionalString(metadata.Platform),
+		RequiredRubyVersion: optionalString(strings.Join(metadata.RequiredRubyVersion, ", ")),
+	}
+	if len(metadata.Authors) > 0 {
+		config.Authors = &metadata.Authors
+	}
+	if len(metadata.Licenses) > 0 {
+		config.Licenses = &metadata.Licenses
+	}
+	if len(metadata.Dependencies) > 0 {
+		dependencies := make([]artifactapi.GemsDependency, 0, len(metadata.Dependencies))
+		for _, d := range metadata.Dependencies {
+			dependencies = append(dependencies, artifactapi.GemsDependency{
+				Name:        d.Name,
+				Requirement: strings.Join(d.Requirements, ", "),
+				Type:        d.Type,
+			})
+		}
+		config.Dependencies = &dependencies
+	}
+	if err := artifactDetail.FromGemsArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetCrateArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.CrateMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetCrateInstallCommand(metadata.Name, metadata.Version, registryURL)
+	config := artifactapi.CrateArtifactDetailConfig{
+		PullCommand:   &pullCommand,
+		Description:   optionalString(metadata.Description),
+		License:       optionalString(metadata.License),
+		Homepage:      optionalString(metadata.Homepage),
+		Repository:    optionalString(metadata.Repository),
+		Documentation: optionalString(metadata.Documentation),
+		RustVersion:   optionalString(metadata.RustVersion),
+	}
+	if len(metadata.Authors) > 0 {
+		config.Authors = &metadata.Authors
+	}
+	if len(metadata.Keywords) > 0 {
+		config.Keywords = &metadata.Keywords
+	}
+	if len(metadata.Categories) > 0 {
+		config.Categories = &metadata.Categories
+	}
+	if len(metadata.Features) > 0 {
+		features := make([]string, 0, len(metadata.Features))
+		for feature := range metadata.Features {
+			features = append(features, feature)
+		}
+		sort.Strings(features)
+		config.Features = &features
+	}
+	if len(metadata.Dependencies) > 0 {
+		dependencies := make([]artifactapi.CrateDependency, 0, len(metadata.Dependencies))
+		for _, d := range metadata.Dependencies {
+			kind := d.Kind
+			if kind == "" {
+				kind = "normal"
+			}
+			dependencies = append(dependencies, artifactapi.CrateDependency{
+				Name:        d.Name,
+				Requirement: d.VersionReq,
+				Kind:        kind,
+				Optional:    d.Optional,
+				Target:      optionalString(d.Target),
+			})
+		}
+		config.Dependencies = &dependencies
+	}
+	if err := artifactDetail.FromCrateArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetGoModuleArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.GoModuleMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetGoModuleInstallCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.GoArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		GoVersion:   optionalString(metadata.GoVersion),
+	}
+	if len(metadata.Requires) > 0 {
+		dependencies := make([]artifactapi.GoDependency, 0, len(metadata.Requires))
+		for _, r := range metadata.Requires {
+			dependencies = append(dependencies, artifactapi.GoDependency{
+				Path:     r.Path,
+				Version:  r.Version,
+				Indirect: r.Indirect,
+			})
+		}
+		config.Dependencies = &dependencies
+	}
+	if err := artifactDetail.FromGoArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetDebArtifactDetail lists the distributions and components the .debs of a version are published
+// to; the pull command uses the first of them.
+func GetDebArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.DebMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	config := artifactapi.DebArtifactDetailConfig{}
+	if len(metadata.Debs) > 0 {
+		first := metadata.Debs[0]
+		pullCommand := GetDebInstallCommand(image.Name, artifact.Version, registryURL, first.Distribution,
+			first.Component)
+		config.PullCommand = &pullCommand
+		config.Maintainer = optionalString(first.Maintainer)
+		config.Depends = optionalString(first.Depends)
+		if first.InstalledSize > 0 {
+			config.InstalledSize = &first.InstalledSize
+		}
+		packages := make([]artifactapi.DebPackageFile, 0, len(metadata.Debs))
+		for _, d := range metadata.Debs {
+			packages = append(packages, artifactapi.DebPackageFile{
+				Distribution: d.Distribution,
+				Component:    d.Component,
+				Architecture: d.Architecture,
+				FileName:     d.Filename,
+				Sha256:       d.Sha256,
+			})
+		}
+		config.Packages = &packages
+	}
+	if err := artifactDetail.FromDebArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetRpmArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.RpmMetadata,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetRpmInstallCommand(image.Name, artifact.Version)
+	config := artifactapi.RpmArtifactDetailConfig{
+		PullCommand: &pullCommand,
+	}
+	if len(metadata.Packages) > 0 {
+		first := metadata.Packages[0]
+		config.Summary = optionalString(first.Summary)
+		config.License = optionalString(first.License)
+		config.Url = optionalString(first.URL)
+		config.Vendor = optionalString(first.Vendor)
+		config.Packager = optionalString(first.Packager)
+		if len(first.Requires) > 0 {
+			requires := make([]string, 0, len(first.Requires))
+			for _, r := range first.Requires {
+				requires = append(requires, formatRpmEntry(r))
+			}
+			config.Requires = &requires
+		}
+		packages := make([]artifactapi.RpmPackageFile, 0, len(metadata.Packages))
+		for _, p := range metadata.Packages {
+			packages = append(packages, artifactapi.RpmPackageFile{
+				Architecture: p.Architecture,
+				FileName:     p.Filename,
+				Sha256:       p.Sha256,
+			})
+		}
+		config.Packages = &packages
+	}
+	if err := artifactDetail.FromRpmArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetAlpineArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.AlpineMetadata,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetAlpineInstallCommand(image.Name, artifact.Version)
+	config := artifactapi.AlpineArtifactDetailConfig{
+		PullCommand: &pullCommand,
+	}
+	if len(metadata.Packages) > 0 {
+		first := metadata.Packages[0]
+		config.Description = optionalString(first.Description)
+		config.License = optionalString(first.License)
+		config.Url = optionalString(first.URL)
+		config.Maintainer = optionalString(first.Maintainer)
+		config.Origin = optionalString(first.Origin)
+		if len(first.Depends) > 0 {
+			depends := first.Depends
+			config.Depends = &depends
+		}
+		packages := make([]artifactapi.AlpinePackageFile, 0, len(metadata.Packages))
+		for _, p := range metadata.Packages {
+			installedSize := p.InstalledSize
+			packages = append(packages, artifactapi.AlpinePackageFile{
+				Architecture:  p.Architecture,
+				FileName:      p.Filename,
+				InstalledSize: &installedSize,
+				Sha256:        p.Sha256,
+			})
+		}
+		config.Packages = &packages
+	}
+	if err := artifactDetail.FromAlpineArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetComposerArtifactDetail returns the details of a version as read from its composer.json.
+func GetComposerArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.ComposerMetadata,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetComposerInstallCommand(image.Name, artifact.Version)
+	config := artifactapi.ComposerArtifactDetailConfig{
+		PullCommand:       &pullCommand,
+		Description:       optionalString(metadata.Description),
+		Type:              optionalString(metadata.Type),
+		VersionNormalized: optionalString(metadata.VersionNormalized),
+		Homepage:          optionalString(metadata.Homepage),
+	}
+	if len(metadata.License) > 0 {
+		license := []string(metadata.License)
+		config.License = &license
+	}
+	if len(metadata.Keywords) > 0 {
+		keywords := metadata.Keywords
+		config.Keywords = &keywords
+	}
+	if len(metadata.Authors) > 0 {
+		authors := make([]artifactapi.ComposerAuthor, 0, len(metadata.Authors))
+		for _, a := range metadata.Authors {
+			authors = append(authors, artifactapi.ComposerAuthor{
+				Name:     optionalString(a.Name),
+				Email:    optionalString(a.Email),
+				Homepage: optionalString(a.Homepage),
+				Role:     optionalString(a.Role),
+			})
+		}
+		config.Authors = &authors
+	}
+	if len(metadata.Require) > 0 {
+		require := metadata.Require
+		config.Require = &require
+	}
+	if len(metadata.RequireDev) > 0 {
+		requireDev := metadata.RequireDev
+		config.RequireDev = &requireDev
+	}
+	if err := artifactDetail.FromComposerArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetCondaArtifactDetail lists the builds of a version, which are published per platform subdir.
+func GetCondaArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.CondaMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetCondaInstallCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.CondaArtifactDetailConfig{
+		PullCommand: &pullCommand,
+	}
+	if len(metadata.Packages) > 0 {
+		first := metadata.Packages[0]
+		config.Summary = optionalString(first.Summary)
+		config.Description = optionalString(first.Description)
+		config.License = optionalString(first.License)
+		config.Home = optionalString(first.Home)
+		config.DevUrl = optionalString(first.DevURL)
+		config.DocUrl = optionalString(first.DocURL)
+		packages := make([]artifactapi.CondaPackageFile, 0, len(metadata.Packages))
+		for _, p := range metadata.Packages {
+			buildNumber, size := p.BuildNumber, p.Size
+			packageFile := artifactapi.CondaPackageFile{
+				Subdir:      p.Subdir,
+				FileName:    p.Filename,
+				Build:       p.Build,
+				BuildNumber: &buildNumber,
+				Size:        &size,
+			}
+			if len(p.Depends) > 0 {
+				depends := p.Depends
+				packageFile.Depends = &depends
+			}
+			packages = append(packages, packageFile)
+		}
+		config.Packages = &packages
+	}
+	if err := artifactDetail.FromCondaArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetTerraformArtifactDetail describes a version of a module along with its submodules and examples,
+// or a version of a provider along with its platforms.
+func GetTerraformArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.TerraformMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetTerraformPullCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.TerraformArtifactDetailConfig{
+		Kind:        artifactapi.TerraformArtifactKindMODULE,
+		PullCommand: &pullCommand,
+		Namespace:   metadata.Namespace,
+		Name:        metadata.Name,
+		System:      optionalString(metadata.System),
+		Readme:      optionalString(metadata.Readme),
+	}
+	if provider := metadata.Provider; provider != nil {
+		platforms := make([]artifactapi.TerraformProviderPlatform, 0, len(provider.Platforms))
+		for _, platform := range provider.Platforms {
+			platforms = append(platforms, artifactapi.TerraformProviderPlatform{
+				Os:       platform.OS,
+				Arch:     platform.Arch,
+				Filename: platform.Filename,
+				Shasum:   platform.Shasum,
+			})
+		}
+		protocols := provider.Protocols
+		config = artifactapi.TerraformArtifactDetailConfig{
+			Kind:         artifactapi.TerraformArtifactKindPROVIDER,
+			PullCommand:  &pullCommand,
+			Namespace:    provider.Namespace,
+			Name:         provider.Type,
+			Protocols:    &protocols,
+			Platforms:    &platforms,
+			SigningKeyId: optionalString(provider.SigningKey.KeyID),
+		}
+	}
+	if len(metadata.Submodules) > 0 {
+		submodules := metadata.Submodules
+		config.Submodules = &submodules
+	}
+	if len(metadata.Examples) > 0 {
+		examples := metadata.Examples
+		config.Examples = &examples
+	}
+	if err := artifactDetail.FromTerraformArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetSwiftArtifactDetail describes a release along with the manifests at the root of its package.
+func GetSwiftArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.SwiftMetadata,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetSwiftPackageDependency(image.Name, artifact.Version)
+	manifests := make([]artifactapi.SwiftManifest, 0, len(metadata.Manifests))
+	for _, manifest := range metadata.Manifests {
+		manifests = append(manifests, artifactapi.SwiftManifest{
+			Filename:     manifest.Filename(),
+			SwiftVersion: optionalString(manifest.SwiftVersion),
+			ToolsVersion: optionalString(manifest.ToolsVersion),
+		})
+	}
+	signed := metadata.Signature != ""
+	config := artifactapi.SwiftArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Scope:       metadata.Scope,
+		Name:        metadata.Name,
+		Manifests:   &manifests,
+		Signed:      &signed,
+	}
+	if metadata.Metadata != nil {
+		config.Description = optionalString(metadata.Metadata.Description)
+		if len(metadata.Metadata.RepositoryURLs) > 0 {
+			repositoryURLs := metadata.Metadata.RepositoryURLs
+			config.RepositoryUrls = &repositoryURLs
+		}
+	}
+	if err := artifactDetail.FromSwiftArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetCocoapodsArtifactDetail describes a version from its podspec.
+func GetCocoapodsArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.CocoapodsMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetCocoapodsInstallCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.CocoapodsArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Summary:     optionalString(metadata.Summary),
+		Homepage:    optionalString(metadata.Homepage),
+		License:     optionalString(metadata.License),
+	}
+	if len(metadata.Platforms) > 0 {
+		platforms := metadata.Platforms
+		config.Platforms = &platforms
+	}
+	if len(metadata.Dependencies) > 0 {
+		dependencies := metadata.Dependencies
+		config.Dependencies = &dependencies
+	}
+	if err := artifactDetail.FromCocoapodsArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetHexArtifactDetail describes a release from the metadata of its tarball.
+func GetHexArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.HexMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetHexInstallCommand(image.Name, artifact.Version, registryURL)
+	hasDocs := metadata.HasDocs
+	config := artifactapi.HexArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		App:         optionalString(metadata.App),
+		Description: optionalString(metadata.Description),
+		HasDocs:     &hasDocs,
+	}
+	if len(metadata.Licenses) > 0 {
+		licenses := metadata.Licenses
+		config.Licenses = &licenses
+	}
+	if len(metadata.Links) > 0 {
+		links := metadata.Links
+		config.Links = &links
+	}
+	if len(metadata.Requirements) > 0 {
+		requirements := make([]string, 0, len(metadata.Requirements))
+		for _, requirement := range metadata.Requirements {
+			value := requirement.Name + " " + requirement.Requirement
+			if requirement.Optional {
+				value += " (optional)"
+			}
+			requirements = append(requirements, value)
+		}
+		config.Requirements = &requirements
+	}
+	if err := artifactDetail.FromHexArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetPubArtifactDetail describes a version from the pubspec it was published with.
+func GetPubArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.PubMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetPubInstallCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.PubArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Description: optionalString(metadata.Description),
+		Homepage:    optionalString(metadata.Homepage),
+		Repository:  optionalString(metadata.Repository),
+	}
+	if len(metadata.Environment) > 0 {
+		environment := metadata.Environment
+		config.Environment = &environment
+	}
+	if len(metadata.Dependencies) > 0 {
+		dependencies := metadata.Dependencies
+		config.Dependencies = &dependencies
+	}
+	if len(metadata.Topics) > 0 {
+		topics := metadata.Topics
+		config.Topics = &topics
+	}
+	if err := artifactDetail.FromPubArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetCranArtifactDetail describes a version from the DESCRIPTION of its source package, or of its
+// first binary package if the source package wasn't published.
+func GetCranArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.CranMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetCranInstallCommand(image.Name, registryURL)
+	config := artifactapi.CranArtifactDetailConfig{PullCommand: &pullCommand}
+	if len(metadata.Packages) > 0 {
+		description := metadata.Packages[0].Description
+		trees := make([]string, 0, len(metadata.Packages))
+		for _, p := range metadata.Packages {
+			if p.Tree == cran.SourceTree {
+				description = p.Description
+			}
+			trees = append(trees, p.Tree)
+		}
+		needsCompilation := description.NeedsCompilation == "yes"
+		config.Title = optionalString(description.Title)
+		config.Description = optionalString(description.Description)
+		config.License = optionalString(description.License)
+		config.Maintainer = optionalString(description.Maintainer)
+		config.Url = optionalString(description.URL)
+		config.Depends = optionalString(description.Depends)
+		config.Imports = optionalString(description.Imports)
+		config.Suggests = optionalString(description.Suggests)
+		config.NeedsCompilation = &needsCompilation
+		config.Trees = &trees
+	}
+	if err := artifactDetail.FromCranArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetGalaxyArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.GalaxyMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetGalaxyInstallCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.GalaxyArtifactDetailConfig{
+		PullCommand:   &pullCommand,
+		Description:   optionalString(metadata.Description),
+		Repository:    optionalString(metadata.Repository),
+		Documentation: optionalString(metadata.Documentation),
+		Homepage:      optionalString(metadata.Homepage),
+	}
+	if len(metadata.Authors) > 0 {
+		authors := metadata.Authors
+		config.Authors = &authors
+	}
+	if len(metadata.License) > 0 {
+		license := metadata.License
+		config.License = &license
+	}
+	if len(metadata.Dependencies) > 0 {
+		dependencies := metadata.Dependencies
+		config.Dependencies = &dependencies
+	}
+	if len(metadata.Tags) > 0 {
+		tags := metadata.Tags
+		config.Tags = &tags
+	}
+	if err := artifactDetail.FromGalaxyArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+func GetPuppetArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.PuppetMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetPuppetInstallCommand(image.Name, artifact.Version, registryURL)
+	config := artifactapi.PuppetArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Summary:     optionalString(metadata.Summary),
+		Author:      optionalString(metadata.Author),
+		License:     optionalString(metadata.License),
+		Source:      optionalString(metadata.Source),
+		ProjectPage: optionalString(metadata.ProjectPage),
+		IssuesUrl:   optionalString(metadata.IssuesURL),
+	}
+	if len(metadata.Dependencies) > 0 {
+		dependencies := make(map[string]string, len(metadata.Dependencies))
+		for _, dependency := range metadata.Dependencies {
+			dependencies[dependency.Name] = dependency.VersionRequirement
+		}
+		config.Dependencies = &dependencies
+	}
+	if len(metadata.OperatingSystemSupport) > 0 {
+		operatingSystems := make([]string, 0, len(metadata.OperatingSystemSupport))
+		for _, os := range metadata.OperatingSystemSupport {
+			operatingSystems = append(operatingSystems, os.OperatingSystem)
+		}
+		config.OperatingSystems = &operatingSystems
+	}
+	if len(metadata.Tags) > 0 {
+		tags := metadata.Tags
+		config.Tags = &tags
+	}
+	if err := artifactDetail.FromPuppetArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetModelArtifactDetail describes a version of a model with its framework, the formats of its
+// weights and its model card.
+func GetModelArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.ModelMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetModelArtifactFileDownloadCommand(registryURL, image.Name, artifact.Version, "<FILENAME>")
+	config := artifactapi.ModelArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Framework:   optionalString(metadata.Framework),
+		Formats:     &metadata.Formats,
+		ModelCard:   optionalString(metadata.ModelCard),
+	}
+	if err := artifactDetail.FromModelArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetHomebrewArtifactDetail lists the bottles of the version, one per platform and rebuild.
+func GetHomebrewArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.HomebrewMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetHomebrewInstallCommand(image.Name, registryURL)
+	bottles := make([]artifactapi.HomebrewBottle, 0, len(metadata.Bottles))
+	for _, bottle := range metadata.Bottles {
+		bottles = append(bottles, artifactapi.HomebrewBottle{
+			Tag:       bottle.Tag,
+			Rebuild:   bottle.Rebuild,
+			Cellar:    bottle.Cellar,
+			Checksum:  bottle.Sha256,
+			SizeBytes: bottle.Size,
+		})
+	}
+	config := artifactapi.HomebrewArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Desc:        optionalString(metadata.Formula.Desc),
+		Homepage:    optionalString(metadata.Formula.Homepage),
+		License:     optionalString(metadata.Formula.License),
+		Bottles:     &bottles,
+	}
+	if err := artifactDetail.FromHomebrewArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetVagrantArtifactDetail lists the boxes of the version, one per provider and architecture.
+func GetVagrantArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.VagrantMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetVagrantBoxAddCommand(image.Name, artifact.Version, registryURL)
+	providers := make([]artifactapi.VagrantBoxProvider, 0, len(metadata.Providers))
+	for _, provider := range metadata.Providers {
+		providers = append(providers, artifactapi.VagrantBoxProvider{
+			Name:         provider.Name,
+			Architecture: optionalString(provider.Architecture),
+			Checksum:     provider.Sha256,
+			SizeBytes:    provider.Size,
+		})
+	}
+	config := artifactapi.VagrantArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Providers:   &providers,
+	}
+	if err := artifactDetail.FromVagrantArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+// GetConanArtifactDetail lists the revisions of the recipe, the latest first, along with the
+// settings, options and requirements each binary package was built with.
+func GetConanArtifactDetail(
+	image *types.Image, artifact *types.Artifact,
+	metadata database.ConanMetadata, registryURL string,
+) artifactapi.ArtifactDetail {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.UpdatedAt)
+	var size int64
+	for _, file := range metadata.Files {
+		size += file.Size
+	}
+	sizeVal := GetSize(size)
+	artifactDetail := &artifactapi.ArtifactDetail{
+		CreatedAt:  &createdAt,
+		ModifiedAt: &modifiedAt,
+		Name:       &image.Name,
+		Version:    artifact.Version,
+		Size:       &sizeVal,
+		SizeBytes:  &size,
+	}
+	pullCommand := GetConanInstallCommand(image.Name, artifact.Version, registryURL)
+	reference := metadata.Reference.String()
+	config := artifactapi.ConanArtifactDetailConfig{
+		PullCommand: &pullCommand,
+		Reference:   &reference,
+	}
+
+	recipes := append([]database.ConanRecipeRevision(nil), metadata.Revisions...)
+	sort.SliceStable(recipes, func(i, j int) bool {
+		return recipes[i].Time > recipes[j].Time
+	})
+	revisions := make([]artifactapi.ConanRecipeRevision, 0, len(recipes))
+	for _, r := range recipes {
+		packages := make([]artifactapi.ConanBinaryPackage, 0, len(r.Packages))
+		for _, p := range r.Packages {
+			var latest *database.ConanPackageRevision
+			for i := range p.Revisions {
+				if latest == nil || p.Revisions[i].Time > latest.Time {
+					latest = &p.Revisions[i]
+				}
+			}
+			if latest == nil {
+				continue
+			}
+			binary := artifactapi.ConanBinaryPackage{PackageId: p.PackageID, LatestRevision: latest.Revision}
+			if latest.Info != nil {
+				settings, options := latest.Info.Settings, latest.Info.Options
+				binary.Settings, binary.Options = &settings, &options
+				if len(latest.Info.Requires) > 0 {
+					requires := latest.Info.Requires
+					binary.Requires = &requires
+				}
+			}
+			packages = append(packages, binary)
+		}
+		revisions = append(revisions, artifactapi.ConanRecipeRevision{
+			Revision:  r.Revision,
+			CreatedAt: GetTimeInMs(time.UnixMilli(r.Time)),
+			Packages:  &packages,
+		})
+	}
+	if len(revisions) > 0 {
+		config.LatestRevision = &revisions[0].Revision
+	}
+	config.Revisions = &revisions
+	if err := artifactDetail.FromConanArtifactDetailConfig(config); err != nil {
+		return artifactapi.ArtifactDetail{}
+	}
+	return *artifactDetail
+}
+
+var rpmEntryOperators = map[string]string{"LT": "<", "GT": ">", "EQ": "=", "LE": "<=", "GE": ">="}
+
+// formatRpmEntry formats a requirement the way it's written in spec files, like "glibc >= 2.34".
+func formatRpmEntry(e rpm.Entry) string {
+	operator, ok := rpmEntryOperators[e.Flags]
+	if !ok {
+		return e.Name
+	}
+	version := e.Version
+	if e.Epoch != "" && e.Epoch != "0" {
+		version = e.Epoch + ":" + version
+	}
+	if e.Release != "" {
+		version += "-" + e.Release
+	}
+	return e.Name + " " + operator + " " + version
+}
+
+// SetPythonFileDetails adds the distribution metadata recorded at upload to the files of a
+// python package version.
+func SetPythonFileDetails(files []artifactapi.FileDetail, metadata database.PyPiMetadata) {
+	for i := range files {
+		d, ok := metadata.Distribution(files[i].Name)
+		if !ok {
+			continue
+		}
+		files[i].FileType = optionalString(d.FileType)
+		files[i].PythonVersion = optionalString(d.PyVersion)
+		files[i].RequiresPython = optionalString(d.RequiresPython)
+	}
+}
+
+// SetConanFileDetails sets the recipe revision, and the package and package revision of binary
+// package files, which the names of the files are paths of relative to the version.
+func SetConanFileDetails(files []artifactapi.FileDetail) {
+	for i := range files {
+		p, ok := conanpkg.ParseFilePath(files[i].Name)
+		if !ok {
+			continue
+		}
+		files[i].Revision = optionalString(p.Revision)
+		files[i].PackageId = optionalString(p.PackageID)
+		files[i].PackageRevision = optionalString(p.PackageRevision)
+	}
+}
+
+// SetTerraformFileDetails sets the platform of the archives of a provider version.
+func SetTerraformFileDetails(files []artifactapi.FileDetail, metadata database.TerraformMetadata) {
+	if metadata.Provider == nil {
+		return
+	}
+	for i := range files {
+		platform, ok := metadata.Provider.PlatformOf(files[i].Name)
+		if !ok {
+			continue
+		}
+		files[i].Os = optionalString(platform.OS)
+		files[i].Arch = optionalString(platform.Arch)
+	}
+}
+
+func GetArtifactSummary(artifact types.ArtifactMetadata) *artifactapi.ArtifactSummaryResponseJSONResponse {
+	createdAt := GetTimeInMs(artifact.CreatedAt)
+	modifiedAt := GetTimeInMs(artifact.ModifiedAt)
+	artifactVersionSummary := &artifactapi.ArtifactSummary{
+		CreatedAt:      &createdAt,
+		ModifiedAt:     &modifiedAt,
+		DownloadsCount: &artifact.DownloadCount,
+		ImageName:      artifact.Name,
+		Labels:         &artifact.Labels,
+		PackageType:    artifact.PackageType,
+	}
+	if artifact.LatestVersion != "" {
+		artifactVersionSummary.LatestVersion = &artifact.LatestVersion
+	}
+	response := &artifactapi.ArtifactSummaryResponseJSONResponse{
+		Data:   *artifactVersionSummary,
+		Status: artifactapi.StatusSUCCESS,
+	}
+	return response
+}
+
+func GetArtifactVersionSummary(
+	artifactName string,
+	packageType artifactapi.PackageType,
+	version string,
+) *artifactapi.ArtifactVersionSummaryResponseJSONResponse {
+	artifactVersionSummary := &artifactapi.ArtifactVersionSummary{
+		ImageName:   artifactName,
+		PackageType: packageType,
+		Version:     version,
+	}
+	response := &artifactapi.ArtifactVersionSummaryResponseJSONResponse{
+		Data:   *artifactVersionSummary,
+		Status: artifactapi.StatusSUCCESS,
+	}
+	return response
+}
+
+// SetRpmVersionSummary fills the summary in with the spec fields of the first package of the version,
+// which the packages of the other architectures share.
+func SetRpmVersionSummary(summary *artifactapi.ArtifactVersionSummary, metadata database.RpmMetadata) {
+	if len(metadata.Packages) == 0 {
+		return
+	}
+	first := metadata.Packages[0]
+	summary.Summary = optionalString(first.Summary)
+	summary.Description = optionalString(first.Description)
+	summary.License = optionalString(first.License)
+	summary.Url = optionalString(first.URL)
 }
 
 func getRepoPath(registry string, image string, tag string) string {
@@ -0,0 +1,222 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// permalinkCodeLength is the number of bytes of the hash of a version its permalink code is encoded from.
+const permalinkCodeLength = 10
+
+// CreateArtifactVersionPermalink returns the permalink of a version, creating its code on first use.
+func (c *APIController) CreateArtifactVersionPermalink(
+	ctx context.Context,
+	r artifact.CreateArtifactVersionPermalinkRequestObject,
+) (artifact.CreateArtifactVersionPermalinkResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwCreateArtifactVersionPermalink400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwCreateArtifactVersionPermalink400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.CreateArtifactVersionPermalink403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return throwCreateArtifactVersionPermalink500Error(err), nil
+	}
+
+	image := string(r.Artifact)
+	version := string(r.Version)
+	err = c.findArtifactVersion(ctx, registry, image, version)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.CreateArtifactVersionPermalink404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, fmt.Sprintf("version %s of %s not found", version, image)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwCreateArtifactVersionPermalink500Error(err), nil
+	}
+
+	permalink := &registrytypes.Permalink{
+		Code:       permalinkCode(registry.ID, image, version),
+		RegistryID: registry.ID,
+		Image:      image,
+		Version:    version,
+		CreatedBy:  session.Principal.ID,
+	}
+	if err = c.PermalinkStore.Create(ctx, permalink); err != nil {
+		return throwCreateArtifactVersionPermalink500Error(err), nil
+	}
+
+	registryURL := c.URLProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name)
+	return artifact.CreateArtifactVersionPermalink200JSONResponse{
+		PermalinkResponseJSONResponse: artifact.PermalinkResponseJSONResponse{
+			Data: artifact.Permalink{
+				Code:               permalink.Code,
+				Shortlink:          c.URLProvider.GenerateAPIURL(ctx, "v1", "registry", "permalinks", permalink.Code),
+				Url:                versionUIURL(registryURL, image, version),
+				RegistryIdentifier: registry.Name,
+				Package:            image,
+				Version:            version,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ResolvePermalink redirects to the UI screen of the version of a permalink. The registry is looked up
+// on every request, so permalinks follow registries that are renamed or moved.
+func (c *APIController) ResolvePermalink(
+	ctx context.Context,
+	r artifact.ResolvePermalinkRequestObject,
+) (artifact.ResolvePermalinkResponseObject, error) {
+	permalink, err := c.PermalinkStore.GetByCode(ctx, string(r.PermalinkCode))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.ResolvePermalink404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "permalink not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwResolvePermalink500Error(err), nil
+	}
+	registry, err := c.RegistryRepository.Get(ctx, permalink.RegistryID)
+	if err != nil {
+		return throwResolvePermalink500Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByID(ctx, registry.ParentID)
+	if err != nil {
+		return throwResolvePermalink500Error(fmt.Errorf("failed to find space: %w", err)), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, registry.Name,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ResolvePermalink403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registryURL := c.URLProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name)
+	return artifact.ResolvePermalink302Response{
+		Headers: artifact.RedirectResponseHeaders{
+			Location: versionUIURL(registryURL, permalink.Image, permalink.Version),
+		},
+	}, nil
+}
+
+// findArtifactVersion returns ErrResourceNotFound unless the version was pushed to the registry. Versions
+// of OCI artifacts are tags or digests.
+func (c *APIController) findArtifactVersion(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	image string,
+	version string,
+) error {
+	if isOCIPackageType(registry.PackageType) {
+		if d, err := digest.Parse(version); err == nil {
+			dgst, err := registrytypes.NewDigest(d)
+			if err != nil {
+				return err
+			}
+			_, err = c.ManifestStore.FindManifestByDigest(ctx, registry.ID, image, dgst)
+			return err
+		}
+		_, err := c.ManifestStore.FindManifestByTagName(ctx, registry.ID, image, version)
+		return err
+	}
+	img, err := c.ImageStore.GetByName(ctx, registry.ID, image)
+	if err != nil {
+		return err
+	}
+	_, err = c.ArtifactStore.GetByName(ctx, img.ID, version)
+	return err
+}
+
+// permalinkCode derives the code of a version from its registry, name and version, so the code of a
+// version is the same however many times its permalink is requested.
+func permalinkCode(registryID int64, image string, version string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s", registryID, image, version)))
+	code := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sum[:permalinkCodeLength])
+	return strings.ToLower(code)
+}
+
+// versionUIURL returns the URL of the UI screen of a version.
+func versionUIURL(registryURL string, image string, version string) string {
+	return registryURL + "/artifacts/" + url.PathEscape(image) + "/versions/" + url.PathEscape(version)
+}
+
+func throwCreateArtifactVersionPermalink400Error(
+	err error,
+) artifact.CreateArtifactVersionPermalink400JSONResponse {
+	return artifact.CreateArtifactVersionPermalink400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwCreateArtifactVersionPermalink500Error(
+	err error,
+) artifact.CreateArtifactVersionPermalink500JSONResponse {
+	return artifact.CreateArtifactVersionPermalink500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwResolvePermalink500Error(err error) artifact.ResolvePermalink500JSONResponse {
+	return artifact.ResolvePermalink500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermalinkCode(t *testing.T) {
+	code := permalinkCode(1, "library/nginx", "1.25")
+	assert.Len(t, code, 16)
+	assert.Regexp(t, "^[a-z2-7]+$", code)
+	assert.Equal(t, code, permalinkCode(1, "library/nginx", "1.25"))
+	assert.NotEqual(t, code, permalinkCode(2, "library/nginx", "1.25"))
+	assert.NotEqual(t, code, permalinkCode(1, "library/nginx", "1.26"))
+}
+
+func TestVersionUIURL(t *testing.T) {
+	assert.Equal(t,
+		"https://app.example.com/spaces/acme/registries/docker/artifacts/library%2Fnginx/versions/1.25",
+		versionUIURL("https://app.example.com/spaces/acme/registries/docker", "library/nginx", "1.25"))
+	assert.Equal(t,
+		"https://app.example.com/spaces/acme/registries/maven/artifacts/com.acme:core/versions/1.0+build.1",
+		versionUIURL("https://app.example.com/spaces/acme/registries/maven", "com.acme:core", "1.0+build.1"))
+}
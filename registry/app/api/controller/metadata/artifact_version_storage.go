@@ -0,0 +1,272 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/services/compliance"
+	registrytypes "github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const versionFilesPageSize = 100
+
+// GetArtifactVersionStorage lists the objects a version is physically stored in, for administrators to
+// debug storage issues. Every object is looked up in the storage, so the objects that went missing and
+// their storage class and replication status are reported.
+func (c *APIController) GetArtifactVersionStorage(
+	ctx context.Context,
+	r artifact.GetArtifactVersionStorageRequestObject,
+) (artifact.GetArtifactVersionStorageResponseObject, error) {
+	session, _ := request.AuthSessionFrom(ctx)
+	if session == nil || !session.Principal.Admin {
+		return artifact.GetArtifactVersionStorage403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, "only administrators can view storage locations"),
+			),
+		}, nil
+	}
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetArtifactVersionStorage400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetArtifactVersionStorage403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwGetArtifactVersionStorage500Error(err), nil
+	}
+
+	rootSpace, err := c.SpaceFinder.FindByID(ctx, registry.RootParentID)
+	if err != nil {
+		return throwGetArtifactVersionStorage500Error(fmt.Errorf("failed to find root space: %w", err)), nil
+	}
+	root := storageRoot(rootSpace.Identifier, registry)
+	image := string(r.Artifact)
+	version := string(r.Version)
+
+	var objects []artifact.StorageObject
+	if isOCIPackageType(registry.PackageType) {
+		objects, err = c.imageStorageObjects(ctx, registry, strings.ToLower(root), image, version)
+	} else {
+		objects, err = c.fileStorageObjects(ctx, registry, root, image, version)
+	}
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return artifact.GetArtifactVersionStorage404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, fmt.Sprintf("version %s of %s not found", version, image)),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwGetArtifactVersionStorage500Error(err), nil
+	}
+
+	var totalSize int64
+	for i := range objects {
+		locateStorageObject(ctx, c.StorageDriver, &objects[i])
+		totalSize += objects[i].Size
+	}
+	return artifact.GetArtifactVersionStorage200JSONResponse{
+		VersionStorageResponseJSONResponse: artifact.VersionStorageResponseJSONResponse{
+			Data: artifact.VersionStorage{
+				Package:       image,
+				Version:       version,
+				StorageDriver: c.StorageDriver.Name(),
+				TotalSize:     totalSize,
+				Objects:       objects,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// imageStorageObjects returns the configuration and layer blobs of the manifest a tag or digest refers to,
+// of the manifests it references for indexes. Blobs the manifests share are listed once.
+func (c *APIController) imageStorageObjects(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	root string,
+	image string,
+	version string,
+) ([]artifact.StorageObject, error) {
+	var m *registrytypes.Manifest
+	if d, err := digest.Parse(version); err == nil {
+		dgst, err := registrytypes.NewDigest(d)
+		if err != nil {
+			return nil, err
+		}
+		m, err = c.ManifestStore.FindManifestByDigest(ctx, registry.ID, image, dgst)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		m, err = c.ManifestStore.FindManifestByTagName(ctx, registry.ID, image, version)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	manifests := registrytypes.Manifests{m}
+	if m.MediaType == manifestlist.MediaTypeManifestList || m.MediaType == v1.MediaTypeImageIndex {
+		references, err := c.ManifestStore.References(ctx, m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list references of manifest %s: %w", m.Digest, err)
+		}
+		manifests = append(manifests, references...)
+	}
+
+	objects := []artifact.StorageObject{}
+	seen := map[digest.Digest]bool{}
+	add := func(kind artifact.StorageObjectKind, m *registrytypes.Manifest, blob *registrytypes.Blob) error {
+		if seen[blob.Digest] {
+			return nil
+		}
+		seen[blob.Digest] = true
+		storagePath, err := storage.PathFn(root, blob.Digest)
+		if err != nil {
+			return fmt.Errorf("failed to get path of blob %s: %w", blob.Digest, err)
+		}
+		objects = append(objects, artifact.StorageObject{
+			Kind:        kind,
+			Name:        m.Digest.String(),
+			Digest:      blob.Digest.String(),
+			Size:        blob.Size,
+			StoragePath: storagePath,
+		})
+		return nil
+	}
+	for _, m := range manifests {
+		if m.Configuration != nil {
+			config, err := c.BlobStore.FindByID(ctx, m.Configuration.BlobID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find configuration of manifest %s: %w", m.Digest, err)
+			}
+			if err = add(artifact.StorageObjectKindCONFIG, m, config); err != nil {
+				return nil, err
+			}
+		}
+		layers, err := c.ManifestStore.LayerBlobs(ctx, m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list layers of manifest %s: %w", m.Digest, err)
+		}
+		for _, layer := range layers {
+			if err = add(artifact.StorageObjectKindLAYER, m, layer); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return objects, nil
+}
+
+// fileStorageObjects returns the files of a version, which are stored in the generic blob of their SHA-256.
+func (c *APIController) fileStorageObjects(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	root string,
+	image string,
+	version string,
+) ([]artifact.StorageObject, error) {
+	img, err := c.ImageStore.GetByName(ctx, registry.ID, image)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = c.ArtifactStore.GetByName(ctx, img.ID, version); err != nil {
+		return nil, err
+	}
+
+	versionPath := compliance.VersionPath(registry.PackageType, image, version)
+	objects := []artifact.StorageObject{}
+	for offset := 0; ; offset += versionFilesPageSize {
+		files, err := c.fileManager.GetFilesMetadata(ctx, versionPath+"/%", registry.ID, "name", "ASC",
+			versionFilesPageSize, offset, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range *files {
+			objects = append(objects, artifact.StorageObject{
+				Kind:        artifact.StorageObjectKindFILE,
+				Name:        file.Path,
+				Digest:      "sha256:" + file.Sha256,
+				Size:        file.Size,
+				StoragePath: path.Join("/", root, "files", file.Sha256),
+			})
+		}
+		if len(*files) < versionFilesPageSize {
+			return objects, nil
+		}
+	}
+}
+
+// locateStorageObject looks up the object in the storage. The status of the object is UNKNOWN if the storage
+// driver can't locate objects.
+func locateStorageObject(ctx context.Context, driver storagedriver.StorageDriver, object *artifact.StorageObject) {
+	object.Status = artifact.StorageObjectStatusUNKNOWN
+	locator, ok := driver.(storagedriver.ObjectLocator)
+	if !ok {
+		return
+	}
+	location, err := locator.Locate(ctx, object.StoragePath)
+	var notFound storagedriver.PathNotFoundError
+	if errors.As(err, &notFound) {
+		object.Status = artifact.StorageObjectStatusMISSING
+		return
+	}
+	if err != nil {
+		message := err.Error()
+		object.Error = &message
+		return
+	}
+
+	object.Status = artifact.StorageObjectStatusPRESENT
+	object.Location = &location.Location
+	object.StoredSize = &location.Size
+	if location.StorageClass != "" {
+		object.StorageClass = &location.StorageClass
+	}
+	if location.ReplicationStatus != "" {
+		object.ReplicationStatus = &location.ReplicationStatus
+	}
+}
+
+func throwGetArtifactVersionStorage500Error(err error) artifact.GetArtifactVersionStorage500JSONResponse {
+	return artifact.GetArtifactVersionStorage500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingLocator struct {
+	storagedriver.StorageDriver
+}
+
+func (failingLocator) Locate(context.Context, string) (*storagedriver.ObjectLocation, error) {
+	return nil, errors.New("access denied")
+}
+
+type plainDriver struct {
+	storagedriver.StorageDriver
+}
+
+func TestLocateStorageObject(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	driver := filesystem.New(filesystem.DriverParameters{RootDirectory: root, MaxThreads: 25})
+	require.NoError(t, driver.PutContent(ctx, "/acme/files/abc", []byte("content")))
+
+	present := artifact.StorageObject{StoragePath: "/acme/files/abc"}
+	locateStorageObject(ctx, driver, &present)
+	assert.Equal(t, artifact.StorageObjectStatusPRESENT, present.Status)
+	require.NotNil(t, present.Location)
+	assert.Equal(t, filepath.Join(root, "acme/files/abc"), *present.Location)
+	require.NotNil(t, present.StoredSize)
+	assert.Equal(t, int64(7), *present.StoredSize)
+	assert.Nil(t, present.StorageClass)
+	assert.Nil(t, present.ReplicationStatus)
+
+	missing := artifact.StorageObject{StoragePath: "/acme/files/def"}
+	locateStorageObject(ctx, driver, &missing)
+	assert.Equal(t, artifact.StorageObjectStatusMISSING, missing.Status)
+	assert.Nil(t, missing.Location)
+
+	failed := artifact.StorageObject{StoragePath: "/acme/files/abc"}
+	locateStorageObject(ctx, failingLocator{}, &failed)
+	assert.Equal(t, artifact.StorageObjectStatusUNKNOWN, failed.Status)
+	require.NotNil(t, failed.Error)
+	assert.Equal(t, "access denied", *failed.Error)
+
+	unsupported := artifact.StorageObject{StoragePath: "/acme/files/abc"}
+	locateStorageObject(ctx, plainDriver{}, &unsupported)
+	assert.Equal(t, artifact.StorageObjectStatusUNKNOWN, unsupported.Status)
+	assert.Nil(t, unsupported.Error)
+}
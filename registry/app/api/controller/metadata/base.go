@@ -21,11 +21,13 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	storagedriver "github.com/harness/gitness/registry/app/driver"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
 	gitnessenum "github.com/harness/gitness/types/enum"
 
@@ -174,6 +176,14 @@ func getManifestConfig(
 	return &config, nil
 }
 
+// storageRoot returns the path prefix under which the blobs of the registry are stored.
+func storageRoot(rootIdentifier string, registry *types.Registry) string {
+	if registry.StoragePrefix != "" {
+		return registry.StoragePrefix
+	}
+	return rootIdentifier
+}
+
 func (c *APIController) setUpstreamProxyIDs(
 	ctx context.Context,
 	registry *types.Registry,
@@ -201,7 +211,7 @@ func (c *APIController) setUpstreamProxyIDs(
 		math.MaxInt,
 		0,
 		"",
-		string(api.RegistryTypeUPSTREAM),
+		"",
 		true,
 	)
 
@@ -211,23 +221,36 @@ func (c *APIController) setUpstreamProxyIDs(
 		return err
 	}
 
-	var upstreamProxies []int64
-	for _, proxy := range *virtualConfig.UpstreamProxies {
-		for _, repo := range *repos {
-			if repo.RegIdentifier == proxy {
-				regID, err := strconv.ParseInt(repo.RegID, 10, 64)
-				if err != nil {
-					continue
-				}
-				upstreamProxies = append(upstreamProxies, regID)
-			}
-		}
+	upstreamProxies, err := upstreamProxyIDs(dto.Identifier, *virtualConfig.UpstreamProxies, *repos)
+	if err != nil {
+		return err
 	}
-
 	registry.UpstreamProxies = upstreamProxies
 	return nil
 }
 
+// upstreamProxyIDs resolves the members of a virtual registry to registry ids, keeping their order.
+// Members are upstream proxies or other virtual registries; unknown members are skipped.
+func upstreamProxyIDs(identifier string, proxies []string, repos []store.RegistryMetadata) ([]int64, error) {
+	var ids []int64
+	for _, proxy := range proxies {
+		if proxy == identifier {
+			return nil, fmt.Errorf("registry %s cannot be a member of itself", identifier)
+		}
+		for _, repo := range repos {
+			if repo.RegIdentifier != proxy {
+				continue
+			}
+			regID, err := strconv.ParseInt(repo.RegID, 10, 64)
+			if err != nil {
+				continue
+			}
+			ids = append(ids, regID)
+		}
+	}
+	return ids, nil
+}
+
 func (c *APIController) getUpstreamProxyKeys(ctx context.Context, ids []int64) []string {
 	repoKeys, _ := c.RegistryRepository.FetchUpstreamProxyKeys(ctx, ids)
 	return repoKeys
@@ -240,6 +263,7 @@ type manifestConfig struct {
 	ModifiedAt *string        `json:"modified,omitempty"`
 	Os         string         `json:"os"`
 	Arch       string         `json:"architecture,omitempty"`
+	Variant    string         `json:"variant,omitempty"`
 	RootFS     rootFS         `json:"rootfs,omitempty"`
 }
 
@@ -275,6 +299,88 @@ func getRepoEntityFields(dto api.RegistryRequest) ([]string, []string, string, [
 	return allowedPattern, blockedPattern, description, labels
 }
 
+// getLatestVersionStrategyFields returns the latest version strategy of the request, keeping the current
+// one for omitted fields.
+func getLatestVersionStrategyFields(
+	dto api.RegistryRequest, current *types.Registry,
+) (api.LatestVersionStrategy, string, error) {
+	strategy := api.LatestVersionStrategyLASTPUSHED
+	pattern := ""
+	if current != nil {
+		if current.LatestVersionStrategy != "" {
+			strategy = current.LatestVersionStrategy
+		}
+		pattern = current.LatestVersionPattern
+	}
+	if dto.LatestVersionStrategy != nil {
+		strategy = *dto.LatestVersionStrategy
+	}
+	if dto.LatestVersionPattern != nil {
+		pattern = *dto.LatestVersionPattern
+	}
+	if err := ValidateLatestVersionStrategy(&strategy, &pattern); err != nil {
+		return "", "", err
+	}
+	if strategy != api.LatestVersionStrategyREGEX {
+		pattern = ""
+	}
+	return strategy, pattern, nil
+}
+
+// getMTLSFields returns the mTLS settings of the request, keeping the current ones for omitted fields.
+func getMTLSFields(dto api.RegistryRequest, current *types.Registry) (api.MTLSMode, string, error) {
+	mode := api.MTLSModeDISABLED
+	caCertificates := ""
+	if current != nil {
+		if current.MTLSMode != "" {
+			mode = current.MTLSMode
+		}
+		caCertificates = current.MTLSCACertificates
+	}
+	if dto.MtlsMode != nil {
+		mode = *dto.MtlsMode
+	}
+	if dto.MtlsCaCertificates != nil {
+		caCertificates = *dto.MtlsCaCertificates
+	}
+	if err := ValidateMTLSConfig(mode, caCertificates); err != nil {
+		return "", "", err
+	}
+	return mode, caCertificates, nil
+}
+
+// getMavenStrictMetadataField returns the strict metadata setting of the request, keeping the current one
+// if omitted.
+func getMavenStrictMetadataField(
+	dto api.RegistryRequest, packageType api.PackageType, current *types.Registry,
+) (bool, error) {
+	strict := current != nil && current.MavenStrictMetadata
+	if dto.MavenStrictMetadata != nil {
+		strict = *dto.MavenStrictMetadata
+	}
+	if err := ValidateMavenStrictMetadata(packageType, strict); err != nil {
+		return false, err
+	}
+	return strict, nil
+}
+
+// getNpmLocalScopesField returns the npm local scopes of the request, keeping the current ones if omitted.
+func getNpmLocalScopesField(
+	dto api.RegistryRequest, packageType api.PackageType, current *types.Registry,
+) ([]string, error) {
+	var scopes []string
+	if current != nil {
+		scopes = current.NpmLocalScopes
+	}
+	if dto.NpmLocalScopes != nil {
+		scopes = *dto.NpmLocalScopes
+	}
+	if err := ValidateNpmLocalScopes(packageType, scopes); err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
 func CreateVirtualRepositoryResponse(
 	registry *types.Registry,
 	upstreamProxyKeys []string,
@@ -286,25 +392,48 @@ func CreateVirtualRepositoryResponse(
 	allowedPattern := registry.AllowedPattern
 	blockedPattern := registry.BlockedPattern
 	labels := registry.Labels
+	latestVersionStrategy := registry.LatestVersionStrategy
+	if latestVersionStrategy == "" {
+		latestVersionStrategy = api.LatestVersionStrategyLASTPUSHED
+	}
+	mtlsMode := registry.MTLSMode
+	if mtlsMode == "" {
+		mtlsMode = api.MTLSModeDISABLED
+	}
 
 	config := api.RegistryConfig{}
 	_ = config.FromVirtualConfig(api.VirtualConfig{UpstreamProxies: &upstreamProxyKeys})
 	response := &api.RegistryResponseJSONResponse{
 		Data: api.Registry{
-			Identifier:     registry.Name,
-			Description:    &registry.Description,
-			Url:            registryURL,
-			PackageType:    registry.PackageType,
-			AllowedPattern: &allowedPattern,
-			BlockedPattern: &blockedPattern,
-			CreatedAt:      &createdAt,
-			ModifiedAt:     &modifiedAt,
-			CleanupPolicy:  CreateCleanupPolicyResponse(cleanupPolicies),
-			Config:         &config,
-			Labels:         &labels,
+			Identifier:            registry.Name,
+			Description:           &registry.Description,
+			Url:                   registryURL,
+			PackageType:           registry.PackageType,
+			AllowedPattern:        &allowedPattern,
+			BlockedPattern:        &blockedPattern,
+			CreatedAt:             &createdAt,
+			ModifiedAt:            &modifiedAt,
+			CleanupPolicy:         CreateCleanupPolicyResponse(cleanupPolicies),
+			Config:                &config,
+			Labels:                &labels,
+			LatestVersionStrategy: &latestVersionStrategy,
+			MtlsMode:              &mtlsMode,
+			MavenStrictMetadata:   &registry.MavenStrictMetadata,
 		},
 		Status: api.StatusSUCCESS,
 	}
+	if registry.LatestVersionPattern != "" {
+		response.Data.LatestVersionPattern = &registry.LatestVersionPattern
+	}
+	if registry.StoragePrefix != "" {
+		response.Data.StoragePrefix = &registry.StoragePrefix
+	}
+	if len(registry.NpmLocalScopes) > 0 {
+		response.Data.NpmLocalScopes = &registry.NpmLocalScopes
+	}
+	if registry.MTLSCACertificates != "" {
+		response.Data.MtlsCaCertificates = &registry.MTLSCACertificates
+	}
 	return response
 }
 
@@ -313,15 +442,16 @@ func CreateUpstreamProxyResponseJSONResponse(upstreamproxy *types.UpstreamProxy)
 	modifiedAt := GetTimeInMs(upstreamproxy.UpdatedAt)
 	allowedPattern := upstreamproxy.AllowedPattern
 	blockedPattern := upstreamproxy.BlockedPattern
-	configAuth := &api.UpstreamConfig_Auth{}
+	configAuth := &api.UpstreamAuth{}
 
-	if api.AuthType(upstreamproxy.RepoAuthType) == api.AuthTypeUserPassword {
+	switch api.AuthType(upstreamproxy.RepoAuthType) {
+	case api.AuthTypeUserPassword:
 		auth := api.UserPassword{}
 		auth.UserName = upstreamproxy.UserName
 		auth.SecretIdentifier = &upstreamproxy.SecretIdentifier
 		auth.SecretSpacePath = &upstreamproxy.SecretSpacePath
 		_ = configAuth.FromUserPassword(auth)
-	} else if api.AuthType(upstreamproxy.RepoAuthType) == api.AuthTypeAccessKeySecretKey {
+	case api.AuthTypeAccessKeySecretKey:
 		auth := api.AccessKeySecretKey{}
 		auth.AccessKey = &upstreamproxy.UserName
 		auth.AccessKeySecretIdentifier = &upstreamproxy.UserNameSecretIdentifier
@@ -333,6 +463,14 @@ func CreateUpstreamProxyResponseJSONResponse(upstreamproxy *types.UpstreamProxy)
 			log.Warn().Msgf("error in converting auth config to access and secret key: %v", err)
 			return &api.RegistryResponseJSONResponse{}
 		}
+	case api.AuthTypeToken:
+		_ = configAuth.FromToken(api.Token{
+			SecretIdentifier: upstreamproxy.SecretIdentifier,
+			SecretSpacePath:  &upstreamproxy.SecretSpacePath,
+		})
+	case api.AuthTypeAwsIam:
+		_ = configAuth.FromAwsIam(api.AwsIam{RoleArn: &upstreamproxy.AwsRoleArn})
+	case api.AuthTypeAnonymous:
 	}
 
 	source := api.UpstreamConfigSource(upstreamproxy.Source)
@@ -343,6 +481,21 @@ func CreateUpstreamProxyResponseJSONResponse(upstreamproxy *types.UpstreamProxy)
 		Source:   &source,
 		Url:      &upstreamproxy.RepoURL,
 	}
+	if upstreamproxy.CredentialsUpdatedAt.UnixMilli() > 0 {
+		credentialsUpdatedAt := GetTimeInMs(upstreamproxy.CredentialsUpdatedAt)
+		config.CredentialsUpdatedAt = &credentialsUpdatedAt
+	}
+	if upstreamproxy.MetadataTTL > 0 {
+		metadataTTL := int64(upstreamproxy.MetadataTTL / time.Second)
+		config.MetadataTtl = &metadataTTL
+	}
+	if upstreamproxy.ArtifactTTL > 0 {
+		artifactTTL := int64(upstreamproxy.ArtifactTTL / time.Second)
+		config.ArtifactTtl = &artifactTTL
+	}
+	if upstreamproxy.Offline {
+		config.Offline = &upstreamproxy.Offline
+	}
 	registryConfig := &api.RegistryConfig{}
 	_ = registryConfig.FromUpstreamConfig(config)
 
@@ -359,6 +512,17 @@ func CreateUpstreamProxyResponseJSONResponse(upstreamproxy *types.UpstreamProxy)
 		},
 		Status: api.StatusSUCCESS,
 	}
+	if upstreamproxy.StoragePrefix != "" {
+		response.Data.StoragePrefix = &upstreamproxy.StoragePrefix
+	}
+	mtlsMode := upstreamproxy.MTLSMode
+	if mtlsMode == "" {
+		mtlsMode = api.MTLSModeDISABLED
+	}
+	response.Data.MtlsMode = &mtlsMode
+	if upstreamproxy.MTLSCACertificates != "" {
+		response.Data.MtlsCaCertificates = &upstreamproxy.MTLSCACertificates
+	}
 	return response
 }
 
@@ -0,0 +1,226 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetCacheEvictionPolicy returns the size of the cache of the upstream proxy along with its cache eviction
+// policy.
+func (c *APIController) GetCacheEvictionPolicy(
+	ctx context.Context,
+	r artifact.GetCacheEvictionPolicyRequestObject,
+) (artifact.GetCacheEvictionPolicyResponseObject, error) {
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView,
+		"cache eviction policies")
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetCacheEvictionPolicy400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetCacheEvictionPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwGetCacheEvictionPolicy500Error(err), nil
+	}
+
+	policy, err := c.CacheEvictionStore.GetPolicy(ctx, registry.ID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return throwGetCacheEvictionPolicy500Error(err), nil
+	}
+	data, err := c.getCacheEvictionPolicy(ctx, registry, policy)
+	if err != nil {
+		return throwGetCacheEvictionPolicy500Error(err), nil
+	}
+	return artifact.GetCacheEvictionPolicy200JSONResponse{
+		CacheEvictionPolicyResponseJSONResponse: artifact.CacheEvictionPolicyResponseJSONResponse{
+			Data:   *data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// SetCacheEvictionPolicy limits the size of the cache of the upstream proxy.
+func (c *APIController) SetCacheEvictionPolicy(
+	ctx context.Context,
+	r artifact.SetCacheEvictionPolicyRequestObject,
+) (artifact.SetCacheEvictionPolicyResponseObject, error) {
+	if r.Body == nil {
+		return throwSetCacheEvictionPolicy400Error(fmt.Errorf("request body is required")), nil
+	}
+	if r.Body.MaxSizeBytes <= 0 {
+		return throwSetCacheEvictionPolicy400Error(fmt.Errorf("maxSizeBytes must be positive")), nil
+	}
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit,
+		"cache eviction policies")
+	switch status {
+	case http.StatusBadRequest:
+		return throwSetCacheEvictionPolicy400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.SetCacheEvictionPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwSetCacheEvictionPolicy500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	err = c.CacheEvictionStore.UpsertPolicy(ctx, &registrytypes.CacheEvictionPolicy{
+		RegistryID:   registry.ID,
+		MaxSizeBytes: r.Body.MaxSizeBytes,
+		UpdatedBy:    session.Principal.ID,
+	})
+	if err != nil {
+		return throwSetCacheEvictionPolicy500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionUpdated,
+		audit.WithData("cache size limit", strconv.FormatInt(r.Body.MaxSizeBytes, 10)),
+	)
+
+	policy, err := c.CacheEvictionStore.GetPolicy(ctx, registry.ID)
+	if err != nil {
+		return throwSetCacheEvictionPolicy500Error(err), nil
+	}
+	data, err := c.getCacheEvictionPolicy(ctx, registry, policy)
+	if err != nil {
+		return throwSetCacheEvictionPolicy500Error(err), nil
+	}
+	return artifact.SetCacheEvictionPolicy200JSONResponse{
+		CacheEvictionPolicyResponseJSONResponse: artifact.CacheEvictionPolicyResponseJSONResponse{
+			Data:   *data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteCacheEvictionPolicy removes the size limit of the cache of the upstream proxy.
+func (c *APIController) DeleteCacheEvictionPolicy(
+	ctx context.Context,
+	r artifact.DeleteCacheEvictionPolicyRequestObject,
+) (artifact.DeleteCacheEvictionPolicyResponseObject, error) {
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit,
+		"cache eviction policies")
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.DeleteCacheEvictionPolicy400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.DeleteCacheEvictionPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwDeleteCacheEvictionPolicy500Error(err), nil
+	}
+
+	err = c.CacheEvictionStore.DeletePolicy(ctx, registry.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteCacheEvictionPolicy404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "cache eviction policy not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteCacheEvictionPolicy500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionUpdated, audit.WithData("cache size limit", "none"))
+
+	return artifact.DeleteCacheEvictionPolicy200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// getCacheEvictionPolicy returns the size of the cache of the upstream proxy along with its cache eviction
+// policy, if it has one.
+func (c *APIController) getCacheEvictionPolicy(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	policy *registrytypes.CacheEvictionPolicy,
+) (*artifact.CacheEvictionPolicy, error) {
+	oci := registry.PackageType == artifact.PackageTypeDOCKER || registry.PackageType == artifact.PackageTypeHELM
+	size, err := c.CacheEvictionStore.CacheSize(ctx, registry.ID, oci)
+	if err != nil {
+		return nil, err
+	}
+	data := &artifact.CacheEvictionPolicy{CacheSizeBytes: size}
+	if policy == nil {
+		return data, nil
+	}
+	data.MaxSizeBytes = &policy.MaxSizeBytes
+	if !policy.LastRunAt.IsZero() {
+		lastRunAt := policy.LastRunAt.UnixMilli()
+		data.LastRunAt = &lastRunAt
+		data.LastEvicted = &policy.LastEvicted
+		data.LastFreedBytes = &policy.LastFreedBytes
+	}
+	return data, nil
+}
+
+func throwGetCacheEvictionPolicy500Error(err error) artifact.GetCacheEvictionPolicy500JSONResponse {
+	return artifact.GetCacheEvictionPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwSetCacheEvictionPolicy400Error(err error) artifact.SetCacheEvictionPolicy400JSONResponse {
+	return artifact.SetCacheEvictionPolicy400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwSetCacheEvictionPolicy500Error(err error) artifact.SetCacheEvictionPolicy500JSONResponse {
+	return artifact.SetCacheEvictionPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwDeleteCacheEvictionPolicy500Error(err error) artifact.DeleteCacheEvictionPolicy500JSONResponse {
+	return artifact.DeleteCacheEvictionPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
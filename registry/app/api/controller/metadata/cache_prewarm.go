@@ -0,0 +1,162 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/cacheprewarm"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// PrewarmUpstreamCache schedules artifacts to be fetched into the cache of the upstream proxy ahead of
+// their first pull.
+func (c *APIController) PrewarmUpstreamCache(
+	ctx context.Context,
+	r artifact.PrewarmUpstreamCacheRequestObject,
+) (artifact.PrewarmUpstreamCacheResponseObject, error) {
+	if r.Body == nil {
+		return throwPrewarmUpstreamCache400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit,
+		"cache prewarming")
+	switch status {
+	case http.StatusBadRequest:
+		return throwPrewarmUpstreamCache400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.PrewarmUpstreamCache403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwPrewarmUpstreamCache500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	artifacts, err := c.CachePrewarmService.Prewarm(ctx, registry, r.Body.Artifacts, session.Principal.ID)
+	if errors.Is(err, cacheprewarm.ErrInvalidCoordinate) || errors.Is(err, cacheprewarm.ErrUnsupportedPackageType) {
+		return throwPrewarmUpstreamCache400Error(err), nil
+	}
+	if err != nil {
+		return throwPrewarmUpstreamCache500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionUpdated,
+		audit.WithData("prewarmed artifacts", strconv.Itoa(len(artifacts))),
+	)
+
+	return artifact.PrewarmUpstreamCache202JSONResponse{
+		CachePrewarmResponseJSONResponse: artifact.CachePrewarmResponseJSONResponse{
+			Data:   mapToAPICachePrewarm(artifacts),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetUpstreamCachePrewarm returns the status of the artifacts of a batch prewarmed in the cache of the
+// upstream proxy.
+func (c *APIController) GetUpstreamCachePrewarm(
+	ctx context.Context,
+	r artifact.GetUpstreamCachePrewarmRequestObject,
+) (artifact.GetUpstreamCachePrewarmResponseObject, error) {
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView,
+		"cache prewarming")
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetUpstreamCachePrewarm400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetUpstreamCachePrewarm403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwGetUpstreamCachePrewarm500Error(err), nil
+	}
+
+	artifacts, err := c.CachePrewarmService.ListBatch(ctx, registry.ID, string(r.Batch))
+	if err != nil {
+		return throwGetUpstreamCachePrewarm500Error(err), nil
+	}
+	if len(artifacts) == 0 {
+		return artifact.GetUpstreamCachePrewarm404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "cache prewarm batch not found"),
+			),
+		}, nil
+	}
+	return artifact.GetUpstreamCachePrewarm200JSONResponse{
+		CachePrewarmResponseJSONResponse: artifact.CachePrewarmResponseJSONResponse{
+			Data:   mapToAPICachePrewarm(artifacts),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToAPICachePrewarm(artifacts []*registrytypes.CachePrewarmArtifact) artifact.CachePrewarm {
+	prewarm := artifact.CachePrewarm{
+		Artifacts: make([]artifact.CachePrewarmArtifact, 0, len(artifacts)),
+	}
+	for _, a := range artifacts {
+		prewarm.Batch = a.Batch
+		apiArtifact := artifact.CachePrewarmArtifact{
+			Coordinate: a.Coordinate,
+			Status:     artifact.CachePrewarmStatus(a.Status),
+			UpdatedAt:  a.UpdatedAt.UnixMilli(),
+		}
+		if a.Error != "" {
+			errMsg := a.Error
+			apiArtifact.Error = &errMsg
+		}
+		prewarm.Artifacts = append(prewarm.Artifacts, apiArtifact)
+	}
+	return prewarm
+}
+
+func throwPrewarmUpstreamCache400Error(err error) artifact.PrewarmUpstreamCache400JSONResponse {
+	return artifact.PrewarmUpstreamCache400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwPrewarmUpstreamCache500Error(err error) artifact.PrewarmUpstreamCache500JSONResponse {
+	return artifact.PrewarmUpstreamCache500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwGetUpstreamCachePrewarm500Error(err error) artifact.GetUpstreamCachePrewarm500JSONResponse {
+	return artifact.GetUpstreamCachePrewarm500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
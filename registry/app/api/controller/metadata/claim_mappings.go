@@ -0,0 +1,268 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/claimsmapping"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CreateClaimMapping maps a claim of the external identity provider to a registry role in the space.
+func (c *APIController) CreateClaimMapping(
+	ctx context.Context,
+	r artifact.CreateClaimMappingRequestObject,
+) (artifact.CreateClaimMappingResponseObject, error) {
+	if r.Body == nil {
+		return throwCreateClaimMapping400Error(fmt.Errorf("request body is required")), nil
+	}
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return throwCreateClaimMapping400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwCreateClaimMapping400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.CreateClaimMapping403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	mapping := &registrytypes.ClaimMapping{
+		SpaceID:   space.ID,
+		Claim:     r.Body.Claim,
+		Value:     r.Body.Value,
+		Role:      registrytypes.RegistryRole(r.Body.Role),
+		CreatedBy: session.Principal.ID,
+	}
+	if r.Body.RegistryIdentifier != nil {
+		mapping.RegistryName = *r.Body.RegistryIdentifier
+	}
+	err = c.ClaimsMappingService.CreateMapping(ctx, mapping)
+	if errors.Is(err, claimsmapping.ErrInvalidMapping) {
+		return throwCreateClaimMapping400Error(err), nil
+	}
+	if err != nil {
+		return artifact.CreateClaimMapping500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	return artifact.CreateClaimMapping201JSONResponse{
+		ClaimMappingResponseJSONResponse: artifact.ClaimMappingResponseJSONResponse{
+			Data:   mapToAPIClaimMapping(mapping),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListClaimMappings lists the claims mappings of the space.
+func (c *APIController) ListClaimMappings(
+	ctx context.Context,
+	r artifact.ListClaimMappingsRequestObject,
+) (artifact.ListClaimMappingsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return artifact.ListClaimMappings400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.ListClaimMappings400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ListClaimMappings403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	mappings, err := c.ClaimsMappingService.ListMappings(ctx, space.ID)
+	if err != nil {
+		return artifact.ListClaimMappings500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	data := make([]artifact.ClaimMapping, 0, len(mappings))
+	for _, mapping := range mappings {
+		data = append(data, mapToAPIClaimMapping(mapping))
+	}
+	return artifact.ListClaimMappings200JSONResponse{
+		ListClaimMappingsResponseJSONResponse: artifact.ListClaimMappingsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteClaimMapping deletes a claims mapping of the space.
+func (c *APIController) DeleteClaimMapping(
+	ctx context.Context,
+	r artifact.DeleteClaimMappingRequestObject,
+) (artifact.DeleteClaimMappingResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return artifact.DeleteClaimMapping400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.DeleteClaimMapping400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.DeleteClaimMapping403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	err = c.ClaimsMappingService.DeleteMapping(ctx, space.ID, int64(r.ClaimMappingId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteClaimMapping404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "claims mapping not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return artifact.DeleteClaimMapping500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	return artifact.DeleteClaimMapping200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// ExchangeIdentityToken exchanges an ID token of the external identity provider for a registry token.
+// The ID token is the only credential of the request.
+func (c *APIController) ExchangeIdentityToken(
+	ctx context.Context,
+	r artifact.ExchangeIdentityTokenRequestObject,
+) (artifact.ExchangeIdentityTokenResponseObject, error) {
+	if r.Body == nil || r.Body.IdToken == "" {
+		return artifact.ExchangeIdentityToken400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, "idToken is required"),
+			),
+		}, nil
+	}
+
+	exchange, err := c.ClaimsMappingService.Exchange(ctx, r.Body.IdToken)
+	if errors.Is(err, claimsmapping.ErrNotConfigured) {
+		return artifact.ExchangeIdentityToken400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if errors.Is(err, claimsmapping.ErrInvalidIDToken) {
+		return artifact.ExchangeIdentityToken401JSONResponse{
+			UnauthenticatedJSONResponse: artifact.UnauthenticatedJSONResponse(
+				*GetErrorResponse(http.StatusUnauthorized, err.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return artifact.ExchangeIdentityToken500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	grants := make([]artifact.AccessGrant, 0, len(exchange.Grants))
+	for _, grant := range exchange.Grants {
+		grants = append(grants, mapToAPIAccessGrant(grant))
+	}
+	return artifact.ExchangeIdentityToken200JSONResponse{
+		IdentityTokenResponseJSONResponse: artifact.IdentityTokenResponseJSONResponse{
+			Data: artifact.IdentityToken{
+				Token:     exchange.Token,
+				Principal: exchange.Principal,
+				ExpiresAt: exchange.ExpiresAt.UnixMilli(),
+				Grants:    grants,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToAPIClaimMapping(mapping *registrytypes.ClaimMapping) artifact.ClaimMapping {
+	return artifact.ClaimMapping{
+		Id:                 mapping.ID,
+		RegistryIdentifier: optionalString(mapping.RegistryName),
+		Claim:              mapping.Claim,
+		Value:              mapping.Value,
+		Role:               artifact.RegistryRole(mapping.Role),
+		CreatedAt:          mapping.CreatedAt.UnixMilli(),
+	}
+}
+
+func throwCreateClaimMapping400Error(err error) artifact.CreateClaimMapping400JSONResponse {
+	return artifact.CreateClaimMapping400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -56,12 +56,28 @@ func getCleanupPolicyEntity(
 	repoID int64,
 ) *types.CleanupPolicy {
 	expireTime := time.Duration(*cleanupPolicy.ExpireDays) * 24 * time.Hour
+	policyType := artifact.CleanupPolicyTypeAGE
+	if cleanupPolicy.Type != nil {
+		policyType = *cleanupPolicy.Type
+	}
+	var excludePattern, versionPrefix, packagePrefix []string
+	if cleanupPolicy.ExcludePattern != nil {
+		excludePattern = *cleanupPolicy.ExcludePattern
+	}
+	if cleanupPolicy.VersionPrefix != nil {
+		versionPrefix = *cleanupPolicy.VersionPrefix
+	}
+	if cleanupPolicy.PackagePrefix != nil {
+		packagePrefix = *cleanupPolicy.PackagePrefix
+	}
 	return &types.CleanupPolicy{
-		Name:          *cleanupPolicy.Name,
-		VersionPrefix: *cleanupPolicy.VersionPrefix,
-		PackagePrefix: *cleanupPolicy.PackagePrefix,
-		ExpiryTime:    expireTime.Milliseconds(),
-		RegistryID:    repoID,
+		Name:           *cleanupPolicy.Name,
+		Type:           policyType,
+		VersionPrefix:  versionPrefix,
+		PackagePrefix:  packagePrefix,
+		ExcludePattern: excludePattern,
+		ExpiryTime:     expireTime.Milliseconds(),
+		RegistryID:     repoID,
 	}
 }
 
@@ -70,12 +86,16 @@ func getCleanupPolicyDto(
 ) *artifact.CleanupPolicy {
 	packagePrefix := cleanupPolicy.PackagePrefix
 	versionPrefix := cleanupPolicy.VersionPrefix
-	expiryDays := int(time.Duration(cleanupPolicy.ExpiryTime).Hours() / 24)
+	excludePattern := cleanupPolicy.ExcludePattern
+	policyType := cleanupPolicy.Type
+	expiryDays := int((time.Duration(cleanupPolicy.ExpiryTime) * time.Millisecond).Hours() / 24)
 
 	return &artifact.CleanupPolicy{
-		Name:          &cleanupPolicy.Name,
-		VersionPrefix: &versionPrefix,
-		PackagePrefix: &packagePrefix,
-		ExpireDays:    &expiryDays,
+		Name:           &cleanupPolicy.Name,
+		Type:           &policyType,
+		VersionPrefix:  &versionPrefix,
+		PackagePrefix:  &packagePrefix,
+		ExcludePattern: &excludePattern,
+		ExpireDays:     &expiryDays,
 	}
 }
@@ -0,0 +1,257 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CloneRegistry creates a copy of a registry for testing changes of its configuration, e.g. cleanup
+// policies, without touching the source registry. The artifacts of the source registry are copied only
+// if requested and share the blobs of the source registry. Webhooks aren't cloned.
+//
+//nolint:gocognit,cyclop
+func (c *APIController) CloneRegistry(
+	ctx context.Context,
+	r artifact.CloneRegistryRequestObject,
+) (artifact.CloneRegistryResponseObject, error) {
+	if r.Body == nil {
+		return throwCloneRegistry400Error(fmt.Errorf("request body is required")), nil
+	}
+	cloneRequest := artifact.RegistryCloneRequest(*r.Body)
+
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwCloneRegistry400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwCloneRegistry400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return throwCloneRegistry403Error(err), nil
+	}
+
+	targetInfo := regInfo
+	if cloneRequest.ParentRef != nil && *cloneRequest.ParentRef != "" {
+		targetInfo, err = c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, *cloneRequest.ParentRef, "")
+		if err != nil {
+			return throwCloneRegistry400Error(err), nil
+		}
+		// the clone references the blobs of the source registry, which are stored per root space.
+		if targetInfo.rootIdentifierID != regInfo.rootIdentifierID {
+			return throwCloneRegistry400Error(
+				fmt.Errorf("registry can only be cloned within root space %s", regInfo.RootIdentifier),
+			), nil
+		}
+	}
+	targetSpace, err := c.SpaceFinder.FindByRef(ctx, targetInfo.ParentRef)
+	if err != nil {
+		return throwCloneRegistry400Error(err), nil
+	}
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		targetSpace,
+		enum.ResourceTypeRegistry,
+		enum.PermissionRegistryEdit,
+	); err != nil {
+		return throwCloneRegistry403Error(err), nil
+	}
+
+	source, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return artifact.CloneRegistry404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	}
+	if err = ValidateIdentifier(cloneRequest.Identifier); err != nil {
+		return throwCloneRegistry400Error(err), nil
+	}
+
+	registry := &registrytypes.Registry{
+		Name:                  cloneRequest.Identifier,
+		ParentID:              targetInfo.parentID,
+		RootParentID:          targetInfo.rootIdentifierID,
+		Description:           source.Description,
+		Type:                  source.Type,
+		PackageType:           source.PackageType,
+		UpstreamProxies:       source.UpstreamProxies,
+		AllowedPattern:        source.AllowedPattern,
+		BlockedPattern:        source.BlockedPattern,
+		Labels:                source.Labels,
+		LatestVersionStrategy: source.LatestVersionStrategy,
+		LatestVersionPattern:  source.LatestVersionPattern,
+		MTLSMode:              source.MTLSMode,
+		MTLSCACertificates:    source.MTLSCACertificates,
+		MavenStrictMetadata:   source.MavenStrictMetadata,
+		NpmLocalScopes:        source.NpmLocalScopes,
+		// the cloned artifacts reference the blobs stored under the source prefix
+		StoragePrefix: source.StoragePrefix,
+	}
+	if cloneRequest.Description != nil {
+		registry.Description = *cloneRequest.Description
+	}
+
+	cleanupPolicies, err := c.CleanupPolicyStore.GetByRegistryID(ctx, source.ID)
+	if err != nil {
+		return throwCloneRegistry500Error(err), nil
+	}
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			registry.ID, err = c.createRegistryWithAudit(ctx, registry, session.Principal, targetInfo.ParentRef)
+			if err != nil {
+				return err
+			}
+
+			if source.Type == artifact.RegistryTypeUPSTREAM {
+				if err = c.cloneUpstreamProxy(ctx, source, registry, session.Principal, targetInfo.ParentRef); err != nil {
+					return err
+				}
+			}
+
+			if cleanupPolicies != nil && len(*cleanupPolicies) > 0 {
+				policies := make([]registrytypes.CleanupPolicy, 0, len(*cleanupPolicies))
+				for _, policy := range *cleanupPolicies {
+					policy.ID = 0
+					policy.RegistryID = registry.ID
+					policies = append(policies, policy)
+				}
+				if err = c.CleanupPolicyStore.ModifyCleanupPolicies(ctx, &policies, nil); err != nil {
+					return fmt.Errorf("failed to clone cleanup policies: %w", err)
+				}
+			}
+
+			if cloneRequest.IncludeArtifacts != nil && *cloneRequest.IncludeArtifacts {
+				if err = c.RegistryRepository.CloneArtifacts(ctx, source.ID, registry.ID); err != nil {
+					return fmt.Errorf("failed to clone artifacts: %w", err)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			if err2 := c.handleDuplicateRegistryError(ctx, registry); err2 != nil {
+				return throwCloneRegistry400Error(err2), nil
+			}
+		}
+		return throwCloneRegistry400Error(err), nil
+	}
+
+	if registry.Type == artifact.RegistryTypeUPSTREAM {
+		upstreamproxyEntity, err := c.UpstreamProxyStore.Get(ctx, registry.ID)
+		if err != nil {
+			return throwCloneRegistry500Error(err), nil
+		}
+		return artifact.CloneRegistry201JSONResponse{
+			RegistryResponseJSONResponse: *CreateUpstreamProxyResponseJSONResponse(upstreamproxyEntity),
+		}, nil
+	}
+
+	repoEntity, err := c.RegistryRepository.Get(ctx, registry.ID)
+	if err != nil {
+		return throwCloneRegistry500Error(err), nil
+	}
+	clonedPolicies, err := c.CleanupPolicyStore.GetByRegistryID(ctx, repoEntity.ID)
+	if err != nil {
+		return throwCloneRegistry500Error(err), nil
+	}
+	return artifact.CloneRegistry201JSONResponse{
+		RegistryResponseJSONResponse: *CreateVirtualRepositoryResponse(
+			repoEntity, c.getUpstreamProxyKeys(ctx, repoEntity.UpstreamProxies),
+			clonedPolicies, c.URLProvider.RegistryURL(ctx, targetInfo.RootIdentifier, repoEntity.Name),
+		),
+	}, nil
+}
+
+func (c *APIController) cloneUpstreamProxy(
+	ctx context.Context,
+	source *registrytypes.Registry,
+	target *registrytypes.Registry,
+	principal types.Principal,
+	parentRef string,
+) error {
+	upstreamProxy, err := c.UpstreamProxyStore.Get(ctx, source.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get upstream proxy of registry %s: %w", source.Name, err)
+	}
+	_, err = c.createUpstreamProxyWithAudit(
+		ctx,
+		&registrytypes.UpstreamProxyConfig{
+			RegistryID:               target.ID,
+			Source:                   upstreamProxy.Source,
+			URL:                      upstreamProxy.RepoURL,
+			AuthType:                 upstreamProxy.RepoAuthType,
+			UserName:                 upstreamProxy.UserName,
+			UserNameSecretIdentifier: upstreamProxy.UserNameSecretIdentifier,
+			UserNameSecretSpaceID:    int(upstreamProxy.UserNameSecretSpaceID),
+			SecretIdentifier:         upstreamProxy.SecretIdentifier,
+			SecretSpaceID:            int(upstreamProxy.SecretSpaceID),
+			Token:                    upstreamProxy.Token,
+			AwsRoleArn:               upstreamProxy.AwsRoleArn,
+			CredentialsUpdatedAt:     upstreamProxy.CredentialsUpdatedAt,
+			MetadataTTL:              upstreamProxy.MetadataTTL,
+			ArtifactTTL:              upstreamProxy.ArtifactTTL,
+			Offline:                  upstreamProxy.Offline,
+		},
+		principal, parentRef, target.Name,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to clone upstream proxy: %w", err)
+	}
+	return nil
+}
+
+func throwCloneRegistry400Error(err error) artifact.CloneRegistry400JSONResponse {
+	return artifact.CloneRegistry400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwCloneRegistry403Error(err error) artifact.CloneRegistry403JSONResponse {
+	return artifact.CloneRegistry403JSONResponse{
+		UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+			*GetErrorResponse(http.StatusForbidden, err.Error()),
+		),
+	}
+}
+
+func throwCloneRegistry500Error(err error) artifact.CloneRegistry500JSONResponse {
+	return artifact.CloneRegistry500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,91 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/utils/versioning"
+)
+
+func (c *APIController) CompareVersions(
+	_ context.Context,
+	r artifact.CompareVersionsRequestObject,
+) (artifact.CompareVersionsResponseObject, error) {
+	if r.Body == nil || len(r.Body.Versions) == 0 {
+		return compareVersionsBadRequest(errors.New("at least one version is required")), nil
+	}
+
+	scheme := versioning.SchemeGeneric
+	switch {
+	case r.Body.Scheme != nil:
+		s, err := versioning.ParseScheme(string(*r.Body.Scheme))
+		if err != nil {
+			return compareVersionsBadRequest(err), nil
+		}
+		scheme = s
+	case r.Body.PackageType != nil:
+		scheme = versioning.SchemeForPackageType(*r.Body.PackageType)
+	}
+
+	versions := make([]string, len(r.Body.Versions))
+	copy(versions, r.Body.Versions)
+	versioning.Sort(scheme, versions)
+
+	infos := make([]artifact.VersionInfo, 0, len(versions))
+	valid := make([]string, 0, len(versions))
+	for _, v := range versions {
+		info := artifact.VersionInfo{Version: v}
+		if normalized, err := versioning.Normalize(scheme, v); err == nil {
+			info.Valid = true
+			info.Normalized = &normalized
+			valid = append(valid, v)
+		}
+		if r.Body.Range != nil && info.Valid {
+			inRange, err := versioning.Satisfies(scheme, v, *r.Body.Range)
+			if err != nil {
+				return compareVersionsBadRequest(err), nil
+			}
+			info.InRange = &inRange
+		}
+		infos = append(infos, info)
+	}
+
+	comparison := artifact.VersionComparison{
+		Scheme:   artifact.VersionScheme(scheme),
+		Versions: infos,
+	}
+	if latest := versioning.Latest(scheme, valid); latest != "" {
+		comparison.Latest = &latest
+	}
+
+	return artifact.CompareVersions200JSONResponse{
+		VersionComparisonResponseJSONResponse: artifact.VersionComparisonResponseJSONResponse{
+			Data:   comparison,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func compareVersionsBadRequest(err error) artifact.CompareVersions400JSONResponse {
+	return artifact.CompareVersions400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
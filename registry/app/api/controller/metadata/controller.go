@@ -47,6 +47,35 @@ type APIController struct {
 	WebhooksExecutionRepository store.WebhooksExecutionRepository
 	RegistryMetadataHelper      RegistryMetadataHelper
 	WebhookService              WebhookService
+	StorageMigrator             StorageMigrator
+	QueueService                QueueService
+	ScanStore                   store.ScanRepository
+	VexStore                    store.VexRepository
+	SecurityService             SecurityService
+	EvidenceService             EvidenceService
+	ResolutionService           ResolutionService
+	AccessGrantService          AccessGrantService
+	ClaimsMappingService        ClaimsMappingService
+	ContentIndexService         ContentIndexService
+	EnrichmentService           EnrichmentService
+	DownloadStatStore           store.DownloadStatRepository
+	OnboardingService           OnboardingService
+	DefaultRegistryStore        store.DefaultRegistryRepository
+	RegistryConfigRevisionStore store.RegistryConfigRevisionRepository
+	PermalinkStore              store.PermalinkRepository
+	MavenRelocationStore        store.MavenRelocationRepository
+	PackageRuleStore            store.PackageRuleRepository
+	UpstreamURLStore            store.UpstreamURLRepository
+	LegalHoldStore              store.LegalHoldRepository
+	DeletionCertificateStore    store.DeletionCertificateRepository
+	ComplianceService           ComplianceService
+	CacheEvictionStore          store.CacheEvictionRepository
+	CachePrewarmService         CachePrewarmService
+	VulnerabilityAllowlistStore store.VulnerabilityAllowlistRepository
+	HelmPushPolicyStore         store.HelmPushPolicyRepository
+	MirrorSyncStore             store.MirrorSyncRepository
+	MirrorSyncService           MirrorSyncService
+	countCache                  *countCache
 }
 
 func NewAPIController(
@@ -70,6 +99,34 @@ func NewAPIController(
 	webhooksExecutionRepository store.WebhooksExecutionRepository,
 	registryMetadataHelper RegistryMetadataHelper,
 	webhookService WebhookService,
+	storageMigrator StorageMigrator,
+	queueService QueueService,
+	scanStore store.ScanRepository,
+	vexStore store.VexRepository,
+	securityService SecurityService,
+	evidenceService EvidenceService,
+	resolutionService ResolutionService,
+	accessGrantService AccessGrantService,
+	claimsMappingService ClaimsMappingService,
+	contentIndexService ContentIndexService,
+	enrichmentService EnrichmentService,
+	downloadStatStore store.DownloadStatRepository,
+	onboardingService OnboardingService,
+	defaultRegistryStore store.DefaultRegistryRepository,
+	registryConfigRevisionStore store.RegistryConfigRevisionRepository,
+	permalinkStore store.PermalinkRepository,
+	mavenRelocationStore store.MavenRelocationRepository,
+	packageRuleStore store.PackageRuleRepository,
+	upstreamURLStore store.UpstreamURLRepository,
+	legalHoldStore store.LegalHoldRepository,
+	deletionCertificateStore store.DeletionCertificateRepository,
+	complianceService ComplianceService,
+	cacheEvictionStore store.CacheEvictionRepository,
+	cachePrewarmService CachePrewarmService,
+	vulnerabilityAllowlistStore store.VulnerabilityAllowlistRepository,
+	helmPushPolicyStore store.HelmPushPolicyRepository,
+	mirrorSyncStore store.MirrorSyncRepository,
+	mirrorSyncService MirrorSyncService,
 ) *APIController {
 	return &APIController{
 		fileManager:                 fileManager,
@@ -92,5 +149,34 @@ func NewAPIController(
 		WebhooksExecutionRepository: webhooksExecutionRepository,
 		RegistryMetadataHelper:      registryMetadataHelper,
 		WebhookService:              webhookService,
+		StorageMigrator:             storageMigrator,
+		QueueService:                queueService,
+		ScanStore:                   scanStore,
+		VexStore:                    vexStore,
+		SecurityService:             securityService,
+		EvidenceService:             evidenceService,
+		ResolutionService:           resolutionService,
+		AccessGrantService:          accessGrantService,
+		ClaimsMappingService:        claimsMappingService,
+		ContentIndexService:         contentIndexService,
+		EnrichmentService:           enrichmentService,
+		DownloadStatStore:           downloadStatStore,
+		OnboardingService:           onboardingService,
+		DefaultRegistryStore:        defaultRegistryStore,
+		RegistryConfigRevisionStore: registryConfigRevisionStore,
+		PermalinkStore:              permalinkStore,
+		MavenRelocationStore:        mavenRelocationStore,
+		PackageRuleStore:            packageRuleStore,
+		UpstreamURLStore:            upstreamURLStore,
+		LegalHoldStore:              legalHoldStore,
+		DeletionCertificateStore:    deletionCertificateStore,
+		ComplianceService:           complianceService,
+		CacheEvictionStore:          cacheEvictionStore,
+		CachePrewarmService:         cachePrewarmService,
+		VulnerabilityAllowlistStore: vulnerabilityAllowlistStore,
+		HelmPushPolicyStore:         helmPushPolicyStore,
+		MirrorSyncStore:             mirrorSyncStore,
+		MirrorSyncService:           mirrorSyncService,
+		countCache:                  newCountCache(countCacheMaxAge),
 	}
 }
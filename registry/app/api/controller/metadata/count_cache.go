@@ -0,0 +1,116 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+const (
+	// countCacheMaxAge is how long the item counts of list requests are served from the cache.
+	countCacheMaxAge = 30 * time.Second
+	// maxCountCacheEntries bounds the count cache, which is keyed by the filters of list requests.
+	maxCountCacheEntries = 10000
+)
+
+// Count is the total number of items a list request pages through.
+type Count struct {
+	Items     int64
+	CountedAt time.Time
+	// Exact is set when the items were counted for the request rather than served from the cache.
+	Exact bool
+}
+
+// countCache caches the total counts of list requests, sparing the COUNT queries over large tables
+// while paging through their results.
+type countCache struct {
+	mx      sync.Mutex
+	entries map[string]Count
+	maxAge  time.Duration
+}
+
+func newCountCache(maxAge time.Duration) *countCache {
+	return &countCache{
+		entries: make(map[string]Count),
+		maxAge:  maxAge,
+	}
+}
+
+// Get returns the count cached for the key, counting the items when the count is missing, older than
+// the max age or an exact count is requested. Counting errors aren't cached.
+func (c *countCache) Get(
+	ctx context.Context, key string, exact bool, count func(ctx context.Context) (int64, error),
+) (Count, error) {
+	now := time.Now()
+	if c != nil && !exact {
+		c.mx.Lock()
+		cached, ok := c.entries[key]
+		c.mx.Unlock()
+		if ok && now.Sub(cached.CountedAt) < c.maxAge {
+			return cached, nil
+		}
+	}
+
+	items, err := count(ctx)
+	if err != nil {
+		return Count{CountedAt: now, Exact: true}, err
+	}
+	counted := Count{Items: items, CountedAt: now, Exact: true}
+	if c != nil {
+		c.put(key, counted)
+	}
+	return counted, nil
+}
+
+func (c *countCache) put(key string, counted Count) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if len(c.entries) >= maxCountCacheEntries {
+		for k, v := range c.entries {
+			if counted.CountedAt.Sub(v.CountedAt) >= c.maxAge {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxCountCacheEntries {
+			clear(c.entries)
+		}
+	}
+	cached := counted
+	cached.Exact = false
+	c.entries[key] = cached
+}
+
+// countKey returns the cache key of a list request from its filters.
+func countKey(list string, filters ...any) string {
+	b, _ := json.Marshal(filters)
+	return list + string(b)
+}
+
+func isExactCount(param *artifact.ExactCountParam) bool {
+	return param != nil && bool(*param)
+}
+
+// countFields returns the freshness fields of list responses for the count.
+func countFields(count Count) (*bool, *string) {
+	exact := count.Exact
+	countedAt := strconv.FormatInt(count.CountedAt.UnixMilli(), 10)
+	return &exact, &countedAt
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountCache(t *testing.T) {
+	cache := newCountCache(time.Minute)
+	calls := 0
+	count := func(context.Context) (int64, error) {
+		calls++
+		return int64(calls * 10), nil
+	}
+	ctx := context.Background()
+
+	counted, err := cache.Get(ctx, "key", false, count)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), counted.Items)
+	assert.True(t, counted.Exact)
+
+	cached, err := cache.Get(ctx, "key", false, count)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), cached.Items)
+	assert.False(t, cached.Exact)
+	assert.Equal(t, counted.CountedAt, cached.CountedAt)
+
+	exact, err := cache.Get(ctx, "key", true, count)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), exact.Items)
+	assert.True(t, exact.Exact)
+
+	other, err := cache.Get(ctx, "other", false, count)
+	require.NoError(t, err)
+	assert.Equal(t, int64(30), other.Items)
+
+	_, err = cache.Get(ctx, "failing", false, func(context.Context) (int64, error) {
+		return 0, errors.New("count failed")
+	})
+	assert.Error(t, err)
+	_, err = cache.Get(ctx, "failing", false, count)
+	require.NoError(t, err)
+	assert.Equal(t, 4, calls)
+}
+
+func TestCountCacheExpiry(t *testing.T) {
+	cache := newCountCache(time.Nanosecond)
+	calls := 0
+	count := func(context.Context) (int64, error) {
+		calls++
+		return 1, nil
+	}
+	_, _ = cache.Get(context.Background(), "key", false, count)
+	time.Sleep(time.Millisecond)
+	counted, _ := cache.Get(context.Background(), "key", false, count)
+	assert.True(t, counted.Exact)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCountKey(t *testing.T) {
+	assert.NotEqual(t, countKey("versions", 1, "a b", "c"), countKey("versions", 1, "a", "b c"))
+	assert.Equal(t, countKey("tags", 1, []string{"x"}), countKey("tags", 1, []string{"x"}))
+}
@@ -0,0 +1,337 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxBulkRegistries is the most registries a single bulk request can create.
+const maxBulkRegistries = 100
+
+// bulkRegistry is a validated registry of a bulk request.
+type bulkRegistry struct {
+	request       artifact.RegistryRequest
+	regInfo       *RegistryRequestBaseInfo
+	registry      *registrytypes.Registry
+	upstreamProxy *registrytypes.UpstreamProxyConfig
+}
+
+func (c *APIController) CreateRegistries(
+	ctx context.Context,
+	r artifact.CreateRegistriesRequestObject,
+) (artifact.CreateRegistriesResponseObject, error) {
+	bulkRequest := artifact.BulkRegistryRequest(*r.Body)
+	if len(bulkRequest.Registries) == 0 || len(bulkRequest.Registries) > maxBulkRegistries {
+		return artifact.CreateRegistries400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest,
+					fmt.Sprintf("between 1 and %d registries can be created at once", maxBulkRegistries)),
+			),
+		}, nil
+	}
+	registryRequests := bulkRegistryRequests(bulkRequest)
+
+	registries, results, err := c.prepareBulkRegistries(ctx, registryRequests)
+	if errors.Is(err, apiauth.ErrNotAuthorized) {
+		return artifact.CreateRegistries403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+	atomic := bulkRequest.Atomic != nil && *bulkRequest.Atomic
+	if atomic && err != nil {
+		return bulkRegistryResponse(results), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	if atomic {
+		c.createRegistriesAtomically(ctx, registries, results, session.Principal)
+		return bulkRegistryResponse(results), nil
+	}
+	for i, registry := range registries {
+		if results[i].Status == artifact.BulkRegistryItemStatusFAILED {
+			continue
+		}
+		var id int64
+		err = c.tx.WithTx(
+			ctx, func(ctx context.Context) error {
+				id, err = c.insertBulkRegistry(ctx, registry, session.Principal)
+				return err
+			},
+		)
+		if err != nil {
+			results[i] = bulkRegistryFailed(registry.request.Identifier, c.bulkRegistryError(ctx, registry, err))
+			continue
+		}
+		results[i] = c.bulkRegistryCreated(ctx, registry, id)
+	}
+	return bulkRegistryResponse(results), nil
+}
+
+// createRegistriesAtomically creates the registries of an atomic bulk request in a single
+// transaction, skipping all of them if any of them fails.
+func (c *APIController) createRegistriesAtomically(
+	ctx context.Context,
+	registries []bulkRegistry,
+	results []artifact.BulkRegistryItemResult,
+	principal types.Principal,
+) {
+	ids := make([]int64, len(registries))
+	failed := -1
+	err := c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			for i, registry := range registries {
+				id, err := c.insertBulkRegistry(ctx, registry, principal)
+				if err != nil {
+					failed = i
+					return err
+				}
+				ids[i] = id
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		for i, registry := range registries {
+			results[i] = bulkRegistrySkipped(registry.request.Identifier)
+		}
+		if failed >= 0 {
+			registry := registries[failed]
+			results[failed] = bulkRegistryFailed(registry.request.Identifier, c.bulkRegistryError(ctx, registry, err))
+		}
+		return
+	}
+	for i, registry := range registries {
+		results[i] = c.bulkRegistryCreated(ctx, registry, ids[i])
+	}
+}
+
+// prepareBulkRegistries checks the access to and validates every registry of a bulk request before
+// any of them is created. If any of them is invalid, it returns an error along with the result of
+// each registry.
+func (c *APIController) prepareBulkRegistries(
+	ctx context.Context,
+	registryRequests []artifact.RegistryRequest,
+) ([]bulkRegistry, []artifact.BulkRegistryItemResult, error) {
+	registries := make([]bulkRegistry, len(registryRequests))
+	results := make([]artifact.BulkRegistryItemResult, len(registryRequests))
+	regInfos := map[string]*RegistryRequestBaseInfo{}
+	identifiers := map[string]bool{}
+	var invalid error
+	for i, registryRequest := range registryRequests {
+		parentRef := *registryRequest.ParentRef
+		regInfo, ok := regInfos[parentRef]
+		if !ok {
+			var err error
+			regInfo, err = c.checkOnboardingAccess(ctx, parentRef)
+			if errors.Is(err, apiauth.ErrNotAuthorized) {
+				return nil, nil, err
+			}
+			if err != nil {
+				invalid = err
+				results[i] = bulkRegistryFailed(registryRequest.Identifier, err)
+				continue
+			}
+			regInfos[parentRef] = regInfo
+		}
+
+		registry, err := c.prepareBulkRegistry(ctx, registryRequest, regInfo, identifiers)
+		if err != nil {
+			invalid = err
+			results[i] = bulkRegistryFailed(registryRequest.Identifier, err)
+			continue
+		}
+		registries[i] = registry
+		results[i] = bulkRegistrySkipped(registryRequest.Identifier)
+	}
+	return registries, results, invalid
+}
+
+func (c *APIController) prepareBulkRegistry(
+	ctx context.Context,
+	registryRequest artifact.RegistryRequest,
+	regInfo *RegistryRequestBaseInfo,
+	identifiers map[string]bool,
+) (bulkRegistry, error) {
+	if identifiers[registryRequest.Identifier] {
+		return bulkRegistry{}, fmt.Errorf("registry %s is in the request more than once", registryRequest.Identifier)
+	}
+	identifiers[registryRequest.Identifier] = true
+	available, err := c.isIdentifierAvailable(ctx, regInfo.rootIdentifierID, registryRequest.Identifier)
+	if err != nil {
+		return bulkRegistry{}, err
+	}
+	if !available {
+		return bulkRegistry{}, fmt.Errorf("registry %s already exists", registryRequest.Identifier)
+	}
+	if err = ValidateCleanupPolicies(registryRequest.CleanupPolicy); err != nil {
+		return bulkRegistry{}, err
+	}
+
+	registry := bulkRegistry{request: registryRequest, regInfo: regInfo}
+	if registryRequest.Config.Type == artifact.RegistryTypeVIRTUAL {
+		registry.registry, err = CreateRegistryEntity(registryRequest, regInfo.parentID, regInfo.rootIdentifierID)
+	} else {
+		registry.registry, registry.upstreamProxy, err = c.CreateUpstreamProxyEntity(
+			ctx, registryRequest, regInfo.parentID, regInfo.rootIdentifierID,
+		)
+	}
+	if err != nil {
+		return bulkRegistry{}, err
+	}
+	return registry, nil
+}
+
+// insertBulkRegistry creates a registry of a bulk request in the transaction of the context, where
+// the upstreams created before it in the same transaction can already be used by a virtual registry.
+func (c *APIController) insertBulkRegistry(
+	ctx context.Context, registry bulkRegistry, principal types.Principal,
+) (int64, error) {
+	parentRef := *registry.request.ParentRef
+	if registry.upstreamProxy == nil {
+		if registry.registry.PackageType != artifact.PackageTypeGENERIC {
+			err := c.setUpstreamProxyIDs(ctx, registry.registry, registry.request, registry.regInfo.parentID)
+			if err != nil {
+				return 0, err
+			}
+		}
+		return c.createRegistryWithAudit(ctx, registry.registry, principal, parentRef)
+	}
+
+	id, err := c.createRegistryWithAudit(ctx, registry.registry, principal, parentRef)
+	if err != nil {
+		return 0, err
+	}
+	registry.upstreamProxy.RegistryID = id
+	if _, err = c.createUpstreamProxyWithAudit(
+		ctx, registry.upstreamProxy, principal, parentRef, registry.registry.Name,
+	); err != nil {
+		return 0, fmt.Errorf("failed to create upstream proxy: %w", err)
+	}
+	return id, nil
+}
+
+// bulkRegistryCreated sets the cleanup policies of a created registry and returns its result. The
+// cleanup policies are set in their own transaction, so a registry can be created without them.
+func (c *APIController) bulkRegistryCreated(
+	ctx context.Context, registry bulkRegistry, id int64,
+) artifact.BulkRegistryItemResult {
+	result := artifact.BulkRegistryItemResult{
+		Identifier: registry.request.Identifier,
+		Status:     artifact.BulkRegistryItemStatusCREATED,
+	}
+	body := artifact.ModifyRegistryJSONRequestBody(registry.request)
+	if policies := CreateCleanupPolicyEntity(&body, id); policies != nil {
+		if err := c.CleanupPolicyStore.ModifyCleanupPolicies(ctx, policies, nil); err != nil {
+			message := fmt.Sprintf("failed to set cleanup policies: %s", err)
+			result.Error = &message
+		}
+	}
+	data, err := c.bulkRegistryData(ctx, registry, id)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to get created registry %s", registry.registry.Name)
+	}
+	result.Registry = data
+	return result
+}
+
+// bulkRegistryError returns why a registry of a bulk request couldn't be created.
+func (c *APIController) bulkRegistryError(ctx context.Context, registry bulkRegistry, err error) error {
+	if isDuplicateKeyError(err) {
+		if err2 := c.handleDuplicateRegistryError(ctx, registry.registry); err2 != nil {
+			return err2
+		}
+	}
+	return err
+}
+
+func (c *APIController) bulkRegistryData(
+	ctx context.Context, registry bulkRegistry, id int64,
+) (*artifact.Registry, error) {
+	if registry.upstreamProxy == nil {
+		response, err := c.virtualRegistryResponse(ctx, id, registry.regInfo.RootIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		return &response.Data, nil
+	}
+	upstreamProxy, err := c.UpstreamProxyStore.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateUpstreamProxyResponseJSONResponse(upstreamProxy).Data, nil
+}
+
+// bulkRegistryRequests returns the registries of a bulk request, in the space of the request
+// unless they set their own.
+func bulkRegistryRequests(bulkRequest artifact.BulkRegistryRequest) []artifact.RegistryRequest {
+	registryRequests := make([]artifact.RegistryRequest, 0, len(bulkRequest.Registries))
+	for _, registryRequest := range bulkRequest.Registries {
+		if registryRequest.ParentRef == nil || *registryRequest.ParentRef == "" {
+			parentRef := bulkRequest.ParentRef
+			registryRequest.ParentRef = &parentRef
+		}
+		registryRequests = append(registryRequests, registryRequest)
+	}
+	return registryRequests
+}
+
+func bulkRegistryResponse(results []artifact.BulkRegistryItemResult) artifact.CreateRegistries200JSONResponse {
+	data := artifact.BulkRegistryResult{Results: results}
+	for _, result := range results {
+		switch result.Status {
+		case artifact.BulkRegistryItemStatusCREATED:
+			data.Created++
+		case artifact.BulkRegistryItemStatusFAILED:
+			data.Failed++
+		case artifact.BulkRegistryItemStatusSKIPPED:
+		}
+	}
+	return artifact.CreateRegistries200JSONResponse{
+		BulkRegistryResponseJSONResponse: artifact.BulkRegistryResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}
+}
+
+func bulkRegistryFailed(identifier string, err error) artifact.BulkRegistryItemResult {
+	message := err.Error()
+	return artifact.BulkRegistryItemResult{
+		Identifier: identifier,
+		Status:     artifact.BulkRegistryItemStatusFAILED,
+		Error:      &message,
+	}
+}
+
+func bulkRegistrySkipped(identifier string) artifact.BulkRegistryItemResult {
+	return artifact.BulkRegistryItemResult{
+		Identifier: identifier,
+		Status:     artifact.BulkRegistryItemStatusSKIPPED,
+	}
+}
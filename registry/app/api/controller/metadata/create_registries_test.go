@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkRegistryRequests(t *testing.T) {
+	own := "acme/team"
+	requests := bulkRegistryRequests(artifact.BulkRegistryRequest{
+		ParentRef: "acme",
+		Registries: []artifact.RegistryRequest{
+			{Identifier: "docker-hub"},
+			{Identifier: "docker", ParentRef: &own},
+		},
+	})
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "acme", *requests[0].ParentRef)
+	assert.Equal(t, own, *requests[1].ParentRef)
+}
+
+func TestBulkRegistryResponse(t *testing.T) {
+	response := bulkRegistryResponse([]artifact.BulkRegistryItemResult{
+		{Identifier: "docker-hub", Status: artifact.BulkRegistryItemStatusCREATED},
+		bulkRegistryFailed("docker", errors.New("registry docker already exists")),
+		bulkRegistrySkipped("npm"),
+	})
+	assert.Equal(t, 1, response.Data.Created)
+	assert.Equal(t, 1, response.Data.Failed)
+	assert.Equal(t, "registry docker already exists", *response.Data.Results[1].Error)
+	assert.Equal(t, artifact.BulkRegistryItemStatusSKIPPED, response.Data.Results[2].Status)
+}
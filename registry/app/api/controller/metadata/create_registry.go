@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
@@ -116,6 +117,8 @@ func (c *APIController) CreateRegistry(
 		return throwCreateRegistry400Error(err), nil //nolint:nilerr
 	}
 
+	c.recordRegistryConfigRevision(ctx, registryID)
+
 	upstreamproxyEntity, err := c.UpstreamProxyStore.Get(ctx, registryID)
 	if err != nil {
 		return throwCreateRegistry400Error(err), nil //nolint:nilerr
@@ -150,21 +153,33 @@ func (c *APIController) createVirtualRegistry(
 		}
 		return throwCreateRegistry400Error(err), nil
 	}
-	repoEntity, err := c.RegistryRepository.Get(ctx, id)
+	c.recordRegistryConfigRevision(ctx, id)
+
+	response, err := c.virtualRegistryResponse(ctx, id, regInfo.RootIdentifier)
 	if err != nil {
 		return throwCreateRegistry400Error(err), nil
 	}
+	return artifact.CreateRegistry201JSONResponse{
+		RegistryResponseJSONResponse: *response,
+	}, nil
+}
+
+func (c *APIController) virtualRegistryResponse(
+	ctx context.Context, id int64, rootIdentifier string,
+) (*artifact.RegistryResponseJSONResponse, error) {
+	repoEntity, err := c.RegistryRepository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 	cleanupPolicies, err := c.CleanupPolicyStore.GetByRegistryID(ctx, repoEntity.ID)
 	if err != nil {
-		return throwCreateRegistry400Error(err), nil
+		return nil, err
 	}
-	repoURL := c.URLProvider.RegistryURL(ctx, regInfo.RootIdentifier, repoEntity.Name)
-	return artifact.CreateRegistry201JSONResponse{
-		RegistryResponseJSONResponse: *CreateVirtualRepositoryResponse(
-			repoEntity, c.getUpstreamProxyKeys(ctx, repoEntity.UpstreamProxies),
-			cleanupPolicies, repoURL,
-		),
-	}, nil
+	repoURL := c.URLProvider.RegistryURL(ctx, rootIdentifier, repoEntity.Name)
+	return CreateVirtualRepositoryResponse(
+		repoEntity, c.getUpstreamProxyKeys(ctx, repoEntity.UpstreamProxies),
+		cleanupPolicies, repoURL,
+	), nil
 }
 
 func (c *APIController) createUpstreamProxyWithAudit(
@@ -209,6 +224,9 @@ func (c *APIController) createRegistryWithAudit(
 	ctx context.Context, registry *registrytypes.Registry,
 	principal types.Principal, parentRef string,
 ) (int64, error) {
+	if err := c.validateStoragePrefix(ctx, registry.RootParentID, registry.StoragePrefix); err != nil {
+		return 0, err
+	}
 	id, err := c.RegistryRepository.Create(ctx, registry)
 	if err != nil {
 		return id, err
@@ -256,16 +274,43 @@ func CreateRegistryEntity(
 	if e != nil {
 		return nil, e
 	}
+	latestVersionStrategy, latestVersionPattern, e := getLatestVersionStrategyFields(dto, nil)
+	if e != nil {
+		return nil, e
+	}
+	mtlsMode, mtlsCACertificates, e := getMTLSFields(dto, nil)
+	if e != nil {
+		return nil, e
+	}
+	mavenStrictMetadata, e := getMavenStrictMetadataField(dto, dto.PackageType, nil)
+	if e != nil {
+		return nil, e
+	}
+	npmLocalScopes, e := getNpmLocalScopesField(dto, dto.PackageType, nil)
+	if e != nil {
+		return nil, e
+	}
+	storagePrefix := ""
+	if dto.StoragePrefix != nil {
+		storagePrefix = *dto.StoragePrefix
+	}
 	entity := &registrytypes.Registry{
-		Name:           dto.Identifier,
-		ParentID:       parentID,
-		RootParentID:   rootParentID,
-		Description:    description,
-		AllowedPattern: allowedPattern,
-		BlockedPattern: blockedPattern,
-		PackageType:    dto.PackageType,
-		Labels:         labels,
-		Type:           dto.Config.Type,
+		Name:                  dto.Identifier,
+		ParentID:              parentID,
+		RootParentID:          rootParentID,
+		Description:           description,
+		AllowedPattern:        allowedPattern,
+		BlockedPattern:        blockedPattern,
+		PackageType:           dto.PackageType,
+		Labels:                labels,
+		Type:                  dto.Config.Type,
+		LatestVersionStrategy: latestVersionStrategy,
+		LatestVersionPattern:  latestVersionPattern,
+		StoragePrefix:         storagePrefix,
+		MTLSMode:              mtlsMode,
+		MTLSCACertificates:    mtlsCACertificates,
+		MavenStrictMetadata:   mavenStrictMetadata,
+		NpmLocalScopes:        npmLocalScopes,
 	}
 	return entity, nil
 }
@@ -294,14 +339,29 @@ func (c *APIController) CreateUpstreamProxyEntity(
 	if e != nil {
 		return nil, nil, e
 	}
+	latestVersionStrategy, latestVersionPattern, e := getLatestVersionStrategyFields(dto, nil)
+	if e != nil {
+		return nil, nil, e
+	}
+	mtlsMode, mtlsCACertificates, e := getMTLSFields(dto, nil)
+	if e != nil {
+		return nil, nil, e
+	}
 	repoEntity := &registrytypes.Registry{
-		Name:           dto.Identifier,
-		ParentID:       parentID,
-		RootParentID:   rootParentID,
-		AllowedPattern: allowedPattern,
-		BlockedPattern: blockedPattern,
-		PackageType:    dto.PackageType,
-		Type:           artifact.RegistryTypeUPSTREAM,
+		Name:                  dto.Identifier,
+		ParentID:              parentID,
+		RootParentID:          rootParentID,
+		AllowedPattern:        allowedPattern,
+		BlockedPattern:        blockedPattern,
+		PackageType:           dto.PackageType,
+		Type:                  artifact.RegistryTypeUPSTREAM,
+		LatestVersionStrategy: latestVersionStrategy,
+		LatestVersionPattern:  latestVersionPattern,
+		MTLSMode:              mtlsMode,
+		MTLSCACertificates:    mtlsCACertificates,
+	}
+	if dto.StoragePrefix != nil {
+		repoEntity.StoragePrefix = *dto.StoragePrefix
 	}
 
 	config, e := dto.Config.AsUpstreamConfig()
@@ -313,6 +373,7 @@ func (c *APIController) CreateUpstreamProxyEntity(
 		URL:      *config.Url,
 		AuthType: string(config.AuthType),
 	}
+	setUpstreamCacheSettings(upstreamProxyConfigEntity, config)
 	if config.Source != nil && len(string(*config.Source)) > 0 {
 		err := ValidateUpstreamSource(string(*config.Source))
 		if err != nil {
@@ -320,61 +381,8 @@ func (c *APIController) CreateUpstreamProxyEntity(
 		}
 		upstreamProxyConfigEntity.Source = string(*config.Source)
 	}
-	//nolint:nestif
-	if config.AuthType == artifact.AuthTypeUserPassword {
-		res, err := config.Auth.AsUserPassword()
-		if err != nil {
-			return nil, nil, err
-		}
-		upstreamProxyConfigEntity.UserName = res.UserName
-		if res.SecretIdentifier == nil {
-			return nil, nil, fmt.Errorf("failed to create upstream proxy: secret_identifier missing")
-		}
-
-		if res.SecretSpacePath != nil && len(*res.SecretSpacePath) > 0 {
-			upstreamProxyConfigEntity.SecretSpaceID, err = c.RegistryMetadataHelper.getSecretSpaceID(ctx,
-				res.SecretSpacePath)
-			if err != nil {
-				return nil, nil, err
-			}
-		} else if res.SecretSpaceId != nil {
-			upstreamProxyConfigEntity.SecretSpaceID = *res.SecretSpaceId
-		}
-
-		upstreamProxyConfigEntity.SecretIdentifier = *res.SecretIdentifier
-	} else if config.AuthType == artifact.AuthTypeAccessKeySecretKey {
-		res, err := config.Auth.AsAccessKeySecretKey()
-		if err != nil {
-			return nil, nil, err
-		}
-		switch {
-		case res.AccessKey != nil && len(*res.AccessKey) > 0:
-			upstreamProxyConfigEntity.UserName = *res.AccessKey
-		case res.AccessKeySecretIdentifier == nil:
-			return nil, nil, fmt.Errorf("failed to create upstream proxy: access_key_secret_identifier missing")
-		default:
-			if res.AccessKeySecretSpacePath != nil && len(*res.AccessKeySecretSpacePath) > 0 {
-				upstreamProxyConfigEntity.UserNameSecretSpaceID, err =
-					c.RegistryMetadataHelper.getSecretSpaceID(ctx, res.AccessKeySecretSpacePath)
-				if err != nil {
-					return nil, nil, err
-				}
-			} else if res.AccessKeySecretSpaceId != nil {
-				upstreamProxyConfigEntity.UserNameSecretSpaceID = *res.AccessKeySecretSpaceId
-			}
-			upstreamProxyConfigEntity.UserNameSecretIdentifier = *res.AccessKeySecretIdentifier
-		}
-
-		if res.SecretKeySpacePath != nil && len(*res.SecretKeySpacePath) > 0 {
-			upstreamProxyConfigEntity.SecretSpaceID, err = c.RegistryMetadataHelper.getSecretSpaceID(ctx,
-				res.SecretKeySpacePath)
-			if err != nil {
-				return nil, nil, err
-			}
-		} else if res.SecretKeySpaceId != nil {
-			upstreamProxyConfigEntity.SecretSpaceID = *res.SecretKeySpaceId
-		}
-		upstreamProxyConfigEntity.SecretIdentifier = res.SecretKeyIdentifier
+	if err := c.setUpstreamAuth(ctx, upstreamProxyConfigEntity, config.AuthType, config.Auth); err != nil {
+		return nil, nil, err
 	}
 	return repoEntity, upstreamProxyConfigEntity, nil
 }
@@ -405,3 +413,112 @@ func (c *APIController) handleDuplicateRegistryError(ctx context.Context, regist
 		parentSpace.Path,
 	)
 }
+
+// setUpstreamCacheSettings sets the cache TTLs and the offline mode of the upstream proxy from its config.
+func setUpstreamCacheSettings(entity *registrytypes.UpstreamProxyConfig, config artifact.UpstreamConfig) {
+	if config.MetadataTtl != nil {
+		entity.MetadataTTL = time.Duration(*config.MetadataTtl) * time.Second
+	}
+	if config.ArtifactTtl != nil {
+		entity.ArtifactTTL = time.Duration(*config.ArtifactTtl) * time.Second
+	}
+	if config.Offline != nil {
+		entity.Offline = *config.Offline
+	}
+}
+
+// setUpstreamAuth sets the auth type of the upstream proxy along with the secrets its credentials are read
+// from, clearing those of the other auth types.
+//
+//nolint:gocognit
+func (c *APIController) setUpstreamAuth(
+	ctx context.Context, entity *registrytypes.UpstreamProxyConfig,
+	authType artifact.AuthType, auth *artifact.UpstreamAuth,
+) error {
+	entity.AuthType = string(authType)
+	entity.UserName = ""
+	entity.UserNameSecretIdentifier = ""
+	entity.UserNameSecretSpaceID = 0
+	entity.SecretIdentifier = ""
+	entity.SecretSpaceID = 0
+	entity.AwsRoleArn = ""
+	if authType == artifact.AuthTypeAnonymous {
+		return nil
+	}
+	if auth == nil && authType != artifact.AuthTypeAwsIam {
+		return fmt.Errorf("failed to create upstream proxy: auth missing for auth type %s", authType)
+	}
+
+	switch authType {
+	case artifact.AuthTypeUserPassword:
+		res, err := auth.AsUserPassword()
+		if err != nil {
+			return err
+		}
+		if res.SecretIdentifier == nil {
+			return fmt.Errorf("failed to create upstream proxy: secret_identifier missing")
+		}
+		entity.UserName = res.UserName
+		entity.SecretIdentifier = *res.SecretIdentifier
+		entity.SecretSpaceID, err = c.secretSpaceID(ctx, res.SecretSpacePath, res.SecretSpaceId)
+		return err
+	case artifact.AuthTypeAccessKeySecretKey:
+		res, err := auth.AsAccessKeySecretKey()
+		if err != nil {
+			return err
+		}
+		switch {
+		case res.AccessKey != nil && len(*res.AccessKey) > 0:
+			entity.UserName = *res.AccessKey
+		case res.AccessKeySecretIdentifier == nil:
+			return fmt.Errorf("failed to create upstream proxy: access_key_secret_identifier missing")
+		default:
+			entity.UserNameSecretSpaceID, err = c.secretSpaceID(ctx, res.AccessKeySecretSpacePath,
+				res.AccessKeySecretSpaceId)
+			if err != nil {
+				return err
+			}
+			entity.UserNameSecretIdentifier = *res.AccessKeySecretIdentifier
+		}
+		entity.SecretIdentifier = res.SecretKeyIdentifier
+		entity.SecretSpaceID, err = c.secretSpaceID(ctx, res.SecretKeySpacePath, res.SecretKeySpaceId)
+		return err
+	case artifact.AuthTypeToken:
+		res, err := auth.AsToken()
+		if err != nil {
+			return err
+		}
+		entity.SecretIdentifier = res.SecretIdentifier
+		entity.SecretSpaceID, err = c.secretSpaceID(ctx, res.SecretSpacePath, res.SecretSpaceId)
+		return err
+	case artifact.AuthTypeAwsIam:
+		if entity.Source != string(artifact.UpstreamConfigSourceAwsEcr) {
+			return fmt.Errorf("failed to create upstream proxy: auth type %s is only supported for %s upstreams",
+				authType, artifact.UpstreamConfigSourceAwsEcr)
+		}
+		if auth == nil {
+			return nil
+		}
+		res, err := auth.AsAwsIam()
+		if err != nil {
+			return err
+		}
+		if res.RoleArn != nil {
+			entity.AwsRoleArn = *res.RoleArn
+		}
+		return nil
+	default:
+		return fmt.Errorf("failed to create upstream proxy: unsupported auth type %s", authType)
+	}
+}
+
+// secretSpaceID returns the ID of the space of a secret, given either by its path or by its ID.
+func (c *APIController) secretSpaceID(ctx context.Context, path *string, id *int) (int, error) {
+	if path != nil && len(*path) > 0 {
+		return c.RegistryMetadataHelper.getSecretSpaceID(ctx, path)
+	}
+	if id != nil {
+		return *id, nil
+	}
+	return 0, nil
+}
@@ -89,6 +89,7 @@ func (c *APIController) CreateWebhook(
 		}
 		return createWebhookBadRequestErrorResponse(fmt.Errorf("failed to store webhook: %w", err))
 	}
+	c.recordRegistryConfigRevision(ctx, regInfo.RegistryID)
 
 	createdWebhook, err := c.WebhooksRepository.GetByRegistryAndIdentifier(
 		ctx, regInfo.RegistryID, webhookRequest.Identifier,
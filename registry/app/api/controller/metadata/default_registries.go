@@ -0,0 +1,372 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/paths"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListDefaultRegistries lists the default registries set on the space.
+func (c *APIController) ListDefaultRegistries(
+	ctx context.Context,
+	r artifact.ListDefaultRegistriesRequestObject,
+) (artifact.ListDefaultRegistriesResponseObject, error) {
+	space, err := c.SpaceFinder.FindByRef(ctx, string(r.SpaceRef))
+	if err != nil {
+		return artifact.ListDefaultRegistries400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if err = c.checkDefaultRegistryAccess(ctx, space, enum.PermissionRegistryView); err != nil {
+		return artifact.ListDefaultRegistries403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	defaultRegistries, err := c.DefaultRegistryStore.List(ctx, space.ID)
+	if err != nil {
+		return throwListDefaultRegistries500Error(err), nil
+	}
+	data := make([]artifact.DefaultRegistry, 0, len(defaultRegistries))
+	for _, defaultRegistry := range defaultRegistries {
+		apiDefaultRegistry, err := c.mapToAPIDefaultRegistry(ctx, space, space, defaultRegistry)
+		if err != nil {
+			return throwListDefaultRegistries500Error(err), nil
+		}
+		data = append(data, apiDefaultRegistry)
+	}
+	return artifact.ListDefaultRegistries200JSONResponse{
+		ListDefaultRegistriesResponseJSONResponse: artifact.ListDefaultRegistriesResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ResolveDefaultRegistry resolves the registry pipelines of the space publish packages of the
+// package type to: the default registry set on the space or its nearest ancestor.
+func (c *APIController) ResolveDefaultRegistry(
+	ctx context.Context,
+	r artifact.ResolveDefaultRegistryRequestObject,
+) (artifact.ResolveDefaultRegistryResponseObject, error) {
+	packageType := artifact.PackageType(r.PackageType)
+	if !IsPackageTypeValid(string(packageType)) {
+		return artifact.ResolveDefaultRegistry400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, fmt.Sprintf("invalid package type: %s", packageType)),
+			),
+		}, nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, string(r.SpaceRef))
+	if err != nil {
+		return artifact.ResolveDefaultRegistry400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if err = c.checkDefaultRegistryAccess(ctx, space, enum.PermissionRegistryView); err != nil {
+		return artifact.ResolveDefaultRegistry403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	spaces, err := c.spaceWithAncestors(ctx, space)
+	if err != nil {
+		return throwResolveDefaultRegistry500Error(err), nil
+	}
+	spaceIDs := make([]int64, 0, len(spaces))
+	for _, s := range spaces {
+		spaceIDs = append(spaceIDs, s.ID)
+	}
+	defaultRegistries, err := c.DefaultRegistryStore.ListBySpaceIDs(ctx, spaceIDs, packageType)
+	if err != nil {
+		return throwResolveDefaultRegistry500Error(err), nil
+	}
+	defaultRegistry, owner := nearestDefaultRegistry(spaces, defaultRegistries)
+	if defaultRegistry == nil {
+		return artifact.ResolveDefaultRegistry404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound,
+					fmt.Sprintf("no default registry is set for package type %s", packageType)),
+			),
+		}, nil
+	}
+
+	data, err := c.mapToAPIDefaultRegistry(ctx, space, owner, defaultRegistry)
+	if err != nil {
+		return throwResolveDefaultRegistry500Error(err), nil
+	}
+	return artifact.ResolveDefaultRegistry200JSONResponse{
+		DefaultRegistryResponseJSONResponse: artifact.DefaultRegistryResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// SetDefaultRegistry sets the default registry of the space for the package type.
+func (c *APIController) SetDefaultRegistry(
+	ctx context.Context,
+	r artifact.SetDefaultRegistryRequestObject,
+) (artifact.SetDefaultRegistryResponseObject, error) {
+	packageType := artifact.PackageType(r.PackageType)
+	if !IsPackageTypeValid(string(packageType)) {
+		return throwSetDefaultRegistry400Error(fmt.Errorf("invalid package type: %s", packageType)), nil
+	}
+	if r.Body == nil || r.Body.RegistryRef == "" {
+		return throwSetDefaultRegistry400Error(fmt.Errorf("registryRef is required")), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, string(r.SpaceRef))
+	if err != nil {
+		return throwSetDefaultRegistry400Error(err), nil
+	}
+	if err = c.checkDefaultRegistryAccess(ctx, space, enum.PermissionRegistryEdit); err != nil {
+		return artifact.SetDefaultRegistry403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", r.Body.RegistryRef)
+	if err != nil {
+		return throwSetDefaultRegistry400Error(err), nil
+	}
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return throwSetDefaultRegistry500Error(err), nil
+	}
+	spaces, err := c.spaceWithAncestors(ctx, space)
+	if err != nil {
+		return throwSetDefaultRegistry500Error(err), nil
+	}
+	if err = validateDefaultRegistry(registry, packageType, spaces); err != nil {
+		return throwSetDefaultRegistry400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	defaultRegistry := &registrytypes.DefaultRegistry{
+		SpaceID:     space.ID,
+		PackageType: packageType,
+		RegistryID:  registry.ID,
+		CreatedBy:   session.Principal.ID,
+		UpdatedBy:   session.Principal.ID,
+	}
+	if err = c.DefaultRegistryStore.Upsert(ctx, defaultRegistry); err != nil {
+		return throwSetDefaultRegistry500Error(err), nil
+	}
+
+	data, err := c.mapToAPIDefaultRegistry(ctx, space, space, defaultRegistry)
+	if err != nil {
+		return throwSetDefaultRegistry500Error(err), nil
+	}
+	return artifact.SetDefaultRegistry200JSONResponse{
+		DefaultRegistryResponseJSONResponse: artifact.DefaultRegistryResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteDefaultRegistry unsets the default registry of the space for the package type.
+func (c *APIController) DeleteDefaultRegistry(
+	ctx context.Context,
+	r artifact.DeleteDefaultRegistryRequestObject,
+) (artifact.DeleteDefaultRegistryResponseObject, error) {
+	space, err := c.SpaceFinder.FindByRef(ctx, string(r.SpaceRef))
+	if err != nil {
+		return artifact.DeleteDefaultRegistry400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if err = c.checkDefaultRegistryAccess(ctx, space, enum.PermissionRegistryEdit); err != nil {
+		return artifact.DeleteDefaultRegistry403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	err = c.DefaultRegistryStore.Delete(ctx, space.ID, artifact.PackageType(r.PackageType))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteDefaultRegistry404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "default registry not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return artifact.DeleteDefaultRegistry500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	return artifact.DeleteDefaultRegistry200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// checkDefaultRegistryAccess checks the permission on the registries of the space, as default
+// registries are configured for the whole space.
+func (c *APIController) checkDefaultRegistryAccess(
+	ctx context.Context,
+	space *types.SpaceCore,
+	permission enum.Permission,
+) error {
+	session, _ := request.AuthSessionFrom(ctx)
+	return apiauth.CheckSpaceScope(ctx, c.Authorizer, session, space, enum.ResourceTypeRegistry, permission)
+}
+
+// spaceWithAncestors returns the space followed by its ancestors, nearest first.
+func (c *APIController) spaceWithAncestors(
+	ctx context.Context,
+	space *types.SpaceCore,
+) ([]*types.SpaceCore, error) {
+	spaces := []*types.SpaceCore{space}
+	for parentID := space.ParentID; parentID > 0; {
+		parent, err := c.SpaceFinder.FindByID(ctx, parentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find space %d: %w", parentID, err)
+		}
+		spaces = append(spaces, parent)
+		parentID = parent.ParentID
+	}
+	return spaces, nil
+}
+
+// validateDefaultRegistry checks that pipelines of the space can publish packages of the package
+// type to the registry: a virtual registry of the package type in the space or an ancestor.
+func validateDefaultRegistry(
+	registry *registrytypes.Registry,
+	packageType artifact.PackageType,
+	spaces []*types.SpaceCore,
+) error {
+	if registry.PackageType != packageType {
+		return fmt.Errorf("registry %s is a %s registry, not a %s registry", registry.Name,
+			registry.PackageType, packageType)
+	}
+	if registry.Type != artifact.RegistryTypeVIRTUAL {
+		return fmt.Errorf("registry %s is an upstream registry, packages can't be published to it", registry.Name)
+	}
+	for _, space := range spaces {
+		if space.ID == registry.ParentID {
+			return nil
+		}
+	}
+	return fmt.Errorf("registry %s is neither in the space nor one of its ancestors", registry.Name)
+}
+
+// nearestDefaultRegistry returns the default registry set on the first of the spaces that has one,
+// and that space.
+func nearestDefaultRegistry(
+	spaces []*types.SpaceCore,
+	defaultRegistries []*registrytypes.DefaultRegistry,
+) (*registrytypes.DefaultRegistry, *types.SpaceCore) {
+	for _, space := range spaces {
+		for _, defaultRegistry := range defaultRegistries {
+			if defaultRegistry.SpaceID == space.ID {
+				return defaultRegistry, space
+			}
+		}
+	}
+	return nil, nil
+}
+
+// mapToAPIDefaultRegistry maps the default registry set on the owner space, as resolved for the
+// space.
+func (c *APIController) mapToAPIDefaultRegistry(
+	ctx context.Context,
+	space *types.SpaceCore,
+	owner *types.SpaceCore,
+	defaultRegistry *registrytypes.DefaultRegistry,
+) (artifact.DefaultRegistry, error) {
+	registry, err := c.RegistryRepository.Get(ctx, defaultRegistry.RegistryID)
+	if err != nil {
+		return artifact.DefaultRegistry{}, fmt.Errorf("failed to find registry %d: %w",
+			defaultRegistry.RegistryID, err)
+	}
+	registrySpace, err := c.SpaceFinder.FindByID(ctx, registry.ParentID)
+	if err != nil {
+		return artifact.DefaultRegistry{}, fmt.Errorf("failed to find space %d: %w", registry.ParentID, err)
+	}
+	rootIdentifier, _, err := paths.DisectRoot(registrySpace.Path)
+	if err != nil {
+		return artifact.DefaultRegistry{}, err
+	}
+	return artifact.DefaultRegistry{
+		PackageType:        defaultRegistry.PackageType,
+		RegistryIdentifier: registry.Name,
+		RegistryRef:        registrySpace.Path + "/" + registry.Name,
+		RegistryUrl: GetRegistryURL(ctx, c.URLProvider, rootIdentifier, registry.Name,
+			registry.PackageType),
+		SpaceRef:  owner.Path,
+		Inherited: owner.ID != space.ID,
+		UpdatedAt: defaultRegistry.UpdatedAt.UnixMilli(),
+	}, nil
+}
+
+func throwListDefaultRegistries500Error(err error) artifact.ListDefaultRegistries500JSONResponse {
+	return artifact.ListDefaultRegistries500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwResolveDefaultRegistry500Error(err error) artifact.ResolveDefaultRegistry500JSONResponse {
+	return artifact.ResolveDefaultRegistry500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwSetDefaultRegistry400Error(err error) artifact.SetDefaultRegistry400JSONResponse {
+	return artifact.SetDefaultRegistry400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwSetDefaultRegistry500Error(err error) artifact.SetDefaultRegistry500JSONResponse {
+	return artifact.SetDefaultRegistry500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNearestDefaultRegistry(t *testing.T) {
+	spaces := []*types.SpaceCore{
+		{ID: 3, ParentID: 2, Path: "acme/platform/team"},
+		{ID: 2, ParentID: 1, Path: "acme/platform"},
+		{ID: 1, Path: "acme"},
+	}
+	defaultRegistries := []*registrytypes.DefaultRegistry{
+		{SpaceID: 1, RegistryID: 10},
+		{SpaceID: 2, RegistryID: 20},
+	}
+
+	defaultRegistry, owner := nearestDefaultRegistry(spaces, defaultRegistries)
+	assert.Equal(t, int64(20), defaultRegistry.RegistryID)
+	assert.Equal(t, "acme/platform", owner.Path)
+
+	defaultRegistry, owner = nearestDefaultRegistry(spaces, nil)
+	assert.Nil(t, defaultRegistry)
+	assert.Nil(t, owner)
+}
+
+func TestValidateDefaultRegistry(t *testing.T) {
+	spaces := []*types.SpaceCore{{ID: 2, ParentID: 1}, {ID: 1}}
+	registry := &registrytypes.Registry{
+		Name:        "npm-releases",
+		ParentID:    1,
+		Type:        artifact.RegistryTypeVIRTUAL,
+		PackageType: artifact.PackageTypeNPM,
+	}
+	assert.NoError(t, validateDefaultRegistry(registry, artifact.PackageTypeNPM, spaces))
+	assert.Error(t, validateDefaultRegistry(registry, artifact.PackageTypePYTHON, spaces))
+	assert.Error(t, validateDefaultRegistry(registry, artifact.PackageTypeNPM, spaces[:1]))
+
+	registry.Type = artifact.RegistryTypeUPSTREAM
+	assert.Error(t, validateDefaultRegistry(registry, artifact.PackageTypeNPM, spaces))
+}
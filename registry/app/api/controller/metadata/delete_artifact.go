@@ -16,6 +16,7 @@ package metadata
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
 	registryTypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -92,6 +94,17 @@ func (c *APIController) DeleteArtifact(ctx context.Context, r artifact.DeleteArt
 			),
 		}, nil
 	}
+	err = pkg.CheckLegalHold(ctx, c.LegalHoldStore, regInfo.RegistryID, artifactName)
+	if errors.Is(err, pkg.ErrLegalHold) {
+		return artifact.DeleteArtifact400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteArtifact500Error(err), err
+	}
 	err = c.tx.WithTx(
 		ctx, func(ctx context.Context) error {
 			err = c.disableImageStatus(
@@ -16,12 +16,14 @@ package metadata
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -75,6 +77,26 @@ func (c *APIController) DeleteArtifactVersion(ctx context.Context, r artifact.De
 		return throwDeleteArtifactVersion500Error(err), err
 	}
 
+	versions := []string{string(r.Version)}
+	if repoEntity.PackageType == artifact.PackageTypeDOCKER || repoEntity.PackageType == artifact.PackageTypeHELM {
+		versions, err = pkg.ManifestVersions(ctx, c.ManifestStore, c.TagStore, regInfo.RegistryID,
+			string(r.Artifact), string(r.Version))
+		if err != nil {
+			return throwDeleteArtifactVersion500Error(err), err
+		}
+	}
+	err = pkg.CheckLegalHold(ctx, c.LegalHoldStore, regInfo.RegistryID, string(r.Artifact), versions...)
+	if errors.Is(err, pkg.ErrLegalHold) {
+		return artifact.DeleteArtifactVersion400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteArtifactVersion500Error(err), err
+	}
+
 	err = c.deleteTagWithAudit(ctx, regInfo, repoEntity.Name, session.Principal, string(r.Artifact),
 		string(r.Version))
 
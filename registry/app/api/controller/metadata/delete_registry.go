@@ -16,6 +16,7 @@ package metadata
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -25,6 +26,7 @@ import (
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
 	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
@@ -81,6 +83,18 @@ func (c *APIController) DeleteRegistry(
 		return throwDeleteRegistry500Error(err), err
 	}
 
+	err = pkg.CheckLegalHold(ctx, c.LegalHoldStore, repoEntity.ID, "")
+	if errors.Is(err, pkg.ErrLegalHold) {
+		return artifact.DeleteRegistry400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteRegistry500Error(err), err
+	}
+
 	if string(repoEntity.Type) == string(artifact.RegistryTypeVIRTUAL) {
 		err = c.tx.WithTx(
 			ctx, func(ctx context.Context) error {
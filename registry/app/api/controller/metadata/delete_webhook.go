@@ -72,6 +72,7 @@ func (c *APIController) DeleteWebhook(
 	if err != nil {
 		return deleteWebhookInternalErrorResponse(err)
 	}
+	c.recordRegistryConfigRevision(ctx, regInfo.RegistryID)
 	return api.DeleteWebhook200JSONResponse{
 		SuccessJSONResponse: api.SuccessJSONResponse(*GetSuccessResponse()),
 	}, nil
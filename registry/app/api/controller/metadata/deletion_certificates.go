@@ -0,0 +1,137 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListDeletionCertificates lists the certificates of the versions purged from a registry, latest first.
+func (c *APIController) ListDeletionCertificates(
+	ctx context.Context,
+	r artifact.ListDeletionCertificatesRequestObject,
+) (artifact.ListDeletionCertificatesResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListDeletionCertificates400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListDeletionCertificates403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListDeletionCertificates500Error(err), nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	certificates, err := c.DeletionCertificateStore.List(ctx, registry.ID, limit, offset)
+	if err != nil {
+		return throwListDeletionCertificates500Error(err), nil
+	}
+	count, err := c.DeletionCertificateStore.Count(ctx, registry.ID)
+	if err != nil {
+		return throwListDeletionCertificates500Error(err), nil
+	}
+	data := make([]artifact.DeletionCertificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		data = append(data, mapToAPIDeletionCertificate(certificate))
+	}
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(data)
+	return artifact.ListDeletionCertificates200JSONResponse{
+		ListDeletionCertificatesResponseJSONResponse: artifact.ListDeletionCertificatesResponseJSONResponse{
+			Data: artifact.ListDeletionCertificates{
+				Certificates: data,
+				ItemCount:    &count,
+				PageCount:    &pageCount,
+				PageIndex:    &pageNumber,
+				PageSize:     &currentPageSize,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetDeletionCertificate returns the certificate of a purged version.
+func (c *APIController) GetDeletionCertificate(
+	ctx context.Context,
+	r artifact.GetDeletionCertificateRequestObject,
+) (artifact.GetDeletionCertificateResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetDeletionCertificate400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetDeletionCertificate403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwGetDeletionCertificate500Error(err), nil
+	}
+
+	certificate, err := c.DeletionCertificateStore.Get(ctx, registry.ID, int64(r.CertificateId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.GetDeletionCertificate404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "deletion certificate not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwGetDeletionCertificate500Error(err), nil
+	}
+	return artifact.GetDeletionCertificate200JSONResponse{
+		DeletionCertificateResponseJSONResponse: artifact.DeletionCertificateResponseJSONResponse{
+			Data:   mapToAPIDeletionCertificate(certificate),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func throwListDeletionCertificates500Error(err error) artifact.ListDeletionCertificates500JSONResponse {
+	return artifact.ListDeletionCertificates500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwGetDeletionCertificate500Error(err error) artifact.GetDeletionCertificate500JSONResponse {
+	return artifact.GetDeletionCertificate500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
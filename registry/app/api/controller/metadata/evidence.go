@@ -0,0 +1,101 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ExportVersionEvidence exports the evidence recorded for a version of an artifact as a gzipped tarball.
+func (c *APIController) ExportVersionEvidence(
+	ctx context.Context,
+	r artifact.ExportVersionEvidenceRequestObject,
+) (artifact.ExportVersionEvidenceResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwExportVersionEvidence400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwExportVersionEvidence400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsDownload)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ExportVersionEvidence403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return throwExportVersionEvidence500Error(err), nil
+	}
+
+	image := string(r.Artifact)
+	version := string(r.Version)
+	bundle, err := c.EvidenceService.Export(ctx, registry, image, version, session.Principal.UID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.ExportVersionEvidence404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact version not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwExportVersionEvidence500Error(err), nil
+	}
+
+	return artifact.ExportVersionEvidence200ApplicationgzipResponse{
+		EvidenceBundleResponseApplicationgzipResponse: artifact.EvidenceBundleResponseApplicationgzipResponse{
+			Body: bytes.NewReader(bundle.Content),
+			Headers: artifact.EvidenceBundleResponseResponseHeaders{
+				ContentDisposition: fmt.Sprintf("attachment; filename=%q", image+"-"+version+"-evidence.tar.gz"),
+				XEvidenceSigned:    bundle.Signed,
+			},
+			ContentLength: int64(len(bundle.Content)),
+		},
+	}, nil
+}
+
+func throwExportVersionEvidence400Error(err error) artifact.ExportVersionEvidence400JSONResponse {
+	return artifact.ExportVersionEvidence400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwExportVersionEvidence500Error(err error) artifact.ExportVersionEvidence500JSONResponse {
+	return artifact.ExportVersionEvidence500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
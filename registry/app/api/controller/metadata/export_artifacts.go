@@ -0,0 +1,194 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+var artifactExportHeader = []string{
+	"registry", "name", "package_type", "version", "modified_at", "download_count", "labels",
+}
+
+// ExportArtifacts streams every artifact of a space that matches the listing filters as CSV.
+func (c *APIController) ExportArtifacts(
+	ctx context.Context,
+	r artifact.ExportArtifactsRequestObject,
+) (artifact.ExportArtifactsResponseObject, error) {
+	regInfo, err := c.GetRegistryRequestInfo(ctx, RegistryRequestParams{
+		packageTypesParam: r.Params.PackageType,
+		search:            r.Params.SearchTerm,
+		Resource:          ArtifactResource,
+		ParentRef:         string(r.SpaceRef),
+		sortOrder:         r.Params.SortOrder,
+		sortField:         r.Params.SortField,
+		registryIDsParam:  r.Params.RegIdentifier,
+	})
+	if err != nil {
+		return throwExportArtifacts400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwExportArtifacts400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space,
+		regInfo.RegistryIdentifier, enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ExportArtifacts403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	latestVersion := r.Params.LatestVersion != nil && bool(*r.Params.LatestVersion)
+	ctx, cancel := context.WithCancel(ctx)
+	artifacts, errs := c.ArtifactStore.StreamAllArtifactsByParentID(
+		ctx, regInfo.parentID, &regInfo.registryIDs,
+		regInfo.sortByField, regInfo.sortByOrder, regInfo.searchTerm, latestVersion, regInfo.packageTypes)
+
+	return artifact.ExportArtifacts200TextcsvResponse{
+		ArtifactExportResponseTextcsvResponse: artifact.ArtifactExportResponseTextcsvResponse{
+			Body: exportArtifactsCSV(cancel, artifacts, errs),
+		},
+	}, nil
+}
+
+// ExportRegistryArtifacts streams the latest version of every artifact of a registry as CSV.
+func (c *APIController) ExportRegistryArtifacts(
+	ctx context.Context,
+	r artifact.ExportRegistryArtifactsRequestObject,
+) (artifact.ExportRegistryArtifactsResponseObject, error) {
+	regInfo, err := c.GetRegistryRequestInfo(ctx, RegistryRequestParams{
+		search:      r.Params.SearchTerm,
+		Resource:    ArtifactResource,
+		RegRef:      string(r.RegistryRef),
+		labelsParam: r.Params.Label,
+		sortOrder:   r.Params.SortOrder,
+		sortField:   r.Params.SortField,
+	})
+	if err != nil {
+		return throwExportRegistryArtifacts400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwExportRegistryArtifacts400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ExportRegistryArtifacts403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, space.ID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return artifact.ExportRegistryArtifacts500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	registryIDs := []int64{registry.ID}
+	if r.Params.Merged != nil && bool(*r.Params.Merged) && registry.Type == artifact.RegistryTypeVIRTUAL {
+		registryIDs = append(registryIDs, registry.UpstreamProxies...)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	artifacts, errs := c.ArtifactStore.StreamAllArtifactsByRepo(
+		ctx, registryIDs, regInfo.sortByField, regInfo.sortByOrder, regInfo.searchTerm, regInfo.labels)
+
+	return artifact.ExportRegistryArtifacts200TextcsvResponse{
+		ArtifactExportResponseTextcsvResponse: artifact.ArtifactExportResponseTextcsvResponse{
+			Body: exportArtifactsCSV(cancel, artifacts, errs),
+		},
+	}, nil
+}
+
+// exportArtifactsCSV writes the streamed artifacts as CSV to the returned reader. Closing the reader,
+// as the response does when the client goes away, cancels the stream. A stream error fails the read.
+func exportArtifactsCSV(
+	cancel context.CancelFunc,
+	artifacts <-chan *types.ArtifactMetadata,
+	errs <-chan error,
+) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		defer cancel()
+		w := csv.NewWriter(pw)
+		err := w.Write(artifactExportHeader)
+		for a := range artifacts {
+			if err != nil {
+				// The reader is gone; stop the query and drain what is already in flight.
+				cancel()
+				continue
+			}
+			version := a.Version
+			if version == "" {
+				version = a.LatestVersion
+			}
+			err = w.Write([]string{
+				a.RepoName, a.Name, string(a.PackageType), version, a.ModifiedAt.UTC().Format(time.RFC3339),
+				strconv.FormatInt(a.DownloadCount, 10), strings.Join(a.Labels, ";"),
+			})
+		}
+		if err == nil {
+			w.Flush()
+			err = w.Error()
+		}
+		if streamErr := <-errs; err == nil {
+			err = streamErr
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+func throwExportArtifacts400Error(err error) artifact.ExportArtifacts400JSONResponse {
+	return artifact.ExportArtifacts400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwExportRegistryArtifacts400Error(err error) artifact.ExportRegistryArtifacts400JSONResponse {
+	return artifact.ExportRegistryArtifacts400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportArtifactsCSV(t *testing.T) {
+	artifacts := make(chan *types.ArtifactMetadata, 2)
+	errs := make(chan error, 1)
+	artifacts <- &types.ArtifactMetadata{
+		RepoName: "generic-local", Name: "app", PackageType: artifact.PackageTypeGENERIC, Version: "1.0.0",
+		ModifiedAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), DownloadCount: 3, Labels: []string{"a", "b"},
+	}
+	artifacts <- &types.ArtifactMetadata{
+		RepoName: "docker-local", Name: "web, api", PackageType: artifact.PackageTypeDOCKER, LatestVersion: "v2",
+		ModifiedAt: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+	}
+	close(artifacts)
+	close(errs)
+
+	body, err := io.ReadAll(exportArtifactsCSV(func() {}, artifacts, errs))
+	require.NoError(t, err)
+	assert.Equal(t, "registry,name,package_type,version,modified_at,download_count,labels\n"+
+		"generic-local,app,GENERIC,1.0.0,2024-05-01T00:00:00Z,3,a;b\n"+
+		"docker-local,\"web, api\",DOCKER,v2,2024-05-02T00:00:00Z,0,\n", string(body))
+}
+
+func TestExportArtifactsCSVStreamError(t *testing.T) {
+	artifacts := make(chan *types.ArtifactMetadata)
+	errs := make(chan error, 1)
+	streamErr := errors.New("connection reset")
+	errs <- streamErr
+	close(artifacts)
+	close(errs)
+
+	_, err := io.ReadAll(exportArtifactsCSV(func() {}, artifacts, errs))
+	require.ErrorIs(t, err, streamErr, "the failure reaches the reader instead of a truncated export")
+}
+
+func TestExportArtifactsCSVReaderClosed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	artifacts := make(chan *types.ArtifactMetadata)
+	errs := make(chan error, 1)
+	// A stand-in for the store stream: it sends until it is canceled.
+	go func() {
+		defer close(artifacts)
+		defer close(errs)
+		for {
+			select {
+			case artifacts <- &types.ArtifactMetadata{Name: "app"}:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	body := exportArtifactsCSV(cancel, artifacts, errs)
+	_, err := body.Read(make([]byte, 16))
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("closing the reader does not cancel the stream")
+	}
+}
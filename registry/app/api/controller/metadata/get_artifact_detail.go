@@ -23,6 +23,7 @@ import (
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/services/enrichment"
 	"github.com/harness/gitness/types/enum"
 )
 
@@ -119,6 +120,294 @@ func (c *APIController) GetArtifactDetails(
 			}, nil
 		}
 		artifactDetails = GetGenericArtifactDetail(img, art, metadata)
+	} else if artifact.PackageTypeNPM == registry.PackageType {
+		var metadata database.NpmMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		artifactDetails = GetNpmArtifactDetail(img, art, metadata)
+	} else if artifact.PackageTypePYTHON == registry.PackageType {
+		var metadata database.PyPiMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "python")
+		artifactDetails = GetPythonArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeNUGET == registry.PackageType {
+		var metadata database.NugetMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "nuget")
+		artifactDetails = GetNugetArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeGEMS == registry.PackageType {
+		var metadata database.GemsMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "rubygems")
+		artifactDetails = GetGemsArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeCRATE == registry.PackageType {
+		var metadata database.CrateMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "cargo")
+		artifactDetails = GetCrateArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeGO == registry.PackageType {
+		var metadata database.GoModuleMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "go")
+		artifactDetails = GetGoModuleArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeDEB == registry.PackageType {
+		var metadata database.DebMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "debian")
+		artifactDetails = GetDebArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeRPM == registry.PackageType {
+		var metadata database.RpmMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		artifactDetails = GetRpmArtifactDetail(img, art, metadata)
+	} else if artifact.PackageTypeALPINE == registry.PackageType {
+		var metadata database.AlpineMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		artifactDetails = GetAlpineArtifactDetail(img, art, metadata)
+	} else if artifact.PackageTypeCONAN == registry.PackageType {
+		var metadata database.ConanMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "conan")
+		artifactDetails = GetConanArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeCOMPOSER == registry.PackageType {
+		var metadata database.ComposerMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		artifactDetails = GetComposerArtifactDetail(img, art, metadata)
+	} else if artifact.PackageTypeCONDA == registry.PackageType {
+		var metadata database.CondaMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "conda")
+		artifactDetails = GetCondaArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeTERRAFORM == registry.PackageType {
+		var metadata database.TerraformMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "terraform")
+		artifactDetails = GetTerraformArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeSWIFT == registry.PackageType {
+		var metadata database.SwiftMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		artifactDetails = GetSwiftArtifactDetail(img, art, metadata)
+	} else if artifact.PackageTypeCOCOAPODS == registry.PackageType {
+		var metadata database.CocoapodsMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "cocoapods")
+		artifactDetails = GetCocoapodsArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeHEX == registry.PackageType {
+		var metadata database.HexMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "hex")
+		artifactDetails = GetHexArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypePUB == registry.PackageType {
+		var metadata database.PubMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "pub")
+		artifactDetails = GetPubArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeCRAN == registry.PackageType {
+		var metadata database.CranMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "cran")
+		artifactDetails = GetCranArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeGALAXY == registry.PackageType {
+		var metadata database.GalaxyMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "galaxy")
+		artifactDetails = GetGalaxyArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypePUPPET == registry.PackageType {
+		var metadata database.PuppetMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "puppet")
+		artifactDetails = GetPuppetArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeVAGRANT == registry.PackageType {
+		var metadata database.VagrantMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "vagrant")
+		artifactDetails = GetVagrantArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeMODEL == registry.PackageType {
+		var metadata database.ModelMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "model")
+		artifactDetails = GetModelArtifactDetail(img, art, metadata, registryURL)
+	} else if artifact.PackageTypeHOMEBREW == registry.PackageType {
+		var metadata database.HomebrewMetadata
+		err := json.Unmarshal(art.Metadata, &metadata)
+		if err != nil {
+			return artifact.GetArtifactDetails500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		registryURL := c.URLProvider.PackageURL(ctx,
+			regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "homebrew")
+		artifactDetails = GetHomebrewArtifactDetail(img, art, metadata, registryURL)
+	}
+	if c.EnrichmentService != nil {
+		artifactDetails.Sections = toArtifactDetailSections(c.EnrichmentService.Enrich(ctx, enrichment.Target{
+			Registry: registry,
+			Image:    img,
+			Artifact: art,
+		}))
 	}
 	return artifact.GetArtifactDetails200JSONResponse{
 		ArtifactDetailResponseJSONResponse: artifact.ArtifactDetailResponseJSONResponse{
@@ -127,3 +416,23 @@ func (c *APIController) GetArtifactDetails(
 		},
 	}, nil
 }
+
+func toArtifactDetailSections(sections []enrichment.Section) *[]artifact.ArtifactDetailSection {
+	if len(sections) == 0 {
+		return nil
+	}
+	result := make([]artifact.ArtifactDetailSection, 0, len(sections))
+	for _, s := range sections {
+		section := artifact.ArtifactDetailSection{
+			Name:   s.Name,
+			Status: artifact.ArtifactDetailSectionStatus(s.Status),
+			Error:  optionalString(s.Error),
+		}
+		if s.Data != nil {
+			data := s.Data
+			section.Data = &data
+		}
+		result = append(result, section)
+	}
+	return &result
+}
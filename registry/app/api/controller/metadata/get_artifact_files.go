@@ -16,6 +16,7 @@ package metadata
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -23,6 +24,7 @@ import (
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/rs/zerolog/log"
@@ -99,6 +101,53 @@ func (c *APIController) GetArtifactFiles(
 
 	registryURL := c.URLProvider.RegistryURL(ctx,
 		reqInfo.RootIdentifier, strings.ToLower(string(registry.PackageType)), reqInfo.RegistryIdentifier)
+	if artifact.PackageTypeNPM == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "npm")
+	} else if artifact.PackageTypePYTHON == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "python")
+	} else if artifact.PackageTypeNUGET == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "nuget")
+	} else if artifact.PackageTypeGEMS == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "rubygems")
+	} else if artifact.PackageTypeCRATE == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "cargo")
+	} else if artifact.PackageTypeGO == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "go")
+	} else if artifact.PackageTypeDEB == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "debian")
+	} else if artifact.PackageTypeRPM == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "rpm")
+	} else if artifact.PackageTypeALPINE == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "alpine")
+	} else if artifact.PackageTypeCONAN == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "conan")
+	} else if artifact.PackageTypeCOMPOSER == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "composer")
+	} else if artifact.PackageTypeCONDA == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "conda")
+	} else if artifact.PackageTypeTERRAFORM == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "terraform")
+	} else if artifact.PackageTypeSWIFT == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "swift")
+	} else if artifact.PackageTypeCOCOAPODS == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "cocoapods")
+	} else if artifact.PackageTypeHEX == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "hex")
+	} else if artifact.PackageTypePUB == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "pub")
+	} else if artifact.PackageTypeCRAN == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "cran")
+	} else if artifact.PackageTypeGALAXY == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "galaxy")
+	} else if artifact.PackageTypePUPPET == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "puppet")
+	} else if artifact.PackageTypeVAGRANT == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "vagrant")
+	} else if artifact.PackageTypeMODEL == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "model")
+	} else if artifact.PackageTypeHOMEBREW == registry.PackageType {
+		registryURL = c.URLProvider.PackageURL(ctx, reqInfo.RootIdentifier+"/"+reqInfo.RegistryIdentifier, "homebrew")
+	}
 	filePathPrefix := "/" + img.Name + "/" + art.Version + "%"
 
 	if artifact.PackageTypeMAVEN == registry.PackageType {
@@ -133,12 +182,57 @@ func (c *APIController) GetArtifactFiles(
 
 	//nolint:exhaustive
 	switch registry.PackageType {
-	case artifact.PackageTypeGENERIC, artifact.PackageTypeMAVEN:
+	case artifact.PackageTypeGENERIC, artifact.PackageTypeMAVEN, artifact.PackageTypeNPM, artifact.PackageTypeNUGET,
+		artifact.PackageTypeGEMS, artifact.PackageTypeCRATE, artifact.PackageTypeGO, artifact.PackageTypeDEB,
+		artifact.PackageTypeRPM, artifact.PackageTypeALPINE, artifact.PackageTypeCOMPOSER, artifact.PackageTypeCONDA,
+		artifact.PackageTypeSWIFT, artifact.PackageTypeCOCOAPODS, artifact.PackageTypeHEX, artifact.PackageTypePUB,
+		artifact.PackageTypeCRAN, artifact.PackageTypeGALAXY, artifact.PackageTypePUPPET, artifact.PackageTypeVAGRANT,
+		artifact.PackageTypeMODEL, artifact.PackageTypeHOMEBREW:
 		return artifact.GetArtifactFiles200JSONResponse{
 			FileDetailResponseJSONResponse: *GetAllArtifactFilesResponse(
 				fileMetadataList, count, reqInfo.pageNumber, reqInfo.limit, registryURL, img.Name, art.Version,
 				registry.PackageType),
 		}, nil
+	case artifact.PackageTypePYTHON:
+		response := GetAllArtifactFilesResponse(
+			fileMetadataList, count, reqInfo.pageNumber, reqInfo.limit, registryURL, img.Name, art.Version,
+			registry.PackageType)
+		var metadata database.PyPiMetadata
+		if err := json.Unmarshal(art.Metadata, &metadata); err != nil {
+			return artifact.GetArtifactFiles500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		SetPythonFileDetails(response.Files, metadata)
+		return artifact.GetArtifactFiles200JSONResponse{
+			FileDetailResponseJSONResponse: *response,
+		}, nil
+	case artifact.PackageTypeCONAN:
+		response := GetAllArtifactFilesResponse(
+			fileMetadataList, count, reqInfo.pageNumber, reqInfo.limit, registryURL, img.Name, art.Version,
+			registry.PackageType)
+		SetConanFileDetails(response.Files)
+		return artifact.GetArtifactFiles200JSONResponse{
+			FileDetailResponseJSONResponse: *response,
+		}, nil
+	case artifact.PackageTypeTERRAFORM:
+		response := GetAllArtifactFilesResponse(
+			fileMetadataList, count, reqInfo.pageNumber, reqInfo.limit, registryURL, img.Name, art.Version,
+			registry.PackageType)
+		var metadata database.TerraformMetadata
+		if err := json.Unmarshal(art.Metadata, &metadata); err != nil {
+			return artifact.GetArtifactFiles500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		SetTerraformFileDetails(response.Files, metadata)
+		return artifact.GetArtifactFiles200JSONResponse{
+			FileDetailResponseJSONResponse: *response,
+		}, nil
 	default:
 		return artifact.GetArtifactFiles400JSONResponse{
 			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
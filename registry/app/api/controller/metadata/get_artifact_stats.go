@@ -16,20 +16,137 @@ package metadata
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/store"
 	"github.com/harness/gitness/types/enum"
 )
 
-//nolint:nilnil
+// statsDateLayout is the MM/DD/YYYY format of the from and to dates of stats.
+const statsDateLayout = "01/02/2006"
+
+// GetArtifactStats returns the downloads of the versions of an artifact, broken down by the kind of
+// client which downloaded them. The to date is inclusive.
 func (c *APIController) GetArtifactStats(
-	_ context.Context,
-	_ artifact.GetArtifactStatsRequestObject,
+	ctx context.Context,
+	r artifact.GetArtifactStatsRequestObject,
 ) (artifact.GetArtifactStatsResponseObject, error) {
-	return nil, nil
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return artifact.GetArtifactStats400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.GetArtifactStats400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space,
+		regInfo.RegistryIdentifier, enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetArtifactStats403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	from, err := parseStatsDate(r.Params.From)
+	if err != nil {
+		return artifact.GetArtifactStats400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	to, err := parseStatsDate(r.Params.To)
+	if err != nil {
+		return artifact.GetArtifactStats400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if !to.IsZero() {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	img, err := c.ImageStore.GetByName(ctx, regInfo.RegistryID, string(r.Artifact))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.GetArtifactStats404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "artifact not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return artifact.GetArtifactStats500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	counts, err := c.DownloadStatStore.CountByClientType(ctx, img.ID, from, to)
+	if err != nil {
+		return artifact.GetArtifactStats500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	var downloadCount int64
+	byClientType := make([]artifact.ClientTypeDownloadStats, 0, len(counts))
+	for _, count := range counts {
+		downloadCount += count.DownloadCount
+		byClientType = append(byClientType, artifact.ClientTypeDownloadStats{
+			ClientType:          artifact.ClientType(count.ClientType),
+			DownloadCount:       count.DownloadCount,
+			UniqueDownloadCount: count.UniqueDownloadCount,
+		})
+	}
+
+	return artifact.GetArtifactStats200JSONResponse{
+		ArtifactStatsResponseJSONResponse: artifact.ArtifactStatsResponseJSONResponse{
+			Data: artifact.ArtifactStats{
+				DownloadCount:         &downloadCount,
+				DownloadsByClientType: &byClientType,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// parseStatsDate parses an optional date of the stats, returning the zero time if it's not set.
+func parseStatsDate[T ~string](date *T) (time.Time, error) {
+	if date == nil || *date == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(statsDateLayout, string(*date))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected MM/DD/YYYY", *date)
+	}
+	return t, nil
 }
 
 //nolint:nilnil
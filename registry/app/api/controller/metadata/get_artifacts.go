@@ -79,9 +79,16 @@ func (c *APIController) GetAllArtifacts(
 		ctx, regInfo.parentID, &regInfo.registryIDs,
 		regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm,
 		latestVersion, regInfo.packageTypes)
-	count, _ := c.TagStore.CountAllArtifactsByParentID(
-		ctx, regInfo.parentID, &regInfo.registryIDs,
-		regInfo.searchTerm, latestVersion, regInfo.packageTypes)
+	key := countKey("artifacts", regInfo.parentID, regInfo.registryIDs, regInfo.searchTerm, latestVersion,
+		regInfo.packageTypes)
+	count, _ := c.countCache.Get(ctx, key, isExactCount(r.Params.ExactCount), func(ctx context.Context) (int64, error) {
+		return c.TagStore.CountAllArtifactsByParentID(
+			ctx, regInfo.parentID, &regInfo.registryIDs,
+			regInfo.searchTerm, latestVersion, regInfo.packageTypes)
+	})
+	if err == nil && latestVersion {
+		err = c.applyLatestVersionStrategies(ctx, regInfo.parentID, artifacts)
+	}
 	if err != nil {
 		return artifact.GetAllArtifacts500JSONResponse{
 			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
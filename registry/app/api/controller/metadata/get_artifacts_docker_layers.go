@@ -97,7 +97,9 @@ func (c *APIController) GetDockerArtifactLayers(
 		return getLayersErrorResponse(ctx, err)
 	}
 
-	mConfig, err := getManifestConfig(ctx, m.Configuration.Digest, regInfo.RootIdentifier, c.StorageDriver)
+	mConfig, err := getManifestConfig(
+		ctx, m.Configuration.Digest, storageRoot(regInfo.RootIdentifier, registry), c.StorageDriver,
+	)
 	if err != nil {
 		return getLayersErrorResponse(ctx, err)
 	}
@@ -126,7 +128,9 @@ func (c *APIController) GetDockerArtifactLayers(
 			}
 			sizeString := DefaultSize
 			if !history.EmptyLayer && len(layers) > layerIndex {
-				sizeString = GetSizeString(layers[layerIndex].Size)
+				sizeBytes := layers[layerIndex].Size
+				sizeString = GetSizeString(sizeBytes)
+				layerEntry.SizeBytes = &sizeBytes
 				layerIndex++
 			}
 			layerEntry.Size = &sizeString
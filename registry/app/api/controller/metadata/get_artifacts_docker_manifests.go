@@ -118,7 +118,7 @@ func (c *APIController) getManifestList(
 		}
 		mConfig, err := getManifestConfig(
 			ctx, referencedManifest.Configuration.Digest,
-			regInfo.RootIdentifier, c.StorageDriver,
+			storageRoot(regInfo.RootIdentifier, registry), c.StorageDriver,
 		)
 		if err != nil {
 			return nil, err
@@ -146,7 +146,9 @@ func getManifestDetails(
 		Digest:         m.Digest.String(),
 		CreatedAt:      &createdAt,
 		Size:           &size,
+		SizeBytes:      &m.TotalSize,
 		DownloadsCount: &downloadsCount,
+		Annotations:    getAnnotationsRef(m.Annotations),
 	}
 	if mConfig != nil {
 		manifestDetails.OsArch = fmt.Sprintf("%s/%s", mConfig.Os, mConfig.Arch)
@@ -182,13 +184,17 @@ func (c *APIController) ProcessManifest(
 	manifestDetailsList := []artifact.DockerManifestDetails{}
 	switch reqManifest := manifest.(type) {
 	case *schema2.DeserializedManifest:
-		mConfig, err := getManifestConfig(ctx, reqManifest.Config().Digest, regInfo.RootIdentifier, c.StorageDriver)
+		mConfig, err := getManifestConfig(
+			ctx, reqManifest.Config().Digest, storageRoot(regInfo.RootIdentifier, registry), c.StorageDriver,
+		)
 		if err != nil {
 			return nil, err
 		}
 		manifestDetailsList = append(manifestDetailsList, getManifestDetails(m, mConfig, downloadCount))
 	case *ocischema.DeserializedManifest:
-		mConfig, err := getManifestConfig(ctx, reqManifest.Config().Digest, regInfo.RootIdentifier, c.StorageDriver)
+		mConfig, err := getManifestConfig(
+			ctx, reqManifest.Config().Digest, storageRoot(regInfo.RootIdentifier, registry), c.StorageDriver,
+		)
 		if err != nil {
 			return nil, err
 		}
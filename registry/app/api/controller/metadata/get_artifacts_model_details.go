@@ -0,0 +1,166 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	modelmetadata "github.com/harness/gitness/registry/app/metadata/model"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) GetModelArtifactDetails(
+	ctx context.Context,
+	r artifact.GetModelArtifactDetailsRequestObject,
+) (artifact.GetModelArtifactDetailsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return artifact.GetModelArtifactDetails400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.GetModelArtifactDetails400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetModelArtifactDetails403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	image := string(r.Artifact)
+	version := string(r.Version)
+
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return getModelArtifactDetailsErrResponse(err)
+	}
+	if registry.PackageType != artifact.PackageTypeMODEL {
+		return artifact.GetModelArtifactDetails400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest,
+					fmt.Sprintf("registry %s isn't an ML model registry", registry.Name)),
+			),
+		}, nil
+	}
+
+	img, err := c.ImageStore.GetByName(ctx, registry.ID, image)
+	if err != nil {
+		return getModelArtifactDetailsErrResponse(err)
+	}
+	art, err := c.ArtifactStore.GetByName(ctx, img.ID, version)
+	if err != nil {
+		return getModelArtifactDetailsErrResponse(err)
+	}
+	var metadata database.ModelMetadata
+	if err = json.Unmarshal(art.Metadata, &metadata); err != nil {
+		return getModelArtifactDetailsErrResponse(err)
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "model")
+	return artifact.GetModelArtifactDetails200JSONResponse{
+		ModelArtifactDetailResponseJSONResponse: artifact.ModelArtifactDetailResponseJSONResponse{
+			Data:   GetModelArtifactDetails(img, art, metadata, registryURL),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetModelArtifactDetails describes a version of a model along with its files.
+func GetModelArtifactDetails(
+	image *types.Image,
+	art *types.Artifact,
+	metadata database.ModelMetadata,
+	registryURL string,
+) artifact.ModelArtifactDetail {
+	createdAt := GetTimeInMs(art.CreatedAt)
+	modifiedAt := GetTimeInMs(art.UpdatedAt)
+	var size int64
+	files := make([]artifact.ModelFile, 0, len(metadata.Files))
+	for _, file := range metadata.Files {
+		size += file.Size
+		fileCreatedAt := fmt.Sprint(file.CreatedAt)
+		modelFile := artifact.ModelFile{
+			Name:      file.Filename,
+			Size:      GetSize(file.Size),
+			SizeBytes: file.Size,
+			CreatedAt: &fileCreatedAt,
+		}
+		if format, ok := modelmetadata.Format(file.Filename); ok {
+			modelFile.Format = &format
+		}
+		files = append(files, modelFile)
+	}
+	sizeVal := GetSize(size)
+	pullCommand := GetModelArtifactFileDownloadCommand(registryURL, image.Name, art.Version, "<FILENAME>")
+	return artifact.ModelArtifactDetail{
+		Artifact:    image.Name,
+		Version:     art.Version,
+		PackageType: artifact.PackageTypeMODEL,
+		Url:         registryURL + "/" + image.Name + "/" + art.Version,
+		Size:        &sizeVal,
+		SizeBytes:   &size,
+		PullCommand: &pullCommand,
+		Framework:   optionalString(metadata.Framework),
+		Formats:     &metadata.Formats,
+		ModelCard:   optionalString(metadata.ModelCard),
+		Files:       files,
+		CreatedAt:   &createdAt,
+		ModifiedAt:  &modifiedAt,
+	}
+}
+
+func getModelArtifactDetailsErrResponse(err error) (artifact.GetModelArtifactDetailsResponseObject, error) {
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return artifact.GetModelArtifactDetails404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	}
+	return artifact.GetModelArtifactDetails500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetModelArtifactDetails(t *testing.T) {
+	metadata := database.ModelMetadata{
+		Files: []database.File{
+			{Filename: "README.md", Size: 100},
+			{Filename: "model.safetensors", Size: 2048},
+		},
+		FileCount: 2,
+		Framework: "pytorch",
+		Formats:   []string{"safetensors"},
+		ModelCard: "# Llama",
+	}
+	detail := GetModelArtifactDetails(&types.Image{Name: "llama"},
+		&types.Artifact{Version: "1.0.0", CreatedAt: time.Now(), UpdatedAt: time.Now()},
+		metadata, "https://example.com/pkg/root/ml/model")
+
+	assert.Equal(t, "llama", detail.Artifact)
+	assert.Equal(t, artifact.PackageTypeMODEL, detail.PackageType)
+	assert.Equal(t, "https://example.com/pkg/root/ml/model/llama/1.0.0", detail.Url)
+	assert.Equal(t, int64(2148), *detail.SizeBytes)
+	assert.Equal(t, "pytorch", *detail.Framework)
+	assert.Equal(t, "# Llama", *detail.ModelCard)
+	require.Len(t, detail.Files, 2)
+	assert.Nil(t, detail.Files[0].Format)
+	assert.Equal(t, "safetensors", *detail.Files[1].Format)
+}
@@ -96,7 +96,15 @@ func (c *APIController) GetArtifactSummary(
 			}, nil
 		}
 	}
+	artifacts := []types.ArtifactMetadata{*metadata}
+	if err = c.applyLatestVersionStrategy(ctx, registry, &artifacts); err != nil {
+		return artifact.GetArtifactSummary500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
 	return artifact.GetArtifactSummary200JSONResponse{
-		ArtifactSummaryResponseJSONResponse: *GetArtifactSummary(*metadata),
+		ArtifactSummaryResponseJSONResponse: *GetArtifactSummary(artifacts[0]),
 	}, nil
 }
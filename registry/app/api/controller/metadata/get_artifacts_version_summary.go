@@ -16,12 +16,14 @@ package metadata
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/types/enum"
 )
 
@@ -38,11 +40,48 @@ func (c *APIController) GetArtifactVersionSummary(
 		}, nil
 	}
 
+	response := GetArtifactVersionSummary(image, pkgType, version)
+	if pkgType == artifact.PackageTypeRPM {
+		metadata, err := c.getRpmMetadata(ctx, r)
+		if err != nil {
+			return artifact.GetArtifactVersionSummary500JSONResponse{
+				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+					*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+				),
+			}, nil
+		}
+		SetRpmVersionSummary(&response.Data, metadata)
+	}
 	return artifact.GetArtifactVersionSummary200JSONResponse{
-		ArtifactVersionSummaryResponseJSONResponse: *GetArtifactVersionSummary(image, pkgType, version),
+		ArtifactVersionSummaryResponseJSONResponse: *response,
 	}, nil
 }
 
+// getRpmMetadata returns the metadata of an rpm version, whose spec fields the summary includes. The
+// access to the registry is checked by FetchArtifactSummary before.
+func (c *APIController) getRpmMetadata(
+	ctx context.Context,
+	r artifact.GetArtifactVersionSummaryRequestObject,
+) (database.RpmMetadata, error) {
+	var metadata database.RpmMetadata
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return metadata, fmt.Errorf("failed to get registry request base info: %w", err)
+	}
+	img, err := c.ImageStore.GetByName(ctx, regInfo.RegistryID, string(r.Artifact))
+	if err != nil {
+		return metadata, err
+	}
+	art, err := c.ArtifactStore.GetByName(ctx, img.ID, string(r.Version))
+	if err != nil {
+		return metadata, err
+	}
+	if err := json.Unmarshal(art.Metadata, &metadata); err != nil {
+		return metadata, fmt.Errorf("failed to parse metadata of version %s: %w", art.Version, err)
+	}
+	return metadata, nil
+}
+
 // FetchArtifactSummary helper function for common logic.
 func (c *APIController) FetchArtifactSummary(
 	ctx context.Context,
@@ -16,6 +16,7 @@ package metadata
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -89,15 +90,18 @@ func (c *APIController) GetAllArtifactVersions(
 			image, regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm,
 		)
 
-		count, _ := c.TagStore.CountAllTagsByRepoAndImage(
-			ctx, regInfo.parentID, regInfo.RegistryIdentifier,
-			image, regInfo.searchTerm,
-		)
+		count, _ := c.countCache.Get(ctx, countKey("tags", registry.ID, image, regInfo.searchTerm),
+			isExactCount(r.Params.ExactCount), func(ctx context.Context) (int64, error) {
+				return c.TagStore.CountAllTagsByRepoAndImage(
+					ctx, regInfo.parentID, regInfo.RegistryIdentifier,
+					image, regInfo.searchTerm,
+				)
+			})
 
 		if err != nil {
 			return throw500Error(err)
 		}
-		err = setDigestCount(ctx, *tags)
+		err = setDigestCount(ctx, registry.PackageType, *tags)
 		if err != nil {
 			return throw500Error(err)
 		}
@@ -117,22 +121,76 @@ func (c *APIController) GetAllArtifactVersions(
 		return throw500Error(err)
 	}
 
-	cnt, _ := c.ArtifactStore.CountAllVersionsByRepoAndImage(
-		ctx, regInfo.parentID, regInfo.RegistryIdentifier,
-		image, regInfo.searchTerm,
-	)
+	cnt, _ := c.countCache.Get(ctx, countKey("versions", registry.ID, image, regInfo.searchTerm),
+		isExactCount(r.Params.ExactCount), func(ctx context.Context) (int64, error) {
+			return c.ArtifactStore.CountAllVersionsByRepoAndImage(
+				ctx, regInfo.parentID, regInfo.RegistryIdentifier,
+				image, regInfo.searchTerm,
+			)
+		})
 
+	registryURL := c.URLProvider.RegistryURL(ctx, regInfo.RootIdentifier, regInfo.RegistryIdentifier)
+	if registry.PackageType == artifact.PackageTypeNPM {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "npm")
+	} else if registry.PackageType == artifact.PackageTypePYTHON {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "python")
+	} else if registry.PackageType == artifact.PackageTypeNUGET {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "nuget")
+	} else if registry.PackageType == artifact.PackageTypeGEMS {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "rubygems")
+	} else if registry.PackageType == artifact.PackageTypeCRATE {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "cargo")
+	} else if registry.PackageType == artifact.PackageTypeGO {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "go")
+	} else if registry.PackageType == artifact.PackageTypeDEB {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "debian")
+	} else if registry.PackageType == artifact.PackageTypeRPM {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "rpm")
+	} else if registry.PackageType == artifact.PackageTypeALPINE {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "alpine")
+	} else if registry.PackageType == artifact.PackageTypeCONAN {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "conan")
+	} else if registry.PackageType == artifact.PackageTypeCOMPOSER {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "composer")
+	} else if registry.PackageType == artifact.PackageTypeCONDA {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "conda")
+	} else if registry.PackageType == artifact.PackageTypeTERRAFORM {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "terraform")
+	} else if registry.PackageType == artifact.PackageTypeSWIFT {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "swift")
+	} else if registry.PackageType == artifact.PackageTypeCOCOAPODS {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "cocoapods")
+	} else if registry.PackageType == artifact.PackageTypeHEX {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "hex")
+	} else if registry.PackageType == artifact.PackageTypePUB {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "pub")
+	} else if registry.PackageType == artifact.PackageTypeCRAN {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "cran")
+	} else if registry.PackageType == artifact.PackageTypeGALAXY {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "galaxy")
+	} else if registry.PackageType == artifact.PackageTypePUPPET {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "puppet")
+	} else if registry.PackageType == artifact.PackageTypeVAGRANT {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "vagrant")
+	} else if registry.PackageType == artifact.PackageTypeMODEL {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "model")
+	} else if registry.PackageType == artifact.PackageTypeHOMEBREW {
+		registryURL = c.URLProvider.PackageURL(ctx, regInfo.RootIdentifier+"/"+regInfo.RegistryIdentifier, "homebrew")
+	}
 	return artifact.GetAllArtifactVersions200JSONResponse{
 		ListArtifactVersionResponseJSONResponse: *GetNonOCIAllArtifactVersionResponse(
-			ctx, metadata, image, cnt, regInfo.pageNumber, regInfo.limit,
-			c.URLProvider.RegistryURL(ctx, regInfo.RootIdentifier, regInfo.RegistryIdentifier),
+			ctx, metadata, image, cnt, regInfo.pageNumber, regInfo.limit, registryURL,
 		),
 	}, nil
 }
 
-func setDigestCount(ctx context.Context, tags []types.TagMetadata) error {
+const unknownPlatform = "unknown"
+
+// setDigestCount sets the number of digests and the platforms available for each tag. Platforms of
+// manifest lists are read from the list itself, for single manifests from the persisted image config.
+func setDigestCount(ctx context.Context, packageType artifact.PackageType, tags []types.TagMetadata) error {
 	for i := range tags {
-		err := setDigestCountInTagMetadata(ctx, &tags[i])
+		err := setDigestCountInTagMetadata(ctx, packageType, &tags[i])
 		if err != nil {
 			return err
 		}
@@ -140,7 +198,7 @@ func setDigestCount(ctx context.Context, tags []types.TagMetadata) error {
 	return nil
 }
 
-func setDigestCountInTagMetadata(ctx context.Context, t *types.TagMetadata) error {
+func setDigestCountInTagMetadata(ctx context.Context, packageType artifact.PackageType, t *types.TagMetadata) error {
 	m := types.Manifest{
 		SchemaVersion: t.SchemaVersion,
 		MediaType:     t.MediaType,
@@ -155,8 +213,10 @@ func setDigestCountInTagMetadata(ctx context.Context, t *types.TagMetadata) erro
 	switch reqManifest := manifest.(type) {
 	case *s2.DeserializedManifest, *os.DeserializedManifest:
 		t.DigestCount = 1
+		t.Platforms = getConfigPlatforms(ctx, packageType, t.ConfigPayload)
 	case *ml.DeserializedManifestList:
 		t.DigestCount = len(reqManifest.Manifests)
+		t.Platforms = getManifestListPlatforms(reqManifest)
 	default:
 		err = fmt.Errorf("unknown manifest type: %T", manifest)
 		log.Ctx(ctx).Error().Stack().Err(err).Msg("Failed to set digest count")
@@ -164,6 +224,47 @@ func setDigestCountInTagMetadata(ctx context.Context, t *types.TagMetadata) erro
 	return nil
 }
 
+// getConfigPlatforms returns the platform of an image from its config. The config is not stored with
+// the manifest if it exceeds the size limit, and the platform is then unknown.
+func getConfigPlatforms(ctx context.Context, packageType artifact.PackageType, configPayload types.Payload) []string {
+	if packageType != artifact.PackageTypeDOCKER || len(configPayload) == 0 {
+		return nil
+	}
+	var mConfig manifestConfig
+	if err := json.Unmarshal(configPayload, &mConfig); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Failed to unmarshal image config for platforms")
+		return nil
+	}
+	if mConfig.Os == "" || mConfig.Arch == "" {
+		return []string{}
+	}
+	return []string{formatPlatform(mConfig.Os, mConfig.Arch, mConfig.Variant)}
+}
+
+func getManifestListPlatforms(manifestList *ml.DeserializedManifestList) []string {
+	platforms := []string{}
+	seen := make(map[string]bool)
+	for _, m := range manifestList.Manifests {
+		if m.Platform.OS == "" || m.Platform.OS == unknownPlatform || m.Platform.Architecture == "" {
+			// attestation manifests are added to indexes with an unknown platform
+			continue
+		}
+		platform := formatPlatform(m.Platform.OS, m.Platform.Architecture, m.Platform.Variant)
+		if !seen[platform] {
+			seen[platform] = true
+			platforms = append(platforms, platform)
+		}
+	}
+	return platforms
+}
+
+func formatPlatform(osName, arch, variant string) string {
+	if variant == "" {
+		return osName + "/" + arch
+	}
+	return osName + "/" + arch + "/" + variant
+}
+
 func throw500Error(err error) (artifact.GetAllArtifactVersionsResponseObject, error) {
 	wrappedErr := fmt.Errorf("internal server error: %w", err)
 	return artifact.GetAllArtifactVersions500JSONResponse{
@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/manifest"
+	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/manifest/schema2"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testManifestList(t *testing.T, platforms ...manifestlist.PlatformSpec) *manifestlist.DeserializedManifestList {
+	t.Helper()
+	descriptors := make([]manifestlist.ManifestDescriptor, len(platforms))
+	for i, platform := range platforms {
+		descriptors[i] = manifestlist.ManifestDescriptor{
+			Descriptor: manifest.Descriptor{
+				MediaType: schema2.MediaTypeManifest,
+				Digest:    digest.FromString(platform.OS + platform.Architecture + platform.Variant),
+				Size:      100,
+			},
+			Platform: platform,
+		}
+	}
+	list, err := manifestlist.FromDescriptors(descriptors)
+	require.NoError(t, err)
+	return list
+}
+
+func TestGetManifestListPlatforms(t *testing.T) {
+	list := testManifestList(t,
+		manifestlist.PlatformSpec{OS: "linux", Architecture: "amd64"},
+		manifestlist.PlatformSpec{OS: "linux", Architecture: "arm64", Variant: "v8"},
+		manifestlist.PlatformSpec{OS: "linux", Architecture: "amd64"},
+		manifestlist.PlatformSpec{OS: "unknown", Architecture: "unknown"},
+		manifestlist.PlatformSpec{OS: "windows"},
+	)
+	assert.Equal(t, []string{"linux/amd64", "linux/arm64/v8"}, getManifestListPlatforms(list))
+
+	assert.Empty(t, getManifestListPlatforms(testManifestList(t)))
+}
+
+func testTagMetadata(t *testing.T, m manifest.Manifest, configPayload string) types.TagMetadata {
+	t.Helper()
+	mediaType, payload, err := m.Payload()
+	require.NoError(t, err)
+	tag := types.TagMetadata{
+		Name:          "latest",
+		SchemaVersion: 2,
+		MediaType:     mediaType,
+		Payload:       payload,
+	}
+	if configPayload != "" {
+		tag.ConfigPayload = types.Payload(configPayload)
+	}
+	return tag
+}
+
+func TestSetDigestCount(t *testing.T) {
+	ctx := context.Background()
+	single, err := schema2.FromStruct(schema2.Manifest{
+		Versioned: schema2.SchemaVersion,
+		Config: manifest.Descriptor{
+			MediaType: schema2.MediaTypeImageConfig,
+			Digest:    digest.FromString("config"),
+			Size:      50,
+		},
+	})
+	require.NoError(t, err)
+
+	tags := []types.TagMetadata{
+		testTagMetadata(t, single, `{"os":"linux","architecture":"arm64","variant":"v8"}`),
+		testTagMetadata(t, single, `{"rootfs":{"type":"layers"}}`),
+		testTagMetadata(t, single, ""),
+		testTagMetadata(t, testManifestList(t,
+			manifestlist.PlatformSpec{OS: "linux", Architecture: "amd64"},
+			manifestlist.PlatformSpec{OS: "linux", Architecture: "arm", Variant: "v7"},
+		), ""),
+	}
+	require.NoError(t, setDigestCount(ctx, artifact.PackageTypeDOCKER, tags))
+
+	assert.Equal(t, 1, tags[0].DigestCount)
+	assert.Equal(t, []string{"linux/arm64/v8"}, tags[0].Platforms, "the platform is read from the stored config")
+	assert.Equal(t, []string{}, tags[1].Platforms)
+	assert.Nil(t, tags[2].Platforms, "the platform of a config that isn't stored is unknown")
+	assert.Equal(t, 2, tags[3].DigestCount)
+	assert.Equal(t, []string{"linux/amd64", "linux/arm/v7"}, tags[3].Platforms)
+
+	helmTags := []types.TagMetadata{testTagMetadata(t, single, `{"name":"chart","version":"1.0.0"}`)}
+	require.NoError(t, setDigestCount(ctx, artifact.PackageTypeHELM, helmTags))
+	assert.Equal(t, 1, helmTags[0].DigestCount)
+	assert.Nil(t, helmTags[0].Platforms)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/harness/gitness/app/paths"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/common"
+	"github.com/harness/gitness/registry/app/metadata/conan"
 	"github.com/harness/gitness/types/enum"
 )
 
@@ -104,6 +105,50 @@ func (c *APIController) GenerateClientSetupDetails(
 		return c.generateGenericClientSetupDetail(ctx, blankString, registryRef, image, tag)
 	case string(artifact.PackageTypePYTHON):
 		return c.generatePythonClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeNPM):
+		return c.generateNpmClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeNUGET):
+		return c.generateNugetClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeGEMS):
+		return c.generateGemsClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeCRATE):
+		return c.generateCrateClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeGO):
+		return c.generateGoModuleClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeDEB):
+		return c.generateDebClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeRPM):
+		return c.generateRpmClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeALPINE):
+		return c.generateAlpineClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeCONAN):
+		return c.generateConanClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeCOMPOSER):
+		return c.generateComposerClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeCONDA):
+		return c.generateCondaClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeTERRAFORM):
+		return c.generateTerraformClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeSWIFT):
+		return c.generateSwiftClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeCOCOAPODS):
+		return c.generateCocoapodsClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeHEX):
+		return c.generateHexClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypePUB):
+		return c.generatePubClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeCRAN):
+		return c.generateCranClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeGALAXY):
+		return c.generateGalaxyClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypePUPPET):
+		return c.generatePuppetClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeVAGRANT):
+		return c.generateVagrantClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeMODEL):
+		return c.generateModelClientSetupDetail(ctx, registryRef, username, image, tag)
+	case string(artifact.PackageTypeHOMEBREW):
+		return c.generateHomebrewClientSetupDetail(ctx, registryRef, username, image, tag)
 	}
 	header1 := "Login to Docker"
 	section1step1Header := "Run this Docker command in your terminal to authenticate the client."
@@ -253,12 +298,23 @@ func (c *APIController) generateGenericClientSetupDetail(
 		{Label: &blankString, Value: &pullValue},
 	}
 	section3step1Type := artifact.ClientSetupStepTypeStatic
+	section3step2Header := "Or download the file of the latest version of the artifact."
+	latestPullValue := "curl --location '<HOSTNAME>/<REGISTRY_NAME>/<ARTIFACT_NAME>/latest/<FILENAME>' " +
+		"--header 'x-api-key: <API_KEY>' -J -O"
+	section3step2Commands := []artifact.ClientSetupStepCommand{
+		{Label: &blankString, Value: &latestPullValue},
+	}
 	section3steps := []artifact.ClientSetupStep{
 		{
 			Header:   &section3step1Header,
 			Commands: &section3step1Commands,
 			Type:     &section3step1Type,
 		},
+		{
+			Header:   &section3step2Header,
+			Commands: &section3step2Commands,
+			Type:     &section3step1Type,
+		},
 	}
 	section3 := artifact.ClientSetupSection{
 		Header: &header3,
@@ -805,6 +861,2472 @@ func (c *APIController) generatePythonClientSetupDetail(
 	}
 }
 
+func (c *APIController) generateNpmClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Authentication section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Authentication"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Create or update the .npmrc file of your project with the following content:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("registry=<REGISTRY_URL>/\n" +
+							"<UPLOAD_URL>:_authToken=*see step 2*"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Publish your package from the directory of its package.json:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("npm publish"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Install a package using npm:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("npm install <ARTIFACT_NAME>@<VERSION>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "npm Client Setup",
+		SecHeader:  "Follow these instructions to install/use npm packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "npm")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeNPM))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateNugetClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Authentication section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Authentication"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the registry as a package source:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("dotnet nuget add source <REGISTRY_URL>/index.json --name <REGISTRY_NAME>" +
+							" --username <USERNAME> --password *see step 2* --store-password-in-clear-text"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Push a package built with dotnet pack:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("dotnet nuget push <ARTIFACT_NAME>.<VERSION>.nupkg --source <REGISTRY_NAME>" +
+							" --api-key *see step 2*"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add a package to your project:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("dotnet add package <ARTIFACT_NAME> --version <VERSION> --source <REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Chocolatey section, choco only speaks the V2 protocol
+	section4 := artifact.ClientSetupSection{
+		Header: stringPtr("Use with Chocolatey"),
+	}
+	_ = section4.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the V2 feed of the registry as a Chocolatey source:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("choco source add --name <REGISTRY_NAME> --source <REGISTRY_URL>/v2/" +
+							" --user <USERNAME> --password *see step 2*"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Push a package built with choco pack:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("choco push <ARTIFACT_NAME>.<VERSION>.nupkg --source <REGISTRY_URL>/v2/" +
+							" --api-key *see step 2*"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Install a package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("choco install <ARTIFACT_NAME> --version <VERSION> --source <REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "NuGet Client Setup",
+		SecHeader:  "Follow these instructions to install/use NuGet packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+			section4,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "nuget")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeNUGET))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateGemsClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Authentication section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Authentication"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the token to ~/.gem/credentials to push gems:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("---\n<REGISTRY_URL>: *see step 1*"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Configure bundler to install gems:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("bundle config set --global <REGISTRY_URL> <USERNAME>:*see step 1*"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Push a gem built with gem build:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("gem push --host <REGISTRY_URL> <ARTIFACT_NAME>-<VERSION>.gem"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add a gem to your Gemfile and install it:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("bundle add <ARTIFACT_NAME> --version <VERSION> --source <REGISTRY_URL>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "RubyGems Client Setup",
+		SecHeader:  "Follow these instructions to install/use gems from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "rubygems")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeGEMS))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateCrateClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Authentication section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Authentication"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the registry to ~/.cargo/config.toml:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("[registries.<REGISTRY_NAME>]\nindex = \"sparse+<REGISTRY_URL>/index/\""),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Log in to the registry with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("cargo login --registry <REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Publish the crate of the current project:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("cargo publish --registry <REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add a crate to the dependencies of your project:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("cargo add <ARTIFACT_NAME>@<VERSION> --registry <REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Cargo Client Setup",
+		SecHeader:  "Follow these instructions to install/use crates from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "cargo")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeCRATE))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateGoModuleClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Authentication section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Authentication"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the token to ~/.netrc, which the go command reads for proxy credentials:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("machine <LOGIN_HOSTNAME>\nlogin <USERNAME>\npassword *see step 1*"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Use the registry as the module proxy:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("go env -w GOPROXY=<REGISTRY_URL>,https://proxy.golang.org,direct"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload the module zip of a version, with files under <ARTIFACT_NAME>@<VERSION>/:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --request PUT '<REGISTRY_URL>/<ARTIFACT_NAME>/@v/<VERSION>.zip' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>' --data-binary @<VERSION>.zip"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add a module to the go.mod of your project:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("GONOSUMDB=<ARTIFACT_NAME> go get <ARTIFACT_NAME>@<VERSION>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Go Client Setup",
+		SecHeader:  "Follow these instructions to install/use go modules from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "go")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeGO))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateDebClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Authentication section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Authentication"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the token to the apt credentials:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("echo 'machine <LOGIN_HOSTNAME> login <USERNAME> password *see step 1*' | " +
+							"sudo tee /etc/apt/auth.conf.d/<REGISTRY_NAME>.conf"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Download the key the repository is signed with:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location '<REGISTRY_URL>/key' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>' | " +
+							"sudo tee /etc/apt/keyrings/<REGISTRY_NAME>.asc"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Add the repository to the apt sources, with a distribution and component:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("echo 'deb [signed-by=/etc/apt/keyrings/<REGISTRY_NAME>.asc] <REGISTRY_URL> " +
+							"<DISTRIBUTION> <COMPONENT>' | sudo tee /etc/apt/sources.list.d/<REGISTRY_NAME>.list"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload a .deb to a component of a distribution:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --upload-file <FILE>.deb " +
+							"'<REGISTRY_URL>/upload/<DISTRIBUTION>/<COMPONENT>' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Update the package lists and install a package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("sudo apt update && sudo apt install <ARTIFACT_NAME>=<VERSION>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Debian Client Setup",
+		SecHeader:  "Follow these instructions to install/use debian packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "debian")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeDEB))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateRpmClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Repository section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Repository"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the repository to yum and dnf, with the token as the password:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("printf '[<REGISTRY_NAME>]\\nname=<REGISTRY_NAME>\\nbaseurl=<REGISTRY_URL>\\n" +
+							"enabled=1\\ngpgcheck=0\\nusername=<USERNAME>\\npassword=*see step 1*\\n' | " +
+							"sudo tee /etc/yum.repos.d/<REGISTRY_NAME>.repo"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload a binary package, the repodata is updated along with it:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --upload-file <FILE>.rpm '<REGISTRY_URL>/upload' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Refresh the repository metadata and install a package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("sudo dnf makecache --repo <REGISTRY_NAME> && " +
+							"sudo dnf install <ARTIFACT_NAME>-<VERSION>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "RPM Client Setup",
+		SecHeader:  "Follow these instructions to install/use rpm packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "rpm")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeRPM))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateAlpineClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Repository section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Repository"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Install the key the indices are signed with:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("sudo curl --location --remote-name --remote-header-name " +
+							"--output-dir /etc/apk/keys '<REGISTRY_URL>/key' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Add the repository to apk, replacing identity-token with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("echo '<UPLOAD_URL>' | sudo tee -a /etc/apk/repositories"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload a package, the index of its architecture is updated along with it:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --upload-file <FILE>.apk '<REGISTRY_URL>/upload' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Refresh the indices and install a package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("sudo apk update && sudo apk add <ARTIFACT_NAME>=<VERSION>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Alpine Client Setup",
+		SecHeader:  "Follow these instructions to install/use alpine packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "alpine")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeALPINE))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateConanClientSetupDetail adds the registry as a remote named after it. Recipes stored with
+// a user and channel don't have their reference as name, so the reference is spelled out.
+func (c *APIController) generateConanClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	reference := "<ARTIFACT_NAME>/<VERSION>"
+	if image != nil && tag != nil {
+		if ref, err := conan.ParseImageName(string(*image), string(*tag)); err == nil {
+			reference = ref.String()
+		}
+	}
+
+	// Remote section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Remote"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the registry as a remote:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("conan remote add <REGISTRY_NAME> <REGISTRY_URL>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Log in to the remote with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("conan remote login <REGISTRY_NAME> <USERNAME> -p <IDENTITY_TOKEN>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload a recipe along with its binary packages:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("conan upload '" + reference + "' --remote=<REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Install a package, along with its requirements:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("conan install --requires=" + reference + " --remote=<REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Conan Client Setup",
+		SecHeader:  "Follow these instructions to install/use conan packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "conan")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeCONAN))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateComposerClientSetupDetail adds the registry to the repositories of the project, Composer
+// authenticates to it with the credentials stored for its host.
+func (c *APIController) generateComposerClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Repository section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Repository"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the registry to the repositories of your project:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("composer config repositories.<REGISTRY_NAME> composer <REGISTRY_URL>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Store the token for the host of the registry:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("composer config --global http-basic.<LOGIN_HOSTNAME> " +
+							"<USERNAME> <IDENTITY_TOKEN>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Archive your package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("composer archive --format=zip --file=package"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Upload the archive, the version can be left out if composer.json has one:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --request PUT --user <USERNAME>:<IDENTITY_TOKEN> " +
+							"--upload-file package.zip '<REGISTRY_URL>/upload?version=<VERSION>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Require a package in your project:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("composer require <ARTIFACT_NAME>:<VERSION>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Composer Client Setup",
+		SecHeader:  "Follow these instructions to install/use composer packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "composer")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeCOMPOSER))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+func (c *APIController) generateCondaClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Channel section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Channel"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the registry to the channels, replacing identity-token with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("conda config --add channels <UPLOAD_URL>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload a .conda or .tar.bz2 package, it's served from the subdir it was built for:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --upload-file <FILE>.conda '<REGISTRY_URL>/upload' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Install a package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("conda install <ARTIFACT_NAME>==<VERSION>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Conda Client Setup",
+		SecHeader:  "Follow these instructions to install/use conda packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "conda")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeCONDA))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateTerraformClientSetupDetail points the host of the registry to the registry in the CLI
+// configuration, as Terraform discovers the module and provider registries of a host at its root.
+func (c *APIController) generateTerraformClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Terraform"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the registry to ~/.terraformrc, replacing identity-token with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("host \"<LOGIN_HOSTNAME>\" {\n" +
+							"  services = {\n" +
+							"    \"modules.v1\"   = \"<REGISTRY_URL>/v1/modules/\"\n" +
+							"    \"providers.v1\" = \"<REGISTRY_URL>/v1/providers/\"\n" +
+							"  }\n" +
+							"}\n" +
+							"credentials \"<LOGIN_HOSTNAME>\" {\n" +
+							"  token = \"identity-token\"\n" +
+							"}"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Add the token to ~/.netrc, which module and provider archives are downloaded with:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("machine <LOGIN_HOSTNAME> login <USERNAME> password identity-token"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Module"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Archive the module, with its .tf files at the top of the archive:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("tar -czf module.tar.gz -C <MODULE_DIR> ."),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Upload the archive as a version of the module:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --upload-file module.tar.gz " +
+							"'<REGISTRY_URL>/v1/modules/<NAMESPACE>/<NAME>/<SYSTEM>/<VERSION>' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Use section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Use Module"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the module to your configuration:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("module \"<NAME>\" {\n" +
+							"  source  = \"<LOGIN_HOSTNAME>/<ARTIFACT_NAME>\"\n" +
+							"  version = \"<VERSION>\"\n" +
+							"}"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Install the module:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("terraform init"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish provider section
+	section4 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Provider"),
+	}
+	_ = section4.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Build and sign the release, e.g. with the goreleaser configuration of the " +
+					"provider scaffolding, and export the public key it's signed with:"),
+				Type: &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("goreleaser release --clean --skip=publish\n" +
+							"gpg --armor --export <GPG_FINGERPRINT> > signing-key.asc"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Upload the archives, SHA256SUMS, its signature and the manifest of the release:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --request PUT " +
+							"'<REGISTRY_URL>/v1/providers/<NAMESPACE>/<TYPE>/<VERSION>' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>' " +
+							"--form 'signing_key=<signing-key.asc' " +
+							"$(for f in dist/terraform-provider-<TYPE>_<VERSION>_*; " +
+							"do echo --form \"file=@$f\"; done)"),
+					},
+				},
+			},
+		},
+	})
+
+	// Use provider section
+	section5 := artifact.ClientSetupSection{
+		Header: stringPtr("Use Provider"),
+	}
+	_ = section5.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Require the provider in your configuration:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("terraform {\n" +
+							"  required_providers {\n" +
+							"    <TYPE> = {\n" +
+							"      source  = \"<LOGIN_HOSTNAME>/<NAMESPACE>/<TYPE>\"\n" +
+							"      version = \"<VERSION>\"\n" +
+							"    }\n" +
+							"  }\n" +
+							"}"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Install the provider, with Terraform or OpenTofu:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("terraform init"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Terraform Client Setup",
+		SecHeader:  "Follow these instructions to install/use terraform modules and providers from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+			section4,
+			section5,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "terraform")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeTERRAFORM))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateSwiftClientSetupDetail sets the registry as the default registry of SwiftPM, which
+// resolves the dependencies declared by package identifier from it.
+func (c *APIController) generateSwiftClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure SwiftPM"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Set the registry as the default registry of the package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("swift package-registry set <REGISTRY_URL>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Log in to the registry, replacing identity-token with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("swift package-registry login <REGISTRY_URL> --token identity-token"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Publish a release of the package from its directory, " +
+					"optionally with package-metadata.json next to Package.swift:"),
+				Type: &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("swift package-registry publish <SCOPE>.<NAME> <VERSION> " +
+							"--url <REGISTRY_URL>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Use section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Use Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the package to the dependencies in your Package.swift:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr(".package(id: \"<ARTIFACT_NAME>\", exact: \"<VERSION>\")"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Resolve the dependencies:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("swift package resolve"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Swift Client Setup",
+		SecHeader:  "Follow these instructions to install/use swift packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "swift")
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeSWIFT))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateCocoapodsClientSetupDetail adds the registry as a CDN source of CocoaPods, which reads the
+// credentials of the registry from ~/.netrc.
+func (c *APIController) generateCocoapodsClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "cocoapods")
+	registryHost := registryURL
+	if regURL, err := url.Parse(registryURL); err == nil && regURL.Host != "" {
+		registryHost = regURL.Hostname()
+	}
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure CocoaPods"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the credentials to your ~/.netrc, replacing identity-token with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("machine " + registryHost + "\nlogin <USERNAME>\npassword identity-token"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Add the registry as a source:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("pod repo add-cdn <REGISTRY_NAME> <REGISTRY_URL>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Push section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Push Pod"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Convert the podspec of the pod to JSON:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("pod ipc spec <ARTIFACT_NAME>.podspec > <ARTIFACT_NAME>.podspec.json"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Push the podspec with an archive of the sources of the pod:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --request PUT '<REGISTRY_URL>/pods' \\\n" +
+							"--form 'podspec=@\"<ARTIFACT_NAME>.podspec.json\"' \\\n" +
+							"--form 'archive=@\"<ARTIFACT_NAME>-<VERSION>.zip\"' \\\n" +
+							"--user <USERNAME>:identity-token"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Pod"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the source and the pod to your Podfile:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("source '<REGISTRY_URL>'\n\npod '<ARTIFACT_NAME>', '<VERSION>'"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Install the pods:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("pod install"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "CocoaPods Client Setup",
+		SecHeader:  "Follow these instructions to install/use pods from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeCOCOAPODS))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateHexClientSetupDetail adds the registry as a repository of Hex, which sends the
+// identity token as the auth key of the repository.
+func (c *APIController) generateHexClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "hex")
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Hex"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Download the public key the resources of the registry are signed with:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location '<REGISTRY_URL>/public_key' " +
+							"--user <USERNAME>:identity-token -o <REGISTRY_NAME>.pem"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Add the registry as a repository, replacing identity-token with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("mix hex.repo add <REGISTRY_NAME> <REGISTRY_URL> " +
+							"--public-key <REGISTRY_NAME>.pem --auth-key identity-token"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Publish the package and its documentation to the API of the registry:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("HEX_API_URL=<REGISTRY_URL>/api HEX_API_KEY=identity-token mix hex.publish"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the package to the dependencies in your mix.exs:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("{:<ARTIFACT_NAME>, \"<VERSION>\", repo: \"<REGISTRY_NAME>\"}"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Fetch the dependencies:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("mix deps.get"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Hex Client Setup",
+		SecHeader:  "Follow these instructions to install/use Hex packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeHEX))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generatePubClientSetupDetail adds the identity token for the registry to dart pub, which sends
+// it as a bearer token to the hosted repository.
+func (c *APIController) generatePubClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "pub")
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure dart pub"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the token for the registry, pasting it when prompted:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("dart pub token add <REGISTRY_URL>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Set the registry to publish to in your pubspec.yaml:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("publish_to: <REGISTRY_URL>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Publish the package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("dart pub publish"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the package to the dependencies in your pubspec.yaml:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("dependencies:\n  <ARTIFACT_NAME>:\n    hosted: <REGISTRY_URL>\n" +
+							"    version: <VERSION>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Or add it with dart pub:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("dart pub add <ARTIFACT_NAME>:<VERSION> --hosted-url <REGISTRY_URL>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Dart Client Setup",
+		SecHeader:  "Follow these instructions to install/use Dart and Flutter packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypePUB))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateCranClientSetupDetail adds the registry to the repositories of R, which sends the
+// identity token as the password of the credentials in the URL of the repository.
+func (c *APIController) generateCranClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "cran")
+	authURL := strings.Replace(registryURL, "://", "://<USERNAME>:identity-token@", 1)
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure R"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the registry to the repositories of R, with the token as identity-token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("options(repos = c(\"<REGISTRY_NAME>\" = \"" + authURL +
+							"\", getOption(\"repos\")))"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Package"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Build the source package and upload it to the source tree:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("R CMD build <ARTIFACT_NAME>"),
+					},
+					{
+						Value: stringPtr("curl --location --upload-file <ARTIFACT_NAME>_<VERSION>.tar.gz " +
+							"'<REGISTRY_URL>/upload/src/contrib' --header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Upload a binary package to the tree of its platform and version of R:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location --upload-file <ARTIFACT_NAME>_<VERSION>.zip " +
+							"'<REGISTRY_URL>/upload/bin/windows/contrib/<R_VERSION>' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Package"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Install the package:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("install.packages(\"<ARTIFACT_NAME>\")"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "R Client Setup",
+		SecHeader:  "Follow these instructions to install/use R packages from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeCRAN))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateGalaxyClientSetupDetail adds the registry to the Galaxy servers of ansible.cfg, which
+// ansible-galaxy sends the identity token of the server to.
+func (c *APIController) generateGalaxyClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "galaxy")
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure ansible-galaxy"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the registry to the Galaxy servers in your ansible.cfg, with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("[galaxy]\nserver_list = <REGISTRY_NAME>\n\n" +
+							"[galaxy_server.<REGISTRY_NAME>]\nurl = <REGISTRY_URL>/\ntoken = <IDENTITY_TOKEN>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Collection"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Build the collection and publish the archive it creates:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("ansible-galaxy collection build"),
+					},
+					{
+						Value: stringPtr("ansible-galaxy collection publish <NAMESPACE>-<NAME>-<VERSION>.tar.gz " +
+							"--server <REGISTRY_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Collection"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Install the collection:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("ansible-galaxy collection install <ARTIFACT_NAME>:<VERSION>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Or add it to your requirements.yml:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("collections:\n  - name: <ARTIFACT_NAME>\n    version: <VERSION>\n" +
+							"    source: <REGISTRY_URL>/"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Ansible Galaxy Client Setup",
+		SecHeader:  "Follow these instructions to install/use Ansible collections from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeGALAXY))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generatePuppetClientSetupDetail configures puppet and r10k to resolve modules from the registry,
+// authenticating with the token as a Forge API key.
+func (c *APIController) generatePuppetClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "puppet")
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure puppet"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Add the registry as the module repository in your puppet.conf, with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("[main]\nmodule_repository = <REGISTRY_URL>\n" +
+							"forge_authorization = Bearer <IDENTITY_TOKEN>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Publish section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Publish Module"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Build the module and publish the archive it creates:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("pdk build"),
+					},
+					{
+						Value: stringPtr("pdk release publish --forge-upload-url <REGISTRY_URL>/v3/releases " +
+							"--forge-token <IDENTITY_TOKEN>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Module"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Install the module:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("puppet module install <ARTIFACT_NAME> --version <VERSION>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Or deploy it with r10k, configuring the registry as the Forge in r10k.yaml:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("forge:\n  baseurl: '<REGISTRY_URL>'\n" +
+							"  authorization_token: 'Bearer <IDENTITY_TOKEN>'"),
+					},
+					{
+						Value: stringPtr("mod '<ARTIFACT_NAME>', '<VERSION>'"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Puppet Client Setup",
+		SecHeader:  "Follow these instructions to install/use Puppet modules from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypePUPPET))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateVagrantClientSetupDetail configures vagrant to resolve boxes from the registry, which it
+// authenticates to with the token as a Vagrant Cloud token.
+func (c *APIController) generateVagrantClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "vagrant")
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure vagrant"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Resolve boxes from the registry, authenticating with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("export VAGRANT_SERVER_URL=<REGISTRY_URL>"),
+					},
+					{
+						Value: stringPtr("export VAGRANT_CLOUD_TOKEN=<IDENTITY_TOKEN>"),
+					},
+				},
+			},
+		},
+	})
+
+	// Upload section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Upload Box"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload the box of a version for its provider, and its architecture if any:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --request PUT --upload-file <FILE> " +
+							"'<REGISTRY_URL>/<ARTIFACT_NAME>/<VERSION>/virtualbox?architecture=amd64' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Use Box"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Add the box:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("vagrant box add <ARTIFACT_NAME> --box-version <VERSION>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Or use it in your Vagrantfile:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("config.vm.box = \"<ARTIFACT_NAME>\"\n" +
+							"config.vm.box_version = \"<VERSION>\""),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Vagrant Client Setup",
+		SecHeader:  "Follow these instructions to install/use Vagrant boxes from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeVAGRANT))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateModelClientSetupDetail uploads the files of a model with curl, in chunks for files too
+// large for a single request.
+func (c *APIController) generateModelClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "model")
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure Authentication"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+		},
+	})
+
+	// Upload section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Upload Model"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Upload a file of the version, such as its weights or README.md model card:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --request PUT --upload-file <FILE> " +
+							"'<REGISTRY_URL>/<ARTIFACT_NAME>/<VERSION>/files/<FILENAME>?framework=pytorch' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Or upload a large file in chunks, starting an upload at the Location returned:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --request POST --include " +
+							"'<REGISTRY_URL>/<ARTIFACT_NAME>/<VERSION>/files/<FILENAME>/uploads' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Append each chunk at the Upload-Offset of the upload, resuming it if interrupted:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --request PATCH --data-binary @<CHUNK> '<UPLOAD_URL>' " +
+							"--header 'Upload-Offset: <OFFSET>' --header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Complete the upload:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --request PUT '<UPLOAD_URL>' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Download section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Download Model"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Download a file of the version:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --location " +
+							"'<REGISTRY_URL>/<ARTIFACT_NAME>/<VERSION>/files/<FILENAME>' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>' -o <FILENAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "ML Model Client Setup",
+		SecHeader:  "Follow these instructions to upload/download ML models from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeMODEL))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
+// generateHomebrewClientSetupDetail fills a local tap with the formulae of the registry, which brew
+// downloads the bottles of with curl, authenticating with the credentials of the .curlrc.
+func (c *APIController) generateHomebrewClientSetupDetail(
+	ctx context.Context,
+	registryRef string,
+	username string,
+	image *artifact.ArtifactParam,
+	tag *artifact.VersionParam,
+) *artifact.ClientSetupDetailsResponseJSONResponse {
+	staticStepType := artifact.ClientSetupStepTypeStatic
+	generateTokenType := artifact.ClientSetupStepTypeGenerateToken
+
+	registryURL := c.URLProvider.PackageURL(ctx, registryRef, "homebrew")
+	tap := HomebrewTap(registryURL)
+	fillTap := "curl --location '<REGISTRY_URL>/tap.tar.gz' --header 'Authorization: Bearer <IDENTITY_TOKEN>' " +
+		"| tar -xz -C \"$(brew --repository " + tap + ")\""
+
+	// Configure section
+	section1 := artifact.ClientSetupSection{
+		Header: stringPtr("Configure brew"),
+	}
+	_ = section1.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Generate an identity token for authentication"),
+				Type:   &generateTokenType,
+			},
+			{
+				Header: stringPtr("Download bottles from the registry, authenticating with the token:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("echo 'user = \"<USERNAME>:<IDENTITY_TOKEN>\"' >> ~/.curlrc"),
+					},
+					{
+						Value: stringPtr("export HOMEBREW_CURLRC=1"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Create a tap for the registry and fill it with its formulae:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("brew tap-new --no-git " + tap),
+					},
+					{
+						Value: stringPtr(fillTap),
+					},
+				},
+			},
+		},
+	})
+
+	// Upload section
+	section2 := artifact.ClientSetupSection{
+		Header: stringPtr("Upload Bottle"),
+	}
+	_ = section2.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Build a bottle of the formula:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("brew install --build-bottle <ARTIFACT_NAME>"),
+					},
+					{
+						Value: stringPtr("brew bottle <ARTIFACT_NAME>"),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Upload the bottle with the cellar it was built for:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("curl --request PUT --upload-file <FILE> " +
+							"'<REGISTRY_URL>/bottles/<FILE>?cellar=any' " +
+							"--header 'Authorization: Bearer <IDENTITY_TOKEN>'"),
+					},
+				},
+			},
+		},
+	})
+
+	// Install section
+	section3 := artifact.ClientSetupSection{
+		Header: stringPtr("Install Formula"),
+	}
+	_ = section3.FromClientSetupStepConfig(artifact.ClientSetupStepConfig{
+		Steps: &[]artifact.ClientSetupStep{
+			{
+				Header: stringPtr("Refresh the formulae of the tap:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr(fillTap),
+					},
+				},
+			},
+			{
+				Header: stringPtr("Install the formula:"),
+				Type:   &staticStepType,
+				Commands: &[]artifact.ClientSetupStepCommand{
+					{
+						Value: stringPtr("brew install " + tap + "/<ARTIFACT_NAME>"),
+					},
+				},
+			},
+		},
+	})
+
+	clientSetupDetails := artifact.ClientSetupDetails{
+		MainHeader: "Homebrew Client Setup",
+		SecHeader:  "Follow these instructions to install/use Homebrew formulae from this registry.",
+		Sections: []artifact.ClientSetupSection{
+			section1,
+			section2,
+			section3,
+		},
+	}
+
+	c.replacePlaceholders(ctx, &clientSetupDetails.Sections, username, registryRef, image, tag, registryURL, "",
+		string(artifact.PackageTypeHOMEBREW))
+
+	return &artifact.ClientSetupDetailsResponseJSONResponse{
+		Data:   clientSetupDetails,
+		Status: artifact.StatusSUCCESS,
+	}
+}
+
 func (c *APIController) replacePlaceholders(
 	ctx context.Context,
 	clientSetupSections *[]artifact.ClientSetupSection,
@@ -817,11 +3339,15 @@ func (c *APIController) replacePlaceholders(
 	pkgType string,
 ) {
 	uploadURL := ""
-	if pkgType == string(artifact.PackageTypePYTHON) {
+	if pkgType == string(artifact.PackageTypePYTHON) || pkgType == string(artifact.PackageTypeALPINE) ||
+		pkgType == string(artifact.PackageTypeCONDA) {
 		regURL, _ := url.Parse(registryURL)
 		// append username:password to the host
 		regURL.User = url.UserPassword(username, "identity-token")
 		uploadURL = regURL.String()
+	} else if pkgType == string(artifact.PackageTypeNPM) {
+		// .npmrc scopes credentials by the registry URL without its scheme
+		uploadURL = "//" + common.TrimURLScheme(registryURL) + "/"
 	}
 
 	for i := range *clientSetupSections {
@@ -173,26 +173,91 @@ func GetRegistryMetadata(
 		if reg.DownloadCount != 0 {
 			downloadCount = ptr.Int64(reg.DownloadCount)
 		}
-
-		regURL := urlProvider.RegistryURL(ctx, rootIdentifier, reg.RegIdentifier)
-		if reg.PackageType == artifact.PackageTypeGENERIC {
-			regURL = urlProvider.RegistryURL(ctx, rootIdentifier, "generic", reg.RegIdentifier)
+		var uniqueDownloadCount *int64
+		if reg.UniqueDownloadCount != 0 {
+			uniqueDownloadCount = ptr.Int64(reg.UniqueDownloadCount)
 		}
+
+		regURL := GetRegistryURL(ctx, urlProvider, rootIdentifier, reg.RegIdentifier, reg.PackageType)
 		// fix: refactor it
 		size := GetSize(reg.Size)
+		sizeBytes := reg.Size
 		repoMetadata := artifact.RegistryMetadata{
-			Identifier:     reg.RegIdentifier,
-			Description:    &description,
-			PackageType:    reg.PackageType,
-			Type:           reg.Type,
-			LastModified:   &modifiedAt,
-			Url:            regURL,
-			ArtifactsCount: artifactCount,
-			DownloadsCount: downloadCount,
-			RegistrySize:   &size,
-			Labels:         labels,
+			Identifier:           reg.RegIdentifier,
+			Description:          &description,
+			PackageType:          reg.PackageType,
+			Type:                 reg.Type,
+			LastModified:         &modifiedAt,
+			Url:                  regURL,
+			ArtifactsCount:       artifactCount,
+			DownloadsCount:       downloadCount,
+			UniqueDownloadsCount: uniqueDownloadCount,
+			RegistrySize:         &size,
+			RegistrySizeBytes:    &sizeBytes,
+			Labels:               labels,
 		}
 		repoMetadataList = append(repoMetadataList, repoMetadata)
 	}
 	return repoMetadataList
 }
+
+// GetRegistryURL returns the URL package clients reach the registry of the package type at.
+func GetRegistryURL(
+	ctx context.Context,
+	urlProvider url.Provider,
+	rootIdentifier string,
+	registryIdentifier string,
+	packageType artifact.PackageType,
+) string {
+	registryURL := urlProvider.RegistryURL(ctx, rootIdentifier, registryIdentifier)
+	if packageType == artifact.PackageTypeGENERIC {
+		registryURL = urlProvider.RegistryURL(ctx, rootIdentifier, "generic", registryIdentifier)
+	} else if packageType == artifact.PackageTypeNPM {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "npm")
+	} else if packageType == artifact.PackageTypePYTHON {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "python")
+	} else if packageType == artifact.PackageTypeNUGET {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "nuget")
+	} else if packageType == artifact.PackageTypeGEMS {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "rubygems")
+	} else if packageType == artifact.PackageTypeCRATE {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "cargo")
+	} else if packageType == artifact.PackageTypeGO {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "go")
+	} else if packageType == artifact.PackageTypeDEB {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "debian")
+	} else if packageType == artifact.PackageTypeRPM {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "rpm")
+	} else if packageType == artifact.PackageTypeALPINE {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "alpine")
+	} else if packageType == artifact.PackageTypeCONAN {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "conan")
+	} else if packageType == artifact.PackageTypeCOMPOSER {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "composer")
+	} else if packageType == artifact.PackageTypeCONDA {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "conda")
+	} else if packageType == artifact.PackageTypeTERRAFORM {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "terraform")
+	} else if packageType == artifact.PackageTypeSWIFT {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "swift")
+	} else if packageType == artifact.PackageTypeCOCOAPODS {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "cocoapods")
+	} else if packageType == artifact.PackageTypeHEX {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "hex")
+	} else if packageType == artifact.PackageTypePUB {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "pub")
+	} else if packageType == artifact.PackageTypeCRAN {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "cran")
+	} else if packageType == artifact.PackageTypeGALAXY {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "galaxy")
+	} else if packageType == artifact.PackageTypePUPPET {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "puppet")
+	} else if packageType == artifact.PackageTypeVAGRANT {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "vagrant")
+	} else if packageType == artifact.PackageTypeMODEL {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "model")
+	} else if packageType == artifact.PackageTypeHOMEBREW {
+		registryURL = urlProvider.PackageURL(ctx, rootIdentifier+"/"+registryIdentifier, "homebrew")
+	}
+	return registryURL
+}
@@ -82,16 +82,24 @@ func (c *APIController) GetAllArtifactsByRegistry(
 	}
 
 	var artifacts *[]types.ArtifactMetadata
-	var count int64
+	var count Count
+	registryIDs := []int64{registry.ID}
+	if r.Params.Merged != nil && bool(*r.Params.Merged) && registry.Type == artifact.RegistryTypeVIRTUAL {
+		registryIDs = append(registryIDs, registry.UpstreamProxies...)
+	}
+	key := countKey("registry-artifacts", registryIDs, regInfo.searchTerm, regInfo.labels)
+	exact := isExactCount(r.Params.ExactCount)
 	if registry.PackageType == artifact.PackageTypeDOCKER || registry.PackageType == artifact.PackageTypeHELM {
 		artifacts, err = c.TagStore.GetAllArtifactsByRepo(
-			ctx, regInfo.parentID, regInfo.RegistryIdentifier,
+			ctx, registryIDs,
 			regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm, regInfo.labels,
 		)
-		count, _ = c.TagStore.CountAllArtifactsByRepo(
-			ctx, regInfo.parentID, regInfo.RegistryIdentifier,
-			regInfo.searchTerm, regInfo.labels,
-		)
+		count, _ = c.countCache.Get(ctx, key, exact, func(ctx context.Context) (int64, error) {
+			return c.TagStore.CountAllArtifactsByRepo(
+				ctx, registryIDs,
+				regInfo.searchTerm, regInfo.labels,
+			)
+		})
 		if err != nil {
 			return artifact.GetAllArtifactsByRegistry500JSONResponse{
 				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
@@ -101,11 +109,13 @@ func (c *APIController) GetAllArtifactsByRegistry(
 		}
 	} else {
 		artifacts, err = c.ArtifactStore.GetAllArtifactsByRepo(
-			ctx, regInfo.parentID, regInfo.RegistryIdentifier,
+			ctx, registryIDs,
 			regInfo.sortByField, regInfo.sortByOrder, regInfo.limit, regInfo.offset, regInfo.searchTerm, regInfo.labels)
-		count, _ = c.ArtifactStore.CountAllArtifactsByRepo(
-			ctx, regInfo.parentID, regInfo.RegistryIdentifier,
-			regInfo.searchTerm, regInfo.labels)
+		count, _ = c.countCache.Get(ctx, key, exact, func(ctx context.Context) (int64, error) {
+			return c.ArtifactStore.CountAllArtifactsByRepo(
+				ctx, registryIDs,
+				regInfo.searchTerm, regInfo.labels)
+		})
 		if err != nil {
 			return artifact.GetAllArtifactsByRegistry500JSONResponse{
 				InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
@@ -114,6 +124,13 @@ func (c *APIController) GetAllArtifactsByRegistry(
 			}, nil
 		}
 	}
+	if err = c.applyLatestVersionStrategy(ctx, registry, artifacts); err != nil {
+		return artifact.GetAllArtifactsByRegistry500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
 	return artifact.GetAllArtifactsByRegistry200JSONResponse{
 		ListRegistryArtifactResponseJSONResponse: *GetAllArtifactByRegistryResponse(
 			artifacts, count, regInfo.pageNumber, regInfo.limit,
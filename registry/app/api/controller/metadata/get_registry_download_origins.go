@@ -0,0 +1,157 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// maxDownloadOriginNetworks is the number of networks listed in the download origins.
+const maxDownloadOriginNetworks = 20
+
+// GetRegistryDownloadOrigins returns the downloads of the artifacts of a registry by country, and the
+// networks downloaded from most. The to date is inclusive.
+func (c *APIController) GetRegistryDownloadOrigins(
+	ctx context.Context,
+	r artifact.GetRegistryDownloadOriginsRequestObject,
+) (artifact.GetRegistryDownloadOriginsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return artifact.GetRegistryDownloadOrigins400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return artifact.GetRegistryDownloadOrigins400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space,
+		regInfo.RegistryIdentifier, enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.GetRegistryDownloadOrigins403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	from, err := parseStatsDate(r.Params.From)
+	if err != nil {
+		return artifact.GetRegistryDownloadOrigins400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	to, err := parseStatsDate(r.Params.To)
+	if err != nil {
+		return artifact.GetRegistryDownloadOrigins400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+	if !to.IsZero() {
+		to = to.AddDate(0, 0, 1)
+	}
+
+	counts, err := c.DownloadStatStore.CountByOrigin(ctx, regInfo.RegistryID, from, to)
+	if err != nil {
+		return artifact.GetRegistryDownloadOrigins500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	return artifact.GetRegistryDownloadOrigins200JSONResponse{
+		RegistryDownloadOriginsResponseJSONResponse: artifact.RegistryDownloadOriginsResponseJSONResponse{
+			Data:   toRegistryDownloadOrigins(counts),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// toRegistryDownloadOrigins sums the downloads from each network up by country. The counts are
+// ordered by downloads, so the first networks are the ones downloaded from most.
+func toRegistryDownloadOrigins(counts []types.OriginDownloadCount) artifact.RegistryDownloadOrigins {
+	origins := artifact.RegistryDownloadOrigins{
+		Regions:  []artifact.RegionDownloadStats{},
+		Networks: []artifact.NetworkDownloadStats{},
+	}
+	regions := make(map[string]*artifact.RegionDownloadStats)
+	for _, count := range counts {
+		origins.DownloadCount += count.DownloadCount
+
+		region, ok := regions[count.Region]
+		if !ok {
+			region = &artifact.RegionDownloadStats{Region: optionalString(count.Region)}
+			regions[count.Region] = region
+		}
+		region.DownloadCount += count.DownloadCount
+		region.UniqueDownloadCount += count.UniqueDownloadCount
+		region.NetworkCount++
+
+		if len(origins.Networks) < maxDownloadOriginNetworks {
+			origins.Networks = append(origins.Networks, artifact.NetworkDownloadStats{
+				Network:             count.Network,
+				Region:              optionalString(count.Region),
+				DownloadCount:       count.DownloadCount,
+				UniqueDownloadCount: count.UniqueDownloadCount,
+			})
+		}
+	}
+
+	for _, region := range regions {
+		origins.Regions = append(origins.Regions, *region)
+	}
+	sort.Slice(origins.Regions, func(i, j int) bool {
+		a, b := origins.Regions[i], origins.Regions[j]
+		if a.DownloadCount != b.DownloadCount {
+			return a.DownloadCount > b.DownloadCount
+		}
+		return regionCode(a) < regionCode(b)
+	})
+	return origins
+}
+
+func regionCode(r artifact.RegionDownloadStats) string {
+	if r.Region == nil {
+		return ""
+	}
+	return *r.Region
+}
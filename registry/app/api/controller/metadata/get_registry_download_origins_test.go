@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToRegistryDownloadOrigins(t *testing.T) {
+	origins := toRegistryDownloadOrigins([]types.OriginDownloadCount{
+		{Region: "DE", Network: "203.0.113.0/24", DownloadCount: 9, UniqueDownloadCount: 3},
+		{Region: "US", Network: "198.51.100.0/24", DownloadCount: 6, UniqueDownloadCount: 6},
+		{Region: "US", Network: "2001:db8:1::/48", DownloadCount: 5, UniqueDownloadCount: 1},
+		{Network: "192.0.2.0/24", DownloadCount: 1, UniqueDownloadCount: 1},
+	})
+
+	assert.Equal(t, int64(21), origins.DownloadCount)
+	require.Len(t, origins.Regions, 3)
+	assert.Equal(t, "US", *origins.Regions[0].Region)
+	assert.Equal(t, int64(11), origins.Regions[0].DownloadCount)
+	assert.Equal(t, int64(7), origins.Regions[0].UniqueDownloadCount)
+	assert.Equal(t, 2, origins.Regions[0].NetworkCount)
+	assert.Equal(t, "DE", *origins.Regions[1].Region)
+	assert.Nil(t, origins.Regions[2].Region)
+
+	require.Len(t, origins.Networks, 4)
+	assert.Equal(t, "203.0.113.0/24", origins.Networks[0].Network)
+	assert.Nil(t, origins.Networks[3].Region)
+}
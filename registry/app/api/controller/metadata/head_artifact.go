@@ -0,0 +1,268 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// headResult carries the status of a failed HEAD lookup to the operation specific responses.
+type headResult struct {
+	status int
+	err    error
+}
+
+func (c *APIController) HeadArtifact(
+	ctx context.Context,
+	r artifact.HeadArtifactRequestObject,
+) (artifact.HeadArtifactResponseObject, error) {
+	regInfo, registry, res := c.headRegistry(ctx, string(r.RegistryRef))
+	if res == nil {
+		res = c.headImage(ctx, registry, string(r.Artifact))
+	}
+	if res != nil {
+		return headArtifactErrorResponse(res), nil
+	}
+
+	image := string(r.Artifact)
+	var versions map[string][]string
+	var err error
+	if isOCIPackageType(registry.PackageType) {
+		versions, err = c.TagStore.GetTagNamesByImageNames(ctx, regInfo.RegistryID, []string{image})
+	} else {
+		versions, err = c.ArtifactStore.GetVersionsByImageNames(ctx, regInfo.RegistryID, []string{image})
+	}
+	if err != nil {
+		return headArtifactErrorResponse(&headResult{status: http.StatusInternalServerError, err: err}), nil
+	}
+
+	latest := versioning.LatestForRegistry(registry, versions[image])
+
+	return artifact.HeadArtifact200Response{
+		Headers: artifact.ArtifactExistsResponseResponseHeaders{
+			XArtifactLatestVersion: latest,
+			XArtifactVersionCount:  int64(len(versions[image])),
+		},
+	}, nil
+}
+
+func (c *APIController) HeadArtifactVersion(
+	ctx context.Context,
+	r artifact.HeadArtifactVersionRequestObject,
+) (artifact.HeadArtifactVersionResponseObject, error) {
+	_, registry, res := c.headRegistry(ctx, string(r.RegistryRef))
+	if res != nil {
+		return headArtifactVersionErrorResponse(res), nil
+	}
+
+	image := string(r.Artifact)
+	version := string(r.Version)
+	if isOCIPackageType(registry.PackageType) {
+		tag, err := c.TagStore.FindTag(ctx, registry.ID, image, version)
+		if err != nil {
+			return headArtifactVersionErrorResponse(headLookupError(err, "version")), nil
+		}
+		m, err := c.ManifestStore.Get(ctx, tag.ManifestID)
+		if err != nil {
+			return headArtifactVersionErrorResponse(headLookupError(err, "manifest")), nil
+		}
+		return artifact.HeadArtifactVersion200Response{
+			Headers: artifact.VersionExistsResponseResponseHeaders{
+				XArtifactDigest: m.Digest.String(),
+				XArtifactSize:   m.TotalSize,
+			},
+		}, nil
+	}
+
+	if res = c.headImage(ctx, registry, image); res != nil {
+		return headArtifactVersionErrorResponse(res), nil
+	}
+	img, err := c.ImageStore.GetByName(ctx, registry.ID, image)
+	if err != nil {
+		return headArtifactVersionErrorResponse(headLookupError(err, "artifact")), nil
+	}
+	art, err := c.ArtifactStore.GetByName(ctx, img.ID, version)
+	if err != nil {
+		return headArtifactVersionErrorResponse(headLookupError(err, "version")), nil
+	}
+
+	var metadata struct {
+		Files []database.File `json:"files"`
+	}
+	if len(art.Metadata) > 0 {
+		if err = json.Unmarshal(art.Metadata, &metadata); err != nil {
+			return headArtifactVersionErrorResponse(&headResult{status: http.StatusInternalServerError, err: err}), nil
+		}
+	}
+	var size int64
+	for _, f := range metadata.Files {
+		size += f.Size
+	}
+	return artifact.HeadArtifactVersion200Response{
+		Headers: artifact.VersionExistsResponseResponseHeaders{XArtifactSize: size},
+	}, nil
+}
+
+func (c *APIController) HeadArtifactFile(
+	ctx context.Context,
+	r artifact.HeadArtifactFileRequestObject,
+) (artifact.HeadArtifactFileResponseObject, error) {
+	_, registry, res := c.headRegistry(ctx, string(r.RegistryRef))
+	if res != nil {
+		return headArtifactFileErrorResponse(res), nil
+	}
+	if registry.PackageType != artifact.PackageTypeGENERIC && registry.PackageType != artifact.PackageTypeMAVEN {
+		return headArtifactFileErrorResponse(&headResult{
+			status: http.StatusBadRequest, err: errors.New("invalid package type"),
+		}), nil
+	}
+
+	fileName := strings.TrimPrefix(string(r.Params.FileName), "/")
+	if fileName == "" {
+		return headArtifactFileErrorResponse(&headResult{
+			status: http.StatusBadRequest, err: errors.New("file name is required"),
+		}), nil
+	}
+	artifactPath := string(r.Artifact)
+	if registry.PackageType == artifact.PackageTypeMAVEN {
+		artifactPath = strings.ReplaceAll(strings.ReplaceAll(artifactPath, ".", "/"), ":", "/")
+	}
+	filePath := "/" + artifactPath + "/" + string(r.Version) + "/" + fileName
+
+	info, err := c.fileManager.GetFileMetadata(ctx, filePath, registry.ID)
+	if err != nil {
+		return headArtifactFileErrorResponse(headLookupError(err, "file")), nil
+	}
+	return artifact.HeadArtifactFile200Response{
+		Headers: artifact.FileExistsResponseResponseHeaders{
+			XArtifactDigest: "sha256:" + info.Sha256,
+			XArtifactSize:   info.Size,
+		},
+	}, nil
+}
+
+// headRegistry resolves the registry of a HEAD request and checks that the caller can view it.
+func (c *APIController) headRegistry(
+	ctx context.Context, registryRef string,
+) (*RegistryRequestBaseInfo, *types.Registry, *headResult) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", registryRef)
+	if err != nil {
+		return nil, nil, &headResult{status: http.StatusBadRequest, err: err}
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return nil, nil, &headResult{status: http.StatusBadRequest, err: err}
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return nil, nil, &headResult{status: http.StatusForbidden, err: err}
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return nil, nil, headLookupError(err, "registry")
+	}
+	return regInfo, registry, nil
+}
+
+func (c *APIController) headImage(ctx context.Context, registry *types.Registry, image string) *headResult {
+	if _, err := c.ImageStore.GetByName(ctx, registry.ID, image); err != nil {
+		return headLookupError(err, "artifact")
+	}
+	return nil
+}
+
+func headLookupError(err error, what string) *headResult {
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return &headResult{status: http.StatusNotFound, err: fmt.Errorf("%s not found", what)}
+	}
+	return &headResult{status: http.StatusInternalServerError, err: err}
+}
+
+func isOCIPackageType(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM
+}
+
+func headArtifactErrorResponse(res *headResult) artifact.HeadArtifactResponseObject {
+	errResp := *GetErrorResponse(res.status, res.err.Error())
+	switch res.status {
+	case http.StatusBadRequest:
+		return artifact.HeadArtifact400JSONResponse{BadRequestJSONResponse: artifact.BadRequestJSONResponse(errResp)}
+	case http.StatusForbidden:
+		return artifact.HeadArtifact403JSONResponse{UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(errResp)}
+	case http.StatusNotFound:
+		return artifact.HeadArtifact404JSONResponse{NotFoundJSONResponse: artifact.NotFoundJSONResponse(errResp)}
+	default:
+		return artifact.HeadArtifact500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(errResp),
+		}
+	}
+}
+
+func headArtifactVersionErrorResponse(res *headResult) artifact.HeadArtifactVersionResponseObject {
+	errResp := *GetErrorResponse(res.status, res.err.Error())
+	switch res.status {
+	case http.StatusBadRequest:
+		return artifact.HeadArtifactVersion400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(errResp),
+		}
+	case http.StatusForbidden:
+		return artifact.HeadArtifactVersion403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(errResp),
+		}
+	case http.StatusNotFound:
+		return artifact.HeadArtifactVersion404JSONResponse{NotFoundJSONResponse: artifact.NotFoundJSONResponse(errResp)}
+	default:
+		return artifact.HeadArtifactVersion500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(errResp),
+		}
+	}
+}
+
+func headArtifactFileErrorResponse(res *headResult) artifact.HeadArtifactFileResponseObject {
+	errResp := *GetErrorResponse(res.status, res.err.Error())
+	switch res.status {
+	case http.StatusBadRequest:
+		return artifact.HeadArtifactFile400JSONResponse{BadRequestJSONResponse: artifact.BadRequestJSONResponse(errResp)}
+	case http.StatusForbidden:
+		return artifact.HeadArtifactFile403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(errResp),
+		}
+	case http.StatusNotFound:
+		return artifact.HeadArtifactFile404JSONResponse{NotFoundJSONResponse: artifact.NotFoundJSONResponse(errResp)}
+	default:
+		return artifact.HeadArtifactFile500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(errResp),
+		}
+	}
+}
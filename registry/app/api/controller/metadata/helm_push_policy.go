@@ -0,0 +1,297 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/chartpolicy"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetHelmPushPolicy returns the checks the charts pushed to the Helm registry are linted against.
+func (c *APIController) GetHelmPushPolicy(
+	ctx context.Context,
+	r artifact.GetHelmPushPolicyRequestObject,
+) (artifact.GetHelmPushPolicyResponseObject, error) {
+	registry, status, err := c.getHelmRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetHelmPushPolicy400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetHelmPushPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwGetHelmPushPolicy500Error(err), nil
+	}
+
+	policy, err := c.HelmPushPolicyStore.Get(ctx, registry.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.GetHelmPushPolicy404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "Helm push policy not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwGetHelmPushPolicy500Error(err), nil
+	}
+	return artifact.GetHelmPushPolicy200JSONResponse{
+		HelmPushPolicyResponseJSONResponse: artifact.HelmPushPolicyResponseJSONResponse{
+			Data:   mapToAPIHelmPushPolicy(policy),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// SetHelmPushPolicy replaces the checks the charts pushed to the Helm registry are linted against.
+func (c *APIController) SetHelmPushPolicy(
+	ctx context.Context,
+	r artifact.SetHelmPushPolicyRequestObject,
+) (artifact.SetHelmPushPolicyResponseObject, error) {
+	if r.Body == nil {
+		return throwSetHelmPushPolicy400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getHelmRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwSetHelmPushPolicy400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.SetHelmPushPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwSetHelmPushPolicy500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	policy, err := mapToHelmPushPolicy(registry.ID, session.Principal.ID, artifact.HelmPushPolicyRequest(*r.Body))
+	if err != nil {
+		return throwSetHelmPushPolicy400Error(err), nil
+	}
+	if err = c.HelmPushPolicyStore.Upsert(ctx, policy); err != nil {
+		return throwSetHelmPushPolicy500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionUpdated,
+		audit.WithData("helm push policy", helmPushPolicySummary(policy)),
+	)
+
+	policy, err = c.HelmPushPolicyStore.Get(ctx, registry.ID)
+	if err != nil {
+		return throwSetHelmPushPolicy500Error(err), nil
+	}
+	return artifact.SetHelmPushPolicy200JSONResponse{
+		HelmPushPolicyResponseJSONResponse: artifact.HelmPushPolicyResponseJSONResponse{
+			Data:   mapToAPIHelmPushPolicy(policy),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteHelmPushPolicy stops linting the charts pushed to the Helm registry.
+func (c *APIController) DeleteHelmPushPolicy(
+	ctx context.Context,
+	r artifact.DeleteHelmPushPolicyRequestObject,
+) (artifact.DeleteHelmPushPolicyResponseObject, error) {
+	registry, status, err := c.getHelmRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.DeleteHelmPushPolicy400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.DeleteHelmPushPolicy403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwDeleteHelmPushPolicy500Error(err), nil
+	}
+
+	err = c.HelmPushPolicyStore.Delete(ctx, registry.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteHelmPushPolicy404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "Helm push policy not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteHelmPushPolicy500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionUpdated, audit.WithData("helm push policy", "none"))
+
+	return artifact.DeleteHelmPushPolicy200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// getHelmRegistry returns the Helm registry charts are pushed to, checking the permission on it.
+func (c *APIController) getHelmRegistry(
+	ctx context.Context,
+	registryRef string,
+	permission enum.Permission,
+) (*registrytypes.Registry, int, error) {
+	registry, status, err := c.getRegistry(ctx, registryRef, permission)
+	if err != nil {
+		return nil, status, err
+	}
+	if registry.PackageType != artifact.PackageTypeHELM || registry.Type != artifact.RegistryTypeVIRTUAL {
+		return nil, http.StatusBadRequest, fmt.Errorf("registry %s is a %s %s registry, push policies are "+
+			"only supported by Helm registries charts are pushed to", registry.Name, registry.PackageType,
+			registry.Type)
+	}
+	return registry, 0, nil
+}
+
+func mapToHelmPushPolicy(
+	registryID int64,
+	principalID int64,
+	in artifact.HelmPushPolicyRequest,
+) (*registrytypes.HelmPushPolicy, error) {
+	policy := &registrytypes.HelmPushPolicy{
+		RegistryID: registryID,
+		UpdatedBy:  principalID,
+	}
+	if in.RequiredValues != nil {
+		for _, key := range *in.RequiredValues {
+			key = strings.TrimSpace(key)
+			if slices.Contains(strings.Split(key, "."), "") {
+				return nil, fmt.Errorf("invalid required value %q, values are dotted keys such as "+
+					"resources.limits.memory", key)
+			}
+			policy.RequiredValues = append(policy.RequiredValues, key)
+		}
+	}
+	if in.DisallowedRegistries != nil {
+		for _, host := range *in.DisallowedRegistries {
+			host = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(host), "https://"), "http://")
+			host = strings.TrimSuffix(host, "/")
+			if host == "" || strings.ContainsAny(host, " /") {
+				return nil, fmt.Errorf("invalid disallowed registry %q, registries are hosts such as docker.io", host)
+			}
+			policy.DisallowedRegistries = append(policy.DisallowedRegistries, host)
+		}
+	}
+	if in.CheckApiVersions != nil {
+		policy.CheckAPIVersions = *in.CheckApiVersions
+	}
+	if in.KubeVersion != nil && *in.KubeVersion != "" {
+		if _, err := chartpolicy.ParseKubeVersion(*in.KubeVersion); err != nil {
+			return nil, err
+		}
+		policy.KubeVersion = strings.TrimSpace(*in.KubeVersion)
+	}
+	if in.Enforce != nil {
+		policy.Enforce = *in.Enforce
+	}
+	return policy, nil
+}
+
+func mapToAPIHelmPushPolicy(policy *registrytypes.HelmPushPolicy) artifact.HelmPushPolicy {
+	data := artifact.HelmPushPolicy{
+		RequiredValues:       policy.RequiredValues,
+		DisallowedRegistries: policy.DisallowedRegistries,
+		CheckApiVersions:     policy.CheckAPIVersions,
+		Enforce:              policy.Enforce,
+		UpdatedAt:            policy.UpdatedAt.UnixMilli(),
+	}
+	if data.RequiredValues == nil {
+		data.RequiredValues = []string{}
+	}
+	if data.DisallowedRegistries == nil {
+		data.DisallowedRegistries = []string{}
+	}
+	if policy.KubeVersion != "" {
+		data.KubeVersion = &policy.KubeVersion
+	}
+	return data
+}
+
+// helmPushPolicySummary describes the checks of the policy for the audit log.
+func helmPushPolicySummary(policy *registrytypes.HelmPushPolicy) string {
+	var checks []string
+	if len(policy.RequiredValues) > 0 {
+		checks = append(checks, "required values "+strings.Join(policy.RequiredValues, ", "))
+	}
+	if len(policy.DisallowedRegistries) > 0 {
+		checks = append(checks, "disallowed registries "+strings.Join(policy.DisallowedRegistries, ", "))
+	}
+	if policy.CheckAPIVersions {
+		checks = append(checks, "removed API versions")
+	}
+	if len(checks) == 0 {
+		checks = append(checks, "no checks")
+	}
+	mode := "warn"
+	if policy.Enforce {
+		mode = "enforce"
+	}
+	return mode + ": " + strings.Join(checks, "; ")
+}
+
+func throwGetHelmPushPolicy500Error(err error) artifact.GetHelmPushPolicy500JSONResponse {
+	return artifact.GetHelmPushPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwSetHelmPushPolicy400Error(err error) artifact.SetHelmPushPolicy400JSONResponse {
+	return artifact.SetHelmPushPolicy400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwSetHelmPushPolicy500Error(err error) artifact.SetHelmPushPolicy500JSONResponse {
+	return artifact.SetHelmPushPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwDeleteHelmPushPolicy500Error(err error) artifact.DeleteHelmPushPolicy500JSONResponse {
+	return artifact.DeleteHelmPushPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapToHelmPushPolicy(t *testing.T) {
+	enforce := true
+	kubeVersion := " 1.29 "
+	in := artifact.HelmPushPolicyRequest{
+		RequiredValues:       &[]string{" resources.limits.memory "},
+		DisallowedRegistries: &[]string{"https://docker.io/", "quay.io"},
+		KubeVersion:          &kubeVersion,
+		Enforce:              &enforce,
+	}
+
+	policy, err := mapToHelmPushPolicy(1, 2, in)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"resources.limits.memory"}, policy.RequiredValues)
+	assert.Equal(t, []string{"docker.io", "quay.io"}, policy.DisallowedRegistries)
+	assert.Equal(t, "1.29", policy.KubeVersion)
+	assert.False(t, policy.CheckAPIVersions)
+	assert.True(t, policy.Enforce)
+	assert.Equal(t, "enforce: required values resources.limits.memory; disallowed registries docker.io, quay.io",
+		helmPushPolicySummary(policy))
+
+	data := mapToAPIHelmPushPolicy(&registrytypes.HelmPushPolicy{})
+	assert.Equal(t, []string{}, data.RequiredValues)
+	assert.Nil(t, data.KubeVersion)
+}
+
+func TestMapToHelmPushPolicy_Invalid(t *testing.T) {
+	kubeVersion := "latest"
+	for _, in := range []artifact.HelmPushPolicyRequest{
+		{RequiredValues: &[]string{"resources..memory"}},
+		{RequiredValues: &[]string{""}},
+		{DisallowedRegistries: &[]string{"docker.io/library"}},
+		{KubeVersion: &kubeVersion},
+	} {
+		_, err := mapToHelmPushPolicy(1, 2, in)
+		assert.Error(t, err)
+	}
+}
@@ -16,9 +16,18 @@ package metadata
 
 import (
 	"context"
+	"time"
 
 	gitnesswebhook "github.com/harness/gitness/app/services/webhook"
 	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/claimsmapping"
+	"github.com/harness/gitness/registry/services/enrichment"
+	"github.com/harness/gitness/registry/services/evidence"
+	"github.com/harness/gitness/registry/services/onboarding"
+	"github.com/harness/gitness/registry/services/queue"
+	"github.com/harness/gitness/registry/services/resolution"
+	"github.com/harness/gitness/registry/services/security"
+	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
@@ -58,3 +67,125 @@ type RegistryMetadataHelper interface {
 type WebhookService interface {
 	ReTriggerWebhookExecution(ctx context.Context, webhookExecutionID int64) (*gitnesswebhook.TriggerResult, error)
 }
+
+type StorageMigrator interface {
+	Migrate(ctx context.Context, registry *registrytypes.Registry, prefix string) error
+}
+
+type QueueService interface {
+	List(ctx context.Context, registryID int64) ([]queue.Queue, error)
+	Get(ctx context.Context, registryID int64, name registrytypes.QueueName) (*queue.Queue, error)
+	Pause(ctx context.Context, registryID int64, name registrytypes.QueueName, principalID int64) error
+	Resume(ctx context.Context, registryID int64, name registrytypes.QueueName) error
+}
+
+type SecurityService interface {
+	Posture(ctx context.Context, registryID int64) (*registrytypes.SecurityPosture, error)
+	Impact(ctx context.Context, spaceID int64, query security.ImpactQuery) ([]*registrytypes.PackageImpact, error)
+	Allowlisted(
+		ctx context.Context,
+		registryID int64,
+		dgst string,
+	) (map[string]*registrytypes.VulnerabilityAllowlistEntry, error)
+}
+
+type EvidenceService interface {
+	Export(
+		ctx context.Context,
+		registry *registrytypes.Registry,
+		image string,
+		version string,
+		exportedBy string,
+	) (*evidence.Bundle, error)
+}
+
+type ComplianceService interface {
+	PurgeVersion(
+		ctx context.Context,
+		registry *registrytypes.Registry,
+		image string,
+		version string,
+		reason string,
+		principalID int64,
+	) (*registrytypes.DeletionCertificate, error)
+}
+
+type CachePrewarmService interface {
+	Prewarm(
+		ctx context.Context,
+		registry *registrytypes.Registry,
+		coordinates []string,
+		principalID int64,
+	) ([]*registrytypes.CachePrewarmArtifact, error)
+	ListBatch(ctx context.Context, registryID int64, batch string) ([]*registrytypes.CachePrewarmArtifact, error)
+}
+
+type MirrorSyncService interface {
+	Run(ctx context.Context, sync *registrytypes.MirrorSync, principalID int64) (*registrytypes.MirrorSyncRun, error)
+}
+
+type AccessGrantService interface {
+	Grant(
+		ctx context.Context,
+		registryID int64,
+		principalUID string,
+		permissions []enum.Permission,
+		duration time.Duration,
+		reason string,
+		grantedBy int64,
+	) (*registrytypes.AccessGrant, error)
+	ListActive(ctx context.Context, registryID int64) ([]*registrytypes.AccessGrant, error)
+	Revoke(ctx context.Context, registryID int64, grantID int64, revokedBy int64) error
+}
+
+type ClaimsMappingService interface {
+	CreateMapping(ctx context.Context, mapping *registrytypes.ClaimMapping) error
+	ListMappings(ctx context.Context, spaceID int64) ([]*registrytypes.ClaimMapping, error)
+	DeleteMapping(ctx context.Context, spaceID int64, id int64) error
+	Exchange(ctx context.Context, rawIDToken string) (*claimsmapping.Exchange, error)
+}
+
+type ResolutionService interface {
+	Resolve(
+		ctx context.Context,
+		registry *registrytypes.Registry,
+		coordinate resolution.Coordinate,
+	) (*resolution.Trace, error)
+}
+
+type EnrichmentService interface {
+	Enrich(ctx context.Context, target enrichment.Target) []enrichment.Section
+}
+
+type OnboardingService interface {
+	CheckUpstream(ctx context.Context, target onboarding.Target) *onboarding.Connectivity
+	EstimateImport(
+		ctx context.Context,
+		target onboarding.Target,
+		repositories []string,
+	) (*onboarding.ImportEstimate, error)
+}
+
+type ContentIndexService interface {
+	Search(
+		ctx context.Context,
+		registry *registrytypes.Registry,
+		query string,
+		limit int,
+		offset int,
+	) ([]*registrytypes.ArchiveEntryMatch, int64, error)
+	SearchClasses(
+		ctx context.Context,
+		registry *registrytypes.Registry,
+		className string,
+		limit int,
+		offset int,
+	) ([]*registrytypes.ArchiveClassMatch, int64, error)
+	SearchLayers(
+		ctx context.Context,
+		registry *registrytypes.Registry,
+		query string,
+		limit int,
+		offset int,
+	) ([]*registrytypes.ArchiveEntryMatch, int64, error)
+}
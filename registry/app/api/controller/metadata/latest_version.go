@@ -0,0 +1,106 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+)
+
+// applyLatestVersionStrategy replaces the last pushed version returned by the store with the
+// latest version according to the strategy configured on the registry.
+func (c *APIController) applyLatestVersionStrategy(
+	ctx context.Context,
+	registry *types.Registry,
+	artifacts *[]types.ArtifactMetadata,
+) error {
+	strategy := registry.LatestVersionStrategy
+	if strategy == "" || strategy == artifact.LatestVersionStrategyLASTPUSHED || artifacts == nil ||
+		len(*artifacts) == 0 {
+		return nil
+	}
+
+	var pattern *regexp.Regexp
+	if strategy == artifact.LatestVersionStrategyREGEX {
+		var err error
+		if pattern, err = versioning.CompileLatestVersionPattern(registry.LatestVersionPattern); err != nil {
+			return err
+		}
+	}
+
+	imageNames := make([]string, 0, len(*artifacts))
+	for _, a := range *artifacts {
+		imageNames = append(imageNames, a.Name)
+	}
+
+	var versions map[string][]string
+	var err error
+	if registry.PackageType == artifact.PackageTypeDOCKER || registry.PackageType == artifact.PackageTypeHELM {
+		versions, err = c.TagStore.GetTagNamesByImageNames(ctx, registry.ID, imageNames)
+	} else {
+		versions, err = c.ArtifactStore.GetVersionsByImageNames(ctx, registry.ID, imageNames)
+	}
+	if err != nil {
+		return err
+	}
+
+	scheme := versioning.SchemeForPackageType(registry.PackageType)
+	for i := range *artifacts {
+		a := &(*artifacts)[i]
+		if latest := versioning.LatestByStrategy(strategy, pattern, scheme, versions[a.Name]); latest != "" {
+			a.LatestVersion = latest
+		}
+	}
+	return nil
+}
+
+// applyLatestVersionStrategies replaces the last pushed versions listed for the artifacts of the
+// registries of a space with the latest versions according to the strategies of their registries.
+func (c *APIController) applyLatestVersionStrategies(
+	ctx context.Context,
+	parentID int64,
+	artifacts *[]types.ArtifactMetadata,
+) error {
+	if artifacts == nil {
+		return nil
+	}
+	indexesByRegistry := make(map[string][]int)
+	for i, a := range *artifacts {
+		indexesByRegistry[a.RepoName] = append(indexesByRegistry[a.RepoName], i)
+	}
+	for registryName, indexes := range indexesByRegistry {
+		registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, parentID, registryName)
+		if err != nil {
+			return err
+		}
+		registryArtifacts := make([]types.ArtifactMetadata, 0, len(indexes))
+		for _, i := range indexes {
+			a := (*artifacts)[i]
+			a.LatestVersion = a.Version
+			registryArtifacts = append(registryArtifacts, a)
+		}
+		if err = c.applyLatestVersionStrategy(ctx, registry, &registryArtifacts); err != nil {
+			return err
+		}
+		for j, i := range indexes {
+			(*artifacts)[i].Version = registryArtifacts[j].LatestVersion
+		}
+	}
+	return nil
+}
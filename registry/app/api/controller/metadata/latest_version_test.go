@@ -0,0 +1,114 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeVersionsArtifactRepository struct {
+	store.ArtifactRepository
+	versions map[int64]map[string][]string
+}
+
+func (f *fakeVersionsArtifactRepository) GetVersionsByImageNames(
+	_ context.Context, registryID int64, _ []string,
+) (map[string][]string, error) {
+	return f.versions[registryID], nil
+}
+
+func TestGetLatestVersionStrategyFields(t *testing.T) {
+	semver := artifact.LatestVersionStrategySEMVER
+	regex := artifact.LatestVersionStrategyREGEX
+	pattern := `^release-(.*)$`
+
+	strategy, p, err := getLatestVersionStrategyFields(artifact.RegistryRequest{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, artifact.LatestVersionStrategyLASTPUSHED, strategy)
+	assert.Empty(t, p)
+
+	// an omitted strategy keeps the current one
+	current := &types.Registry{LatestVersionStrategy: regex, LatestVersionPattern: pattern}
+	strategy, p, err = getLatestVersionStrategyFields(artifact.RegistryRequest{}, current)
+	require.NoError(t, err)
+	assert.Equal(t, regex, strategy)
+	assert.Equal(t, pattern, p)
+
+	strategy, p, err = getLatestVersionStrategyFields(artifact.RegistryRequest{LatestVersionStrategy: &semver}, current)
+	require.NoError(t, err)
+	assert.Equal(t, semver, strategy)
+	assert.Empty(t, p, "the pattern is only kept for the REGEX strategy")
+
+	_, _, err = getLatestVersionStrategyFields(artifact.RegistryRequest{LatestVersionStrategy: &regex}, nil)
+	assert.Error(t, err, "the REGEX strategy needs a pattern")
+}
+
+func TestUpdateUpstreamProxyEntityKeepsLatestVersionStrategy(t *testing.T) {
+	c := &APIController{}
+	dto := testUpstreamRegistryRequest(t)
+	existing := &types.Registry{LatestVersionStrategy: artifact.LatestVersionStrategySEMVER}
+
+	registry, _, err := c.UpdateUpstreamProxyEntity(context.Background(), dto, 1, 1,
+		&types.UpstreamProxy{RepoKey: "npm-proxy", PackageType: artifact.PackageTypeNPM}, existing)
+	require.NoError(t, err)
+	assert.Equal(t, artifact.LatestVersionStrategySEMVER, registry.LatestVersionStrategy)
+
+	registry, _, err = c.CreateUpstreamProxyEntity(context.Background(), dto, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, artifact.LatestVersionStrategyLASTPUSHED, registry.LatestVersionStrategy)
+}
+
+func TestApplyLatestVersionStrategies(t *testing.T) {
+	ctx := context.Background()
+	registries := new(MockRegistryRepository)
+	c := &APIController{
+		RegistryRepository: registries,
+		ArtifactStore: &fakeVersionsArtifactRepository{versions: map[int64]map[string][]string{
+			1: {"lib": {"1.9.0", "1.10.0", "1.2.0"}},
+			2: {"lib": {"1.9.0", "1.10.0", "1.2.0"}},
+		}},
+	}
+	registries.On("GetByParentIDAndName", ctx, int64(5), "semver").Return(&types.Registry{
+		ID: 1, Name: "semver", PackageType: artifact.PackageTypeMAVEN,
+		LatestVersionStrategy: artifact.LatestVersionStrategySEMVER,
+	}, nil)
+	registries.On("GetByParentIDAndName", ctx, int64(5), "pushed").Return(&types.Registry{
+		ID: 2, Name: "pushed", PackageType: artifact.PackageTypeMAVEN,
+	}, nil)
+
+	artifacts := []types.ArtifactMetadata{
+		{RepoName: "semver", Name: "lib", Version: "1.9.0"},
+		{RepoName: "pushed", Name: "lib", Version: "1.9.0"},
+	}
+	require.NoError(t, c.applyLatestVersionStrategies(ctx, 5, &artifacts))
+	assert.Equal(t, "1.10.0", artifacts[0].Version)
+	assert.Equal(t, "1.9.0", artifacts[1].Version)
+	registries.AssertExpectations(t)
+}
+
+func TestGetArtifactSummaryLatestVersion(t *testing.T) {
+	summary := GetArtifactSummary(types.ArtifactMetadata{Name: "lib", LatestVersion: "1.10.0"})
+	require.NotNil(t, summary.Data.LatestVersion)
+	assert.Equal(t, "1.10.0", *summary.Data.LatestVersion)
+	assert.Nil(t, GetArtifactSummary(types.ArtifactMetadata{Name: "lib"}).Data.LatestVersion)
+}
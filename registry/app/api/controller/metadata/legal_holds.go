@@ -0,0 +1,254 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CreateLegalHold places a legal hold on a package, or on a version of it, which blocks deleting it
+// by any means until the hold is released.
+func (c *APIController) CreateLegalHold(
+	ctx context.Context,
+	r artifact.CreateLegalHoldRequestObject,
+) (artifact.CreateLegalHoldResponseObject, error) {
+	if r.Body == nil {
+		return throwCreateLegalHold400Error(fmt.Errorf("request body is required")), nil
+	}
+	if r.Body.Package == "" {
+		return throwCreateLegalHold400Error(fmt.Errorf("package is required")), nil
+	}
+	if r.Body.Reason == "" {
+		return throwCreateLegalHold400Error(fmt.Errorf("reason is required")), nil
+	}
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwCreateLegalHold400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.CreateLegalHold403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwCreateLegalHold500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	hold := &registrytypes.LegalHold{
+		RegistryID: registry.ID,
+		ImageName:  r.Body.Package,
+		Reason:     r.Body.Reason,
+		CreatedBy:  session.Principal.ID,
+	}
+	if r.Body.Version != nil {
+		hold.Version = *r.Body.Version
+	}
+	if err = c.LegalHoldStore.Create(ctx, hold); err != nil {
+		if errors.Is(err, store.ErrDuplicate) {
+			return throwCreateLegalHold400Error(fmt.Errorf("a legal hold already exists on %s",
+				holdTarget(hold))), nil
+		}
+		return throwCreateLegalHold500Error(err), nil
+	}
+
+	c.logRegistryAudit(ctx, registry, audit.ActionCreated,
+		audit.WithData("legal hold", holdTarget(hold)),
+		audit.WithData("reason", hold.Reason),
+	)
+
+	return artifact.CreateLegalHold201JSONResponse{
+		LegalHoldResponseJSONResponse: artifact.LegalHoldResponseJSONResponse{
+			Data:   mapToAPILegalHold(hold),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListLegalHolds lists the legal holds on the packages of a registry.
+func (c *APIController) ListLegalHolds(
+	ctx context.Context,
+	r artifact.ListLegalHoldsRequestObject,
+) (artifact.ListLegalHoldsResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListLegalHolds400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListLegalHolds403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListLegalHolds500Error(err), nil
+	}
+
+	holds, err := c.LegalHoldStore.List(ctx, registry.ID)
+	if err != nil {
+		return throwListLegalHolds500Error(err), nil
+	}
+	data := make([]artifact.LegalHold, 0, len(holds))
+	for _, hold := range holds {
+		data = append(data, mapToAPILegalHold(hold))
+	}
+	return artifact.ListLegalHolds200JSONResponse{
+		ListLegalHoldsResponseJSONResponse: artifact.ListLegalHoldsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteLegalHold releases a legal hold.
+func (c *APIController) DeleteLegalHold(
+	ctx context.Context,
+	r artifact.DeleteLegalHoldRequestObject,
+) (artifact.DeleteLegalHoldResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.DeleteLegalHold400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.DeleteLegalHold403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwDeleteLegalHold500Error(err), nil
+	}
+
+	err = c.LegalHoldStore.Delete(ctx, registry.ID, int64(r.LegalHoldId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteLegalHold404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "legal hold not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteLegalHold500Error(err), nil
+	}
+
+	c.logRegistryAudit(ctx, registry, audit.ActionDeleted,
+		audit.WithData("legal hold", strconv.FormatInt(int64(r.LegalHoldId), 10)),
+	)
+
+	return artifact.DeleteLegalHold200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// logRegistryAudit records an audit log entry of an action on the registry, failures are only logged.
+func (c *APIController) logRegistryAudit(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	action audit.Action,
+	options ...audit.Option,
+) {
+	space, err := c.SpaceFinder.FindByID(ctx, registry.ParentID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to find space of registry %s for audit log", registry.Name)
+		return
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	auditErr := c.AuditService.Log(
+		ctx,
+		session.Principal,
+		audit.NewResource(audit.ResourceTypeRegistry, registry.Name),
+		action,
+		space.Path,
+		options...,
+	)
+	if auditErr != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to insert audit log for registry %s: %s", registry.Name, auditErr)
+	}
+}
+
+func holdTarget(hold *registrytypes.LegalHold) string {
+	if hold.Version == "" {
+		return hold.ImageName
+	}
+	return hold.ImageName + "@" + hold.Version
+}
+
+func mapToAPILegalHold(hold *registrytypes.LegalHold) artifact.LegalHold {
+	apiHold := artifact.LegalHold{
+		Id:        hold.ID,
+		Package:   hold.ImageName,
+		Reason:    hold.Reason,
+		CreatedAt: hold.CreatedAt.UnixMilli(),
+		CreatedBy: hold.CreatedBy,
+	}
+	if hold.Version != "" {
+		apiHold.Version = &hold.Version
+	}
+	return apiHold
+}
+
+func throwCreateLegalHold400Error(err error) artifact.CreateLegalHold400JSONResponse {
+	return artifact.CreateLegalHold400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwCreateLegalHold500Error(err error) artifact.CreateLegalHold500JSONResponse {
+	return artifact.CreateLegalHold500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListLegalHolds500Error(err error) artifact.ListLegalHolds500JSONResponse {
+	return artifact.ListLegalHolds500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwDeleteLegalHold500Error(err error) artifact.DeleteLegalHold500JSONResponse {
+	return artifact.DeleteLegalHold500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -183,6 +183,8 @@ func mapTpAPITriggerType(trigger enum.WebhookTrigger) api.Trigger {
 		return api.TriggerARTIFACTMODIFICATION
 	case enum.WebhookTriggerArtifactDeleted:
 		return api.TriggerARTIFACTDELETION
+	case enum.WebhookTriggerArtifactBaseImageUpdated:
+		return api.TriggerBASEIMAGEMODIFICATION
 	}
 	return ""
 }
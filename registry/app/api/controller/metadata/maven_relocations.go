@@ -0,0 +1,268 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+var mavenCoordinatePattern = regexp.MustCompile(`^[A-Za-z0-9_.\-]+$`)
+
+// CreateMavenRelocation relocates a Maven artifact of the registry, replacing its previous relocation.
+func (c *APIController) CreateMavenRelocation(
+	ctx context.Context,
+	r artifact.CreateMavenRelocationRequestObject,
+) (artifact.CreateMavenRelocationResponseObject, error) {
+	if r.Body == nil {
+		return throwCreateMavenRelocation400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getMavenRelocationRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwCreateMavenRelocation400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.CreateMavenRelocation403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwCreateMavenRelocation500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	relocation := &registrytypes.MavenRelocation{
+		RegistryID:       registry.ID,
+		GroupID:          r.Body.GroupId,
+		ArtifactID:       r.Body.ArtifactId,
+		TargetGroupID:    r.Body.TargetGroupId,
+		TargetArtifactID: r.Body.TargetArtifactId,
+		CreatedBy:        session.Principal.ID,
+		UpdatedBy:        session.Principal.ID,
+	}
+	if r.Body.TargetVersion != nil {
+		relocation.TargetVersion = *r.Body.TargetVersion
+	}
+	if r.Body.Message != nil {
+		relocation.Message = *r.Body.Message
+	}
+	if err = validateMavenRelocation(relocation); err != nil {
+		return throwCreateMavenRelocation400Error(err), nil
+	}
+	if err = c.MavenRelocationStore.Upsert(ctx, relocation); err != nil {
+		return throwCreateMavenRelocation500Error(err), nil
+	}
+
+	return artifact.CreateMavenRelocation201JSONResponse{
+		MavenRelocationResponseJSONResponse: artifact.MavenRelocationResponseJSONResponse{
+			Data:   mapToAPIMavenRelocation(relocation),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListMavenRelocations lists the relocations of the Maven artifacts of the registry.
+func (c *APIController) ListMavenRelocations(
+	ctx context.Context,
+	r artifact.ListMavenRelocationsRequestObject,
+) (artifact.ListMavenRelocationsResponseObject, error) {
+	registry, status, err := c.getMavenRelocationRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListMavenRelocations400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListMavenRelocations403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListMavenRelocations500Error(err), nil
+	}
+
+	relocations, err := c.MavenRelocationStore.List(ctx, registry.ID)
+	if err != nil {
+		return throwListMavenRelocations500Error(err), nil
+	}
+	data := make([]artifact.MavenRelocation, 0, len(relocations))
+	for _, relocation := range relocations {
+		data = append(data, mapToAPIMavenRelocation(relocation))
+	}
+	return artifact.ListMavenRelocations200JSONResponse{
+		ListMavenRelocationsResponseJSONResponse: artifact.ListMavenRelocationsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteMavenRelocation stops serving relocation POMs at the old coordinates of a Maven artifact.
+func (c *APIController) DeleteMavenRelocation(
+	ctx context.Context,
+	r artifact.DeleteMavenRelocationRequestObject,
+) (artifact.DeleteMavenRelocationResponseObject, error) {
+	registry, status, err := c.getMavenRelocationRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.DeleteMavenRelocation400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.DeleteMavenRelocation403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwDeleteMavenRelocation500Error(err), nil
+	}
+
+	err = c.MavenRelocationStore.Delete(ctx, registry.ID, int64(r.RelocationId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteMavenRelocation404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "maven relocation not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteMavenRelocation500Error(err), nil
+	}
+	return artifact.DeleteMavenRelocation200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// getMavenRelocationRegistry returns the Maven registry the relocations are managed on, checking
+// the permission on it. On failure it returns the status of the error response.
+func (c *APIController) getMavenRelocationRegistry(
+	ctx context.Context,
+	registryRef string,
+	permission enum.Permission,
+) (*registrytypes.Registry, int, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", registryRef)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier, permission)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return nil, http.StatusForbidden, err
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if registry.PackageType != artifact.PackageTypeMAVEN {
+		return nil, http.StatusBadRequest, fmt.Errorf("registry %s is a %s registry, relocations are only "+
+			"supported by maven registries", registry.Name, registry.PackageType)
+	}
+	return registry, 0, nil
+}
+
+// validateMavenRelocation checks the coordinates of a relocation, which must not point the
+// artifact at itself.
+func validateMavenRelocation(relocation *registrytypes.MavenRelocation) error {
+	for name, value := range map[string]string{
+		"groupId":          relocation.GroupID,
+		"artifactId":       relocation.ArtifactID,
+		"targetGroupId":    relocation.TargetGroupID,
+		"targetArtifactId": relocation.TargetArtifactID,
+	} {
+		if !mavenCoordinatePattern.MatchString(value) {
+			return fmt.Errorf("invalid %s: %q", name, value)
+		}
+	}
+	if relocation.TargetVersion != "" && !mavenCoordinatePattern.MatchString(relocation.TargetVersion) {
+		return fmt.Errorf("invalid targetVersion: %q", relocation.TargetVersion)
+	}
+	if relocation.GroupID == relocation.TargetGroupID && relocation.ArtifactID == relocation.TargetArtifactID {
+		return fmt.Errorf("%s:%s can't be relocated to itself", relocation.GroupID, relocation.ArtifactID)
+	}
+	return nil
+}
+
+func mapToAPIMavenRelocation(relocation *registrytypes.MavenRelocation) artifact.MavenRelocation {
+	apiRelocation := artifact.MavenRelocation{
+		Id:               relocation.ID,
+		GroupId:          relocation.GroupID,
+		ArtifactId:       relocation.ArtifactID,
+		TargetGroupId:    relocation.TargetGroupID,
+		TargetArtifactId: relocation.TargetArtifactID,
+		UpdatedAt:        relocation.UpdatedAt.UnixMilli(),
+	}
+	if relocation.TargetVersion != "" {
+		apiRelocation.TargetVersion = &relocation.TargetVersion
+	}
+	if relocation.Message != "" {
+		apiRelocation.Message = &relocation.Message
+	}
+	return apiRelocation
+}
+
+func throwCreateMavenRelocation400Error(err error) artifact.CreateMavenRelocation400JSONResponse {
+	return artifact.CreateMavenRelocation400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwCreateMavenRelocation500Error(err error) artifact.CreateMavenRelocation500JSONResponse {
+	return artifact.CreateMavenRelocation500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListMavenRelocations500Error(err error) artifact.ListMavenRelocations500JSONResponse {
+	return artifact.ListMavenRelocations500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwDeleteMavenRelocation500Error(err error) artifact.DeleteMavenRelocation500JSONResponse {
+	return artifact.DeleteMavenRelocation500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
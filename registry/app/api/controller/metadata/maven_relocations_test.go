@@ -0,0 +1,43 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	registrytypes "github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMavenRelocation(t *testing.T) {
+	relocation := &registrytypes.MavenRelocation{
+		GroupID:          "com.example",
+		ArtifactID:       "lib",
+		TargetGroupID:    "org.example",
+		TargetArtifactID: "lib",
+	}
+	assert.NoError(t, validateMavenRelocation(relocation))
+
+	relocation.TargetVersion = "2.0/0"
+	assert.Error(t, validateMavenRelocation(relocation))
+
+	relocation.TargetVersion = ""
+	relocation.TargetGroupID = "com.example"
+	assert.Error(t, validateMavenRelocation(relocation))
+
+	relocation.ArtifactID = "lib:core"
+	assert.Error(t, validateMavenRelocation(relocation))
+}
@@ -0,0 +1,733 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/mirrorsync"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/distribution/reference"
+)
+
+// mirrorSyncRunsListed is the number of the most recent runs of a mirror sync that are listed.
+const mirrorSyncRunsListed = 50
+
+var mirrorRepositoryPattern = regexp.MustCompile("^" + reference.NameRegexp.String() + "$")
+
+// CreateMirrorSync replicates the tags of a repository of an upstream proxy into the registry every
+// interval.
+func (c *APIController) CreateMirrorSync(
+	ctx context.Context,
+	r artifact.CreateMirrorSyncRequestObject,
+) (artifact.CreateMirrorSyncResponseObject, error) {
+	if r.Body == nil {
+		return throwCreateMirrorSync400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getMirrorSyncRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwCreateMirrorSync400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.CreateMirrorSync403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwCreateMirrorSync500Error(err), nil
+	}
+
+	in := artifact.MirrorSyncRequest(*r.Body)
+	upstream, status, err := c.getMirrorSyncUpstream(ctx, registry, in.UpstreamRegistry)
+	switch status {
+	case http.StatusBadRequest:
+		return throwCreateMirrorSync400Error(err), nil
+	case http.StatusInternalServerError:
+		return throwCreateMirrorSync500Error(err), nil
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	sync := &registrytypes.MirrorSync{
+		RegistryID: registry.ID,
+		Repository: strings.TrimSpace(in.Repository),
+		NextRunAt:  time.Now(),
+		CreatedBy:  session.Principal.ID,
+	}
+	if !mirrorRepositoryPattern.MatchString(sync.Repository) {
+		return throwCreateMirrorSync400Error(fmt.Errorf("invalid repository %q, repositories are names such "+
+			"as library/nginx", sync.Repository)), nil
+	}
+	if err = applyMirrorSyncRequest(sync, upstream, in); err != nil {
+		return throwCreateMirrorSync400Error(err), nil
+	}
+	if err = c.MirrorSyncStore.Create(ctx, sync); err != nil {
+		if errors.Is(err, store.ErrDuplicate) {
+			return throwCreateMirrorSync400Error(fmt.Errorf("a mirror sync of %s already exists",
+				sync.Repository)), nil
+		}
+		return throwCreateMirrorSync500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionCreated,
+		audit.WithData("mirror sync", mirrorSyncSummary(sync, upstream.Name)),
+	)
+
+	return artifact.CreateMirrorSync201JSONResponse{
+		MirrorSyncResponseJSONResponse: artifact.MirrorSyncResponseJSONResponse{
+			Data:   mapToAPIMirrorSync(sync, upstream.Name, nil),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListMirrorSyncs lists the mirror syncs of the registry with their last run.
+func (c *APIController) ListMirrorSyncs(
+	ctx context.Context,
+	r artifact.ListMirrorSyncsRequestObject,
+) (artifact.ListMirrorSyncsResponseObject, error) {
+	registry, status, err := c.getMirrorSyncRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListMirrorSyncs400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListMirrorSyncs403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListMirrorSyncs500Error(err), nil
+	}
+
+	syncs, err := c.MirrorSyncStore.List(ctx, registry.ID)
+	if err != nil {
+		return throwListMirrorSyncs500Error(err), nil
+	}
+	data := make([]artifact.MirrorSync, 0, len(syncs))
+	upstreamNames := make(map[int64]string)
+	for _, sync := range syncs {
+		if _, ok := upstreamNames[sync.UpstreamRegistryID]; !ok {
+			upstream, err := c.RegistryRepository.Get(ctx, sync.UpstreamRegistryID)
+			if err != nil {
+				return throwListMirrorSyncs500Error(err), nil
+			}
+			upstreamNames[sync.UpstreamRegistryID] = upstream.Name
+		}
+		lastRun, err := c.getLastMirrorSyncRun(ctx, sync.ID)
+		if err != nil {
+			return throwListMirrorSyncs500Error(err), nil
+		}
+		data = append(data, mapToAPIMirrorSync(sync, upstreamNames[sync.UpstreamRegistryID], lastRun))
+	}
+	return artifact.ListMirrorSyncs200JSONResponse{
+		ListMirrorSyncsResponseJSONResponse: artifact.ListMirrorSyncsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetMirrorSync returns the mirror sync with its last run.
+func (c *APIController) GetMirrorSync(
+	ctx context.Context,
+	r artifact.GetMirrorSyncRequestObject,
+) (artifact.GetMirrorSyncResponseObject, error) {
+	_, sync, status, err := c.getMirrorSync(ctx, string(r.RegistryRef), int64(r.MirrorSyncId),
+		enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetMirrorSync400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetMirrorSync403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusNotFound:
+		return artifact.GetMirrorSync404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwGetMirrorSync500Error(err), nil
+	}
+
+	upstream, err := c.RegistryRepository.Get(ctx, sync.UpstreamRegistryID)
+	if err != nil {
+		return throwGetMirrorSync500Error(err), nil
+	}
+	lastRun, err := c.getLastMirrorSyncRun(ctx, sync.ID)
+	if err != nil {
+		return throwGetMirrorSync500Error(err), nil
+	}
+	return artifact.GetMirrorSync200JSONResponse{
+		MirrorSyncResponseJSONResponse: artifact.MirrorSyncResponseJSONResponse{
+			Data:   mapToAPIMirrorSync(sync, upstream.Name, lastRun),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// UpdateMirrorSync replaces the upstream proxy, tag pattern, interval and state of the mirror sync.
+func (c *APIController) UpdateMirrorSync(
+	ctx context.Context,
+	r artifact.UpdateMirrorSyncRequestObject,
+) (artifact.UpdateMirrorSyncResponseObject, error) {
+	if r.Body == nil {
+		return throwUpdateMirrorSync400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, sync, status, err := c.getMirrorSync(ctx, string(r.RegistryRef), int64(r.MirrorSyncId),
+		enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwUpdateMirrorSync400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.UpdateMirrorSync403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusNotFound:
+		return artifact.UpdateMirrorSync404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwUpdateMirrorSync500Error(err), nil
+	}
+
+	in := artifact.MirrorSyncRequest(*r.Body)
+	upstream, status, err := c.getMirrorSyncUpstream(ctx, registry, in.UpstreamRegistry)
+	switch status {
+	case http.StatusBadRequest:
+		return throwUpdateMirrorSync400Error(err), nil
+	case http.StatusInternalServerError:
+		return throwUpdateMirrorSync500Error(err), nil
+	}
+	wasEnabled := sync.Enabled
+	if err = applyMirrorSyncRequest(sync, upstream, in); err != nil {
+		return throwUpdateMirrorSync400Error(err), nil
+	}
+	// a re-enabled sync catches up on the runs it missed right away.
+	if sync.Enabled && !wasEnabled {
+		sync.NextRunAt = time.Now()
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	sync.UpdatedBy = session.Principal.ID
+	if err = c.MirrorSyncStore.Update(ctx, sync); err != nil {
+		return throwUpdateMirrorSync500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionUpdated,
+		audit.WithData("mirror sync", mirrorSyncSummary(sync, upstream.Name)),
+	)
+
+	lastRun, err := c.getLastMirrorSyncRun(ctx, sync.ID)
+	if err != nil {
+		return throwUpdateMirrorSync500Error(err), nil
+	}
+	return artifact.UpdateMirrorSync200JSONResponse{
+		MirrorSyncResponseJSONResponse: artifact.MirrorSyncResponseJSONResponse{
+			Data:   mapToAPIMirrorSync(sync, upstream.Name, lastRun),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteMirrorSync stops replicating the repository of the mirror sync, the replicated tags are kept.
+func (c *APIController) DeleteMirrorSync(
+	ctx context.Context,
+	r artifact.DeleteMirrorSyncRequestObject,
+) (artifact.DeleteMirrorSyncResponseObject, error) {
+	registry, status, err := c.getMirrorSyncRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.DeleteMirrorSync400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.DeleteMirrorSync403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwDeleteMirrorSync500Error(err), nil
+	}
+
+	sync, err := c.MirrorSyncStore.Get(ctx, registry.ID, int64(r.MirrorSyncId))
+	if err == nil {
+		err = c.MirrorSyncStore.Delete(ctx, registry.ID, sync.ID)
+	}
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteMirrorSync404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "mirror sync not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteMirrorSync500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionDeleted, audit.WithData("mirror sync", sync.Repository))
+
+	return artifact.DeleteMirrorSync200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// RunMirrorSync starts a run of the mirror sync ahead of its next interval.
+func (c *APIController) RunMirrorSync(
+	ctx context.Context,
+	r artifact.RunMirrorSyncRequestObject,
+) (artifact.RunMirrorSyncResponseObject, error) {
+	registry, sync, status, err := c.getMirrorSync(ctx, string(r.RegistryRef), int64(r.MirrorSyncId),
+		enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwRunMirrorSync400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.RunMirrorSync403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusNotFound:
+		return artifact.RunMirrorSync404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwRunMirrorSync500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	run, err := c.MirrorSyncService.Run(ctx, sync, session.Principal.ID)
+	if errors.Is(err, mirrorsync.ErrRunInProgress) {
+		return throwRunMirrorSync400Error(err), nil
+	}
+	if err != nil {
+		return throwRunMirrorSync500Error(err), nil
+	}
+	c.logRegistryAudit(ctx, registry, audit.ActionUpdated, audit.WithData("mirror sync run", sync.Repository))
+
+	return artifact.RunMirrorSync202JSONResponse{
+		MirrorSyncRunResponseJSONResponse: artifact.MirrorSyncRunResponseJSONResponse{
+			Data:   mapToAPIMirrorSyncRun(run, nil),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListMirrorSyncRuns lists the most recent runs of the mirror sync.
+func (c *APIController) ListMirrorSyncRuns(
+	ctx context.Context,
+	r artifact.ListMirrorSyncRunsRequestObject,
+) (artifact.ListMirrorSyncRunsResponseObject, error) {
+	_, sync, status, err := c.getMirrorSync(ctx, string(r.RegistryRef), int64(r.MirrorSyncId),
+		enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListMirrorSyncRuns400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListMirrorSyncRuns403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusNotFound:
+		return artifact.ListMirrorSyncRuns404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListMirrorSyncRuns500Error(err), nil
+	}
+
+	runs, err := c.MirrorSyncStore.ListRuns(ctx, sync.ID, mirrorSyncRunsListed)
+	if err != nil {
+		return throwListMirrorSyncRuns500Error(err), nil
+	}
+	data := make([]artifact.MirrorSyncRun, 0, len(runs))
+	for _, run := range runs {
+		data = append(data, mapToAPIMirrorSyncRun(run, nil))
+	}
+	return artifact.ListMirrorSyncRuns200JSONResponse{
+		ListMirrorSyncRunsResponseJSONResponse: artifact.ListMirrorSyncRunsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetMirrorSyncRun returns the run of the mirror sync with the status of each tag it replicates.
+func (c *APIController) GetMirrorSyncRun(
+	ctx context.Context,
+	r artifact.GetMirrorSyncRunRequestObject,
+) (artifact.GetMirrorSyncRunResponseObject, error) {
+	_, sync, status, err := c.getMirrorSync(ctx, string(r.RegistryRef), int64(r.MirrorSyncId),
+		enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetMirrorSyncRun400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetMirrorSyncRun403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusNotFound:
+		return throwGetMirrorSyncRun404Error(err), nil
+	case http.StatusInternalServerError:
+		return throwGetMirrorSyncRun500Error(err), nil
+	}
+
+	run, err := c.MirrorSyncStore.GetRun(ctx, sync.ID, int64(r.MirrorSyncRunId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return throwGetMirrorSyncRun404Error(fmt.Errorf("mirror sync run not found")), nil
+	}
+	if err != nil {
+		return throwGetMirrorSyncRun500Error(err), nil
+	}
+	artifacts, err := c.MirrorSyncStore.ListArtifacts(ctx, run.ID)
+	if err != nil {
+		return throwGetMirrorSyncRun500Error(err), nil
+	}
+	return artifact.GetMirrorSyncRun200JSONResponse{
+		MirrorSyncRunResponseJSONResponse: artifact.MirrorSyncRunResponseJSONResponse{
+			Data:   mapToAPIMirrorSyncRun(run, artifacts),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// getMirrorSyncRegistry returns the registry the tags of the mirror syncs are replicated into, checking
+// the permission on it.
+func (c *APIController) getMirrorSyncRegistry(
+	ctx context.Context,
+	registryRef string,
+	permission enum.Permission,
+) (*registrytypes.Registry, int, error) {
+	registry, status, err := c.getRegistry(ctx, registryRef, permission)
+	if err != nil {
+		return nil, status, err
+	}
+	if registry.Type != artifact.RegistryTypeVIRTUAL || (registry.PackageType != artifact.PackageTypeDOCKER &&
+		registry.PackageType != artifact.PackageTypeHELM) {
+		return nil, http.StatusBadRequest, fmt.Errorf("registry %s is a %s %s registry, mirror syncs are only "+
+			"supported by Docker and Helm registries artifacts are pushed to", registry.Name,
+			registry.PackageType, registry.Type)
+	}
+	return registry, 0, nil
+}
+
+// getMirrorSync returns the mirror sync of the registry, checking the permission on the registry. On
+// failure it returns the status of the error response.
+func (c *APIController) getMirrorSync(
+	ctx context.Context,
+	registryRef string,
+	id int64,
+	permission enum.Permission,
+) (*registrytypes.Registry, *registrytypes.MirrorSync, int, error) {
+	registry, status, err := c.getMirrorSyncRegistry(ctx, registryRef, permission)
+	if err != nil {
+		return nil, nil, status, err
+	}
+	sync, err := c.MirrorSyncStore.Get(ctx, registry.ID, id)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, nil, http.StatusNotFound, fmt.Errorf("mirror sync not found")
+	}
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, err
+	}
+	return registry, sync, 0, nil
+}
+
+// getMirrorSyncUpstream returns the upstream proxy the registry replicates a repository from, which must
+// be of the package type of the registry and in its space.
+func (c *APIController) getMirrorSyncUpstream(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	identifier string,
+) (*registrytypes.Registry, int, error) {
+	upstream, err := c.RegistryRepository.GetByParentIDAndName(ctx, registry.ParentID, identifier)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, http.StatusBadRequest, fmt.Errorf("upstream registry %q not found", identifier)
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if upstream.Type != artifact.RegistryTypeUPSTREAM || upstream.PackageType != registry.PackageType {
+		return nil, http.StatusBadRequest, fmt.Errorf("registry %s is a %s %s registry, repositories are "+
+			"replicated from %s upstream registries", upstream.Name, upstream.PackageType, upstream.Type,
+			registry.PackageType)
+	}
+	return upstream, 0, nil
+}
+
+func (c *APIController) getLastMirrorSyncRun(
+	ctx context.Context,
+	syncID int64,
+) (*registrytypes.MirrorSyncRun, error) {
+	runs, err := c.MirrorSyncStore.ListRuns(ctx, syncID, 1)
+	if err != nil || len(runs) == 0 {
+		return nil, err
+	}
+	return runs[0], nil
+}
+
+// applyMirrorSyncRequest sets the upstream proxy, tag pattern, interval and state of the request on the
+// mirror sync, the repository of a mirror sync is set on creation only.
+func applyMirrorSyncRequest(
+	sync *registrytypes.MirrorSync,
+	upstream *registrytypes.Registry,
+	in artifact.MirrorSyncRequest,
+) error {
+	sync.UpstreamRegistryID = upstream.ID
+	sync.TagPattern = ""
+	if in.TagPattern != nil {
+		sync.TagPattern = strings.TrimSpace(*in.TagPattern)
+	}
+	if _, err := mirrorsync.FilterTags(nil, sync.TagPattern); err != nil {
+		return err
+	}
+	sync.IntervalMinutes = mirrorsync.DefaultIntervalMinutes
+	if in.IntervalMinutes != nil {
+		sync.IntervalMinutes = *in.IntervalMinutes
+	}
+	if sync.IntervalMinutes < mirrorsync.MinIntervalMinutes {
+		return fmt.Errorf("interval of %d minutes is too short, mirror syncs are run at most every %d minutes",
+			sync.IntervalMinutes, mirrorsync.MinIntervalMinutes)
+	}
+	sync.Enabled = true
+	if in.Enabled != nil {
+		sync.Enabled = *in.Enabled
+	}
+	return nil
+}
+
+func mapToAPIMirrorSync(
+	sync *registrytypes.MirrorSync,
+	upstreamName string,
+	lastRun *registrytypes.MirrorSyncRun,
+) artifact.MirrorSync {
+	data := artifact.MirrorSync{
+		Id:               sync.ID,
+		UpstreamRegistry: upstreamName,
+		Repository:       sync.Repository,
+		IntervalMinutes:  sync.IntervalMinutes,
+		Enabled:          sync.Enabled,
+		NextRunAt:        sync.NextRunAt.UnixMilli(),
+		CreatedAt:        sync.CreatedAt.UnixMilli(),
+		UpdatedAt:        sync.UpdatedAt.UnixMilli(),
+	}
+	if sync.TagPattern != "" {
+		data.TagPattern = &sync.TagPattern
+	}
+	if lastRun != nil {
+		run := mapToAPIMirrorSyncRun(lastRun, nil)
+		data.LastRun = &run
+	}
+	return data
+}
+
+func mapToAPIMirrorSyncRun(
+	run *registrytypes.MirrorSyncRun,
+	artifacts []*registrytypes.MirrorSyncArtifact,
+) artifact.MirrorSyncRun {
+	data := artifact.MirrorSyncRun{
+		Id:        run.ID,
+		Status:    artifact.MirrorSyncRunStatus(run.Status),
+		Trigger:   artifact.MirrorSyncTrigger(run.Trigger),
+		Tags:      run.Tags,
+		Synced:    run.Synced,
+		Skipped:   run.Skipped,
+		Failed:    run.Failed,
+		CreatedAt: run.CreatedAt.UnixMilli(),
+	}
+	if run.Error != "" {
+		data.Error = &run.Error
+	}
+	if !run.StartedAt.IsZero() {
+		startedAt := run.StartedAt.UnixMilli()
+		data.StartedAt = &startedAt
+	}
+	if !run.FinishedAt.IsZero() {
+		finishedAt := run.FinishedAt.UnixMilli()
+		data.FinishedAt = &finishedAt
+	}
+	if artifacts != nil {
+		apiArtifacts := make([]artifact.MirrorSyncArtifact, 0, len(artifacts))
+		for _, a := range artifacts {
+			apiArtifact := artifact.MirrorSyncArtifact{
+				Tag:       a.Tag,
+				Status:    artifact.MirrorSyncArtifactStatus(a.Status),
+				UpdatedAt: a.UpdatedAt.UnixMilli(),
+			}
+			if a.Digest != "" {
+				dgst := a.Digest
+				apiArtifact.Digest = &dgst
+			}
+			if a.Error != "" {
+				errMsg := a.Error
+				apiArtifact.Error = &errMsg
+			}
+			apiArtifacts = append(apiArtifacts, apiArtifact)
+		}
+		data.Artifacts = &apiArtifacts
+	}
+	return data
+}
+
+// mirrorSyncSummary describes the mirror sync for the audit log.
+func mirrorSyncSummary(sync *registrytypes.MirrorSync, upstreamName string) string {
+	tags := "all tags"
+	if sync.TagPattern != "" {
+		tags = "tags matching " + sync.TagPattern
+	}
+	state := fmt.Sprintf("every %d minutes", sync.IntervalMinutes)
+	if !sync.Enabled {
+		state = "disabled"
+	}
+	return fmt.Sprintf("%s of %s from %s, %s", tags, sync.Repository, upstreamName, state)
+}
+
+func throwCreateMirrorSync400Error(err error) artifact.CreateMirrorSync400JSONResponse {
+	return artifact.CreateMirrorSync400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwCreateMirrorSync500Error(err error) artifact.CreateMirrorSync500JSONResponse {
+	return artifact.CreateMirrorSync500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListMirrorSyncs500Error(err error) artifact.ListMirrorSyncs500JSONResponse {
+	return artifact.ListMirrorSyncs500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwGetMirrorSync500Error(err error) artifact.GetMirrorSync500JSONResponse {
+	return artifact.GetMirrorSync500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwUpdateMirrorSync400Error(err error) artifact.UpdateMirrorSync400JSONResponse {
+	return artifact.UpdateMirrorSync400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwUpdateMirrorSync500Error(err error) artifact.UpdateMirrorSync500JSONResponse {
+	return artifact.UpdateMirrorSync500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwDeleteMirrorSync500Error(err error) artifact.DeleteMirrorSync500JSONResponse {
+	return artifact.DeleteMirrorSync500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwRunMirrorSync400Error(err error) artifact.RunMirrorSync400JSONResponse {
+	return artifact.RunMirrorSync400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwRunMirrorSync500Error(err error) artifact.RunMirrorSync500JSONResponse {
+	return artifact.RunMirrorSync500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListMirrorSyncRuns500Error(err error) artifact.ListMirrorSyncRuns500JSONResponse {
+	return artifact.ListMirrorSyncRuns500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwGetMirrorSyncRun404Error(err error) artifact.GetMirrorSyncRun404JSONResponse {
+	return artifact.GetMirrorSyncRun404JSONResponse{
+		NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+			*GetErrorResponse(http.StatusNotFound, err.Error()),
+		),
+	}
+}
+
+func throwGetMirrorSyncRun500Error(err error) artifact.GetMirrorSyncRun500JSONResponse {
+	return artifact.GetMirrorSyncRun500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMirrorSyncRequest(t *testing.T) {
+	upstream := &registrytypes.Registry{ID: 7, Name: "dockerhub"}
+	sync := &registrytypes.MirrorSync{Repository: "library/nginx"}
+	require.NoError(t, applyMirrorSyncRequest(sync, upstream, artifact.MirrorSyncRequest{}))
+	assert.Equal(t, int64(7), sync.UpstreamRegistryID)
+	assert.Equal(t, 60, sync.IntervalMinutes)
+	assert.True(t, sync.Enabled)
+	assert.Equal(t, "all tags of library/nginx from dockerhub, every 60 minutes", mirrorSyncSummary(sync, "dockerhub"))
+
+	pattern := ` ^1\.2[0-9]$ `
+	interval := 15
+	enabled := false
+	require.NoError(t, applyMirrorSyncRequest(sync, upstream, artifact.MirrorSyncRequest{
+		TagPattern:      &pattern,
+		IntervalMinutes: &interval,
+		Enabled:         &enabled,
+	}))
+	assert.Equal(t, `^1\.2[0-9]$`, sync.TagPattern)
+	assert.Equal(t, 15, sync.IntervalMinutes)
+	assert.Equal(t, `tags matching ^1\.2[0-9]$ of library/nginx from dockerhub, disabled`,
+		mirrorSyncSummary(sync, "dockerhub"))
+
+	invalid := "(1.2"
+	assert.Error(t, applyMirrorSyncRequest(sync, upstream, artifact.MirrorSyncRequest{TagPattern: &invalid}))
+	short := 1
+	assert.Error(t, applyMirrorSyncRequest(sync, upstream, artifact.MirrorSyncRequest{IntervalMinutes: &short}))
+}
+
+func TestMirrorRepositoryPattern(t *testing.T) {
+	assert.True(t, mirrorRepositoryPattern.MatchString("nginx"))
+	assert.True(t, mirrorRepositoryPattern.MatchString("bitnami/charts/redis"))
+	assert.False(t, mirrorRepositoryPattern.MatchString("library/nginx:1.25"))
+	assert.False(t, mirrorRepositoryPattern.MatchString("library//nginx"))
+}
+
+func TestMapToAPIMirrorSyncRun(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	run := &registrytypes.MirrorSyncRun{
+		ID:        3,
+		Status:    registrytypes.MirrorSyncRunStatusFailed,
+		Trigger:   registrytypes.MirrorSyncTriggerManual,
+		Tags:      2,
+		Synced:    1,
+		Failed:    1,
+		StartedAt: now,
+		CreatedAt: now,
+	}
+	data := mapToAPIMirrorSyncRun(run, []*registrytypes.MirrorSyncArtifact{
+		{Tag: "1.25", Digest: "sha256:abc", Status: registrytypes.MirrorSyncArtifactStatusSynced, UpdatedAt: now},
+		{Tag: "1.26", Status: registrytypes.MirrorSyncArtifactStatusFailed, Error: "not found", UpdatedAt: now},
+	})
+	assert.Equal(t, artifact.MirrorSyncRunStatus(registrytypes.MirrorSyncRunStatusFailed), data.Status)
+	require.NotNil(t, data.StartedAt)
+	assert.Equal(t, int64(1700000000000), *data.StartedAt)
+	assert.Nil(t, data.FinishedAt)
+	assert.Nil(t, data.Error)
+	require.NotNil(t, data.Artifacts)
+	require.Len(t, *data.Artifacts, 2)
+	assert.Equal(t, "sha256:abc", *(*data.Artifacts)[0].Digest)
+	assert.Nil(t, (*data.Artifacts)[0].Error)
+	assert.Nil(t, (*data.Artifacts)[1].Digest)
+	assert.Equal(t, "not found", *(*data.Artifacts)[1].Error)
+
+	assert.Nil(t, mapToAPIMirrorSyncRun(run, nil).Artifacts)
+}
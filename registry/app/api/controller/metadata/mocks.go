@@ -267,6 +267,11 @@ func (m *MockRegistryRepository) GetByIDIn(_ context.Context, _ []int64) (regist
 	panic("implement me")
 }
 
+func (m *MockRegistryRepository) ListByParentID(_ context.Context, _ int64) (registries *[]types.Registry, err error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (m *MockRegistryRepository) GetByRootParentIDAndName(
 	_ context.Context, _ int64, _ string,
 ) (registry *types.Registry, err error) {
@@ -329,6 +334,26 @@ func (m *MockRegistryRepository) Count(_ context.Context) (int64, error) {
 	panic("implement me")
 }
 
+func (m *MockRegistryRepository) CloneArtifacts(_ context.Context, _ int64, _ int64) error {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *MockRegistryRepository) UpdateStoragePrefix(_ context.Context, _ int64, _ string) error {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *MockRegistryRepository) ListStoragePrefixes(_ context.Context, _ int64) ([]string, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
+func (m *MockRegistryRepository) FindRootParentIDsByStoragePrefix(_ context.Context, _ string) ([]int64, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (m *MockSpacePathStore) InsertSegment(_ context.Context, _ *gitnesstypes.SpacePathSegment) error {
 	// TODO implement me
 	panic("implement me")
@@ -0,0 +1,375 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/onboarding"
+	"github.com/harness/gitness/store"
+	gitnessenum "github.com/harness/gitness/types/enum"
+)
+
+// maxIdentifierSuggestions is the number of suffixed identifiers tried for a suggestion when an
+// identifier is taken.
+const maxIdentifierSuggestions = 10
+
+func (c *APIController) ValidateRegistryIdentifier(
+	ctx context.Context,
+	r artifact.ValidateRegistryIdentifierRequestObject,
+) (artifact.ValidateRegistryIdentifierResponseObject, error) {
+	regInfo, err := c.checkOnboardingAccess(ctx, r.Body.ParentRef)
+	if err != nil {
+		if errors.Is(err, apiauth.ErrNotAuthorized) {
+			return artifact.ValidateRegistryIdentifier403JSONResponse{
+				UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+					*GetErrorResponse(http.StatusForbidden, err.Error()),
+				),
+			}, nil
+		}
+		return artifact.ValidateRegistryIdentifier400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	check := artifact.RegistryIdentifierCheck{Identifier: r.Body.Identifier}
+	if err := ValidateIdentifier(r.Body.Identifier); err != nil {
+		reason := err.Error()
+		check.Reason = &reason
+		return artifact.ValidateRegistryIdentifier200JSONResponse{
+			RegistryIdentifierCheckResponseJSONResponse: artifact.RegistryIdentifierCheckResponseJSONResponse{
+				Data:   check,
+				Status: artifact.StatusSUCCESS,
+			},
+		}, nil
+	}
+	check.Valid = true
+
+	available, err := c.isIdentifierAvailable(ctx, regInfo.rootIdentifierID, r.Body.Identifier)
+	if err != nil {
+		return artifact.ValidateRegistryIdentifier500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	check.Available = available
+	if !available {
+		reason := fmt.Sprintf("a registry named '%s' already exists in '%s'", r.Body.Identifier,
+			regInfo.RootIdentifier)
+		check.Reason = &reason
+		for i := 2; i <= maxIdentifierSuggestions; i++ {
+			suggestion := fmt.Sprintf("%s-%d", r.Body.Identifier, i)
+			if ValidateIdentifier(suggestion) != nil {
+				break
+			}
+			free, err := c.isIdentifierAvailable(ctx, regInfo.rootIdentifierID, suggestion)
+			if err != nil {
+				return artifact.ValidateRegistryIdentifier500JSONResponse{
+					InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+						*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+					),
+				}, nil
+			}
+			if free {
+				check.Suggestion = &suggestion
+				break
+			}
+		}
+	}
+
+	return artifact.ValidateRegistryIdentifier200JSONResponse{
+		RegistryIdentifierCheckResponseJSONResponse: artifact.RegistryIdentifierCheckResponseJSONResponse{
+			Data:   check,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func (c *APIController) CheckUpstreamConnectivity(
+	ctx context.Context,
+	r artifact.CheckUpstreamConnectivityRequestObject,
+) (artifact.CheckUpstreamConnectivityResponseObject, error) {
+	if _, err := c.checkOnboardingAccess(ctx, r.Body.ParentRef); err != nil {
+		if errors.Is(err, apiauth.ErrNotAuthorized) {
+			return artifact.CheckUpstreamConnectivity403JSONResponse{
+				UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+					*GetErrorResponse(http.StatusForbidden, err.Error()),
+				),
+			}, nil
+		}
+		return artifact.CheckUpstreamConnectivity400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	result := c.OnboardingService.CheckUpstream(ctx, onboarding.Target{
+		PackageType: r.Body.PackageType,
+		Source:      derefString(r.Body.Source),
+		URL:         derefString(r.Body.Url),
+		UserName:    derefString(r.Body.UserName),
+		Password:    derefString(r.Body.Password),
+	})
+	check := artifact.UpstreamCheck{
+		Url:        result.URL,
+		Reachable:  result.Reachable,
+		Authorized: result.Authorized,
+		LatencyMs:  result.Latency.Milliseconds(),
+	}
+	if result.StatusCode != 0 {
+		check.StatusCode = &result.StatusCode
+	}
+	if result.Message != "" {
+		check.Message = &result.Message
+	}
+	return artifact.CheckUpstreamConnectivity200JSONResponse{
+		UpstreamCheckResponseJSONResponse: artifact.UpstreamCheckResponseJSONResponse{
+			Data:   check,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func (c *APIController) EstimateRegistryImport(
+	ctx context.Context,
+	r artifact.EstimateRegistryImportRequestObject,
+) (artifact.EstimateRegistryImportResponseObject, error) {
+	if _, err := c.checkOnboardingAccess(ctx, r.Body.ParentRef); err != nil {
+		if errors.Is(err, apiauth.ErrNotAuthorized) {
+			return artifact.EstimateRegistryImport403JSONResponse{
+				UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+					*GetErrorResponse(http.StatusForbidden, err.Error()),
+				),
+			}, nil
+		}
+		return artifact.EstimateRegistryImport400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	var repositories []string
+	if r.Body.Repositories != nil {
+		repositories = *r.Body.Repositories
+	}
+	estimate, err := c.OnboardingService.EstimateImport(ctx, onboarding.Target{
+		PackageType: r.Body.PackageType,
+		Source:      derefString(r.Body.Source),
+		URL:         derefString(r.Body.Url),
+		UserName:    derefString(r.Body.UserName),
+		Password:    derefString(r.Body.Password),
+	}, repositories)
+	if err != nil {
+		return artifact.EstimateRegistryImport400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	return artifact.EstimateRegistryImport200JSONResponse{
+		ImportEstimateResponseJSONResponse: artifact.ImportEstimateResponseJSONResponse{
+			Data: artifact.ImportEstimate{
+				RepositoryCount:     estimate.Repositories,
+				SampledRepositories: estimate.SampledRepositories,
+				TagCount:            estimate.Tags,
+				SampledTags:         estimate.SampledTags,
+				SampledSizeBytes:    estimate.SampledSize,
+				EstimatedSizeBytes:  estimate.Size,
+				EstimatedSize:       GetSize(estimate.Size),
+				Exact:               estimate.Exact,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// OnboardRegistry creates a registry from the few settings the onboarding asks for, setting the
+// rest to their recommended values: an upstream registry if an upstream is given and a virtual
+// registry otherwise, with the latest version picked the way the package ecosystem orders
+// versions.
+func (c *APIController) OnboardRegistry(
+	ctx context.Context,
+	r artifact.OnboardRegistryRequestObject,
+) (artifact.OnboardRegistryResponseObject, error) {
+	registryRequest, appliedDefaults, err := onboardingRegistryRequest(artifact.RegistryOnboardingRequest(*r.Body))
+	if err != nil {
+		return artifact.OnboardRegistry400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	}
+
+	body := artifact.CreateRegistryJSONRequestBody(registryRequest)
+	response, err := c.CreateRegistry(ctx, artifact.CreateRegistryRequestObject{Body: &body})
+	switch resp := response.(type) {
+	case artifact.CreateRegistry201JSONResponse:
+		return artifact.OnboardRegistry201JSONResponse{
+			RegistryOnboardingResponseJSONResponse: artifact.RegistryOnboardingResponseJSONResponse{
+				Data: artifact.RegistryOnboarding{
+					Registry:        resp.Data,
+					AppliedDefaults: appliedDefaults,
+				},
+				Status: artifact.StatusSUCCESS,
+			},
+		}, nil
+	case artifact.CreateRegistry400JSONResponse:
+		return artifact.OnboardRegistry400JSONResponse(resp), nil
+	case artifact.CreateRegistry403JSONResponse:
+		return artifact.OnboardRegistry403JSONResponse(resp), nil
+	}
+	if err == nil {
+		err = errors.New("unexpected response creating the registry")
+	}
+	return artifact.OnboardRegistry500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}, nil
+}
+
+// onboardingRegistryRequest returns the request creating the onboarded registry and the settings
+// that were defaulted for it.
+func onboardingRegistryRequest(
+	req artifact.RegistryOnboardingRequest,
+) (artifact.RegistryRequest, []string, error) {
+	appliedDefaults := []string{}
+	registryRequest := artifact.RegistryRequest{
+		Identifier:  req.Identifier,
+		PackageType: req.PackageType,
+		ParentRef:   &req.ParentRef,
+		Description: req.Description,
+	}
+	if req.Description == nil || *req.Description == "" {
+		description := fmt.Sprintf("%s registry", req.PackageType)
+		registryRequest.Description = &description
+		appliedDefaults = append(appliedDefaults, "description")
+	}
+
+	strategy := recommendedLatestVersionStrategy(req.PackageType)
+	registryRequest.LatestVersionStrategy = &strategy
+	appliedDefaults = append(appliedDefaults, "latestVersionStrategy")
+
+	config := &artifact.RegistryConfig{}
+	if req.Upstream != nil {
+		upstream := *req.Upstream
+		if upstream.Source == nil {
+			source := recommendedUpstreamSource(req.PackageType, upstream.Url)
+			upstream.Source = &source
+			appliedDefaults = append(appliedDefaults, "upstream.source")
+		}
+		if upstream.AuthType == "" {
+			upstream.AuthType = artifact.AuthTypeAnonymous
+			appliedDefaults = append(appliedDefaults, "upstream.authType")
+		}
+		if err := config.FromUpstreamConfig(upstream); err != nil {
+			return artifact.RegistryRequest{}, nil, err
+		}
+		config.Type = artifact.RegistryTypeUPSTREAM
+	} else {
+		if err := config.FromVirtualConfig(artifact.VirtualConfig{UpstreamProxies: req.UpstreamProxies}); err != nil {
+			return artifact.RegistryRequest{}, nil, err
+		}
+		config.Type = artifact.RegistryTypeVIRTUAL
+	}
+	registryRequest.Config = config
+	return registryRequest, appliedDefaults, nil
+}
+
+// recommendedLatestVersionStrategy orders versions semantically for the ecosystems whose
+// versions are semantic by convention and by push time for all others, like docker tags.
+func recommendedLatestVersionStrategy(packageType artifact.PackageType) artifact.LatestVersionStrategy {
+	//nolint:exhaustive
+	switch packageType {
+	case artifact.PackageTypeNPM, artifact.PackageTypeCRATE, artifact.PackageTypeGO, artifact.PackageTypeNUGET,
+		artifact.PackageTypeGEMS, artifact.PackageTypeCOMPOSER:
+		return artifact.LatestVersionStrategySEMVER
+	default:
+		return artifact.LatestVersionStrategyLASTPUSHED
+	}
+}
+
+// recommendedUpstreamSource is the public source of the package type if no URL is given, and a
+// custom source otherwise.
+func recommendedUpstreamSource(packageType artifact.PackageType, url *string) artifact.UpstreamConfigSource {
+	if url != nil && *url != "" {
+		return artifact.UpstreamConfigSourceCustom
+	}
+	//nolint:exhaustive
+	switch packageType {
+	case artifact.PackageTypeDOCKER:
+		return artifact.UpstreamConfigSourceDockerhub
+	case artifact.PackageTypeMAVEN:
+		return artifact.UpstreamConfigSourceMavenCentral
+	case artifact.PackageTypeNPM:
+		return artifact.UpstreamConfigSourceNpmjs
+	case artifact.PackageTypePYTHON:
+		return artifact.UpstreamConfigSourcePyPi
+	default:
+		return artifact.UpstreamConfigSourceCustom
+	}
+}
+
+// checkOnboardingAccess checks the principal can create registries in the parent space.
+func (c *APIController) checkOnboardingAccess(
+	ctx context.Context,
+	parentRef string,
+) (*RegistryRequestBaseInfo, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, parentRef, "")
+	if err != nil {
+		return nil, err
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return nil, err
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.Authorizer,
+		session,
+		space,
+		gitnessenum.ResourceTypeRegistry,
+		gitnessenum.PermissionRegistryEdit,
+	); err != nil {
+		return nil, err
+	}
+	return regInfo, nil
+}
+
+// isIdentifierAvailable reports whether no registry of the root space has the identifier, as
+// registry identifiers are unique within a root space.
+func (c *APIController) isIdentifierAvailable(ctx context.Context, rootID int64, identifier string) (bool, error) {
+	_, err := c.RegistryRepository.GetByRootParentIDAndName(ctx, rootID, identifier)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, nil
+}
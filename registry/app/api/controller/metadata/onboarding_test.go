@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnboardingRegistryRequest(t *testing.T) {
+	req, appliedDefaults, err := onboardingRegistryRequest(artifact.RegistryOnboardingRequest{
+		Identifier:  "docker-hub",
+		PackageType: artifact.PackageTypeDOCKER,
+		ParentRef:   "acme",
+		Upstream:    &artifact.UpstreamConfig{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"description", "latestVersionStrategy", "upstream.source", "upstream.authType"},
+		appliedDefaults)
+	assert.Equal(t, artifact.LatestVersionStrategyLASTPUSHED, *req.LatestVersionStrategy)
+	assert.Equal(t, artifact.RegistryTypeUPSTREAM, req.Config.Type)
+	upstream, err := req.Config.AsUpstreamConfig()
+	require.NoError(t, err)
+	assert.Equal(t, artifact.UpstreamConfigSourceDockerhub, *upstream.Source)
+	assert.Equal(t, artifact.AuthTypeAnonymous, upstream.AuthType)
+
+	description := "packages"
+	proxies := []string{"npmjs"}
+	req, appliedDefaults, err = onboardingRegistryRequest(artifact.RegistryOnboardingRequest{
+		Identifier:      "npm",
+		PackageType:     artifact.PackageTypeNPM,
+		ParentRef:       "acme",
+		Description:     &description,
+		UpstreamProxies: &proxies,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"latestVersionStrategy"}, appliedDefaults)
+	assert.Equal(t, artifact.LatestVersionStrategySEMVER, *req.LatestVersionStrategy)
+	assert.Equal(t, artifact.RegistryTypeVIRTUAL, req.Config.Type)
+	virtual, err := req.Config.AsVirtualConfig()
+	require.NoError(t, err)
+	assert.Equal(t, proxies, *virtual.UpstreamProxies)
+}
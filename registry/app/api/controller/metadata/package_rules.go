@@ -0,0 +1,354 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CreatePackageRule adds a rule deciding which packages the upstream proxy fetches from its upstream.
+func (c *APIController) CreatePackageRule(
+	ctx context.Context,
+	r artifact.CreatePackageRuleRequestObject,
+) (artifact.CreatePackageRuleResponseObject, error) {
+	if r.Body == nil {
+		return throwCreatePackageRule400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit,
+		"package rules")
+	switch status {
+	case http.StatusBadRequest:
+		return throwCreatePackageRule400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.CreatePackageRule403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwCreatePackageRule500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	rule := &registrytypes.PackageRule{
+		RegistryID: registry.ID,
+		Action:     registrytypes.PackageRuleAction(r.Body.Action),
+		CreatedBy:  session.Principal.ID,
+		UpdatedBy:  session.Principal.ID,
+	}
+	if r.Body.Group != nil {
+		rule.Group = *r.Body.Group
+	}
+	if r.Body.Name != nil {
+		rule.Name = *r.Body.Name
+	}
+	if r.Body.VersionRange != nil {
+		rule.VersionRange = *r.Body.VersionRange
+	}
+	if r.Body.Description != nil {
+		rule.Description = *r.Body.Description
+	}
+	if err = proxy.ValidatePackageRule(rule); err != nil {
+		return throwCreatePackageRule400Error(err), nil
+	}
+	if err = c.PackageRuleStore.Create(ctx, rule); err != nil {
+		return throwCreatePackageRule500Error(err), nil
+	}
+
+	return artifact.CreatePackageRule201JSONResponse{
+		PackageRuleResponseJSONResponse: artifact.PackageRuleResponseJSONResponse{
+			Data:   mapToAPIPackageRule(rule),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListPackageRules lists the package rules of the upstream proxy.
+func (c *APIController) ListPackageRules(
+	ctx context.Context,
+	r artifact.ListPackageRulesRequestObject,
+) (artifact.ListPackageRulesResponseObject, error) {
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView,
+		"package rules")
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListPackageRules400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListPackageRules403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListPackageRules500Error(err), nil
+	}
+
+	rules, err := c.PackageRuleStore.List(ctx, registry.ID)
+	if err != nil {
+		return throwListPackageRules500Error(err), nil
+	}
+	data := make([]artifact.PackageRule, 0, len(rules))
+	for _, rule := range rules {
+		data = append(data, mapToAPIPackageRule(rule))
+	}
+	return artifact.ListPackageRules200JSONResponse{
+		ListPackageRulesResponseJSONResponse: artifact.ListPackageRulesResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeletePackageRule deletes a package rule of the upstream proxy.
+func (c *APIController) DeletePackageRule(
+	ctx context.Context,
+	r artifact.DeletePackageRuleRequestObject,
+) (artifact.DeletePackageRuleResponseObject, error) {
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit,
+		"package rules")
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.DeletePackageRule400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.DeletePackageRule403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwDeletePackageRule500Error(err), nil
+	}
+
+	err = c.PackageRuleStore.Delete(ctx, registry.ID, int64(r.RuleId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeletePackageRule404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "package rule not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeletePackageRule500Error(err), nil
+	}
+	return artifact.DeletePackageRule200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// ListPackageRuleViolations lists the packages the package rules of the upstream proxy blocked, most
+// recently requested first.
+func (c *APIController) ListPackageRuleViolations(
+	ctx context.Context,
+	r artifact.ListPackageRuleViolationsRequestObject,
+) (artifact.ListPackageRuleViolationsResponseObject, error) {
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView,
+		"package rules")
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListPackageRuleViolations400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListPackageRuleViolations403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListPackageRuleViolations500Error(err), nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	violations, err := c.PackageRuleStore.ListViolations(ctx, registry.ID, limit, offset)
+	if err != nil {
+		return throwListPackageRuleViolations500Error(err), nil
+	}
+	count, err := c.PackageRuleStore.CountViolations(ctx, registry.ID)
+	if err != nil {
+		return throwListPackageRuleViolations500Error(err), nil
+	}
+	data := make([]artifact.PackageRuleViolation, 0, len(violations))
+	for _, violation := range violations {
+		data = append(data, mapToAPIPackageRuleViolation(violation))
+	}
+	pageCount := GetPageCount(count, limit)
+	currentPageSize := len(data)
+	return artifact.ListPackageRuleViolations200JSONResponse{
+		ListPackageRuleViolationsResponseJSONResponse: artifact.ListPackageRuleViolationsResponseJSONResponse{
+			Data: artifact.ListPackageRuleViolations{
+				Violations: data,
+				ItemCount:  &count,
+				PageCount:  &pageCount,
+				PageIndex:  &pageNumber,
+				PageSize:   &currentPageSize,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// getUpstreamRegistry returns the upstream proxy a feature only upstream proxies support is managed on,
+// checking the permission on it. On failure it returns the status of the error response.
+func (c *APIController) getUpstreamRegistry(
+	ctx context.Context,
+	registryRef string,
+	permission enum.Permission,
+	feature string,
+) (*registrytypes.Registry, int, error) {
+	registry, status, err := c.getRegistry(ctx, registryRef, permission)
+	if err != nil {
+		return nil, status, err
+	}
+	if registry.Type != artifact.RegistryTypeUPSTREAM {
+		return nil, http.StatusBadRequest, fmt.Errorf("registry %s is a %s registry, %s are only "+
+			"supported by upstream registries", registry.Name, registry.Type, feature)
+	}
+	return registry, 0, nil
+}
+
+// getRegistry returns the registry, checking the permission on it. On failure it returns the status of
+// the error response.
+func (c *APIController) getRegistry(
+	ctx context.Context,
+	registryRef string,
+	permission enum.Permission,
+) (*registrytypes.Registry, int, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", registryRef)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier, permission)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return nil, http.StatusForbidden, err
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return registry, 0, nil
+}
+
+func mapToAPIPackageRule(rule *registrytypes.PackageRule) artifact.PackageRule {
+	apiRule := artifact.PackageRule{
+		Id:        rule.ID,
+		Action:    artifact.PackageRuleAction(rule.Action),
+		CreatedAt: rule.CreatedAt.UnixMilli(),
+		UpdatedAt: rule.UpdatedAt.UnixMilli(),
+	}
+	if rule.Group != "" {
+		apiRule.Group = &rule.Group
+	}
+	if rule.Name != "" {
+		apiRule.Name = &rule.Name
+	}
+	if rule.VersionRange != "" {
+		apiRule.VersionRange = &rule.VersionRange
+	}
+	if rule.Description != "" {
+		apiRule.Description = &rule.Description
+	}
+	return apiRule
+}
+
+func mapToAPIPackageRuleViolation(violation *registrytypes.PackageRuleViolation) artifact.PackageRuleViolation {
+	apiViolation := artifact.PackageRuleViolation{
+		Id:          violation.ID,
+		Name:        violation.Name,
+		Reason:      violation.Reason,
+		Count:       violation.Count,
+		FirstSeenAt: violation.CreatedAt.UnixMilli(),
+		LastSeenAt:  violation.LastSeenAt.UnixMilli(),
+	}
+	if violation.RuleID != 0 {
+		apiViolation.RuleId = &violation.RuleID
+	}
+	if violation.Group != "" {
+		apiViolation.Group = &violation.Group
+	}
+	if violation.Version != "" {
+		apiViolation.Version = &violation.Version
+	}
+	return apiViolation
+}
+
+func throwCreatePackageRule400Error(err error) artifact.CreatePackageRule400JSONResponse {
+	return artifact.CreatePackageRule400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwCreatePackageRule500Error(err error) artifact.CreatePackageRule500JSONResponse {
+	return artifact.CreatePackageRule500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListPackageRules500Error(err error) artifact.ListPackageRules500JSONResponse {
+	return artifact.ListPackageRules500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwDeletePackageRule500Error(err error) artifact.DeletePackageRule500JSONResponse {
+	return artifact.DeletePackageRule500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListPackageRuleViolations500Error(err error) artifact.ListPackageRuleViolations500JSONResponse {
+	return artifact.ListPackageRuleViolations500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
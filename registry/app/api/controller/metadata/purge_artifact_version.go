@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/services/compliance"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// PurgeArtifactVersion hard deletes a version with its files, metadata and download records, for data
+// erasure requests, and returns the certificate recording what was deleted.
+func (c *APIController) PurgeArtifactVersion(
+	ctx context.Context,
+	r artifact.PurgeArtifactVersionRequestObject,
+) (artifact.PurgeArtifactVersionResponseObject, error) {
+	if r.Body == nil || r.Body.Reason == "" {
+		return throwPurgeArtifactVersion400Error(fmt.Errorf("reason is required")), nil
+	}
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionArtifactsDelete)
+	switch status {
+	case http.StatusBadRequest:
+		return throwPurgeArtifactVersion400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.PurgeArtifactVersion403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwPurgeArtifactVersion500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	certificate, err := c.ComplianceService.PurgeVersion(ctx, registry, string(r.Artifact), string(r.Version),
+		r.Body.Reason, session.Principal.ID)
+	if errors.Is(err, compliance.ErrVersionNotFound) {
+		return artifact.PurgeArtifactVersion404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	}
+	if errors.Is(err, pkg.ErrLegalHold) {
+		return throwPurgeArtifactVersion400Error(err), nil
+	}
+	if err != nil {
+		return throwPurgeArtifactVersion500Error(err), nil
+	}
+
+	c.logRegistryAudit(ctx, registry, audit.ActionDeleted,
+		audit.WithData("purged version", string(r.Artifact)+"@"+string(r.Version)),
+		audit.WithData("reason", r.Body.Reason),
+	)
+
+	return artifact.PurgeArtifactVersion200JSONResponse{
+		DeletionCertificateResponseJSONResponse: artifact.DeletionCertificateResponseJSONResponse{
+			Data:   mapToAPIDeletionCertificate(certificate),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToAPIDeletionCertificate(certificate *registrytypes.DeletionCertificate) artifact.DeletionCertificate {
+	blobs := make([]artifact.DeletedBlob, 0, len(certificate.Blobs))
+	for _, blob := range certificate.Blobs {
+		blobs = append(blobs, artifact.DeletedBlob{
+			Digest:      blob.Digest,
+			Size:        blob.Size,
+			Disposition: artifact.DeletedBlobDisposition(blob.Disposition),
+		})
+	}
+	deletedItems := certificate.DeletedItems
+	if deletedItems == nil {
+		deletedItems = []string{}
+	}
+	return artifact.DeletionCertificate{
+		Id:                 certificate.ID,
+		RegistryIdentifier: certificate.RegistryName,
+		PackageType:        artifact.PackageType(certificate.PackageType),
+		Package:            certificate.ImageName,
+		Version:            certificate.Version,
+		Reason:             certificate.Reason,
+		DeletedItems:       deletedItems,
+		Blobs:              blobs,
+		DownloadRecords:    certificate.DownloadRecords,
+		DeletedAt:          certificate.DeletedAt.UnixMilli(),
+		DeletedBy:          certificate.DeletedBy,
+		Checksum:           certificate.Checksum,
+	}
+}
+
+func throwPurgeArtifactVersion400Error(err error) artifact.PurgeArtifactVersion400JSONResponse {
+	return artifact.PurgeArtifactVersion400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwPurgeArtifactVersion500Error(err error) artifact.PurgeArtifactVersion500JSONResponse {
+	return artifact.PurgeArtifactVersion500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,781 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/claimsmapping"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"gopkg.in/yaml.v3"
+)
+
+// registryConfigVersion is the version of the registry configuration documents.
+const registryConfigVersion = "v1"
+
+// errRegistryConfigRejected is returned when a change of a registry configuration is rejected.
+var errRegistryConfigRejected = errors.New("registry configuration rejected")
+
+// registryConfigDocument is the configuration of a registry as it's exported and applied. It
+// leaves out the space of the registry, so that it can be applied to another space.
+type registryConfigDocument struct {
+	Version     string                     `json:"version"`
+	Registry    artifact.RegistryRequest   `json:"registry"`
+	Webhooks    []artifact.WebhookRequest  `json:"webhooks,omitempty"`
+	Permissions []registryConfigPermission `json:"permissions,omitempty"`
+}
+
+// registryConfigPermission is a claims mapping of the registry.
+type registryConfigPermission struct {
+	Claim string                `json:"claim"`
+	Value string                `json:"value"`
+	Role  artifact.RegistryRole `json:"role"`
+}
+
+func (p registryConfigPermission) key() string {
+	return p.Claim + "=" + p.Value
+}
+
+// ExportRegistryConfig exports the configuration of a registry as YAML.
+func (c *APIController) ExportRegistryConfig(
+	ctx context.Context,
+	r artifact.ExportRegistryConfigRequestObject,
+) (artifact.ExportRegistryConfigResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwExportRegistryConfig400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwExportRegistryConfig400Error(err), nil
+	}
+
+	// The configuration has the claims mappings of the registry, which need edit access.
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ExportRegistryConfig403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.ExportRegistryConfig404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "registry doesn't exist with this key"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwExportRegistryConfig500Error(err), nil
+	}
+	doc, err := c.registryConfig(ctx, registry)
+	if err != nil {
+		return throwExportRegistryConfig500Error(err), nil
+	}
+	out, err := marshalRegistryConfig(doc)
+	if err != nil {
+		return throwExportRegistryConfig500Error(err), nil
+	}
+
+	return artifact.ExportRegistryConfig200JSONResponse{
+		RegistryConfigResponseJSONResponse: artifact.RegistryConfigResponseJSONResponse{
+			Data: artifact.RegistryConfigExport{
+				RegistryIdentifier: registry.Name,
+				Yaml:               out,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ApplyRegistryConfig applies an exported registry configuration to a space, making only the
+// changes needed for the registry, its webhooks and its permissions to match it.
+func (c *APIController) ApplyRegistryConfig(
+	ctx context.Context,
+	r artifact.ApplyRegistryConfigRequestObject,
+) (artifact.ApplyRegistryConfigResponseObject, error) {
+	if r.Body == nil {
+		return throwApplyRegistryConfig400Error(fmt.Errorf("request body is required")), nil
+	}
+	desired, err := unmarshalRegistryConfig(r.Body.Yaml)
+	if err != nil {
+		return throwApplyRegistryConfig400Error(err), nil
+	}
+	regInfo, err := c.checkOnboardingAccess(ctx, r.Body.ParentRef)
+	if errors.Is(err, apiauth.ErrNotAuthorized) {
+		return throwApplyRegistryConfig403Error(err), nil
+	}
+	if err != nil {
+		return throwApplyRegistryConfig400Error(err), nil
+	}
+
+	dryRun := r.Body.DryRun != nil && *r.Body.DryRun
+	changes, err := c.applyRegistryConfig(ctx, regInfo, desired, dryRun)
+	switch {
+	case errors.Is(err, apiauth.ErrNotAuthorized):
+		return throwApplyRegistryConfig403Error(err), nil
+	case errors.Is(err, errRegistryConfigRejected):
+		return throwApplyRegistryConfig400Error(err), nil
+	case err != nil:
+		return throwApplyRegistryConfig500Error(err), nil
+	}
+
+	return artifact.ApplyRegistryConfig200JSONResponse{
+		RegistryConfigApplyResponseJSONResponse: artifact.RegistryConfigApplyResponseJSONResponse{
+			Data: artifact.RegistryConfigApplyResult{
+				RegistryIdentifier: desired.Registry.Identifier,
+				DryRun:             dryRun,
+				Changes:            changes,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// applyRegistryConfig makes the changes of a registry configuration to the space of the request,
+// and records the configuration they result in as a single revision.
+func (c *APIController) applyRegistryConfig(
+	ctx context.Context,
+	regInfo *RegistryRequestBaseInfo,
+	desired *registryConfigDocument,
+	dryRun bool,
+) ([]artifact.RegistryConfigChange, error) {
+	current, registry, err := c.currentRegistryConfig(ctx, regInfo, desired.Registry.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkRegistryConfigTarget(registry, regInfo, desired); err != nil {
+		return nil, rejectedRegistryConfigError(err.Error())
+	}
+
+	changes := registryConfigChanges(current, desired)
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+	err = c.applyRegistryConfigChanges(withoutRegistryConfigRevisions(ctx), regInfo, desired, changes)
+	// The changes made before a failed one are recorded too.
+	if registry, getErr := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID,
+		desired.Registry.Identifier); getErr == nil {
+		c.recordRegistryConfigRevision(ctx, registry.ID)
+	}
+	return changes, err
+}
+
+// currentRegistryConfig returns the configuration and the registry with the identifier in the
+// root space of the request, or nil if there's no such registry.
+func (c *APIController) currentRegistryConfig(
+	ctx context.Context,
+	regInfo *RegistryRequestBaseInfo,
+	identifier string,
+) (*registryConfigDocument, *registrytypes.Registry, error) {
+	registry, err := c.RegistryRepository.GetByRootParentIDAndName(ctx, regInfo.rootIdentifierID, identifier)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if registry.ParentID != regInfo.parentID {
+		return nil, registry, nil
+	}
+	doc, err := c.registryConfig(ctx, registry)
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, registry, nil
+}
+
+// checkRegistryConfigTarget checks that the configuration can be applied to the existing
+// registry, which has to be in the space and of the same kind.
+func checkRegistryConfigTarget(
+	registry *registrytypes.Registry,
+	regInfo *RegistryRequestBaseInfo,
+	desired *registryConfigDocument,
+) error {
+	if registry == nil {
+		return nil
+	}
+	if registry.ParentID != regInfo.parentID {
+		return fmt.Errorf("registry %s exists in another space", registry.Name)
+	}
+	if registry.PackageType != desired.Registry.PackageType {
+		return fmt.Errorf("registry %s is a %s registry, the package type can't be changed",
+			registry.Name, registry.PackageType)
+	}
+	if string(registry.Type) != string(desired.Registry.Config.Type) {
+		return fmt.Errorf("registry %s is a %s registry, the registry type can't be changed",
+			registry.Name, registry.Type)
+	}
+	return nil
+}
+
+// registryConfig returns the configuration of the registry.
+func (c *APIController) registryConfig(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+) (*registryConfigDocument, error) {
+	var data artifact.Registry
+	if string(registry.Type) == string(artifact.RegistryTypeVIRTUAL) {
+		cleanupPolicies, err := c.CleanupPolicyStore.GetByRegistryID(ctx, registry.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cleanup policies: %w", err)
+		}
+		data = CreateVirtualRepositoryResponse(
+			registry, c.getUpstreamProxyKeys(ctx, registry.UpstreamProxies), cleanupPolicies, "",
+		).Data
+	} else {
+		upstreamProxy, err := c.UpstreamProxyStore.Get(ctx, registry.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get upstream proxy: %w", err)
+		}
+		data = CreateUpstreamProxyResponseJSONResponse(upstreamProxy).Data
+	}
+
+	doc := &registryConfigDocument{
+		Version: registryConfigVersion,
+		Registry: artifact.RegistryRequest{
+			Identifier:            data.Identifier,
+			PackageType:           data.PackageType,
+			Description:           data.Description,
+			Config:                data.Config,
+			AllowedPattern:        data.AllowedPattern,
+			BlockedPattern:        data.BlockedPattern,
+			CleanupPolicy:         data.CleanupPolicy,
+			Labels:                data.Labels,
+			LatestVersionStrategy: data.LatestVersionStrategy,
+			LatestVersionPattern:  data.LatestVersionPattern,
+			MtlsMode:              data.MtlsMode,
+			MtlsCaCertificates:    data.MtlsCaCertificates,
+			MavenStrictMetadata:   data.MavenStrictMetadata,
+			NpmLocalScopes:        data.NpmLocalScopes,
+			StoragePrefix:         data.StoragePrefix,
+		},
+	}
+
+	webhooks, err := c.WebhooksRepository.ListAllByRegistry(ctx, []gitnesstypes.WebhookParentInfo{
+		{ID: registry.ID, Type: enum.WebhookParentRegistry},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	for _, webhook := range webhooks {
+		if webhook.Type == enum.WebhookTypeInternal {
+			continue
+		}
+		w, err := c.RegistryMetadataHelper.MapToWebhookResponseEntity(ctx, webhook)
+		if err != nil {
+			return nil, err
+		}
+		// The secret is referenced by the path of its space only, as space IDs differ between
+		// environments.
+		doc.Webhooks = append(doc.Webhooks, artifact.WebhookRequest{
+			Identifier:       w.Identifier,
+			Name:             w.Name,
+			Description:      w.Description,
+			Url:              w.Url,
+			Enabled:          w.Enabled,
+			Insecure:         w.Insecure,
+			Triggers:         w.Triggers,
+			ExtraHeaders:     w.ExtraHeaders,
+			SecretIdentifier: w.SecretIdentifier,
+			SecretSpacePath:  w.SecretSpacePath,
+		})
+	}
+	sort.Slice(doc.Webhooks, func(i, j int) bool {
+		return doc.Webhooks[i].Identifier < doc.Webhooks[j].Identifier
+	})
+
+	mappings, err := c.ClaimsMappingService.ListMappings(ctx, registry.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claims mappings: %w", err)
+	}
+	for _, mapping := range mappings {
+		if mapping.RegistryName != registry.Name {
+			continue
+		}
+		doc.Permissions = append(doc.Permissions, registryConfigPermission{
+			Claim: mapping.Claim,
+			Value: mapping.Value,
+			Role:  artifact.RegistryRole(mapping.Role),
+		})
+	}
+	sort.Slice(doc.Permissions, func(i, j int) bool {
+		return doc.Permissions[i].key() < doc.Permissions[j].key()
+	})
+	return doc, nil
+}
+
+// registryConfigChanges returns the changes that make the current configuration of a registry,
+// nil if the registry doesn't exist, match the desired one.
+func registryConfigChanges(current, desired *registryConfigDocument) []artifact.RegistryConfigChange {
+	changes := []artifact.RegistryConfigChange{}
+	if current == nil {
+		current = &registryConfigDocument{}
+		changes = append(changes, registryConfigChange(artifact.RegistryConfigResourceREGISTRY,
+			desired.Registry.Identifier, artifact.RegistryConfigActionCREATE))
+	} else if !sameRegistryConfig(current.Registry, desired.Registry) {
+		changes = append(changes, registryConfigChange(artifact.RegistryConfigResourceREGISTRY,
+			desired.Registry.Identifier, artifact.RegistryConfigActionUPDATE))
+	}
+
+	webhooks := map[string]artifact.WebhookRequest{}
+	for _, webhook := range current.Webhooks {
+		webhooks[webhook.Identifier] = webhook
+	}
+	for _, webhook := range desired.Webhooks {
+		existing, ok := webhooks[webhook.Identifier]
+		delete(webhooks, webhook.Identifier)
+		switch {
+		case !ok:
+			changes = append(changes, registryConfigChange(artifact.RegistryConfigResourceWEBHOOK,
+				webhook.Identifier, artifact.RegistryConfigActionCREATE))
+		case !sameRegistryConfig(existing, webhook):
+			changes = append(changes, registryConfigChange(artifact.RegistryConfigResourceWEBHOOK,
+				webhook.Identifier, artifact.RegistryConfigActionUPDATE))
+		}
+	}
+	for _, webhook := range current.Webhooks {
+		if _, ok := webhooks[webhook.Identifier]; ok {
+			changes = append(changes, registryConfigChange(artifact.RegistryConfigResourceWEBHOOK,
+				webhook.Identifier, artifact.RegistryConfigActionDELETE))
+		}
+	}
+
+	permissions := map[string]registryConfigPermission{}
+	for _, permission := range current.Permissions {
+		permissions[permission.key()] = permission
+	}
+	for _, permission := range desired.Permissions {
+		existing, ok := permissions[permission.key()]
+		delete(permissions, permission.key())
+		switch {
+		case !ok:
+			changes = append(changes, registryConfigChange(artifact.RegistryConfigResourcePERMISSION,
+				permission.key(), artifact.RegistryConfigActionCREATE))
+		case existing.Role != permission.Role:
+			changes = append(changes, registryConfigChange(artifact.RegistryConfigResourcePERMISSION,
+				permission.key(), artifact.RegistryConfigActionUPDATE))
+		}
+	}
+	for _, permission := range current.Permissions {
+		if _, ok := permissions[permission.key()]; ok {
+			changes = append(changes, registryConfigChange(artifact.RegistryConfigResourcePERMISSION,
+				permission.key(), artifact.RegistryConfigActionDELETE))
+		}
+	}
+	return changes
+}
+
+func registryConfigChange(
+	resource artifact.RegistryConfigResource,
+	identifier string,
+	action artifact.RegistryConfigAction,
+) artifact.RegistryConfigChange {
+	return artifact.RegistryConfigChange{Resource: resource, Identifier: identifier, Action: action}
+}
+
+// applyRegistryConfigChanges makes the changes of a registry configuration in order, through the
+// same handlers as the API so that they're validated and audited the same way. The changes
+// aren't made in a single transaction, but applying the configuration again picks up where a
+// failed change left off.
+func (c *APIController) applyRegistryConfigChanges(
+	ctx context.Context,
+	regInfo *RegistryRequestBaseInfo,
+	desired *registryConfigDocument,
+	changes []artifact.RegistryConfigChange,
+) error {
+	registryRef := regInfo.ParentRef + "/" + desired.Registry.Identifier
+	webhooks := map[string]artifact.WebhookRequest{}
+	for _, webhook := range desired.Webhooks {
+		webhooks[webhook.Identifier] = webhook
+	}
+	permissions := map[string]registryConfigPermission{}
+	for _, permission := range desired.Permissions {
+		permissions[permission.key()] = permission
+	}
+
+	for _, change := range changes {
+		var err error
+		switch change.Resource {
+		case artifact.RegistryConfigResourceREGISTRY:
+			err = c.applyRegistryChange(ctx, regInfo, registryRef, desired.Registry, change.Action)
+		case artifact.RegistryConfigResourceWEBHOOK:
+			err = c.applyWebhookChange(ctx, registryRef, webhooks[change.Identifier], change)
+		case artifact.RegistryConfigResourcePERMISSION:
+			err = c.applyPermissionChange(ctx, regInfo, desired, permissions[change.Identifier], change)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s %s %s: %w", change.Action, change.Resource, change.Identifier, err)
+		}
+	}
+	return nil
+}
+
+func (c *APIController) applyRegistryChange(
+	ctx context.Context,
+	regInfo *RegistryRequestBaseInfo,
+	registryRef string,
+	registryRequest artifact.RegistryRequest,
+	action artifact.RegistryConfigAction,
+) error {
+	registryRequest.ParentRef = &regInfo.ParentRef
+	if action == artifact.RegistryConfigActionCREATE {
+		body := artifact.CreateRegistryJSONRequestBody(registryRequest)
+		response, err := c.CreateRegistry(ctx, artifact.CreateRegistryRequestObject{Body: &body})
+		switch resp := response.(type) {
+		case artifact.CreateRegistry201JSONResponse:
+			return nil
+		case artifact.CreateRegistry400JSONResponse:
+			return rejectedRegistryConfigError(resp.Message)
+		case artifact.CreateRegistry403JSONResponse:
+			return unauthorizedRegistryConfigError(resp.Message)
+		case artifact.CreateRegistry500JSONResponse:
+			return errors.New(resp.Message)
+		}
+		return unexpectedRegistryConfigError(err)
+	}
+
+	body := artifact.ModifyRegistryJSONRequestBody(registryRequest)
+	response, err := c.ModifyRegistry(ctx, artifact.ModifyRegistryRequestObject{
+		RegistryRef: artifact.RegistryRefPathParam(registryRef),
+		Body:        &body,
+	})
+	switch resp := response.(type) {
+	case artifact.ModifyRegistry200JSONResponse:
+		return nil
+	case artifact.ModifyRegistry400JSONResponse:
+		return rejectedRegistryConfigError(resp.Message)
+	case artifact.ModifyRegistry403JSONResponse:
+		return unauthorizedRegistryConfigError(resp.Message)
+	case artifact.ModifyRegistry404JSONResponse:
+		return rejectedRegistryConfigError(resp.Message)
+	case artifact.ModifyRegistry500JSONResponse:
+		return errors.New(resp.Message)
+	}
+	return unexpectedRegistryConfigError(err)
+}
+
+func (c *APIController) applyWebhookChange(
+	ctx context.Context,
+	registryRef string,
+	webhookRequest artifact.WebhookRequest,
+	change artifact.RegistryConfigChange,
+) error {
+	ref := artifact.RegistryRefPathParam(registryRef)
+	identifier := artifact.WebhookIdentifierPathParam(change.Identifier)
+	var response any
+	var err error
+	switch change.Action {
+	case artifact.RegistryConfigActionCREATE:
+		body := artifact.CreateWebhookJSONRequestBody(webhookRequest)
+		response, err = c.CreateWebhook(ctx, artifact.CreateWebhookRequestObject{RegistryRef: ref, Body: &body})
+	case artifact.RegistryConfigActionUPDATE:
+		body := artifact.UpdateWebhookJSONRequestBody(webhookRequest)
+		response, err = c.UpdateWebhook(ctx, artifact.UpdateWebhookRequestObject{
+			RegistryRef:       ref,
+			WebhookIdentifier: identifier,
+			Body:              &body,
+		})
+	case artifact.RegistryConfigActionDELETE:
+		response, err = c.DeleteWebhook(ctx, artifact.DeleteWebhookRequestObject{
+			RegistryRef:       ref,
+			WebhookIdentifier: identifier,
+		})
+	}
+
+	switch resp := response.(type) {
+	case artifact.CreateWebhook201JSONResponse, artifact.UpdateWebhook201JSONResponse,
+		artifact.DeleteWebhook200JSONResponse:
+		return nil
+	case artifact.CreateWebhook400JSONResponse:
+		return rejectedRegistryConfigError(resp.Message)
+	case artifact.UpdateWebhook400JSONResponse:
+		return rejectedRegistryConfigError(resp.Message)
+	case artifact.DeleteWebhook400JSONResponse:
+		return rejectedRegistryConfigError(resp.Message)
+	case artifact.CreateWebhook403JSONResponse:
+		return unauthorizedRegistryConfigError(resp.Message)
+	case artifact.UpdateWebhook403JSONResponse:
+		return unauthorizedRegistryConfigError(resp.Message)
+	case artifact.DeleteWebhook403JSONResponse:
+		return unauthorizedRegistryConfigError(resp.Message)
+	}
+	return unexpectedRegistryConfigError(err)
+}
+
+func (c *APIController) applyPermissionChange(
+	ctx context.Context,
+	regInfo *RegistryRequestBaseInfo,
+	desired *registryConfigDocument,
+	permission registryConfigPermission,
+	change artifact.RegistryConfigChange,
+) error {
+	// Claims mappings can't be updated, so a changed role replaces the mapping.
+	if change.Action != artifact.RegistryConfigActionCREATE {
+		mappings, err := c.ClaimsMappingService.ListMappings(ctx, regInfo.parentID)
+		if err != nil {
+			return err
+		}
+		for _, mapping := range mappings {
+			existing := registryConfigPermission{Claim: mapping.Claim, Value: mapping.Value}
+			if mapping.RegistryName != desired.Registry.Identifier || existing.key() != change.Identifier {
+				continue
+			}
+			if err = c.ClaimsMappingService.DeleteMapping(ctx, regInfo.parentID, mapping.ID); err != nil {
+				return err
+			}
+		}
+	}
+	if change.Action == artifact.RegistryConfigActionDELETE {
+		return nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	err := c.ClaimsMappingService.CreateMapping(ctx, &registrytypes.ClaimMapping{
+		SpaceID:      regInfo.parentID,
+		RegistryName: desired.Registry.Identifier,
+		Claim:        permission.Claim,
+		Value:        permission.Value,
+		Role:         registrytypes.RegistryRole(permission.Role),
+		CreatedBy:    session.Principal.ID,
+	})
+	if errors.Is(err, claimsmapping.ErrInvalidMapping) {
+		return fmt.Errorf("%w: %w", errRegistryConfigRejected, err)
+	}
+	return err
+}
+
+func rejectedRegistryConfigError(message string) error {
+	return fmt.Errorf("%w: %s", errRegistryConfigRejected, message)
+}
+
+func unauthorizedRegistryConfigError(message string) error {
+	return fmt.Errorf("%w: %s", apiauth.ErrNotAuthorized, message)
+}
+
+func unexpectedRegistryConfigError(err error) error {
+	if err == nil {
+		err = errors.New("unexpected response")
+	}
+	return err
+}
+
+// marshalRegistryConfig returns the configuration as YAML, with the keys the API uses for the
+// registry and its webhooks.
+func marshalRegistryConfig(doc *registryConfigDocument) (string, error) {
+	value, err := registryConfigValue(doc)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(value); err != nil {
+		return "", fmt.Errorf("failed to encode registry configuration: %w", err)
+	}
+	if err = encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode registry configuration: %w", err)
+	}
+	return out.String(), nil
+}
+
+// unmarshalRegistryConfig parses and validates a registry configuration. Unknown keys are
+// rejected, so that a misspelt setting isn't silently dropped.
+func unmarshalRegistryConfig(in string) (*registryConfigDocument, error) {
+	var value any
+	if err := yaml.Unmarshal([]byte(in), &value); err != nil {
+		return nil, fmt.Errorf("invalid registry configuration: %w", err)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry configuration: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	doc := &registryConfigDocument{}
+	if err = decoder.Decode(doc); err != nil {
+		return nil, fmt.Errorf("invalid registry configuration: %w", err)
+	}
+
+	if doc.Version != registryConfigVersion {
+		return nil, fmt.Errorf("unsupported registry configuration version %q, expected %q",
+			doc.Version, registryConfigVersion)
+	}
+	if doc.Registry.Identifier == "" || doc.Registry.PackageType == "" || doc.Registry.Config == nil {
+		return nil, fmt.Errorf("registry identifier, package type and config are required")
+	}
+	// The space comes from the request, so that the configuration can be applied to any space.
+	doc.Registry.ParentRef = nil
+	webhooks := map[string]bool{}
+	for _, webhook := range doc.Webhooks {
+		if webhooks[webhook.Identifier] {
+			return nil, fmt.Errorf("webhook %s is in the configuration more than once", webhook.Identifier)
+		}
+		webhooks[webhook.Identifier] = true
+	}
+	permissions := map[string]bool{}
+	for _, permission := range doc.Permissions {
+		if permissions[permission.key()] {
+			return nil, fmt.Errorf("permission %s is in the configuration more than once", permission.key())
+		}
+		permissions[permission.key()] = true
+	}
+	return doc, nil
+}
+
+// sameRegistryConfig reports whether two settings are the same, ignoring the unset and empty
+// values that the API treats as their default.
+func sameRegistryConfig(a, b any) bool {
+	aValue, err := registryConfigValue(a)
+	if err != nil {
+		return false
+	}
+	bValue, err := registryConfigValue(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(compactRegistryConfigValue(aValue), compactRegistryConfigValue(bValue))
+}
+
+// registryConfigValue returns v as the generic value it's encoded to in JSON, with integers as
+// int64 so that they're kept as integers in YAML.
+func registryConfigValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode registry configuration: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err = decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to encode registry configuration: %w", err)
+	}
+	return registryConfigNumbers(value), nil
+}
+
+func registryConfigNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = registryConfigNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = registryConfigNumbers(item)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return value
+}
+
+// compactRegistryConfigValue drops the empty values of a generic value, returning nil if it's
+// empty itself.
+func compactRegistryConfigValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		out := map[string]any{}
+		for key, item := range v {
+			if item = compactRegistryConfigValue(item); item != nil {
+				out[key] = item
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = compactRegistryConfigValue(item)
+		}
+		return out
+	case string:
+		if v == "" {
+			return nil
+		}
+	case bool:
+		if !v {
+			return nil
+		}
+	}
+	return value
+}
+
+func throwExportRegistryConfig400Error(err error) artifact.ExportRegistryConfig400JSONResponse {
+	return artifact.ExportRegistryConfig400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwExportRegistryConfig500Error(err error) artifact.ExportRegistryConfig500JSONResponse {
+	return artifact.ExportRegistryConfig500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwApplyRegistryConfig400Error(err error) artifact.ApplyRegistryConfig400JSONResponse {
+	return artifact.ApplyRegistryConfig400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwApplyRegistryConfig403Error(err error) artifact.ApplyRegistryConfig403JSONResponse {
+	return artifact.ApplyRegistryConfig403JSONResponse{
+		UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+			*GetErrorResponse(http.StatusForbidden, err.Error()),
+		),
+	}
+}
+
+func throwApplyRegistryConfig500Error(err error) artifact.ApplyRegistryConfig500JSONResponse {
+	return artifact.ApplyRegistryConfig500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,403 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// registryConfigApplyKey marks the context of the changes of an applied configuration, which is
+// recorded as a single revision once they're all made.
+type registryConfigApplyKey struct{}
+
+func withoutRegistryConfigRevisions(ctx context.Context) context.Context {
+	return context.WithValue(ctx, registryConfigApplyKey{}, true)
+}
+
+// recordRegistryConfigRevision records the configuration of a registry after a change, unless
+// it's the same as the latest revision. The change is made by then, so a failure to record it is
+// only logged.
+func (c *APIController) recordRegistryConfigRevision(ctx context.Context, registryID int64) {
+	if ctx.Value(registryConfigApplyKey{}) != nil {
+		return
+	}
+	if err := c.createRegistryConfigRevision(ctx, registryID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to record configuration revision of registry %d", registryID)
+	}
+}
+
+func (c *APIController) createRegistryConfigRevision(ctx context.Context, registryID int64) error {
+	registry, err := c.RegistryRepository.Get(ctx, registryID)
+	if err != nil {
+		return fmt.Errorf("failed to get registry: %w", err)
+	}
+	doc, err := c.registryConfig(ctx, registry)
+	if err != nil {
+		return err
+	}
+	latest, err := c.RegistryConfigRevisionStore.GetLatest(ctx, registryID)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return fmt.Errorf("failed to get latest revision: %w", err)
+	}
+	if latest != nil {
+		previous, err := decodeRegistryConfigRevision(latest)
+		if err != nil {
+			return err
+		}
+		if len(registryConfigChanges(previous, doc)) == 0 {
+			return nil
+		}
+	}
+
+	config, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode registry configuration: %w", err)
+	}
+	revision := &registrytypes.RegistryConfigRevision{
+		RegistryID: registryID,
+		Config:     config,
+	}
+	if session, ok := request.AuthSessionFrom(ctx); ok {
+		revision.CreatedBy = session.Principal.ID
+	}
+	return c.RegistryConfigRevisionStore.Create(ctx, revision)
+}
+
+// ListRegistryConfigRevisions lists the revisions of the configuration of a registry with the
+// changes each made to the revision before it.
+func (c *APIController) ListRegistryConfigRevisions(
+	ctx context.Context,
+	r artifact.ListRegistryConfigRevisionsRequestObject,
+) (artifact.ListRegistryConfigRevisionsResponseObject, error) {
+	registry, _, err := c.registryConfigRevisionTarget(ctx, string(r.RegistryRef))
+	if err != nil {
+		return listRegistryConfigRevisionsErrorResponse(err), nil
+	}
+
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	limit := GetPageLimit(r.Params.Size)
+	pageNumber := GetPageNumber(r.Params.Page)
+	count, err := c.RegistryConfigRevisionStore.Count(ctx, registry.ID)
+	if err != nil {
+		return throwListRegistryConfigRevisions500Error(err), nil
+	}
+	// One more revision is listed than the page has, as the changes of a revision are against the
+	// one before it.
+	revisions, err := c.RegistryConfigRevisionStore.List(ctx, registry.ID, limit+1, offset)
+	if err != nil {
+		return throwListRegistryConfigRevisions500Error(err), nil
+	}
+	docs := make([]*registryConfigDocument, 0, len(revisions))
+	for _, revision := range revisions {
+		doc, err := decodeRegistryConfigRevision(revision)
+		if err != nil {
+			return throwListRegistryConfigRevisions500Error(err), nil
+		}
+		docs = append(docs, doc)
+	}
+
+	list := []artifact.RegistryConfigRevision{}
+	for i := 0; i < len(revisions) && i < limit; i++ {
+		var previous *registryConfigDocument
+		if i+1 < len(docs) {
+			previous = docs[i+1]
+		}
+		list = append(list, mapToRegistryConfigRevision(revisions[i], registryConfigChanges(previous, docs[i])))
+	}
+	pageCount := GetPageCount(count, limit)
+	return artifact.ListRegistryConfigRevisions200JSONResponse{
+		ListRegistryConfigRevisionsResponseJSONResponse: artifact.ListRegistryConfigRevisionsResponseJSONResponse{
+			Data: artifact.ListRegistryConfigRevisions{
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &limit,
+				Revisions: list,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetRegistryConfigRevision returns a revision of the configuration of a registry along with the
+// configuration before and after it.
+func (c *APIController) GetRegistryConfigRevision(
+	ctx context.Context,
+	r artifact.GetRegistryConfigRevisionRequestObject,
+) (artifact.GetRegistryConfigRevisionResponseObject, error) {
+	registry, _, err := c.registryConfigRevisionTarget(ctx, string(r.RegistryRef))
+	var revision *registrytypes.RegistryConfigRevision
+	if err == nil {
+		revision, err = c.getRegistryConfigRevision(ctx, registry.ID, int64(r.Revision))
+	}
+	if err != nil {
+		return getRegistryConfigRevisionErrorResponse(err), nil
+	}
+
+	after, err := decodeRegistryConfigRevision(revision)
+	if err != nil {
+		return throwGetRegistryConfigRevision500Error(err), nil
+	}
+	var before *registryConfigDocument
+	detail := artifact.RegistryConfigRevisionDetail{}
+	if revision.Revision > 1 {
+		previous, err := c.RegistryConfigRevisionStore.Get(ctx, registry.ID, revision.Revision-1)
+		if err != nil {
+			return throwGetRegistryConfigRevision500Error(err), nil
+		}
+		if before, err = decodeRegistryConfigRevision(previous); err != nil {
+			return throwGetRegistryConfigRevision500Error(err), nil
+		}
+		beforeYAML, err := marshalRegistryConfig(before)
+		if err != nil {
+			return throwGetRegistryConfigRevision500Error(err), nil
+		}
+		detail.Before = &beforeYAML
+	}
+	if detail.After, err = marshalRegistryConfig(after); err != nil {
+		return throwGetRegistryConfigRevision500Error(err), nil
+	}
+	detail.RegistryConfigRevision = mapToRegistryConfigRevision(revision, registryConfigChanges(before, after))
+
+	return artifact.GetRegistryConfigRevision200JSONResponse{
+		RegistryConfigRevisionResponseJSONResponse: artifact.RegistryConfigRevisionResponseJSONResponse{
+			Data:   detail,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// RollbackRegistryConfig applies the configuration of a revision to its registry, the way an
+// exported configuration is applied.
+func (c *APIController) RollbackRegistryConfig(
+	ctx context.Context,
+	r artifact.RollbackRegistryConfigRequestObject,
+) (artifact.RollbackRegistryConfigResponseObject, error) {
+	registry, regInfo, err := c.registryConfigRevisionTarget(ctx, string(r.RegistryRef))
+	var revision *registrytypes.RegistryConfigRevision
+	if err == nil {
+		revision, err = c.getRegistryConfigRevision(ctx, registry.ID, int64(r.Revision))
+	}
+	var desired *registryConfigDocument
+	if err == nil {
+		desired, err = decodeRegistryConfigRevision(revision)
+	}
+	var changes []artifact.RegistryConfigChange
+	if err == nil {
+		regInfo, err = c.checkOnboardingAccess(ctx, regInfo.ParentRef)
+	}
+	if err == nil {
+		changes, err = c.applyRegistryConfig(ctx, regInfo, desired, false)
+	}
+	if err != nil {
+		return rollbackRegistryConfigErrorResponse(err), nil
+	}
+
+	return artifact.RollbackRegistryConfig200JSONResponse{
+		RegistryConfigApplyResponseJSONResponse: artifact.RegistryConfigApplyResponseJSONResponse{
+			Data: artifact.RegistryConfigApplyResult{
+				RegistryIdentifier: desired.Registry.Identifier,
+				DryRun:             false,
+				Changes:            changes,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// errRegistryConfigRevisionNotFound is returned for a registry or a revision that doesn't exist.
+var errRegistryConfigRevisionNotFound = errors.New("not found")
+
+// registryConfigRevisionTarget returns the registry whose revisions are asked for. The revisions
+// have the claims mappings of the registry, which need edit access like the exported configuration.
+func (c *APIController) registryConfigRevisionTarget(
+	ctx context.Context,
+	registryRef string,
+) (*registrytypes.Registry, *RegistryRequestBaseInfo, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", registryRef)
+	if err != nil {
+		return nil, nil, rejectedRegistryConfigError(err.Error())
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return nil, nil, rejectedRegistryConfigError(err.Error())
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return nil, nil, err
+	}
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, nil, fmt.Errorf("registry doesn't exist with this key: %w", errRegistryConfigRevisionNotFound)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return registry, regInfo, nil
+}
+
+func (c *APIController) getRegistryConfigRevision(
+	ctx context.Context,
+	registryID int64,
+	revision int64,
+) (*registrytypes.RegistryConfigRevision, error) {
+	configRevision, err := c.RegistryConfigRevisionStore.Get(ctx, registryID, revision)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("revision %d doesn't exist: %w", revision, errRegistryConfigRevisionNotFound)
+	}
+	return configRevision, err
+}
+
+func decodeRegistryConfigRevision(revision *registrytypes.RegistryConfigRevision) (*registryConfigDocument, error) {
+	doc := &registryConfigDocument{}
+	if err := json.Unmarshal(revision.Config, doc); err != nil {
+		return nil, fmt.Errorf("failed to decode revision %d: %w", revision.Revision, err)
+	}
+	return doc, nil
+}
+
+func mapToRegistryConfigRevision(
+	revision *registrytypes.RegistryConfigRevision,
+	changes []artifact.RegistryConfigChange,
+) artifact.RegistryConfigRevision {
+	result := artifact.RegistryConfigRevision{
+		Revision:  revision.Revision,
+		CreatedAt: revision.CreatedAt.Format(time.RFC3339),
+		Changes:   changes,
+	}
+	if revision.CreatedBy != 0 {
+		result.CreatedBy = &revision.CreatedBy
+	}
+	return result
+}
+
+func listRegistryConfigRevisionsErrorResponse(err error) artifact.ListRegistryConfigRevisionsResponseObject {
+	switch {
+	case errors.Is(err, apiauth.ErrNotAuthorized):
+		return artifact.ListRegistryConfigRevisions403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}
+	case errors.Is(err, errRegistryConfigRevisionNotFound):
+		return artifact.ListRegistryConfigRevisions404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}
+	case errors.Is(err, errRegistryConfigRejected):
+		return artifact.ListRegistryConfigRevisions400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}
+	default:
+		return artifact.ListRegistryConfigRevisions500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}
+	}
+}
+
+func getRegistryConfigRevisionErrorResponse(err error) artifact.GetRegistryConfigRevisionResponseObject {
+	switch {
+	case errors.Is(err, apiauth.ErrNotAuthorized):
+		return artifact.GetRegistryConfigRevision403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}
+	case errors.Is(err, errRegistryConfigRevisionNotFound):
+		return artifact.GetRegistryConfigRevision404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}
+	case errors.Is(err, errRegistryConfigRejected):
+		return artifact.GetRegistryConfigRevision400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}
+	default:
+		return artifact.GetRegistryConfigRevision500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}
+	}
+}
+
+func rollbackRegistryConfigErrorResponse(err error) artifact.RollbackRegistryConfigResponseObject {
+	switch {
+	case errors.Is(err, apiauth.ErrNotAuthorized):
+		return artifact.RollbackRegistryConfig403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}
+	case errors.Is(err, errRegistryConfigRevisionNotFound):
+		return artifact.RollbackRegistryConfig404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}
+	case errors.Is(err, errRegistryConfigRejected):
+		return artifact.RollbackRegistryConfig400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}
+	default:
+		return artifact.RollbackRegistryConfig500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}
+	}
+}
+
+func throwListRegistryConfigRevisions500Error(err error) artifact.ListRegistryConfigRevisions500JSONResponse {
+	return artifact.ListRegistryConfigRevisions500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwGetRegistryConfigRevision500Error(err error) artifact.GetRegistryConfigRevision500JSONResponse {
+	return artifact.GetRegistryConfigRevision500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
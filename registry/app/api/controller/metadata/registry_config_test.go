@@ -0,0 +1,105 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRegistryConfig(t *testing.T) *registryConfigDocument {
+	t.Helper()
+	config := artifact.RegistryConfig{}
+	require.NoError(t, config.FromVirtualConfig(artifact.VirtualConfig{UpstreamProxies: &[]string{"npmjs"}}))
+	description := "releases"
+	return &registryConfigDocument{
+		Version: registryConfigVersion,
+		Registry: artifact.RegistryRequest{
+			Identifier:  "npm-releases",
+			PackageType: artifact.PackageTypeNPM,
+			Description: &description,
+			Config:      &config,
+			Labels:      &[]string{},
+		},
+		Webhooks: []artifact.WebhookRequest{
+			{Identifier: "ci", Name: "CI", Url: "https://ci.example.com/hook", Enabled: true},
+		},
+		Permissions: []registryConfigPermission{
+			{Claim: "groups", Value: "release", Role: artifact.RegistryRoleMAINTAINER},
+		},
+	}
+}
+
+func TestRegistryConfigRoundTrip(t *testing.T) {
+	doc := testRegistryConfig(t)
+	out, err := marshalRegistryConfig(doc)
+	require.NoError(t, err)
+
+	parsed, err := unmarshalRegistryConfig(out)
+	require.NoError(t, err)
+	assert.Empty(t, registryConfigChanges(doc, parsed))
+
+	_, err = unmarshalRegistryConfig(out + "unknown: true\n")
+	assert.Error(t, err)
+	_, err = unmarshalRegistryConfig("version: v0\n")
+	assert.Error(t, err)
+}
+
+func TestRegistryConfigChanges(t *testing.T) {
+	desired := testRegistryConfig(t)
+	changes := registryConfigChanges(nil, desired)
+	assert.Equal(t, []artifact.RegistryConfigChange{
+		{Resource: artifact.RegistryConfigResourceREGISTRY, Identifier: "npm-releases",
+			Action: artifact.RegistryConfigActionCREATE},
+		{Resource: artifact.RegistryConfigResourceWEBHOOK, Identifier: "ci",
+			Action: artifact.RegistryConfigActionCREATE},
+		{Resource: artifact.RegistryConfigResourcePERMISSION, Identifier: "groups=release",
+			Action: artifact.RegistryConfigActionCREATE},
+	}, changes)
+
+	current := testRegistryConfig(t)
+	current.Registry.Labels = nil
+	current.Webhooks[0].Enabled = false
+	current.Webhooks = append(current.Webhooks, artifact.WebhookRequest{Identifier: "old"})
+	current.Permissions[0].Role = artifact.RegistryRoleREADER
+	changes = registryConfigChanges(current, desired)
+	assert.Equal(t, []artifact.RegistryConfigChange{
+		{Resource: artifact.RegistryConfigResourceWEBHOOK, Identifier: "ci",
+			Action: artifact.RegistryConfigActionUPDATE},
+		{Resource: artifact.RegistryConfigResourceWEBHOOK, Identifier: "old",
+			Action: artifact.RegistryConfigActionDELETE},
+		{Resource: artifact.RegistryConfigResourcePERMISSION, Identifier: "groups=release",
+			Action: artifact.RegistryConfigActionUPDATE},
+	}, changes)
+}
+
+func TestRegistryConfigRevisionRoundTrip(t *testing.T) {
+	doc := testRegistryConfig(t)
+	config, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	decoded, err := decodeRegistryConfigRevision(&registrytypes.RegistryConfigRevision{Revision: 1, Config: config})
+	require.NoError(t, err)
+	assert.Empty(t, registryConfigChanges(doc, decoded))
+
+	_, err = decodeRegistryConfigRevision(&registrytypes.RegistryConfigRevision{Revision: 2, Config: []byte("{")})
+	assert.Error(t, err)
+}
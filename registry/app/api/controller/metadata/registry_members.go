@@ -0,0 +1,188 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListRegistryMembers lists the members of a virtual registry in the order they're consulted.
+func (c *APIController) ListRegistryMembers(
+	ctx context.Context,
+	r artifact.ListRegistryMembersRequestObject,
+) (artifact.ListRegistryMembersResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return throwListRegistryMembers400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.ListRegistryMembers403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListRegistryMembers500Error(err), nil
+	}
+	if registry.Type != artifact.RegistryTypeVIRTUAL {
+		return throwListRegistryMembers400Error(fmt.Errorf("registry %s isn't a virtual registry",
+			registry.Name)), nil
+	}
+
+	members, err := pkg.GetUpstreamRegistries(ctx, c.RegistryRepository, registry)
+	if err != nil {
+		return throwListRegistryMembers500Error(err), nil
+	}
+	return artifact.ListRegistryMembers200JSONResponse{
+		RegistryMembersResponseJSONResponse: artifact.RegistryMembersResponseJSONResponse{
+			Data:   mapToAPIRegistryMembers(members),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ReorderRegistryMembers sets the order the members of a virtual registry are consulted in. The members
+// themselves are left as they are, the request must list each of them once.
+func (c *APIController) ReorderRegistryMembers(
+	ctx context.Context,
+	r artifact.ReorderRegistryMembersRequestObject,
+) (artifact.ReorderRegistryMembersResponseObject, error) {
+	if r.Body == nil {
+		return throwReorderRegistryMembers400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwReorderRegistryMembers400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.ReorderRegistryMembers403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwReorderRegistryMembers500Error(err), nil
+	}
+	if registry.Type != artifact.RegistryTypeVIRTUAL {
+		return throwReorderRegistryMembers400Error(fmt.Errorf("registry %s isn't a virtual registry",
+			registry.Name)), nil
+	}
+
+	members, err := pkg.GetUpstreamRegistries(ctx, c.RegistryRepository, registry)
+	if err != nil {
+		return throwReorderRegistryMembers500Error(err), nil
+	}
+	reordered, err := reorderMembers(members, r.Body.Members)
+	if err != nil {
+		return throwReorderRegistryMembers400Error(err), nil
+	}
+
+	space, err := c.SpaceFinder.FindByID(ctx, registry.ParentID)
+	if err != nil {
+		return throwReorderRegistryMembers500Error(fmt.Errorf("failed to find space: %w", err)), nil
+	}
+	updated := *registry
+	updated.UpstreamProxies = make([]int64, 0, len(reordered))
+	for _, member := range reordered {
+		updated.UpstreamProxies = append(updated.UpstreamProxies, member.ID)
+	}
+	session, _ := request.AuthSessionFrom(ctx)
+	if err = c.updateRegistryWithAudit(ctx, registry, &updated, session.Principal, space.Path); err != nil {
+		return throwReorderRegistryMembers500Error(err), nil
+	}
+	c.recordRegistryConfigRevision(ctx, registry.ID)
+
+	return artifact.ReorderRegistryMembers200JSONResponse{
+		RegistryMembersResponseJSONResponse: artifact.RegistryMembersResponseJSONResponse{
+			Data:   mapToAPIRegistryMembers(reordered),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// reorderMembers orders the members of a virtual registry by their identifiers, which must list each member
+// exactly once.
+func reorderMembers(members []registrytypes.Registry, order []string) ([]registrytypes.Registry, error) {
+	if len(order) != len(members) {
+		return nil, fmt.Errorf("members must list the %d members of the registry, got %d", len(members),
+			len(order))
+	}
+	byName := make(map[string]registrytypes.Registry, len(members))
+	for _, member := range members {
+		byName[member.Name] = member
+	}
+	reordered := make([]registrytypes.Registry, 0, len(order))
+	for _, name := range order {
+		member, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%s isn't a member of the registry or is listed more than once", name)
+		}
+		delete(byName, name)
+		reordered = append(reordered, member)
+	}
+	return reordered, nil
+}
+
+func mapToAPIRegistryMembers(members []registrytypes.Registry) []artifact.RegistryMember {
+	apiMembers := make([]artifact.RegistryMember, 0, len(members))
+	for i, member := range members {
+		apiMembers = append(apiMembers, artifact.RegistryMember{
+			Identifier: member.Name,
+			Type:       member.Type,
+			Priority:   i + 1,
+		})
+	}
+	return apiMembers
+}
+
+func throwListRegistryMembers400Error(err error) artifact.ListRegistryMembers400JSONResponse {
+	return artifact.ListRegistryMembers400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwListRegistryMembers500Error(err error) artifact.ListRegistryMembers500JSONResponse {
+	return artifact.ListRegistryMembers500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwReorderRegistryMembers400Error(err error) artifact.ReorderRegistryMembers400JSONResponse {
+	return artifact.ReorderRegistryMembers400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwReorderRegistryMembers500Error(err error) artifact.ReorderRegistryMembers500JSONResponse {
+	return artifact.ReorderRegistryMembers500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
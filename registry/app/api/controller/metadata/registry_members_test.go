@@ -0,0 +1,51 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorderMembers(t *testing.T) {
+	members := []registrytypes.Registry{
+		{ID: 1, Name: "dockerhub", Type: artifact.RegistryTypeUPSTREAM},
+		{ID: 2, Name: "mirror", Type: artifact.RegistryTypeUPSTREAM},
+		{ID: 3, Name: "releases", Type: artifact.RegistryTypeVIRTUAL},
+	}
+
+	reordered, err := reorderMembers(members, []string{"mirror", "releases", "dockerhub"})
+	require.NoError(t, err)
+	apiMembers := mapToAPIRegistryMembers(reordered)
+	require.Len(t, apiMembers, 3)
+	assert.Equal(t, "mirror", apiMembers[0].Identifier)
+	assert.Equal(t, 1, apiMembers[0].Priority)
+	assert.Equal(t, "dockerhub", apiMembers[2].Identifier)
+	assert.Equal(t, 3, apiMembers[2].Priority)
+
+	for _, order := range [][]string{
+		{"mirror", "dockerhub"},
+		{"mirror", "mirror", "dockerhub"},
+		{"mirror", "releases", "other"},
+	} {
+		_, err = reorderMembers(members, order)
+		assert.Error(t, err, order)
+	}
+}
@@ -256,6 +256,8 @@ func (r *GitnessRegistryMetadataHelper) MapToInternalWebhookTriggers(
 			webhookTriggers = append(webhookTriggers, enum.WebhookTriggerArtifactUpdated)
 		case api.TriggerARTIFACTDELETION:
 			webhookTriggers = append(webhookTriggers, enum.WebhookTriggerArtifactDeleted)
+		case api.TriggerBASEIMAGEMODIFICATION:
+			webhookTriggers = append(webhookTriggers, enum.WebhookTriggerArtifactBaseImageUpdated)
 		}
 	}
 	return webhookTriggers
@@ -287,6 +289,8 @@ func (r *GitnessRegistryMetadataHelper) MapToAPIWebhookTriggers(triggers []enum.
 			webhookTriggers = append(webhookTriggers, api.TriggerARTIFACTMODIFICATION)
 		case enum.WebhookTriggerArtifactDeleted:
 			webhookTriggers = append(webhookTriggers, api.TriggerARTIFACTDELETION)
+		case enum.WebhookTriggerArtifactBaseImageUpdated:
+			webhookTriggers = append(webhookTriggers, api.TriggerBASEIMAGEMODIFICATION)
 		}
 	}
 	return webhookTriggers
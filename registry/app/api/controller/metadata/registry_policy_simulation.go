@@ -0,0 +1,251 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/handler/utils"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/cleanup"
+	registrytypes "github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// maxPolicySimulationMatches is the number of deleted and blocked versions listed by a simulation,
+// which counts all of them.
+const maxPolicySimulationMatches = 1000
+
+// policySimulation is a draft of the policies of a registry.
+type policySimulation struct {
+	cleanupPolicies []registrytypes.CleanupPolicy
+	allowedPattern  []string
+	blockedPattern  []string
+	// admission is set if patterns were given, which are evaluated together as on a push.
+	admission bool
+}
+
+// SimulateRegistryPolicies evaluates draft cleanup policies and allowed or blocked patterns against
+// the versions of a registry, so their effect is known before they are set on it.
+func (c *APIController) SimulateRegistryPolicies(
+	ctx context.Context,
+	r artifact.SimulateRegistryPoliciesRequestObject,
+) (artifact.SimulateRegistryPoliciesResponseObject, error) {
+	if r.Body == nil {
+		return throwSimulateRegistryPolicies400Error(errors.New("request body is required")), nil
+	}
+	simulation, err := newPolicySimulation(artifact.RegistryPolicySimulationRequest(*r.Body))
+	if err != nil {
+		return throwSimulateRegistryPolicies400Error(err), nil
+	}
+
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwSimulateRegistryPolicies400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwSimulateRegistryPolicies400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.SimulateRegistryPolicies403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return artifact.SimulateRegistryPolicies404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, err.Error()),
+			),
+		}, nil
+	}
+	candidates, err := c.listPolicySimulationCandidates(ctx, registry)
+	if err != nil {
+		return artifact.SimulateRegistryPolicies500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	return artifact.SimulateRegistryPolicies200JSONResponse{
+		RegistryPolicySimulationResponseJSONResponse: artifact.RegistryPolicySimulationResponseJSONResponse{
+			Data:   simulation.evaluate(candidates, time.Now()),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func newPolicySimulation(req artifact.RegistryPolicySimulationRequest) (*policySimulation, error) {
+	simulation := &policySimulation{}
+	if req.CleanupPolicy != nil {
+		if err := ValidateCleanupPolicies(req.CleanupPolicy); err != nil {
+			return nil, err
+		}
+		for _, policy := range *req.CleanupPolicy {
+			if policy.Name == nil || policy.ExpireDays == nil {
+				return nil, errors.New("name and expireDays are required for a cleanup policy")
+			}
+			simulation.cleanupPolicies = append(simulation.cleanupPolicies, *getCleanupPolicyEntity(policy, 0))
+		}
+	}
+	if req.AllowedPattern != nil {
+		simulation.allowedPattern = *req.AllowedPattern
+		simulation.admission = true
+	}
+	if req.BlockedPattern != nil {
+		simulation.blockedPattern = *req.BlockedPattern
+		simulation.admission = true
+	}
+	for _, pattern := range append(append([]string{}, simulation.allowedPattern...), simulation.blockedPattern...) {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	if len(simulation.cleanupPolicies) == 0 && !simulation.admission {
+		return nil, errors.New("cleanup policies or patterns are required")
+	}
+	return simulation, nil
+}
+
+// listPolicySimulationCandidates lists the tags of OCI registries, along with when they were last
+// pulled, and the versions of other registries.
+func (c *APIController) listPolicySimulationCandidates(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+) ([]cleanup.Candidate, error) {
+	if isOCIPackageType(registry.PackageType) {
+		tags, err := c.TagStore.ListTagsNotPulledSince(ctx, registry.ID, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags of registry %s: %w", registry.Name, err)
+		}
+		candidates := make([]cleanup.Candidate, 0, len(tags))
+		for _, tag := range tags {
+			candidates = append(candidates, cleanup.Candidate{
+				Image:        tag.ImageName,
+				Version:      tag.Name,
+				CreatedAt:    tag.CreatedAt,
+				LastPulledAt: tag.LastPulledAt,
+				Tag:          true,
+			})
+		}
+		return candidates, nil
+	}
+
+	names, err := c.ImageStore.ListNamesByRegistryID(ctx, registry.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts of registry %s: %w", registry.Name, err)
+	}
+	var candidates []cleanup.Candidate
+	for _, name := range names {
+		artifacts, err := c.ArtifactStore.GetByRegistryIDAndImage(ctx, registry.ID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions of %s: %w", name, err)
+		}
+		for _, a := range *artifacts {
+			candidates = append(candidates, cleanup.Candidate{
+				Image:     name,
+				Version:   a.Version,
+				CreatedAt: a.CreatedAt,
+			})
+		}
+	}
+	return candidates, nil
+}
+
+// evaluate reports the candidates the first matching cleanup policy would delete at now, and the
+// ones the patterns would have rejected when they were pushed.
+func (s *policySimulation) evaluate(candidates []cleanup.Candidate, now time.Time) artifact.RegistryPolicySimulation {
+	result := artifact.RegistryPolicySimulation{
+		Evaluated: int64(len(candidates)),
+		Deleted:   []artifact.PolicySimulationMatch{},
+		Blocked:   []artifact.PolicySimulationMatch{},
+	}
+	for _, candidate := range candidates {
+		for _, policy := range s.cleanupPolicies {
+			reason, deleted := cleanup.Evaluate(policy, candidate, now)
+			if !deleted {
+				continue
+			}
+			result.DeletedCount++
+			if len(result.Deleted) < maxPolicySimulationMatches {
+				match := policySimulationMatch(candidate, reason)
+				name := policy.Name
+				match.Policy = &name
+				result.Deleted = append(result.Deleted, match)
+			}
+			break
+		}
+		if !s.admission {
+			continue
+		}
+		allowed, err := utils.MatchArtifactFilter(s.allowedPattern, s.blockedPattern,
+			candidate.Image+":"+candidate.Version)
+		if allowed {
+			continue
+		}
+		result.BlockedCount++
+		if len(result.Blocked) < maxPolicySimulationMatches {
+			reason := "rejected by the patterns"
+			if err != nil {
+				reason = err.Error()
+			}
+			result.Blocked = append(result.Blocked, policySimulationMatch(candidate, reason))
+		}
+	}
+	return result
+}
+
+func policySimulationMatch(candidate cleanup.Candidate, reason string) artifact.PolicySimulationMatch {
+	createdAt := candidate.CreatedAt.UnixMilli()
+	match := artifact.PolicySimulationMatch{
+		Package:   candidate.Image,
+		Version:   candidate.Version,
+		Reason:    reason,
+		CreatedAt: &createdAt,
+	}
+	if !candidate.LastPulledAt.IsZero() {
+		lastPulledAt := candidate.LastPulledAt.UnixMilli()
+		match.LastPulledAt = &lastPulledAt
+	}
+	return match
+}
+
+func throwSimulateRegistryPolicies400Error(err error) artifact.SimulateRegistryPolicies400JSONResponse {
+	return artifact.SimulateRegistryPolicies400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
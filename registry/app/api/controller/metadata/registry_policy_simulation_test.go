@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/cleanup"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicySimulation(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	name := "stale-builds"
+	expireDays := 30
+	policyType := artifact.CleanupPolicyTypeNOTPULLED
+	simulation, err := newPolicySimulation(artifact.RegistryPolicySimulationRequest{
+		CleanupPolicy: &[]artifact.CleanupPolicy{
+			{Name: &name, ExpireDays: &expireDays, Type: &policyType, ExcludePattern: &[]string{"latest"}},
+		},
+		BlockedPattern: &[]string{":pr-"},
+	})
+	require.NoError(t, err)
+
+	result := simulation.evaluate([]cleanup.Candidate{
+		{Image: "app", Version: "build-1", CreatedAt: now.AddDate(0, -3, 0), Tag: true},
+		{Image: "app", Version: "latest", CreatedAt: now.AddDate(0, -3, 0), Tag: true},
+		{Image: "app", Version: "pr-7", CreatedAt: now.AddDate(0, 0, -2), LastPulledAt: now, Tag: true},
+	}, now)
+	assert.Equal(t, int64(3), result.Evaluated)
+	assert.Equal(t, int64(1), result.DeletedCount)
+	assert.Equal(t, "build-1", result.Deleted[0].Version)
+	assert.Equal(t, name, *result.Deleted[0].Policy)
+	assert.Equal(t, int64(1), result.BlockedCount)
+	assert.Equal(t, "pr-7", result.Blocked[0].Version)
+	assert.Nil(t, result.Blocked[0].Policy)
+}
+
+func TestPolicySimulationInvalid(t *testing.T) {
+	_, err := newPolicySimulation(artifact.RegistryPolicySimulationRequest{})
+	assert.Error(t, err)
+	_, err = newPolicySimulation(artifact.RegistryPolicySimulationRequest{AllowedPattern: &[]string{"("}})
+	assert.Error(t, err)
+	_, err = newPolicySimulation(artifact.RegistryPolicySimulationRequest{
+		CleanupPolicy: &[]artifact.CleanupPolicy{{}},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,213 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/queue"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// queueFailure carries the status of a failed queue request to the operation specific responses.
+type queueFailure struct {
+	status int
+	err    error
+}
+
+func (c *APIController) ListRegistryQueues(
+	ctx context.Context,
+	r artifact.ListRegistryQueuesRequestObject,
+) (artifact.ListRegistryQueuesResponseObject, error) {
+	regInfo, failure := c.queueRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	if failure != nil {
+		return listRegistryQueuesErrorResponse(failure), nil
+	}
+
+	queues, err := c.QueueService.List(ctx, regInfo.RegistryID)
+	if err != nil {
+		return listRegistryQueuesErrorResponse(&queueFailure{status: http.StatusInternalServerError, err: err}), nil
+	}
+
+	now := time.Now()
+	data := make([]artifact.RegistryQueue, 0, len(queues))
+	for i := range queues {
+		data = append(data, mapToAPIRegistryQueue(&queues[i], now))
+	}
+	return artifact.ListRegistryQueues200JSONResponse{
+		ListRegistryQueuesResponseJSONResponse: artifact.ListRegistryQueuesResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func (c *APIController) PauseRegistryQueue(
+	ctx context.Context,
+	r artifact.PauseRegistryQueueRequestObject,
+) (artifact.PauseRegistryQueueResponseObject, error) {
+	regInfo, failure := c.queueRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	if failure != nil {
+		return pauseRegistryQueueErrorResponse(failure), nil
+	}
+
+	name := types.QueueName(r.Queue)
+	session, _ := request.AuthSessionFrom(ctx)
+	if err := c.QueueService.Pause(ctx, regInfo.RegistryID, name, session.Principal.ID); err != nil {
+		return pauseRegistryQueueErrorResponse(queueServiceFailure(err)), nil
+	}
+	q, err := c.QueueService.Get(ctx, regInfo.RegistryID, name)
+	if err != nil {
+		return pauseRegistryQueueErrorResponse(queueServiceFailure(err)), nil
+	}
+	return artifact.PauseRegistryQueue200JSONResponse{
+		RegistryQueueResponseJSONResponse: artifact.RegistryQueueResponseJSONResponse{
+			Data:   mapToAPIRegistryQueue(q, time.Now()),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func (c *APIController) ResumeRegistryQueue(
+	ctx context.Context,
+	r artifact.ResumeRegistryQueueRequestObject,
+) (artifact.ResumeRegistryQueueResponseObject, error) {
+	regInfo, failure := c.queueRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	if failure != nil {
+		return resumeRegistryQueueErrorResponse(failure), nil
+	}
+
+	name := types.QueueName(r.Queue)
+	if err := c.QueueService.Resume(ctx, regInfo.RegistryID, name); err != nil {
+		return resumeRegistryQueueErrorResponse(queueServiceFailure(err)), nil
+	}
+	q, err := c.QueueService.Get(ctx, regInfo.RegistryID, name)
+	if err != nil {
+		return resumeRegistryQueueErrorResponse(queueServiceFailure(err)), nil
+	}
+	return artifact.ResumeRegistryQueue200JSONResponse{
+		RegistryQueueResponseJSONResponse: artifact.RegistryQueueResponseJSONResponse{
+			Data:   mapToAPIRegistryQueue(q, time.Now()),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// queueRegistry resolves the registry of a queue request and checks the permission of the caller.
+func (c *APIController) queueRegistry(
+	ctx context.Context,
+	registryRef string,
+	permission enum.Permission,
+) (*RegistryRequestBaseInfo, *queueFailure) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", registryRef)
+	if err != nil {
+		return nil, &queueFailure{status: http.StatusBadRequest, err: err}
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return nil, &queueFailure{status: http.StatusBadRequest, err: err}
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier, permission)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return nil, &queueFailure{status: http.StatusForbidden, err: err}
+	}
+	return regInfo, nil
+}
+
+func queueServiceFailure(err error) *queueFailure {
+	if errors.Is(err, queue.ErrUnknownQueue) {
+		return &queueFailure{status: http.StatusNotFound, err: err}
+	}
+	return &queueFailure{status: http.StatusInternalServerError, err: err}
+}
+
+func mapToAPIRegistryQueue(q *queue.Queue, now time.Time) artifact.RegistryQueue {
+	apiQueue := artifact.RegistryQueue{
+		Name:    artifact.RegistryQueueName(q.Name),
+		Pending: q.Pending,
+		Running: q.Running,
+		Paused:  q.Paused,
+	}
+	if q.OldestPendingAt > 0 {
+		oldestPendingAt := q.OldestPendingAt
+		age := int64(now.Sub(time.UnixMilli(oldestPendingAt)).Seconds())
+		apiQueue.OldestPendingAt = &oldestPendingAt
+		apiQueue.OldestPendingAgeSeconds = &age
+	}
+	if q.Paused {
+		pausedAt := q.PausedAt
+		pausedBy := q.PausedBy
+		apiQueue.PausedAt = &pausedAt
+		apiQueue.PausedBy = &pausedBy
+	}
+	return apiQueue
+}
+
+func listRegistryQueuesErrorResponse(f *queueFailure) artifact.ListRegistryQueuesResponseObject {
+	e := *GetErrorResponse(f.status, f.err.Error())
+	switch f.status {
+	case http.StatusBadRequest:
+		return artifact.ListRegistryQueues400JSONResponse{BadRequestJSONResponse: artifact.BadRequestJSONResponse(e)}
+	case http.StatusForbidden:
+		return artifact.ListRegistryQueues403JSONResponse{UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(e)}
+	case http.StatusNotFound:
+		return artifact.ListRegistryQueues404JSONResponse{NotFoundJSONResponse: artifact.NotFoundJSONResponse(e)}
+	default:
+		return artifact.ListRegistryQueues500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(e),
+		}
+	}
+}
+
+func pauseRegistryQueueErrorResponse(f *queueFailure) artifact.PauseRegistryQueueResponseObject {
+	e := *GetErrorResponse(f.status, f.err.Error())
+	switch f.status {
+	case http.StatusBadRequest:
+		return artifact.PauseRegistryQueue400JSONResponse{BadRequestJSONResponse: artifact.BadRequestJSONResponse(e)}
+	case http.StatusForbidden:
+		return artifact.PauseRegistryQueue403JSONResponse{UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(e)}
+	case http.StatusNotFound:
+		return artifact.PauseRegistryQueue404JSONResponse{NotFoundJSONResponse: artifact.NotFoundJSONResponse(e)}
+	default:
+		return artifact.PauseRegistryQueue500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(e),
+		}
+	}
+}
+
+func resumeRegistryQueueErrorResponse(f *queueFailure) artifact.ResumeRegistryQueueResponseObject {
+	e := *GetErrorResponse(f.status, f.err.Error())
+	switch f.status {
+	case http.StatusBadRequest:
+		return artifact.ResumeRegistryQueue400JSONResponse{BadRequestJSONResponse: artifact.BadRequestJSONResponse(e)}
+	case http.StatusForbidden:
+		return artifact.ResumeRegistryQueue403JSONResponse{UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(e)}
+	case http.StatusNotFound:
+		return artifact.ResumeRegistryQueue404JSONResponse{NotFoundJSONResponse: artifact.NotFoundJSONResponse(e)}
+	default:
+		return artifact.ResumeRegistryQueue500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(e),
+		}
+	}
+}
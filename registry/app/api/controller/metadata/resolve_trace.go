@@ -0,0 +1,183 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/resolution"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetResolveTrace reports which registry, among the registry and its upstream proxies, would answer
+// for a coordinate and why the others wouldn't.
+func (c *APIController) GetResolveTrace(
+	ctx context.Context,
+	r artifact.GetResolveTraceRequestObject,
+) (artifact.GetResolveTraceResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwGetResolveTrace400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwGetResolveTrace400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.GetResolveTrace403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	coordinate := resolution.Coordinate{
+		Artifact: strings.TrimSpace(string(r.Params.Artifact)),
+		Version:  strings.TrimSpace(string(r.Params.Version)),
+	}
+	if r.Params.File != nil {
+		coordinate.File = strings.TrimSpace(string(*r.Params.File))
+	}
+	if coordinate.Artifact == "" || coordinate.Version == "" {
+		return throwGetResolveTrace400Error(errors.New("artifact and version are required")), nil
+	}
+
+	registry, err := c.RegistryRepository.GetByParentIDAndName(ctx, regInfo.parentID, regInfo.RegistryIdentifier)
+	if err != nil {
+		return throwGetResolveTrace500Error(err), nil
+	}
+
+	trace, err := c.ResolutionService.Resolve(ctx, registry, coordinate)
+	if err != nil {
+		return throwGetResolveTrace500Error(err), nil
+	}
+
+	return artifact.GetResolveTrace200JSONResponse{
+		ResolveTraceResponseJSONResponse: artifact.ResolveTraceResponseJSONResponse{
+			Data:   mapToAPIResolveTrace(coordinate, trace),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ResolveArtifactPath reports which registry, among the registry and its members, would serve the artifact
+// at a path, as it's pulled from the registry.
+func (c *APIController) ResolveArtifactPath(
+	ctx context.Context,
+	r artifact.ResolveArtifactPathRequestObject,
+) (artifact.ResolveArtifactPathResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return throwResolveArtifactPath400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.ResolveArtifactPath403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwResolveArtifactPath500Error(err), nil
+	}
+
+	coordinate, err := resolution.ParsePath(registry.PackageType, string(r.Params.Path))
+	if err != nil {
+		return throwResolveArtifactPath400Error(err), nil
+	}
+	trace, err := c.ResolutionService.Resolve(ctx, registry, coordinate)
+	if err != nil {
+		return throwResolveArtifactPath500Error(err), nil
+	}
+
+	result := mapToAPIResolveTrace(coordinate, trace)
+	path := string(r.Params.Path)
+	result.Path = &path
+	return artifact.ResolveArtifactPath200JSONResponse{
+		ResolveTraceResponseJSONResponse: artifact.ResolveTraceResponseJSONResponse{
+			Data:   result,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToAPIResolveTrace(coordinate resolution.Coordinate, trace *resolution.Trace) artifact.ResolveTrace {
+	result := artifact.ResolveTrace{
+		Artifact: coordinate.Artifact,
+		Version:  coordinate.Version,
+		Steps:    make([]artifact.ResolveStep, 0, len(trace.Steps)),
+	}
+	if coordinate.File != "" {
+		result.File = &coordinate.File
+	}
+	if trace.AnsweredBy != "" {
+		result.AnsweredBy = &trace.AnsweredBy
+	}
+	for _, step := range trace.Steps {
+		s := artifact.ResolveStep{
+			RegistryIdentifier: step.RegistryIdentifier,
+			RegistryType:       step.RegistryType,
+			Outcome:            artifact.ResolveOutcome(step.Outcome),
+			Reason:             step.Reason,
+		}
+		if step.UpstreamURL != "" {
+			upstreamURL := step.UpstreamURL
+			s.UpstreamUrl = &upstreamURL
+		}
+		result.Steps = append(result.Steps, s)
+	}
+	return result
+}
+
+func throwGetResolveTrace400Error(err error) artifact.GetResolveTrace400JSONResponse {
+	return artifact.GetResolveTrace400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwGetResolveTrace500Error(err error) artifact.GetResolveTrace500JSONResponse {
+	return artifact.GetResolveTrace500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwResolveArtifactPath400Error(err error) artifact.ResolveArtifactPath400JSONResponse {
+	return artifact.ResolveArtifactPath400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwResolveArtifactPath500Error(err error) artifact.ResolveArtifactPath500JSONResponse {
+	return artifact.ResolveArtifactPath500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
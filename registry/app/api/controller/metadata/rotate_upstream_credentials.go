@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	gitnessenum "github.com/harness/gitness/types/enum"
+)
+
+// RotateUpstreamCredentials replaces the credentials an upstream proxy authenticates to its upstream with,
+// keeping the rest of its configuration.
+func (c *APIController) RotateUpstreamCredentials(
+	ctx context.Context,
+	r artifact.RotateUpstreamCredentialsRequestObject,
+) (artifact.RotateUpstreamCredentialsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwRotateUpstreamCredentials400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwRotateUpstreamCredentials400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space,
+		regInfo.RegistryIdentifier, gitnessenum.PermissionRegistryEdit)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.RotateUpstreamCredentials403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+	if r.Body == nil {
+		return throwRotateUpstreamCredentials400Error(fmt.Errorf("credentials missing")), nil
+	}
+
+	upstreamProxyEntity, err := c.UpstreamProxyStore.GetByRegistryIdentifier(
+		ctx, regInfo.parentID, regInfo.RegistryIdentifier,
+	)
+	if err != nil || upstreamProxyEntity == nil || len(upstreamProxyEntity.RepoKey) == 0 {
+		return artifact.RotateUpstreamCredentials404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "upstream registry doesn't exist with this key"),
+			),
+		}, nil
+	}
+
+	upstreamProxy := &types.UpstreamProxyConfig{
+		ID:          upstreamProxyEntity.ID,
+		RegistryID:  upstreamProxyEntity.RegistryID,
+		Source:      upstreamProxyEntity.Source,
+		URL:         upstreamProxyEntity.RepoURL,
+		MetadataTTL: upstreamProxyEntity.MetadataTTL,
+		ArtifactTTL: upstreamProxyEntity.ArtifactTTL,
+		Offline:     upstreamProxyEntity.Offline,
+		CreatedAt:   upstreamProxyEntity.CreatedAt,
+		CreatedBy:   upstreamProxyEntity.CreatedBy,
+	}
+	if err = c.setUpstreamAuth(ctx, upstreamProxy, r.Body.AuthType, r.Body.Auth); err != nil {
+		return throwRotateUpstreamCredentials400Error(err), nil
+	}
+	err = c.updateUpstreamProxyWithAudit(
+		ctx, upstreamProxy, session.Principal, regInfo.ParentRef, upstreamProxyEntity.RepoKey,
+	)
+	if err != nil {
+		return throwRotateUpstreamCredentials500Error(err), err
+	}
+	c.recordRegistryConfigRevision(ctx, upstreamProxyEntity.RegistryID)
+
+	modifiedEntity, err := c.UpstreamProxyStore.Get(ctx, upstreamProxyEntity.RegistryID)
+	if err != nil {
+		return throwRotateUpstreamCredentials500Error(err), err
+	}
+	return artifact.RotateUpstreamCredentials200JSONResponse{
+		RegistryResponseJSONResponse: *CreateUpstreamProxyResponseJSONResponse(modifiedEntity),
+	}, nil
+}
+
+func throwRotateUpstreamCredentials400Error(err error) artifact.RotateUpstreamCredentials400JSONResponse {
+	return artifact.RotateUpstreamCredentials400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwRotateUpstreamCredentials500Error(err error) artifact.RotateUpstreamCredentials500JSONResponse {
+	return artifact.RotateUpstreamCredentials500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
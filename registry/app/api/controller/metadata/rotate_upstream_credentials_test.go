@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetUpstreamAuth(t *testing.T) {
+	c := &APIController{}
+	spaceID := 7
+	entity := &types.UpstreamProxyConfig{
+		Source:   string(artifact.UpstreamConfigSourceCustom),
+		UserName: "previous",
+	}
+
+	auth := &artifact.UpstreamAuth{}
+	require.NoError(t, auth.FromToken(artifact.Token{SecretIdentifier: "npm-token", SecretSpaceId: &spaceID}))
+	require.NoError(t, c.setUpstreamAuth(context.Background(), entity, artifact.AuthTypeToken, auth))
+	assert.Equal(t, string(artifact.AuthTypeToken), entity.AuthType)
+	assert.Equal(t, "npm-token", entity.SecretIdentifier)
+	assert.Equal(t, spaceID, entity.SecretSpaceID)
+	assert.Empty(t, entity.UserName)
+
+	// AwsIam is only supported for ECR upstreams.
+	assert.Error(t, c.setUpstreamAuth(context.Background(), entity, artifact.AuthTypeAwsIam, nil))
+	entity.Source = string(artifact.UpstreamConfigSourceAwsEcr)
+	roleArn := "arn:aws:iam::123456789012:role/ecr-pull"
+	require.NoError(t, auth.FromAwsIam(artifact.AwsIam{RoleArn: &roleArn}))
+	require.NoError(t, c.setUpstreamAuth(context.Background(), entity, artifact.AuthTypeAwsIam, auth))
+	assert.Equal(t, roleArn, entity.AwsRoleArn)
+	assert.Empty(t, entity.SecretIdentifier)
+
+	assert.Error(t, c.setUpstreamAuth(context.Background(), entity, artifact.AuthTypeUserPassword, nil))
+}
+
+func TestCredentialsChanged(t *testing.T) {
+	existing := &types.UpstreamProxy{
+		RepoAuthType:     string(artifact.AuthTypeToken),
+		SecretIdentifier: "npm-token",
+		SecretSpaceID:    7,
+	}
+	updated := &types.UpstreamProxyConfig{
+		AuthType:         string(artifact.AuthTypeToken),
+		SecretIdentifier: "npm-token",
+		SecretSpaceID:    7,
+	}
+	assert.False(t, credentialsChanged(existing, updated))
+	updated.SecretIdentifier = "npm-token-2"
+	assert.True(t, credentialsChanged(existing, updated))
+}
@@ -0,0 +1,466 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/vex"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	// maxScanFindings limits the number of findings of a single scan report.
+	maxScanFindings = 10000
+	// maxScanComponents limits the number of SBOM components of a single scan report.
+	maxScanComponents = 50000
+)
+
+// IngestScanResult records the result of a scan reported by any scanner against an artifact digest.
+func (c *APIController) IngestScanResult(
+	ctx context.Context,
+	r artifact.IngestScanResultRequestObject,
+) (artifact.IngestScanResultResponseObject, error) {
+	if r.Body == nil {
+		return throwIngestScanResult400Error(fmt.Errorf("request body is required")), nil
+	}
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwIngestScanResult400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwIngestScanResult400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsUpload)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.IngestScanResult403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	scan, findings, err := mapToScan(regInfo.RegistryID, session.Principal.ID, artifact.ScanResultRequest(*r.Body))
+	if err != nil {
+		return throwIngestScanResult400Error(err), nil
+	}
+	components, err := mapToScanComponents(r.Body.Components)
+	if err != nil {
+		return throwIngestScanResult400Error(err), nil
+	}
+
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := c.ScanStore.Create(ctx, scan); err != nil {
+			return err
+		}
+		for _, finding := range findings {
+			finding.ScanID = scan.ID
+		}
+		if err := c.ScanStore.CreateFindings(ctx, findings); err != nil {
+			return err
+		}
+		for _, component := range components {
+			component.ScanID = scan.ID
+		}
+		return c.ScanStore.CreateComponents(ctx, components)
+	})
+	if err != nil {
+		return throwIngestScanResult500Error(err), nil
+	}
+
+	result, err := c.scanResultWithFindings(ctx, scan, findings)
+	if err != nil {
+		return throwIngestScanResult500Error(err), nil
+	}
+	return artifact.IngestScanResult201JSONResponse{
+		ScanResultResponseJSONResponse: artifact.ScanResultResponseJSONResponse{
+			Data:   result,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListScanResults lists the scan results of an artifact digest, the most recent first.
+func (c *APIController) ListScanResults(
+	ctx context.Context,
+	r artifact.ListScanResultsRequestObject,
+) (artifact.ListScanResultsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwListScanResults400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwListScanResults400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ListScanResults403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	dgst, err := digest.Parse(string(r.Params.Digest))
+	if err != nil {
+		return throwListScanResults400Error(fmt.Errorf("invalid digest: %w", err)), nil
+	}
+	scans, err := c.ScanStore.ListByDigest(ctx, regInfo.RegistryID, dgst.String())
+	if err != nil {
+		return throwListScanResults500Error(err), nil
+	}
+	scanIDs := make([]int64, 0, len(scans))
+	for _, scan := range scans {
+		scanIDs = append(scanIDs, scan.ID)
+	}
+	suppressedIdentifiers, allowlistedIdentifiers, err := c.ignoredVulnerabilities(ctx, regInfo.RegistryID,
+		dgst.String())
+	if err != nil {
+		return throwListScanResults500Error(err), nil
+	}
+	counts, err := c.ScanStore.CountFindingsBySeverity(ctx, scanIDs,
+		append(suppressedIdentifiers, allowlistedIdentifiers...))
+	if err != nil {
+		return throwListScanResults500Error(err), nil
+	}
+	suppressedCounts := map[int64]int64{}
+	if len(suppressedIdentifiers) > 0 {
+		suppressedCounts, err = c.ScanStore.CountSuppressedFindings(ctx, scanIDs, suppressedIdentifiers)
+		if err != nil {
+			return throwListScanResults500Error(err), nil
+		}
+	}
+	allowlistedCounts := map[int64]int64{}
+	if len(allowlistedIdentifiers) > 0 {
+		allowlistedCounts, err = c.ScanStore.CountSuppressedFindings(ctx, scanIDs, allowlistedIdentifiers)
+		if err != nil {
+			return throwListScanResults500Error(err), nil
+		}
+	}
+
+	results := make([]artifact.ScanResult, 0, len(scans))
+	for _, scan := range scans {
+		results = append(results, mapToAPIScanResult(scan, counts[scan.ID], suppressedCounts[scan.ID],
+			allowlistedCounts[scan.ID]))
+	}
+	return artifact.ListScanResults200JSONResponse{
+		ListScanResultsResponseJSONResponse: artifact.ListScanResultsResponseJSONResponse{
+			Data:   results,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// GetScanResult returns a scan result with its findings.
+func (c *APIController) GetScanResult(
+	ctx context.Context,
+	r artifact.GetScanResultRequestObject,
+) (artifact.GetScanResultResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwGetScanResult400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwGetScanResult400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.GetScanResult403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	scan, err := c.ScanStore.Get(ctx, regInfo.RegistryID, int64(r.ScanId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.GetScanResult404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "scan result not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwGetScanResult500Error(err), nil
+	}
+	findings, err := c.ScanStore.ListFindings(ctx, scan.ID)
+	if err != nil {
+		return throwGetScanResult500Error(err), nil
+	}
+
+	result, err := c.scanResultWithFindings(ctx, scan, findings)
+	if err != nil {
+		return throwGetScanResult500Error(err), nil
+	}
+	return artifact.GetScanResult200JSONResponse{
+		ScanResultResponseJSONResponse: artifact.ScanResultResponseJSONResponse{
+			Data:   result,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToScan(
+	registryID int64,
+	principalID int64,
+	in artifact.ScanResultRequest,
+) (*registrytypes.Scan, []*registrytypes.ScanFinding, error) {
+	dgst, err := digest.Parse(in.Digest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid digest: %w", err)
+	}
+	if strings.TrimSpace(in.Tool) == "" {
+		return nil, nil, fmt.Errorf("tool is required")
+	}
+	if in.StartedAt <= 0 || in.FinishedAt < in.StartedAt {
+		return nil, nil, fmt.Errorf("scan must finish after it started")
+	}
+
+	scan := &registrytypes.Scan{
+		RegistryID:  registryID,
+		Digest:      dgst.String(),
+		Tool:        strings.TrimSpace(in.Tool),
+		ToolVersion: strings.TrimSpace(derefString(in.ToolVersion)),
+		StartedAt:   time.UnixMilli(in.StartedAt),
+		FinishedAt:  time.UnixMilli(in.FinishedAt),
+		CreatedBy:   principalID,
+	}
+	if in.Findings == nil {
+		return scan, nil, nil
+	}
+	if len(*in.Findings) > maxScanFindings {
+		return nil, nil, fmt.Errorf("a scan can report at most %d findings", maxScanFindings)
+	}
+
+	findings := make([]*registrytypes.ScanFinding, 0, len(*in.Findings))
+	for _, f := range *in.Findings {
+		if strings.TrimSpace(f.Identifier) == "" {
+			return nil, nil, fmt.Errorf("finding identifier is required")
+		}
+		severity := registrytypes.ScanSeverity(strings.ToUpper(string(f.Severity)))
+		if !severity.IsValid() {
+			return nil, nil, fmt.Errorf("invalid severity %q of finding %s", f.Severity, f.Identifier)
+		}
+		findings = append(findings, &registrytypes.ScanFinding{
+			Identifier:     strings.TrimSpace(f.Identifier),
+			Severity:       severity,
+			PackageName:    derefString(f.PackageName),
+			PackageVersion: derefString(f.PackageVersion),
+			FixedVersion:   derefString(f.FixedVersion),
+			Title:          derefString(f.Title),
+			URL:            derefString(f.Url),
+		})
+	}
+	return scan, findings, nil
+}
+
+func mapToScanComponents(in *[]artifact.ScanComponent) ([]*registrytypes.ScanComponent, error) {
+	if in == nil {
+		return nil, nil
+	}
+	if len(*in) > maxScanComponents {
+		return nil, fmt.Errorf("a scan can report at most %d components", maxScanComponents)
+	}
+	components := make([]*registrytypes.ScanComponent, 0, len(*in))
+	for _, component := range *in {
+		if strings.TrimSpace(component.Name) == "" {
+			return nil, fmt.Errorf("component name is required")
+		}
+		components = append(components, &registrytypes.ScanComponent{
+			Name:    strings.TrimSpace(component.Name),
+			Version: strings.TrimSpace(derefString(component.Version)),
+			Purl:    strings.TrimSpace(derefString(component.Purl)),
+		})
+	}
+	return components, nil
+}
+
+// scanResultWithFindings maps a scan with its findings, leaving the findings suppressed by the
+// active VEX statements of the artifact or allowlisted for it out of the severity counts.
+func (c *APIController) scanResultWithFindings(
+	ctx context.Context,
+	scan *registrytypes.Scan,
+	findings []*registrytypes.ScanFinding,
+) (artifact.ScanResult, error) {
+	statements, err := c.activeVexStatements(ctx, scan.RegistryID, scan.Digest)
+	if err != nil {
+		return artifact.ScanResult{}, err
+	}
+	byVulnerability := make(map[string]*registrytypes.VexStatement, len(statements))
+	for _, statement := range statements {
+		byVulnerability[statement.Vulnerability] = statement
+	}
+	allowlist, err := c.SecurityService.Allowlisted(ctx, scan.RegistryID, scan.Digest)
+	if err != nil {
+		return artifact.ScanResult{}, err
+	}
+
+	counts := registrytypes.ScanSeverityCounts{}
+	var suppressed, allowlisted int64
+	apiFindings := make([]artifact.ScanFinding, 0, len(findings))
+	for _, f := range findings {
+		apiFinding := mapToAPIScanFinding(f)
+		identifier := vex.NormalizeVulnerability(f.Identifier)
+		if statement, ok := byVulnerability[identifier]; ok {
+			status := artifact.VexStatus(statement.Status)
+			isSuppressed := statement.Status.Suppresses()
+			apiFinding.VexStatus = &status
+			apiFinding.Suppressed = &isSuppressed
+		}
+		if entry, ok := allowlist[identifier]; ok {
+			isAllowlisted := true
+			apiEntry := mapToAPIVulnerabilityAllowlistEntry(entry, time.Now())
+			apiFinding.Allowlisted = &isAllowlisted
+			apiFinding.AllowlistEntry = &apiEntry
+		}
+		switch {
+		case apiFinding.Suppressed != nil && *apiFinding.Suppressed:
+			suppressed++
+		case apiFinding.Allowlisted != nil:
+			allowlisted++
+		default:
+			counts[f.Severity]++
+		}
+		apiFindings = append(apiFindings, apiFinding)
+	}
+
+	result := mapToAPIScanResult(scan, counts, suppressed, allowlisted)
+	result.Findings = &apiFindings
+	return result, nil
+}
+
+func mapToAPIScanResult(
+	scan *registrytypes.Scan,
+	counts registrytypes.ScanSeverityCounts,
+	suppressed int64,
+	allowlisted int64,
+) artifact.ScanResult {
+	return artifact.ScanResult{
+		Id:          scan.ID,
+		Digest:      scan.Digest,
+		Tool:        scan.Tool,
+		ToolVersion: scan.ToolVersion,
+		StartedAt:   scan.StartedAt.UnixMilli(),
+		FinishedAt:  scan.FinishedAt.UnixMilli(),
+		CreatedAt:   scan.CreatedAt.UnixMilli(),
+		SeverityCounts: artifact.ScanSeverityCounts{
+			Critical:    counts[registrytypes.ScanSeverityCritical],
+			High:        counts[registrytypes.ScanSeverityHigh],
+			Medium:      counts[registrytypes.ScanSeverityMedium],
+			Low:         counts[registrytypes.ScanSeverityLow],
+			Unknown:     counts[registrytypes.ScanSeverityUnknown],
+			Suppressed:  suppressed,
+			Allowlisted: allowlisted,
+		},
+	}
+}
+
+func mapToAPIScanFinding(f *registrytypes.ScanFinding) artifact.ScanFinding {
+	return artifact.ScanFinding{
+		Identifier:     f.Identifier,
+		Severity:       artifact.ScanSeverity(f.Severity),
+		PackageName:    optionalString(f.PackageName),
+		PackageVersion: optionalString(f.PackageVersion),
+		FixedVersion:   optionalString(f.FixedVersion),
+		Title:          optionalString(f.Title),
+		Url:            optionalString(f.URL),
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func throwIngestScanResult400Error(err error) artifact.IngestScanResult400JSONResponse {
+	return artifact.IngestScanResult400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwIngestScanResult500Error(err error) artifact.IngestScanResult500JSONResponse {
+	return artifact.IngestScanResult500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListScanResults400Error(err error) artifact.ListScanResults400JSONResponse {
+	return artifact.ListScanResults400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwListScanResults500Error(err error) artifact.ListScanResults500JSONResponse {
+	return artifact.ListScanResults500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwGetScanResult400Error(err error) artifact.GetScanResult400JSONResponse {
+	return artifact.GetScanResult400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwGetScanResult500Error(err error) artifact.GetScanResult500JSONResponse {
+	return artifact.GetScanResult500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
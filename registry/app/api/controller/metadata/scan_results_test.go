@@ -0,0 +1,65 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testScanDigest = "sha256:0b4b0a8ea9cf8ec5e85a0d3d29df3e5ed1dd3bbc0fd2fad8b6dcce2f1bee5f43"
+
+func TestMapToScan(t *testing.T) {
+	fixedVersion := "5.6.1"
+	in := artifact.ScanResultRequest{
+		Digest:     testScanDigest,
+		Tool:       " trivy ",
+		StartedAt:  1000,
+		FinishedAt: 2000,
+		Findings: &[]artifact.ScanFinding{
+			{Identifier: "CVE-2024-3094", Severity: "critical", FixedVersion: &fixedVersion},
+			{Identifier: "CVE-2023-4863", Severity: artifact.ScanSeverityHIGH},
+		},
+	}
+
+	scan, findings, err := mapToScan(1, 2, in)
+	require.NoError(t, err)
+	assert.Equal(t, "trivy", scan.Tool)
+	assert.Equal(t, int64(2000), scan.FinishedAt.UnixMilli())
+	require.Len(t, findings, 2)
+	assert.Equal(t, registrytypes.ScanSeverityCritical, findings[0].Severity)
+	assert.Equal(t, "5.6.1", findings[0].FixedVersion)
+}
+
+func TestMapToScan_Invalid(t *testing.T) {
+	valid := artifact.ScanResultRequest{Digest: testScanDigest, Tool: "trivy", StartedAt: 1000, FinishedAt: 2000}
+
+	invalidDigest := valid
+	invalidDigest.Digest = "latest"
+	invalidTimes := valid
+	invalidTimes.FinishedAt = 500
+	invalidSeverity := valid
+	invalidSeverity.Findings = &[]artifact.ScanFinding{{Identifier: "CVE-2024-3094", Severity: "SEVERE"}}
+
+	for _, in := range []artifact.ScanResultRequest{invalidDigest, invalidTimes, invalidSeverity} {
+		_, _, err := mapToScan(1, 2, in)
+		assert.Error(t, err)
+	}
+}
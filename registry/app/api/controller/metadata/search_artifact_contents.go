@@ -0,0 +1,139 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// SearchArtifactContents finds the artifacts of a registry and its upstream registries
+// containing a file whose path matches the query.
+func (c *APIController) SearchArtifactContents(
+	ctx context.Context,
+	r artifact.SearchArtifactContentsRequestObject,
+) (artifact.SearchArtifactContentsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return searchContents400Error(err), nil
+	}
+
+	query := strings.TrimSpace(string(r.Params.Query))
+	if query == "" {
+		return searchContents400Error(errors.New("query is required")), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return searchContents400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space,
+		regInfo.RegistryIdentifier, enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.SearchArtifactContents403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	registry, err := c.RegistryRepository.Get(ctx, regInfo.RegistryID)
+	if err != nil {
+		return searchContents500Error(err), nil
+	}
+
+	limit := GetPageLimit(r.Params.Size)
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	pageNumber := GetPageNumber(r.Params.Page)
+
+	matches, count, err := c.ContentIndexService.Search(ctx, registry, query, limit, offset)
+	if err != nil {
+		return searchContents500Error(err), nil
+	}
+
+	names := map[int64]string{registry.ID: registry.Name}
+	if len(registry.UpstreamProxies) > 0 {
+		upstreams, err := c.RegistryRepository.GetByIDIn(ctx, registry.UpstreamProxies)
+		if err != nil {
+			return searchContents500Error(err), nil
+		}
+		for _, upstream := range *upstreams {
+			names[upstream.ID] = upstream.Name
+		}
+	}
+
+	pageCount := GetPageCount(count, limit)
+	return artifact.SearchArtifactContents200JSONResponse{
+		ListArtifactContentsResponseJSONResponse: artifact.ListArtifactContentsResponseJSONResponse{
+			Data: artifact.ListArtifactContents{
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &limit,
+				Contents:  GetArtifactContents(matches, names),
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func GetArtifactContents(
+	matches []*types.ArchiveEntryMatch,
+	registryNames map[int64]string,
+) []artifact.ArtifactContent {
+	result := make([]artifact.ArtifactContent, 0, len(matches))
+	for _, m := range matches {
+		result = append(result, artifact.ArtifactContent{
+			RegistryIdentifier: registryNames[m.RegistryID],
+			Artifact:           m.ImageName,
+			Version:            m.Version,
+			Archive:            m.ArchiveName,
+			Path:               m.Path,
+			Size:               m.Size,
+		})
+	}
+	return result
+}
+
+func searchContents400Error(err error) artifact.SearchArtifactContents400JSONResponse {
+	return artifact.SearchArtifactContents400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func searchContents500Error(err error) artifact.SearchArtifactContents500JSONResponse {
+	return artifact.SearchArtifactContents500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,155 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func (c *APIController) SearchDockerManifestsByAnnotation(
+	ctx context.Context,
+	r artifact.SearchDockerManifestsByAnnotationRequestObject,
+) (artifact.SearchDockerManifestsByAnnotationResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return searchByAnnotation400Error(err), nil
+	}
+
+	key := strings.TrimSpace(string(r.Params.Key))
+	if key == "" {
+		return searchByAnnotation400Error(errors.New("annotation key is required")), nil
+	}
+
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return searchByAnnotation400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space,
+		regInfo.RegistryIdentifier, enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(
+		ctx,
+		c.Authorizer,
+		session,
+		permissionChecks...,
+	); err != nil {
+		return artifact.SearchDockerManifestsByAnnotation403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	var value *string
+	if r.Params.Value != nil {
+		v := string(*r.Params.Value)
+		value = &v
+	}
+	limit := GetPageLimit(r.Params.Size)
+	offset := GetOffset(r.Params.Size, r.Params.Page)
+	pageNumber := GetPageNumber(r.Params.Page)
+
+	manifests, err := c.ManifestStore.ListManifestsByAnnotation(ctx, regInfo.RegistryID, key, value, limit, offset)
+	if err != nil {
+		return searchByAnnotation500Error(err), nil
+	}
+	count, err := c.ManifestStore.CountManifestsByAnnotation(ctx, regInfo.RegistryID, key, value)
+	if err != nil {
+		return searchByAnnotation500Error(err), nil
+	}
+
+	ids := make([]int64, 0, len(manifests))
+	for _, m := range manifests {
+		ids = append(ids, m.ID)
+	}
+	tags, err := c.TagStore.GetTagNamesByManifestIDs(ctx, regInfo.RegistryID, ids)
+	if err != nil {
+		return searchByAnnotation500Error(err), nil
+	}
+
+	pageCount := GetPageCount(count, limit)
+	return artifact.SearchDockerManifestsByAnnotation200JSONResponse{
+		ListAnnotatedManifestsResponseJSONResponse: artifact.ListAnnotatedManifestsResponseJSONResponse{
+			Data: artifact.ListAnnotatedManifests{
+				ItemCount: &count,
+				PageCount: &pageCount,
+				PageIndex: &pageNumber,
+				PageSize:  &limit,
+				Manifests: GetAnnotatedManifests(manifests, tags),
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func GetAnnotatedManifests(manifests types.Manifests, tags map[int64][]string) []artifact.AnnotatedManifest {
+	result := make([]artifact.AnnotatedManifest, 0, len(manifests))
+	for _, m := range manifests {
+		createdAt := GetTimeInMs(m.CreatedAt)
+		manifestTags := tags[m.ID]
+		if manifestTags == nil {
+			manifestTags = []string{}
+		}
+		result = append(result, artifact.AnnotatedManifest{
+			ImageName:   m.ImageName,
+			Digest:      m.Digest.String(),
+			Tags:        manifestTags,
+			Annotations: getAnnotations(m.Annotations),
+			CreatedAt:   &createdAt,
+		})
+	}
+	return result
+}
+
+func getAnnotations(annotations types.JSONB) artifact.Annotations {
+	if annotations == nil {
+		return artifact.Annotations{}
+	}
+	return artifact.Annotations(annotations)
+}
+
+func searchByAnnotation400Error(err error) artifact.SearchDockerManifestsByAnnotation400JSONResponse {
+	return artifact.SearchDockerManifestsByAnnotation400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func searchByAnnotation500Error(err error) artifact.SearchDockerManifestsByAnnotation500JSONResponse {
+	return artifact.SearchDockerManifestsByAnnotation500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func getAnnotationsRef(annotations types.JSONB) *artifact.Annotations {
+	if len(annotations) == 0 {
+		return nil
+	}
+	result := getAnnotations(annotations)
+	return &result
+}
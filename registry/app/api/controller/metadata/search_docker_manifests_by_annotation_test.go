@@ -0,0 +1,167 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAnnotatedManifestRepository struct {
+	store.ManifestRepository
+	manifests types.Manifests
+}
+
+func (f *fakeAnnotatedManifestRepository) matching(key string, value *string) types.Manifests {
+	var manifests types.Manifests
+	for _, m := range f.manifests {
+		if v, ok := m.Annotations[key]; ok && (value == nil || v == *value) {
+			manifests = append(manifests, m)
+		}
+	}
+	return manifests
+}
+
+func (f *fakeAnnotatedManifestRepository) ListManifestsByAnnotation(
+	_ context.Context, _ int64, key string, value *string, limit int, offset int,
+) (types.Manifests, error) {
+	manifests := f.matching(key, value)
+	if offset >= len(manifests) {
+		return types.Manifests{}, nil
+	}
+	return manifests[offset:min(offset+limit, len(manifests))], nil
+}
+
+func (f *fakeAnnotatedManifestRepository) CountManifestsByAnnotation(
+	_ context.Context, _ int64, key string, value *string,
+) (int64, error) {
+	return int64(len(f.matching(key, value))), nil
+}
+
+type fakeManifestTagRepository struct {
+	store.TagRepository
+	tags map[int64][]string
+}
+
+func (f *fakeManifestTagRepository) GetTagNamesByManifestIDs(
+	_ context.Context, _ int64, manifestIDs []int64,
+) (map[int64][]string, error) {
+	tags := make(map[int64][]string)
+	for _, id := range manifestIDs {
+		if names, ok := f.tags[id]; ok {
+			tags[id] = names
+		}
+	}
+	return tags, nil
+}
+
+func newAnnotationSearchController(ctx context.Context) (*APIController, *MockAuthorizer) {
+	mockSpaceFinder := new(MockSpaceFinder)
+	mockAuthorizer := new(MockAuthorizer)
+	mockRegistryMetadataHelper := new(MockRegistryMetadataHelper)
+	regInfo := &RegistryRequestBaseInfo{
+		RegistryID:         1,
+		RegistryIdentifier: "reg",
+		ParentRef:          "root/parent",
+	}
+	space := &gitnesstypes.SpaceCore{ID: 2}
+	var permissionChecks []gitnesstypes.PermissionCheck
+	mockRegistryMetadataHelper.On("GetRegistryRequestBaseInfo", ctx, "", "reg").Return(regInfo, nil)
+	mockSpaceFinder.On("FindByRef", ctx, "root/parent").Return(space, nil)
+	mockRegistryMetadataHelper.On("GetPermissionChecks", space,
+		regInfo.RegistryIdentifier, enum.PermissionRegistryView).Return(permissionChecks)
+
+	createdAt := time.UnixMilli(1_700_000_000_000)
+	return &APIController{
+		SpaceFinder:            mockSpaceFinder,
+		Authorizer:             mockAuthorizer,
+		RegistryMetadataHelper: mockRegistryMetadataHelper,
+		ManifestStore: &fakeAnnotatedManifestRepository{manifests: types.Manifests{
+			{ID: 10, ImageName: "app", Digest: digest.FromString("release"), CreatedAt: createdAt,
+				Annotations: types.JSONB{"org.opencontainers.image.revision": "abc123"}},
+			{ID: 11, ImageName: "app", Digest: digest.FromString("nightly"), CreatedAt: createdAt,
+				Annotations: types.JSONB{"org.opencontainers.image.revision": "def456"}},
+			{ID: 12, ImageName: "app", Digest: digest.FromString("plain"), CreatedAt: createdAt},
+		}},
+		TagStore: &fakeManifestTagRepository{tags: map[int64][]string{10: {"1.0.0", "latest"}}},
+	}, mockAuthorizer
+}
+
+func searchByAnnotation(
+	ctx context.Context, controller *APIController, params api.SearchDockerManifestsByAnnotationParams,
+) (api.SearchDockerManifestsByAnnotationResponseObject, error) {
+	return controller.SearchDockerManifestsByAnnotation(ctx, api.SearchDockerManifestsByAnnotationRequestObject{
+		RegistryRef: "reg",
+		Params:      params,
+	})
+}
+
+func TestSearchDockerManifestsByAnnotation(t *testing.T) {
+	ctx := context.Background()
+	controller, mockAuthorizer := newAnnotationSearchController(ctx)
+	mockAuthorizer.On("CheckAll", ctx, mock.Anything, mock.Anything).Return(true, nil)
+
+	value := api.AnnotationValueParam("abc123")
+	response, err := searchByAnnotation(ctx, controller, api.SearchDockerManifestsByAnnotationParams{
+		Key:   " org.opencontainers.image.revision ",
+		Value: &value,
+	})
+	require.NoError(t, err)
+	require.IsType(t, api.SearchDockerManifestsByAnnotation200JSONResponse{}, response)
+	data := response.(api.SearchDockerManifestsByAnnotation200JSONResponse).Data
+	assert.EqualValues(t, 1, *data.ItemCount)
+	require.Len(t, data.Manifests, 1)
+	assert.Equal(t, digest.FromString("release").String(), data.Manifests[0].Digest)
+	assert.Equal(t, []string{"1.0.0", "latest"}, data.Manifests[0].Tags)
+	assert.Equal(t, api.Annotations{"org.opencontainers.image.revision": "abc123"}, data.Manifests[0].Annotations)
+	assert.Equal(t, "1700000000000", *data.Manifests[0].CreatedAt)
+
+	response, err = searchByAnnotation(ctx, controller, api.SearchDockerManifestsByAnnotationParams{
+		Key: "org.opencontainers.image.revision",
+	})
+	require.NoError(t, err)
+	data = response.(api.SearchDockerManifestsByAnnotation200JSONResponse).Data
+	assert.EqualValues(t, 2, *data.ItemCount)
+	require.Len(t, data.Manifests, 2)
+	assert.Equal(t, []string{}, data.Manifests[1].Tags)
+}
+
+func TestSearchDockerManifestsByAnnotation_Errors(t *testing.T) {
+	ctx := context.Background()
+	controller, mockAuthorizer := newAnnotationSearchController(ctx)
+
+	response, err := searchByAnnotation(ctx, controller, api.SearchDockerManifestsByAnnotationParams{Key: " "})
+	require.NoError(t, err)
+	assert.IsType(t, api.SearchDockerManifestsByAnnotation400JSONResponse{}, response)
+
+	mockAuthorizer.On("CheckAll", ctx, mock.Anything, mock.Anything).Return(false, nil)
+	response, err = searchByAnnotation(ctx, controller, api.SearchDockerManifestsByAnnotationParams{
+		Key: "org.opencontainers.image.revision",
+	})
+	require.NoError(t, err)
+	assert.IsType(t, api.SearchDockerManifestsByAnnotation403JSONResponse{}, response)
+}
@@ -0,0 +1,206 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/security"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetPackageImpact finds the artifacts of the registries of a space that include a vulnerable version
+// of a package.
+func (c *APIController) GetPackageImpact(
+	ctx context.Context,
+	r artifact.GetPackageImpactRequestObject,
+) (artifact.GetPackageImpactResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, string(r.SpaceRef), "")
+	if err != nil {
+		return throwGetPackageImpact400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwGetPackageImpact400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.GetPackageImpact403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	query, err := mapToImpactQuery(r.Params)
+	if err != nil {
+		return throwGetPackageImpact400Error(err), nil
+	}
+	impacts, err := c.SecurityService.Impact(ctx, space.ID, query)
+	if errors.Is(err, security.ErrInvalidRange) {
+		return throwGetPackageImpact400Error(err), nil
+	}
+	if err != nil {
+		return throwGetPackageImpact500Error(err), nil
+	}
+	names, err := c.impactRegistryNames(ctx, impacts)
+	if err != nil {
+		return throwGetPackageImpact500Error(err), nil
+	}
+
+	artifacts := make([]artifact.PackageImpact, 0, len(impacts))
+	for _, impact := range impacts {
+		artifacts = append(artifacts, mapToAPIPackageImpact(impact, names))
+	}
+	return artifact.GetPackageImpact200JSONResponse{
+		PackageImpactResponseJSONResponse: artifact.PackageImpactResponseJSONResponse{
+			Data: artifact.PackageImpactReport{
+				PackageName: query.PackageName,
+				Range:       query.Range,
+				Scheme:      artifact.VersionScheme(query.Scheme),
+				Artifacts:   artifacts,
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func mapToImpactQuery(params artifact.GetPackageImpactParams) (security.ImpactQuery, error) {
+	query := security.ImpactQuery{
+		PackageName: strings.TrimSpace(string(params.Package)),
+		Scheme:      versioning.SchemeSemver,
+	}
+	if query.PackageName == "" {
+		return query, fmt.Errorf("package is required")
+	}
+	if params.Range != nil {
+		query.Range = strings.TrimSpace(string(*params.Range))
+	}
+	if params.Scheme != nil {
+		scheme, err := versioning.ParseScheme(string(*params.Scheme))
+		if err != nil {
+			return query, err
+		}
+		query.Scheme = scheme
+	}
+	if params.Vulnerability != nil {
+		query.Vulnerability = strings.TrimSpace(string(*params.Vulnerability))
+	}
+	return query, nil
+}
+
+// impactRegistryNames resolves the names of the registries the impacted artifacts are held in.
+func (c *APIController) impactRegistryNames(
+	ctx context.Context,
+	impacts []*registrytypes.PackageImpact,
+) (map[int64]string, error) {
+	ids := make(map[int64]struct{})
+	for _, impact := range impacts {
+		ids[impact.RegistryID] = struct{}{}
+		for _, image := range impact.Images {
+			ids[image.RegistryID] = struct{}{}
+		}
+		for _, file := range impact.Files {
+			ids[file.RegistryID] = struct{}{}
+		}
+		for _, id := range impact.VirtualRegistryIDs {
+			ids[id] = struct{}{}
+		}
+	}
+	names := make(map[int64]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+	registryIDs := make([]int64, 0, len(ids))
+	for id := range ids {
+		registryIDs = append(registryIDs, id)
+	}
+	registries, err := c.RegistryRepository.GetByIDIn(ctx, registryIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, registry := range *registries {
+		names[registry.ID] = registry.Name
+	}
+	return names, nil
+}
+
+// mapToAPIPackageImpact groups the tags of the images holding the artifact per registry and image.
+func mapToAPIPackageImpact(impact *registrytypes.PackageImpact, names map[int64]string) artifact.PackageImpact {
+	result := artifact.PackageImpact{
+		RegistryIdentifier: names[impact.RegistryID],
+		Digest:             impact.Digest,
+		PackageName:        impact.PackageName,
+		PackageVersion:     impact.PackageVersion,
+		Images:             []artifact.ImpactedImage{},
+		Files:              make([]artifact.ImpactedFile, 0, len(impact.Files)),
+		VirtualRegistries:  make([]string, 0, len(impact.VirtualRegistryIDs)),
+	}
+	index := make(map[registrytypes.ManifestLocation]int)
+	for _, image := range impact.Images {
+		key := registrytypes.ManifestLocation{RegistryID: image.RegistryID, ImageName: image.ImageName}
+		i, ok := index[key]
+		if !ok {
+			i = len(result.Images)
+			index[key] = i
+			result.Images = append(result.Images, artifact.ImpactedImage{
+				RegistryIdentifier: names[image.RegistryID],
+				Image:              image.ImageName,
+				Tags:               []string{},
+			})
+		}
+		if image.Tag != "" {
+			result.Images[i].Tags = append(result.Images[i].Tags, image.Tag)
+		}
+	}
+	for _, file := range impact.Files {
+		result.Files = append(result.Files, artifact.ImpactedFile{
+			RegistryIdentifier: names[file.RegistryID],
+			Path:               file.Path,
+		})
+	}
+	for _, id := range impact.VirtualRegistryIDs {
+		result.VirtualRegistries = append(result.VirtualRegistries, names[id])
+	}
+	return result
+}
+
+func throwGetPackageImpact400Error(err error) artifact.GetPackageImpact400JSONResponse {
+	return artifact.GetPackageImpact400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwGetPackageImpact500Error(err error) artifact.GetPackageImpact500JSONResponse {
+	return artifact.GetPackageImpact500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
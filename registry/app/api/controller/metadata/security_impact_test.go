@@ -0,0 +1,85 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapToImpactQuery(t *testing.T) {
+	scheme := artifact.ImpactSchemeParam(artifact.VersionSchemeMAVEN)
+	constraint := artifact.ImpactRangeParam(" [2.0,2.15.0) ")
+	query, err := mapToImpactQuery(artifact.GetPackageImpactParams{
+		Package: "log4j-core",
+		Range:   &constraint,
+		Scheme:  &scheme,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, versioning.SchemeMaven, query.Scheme)
+	assert.Equal(t, "[2.0,2.15.0)", query.Range)
+
+	query, err = mapToImpactQuery(artifact.GetPackageImpactParams{Package: "lodash"})
+	require.NoError(t, err)
+	assert.Equal(t, versioning.SchemeSemver, query.Scheme)
+
+	_, err = mapToImpactQuery(artifact.GetPackageImpactParams{Package: " "})
+	assert.Error(t, err)
+}
+
+func TestMapToAPIPackageImpact(t *testing.T) {
+	impact := &registrytypes.PackageImpact{
+		RegistryID:     1,
+		Digest:         testScanDigest,
+		PackageName:    "log4j-core",
+		PackageVersion: "2.14.1",
+		Images: []*registrytypes.ManifestLocation{
+			{RegistryID: 1, ImageName: "app", Tag: "1.0"},
+			{RegistryID: 1, ImageName: "app", Tag: "latest"},
+			{RegistryID: 2, ImageName: "app", Tag: "1.0"},
+			{RegistryID: 2, ImageName: "legacy"},
+		},
+		VirtualRegistryIDs: []int64{3},
+	}
+	names := map[int64]string{1: "dev", 2: "prod", 3: "all"}
+
+	result := mapToAPIPackageImpact(impact, names)
+	assert.Equal(t, "dev", result.RegistryIdentifier)
+	assert.Equal(t, []artifact.ImpactedImage{
+		{RegistryIdentifier: "dev", Image: "app", Tags: []string{"1.0", "latest"}},
+		{RegistryIdentifier: "prod", Image: "app", Tags: []string{"1.0"}},
+		{RegistryIdentifier: "prod", Image: "legacy", Tags: []string{}},
+	}, result.Images)
+	assert.Empty(t, result.Files)
+	assert.Equal(t, []string{"all"}, result.VirtualRegistries)
+}
+
+func TestMapToScanComponents(t *testing.T) {
+	version := "2.14.1"
+	components, err := mapToScanComponents(&[]artifact.ScanComponent{{Name: " log4j-core ", Version: &version}})
+	require.NoError(t, err)
+	require.Len(t, components, 1)
+	assert.Equal(t, "log4j-core", components[0].Name)
+	assert.Equal(t, "2.14.1", components[0].Version)
+
+	_, err = mapToScanComponents(&[]artifact.ScanComponent{{Name: ""}})
+	assert.Error(t, err)
+}
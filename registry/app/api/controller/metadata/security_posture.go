@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetRegistrySecurityPosture returns the aggregated security state of the versions of a registry.
+func (c *APIController) GetRegistrySecurityPosture(
+	ctx context.Context,
+	r artifact.GetRegistrySecurityPostureRequestObject,
+) (artifact.GetRegistrySecurityPostureResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwGetRegistrySecurityPosture400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwGetRegistrySecurityPosture400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.GetRegistrySecurityPosture403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	posture, err := c.SecurityService.Posture(ctx, regInfo.RegistryID)
+	if err != nil {
+		return artifact.GetRegistrySecurityPosture500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	data := artifact.RegistrySecurityPosture{
+		Versions:                    posture.Versions,
+		UnsignedVersions:            posture.UnsignedVersions,
+		UnscannedVersions:           posture.UnscannedVersions,
+		CriticalVersions:            posture.CriticalVersions,
+		CriticalCves:                posture.CriticalCVEs,
+		SuppressedCriticalFindings:  posture.SuppressedCriticalFindings,
+		AllowlistedCriticalFindings: posture.AllowlistedCriticalFindings,
+	}
+	if posture.LastScannedAt > 0 {
+		data.LastScannedAt = &posture.LastScannedAt
+	}
+	return artifact.GetRegistrySecurityPosture200JSONResponse{
+		RegistrySecurityPostureResponseJSONResponse: artifact.RegistrySecurityPostureResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func throwGetRegistrySecurityPosture400Error(err error) artifact.GetRegistrySecurityPosture400JSONResponse {
+	return artifact.GetRegistrySecurityPosture400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store"
+)
+
+// validateStoragePrefix makes sure the storage prefix doesn't point into the storage of another root space,
+// either its default storage root or a prefix used by one of its registries.
+func (c *APIController) validateStoragePrefix(ctx context.Context, rootParentID int64, prefix string) error {
+	if prefix == "" {
+		return nil
+	}
+	if err := ValidateStoragePrefix(prefix); err != nil {
+		return err
+	}
+
+	firstSegment, _, _ := strings.Cut(prefix, "/")
+	space, err := c.SpaceFinder.FindByRef(ctx, firstSegment)
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return fmt.Errorf("failed to check storage prefix: %w", err)
+	}
+	if err == nil && space.ParentID == 0 && space.ID != rootParentID &&
+		strings.EqualFold(space.Identifier, firstSegment) {
+		return fmt.Errorf("storage prefix %q is reserved for another root space", prefix)
+	}
+
+	rootParentIDs, err := c.RegistryRepository.FindRootParentIDsByStoragePrefix(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to check storage prefix: %w", err)
+	}
+	for _, id := range rootParentIDs {
+		if id != rootParentID {
+			return fmt.Errorf("storage prefix %q is used by another root space", prefix)
+		}
+	}
+	return nil
+}
+
+// storagePrefixChange returns the requested storage prefix and whether it differs from the current one.
+func (c *APIController) storagePrefixChange(
+	ctx context.Context, registry *registrytypes.Registry, prefix *string,
+) (string, bool, error) {
+	if prefix == nil || *prefix == registry.StoragePrefix {
+		return registry.StoragePrefix, false, nil
+	}
+	if err := c.validateStoragePrefix(ctx, registry.RootParentID, *prefix); err != nil {
+		return "", false, err
+	}
+	return *prefix, true, nil
+}
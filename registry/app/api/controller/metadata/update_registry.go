@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/request"
@@ -73,8 +74,19 @@ func (c *APIController) ModifyRegistry(
 		return throwModifyRegistry500Error(err), err
 	}
 
+	storagePrefix, storagePrefixChanged, err := c.storagePrefixChange(ctx, repoEntity, r.Body.StoragePrefix)
+	if err != nil {
+		return throwModifyRegistry400Error(err), nil
+	}
+
 	if string(repoEntity.Type) == string(artifact.RegistryTypeVIRTUAL) {
-		return c.updateVirtualRegistry(ctx, r, repoEntity, err, regInfo, session)
+		resp, err := c.updateVirtualRegistry(ctx, r, repoEntity, err, regInfo, session)
+		if _, ok := resp.(artifact.ModifyRegistry200JSONResponse); ok && storagePrefixChanged {
+			if err = c.StorageMigrator.Migrate(ctx, repoEntity, storagePrefix); err != nil {
+				return throwModifyRegistry400Error(err), nil
+			}
+		}
+		return resp, err
 	}
 	upstreamproxyEntity, err := c.UpstreamProxyStore.GetByRegistryIdentifier(
 		ctx, regInfo.parentID,
@@ -93,14 +105,14 @@ func (c *APIController) ModifyRegistry(
 	registry, upstreamproxy, err := c.UpdateUpstreamProxyEntity(
 		ctx,
 		artifact.RegistryRequest(*r.Body),
-		regInfo.parentID, regInfo.rootIdentifierID, upstreamproxyEntity,
+		regInfo.parentID, regInfo.rootIdentifierID, upstreamproxyEntity, repoEntity,
 	)
-	registry.ID = repoEntity.ID
-	upstreamproxy.ID = upstreamproxyEntity.ID
-	upstreamproxy.RegistryID = repoEntity.ID
 	if err != nil {
 		return throwModifyRegistry500Error(err), err
 	}
+	registry.ID = repoEntity.ID
+	upstreamproxy.ID = upstreamproxyEntity.ID
+	upstreamproxy.RegistryID = repoEntity.ID
 	err = c.tx.WithTx(
 		ctx, func(ctx context.Context) error {
 			err = c.updateRegistryWithAudit(ctx, repoEntity, registry, session.Principal, regInfo.ParentRef)
@@ -122,6 +134,13 @@ func (c *APIController) ModifyRegistry(
 	if err != nil {
 		return throwModifyRegistry500Error(err), err
 	}
+	if storagePrefixChanged {
+		if err = c.StorageMigrator.Migrate(ctx, repoEntity, storagePrefix); err != nil {
+			return throwModifyRegistry400Error(err), nil
+		}
+	}
+	c.recordRegistryConfigRevision(ctx, repoEntity.ID)
+
 	modifiedRepoEntity, err := c.UpstreamProxyStore.Get(ctx, upstreamproxyEntity.RegistryID)
 	if err != nil {
 		return throwModifyRegistry500Error(err), err
@@ -171,6 +190,7 @@ func (c *APIController) updateVirtualRegistry(
 	if err != nil {
 		return throwModifyRegistry500Error(err), nil
 	}
+	c.recordRegistryConfigRevision(ctx, registry.ID)
 	modifiedRepoEntity, err := c.RegistryRepository.Get(ctx, registry.ID)
 	if err != nil {
 		return throwModifyRegistry500Error(err), nil
@@ -200,6 +220,10 @@ func (c *APIController) updateUpstreamProxyWithAudit(
 		)
 	}
 
+	upstreamProxy.CredentialsUpdatedAt = time.Now()
+	if existingUpstreamProxy != nil && !credentialsChanged(existingUpstreamProxy, upstreamProxy) {
+		upstreamProxy.CredentialsUpdatedAt = existingUpstreamProxy.CredentialsUpdatedAt
+	}
 	err = c.UpstreamProxyStore.Update(ctx, upstreamProxy)
 	if err != nil {
 		return err
@@ -252,6 +276,8 @@ func (c *APIController) updateRegistryWithAudit(
 	ctx context.Context, oldRegistry *types.Registry,
 	newRegistry *types.Registry, principal types2.Principal, parentRef string,
 ) error {
+	// the storage prefix is only switched by the storage migration, once the blobs have been copied
+	newRegistry.StoragePrefix = oldRegistry.StoragePrefix
 	err := c.RegistryRepository.Update(ctx, newRegistry)
 	if err != nil {
 		return err
@@ -272,6 +298,14 @@ func (c *APIController) updateRegistryWithAudit(
 	return err
 }
 
+func throwModifyRegistry400Error(err error) artifact.ModifyRegistry400JSONResponse {
+	return artifact.ModifyRegistry400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
 func throwModifyRegistry500Error(err error) artifact.ModifyRegistry500JSONResponse {
 	return artifact.ModifyRegistry500JSONResponse{
 		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
@@ -313,18 +347,44 @@ func UpdateRepoEntity(
 	if e != nil {
 		return nil, e
 	}
+	e = ValidateCleanupPolicies(dto.CleanupPolicy)
+	if e != nil {
+		return nil, e
+	}
+	latestVersionStrategy, latestVersionPattern, e := getLatestVersionStrategyFields(dto, existingRepo)
+	if e != nil {
+		return nil, e
+	}
+	mtlsMode, mtlsCACertificates, e := getMTLSFields(dto, existingRepo)
+	if e != nil {
+		return nil, e
+	}
+	mavenStrictMetadata, e := getMavenStrictMetadataField(dto, existingRepo.PackageType, existingRepo)
+	if e != nil {
+		return nil, e
+	}
+	npmLocalScopes, e := getNpmLocalScopesField(dto, existingRepo.PackageType, existingRepo)
+	if e != nil {
+		return nil, e
+	}
 	entity := &types.Registry{
-		Name:           dto.Identifier,
-		ID:             existingRepo.ID,
-		ParentID:       parentID,
-		RootParentID:   rootParentID,
-		Description:    description,
-		AllowedPattern: allowedPattern,
-		BlockedPattern: blockedPattern,
-		PackageType:    existingRepo.PackageType,
-		Type:           existingRepo.Type,
-		Labels:         labels,
-		CreatedAt:      existingRepo.CreatedAt,
+		Name:                  dto.Identifier,
+		ID:                    existingRepo.ID,
+		ParentID:              parentID,
+		RootParentID:          rootParentID,
+		Description:           description,
+		AllowedPattern:        allowedPattern,
+		BlockedPattern:        blockedPattern,
+		PackageType:           existingRepo.PackageType,
+		Type:                  existingRepo.Type,
+		Labels:                labels,
+		CreatedAt:             existingRepo.CreatedAt,
+		LatestVersionStrategy: latestVersionStrategy,
+		LatestVersionPattern:  latestVersionPattern,
+		MTLSMode:              mtlsMode,
+		MTLSCACertificates:    mtlsCACertificates,
+		MavenStrictMetadata:   mavenStrictMetadata,
+		NpmLocalScopes:        npmLocalScopes,
 	}
 	return entity, nil
 }
@@ -332,6 +392,7 @@ func UpdateRepoEntity(
 //nolint:gocognit,cyclop
 func (c *APIController) UpdateUpstreamProxyEntity(
 	ctx context.Context, dto artifact.RegistryRequest, parentID int64, rootParentID int64, u *types.UpstreamProxy,
+	existingRepo *types.Registry,
 ) (*types.Registry, *types.UpstreamProxyConfig, error) {
 	allowedPattern := []string{}
 	if dto.AllowedPattern != nil {
@@ -349,25 +410,45 @@ func (c *APIController) UpdateUpstreamProxyEntity(
 	if e != nil {
 		return nil, nil, e
 	}
+	latestVersionStrategy, latestVersionPattern, e := getLatestVersionStrategyFields(dto, existingRepo)
+	if e != nil {
+		return nil, nil, e
+	}
+	mtlsMode, mtlsCACertificates, e := getMTLSFields(dto, existingRepo)
+	if e != nil {
+		return nil, nil, e
+	}
 	repoEntity := &types.Registry{
-		ID:             u.RegistryID,
-		Name:           dto.Identifier,
-		ParentID:       parentID,
-		RootParentID:   rootParentID,
-		AllowedPattern: allowedPattern,
-		BlockedPattern: blockedPattern,
-		PackageType:    dto.PackageType,
-		Type:           artifact.RegistryTypeUPSTREAM,
-		CreatedAt:      u.CreatedAt,
+		ID:                    u.RegistryID,
+		Name:                  dto.Identifier,
+		ParentID:              parentID,
+		RootParentID:          rootParentID,
+		AllowedPattern:        allowedPattern,
+		BlockedPattern:        blockedPattern,
+		PackageType:           dto.PackageType,
+		Type:                  artifact.RegistryTypeUPSTREAM,
+		CreatedAt:             u.CreatedAt,
+		LatestVersionStrategy: latestVersionStrategy,
+		LatestVersionPattern:  latestVersionPattern,
+		MTLSMode:              mtlsMode,
+		MTLSCACertificates:    mtlsCACertificates,
 	}
 	config, _ := dto.Config.AsUpstreamConfig()
+	if e = ValidateUpstreamCacheSettings(config); e != nil {
+		return nil, nil, e
+	}
 	CleanURLPath(config.Url)
 	upstreamProxyConfigEntity := &types.UpstreamProxyConfig{
-		URL:        *config.Url,
-		AuthType:   string(config.AuthType),
-		RegistryID: u.RegistryID,
-		CreatedAt:  u.CreatedAt,
-	}
+		URL:         *config.Url,
+		AuthType:    string(config.AuthType),
+		RegistryID:  u.RegistryID,
+		MetadataTTL: u.MetadataTTL,
+		ArtifactTTL: u.ArtifactTTL,
+		Offline:     u.Offline,
+		CreatedAt:   u.CreatedAt,
+	}
+	// Settings missing from the request keep their values.
+	setUpstreamCacheSettings(upstreamProxyConfigEntity, config)
 	if config.Source != nil && len(string(*config.Source)) > 0 {
 		err := ValidateUpstreamSource(string(*config.Source))
 		if err != nil {
@@ -381,63 +462,20 @@ func (c *APIController) UpdateUpstreamProxyEntity(
 	if u.ID != -1 {
 		upstreamProxyConfigEntity.ID = u.ID
 	}
-	switch {
-	case config.AuthType == artifact.AuthTypeUserPassword:
-		res, err := config.Auth.AsUserPassword()
-		if err != nil {
-			return nil, nil, err
-		}
-		upstreamProxyConfigEntity.UserName = res.UserName
-		if res.SecretIdentifier == nil {
-			return nil, nil, fmt.Errorf("failed to create upstream proxy: secret_identifier missing")
-		}
-
-		if res.SecretSpacePath != nil && len(*res.SecretSpacePath) > 0 {
-			upstreamProxyConfigEntity.SecretSpaceID, err = c.RegistryMetadataHelper.getSecretSpaceID(ctx, res.SecretSpacePath)
-			if err != nil {
-				return nil, nil, err
-			}
-		} else if res.SecretSpaceId != nil {
-			upstreamProxyConfigEntity.SecretSpaceID = *res.SecretSpaceId
-		}
-		upstreamProxyConfigEntity.SecretIdentifier = *res.SecretIdentifier
-	case config.AuthType == artifact.AuthTypeAccessKeySecretKey:
-		res, err := config.Auth.AsAccessKeySecretKey()
-		if err != nil {
-			return nil, nil, err
-		}
-		switch {
-		case res.AccessKey != nil && len(*res.AccessKey) > 0:
-			upstreamProxyConfigEntity.UserName = *res.AccessKey
-		case res.AccessKeySecretIdentifier == nil:
-			return nil, nil, fmt.Errorf("failed to create upstream proxy: access_key_secret_identifier missing")
-		default:
-			if res.AccessKeySecretSpacePath != nil && len(*res.AccessKeySecretSpacePath) > 0 {
-				upstreamProxyConfigEntity.UserNameSecretSpaceID, err =
-					c.RegistryMetadataHelper.getSecretSpaceID(ctx, res.AccessKeySecretSpacePath)
-				if err != nil {
-					return nil, nil, err
-				}
-			} else if res.AccessKeySecretSpaceId != nil {
-				upstreamProxyConfigEntity.UserNameSecretSpaceID = *res.AccessKeySecretSpaceId
-			}
-			upstreamProxyConfigEntity.UserNameSecretIdentifier = *res.AccessKeySecretIdentifier
-		}
-
-		if res.SecretKeySpacePath != nil && len(*res.SecretKeySpacePath) > 0 {
-			upstreamProxyConfigEntity.SecretSpaceID, err =
-				c.RegistryMetadataHelper.getSecretSpaceID(ctx, res.SecretKeySpacePath)
-			if err != nil {
-				return nil, nil, err
-			}
-		} else if res.SecretKeySpaceId != nil {
-			upstreamProxyConfigEntity.SecretSpaceID = *res.SecretKeySpaceId
-		}
-		upstreamProxyConfigEntity.SecretIdentifier = res.SecretKeyIdentifier
-	default:
-		upstreamProxyConfigEntity.UserName = ""
-		upstreamProxyConfigEntity.SecretIdentifier = ""
-		upstreamProxyConfigEntity.SecretSpaceID = 0
+	if e = c.setUpstreamAuth(ctx, upstreamProxyConfigEntity, config.AuthType, config.Auth); e != nil {
+		return nil, nil, e
 	}
 	return repoEntity, upstreamProxyConfigEntity, nil
 }
+
+// credentialsChanged returns whether the update changes the auth type of the upstream proxy or the
+// secrets its credentials are read from.
+func credentialsChanged(existing *types.UpstreamProxy, updated *types.UpstreamProxyConfig) bool {
+	return existing.RepoAuthType != updated.AuthType ||
+		existing.UserName != updated.UserName ||
+		existing.UserNameSecretIdentifier != updated.UserNameSecretIdentifier ||
+		existing.UserNameSecretSpaceID != int64(updated.UserNameSecretSpaceID) ||
+		existing.SecretIdentifier != updated.SecretIdentifier ||
+		existing.SecretSpaceID != int64(updated.SecretSpaceID) ||
+		existing.AwsRoleArn != updated.AwsRoleArn
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCACertificate(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "registry-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testUpstreamRegistryRequest(t *testing.T) artifact.RegistryRequest {
+	t.Helper()
+	config := artifact.RegistryConfig{}
+	source := artifact.UpstreamConfigSourceCustom
+	url := "https://registry.npmjs.org"
+	require.NoError(t, config.FromUpstreamConfig(artifact.UpstreamConfig{
+		AuthType: artifact.AuthTypeAnonymous, Source: &source, Url: &url,
+	}))
+	return artifact.RegistryRequest{Identifier: "npm-proxy", PackageType: artifact.PackageTypeNPM, Config: &config}
+}
+
+func TestUpstreamProxyEntityMTLS(t *testing.T) {
+	ctx := context.Background()
+	c := &APIController{}
+	caCertificates := testCACertificate(t)
+	mode := artifact.MTLSModePUSH
+
+	dto := testUpstreamRegistryRequest(t)
+	dto.MtlsMode = &mode
+	dto.MtlsCaCertificates = &caCertificates
+	registry, _, err := c.CreateUpstreamProxyEntity(ctx, dto, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, artifact.MTLSModePUSH, registry.MTLSMode)
+	assert.Equal(t, caCertificates, registry.MTLSCACertificates)
+
+	// omitted settings keep the current ones on update
+	upstream := &types.UpstreamProxy{RepoKey: "npm-proxy", PackageType: artifact.PackageTypeNPM}
+	registry, _, err = c.UpdateUpstreamProxyEntity(ctx, testUpstreamRegistryRequest(t), 1, 1, upstream, registry)
+	require.NoError(t, err)
+	assert.Equal(t, artifact.MTLSModePUSH, registry.MTLSMode)
+	assert.Equal(t, caCertificates, registry.MTLSCACertificates)
+
+	dto = testUpstreamRegistryRequest(t)
+	empty := ""
+	dto.MtlsCaCertificates = &empty
+	_, _, err = c.UpdateUpstreamProxyEntity(ctx, dto, 1, 1, upstream, registry)
+	assert.Error(t, err, "the PUSH mode needs certificate authorities")
+}
+
+func TestCreateUpstreamProxyResponseMTLS(t *testing.T) {
+	response := CreateUpstreamProxyResponseJSONResponse(&types.UpstreamProxy{
+		RepoKey: "npm-proxy", RepoAuthType: string(artifact.AuthTypeAnonymous),
+	})
+	require.NotNil(t, response.Data.MtlsMode)
+	assert.Equal(t, artifact.MTLSModeDISABLED, *response.Data.MtlsMode)
+	assert.Nil(t, response.Data.MtlsCaCertificates)
+
+	response = CreateUpstreamProxyResponseJSONResponse(&types.UpstreamProxy{
+		RepoKey: "npm-proxy", RepoAuthType: string(artifact.AuthTypeAnonymous),
+		MTLSMode: artifact.MTLSModeALL, MTLSCACertificates: "certificates",
+	})
+	assert.Equal(t, artifact.MTLSModeALL, *response.Data.MtlsMode)
+	assert.Equal(t, "certificates", *response.Data.MtlsCaCertificates)
+}
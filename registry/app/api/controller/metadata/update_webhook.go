@@ -82,6 +82,7 @@ func (c *APIController) UpdateWebhook(
 			webhookRequest.Identifier, regInfo.RegistryRef, err)
 		return updateWebhookBadRequestErrorResponse(fmt.Errorf("failed to update webhook"))
 	}
+	c.recordRegistryConfigRevision(ctx, regInfo.RegistryID)
 
 	updatedWebhook, err := c.WebhooksRepository.GetByRegistryAndIdentifier(
 		ctx, regInfo.RegistryID, webhookRequest.Identifier,
@@ -0,0 +1,204 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// GetUpstreamStatus returns the URLs of the upstream proxy with their health, and the URL it currently
+// fetches from.
+func (c *APIController) GetUpstreamStatus(
+	ctx context.Context,
+	r artifact.GetUpstreamStatusRequestObject,
+) (artifact.GetUpstreamStatusResponseObject, error) {
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView,
+		"failover urls")
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.GetUpstreamStatus400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.GetUpstreamStatus403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwGetUpstreamStatus500Error(err), nil
+	}
+
+	upstreamProxy, err := c.UpstreamProxyStore.GetByRegistryIdentifier(ctx, registry.ParentID, registry.Name)
+	if err != nil {
+		return throwGetUpstreamStatus500Error(err), nil
+	}
+	upstreamStatus, err := c.getUpstreamStatus(ctx, upstreamProxy)
+	if err != nil {
+		return throwGetUpstreamStatus500Error(err), nil
+	}
+	return artifact.GetUpstreamStatus200JSONResponse{
+		UpstreamStatusResponseJSONResponse: artifact.UpstreamStatusResponseJSONResponse{
+			Data:   *upstreamStatus,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// SetUpstreamURLs replaces the URLs the upstream proxy fails over to, in the order they're tried after
+// its primary URL.
+func (c *APIController) SetUpstreamURLs(
+	ctx context.Context,
+	r artifact.SetUpstreamURLsRequestObject,
+) (artifact.SetUpstreamURLsResponseObject, error) {
+	if r.Body == nil {
+		return throwSetUpstreamURLs400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getUpstreamRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit,
+		"failover urls")
+	switch status {
+	case http.StatusBadRequest:
+		return throwSetUpstreamURLs400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.SetUpstreamURLs403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwSetUpstreamURLs500Error(err), nil
+	}
+
+	upstreamProxy, err := c.UpstreamProxyStore.GetByRegistryIdentifier(ctx, registry.ParentID, registry.Name)
+	if err != nil {
+		return throwSetUpstreamURLs500Error(err), nil
+	}
+	if err = proxy.ValidateFailoverURLs(proxy.PrimaryUpstreamURL(*upstreamProxy), r.Body.Urls); err != nil {
+		return throwSetUpstreamURLs400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		return c.UpstreamURLStore.ReplaceURLs(ctx, registry.ID, r.Body.Urls, session.Principal.ID)
+	})
+	if err != nil {
+		return throwSetUpstreamURLs500Error(err), nil
+	}
+
+	upstreamStatus, err := c.getUpstreamStatus(ctx, upstreamProxy)
+	if err != nil {
+		return throwSetUpstreamURLs500Error(err), nil
+	}
+	return artifact.SetUpstreamURLs200JSONResponse{
+		UpstreamStatusResponseJSONResponse: artifact.UpstreamStatusResponseJSONResponse{
+			Data:   *upstreamStatus,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+func (c *APIController) getUpstreamStatus(
+	ctx context.Context,
+	upstreamProxy *registrytypes.UpstreamProxy,
+) (*artifact.UpstreamStatus, error) {
+	urls, err := proxy.UpstreamURLs(ctx, c.UpstreamURLStore, *upstreamProxy)
+	if err != nil {
+		return nil, err
+	}
+	health, err := c.UpstreamURLStore.ListHealth(ctx, upstreamProxy.RegistryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upstream health: %w", err)
+	}
+	healthByURL := make(map[string]*registrytypes.UpstreamHealth, len(health))
+	for _, h := range health {
+		healthByURL[h.URL] = h
+	}
+
+	active := proxy.ActiveUpstreamURL(urls, health)
+	statuses := make([]artifact.UpstreamURLStatus, 0, len(urls))
+	for i, u := range urls {
+		statuses = append(statuses, mapToAPIUpstreamURLStatus(u, healthByURL[u], i == 0, u == active))
+	}
+	return &artifact.UpstreamStatus{
+		ActiveUrl: active,
+		Urls:      statuses,
+	}, nil
+}
+
+func mapToAPIUpstreamURLStatus(
+	upstreamURL string,
+	health *registrytypes.UpstreamHealth,
+	primary bool,
+	active bool,
+) artifact.UpstreamURLStatus {
+	status := artifact.UpstreamURLStatus{
+		Url:     upstreamURL,
+		Primary: primary,
+		Active:  active,
+		Health:  artifact.UpstreamHealthStatusUNKNOWN,
+	}
+	if health == nil {
+		return status
+	}
+	status.Health = artifact.UpstreamHealthStatusHEALTHY
+	if !health.Healthy {
+		status.Health = artifact.UpstreamHealthStatusUNHEALTHY
+	}
+	failures := health.ConsecutiveFailures
+	status.ConsecutiveFailures = &failures
+	checkedAt := health.CheckedAt.UnixMilli()
+	status.LastCheckedAt = &checkedAt
+	latency := health.Latency.Milliseconds()
+	status.LatencyMs = &latency
+	if health.LastError != "" {
+		lastError := health.LastError
+		status.LastError = &lastError
+	}
+	return status
+}
+
+func throwGetUpstreamStatus500Error(err error) artifact.GetUpstreamStatus500JSONResponse {
+	return artifact.GetUpstreamStatus500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwSetUpstreamURLs400Error(err error) artifact.SetUpstreamURLs400JSONResponse {
+	return artifact.SetUpstreamURLs400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwSetUpstreamURLs500Error(err error) artifact.SetUpstreamURLs500JSONResponse {
+	return artifact.SetUpstreamURLs500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -19,13 +19,25 @@ import (
 	"fmt"
 	"math"
 	"net/url"
+	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	a "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/metadata/cocoapods"
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	"github.com/harness/gitness/registry/app/metadata/gomodule"
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/app/metadata/terraform"
+	"github.com/harness/gitness/registry/app/metadata/vagrant"
+	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
+	conanpkg "github.com/harness/gitness/registry/app/pkg/conan"
+	"github.com/harness/gitness/registry/utils/versioning"
 
 	"github.com/inhies/go-bytesize"
 	"github.com/rs/zerolog/log"
@@ -51,6 +63,16 @@ const (
 	internalWebhookIdentifier = "harnesstriggerwebhok"
 )
 
+const (
+	StoragePrefixErrorMsg = "storage prefix should be at most 255 characters long and consist of / separated " +
+		"segments of lower case characters, numbers and ._-, each starting and ending with numbers or characters"
+	RegexStoragePrefixPattern = "^[a-z0-9]+(?:[._-][a-z0-9]+)*(?:/[a-z0-9]+(?:[._-][a-z0-9]+)*)*$"
+	maxStoragePrefixLength    = 255
+)
+
+// reservedStoragePrefixSegments are the directories used inside a storage root.
+var reservedStoragePrefixSegments = []string{"docker", "files", "tmp"}
+
 var RegistrySortMap = map[string]string{
 	"identifier":     "name",
 	"lastModified":   "updated_at",
@@ -116,6 +138,28 @@ var validPackageTypes = []string{
 	string(a.PackageTypeGENERIC),
 	string(a.PackageTypeMAVEN),
 	string(a.PackageTypePYTHON),
+	string(a.PackageTypeNPM),
+	string(a.PackageTypeNUGET),
+	string(a.PackageTypeGEMS),
+	string(a.PackageTypeCRATE),
+	string(a.PackageTypeGO),
+	string(a.PackageTypeDEB),
+	string(a.PackageTypeRPM),
+	string(a.PackageTypeALPINE),
+	string(a.PackageTypeCONAN),
+	string(a.PackageTypeCOMPOSER),
+	string(a.PackageTypeCONDA),
+	string(a.PackageTypeTERRAFORM),
+	string(a.PackageTypeSWIFT),
+	string(a.PackageTypeCOCOAPODS),
+	string(a.PackageTypeHEX),
+	string(a.PackageTypePUB),
+	string(a.PackageTypeCRAN),
+	string(a.PackageTypeGALAXY),
+	string(a.PackageTypePUPPET),
+	string(a.PackageTypeVAGRANT),
+	string(a.PackageTypeMODEL),
+	string(a.PackageTypeHOMEBREW),
 }
 
 var validUpstreamSources = []string{
@@ -123,6 +167,8 @@ var validUpstreamSources = []string{
 	string(a.UpstreamConfigSourceDockerhub),
 	string(a.UpstreamConfigSourceAwsEcr),
 	string(a.UpstreamConfigSourceMavenCentral),
+	string(a.UpstreamConfigSourceGoogleMaven),
+	string(a.UpstreamConfigSourceNpmjs),
 	string(a.UpstreamConfigSourcePyPi),
 }
 
@@ -173,6 +219,22 @@ func ValidateIdentifier(identifier string) error {
 	return nil
 }
 
+func ValidateStoragePrefix(prefix string) error {
+	if len(prefix) > maxStoragePrefixLength {
+		return errors.New(StoragePrefixErrorMsg)
+	}
+	matched, err := regexp.MatchString(RegexStoragePrefixPattern, prefix)
+	if err != nil || !matched {
+		return errors.New(StoragePrefixErrorMsg)
+	}
+	for _, segment := range strings.Split(prefix, "/") {
+		if slices.Contains(reservedStoragePrefixSegments, segment) {
+			return fmt.Errorf("storage prefix can't contain the reserved segment %q", segment)
+		}
+	}
+	return nil
+}
+
 func ValidateUpstream(config *a.RegistryConfig) error {
 	upstreamConfig, err := config.AsUpstreamConfig()
 	if err != nil {
@@ -180,11 +242,104 @@ func ValidateUpstream(config *a.RegistryConfig) error {
 	}
 	if !commons.IsEmpty(config.Type) && config.Type == a.RegistryTypeUPSTREAM &&
 		*upstreamConfig.Source != a.UpstreamConfigSourceDockerhub &&
-		*upstreamConfig.Source != a.UpstreamConfigSourceMavenCentral {
+		*upstreamConfig.Source != a.UpstreamConfigSourceMavenCentral &&
+		*upstreamConfig.Source != a.UpstreamConfigSourceGoogleMaven &&
+		*upstreamConfig.Source != a.UpstreamConfigSourceNpmjs &&
+		*upstreamConfig.Source != a.UpstreamConfigSourcePyPi {
 		if commons.IsEmpty(upstreamConfig.Url) {
 			return errors.New("URL is required for upstream repository")
 		}
 	}
+	return ValidateUpstreamCacheSettings(upstreamConfig)
+}
+
+func ValidateUpstreamCacheSettings(config a.UpstreamConfig) error {
+	if config.MetadataTtl != nil && *config.MetadataTtl < 0 || config.ArtifactTtl != nil && *config.ArtifactTtl < 0 {
+		return errors.New("cache TTLs of upstream repository can't be negative")
+	}
+	return nil
+}
+
+func ValidateLatestVersionStrategy(strategy *a.LatestVersionStrategy, pattern *string) error {
+	if strategy == nil {
+		return nil
+	}
+	switch *strategy {
+	case a.LatestVersionStrategyLASTPUSHED, a.LatestVersionStrategySEMVER:
+		return nil
+	case a.LatestVersionStrategyREGEX:
+		p := ""
+		if pattern != nil {
+			p = *pattern
+		}
+		_, err := versioning.CompileLatestVersionPattern(p)
+		return err
+	default:
+		return errors.New("invalid latest version strategy")
+	}
+}
+
+func ValidateMTLSConfig(mode a.MTLSMode, caCertificates string) error {
+	switch mode {
+	case a.MTLSModeDISABLED:
+		return nil
+	case a.MTLSModePUSH, a.MTLSModeALL:
+		if _, err := pkg.ParseCACertificates(caCertificates); err != nil {
+			return fmt.Errorf("invalid mTLS certificate authorities: %w", err)
+		}
+		return nil
+	default:
+		return errors.New("invalid mTLS mode")
+	}
+}
+
+func ValidateMavenStrictMetadata(packageType a.PackageType, strict bool) error {
+	if strict && packageType != a.PackageTypeMAVEN {
+		return errors.New("strict metadata is only supported by maven registries")
+	}
+	return nil
+}
+
+func ValidateNpmLocalScopes(packageType a.PackageType, scopes []string) error {
+	if len(scopes) == 0 {
+		return nil
+	}
+	if packageType != a.PackageTypeNPM {
+		return errors.New("local scopes are only supported by npm registries")
+	}
+	for _, scope := range scopes {
+		if err := npm.ValidateScope(scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ValidateCleanupPolicies(policies *[]a.CleanupPolicy) error {
+	if policies == nil {
+		return nil
+	}
+	for _, policy := range *policies {
+		if policy.Type != nil {
+			switch *policy.Type {
+			case a.CleanupPolicyTypeAGE:
+			case a.CleanupPolicyTypeNOTPULLED:
+				if policy.ExpireDays == nil || *policy.ExpireDays <= 0 {
+					return errors.New("expireDays must be positive for a NOT_PULLED cleanup policy")
+				}
+			default:
+				return errors.New("invalid cleanup policy type")
+			}
+		}
+		if policy.ExcludePattern == nil {
+			continue
+		}
+		for _, pattern := range *policy.ExcludePattern {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("invalid cleanup policy exclude pattern %q: %w", pattern, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -326,8 +481,14 @@ func GetPageCount(count int64, pageSize int) int64 {
 }
 
 func GetImageSize(size string) string {
+	return GetSize(GetImageSizeBytes(size))
+}
+
+// GetImageSizeBytes parses the size of a tag, which the store sums up as a string; sizes that
+// don't parse count as 0 bytes, as they do for GetImageSize.
+func GetImageSizeBytes(size string) int64 {
 	sizeVal, _ := strconv.ParseInt(size, 10, 64)
-	return GetSize(sizeVal)
+	return sizeVal
 }
 
 func GetSize(sizeVal int64) string {
@@ -368,6 +529,52 @@ func GetPullCommand(
 		return GetHelmPullCommand(image, tag, registryURL)
 	case string(a.PackageTypeGENERIC):
 		return GetGenericArtifactFileDownloadCommand(registryURL, image, tag, "<FILENAME>")
+	case string(a.PackageTypeNPM):
+		return GetNpmInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypePYTHON):
+		return GetPythonInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeNUGET):
+		return GetNugetInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeGEMS):
+		return GetGemsInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeCRATE):
+		return GetCrateInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeGO):
+		return GetGoModuleInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeDEB):
+		return GetDebInstallCommand(image, tag, registryURL, "<DISTRIBUTION>", "<COMPONENT>")
+	case string(a.PackageTypeRPM):
+		return GetRpmInstallCommand(image, tag)
+	case string(a.PackageTypeALPINE):
+		return GetAlpineInstallCommand(image, tag)
+	case string(a.PackageTypeCONAN):
+		return GetConanInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeCOMPOSER):
+		return GetComposerInstallCommand(image, tag)
+	case string(a.PackageTypeCONDA):
+		return GetCondaInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeTERRAFORM):
+		return GetTerraformPullCommand(image, tag, registryURL)
+	case string(a.PackageTypeSWIFT):
+		return GetSwiftPackageDependency(image, tag)
+	case string(a.PackageTypeCOCOAPODS):
+		return GetCocoapodsInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeHEX):
+		return GetHexInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypePUB):
+		return GetPubInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeCRAN):
+		return GetCranInstallCommand(image, registryURL)
+	case string(a.PackageTypeGALAXY):
+		return GetGalaxyInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypePUPPET):
+		return GetPuppetInstallCommand(image, tag, registryURL)
+	case string(a.PackageTypeVAGRANT):
+		return GetVagrantBoxAddCommand(image, tag, registryURL)
+	case string(a.PackageTypeMODEL):
+		return GetModelArtifactFileDownloadCommand(registryURL, image, tag, "<FILENAME>")
+	case string(a.PackageTypeHOMEBREW):
+		return GetHomebrewInstallCommand(image, registryURL)
 	default:
 		return ""
 	}
@@ -384,6 +591,351 @@ func GetHelmPullCommand(image string, tag string, registryURL string) string {
 	return "helm pull oci://" + GetRepoURLWithoutProtocol(registryURL) + "/" + image + ":" + tag
 }
 
+func GetNpmInstallCommand(image string, version string, registryURL string) string {
+	return "npm install " + image + "@" + version + " --registry " + registryURL + "/"
+}
+
+func GetNpmArtifactFileDownloadCommand(regURL, artifact, filename string) string {
+	return "curl --location '" + regURL + "/" + artifact + "/-/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+func GetPythonInstallCommand(image string, version string, registryURL string) string {
+	return "pip install --index-url " + registryURL + "/simple --no-deps " + image + "==" + version
+}
+
+func GetPythonArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	return "curl --location '" + regURL + "/files/" + artifact + "/" + version + "/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+func GetNugetInstallCommand(image string, version string, registryURL string) string {
+	return "dotnet add package " + image + " --version " + version + " --source " + registryURL + "/index.json"
+}
+
+func GetNugetArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	return "curl --location '" + regURL + "/package/" + artifact + "/" + version + "/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+// GetGemsInstallCommand adds the gem to the Gemfile of the project, bundler resolves it from the
+// compact index of the registry.
+func GetGemsInstallCommand(image string, version string, registryURL string) string {
+	return "bundle add " + image + " --version " + version + " --source " + registryURL
+}
+
+func GetGemsArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/gems/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+// GetCrateInstallCommand adds the crate to the Cargo.toml of the project. The client setup names the
+// registry after its identifier in the cargo config, which is the next to last segment of the URL.
+func GetCrateInstallCommand(image string, version string, registryURL string) string {
+	return "cargo add " + image + "@" + version + " --registry " + path.Base(path.Dir(registryURL))
+}
+
+func GetCrateArtifactFileDownloadCommand(regURL, artifact, version string) string {
+	return "curl --location '" + regURL + "/api/v1/crates/" + artifact + "/" + version + "/download'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + artifact + "-" + version + ".crate"
+}
+
+// GetGoModuleInstallCommand fetches the module through the registry only; the module is excluded
+// from the checksum database, which doesn't know private modules.
+func GetGoModuleInstallCommand(image string, version string, registryURL string) string {
+	return "GOPROXY=" + registryURL + " GONOSUMDB=" + image + " go get " + image + "@" + version
+}
+
+func GetGoModuleArtifactFileDownloadCommand(regURL, artifact, filename string) string {
+	return "curl --location '" + regURL + "/" + gomodule.EscapePath(artifact) + "/@v/" +
+		gomodule.EscapePath(filename) + "' --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " +
+		path.Base(artifact) + "-" + filename
+}
+
+// GetDebInstallCommand adds the repository to the apt sources and installs the version. The client
+// setup stores the signing key of the repository under the name of the registry, which is the next
+// to last segment of the URL.
+func GetDebInstallCommand(image, version, registryURL, distribution, component string) string {
+	name := path.Base(path.Dir(registryURL))
+	return "echo 'deb [signed-by=/etc/apt/keyrings/" + name + ".asc] " + registryURL + " " + distribution + " " +
+		component + "' | sudo tee /etc/apt/sources.list.d/" + name + ".list && sudo apt update && " +
+		"sudo apt install " + image + "=" + version
+}
+
+// GetDebArtifactFileDownloadCommand downloads a .deb from the pool, which looks packages up by filename.
+func GetDebArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/pool/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+// GetRpmInstallCommand installs the version from the repositories dnf is set up with, the version
+// being the [epoch:]version-release the package is listed by.
+func GetRpmInstallCommand(image, version string) string {
+	return "sudo dnf install " + image + "-" + version
+}
+
+func GetRpmArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/Packages/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+// GetAlpineInstallCommand installs the version from the repositories apk is set up with.
+func GetAlpineInstallCommand(image, version string) string {
+	return "apk add " + image + "=" + version
+}
+
+// GetAlpineArtifactFileDownloadCommand downloads an .apk, whose filename is prefixed with the
+// architecture directory it's served from.
+func GetAlpineArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+// GetComposerInstallCommand requires the version in the project, which the client setup adds the
+// registry to the repositories of.
+func GetComposerInstallCommand(image, version string) string {
+	return "composer require " + image + ":" + version
+}
+
+func GetComposerArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	return "curl --location '" + regURL + "/files/" + artifact + "/" + version + "/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+// GetCondaInstallCommand installs the exact version from the channel of the registry, conda picks
+// the build for the platform it runs on.
+func GetCondaInstallCommand(image, version, registryURL string) string {
+	return "conda install --channel " + registryURL + " " + image + "==" + version
+}
+
+// GetCondaArtifactFileDownloadCommand downloads a package, whose filename is prefixed with the
+// subdir it's served from.
+func GetCondaArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
+// GetTerraformPullCommand uses the version of the module or provider stored under the image.
+func GetTerraformPullCommand(image, version, registryURL string) string {
+	if terraform.IsProviderImage(image) {
+		return GetTerraformProviderBlock(image, version, registryURL)
+	}
+	return GetTerraformModuleBlock(image, version, registryURL)
+}
+
+// GetTerraformModuleBlock uses the version of the module, whose source is addressed by the host
+// of the registry the client setup points to the registry.
+func GetTerraformModuleBlock(image, version, registryURL string) string {
+	return "module \"" + path.Base(path.Dir(image)) + "\" {\n" +
+		"  source  = \"" + terraformHost(registryURL) + "/" + image + "\"\n" +
+		"  version = \"" + version + "\"\n}"
+}
+
+// GetTerraformProviderBlock requires the version of the provider, whose source is addressed by the
+// host of the registry like the sources of modules.
+func GetTerraformProviderBlock(image, version, registryURL string) string {
+	return "terraform {\n" +
+		"  required_providers {\n" +
+		"    " + path.Base(image) + " = {\n" +
+		"      source  = \"" + terraformHost(registryURL) + "/" + image + "\"\n" +
+		"      version = \"" + version + "\"\n" +
+		"    }\n" +
+		"  }\n}"
+}
+
+func GetTerraformArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	fileURL := regURL + "/v1/modules/" + artifact + "/" + version + "/archive.tar.gz"
+	if terraform.IsProviderImage(artifact) {
+		fileURL = regURL + "/v1/providers/" + artifact + "/" + version + "/files/" + filename
+	}
+	return "curl --location '" + fileURL + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetSwiftPackageDependency depends on the release by the identifier of its package, which the
+// releases are stored under.
+func GetSwiftPackageDependency(image, version string) string {
+	return ".package(id: \"" + image + "\", exact: \"" + version + "\")"
+}
+
+// GetSwiftArtifactFileDownloadCommand downloads the source archive of a release, or one of its
+// manifests.
+func GetSwiftArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	scope, name, _ := strings.Cut(artifact, ".")
+	releaseURL := regURL + "/" + scope + "/" + name + "/" + version
+	fileURL := releaseURL + ".zip"
+	if manifest, ok := swift.ParseManifestFilename(filename); ok {
+		fileURL = releaseURL + "/" + swift.ManifestName
+		if manifest.SwiftVersion != "" {
+			fileURL += "?swift-version=" + manifest.SwiftVersion
+		}
+	}
+	return "curl --location '" + fileURL + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetCocoapodsInstallCommand is the Podfile line installing the version from the registry, which
+// the client setup adds as a source.
+func GetCocoapodsInstallCommand(image, version, registryURL string) string {
+	return "pod '" + image + "', '" + version + "', :source => '" + registryURL + "'"
+}
+
+// GetCocoapodsArtifactFileDownloadCommand downloads the archive of a version, or its podspec from
+// the Specs directory.
+func GetCocoapodsArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	fileURL := regURL + "/pods/" + artifact + "/" + version + "/" + filename
+	if filename == cocoapods.PodspecFilename(artifact) {
+		fileURL = regURL + "/" + cocoapods.SpecsDir + "/" + strings.Join(cocoapods.ShardPrefix(artifact), "/") +
+			"/" + artifact + "/" + version + "/" + filename
+	}
+	return "curl --location '" + fileURL + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetHexInstallCommand is the mix.exs dependency installing the release from the registry, which
+// the client setup adds as a repository named as the registry.
+func GetHexInstallCommand(image, version, registryURL string) string {
+	repo := path.Base(path.Dir(registryURL))
+	return "{:" + image + ", \"" + version + "\", repo: \"" + repo + "\"}"
+}
+
+// GetHexArtifactFileDownloadCommand downloads the tarball of a release, or its documentation.
+func GetHexArtifactFileDownloadCommand(regURL, filename string) string {
+	fileURL := regURL + "/tarballs/" + filename
+	if strings.HasSuffix(filename, ".tar.gz") {
+		fileURL = regURL + "/docs/" + filename
+	}
+	return "curl --location '" + fileURL + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetPubInstallCommand adds the version as a dependency hosted by the registry.
+func GetPubInstallCommand(image, version, registryURL string) string {
+	return "dart pub add " + image + ":" + version + " --hosted-url " + registryURL
+}
+
+// GetPubArtifactFileDownloadCommand downloads the archive of a version.
+func GetPubArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	fileURL := regURL + "/packages/" + artifact + "/versions/" + version + ".tar.gz"
+	return "curl --location '" + fileURL + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetCranInstallCommand installs the package from the registry, which provides the latest version
+// published to the tree of the platform.
+func GetCranInstallCommand(image, registryURL string) string {
+	return "install.packages(\"" + image + "\", repos = \"" + registryURL + "\")"
+}
+
+// GetCranArtifactFileDownloadCommand downloads an archive by its path in the repository, such as
+// src/contrib/<file> or bin/windows/contrib/<R version>/<file>.
+func GetCranArtifactFileDownloadCommand(regURL, filePath string) string {
+	return "curl --location '" + regURL + "/" + filePath + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + path.Base(filePath)
+}
+
+// GetGalaxyInstallCommand installs a version of the collection from the registry, which is the
+// Galaxy server of the client setup.
+func GetGalaxyInstallCommand(image, version, registryURL string) string {
+	return "ansible-galaxy collection install " + image + ":" + version + " --server " + registryURL + "/"
+}
+
+// GetGalaxyArtifactFileDownloadCommand downloads the archive of a version.
+func GetGalaxyArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/download/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetPuppetInstallCommand installs a release of the module from the registry, which puppet
+// resolves its dependencies from too.
+func GetPuppetInstallCommand(image, version, registryURL string) string {
+	return "puppet module install " + image + " --version " + version + " --module_repository " + registryURL
+}
+
+// GetPuppetArtifactFileDownloadCommand downloads the archive of a release.
+func GetPuppetArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/v3/files/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetVagrantBoxAddCommand adds a version of the box from its catalog in the registry.
+func GetVagrantBoxAddCommand(image, version, registryURL string) string {
+	return "vagrant box add " + registryURL + "/" + image + " --box-version " + version
+}
+
+// GetVagrantArtifactFileDownloadCommand downloads the box of a version for the provider and the
+// architecture in its filename.
+func GetVagrantArtifactFileDownloadCommand(regURL, image, version, filename string) string {
+	_, name, _ := strings.Cut(image, "/")
+	provider, architecture, err := vagrant.ParseBoxFilename(name, version, filename)
+	if err != nil {
+		return ""
+	}
+	downloadURL := regURL + "/" + image + "/" + version + "/" + provider
+	if architecture != "" {
+		downloadURL += "?architecture=" + architecture
+	}
+	return "curl --location '" + downloadURL + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetModelArtifactFileDownloadCommand downloads a file of a version of a model.
+func GetModelArtifactFileDownloadCommand(regURL, image, version, filename string) string {
+	return "curl --location '" + regURL + "/" + image + "/" + version + "/files/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// GetHomebrewInstallCommand installs the latest version of the formula from the tap the client
+// setup fills from the registry.
+func GetHomebrewInstallCommand(image, registryURL string) string {
+	return "brew install " + HomebrewTap(registryURL) + "/" + image
+}
+
+// GetHomebrewArtifactFileDownloadCommand downloads a bottle of a version.
+func GetHomebrewArtifactFileDownloadCommand(regURL, filename string) string {
+	return "curl --location '" + regURL + "/bottles/" + filename + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o " + filename
+}
+
+// HomebrewTap returns the name of the tap the client setup creates for the registry, which is named
+// after the root space and the registry, the segments of the URL before the package type.
+func HomebrewTap(registryURL string) string {
+	registryPath := path.Dir(registryURL)
+	return strings.ToLower(path.Base(path.Dir(registryPath)) + "/" + path.Base(registryPath))
+}
+
+func terraformHost(registryURL string) string {
+	if regURL, err := url.Parse(registryURL); err == nil && regURL.Host != "" {
+		return regURL.Host
+	}
+	return registryURL
+}
+
+// GetConanInstallCommand installs the recipe from the remote the client setup adds, which is named
+// after the registry, the next to last segment of the URL.
+func GetConanInstallCommand(image, version, registryURL string) string {
+	ref, err := conan.ParseImageName(image, version)
+	if err != nil {
+		return ""
+	}
+	return "conan install --requires=" + ref.String() + " --remote=" + path.Base(path.Dir(registryURL))
+}
+
+// GetConanArtifactFileDownloadCommand downloads a file of a recipe or binary package revision,
+// whose filename is its path relative to the version.
+func GetConanArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
+	ref, err := conan.ParseImageName(artifact, version)
+	if err != nil {
+		return ""
+	}
+	p, ok := conanpkg.ParseFilePath(filename)
+	if !ok {
+		return ""
+	}
+	return "curl --location '" + regURL + "/v2/conans/" + ref.URLPath() + "/" + p.URLPath() + "'" +
+		" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -O"
+}
+
 func GetGenericArtifactFileDownloadCommand(regURL, artifact, version, filename string) string {
 	downloadCommand := "curl --location '<HOSTNAME>/<ARTIFACT>:<VERSION>:<FILENAME>' --header 'x-api-key: <API_KEY>'" +
 		" -J -O"
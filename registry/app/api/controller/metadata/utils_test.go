@@ -15,10 +15,12 @@
 package metadata
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -56,6 +58,17 @@ func TestValidateIdentifier_InvalidIdentifier(t *testing.T) {
 	assert.Equal(t, RegistryIdentifierErrorMsg, err.Error())
 }
 
+func TestValidateStoragePrefix(t *testing.T) {
+	assert.NoError(t, ValidateStoragePrefix("teams/payments"))
+	assert.NoError(t, ValidateStoragePrefix("bucket-prefix_1.0"))
+
+	for _, prefix := range []string{"", "/teams", "teams/", "teams//payments", "teams/../root", "Teams", "a b"} {
+		assert.Error(t, ValidateStoragePrefix(prefix), prefix)
+	}
+	assert.Error(t, ValidateStoragePrefix("teams/docker"))
+	assert.Error(t, ValidateStoragePrefix(strings.Repeat("a", 256)))
+}
+
 func TestCleanURLPath_ValidURL(t *testing.T) {
 	input := "https://example.com/path/"
 	expected := "https://example.com/path"
@@ -149,6 +162,65 @@ func TestGetPullCommand_ValidCommand(t *testing.T) {
 		GetPullCommand("image", "tag", "DOCKER", "https://example.com"))
 	assert.Equal(t, "helm pull oci://example.com/image:tag",
 		GetPullCommand("image", "tag", "HELM", "https://example.com"))
+	assert.Equal(t, "npm install @scope/pkg@1.0.0 --registry https://example.com/pkg/root/npm-reg/npm/",
+		GetPullCommand("@scope/pkg", "1.0.0", "NPM", "https://example.com/pkg/root/npm-reg/npm"))
+	assert.Equal(t,
+		"pip install --index-url https://example.com/pkg/root/py-reg/python/simple --no-deps requests==2.32.0",
+		GetPullCommand("requests", "2.32.0", "PYTHON", "https://example.com/pkg/root/py-reg/python"))
+	assert.Equal(t, "dotnet add package Acme.Logging --version 1.2.0 --source https://example.com/nuget/index.json",
+		GetPullCommand("Acme.Logging", "1.2.0", "NUGET", "https://example.com/nuget"))
+	assert.Equal(t, "bundle add rake --version 13.0.6 --source https://example.com/rubygems",
+		GetPullCommand("rake", "13.0.6", "GEMS", "https://example.com/rubygems"))
+	assert.Equal(t, "cargo add serde@1.0.200 --registry crates",
+		GetPullCommand("serde", "1.0.200", "CRATE", "https://example.com/pkg/root/crates/cargo"))
+	assert.Equal(t,
+		"GOPROXY=https://example.com/go GONOSUMDB=example.com/mod go get example.com/mod@v1.2.0",
+		GetPullCommand("example.com/mod", "v1.2.0", "GO", "https://example.com/go"))
+	assert.Equal(t,
+		"echo 'deb [signed-by=/etc/apt/keyrings/debs.asc] https://example.com/pkg/root/debs/debian "+
+			"<DISTRIBUTION> <COMPONENT>' | sudo tee /etc/apt/sources.list.d/debs.list && sudo apt update && "+
+			"sudo apt install hello=2.10-3",
+		GetPullCommand("hello", "2.10-3", "DEB", "https://example.com/pkg/root/debs/debian"))
+	assert.Equal(t, "sudo dnf install hello-1:2.10-3.el9",
+		GetPullCommand("hello", "1:2.10-3.el9", "RPM", "https://example.com/pkg/root/rpms/rpm"))
+	assert.Equal(t, "apk add hello=2.12-r1",
+		GetPullCommand("hello", "2.12-r1", "ALPINE", "https://example.com/pkg/root/apks/alpine"))
+	assert.Equal(t, "conan install --requires=zlib/1.3.1@acme/stable --remote=recipes",
+		GetPullCommand("zlib@acme:stable", "1.3.1", "CONAN", "https://example.com/pkg/root/recipes/conan"))
+	assert.Equal(t, "composer require acme/hello:1.0.0",
+		GetPullCommand("acme/hello", "1.0.0", "COMPOSER", "https://example.com/pkg/root/php/composer"))
+	assert.Equal(t, "conda install --channel https://example.com/pkg/root/forge/conda numpy==1.26.4",
+		GetPullCommand("numpy", "1.26.4", "CONDA", "https://example.com/pkg/root/forge/conda"))
+	assert.Equal(t, "module \"vpc\" {\n  source  = \"example.com/acme/vpc/aws\"\n  version = \"1.2.0\"\n}",
+		GetPullCommand("acme/vpc/aws", "1.2.0", "TERRAFORM", "https://example.com/pkg/root/modules/terraform"))
+	assert.Equal(t, "terraform {\n  required_providers {\n    cloud = {\n"+
+		"      source  = \"example.com/acme/cloud\"\n      version = \"1.0.0\"\n    }\n  }\n}",
+		GetPullCommand("acme/cloud", "1.0.0", "TERRAFORM", "https://example.com/pkg/root/providers/terraform"))
+	assert.Equal(t, ".package(id: \"mona.linkedlist\", exact: \"1.1.1\")",
+		GetPullCommand("mona.linkedlist", "1.1.1", "SWIFT", "https://example.com/pkg/root/packages/swift"))
+	assert.Equal(t, "pod 'AFNetworking', '4.0.1', :source => 'https://example.com/pkg/root/ios/cocoapods'",
+		GetPullCommand("AFNetworking", "4.0.1", "COCOAPODS", "https://example.com/pkg/root/ios/cocoapods"))
+	assert.Equal(t, `{:plug_auth, "1.2.0", repo: "elixir"}`,
+		GetPullCommand("plug_auth", "1.2.0", "HEX", "https://example.com/pkg/root/elixir/hex"))
+	assert.Equal(t, "dart pub add shelf_auth:1.2.0 --hosted-url https://example.com/pkg/root/dart/pub",
+		GetPullCommand("shelf_auth", "1.2.0", "PUB", "https://example.com/pkg/root/dart/pub"))
+	assert.Equal(t, `install.packages("tidyfoo", repos = "https://example.com/pkg/root/r/cran")`,
+		GetPullCommand("tidyfoo", "1.2-3", "CRAN", "https://example.com/pkg/root/r/cran"))
+	assert.Equal(t,
+		"ansible-galaxy collection install acme.net_tools:1.2.0 --server https://example.com/pkg/root/ops/galaxy/",
+		GetPullCommand("acme.net_tools", "1.2.0", "GALAXY", "https://example.com/pkg/root/ops/galaxy"))
+	assert.Equal(t,
+		"puppet module install acme-ntp --version 1.2.0 --module_repository https://example.com/pkg/root/ops/puppet",
+		GetPullCommand("acme-ntp", "1.2.0", "PUPPET", "https://example.com/pkg/root/ops/puppet"))
+	assert.Equal(t,
+		"vagrant box add https://example.com/pkg/root/ops/vagrant/acme/ubuntu --box-version 1.2.0",
+		GetPullCommand("acme/ubuntu", "1.2.0", "VAGRANT", "https://example.com/pkg/root/ops/vagrant"))
+	assert.Equal(t,
+		"curl --location 'https://example.com/pkg/root/ml/model/llama/1.0.0/files/<FILENAME>'"+
+			" --header 'Authorization: Bearer <IDENTITY_TOKEN>' -o <FILENAME>",
+		GetPullCommand("llama", "1.0.0", "MODEL", "https://example.com/pkg/root/ml/model"))
+	assert.Equal(t, "brew install root/ops/acmectl",
+		GetPullCommand("acmectl", "1.4.0", "HOMEBREW", "https://example.com/pkg/root/ops/homebrew"))
 	assert.Equal(t, "", GetPullCommand("image", "tag", "INVALID", "https://example.com"))
 }
 
@@ -159,3 +231,60 @@ func TestGetDockerPullCommand_ValidCommand(t *testing.T) {
 func TestGetHelmPullCommand_ValidCommand(t *testing.T) {
 	assert.Equal(t, "helm pull oci://example.com/image:tag", GetHelmPullCommand("image", "tag", "https://example.com"))
 }
+
+func TestValidateCleanupPolicies(t *testing.T) {
+	notPulled := artifact.CleanupPolicyTypeNOTPULLED
+	days := 30
+	zero := 0
+
+	assert.NoError(t, ValidateCleanupPolicies(nil))
+	assert.NoError(t, ValidateCleanupPolicies(&[]artifact.CleanupPolicy{
+		{Type: &notPulled, ExpireDays: &days, ExcludePattern: &[]string{"v*", "release-[0-9]*"}},
+	}))
+	assert.Error(t, ValidateCleanupPolicies(&[]artifact.CleanupPolicy{{Type: &notPulled, ExpireDays: &zero}}))
+	assert.Error(t, ValidateCleanupPolicies(&[]artifact.CleanupPolicy{
+		{Type: &notPulled, ExpireDays: &days, ExcludePattern: &[]string{"[a-"}},
+	}))
+}
+
+func TestValidateMTLSConfig(t *testing.T) {
+	assert.NoError(t, ValidateMTLSConfig(artifact.MTLSModeDISABLED, ""))
+	assert.Error(t, ValidateMTLSConfig(artifact.MTLSModePUSH, ""))
+	assert.Error(t, ValidateMTLSConfig(artifact.MTLSModeALL, "not a certificate"))
+	assert.Error(t, ValidateMTLSConfig("SOMETIMES", ""))
+}
+
+func TestValidateMavenStrictMetadata(t *testing.T) {
+	assert.NoError(t, ValidateMavenStrictMetadata(artifact.PackageTypeMAVEN, true))
+	assert.NoError(t, ValidateMavenStrictMetadata(artifact.PackageTypeNPM, false))
+	assert.Error(t, ValidateMavenStrictMetadata(artifact.PackageTypeNPM, true))
+}
+
+func TestValidateNpmLocalScopes(t *testing.T) {
+	assert.NoError(t, ValidateNpmLocalScopes(artifact.PackageTypeNPM, []string{"@mycorp", "@my-corp.tools"}))
+	assert.NoError(t, ValidateNpmLocalScopes(artifact.PackageTypeMAVEN, nil))
+	assert.Error(t, ValidateNpmLocalScopes(artifact.PackageTypeMAVEN, []string{"@mycorp"}))
+	assert.Error(t, ValidateNpmLocalScopes(artifact.PackageTypeNPM, []string{"mycorp"}))
+	assert.Error(t, ValidateNpmLocalScopes(artifact.PackageTypeNPM, []string{"@MyCorp"}))
+	assert.Error(t, ValidateNpmLocalScopes(artifact.PackageTypeNPM, []string{"@my/corp"}))
+}
+
+func TestGetImageSizeBytes(t *testing.T) {
+	assert.Equal(t, int64(1258291), GetImageSizeBytes("1258291"))
+	assert.Equal(t, "1.20MB", GetImageSize("1258291"))
+	assert.Equal(t, int64(0), GetImageSizeBytes(""))
+}
+
+func TestUpstreamProxyIDs(t *testing.T) {
+	repos := []store.RegistryMetadata{
+		{RegID: "1", RegIdentifier: "npmjs", Type: artifact.RegistryTypeUPSTREAM},
+		{RegID: "2", RegIdentifier: "internal", Type: artifact.RegistryTypeVIRTUAL},
+		{RegID: "3", RegIdentifier: "mirror", Type: artifact.RegistryTypeUPSTREAM},
+	}
+	ids, err := upstreamProxyIDs("group", []string{"mirror", "internal", "unknown", "npmjs"}, repos)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2, 1}, ids)
+
+	_, err = upstreamProxyIDs("group", []string{"npmjs", "group"}, repos)
+	assert.Error(t, err)
+}
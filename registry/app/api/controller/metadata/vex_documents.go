@@ -0,0 +1,246 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/vex"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// UploadVexDocument attaches an OpenVEX or CSAF VEX document to an artifact digest.
+func (c *APIController) UploadVexDocument(
+	ctx context.Context,
+	r artifact.UploadVexDocumentRequestObject,
+) (artifact.UploadVexDocumentResponseObject, error) {
+	if r.Body == nil {
+		return throwUploadVexDocument400Error(fmt.Errorf("request body is required")), nil
+	}
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwUploadVexDocument400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwUploadVexDocument400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsUpload)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.UploadVexDocument403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	dgst, err := digest.Parse(string(r.Params.Digest))
+	if err != nil {
+		return throwUploadVexDocument400Error(fmt.Errorf("invalid digest: %w", err)), nil
+	}
+	content, err := json.Marshal(r.Body)
+	if err != nil {
+		return throwUploadVexDocument400Error(err), nil
+	}
+	doc, err := vex.Parse(content)
+	if err != nil {
+		return throwUploadVexDocument400Error(err), nil
+	}
+	doc.RegistryID = regInfo.RegistryID
+	doc.Digest = dgst.String()
+	doc.CreatedBy = session.Principal.ID
+
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		return c.VexStore.Create(ctx, doc)
+	})
+	if err != nil {
+		return artifact.UploadVexDocument500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	return artifact.UploadVexDocument201JSONResponse{
+		VexDocumentResponseJSONResponse: artifact.VexDocumentResponseJSONResponse{
+			Data: artifact.VexDocument{
+				Id:             doc.ID,
+				Digest:         doc.Digest,
+				Format:         artifact.VexFormat(doc.Format),
+				Identifier:     doc.Identifier,
+				Author:         optionalString(doc.Author),
+				IssuedAt:       doc.IssuedAt.UnixMilli(),
+				CreatedAt:      doc.CreatedAt.UnixMilli(),
+				StatementCount: int64(len(doc.Statements)),
+			},
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListVexStatements lists the VEX statements in effect for an artifact digest.
+func (c *APIController) ListVexStatements(
+	ctx context.Context,
+	r artifact.ListVexStatementsRequestObject,
+) (artifact.ListVexStatementsResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwListVexStatements400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwListVexStatements400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionRegistryView)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.ListVexStatements403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	dgst, err := digest.Parse(string(r.Params.Digest))
+	if err != nil {
+		return throwListVexStatements400Error(fmt.Errorf("invalid digest: %w", err)), nil
+	}
+	statements, err := c.activeVexStatements(ctx, regInfo.RegistryID, dgst.String())
+	if err != nil {
+		return artifact.ListVexStatements500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+
+	data := make([]artifact.VexStatement, 0, len(statements))
+	for _, s := range statements {
+		data = append(data, artifact.VexStatement{
+			Vulnerability:      s.Vulnerability,
+			Status:             artifact.VexStatus(s.Status),
+			Justification:      optionalString(s.Justification),
+			ImpactStatement:    optionalString(s.ImpactStatement),
+			Timestamp:          s.Timestamp.UnixMilli(),
+			DocumentId:         s.DocumentID,
+			DocumentIdentifier: s.DocumentIdentifier,
+			Format:             artifact.VexFormat(s.Format),
+		})
+	}
+	return artifact.ListVexStatements200JSONResponse{
+		ListVexStatementsResponseJSONResponse: artifact.ListVexStatementsResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteVexDocument detaches a VEX document from its artifact.
+func (c *APIController) DeleteVexDocument(
+	ctx context.Context,
+	r artifact.DeleteVexDocumentRequestObject,
+) (artifact.DeleteVexDocumentResponseObject, error) {
+	regInfo, err := c.RegistryMetadataHelper.GetRegistryRequestBaseInfo(ctx, "", string(r.RegistryRef))
+	if err != nil {
+		return throwDeleteVexDocument400Error(err), nil
+	}
+	space, err := c.SpaceFinder.FindByRef(ctx, regInfo.ParentRef)
+	if err != nil {
+		return throwDeleteVexDocument400Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	permissionChecks := c.RegistryMetadataHelper.GetPermissionChecks(space, regInfo.RegistryIdentifier,
+		enum.PermissionArtifactsDelete)
+	if err = apiauth.CheckRegistry(ctx, c.Authorizer, session, permissionChecks...); err != nil {
+		return artifact.DeleteVexDocument403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	}
+
+	err = c.VexStore.Delete(ctx, regInfo.RegistryID, int64(r.VexDocumentId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteVexDocument404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "VEX document not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return artifact.DeleteVexDocument500JSONResponse{
+			InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+				*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+			),
+		}, nil
+	}
+	return artifact.DeleteVexDocument200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// activeVexStatements returns the VEX statements in effect for the artifact with the given digest.
+func (c *APIController) activeVexStatements(
+	ctx context.Context,
+	registryID int64,
+	dgst string,
+) ([]*registrytypes.VexStatement, error) {
+	statements, err := c.VexStore.ListStatements(ctx, registryID, dgst)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VEX statements: %w", err)
+	}
+	return vex.Active(statements), nil
+}
+
+func throwUploadVexDocument400Error(err error) artifact.UploadVexDocument400JSONResponse {
+	return artifact.UploadVexDocument400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwListVexStatements400Error(err error) artifact.ListVexStatements400JSONResponse {
+	return artifact.ListVexStatements400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwDeleteVexDocument400Error(err error) artifact.DeleteVexDocument400JSONResponse {
+	return artifact.DeleteVexDocument400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
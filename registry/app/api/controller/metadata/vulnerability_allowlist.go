@@ -0,0 +1,299 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/vex"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// CreateVulnerabilityAllowlistEntry allowlists a vulnerability for a package, or one of its artifacts, until
+// an expiry date. Allowlisting a vulnerability again replaces its justification and expiry.
+func (c *APIController) CreateVulnerabilityAllowlistEntry(
+	ctx context.Context,
+	r artifact.CreateVulnerabilityAllowlistEntryRequestObject,
+) (artifact.CreateVulnerabilityAllowlistEntryResponseObject, error) {
+	if r.Body == nil {
+		return throwCreateVulnerabilityAllowlistEntry400Error(fmt.Errorf("request body is required")), nil
+	}
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return throwCreateVulnerabilityAllowlistEntry400Error(err), nil
+	case http.StatusForbidden:
+		return artifact.CreateVulnerabilityAllowlistEntry403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwCreateVulnerabilityAllowlistEntry500Error(err), nil
+	}
+
+	session, _ := request.AuthSessionFrom(ctx)
+	entry, err := mapToVulnerabilityAllowlistEntry(registry.ID, session.Principal.ID,
+		artifact.VulnerabilityAllowlistRequest(*r.Body), time.Now())
+	if err != nil {
+		return throwCreateVulnerabilityAllowlistEntry400Error(err), nil
+	}
+	if err = c.VulnerabilityAllowlistStore.Save(ctx, entry); err != nil {
+		return throwCreateVulnerabilityAllowlistEntry500Error(err), nil
+	}
+
+	c.logRegistryAudit(ctx, registry, audit.ActionCreated,
+		audit.WithData("allowlisted vulnerability", entry.Vulnerability),
+		audit.WithData("package", allowlistTarget(entry)),
+		audit.WithData("justification", entry.Justification),
+		audit.WithData("expires at", entry.ExpiresAt.UTC().Format(time.RFC3339)),
+	)
+
+	return artifact.CreateVulnerabilityAllowlistEntry201JSONResponse{
+		VulnerabilityAllowlistEntryResponseJSONResponse: artifact.VulnerabilityAllowlistEntryResponseJSONResponse{
+			Data:   mapToAPIVulnerabilityAllowlistEntry(entry, time.Now()),
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// ListVulnerabilityAllowlist lists the vulnerabilities allowlisted for the packages of a registry, including
+// the expired ones.
+func (c *APIController) ListVulnerabilityAllowlist(
+	ctx context.Context,
+	r artifact.ListVulnerabilityAllowlistRequestObject,
+) (artifact.ListVulnerabilityAllowlistResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryView)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.ListVulnerabilityAllowlist400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.ListVulnerabilityAllowlist403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwListVulnerabilityAllowlist500Error(err), nil
+	}
+
+	entries, err := c.VulnerabilityAllowlistStore.List(ctx, registry.ID)
+	if err != nil {
+		return throwListVulnerabilityAllowlist500Error(err), nil
+	}
+	now := time.Now()
+	data := make([]artifact.VulnerabilityAllowlistEntry, 0, len(entries))
+	for _, entry := range entries {
+		data = append(data, mapToAPIVulnerabilityAllowlistEntry(entry, now))
+	}
+	return artifact.ListVulnerabilityAllowlist200JSONResponse{
+		ListVulnerabilityAllowlistResponseJSONResponse: artifact.ListVulnerabilityAllowlistResponseJSONResponse{
+			Data:   data,
+			Status: artifact.StatusSUCCESS,
+		},
+	}, nil
+}
+
+// DeleteVulnerabilityAllowlistEntry removes an allowlisted vulnerability.
+func (c *APIController) DeleteVulnerabilityAllowlistEntry(
+	ctx context.Context,
+	r artifact.DeleteVulnerabilityAllowlistEntryRequestObject,
+) (artifact.DeleteVulnerabilityAllowlistEntryResponseObject, error) {
+	registry, status, err := c.getRegistry(ctx, string(r.RegistryRef), enum.PermissionRegistryEdit)
+	switch status {
+	case http.StatusBadRequest:
+		return artifact.DeleteVulnerabilityAllowlistEntry400JSONResponse{
+			BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+				*GetErrorResponse(http.StatusBadRequest, err.Error()),
+			),
+		}, nil
+	case http.StatusForbidden:
+		return artifact.DeleteVulnerabilityAllowlistEntry403JSONResponse{
+			UnauthorizedJSONResponse: artifact.UnauthorizedJSONResponse(
+				*GetErrorResponse(http.StatusForbidden, err.Error()),
+			),
+		}, nil
+	case http.StatusInternalServerError:
+		return throwDeleteVulnerabilityAllowlistEntry500Error(err), nil
+	}
+
+	err = c.VulnerabilityAllowlistStore.Delete(ctx, registry.ID, int64(r.AllowlistEntryId))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return artifact.DeleteVulnerabilityAllowlistEntry404JSONResponse{
+			NotFoundJSONResponse: artifact.NotFoundJSONResponse(
+				*GetErrorResponse(http.StatusNotFound, "vulnerability allowlist entry not found"),
+			),
+		}, nil
+	}
+	if err != nil {
+		return throwDeleteVulnerabilityAllowlistEntry500Error(err), nil
+	}
+
+	c.logRegistryAudit(ctx, registry, audit.ActionDeleted,
+		audit.WithData("vulnerability allowlist entry", strconv.FormatInt(int64(r.AllowlistEntryId), 10)),
+	)
+
+	return artifact.DeleteVulnerabilityAllowlistEntry200JSONResponse{
+		SuccessJSONResponse: artifact.SuccessJSONResponse(*GetSuccessResponse()),
+	}, nil
+}
+
+// ignoredVulnerabilities returns the vulnerabilities suppressed by the active VEX statements of a digest, and
+// the ones allowlisted for it that aren't suppressed already.
+func (c *APIController) ignoredVulnerabilities(
+	ctx context.Context,
+	registryID int64,
+	dgst string,
+) ([]string, []string, error) {
+	statements, err := c.activeVexStatements(ctx, registryID, dgst)
+	if err != nil {
+		return nil, nil, err
+	}
+	suppressed := vex.Suppressed(statements)
+	allowlist, err := c.SecurityService.Allowlisted(ctx, registryID, dgst)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suppressedIdentifiers := make([]string, 0, len(suppressed))
+	for identifier := range suppressed {
+		suppressedIdentifiers = append(suppressedIdentifiers, identifier)
+	}
+	allowlistedIdentifiers := make([]string, 0, len(allowlist))
+	for identifier := range allowlist {
+		if _, ok := suppressed[identifier]; !ok {
+			allowlistedIdentifiers = append(allowlistedIdentifiers, identifier)
+		}
+	}
+	return suppressedIdentifiers, allowlistedIdentifiers, nil
+}
+
+func mapToVulnerabilityAllowlistEntry(
+	registryID int64,
+	principalID int64,
+	in artifact.VulnerabilityAllowlistRequest,
+	now time.Time,
+) (*registrytypes.VulnerabilityAllowlistEntry, error) {
+	if strings.TrimSpace(in.Package) == "" {
+		return nil, fmt.Errorf("package is required")
+	}
+	vulnerability := vex.NormalizeVulnerability(in.Vulnerability)
+	if vulnerability == "" {
+		return nil, fmt.Errorf("vulnerability is required")
+	}
+	if strings.TrimSpace(in.Justification) == "" {
+		return nil, fmt.Errorf("justification is required")
+	}
+	expiresAt := time.UnixMilli(in.ExpiresAt)
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("expiry must be in the future")
+	}
+
+	entry := &registrytypes.VulnerabilityAllowlistEntry{
+		RegistryID:    registryID,
+		ImageName:     strings.TrimSpace(in.Package),
+		Vulnerability: vulnerability,
+		Justification: strings.TrimSpace(in.Justification),
+		ExpiresAt:     expiresAt,
+		CreatedBy:     principalID,
+	}
+	if in.Digest != nil && *in.Digest != "" {
+		dgst, err := digest.Parse(*in.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid digest: %w", err)
+		}
+		entry.Digest = dgst.String()
+	}
+	return entry, nil
+}
+
+func allowlistTarget(entry *registrytypes.VulnerabilityAllowlistEntry) string {
+	if entry.Digest == "" {
+		return entry.ImageName
+	}
+	return entry.ImageName + "@" + entry.Digest
+}
+
+func mapToAPIVulnerabilityAllowlistEntry(
+	entry *registrytypes.VulnerabilityAllowlistEntry,
+	now time.Time,
+) artifact.VulnerabilityAllowlistEntry {
+	return artifact.VulnerabilityAllowlistEntry{
+		Id:            entry.ID,
+		Package:       entry.ImageName,
+		Digest:        optionalString(entry.Digest),
+		Vulnerability: entry.Vulnerability,
+		Justification: entry.Justification,
+		ExpiresAt:     entry.ExpiresAt.UnixMilli(),
+		Expired:       entry.Expired(now),
+		CreatedAt:     entry.CreatedAt.UnixMilli(),
+		CreatedBy:     entry.CreatedBy,
+	}
+}
+
+func throwCreateVulnerabilityAllowlistEntry400Error(
+	err error,
+) artifact.CreateVulnerabilityAllowlistEntry400JSONResponse {
+	return artifact.CreateVulnerabilityAllowlistEntry400JSONResponse{
+		BadRequestJSONResponse: artifact.BadRequestJSONResponse(
+			*GetErrorResponse(http.StatusBadRequest, err.Error()),
+		),
+	}
+}
+
+func throwCreateVulnerabilityAllowlistEntry500Error(
+	err error,
+) artifact.CreateVulnerabilityAllowlistEntry500JSONResponse {
+	return artifact.CreateVulnerabilityAllowlistEntry500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwListVulnerabilityAllowlist500Error(err error) artifact.ListVulnerabilityAllowlist500JSONResponse {
+	return artifact.ListVulnerabilityAllowlist500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
+
+func throwDeleteVulnerabilityAllowlistEntry500Error(
+	err error,
+) artifact.DeleteVulnerabilityAllowlistEntry500JSONResponse {
+	return artifact.DeleteVulnerabilityAllowlistEntry500JSONResponse{
+		InternalServerErrorJSONResponse: artifact.InternalServerErrorJSONResponse(
+			*GetErrorResponse(http.StatusInternalServerError, err.Error()),
+		),
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapToVulnerabilityAllowlistEntry(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	dgst := testScanDigest
+	in := artifact.VulnerabilityAllowlistRequest{
+		Package:       " app ",
+		Digest:        &dgst,
+		Vulnerability: " cve-2024-3094 ",
+		Justification: "not reachable",
+		ExpiresAt:     now.Add(time.Hour).UnixMilli(),
+	}
+
+	entry, err := mapToVulnerabilityAllowlistEntry(1, 2, in, now)
+	require.NoError(t, err)
+	assert.Equal(t, "app", entry.ImageName)
+	assert.Equal(t, testScanDigest, entry.Digest)
+	assert.Equal(t, "CVE-2024-3094", entry.Vulnerability)
+	assert.True(t, entry.Covers("app", testScanDigest))
+	assert.False(t, entry.Covers("app", "sha256:other"))
+	assert.False(t, entry.Expired(now))
+	assert.True(t, entry.Expired(now.Add(time.Hour)))
+
+	apiEntry := mapToAPIVulnerabilityAllowlistEntry(entry, now.Add(2*time.Hour))
+	assert.True(t, apiEntry.Expired)
+	require.NotNil(t, apiEntry.Digest)
+	assert.Equal(t, testScanDigest, *apiEntry.Digest)
+}
+
+func TestMapToVulnerabilityAllowlistEntry_Invalid(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+	valid := artifact.VulnerabilityAllowlistRequest{
+		Package:       "app",
+		Vulnerability: "CVE-2024-3094",
+		Justification: "not reachable",
+		ExpiresAt:     now.Add(time.Hour).UnixMilli(),
+	}
+
+	invalidDigest := valid
+	latest := "latest"
+	invalidDigest.Digest = &latest
+	expired := valid
+	expired.ExpiresAt = now.UnixMilli()
+	unjustified := valid
+	unjustified.Justification = " "
+
+	for _, in := range []artifact.VulnerabilityAllowlistRequest{invalidDigest, expired, unjustified} {
+		_, err := mapToVulnerabilityAllowlistEntry(1, 2, in, now)
+		assert.Error(t, err)
+	}
+}
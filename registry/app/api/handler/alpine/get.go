@@ -0,0 +1,90 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"net/http"
+
+	alpinepkg "github.com/harness/gitness/registry/app/pkg/alpine"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetIndex(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Architecture = chi.URLParam(r, "architecture")
+
+	headers, fileReader, redirectURL, errc := h.controller.GetIndex(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.serveFile(w, r, headers, fileReader, redirectURL, alpinepkg.FileIndex)
+}
+
+// GetPublicKey serves the key the indices are signed with, named as apk looks it up in /etc/apk/keys.
+func (h *handler) GetPublicKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name, key, errc := h.controller.GetPublicKey(ctx)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-pem-file")
+	w.Header().Set("Content-Disposition", "attachment; filename="+name)
+	_, _ = w.Write(key)
+}
+
+func (h *handler) DownloadPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Architecture = chi.URLParam(r, "architecture")
+	info.Filename = chi.URLParam(r, "filename")
+
+	headers, fileReader, redirectURL, errc := h.controller.DownloadPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+info.Filename)
+	h.serveFile(w, r, headers, fileReader, redirectURL, info.Filename)
+}
+
+func (h *handler) serveFile(
+	w http.ResponseWriter,
+	r *http.Request,
+	headers *commons.ResponseHeaders,
+	fileReader *storage.FileReader,
+	redirectURL string,
+	filename string,
+) {
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	h.ServeContent(w, r, fileReader, filename)
+	headers.WriteToResponse(w)
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	alpinepkg "github.com/harness/gitness/registry/app/pkg/alpine"
+)
+
+type Handler interface {
+	UploadPackage(writer http.ResponseWriter, request *http.Request)
+	// GetIndex serves the APKINDEX.tar.gz of an architecture, which apk reads the repository from.
+	GetIndex(writer http.ResponseWriter, request *http.Request)
+	GetPublicKey(writer http.ResponseWriter, request *http.Request)
+	DownloadPackage(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller alpinepkg.Controller
+}
+
+func NewHandler(
+	controller alpinepkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (alpinepkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return alpinepkg.ArtifactInfo{}, e
+	}
+	return alpinepkg.ArtifactInfo{
+		ArtifactInfo: &info,
+	}, nil
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/metadata/cargo"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// GetConfig serves config.json at the root of the sparse index.
+func (h *handler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	h.writeJSON(w, h.controller.GetConfig(ctx, info))
+}
+
+// GetIndex serves the index file of a crate. The crate is taken from the last path segment, and
+// the path has to be the one cargo derives from the name.
+func (h *handler) GetIndex(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	indexPath := chi.URLParam(r, "*")
+	name := strings.ToLower(path.Base(indexPath))
+	if strings.ToLower(indexPath) != cargo.IndexPath(name) {
+		h.HandleErrors(ctx, invalidRequest(fmt.Errorf("%s isn't the index path of a crate", indexPath)), w)
+		return
+	}
+	info.Image = name
+
+	index, errc := h.controller.GetIndex(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write(index)
+}
+
+// DownloadCrate serves the .crate of a version.
+func (h *handler) DownloadCrate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Image = strings.ToLower(info.Image)
+
+	headers, fileReader, redirectURL, errc := h.controller.DownloadCrate(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	filename := cargo.Filename(info.Image, info.Version)
+	w.Header().Set("Content-Disposition", "attachment; filename="+filename)
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	h.ServeContent(w, r, fileReader, filename)
+	headers.WriteToResponse(w)
+}
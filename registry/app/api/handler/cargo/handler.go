@@ -0,0 +1,73 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/cargo"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler interface {
+	GetConfig(writer http.ResponseWriter, request *http.Request)
+	GetIndex(writer http.ResponseWriter, request *http.Request)
+	PublishCrate(writer http.ResponseWriter, request *http.Request)
+	DownloadCrate(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller cargo.Controller
+}
+
+func NewHandler(
+	controller cargo.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (cargo.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return cargo.ArtifactInfo{}, e
+	}
+	info.Image = chi.URLParam(r, "name")
+
+	return cargo.ArtifactInfo{
+		ArtifactInfo: &info,
+		Version:      chi.URLParam(r, "version"),
+	}, nil
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
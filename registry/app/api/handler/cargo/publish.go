@@ -0,0 +1,44 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+)
+
+// PublishCrate handles `cargo publish`, which sends the crate metadata JSON followed by the .crate.
+func (h *handler) PublishCrate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	if r.Body == nil || r.ContentLength == 0 {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("crate is required"), w)
+		return
+	}
+
+	headers, response, errc := h.controller.PublishCrate(ctx, info, r.Body)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteToResponse(w)
+	h.writeJSON(w, response)
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/metadata/cocoapods"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const textContentType = "text/plain; charset=utf-8"
+
+func (h *handler) GetVersionFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if _, err := h.getPackageArtifactInfo(r); err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	w.Header().Set("Content-Type", "text/yaml")
+	_, _ = w.Write([]byte(cocoapods.VersionFileContent()))
+}
+
+func (h *handler) ListPods(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	names, errc := h.controller.ListPods(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	writeLines(w, names)
+}
+
+func (h *handler) ListShardVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	pods, errc := h.controller.ListShardVersions(ctx, info, shardPrefix(r))
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	lines := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		lines = append(lines, pod.String())
+	}
+	writeLines(w, lines)
+}
+
+func (h *handler) ListDeprecatedPodspecs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if _, err := h.getPackageArtifactInfo(r); err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	writeLines(w, nil)
+}
+
+func (h *handler) GetPodspec(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	filename := chi.URLParam(r, "file")
+	headers, fileReader, redirectURL, errc := h.controller.GetPodspec(ctx, info, shardPrefix(r), filename)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, filename)
+}
+
+func (h *handler) DownloadArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	filename := chi.URLParam(r, "file")
+	headers, fileReader, redirectURL, errc := h.controller.DownloadArchive(ctx, info, filename)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, filename)
+}
+
+// shardPrefix returns the shard of the Specs directory in the path of a request.
+func shardPrefix(r *http.Request) []string {
+	return []string{chi.URLParam(r, "a"), chi.URLParam(r, "b"), chi.URLParam(r, "c")}
+}
+
+func writeLines(w http.ResponseWriter, lines []string) {
+	w.Header().Set("Content-Type", textContentType)
+	if len(lines) == 0 {
+		return
+	}
+	_, _ = w.Write([]byte(strings.Join(lines, "\n") + "\n"))
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	cocoapodspkg "github.com/harness/gitness/registry/app/pkg/cocoapods"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler serves cocoapods registries as CDN sources, which CocoaPods reads as static files.
+type Handler interface {
+	GetVersionFile(writer http.ResponseWriter, request *http.Request)
+	ListPods(writer http.ResponseWriter, request *http.Request)
+	// ListShardVersions serves the versions of the pods in a shard, as all_pods_versions_a_b_c.txt.
+	ListShardVersions(writer http.ResponseWriter, request *http.Request)
+	// ListDeprecatedPodspecs serves deprecated_podspecs.txt, which is empty as pods can't be
+	// deprecated.
+	ListDeprecatedPodspecs(writer http.ResponseWriter, request *http.Request)
+	GetPodspec(writer http.ResponseWriter, request *http.Request)
+	DownloadArchive(writer http.ResponseWriter, request *http.Request)
+	// Push pushes a version from the podspec and archive fields of a multipart form.
+	Push(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller cocoapodspkg.Controller
+}
+
+func NewHandler(
+	controller cocoapodspkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (cocoapodspkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return cocoapodspkg.ArtifactInfo{}, e
+	}
+	return cocoapodspkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Name:         chi.URLParam(r, "name"),
+		Version:      chi.URLParam(r, "version"),
+	}, nil
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
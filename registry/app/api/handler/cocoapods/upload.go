@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	cocoapodspkg "github.com/harness/gitness/registry/app/pkg/cocoapods"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+)
+
+const (
+	// maxPushMemory is the part of a pushed version kept in memory, the rest is buffered on disk.
+	maxPushMemory = 32 << 20
+	// maxPodspecSize is the largest podspec of a version.
+	maxPodspecSize = 1 << 20
+
+	podspecField = "podspec"
+	archiveField = "archive"
+)
+
+func (h *handler) Push(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	if err := r.ParseMultipartForm(maxPushMemory); err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to parse pod: "+err.Error()), w)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	podspecFile, _, err := r.FormFile(podspecField)
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("podspec is required"), w)
+		return
+	}
+	defer podspecFile.Close()
+	podspec, err := io.ReadAll(io.LimitReader(podspecFile, maxPodspecSize+1))
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read podspec: "+err.Error()), w)
+		return
+	}
+	if len(podspec) > maxPodspecSize {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("podspec is too large"), w)
+		return
+	}
+	archive, archiveHeader, err := r.FormFile(archiveField)
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("archive is required"), w)
+		return
+	}
+	defer archive.Close()
+
+	headers, errc := h.controller.Push(ctx, info, cocoapodspkg.PushRequest{
+		Podspec:         podspec,
+		Archive:         archive,
+		ArchiveFilename: archiveHeader.Filename,
+	})
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteToResponse(w)
+}
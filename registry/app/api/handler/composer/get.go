@@ -0,0 +1,152 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	providerIncludePrefix = "provider-latest$"
+	jsonSuffix            = ".json"
+	devSuffix             = "~dev"
+)
+
+func (h *handler) GetRepository(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	repository, errc := h.controller.GetRepository(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeJSON(w, repository)
+}
+
+// GetProviderInclude serves p/provider-latest$<hash>.json, the hash is ignored as the include is
+// generated from the published versions.
+func (h *handler) GetProviderInclude(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	file, err := fileParam(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	if !strings.HasPrefix(file, providerIncludePrefix) || !strings.HasSuffix(file, jsonSuffix) {
+		h.HandleErrors(ctx, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("%s not found", file)), w)
+		return
+	}
+	content, errc := h.controller.GetProviderInclude(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeFile(w, content)
+}
+
+// GetProviderFile serves p/<vendor>/<name>$<hash>.json.
+func (h *handler) GetProviderFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	file, err := fileParam(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	name, _, _ := strings.Cut(strings.TrimSuffix(file, jsonSuffix), "$")
+	info.Image = chi.URLParam(r, "vendor") + "/" + name
+
+	content, errc := h.controller.GetProviderFile(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeFile(w, content)
+}
+
+// GetMetadataFile serves p2/<vendor>/<name>.json along with p2/<vendor>/<name>~dev.json.
+func (h *handler) GetMetadataFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	file, err := fileParam(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	name := strings.TrimSuffix(file, jsonSuffix)
+	name, info.Dev = strings.CutSuffix(name, devSuffix)
+	info.Image = chi.URLParam(r, "vendor") + "/" + name
+
+	metadataFile, errc := h.controller.GetMetadataFile(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeJSON(w, metadataFile)
+}
+
+func (h *handler) DownloadPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Version = chi.URLParam(r, "version")
+	info.Filename = chi.URLParam(r, "filename")
+
+	headers, fileReader, redirectURL, errc := h.controller.DownloadPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+info.Filename)
+	h.ServeContent(w, r, fileReader, info.Filename)
+	headers.WriteToResponse(w)
+}
+
+// writeFile writes a generated file as it is, as Composer checks it against the hash it was listed with.
+func (h *handler) writeFile(w http.ResponseWriter, content []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(content)
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	composerpkg "github.com/harness/gitness/registry/app/pkg/composer"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler interface {
+	UploadPackage(writer http.ResponseWriter, request *http.Request)
+	// GetRepository serves packages.json, which Composer reads the repository from.
+	GetRepository(writer http.ResponseWriter, request *http.Request)
+	GetProviderInclude(writer http.ResponseWriter, request *http.Request)
+	GetProviderFile(writer http.ResponseWriter, request *http.Request)
+	GetMetadataFile(writer http.ResponseWriter, request *http.Request)
+	DownloadPackage(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller composerpkg.Controller
+}
+
+func NewHandler(
+	controller composerpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo returns the artifact info of a request, the package is named by the
+// vendor and name of its path.
+func (h *handler) getPackageArtifactInfo(r *http.Request) (composerpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return composerpkg.ArtifactInfo{}, e
+	}
+	if vendor, name := chi.URLParam(r, "vendor"), chi.URLParam(r, "name"); vendor != "" && name != "" {
+		info.Image = vendor + "/" + name
+	}
+	return composerpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+	}, nil
+}
+
+// fileParam returns the file of the path, unescaped as Composer may escape the $ of hashed files.
+func fileParam(r *http.Request) (string, error) {
+	return url.PathUnescape(chi.URLParam(r, "file"))
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
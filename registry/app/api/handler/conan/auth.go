@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	conanpkg "github.com/harness/gitness/registry/app/pkg/conan"
+)
+
+func (h *handler) Ping(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("X-Conan-Server-Capabilities", conanpkg.Capabilities)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Authenticate hands the token of `conan remote login`, sent as the password, back to the client,
+// as tokens are accepted as bearer tokens too.
+func (h *handler) Authenticate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	token := requestToken(r)
+	if session, ok := request.AuthSessionFrom(ctx); !ok || auth.IsAnonymousSession(session) || token == "" {
+		h.HandleErrors(ctx, errcode.ErrCodeUnauthorized.WithMessage("a username and token are required"), w)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(token))
+}
+
+func (h *handler) CheckCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if session, ok := request.AuthSessionFrom(ctx); !ok || auth.IsAnonymousSession(session) {
+		h.HandleErrors(ctx, errcode.ErrCodeUnauthorized.WithMessage("invalid credentials"), w)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// requestToken returns the token of the Authorization header, which is the password of basic
+// auth or a bearer token.
+func requestToken(r *http.Request) string {
+	if _, password, ok := r.BasicAuth(); ok {
+		return password
+	}
+	if token, found := strings.CutPrefix(r.Header.Get(request.HeaderAuthorization), "Bearer "); found {
+		return token
+	}
+	return ""
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	conanpkg "github.com/harness/gitness/registry/app/pkg/conan"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler interface {
+	// Ping announces the capabilities of the server, which clients check before anything else.
+	Ping(writer http.ResponseWriter, request *http.Request)
+	// Authenticate returns the token the client authenticated with, which it sends from then on.
+	Authenticate(writer http.ResponseWriter, request *http.Request)
+	CheckCredentials(writer http.ResponseWriter, request *http.Request)
+	SearchRecipes(writer http.ResponseWriter, request *http.Request)
+	SearchPackages(writer http.ResponseWriter, request *http.Request)
+	GetLatestRevision(writer http.ResponseWriter, request *http.Request)
+	ListRevisions(writer http.ResponseWriter, request *http.Request)
+	ListFiles(writer http.ResponseWriter, request *http.Request)
+	DownloadFile(writer http.ResponseWriter, request *http.Request)
+	UploadFile(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller conanpkg.Controller
+}
+
+func NewHandler(
+	controller conanpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo returns the artifact info of a request along with the recipe, revisions,
+// package and file of its path, each of which is validated if it's part of the route.
+func (h *handler) getPackageArtifactInfo(r *http.Request) (conanpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return conanpkg.ArtifactInfo{}, e
+	}
+	result := conanpkg.ArtifactInfo{
+		ArtifactInfo:    &info,
+		Revision:        chi.URLParam(r, "revision"),
+		PackageID:       chi.URLParam(r, "packageID"),
+		PackageRevision: chi.URLParam(r, "packageRevision"),
+		Filename:        chi.URLParam(r, "filename"),
+	}
+	if name := chi.URLParam(r, "name"); name != "" {
+		ref, err := conan.ParseReference(name, chi.URLParam(r, "version"), chi.URLParam(r, "user"),
+			chi.URLParam(r, "channel"))
+		if err != nil {
+			return conanpkg.ArtifactInfo{}, err
+		}
+		result.Reference = ref
+		info.Image = ref.ImageName()
+	}
+	for _, revision := range []string{result.Revision, result.PackageRevision} {
+		if revision == "" {
+			continue
+		}
+		if err := conan.ValidateRevision(revision); err != nil {
+			return conanpkg.ArtifactInfo{}, err
+		}
+	}
+	if result.PackageID != "" {
+		if err := conan.ValidatePackageID(result.PackageID); err != nil {
+			return conanpkg.ArtifactInfo{}, err
+		}
+	}
+	if result.Filename != "" {
+		if err := conan.ValidateFilename(result.Filename); err != nil {
+			return conanpkg.ArtifactInfo{}, err
+		}
+	}
+	return result, nil
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
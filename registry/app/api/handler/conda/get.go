@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conda
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetChannelData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	channelData, errc := h.controller.GetChannelData(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeJSON(w, channelData)
+}
+
+// GetRepoData serves repodata.json, and current_repodata.json, which conda reads first and which
+// may list all versions, as it's meant to speed up resolving only.
+func (h *handler) GetRepoData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Subdir = chi.URLParam(r, "subdir")
+
+	repoData, errc := h.controller.GetRepoData(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeJSON(w, repoData)
+}
+
+func (h *handler) DownloadPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Subdir = chi.URLParam(r, "subdir")
+	info.Filename = chi.URLParam(r, "filename")
+
+	headers, fileReader, redirectURL, errc := h.controller.DownloadPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+info.Filename)
+	h.ServeContent(w, r, fileReader, info.Filename)
+	headers.WriteToResponse(w)
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conda
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	condapkg "github.com/harness/gitness/registry/app/pkg/conda"
+)
+
+type Handler interface {
+	UploadPackage(writer http.ResponseWriter, request *http.Request)
+	// GetChannelData serves channeldata.json, which describes the packages of the channel.
+	GetChannelData(writer http.ResponseWriter, request *http.Request)
+	// GetRepoData serves the repodata.json of a subdir, which conda resolves packages from.
+	GetRepoData(writer http.ResponseWriter, request *http.Request)
+	DownloadPackage(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller condapkg.Controller
+}
+
+func NewHandler(
+	controller condapkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (condapkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return condapkg.ArtifactInfo{}, e
+	}
+	return condapkg.ArtifactInfo{
+		ArtifactInfo: &info,
+	}, nil
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cran
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	cranpkg "github.com/harness/gitness/registry/app/pkg/cran"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetSourceFile(w http.ResponseWriter, r *http.Request) {
+	h.getFile(w, r, "src/"+chi.URLParam(r, "*"))
+}
+
+func (h *handler) GetBinaryFile(w http.ResponseWriter, r *http.Request) {
+	h.getFile(w, r, "bin/"+chi.URLParam(r, "*"))
+}
+
+func (h *handler) getFile(w http.ResponseWriter, r *http.Request, filePath string) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	file, err := parseFilePath(&info, filePath)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	if file == cranpkg.FilePackages || file == cranpkg.FilePackagesGz {
+		h.getIndex(w, r, info, file == cranpkg.FilePackagesGz)
+		return
+	}
+
+	info.Filename = file
+	headers, fileReader, redirectURL, errc := h.controller.DownloadPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, info.Filename)
+}
+
+func (h *handler) getIndex(w http.ResponseWriter, r *http.Request, info cranpkg.ArtifactInfo, compressed bool) {
+	ctx := r.Context()
+	index, errc := h.controller.GetIndex(ctx, info, compressed)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	contentType := "text/plain; charset=utf-8"
+	if compressed {
+		contentType = "application/gzip"
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(index)
+}
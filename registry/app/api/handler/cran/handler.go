@@ -0,0 +1,91 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cran
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/cran"
+	cranpkg "github.com/harness/gitness/registry/app/pkg/cran"
+)
+
+// archiveDir is the directory of the source tree which CRAN keeps the previous versions of
+// packages in, as Archive/<package>/<file>.
+const archiveDir = "Archive"
+
+type Handler interface {
+	// UploadPackage publishes the archive in the body of the request to the tree in its path.
+	UploadPackage(writer http.ResponseWriter, request *http.Request)
+	// GetSourceFile serves the PACKAGES indices and the archives of the src/contrib tree.
+	GetSourceFile(writer http.ResponseWriter, request *http.Request)
+	// GetBinaryFile serves the PACKAGES indices and the archives of the binary trees under bin/.
+	GetBinaryFile(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller cranpkg.Controller
+}
+
+func NewHandler(
+	controller cranpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (cranpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return cranpkg.ArtifactInfo{}, e
+	}
+	return cranpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+	}, nil
+}
+
+// parseFilePath parses the path of a file of a tree, returning the file requested. Archives of
+// the source tree can also be requested under src/contrib/Archive/<package>.
+func parseFilePath(info *cranpkg.ArtifactInfo, filePath string) (string, error) {
+	dir, file := path.Split(filePath)
+	dir = strings.TrimSuffix(dir, "/")
+	if archive, found := strings.CutPrefix(dir, cran.SourceTree+"/"+archiveDir+"/"); found &&
+		!strings.Contains(archive, "/") {
+		dir = cran.SourceTree
+	}
+	tree, err := cran.ParseTree(dir)
+	if err != nil {
+		return "", err
+	}
+	if file == "" {
+		return "", fmt.Errorf("%s isn't a file of a tree", filePath)
+	}
+	info.Tree = tree
+	return file, nil
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
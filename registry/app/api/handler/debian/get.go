@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	debianpkg "github.com/harness/gitness/registry/app/pkg/debian"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetDistributionFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	file, err := parseDistributionPath(&info, chi.URLParam(r, "*"))
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+
+	var content []byte
+	var errc errcode.Error
+	contentType := "text/plain; charset=utf-8"
+	switch {
+	case info.Component == "" && (file == debianpkg.FileRelease || file == debianpkg.FileInRelease ||
+		file == debianpkg.FileReleaseGPG):
+		content, errc = h.controller.GetReleaseFile(ctx, info, file)
+	case info.Component != "" && file == debianpkg.FilePackages:
+		content, errc = h.controller.GetPackages(ctx, info, false)
+	case info.Component != "" && file == debianpkg.FilePackagesGz:
+		content, errc = h.controller.GetPackages(ctx, info, true)
+		contentType = "application/gzip"
+	default:
+		errc = errcode.ErrCodeNameUnknown.WithMessage(file + " not found")
+	}
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(content)
+}
+
+// DownloadPackage serves a .deb of the pool, which is looked up by its filename.
+func (h *handler) DownloadPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Filename = path.Base(chi.URLParam(r, "*"))
+
+	headers, fileReader, redirectURL, errc := h.controller.DownloadPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+info.Filename)
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	h.ServeContent(w, r, fileReader, info.Filename)
+	headers.WriteToResponse(w)
+}
+
+// GetPublicKey serves the key the Release files are signed with.
+func (h *handler) GetPublicKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	key, errc := h.controller.GetPublicKey(ctx)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pgp-keys")
+	_, _ = w.Write(key)
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/debian"
+	debianpkg "github.com/harness/gitness/registry/app/pkg/debian"
+)
+
+type Handler interface {
+	UploadPackage(writer http.ResponseWriter, request *http.Request)
+	// GetDistributionFile serves the Release files and the Packages indices under dists/.
+	GetDistributionFile(writer http.ResponseWriter, request *http.Request)
+	DownloadPackage(writer http.ResponseWriter, request *http.Request)
+	GetPublicKey(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller debianpkg.Controller
+}
+
+func NewHandler(
+	controller debianpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (debianpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return debianpkg.ArtifactInfo{}, e
+	}
+	return debianpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+	}, nil
+}
+
+// parseDistributionPath parses a path under dists/, which is either <distribution>/<release file>
+// or <distribution>/<component>/binary-<architecture>/<index>, returning the file requested.
+func parseDistributionPath(info *debianpkg.ArtifactInfo, path string) (string, error) {
+	parts := strings.Split(path, "/")
+	switch {
+	case len(parts) == 2:
+		info.Distribution = parts[0]
+	case len(parts) == 4 && strings.HasPrefix(parts[2], "binary-"):
+		info.Distribution, info.Component = parts[0], parts[1]
+		info.Architecture = strings.TrimPrefix(parts[2], "binary-")
+		if err := debian.ValidateDistribution(info.Component); err != nil {
+			return "", err
+		}
+		if err := debian.ValidateDistribution(info.Architecture); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("%s isn't a file of a distribution", path)
+	}
+	if err := debian.ValidateDistribution(info.Distribution); err != nil {
+		return "", err
+	}
+	return parts[len(parts)-1], nil
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
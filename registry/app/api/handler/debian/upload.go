@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/debian"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// UploadPackage handles PUT upload/<distribution>/<component> with the .deb as the body. The
+// .deb is buffered to a temporary file to read its control file before it's stored.
+func (h *handler) UploadPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Distribution = chi.URLParam(r, "distribution")
+	info.Component = chi.URLParam(r, "component")
+	for _, name := range []string{info.Distribution, info.Component} {
+		if err := debian.ValidateDistribution(name); err != nil {
+			h.HandleErrors(ctx, invalidRequest(err), w)
+			return
+		}
+	}
+
+	tmp, err := os.CreateTemp("", "registry-deb-*")
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	size, err := io.Copy(tmp, r.Body)
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read package: "+err.Error()), w)
+		return
+	}
+	if size == 0 {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("package is required"), w)
+		return
+	}
+
+	headers, errc := h.controller.UploadPackage(ctx, info, tmp, size)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteToResponse(w)
+}
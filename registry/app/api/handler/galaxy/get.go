@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"net/http"
+
+	galaxymetadata "github.com/harness/gitness/registry/app/metadata/galaxy"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetAPIVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	versions, errc := h.controller.GetAPIVersions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, versions)
+}
+
+func (h *handler) GetCollection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	collection, errc := h.controller.GetCollection(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, collection)
+}
+
+func (h *handler) ListVersions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	limit, offset, err := pageParams(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	versions, errc := h.controller.ListVersions(ctx, info, limit, offset)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, versions)
+}
+
+func (h *handler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	version, errc := h.controller.GetVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, version)
+}
+
+func (h *handler) DownloadArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	filename := chi.URLParam(r, "file")
+	info.Namespace, info.Name, info.Version, err = galaxymetadata.ParseArchiveFilename(filename)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	headers, fileReader, redirectURL, errc := h.controller.DownloadArchive(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, filename)
+}
+
+func (h *handler) GetImportTask(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	task, errc := h.controller.GetImportTask(ctx, info, chi.URLParam(r, "task"))
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, task)
+}
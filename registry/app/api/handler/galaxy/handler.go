@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	galaxypkg "github.com/harness/gitness/registry/app/pkg/galaxy"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// defaultPageSize is the number of versions listed unless clients ask for another limit.
+const defaultPageSize = 100
+
+// Handler serves Ansible Galaxy registries with version 3 of the Galaxy API, which
+// ansible-galaxy installs and publishes collections with.
+type Handler interface {
+	GetAPIVersions(writer http.ResponseWriter, request *http.Request)
+	GetCollection(writer http.ResponseWriter, request *http.Request)
+	ListVersions(writer http.ResponseWriter, request *http.Request)
+	GetVersion(writer http.ResponseWriter, request *http.Request)
+	// DownloadArchive serves the archive of a version, named as namespace-name-version.tar.gz.
+	DownloadArchive(writer http.ResponseWriter, request *http.Request)
+	// Upload publishes a version from the archive posted in the file field of a multipart form.
+	Upload(writer http.ResponseWriter, request *http.Request)
+	GetImportTask(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller galaxypkg.Controller
+}
+
+func NewHandler(
+	controller galaxypkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo returns the info of a request along with the collection and version in
+// its path.
+func (h *handler) getPackageArtifactInfo(r *http.Request) (galaxypkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return galaxypkg.ArtifactInfo{}, e
+	}
+	return galaxypkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Namespace:    chi.URLParam(r, "namespace"),
+		Name:         chi.URLParam(r, "name"),
+		Version:      chi.URLParam(r, "version"),
+	}, nil
+}
+
+// handleErrors writes errors as the Galaxy API does, for ansible-galaxy to show their title and
+// detail.
+func handleErrors(ctx context.Context, err errcode.Error, w http.ResponseWriter) {
+	if commons.IsEmptyError(err) {
+		return
+	}
+	detail := err.Message
+	if err.Detail != nil {
+		detail = fmt.Sprintf("%s: %v", detail, err.Detail)
+	}
+	status := err.Code.Descriptor().HTTPStatusCode
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   err.Code.Descriptor().Value,
+			"title":  err.Code.Descriptor().Message,
+			"detail": detail,
+		}},
+	})
+	log.Ctx(ctx).Error().Msgf("Error occurred while performing artifact action: %s", err.Message)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
+
+// pageParams returns the limit and the offset of a page of a list.
+func pageParams(r *http.Request) (int, int, error) {
+	limit, offset := defaultPageSize, 0
+	var err error
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil {
+			return 0, 0, fmt.Errorf("invalid limit %q", value)
+		}
+	}
+	if value := r.URL.Query().Get("offset"); value != "" {
+		if offset, err = strconv.Atoi(value); err != nil {
+			return 0, 0, fmt.Errorf("invalid offset %q", value)
+		}
+	}
+	return limit, offset, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+)
+
+const (
+	// archiveField is the field of the multipart form clients post the archive in.
+	archiveField = "file"
+	// checksumField is the field holding the sha256 checksum of the archive.
+	checksumField = "sha256"
+	// maxChecksumSize bounds the checksum field read from the form.
+	maxChecksumSize = 256
+)
+
+// Upload streams the archive to a temporary file, as it's read again to parse its manifest. The
+// archive is imported once uploaded, so clients find its import task completed.
+func (h *handler) Upload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	reader, err := r.MultipartReader()
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+
+	tmp, err := os.CreateTemp("", "registry-galaxy-*")
+	if err != nil {
+		handleErrors(ctx, errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	var size int64
+	var checksum string
+	found := false
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			handleErrors(ctx, invalidRequest(err), w)
+			return
+		}
+		switch part.FormName() {
+		case archiveField:
+			if size, err = io.Copy(tmp, part); err != nil {
+				handleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read archive: "+err.Error()), w)
+				return
+			}
+			found = true
+		case checksumField:
+			value, err := io.ReadAll(io.LimitReader(part, maxChecksumSize))
+			if err != nil {
+				handleErrors(ctx, invalidRequest(err), w)
+				return
+			}
+			checksum = string(value)
+		}
+	}
+	if !found {
+		handleErrors(ctx, invalidRequest(errors.New("archive not found in form field "+archiveField)), w)
+		return
+	}
+
+	task, errc := h.controller.Upload(ctx, info, tmp, size, checksum)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, task)
+}
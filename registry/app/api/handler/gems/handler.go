@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gems
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/gems"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler interface {
+	PushGem(writer http.ResponseWriter, request *http.Request)
+	GetNames(writer http.ResponseWriter, request *http.Request)
+	GetVersions(writer http.ResponseWriter, request *http.Request)
+	GetInfo(writer http.ResponseWriter, request *http.Request)
+	DownloadGem(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller gems.Controller
+}
+
+func NewHandler(
+	controller gems.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (gems.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return gems.ArtifactInfo{}, e
+	}
+	info.Image = chi.URLParam(r, "name")
+
+	return gems.ArtifactInfo{
+		ArtifactInfo: &info,
+		Filename:     chi.URLParam(r, "filename"),
+	}, nil
+}
+
+// writeText writes a file of the compact index.
+func (h *handler) writeText(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(body))
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -31,6 +31,7 @@ import (
 	artifact2 "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/generic"
 
@@ -47,7 +48,7 @@ const (
 func NewGenericArtifactHandler(
 	spaceStore corestore.SpaceStore, controller *generic.Controller, tokenStore corestore.TokenStore,
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator, urlProvider urlprovider.Provider,
-	authorizer authz.Authorizer,
+	authorizer authz.Authorizer, blobServer *blobserve.Server,
 ) *Handler {
 	return &Handler{
 		Controller:    controller,
@@ -57,6 +58,7 @@ func NewGenericArtifactHandler(
 		Authenticator: authenticator,
 		URLProvider:   urlProvider,
 		Authorizer:    authorizer,
+		BlobServer:    blobServer,
 	}
 }
 
@@ -68,6 +70,7 @@ type Handler struct {
 	Authenticator authn.Authenticator
 	URLProvider   urlprovider.Provider
 	Authorizer    authz.Authorizer
+	BlobServer    *blobserve.Server
 }
 
 func (h *Handler) GetArtifactInfo(r *http.Request) (pkg.GenericArtifactInfo, errcode.Error) {
@@ -121,6 +124,7 @@ func (h *Handler) GetArtifactInfo(r *http.Request) (pkg.GenericArtifactInfo, err
 				RootIdentifier: rootIdentifier,
 				RootParentID:   rootSpace.ID,
 				ParentID:       registry.ParentID,
+				StoragePrefix:  registry.StoragePrefix,
 			},
 			RegIdentifier: registryIdentifier,
 			Image:         artifact,
@@ -129,6 +133,7 @@ func (h *Handler) GetArtifactInfo(r *http.Request) (pkg.GenericArtifactInfo, err
 		Version:     tag,
 		FileName:    fileName,
 		Description: description,
+		CreateOnly:  commons.IsCreateOnly(r),
 	}
 
 	log.Ctx(ctx).Info().Msgf("Dispatch: URI: %s", path)
@@ -142,6 +147,14 @@ func (h *Handler) GetArtifactInfo(r *http.Request) (pkg.GenericArtifactInfo, err
 		return pkg.GenericArtifactInfo{}, errcode.ErrCodeRegNotFound.WithDetail(err)
 	}
 
+	if (r.Method == http.MethodGet || r.Method == http.MethodHead) && info.Version == generic.LatestVersion {
+		version, err := h.Controller.ResolveLatestVersion(ctx, registry, *info)
+		if err != nil {
+			return pkg.GenericArtifactInfo{}, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		info.Version = version
+	}
+
 	if !commons.IsEmpty(info.Image) && !commons.IsEmpty(info.Version) && !commons.IsEmpty(info.FileName) {
 		flag, err2 := utils.MatchArtifactFilter(registry.AllowedPattern, registry.BlockedPattern,
 			info.Image+":"+info.Version+":"+info.FileName)
@@ -155,7 +168,9 @@ func (h *Handler) GetArtifactInfo(r *http.Request) (pkg.GenericArtifactInfo, err
 
 // ExtractPathVars extracts registry,image, reference, digest and tag from the path
 // Path format: /generic/:rootSpace/:registry/:image/:tag (for ex:
-// /generic/myRootSpace/reg1/alpine/v1).
+// /generic/myRootSpace/reg1/alpine/v1), with the filename in the path or a form parameter.
+// Downloads of the latest tag, e.g. /generic/myRootSpace/reg1/alpine/latest/alpine.tar.gz, resolve
+// to the latest version of the artifact.
 func ExtractPathVars(r *http.Request) (rootIdentifier, registry, artifact,
 	tag, fileName string, description string, err error) {
 	path := r.URL.Path
@@ -192,14 +207,18 @@ func ExtractPathVars(r *http.Request) (rootIdentifier, registry, artifact,
 		tag = segments[1]
 		fileName = segments[2]
 	} else {
-		segments := strings.SplitN(artifactPath, "/", 2)
+		segments := strings.SplitN(artifactPath, "/", 3)
 		if len(segments) < 2 {
 			return "", "", "", "", "", "", fmt.Errorf("invalid artifact format: %s", artifactPath)
 		}
 		artifact = segments[0]
 		tag = segments[1]
 
-		fileName = r.FormValue("filename")
+		if len(segments) == 3 {
+			fileName = segments[2]
+		} else {
+			fileName = r.FormValue("filename")
+		}
 		if fileName == "" {
 			return "", "", "", "", "", "", fmt.Errorf("filename not provided in path or form parameter")
 		}
@@ -0,0 +1,51 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractPathVars(t *testing.T) {
+	tests := []struct {
+		path     string
+		artifact string
+		tag      string
+		fileName string
+	}{
+		{"/generic/acme/files/cli:1.0.0:cli.tar.gz", "cli", "1.0.0", "cli.tar.gz"},
+		{"/generic/acme/files/cli:latest:cli.tar.gz", "cli", "latest", "cli.tar.gz"},
+		{"/generic/acme/files/cli/latest/cli.tar.gz", "cli", "latest", "cli.tar.gz"},
+		{"/generic/acme/files/cli/1.0.0?filename=cli.tar.gz", "cli", "1.0.0", "cli.tar.gz"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		root, registry, artifact, tag, fileName, _, err := ExtractPathVars(r)
+		require.NoError(t, err, tt.path)
+		assert.Equal(t, "acme", root)
+		assert.Equal(t, "files", registry)
+		assert.Equal(t, tt.artifact, artifact, tt.path)
+		assert.Equal(t, tt.tag, tag, tt.path)
+		assert.Equal(t, tt.fileName, fileName, tt.path)
+	}
+
+	_, _, _, _, _, _, err := ExtractPathVars(httptest.NewRequest(http.MethodGet, "/generic/acme/files/cli/latest", nil))
+	assert.Error(t, err, "the filename is required")
+}
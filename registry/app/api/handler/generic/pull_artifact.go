@@ -15,12 +15,11 @@
 package generic
 
 import (
+	"io"
 	"net/http"
-	"time"
 
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
-	"github.com/harness/gitness/registry/app/storage"
 )
 
 func (h *Handler) PullArtifact(w http.ResponseWriter, r *http.Request) {
@@ -46,9 +45,9 @@ func (h *Handler) PullArtifact(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) serveContent(
-	w http.ResponseWriter, r *http.Request, fileReader *storage.FileReader, info pkg.GenericArtifactInfo,
+	w http.ResponseWriter, r *http.Request, fileReader io.ReadSeeker, info pkg.GenericArtifactInfo,
 ) {
 	if fileReader != nil {
-		http.ServeContent(w, r, info.FileName, time.Time{}, fileReader)
+		h.BlobServer.ServeContent(w, r, info.FileName, fileReader)
 	}
 }
@@ -19,6 +19,7 @@ import (
 	"net/http"
 
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 )
 
@@ -38,10 +39,10 @@ func (h *Handler) PushArtifact(w http.ResponseWriter, r *http.Request) {
 	}
 	ctx := r.Context()
 	defer file.Close()
-	headers, sha256, err := h.Controller.UploadArtifact(ctx, info, file)
+	headers, fileInfo, err := h.Controller.UploadArtifact(ctx, info, file)
 	if commons.IsEmptyError(err) {
 		headers.WriteToResponse(w)
-		_, err := w.Write([]byte(fmt.Sprintf("Pushed.\nSha256: %s", sha256)))
+		_, err := w.Write([]byte(pushMessage(fileInfo)))
 		if err != nil {
 			handleErrors(r.Context(), errcode.ErrCodeUnknown.WithDetail(err), w)
 			return
@@ -49,3 +50,13 @@ func (h *Handler) PushArtifact(w http.ResponseWriter, r *http.Request) {
 	}
 	handleErrors(r.Context(), err, w)
 }
+
+// pushMessage reports the content of a pushed file, and the bytes that weren't stored again when
+// the content was deduplicated.
+func pushMessage(fileInfo pkg.FileInfo) string {
+	message := fmt.Sprintf("Pushed.\nSha256: %s", fileInfo.Sha256)
+	if fileInfo.Deduplicated {
+		message += fmt.Sprintf("\n%d bytes deduplicated", fileInfo.Size)
+	}
+	return message
+}
@@ -0,0 +1,29 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/pkg"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushMessage(t *testing.T) {
+	assert.Equal(t, "Pushed.\nSha256: abc", pushMessage(pkg.FileInfo{Sha256: "abc", Size: 7}))
+	assert.Equal(t, "Pushed.\nSha256: abc\n7 bytes deduplicated",
+		pushMessage(pkg.FileInfo{Sha256: "abc", Size: 7, Deduplicated: true}))
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	gomodulepkg "github.com/harness/gitness/registry/app/pkg/gomodule"
+)
+
+func (h *handler) GetFile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, file, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+
+	switch file {
+	case "list":
+		versions, errc := h.controller.ListVersions(ctx, info)
+		if !commons.IsEmptyError(errc) {
+			h.HandleErrors(ctx, errc, w)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(versions))
+		return
+	case fileLatest:
+		latest, errc := h.controller.GetLatest(ctx, info)
+		if !commons.IsEmptyError(errc) {
+			h.HandleErrors(ctx, errc, w)
+			return
+		}
+		h.writeJSON(w, latest)
+		return
+	}
+
+	version, extension, err := splitFile(file)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Version = version
+
+	switch extension {
+	case ".info":
+		versionInfo, errc := h.controller.GetInfo(ctx, info)
+		if !commons.IsEmptyError(errc) {
+			h.HandleErrors(ctx, errc, w)
+			return
+		}
+		h.writeJSON(w, versionInfo)
+	case gomodulepkg.ExtensionMod, gomodulepkg.ExtensionZip:
+		h.downloadFile(w, r, info, extension)
+	default:
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("unsupported file "+file), w)
+	}
+}
+
+func (h *handler) downloadFile(w http.ResponseWriter, r *http.Request, info gomodulepkg.ArtifactInfo,
+	extension string) {
+	ctx := r.Context()
+	headers, fileReader, redirectURL, errc := h.controller.DownloadFile(ctx, info, extension)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	filename := info.Version + extension
+	if extension == gomodulepkg.ExtensionZip {
+		w.Header().Set("Content-Disposition", "attachment; filename="+path.Base(info.Image)+"-"+filename)
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	h.ServeContent(w, r, fileReader, filename)
+	headers.WriteToResponse(w)
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/gomodule"
+	gomodulepkg "github.com/harness/gitness/registry/app/pkg/gomodule"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// fileLatest is the file of a proxy request for the latest version of a module.
+const fileLatest = "@latest"
+
+type Handler interface {
+	// GetFile serves the proxy requests under <module>/@v/ and <module>/@latest.
+	GetFile(writer http.ResponseWriter, request *http.Request)
+	PublishModule(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller gomodulepkg.Controller
+}
+
+func NewHandler(
+	controller gomodulepkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo reads the module path from the request path, returning the file requested
+// for the module: "list", "<version>.<ext>" or "@latest".
+func (h *handler) getPackageArtifactInfo(r *http.Request) (gomodulepkg.ArtifactInfo, string, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return gomodulepkg.ArtifactInfo{}, "", e
+	}
+	module, file, err := parsePath(chi.URLParam(r, "*"))
+	if err != nil {
+		return gomodulepkg.ArtifactInfo{}, "", err
+	}
+	info.Image = module
+
+	return gomodulepkg.ArtifactInfo{
+		ArtifactInfo: &info,
+	}, file, nil
+}
+
+func parsePath(path string) (string, string, error) {
+	escaped, file, found := strings.Cut(path, "/@v/")
+	if !found {
+		var latest bool
+		escaped, latest = strings.CutSuffix(path, "/"+fileLatest)
+		if !latest {
+			return "", "", fmt.Errorf("%s isn't a module proxy path", path)
+		}
+		file = fileLatest
+	}
+	module, err := gomodule.UnescapePath(escaped)
+	if err != nil {
+		return "", "", err
+	}
+	if err := gomodule.ValidatePath(module); err != nil {
+		return "", "", err
+	}
+	return module, file, nil
+}
+
+// splitFile splits the file of a version into the unescaped version and the extension.
+func splitFile(file string) (string, string, error) {
+	dot := strings.LastIndex(file, ".")
+	if dot <= 0 {
+		return "", "", fmt.Errorf("%s isn't a file of a module version", file)
+	}
+	version, err := gomodule.UnescapePath(file[:dot])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid version %s: %w", file[:dot], err)
+	}
+	return version, file[dot:], nil
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
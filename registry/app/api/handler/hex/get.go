@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	hexpkg "github.com/harness/gitness/registry/app/pkg/hex"
+	"github.com/harness/gitness/registry/app/storage"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetPublicKey(w http.ResponseWriter, r *http.Request) {
+	h.serveResource(w, r, "application/x-pem-file", h.controller.GetPublicKey)
+}
+
+func (h *handler) GetNames(w http.ResponseWriter, r *http.Request) {
+	h.serveResource(w, r, "application/octet-stream", h.controller.GetNames)
+}
+
+func (h *handler) GetVersions(w http.ResponseWriter, r *http.Request) {
+	h.serveResource(w, r, "application/octet-stream", h.controller.GetVersions)
+}
+
+func (h *handler) GetPackage(w http.ResponseWriter, r *http.Request) {
+	h.serveResource(w, r, "application/octet-stream", h.controller.GetPackage)
+}
+
+func (h *handler) DownloadTarball(w http.ResponseWriter, r *http.Request) {
+	h.serveFile(w, r, ".tar", h.controller.DownloadTarball)
+}
+
+func (h *handler) DownloadDocs(w http.ResponseWriter, r *http.Request) {
+	h.serveFile(w, r, ".tar.gz", h.controller.DownloadDocs)
+}
+
+func (h *handler) GetPackageInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r, "")
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	packageInfo, errc := h.controller.GetPackageInfo(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeAPI(w, r, http.StatusOK, packageInfo)
+}
+
+func (h *handler) GetReleaseInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r, "")
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	releaseInfo, errc := h.controller.GetReleaseInfo(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeAPI(w, r, http.StatusOK, releaseInfo)
+}
+
+// serveResource serves a resource of the repository, which clients verify rather than cache by
+// their headers.
+func (h *handler) serveResource(
+	w http.ResponseWriter,
+	r *http.Request,
+	contentType string,
+	get func(ctx context.Context, info hexpkg.ArtifactInfo) ([]byte, errcode.Error),
+) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r, "")
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	resource, errc := get(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(resource)
+}
+
+func (h *handler) serveFile(
+	w http.ResponseWriter,
+	r *http.Request,
+	extension string,
+	download func(ctx context.Context, info hexpkg.ArtifactInfo) (
+		*commons.ResponseHeaders, *storage.FileReader, string, errcode.Error),
+) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r, extension)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	headers, fileReader, redirectURL, errc := download(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, chi.URLParam(r, "file"))
+}
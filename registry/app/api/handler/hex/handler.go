@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/hex"
+	hexpkg "github.com/harness/gitness/registry/app/pkg/hex"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler serves hex registries both as repositories, which mix and rebar3 fetch packages from,
+// and as the API they publish packages with.
+type Handler interface {
+	GetPublicKey(writer http.ResponseWriter, request *http.Request)
+	GetNames(writer http.ResponseWriter, request *http.Request)
+	GetVersions(writer http.ResponseWriter, request *http.Request)
+	GetPackage(writer http.ResponseWriter, request *http.Request)
+	// DownloadTarball serves a release tarball, named as name-version.tar.
+	DownloadTarball(writer http.ResponseWriter, request *http.Request)
+	// DownloadDocs serves the documentation of a release, named as name-version.tar.gz.
+	DownloadDocs(writer http.ResponseWriter, request *http.Request)
+	GetPackageInfo(writer http.ResponseWriter, request *http.Request)
+	GetReleaseInfo(writer http.ResponseWriter, request *http.Request)
+	// Publish publishes a release from the tarball in the body of the request.
+	Publish(writer http.ResponseWriter, request *http.Request)
+	PublishDocs(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller hexpkg.Controller
+}
+
+func NewHandler(
+	controller hexpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo returns the info of a request along with the package and version in its
+// path, or in the file it requests without the extension.
+func (h *handler) getPackageArtifactInfo(r *http.Request, extension string) (hexpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return hexpkg.ArtifactInfo{}, e
+	}
+	name, version := chi.URLParam(r, "name"), chi.URLParam(r, "version")
+	if extension != "" {
+		file, hasExtension := strings.CutSuffix(chi.URLParam(r, "file"), extension)
+		// Names of packages can't contain a dash, which versions can.
+		var ok bool
+		if name, version, ok = strings.Cut(file, "-"); !hasExtension || !ok {
+			return hexpkg.ArtifactInfo{}, fmt.Errorf("file isn't named as name-version%s", extension)
+		}
+	}
+	return hexpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Name:         name,
+		Version:      version,
+	}, nil
+}
+
+// writeAPI writes a response of the API, in the external term format clients request it in, or
+// in JSON.
+func (h *handler) writeAPI(w http.ResponseWriter, r *http.Request, code int, v any) {
+	if !strings.Contains(r.Header.Get("Accept"), hex.TermContentType) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(v)
+		return
+	}
+	term, err := hex.EncodeTerm(v)
+	if err != nil {
+		h.HandleErrors(r.Context(), errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	w.Header().Set("Content-Type", hex.TermContentType)
+	w.WriteHeader(code)
+	_, _ = w.Write(term)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package homebrew
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/homebrew"
+	homebrewpkg "github.com/harness/gitness/registry/app/pkg/homebrew"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Handler serves Homebrew registries. brew downloads bottles from the root_url of the bottle
+// blocks of the formulae of a tap, which is filled from the formulae the registry generates.
+type Handler interface {
+	// GetFormula serves the formula of the latest version of a formula, as Formula/name.rb.
+	GetFormula(writer http.ResponseWriter, request *http.Request)
+	// GetIndex describes the formulae of the registry in the formula JSON format of brew.
+	GetIndex(writer http.ResponseWriter, request *http.Request)
+	// GetTapArchive serves a gzipped tarball of the formulae of the registry, which taps are filled
+	// from.
+	GetTapArchive(writer http.ResponseWriter, request *http.Request)
+	// DownloadBottle serves a bottle, named as name--version.tag.bottle.tar.gz as brew downloads it.
+	DownloadBottle(writer http.ResponseWriter, request *http.Request)
+	// UploadBottle stores the bottle in the request body, built for the cellar query parameter.
+	UploadBottle(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller homebrewpkg.Controller
+}
+
+func NewHandler(
+	controller homebrewpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (homebrewpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return homebrewpkg.ArtifactInfo{}, e
+	}
+	return homebrewpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Name:         strings.TrimSuffix(chi.URLParam(r, "formula"), ".rb"),
+		Cellar:       r.URL.Query().Get(homebrewpkg.CellarParam),
+	}, nil
+}
+
+// getBottleArtifactInfo reads the name, the version, the tag and the rebuild of a bottle from its
+// filename, which brew escapes the name in, such as python%403.12--3.12.4.arm64_sonoma.bottle.tar.gz.
+func (h *handler) getBottleArtifactInfo(r *http.Request) (homebrewpkg.ArtifactInfo, error) {
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		return info, err
+	}
+	filename, err := url.PathUnescape(chi.URLParam(r, "filename"))
+	if err != nil {
+		return info, err
+	}
+	info.Name, info.Version, info.Tag, info.Rebuild, err = homebrew.ParseBottleFilename(filename)
+	if err != nil {
+		return info, err
+	}
+	info.Filename = filename
+	return info, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
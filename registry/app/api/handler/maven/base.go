@@ -32,6 +32,7 @@ import (
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/maven"
 	"github.com/harness/gitness/registry/request"
@@ -46,11 +47,13 @@ type Handler struct {
 	UserCtrl      *usercontroller.Controller
 	Authenticator authn.Authenticator
 	Authorizer    authz.Authorizer
+	BlobServer    *blobserve.Server
 }
 
 func NewHandler(
 	controller *maven.Controller, spaceStore corestore.SpaceStore, tokenStore corestore.TokenStore,
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator, authorizer authz.Authorizer,
+	blobServer *blobserve.Server,
 ) *Handler {
 	return &Handler{
 		Controller:    controller,
@@ -59,6 +62,7 @@ func NewHandler(
 		UserCtrl:      userCtrl,
 		Authenticator: authenticator,
 		Authorizer:    authorizer,
+		BlobServer:    blobServer,
 	}
 }
 
@@ -117,14 +121,17 @@ func (h *Handler) GetArtifactInfo(r *http.Request, remoteSupport bool) (pkg.Mave
 			RootIdentifier: rootIdentifier,
 			RootParentID:   rootSpace.ID,
 			ParentID:       registry.ParentID,
+			StoragePrefix:  registry.StoragePrefix,
 		},
-		RegIdentifier: registryIdentifier,
-		RegistryID:    registry.ID,
-		GroupID:       groupID,
-		ArtifactID:    artifactID,
-		Version:       version,
-		FileName:      fileName,
-		Path:          r.URL.Path,
+		RegIdentifier:  registryIdentifier,
+		RegistryID:     registry.ID,
+		GroupID:        groupID,
+		ArtifactID:     artifactID,
+		Version:        version,
+		FileName:       fileName,
+		Path:           r.URL.Path,
+		CreateOnly:     commons.IsCreateOnly(r),
+		StrictMetadata: registry.MavenStrictMetadata,
 	}
 
 	log.Ctx(ctx).Info().Msgf("Dispatch: URI: %s", path)
@@ -16,9 +16,7 @@ package maven
 
 import (
 	"errors"
-	"io"
 	"net/http"
-	"time"
 
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
@@ -76,10 +74,10 @@ func (h *Handler) serveContent(
 	w http.ResponseWriter, r *http.Request, response *maven.GetArtifactResponse, info pkg.MavenArtifactInfo,
 ) {
 	if response.Body != nil {
-		http.ServeContent(w, r, info.FileName, time.Time{}, response.Body)
+		h.BlobServer.ServeContent(w, r, info.FileName, response.Body)
 	} else {
 		w.Header().Set("Transfer-Encoding", "chunked")
-		_, err2 := io.Copy(w, response.ReadCloser)
+		_, err2 := h.BlobServer.Copy(w, r, response.ReadCloser, -1)
 		if err2 != nil {
 			response.Errors = append(response.Errors, errors.New("error copying file to response"))
 			log.Ctx(r.Context()).Error().Msg("error copying file to response:")
@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	modelpkg "github.com/harness/gitness/registry/app/pkg/model"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// frameworkParam is the query parameter the framework of a model is uploaded with.
+	frameworkParam = "framework"
+	// uploadOffsetHeader carries the size of the content of a resumable upload so far, which is
+	// where its next chunk starts.
+	uploadOffsetHeader = "Upload-Offset"
+)
+
+// Handler serves ML model registries, whose versions hold the files of a model. Files too large
+// to upload in a single request are uploaded in chunks, resuming where an interrupted upload
+// stopped.
+type Handler interface {
+	DownloadFile(writer http.ResponseWriter, request *http.Request)
+	// UploadFile stores the file in the request body, with the framework query parameter if set.
+	UploadFile(writer http.ResponseWriter, request *http.Request)
+	// StartUpload starts a resumable upload of a file, linking to the upload with Location.
+	StartUpload(writer http.ResponseWriter, request *http.Request)
+	// GetUploadOffset serves the Upload-Offset a resumable upload continues from.
+	GetUploadOffset(writer http.ResponseWriter, request *http.Request)
+	// AppendUpload appends the chunk in the request body to a resumable upload, at the position
+	// in its Upload-Offset header.
+	AppendUpload(writer http.ResponseWriter, request *http.Request)
+	// CompleteUpload adds the content of a resumable upload as the file, with the framework query
+	// parameter if set.
+	CompleteUpload(writer http.ResponseWriter, request *http.Request)
+	CancelUpload(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller modelpkg.Controller
+}
+
+func NewHandler(
+	controller modelpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (modelpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return modelpkg.ArtifactInfo{}, e
+	}
+	return modelpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Name:         chi.URLParam(r, "name"),
+		Version:      chi.URLParam(r, "version"),
+		Filename:     chi.URLParam(r, "filename"),
+		Framework:    r.URL.Query().Get(frameworkParam),
+	}, nil
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+)
+
+// GetPackage serves the package document of a package, or one of its tarballs.
+func (h *handler) GetPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage(err.Error()), w)
+		return
+	}
+
+	if info.Filename != "" {
+		headers, fileReader, redirectURL, err := h.controller.DownloadPackageFile(ctx, info)
+		if !commons.IsEmptyError(err) {
+			h.HandleErrors(ctx, err, w)
+			return
+		}
+		w.Header().Set("Content-Disposition", "attachment; filename="+info.Filename)
+		if redirectURL != "" {
+			http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+			return
+		}
+		h.ServeContent(w, r, fileReader, info.Filename)
+		headers.WriteToResponse(w)
+		return
+	}
+
+	packageMetadata, errc := h.controller.GetPackageMetadata(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(packageMetadata); err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeUnknown.WithDetail(err), w)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/pkg/npm"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler interface {
+	GetPackage(writer http.ResponseWriter, request *http.Request)
+	PublishPackage(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller npm.Controller
+}
+
+func NewHandler(
+	controller npm.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (npm.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return npm.ArtifactInfo{}, e
+	}
+
+	name, filename, err := ParsePackagePath(chi.URLParam(r, "*"))
+	if err != nil {
+		return npm.ArtifactInfo{}, err
+	}
+	info.Image = name
+
+	return npm.ArtifactInfo{
+		ArtifactInfo: &info,
+		Filename:     filename,
+	}, nil
+}
+
+// ParsePackagePath splits the path of an npm request into the package name and, for tarball
+// downloads, the tarball name. The client escapes the slash of scoped packages in metadata
+// requests ("@scope%2fname"), but not in tarball URLs ("@scope/name/-/name-1.0.0.tgz").
+func ParsePackagePath(p string) (name string, filename string, err error) {
+	p, err = url.PathUnescape(p)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid package path: %w", err)
+	}
+	p = strings.Trim(p, "/")
+	if i := strings.Index(p, "/-/"); i >= 0 {
+		name, filename = p[:i], p[i+len("/-/"):]
+		if filename == "" || strings.Contains(filename, "/") {
+			return "", "", fmt.Errorf("invalid tarball path: %s", p)
+		}
+	} else {
+		name = p
+	}
+
+	slashes := strings.Count(name, "/")
+	if name == "" || (strings.HasPrefix(name, "@") && slashes != 1) || (!strings.HasPrefix(name, "@") && slashes != 0) {
+		return "", "", fmt.Errorf("invalid package name: %s", name)
+	}
+	return name, filename, nil
+}
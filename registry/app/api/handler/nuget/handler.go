@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	nugetmetadata "github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/pkg/nuget"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler interface {
+	GetServiceIndex(writer http.ResponseWriter, request *http.Request)
+	PushPackage(writer http.ResponseWriter, request *http.Request)
+	ListPackageVersions(writer http.ResponseWriter, request *http.Request)
+	GetRegistrationIndex(writer http.ResponseWriter, request *http.Request)
+	GetRegistrationLeaf(writer http.ResponseWriter, request *http.Request)
+	DownloadPackageFile(writer http.ResponseWriter, request *http.Request)
+	GetServiceDocument(writer http.ResponseWriter, request *http.Request)
+	GetServiceMetadata(writer http.ResponseWriter, request *http.Request)
+	ListPackages(writer http.ResponseWriter, request *http.Request)
+	SearchPackages(writer http.ResponseWriter, request *http.Request)
+	FindPackagesByID(writer http.ResponseWriter, request *http.Request)
+	GetPackage(writer http.ResponseWriter, request *http.Request)
+	DownloadPackage(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller nuget.Controller
+}
+
+func NewHandler(
+	controller nuget.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo reads the package id, version and file name of the request. Clients send
+// them lowercase, the version is normalized as well so it matches the stored one.
+func (h *handler) getPackageArtifactInfo(r *http.Request) (nuget.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return nuget.ArtifactInfo{}, e
+	}
+
+	id := strings.ToLower(chi.URLParam(r, "id"))
+	if id != "" {
+		if err := nugetmetadata.ValidatePackageID(id); err != nil {
+			return nuget.ArtifactInfo{}, err
+		}
+	}
+	info.Image = id
+
+	v := chi.URLParam(r, "version")
+	// registration leaves are named after the version, chi can't split "{version}.json" on the dot
+	if leaf := chi.URLParam(r, "leaf"); leaf != "" {
+		if !strings.HasSuffix(leaf, ".json") {
+			return nuget.ArtifactInfo{}, fmt.Errorf("invalid registration leaf %s", leaf)
+		}
+		v = strings.TrimSuffix(leaf, ".json")
+	}
+	var version string
+	if v != "" {
+		normalized, err := nugetmetadata.NormalizeVersion(v)
+		if err != nil {
+			return nuget.ArtifactInfo{}, err
+		}
+		version = strings.ToLower(normalized)
+	}
+
+	return nuget.ArtifactInfo{
+		ArtifactInfo: &info,
+		Version:      version,
+		Filename:     strings.ToLower(chi.URLParam(r, "filename")),
+	}, nil
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, r *http.Request, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		h.HandleErrors(r.Context(), errcode.ErrCodeUnknown.WithDetail(err), w)
+	}
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+)
+
+// maxPushMemory is the part of a pushed package kept in memory, the rest is buffered on disk.
+const maxPushMemory = 32 << 20
+
+// GetServiceIndex serves the service index the client discovers the feed resources from.
+func (h *handler) GetServiceIndex(w http.ResponseWriter, r *http.Request) {
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(r.Context(), invalidRequest(err), w)
+		return
+	}
+	h.writeJSON(w, r, h.controller.GetServiceIndex(r.Context(), info))
+}
+
+// PushPackage handles `dotnet nuget push`, which sends the .nupkg as the only file of a multipart form.
+func (h *handler) PushPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	if err := r.ParseMultipartForm(maxPushMemory); err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to parse package: "+err.Error()), w)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+	for _, headers := range r.MultipartForm.File {
+		if len(headers) == 0 {
+			continue
+		}
+		file, err := headers[0].Open()
+		if err != nil {
+			h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read package: "+err.Error()), w)
+			return
+		}
+		defer file.Close()
+
+		responseHeaders, errc := h.controller.PushPackage(ctx, info, file, headers[0].Size)
+		if !commons.IsEmptyError(errc) {
+			h.HandleErrors(ctx, errc, w)
+			return
+		}
+		responseHeaders.WriteToResponse(w)
+		return
+	}
+	h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("package file is required"), w)
+}
@@ -0,0 +1,206 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	nugetmetadata "github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/nuget"
+)
+
+const xmlContentType = "application/atom+xml; charset=utf-8"
+
+// idFilterPattern matches the $filter clients send to look a package up by id.
+var idFilterPattern = regexp.MustCompile(`^(?:tolower\(Id\)|Id) eq '([^']+)'$`)
+
+// GetServiceDocument serves the root of the V2 feed Chocolatey and older NuGet clients use.
+func (h *handler) GetServiceDocument(w http.ResponseWriter, r *http.Request) {
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(r.Context(), invalidRequest(err), w)
+		return
+	}
+	h.writeXML(w, r, "application/xml; charset=utf-8", h.controller.GetServiceDocument(r.Context(), info))
+}
+
+// GetServiceMetadata serves the $metadata document of the V2 feed.
+func (h *handler) GetServiceMetadata(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	_, _ = w.Write([]byte(nuget.ServiceMetadata))
+}
+
+// ListPackages serves the Packages() collection, and its $count, of the V2 feed.
+func (h *handler) ListPackages(w http.ResponseWriter, r *http.Request) {
+	query, err := searchQuery(r)
+	if err != nil {
+		h.HandleErrors(r.Context(), invalidRequest(err), w)
+		return
+	}
+	query.IncludePrerelease = true
+	h.writeFeed(w, r, query)
+}
+
+// SearchPackages serves the Search() function, and its $count, of the V2 feed, which backs
+// `choco search` and `choco install`.
+func (h *handler) SearchPackages(w http.ResponseWriter, r *http.Request) {
+	query, err := searchQuery(r)
+	if err != nil {
+		h.HandleErrors(r.Context(), invalidRequest(err), w)
+		return
+	}
+	query.Term = unquote(r.URL.Query().Get("searchTerm"))
+	query.IncludePrerelease = r.URL.Query().Get("includePrerelease") == "true"
+	h.writeFeed(w, r, query)
+}
+
+// FindPackagesByID serves the FindPackagesById() function, and its $count, of the V2 feed, which
+// lists all versions of a package.
+func (h *handler) FindPackagesByID(w http.ResponseWriter, r *http.Request) {
+	query, err := searchQuery(r)
+	if err != nil {
+		h.HandleErrors(r.Context(), invalidRequest(err), w)
+		return
+	}
+	query.ID = unquote(r.URL.Query().Get("id"))
+	if err := nugetmetadata.ValidatePackageID(query.ID); err != nil {
+		h.HandleErrors(r.Context(), invalidRequest(err), w)
+		return
+	}
+	query.IncludePrerelease = true
+	h.writeFeed(w, r, query)
+}
+
+// GetPackage serves a single version of a package of the V2 feed.
+func (h *handler) GetPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	entry, errc := h.controller.GetPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeXML(w, r, xmlContentType, entry)
+}
+
+// DownloadPackage serves the .nupkg of a version from the content link of its V2 feed entry.
+func (h *handler) DownloadPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Filename = nugetmetadata.PackageFilename(info.Image, info.Version)
+	headers, fileReader, redirectURL, errc := h.controller.DownloadPackageFile(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+info.Filename)
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	h.ServeContent(w, r, fileReader, info.Filename)
+	headers.WriteToResponse(w)
+}
+
+// writeFeed writes the feed of the packages matching the query, or the number of them if the
+// request is for the $count of the collection.
+func (h *handler) writeFeed(w http.ResponseWriter, r *http.Request, query nuget.SearchQuery) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	countOnly := strings.HasSuffix(r.URL.Path, "/$count")
+	if countOnly {
+		query.Skip, query.Top = 0, 0
+	}
+	feed, errc := h.controller.SearchPackages(ctx, info, query)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if countOnly {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(strconv.Itoa(feed.Count)))
+		return
+	}
+	h.writeXML(w, r, xmlContentType, feed)
+}
+
+func (h *handler) writeXML(w http.ResponseWriter, r *http.Request, contentType string, v any) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		h.HandleErrors(r.Context(), errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(data)
+}
+
+// searchQuery reads the OData options of a request to the V2 feed. Of the $filter expressions
+// only the ones clients send to look up the latest versions or a package by id are supported.
+func searchQuery(r *http.Request) (nuget.SearchQuery, error) {
+	values := r.URL.Query()
+	query := nuget.SearchQuery{}
+	var err error
+	if skip := values.Get("$skip"); skip != "" {
+		if query.Skip, err = strconv.Atoi(skip); err != nil || query.Skip < 0 {
+			return query, fmt.Errorf("invalid $skip %s", skip)
+		}
+	}
+	if top := values.Get("$top"); top != "" {
+		if query.Top, err = strconv.Atoi(top); err != nil || query.Top < 0 {
+			return query, fmt.Errorf("invalid $top %s", top)
+		}
+	}
+	filter := strings.TrimSpace(values.Get("$filter"))
+	switch {
+	case filter == "":
+	case filter == "IsLatestVersion":
+		query.Latest = true
+	case filter == "IsAbsoluteLatestVersion":
+		query.AbsoluteLatest = true
+	case idFilterPattern.MatchString(filter):
+		query.ID = idFilterPattern.FindStringSubmatch(filter)[1]
+		if err := nugetmetadata.ValidatePackageID(query.ID); err != nil {
+			return query, err
+		}
+	default:
+		return query, fmt.Errorf("unsupported $filter %s", filter)
+	}
+	return query, nil
+}
+
+// unquote removes the quotes around a string parameter of an OData function.
+func unquote(s string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "'"), "'")
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/harness/gitness/registry/app/dist_temp/dcontext"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/docker"
 	"github.com/harness/gitness/registry/request"
@@ -45,7 +46,7 @@ func NewHandler(
 	controller *docker.Controller, spaceFinder refcache.SpaceFinder, spaceStore corestore.SpaceStore,
 	tokenStore corestore.TokenStore,
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator, urlProvider urlprovider.Provider,
-	authorizer authz.Authorizer, ociRelativeURL bool,
+	authorizer authz.Authorizer, ociRelativeURL bool, blobServer *blobserve.Server,
 ) *Handler {
 	return &Handler{
 		Controller:     controller,
@@ -57,6 +58,7 @@ func NewHandler(
 		URLProvider:    urlProvider,
 		Authorizer:     authorizer,
 		OCIRelativeURL: ociRelativeURL,
+		BlobServer:     blobServer,
 	}
 }
 
@@ -70,6 +72,7 @@ type Handler struct {
 	URLProvider    urlprovider.Provider
 	Authorizer     authz.Authorizer
 	OCIRelativeURL bool
+	BlobServer     *blobserve.Server
 }
 
 type routeType string
@@ -225,6 +228,7 @@ func (h *Handler) GetRegistryInfo(r *http.Request, remoteSupport bool) (pkg.Regi
 				RootIdentifier: rootIdentifier,
 				RootParentID:   rootSpace.ID,
 				ParentID:       registry.ParentID,
+				StoragePrefix:  registry.StoragePrefix,
 			},
 			RegIdentifier: registryIdentifier,
 			Image:         image,
@@ -235,6 +239,7 @@ func (h *Handler) GetRegistryInfo(r *http.Request, remoteSupport bool) (pkg.Regi
 		URLBuilder:  v2.NewURLBuilderFromRequest(r, h.OCIRelativeURL),
 		Path:        r.URL.Path,
 		PackageType: registry.PackageType,
+		IfMatch:     r.Header.Get(commons.HeaderIfMatch),
 	}
 
 	log.Ctx(ctx).Info().Msgf("Dispatch: URI: %s", path)
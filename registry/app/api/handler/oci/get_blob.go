@@ -17,9 +17,7 @@ package oci
 import (
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"time"
 
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
@@ -84,10 +82,10 @@ func (h *Handler) serveContent(
 	w http.ResponseWriter, r *http.Request, response *docker.GetBlobResponse, info pkg.RegistryInfo,
 ) {
 	if response.Body != nil {
-		http.ServeContent(w, r, info.Digest, time.Time{}, response.Body)
+		h.BlobServer.ServeContent(w, r, info.Digest, response.Body)
 	} else {
 		// Use io.CopyN to avoid out of memory when pulling big blob
-		written, err2 := io.CopyN(w, response.ReadCloser, response.Size)
+		written, err2 := h.BlobServer.Copy(w, r, response.ReadCloser, response.Size)
 		if err2 != nil {
 			response.Errors = append(response.Errors, errors.New("error copying blob to response"))
 			log.Ctx(r.Context()).Error().Msg("error copying blob to response:")
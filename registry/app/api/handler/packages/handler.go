@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 
 	usercontroller "github.com/harness/gitness/app/api/controller/user"
 	"github.com/harness/gitness/app/auth/authn"
@@ -29,6 +28,7 @@ import (
 	artifact2 "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
@@ -41,7 +41,7 @@ func NewHandler(
 	registryDao store.RegistryRepository,
 	spaceStore corestore.SpaceStore, tokenStore corestore.TokenStore,
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator,
-	urlProvider urlprovider.Provider, authorizer authz.Authorizer,
+	urlProvider urlprovider.Provider, authorizer authz.Authorizer, blobServer *blobserve.Server,
 ) Handler {
 	return &handler{
 		RegistryDao:   registryDao,
@@ -51,6 +51,7 @@ func NewHandler(
 		Authenticator: authenticator,
 		URLProvider:   urlProvider,
 		Authorizer:    authorizer,
+		BlobServer:    blobServer,
 	}
 }
 
@@ -62,6 +63,7 @@ type handler struct {
 	Authenticator authn.Authenticator
 	URLProvider   urlprovider.Provider
 	Authorizer    authz.Authorizer
+	BlobServer    *blobserve.Server
 }
 
 type Handler interface {
@@ -81,15 +83,59 @@ type Handler interface {
 type PathPackageType string
 
 const (
-	PathPackageTypeGeneric PathPackageType = "generic"
-	PathPackageTypeMaven   PathPackageType = "maven"
-	PathPackageTypePython  PathPackageType = "python"
+	PathPackageTypeGeneric   PathPackageType = "generic"
+	PathPackageTypeMaven     PathPackageType = "maven"
+	PathPackageTypePython    PathPackageType = "python"
+	PathPackageTypeNpm       PathPackageType = "npm"
+	PathPackageTypeNuget     PathPackageType = "nuget"
+	PathPackageTypeGems      PathPackageType = "rubygems"
+	PathPackageTypeCargo     PathPackageType = "cargo"
+	PathPackageTypeGo        PathPackageType = "go"
+	PathPackageTypeDebian    PathPackageType = "debian"
+	PathPackageTypeRpm       PathPackageType = "rpm"
+	PathPackageTypeAlpine    PathPackageType = "alpine"
+	PathPackageTypeConan     PathPackageType = "conan"
+	PathPackageTypeComposer  PathPackageType = "composer"
+	PathPackageTypeConda     PathPackageType = "conda"
+	PathPackageTypeTerraform PathPackageType = "terraform"
+	PathPackageTypeSwift     PathPackageType = "swift"
+	PathPackageTypeCocoapods PathPackageType = "cocoapods"
+	PathPackageTypeHex       PathPackageType = "hex"
+	PathPackageTypePub       PathPackageType = "pub"
+	PathPackageTypeCran      PathPackageType = "cran"
+	PathPackageTypeGalaxy    PathPackageType = "galaxy"
+	PathPackageTypePuppet    PathPackageType = "puppet"
+	PathPackageTypeVagrant   PathPackageType = "vagrant"
+	PathPackageTypeModel     PathPackageType = "model"
+	PathPackageTypeHomebrew  PathPackageType = "homebrew"
 )
 
 var packageTypeMap = map[PathPackageType]artifact2.PackageType{
-	PathPackageTypeGeneric: artifact2.PackageTypeGENERIC,
-	PathPackageTypeMaven:   artifact2.PackageTypeMAVEN,
-	PathPackageTypePython:  artifact2.PackageTypePYTHON,
+	PathPackageTypeGeneric:   artifact2.PackageTypeGENERIC,
+	PathPackageTypeMaven:     artifact2.PackageTypeMAVEN,
+	PathPackageTypePython:    artifact2.PackageTypePYTHON,
+	PathPackageTypeNpm:       artifact2.PackageTypeNPM,
+	PathPackageTypeNuget:     artifact2.PackageTypeNUGET,
+	PathPackageTypeGems:      artifact2.PackageTypeGEMS,
+	PathPackageTypeCargo:     artifact2.PackageTypeCRATE,
+	PathPackageTypeGo:        artifact2.PackageTypeGO,
+	PathPackageTypeDebian:    artifact2.PackageTypeDEB,
+	PathPackageTypeRpm:       artifact2.PackageTypeRPM,
+	PathPackageTypeAlpine:    artifact2.PackageTypeALPINE,
+	PathPackageTypeConan:     artifact2.PackageTypeCONAN,
+	PathPackageTypeComposer:  artifact2.PackageTypeCOMPOSER,
+	PathPackageTypeConda:     artifact2.PackageTypeCONDA,
+	PathPackageTypeTerraform: artifact2.PackageTypeTERRAFORM,
+	PathPackageTypeSwift:     artifact2.PackageTypeSWIFT,
+	PathPackageTypeCocoapods: artifact2.PackageTypeCOCOAPODS,
+	PathPackageTypeHex:       artifact2.PackageTypeHEX,
+	PathPackageTypePub:       artifact2.PackageTypePUB,
+	PathPackageTypeCran:      artifact2.PackageTypeCRAN,
+	PathPackageTypeGalaxy:    artifact2.PackageTypeGALAXY,
+	PathPackageTypePuppet:    artifact2.PackageTypePUPPET,
+	PathPackageTypeVagrant:   artifact2.PackageTypeVAGRANT,
+	PathPackageTypeModel:     artifact2.PackageTypeMODEL,
+	PathPackageTypeHomebrew:  artifact2.PackageTypeHOMEBREW,
 }
 
 func (h *handler) GetAuthenticator() authn.Authenticator {
@@ -141,6 +187,7 @@ func (h *handler) GetArtifactInfo(r *http.Request) (pkg.ArtifactInfo, errcode.Er
 			RootIdentifier:  rootIdentifier,
 			RootParentID:    rootSpace.ID,
 			ParentID:        registry.ParentID,
+			StoragePrefix:   registry.StoragePrefix,
 			PathPackageType: pathPackageType,
 		},
 		RegIdentifier: registryIdentifier,
@@ -181,6 +228,6 @@ func (h *handler) ServeContent(
 	w http.ResponseWriter, r *http.Request, fileReader *storage.FileReader, filename string,
 ) {
 	if fileReader != nil {
-		http.ServeContent(w, r, filename, time.Time{}, fileReader)
+		h.BlobServer.ServeContent(w, r, filename, fileReader)
 	}
 }
@@ -0,0 +1,82 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	pubmetadata "github.com/harness/gitness/registry/app/metadata/pub"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetPackage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	listing, errc := h.controller.GetPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, listing)
+}
+
+func (h *handler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	version, errc := h.controller.GetVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, version)
+}
+
+func (h *handler) DownloadArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	version, ok := strings.CutSuffix(chi.URLParam(r, "file"), ".tar.gz")
+	if !ok {
+		handleErrors(ctx, invalidRequest(errors.New("file isn't named as version.tar.gz")), w)
+		return
+	}
+	info.Version = version
+	headers, fileReader, redirectURL, errc := h.controller.DownloadArchive(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, pubmetadata.ArchiveFilename(info.Name, info.Version))
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	pubpkg "github.com/harness/gitness/registry/app/pkg/pub"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// contentType is the media type of version 2 of the hosted repository API, which clients
+// request in their Accept header.
+const contentType = "application/vnd.pub.v2+json"
+
+// Handler serves pub registries as hosted repositories, which dart pub resolves and publishes
+// packages with.
+type Handler interface {
+	GetPackage(writer http.ResponseWriter, request *http.Request)
+	GetVersion(writer http.ResponseWriter, request *http.Request)
+	// DownloadArchive serves the archive of a version, named as version.tar.gz.
+	DownloadArchive(writer http.ResponseWriter, request *http.Request)
+	NewUpload(writer http.ResponseWriter, request *http.Request)
+	// Upload publishes a version from the archive posted in the file field of a multipart form.
+	Upload(writer http.ResponseWriter, request *http.Request)
+	FinalizeUpload(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller pubpkg.Controller
+}
+
+func NewHandler(
+	controller pubpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo returns the info of a request along with the package and version in its
+// path, or in its query once an upload is finalized.
+func (h *handler) getPackageArtifactInfo(r *http.Request) (pubpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return pubpkg.ArtifactInfo{}, e
+	}
+	name, version := chi.URLParam(r, "package"), chi.URLParam(r, "version")
+	if name == "" {
+		name, version = r.URL.Query().Get("package"), r.URL.Query().Get("version")
+	}
+	return pubpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Name:         name,
+		Version:      version,
+	}, nil
+}
+
+// handleErrors writes errors as the hosted repository API does, for clients to show their message.
+func handleErrors(ctx context.Context, err errcode.Error, w http.ResponseWriter) {
+	if commons.IsEmptyError(err) {
+		return
+	}
+	message := err.Message
+	if err.Detail != nil {
+		message = fmt.Sprintf("%s: %v", message, err.Detail)
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(err.Code.Descriptor().HTTPStatusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]string{
+			"code":    err.Code.Descriptor().Value,
+			"message": message,
+		},
+	})
+	log.Ctx(ctx).Error().Msgf("Error occurred while performing artifact action: %s", err.Message)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", contentType)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+)
+
+// archiveField is the field of the multipart form clients post the archive in.
+const archiveField = "file"
+
+func (h *handler) NewUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	uploadURL, errc := h.controller.NewUpload(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, uploadURL)
+}
+
+// Upload streams the archive to a temporary file, as it's read twice to parse its pubspec.
+func (h *handler) Upload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	reader, err := r.MultipartReader()
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+
+	tmp, err := os.CreateTemp("", "registry-pub-*")
+	if err != nil {
+		handleErrors(ctx, errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	var size int64
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			handleErrors(ctx, invalidRequest(errors.New("archive not found in form field "+archiveField)), w)
+			return
+		}
+		if err != nil {
+			handleErrors(ctx, invalidRequest(err), w)
+			return
+		}
+		if part.FormName() != archiveField {
+			continue
+		}
+		if size, err = io.Copy(tmp, part); err != nil {
+			handleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read archive: "+err.Error()), w)
+			return
+		}
+		break
+	}
+
+	headers, errc := h.controller.Upload(ctx, info, tmp, size)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteToResponse(w)
+}
+
+func (h *handler) FinalizeUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	response, errc := h.controller.FinalizeUpload(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, response)
+}
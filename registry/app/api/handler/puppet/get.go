@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"net/http"
+
+	puppetmetadata "github.com/harness/gitness/registry/app/metadata/puppet"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *handler) GetModule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Owner, info.Name, err = puppetmetadata.SplitSlug(chi.URLParam(r, "module"))
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	module, errc := h.controller.GetModule(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, module)
+}
+
+func (h *handler) ListReleases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Owner, info.Name, err = puppetmetadata.SplitSlug(r.URL.Query().Get("module"))
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	limit, offset, err := pageParams(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	releases, errc := h.controller.ListReleases(ctx, info, limit, offset)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, releases)
+}
+
+func (h *handler) GetRelease(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	info.Owner, info.Name, info.Version, err = puppetmetadata.ParseReleaseSlug(chi.URLParam(r, "release"))
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	release, errc := h.controller.GetRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusOK, release)
+}
+
+func (h *handler) DownloadArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	filename := chi.URLParam(r, "file")
+	info.Owner, info.Name, info.Version, err = puppetmetadata.ParseArchiveFilename(filename)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	headers, fileReader, redirectURL, errc := h.controller.DownloadArchive(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, filename)
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	puppetpkg "github.com/harness/gitness/registry/app/pkg/puppet"
+
+	"github.com/rs/zerolog/log"
+)
+
+// defaultPageSize is the number of releases listed unless clients ask for another limit, as on
+// the Forge.
+const defaultPageSize = 20
+
+// Handler serves Puppet registries with version 3 of the Forge API, which puppet module install
+// and r10k resolve modules with.
+type Handler interface {
+	// GetModule describes a module, identified by its slug such as puppetlabs-stdlib.
+	GetModule(writer http.ResponseWriter, request *http.Request)
+	// ListReleases lists the releases of the module in the module query parameter.
+	ListReleases(writer http.ResponseWriter, request *http.Request)
+	// GetRelease describes a release, identified by its slug such as puppetlabs-stdlib-9.0.0.
+	GetRelease(writer http.ResponseWriter, request *http.Request)
+	// DownloadArchive serves the archive of a release, named as owner-name-version.tar.gz.
+	DownloadArchive(writer http.ResponseWriter, request *http.Request)
+	// Publish publishes a release from the archive posted in the file field of a multipart form,
+	// or base64 encoded in the file field of a JSON object.
+	Publish(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller puppetpkg.Controller
+}
+
+func NewHandler(
+	controller puppetpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (puppetpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return puppetpkg.ArtifactInfo{}, e
+	}
+	return puppetpkg.ArtifactInfo{ArtifactInfo: &info}, nil
+}
+
+// handleErrors writes errors as the Forge API does, for puppet to show their message.
+func handleErrors(ctx context.Context, err errcode.Error, w http.ResponseWriter) {
+	if commons.IsEmptyError(err) {
+		return
+	}
+	detail := err.Message
+	if err.Detail != nil {
+		detail = fmt.Sprintf("%s: %v", detail, err.Detail)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.Code.Descriptor().HTTPStatusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"message": err.Code.Descriptor().Message,
+		"errors":  []string{detail},
+	})
+	log.Ctx(ctx).Error().Msgf("Error occurred while performing artifact action: %s", err.Message)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
+
+// pageParams returns the limit and the offset of a page of a list.
+func pageParams(r *http.Request) (int, int, error) {
+	limit, offset := defaultPageSize, 0
+	var err error
+	if value := r.URL.Query().Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil {
+			return 0, 0, fmt.Errorf("invalid limit %q", value)
+		}
+	}
+	if value := r.URL.Query().Get("offset"); value != "" {
+		if offset, err = strconv.Atoi(value); err != nil {
+			return 0, 0, fmt.Errorf("invalid offset %q", value)
+		}
+	}
+	return limit, offset, nil
+}
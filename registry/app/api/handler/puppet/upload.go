@@ -0,0 +1,104 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+)
+
+// archiveField is the field clients post the archive in, as a multipart form file or a base64
+// encoded JSON string.
+const archiveField = "file"
+
+// Publish streams the archive to a temporary file, as it's read again to parse its metadata.
+// pdk posts the archive base64 encoded in a JSON object, other clients in a multipart form.
+func (h *handler) Publish(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+
+	tmp, err := os.CreateTemp("", "registry-puppet-*")
+	if err != nil {
+		handleErrors(ctx, errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var size int64
+	if strings.HasPrefix(mediaType, "multipart/") {
+		size, err = copyFormArchive(r, tmp)
+	} else {
+		size, err = copyJSONArchive(r, tmp)
+	}
+	if err != nil {
+		handleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+
+	release, errc := h.controller.Publish(ctx, info, tmp, size)
+	if !commons.IsEmptyError(errc) {
+		handleErrors(ctx, errc, w)
+		return
+	}
+	writeJSON(w, http.StatusCreated, release)
+}
+
+func copyFormArchive(r *http.Request, dst io.Writer) (int64, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return 0, errors.New("archive not found in form field " + archiveField)
+		}
+		if err != nil {
+			return 0, err
+		}
+		if part.FormName() == archiveField {
+			return io.Copy(dst, part)
+		}
+	}
+}
+
+func copyJSONArchive(r *http.Request, dst io.Writer) (int64, error) {
+	var body struct {
+		File string `json:"file"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return 0, err
+	}
+	if body.File == "" {
+		return 0, errors.New("archive not found in field " + archiveField)
+	}
+	return io.Copy(dst, base64.NewDecoder(base64.StdEncoding, strings.NewReader(body.File)))
+}
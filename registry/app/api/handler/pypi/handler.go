@@ -29,6 +29,7 @@ type Handler interface {
 	DownloadPackageFile(http.ResponseWriter, *http.Request)
 	UploadPackageFile(writer http.ResponseWriter, request *http.Request)
 	PackageMetadata(writer http.ResponseWriter, request *http.Request)
+	ListPackages(writer http.ResponseWriter, request *http.Request)
 }
 
 type handler struct {
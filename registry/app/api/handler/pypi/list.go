@@ -15,8 +15,11 @@
 package pypi
 
 import (
+	"errors"
 	"html/template"
 	"net/http"
+
+	"github.com/harness/gitness/store"
 )
 
 const HTMLTemplate = `
@@ -30,12 +33,54 @@ const HTMLTemplate = `
 		{{- /* PEP 503 – Simple Repository API: https://peps.python.org/pep-0503/ */ -}}
 		<h1>Links for {{.Name}}</h1>
 			{{range .Files}}
-				<a href="{{.FileURL}}"{{if .RequiresPython}} data-requires-python="{{.RequiresPython}}"{{end}}>{{.Name}}</a><br>
+				<a href="{{.FileURL}}"{{if .RequiresPython}} data-requires-python="{{.RequiresPython}}"{{end}}
+				{{- if .Yanked}} data-yanked=""{{end}}>{{.Name}}</a><br>
 			{{end}}
 	</body>
 </html>
 `
 
+const IndexHTMLTemplate = `
+<!DOCTYPE html>
+<html>
+	<head>
+		<meta name="pypi:repository-version" content="1.3">
+		<title>Simple index</title>
+	</head>
+	<body>
+		{{- range . }}
+			<a href="{{.URL}}">{{.Name}}</a><br>
+		{{- end }}
+	</body>
+</html>
+`
+
+// ListPackages serves the root of the simple repository API, which lists all projects.
+func (h *handler) ListPackages(w http.ResponseWriter, r *http.Request) {
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	packages, err := h.controller.ListPackages(r.Context(), info)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	tmpl, err := template.New("index").Parse(IndexHTMLTemplate)
+	if err != nil {
+		http.Error(w, "Template error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := tmpl.Execute(w, packages); err != nil {
+		http.Error(w, "Rendering error", http.StatusInternalServerError)
+	}
+}
+
 func (h *handler) PackageMetadata(w http.ResponseWriter, r *http.Request) {
 	info, err := h.getPackageArtifactInfo(r)
 	if err != nil {
@@ -49,6 +94,10 @@ func (h *handler) PackageMetadata(w http.ResponseWriter, r *http.Request) {
 	}
 
 	packageData, err := h.controller.GetPackageMetadata(r.Context(), info, info.Image)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	jsonExtension = ".json"
+	zipExtension  = ".zip"
+)
+
+func (h *handler) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if _, err := h.getPackageArtifactInfo(r); err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *handler) ListReleases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r, jsonExtension)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	releases, headers, errc := h.controller.ListReleases(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.writeJSON(w, releases)
+}
+
+func (h *handler) GetRelease(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(chi.URLParam(r, "version"), zipExtension) {
+		h.downloadSourceArchive(w, r)
+		return
+	}
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r, jsonExtension)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	release, headers, errc := h.controller.GetRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.writeJSON(w, release)
+}
+
+func (h *handler) downloadSourceArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r, zipExtension)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	headers, fileReader, redirectURL, errc := h.controller.DownloadSourceArchive(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, info.Name+"-"+info.Version+zipExtension)
+}
+
+func (h *handler) GetManifest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	manifest := swift.Manifest{SwiftVersion: r.URL.Query().Get("swift-version")}
+	headers, fileReader, redirectURL, errc := h.controller.GetManifest(ctx, info, manifest.SwiftVersion)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	if headers.Code == http.StatusSeeOther {
+		headers.WriteToResponse(w)
+		return
+	}
+	if redirectURL != "" {
+		http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
+		return
+	}
+	headers.WriteHeadersToResponse(w)
+	h.ServeContent(w, r, fileReader, manifest.Filename())
+}
+
+func (h *handler) LookupIdentifiers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	identifiers, errc := h.controller.LookupIdentifiers(ctx, info, r.URL.Query().Get("url"))
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	h.writeJSON(w, identifiers)
+}
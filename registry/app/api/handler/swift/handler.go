@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	swiftpkg "github.com/harness/gitness/registry/app/pkg/swift"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// contentVersionHeader is the header every response tells the version of the registry API in.
+	contentVersionHeader = "Content-Version"
+	apiVersion           = "1"
+	// mediaTypePrefix starts the media types clients ask for a version of the API with, such as
+	// application/vnd.swift.registry.v1+json.
+	mediaTypePrefix = "application/vnd.swift.registry"
+)
+
+type Handler interface {
+	// APIVersion rejects the requests for another version of the registry API, and tells the
+	// version of the others in their response.
+	APIVersion(next http.Handler) http.Handler
+	// Login lets clients check their credentials, which every request of the registry is
+	// authenticated with.
+	Login(writer http.ResponseWriter, request *http.Request)
+	ListReleases(writer http.ResponseWriter, request *http.Request)
+	// GetRelease serves the metadata of a release, or its source archive for a .zip version.
+	GetRelease(writer http.ResponseWriter, request *http.Request)
+	GetManifest(writer http.ResponseWriter, request *http.Request)
+	LookupIdentifiers(writer http.ResponseWriter, request *http.Request)
+	// Publish publishes a release from the parts of a multipart form.
+	Publish(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller swiftpkg.Controller
+}
+
+func NewHandler(
+	controller swiftpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) APIVersion(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentVersionHeader, apiVersion)
+		if version, ok := requestedVersion(r.Header.Get("Accept")); ok && version != apiVersion {
+			h.HandleErrors(r.Context(), errcode.ErrCodeUnsupported.WithMessage(
+				fmt.Sprintf("unsupported registry API version %s", version)), w)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// getPackageArtifactInfo returns the info of a request along with the package identifier and
+// the version in its path, without the extension of the resource asked for.
+func (h *handler) getPackageArtifactInfo(r *http.Request, extensions ...string) (swiftpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return swiftpkg.ArtifactInfo{}, e
+	}
+	name := chi.URLParam(r, "name")
+	version := chi.URLParam(r, "version")
+	for _, extension := range extensions {
+		if version == "" {
+			name = strings.TrimSuffix(name, extension)
+		} else {
+			version = strings.TrimSuffix(version, extension)
+		}
+	}
+	return swiftpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Scope:        chi.URLParam(r, "scope"),
+		Name:         name,
+		Version:      version,
+	}, nil
+}
+
+// requestedVersion returns the version of the registry API an Accept header asks for, if any.
+func requestedVersion(accept string) (string, bool) {
+	for _, mediaType := range strings.Split(accept, ",") {
+		mediaType, _, _ = strings.Cut(strings.TrimSpace(mediaType), ";")
+		rest, ok := strings.CutPrefix(mediaType, mediaTypePrefix)
+		if !ok {
+			continue
+		}
+		rest, _, _ = strings.Cut(rest, "+")
+		if version, ok := strings.CutPrefix(rest, ".v"); ok {
+			return version, true
+		}
+	}
+	return "", false
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	swiftpkg "github.com/harness/gitness/registry/app/pkg/swift"
+)
+
+const (
+	signatureFormatHeader = "X-Swift-Package-Signature-Format"
+
+	metadataPart               = "metadata"
+	sourceArchiveSignaturePart = "source-archive-signature"
+	metadataSignaturePart      = "metadata-signature"
+	// maxPartSize is the largest metadata or signature part of a release.
+	maxPartSize = 1 << 20
+)
+
+// Publish streams the parts of the form, as clients send the source archive without a filename,
+// which a parsed form would keep in memory.
+func (h *handler) Publish(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	reader, err := r.MultipartReader()
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read release: "+err.Error()), w)
+		return
+	}
+
+	tmp, err := os.CreateTemp("", "registry-swift-*")
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	release := swiftpkg.PublishRequest{
+		SourceArchive:   tmp,
+		SignatureFormat: r.Header.Get(signatureFormatHeader),
+	}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read release: "+err.Error()), w)
+			return
+		}
+		switch part.FormName() {
+		case swift.SourceArchiveResource:
+			release.Size, err = io.Copy(tmp, part)
+		case sourceArchiveSignaturePart:
+			release.Signature, err = readPart(part)
+		case metadataPart:
+			release.Metadata, err = readPart(part)
+		case metadataSignaturePart:
+			// Only the signature of the source archive is served to clients.
+			_, err = readPart(part)
+		}
+		_ = part.Close()
+		if err != nil {
+			h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read release: "+err.Error()), w)
+			return
+		}
+	}
+	if release.Size == 0 {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("source archive is required"), w)
+		return
+	}
+
+	headers, errc := h.controller.Publish(ctx, info, release)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteToResponse(w)
+}
+
+func readPart(part *multipart.Part) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(part, maxPartSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxPartSize {
+		return nil, errors.New(part.FormName() + " is too large")
+	}
+	return data, nil
+}
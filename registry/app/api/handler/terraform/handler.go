@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	terraformpkg "github.com/harness/gitness/registry/app/pkg/terraform"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler interface {
+	// GetServiceDiscovery serves the service discovery document of the registry.
+	GetServiceDiscovery(writer http.ResponseWriter, request *http.Request)
+	UploadModule(writer http.ResponseWriter, request *http.Request)
+	ListVersions(writer http.ResponseWriter, request *http.Request)
+	// GetDownloadURL answers the download request of Terraform, pointing it to the archive.
+	GetDownloadURL(writer http.ResponseWriter, request *http.Request)
+	DownloadArchive(writer http.ResponseWriter, request *http.Request)
+	// UploadProvider publishes a provider version from the files of its release in a multipart form.
+	UploadProvider(writer http.ResponseWriter, request *http.Request)
+	ListProviderVersions(writer http.ResponseWriter, request *http.Request)
+	// GetProviderPackage answers the download request of Terraform for a platform.
+	GetProviderPackage(writer http.ResponseWriter, request *http.Request)
+	DownloadProviderFile(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller terraformpkg.Controller
+}
+
+func NewHandler(
+	controller terraformpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+// getPackageArtifactInfo returns the info of a request along with the module or provider address
+// and the version in its path.
+func (h *handler) getPackageArtifactInfo(r *http.Request) (terraformpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return terraformpkg.ArtifactInfo{}, e
+	}
+	return terraformpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Namespace:    chi.URLParam(r, "namespace"),
+		Name:         chi.URLParam(r, "name"),
+		System:       chi.URLParam(r, "system"),
+		Version:      chi.URLParam(r, "version"),
+		Type:         chi.URLParam(r, "type"),
+		OS:           chi.URLParam(r, "os"),
+		Arch:         chi.URLParam(r, "arch"),
+		Filename:     chi.URLParam(r, "filename"),
+	}, nil
+}
+
+func (h *handler) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
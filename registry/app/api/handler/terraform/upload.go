@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	terraformpkg "github.com/harness/gitness/registry/app/pkg/terraform"
+)
+
+// maxReleaseMemory is the part of an uploaded provider release kept in memory, the rest is
+// buffered on disk.
+const maxReleaseMemory = 32 << 20
+
+func (h *handler) UploadModule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+
+	tmp, err := os.CreateTemp("", "registry-terraform-*")
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeUnknown.WithDetail(err), w)
+		return
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	size, err := io.Copy(tmp, r.Body)
+	if err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read module: "+err.Error()), w)
+		return
+	}
+	if size == 0 {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("module archive is required"), w)
+		return
+	}
+
+	headers, errc := h.controller.UploadModule(ctx, info, tmp, size)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	headers.WriteToResponse(w)
+}
+
+// UploadProvider reads the release files from the file fields of the form and the ASCII armored
+// public key they are signed with from its signing_key field.
+func (h *handler) UploadProvider(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	info, err := h.getPackageArtifactInfo(r)
+	if err != nil {
+		h.HandleErrors(ctx, invalidRequest(err), w)
+		return
+	}
+	if err := r.ParseMultipartForm(maxReleaseMemory); err != nil {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to parse release: "+err.Error()), w)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	headers := r.MultipartForm.File["file"]
+	if len(headers) == 0 {
+		h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("release files are required"), w)
+		return
+	}
+	files := make([]terraformpkg.ReleaseFile, 0, len(headers))
+	for _, header := range headers {
+		file, err := header.Open()
+		if err != nil {
+			h.HandleErrors(ctx, errcode.ErrCodeInvalidRequest.WithMessage("failed to read release: "+err.Error()), w)
+			return
+		}
+		defer file.Close()
+		files = append(files, terraformpkg.ReleaseFile{Filename: header.Filename, File: file, Size: header.Size})
+	}
+
+	responseHeaders, errc := h.controller.UploadProvider(ctx, info, r.FormValue("signing_key"), files)
+	if !commons.IsEmptyError(errc) {
+		h.HandleErrors(ctx, errc, w)
+		return
+	}
+	responseHeaders.WriteToResponse(w)
+}
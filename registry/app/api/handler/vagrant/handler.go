@@ -0,0 +1,101 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vagrant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	vagrantpkg "github.com/harness/gitness/registry/app/pkg/vagrant"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// Handler serves Vagrant registries. Vagrant resolves boxes named org/name from the catalog at
+// the registry URL followed by the name, as it does with the server URL it's configured with.
+type Handler interface {
+	// GetCatalog serves the catalog of a box, listing its versions and the boxes of their providers.
+	GetCatalog(writer http.ResponseWriter, request *http.Request)
+	// DownloadBox serves the box of a version for a provider, and the architecture query
+	// parameter unless the box runs on any.
+	DownloadBox(writer http.ResponseWriter, request *http.Request)
+	// UploadBox stores the box in the request body as the box of a version for a provider, and the
+	// architecture query parameter unless the box runs on any.
+	UploadBox(writer http.ResponseWriter, request *http.Request)
+}
+
+type handler struct {
+	packages.Handler
+	controller vagrantpkg.Controller
+}
+
+func NewHandler(
+	controller vagrantpkg.Controller,
+	packageHandler packages.Handler,
+) Handler {
+	return &handler{
+		Handler:    packageHandler,
+		controller: controller,
+	}
+}
+
+var _ Handler = (*handler)(nil)
+
+func (h *handler) getPackageArtifactInfo(r *http.Request) (vagrantpkg.ArtifactInfo, error) {
+	info, e := h.GetArtifactInfo(r)
+	if e.Error() != "" {
+		return vagrantpkg.ArtifactInfo{}, e
+	}
+	return vagrantpkg.ArtifactInfo{
+		ArtifactInfo: &info,
+		Org:          chi.URLParam(r, "org"),
+		Name:         chi.URLParam(r, "name"),
+		Version:      chi.URLParam(r, "version"),
+		Provider:     chi.URLParam(r, "provider"),
+		Architecture: r.URL.Query().Get(vagrantpkg.ArchitectureParam),
+	}, nil
+}
+
+// handleErrors writes errors as Vagrant Cloud does, for vagrant to show them.
+func handleErrors(ctx context.Context, err errcode.Error, w http.ResponseWriter) {
+	if commons.IsEmptyError(err) {
+		return
+	}
+	detail := err.Message
+	if err.Detail != nil {
+		detail = fmt.Sprintf("%s: %v", detail, err.Detail)
+	}
+	writeJSON(w, err.Code.Descriptor().HTTPStatusCode, map[string]any{
+		"success": false,
+		"errors":  []string{detail},
+	})
+	log.Ctx(ctx).Error().Msgf("Error occurred while performing artifact action: %s", err.Message)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func invalidRequest(err error) errcode.Error {
+	return errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf("invalid request: %s", err))
+}
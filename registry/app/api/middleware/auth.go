@@ -28,6 +28,7 @@ import (
 	"github.com/harness/gitness/registry/app/api/handler/oci"
 	registryauth "github.com/harness/gitness/registry/app/auth"
 	"github.com/harness/gitness/registry/app/common"
+	"github.com/harness/gitness/registry/utils"
 
 	"github.com/rs/zerolog/log"
 )
@@ -73,13 +74,15 @@ func BlockNonOciSourceToken(urlProvider url.Provider) func(http.Handler) http.Ha
 	}
 }
 
-func CheckAuth() func(http.Handler) http.Handler {
+// CheckAuth rejects anonymous requests, except for the paths ending with one of the given suffixes.
+func CheckAuth(anonymousPathSuffixes ...string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(
 			func(w http.ResponseWriter, r *http.Request) {
 				ctx := r.Context()
 				session, _ := request.AuthSessionFrom(ctx)
-				if session.Principal == auth.AnonymousPrincipal {
+				if session.Principal == auth.AnonymousPrincipal &&
+					!utils.HasAnySuffix(r.URL.Path, anonymousPathSuffixes) {
 					render.Unauthorized(ctx, w)
 					return
 				}
@@ -126,6 +129,36 @@ func CheckMavenAuthHeader() func(http.Handler) http.Handler {
 	}
 }
 
+// CheckNugetAPIKeyHeader authenticates the NuGet client, which sends the token configured with
+// `dotnet nuget push --api-key` in the X-NuGet-ApiKey header.
+func CheckNugetAPIKeyHeader() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if apiKey := r.Header.Get("X-NuGet-ApiKey"); apiKey != "" && r.Header.Get("Authorization") == "" {
+					r.Header.Set("Authorization", apiKey)
+				}
+				next.ServeHTTP(w, r)
+			},
+		)
+	}
+}
+
+// CheckGalaxyTokenHeader authenticates ansible-galaxy, which sends the token configured for a
+// server in the Authorization header with the Token prefix.
+func CheckGalaxyTokenHeader() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Token "); ok {
+					r.Header.Set("Authorization", token)
+				}
+				next.ServeHTTP(w, r)
+			},
+		)
+	}
+}
+
 func setMavenHeaders(w http.ResponseWriter) {
 	w.Header().Set("WWW-Authenticate", "Basic realm=\"Harness Registry\"")
 }
@@ -48,6 +48,11 @@ func (w *StatusWriter) WriteHeader(code int) {
 	w.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap returns the wrapped writer for http.ResponseController.
+func (w *StatusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (w *StatusWriter) Write(p []byte) (n int, err error) {
 	n, err = w.ResponseWriter.Write(p)
 	if w.StatusCode == 0 {
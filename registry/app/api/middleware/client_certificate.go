@@ -0,0 +1,118 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/request"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ClientCertificate stores the TLS client certificate of the request in the context, taken from the
+// TLS connection or, behind a TLS terminating proxy, from the configured header. Once a request with
+// a certificate succeeds, the pushes the certificate was verified for are recorded in the audit log.
+func ClientCertificate(header string, auditService audit.Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			chain, err := clientCertificateChain(r, header)
+			if err != nil {
+				log.Ctx(ctx).Warn().Err(err).Msgf("ignoring invalid client certificate header %s", header)
+			}
+			if len(chain) == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cert := &request.ClientCertificate{Chain: chain}
+			sw := &StatusWriter{ResponseWriter: w}
+			next.ServeHTTP(sw, r.WithContext(request.WithClientCertificate(ctx, cert)))
+			if sw.StatusCode >= http.StatusBadRequest {
+				return
+			}
+			auditClientCertificatePushes(r, cert, auditService)
+		})
+	}
+}
+
+func clientCertificateChain(r *http.Request, header string) ([]*x509.Certificate, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return r.TLS.PeerCertificates, nil
+	}
+	if header == "" || r.Header.Get(header) == "" {
+		return nil, nil
+	}
+	data, err := url.QueryUnescape(r.Header.Get(header))
+	if err != nil {
+		return nil, err
+	}
+	var chain []*x509.Certificate
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return chain, nil
+}
+
+func auditClientCertificatePushes(r *http.Request, cert *request.ClientCertificate, auditService audit.Service) {
+	ctx := r.Context()
+	audited := map[string]bool{}
+	for _, v := range cert.Verifications() {
+		key := v.SpacePath + "/" + v.RegistryName
+		if !v.Push || audited[key] {
+			continue
+		}
+		audited[key] = true
+		err := auditService.Log(
+			ctx,
+			v.Principal,
+			audit.NewResource(audit.ResourceTypeRegistry, v.RegistryName),
+			audit.ActionUpdated,
+			v.SpacePath,
+			audit.WithRequestMethod(r.Method),
+			audit.WithData(
+				"registry name", v.RegistryName,
+				"request path", r.URL.Path,
+				"client certificate", cert.Identity(),
+				"client certificate fingerprint", cert.Fingerprint(),
+			),
+		)
+		if err != nil {
+			log.Ctx(ctx).Warn().Msgf("failed to insert audit log for client certificate push: %s", err)
+		}
+	}
+}
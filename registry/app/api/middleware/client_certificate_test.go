@@ -0,0 +1,109 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingAuditService struct {
+	events []audit.Event
+}
+
+func (s *recordingAuditService) Log(
+	_ context.Context,
+	user types.Principal,
+	resource audit.Resource,
+	action audit.Action,
+	spacePath string,
+	options ...audit.Option,
+) error {
+	event := audit.Event{User: user, Resource: resource, Action: action, SpacePath: spacePath}
+	for _, o := range options {
+		o.Apply(&event)
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func selfSignedPEM(t *testing.T, name string) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestClientCertificate(t *testing.T) {
+	const header = "X-Client-Cert"
+	auditService := &recordingAuditService{}
+	status := http.StatusCreated
+	handler := ClientCertificate(header, auditService)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cert := request.ClientCertificateFrom(r.Context())
+		if cert != nil {
+			for _, push := range []bool{true, true, false} {
+				cert.AddVerification(request.ClientCertificateVerification{
+					SpacePath: "acme", RegistryName: "secure", Principal: types.Principal{UID: "ci"}, Push: push,
+				})
+			}
+		}
+		w.WriteHeader(status)
+	}))
+
+	req := httptest.NewRequest(http.MethodPut, "/pkg/acme/secure/generic/app/1.0", nil)
+	req.Header.Set(header, url.QueryEscape(selfSignedPEM(t, "ci-runner")))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Len(t, auditService.events, 1)
+	event := auditService.events[0]
+	assert.Equal(t, "secure", event.Resource.Identifier)
+	assert.Equal(t, "acme", event.SpacePath)
+	assert.Equal(t, http.MethodPut, event.RequestMethod)
+	assert.Equal(t, "CN=ci-runner", event.Data["client certificate"])
+
+	// failed requests and requests without a certificate aren't audited
+	status = http.StatusForbidden
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	status = http.StatusCreated
+	req.Header.Set(header, "garbage")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Len(t, auditService.events, 1)
+}
@@ -0,0 +1,251 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	encodingGzip     = "gzip"
+	encodingZstd     = "zstd"
+	encodingIdentity = "identity"
+
+	headerAcceptEncoding  = "Accept-Encoding"
+	headerContentEncoding = "Content-Encoding"
+	headerContentLength   = "Content-Length"
+	headerContentType     = "Content-Type"
+	headerVary            = "Vary"
+
+	// compressMinSize is the size below which responses are sent as they are,
+	// compressing them would cost more than it saves.
+	compressMinSize = 1024
+)
+
+// supportedEncodings lists the encodings in the order the server prefers them.
+var supportedEncodings = []string{encodingZstd, encodingGzip}
+
+var (
+	gzipWriters = sync.Pool{New: func() any {
+		return gzip.NewWriter(io.Discard)
+	}}
+	zstdWriters = sync.Pool{New: func() any {
+		w, _ := zstd.NewWriter(io.Discard, zstd.WithEncoderConcurrency(1))
+		return w
+	}}
+)
+
+// Compress encodes the JSON responses of the handler with zstd or gzip, depending on
+// the Accept-Encoding header of the request.
+func Compress() func(http.Handler) http.Handler {
+	return CompressPaths()
+}
+
+// CompressPaths is Compress for the requests whose path matches one of the patterns,
+// all requests are compressed if no pattern is given.
+func CompressPaths(patterns ...string) func(http.Handler) http.Handler {
+	regexps := make([]*regexp.Regexp, len(patterns))
+	for i, p := range patterns {
+		regexps[i] = regexp.MustCompile(p)
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !matchesAny(regexps, r.URL.Path) || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.Header().Add(headerVary, headerAcceptEncoding)
+			encoding := negotiateEncoding(r.Header.Values(headerAcceptEncoding))
+			if encoding == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			cw := &compressWriter{ResponseWriter: w, encoding: encoding}
+			defer func() {
+				if err := cw.Close(); err != nil {
+					log.Ctx(r.Context()).Warn().Err(err).Msgf("failed to finish %s response", encoding)
+				}
+			}()
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+func matchesAny(regexps []*regexp.Regexp, path string) bool {
+	if len(regexps) == 0 {
+		return true
+	}
+	for _, re := range regexps {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// negotiateEncoding picks the supported encoding with the highest quality in the
+// Accept-Encoding header, or returns an empty string if the response is sent as is.
+func negotiateEncoding(values []string) string {
+	qualities := map[string]float64{}
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+			q := 1.0
+			for _, param := range strings.Split(params, ";") {
+				key, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || strings.ToLower(strings.TrimSpace(key)) != "q" {
+					continue
+				}
+				parsed, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+				if err != nil {
+					parsed = 0
+				}
+				q = parsed
+			}
+			qualities[name] = q
+		}
+	}
+
+	best, bestQ := "", 0.0
+	for _, encoding := range supportedEncodings {
+		q, ok := qualities[encoding]
+		if !ok {
+			q, ok = qualities["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = encoding, q
+		}
+	}
+	if identity, ok := qualities[encodingIdentity]; ok && identity > bestQ {
+		return ""
+	}
+	return best
+}
+
+// compressWriter holds the response back until compressMinSize bytes have been written,
+// and then decides whether it is worth compressing.
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	status   int
+	buf      []byte
+	started  bool
+	encoder  io.WriteCloser
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if w.started {
+		if w.encoder != nil {
+			return w.encoder.Write(p)
+		}
+		return w.ResponseWriter.Write(p)
+	}
+	w.buf = append(w.buf, p...)
+	if len(w.buf) < compressMinSize {
+		return len(p), nil
+	}
+	if err := w.start(true); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *compressWriter) start(compress bool) error {
+	w.started = true
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	header := w.Header()
+	if compress && header.Get(headerContentEncoding) == "" && isCompressible(header.Get(headerContentType)) &&
+		w.status != http.StatusNoContent && w.status != http.StatusNotModified {
+		header.Set(headerContentEncoding, w.encoding)
+		header.Del(headerContentLength)
+		w.encoder = newEncoder(w.encoding, w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if w.encoder != nil {
+		_, err := w.encoder.Write(buf)
+		return err
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+// Close writes what is left of the response and returns the encoder to its pool.
+func (w *compressWriter) Close() error {
+	if !w.started {
+		if w.status == 0 && len(w.buf) == 0 {
+			return nil
+		}
+		if err := w.start(false); err != nil {
+			return err
+		}
+	}
+	if w.encoder == nil {
+		return nil
+	}
+	err := w.encoder.Close()
+	switch e := w.encoder.(type) {
+	case *gzip.Writer:
+		gzipWriters.Put(e)
+	case *zstd.Encoder:
+		zstdWriters.Put(e)
+	}
+	w.encoder = nil
+	return err
+}
+
+func newEncoder(encoding string, w io.Writer) io.WriteCloser {
+	if encoding == encodingZstd {
+		e, _ := zstdWriters.Get().(*zstd.Encoder)
+		e.Reset(w)
+		return e
+	}
+	e, _ := gzipWriters.Get().(*gzip.Writer)
+	e.Reset(w)
+	return e
+}
+
+func isCompressible(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	return mediaType == "" || strings.HasPrefix(mediaType, "text/") ||
+		mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
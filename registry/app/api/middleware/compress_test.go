@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", ""},
+		{"gzip", encodingGzip},
+		{"gzip, deflate, br, zstd", encodingZstd},
+		{"zstd;q=0.5, gzip", encodingGzip},
+		{"gzip;q=0, zstd;q=0", ""},
+		{"*", encodingZstd},
+		{"*;q=0.1, identity", ""},
+		{"deflate", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, negotiateEncoding([]string{tt.header}), tt.header)
+	}
+}
+
+func TestCompressPaths(t *testing.T) {
+	large := strings.Repeat(`{"name":"artifact"},`, 500)
+	handler := CompressPaths("/artifacts$")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("small") != "" {
+			_, _ = io.WriteString(w, "{}")
+			return
+		}
+		_, _ = io.WriteString(w, large)
+	}))
+
+	get := func(path, acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("/registry/r/artifacts", "gzip")
+	assert.Equal(t, encodingGzip, rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+	gr, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	assert.Equal(t, large, string(body))
+
+	rec = get("/registry/r/artifacts", "zstd")
+	assert.Equal(t, encodingZstd, rec.Header().Get("Content-Encoding"))
+	zr, err := zstd.NewReader(rec.Body)
+	require.NoError(t, err)
+	body, err = io.ReadAll(zr)
+	zr.Close()
+	require.NoError(t, err)
+	assert.Equal(t, large, string(body))
+
+	rec = get("/registry/r/artifacts?small=1", "gzip")
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, "{}", rec.Body.String())
+
+	rec = get("/registry/r", "gzip")
+	assert.Empty(t, rec.Header().Get("Content-Encoding"))
+	assert.Equal(t, large, rec.Body.String())
+}
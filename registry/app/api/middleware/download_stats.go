@@ -18,19 +18,29 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
 
 	"github.com/harness/gitness/registry/app/api/handler/generic"
 	"github.com/harness/gitness/registry/app/api/handler/maven"
 	"github.com/harness/gitness/registry/app/api/handler/oci"
 	"github.com/harness/gitness/registry/app/api/router/utils"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/dist_temp/requestutil"
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/docker"
 	generic2 "github.com/harness/gitness/registry/app/pkg/generic"
 	maven2 "github.com/harness/gitness/registry/app/pkg/maven"
 	mavenutils "github.com/harness/gitness/registry/app/pkg/maven/utils"
+	registryrequest "github.com/harness/gitness/registry/request"
 	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/origin"
+	"github.com/harness/gitness/registry/utils/useragent"
 	"github.com/harness/gitness/store"
 
 	"github.com/opencontainers/go-digest"
@@ -68,7 +78,7 @@ func TrackDownloadStat(h *oci.Handler) func(http.Handler) http.Handler {
 					return
 				}
 
-				err = dbDownloadStat(ctx, h.Controller, info)
+				err = dbDownloadStat(ctx, h.Controller, info, newDownloadStat(r))
 				if err != nil {
 					log.Ctx(ctx).Error().Stack().Str("middleware",
 						"TrackDownloadStat").Err(err).Msgf("error while putting download stat of artifact, %v",
@@ -84,12 +94,21 @@ func dbDownloadStat(
 	ctx context.Context,
 	c *docker.Controller,
 	info pkg.RegistryInfo,
+	downloadStat *types.DownloadStat,
 ) error {
 	registry, err := c.RegistryDao.GetByParentIDAndName(ctx, info.ParentID, info.RegIdentifier)
 	if err != nil {
 		return err
 	}
 
+	if info.Tag != "" {
+		err = c.DBStore.TagDao.UpdateLastPulledAt(ctx, registry.ID, info.Image, info.Tag, time.Now())
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to update last pulled time of tag %s:%s",
+				info.Image, info.Tag)
+		}
+	}
+
 	image, err := c.DBStore.ImageDao.GetByName(ctx, registry.ID, info.Image)
 	if errors.Is(err, store.ErrResourceNotFound) {
 		image, err = getImageFromUpstreamProxy(ctx, c, info)
@@ -108,11 +127,9 @@ func dbDownloadStat(
 		return err
 	}
 
-	downloadStat := &types.DownloadStat{
-		ArtifactID: artifact.ID,
-	}
+	downloadStat.ArtifactID = artifact.ID
 
-	if err := c.DBStore.DownloadStatDao.Create(ctx, downloadStat); err != nil {
+	if err := c.DBStore.DownloadStatRecorder.Record(ctx, downloadStat); err != nil {
 		return err
 	}
 	return nil
@@ -133,7 +150,8 @@ func TrackDownloadStatForGenericArtifact(h *generic.Handler) func(http.Handler)
 					return
 				}
 
-				if sw.StatusCode != http.StatusOK && sw.StatusCode != http.StatusTemporaryRedirect {
+				if sw.StatusCode != http.StatusOK && sw.StatusCode != http.StatusTemporaryRedirect &&
+					sw.StatusCode != http.StatusPartialContent {
 					return
 				}
 
@@ -145,7 +163,7 @@ func TrackDownloadStatForGenericArtifact(h *generic.Handler) func(http.Handler)
 					return
 				}
 
-				err = dbDownloadStatForGenericArtifact(ctx, h.Controller, info)
+				err = dbDownloadStatForGenericArtifact(ctx, h.Controller, info, newDownloadStat(r))
 				if !commons.IsEmptyError(err) {
 					log.Ctx(ctx).Error().Stack().Str("middleware",
 						"TrackDownloadStat").Err(err).Msgf("error while putting download stat of artifact, %v",
@@ -172,7 +190,8 @@ func TrackDownloadStatForMavenArtifact(h *maven.Handler) func(http.Handler) http
 					return
 				}
 
-				if sw.StatusCode != http.StatusOK && sw.StatusCode != http.StatusTemporaryRedirect {
+				if sw.StatusCode != http.StatusOK && sw.StatusCode != http.StatusTemporaryRedirect &&
+					sw.StatusCode != http.StatusPartialContent {
 					return
 				}
 
@@ -188,7 +207,7 @@ func TrackDownloadStatForMavenArtifact(h *maven.Handler) func(http.Handler) http
 					return
 				}
 
-				err2 := dbDownloadStatForMavenArtifact(ctx, h.Controller, info)
+				err2 := dbDownloadStatForMavenArtifact(ctx, h.Controller, info, newDownloadStat(r))
 				if !commons.IsEmptyError(err2) {
 					log.Ctx(ctx).Error().Stack().Str("middleware",
 						"TrackDownloadStat").Err(err).Msgf("error while putting download stat of artifact, %v",
@@ -204,6 +223,7 @@ func dbDownloadStatForGenericArtifact(
 	ctx context.Context,
 	c *generic2.Controller,
 	info pkg.GenericArtifactInfo,
+	downloadStat *types.DownloadStat,
 ) errcode.Error {
 	registry, err := c.DBStore.RegistryDao.GetByParentIDAndName(ctx, info.ParentID, info.RegIdentifier)
 	if err != nil {
@@ -220,11 +240,9 @@ func dbDownloadStatForGenericArtifact(
 		return errcode.ErrCodeInvalidRequest.WithDetail(err)
 	}
 
-	downloadStat := &types.DownloadStat{
-		ArtifactID: artifact.ID,
-	}
+	downloadStat.ArtifactID = artifact.ID
 
-	if err := c.DBStore.DownloadStatDao.Create(ctx, downloadStat); err != nil {
+	if err := c.DBStore.DownloadStatRecorder.Record(ctx, downloadStat); err != nil {
 		return errcode.ErrCodeNameUnknown.WithDetail(err)
 	}
 	return errcode.Error{}
@@ -234,6 +252,7 @@ func dbDownloadStatForMavenArtifact(
 	ctx context.Context,
 	c *maven2.Controller,
 	info pkg.MavenArtifactInfo,
+	downloadStat *types.DownloadStat,
 ) errcode.Error {
 	imageName := info.GroupID + ":" + info.ArtifactID
 	registry, err := c.DBStore.RegistryDao.GetByParentIDAndName(ctx, info.ParentID, info.RegIdentifier)
@@ -254,12 +273,47 @@ func dbDownloadStatForMavenArtifact(
 		return errcode.ErrCodeInvalidRequest.WithDetail(err)
 	}
 
-	downloadStat := &types.DownloadStat{
-		ArtifactID: artifact.ID,
-	}
+	downloadStat.ArtifactID = artifact.ID
 
-	if err := c.DBStore.DownloadStatDao.Create(ctx, downloadStat); err != nil {
+	if err := c.DBStore.DownloadStatRecorder.Record(ctx, downloadStat); err != nil {
 		return errcode.ErrCodeNameUnknown.WithDetail(err)
 	}
 	return errcode.Error{}
 }
+
+// newDownloadStat returns the download stat of a request, whose client is the principal of the request,
+// or the remote IP if the request is anonymous, and whose client type is told by the User-Agent.
+func newDownloadStat(r *http.Request) *types.DownloadStat {
+	remoteIP := requestutil.RemoteIP(r)
+	client := "ip:" + remoteIP
+	if session, ok := request.AuthSessionFrom(r.Context()); ok && !auth.IsAnonymousSession(session) {
+		client = "principal:" + strconv.FormatInt(session.Principal.ID, 10)
+	}
+	return &types.DownloadStat{
+		Client:       client,
+		Continuation: isDownloadContinuation(r),
+		ClientType:   string(useragent.ParseClientType(r.UserAgent())),
+		Network:      origin.Network(remoteIP),
+		Region:       origin.Region(r),
+	}
+}
+
+// isDownloadContinuation reports whether a request resumes a download, requesting a range which doesn't
+// start at the beginning of the file.
+func isDownloadContinuation(r *http.Request) bool {
+	ranges := r.Header.Get("Range")
+	return ranges != "" && !strings.HasPrefix(strings.TrimSpace(ranges), "bytes=0-")
+}
+
+// StoreDownloadStat stores the download stat of GET requests in the context, for the package controllers to
+// record once they served the file of an artifact. It must run after the authentication.
+func StoreDownloadStat(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := registryrequest.WithDownloadStat(r.Context(), newDownloadStat(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	registryrequest "github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsDownloadContinuation(t *testing.T) {
+	tests := []struct {
+		ranges string
+		want   bool
+	}{
+		{"", false},
+		{"bytes=0-", false},
+		{"bytes=0-1023", false},
+		{"bytes=1024-", true},
+		{"bytes=500-999", true},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/file", nil)
+		if tt.ranges != "" {
+			r.Header.Set("Range", tt.ranges)
+		}
+		assert.Equal(t, tt.want, isDownloadContinuation(r), tt.ranges)
+	}
+}
+
+func TestNewDownloadStat(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/file", nil)
+	r.RemoteAddr = "10.0.0.1:4321"
+	r = r.WithContext(request.WithAuthSession(r.Context(), &auth.Session{Principal: auth.AnonymousPrincipal}))
+	assert.Equal(t, "ip:10.0.0.1", newDownloadStat(r).Client)
+
+	r = r.WithContext(request.WithAuthSession(r.Context(), &auth.Session{Principal: types.Principal{ID: 7}}))
+	r.Header.Set("Range", "bytes=100-")
+	r.Header.Set("User-Agent", "containerd/v1.7.2")
+	stat := newDownloadStat(r)
+	assert.Equal(t, "principal:7", stat.Client)
+	assert.True(t, stat.Continuation)
+	assert.Equal(t, "CONTAINERD", stat.ClientType)
+	assert.Equal(t, "10.0.0.0/24", stat.Network)
+	assert.Empty(t, stat.Region)
+
+	r.Header.Set("CF-IPCountry", "NL")
+	assert.Equal(t, "NL", newDownloadStat(r).Region)
+}
+
+func TestStoreDownloadStat(t *testing.T) {
+	var stored bool
+	h := StoreDownloadStat(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		_, stored = registryrequest.DownloadStatFrom(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/file", nil)
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.True(t, stored)
+
+	r = httptest.NewRequest(http.MethodHead, "/file", nil)
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	assert.False(t, stored, "only downloads are recorded")
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/utils/drain"
+
+	"github.com/rs/zerolog/log"
+)
+
+// drainRetryAfter is the Retry-After of the transfers refused while draining, in seconds. The
+// connection is closed as well, so the retry is sent to another instance behind the load balancer.
+const drainRetryAfter = "10"
+
+// Drain refuses new uploads and downloads of the package endpoints once the instance is shutting
+// down, and tracks the admitted ones so the shutdown waits for them.
+func Drain(drainer *drain.Drainer) func(http.Handler) http.Handler {
+	return drainTransfers(drainer, func(ctx context.Context, w http.ResponseWriter) {
+		render.UserError(ctx, w, usererror.Newf(http.StatusServiceUnavailable, "server is shutting down"))
+	})
+}
+
+// OciDrain is Drain for the OCI endpoints, responding with OCI formatted errors.
+func OciDrain(drainer *drain.Drainer) func(http.Handler) http.Handler {
+	return drainTransfers(drainer, func(ctx context.Context, w http.ResponseWriter) {
+		if err := errcode.ServeJSON(w, errcode.ErrCodeUnavailable.WithDetail("server is shutting down")); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to write unavailable response")
+		}
+	})
+}
+
+func drainTransfers(
+	drainer *drain.Drainer,
+	deny func(ctx context.Context, w http.ResponseWriter),
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				ctx, done, ok := drainer.Begin(r.Context())
+				if !ok {
+					w.Header().Set(headerRetryAfter, drainRetryAfter)
+					w.Header().Set("Connection", "close")
+					deny(r.Context(), w)
+					return
+				}
+				defer done()
+				next.ServeHTTP(w, r.WithContext(ctx))
+			},
+		)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/utils/drain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciDrain(t *testing.T) {
+	drainer := drain.New()
+	handler := OciDrain(drainer)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/v2/root/reg/app/blobs/uploads/1", nil))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, 0, drainer.InFlight(), "the transfer is done once the handler returns")
+
+	assert.Equal(t, 0, drainer.Drain(context.Background(), time.Second))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPatch, "/v2/root/reg/app/blobs/uploads/1", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, drainRetryAfter, rec.Header().Get(headerRetryAfter))
+	assert.Equal(t, "close", rec.Header().Get("Connection"))
+	assert.Contains(t, rec.Body.String(), "UNAVAILABLE")
+}
@@ -0,0 +1,110 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/utils/ratelimit"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerQuotaLimit         = "X-Quota-Limit"
+	headerQuotaRemaining     = "X-Quota-Remaining"
+	headerQuotaReset         = "X-Quota-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+// RateLimit throttles requests of the registry API and package endpoints and reports
+// the remaining budget of the caller in the response headers.
+func RateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return rateLimit(limiter, func(ctx context.Context, w http.ResponseWriter) {
+		render.UserError(ctx, w, usererror.Newf(http.StatusTooManyRequests, "too many requests"))
+	})
+}
+
+// OciRateLimit is RateLimit for the OCI endpoints, responding with OCI formatted errors.
+func OciRateLimit(limiter *ratelimit.Limiter) func(http.Handler) http.Handler {
+	return rateLimit(limiter, func(ctx context.Context, w http.ResponseWriter) {
+		if err := errcode.ServeJSON(w, errcode.ErrCodeTooManyRequests); err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("failed to write too many requests response")
+		}
+	})
+}
+
+func rateLimit(
+	limiter *ratelimit.Limiter,
+	deny func(ctx context.Context, w http.ResponseWriter),
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				ctx := r.Context()
+				usage := limiter.Take(rateLimitKey(r))
+				setRateLimitHeaders(w, usage)
+				if !usage.Allowed {
+					retryAfter := usage.RetryAfter(time.Now())
+					w.Header().Set(headerRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+					deny(ctx, w)
+					return
+				}
+				next.ServeHTTP(w, r)
+			},
+		)
+	}
+}
+
+// rateLimitKey identifies the caller by its principal, or by its address for anonymous requests.
+func rateLimitKey(r *http.Request) string {
+	if session, ok := request.AuthSessionFrom(r.Context()); ok && session.Principal != auth.AnonymousPrincipal {
+		return "principal:" + strconv.FormatInt(session.Principal.ID, 10)
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "address:" + host
+}
+
+func setRateLimitHeaders(w http.ResponseWriter, usage ratelimit.Usage) {
+	if usage.Limit > 0 {
+		w.Header().Set(headerRateLimitLimit, strconv.Itoa(usage.Limit))
+		w.Header().Set(headerRateLimitRemaining, strconv.Itoa(usage.Remaining))
+		w.Header().Set(headerRateLimitReset, strconv.FormatInt(usage.Reset.Unix(), 10))
+	}
+	if usage.Quota > 0 {
+		w.Header().Set(headerQuotaLimit, strconv.Itoa(usage.Quota))
+		w.Header().Set(headerQuotaRemaining, strconv.Itoa(usage.QuotaRemaining))
+		w.Header().Set(headerQuotaReset, strconv.FormatInt(usage.QuotaReset.Unix(), 10))
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// ReadReplica serves the reads of GET and HEAD requests from the read replica while its replication lag
+// is tolerated. Their writes, and every query of other requests, still run on the primary.
+func ReadReplica(replica *database.Replica) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if replica == nil {
+			return next
+		}
+		return http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet && r.Method != http.MethodHead {
+					next.ServeHTTP(w, r)
+					return
+				}
+				ctx := r.Context()
+				next.ServeHTTP(w, r.WithContext(dbtx.WithReplica(ctx, replica.DB(ctx))))
+			},
+		)
+	}
+}
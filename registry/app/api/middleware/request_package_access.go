@@ -38,6 +38,7 @@ func RequestPackageAccess(
 				render.Forbiddenf(r.Context(), w,
 					"Access denied as permission: %s is required for path: %s, method: %s", reqPermissions, r.URL.Path,
 					r.Method)
+				return
 			}
 
 			next.ServeHTTP(w, r.WithContext(r.Context()))
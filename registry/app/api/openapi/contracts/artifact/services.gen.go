@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -26,6 +27,30 @@ type ServerInterface interface {
 	// Create Registry.
 	// (POST /registry)
 	CreateRegistry(w http.ResponseWriter, r *http.Request, params CreateRegistryParams)
+	// Create Registries
+	// (POST /registry/bulk)
+	CreateRegistries(w http.ResponseWriter, r *http.Request)
+	// Apply a Registry configuration
+	// (POST /registry/config)
+	ApplyRegistryConfig(w http.ResponseWriter, r *http.Request)
+	// Exchange an ID token for a registry token
+	// (POST /registry/identity/token)
+	ExchangeIdentityToken(w http.ResponseWriter, r *http.Request)
+	// Onboard a Registry
+	// (POST /registry/onboarding)
+	OnboardRegistry(w http.ResponseWriter, r *http.Request)
+	// Check a Registry identifier
+	// (POST /registry/onboarding/identifier-check)
+	ValidateRegistryIdentifier(w http.ResponseWriter, r *http.Request)
+	// Estimate an import
+	// (POST /registry/onboarding/import-estimate)
+	EstimateRegistryImport(w http.ResponseWriter, r *http.Request)
+	// Check an upstream
+	// (POST /registry/onboarding/upstream-check)
+	CheckUpstreamConnectivity(w http.ResponseWriter, r *http.Request)
+	// Resolve a permalink
+	// (GET /registry/permalinks/{permalink_code})
+	ResolvePermalink(w http.ResponseWriter, r *http.Request, permalinkCode PermalinkCodePathParam)
 	// Delete a Registry
 	// (DELETE /registry/{registry_ref})
 	DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
@@ -35,6 +60,18 @@ type ServerInterface interface {
 	// Updates a Registry
 	// (PUT /registry/{registry_ref})
 	ModifyRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List active access grants
+	// (GET /registry/{registry_ref}/access-grants)
+	ListAccessGrants(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Grant temporary access to a registry
+	// (POST /registry/{registry_ref}/access-grants)
+	CreateAccessGrant(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Revoke an access grant
+	// (DELETE /registry/{registry_ref}/access-grants/{access_grant_id})
+	RevokeAccessGrant(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, accessGrantId AccessGrantIdPathParam)
+	// Search Docker manifests by annotation
+	// (GET /registry/{registry_ref}/annotations/search)
+	SearchDockerManifestsByAnnotation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchDockerManifestsByAnnotationParams)
 	// List Artifact Labels
 	// (GET /registry/{registry_ref}/artifact/labels)
 	ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams)
@@ -44,6 +81,15 @@ type ServerInterface interface {
 	// Delete Artifact
 	// (DELETE /registry/{registry_ref}/artifact/{artifact})
 	DeleteArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam)
+	// Check Artifact Exists
+	// (HEAD /registry/{registry_ref}/artifact/{artifact})
+	HeadArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam)
+	// Get a badge of an artifact
+	// (GET /registry/{registry_ref}/artifact/{artifact}/badge/{badge})
+	GetArtifactBadge(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, badge BadgePathParam, params GetArtifactBadgeParams)
+	// Get the feed of an artifact
+	// (GET /registry/{registry_ref}/artifact/{artifact}/feed)
+	GetArtifactFeed(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactFeedParams)
 	// Update Artifact Labels
 	// (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
 	UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam)
@@ -56,6 +102,9 @@ type ServerInterface interface {
 	// Delete an Artifact Version
 	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version})
 	DeleteArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Check Artifact Version Exists
+	// (HEAD /registry/{registry_ref}/artifact/{artifact}/version/{version})
+	HeadArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
 	// Describe Artifact Details
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/details)
 	GetArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactDetailsParams)
@@ -71,6 +120,12 @@ type ServerInterface interface {
 	// Describe Docker Artifact Manifests
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests)
 	GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Export the evidence bundle of a version
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/evidence)
+	ExportVersionEvidence(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Check Artifact File Exists
+	// (HEAD /registry/{registry_ref}/artifact/{artifact}/version/{version}/file)
+	HeadArtifactFile(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params HeadArtifactFileParams)
 	// Describe Artifact files
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/files)
 	GetArtifactFiles(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactFilesParams)
@@ -80,6 +135,18 @@ type ServerInterface interface {
 	// Describe Helm Artifact Manifest
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest)
 	GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Describe Model Artifact Detail
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/model/details)
+	GetModelArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Create a permalink of an artifact version
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/permalink)
+	CreateArtifactVersionPermalink(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Hard delete an artifact version
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/purge)
+	PurgeArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
+	// Get storage locations of an artifact version
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/storage)
+	GetArtifactVersionStorage(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
 	// Get Artifact Version Summary
 	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
 	GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam)
@@ -89,9 +156,189 @@ type ServerInterface interface {
 	// List Artifacts for Registry
 	// (GET /registry/{registry_ref}/artifacts)
 	GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetAllArtifactsByRegistryParams)
+	// Export Artifacts for Registry
+	// (GET /registry/{registry_ref}/artifacts/export)
+	ExportRegistryArtifacts(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ExportRegistryArtifactsParams)
+	// Search Java classes inside artifacts
+	// (GET /registry/{registry_ref}/classes/search)
+	SearchArtifactClasses(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchArtifactClassesParams)
 	// Returns CLI Client Setup Details
 	// (GET /registry/{registry_ref}/client-setup-details)
 	GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams)
+	// Clone a Registry
+	// (POST /registry/{registry_ref}/clone)
+	CloneRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Export a Registry configuration
+	// (GET /registry/{registry_ref}/config)
+	ExportRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List the revisions of a Registry configuration
+	// (GET /registry/{registry_ref}/config/revisions)
+	ListRegistryConfigRevisions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryConfigRevisionsParams)
+	// Get a revision of a Registry configuration
+	// (GET /registry/{registry_ref}/config/revisions/{revision})
+	GetRegistryConfigRevision(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, revision ConfigRevisionPathParam)
+	// Roll back a Registry configuration
+	// (POST /registry/{registry_ref}/config/revisions/{revision}/rollback)
+	RollbackRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, revision ConfigRevisionPathParam)
+	// Search files inside artifacts
+	// (GET /registry/{registry_ref}/contents/search)
+	SearchArtifactContents(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchArtifactContentsParams)
+	// List deletion certificates
+	// (GET /registry/{registry_ref}/deletion-certificates)
+	ListDeletionCertificates(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListDeletionCertificatesParams)
+	// Get a deletion certificate
+	// (GET /registry/{registry_ref}/deletion-certificates/{certificate_id})
+	GetDeletionCertificate(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, certificateId CertificateIdPathParam)
+	// Get the download origins of a registry
+	// (GET /registry/{registry_ref}/download-origins)
+	GetRegistryDownloadOrigins(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetRegistryDownloadOriginsParams)
+	// Get the feed of a registry
+	// (GET /registry/{registry_ref}/feed)
+	GetRegistryFeed(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetRegistryFeedParams)
+	// Delete Helm push policy
+	// (DELETE /registry/{registry_ref}/helm/push-policy)
+	DeleteHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Get Helm push policy
+	// (GET /registry/{registry_ref}/helm/push-policy)
+	GetHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Set Helm push policy
+	// (PUT /registry/{registry_ref}/helm/push-policy)
+	SetHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Search files inside image layers
+	// (GET /registry/{registry_ref}/layers/search)
+	SearchImageLayerContents(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchImageLayerContentsParams)
+	// List legal holds
+	// (GET /registry/{registry_ref}/legal-holds)
+	ListLegalHolds(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Place a legal hold
+	// (POST /registry/{registry_ref}/legal-holds)
+	CreateLegalHold(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Release a legal hold
+	// (DELETE /registry/{registry_ref}/legal-holds/{legal_hold_id})
+	DeleteLegalHold(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, legalHoldId LegalHoldIdPathParam)
+	// List Maven relocations
+	// (GET /registry/{registry_ref}/maven/relocations)
+	ListMavenRelocations(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Relocate a Maven artifact
+	// (POST /registry/{registry_ref}/maven/relocations)
+	CreateMavenRelocation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Delete a Maven relocation
+	// (DELETE /registry/{registry_ref}/maven/relocations/{relocation_id})
+	DeleteMavenRelocation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, relocationId RelocationIdPathParam)
+	// List the members of a virtual registry
+	// (GET /registry/{registry_ref}/members)
+	ListRegistryMembers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Reorder the members of a virtual registry
+	// (PUT /registry/{registry_ref}/members)
+	ReorderRegistryMembers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List mirror syncs
+	// (GET /registry/{registry_ref}/mirror-syncs)
+	ListMirrorSyncs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Create a mirror sync
+	// (POST /registry/{registry_ref}/mirror-syncs)
+	CreateMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Delete a mirror sync
+	// (DELETE /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+	DeleteMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam)
+	// Get a mirror sync
+	// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+	GetMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam)
+	// Update a mirror sync
+	// (PUT /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+	UpdateMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam)
+	// List mirror sync runs
+	// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs)
+	ListMirrorSyncRuns(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam)
+	// Run a mirror sync
+	// (POST /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs)
+	RunMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam)
+	// Get a mirror sync run
+	// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs/{mirror_sync_run_id})
+	GetMirrorSyncRun(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam, mirrorSyncRunId MirrorSyncRunIdPathParam)
+	// Simulate Registry Policies
+	// (POST /registry/{registry_ref}/policies/simulate)
+	SimulateRegistryPolicies(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List registry operation queues
+	// (GET /registry/{registry_ref}/queues)
+	ListRegistryQueues(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Pause a registry operation queue
+	// (POST /registry/{registry_ref}/queues/{queue}/pause)
+	PauseRegistryQueue(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, queue QueuePathParam)
+	// Resume a registry operation queue
+	// (POST /registry/{registry_ref}/queues/{queue}/resume)
+	ResumeRegistryQueue(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, queue QueuePathParam)
+	// Trace how a coordinate resolves
+	// (GET /registry/{registry_ref}/resolve)
+	GetResolveTrace(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetResolveTraceParams)
+	// Trace how an artifact path resolves
+	// (GET /registry/{registry_ref}/resolve/path)
+	ResolveArtifactPath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ResolveArtifactPathParams)
+	// List scan results
+	// (GET /registry/{registry_ref}/scans)
+	ListScanResults(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListScanResultsParams)
+	// Report a scan result
+	// (POST /registry/{registry_ref}/scans)
+	IngestScanResult(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Get a scan result
+	// (GET /registry/{registry_ref}/scans/{scan_id})
+	GetScanResult(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, scanId ScanIdPathParam)
+	// List the vulnerability allowlist
+	// (GET /registry/{registry_ref}/security/allowlist)
+	ListVulnerabilityAllowlist(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Allowlist a vulnerability
+	// (POST /registry/{registry_ref}/security/allowlist)
+	CreateVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Remove a vulnerability from the allowlist
+	// (DELETE /registry/{registry_ref}/security/allowlist/{allowlist_entry_id})
+	DeleteVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, allowlistEntryId AllowlistEntryIdPathParam)
+	// Get the security posture of a registry
+	// (GET /registry/{registry_ref}/security/posture)
+	GetRegistrySecurityPosture(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Delete cache eviction policy
+	// (DELETE /registry/{registry_ref}/upstream/cache-eviction)
+	DeleteCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Get cache eviction policy
+	// (GET /registry/{registry_ref}/upstream/cache-eviction)
+	GetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Set cache eviction policy
+	// (PUT /registry/{registry_ref}/upstream/cache-eviction)
+	SetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Rotate Upstream Credentials
+	// (PUT /registry/{registry_ref}/upstream/credentials)
+	RotateUpstreamCredentials(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List package rule violations
+	// (GET /registry/{registry_ref}/upstream/package-rule-violations)
+	ListPackageRuleViolations(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListPackageRuleViolationsParams)
+	// List package rules
+	// (GET /registry/{registry_ref}/upstream/package-rules)
+	ListPackageRules(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Create a package rule
+	// (POST /registry/{registry_ref}/upstream/package-rules)
+	CreatePackageRule(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Delete a package rule
+	// (DELETE /registry/{registry_ref}/upstream/package-rules/{rule_id})
+	DeletePackageRule(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, ruleId RuleIdPathParam)
+	// Prewarm upstream cache
+	// (POST /registry/{registry_ref}/upstream/prewarm)
+	PrewarmUpstreamCache(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Get upstream cache prewarm
+	// (GET /registry/{registry_ref}/upstream/prewarm/{batch})
+	GetUpstreamCachePrewarm(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, batch PrewarmBatchPathParam)
+	// Get upstream status
+	// (GET /registry/{registry_ref}/upstream/status)
+	GetUpstreamStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// Set failover upstream URLs
+	// (PUT /registry/{registry_ref}/upstream/urls)
+	SetUpstreamURLs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam)
+	// List active VEX statements
+	// (GET /registry/{registry_ref}/vex)
+	ListVexStatements(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListVexStatementsParams)
+	// Attach a VEX document
+	// (POST /registry/{registry_ref}/vex)
+	UploadVexDocument(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params UploadVexDocumentParams)
+	// Delete a VEX document
+	// (DELETE /registry/{registry_ref}/vex/{vex_document_id})
+	DeleteVexDocument(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, vexDocumentId VexDocumentIdPathParam)
 	// ListWebhooks
 	// (GET /registry/{registry_ref}/webhooks)
 	ListWebhooks(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListWebhooksParams)
@@ -122,9 +369,39 @@ type ServerInterface interface {
 	// List Artifacts
 	// (GET /spaces/{space_ref}/artifacts)
 	GetAllArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllArtifactsParams)
+	// Export Artifacts
+	// (GET /spaces/{space_ref}/artifacts/export)
+	ExportArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ExportArtifactsParams)
+	// List claims mappings
+	// (GET /spaces/{space_ref}/claim-mappings)
+	ListClaimMappings(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Map an identity provider claim to a registry role
+	// (POST /spaces/{space_ref}/claim-mappings)
+	CreateClaimMapping(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Delete a claims mapping
+	// (DELETE /spaces/{space_ref}/claim-mappings/{claim_mapping_id})
+	DeleteClaimMapping(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, claimMappingId ClaimMappingIdPathParam)
+	// List default registries
+	// (GET /spaces/{space_ref}/default-registries)
+	ListDefaultRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam)
+	// Unset the default registry
+	// (DELETE /spaces/{space_ref}/default-registries/{package_type})
+	DeleteDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam)
+	// Resolve the default registry
+	// (GET /spaces/{space_ref}/default-registries/{package_type})
+	ResolveDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam)
+	// Set the default registry
+	// (PUT /spaces/{space_ref}/default-registries/{package_type})
+	SetDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam)
 	// List Registries
 	// (GET /spaces/{space_ref}/registries)
 	GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams)
+	// Find the artifacts affected by a vulnerable package
+	// (GET /spaces/{space_ref}/security/impact)
+	GetPackageImpact(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetPackageImpactParams)
+	// Compare Versions
+	// (POST /versions/compare)
+	CompareVersions(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -137,6 +414,54 @@ func (_ Unimplemented) CreateRegistry(w http.ResponseWriter, r *http.Request, pa
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create Registries
+// (POST /registry/bulk)
+func (_ Unimplemented) CreateRegistries(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply a Registry configuration
+// (POST /registry/config)
+func (_ Unimplemented) ApplyRegistryConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Exchange an ID token for a registry token
+// (POST /registry/identity/token)
+func (_ Unimplemented) ExchangeIdentityToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Onboard a Registry
+// (POST /registry/onboarding)
+func (_ Unimplemented) OnboardRegistry(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check a Registry identifier
+// (POST /registry/onboarding/identifier-check)
+func (_ Unimplemented) ValidateRegistryIdentifier(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Estimate an import
+// (POST /registry/onboarding/import-estimate)
+func (_ Unimplemented) EstimateRegistryImport(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check an upstream
+// (POST /registry/onboarding/upstream-check)
+func (_ Unimplemented) CheckUpstreamConnectivity(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resolve a permalink
+// (GET /registry/permalinks/{permalink_code})
+func (_ Unimplemented) ResolvePermalink(w http.ResponseWriter, r *http.Request, permalinkCode PermalinkCodePathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a Registry
 // (DELETE /registry/{registry_ref})
 func (_ Unimplemented) DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
@@ -155,6 +480,30 @@ func (_ Unimplemented) ModifyRegistry(w http.ResponseWriter, r *http.Request, re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List active access grants
+// (GET /registry/{registry_ref}/access-grants)
+func (_ Unimplemented) ListAccessGrants(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Grant temporary access to a registry
+// (POST /registry/{registry_ref}/access-grants)
+func (_ Unimplemented) CreateAccessGrant(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an access grant
+// (DELETE /registry/{registry_ref}/access-grants/{access_grant_id})
+func (_ Unimplemented) RevokeAccessGrant(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, accessGrantId AccessGrantIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search Docker manifests by annotation
+// (GET /registry/{registry_ref}/annotations/search)
+func (_ Unimplemented) SearchDockerManifestsByAnnotation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchDockerManifestsByAnnotationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List Artifact Labels
 // (GET /registry/{registry_ref}/artifact/labels)
 func (_ Unimplemented) ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams) {
@@ -173,6 +522,24 @@ func (_ Unimplemented) DeleteArtifact(w http.ResponseWriter, r *http.Request, re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check Artifact Exists
+// (HEAD /registry/{registry_ref}/artifact/{artifact})
+func (_ Unimplemented) HeadArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a badge of an artifact
+// (GET /registry/{registry_ref}/artifact/{artifact}/badge/{badge})
+func (_ Unimplemented) GetArtifactBadge(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, badge BadgePathParam, params GetArtifactBadgeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the feed of an artifact
+// (GET /registry/{registry_ref}/artifact/{artifact}/feed)
+func (_ Unimplemented) GetArtifactFeed(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactFeedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Update Artifact Labels
 // (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
 func (_ Unimplemented) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
@@ -197,6 +564,12 @@ func (_ Unimplemented) DeleteArtifactVersion(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check Artifact Version Exists
+// (HEAD /registry/{registry_ref}/artifact/{artifact}/version/{version})
+func (_ Unimplemented) HeadArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Describe Artifact Details
 // (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/details)
 func (_ Unimplemented) GetArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactDetailsParams) {
@@ -227,6 +600,18 @@ func (_ Unimplemented) GetDockerArtifactManifests(w http.ResponseWriter, r *http
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export the evidence bundle of a version
+// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/evidence)
+func (_ Unimplemented) ExportVersionEvidence(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check Artifact File Exists
+// (HEAD /registry/{registry_ref}/artifact/{artifact}/version/{version}/file)
+func (_ Unimplemented) HeadArtifactFile(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params HeadArtifactFileParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Describe Artifact files
 // (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/files)
 func (_ Unimplemented) GetArtifactFiles(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactFilesParams) {
@@ -245,6 +630,30 @@ func (_ Unimplemented) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Re
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Describe Model Artifact Detail
+// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/model/details)
+func (_ Unimplemented) GetModelArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a permalink of an artifact version
+// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/permalink)
+func (_ Unimplemented) CreateArtifactVersionPermalink(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Hard delete an artifact version
+// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/purge)
+func (_ Unimplemented) PurgeArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get storage locations of an artifact version
+// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/storage)
+func (_ Unimplemented) GetArtifactVersionStorage(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Artifact Version Summary
 // (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
 func (_ Unimplemented) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
@@ -263,232 +672,525 @@ func (_ Unimplemented) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Export Artifacts for Registry
+// (GET /registry/{registry_ref}/artifacts/export)
+func (_ Unimplemented) ExportRegistryArtifacts(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ExportRegistryArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search Java classes inside artifacts
+// (GET /registry/{registry_ref}/classes/search)
+func (_ Unimplemented) SearchArtifactClasses(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchArtifactClassesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Returns CLI Client Setup Details
 // (GET /registry/{registry_ref}/client-setup-details)
 func (_ Unimplemented) GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ListWebhooks
-// (GET /registry/{registry_ref}/webhooks)
-func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListWebhooksParams) {
+// Clone a Registry
+// (POST /registry/{registry_ref}/clone)
+func (_ Unimplemented) CloneRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// CreateWebhook
-// (POST /registry/{registry_ref}/webhooks)
-func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+// Export a Registry configuration
+// (GET /registry/{registry_ref}/config)
+func (_ Unimplemented) ExportRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// DeleteWebhook
-// (DELETE /registry/{registry_ref}/webhooks/{webhook_identifier})
-func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+// List the revisions of a Registry configuration
+// (GET /registry/{registry_ref}/config/revisions)
+func (_ Unimplemented) ListRegistryConfigRevisions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryConfigRevisionsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetWebhook
-// (GET /registry/{registry_ref}/webhooks/{webhook_identifier})
-func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+// Get a revision of a Registry configuration
+// (GET /registry/{registry_ref}/config/revisions/{revision})
+func (_ Unimplemented) GetRegistryConfigRevision(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, revision ConfigRevisionPathParam) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// UpdateWebhook
-// (PUT /registry/{registry_ref}/webhooks/{webhook_identifier})
-func (_ Unimplemented) UpdateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+// Roll back a Registry configuration
+// (POST /registry/{registry_ref}/config/revisions/{revision}/rollback)
+func (_ Unimplemented) RollbackRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, revision ConfigRevisionPathParam) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ListWebhookExecutions
-// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions)
-func (_ Unimplemented) ListWebhookExecutions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, params ListWebhookExecutionsParams) {
+// Search files inside artifacts
+// (GET /registry/{registry_ref}/contents/search)
+func (_ Unimplemented) SearchArtifactContents(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchArtifactContentsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetWebhookExecution
-// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id})
-func (_ Unimplemented) GetWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+// List deletion certificates
+// (GET /registry/{registry_ref}/deletion-certificates)
+func (_ Unimplemented) ListDeletionCertificates(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListDeletionCertificatesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ReTriggerWebhookExecution
-// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
-func (_ Unimplemented) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+// Get a deletion certificate
+// (GET /registry/{registry_ref}/deletion-certificates/{certificate_id})
+func (_ Unimplemented) GetDeletionCertificate(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, certificateId CertificateIdPathParam) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get Artifact Stats
-// (GET /spaces/{space_ref}/artifact/stats)
-func (_ Unimplemented) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetArtifactStatsForSpaceParams) {
+// Get the download origins of a registry
+// (GET /registry/{registry_ref}/download-origins)
+func (_ Unimplemented) GetRegistryDownloadOrigins(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetRegistryDownloadOriginsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List Artifacts
-// (GET /spaces/{space_ref}/artifacts)
-func (_ Unimplemented) GetAllArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllArtifactsParams) {
+// Get the feed of a registry
+// (GET /registry/{registry_ref}/feed)
+func (_ Unimplemented) GetRegistryFeed(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetRegistryFeedParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List Registries
-// (GET /spaces/{space_ref}/registries)
-func (_ Unimplemented) GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams) {
+// Delete Helm push policy
+// (DELETE /registry/{registry_ref}/helm/push-policy)
+func (_ Unimplemented) DeleteHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Get Helm push policy
+// (GET /registry/{registry_ref}/helm/push-policy)
+func (_ Unimplemented) GetHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Set Helm push policy
+// (PUT /registry/{registry_ref}/helm/push-policy)
+func (_ Unimplemented) SetHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// CreateRegistry operation middleware
-func (siw *ServerInterfaceWrapper) CreateRegistry(w http.ResponseWriter, r *http.Request) {
+// Search files inside image layers
+// (GET /registry/{registry_ref}/layers/search)
+func (_ Unimplemented) SearchImageLayerContents(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchImageLayerContentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// List legal holds
+// (GET /registry/{registry_ref}/legal-holds)
+func (_ Unimplemented) ListLegalHolds(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateRegistryParams
+// Place a legal hold
+// (POST /registry/{registry_ref}/legal-holds)
+func (_ Unimplemented) CreateLegalHold(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "space_ref" -------------
+// Release a legal hold
+// (DELETE /registry/{registry_ref}/legal-holds/{legal_hold_id})
+func (_ Unimplemented) DeleteLegalHold(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, legalHoldId LegalHoldIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "space_ref", r.URL.Query(), &params.SpaceRef)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
-		return
-	}
+// List Maven relocations
+// (GET /registry/{registry_ref}/maven/relocations)
+func (_ Unimplemented) ListMavenRelocations(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRegistry(w, r, params)
-	}))
+// Relocate a Maven artifact
+// (POST /registry/{registry_ref}/maven/relocations)
+func (_ Unimplemented) CreateMavenRelocation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Delete a Maven relocation
+// (DELETE /registry/{registry_ref}/maven/relocations/{relocation_id})
+func (_ Unimplemented) DeleteMavenRelocation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, relocationId RelocationIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List the members of a virtual registry
+// (GET /registry/{registry_ref}/members)
+func (_ Unimplemented) ListRegistryMembers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// DeleteRegistry operation middleware
-func (siw *ServerInterfaceWrapper) DeleteRegistry(w http.ResponseWriter, r *http.Request) {
+// Reorder the members of a virtual registry
+// (PUT /registry/{registry_ref}/members)
+func (_ Unimplemented) ReorderRegistryMembers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// List mirror syncs
+// (GET /registry/{registry_ref}/mirror-syncs)
+func (_ Unimplemented) ListMirrorSyncs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+// Create a mirror sync
+// (POST /registry/{registry_ref}/mirror-syncs)
+func (_ Unimplemented) CreateMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
-	}
+// Delete a mirror sync
+// (DELETE /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+func (_ Unimplemented) DeleteMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteRegistry(w, r, registryRef)
-	}))
+// Get a mirror sync
+// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+func (_ Unimplemented) GetMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Update a mirror sync
+// (PUT /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+func (_ Unimplemented) UpdateMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// List mirror sync runs
+// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs)
+func (_ Unimplemented) ListMirrorSyncRuns(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// GetRegistry operation middleware
-func (siw *ServerInterfaceWrapper) GetRegistry(w http.ResponseWriter, r *http.Request) {
+// Run a mirror sync
+// (POST /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs)
+func (_ Unimplemented) RunMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Get a mirror sync run
+// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs/{mirror_sync_run_id})
+func (_ Unimplemented) GetMirrorSyncRun(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam, mirrorSyncRunId MirrorSyncRunIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+// Simulate Registry Policies
+// (POST /registry/{registry_ref}/policies/simulate)
+func (_ Unimplemented) SimulateRegistryPolicies(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
-	}
+// List registry operation queues
+// (GET /registry/{registry_ref}/queues)
+func (_ Unimplemented) ListRegistryQueues(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRegistry(w, r, registryRef)
-	}))
+// Pause a registry operation queue
+// (POST /registry/{registry_ref}/queues/{queue}/pause)
+func (_ Unimplemented) PauseRegistryQueue(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, queue QueuePathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Resume a registry operation queue
+// (POST /registry/{registry_ref}/queues/{queue}/resume)
+func (_ Unimplemented) ResumeRegistryQueue(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, queue QueuePathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Trace how a coordinate resolves
+// (GET /registry/{registry_ref}/resolve)
+func (_ Unimplemented) GetResolveTrace(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetResolveTraceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ModifyRegistry operation middleware
-func (siw *ServerInterfaceWrapper) ModifyRegistry(w http.ResponseWriter, r *http.Request) {
+// Trace how an artifact path resolves
+// (GET /registry/{registry_ref}/resolve/path)
+func (_ Unimplemented) ResolveArtifactPath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ResolveArtifactPathParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// List scan results
+// (GET /registry/{registry_ref}/scans)
+func (_ Unimplemented) ListScanResults(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListScanResultsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+// Report a scan result
+// (POST /registry/{registry_ref}/scans)
+func (_ Unimplemented) IngestScanResult(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
-	}
+// Get a scan result
+// (GET /registry/{registry_ref}/scans/{scan_id})
+func (_ Unimplemented) GetScanResult(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, scanId ScanIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ModifyRegistry(w, r, registryRef)
-	}))
+// List the vulnerability allowlist
+// (GET /registry/{registry_ref}/security/allowlist)
+func (_ Unimplemented) ListVulnerabilityAllowlist(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Allowlist a vulnerability
+// (POST /registry/{registry_ref}/security/allowlist)
+func (_ Unimplemented) CreateVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Remove a vulnerability from the allowlist
+// (DELETE /registry/{registry_ref}/security/allowlist/{allowlist_entry_id})
+func (_ Unimplemented) DeleteVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, allowlistEntryId AllowlistEntryIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ListArtifactLabels operation middleware
-func (siw *ServerInterfaceWrapper) ListArtifactLabels(w http.ResponseWriter, r *http.Request) {
+// Get the security posture of a registry
+// (GET /registry/{registry_ref}/security/posture)
+func (_ Unimplemented) GetRegistrySecurityPosture(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Delete cache eviction policy
+// (DELETE /registry/{registry_ref}/upstream/cache-eviction)
+func (_ Unimplemented) DeleteCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+// Get cache eviction policy
+// (GET /registry/{registry_ref}/upstream/cache-eviction)
+func (_ Unimplemented) GetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
-	}
+// Set cache eviction policy
+// (PUT /registry/{registry_ref}/upstream/cache-eviction)
+func (_ Unimplemented) SetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListArtifactLabelsParams
+// Rotate Upstream Credentials
+// (PUT /registry/{registry_ref}/upstream/credentials)
+func (_ Unimplemented) RotateUpstreamCredentials(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "page" -------------
+// List package rule violations
+// (GET /registry/{registry_ref}/upstream/package-rule-violations)
+func (_ Unimplemented) ListPackageRuleViolations(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListPackageRuleViolationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
+// List package rules
+// (GET /registry/{registry_ref}/upstream/package-rules)
+func (_ Unimplemented) ListPackageRules(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "size" -------------
+// Create a package rule
+// (POST /registry/{registry_ref}/upstream/package-rules)
+func (_ Unimplemented) CreatePackageRule(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
-		return
-	}
+// Delete a package rule
+// (DELETE /registry/{registry_ref}/upstream/package-rules/{rule_id})
+func (_ Unimplemented) DeletePackageRule(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, ruleId RuleIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Optional query parameter "search_term" -------------
+// Prewarm upstream cache
+// (POST /registry/{registry_ref}/upstream/prewarm)
+func (_ Unimplemented) PrewarmUpstreamCache(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+// Get upstream cache prewarm
+// (GET /registry/{registry_ref}/upstream/prewarm/{batch})
+func (_ Unimplemented) GetUpstreamCachePrewarm(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, batch PrewarmBatchPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get upstream status
+// (GET /registry/{registry_ref}/upstream/status)
+func (_ Unimplemented) GetUpstreamStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set failover upstream URLs
+// (PUT /registry/{registry_ref}/upstream/urls)
+func (_ Unimplemented) SetUpstreamURLs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List active VEX statements
+// (GET /registry/{registry_ref}/vex)
+func (_ Unimplemented) ListVexStatements(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListVexStatementsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Attach a VEX document
+// (POST /registry/{registry_ref}/vex)
+func (_ Unimplemented) UploadVexDocument(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params UploadVexDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a VEX document
+// (DELETE /registry/{registry_ref}/vex/{vex_document_id})
+func (_ Unimplemented) DeleteVexDocument(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, vexDocumentId VexDocumentIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ListWebhooks
+// (GET /registry/{registry_ref}/webhooks)
+func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListWebhooksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// CreateWebhook
+// (POST /registry/{registry_ref}/webhooks)
+func (_ Unimplemented) CreateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// DeleteWebhook
+// (DELETE /registry/{registry_ref}/webhooks/{webhook_identifier})
+func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetWebhook
+// (GET /registry/{registry_ref}/webhooks/{webhook_identifier})
+func (_ Unimplemented) GetWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// UpdateWebhook
+// (PUT /registry/{registry_ref}/webhooks/{webhook_identifier})
+func (_ Unimplemented) UpdateWebhook(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ListWebhookExecutions
+// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions)
+func (_ Unimplemented) ListWebhookExecutions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, params ListWebhookExecutionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// GetWebhookExecution
+// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id})
+func (_ Unimplemented) GetWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ReTriggerWebhookExecution
+// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
+func (_ Unimplemented) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, webhookIdentifier WebhookIdentifierPathParam, webhookExecutionId WebhookExecutionIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Artifact Stats
+// (GET /spaces/{space_ref}/artifact/stats)
+func (_ Unimplemented) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetArtifactStatsForSpaceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Artifacts
+// (GET /spaces/{space_ref}/artifacts)
+func (_ Unimplemented) GetAllArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export Artifacts
+// (GET /spaces/{space_ref}/artifacts/export)
+func (_ Unimplemented) ExportArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ExportArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List claims mappings
+// (GET /spaces/{space_ref}/claim-mappings)
+func (_ Unimplemented) ListClaimMappings(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Map an identity provider claim to a registry role
+// (POST /spaces/{space_ref}/claim-mappings)
+func (_ Unimplemented) CreateClaimMapping(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a claims mapping
+// (DELETE /spaces/{space_ref}/claim-mappings/{claim_mapping_id})
+func (_ Unimplemented) DeleteClaimMapping(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, claimMappingId ClaimMappingIdPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List default registries
+// (GET /spaces/{space_ref}/default-registries)
+func (_ Unimplemented) ListDefaultRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unset the default registry
+// (DELETE /spaces/{space_ref}/default-registries/{package_type})
+func (_ Unimplemented) DeleteDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resolve the default registry
+// (GET /spaces/{space_ref}/default-registries/{package_type})
+func (_ Unimplemented) ResolveDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the default registry
+// (PUT /spaces/{space_ref}/default-registries/{package_type})
+func (_ Unimplemented) SetDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List Registries
+// (GET /spaces/{space_ref}/registries)
+func (_ Unimplemented) GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Find the artifacts affected by a vulnerable package
+// (GET /spaces/{space_ref}/security/impact)
+func (_ Unimplemented) GetPackageImpact(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetPackageImpactParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Compare Versions
+// (POST /versions/compare)
+func (_ Unimplemented) CompareVersions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// CreateRegistry operation middleware
+func (siw *ServerInterfaceWrapper) CreateRegistry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateRegistryParams
+
+	// ------------- Optional query parameter "space_ref" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "space_ref", r.URL.Query(), &params.SpaceRef)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListArtifactLabels(w, r, registryRef, params)
+		siw.Handler.CreateRegistry(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -498,41 +1200,39 @@ func (siw *ServerInterfaceWrapper) ListArtifactLabels(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactStatsForRegistry operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// CreateRegistries operation middleware
+func (siw *ServerInterfaceWrapper) CreateRegistries(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateRegistries(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactStatsForRegistryParams
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "from" -------------
+// ApplyRegistryConfig operation middleware
+func (siw *ServerInterfaceWrapper) ApplyRegistryConfig(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyRegistryConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
-		return
-	}
+// ExchangeIdentityToken operation middleware
+func (siw *ServerInterfaceWrapper) ExchangeIdentityToken(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactStatsForRegistry(w, r, registryRef, params)
+		siw.Handler.ExchangeIdentityToken(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -542,31 +1242,25 @@ func (siw *ServerInterfaceWrapper) GetArtifactStatsForRegistry(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteArtifact operation middleware
-func (siw *ServerInterfaceWrapper) DeleteArtifact(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// OnboardRegistry operation middleware
+func (siw *ServerInterfaceWrapper) OnboardRegistry(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OnboardRegistry(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
-		return
-	}
+// ValidateRegistryIdentifier operation middleware
+func (siw *ServerInterfaceWrapper) ValidateRegistryIdentifier(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteArtifact(w, r, registryRef, artifact)
+		siw.Handler.ValidateRegistryIdentifier(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -576,31 +1270,25 @@ func (siw *ServerInterfaceWrapper) DeleteArtifact(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateArtifactLabels operation middleware
-func (siw *ServerInterfaceWrapper) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// EstimateRegistryImport operation middleware
+func (siw *ServerInterfaceWrapper) EstimateRegistryImport(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EstimateRegistryImport(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
-		return
-	}
+// CheckUpstreamConnectivity operation middleware
+func (siw *ServerInterfaceWrapper) CheckUpstreamConnectivity(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateArtifactLabels(w, r, registryRef, artifact)
+		siw.Handler.CheckUpstreamConnectivity(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -610,50 +1298,47 @@ func (siw *ServerInterfaceWrapper) UpdateArtifactLabels(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactStats operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *http.Request) {
+// ResolvePermalink operation middleware
+func (siw *ServerInterfaceWrapper) ResolvePermalink(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "registry_ref" -------------
-	var registryRef RegistryRefPathParam
+	// ------------- Path parameter "permalink_code" -------------
+	var permalinkCode PermalinkCodePathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "permalink_code", chi.URLParam(r, "permalink_code"), &permalinkCode, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "permalink_code", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResolvePermalink(w, r, permalinkCode)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactStatsParams
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "from" -------------
+// DeleteRegistry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRegistry(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
-		return
-	}
+	var err error
 
-	// ------------- Optional query parameter "to" -------------
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactStats(w, r, registryRef, artifact, params)
+		siw.Handler.DeleteRegistry(w, r, registryRef)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -663,8 +1348,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *http.Request) {
+// GetRegistry operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -677,17 +1362,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactSummary(w, r, registryRef, artifact)
+		siw.Handler.GetRegistry(w, r, registryRef)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -697,8 +1373,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteArtifactVersion operation middleware
-func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request) {
+// ModifyRegistry operation middleware
+func (siw *ServerInterfaceWrapper) ModifyRegistry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -711,26 +1387,33 @@ func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ModifyRegistry(w, r, registryRef)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// ListAccessGrants operation middleware
+func (siw *ServerInterfaceWrapper) ListAccessGrants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteArtifactVersion(w, r, registryRef, artifact, version)
+		siw.Handler.ListAccessGrants(w, r, registryRef)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -740,8 +1423,8 @@ func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *http.Request) {
+// CreateAccessGrant operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccessGrant(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -754,37 +1437,42 @@ func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAccessGrant(w, r, registryRef)
+	}))
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// RevokeAccessGrant operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAccessGrant(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactDetailsParams
-
-	// ------------- Optional query parameter "childVersion" -------------
+	// ------------- Path parameter "access_grant_id" -------------
+	var accessGrantId AccessGrantIdPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "childVersion", r.URL.Query(), &params.ChildVersion)
+	err = runtime.BindStyledParameterWithOptions("simple", "access_grant_id", chi.URLParam(r, "access_grant_id"), &accessGrantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "childVersion", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "access_grant_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactDetails(w, r, registryRef, artifact, version, params)
+		siw.Handler.RevokeAccessGrant(w, r, registryRef, accessGrantId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -794,8 +1482,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request) {
+// SearchDockerManifestsByAnnotation operation middleware
+func (siw *ServerInterfaceWrapper) SearchDockerManifestsByAnnotation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -808,44 +1496,50 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWrite
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchDockerManifestsByAnnotationParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+	// ------------- Required query parameter "key" -------------
+
+	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "key"})
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, true, "key", r.URL.Query(), &params.Key)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDockerArtifactDetailsParams
+	// ------------- Optional query parameter "value" -------------
 
-	// ------------- Required query parameter "digest" -------------
+	err = runtime.BindQueryParameter("form", true, false, "value", r.URL.Query(), &params.Value)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "value", Err: err})
+		return
+	}
 
-	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+	// ------------- Optional query parameter "page" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactDetails(w, r, registryRef, artifact, version, params)
+		siw.Handler.SearchDockerManifestsByAnnotation(w, r, registryRef, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -855,8 +1549,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactLayers operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request) {
+// ListArtifactLabels operation middleware
+func (siw *ServerInterfaceWrapper) ListArtifactLabels(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -869,44 +1563,35 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListArtifactLabelsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// ------------- Optional query parameter "size" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDockerArtifactLayersParams
-
-	// ------------- Required query parameter "digest" -------------
-
-	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
-		return
-	}
+	// ------------- Optional query parameter "search_term" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactLayers(w, r, registryRef, artifact, version, params)
+		siw.Handler.ListArtifactLabels(w, r, registryRef, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -916,8 +1601,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactManifest operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request) {
+// GetArtifactStatsForRegistry operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -930,44 +1615,61 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "artifact" -------------
-	var artifact ArtifactPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactStatsForRegistryParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDockerArtifactManifestParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArtifactStatsForRegistry(w, r, registryRef, params)
+	}))
 
-	// ------------- Required query parameter "digest" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+	handler.ServeHTTP(w, r)
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+// DeleteArtifact operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArtifact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactManifest(w, r, registryRef, artifact, version, params)
+		siw.Handler.DeleteArtifact(w, r, registryRef, artifact)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -977,8 +1679,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetDockerArtifactManifests operation middleware
-func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request) {
+// HeadArtifact operation middleware
+func (siw *ServerInterfaceWrapper) HeadArtifact(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1000,17 +1702,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWri
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDockerArtifactManifests(w, r, registryRef, artifact, version)
+		siw.Handler.HeadArtifact(w, r, registryRef, artifact)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1020,8 +1713,8 @@ func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactFiles operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *http.Request) {
+// GetArtifactBadge operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactBadge(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1043,60 +1736,81 @@ func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// ------------- Path parameter "badge" -------------
+	var badge BadgePathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "badge", chi.URLParam(r, "badge"), &badge, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "badge", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactFilesParams
+	var params GetArtifactBadgeParams
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "style" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "style", r.URL.Query(), &params.Style)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "style", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// ------------- Optional query parameter "label" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Optional query parameter "color" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindQueryParameter("form", true, false, "color", r.URL.Query(), &params.Color)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "color", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// ------------- Optional query parameter "labelColor" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	err = runtime.BindQueryParameter("form", true, false, "labelColor", r.URL.Query(), &params.LabelColor)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelColor", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	// ------------- Optional query parameter "maxAge" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindQueryParameter("form", true, false, "maxAge", r.URL.Query(), &params.MaxAge)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxAge", Err: err})
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeaderParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactFiles(w, r, registryRef, artifact, version, params)
+		siw.Handler.GetArtifactBadge(w, r, registryRef, artifact, badge, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1106,8 +1820,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetHelmArtifactDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request) {
+// GetArtifactFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactFeed(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1129,17 +1843,40 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactFeedParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		return
 	}
 
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeaderParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHelmArtifactDetails(w, r, registryRef, artifact, version)
+		siw.Handler.GetArtifactFeed(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1149,8 +1886,8 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetHelmArtifactManifest operation middleware
-func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request) {
+// UpdateArtifactLabels operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1172,17 +1909,8 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
-
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHelmArtifactManifest(w, r, registryRef, artifact, version)
+		siw.Handler.UpdateArtifactLabels(w, r, registryRef, artifact)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1192,8 +1920,8 @@ func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactVersionSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request) {
+// GetArtifactStats operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactStats(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1215,17 +1943,27 @@ func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var version VersionPathParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactStatsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactVersionSummary(w, r, registryRef, artifact, version)
+		siw.Handler.GetArtifactStats(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1235,8 +1973,8 @@ func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllArtifactVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request) {
+// GetArtifactSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactSummary(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1258,51 +1996,51 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter,
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllArtifactVersionsParams
-
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArtifactSummary(w, r, registryRef, artifact)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
-		return
-	}
+// DeleteArtifactVersion operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "sort_order" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllArtifactVersions(w, r, registryRef, artifact, params)
+		siw.Handler.DeleteArtifactVersion(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1312,8 +2050,8 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllArtifactsByRegistry operation middleware
-func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request) {
+// HeadArtifactVersion operation middleware
+func (siw *ServerInterfaceWrapper) HeadArtifactVersion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1326,59 +2064,80 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWrit
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllArtifactsByRegistryParams
-
-	// ------------- Optional query parameter "label" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HeadArtifactVersion(w, r, registryRef, artifact, version)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactDetails(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactDetailsParams
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	// ------------- Optional query parameter "childVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "childVersion", r.URL.Query(), &params.ChildVersion)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "childVersion", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllArtifactsByRegistry(w, r, registryRef, params)
+		siw.Handler.GetArtifactDetails(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1388,8 +2147,8 @@ func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetClientSetupDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1402,27 +2161,44 @@ func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter,
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetClientSetupDetailsParams
-
-	// ------------- Optional query parameter "artifact" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "artifact", r.URL.Query(), &params.Artifact)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "version" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDockerArtifactDetailsParams
+
+	// ------------- Required query parameter "digest" -------------
+
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetClientSetupDetails(w, r, registryRef, params)
+		siw.Handler.GetDockerArtifactDetails(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1432,8 +2208,8 @@ func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListWebhooks operation middleware
-func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactLayers operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1446,51 +2222,44 @@ func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWebhooksParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDockerArtifactLayersParams
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
-		return
-	}
+	// ------------- Required query parameter "digest" -------------
 
-	// ------------- Optional query parameter "sort_field" -------------
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebhooks(w, r, registryRef, params)
+		siw.Handler.GetDockerArtifactLayers(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1500,8 +2269,8 @@ func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactManifest operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1514,8 +2283,44 @@ func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDockerArtifactManifestParams
+
+	// ------------- Required query parameter "digest" -------------
+
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWebhook(w, r, registryRef)
+		siw.Handler.GetDockerArtifactManifest(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1525,8 +2330,8 @@ func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWebhook operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+// GetDockerArtifactManifests operation middleware
+func (siw *ServerInterfaceWrapper) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1539,17 +2344,26 @@ func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWebhook(w, r, registryRef, webhookIdentifier)
+		siw.Handler.GetDockerArtifactManifests(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1559,8 +2373,8 @@ func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetWebhook operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+// ExportVersionEvidence operation middleware
+func (siw *ServerInterfaceWrapper) ExportVersionEvidence(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1573,17 +2387,26 @@ func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhook(w, r, registryRef, webhookIdentifier)
+		siw.Handler.ExportVersionEvidence(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1593,8 +2416,8 @@ func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+// HeadArtifactFile operation middleware
+func (siw *ServerInterfaceWrapper) HeadArtifactFile(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1607,17 +2430,44 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HeadArtifactFileParams
+
+	// ------------- Required query parameter "file_name" -------------
+
+	if paramValue := r.URL.Query().Get("file_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "file_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "file_name", r.URL.Query(), &params.FileName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file_name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWebhook(w, r, registryRef, webhookIdentifier)
+		siw.Handler.HeadArtifactFile(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1627,8 +2477,8 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListWebhookExecutions operation middleware
-func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter, r *http.Request) {
+// GetArtifactFiles operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactFiles(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1641,17 +2491,26 @@ func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWebhookExecutionsParams
+	var params GetArtifactFilesParams
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1669,8 +2528,32 @@ func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebhookExecutions(w, r, registryRef, webhookIdentifier, params)
+		siw.Handler.GetArtifactFiles(w, r, registryRef, artifact, version, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1680,8 +2563,8 @@ func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetWebhookExecution operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r *http.Request) {
+// GetHelmArtifactDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1694,26 +2577,26 @@ func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "webhook_execution_id" -------------
-	var webhookExecutionId WebhookExecutionIdPathParam
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+		siw.Handler.GetHelmArtifactDetails(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1723,8 +2606,8 @@ func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ReTriggerWebhookExecution operation middleware
-func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request) {
+// GetHelmArtifactManifest operation middleware
+func (siw *ServerInterfaceWrapper) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1737,26 +2620,26 @@ func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "webhook_identifier" -------------
-	var webhookIdentifier WebhookIdentifierPathParam
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "webhook_execution_id" -------------
-	var webhookExecutionId WebhookExecutionIdPathParam
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReTriggerWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+		siw.Handler.GetHelmArtifactManifest(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1766,41 +2649,40 @@ func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetArtifactStatsForSpace operation middleware
-func (siw *ServerInterfaceWrapper) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request) {
+// GetModelArtifactDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetModelArtifactDetails(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "space_ref" -------------
-	var spaceRef SpaceRefPathParam
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetArtifactStatsForSpaceParams
-
-	// ------------- Optional query parameter "from" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetArtifactStatsForSpace(w, r, spaceRef, params)
+		siw.Handler.GetModelArtifactDetails(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1810,89 +2692,126 @@ func (siw *ServerInterfaceWrapper) GetArtifactStatsForSpace(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllArtifacts operation middleware
-func (siw *ServerInterfaceWrapper) GetAllArtifacts(w http.ResponseWriter, r *http.Request) {
+// CreateArtifactVersionPermalink operation middleware
+func (siw *ServerInterfaceWrapper) CreateArtifactVersionPermalink(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "space_ref" -------------
-	var spaceRef SpaceRefPathParam
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllArtifactsParams
-
-	// ------------- Optional query parameter "reg_identifier" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "reg_identifier", r.URL.Query(), &params.RegIdentifier)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reg_identifier", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateArtifactVersionPermalink(w, r, registryRef, artifact, version)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PurgeArtifactVersion operation middleware
+func (siw *ServerInterfaceWrapper) PurgeArtifactVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_order" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_field" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search_term" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PurgeArtifactVersion(w, r, registryRef, artifact, version)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactVersionStorage operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactVersionStorage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "latest_version" -------------
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "latest_version", r.URL.Query(), &params.LatestVersion)
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "latest_version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "package_type" -------------
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
 
-	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllArtifacts(w, r, spaceRef, params)
+		siw.Handler.GetArtifactVersionStorage(w, r, registryRef, artifact, version)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1902,39 +2821,75 @@ func (siw *ServerInterfaceWrapper) GetAllArtifacts(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetAllRegistries operation middleware
-func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *http.Request) {
+// GetArtifactVersionSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "space_ref" -------------
-	var spaceRef SpaceRefPathParam
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllRegistriesParams
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
 
-	// ------------- Optional query parameter "package_type" -------------
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	// ------------- Path parameter "version" -------------
+	var version VersionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", chi.URLParam(r, "version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "type" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArtifactVersionSummary(w, r, registryRef, artifact, version)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAllArtifactVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "artifact" -------------
+	var artifact ArtifactPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "artifact", chi.URLParam(r, "artifact"), &artifact, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllArtifactVersionsParams
+
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
@@ -1975,16 +2930,16 @@ func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Optional query parameter "recursive" -------------
+	// ------------- Optional query parameter "exact_count" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "recursive", r.URL.Query(), &params.Recursive)
+	err = runtime.BindQueryParameter("form", true, false, "exact_count", r.URL.Query(), &params.ExactCount)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recursive", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exact_count", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAllRegistries(w, r, spaceRef, params)
+		siw.Handler.GetAllArtifactVersions(w, r, registryRef, artifact, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1994,2801 +2949,15349 @@ func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
-
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// GetAllArtifactsByRegistry operation middleware
+func (siw *ServerInterfaceWrapper) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	var err error
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllArtifactsByRegistryParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Optional query parameter "label" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "page" -------------
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "size" -------------
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "sort_order" -------------
 
-func (e *InvalidParamFormatError) Unwrap() error {
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exact_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exact_count", r.URL.Query(), &params.ExactCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exact_count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "merged" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged", r.URL.Query(), &params.Merged)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllArtifactsByRegistry(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportRegistryArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) ExportRegistryArtifacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportRegistryArtifactsParams
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", r.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "label", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "merged" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged", r.URL.Query(), &params.Merged)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportRegistryArtifacts(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchArtifactClasses operation middleware
+func (siw *ServerInterfaceWrapper) SearchArtifactClasses(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchArtifactClassesParams
+
+	// ------------- Required query parameter "query" -------------
+
+	if paramValue := r.URL.Query().Get("query"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchArtifactClasses(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetClientSetupDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetClientSetupDetails(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClientSetupDetailsParams
+
+	// ------------- Optional query parameter "artifact" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "artifact", r.URL.Query(), &params.Artifact)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientSetupDetails(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneRegistry operation middleware
+func (siw *ServerInterfaceWrapper) CloneRegistry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneRegistry(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportRegistryConfig operation middleware
+func (siw *ServerInterfaceWrapper) ExportRegistryConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportRegistryConfig(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRegistryConfigRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ListRegistryConfigRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRegistryConfigRevisionsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRegistryConfigRevisions(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistryConfigRevision operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistryConfigRevision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision ConfigRevisionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistryConfigRevision(w, r, registryRef, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackRegistryConfig operation middleware
+func (siw *ServerInterfaceWrapper) RollbackRegistryConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision ConfigRevisionPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackRegistryConfig(w, r, registryRef, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchArtifactContents operation middleware
+func (siw *ServerInterfaceWrapper) SearchArtifactContents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchArtifactContentsParams
+
+	// ------------- Required query parameter "query" -------------
+
+	if paramValue := r.URL.Query().Get("query"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchArtifactContents(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDeletionCertificates operation middleware
+func (siw *ServerInterfaceWrapper) ListDeletionCertificates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeletionCertificatesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeletionCertificates(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeletionCertificate operation middleware
+func (siw *ServerInterfaceWrapper) GetDeletionCertificate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "certificate_id" -------------
+	var certificateId CertificateIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "certificate_id", chi.URLParam(r, "certificate_id"), &certificateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "certificate_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeletionCertificate(w, r, registryRef, certificateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistryDownloadOrigins operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistryDownloadOrigins(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRegistryDownloadOriginsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistryDownloadOrigins(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistryFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistryFeed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRegistryFeedParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeaderParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistryFeed(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteHelmPushPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHelmPushPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHelmPushPolicy(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHelmPushPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetHelmPushPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHelmPushPolicy(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetHelmPushPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetHelmPushPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetHelmPushPolicy(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchImageLayerContents operation middleware
+func (siw *ServerInterfaceWrapper) SearchImageLayerContents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchImageLayerContentsParams
+
+	// ------------- Required query parameter "query" -------------
+
+	if paramValue := r.URL.Query().Get("query"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchImageLayerContents(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLegalHolds operation middleware
+func (siw *ServerInterfaceWrapper) ListLegalHolds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLegalHolds(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateLegalHold operation middleware
+func (siw *ServerInterfaceWrapper) CreateLegalHold(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLegalHold(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLegalHold operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLegalHold(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "legal_hold_id" -------------
+	var legalHoldId LegalHoldIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "legal_hold_id", chi.URLParam(r, "legal_hold_id"), &legalHoldId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "legal_hold_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLegalHold(w, r, registryRef, legalHoldId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMavenRelocations operation middleware
+func (siw *ServerInterfaceWrapper) ListMavenRelocations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMavenRelocations(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMavenRelocation operation middleware
+func (siw *ServerInterfaceWrapper) CreateMavenRelocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMavenRelocation(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMavenRelocation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMavenRelocation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relocation_id" -------------
+	var relocationId RelocationIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "relocation_id", chi.URLParam(r, "relocation_id"), &relocationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relocation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMavenRelocation(w, r, registryRef, relocationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRegistryMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListRegistryMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRegistryMembers(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReorderRegistryMembers operation middleware
+func (siw *ServerInterfaceWrapper) ReorderRegistryMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReorderRegistryMembers(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMirrorSyncs operation middleware
+func (siw *ServerInterfaceWrapper) ListMirrorSyncs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMirrorSyncs(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMirrorSync operation middleware
+func (siw *ServerInterfaceWrapper) CreateMirrorSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMirrorSync(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMirrorSync operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMirrorSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "mirror_sync_id" -------------
+	var mirrorSyncId MirrorSyncIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mirror_sync_id", chi.URLParam(r, "mirror_sync_id"), &mirrorSyncId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mirror_sync_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMirrorSync(w, r, registryRef, mirrorSyncId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMirrorSync operation middleware
+func (siw *ServerInterfaceWrapper) GetMirrorSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "mirror_sync_id" -------------
+	var mirrorSyncId MirrorSyncIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mirror_sync_id", chi.URLParam(r, "mirror_sync_id"), &mirrorSyncId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mirror_sync_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMirrorSync(w, r, registryRef, mirrorSyncId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMirrorSync operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMirrorSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "mirror_sync_id" -------------
+	var mirrorSyncId MirrorSyncIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mirror_sync_id", chi.URLParam(r, "mirror_sync_id"), &mirrorSyncId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mirror_sync_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMirrorSync(w, r, registryRef, mirrorSyncId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMirrorSyncRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListMirrorSyncRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "mirror_sync_id" -------------
+	var mirrorSyncId MirrorSyncIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mirror_sync_id", chi.URLParam(r, "mirror_sync_id"), &mirrorSyncId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mirror_sync_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMirrorSyncRuns(w, r, registryRef, mirrorSyncId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunMirrorSync operation middleware
+func (siw *ServerInterfaceWrapper) RunMirrorSync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "mirror_sync_id" -------------
+	var mirrorSyncId MirrorSyncIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mirror_sync_id", chi.URLParam(r, "mirror_sync_id"), &mirrorSyncId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mirror_sync_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunMirrorSync(w, r, registryRef, mirrorSyncId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMirrorSyncRun operation middleware
+func (siw *ServerInterfaceWrapper) GetMirrorSyncRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "mirror_sync_id" -------------
+	var mirrorSyncId MirrorSyncIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mirror_sync_id", chi.URLParam(r, "mirror_sync_id"), &mirrorSyncId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mirror_sync_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "mirror_sync_run_id" -------------
+	var mirrorSyncRunId MirrorSyncRunIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "mirror_sync_run_id", chi.URLParam(r, "mirror_sync_run_id"), &mirrorSyncRunId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mirror_sync_run_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMirrorSyncRun(w, r, registryRef, mirrorSyncId, mirrorSyncRunId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SimulateRegistryPolicies operation middleware
+func (siw *ServerInterfaceWrapper) SimulateRegistryPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SimulateRegistryPolicies(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRegistryQueues operation middleware
+func (siw *ServerInterfaceWrapper) ListRegistryQueues(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRegistryQueues(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseRegistryQueue operation middleware
+func (siw *ServerInterfaceWrapper) PauseRegistryQueue(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "queue" -------------
+	var queue QueuePathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "queue", chi.URLParam(r, "queue"), &queue, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queue", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseRegistryQueue(w, r, registryRef, queue)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeRegistryQueue operation middleware
+func (siw *ServerInterfaceWrapper) ResumeRegistryQueue(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "queue" -------------
+	var queue QueuePathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "queue", chi.URLParam(r, "queue"), &queue, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queue", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeRegistryQueue(w, r, registryRef, queue)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetResolveTrace operation middleware
+func (siw *ServerInterfaceWrapper) GetResolveTrace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetResolveTraceParams
+
+	// ------------- Required query parameter "artifact" -------------
+
+	if paramValue := r.URL.Query().Get("artifact"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "artifact"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "artifact", r.URL.Query(), &params.Artifact)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifact", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "version" -------------
+
+	if paramValue := r.URL.Query().Get("version"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "version"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "file" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "file", r.URL.Query(), &params.File)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "file", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetResolveTrace(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResolveArtifactPath operation middleware
+func (siw *ServerInterfaceWrapper) ResolveArtifactPath(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResolveArtifactPathParams
+
+	// ------------- Required query parameter "path" -------------
+
+	if paramValue := r.URL.Query().Get("path"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "path"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "path", r.URL.Query(), &params.Path)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "path", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResolveArtifactPath(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListScanResults operation middleware
+func (siw *ServerInterfaceWrapper) ListScanResults(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScanResultsParams
+
+	// ------------- Required query parameter "digest" -------------
+
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListScanResults(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IngestScanResult operation middleware
+func (siw *ServerInterfaceWrapper) IngestScanResult(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestScanResult(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScanResult operation middleware
+func (siw *ServerInterfaceWrapper) GetScanResult(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "scan_id" -------------
+	var scanId ScanIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scan_id", chi.URLParam(r, "scan_id"), &scanId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scan_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScanResult(w, r, registryRef, scanId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVulnerabilityAllowlist operation middleware
+func (siw *ServerInterfaceWrapper) ListVulnerabilityAllowlist(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVulnerabilityAllowlist(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateVulnerabilityAllowlistEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVulnerabilityAllowlistEntry(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVulnerabilityAllowlistEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "allowlist_entry_id" -------------
+	var allowlistEntryId AllowlistEntryIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "allowlist_entry_id", chi.URLParam(r, "allowlist_entry_id"), &allowlistEntryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "allowlist_entry_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVulnerabilityAllowlistEntry(w, r, registryRef, allowlistEntryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRegistrySecurityPosture operation middleware
+func (siw *ServerInterfaceWrapper) GetRegistrySecurityPosture(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRegistrySecurityPosture(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCacheEvictionPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCacheEvictionPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCacheEvictionPolicy(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCacheEvictionPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCacheEvictionPolicy(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetCacheEvictionPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetCacheEvictionPolicy(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateUpstreamCredentials operation middleware
+func (siw *ServerInterfaceWrapper) RotateUpstreamCredentials(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateUpstreamCredentials(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPackageRuleViolations operation middleware
+func (siw *ServerInterfaceWrapper) ListPackageRuleViolations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPackageRuleViolationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPackageRuleViolations(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPackageRules operation middleware
+func (siw *ServerInterfaceWrapper) ListPackageRules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPackageRules(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePackageRule operation middleware
+func (siw *ServerInterfaceWrapper) CreatePackageRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePackageRule(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePackageRule operation middleware
+func (siw *ServerInterfaceWrapper) DeletePackageRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rule_id" -------------
+	var ruleId RuleIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rule_id", chi.URLParam(r, "rule_id"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rule_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePackageRule(w, r, registryRef, ruleId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PrewarmUpstreamCache operation middleware
+func (siw *ServerInterfaceWrapper) PrewarmUpstreamCache(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PrewarmUpstreamCache(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUpstreamCachePrewarm operation middleware
+func (siw *ServerInterfaceWrapper) GetUpstreamCachePrewarm(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "batch" -------------
+	var batch PrewarmBatchPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "batch", chi.URLParam(r, "batch"), &batch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "batch", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUpstreamCachePrewarm(w, r, registryRef, batch)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUpstreamStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetUpstreamStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUpstreamStatus(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUpstreamURLs operation middleware
+func (siw *ServerInterfaceWrapper) SetUpstreamURLs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUpstreamURLs(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVexStatements operation middleware
+func (siw *ServerInterfaceWrapper) ListVexStatements(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVexStatementsParams
+
+	// ------------- Required query parameter "digest" -------------
+
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVexStatements(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadVexDocument operation middleware
+func (siw *ServerInterfaceWrapper) UploadVexDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadVexDocumentParams
+
+	// ------------- Required query parameter "digest" -------------
+
+	if paramValue := r.URL.Query().Get("digest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "digest"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "digest", r.URL.Query(), &params.Digest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "digest", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadVexDocument(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVexDocument operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVexDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "vex_document_id" -------------
+	var vexDocumentId VexDocumentIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vex_document_id", chi.URLParam(r, "vex_document_id"), &vexDocumentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vex_document_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVexDocument(w, r, registryRef, vexDocumentId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhooksParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r, registryRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r, registryRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, registryRef, webhookIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhook(w, r, registryRef, webhookIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhook(w, r, registryRef, webhookIdentifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookExecutions operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookExecutions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookExecutionsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookExecutions(w, r, registryRef, webhookIdentifier, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookExecution operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_execution_id" -------------
+	var webhookExecutionId WebhookExecutionIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReTriggerWebhookExecution operation middleware
+func (siw *ServerInterfaceWrapper) ReTriggerWebhookExecution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "registry_ref" -------------
+	var registryRef RegistryRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "registry_ref", chi.URLParam(r, "registry_ref"), &registryRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "registry_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_identifier" -------------
+	var webhookIdentifier WebhookIdentifierPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_identifier", chi.URLParam(r, "webhook_identifier"), &webhookIdentifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "webhook_execution_id" -------------
+	var webhookExecutionId WebhookExecutionIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhook_execution_id", chi.URLParam(r, "webhook_execution_id"), &webhookExecutionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_execution_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReTriggerWebhookExecution(w, r, registryRef, webhookIdentifier, webhookExecutionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetArtifactStatsForSpace operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactStatsForSpace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArtifactStatsForSpaceParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArtifactStatsForSpace(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAllArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) GetAllArtifacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllArtifactsParams
+
+	// ------------- Optional query parameter "reg_identifier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reg_identifier", r.URL.Query(), &params.RegIdentifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reg_identifier", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "latest_version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "latest_version", r.URL.Query(), &params.LatestVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "latest_version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "package_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exact_count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exact_count", r.URL.Query(), &params.ExactCount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exact_count", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllArtifacts(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) ExportArtifacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportArtifactsParams
+
+	// ------------- Optional query parameter "reg_identifier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reg_identifier", r.URL.Query(), &params.RegIdentifier)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reg_identifier", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "latest_version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "latest_version", r.URL.Query(), &params.LatestVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "latest_version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "package_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportArtifacts(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClaimMappings operation middleware
+func (siw *ServerInterfaceWrapper) ListClaimMappings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClaimMappings(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateClaimMapping operation middleware
+func (siw *ServerInterfaceWrapper) CreateClaimMapping(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateClaimMapping(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteClaimMapping operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClaimMapping(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "claim_mapping_id" -------------
+	var claimMappingId ClaimMappingIdPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "claim_mapping_id", chi.URLParam(r, "claim_mapping_id"), &claimMappingId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "claim_mapping_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteClaimMapping(w, r, spaceRef, claimMappingId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDefaultRegistries operation middleware
+func (siw *ServerInterfaceWrapper) ListDefaultRegistries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDefaultRegistries(w, r, spaceRef)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteDefaultRegistry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDefaultRegistry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "package_type" -------------
+	var packageType PackageTypePathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "package_type", chi.URLParam(r, "package_type"), &packageType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDefaultRegistry(w, r, spaceRef, packageType)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResolveDefaultRegistry operation middleware
+func (siw *ServerInterfaceWrapper) ResolveDefaultRegistry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "package_type" -------------
+	var packageType PackageTypePathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "package_type", chi.URLParam(r, "package_type"), &packageType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResolveDefaultRegistry(w, r, spaceRef, packageType)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetDefaultRegistry operation middleware
+func (siw *ServerInterfaceWrapper) SetDefaultRegistry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "package_type" -------------
+	var packageType PackageTypePathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "package_type", chi.URLParam(r, "package_type"), &packageType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetDefaultRegistry(w, r, spaceRef, packageType)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAllRegistries operation middleware
+func (siw *ServerInterfaceWrapper) GetAllRegistries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllRegistriesParams
+
+	// ------------- Optional query parameter "package_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "package_type", r.URL.Query(), &params.PackageType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_order", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_field", r.URL.Query(), &params.SortField)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_field", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search_term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_term", r.URL.Query(), &params.SearchTerm)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search_term", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "recursive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recursive", r.URL.Query(), &params.Recursive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recursive", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAllRegistries(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPackageImpact operation middleware
+func (siw *ServerInterfaceWrapper) GetPackageImpact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "space_ref" -------------
+	var spaceRef SpaceRefPathParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "space_ref", chi.URLParam(r, "space_ref"), &spaceRef, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "space_ref", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackageImpactParams
+
+	// ------------- Required query parameter "package" -------------
+
+	if paramValue := r.URL.Query().Get("package"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "package"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "package", r.URL.Query(), &params.Package)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "range" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "range", r.URL.Query(), &params.Range)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "range", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "scheme" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scheme", r.URL.Query(), &params.Scheme)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheme", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "vulnerability" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vulnerability", r.URL.Query(), &params.Vulnerability)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vulnerability", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackageImpact(w, r, spaceRef, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompareVersions operation middleware
+func (siw *ServerInterfaceWrapper) CompareVersions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompareVersions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
 	return e.Err
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry", wrapper.CreateRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/bulk", wrapper.CreateRegistries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/config", wrapper.ApplyRegistryConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/identity/token", wrapper.ExchangeIdentityToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/onboarding", wrapper.OnboardRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/onboarding/identifier-check", wrapper.ValidateRegistryIdentifier)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/onboarding/import-estimate", wrapper.EstimateRegistryImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/onboarding/upstream-check", wrapper.CheckUpstreamConnectivity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/permalinks/{permalink_code}", wrapper.ResolvePermalink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}", wrapper.DeleteRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}", wrapper.GetRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}", wrapper.ModifyRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/access-grants", wrapper.ListAccessGrants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/access-grants", wrapper.CreateAccessGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/access-grants/{access_grant_id}", wrapper.RevokeAccessGrant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/annotations/search", wrapper.SearchDockerManifestsByAnnotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/labels", wrapper.ListArtifactLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/stats", wrapper.GetArtifactStatsForRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}", wrapper.DeleteArtifact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Head(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}", wrapper.HeadArtifact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/badge/{badge}", wrapper.GetArtifactBadge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/feed", wrapper.GetArtifactFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/labels", wrapper.UpdateArtifactLabels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/stats", wrapper.GetArtifactStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/summary", wrapper.GetArtifactSummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}", wrapper.DeleteArtifactVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Head(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}", wrapper.HeadArtifactVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/details", wrapper.GetArtifactDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details", wrapper.GetDockerArtifactDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers", wrapper.GetDockerArtifactLayers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest", wrapper.GetDockerArtifactManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests", wrapper.GetDockerArtifactManifests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/evidence", wrapper.ExportVersionEvidence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Head(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/file", wrapper.HeadArtifactFile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/files", wrapper.GetArtifactFiles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details", wrapper.GetHelmArtifactDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest", wrapper.GetHelmArtifactManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/model/details", wrapper.GetModelArtifactDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/permalink", wrapper.CreateArtifactVersionPermalink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/purge", wrapper.PurgeArtifactVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/storage", wrapper.GetArtifactVersionStorage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/summary", wrapper.GetArtifactVersionSummary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/versions", wrapper.GetAllArtifactVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifacts", wrapper.GetAllArtifactsByRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/artifacts/export", wrapper.ExportRegistryArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/classes/search", wrapper.SearchArtifactClasses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/client-setup-details", wrapper.GetClientSetupDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/clone", wrapper.CloneRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/config", wrapper.ExportRegistryConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/config/revisions", wrapper.ListRegistryConfigRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/config/revisions/{revision}", wrapper.GetRegistryConfigRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/config/revisions/{revision}/rollback", wrapper.RollbackRegistryConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/contents/search", wrapper.SearchArtifactContents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/deletion-certificates", wrapper.ListDeletionCertificates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/deletion-certificates/{certificate_id}", wrapper.GetDeletionCertificate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/download-origins", wrapper.GetRegistryDownloadOrigins)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/feed", wrapper.GetRegistryFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/helm/push-policy", wrapper.DeleteHelmPushPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/helm/push-policy", wrapper.GetHelmPushPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/helm/push-policy", wrapper.SetHelmPushPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/layers/search", wrapper.SearchImageLayerContents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/legal-holds", wrapper.ListLegalHolds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/legal-holds", wrapper.CreateLegalHold)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/legal-holds/{legal_hold_id}", wrapper.DeleteLegalHold)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/maven/relocations", wrapper.ListMavenRelocations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/maven/relocations", wrapper.CreateMavenRelocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/maven/relocations/{relocation_id}", wrapper.DeleteMavenRelocation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/members", wrapper.ListRegistryMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/members", wrapper.ReorderRegistryMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/mirror-syncs", wrapper.ListMirrorSyncs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/mirror-syncs", wrapper.CreateMirrorSync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/mirror-syncs/{mirror_sync_id}", wrapper.DeleteMirrorSync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/mirror-syncs/{mirror_sync_id}", wrapper.GetMirrorSync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/mirror-syncs/{mirror_sync_id}", wrapper.UpdateMirrorSync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs", wrapper.ListMirrorSyncRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs", wrapper.RunMirrorSync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs/{mirror_sync_run_id}", wrapper.GetMirrorSyncRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/policies/simulate", wrapper.SimulateRegistryPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/queues", wrapper.ListRegistryQueues)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/queues/{queue}/pause", wrapper.PauseRegistryQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/queues/{queue}/resume", wrapper.ResumeRegistryQueue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/resolve", wrapper.GetResolveTrace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/resolve/path", wrapper.ResolveArtifactPath)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/scans", wrapper.ListScanResults)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/scans", wrapper.IngestScanResult)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/scans/{scan_id}", wrapper.GetScanResult)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/security/allowlist", wrapper.ListVulnerabilityAllowlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/security/allowlist", wrapper.CreateVulnerabilityAllowlistEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/security/allowlist/{allowlist_entry_id}", wrapper.DeleteVulnerabilityAllowlistEntry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/security/posture", wrapper.GetRegistrySecurityPosture)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/upstream/cache-eviction", wrapper.DeleteCacheEvictionPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/upstream/cache-eviction", wrapper.GetCacheEvictionPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/upstream/cache-eviction", wrapper.SetCacheEvictionPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/upstream/credentials", wrapper.RotateUpstreamCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/upstream/package-rule-violations", wrapper.ListPackageRuleViolations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/upstream/package-rules", wrapper.ListPackageRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/upstream/package-rules", wrapper.CreatePackageRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/upstream/package-rules/{rule_id}", wrapper.DeletePackageRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/upstream/prewarm", wrapper.PrewarmUpstreamCache)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/upstream/prewarm/{batch}", wrapper.GetUpstreamCachePrewarm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/upstream/status", wrapper.GetUpstreamStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/upstream/urls", wrapper.SetUpstreamURLs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/vex", wrapper.ListVexStatements)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/vex", wrapper.UploadVexDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/vex/{vex_document_id}", wrapper.DeleteVexDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions", wrapper.ListWebhookExecutions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}", wrapper.GetWebhookExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger", wrapper.ReTriggerWebhookExecution)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/artifact/stats", wrapper.GetArtifactStatsForSpace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/artifacts", wrapper.GetAllArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/artifacts/export", wrapper.ExportArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/claim-mappings", wrapper.ListClaimMappings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/spaces/{space_ref}/claim-mappings", wrapper.CreateClaimMapping)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/spaces/{space_ref}/claim-mappings/{claim_mapping_id}", wrapper.DeleteClaimMapping)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/default-registries", wrapper.ListDefaultRegistries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/spaces/{space_ref}/default-registries/{package_type}", wrapper.DeleteDefaultRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/default-registries/{package_type}", wrapper.ResolveDefaultRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/spaces/{space_ref}/default-registries/{package_type}", wrapper.SetDefaultRegistry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/registries", wrapper.GetAllRegistries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/spaces/{space_ref}/security/impact", wrapper.GetPackageImpact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/versions/compare", wrapper.CompareVersions)
+	})
+
+	return r
+}
+
+type AccessGrantResponseJSONResponse struct {
+	Data AccessGrant `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactBadgeResponseResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+type ArtifactBadgeResponseImagesvgXmlResponse struct {
+	Body io.Reader
+
+	Headers       ArtifactBadgeResponseResponseHeaders
+	ContentLength int64
+}
+
+type ArtifactDetailResponseJSONResponse struct {
+	// Data Artifact Detail
+	Data ArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactExistsResponseResponseHeaders struct {
+	XArtifactLatestVersion string
+	XArtifactVersionCount  int64
+}
+type ArtifactExistsResponseResponse struct {
+	Headers ArtifactExistsResponseResponseHeaders
+}
+
+type ArtifactExportResponseTextcsvResponse struct {
+	Body io.Reader
+
+	ContentLength int64
+}
+
+type ArtifactLabelResponseJSONResponse struct {
+	// Data Harness Artifact Summary
+	Data ArtifactSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactStatsResponseJSONResponse struct {
+	// Data Harness Artifact Stats
+	Data ArtifactStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactSummaryResponseJSONResponse struct {
+	// Data Harness Artifact Summary
+	Data ArtifactSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ArtifactVersionSummaryResponseJSONResponse struct {
+	// Data Docker Artifact Version Summary
+	Data ArtifactVersionSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type BadRequestJSONResponse Error
+
+type BulkRegistryResponseJSONResponse struct {
+	// Data Result of each registry of a bulk request, in the order of the request
+	Data BulkRegistryResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type CacheEvictionPolicyResponseJSONResponse struct {
+	Data CacheEvictionPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type CachePrewarmResponseJSONResponse struct {
+	Data CachePrewarm `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ClaimMappingResponseJSONResponse struct {
+	Data ClaimMapping `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ClientSetupDetailsResponseJSONResponse struct {
+	// Data Client Setup Details
+	Data ClientSetupDetails `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DefaultRegistryResponseJSONResponse struct {
+	Data DefaultRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DeletionCertificateResponseJSONResponse struct {
+	Data DeletionCertificate `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerArtifactDetailResponseJSONResponse struct {
+	// Data Docker Artifact Detail
+	Data DockerArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerArtifactManifestResponseJSONResponse struct {
+	// Data Docker Artifact Manifest
+	Data DockerArtifactManifest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerLayersResponseJSONResponse struct {
+	// Data Harness Layers Summary
+	Data DockerLayersSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type DockerManifestsResponseJSONResponse struct {
+	// Data Harness Manifests
+	Data DockerManifests `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type EvidenceBundleResponseResponseHeaders struct {
+	ContentDisposition string
+	XEvidenceSigned    bool
+}
+type EvidenceBundleResponseApplicationgzipResponse struct {
+	Body io.Reader
+
+	Headers       EvidenceBundleResponseResponseHeaders
+	ContentLength int64
+}
+
+type FeedResponseResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+type FeedResponseApplicationatomXmlResponse struct {
+	Body io.Reader
+
+	Headers       FeedResponseResponseHeaders
+	ContentLength int64
+}
+
+type FileDetailResponseJSONResponse struct {
+	// Files A list of Harness Artifact Files
+	Files []FileDetail `json:"files"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type FileExistsResponseResponseHeaders struct {
+	XArtifactDigest string
+	XArtifactSize   int64
+}
+type FileExistsResponseResponse struct {
+	Headers FileExistsResponseResponseHeaders
+}
+
+type HelmArtifactDetailResponseJSONResponse struct {
+	// Data Helm Artifact Detail
+	Data HelmArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type HelmArtifactManifestResponseJSONResponse struct {
+	// Data Helm Artifact Manifest
+	Data HelmArtifactManifest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type HelmPushPolicyResponseJSONResponse struct {
+	Data HelmPushPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type IdentityTokenResponseJSONResponse struct {
+	Data IdentityToken `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ImportEstimateResponseJSONResponse struct {
+	// Data Estimated size of an import. The sizes of the sampled tags are extrapolated to all tags, counting layers shared by sampled tags once.
+	Data ImportEstimate `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type InternalServerErrorJSONResponse Error
+
+type LegalHoldResponseJSONResponse struct {
+	Data LegalHold `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListAccessGrantsResponseJSONResponse struct {
+	Data []AccessGrant `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListAnnotatedManifestsResponseJSONResponse struct {
+	// Data A list of annotated manifests
+	Data ListAnnotatedManifests `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactClassesResponseJSONResponse struct {
+	// Data A list of Java classes inside artifacts
+	Data ListArtifactClasses `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactContentsResponseJSONResponse struct {
+	// Data A list of files inside artifacts
+	Data ListArtifactContents `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactLabelResponseJSONResponse struct {
+	// Data A list of Harness Artifact Labels
+	Data ListArtifactLabel `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactResponseJSONResponse struct {
+	// Data A list of Artifacts
+	Data ListArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListArtifactVersionResponseJSONResponse struct {
+	// Data A list of Artifact versions
+	Data ListArtifactVersion `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListClaimMappingsResponseJSONResponse struct {
+	Data []ClaimMapping `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListDefaultRegistriesResponseJSONResponse struct {
+	Data []DefaultRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListDeletionCertificatesResponseJSONResponse struct {
+	// Data A list of deletion certificates
+	Data ListDeletionCertificates `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListImageLayerContentsResponseJSONResponse struct {
+	// Data A list of files inside image layers
+	Data ListImageLayerContents `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListLegalHoldsResponseJSONResponse struct {
+	Data []LegalHold `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListMavenRelocationsResponseJSONResponse struct {
+	Data []MavenRelocation `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListMirrorSyncRunsResponseJSONResponse struct {
+	Data []MirrorSyncRun `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListMirrorSyncsResponseJSONResponse struct {
+	Data []MirrorSync `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListPackageRuleViolationsResponseJSONResponse struct {
+	// Data A list of package rule violations
+	Data ListPackageRuleViolations `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListPackageRulesResponseJSONResponse struct {
+	Data []PackageRule `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryArtifactResponseJSONResponse struct {
+	// Data A list of Artifacts
+	Data ListRegistryArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryConfigRevisionsResponseJSONResponse struct {
+	// Data A list of revisions of the configuration of a registry
+	Data ListRegistryConfigRevisions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryQueuesResponseJSONResponse struct {
+	Data []RegistryQueue `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListRegistryResponseJSONResponse struct {
+	// Data A list of Harness Artifact Registries
+	Data ListRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListScanResultsResponseJSONResponse struct {
+	Data []ScanResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListVexStatementsResponseJSONResponse struct {
+	Data []VexStatement `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListVulnerabilityAllowlistResponseJSONResponse struct {
+	Data []VulnerabilityAllowlistEntry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListWebhooksExecutionResponseJSONResponse struct {
+	// Data A list of Harness Registries webhooks executions
+	Data ListWebhooksExecutions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ListWebhooksResponseJSONResponse struct {
+	// Data A list of Harness Registries webhooks
+	Data ListWebhooks `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type MavenRelocationResponseJSONResponse struct {
+	Data MavenRelocation `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type MirrorSyncResponseJSONResponse struct {
+	Data MirrorSync `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type MirrorSyncRunResponseJSONResponse struct {
+	Data MirrorSyncRun `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ModelArtifactDetailResponseJSONResponse struct {
+	// Data ML Model Artifact Detail
+	Data ModelArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type NotFoundJSONResponse Error
+
+type NotModifiedResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+type NotModifiedResponse struct {
+	Headers NotModifiedResponseHeaders
+}
+
+type PackageImpactResponseJSONResponse struct {
+	Data PackageImpactReport `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type PackageRuleResponseJSONResponse struct {
+	Data PackageRule `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type PermalinkResponseJSONResponse struct {
+	// Data Permalink of an artifact version.
+	Data Permalink `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RedirectResponseHeaders struct {
+	Location string
+}
+type RedirectResponse struct {
+	Headers RedirectResponseHeaders
+}
+
+type RegistryConfigApplyResponseJSONResponse struct {
+	// Data Changes that applying a registry configuration made, or would make on a dry run
+	Data RegistryConfigApplyResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryConfigResponseJSONResponse struct {
+	Data RegistryConfigExport `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryConfigRevisionResponseJSONResponse struct {
+	Data RegistryConfigRevisionDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryDownloadOriginsResponseJSONResponse struct {
+	// Data Downloads of the artifacts of a registry by where they were downloaded from
+	Data RegistryDownloadOrigins `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryIdentifierCheckResponseJSONResponse struct {
+	// Data Whether a registry can be created with an identifier
+	Data RegistryIdentifierCheck `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryMembersResponseJSONResponse struct {
+	Data []RegistryMember `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryOnboardingResponseJSONResponse struct {
+	// Data Registry created by the onboarding along with the defaults applied to it
+	Data RegistryOnboarding `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryPolicySimulationResponseJSONResponse struct {
+	// Data Outcome of draft policies on the versions of a registry. Tags of OCI registries are evaluated, along with their pulls, and versions of other registries by age only.
+	Data RegistryPolicySimulation `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryQueueResponseJSONResponse struct {
+	// Data Backlog of a queue of background operations of a registry
+	Data RegistryQueue `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistryResponseJSONResponse struct {
+	// Data Harness Artifact Registry
+	Data Registry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type RegistrySecurityPostureResponseJSONResponse struct {
+	// Data Security state of the versions of a registry
+	Data RegistrySecurityPosture `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ResolveTraceResponseJSONResponse struct {
+	Data ResolveTrace `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type ScanResultResponseJSONResponse struct {
+	// Data Scan result of an artifact
+	Data ScanResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type SuccessJSONResponse struct {
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type UnauthenticatedJSONResponse Error
+
+type UnauthorizedJSONResponse Error
+
+type UpstreamCheckResponseJSONResponse struct {
+	// Data Result of checking an upstream
+	Data UpstreamCheck `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type UpstreamStatusResponseJSONResponse struct {
+	Data UpstreamStatus `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type VersionComparisonResponseJSONResponse struct {
+	// Data Versions sorted in ascending order
+	Data VersionComparison `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type VersionExistsResponseResponseHeaders struct {
+	XArtifactDigest string
+	XArtifactSize   int64
+}
+type VersionExistsResponseResponse struct {
+	Headers VersionExistsResponseResponseHeaders
+}
+
+type VersionStorageResponseJSONResponse struct {
+	Data VersionStorage `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type VexDocumentResponseJSONResponse struct {
+	// Data VEX document attached to an artifact
+	Data VexDocument `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type VulnerabilityAllowlistEntryResponseJSONResponse struct {
+	// Data Vulnerability allowlisted for a package, or one of its artifacts, until an expiry date
+	Data VulnerabilityAllowlistEntry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type WebhookExecutionResponseJSONResponse struct {
+	// Data Harness Regstries Webhook Execution
+	Data WebhookExecution `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type WebhookResponseJSONResponse struct {
+	// Data Harness Regstries Webhook
+	Data Webhook `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+type CreateRegistryRequestObject struct {
+	Params CreateRegistryParams
+	Body   *CreateRegistryJSONRequestBody
+}
+
+type CreateRegistryResponseObject interface {
+	VisitCreateRegistryResponse(w http.ResponseWriter) error
+}
+
+type CreateRegistry201JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response CreateRegistry201JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateRegistry400JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateRegistry401JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateRegistry403JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateRegistry500JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistriesRequestObject struct {
+	Body *CreateRegistriesJSONRequestBody
+}
+
+type CreateRegistriesResponseObject interface {
+	VisitCreateRegistriesResponse(w http.ResponseWriter) error
+}
+
+type CreateRegistries200JSONResponse struct {
+	BulkRegistryResponseJSONResponse
+}
+
+func (response CreateRegistries200JSONResponse) VisitCreateRegistriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistries400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateRegistries400JSONResponse) VisitCreateRegistriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistries401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateRegistries401JSONResponse) VisitCreateRegistriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistries403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateRegistries403JSONResponse) VisitCreateRegistriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateRegistries500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateRegistries500JSONResponse) VisitCreateRegistriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfigRequestObject struct {
+	Body *ApplyRegistryConfigJSONRequestBody
+}
+
+type ApplyRegistryConfigResponseObject interface {
+	VisitApplyRegistryConfigResponse(w http.ResponseWriter) error
+}
+
+type ApplyRegistryConfig200JSONResponse struct {
+	RegistryConfigApplyResponseJSONResponse
+}
+
+func (response ApplyRegistryConfig200JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApplyRegistryConfig400JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ApplyRegistryConfig401JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApplyRegistryConfig403JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyRegistryConfig500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApplyRegistryConfig500JSONResponse) VisitApplyRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExchangeIdentityTokenRequestObject struct {
+	Body *ExchangeIdentityTokenJSONRequestBody
+}
+
+type ExchangeIdentityTokenResponseObject interface {
+	VisitExchangeIdentityTokenResponse(w http.ResponseWriter) error
+}
+
+type ExchangeIdentityToken200JSONResponse struct {
+	IdentityTokenResponseJSONResponse
+}
+
+func (response ExchangeIdentityToken200JSONResponse) VisitExchangeIdentityTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExchangeIdentityToken400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExchangeIdentityToken400JSONResponse) VisitExchangeIdentityTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExchangeIdentityToken401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ExchangeIdentityToken401JSONResponse) VisitExchangeIdentityTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExchangeIdentityToken500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ExchangeIdentityToken500JSONResponse) VisitExchangeIdentityTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OnboardRegistryRequestObject struct {
+	Body *OnboardRegistryJSONRequestBody
+}
+
+type OnboardRegistryResponseObject interface {
+	VisitOnboardRegistryResponse(w http.ResponseWriter) error
+}
+
+type OnboardRegistry201JSONResponse struct {
+	RegistryOnboardingResponseJSONResponse
+}
+
+func (response OnboardRegistry201JSONResponse) VisitOnboardRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OnboardRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response OnboardRegistry400JSONResponse) VisitOnboardRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OnboardRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response OnboardRegistry401JSONResponse) VisitOnboardRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OnboardRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response OnboardRegistry403JSONResponse) VisitOnboardRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type OnboardRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response OnboardRegistry500JSONResponse) VisitOnboardRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateRegistryIdentifierRequestObject struct {
+	Body *ValidateRegistryIdentifierJSONRequestBody
+}
+
+type ValidateRegistryIdentifierResponseObject interface {
+	VisitValidateRegistryIdentifierResponse(w http.ResponseWriter) error
+}
+
+type ValidateRegistryIdentifier200JSONResponse struct {
+	RegistryIdentifierCheckResponseJSONResponse
+}
+
+func (response ValidateRegistryIdentifier200JSONResponse) VisitValidateRegistryIdentifierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateRegistryIdentifier400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ValidateRegistryIdentifier400JSONResponse) VisitValidateRegistryIdentifierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateRegistryIdentifier401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ValidateRegistryIdentifier401JSONResponse) VisitValidateRegistryIdentifierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateRegistryIdentifier403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ValidateRegistryIdentifier403JSONResponse) VisitValidateRegistryIdentifierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateRegistryIdentifier500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ValidateRegistryIdentifier500JSONResponse) VisitValidateRegistryIdentifierResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateRegistryImportRequestObject struct {
+	Body *EstimateRegistryImportJSONRequestBody
+}
+
+type EstimateRegistryImportResponseObject interface {
+	VisitEstimateRegistryImportResponse(w http.ResponseWriter) error
+}
+
+type EstimateRegistryImport200JSONResponse struct {
+	ImportEstimateResponseJSONResponse
+}
+
+func (response EstimateRegistryImport200JSONResponse) VisitEstimateRegistryImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateRegistryImport400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EstimateRegistryImport400JSONResponse) VisitEstimateRegistryImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateRegistryImport401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response EstimateRegistryImport401JSONResponse) VisitEstimateRegistryImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateRegistryImport403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response EstimateRegistryImport403JSONResponse) VisitEstimateRegistryImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateRegistryImport500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response EstimateRegistryImport500JSONResponse) VisitEstimateRegistryImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckUpstreamConnectivityRequestObject struct {
+	Body *CheckUpstreamConnectivityJSONRequestBody
+}
+
+type CheckUpstreamConnectivityResponseObject interface {
+	VisitCheckUpstreamConnectivityResponse(w http.ResponseWriter) error
+}
+
+type CheckUpstreamConnectivity200JSONResponse struct {
+	UpstreamCheckResponseJSONResponse
+}
+
+func (response CheckUpstreamConnectivity200JSONResponse) VisitCheckUpstreamConnectivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckUpstreamConnectivity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CheckUpstreamConnectivity400JSONResponse) VisitCheckUpstreamConnectivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckUpstreamConnectivity401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CheckUpstreamConnectivity401JSONResponse) VisitCheckUpstreamConnectivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckUpstreamConnectivity403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CheckUpstreamConnectivity403JSONResponse) VisitCheckUpstreamConnectivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckUpstreamConnectivity500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CheckUpstreamConnectivity500JSONResponse) VisitCheckUpstreamConnectivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolvePermalinkRequestObject struct {
+	PermalinkCode PermalinkCodePathParam `json:"permalink_code"`
+}
+
+type ResolvePermalinkResponseObject interface {
+	VisitResolvePermalinkResponse(w http.ResponseWriter) error
+}
+
+type ResolvePermalink302Response = RedirectResponse
+
+func (response ResolvePermalink302Response) VisitResolvePermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type ResolvePermalink400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ResolvePermalink400JSONResponse) VisitResolvePermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolvePermalink401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ResolvePermalink401JSONResponse) VisitResolvePermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolvePermalink403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResolvePermalink403JSONResponse) VisitResolvePermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolvePermalink404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResolvePermalink404JSONResponse) VisitResolvePermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolvePermalink500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ResolvePermalink500JSONResponse) VisitResolvePermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type DeleteRegistryResponseObject interface {
+	VisitDeleteRegistryResponse(w http.ResponseWriter) error
+}
+
+type DeleteRegistry200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteRegistry200JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteRegistry400JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteRegistry401JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteRegistry403JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteRegistry404JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteRegistry500JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetRegistryResponseObject interface {
+	VisitGetRegistryResponse(w http.ResponseWriter) error
+}
+
+type GetRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response GetRegistry200JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistry400JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistry401JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistry403JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistry404JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistry500JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *ModifyRegistryJSONRequestBody
+}
+
+type ModifyRegistryResponseObject interface {
+	VisitModifyRegistryResponse(w http.ResponseWriter) error
+}
+
+type ModifyRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response ModifyRegistry200JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ModifyRegistry400JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ModifyRegistry401JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ModifyRegistry403JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ModifyRegistry404JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModifyRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ModifyRegistry500JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccessGrantsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListAccessGrantsResponseObject interface {
+	VisitListAccessGrantsResponse(w http.ResponseWriter) error
+}
+
+type ListAccessGrants200JSONResponse struct {
+	ListAccessGrantsResponseJSONResponse
+}
+
+func (response ListAccessGrants200JSONResponse) VisitListAccessGrantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccessGrants400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListAccessGrants400JSONResponse) VisitListAccessGrantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccessGrants401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListAccessGrants401JSONResponse) VisitListAccessGrantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccessGrants403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListAccessGrants403JSONResponse) VisitListAccessGrantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccessGrants404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListAccessGrants404JSONResponse) VisitListAccessGrantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccessGrants500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListAccessGrants500JSONResponse) VisitListAccessGrantsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessGrantRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateAccessGrantJSONRequestBody
+}
+
+type CreateAccessGrantResponseObject interface {
+	VisitCreateAccessGrantResponse(w http.ResponseWriter) error
+}
+
+type CreateAccessGrant201JSONResponse struct {
+	AccessGrantResponseJSONResponse
+}
+
+func (response CreateAccessGrant201JSONResponse) VisitCreateAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessGrant400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateAccessGrant400JSONResponse) VisitCreateAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessGrant401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateAccessGrant401JSONResponse) VisitCreateAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessGrant403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateAccessGrant403JSONResponse) VisitCreateAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessGrant404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateAccessGrant404JSONResponse) VisitCreateAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccessGrant500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateAccessGrant500JSONResponse) VisitCreateAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAccessGrantRequestObject struct {
+	RegistryRef   RegistryRefPathParam   `json:"registry_ref"`
+	AccessGrantId AccessGrantIdPathParam `json:"access_grant_id"`
+}
+
+type RevokeAccessGrantResponseObject interface {
+	VisitRevokeAccessGrantResponse(w http.ResponseWriter) error
+}
+
+type RevokeAccessGrant200JSONResponse struct{ SuccessJSONResponse }
+
+func (response RevokeAccessGrant200JSONResponse) VisitRevokeAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAccessGrant400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RevokeAccessGrant400JSONResponse) VisitRevokeAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAccessGrant401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RevokeAccessGrant401JSONResponse) VisitRevokeAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAccessGrant403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeAccessGrant403JSONResponse) VisitRevokeAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAccessGrant404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeAccessGrant404JSONResponse) VisitRevokeAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAccessGrant500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RevokeAccessGrant500JSONResponse) VisitRevokeAccessGrantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchDockerManifestsByAnnotationRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      SearchDockerManifestsByAnnotationParams
+}
+
+type SearchDockerManifestsByAnnotationResponseObject interface {
+	VisitSearchDockerManifestsByAnnotationResponse(w http.ResponseWriter) error
+}
+
+type SearchDockerManifestsByAnnotation200JSONResponse struct {
+	ListAnnotatedManifestsResponseJSONResponse
+}
+
+func (response SearchDockerManifestsByAnnotation200JSONResponse) VisitSearchDockerManifestsByAnnotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchDockerManifestsByAnnotation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchDockerManifestsByAnnotation400JSONResponse) VisitSearchDockerManifestsByAnnotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchDockerManifestsByAnnotation401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SearchDockerManifestsByAnnotation401JSONResponse) VisitSearchDockerManifestsByAnnotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchDockerManifestsByAnnotation403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SearchDockerManifestsByAnnotation403JSONResponse) VisitSearchDockerManifestsByAnnotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchDockerManifestsByAnnotation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SearchDockerManifestsByAnnotation404JSONResponse) VisitSearchDockerManifestsByAnnotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchDockerManifestsByAnnotation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SearchDockerManifestsByAnnotation500JSONResponse) VisitSearchDockerManifestsByAnnotationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabelsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListArtifactLabelsParams
+}
+
+type ListArtifactLabelsResponseObject interface {
+	VisitListArtifactLabelsResponse(w http.ResponseWriter) error
+}
+
+type ListArtifactLabels200JSONResponse struct {
+	ListArtifactLabelResponseJSONResponse
+}
+
+func (response ListArtifactLabels200JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListArtifactLabels400JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListArtifactLabels401JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListArtifactLabels403JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListArtifactLabels404JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListArtifactLabels500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListArtifactLabels500JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetArtifactStatsForRegistryParams
+}
+
+type GetArtifactStatsForRegistryResponseObject interface {
+	VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactStatsForRegistry200JSONResponse struct {
+	ArtifactStatsResponseJSONResponse
+}
+
+func (response GetArtifactStatsForRegistry200JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactStatsForRegistry400JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactStatsForRegistry401JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactStatsForRegistry403JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactStatsForRegistry404JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactStatsForRegistry500JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+}
+
+type DeleteArtifactResponseObject interface {
+	VisitDeleteArtifactResponse(w http.ResponseWriter) error
+}
+
+type DeleteArtifact200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteArtifact200JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteArtifact400JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteArtifact401JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteArtifact403JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArtifact404JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifact500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteArtifact500JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+}
+
+type HeadArtifactResponseObject interface {
+	VisitHeadArtifactResponse(w http.ResponseWriter) error
+}
+
+type HeadArtifact200Response = ArtifactExistsResponseResponse
+
+func (response HeadArtifact200Response) VisitHeadArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Artifact-Latest-Version", fmt.Sprint(response.Headers.XArtifactLatestVersion))
+	w.Header().Set("X-Artifact-Version-Count", fmt.Sprint(response.Headers.XArtifactVersionCount))
+	w.WriteHeader(200)
+	return nil
+}
+
+type HeadArtifact400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response HeadArtifact400JSONResponse) VisitHeadArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifact401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response HeadArtifact401JSONResponse) VisitHeadArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifact403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response HeadArtifact403JSONResponse) VisitHeadArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifact404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response HeadArtifact404JSONResponse) VisitHeadArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifact500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response HeadArtifact500JSONResponse) VisitHeadArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactBadgeRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Badge       BadgePathParam       `json:"badge"`
+	Params      GetArtifactBadgeParams
+}
+
+type GetArtifactBadgeResponseObject interface {
+	VisitGetArtifactBadgeResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactBadge200ImagesvgXmlResponse struct {
+	ArtifactBadgeResponseImagesvgXmlResponse
+}
+
+func (response GetArtifactBadge200ImagesvgXmlResponse) VisitGetArtifactBadgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetArtifactBadge304Response = NotModifiedResponse
+
+func (response GetArtifactBadge304Response) VisitGetArtifactBadgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetArtifactBadge400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactBadge400JSONResponse) VisitGetArtifactBadgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactBadge401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactBadge401JSONResponse) VisitGetArtifactBadgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactBadge403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactBadge403JSONResponse) VisitGetArtifactBadgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactBadge404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactBadge404JSONResponse) VisitGetArtifactBadgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactBadge500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactBadge500JSONResponse) VisitGetArtifactBadgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFeedRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetArtifactFeedParams
+}
+
+type GetArtifactFeedResponseObject interface {
+	VisitGetArtifactFeedResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactFeed200ApplicationatomXmlResponse struct {
+	FeedResponseApplicationatomXmlResponse
+}
+
+func (response GetArtifactFeed200ApplicationatomXmlResponse) VisitGetArtifactFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/atom+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetArtifactFeed304Response = NotModifiedResponse
+
+func (response GetArtifactFeed304Response) VisitGetArtifactFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetArtifactFeed400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactFeed400JSONResponse) VisitGetArtifactFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFeed401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactFeed401JSONResponse) VisitGetArtifactFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFeed403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactFeed403JSONResponse) VisitGetArtifactFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFeed404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactFeed404JSONResponse) VisitGetArtifactFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFeed500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactFeed500JSONResponse) VisitGetArtifactFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabelsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Body        *UpdateArtifactLabelsJSONRequestBody
+}
+
+type UpdateArtifactLabelsResponseObject interface {
+	VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error
+}
+
+type UpdateArtifactLabels200JSONResponse struct {
+	ArtifactLabelResponseJSONResponse
+}
+
+func (response UpdateArtifactLabels200JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateArtifactLabels400JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UpdateArtifactLabels401JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateArtifactLabels403JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateArtifactLabels404JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateArtifactLabels500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateArtifactLabels500JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetArtifactStatsParams
+}
+
+type GetArtifactStatsResponseObject interface {
+	VisitGetArtifactStatsResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactStats200JSONResponse struct {
+	ArtifactStatsResponseJSONResponse
+}
+
+func (response GetArtifactStats200JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactStats400JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactStats401JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactStats403JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactStats404JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStats500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactStats500JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummaryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+}
+
+type GetArtifactSummaryResponseObject interface {
+	VisitGetArtifactSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactSummary200JSONResponse struct {
+	ArtifactSummaryResponseJSONResponse
+}
+
+func (response GetArtifactSummary200JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactSummary400JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactSummary401JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactSummary403JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactSummary404JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactSummary500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactSummary500JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type DeleteArtifactVersionResponseObject interface {
+	VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error
+}
+
+type DeleteArtifactVersion200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteArtifactVersion200JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteArtifactVersion400JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteArtifactVersion401JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteArtifactVersion403JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteArtifactVersion404JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteArtifactVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteArtifactVersion500JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactVersionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type HeadArtifactVersionResponseObject interface {
+	VisitHeadArtifactVersionResponse(w http.ResponseWriter) error
+}
+
+type HeadArtifactVersion200Response = VersionExistsResponseResponse
+
+func (response HeadArtifactVersion200Response) VisitHeadArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Artifact-Digest", fmt.Sprint(response.Headers.XArtifactDigest))
+	w.Header().Set("X-Artifact-Size", fmt.Sprint(response.Headers.XArtifactSize))
+	w.WriteHeader(200)
+	return nil
+}
+
+type HeadArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response HeadArtifactVersion400JSONResponse) VisitHeadArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response HeadArtifactVersion401JSONResponse) VisitHeadArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response HeadArtifactVersion403JSONResponse) VisitHeadArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response HeadArtifactVersion404JSONResponse) VisitHeadArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response HeadArtifactVersion500JSONResponse) VisitHeadArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetArtifactDetailsParams
+}
+
+type GetArtifactDetailsResponseObject interface {
+	VisitGetArtifactDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactDetails200JSONResponse struct {
+	ArtifactDetailResponseJSONResponse
+}
+
+func (response GetArtifactDetails200JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactDetails400JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactDetails401JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactDetails403JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactDetails404JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactDetails500JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetDockerArtifactDetailsParams
+}
+
+type GetDockerArtifactDetailsResponseObject interface {
+	VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactDetails200JSONResponse struct {
+	DockerArtifactDetailResponseJSONResponse
+}
+
+func (response GetDockerArtifactDetails200JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactDetails400JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactDetails401JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactDetails403JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactDetails404JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactDetails500JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayersRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetDockerArtifactLayersParams
+}
+
+type GetDockerArtifactLayersResponseObject interface {
+	VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactLayers200JSONResponse struct {
+	DockerLayersResponseJSONResponse
+}
+
+func (response GetDockerArtifactLayers200JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactLayers400JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactLayers401JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactLayers403JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactLayers404JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactLayers500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactLayers500JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifestRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetDockerArtifactManifestParams
+}
+
+type GetDockerArtifactManifestResponseObject interface {
+	VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactManifest200JSONResponse struct {
+	DockerArtifactManifestResponseJSONResponse
+}
+
+func (response GetDockerArtifactManifest200JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactManifest400JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactManifest401JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactManifest403JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactManifest404JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactManifest500JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifestsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetDockerArtifactManifestsResponseObject interface {
+	VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error
+}
+
+type GetDockerArtifactManifests200JSONResponse struct {
+	DockerManifestsResponseJSONResponse
+}
+
+func (response GetDockerArtifactManifests200JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDockerArtifactManifests400JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDockerArtifactManifests401JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDockerArtifactManifests403JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDockerArtifactManifests404JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDockerArtifactManifests500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDockerArtifactManifests500JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportVersionEvidenceRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type ExportVersionEvidenceResponseObject interface {
+	VisitExportVersionEvidenceResponse(w http.ResponseWriter) error
+}
+
+type ExportVersionEvidence200ApplicationgzipResponse struct {
+	EvidenceBundleResponseApplicationgzipResponse
+}
+
+func (response ExportVersionEvidence200ApplicationgzipResponse) VisitExportVersionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("X-Evidence-Signed", fmt.Sprint(response.Headers.XEvidenceSigned))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportVersionEvidence400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportVersionEvidence400JSONResponse) VisitExportVersionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportVersionEvidence401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ExportVersionEvidence401JSONResponse) VisitExportVersionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportVersionEvidence403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportVersionEvidence403JSONResponse) VisitExportVersionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportVersionEvidence404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportVersionEvidence404JSONResponse) VisitExportVersionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportVersionEvidence500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ExportVersionEvidence500JSONResponse) VisitExportVersionEvidenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactFileRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      HeadArtifactFileParams
+}
+
+type HeadArtifactFileResponseObject interface {
+	VisitHeadArtifactFileResponse(w http.ResponseWriter) error
+}
+
+type HeadArtifactFile200Response = FileExistsResponseResponse
+
+func (response HeadArtifactFile200Response) VisitHeadArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Artifact-Digest", fmt.Sprint(response.Headers.XArtifactDigest))
+	w.Header().Set("X-Artifact-Size", fmt.Sprint(response.Headers.XArtifactSize))
+	w.WriteHeader(200)
+	return nil
+}
+
+type HeadArtifactFile400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response HeadArtifactFile400JSONResponse) VisitHeadArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactFile401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response HeadArtifactFile401JSONResponse) VisitHeadArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactFile403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response HeadArtifactFile403JSONResponse) VisitHeadArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactFile404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response HeadArtifactFile404JSONResponse) VisitHeadArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HeadArtifactFile500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response HeadArtifactFile500JSONResponse) VisitHeadArtifactFileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFilesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Params      GetArtifactFilesParams
+}
+
+type GetArtifactFilesResponseObject interface {
+	VisitGetArtifactFilesResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactFiles200JSONResponse struct{ FileDetailResponseJSONResponse }
+
+func (response GetArtifactFiles200JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactFiles400JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactFiles401JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactFiles403JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactFiles404JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactFiles500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactFiles500JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetHelmArtifactDetailsResponseObject interface {
+	VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetHelmArtifactDetails200JSONResponse struct {
+	HelmArtifactDetailResponseJSONResponse
+}
+
+func (response GetHelmArtifactDetails200JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetHelmArtifactDetails400JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetHelmArtifactDetails401JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetHelmArtifactDetails403JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetHelmArtifactDetails404JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetHelmArtifactDetails500JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifestRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetHelmArtifactManifestResponseObject interface {
+	VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error
+}
+
+type GetHelmArtifactManifest200JSONResponse struct {
+	HelmArtifactManifestResponseJSONResponse
+}
+
+func (response GetHelmArtifactManifest200JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetHelmArtifactManifest400JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetHelmArtifactManifest401JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetHelmArtifactManifest403JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetHelmArtifactManifest404JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmArtifactManifest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetHelmArtifactManifest500JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelArtifactDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetModelArtifactDetailsResponseObject interface {
+	VisitGetModelArtifactDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetModelArtifactDetails200JSONResponse struct {
+	ModelArtifactDetailResponseJSONResponse
+}
+
+func (response GetModelArtifactDetails200JSONResponse) VisitGetModelArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetModelArtifactDetails400JSONResponse) VisitGetModelArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetModelArtifactDetails401JSONResponse) VisitGetModelArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetModelArtifactDetails403JSONResponse) VisitGetModelArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetModelArtifactDetails404JSONResponse) VisitGetModelArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModelArtifactDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetModelArtifactDetails500JSONResponse) VisitGetModelArtifactDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArtifactVersionPermalinkRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type CreateArtifactVersionPermalinkResponseObject interface {
+	VisitCreateArtifactVersionPermalinkResponse(w http.ResponseWriter) error
+}
+
+type CreateArtifactVersionPermalink200JSONResponse struct{ PermalinkResponseJSONResponse }
+
+func (response CreateArtifactVersionPermalink200JSONResponse) VisitCreateArtifactVersionPermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArtifactVersionPermalink400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateArtifactVersionPermalink400JSONResponse) VisitCreateArtifactVersionPermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArtifactVersionPermalink401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateArtifactVersionPermalink401JSONResponse) VisitCreateArtifactVersionPermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArtifactVersionPermalink403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateArtifactVersionPermalink403JSONResponse) VisitCreateArtifactVersionPermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArtifactVersionPermalink404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateArtifactVersionPermalink404JSONResponse) VisitCreateArtifactVersionPermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateArtifactVersionPermalink500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateArtifactVersionPermalink500JSONResponse) VisitCreateArtifactVersionPermalinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersionRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+	Body        *PurgeArtifactVersionJSONRequestBody
+}
+
+type PurgeArtifactVersionResponseObject interface {
+	VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error
+}
+
+type PurgeArtifactVersion200JSONResponse struct {
+	DeletionCertificateResponseJSONResponse
+}
+
+func (response PurgeArtifactVersion200JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PurgeArtifactVersion400JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PurgeArtifactVersion401JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PurgeArtifactVersion403JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PurgeArtifactVersion404JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgeArtifactVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PurgeArtifactVersion500JSONResponse) VisitPurgeArtifactVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionStorageRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetArtifactVersionStorageResponseObject interface {
+	VisitGetArtifactVersionStorageResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactVersionStorage200JSONResponse struct {
+	VersionStorageResponseJSONResponse
+}
+
+func (response GetArtifactVersionStorage200JSONResponse) VisitGetArtifactVersionStorageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionStorage400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactVersionStorage400JSONResponse) VisitGetArtifactVersionStorageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionStorage401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactVersionStorage401JSONResponse) VisitGetArtifactVersionStorageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionStorage403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactVersionStorage403JSONResponse) VisitGetArtifactVersionStorageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionStorage404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactVersionStorage404JSONResponse) VisitGetArtifactVersionStorageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionStorage500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactVersionStorage500JSONResponse) VisitGetArtifactVersionStorageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummaryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Version     VersionPathParam     `json:"version"`
+}
+
+type GetArtifactVersionSummaryResponseObject interface {
+	VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetArtifactVersionSummary200JSONResponse struct {
+	ArtifactVersionSummaryResponseJSONResponse
+}
+
+func (response GetArtifactVersionSummary200JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetArtifactVersionSummary400JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetArtifactVersionSummary401JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactVersionSummary403JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetArtifactVersionSummary404JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactVersionSummary500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetArtifactVersionSummary500JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersionsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Artifact    ArtifactPathParam    `json:"artifact"`
+	Params      GetAllArtifactVersionsParams
+}
+
+type GetAllArtifactVersionsResponseObject interface {
+	VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetAllArtifactVersions200JSONResponse struct {
+	ListArtifactVersionResponseJSONResponse
+}
+
+func (response GetAllArtifactVersions200JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAllArtifactVersions400JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAllArtifactVersions401JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetAllArtifactVersions403JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAllArtifactVersions404JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactVersions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetAllArtifactVersions500JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetAllArtifactsByRegistryParams
+}
+
+type GetAllArtifactsByRegistryResponseObject interface {
+	VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error
+}
+
+type GetAllArtifactsByRegistry200JSONResponse struct {
+	ListRegistryArtifactResponseJSONResponse
+}
+
+func (response GetAllArtifactsByRegistry200JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetAllArtifactsByRegistry400JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetAllArtifactsByRegistry401JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetAllArtifactsByRegistry403JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetAllArtifactsByRegistry404JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllArtifactsByRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetAllArtifactsByRegistry500JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryArtifactsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ExportRegistryArtifactsParams
+}
+
+type ExportRegistryArtifactsResponseObject interface {
+	VisitExportRegistryArtifactsResponse(w http.ResponseWriter) error
+}
+
+type ExportRegistryArtifacts200TextcsvResponse struct {
+	ArtifactExportResponseTextcsvResponse
+}
+
+func (response ExportRegistryArtifacts200TextcsvResponse) VisitExportRegistryArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportRegistryArtifacts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportRegistryArtifacts400JSONResponse) VisitExportRegistryArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ExportRegistryArtifacts401JSONResponse) VisitExportRegistryArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportRegistryArtifacts403JSONResponse) VisitExportRegistryArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryArtifacts404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportRegistryArtifacts404JSONResponse) VisitExportRegistryArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryArtifacts500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ExportRegistryArtifacts500JSONResponse) VisitExportRegistryArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactClassesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      SearchArtifactClassesParams
+}
+
+type SearchArtifactClassesResponseObject interface {
+	VisitSearchArtifactClassesResponse(w http.ResponseWriter) error
+}
+
+type SearchArtifactClasses200JSONResponse struct {
+	ListArtifactClassesResponseJSONResponse
+}
+
+func (response SearchArtifactClasses200JSONResponse) VisitSearchArtifactClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactClasses400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchArtifactClasses400JSONResponse) VisitSearchArtifactClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactClasses401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SearchArtifactClasses401JSONResponse) VisitSearchArtifactClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactClasses403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SearchArtifactClasses403JSONResponse) VisitSearchArtifactClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactClasses404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SearchArtifactClasses404JSONResponse) VisitSearchArtifactClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactClasses500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SearchArtifactClasses500JSONResponse) VisitSearchArtifactClassesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetailsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetClientSetupDetailsParams
+}
+
+type GetClientSetupDetailsResponseObject interface {
+	VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetClientSetupDetails200JSONResponse struct {
+	ClientSetupDetailsResponseJSONResponse
+}
+
+func (response GetClientSetupDetails200JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetClientSetupDetails400JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetClientSetupDetails401JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetClientSetupDetails403JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetClientSetupDetails404JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientSetupDetails500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetClientSetupDetails500JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneRegistryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CloneRegistryJSONRequestBody
+}
+
+type CloneRegistryResponseObject interface {
+	VisitCloneRegistryResponse(w http.ResponseWriter) error
+}
+
+type CloneRegistry201JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response CloneRegistry201JSONResponse) VisitCloneRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneRegistry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CloneRegistry400JSONResponse) VisitCloneRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CloneRegistry401JSONResponse) VisitCloneRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CloneRegistry403JSONResponse) VisitCloneRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneRegistry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CloneRegistry404JSONResponse) VisitCloneRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneRegistry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CloneRegistry500JSONResponse) VisitCloneRegistryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryConfigRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ExportRegistryConfigResponseObject interface {
+	VisitExportRegistryConfigResponse(w http.ResponseWriter) error
+}
+
+type ExportRegistryConfig200JSONResponse struct {
+	RegistryConfigResponseJSONResponse
+}
+
+func (response ExportRegistryConfig200JSONResponse) VisitExportRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportRegistryConfig400JSONResponse) VisitExportRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryConfig401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ExportRegistryConfig401JSONResponse) VisitExportRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryConfig403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportRegistryConfig403JSONResponse) VisitExportRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryConfig404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportRegistryConfig404JSONResponse) VisitExportRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportRegistryConfig500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ExportRegistryConfig500JSONResponse) VisitExportRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryConfigRevisionsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListRegistryConfigRevisionsParams
+}
+
+type ListRegistryConfigRevisionsResponseObject interface {
+	VisitListRegistryConfigRevisionsResponse(w http.ResponseWriter) error
+}
+
+type ListRegistryConfigRevisions200JSONResponse struct {
+	ListRegistryConfigRevisionsResponseJSONResponse
+}
+
+func (response ListRegistryConfigRevisions200JSONResponse) VisitListRegistryConfigRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryConfigRevisions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRegistryConfigRevisions400JSONResponse) VisitListRegistryConfigRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryConfigRevisions401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListRegistryConfigRevisions401JSONResponse) VisitListRegistryConfigRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryConfigRevisions403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRegistryConfigRevisions403JSONResponse) VisitListRegistryConfigRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryConfigRevisions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListRegistryConfigRevisions404JSONResponse) VisitListRegistryConfigRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryConfigRevisions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListRegistryConfigRevisions500JSONResponse) VisitListRegistryConfigRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryConfigRevisionRequestObject struct {
+	RegistryRef RegistryRefPathParam    `json:"registry_ref"`
+	Revision    ConfigRevisionPathParam `json:"revision"`
+}
+
+type GetRegistryConfigRevisionResponseObject interface {
+	VisitGetRegistryConfigRevisionResponse(w http.ResponseWriter) error
+}
+
+type GetRegistryConfigRevision200JSONResponse struct {
+	RegistryConfigRevisionResponseJSONResponse
+}
+
+func (response GetRegistryConfigRevision200JSONResponse) VisitGetRegistryConfigRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryConfigRevision400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistryConfigRevision400JSONResponse) VisitGetRegistryConfigRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryConfigRevision401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistryConfigRevision401JSONResponse) VisitGetRegistryConfigRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryConfigRevision403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistryConfigRevision403JSONResponse) VisitGetRegistryConfigRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryConfigRevision404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistryConfigRevision404JSONResponse) VisitGetRegistryConfigRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryConfigRevision500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistryConfigRevision500JSONResponse) VisitGetRegistryConfigRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackRegistryConfigRequestObject struct {
+	RegistryRef RegistryRefPathParam    `json:"registry_ref"`
+	Revision    ConfigRevisionPathParam `json:"revision"`
+}
+
+type RollbackRegistryConfigResponseObject interface {
+	VisitRollbackRegistryConfigResponse(w http.ResponseWriter) error
+}
+
+type RollbackRegistryConfig200JSONResponse struct {
+	RegistryConfigApplyResponseJSONResponse
+}
+
+func (response RollbackRegistryConfig200JSONResponse) VisitRollbackRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackRegistryConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RollbackRegistryConfig400JSONResponse) VisitRollbackRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackRegistryConfig401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RollbackRegistryConfig401JSONResponse) VisitRollbackRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackRegistryConfig403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RollbackRegistryConfig403JSONResponse) VisitRollbackRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackRegistryConfig404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RollbackRegistryConfig404JSONResponse) VisitRollbackRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackRegistryConfig500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RollbackRegistryConfig500JSONResponse) VisitRollbackRegistryConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactContentsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      SearchArtifactContentsParams
+}
+
+type SearchArtifactContentsResponseObject interface {
+	VisitSearchArtifactContentsResponse(w http.ResponseWriter) error
+}
+
+type SearchArtifactContents200JSONResponse struct {
+	ListArtifactContentsResponseJSONResponse
+}
+
+func (response SearchArtifactContents200JSONResponse) VisitSearchArtifactContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactContents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchArtifactContents400JSONResponse) VisitSearchArtifactContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactContents401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SearchArtifactContents401JSONResponse) VisitSearchArtifactContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactContents403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SearchArtifactContents403JSONResponse) VisitSearchArtifactContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactContents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SearchArtifactContents404JSONResponse) VisitSearchArtifactContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchArtifactContents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SearchArtifactContents500JSONResponse) VisitSearchArtifactContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionCertificatesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListDeletionCertificatesParams
+}
+
+type ListDeletionCertificatesResponseObject interface {
+	VisitListDeletionCertificatesResponse(w http.ResponseWriter) error
+}
+
+type ListDeletionCertificates200JSONResponse struct {
+	ListDeletionCertificatesResponseJSONResponse
+}
+
+func (response ListDeletionCertificates200JSONResponse) VisitListDeletionCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionCertificates400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListDeletionCertificates400JSONResponse) VisitListDeletionCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionCertificates401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListDeletionCertificates401JSONResponse) VisitListDeletionCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionCertificates403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListDeletionCertificates403JSONResponse) VisitListDeletionCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionCertificates404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListDeletionCertificates404JSONResponse) VisitListDeletionCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeletionCertificates500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListDeletionCertificates500JSONResponse) VisitListDeletionCertificatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeletionCertificateRequestObject struct {
+	RegistryRef   RegistryRefPathParam   `json:"registry_ref"`
+	CertificateId CertificateIdPathParam `json:"certificate_id"`
+}
+
+type GetDeletionCertificateResponseObject interface {
+	VisitGetDeletionCertificateResponse(w http.ResponseWriter) error
+}
+
+type GetDeletionCertificate200JSONResponse struct {
+	DeletionCertificateResponseJSONResponse
+}
+
+func (response GetDeletionCertificate200JSONResponse) VisitGetDeletionCertificateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeletionCertificate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetDeletionCertificate400JSONResponse) VisitGetDeletionCertificateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeletionCertificate401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetDeletionCertificate401JSONResponse) VisitGetDeletionCertificateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeletionCertificate403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetDeletionCertificate403JSONResponse) VisitGetDeletionCertificateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeletionCertificate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetDeletionCertificate404JSONResponse) VisitGetDeletionCertificateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeletionCertificate500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetDeletionCertificate500JSONResponse) VisitGetDeletionCertificateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryDownloadOriginsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetRegistryDownloadOriginsParams
+}
+
+type GetRegistryDownloadOriginsResponseObject interface {
+	VisitGetRegistryDownloadOriginsResponse(w http.ResponseWriter) error
+}
+
+type GetRegistryDownloadOrigins200JSONResponse struct {
+	RegistryDownloadOriginsResponseJSONResponse
+}
+
+func (response GetRegistryDownloadOrigins200JSONResponse) VisitGetRegistryDownloadOriginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryDownloadOrigins400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistryDownloadOrigins400JSONResponse) VisitGetRegistryDownloadOriginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryDownloadOrigins401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistryDownloadOrigins401JSONResponse) VisitGetRegistryDownloadOriginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryDownloadOrigins403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistryDownloadOrigins403JSONResponse) VisitGetRegistryDownloadOriginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryDownloadOrigins404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistryDownloadOrigins404JSONResponse) VisitGetRegistryDownloadOriginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryDownloadOrigins500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistryDownloadOrigins500JSONResponse) VisitGetRegistryDownloadOriginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryFeedRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetRegistryFeedParams
+}
+
+type GetRegistryFeedResponseObject interface {
+	VisitGetRegistryFeedResponse(w http.ResponseWriter) error
+}
+
+type GetRegistryFeed200ApplicationatomXmlResponse struct {
+	FeedResponseApplicationatomXmlResponse
+}
+
+func (response GetRegistryFeed200ApplicationatomXmlResponse) VisitGetRegistryFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/atom+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetRegistryFeed304Response = NotModifiedResponse
+
+func (response GetRegistryFeed304Response) VisitGetRegistryFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetRegistryFeed400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistryFeed400JSONResponse) VisitGetRegistryFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryFeed401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistryFeed401JSONResponse) VisitGetRegistryFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryFeed403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistryFeed403JSONResponse) VisitGetRegistryFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryFeed404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistryFeed404JSONResponse) VisitGetRegistryFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistryFeed500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistryFeed500JSONResponse) VisitGetRegistryFeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHelmPushPolicyRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type DeleteHelmPushPolicyResponseObject interface {
+	VisitDeleteHelmPushPolicyResponse(w http.ResponseWriter) error
+}
+
+type DeleteHelmPushPolicy200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteHelmPushPolicy200JSONResponse) VisitDeleteHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHelmPushPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteHelmPushPolicy400JSONResponse) VisitDeleteHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHelmPushPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteHelmPushPolicy401JSONResponse) VisitDeleteHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHelmPushPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteHelmPushPolicy403JSONResponse) VisitDeleteHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHelmPushPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteHelmPushPolicy404JSONResponse) VisitDeleteHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHelmPushPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteHelmPushPolicy500JSONResponse) VisitDeleteHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmPushPolicyRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetHelmPushPolicyResponseObject interface {
+	VisitGetHelmPushPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetHelmPushPolicy200JSONResponse struct {
+	HelmPushPolicyResponseJSONResponse
+}
+
+func (response GetHelmPushPolicy200JSONResponse) VisitGetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmPushPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetHelmPushPolicy400JSONResponse) VisitGetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmPushPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetHelmPushPolicy401JSONResponse) VisitGetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmPushPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetHelmPushPolicy403JSONResponse) VisitGetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmPushPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetHelmPushPolicy404JSONResponse) VisitGetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHelmPushPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetHelmPushPolicy500JSONResponse) VisitGetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetHelmPushPolicyRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *SetHelmPushPolicyJSONRequestBody
+}
+
+type SetHelmPushPolicyResponseObject interface {
+	VisitSetHelmPushPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetHelmPushPolicy200JSONResponse struct {
+	HelmPushPolicyResponseJSONResponse
+}
+
+func (response SetHelmPushPolicy200JSONResponse) VisitSetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetHelmPushPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetHelmPushPolicy400JSONResponse) VisitSetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetHelmPushPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SetHelmPushPolicy401JSONResponse) VisitSetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetHelmPushPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetHelmPushPolicy403JSONResponse) VisitSetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetHelmPushPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetHelmPushPolicy404JSONResponse) VisitSetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetHelmPushPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SetHelmPushPolicy500JSONResponse) VisitSetHelmPushPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchImageLayerContentsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      SearchImageLayerContentsParams
+}
+
+type SearchImageLayerContentsResponseObject interface {
+	VisitSearchImageLayerContentsResponse(w http.ResponseWriter) error
+}
+
+type SearchImageLayerContents200JSONResponse struct {
+	ListImageLayerContentsResponseJSONResponse
+}
+
+func (response SearchImageLayerContents200JSONResponse) VisitSearchImageLayerContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchImageLayerContents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchImageLayerContents400JSONResponse) VisitSearchImageLayerContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchImageLayerContents401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SearchImageLayerContents401JSONResponse) VisitSearchImageLayerContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchImageLayerContents403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SearchImageLayerContents403JSONResponse) VisitSearchImageLayerContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchImageLayerContents404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SearchImageLayerContents404JSONResponse) VisitSearchImageLayerContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchImageLayerContents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SearchImageLayerContents500JSONResponse) VisitSearchImageLayerContentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLegalHoldsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListLegalHoldsResponseObject interface {
+	VisitListLegalHoldsResponse(w http.ResponseWriter) error
+}
+
+type ListLegalHolds200JSONResponse struct {
+	ListLegalHoldsResponseJSONResponse
+}
+
+func (response ListLegalHolds200JSONResponse) VisitListLegalHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLegalHolds400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListLegalHolds400JSONResponse) VisitListLegalHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLegalHolds401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListLegalHolds401JSONResponse) VisitListLegalHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLegalHolds403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListLegalHolds403JSONResponse) VisitListLegalHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLegalHolds404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListLegalHolds404JSONResponse) VisitListLegalHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLegalHolds500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListLegalHolds500JSONResponse) VisitListLegalHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLegalHoldRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateLegalHoldJSONRequestBody
+}
+
+type CreateLegalHoldResponseObject interface {
+	VisitCreateLegalHoldResponse(w http.ResponseWriter) error
+}
+
+type CreateLegalHold201JSONResponse struct{ LegalHoldResponseJSONResponse }
+
+func (response CreateLegalHold201JSONResponse) VisitCreateLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLegalHold400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateLegalHold400JSONResponse) VisitCreateLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLegalHold401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateLegalHold401JSONResponse) VisitCreateLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLegalHold403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateLegalHold403JSONResponse) VisitCreateLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLegalHold404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateLegalHold404JSONResponse) VisitCreateLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLegalHold500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateLegalHold500JSONResponse) VisitCreateLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLegalHoldRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	LegalHoldId LegalHoldIdPathParam `json:"legal_hold_id"`
+}
+
+type DeleteLegalHoldResponseObject interface {
+	VisitDeleteLegalHoldResponse(w http.ResponseWriter) error
+}
+
+type DeleteLegalHold200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteLegalHold200JSONResponse) VisitDeleteLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLegalHold400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteLegalHold400JSONResponse) VisitDeleteLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLegalHold401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteLegalHold401JSONResponse) VisitDeleteLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLegalHold403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteLegalHold403JSONResponse) VisitDeleteLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLegalHold404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteLegalHold404JSONResponse) VisitDeleteLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLegalHold500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteLegalHold500JSONResponse) VisitDeleteLegalHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMavenRelocationsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListMavenRelocationsResponseObject interface {
+	VisitListMavenRelocationsResponse(w http.ResponseWriter) error
+}
+
+type ListMavenRelocations200JSONResponse struct {
+	ListMavenRelocationsResponseJSONResponse
+}
+
+func (response ListMavenRelocations200JSONResponse) VisitListMavenRelocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMavenRelocations400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListMavenRelocations400JSONResponse) VisitListMavenRelocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMavenRelocations401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListMavenRelocations401JSONResponse) VisitListMavenRelocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMavenRelocations403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListMavenRelocations403JSONResponse) VisitListMavenRelocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMavenRelocations404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListMavenRelocations404JSONResponse) VisitListMavenRelocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMavenRelocations500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListMavenRelocations500JSONResponse) VisitListMavenRelocationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMavenRelocationRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateMavenRelocationJSONRequestBody
+}
+
+type CreateMavenRelocationResponseObject interface {
+	VisitCreateMavenRelocationResponse(w http.ResponseWriter) error
+}
+
+type CreateMavenRelocation201JSONResponse struct {
+	MavenRelocationResponseJSONResponse
+}
+
+func (response CreateMavenRelocation201JSONResponse) VisitCreateMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMavenRelocation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateMavenRelocation400JSONResponse) VisitCreateMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMavenRelocation401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateMavenRelocation401JSONResponse) VisitCreateMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMavenRelocation403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateMavenRelocation403JSONResponse) VisitCreateMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMavenRelocation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateMavenRelocation404JSONResponse) VisitCreateMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMavenRelocation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateMavenRelocation500JSONResponse) VisitCreateMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMavenRelocationRequestObject struct {
+	RegistryRef  RegistryRefPathParam  `json:"registry_ref"`
+	RelocationId RelocationIdPathParam `json:"relocation_id"`
+}
+
+type DeleteMavenRelocationResponseObject interface {
+	VisitDeleteMavenRelocationResponse(w http.ResponseWriter) error
+}
+
+type DeleteMavenRelocation200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteMavenRelocation200JSONResponse) VisitDeleteMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMavenRelocation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteMavenRelocation400JSONResponse) VisitDeleteMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMavenRelocation401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteMavenRelocation401JSONResponse) VisitDeleteMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMavenRelocation403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteMavenRelocation403JSONResponse) VisitDeleteMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMavenRelocation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteMavenRelocation404JSONResponse) VisitDeleteMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMavenRelocation500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteMavenRelocation500JSONResponse) VisitDeleteMavenRelocationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryMembersRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListRegistryMembersResponseObject interface {
+	VisitListRegistryMembersResponse(w http.ResponseWriter) error
+}
+
+type ListRegistryMembers200JSONResponse struct {
+	RegistryMembersResponseJSONResponse
+}
+
+func (response ListRegistryMembers200JSONResponse) VisitListRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryMembers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRegistryMembers400JSONResponse) VisitListRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryMembers401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListRegistryMembers401JSONResponse) VisitListRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryMembers403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRegistryMembers403JSONResponse) VisitListRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListRegistryMembers404JSONResponse) VisitListRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryMembers500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListRegistryMembers500JSONResponse) VisitListRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderRegistryMembersRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *ReorderRegistryMembersJSONRequestBody
+}
+
+type ReorderRegistryMembersResponseObject interface {
+	VisitReorderRegistryMembersResponse(w http.ResponseWriter) error
+}
+
+type ReorderRegistryMembers200JSONResponse struct {
+	RegistryMembersResponseJSONResponse
+}
+
+func (response ReorderRegistryMembers200JSONResponse) VisitReorderRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderRegistryMembers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReorderRegistryMembers400JSONResponse) VisitReorderRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderRegistryMembers401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ReorderRegistryMembers401JSONResponse) VisitReorderRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderRegistryMembers403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReorderRegistryMembers403JSONResponse) VisitReorderRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderRegistryMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ReorderRegistryMembers404JSONResponse) VisitReorderRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReorderRegistryMembers500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ReorderRegistryMembers500JSONResponse) VisitReorderRegistryMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListMirrorSyncsResponseObject interface {
+	VisitListMirrorSyncsResponse(w http.ResponseWriter) error
+}
+
+type ListMirrorSyncs200JSONResponse struct {
+	ListMirrorSyncsResponseJSONResponse
+}
+
+func (response ListMirrorSyncs200JSONResponse) VisitListMirrorSyncsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListMirrorSyncs400JSONResponse) VisitListMirrorSyncsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncs401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListMirrorSyncs401JSONResponse) VisitListMirrorSyncsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncs403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListMirrorSyncs403JSONResponse) VisitListMirrorSyncsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListMirrorSyncs404JSONResponse) VisitListMirrorSyncsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncs500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListMirrorSyncs500JSONResponse) VisitListMirrorSyncsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMirrorSyncRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateMirrorSyncJSONRequestBody
+}
+
+type CreateMirrorSyncResponseObject interface {
+	VisitCreateMirrorSyncResponse(w http.ResponseWriter) error
+}
+
+type CreateMirrorSync201JSONResponse struct{ MirrorSyncResponseJSONResponse }
+
+func (response CreateMirrorSync201JSONResponse) VisitCreateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMirrorSync400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateMirrorSync400JSONResponse) VisitCreateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMirrorSync401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateMirrorSync401JSONResponse) VisitCreateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMirrorSync403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateMirrorSync403JSONResponse) VisitCreateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMirrorSync404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateMirrorSync404JSONResponse) VisitCreateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMirrorSync500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateMirrorSync500JSONResponse) VisitCreateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMirrorSyncRequestObject struct {
+	RegistryRef  RegistryRefPathParam  `json:"registry_ref"`
+	MirrorSyncId MirrorSyncIdPathParam `json:"mirror_sync_id"`
+}
+
+type DeleteMirrorSyncResponseObject interface {
+	VisitDeleteMirrorSyncResponse(w http.ResponseWriter) error
+}
+
+type DeleteMirrorSync200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteMirrorSync200JSONResponse) VisitDeleteMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMirrorSync400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteMirrorSync400JSONResponse) VisitDeleteMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMirrorSync401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteMirrorSync401JSONResponse) VisitDeleteMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMirrorSync403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteMirrorSync403JSONResponse) VisitDeleteMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMirrorSync404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteMirrorSync404JSONResponse) VisitDeleteMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMirrorSync500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteMirrorSync500JSONResponse) VisitDeleteMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSyncRequestObject struct {
+	RegistryRef  RegistryRefPathParam  `json:"registry_ref"`
+	MirrorSyncId MirrorSyncIdPathParam `json:"mirror_sync_id"`
+}
+
+type GetMirrorSyncResponseObject interface {
+	VisitGetMirrorSyncResponse(w http.ResponseWriter) error
+}
+
+type GetMirrorSync200JSONResponse struct{ MirrorSyncResponseJSONResponse }
+
+func (response GetMirrorSync200JSONResponse) VisitGetMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSync400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetMirrorSync400JSONResponse) VisitGetMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSync401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetMirrorSync401JSONResponse) VisitGetMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSync403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMirrorSync403JSONResponse) VisitGetMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSync404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMirrorSync404JSONResponse) VisitGetMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSync500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetMirrorSync500JSONResponse) VisitGetMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMirrorSyncRequestObject struct {
+	RegistryRef  RegistryRefPathParam  `json:"registry_ref"`
+	MirrorSyncId MirrorSyncIdPathParam `json:"mirror_sync_id"`
+	Body         *UpdateMirrorSyncJSONRequestBody
+}
+
+type UpdateMirrorSyncResponseObject interface {
+	VisitUpdateMirrorSyncResponse(w http.ResponseWriter) error
+}
+
+type UpdateMirrorSync200JSONResponse struct{ MirrorSyncResponseJSONResponse }
+
+func (response UpdateMirrorSync200JSONResponse) VisitUpdateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMirrorSync400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateMirrorSync400JSONResponse) VisitUpdateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMirrorSync401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UpdateMirrorSync401JSONResponse) VisitUpdateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMirrorSync403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateMirrorSync403JSONResponse) VisitUpdateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMirrorSync404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateMirrorSync404JSONResponse) VisitUpdateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMirrorSync500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateMirrorSync500JSONResponse) VisitUpdateMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncRunsRequestObject struct {
+	RegistryRef  RegistryRefPathParam  `json:"registry_ref"`
+	MirrorSyncId MirrorSyncIdPathParam `json:"mirror_sync_id"`
+}
+
+type ListMirrorSyncRunsResponseObject interface {
+	VisitListMirrorSyncRunsResponse(w http.ResponseWriter) error
+}
+
+type ListMirrorSyncRuns200JSONResponse struct {
+	ListMirrorSyncRunsResponseJSONResponse
+}
+
+func (response ListMirrorSyncRuns200JSONResponse) VisitListMirrorSyncRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncRuns400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListMirrorSyncRuns400JSONResponse) VisitListMirrorSyncRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncRuns401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListMirrorSyncRuns401JSONResponse) VisitListMirrorSyncRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncRuns403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListMirrorSyncRuns403JSONResponse) VisitListMirrorSyncRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncRuns404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListMirrorSyncRuns404JSONResponse) VisitListMirrorSyncRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMirrorSyncRuns500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListMirrorSyncRuns500JSONResponse) VisitListMirrorSyncRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMirrorSyncRequestObject struct {
+	RegistryRef  RegistryRefPathParam  `json:"registry_ref"`
+	MirrorSyncId MirrorSyncIdPathParam `json:"mirror_sync_id"`
+}
+
+type RunMirrorSyncResponseObject interface {
+	VisitRunMirrorSyncResponse(w http.ResponseWriter) error
+}
+
+type RunMirrorSync202JSONResponse struct {
+	MirrorSyncRunResponseJSONResponse
+}
+
+func (response RunMirrorSync202JSONResponse) VisitRunMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMirrorSync400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RunMirrorSync400JSONResponse) VisitRunMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMirrorSync401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RunMirrorSync401JSONResponse) VisitRunMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMirrorSync403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RunMirrorSync403JSONResponse) VisitRunMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMirrorSync404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RunMirrorSync404JSONResponse) VisitRunMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMirrorSync500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RunMirrorSync500JSONResponse) VisitRunMirrorSyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSyncRunRequestObject struct {
+	RegistryRef     RegistryRefPathParam     `json:"registry_ref"`
+	MirrorSyncId    MirrorSyncIdPathParam    `json:"mirror_sync_id"`
+	MirrorSyncRunId MirrorSyncRunIdPathParam `json:"mirror_sync_run_id"`
+}
+
+type GetMirrorSyncRunResponseObject interface {
+	VisitGetMirrorSyncRunResponse(w http.ResponseWriter) error
+}
+
+type GetMirrorSyncRun200JSONResponse struct {
+	MirrorSyncRunResponseJSONResponse
+}
+
+func (response GetMirrorSyncRun200JSONResponse) VisitGetMirrorSyncRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSyncRun400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetMirrorSyncRun400JSONResponse) VisitGetMirrorSyncRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSyncRun401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetMirrorSyncRun401JSONResponse) VisitGetMirrorSyncRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSyncRun403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMirrorSyncRun403JSONResponse) VisitGetMirrorSyncRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSyncRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMirrorSyncRun404JSONResponse) VisitGetMirrorSyncRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMirrorSyncRun500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetMirrorSyncRun500JSONResponse) VisitGetMirrorSyncRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateRegistryPoliciesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *SimulateRegistryPoliciesJSONRequestBody
+}
+
+type SimulateRegistryPoliciesResponseObject interface {
+	VisitSimulateRegistryPoliciesResponse(w http.ResponseWriter) error
+}
+
+type SimulateRegistryPolicies200JSONResponse struct {
+	RegistryPolicySimulationResponseJSONResponse
+}
+
+func (response SimulateRegistryPolicies200JSONResponse) VisitSimulateRegistryPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateRegistryPolicies400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SimulateRegistryPolicies400JSONResponse) VisitSimulateRegistryPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateRegistryPolicies401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SimulateRegistryPolicies401JSONResponse) VisitSimulateRegistryPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateRegistryPolicies403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SimulateRegistryPolicies403JSONResponse) VisitSimulateRegistryPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateRegistryPolicies404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SimulateRegistryPolicies404JSONResponse) VisitSimulateRegistryPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SimulateRegistryPolicies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SimulateRegistryPolicies500JSONResponse) VisitSimulateRegistryPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryQueuesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListRegistryQueuesResponseObject interface {
+	VisitListRegistryQueuesResponse(w http.ResponseWriter) error
+}
+
+type ListRegistryQueues200JSONResponse struct {
+	ListRegistryQueuesResponseJSONResponse
+}
+
+func (response ListRegistryQueues200JSONResponse) VisitListRegistryQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryQueues400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRegistryQueues400JSONResponse) VisitListRegistryQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryQueues401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListRegistryQueues401JSONResponse) VisitListRegistryQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryQueues403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRegistryQueues403JSONResponse) VisitListRegistryQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryQueues404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListRegistryQueues404JSONResponse) VisitListRegistryQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRegistryQueues500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListRegistryQueues500JSONResponse) VisitListRegistryQueuesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseRegistryQueueRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Queue       QueuePathParam       `json:"queue"`
+}
+
+type PauseRegistryQueueResponseObject interface {
+	VisitPauseRegistryQueueResponse(w http.ResponseWriter) error
+}
+
+type PauseRegistryQueue200JSONResponse struct {
+	RegistryQueueResponseJSONResponse
+}
+
+func (response PauseRegistryQueue200JSONResponse) VisitPauseRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseRegistryQueue400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PauseRegistryQueue400JSONResponse) VisitPauseRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseRegistryQueue401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PauseRegistryQueue401JSONResponse) VisitPauseRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseRegistryQueue403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PauseRegistryQueue403JSONResponse) VisitPauseRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseRegistryQueue404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PauseRegistryQueue404JSONResponse) VisitPauseRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseRegistryQueue500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PauseRegistryQueue500JSONResponse) VisitPauseRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeRegistryQueueRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Queue       QueuePathParam       `json:"queue"`
+}
+
+type ResumeRegistryQueueResponseObject interface {
+	VisitResumeRegistryQueueResponse(w http.ResponseWriter) error
+}
+
+type ResumeRegistryQueue200JSONResponse struct {
+	RegistryQueueResponseJSONResponse
+}
+
+func (response ResumeRegistryQueue200JSONResponse) VisitResumeRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeRegistryQueue400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ResumeRegistryQueue400JSONResponse) VisitResumeRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeRegistryQueue401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ResumeRegistryQueue401JSONResponse) VisitResumeRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeRegistryQueue403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResumeRegistryQueue403JSONResponse) VisitResumeRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeRegistryQueue404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResumeRegistryQueue404JSONResponse) VisitResumeRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeRegistryQueue500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ResumeRegistryQueue500JSONResponse) VisitResumeRegistryQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResolveTraceRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      GetResolveTraceParams
+}
+
+type GetResolveTraceResponseObject interface {
+	VisitGetResolveTraceResponse(w http.ResponseWriter) error
+}
+
+type GetResolveTrace200JSONResponse struct {
+	ResolveTraceResponseJSONResponse
+}
+
+func (response GetResolveTrace200JSONResponse) VisitGetResolveTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResolveTrace400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetResolveTrace400JSONResponse) VisitGetResolveTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResolveTrace401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetResolveTrace401JSONResponse) VisitGetResolveTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResolveTrace403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetResolveTrace403JSONResponse) VisitGetResolveTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResolveTrace404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetResolveTrace404JSONResponse) VisitGetResolveTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetResolveTrace500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetResolveTrace500JSONResponse) VisitGetResolveTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveArtifactPathRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ResolveArtifactPathParams
+}
+
+type ResolveArtifactPathResponseObject interface {
+	VisitResolveArtifactPathResponse(w http.ResponseWriter) error
+}
+
+type ResolveArtifactPath200JSONResponse struct {
+	ResolveTraceResponseJSONResponse
+}
+
+func (response ResolveArtifactPath200JSONResponse) VisitResolveArtifactPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveArtifactPath400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ResolveArtifactPath400JSONResponse) VisitResolveArtifactPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveArtifactPath401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ResolveArtifactPath401JSONResponse) VisitResolveArtifactPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveArtifactPath403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResolveArtifactPath403JSONResponse) VisitResolveArtifactPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveArtifactPath404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResolveArtifactPath404JSONResponse) VisitResolveArtifactPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveArtifactPath500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ResolveArtifactPath500JSONResponse) VisitResolveArtifactPathResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScanResultsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListScanResultsParams
+}
+
+type ListScanResultsResponseObject interface {
+	VisitListScanResultsResponse(w http.ResponseWriter) error
+}
+
+type ListScanResults200JSONResponse struct {
+	ListScanResultsResponseJSONResponse
+}
+
+func (response ListScanResults200JSONResponse) VisitListScanResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScanResults400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListScanResults400JSONResponse) VisitListScanResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScanResults401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListScanResults401JSONResponse) VisitListScanResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScanResults403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListScanResults403JSONResponse) VisitListScanResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScanResults404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListScanResults404JSONResponse) VisitListScanResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScanResults500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListScanResults500JSONResponse) VisitListScanResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestScanResultRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *IngestScanResultJSONRequestBody
+}
+
+type IngestScanResultResponseObject interface {
+	VisitIngestScanResultResponse(w http.ResponseWriter) error
+}
+
+type IngestScanResult201JSONResponse struct{ ScanResultResponseJSONResponse }
+
+func (response IngestScanResult201JSONResponse) VisitIngestScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestScanResult400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response IngestScanResult400JSONResponse) VisitIngestScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestScanResult401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response IngestScanResult401JSONResponse) VisitIngestScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestScanResult403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response IngestScanResult403JSONResponse) VisitIngestScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestScanResult404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response IngestScanResult404JSONResponse) VisitIngestScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestScanResult500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response IngestScanResult500JSONResponse) VisitIngestScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScanResultRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	ScanId      ScanIdPathParam      `json:"scan_id"`
+}
+
+type GetScanResultResponseObject interface {
+	VisitGetScanResultResponse(w http.ResponseWriter) error
+}
+
+type GetScanResult200JSONResponse struct{ ScanResultResponseJSONResponse }
+
+func (response GetScanResult200JSONResponse) VisitGetScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScanResult400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetScanResult400JSONResponse) VisitGetScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScanResult401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetScanResult401JSONResponse) VisitGetScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScanResult403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetScanResult403JSONResponse) VisitGetScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScanResult404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetScanResult404JSONResponse) VisitGetScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScanResult500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetScanResult500JSONResponse) VisitGetScanResultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVulnerabilityAllowlistRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListVulnerabilityAllowlistResponseObject interface {
+	VisitListVulnerabilityAllowlistResponse(w http.ResponseWriter) error
+}
+
+type ListVulnerabilityAllowlist200JSONResponse struct {
+	ListVulnerabilityAllowlistResponseJSONResponse
+}
+
+func (response ListVulnerabilityAllowlist200JSONResponse) VisitListVulnerabilityAllowlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVulnerabilityAllowlist400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListVulnerabilityAllowlist400JSONResponse) VisitListVulnerabilityAllowlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVulnerabilityAllowlist401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListVulnerabilityAllowlist401JSONResponse) VisitListVulnerabilityAllowlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVulnerabilityAllowlist403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListVulnerabilityAllowlist403JSONResponse) VisitListVulnerabilityAllowlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVulnerabilityAllowlist404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListVulnerabilityAllowlist404JSONResponse) VisitListVulnerabilityAllowlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVulnerabilityAllowlist500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListVulnerabilityAllowlist500JSONResponse) VisitListVulnerabilityAllowlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVulnerabilityAllowlistEntryRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateVulnerabilityAllowlistEntryJSONRequestBody
+}
+
+type CreateVulnerabilityAllowlistEntryResponseObject interface {
+	VisitCreateVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error
+}
+
+type CreateVulnerabilityAllowlistEntry201JSONResponse struct {
+	VulnerabilityAllowlistEntryResponseJSONResponse
+}
+
+func (response CreateVulnerabilityAllowlistEntry201JSONResponse) VisitCreateVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVulnerabilityAllowlistEntry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateVulnerabilityAllowlistEntry400JSONResponse) VisitCreateVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVulnerabilityAllowlistEntry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateVulnerabilityAllowlistEntry401JSONResponse) VisitCreateVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVulnerabilityAllowlistEntry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateVulnerabilityAllowlistEntry403JSONResponse) VisitCreateVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVulnerabilityAllowlistEntry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateVulnerabilityAllowlistEntry404JSONResponse) VisitCreateVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVulnerabilityAllowlistEntry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateVulnerabilityAllowlistEntry500JSONResponse) VisitCreateVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVulnerabilityAllowlistEntryRequestObject struct {
+	RegistryRef      RegistryRefPathParam      `json:"registry_ref"`
+	AllowlistEntryId AllowlistEntryIdPathParam `json:"allowlist_entry_id"`
+}
+
+type DeleteVulnerabilityAllowlistEntryResponseObject interface {
+	VisitDeleteVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error
+}
+
+type DeleteVulnerabilityAllowlistEntry200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteVulnerabilityAllowlistEntry200JSONResponse) VisitDeleteVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVulnerabilityAllowlistEntry400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteVulnerabilityAllowlistEntry400JSONResponse) VisitDeleteVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVulnerabilityAllowlistEntry401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteVulnerabilityAllowlistEntry401JSONResponse) VisitDeleteVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVulnerabilityAllowlistEntry403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVulnerabilityAllowlistEntry403JSONResponse) VisitDeleteVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVulnerabilityAllowlistEntry404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVulnerabilityAllowlistEntry404JSONResponse) VisitDeleteVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVulnerabilityAllowlistEntry500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteVulnerabilityAllowlistEntry500JSONResponse) VisitDeleteVulnerabilityAllowlistEntryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySecurityPostureRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetRegistrySecurityPostureResponseObject interface {
+	VisitGetRegistrySecurityPostureResponse(w http.ResponseWriter) error
+}
+
+type GetRegistrySecurityPosture200JSONResponse struct {
+	RegistrySecurityPostureResponseJSONResponse
+}
+
+func (response GetRegistrySecurityPosture200JSONResponse) VisitGetRegistrySecurityPostureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySecurityPosture400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRegistrySecurityPosture400JSONResponse) VisitGetRegistrySecurityPostureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySecurityPosture401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetRegistrySecurityPosture401JSONResponse) VisitGetRegistrySecurityPostureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySecurityPosture403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRegistrySecurityPosture403JSONResponse) VisitGetRegistrySecurityPostureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySecurityPosture404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRegistrySecurityPosture404JSONResponse) VisitGetRegistrySecurityPostureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRegistrySecurityPosture500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetRegistrySecurityPosture500JSONResponse) VisitGetRegistrySecurityPostureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCacheEvictionPolicyRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type DeleteCacheEvictionPolicyResponseObject interface {
+	VisitDeleteCacheEvictionPolicyResponse(w http.ResponseWriter) error
+}
+
+type DeleteCacheEvictionPolicy200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteCacheEvictionPolicy200JSONResponse) VisitDeleteCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCacheEvictionPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteCacheEvictionPolicy400JSONResponse) VisitDeleteCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCacheEvictionPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteCacheEvictionPolicy401JSONResponse) VisitDeleteCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCacheEvictionPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteCacheEvictionPolicy403JSONResponse) VisitDeleteCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCacheEvictionPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCacheEvictionPolicy404JSONResponse) VisitDeleteCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCacheEvictionPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteCacheEvictionPolicy500JSONResponse) VisitDeleteCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCacheEvictionPolicyRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetCacheEvictionPolicyResponseObject interface {
+	VisitGetCacheEvictionPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetCacheEvictionPolicy200JSONResponse struct {
+	CacheEvictionPolicyResponseJSONResponse
+}
+
+func (response GetCacheEvictionPolicy200JSONResponse) VisitGetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCacheEvictionPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCacheEvictionPolicy400JSONResponse) VisitGetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCacheEvictionPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetCacheEvictionPolicy401JSONResponse) VisitGetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCacheEvictionPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCacheEvictionPolicy403JSONResponse) VisitGetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCacheEvictionPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCacheEvictionPolicy404JSONResponse) VisitGetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCacheEvictionPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCacheEvictionPolicy500JSONResponse) VisitGetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCacheEvictionPolicyRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *SetCacheEvictionPolicyJSONRequestBody
+}
+
+type SetCacheEvictionPolicyResponseObject interface {
+	VisitSetCacheEvictionPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetCacheEvictionPolicy200JSONResponse struct {
+	CacheEvictionPolicyResponseJSONResponse
+}
+
+func (response SetCacheEvictionPolicy200JSONResponse) VisitSetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCacheEvictionPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetCacheEvictionPolicy400JSONResponse) VisitSetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCacheEvictionPolicy401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SetCacheEvictionPolicy401JSONResponse) VisitSetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCacheEvictionPolicy403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetCacheEvictionPolicy403JSONResponse) VisitSetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCacheEvictionPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetCacheEvictionPolicy404JSONResponse) VisitSetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetCacheEvictionPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SetCacheEvictionPolicy500JSONResponse) VisitSetCacheEvictionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateUpstreamCredentialsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *RotateUpstreamCredentialsJSONRequestBody
+}
+
+type RotateUpstreamCredentialsResponseObject interface {
+	VisitRotateUpstreamCredentialsResponse(w http.ResponseWriter) error
+}
+
+type RotateUpstreamCredentials200JSONResponse struct{ RegistryResponseJSONResponse }
+
+func (response RotateUpstreamCredentials200JSONResponse) VisitRotateUpstreamCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateUpstreamCredentials400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RotateUpstreamCredentials400JSONResponse) VisitRotateUpstreamCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateUpstreamCredentials401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response RotateUpstreamCredentials401JSONResponse) VisitRotateUpstreamCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateUpstreamCredentials403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RotateUpstreamCredentials403JSONResponse) VisitRotateUpstreamCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateUpstreamCredentials404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RotateUpstreamCredentials404JSONResponse) VisitRotateUpstreamCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateUpstreamCredentials500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RotateUpstreamCredentials500JSONResponse) VisitRotateUpstreamCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRuleViolationsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListPackageRuleViolationsParams
+}
+
+type ListPackageRuleViolationsResponseObject interface {
+	VisitListPackageRuleViolationsResponse(w http.ResponseWriter) error
+}
+
+type ListPackageRuleViolations200JSONResponse struct {
+	ListPackageRuleViolationsResponseJSONResponse
+}
+
+func (response ListPackageRuleViolations200JSONResponse) VisitListPackageRuleViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRuleViolations400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPackageRuleViolations400JSONResponse) VisitListPackageRuleViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRuleViolations401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListPackageRuleViolations401JSONResponse) VisitListPackageRuleViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRuleViolations403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPackageRuleViolations403JSONResponse) VisitListPackageRuleViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRuleViolations404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListPackageRuleViolations404JSONResponse) VisitListPackageRuleViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRuleViolations500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListPackageRuleViolations500JSONResponse) VisitListPackageRuleViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRulesRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type ListPackageRulesResponseObject interface {
+	VisitListPackageRulesResponse(w http.ResponseWriter) error
+}
+
+type ListPackageRules200JSONResponse struct {
+	ListPackageRulesResponseJSONResponse
+}
+
+func (response ListPackageRules200JSONResponse) VisitListPackageRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRules400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPackageRules400JSONResponse) VisitListPackageRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRules401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListPackageRules401JSONResponse) VisitListPackageRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRules403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPackageRules403JSONResponse) VisitListPackageRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRules404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListPackageRules404JSONResponse) VisitListPackageRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPackageRules500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListPackageRules500JSONResponse) VisitListPackageRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackageRuleRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreatePackageRuleJSONRequestBody
+}
+
+type CreatePackageRuleResponseObject interface {
+	VisitCreatePackageRuleResponse(w http.ResponseWriter) error
+}
+
+type CreatePackageRule201JSONResponse struct {
+	PackageRuleResponseJSONResponse
+}
+
+func (response CreatePackageRule201JSONResponse) VisitCreatePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackageRule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreatePackageRule400JSONResponse) VisitCreatePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackageRule401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreatePackageRule401JSONResponse) VisitCreatePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackageRule403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreatePackageRule403JSONResponse) VisitCreatePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackageRule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreatePackageRule404JSONResponse) VisitCreatePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePackageRule500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreatePackageRule500JSONResponse) VisitCreatePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackageRuleRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	RuleId      RuleIdPathParam      `json:"rule_id"`
+}
+
+type DeletePackageRuleResponseObject interface {
+	VisitDeletePackageRuleResponse(w http.ResponseWriter) error
+}
+
+type DeletePackageRule200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeletePackageRule200JSONResponse) VisitDeletePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackageRule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeletePackageRule400JSONResponse) VisitDeletePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackageRule401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeletePackageRule401JSONResponse) VisitDeletePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackageRule403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeletePackageRule403JSONResponse) VisitDeletePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackageRule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeletePackageRule404JSONResponse) VisitDeletePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePackageRule500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeletePackageRule500JSONResponse) VisitDeletePackageRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrewarmUpstreamCacheRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *PrewarmUpstreamCacheJSONRequestBody
+}
+
+type PrewarmUpstreamCacheResponseObject interface {
+	VisitPrewarmUpstreamCacheResponse(w http.ResponseWriter) error
+}
+
+type PrewarmUpstreamCache202JSONResponse struct {
+	CachePrewarmResponseJSONResponse
+}
+
+func (response PrewarmUpstreamCache202JSONResponse) VisitPrewarmUpstreamCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrewarmUpstreamCache400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PrewarmUpstreamCache400JSONResponse) VisitPrewarmUpstreamCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrewarmUpstreamCache401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response PrewarmUpstreamCache401JSONResponse) VisitPrewarmUpstreamCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrewarmUpstreamCache403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PrewarmUpstreamCache403JSONResponse) VisitPrewarmUpstreamCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrewarmUpstreamCache404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PrewarmUpstreamCache404JSONResponse) VisitPrewarmUpstreamCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrewarmUpstreamCache500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PrewarmUpstreamCache500JSONResponse) VisitPrewarmUpstreamCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamCachePrewarmRequestObject struct {
+	RegistryRef RegistryRefPathParam  `json:"registry_ref"`
+	Batch       PrewarmBatchPathParam `json:"batch"`
+}
+
+type GetUpstreamCachePrewarmResponseObject interface {
+	VisitGetUpstreamCachePrewarmResponse(w http.ResponseWriter) error
+}
+
+type GetUpstreamCachePrewarm200JSONResponse struct {
+	CachePrewarmResponseJSONResponse
+}
+
+func (response GetUpstreamCachePrewarm200JSONResponse) VisitGetUpstreamCachePrewarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamCachePrewarm400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUpstreamCachePrewarm400JSONResponse) VisitGetUpstreamCachePrewarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamCachePrewarm401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetUpstreamCachePrewarm401JSONResponse) VisitGetUpstreamCachePrewarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamCachePrewarm403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUpstreamCachePrewarm403JSONResponse) VisitGetUpstreamCachePrewarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamCachePrewarm404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUpstreamCachePrewarm404JSONResponse) VisitGetUpstreamCachePrewarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamCachePrewarm500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetUpstreamCachePrewarm500JSONResponse) VisitGetUpstreamCachePrewarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamStatusRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+}
+
+type GetUpstreamStatusResponseObject interface {
+	VisitGetUpstreamStatusResponse(w http.ResponseWriter) error
+}
+
+type GetUpstreamStatus200JSONResponse struct {
+	UpstreamStatusResponseJSONResponse
+}
+
+func (response GetUpstreamStatus200JSONResponse) VisitGetUpstreamStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUpstreamStatus400JSONResponse) VisitGetUpstreamStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamStatus401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetUpstreamStatus401JSONResponse) VisitGetUpstreamStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamStatus403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUpstreamStatus403JSONResponse) VisitGetUpstreamStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUpstreamStatus404JSONResponse) VisitGetUpstreamStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpstreamStatus500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetUpstreamStatus500JSONResponse) VisitGetUpstreamStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUpstreamURLsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *SetUpstreamURLsJSONRequestBody
+}
+
+type SetUpstreamURLsResponseObject interface {
+	VisitSetUpstreamURLsResponse(w http.ResponseWriter) error
+}
+
+type SetUpstreamURLs200JSONResponse struct {
+	UpstreamStatusResponseJSONResponse
+}
+
+func (response SetUpstreamURLs200JSONResponse) VisitSetUpstreamURLsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUpstreamURLs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetUpstreamURLs400JSONResponse) VisitSetUpstreamURLsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUpstreamURLs401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response SetUpstreamURLs401JSONResponse) VisitSetUpstreamURLsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUpstreamURLs403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SetUpstreamURLs403JSONResponse) VisitSetUpstreamURLsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUpstreamURLs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetUpstreamURLs404JSONResponse) VisitSetUpstreamURLsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUpstreamURLs500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SetUpstreamURLs500JSONResponse) VisitSetUpstreamURLsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVexStatementsRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListVexStatementsParams
+}
+
+type ListVexStatementsResponseObject interface {
+	VisitListVexStatementsResponse(w http.ResponseWriter) error
+}
+
+type ListVexStatements200JSONResponse struct {
+	ListVexStatementsResponseJSONResponse
+}
+
+func (response ListVexStatements200JSONResponse) VisitListVexStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVexStatements400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListVexStatements400JSONResponse) VisitListVexStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVexStatements401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListVexStatements401JSONResponse) VisitListVexStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVexStatements403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListVexStatements403JSONResponse) VisitListVexStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVexStatements404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListVexStatements404JSONResponse) VisitListVexStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVexStatements500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListVexStatements500JSONResponse) VisitListVexStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadVexDocumentRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      UploadVexDocumentParams
+	Body        *UploadVexDocumentJSONRequestBody
+}
+
+type UploadVexDocumentResponseObject interface {
+	VisitUploadVexDocumentResponse(w http.ResponseWriter) error
+}
+
+type UploadVexDocument201JSONResponse struct {
+	VexDocumentResponseJSONResponse
+}
+
+func (response UploadVexDocument201JSONResponse) VisitUploadVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadVexDocument400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UploadVexDocument400JSONResponse) VisitUploadVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadVexDocument401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response UploadVexDocument401JSONResponse) VisitUploadVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadVexDocument403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UploadVexDocument403JSONResponse) VisitUploadVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadVexDocument404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UploadVexDocument404JSONResponse) VisitUploadVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UploadVexDocument500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UploadVexDocument500JSONResponse) VisitUploadVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVexDocumentRequestObject struct {
+	RegistryRef   RegistryRefPathParam   `json:"registry_ref"`
+	VexDocumentId VexDocumentIdPathParam `json:"vex_document_id"`
+}
+
+type DeleteVexDocumentResponseObject interface {
+	VisitDeleteVexDocumentResponse(w http.ResponseWriter) error
+}
+
+type DeleteVexDocument200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteVexDocument200JSONResponse) VisitDeleteVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVexDocument400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteVexDocument400JSONResponse) VisitDeleteVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVexDocument401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteVexDocument401JSONResponse) VisitDeleteVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVexDocument403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVexDocument403JSONResponse) VisitDeleteVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVexDocument404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVexDocument404JSONResponse) VisitDeleteVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVexDocument500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteVexDocument500JSONResponse) VisitDeleteVexDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Params      ListWebhooksParams
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse struct {
+	ListWebhooksResponseJSONResponse
+}
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListWebhooks400JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+	Body        *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook200JSONResponse struct{ SuccessJSONResponse }
+
+func (response DeleteWebhook200JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteWebhook400JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type GetWebhook200JSONResponse struct{ WebhookResponseJSONResponse }
+
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+type GetWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetWebhook400JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type GetWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response GetWebhook401JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type GetWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetWebhook403JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type GetWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+func (response GetWebhook500JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/registry", wrapper.CreateRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}", wrapper.DeleteRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}", wrapper.GetRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/registry/{registry_ref}", wrapper.ModifyRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/labels", wrapper.ListArtifactLabels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/stats", wrapper.GetArtifactStatsForRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}", wrapper.DeleteArtifact)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/labels", wrapper.UpdateArtifactLabels)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/stats", wrapper.GetArtifactStats)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/summary", wrapper.GetArtifactSummary)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}", wrapper.DeleteArtifactVersion)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/details", wrapper.GetArtifactDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details", wrapper.GetDockerArtifactDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers", wrapper.GetDockerArtifactLayers)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest", wrapper.GetDockerArtifactManifest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests", wrapper.GetDockerArtifactManifests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/files", wrapper.GetArtifactFiles)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details", wrapper.GetHelmArtifactDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest", wrapper.GetHelmArtifactManifest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/version/{version}/summary", wrapper.GetArtifactVersionSummary)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifact/{artifact}/versions", wrapper.GetAllArtifactVersions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/artifacts", wrapper.GetAllArtifactsByRegistry)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/client-setup-details", wrapper.GetClientSetupDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.ListWebhooks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/registry/{registry_ref}/webhooks", wrapper.CreateWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.DeleteWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.GetWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}", wrapper.UpdateWebhook)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions", wrapper.ListWebhookExecutions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}", wrapper.GetWebhookExecution)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger", wrapper.ReTriggerWebhookExecution)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/spaces/{space_ref}/artifact/stats", wrapper.GetArtifactStatsForSpace)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/spaces/{space_ref}/artifacts", wrapper.GetAllArtifacts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/spaces/{space_ref}/registries", wrapper.GetAllRegistries)
-	})
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return r
+type UpdateWebhookRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+	Body              *UpdateWebhookJSONRequestBody
 }
 
-type ArtifactDetailResponseJSONResponse struct {
-	// Data Artifact Detail
-	Data ArtifactDetail `json:"data"`
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type UpdateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
+
+func (response UpdateWebhook201JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactLabelResponseJSONResponse struct {
-	// Data Harness Artifact Summary
-	Data ArtifactSummary `json:"data"`
+type UpdateWebhook400JSONResponse struct{ BadRequestJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactStatsResponseJSONResponse struct {
-	// Data Harness Artifact Stats
-	Data ArtifactStats `json:"data"`
+type UpdateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateWebhook401JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactSummaryResponseJSONResponse struct {
-	// Data Harness Artifact Summary
-	Data ArtifactSummary `json:"data"`
+type UpdateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ArtifactVersionSummaryResponseJSONResponse struct {
-	// Data Docker Artifact Version Summary
-	Data ArtifactVersionSummary `json:"data"`
+type UpdateWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookExecutionsRequestObject struct {
+	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
+	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
+	Params            ListWebhookExecutionsParams
 }
 
-type BadRequestJSONResponse Error
-
-type ClientSetupDetailsResponseJSONResponse struct {
-	// Data Client Setup Details
-	Data ClientSetupDetails `json:"data"`
+type ListWebhookExecutionsResponseObject interface {
+	VisitListWebhookExecutionsResponse(w http.ResponseWriter) error
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type ListWebhookExecutions200JSONResponse struct {
+	ListWebhooksExecutionResponseJSONResponse
 }
 
-type DockerArtifactDetailResponseJSONResponse struct {
-	// Data Docker Artifact Detail
-	Data DockerArtifactDetail `json:"data"`
+func (response ListWebhookExecutions200JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DockerArtifactManifestResponseJSONResponse struct {
-	// Data Docker Artifact Manifest
-	Data DockerArtifactManifest `json:"data"`
+type ListWebhookExecutions400JSONResponse struct{ BadRequestJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListWebhookExecutions400JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DockerLayersResponseJSONResponse struct {
-	// Data Harness Layers Summary
-	Data DockerLayersSummary `json:"data"`
+type ListWebhookExecutions401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListWebhookExecutions401JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DockerManifestsResponseJSONResponse struct {
-	// Data Harness Manifests
-	Data DockerManifests `json:"data"`
+type ListWebhookExecutions403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response ListWebhookExecutions403JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type FileDetailResponseJSONResponse struct {
-	// Files A list of Harness Artifact Files
-	Files []FileDetail `json:"files"`
+type ListWebhookExecutions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-	// ItemCount The total number of items
-	ItemCount *int64 `json:"itemCount,omitempty"`
+func (response ListWebhookExecutions500JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// PageCount The total number of pages
-	PageCount *int64 `json:"pageCount,omitempty"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// PageIndex The current page
-	PageIndex *int64 `json:"pageIndex,omitempty"`
+type GetWebhookExecutionRequestObject struct {
+	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
+	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
+	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
+}
 
-	// PageSize The number of items per page
-	PageSize *int `json:"pageSize,omitempty"`
+type GetWebhookExecutionResponseObject interface {
+	VisitGetWebhookExecutionResponse(w http.ResponseWriter) error
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type GetWebhookExecution200JSONResponse struct {
+	WebhookExecutionResponseJSONResponse
 }
 
-type HelmArtifactDetailResponseJSONResponse struct {
-	// Data Helm Artifact Detail
-	Data HelmArtifactDetail `json:"data"`
+func (response GetWebhookExecution200JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HelmArtifactManifestResponseJSONResponse struct {
-	// Data Helm Artifact Manifest
-	Data HelmArtifactManifest `json:"data"`
+type GetWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+func (response GetWebhookExecution400JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InternalServerErrorJSONResponse Error
+type GetWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-type ListArtifactLabelResponseJSONResponse struct {
-	// Data A list of Harness Artifact Labels
-	Data ListArtifactLabel `json:"data"`
+func (response GetWebhookExecution401JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactResponseJSONResponse struct {
-	// Data A list of Artifacts
-	Data ListArtifact `json:"data"`
+type GetWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
-}
+func (response GetWebhookExecution403JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type ListArtifactVersionResponseJSONResponse struct {
-	// Data A list of Artifact versions
-	Data ListArtifactVersion `json:"data"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type GetWebhookExecution500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-type ListRegistryArtifactResponseJSONResponse struct {
-	// Data A list of Artifacts
-	Data ListRegistryArtifact `json:"data"`
+func (response GetWebhookExecution500JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRegistryResponseJSONResponse struct {
-	// Data A list of Harness Artifact Registries
-	Data ListRegistry `json:"data"`
-
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type ReTriggerWebhookExecutionRequestObject struct {
+	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
+	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
+	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
 }
 
-type ListWebhooksExecutionResponseJSONResponse struct {
-	// Data A list of Harness Registries webhooks executions
-	Data ListWebhooksExecutions `json:"data"`
+type ReTriggerWebhookExecutionResponseObject interface {
+	VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type ReTriggerWebhookExecution200JSONResponse struct {
+	WebhookExecutionResponseJSONResponse
 }
 
-type ListWebhooksResponseJSONResponse struct {
-	// Data A list of Harness Registries webhooks
-	Data ListWebhooks `json:"data"`
+func (response ReTriggerWebhookExecution200JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type NotFoundJSONResponse Error
+type ReTriggerWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
 
-type RegistryResponseJSONResponse struct {
-	// Data Harness Artifact Registry
-	Data Registry `json:"data"`
+func (response ReTriggerWebhookExecution400JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SuccessJSONResponse struct {
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type ReTriggerWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
+
+func (response ReTriggerWebhookExecution401JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnauthenticatedJSONResponse Error
+type ReTriggerWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
 
-type UnauthorizedJSONResponse Error
+func (response ReTriggerWebhookExecution403JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type WebhookExecutionResponseJSONResponse struct {
-	// Data Harness Regstries Webhook Execution
-	Data WebhookExecution `json:"data"`
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+type ReTriggerWebhookExecution500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-type WebhookResponseJSONResponse struct {
-	// Data Harness Regstries Webhook
-	Data Webhook `json:"data"`
+func (response ReTriggerWebhookExecution500JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistryRequestObject struct {
-	Params CreateRegistryParams
-	Body   *CreateRegistryJSONRequestBody
+type GetArtifactStatsForSpaceRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetArtifactStatsForSpaceParams
 }
 
-type CreateRegistryResponseObject interface {
-	VisitCreateRegistryResponse(w http.ResponseWriter) error
+type GetArtifactStatsForSpaceResponseObject interface {
+	VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error
 }
 
-type CreateRegistry201JSONResponse struct{ RegistryResponseJSONResponse }
+type GetArtifactStatsForSpace200JSONResponse struct {
+	ArtifactStatsResponseJSONResponse
+}
 
-func (response CreateRegistry201JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace200JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type GetArtifactStatsForSpace400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CreateRegistry400JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace400JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetArtifactStatsForSpace401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response CreateRegistry401JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace401JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetArtifactStatsForSpace403JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetArtifactStatsForSpace403JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArtifactStatsForSpace404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateRegistry403JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace404JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateRegistry500JSONResponse struct {
+type GetArtifactStatsForSpace500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response CreateRegistry500JSONResponse) VisitCreateRegistryResponse(w http.ResponseWriter) error {
+func (response GetArtifactStatsForSpace500JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+type GetAllArtifactsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetAllArtifactsParams
 }
 
-type DeleteRegistryResponseObject interface {
-	VisitDeleteRegistryResponse(w http.ResponseWriter) error
+type GetAllArtifactsResponseObject interface {
+	VisitGetAllArtifactsResponse(w http.ResponseWriter) error
 }
 
-type DeleteRegistry200JSONResponse struct{ SuccessJSONResponse }
+type GetAllArtifacts200JSONResponse struct {
+	ListArtifactResponseJSONResponse
+}
 
-func (response DeleteRegistry200JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts200JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type GetAllArtifacts400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteRegistry400JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts400JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetAllArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteRegistry401JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts401JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAllArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteRegistry403JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts403JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type GetAllArtifacts404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteRegistry404JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts404JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRegistry500JSONResponse struct {
+type GetAllArtifacts500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteRegistry500JSONResponse) VisitDeleteRegistryResponse(w http.ResponseWriter) error {
+func (response GetAllArtifacts500JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
+type ExportArtifactsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   ExportArtifactsParams
 }
 
-type GetRegistryResponseObject interface {
-	VisitGetRegistryResponse(w http.ResponseWriter) error
+type ExportArtifactsResponseObject interface {
+	VisitExportArtifactsResponse(w http.ResponseWriter) error
 }
 
-type GetRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+type ExportArtifacts200TextcsvResponse struct {
+	ArtifactExportResponseTextcsvResponse
+}
 
-func (response GetRegistry200JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response ExportArtifacts200TextcsvResponse) VisitExportArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type GetRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type ExportArtifacts400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetRegistry400JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ExportArtifacts400JSONResponse) VisitExportArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ExportArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetRegistry401JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ExportArtifacts401JSONResponse) VisitExportArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type ExportArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetRegistry403JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ExportArtifacts403JSONResponse) VisitExportArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type ExportArtifacts404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetRegistry404JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ExportArtifacts404JSONResponse) VisitExportArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRegistry500JSONResponse struct {
+type ExportArtifacts500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetRegistry500JSONResponse) VisitGetRegistryResponse(w http.ResponseWriter) error {
+func (response ExportArtifacts500JSONResponse) VisitExportArtifactsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Body        *ModifyRegistryJSONRequestBody
+type ListClaimMappingsRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
 }
 
-type ModifyRegistryResponseObject interface {
-	VisitModifyRegistryResponse(w http.ResponseWriter) error
+type ListClaimMappingsResponseObject interface {
+	VisitListClaimMappingsResponse(w http.ResponseWriter) error
 }
 
-type ModifyRegistry200JSONResponse struct{ RegistryResponseJSONResponse }
+type ListClaimMappings200JSONResponse struct {
+	ListClaimMappingsResponseJSONResponse
+}
 
-func (response ModifyRegistry200JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response ListClaimMappings200JSONResponse) VisitListClaimMappingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type ListClaimMappings400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ModifyRegistry400JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response ListClaimMappings400JSONResponse) VisitListClaimMappingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListClaimMappings401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response ModifyRegistry401JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response ListClaimMappings401JSONResponse) VisitListClaimMappingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListClaimMappings403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ModifyRegistry403JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response ListClaimMappings403JSONResponse) VisitListClaimMappingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type ListClaimMappings404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ModifyRegistry404JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response ListClaimMappings404JSONResponse) VisitListClaimMappingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModifyRegistry500JSONResponse struct {
+type ListClaimMappings500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response ModifyRegistry500JSONResponse) VisitModifyRegistryResponse(w http.ResponseWriter) error {
+func (response ListClaimMappings500JSONResponse) VisitListClaimMappingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabelsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      ListArtifactLabelsParams
+type CreateClaimMappingRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Body     *CreateClaimMappingJSONRequestBody
 }
 
-type ListArtifactLabelsResponseObject interface {
-	VisitListArtifactLabelsResponse(w http.ResponseWriter) error
+type CreateClaimMappingResponseObject interface {
+	VisitCreateClaimMappingResponse(w http.ResponseWriter) error
 }
 
-type ListArtifactLabels200JSONResponse struct {
-	ListArtifactLabelResponseJSONResponse
+type CreateClaimMapping201JSONResponse struct {
+	ClaimMappingResponseJSONResponse
 }
 
-func (response ListArtifactLabels200JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response CreateClaimMapping201JSONResponse) VisitCreateClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+type CreateClaimMapping400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListArtifactLabels400JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response CreateClaimMapping400JSONResponse) VisitCreateClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+type CreateClaimMapping401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response ListArtifactLabels401JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response CreateClaimMapping401JSONResponse) VisitCreateClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateClaimMapping403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListArtifactLabels403JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response CreateClaimMapping403JSONResponse) VisitCreateClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+type CreateClaimMapping404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ListArtifactLabels404JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response CreateClaimMapping404JSONResponse) VisitCreateClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListArtifactLabels500JSONResponse struct {
+type CreateClaimMapping500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response ListArtifactLabels500JSONResponse) VisitListArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response CreateClaimMapping500JSONResponse) VisitCreateClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      GetArtifactStatsForRegistryParams
+type DeleteClaimMappingRequestObject struct {
+	SpaceRef       SpaceRefPathParam       `json:"space_ref"`
+	ClaimMappingId ClaimMappingIdPathParam `json:"claim_mapping_id"`
 }
 
-type GetArtifactStatsForRegistryResponseObject interface {
-	VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error
+type DeleteClaimMappingResponseObject interface {
+	VisitDeleteClaimMappingResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactStatsForRegistry200JSONResponse struct {
-	ArtifactStatsResponseJSONResponse
-}
+type DeleteClaimMapping200JSONResponse struct{ SuccessJSONResponse }
 
-func (response GetArtifactStatsForRegistry200JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response DeleteClaimMapping200JSONResponse) VisitDeleteClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteClaimMapping400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactStatsForRegistry400JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response DeleteClaimMapping400JSONResponse) VisitDeleteClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
+type DeleteClaimMapping401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactStatsForRegistry401JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response DeleteClaimMapping401JSONResponse) VisitDeleteClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteClaimMapping403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactStatsForRegistry403JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response DeleteClaimMapping403JSONResponse) VisitDeleteClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry404JSONResponse struct{ NotFoundJSONResponse }
+type DeleteClaimMapping404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactStatsForRegistry404JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response DeleteClaimMapping404JSONResponse) VisitDeleteClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsForRegistry500JSONResponse struct {
+type DeleteClaimMapping500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactStatsForRegistry500JSONResponse) VisitGetArtifactStatsForRegistryResponse(w http.ResponseWriter) error {
+func (response DeleteClaimMapping500JSONResponse) VisitDeleteClaimMappingResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
+type ListDefaultRegistriesRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
 }
 
-type DeleteArtifactResponseObject interface {
-	VisitDeleteArtifactResponse(w http.ResponseWriter) error
+type ListDefaultRegistriesResponseObject interface {
+	VisitListDefaultRegistriesResponse(w http.ResponseWriter) error
 }
 
-type DeleteArtifact200JSONResponse struct{ SuccessJSONResponse }
+type ListDefaultRegistries200JSONResponse struct {
+	ListDefaultRegistriesResponseJSONResponse
+}
 
-func (response DeleteArtifact200JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response ListDefaultRegistries200JSONResponse) VisitListDefaultRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact400JSONResponse struct{ BadRequestJSONResponse }
+type ListDefaultRegistries400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteArtifact400JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response ListDefaultRegistries400JSONResponse) VisitListDefaultRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ListDefaultRegistries401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteArtifact401JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response ListDefaultRegistries401JSONResponse) VisitListDefaultRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact403JSONResponse struct{ UnauthorizedJSONResponse }
+type ListDefaultRegistries403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteArtifact403JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response ListDefaultRegistries403JSONResponse) VisitListDefaultRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact404JSONResponse struct{ NotFoundJSONResponse }
+type ListDefaultRegistries404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteArtifact404JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response ListDefaultRegistries404JSONResponse) VisitListDefaultRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifact500JSONResponse struct {
+type ListDefaultRegistries500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteArtifact500JSONResponse) VisitDeleteArtifactResponse(w http.ResponseWriter) error {
+func (response ListDefaultRegistries500JSONResponse) VisitListDefaultRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabelsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Body        *UpdateArtifactLabelsJSONRequestBody
+type DeleteDefaultRegistryRequestObject struct {
+	SpaceRef    SpaceRefPathParam    `json:"space_ref"`
+	PackageType PackageTypePathParam `json:"package_type"`
 }
 
-type UpdateArtifactLabelsResponseObject interface {
-	VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error
+type DeleteDefaultRegistryResponseObject interface {
+	VisitDeleteDefaultRegistryResponse(w http.ResponseWriter) error
 }
 
-type UpdateArtifactLabels200JSONResponse struct {
-	ArtifactLabelResponseJSONResponse
-}
+type DeleteDefaultRegistry200JSONResponse struct{ SuccessJSONResponse }
 
-func (response UpdateArtifactLabels200JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response DeleteDefaultRegistry200JSONResponse) VisitDeleteDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteDefaultRegistry400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateArtifactLabels400JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response DeleteDefaultRegistry400JSONResponse) VisitDeleteDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels401JSONResponse struct{ UnauthenticatedJSONResponse }
+type DeleteDefaultRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response UpdateArtifactLabels401JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response DeleteDefaultRegistry401JSONResponse) VisitDeleteDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels403JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteDefaultRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateArtifactLabels403JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response DeleteDefaultRegistry403JSONResponse) VisitDeleteDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels404JSONResponse struct{ NotFoundJSONResponse }
+type DeleteDefaultRegistry404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpdateArtifactLabels404JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response DeleteDefaultRegistry404JSONResponse) VisitDeleteDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateArtifactLabels500JSONResponse struct {
+type DeleteDefaultRegistry500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response UpdateArtifactLabels500JSONResponse) VisitUpdateArtifactLabelsResponse(w http.ResponseWriter) error {
+func (response DeleteDefaultRegistry500JSONResponse) VisitDeleteDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStatsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      GetArtifactStatsParams
+type ResolveDefaultRegistryRequestObject struct {
+	SpaceRef    SpaceRefPathParam    `json:"space_ref"`
+	PackageType PackageTypePathParam `json:"package_type"`
 }
 
-type GetArtifactStatsResponseObject interface {
-	VisitGetArtifactStatsResponse(w http.ResponseWriter) error
+type ResolveDefaultRegistryResponseObject interface {
+	VisitResolveDefaultRegistryResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactStats200JSONResponse struct {
-	ArtifactStatsResponseJSONResponse
+type ResolveDefaultRegistry200JSONResponse struct {
+	DefaultRegistryResponseJSONResponse
 }
 
-func (response GetArtifactStats200JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response ResolveDefaultRegistry200JSONResponse) VisitResolveDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats400JSONResponse struct{ BadRequestJSONResponse }
+type ResolveDefaultRegistry400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactStats400JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response ResolveDefaultRegistry400JSONResponse) VisitResolveDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats401JSONResponse struct{ UnauthenticatedJSONResponse }
+type ResolveDefaultRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactStats401JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response ResolveDefaultRegistry401JSONResponse) VisitResolveDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats403JSONResponse struct{ UnauthorizedJSONResponse }
+type ResolveDefaultRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactStats403JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response ResolveDefaultRegistry403JSONResponse) VisitResolveDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats404JSONResponse struct{ NotFoundJSONResponse }
+type ResolveDefaultRegistry404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactStats404JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response ResolveDefaultRegistry404JSONResponse) VisitResolveDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactStats500JSONResponse struct {
+type ResolveDefaultRegistry500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactStats500JSONResponse) VisitGetArtifactStatsResponse(w http.ResponseWriter) error {
+func (response ResolveDefaultRegistry500JSONResponse) VisitResolveDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummaryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
+type SetDefaultRegistryRequestObject struct {
+	SpaceRef    SpaceRefPathParam    `json:"space_ref"`
+	PackageType PackageTypePathParam `json:"package_type"`
+	Body        *SetDefaultRegistryJSONRequestBody
 }
 
-type GetArtifactSummaryResponseObject interface {
-	VisitGetArtifactSummaryResponse(w http.ResponseWriter) error
+type SetDefaultRegistryResponseObject interface {
+	VisitSetDefaultRegistryResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactSummary200JSONResponse struct {
-	ArtifactSummaryResponseJSONResponse
+type SetDefaultRegistry200JSONResponse struct {
+	DefaultRegistryResponseJSONResponse
 }
 
-func (response GetArtifactSummary200JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response SetDefaultRegistry200JSONResponse) VisitSetDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary400JSONResponse struct{ BadRequestJSONResponse }
+type SetDefaultRegistry400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactSummary400JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response SetDefaultRegistry400JSONResponse) VisitSetDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
+type SetDefaultRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactSummary401JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response SetDefaultRegistry401JSONResponse) VisitSetDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+type SetDefaultRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactSummary403JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response SetDefaultRegistry403JSONResponse) VisitSetDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary404JSONResponse struct{ NotFoundJSONResponse }
+type SetDefaultRegistry404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactSummary404JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response SetDefaultRegistry404JSONResponse) VisitSetDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactSummary500JSONResponse struct {
+type SetDefaultRegistry500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactSummary500JSONResponse) VisitGetArtifactSummaryResponse(w http.ResponseWriter) error {
+func (response SetDefaultRegistry500JSONResponse) VisitSetDefaultRegistryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersionRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
+type GetAllRegistriesRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetAllRegistriesParams
 }
 
-type DeleteArtifactVersionResponseObject interface {
-	VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error
+type GetAllRegistriesResponseObject interface {
+	VisitGetAllRegistriesResponse(w http.ResponseWriter) error
 }
 
-type DeleteArtifactVersion200JSONResponse struct{ SuccessJSONResponse }
+type GetAllRegistries200JSONResponse struct {
+	ListRegistryResponseJSONResponse
+}
 
-func (response DeleteArtifactVersion200JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries200JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion400JSONResponse struct{ BadRequestJSONResponse }
+type GetAllRegistries400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteArtifactVersion400JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries400JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetAllRegistries401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response DeleteArtifactVersion401JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries401JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetAllRegistries403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteArtifactVersion403JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries403JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion404JSONResponse struct{ NotFoundJSONResponse }
+type GetAllRegistries404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteArtifactVersion404JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries404JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteArtifactVersion500JSONResponse struct {
+type GetAllRegistries500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteArtifactVersion500JSONResponse) VisitDeleteArtifactVersionResponse(w http.ResponseWriter) error {
+func (response GetAllRegistries500JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetArtifactDetailsParams
+type GetPackageImpactRequestObject struct {
+	SpaceRef SpaceRefPathParam `json:"space_ref"`
+	Params   GetPackageImpactParams
 }
 
-type GetArtifactDetailsResponseObject interface {
-	VisitGetArtifactDetailsResponse(w http.ResponseWriter) error
+type GetPackageImpactResponseObject interface {
+	VisitGetPackageImpactResponse(w http.ResponseWriter) error
 }
 
-type GetArtifactDetails200JSONResponse struct {
-	ArtifactDetailResponseJSONResponse
+type GetPackageImpact200JSONResponse struct {
+	PackageImpactResponseJSONResponse
 }
 
-func (response GetArtifactDetails200JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetPackageImpact200JSONResponse) VisitGetPackageImpactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+type GetPackageImpact400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetArtifactDetails400JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetPackageImpact400JSONResponse) VisitGetPackageImpactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+type GetPackageImpact401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetArtifactDetails401JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetPackageImpact401JSONResponse) VisitGetPackageImpactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+type GetPackageImpact403JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetArtifactDetails403JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetPackageImpact403JSONResponse) VisitGetPackageImpactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+type GetPackageImpact404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetArtifactDetails404JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetPackageImpact404JSONResponse) VisitGetPackageImpactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetArtifactDetails500JSONResponse struct {
+type GetPackageImpact500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetArtifactDetails500JSONResponse) VisitGetArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response GetPackageImpact500JSONResponse) VisitGetPackageImpactResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetDockerArtifactDetailsParams
+type CompareVersionsRequestObject struct {
+	Body *CompareVersionsJSONRequestBody
 }
 
-type GetDockerArtifactDetailsResponseObject interface {
-	VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error
+type CompareVersionsResponseObject interface {
+	VisitCompareVersionsResponse(w http.ResponseWriter) error
 }
 
-type GetDockerArtifactDetails200JSONResponse struct {
-	DockerArtifactDetailResponseJSONResponse
+type CompareVersions200JSONResponse struct {
+	VersionComparisonResponseJSONResponse
 }
 
-func (response GetDockerArtifactDetails200JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CompareVersions200JSONResponse) VisitCompareVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
+type CompareVersions400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetDockerArtifactDetails400JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CompareVersions400JSONResponse) VisitCompareVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+type CompareVersions401JSONResponse struct{ UnauthenticatedJSONResponse }
 
-func (response GetDockerArtifactDetails401JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CompareVersions401JSONResponse) VisitCompareVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+type CompareVersions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
 
-func (response GetDockerArtifactDetails403JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
+func (response CompareVersions500JSONResponse) VisitCompareVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDockerArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Create Registry.
+	// (POST /registry)
+	CreateRegistry(ctx context.Context, request CreateRegistryRequestObject) (CreateRegistryResponseObject, error)
+	// Create Registries
+	// (POST /registry/bulk)
+	CreateRegistries(ctx context.Context, request CreateRegistriesRequestObject) (CreateRegistriesResponseObject, error)
+	// Apply a Registry configuration
+	// (POST /registry/config)
+	ApplyRegistryConfig(ctx context.Context, request ApplyRegistryConfigRequestObject) (ApplyRegistryConfigResponseObject, error)
+	// Exchange an ID token for a registry token
+	// (POST /registry/identity/token)
+	ExchangeIdentityToken(ctx context.Context, request ExchangeIdentityTokenRequestObject) (ExchangeIdentityTokenResponseObject, error)
+	// Onboard a Registry
+	// (POST /registry/onboarding)
+	OnboardRegistry(ctx context.Context, request OnboardRegistryRequestObject) (OnboardRegistryResponseObject, error)
+	// Check a Registry identifier
+	// (POST /registry/onboarding/identifier-check)
+	ValidateRegistryIdentifier(ctx context.Context, request ValidateRegistryIdentifierRequestObject) (ValidateRegistryIdentifierResponseObject, error)
+	// Estimate an import
+	// (POST /registry/onboarding/import-estimate)
+	EstimateRegistryImport(ctx context.Context, request EstimateRegistryImportRequestObject) (EstimateRegistryImportResponseObject, error)
+	// Check an upstream
+	// (POST /registry/onboarding/upstream-check)
+	CheckUpstreamConnectivity(ctx context.Context, request CheckUpstreamConnectivityRequestObject) (CheckUpstreamConnectivityResponseObject, error)
+	// Resolve a permalink
+	// (GET /registry/permalinks/{permalink_code})
+	ResolvePermalink(ctx context.Context, request ResolvePermalinkRequestObject) (ResolvePermalinkResponseObject, error)
+	// Delete a Registry
+	// (DELETE /registry/{registry_ref})
+	DeleteRegistry(ctx context.Context, request DeleteRegistryRequestObject) (DeleteRegistryResponseObject, error)
+	// Returns Registry Details
+	// (GET /registry/{registry_ref})
+	GetRegistry(ctx context.Context, request GetRegistryRequestObject) (GetRegistryResponseObject, error)
+	// Updates a Registry
+	// (PUT /registry/{registry_ref})
+	ModifyRegistry(ctx context.Context, request ModifyRegistryRequestObject) (ModifyRegistryResponseObject, error)
+	// List active access grants
+	// (GET /registry/{registry_ref}/access-grants)
+	ListAccessGrants(ctx context.Context, request ListAccessGrantsRequestObject) (ListAccessGrantsResponseObject, error)
+	// Grant temporary access to a registry
+	// (POST /registry/{registry_ref}/access-grants)
+	CreateAccessGrant(ctx context.Context, request CreateAccessGrantRequestObject) (CreateAccessGrantResponseObject, error)
+	// Revoke an access grant
+	// (DELETE /registry/{registry_ref}/access-grants/{access_grant_id})
+	RevokeAccessGrant(ctx context.Context, request RevokeAccessGrantRequestObject) (RevokeAccessGrantResponseObject, error)
+	// Search Docker manifests by annotation
+	// (GET /registry/{registry_ref}/annotations/search)
+	SearchDockerManifestsByAnnotation(ctx context.Context, request SearchDockerManifestsByAnnotationRequestObject) (SearchDockerManifestsByAnnotationResponseObject, error)
+	// List Artifact Labels
+	// (GET /registry/{registry_ref}/artifact/labels)
+	ListArtifactLabels(ctx context.Context, request ListArtifactLabelsRequestObject) (ListArtifactLabelsResponseObject, error)
+	// Get Artifact Stats
+	// (GET /registry/{registry_ref}/artifact/stats)
+	GetArtifactStatsForRegistry(ctx context.Context, request GetArtifactStatsForRegistryRequestObject) (GetArtifactStatsForRegistryResponseObject, error)
+	// Delete Artifact
+	// (DELETE /registry/{registry_ref}/artifact/{artifact})
+	DeleteArtifact(ctx context.Context, request DeleteArtifactRequestObject) (DeleteArtifactResponseObject, error)
+	// Check Artifact Exists
+	// (HEAD /registry/{registry_ref}/artifact/{artifact})
+	HeadArtifact(ctx context.Context, request HeadArtifactRequestObject) (HeadArtifactResponseObject, error)
+	// Get a badge of an artifact
+	// (GET /registry/{registry_ref}/artifact/{artifact}/badge/{badge})
+	GetArtifactBadge(ctx context.Context, request GetArtifactBadgeRequestObject) (GetArtifactBadgeResponseObject, error)
+	// Get the feed of an artifact
+	// (GET /registry/{registry_ref}/artifact/{artifact}/feed)
+	GetArtifactFeed(ctx context.Context, request GetArtifactFeedRequestObject) (GetArtifactFeedResponseObject, error)
+	// Update Artifact Labels
+	// (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
+	UpdateArtifactLabels(ctx context.Context, request UpdateArtifactLabelsRequestObject) (UpdateArtifactLabelsResponseObject, error)
+	// Get Artifact Stats
+	// (GET /registry/{registry_ref}/artifact/{artifact}/stats)
+	GetArtifactStats(ctx context.Context, request GetArtifactStatsRequestObject) (GetArtifactStatsResponseObject, error)
+	// Get Artifact Summary
+	// (GET /registry/{registry_ref}/artifact/{artifact}/summary)
+	GetArtifactSummary(ctx context.Context, request GetArtifactSummaryRequestObject) (GetArtifactSummaryResponseObject, error)
+	// Delete an Artifact Version
+	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version})
+	DeleteArtifactVersion(ctx context.Context, request DeleteArtifactVersionRequestObject) (DeleteArtifactVersionResponseObject, error)
+	// Check Artifact Version Exists
+	// (HEAD /registry/{registry_ref}/artifact/{artifact}/version/{version})
+	HeadArtifactVersion(ctx context.Context, request HeadArtifactVersionRequestObject) (HeadArtifactVersionResponseObject, error)
+	// Describe Artifact Details
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/details)
+	GetArtifactDetails(ctx context.Context, request GetArtifactDetailsRequestObject) (GetArtifactDetailsResponseObject, error)
+	// Describe Docker Artifact Detail
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details)
+	GetDockerArtifactDetails(ctx context.Context, request GetDockerArtifactDetailsRequestObject) (GetDockerArtifactDetailsResponseObject, error)
+	// Describe Docker Artifact Layers
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers)
+	GetDockerArtifactLayers(ctx context.Context, request GetDockerArtifactLayersRequestObject) (GetDockerArtifactLayersResponseObject, error)
+	// Describe Docker Artifact Manifest
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest)
+	GetDockerArtifactManifest(ctx context.Context, request GetDockerArtifactManifestRequestObject) (GetDockerArtifactManifestResponseObject, error)
+	// Describe Docker Artifact Manifests
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests)
+	GetDockerArtifactManifests(ctx context.Context, request GetDockerArtifactManifestsRequestObject) (GetDockerArtifactManifestsResponseObject, error)
+	// Export the evidence bundle of a version
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/evidence)
+	ExportVersionEvidence(ctx context.Context, request ExportVersionEvidenceRequestObject) (ExportVersionEvidenceResponseObject, error)
+	// Check Artifact File Exists
+	// (HEAD /registry/{registry_ref}/artifact/{artifact}/version/{version}/file)
+	HeadArtifactFile(ctx context.Context, request HeadArtifactFileRequestObject) (HeadArtifactFileResponseObject, error)
+	// Describe Artifact files
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/files)
+	GetArtifactFiles(ctx context.Context, request GetArtifactFilesRequestObject) (GetArtifactFilesResponseObject, error)
+	// Describe Helm Artifact Detail
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details)
+	GetHelmArtifactDetails(ctx context.Context, request GetHelmArtifactDetailsRequestObject) (GetHelmArtifactDetailsResponseObject, error)
+	// Describe Helm Artifact Manifest
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest)
+	GetHelmArtifactManifest(ctx context.Context, request GetHelmArtifactManifestRequestObject) (GetHelmArtifactManifestResponseObject, error)
+	// Describe Model Artifact Detail
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/model/details)
+	GetModelArtifactDetails(ctx context.Context, request GetModelArtifactDetailsRequestObject) (GetModelArtifactDetailsResponseObject, error)
+	// Create a permalink of an artifact version
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/permalink)
+	CreateArtifactVersionPermalink(ctx context.Context, request CreateArtifactVersionPermalinkRequestObject) (CreateArtifactVersionPermalinkResponseObject, error)
+	// Hard delete an artifact version
+	// (POST /registry/{registry_ref}/artifact/{artifact}/version/{version}/purge)
+	PurgeArtifactVersion(ctx context.Context, request PurgeArtifactVersionRequestObject) (PurgeArtifactVersionResponseObject, error)
+	// Get storage locations of an artifact version
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/storage)
+	GetArtifactVersionStorage(ctx context.Context, request GetArtifactVersionStorageRequestObject) (GetArtifactVersionStorageResponseObject, error)
+	// Get Artifact Version Summary
+	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
+	GetArtifactVersionSummary(ctx context.Context, request GetArtifactVersionSummaryRequestObject) (GetArtifactVersionSummaryResponseObject, error)
+	// List Artifact Versions
+	// (GET /registry/{registry_ref}/artifact/{artifact}/versions)
+	GetAllArtifactVersions(ctx context.Context, request GetAllArtifactVersionsRequestObject) (GetAllArtifactVersionsResponseObject, error)
+	// List Artifacts for Registry
+	// (GET /registry/{registry_ref}/artifacts)
+	GetAllArtifactsByRegistry(ctx context.Context, request GetAllArtifactsByRegistryRequestObject) (GetAllArtifactsByRegistryResponseObject, error)
+	// Export Artifacts for Registry
+	// (GET /registry/{registry_ref}/artifacts/export)
+	ExportRegistryArtifacts(ctx context.Context, request ExportRegistryArtifactsRequestObject) (ExportRegistryArtifactsResponseObject, error)
+	// Search Java classes inside artifacts
+	// (GET /registry/{registry_ref}/classes/search)
+	SearchArtifactClasses(ctx context.Context, request SearchArtifactClassesRequestObject) (SearchArtifactClassesResponseObject, error)
+	// Returns CLI Client Setup Details
+	// (GET /registry/{registry_ref}/client-setup-details)
+	GetClientSetupDetails(ctx context.Context, request GetClientSetupDetailsRequestObject) (GetClientSetupDetailsResponseObject, error)
+	// Clone a Registry
+	// (POST /registry/{registry_ref}/clone)
+	CloneRegistry(ctx context.Context, request CloneRegistryRequestObject) (CloneRegistryResponseObject, error)
+	// Export a Registry configuration
+	// (GET /registry/{registry_ref}/config)
+	ExportRegistryConfig(ctx context.Context, request ExportRegistryConfigRequestObject) (ExportRegistryConfigResponseObject, error)
+	// List the revisions of a Registry configuration
+	// (GET /registry/{registry_ref}/config/revisions)
+	ListRegistryConfigRevisions(ctx context.Context, request ListRegistryConfigRevisionsRequestObject) (ListRegistryConfigRevisionsResponseObject, error)
+	// Get a revision of a Registry configuration
+	// (GET /registry/{registry_ref}/config/revisions/{revision})
+	GetRegistryConfigRevision(ctx context.Context, request GetRegistryConfigRevisionRequestObject) (GetRegistryConfigRevisionResponseObject, error)
+	// Roll back a Registry configuration
+	// (POST /registry/{registry_ref}/config/revisions/{revision}/rollback)
+	RollbackRegistryConfig(ctx context.Context, request RollbackRegistryConfigRequestObject) (RollbackRegistryConfigResponseObject, error)
+	// Search files inside artifacts
+	// (GET /registry/{registry_ref}/contents/search)
+	SearchArtifactContents(ctx context.Context, request SearchArtifactContentsRequestObject) (SearchArtifactContentsResponseObject, error)
+	// List deletion certificates
+	// (GET /registry/{registry_ref}/deletion-certificates)
+	ListDeletionCertificates(ctx context.Context, request ListDeletionCertificatesRequestObject) (ListDeletionCertificatesResponseObject, error)
+	// Get a deletion certificate
+	// (GET /registry/{registry_ref}/deletion-certificates/{certificate_id})
+	GetDeletionCertificate(ctx context.Context, request GetDeletionCertificateRequestObject) (GetDeletionCertificateResponseObject, error)
+	// Get the download origins of a registry
+	// (GET /registry/{registry_ref}/download-origins)
+	GetRegistryDownloadOrigins(ctx context.Context, request GetRegistryDownloadOriginsRequestObject) (GetRegistryDownloadOriginsResponseObject, error)
+	// Get the feed of a registry
+	// (GET /registry/{registry_ref}/feed)
+	GetRegistryFeed(ctx context.Context, request GetRegistryFeedRequestObject) (GetRegistryFeedResponseObject, error)
+	// Delete Helm push policy
+	// (DELETE /registry/{registry_ref}/helm/push-policy)
+	DeleteHelmPushPolicy(ctx context.Context, request DeleteHelmPushPolicyRequestObject) (DeleteHelmPushPolicyResponseObject, error)
+	// Get Helm push policy
+	// (GET /registry/{registry_ref}/helm/push-policy)
+	GetHelmPushPolicy(ctx context.Context, request GetHelmPushPolicyRequestObject) (GetHelmPushPolicyResponseObject, error)
+	// Set Helm push policy
+	// (PUT /registry/{registry_ref}/helm/push-policy)
+	SetHelmPushPolicy(ctx context.Context, request SetHelmPushPolicyRequestObject) (SetHelmPushPolicyResponseObject, error)
+	// Search files inside image layers
+	// (GET /registry/{registry_ref}/layers/search)
+	SearchImageLayerContents(ctx context.Context, request SearchImageLayerContentsRequestObject) (SearchImageLayerContentsResponseObject, error)
+	// List legal holds
+	// (GET /registry/{registry_ref}/legal-holds)
+	ListLegalHolds(ctx context.Context, request ListLegalHoldsRequestObject) (ListLegalHoldsResponseObject, error)
+	// Place a legal hold
+	// (POST /registry/{registry_ref}/legal-holds)
+	CreateLegalHold(ctx context.Context, request CreateLegalHoldRequestObject) (CreateLegalHoldResponseObject, error)
+	// Release a legal hold
+	// (DELETE /registry/{registry_ref}/legal-holds/{legal_hold_id})
+	DeleteLegalHold(ctx context.Context, request DeleteLegalHoldRequestObject) (DeleteLegalHoldResponseObject, error)
+	// List Maven relocations
+	// (GET /registry/{registry_ref}/maven/relocations)
+	ListMavenRelocations(ctx context.Context, request ListMavenRelocationsRequestObject) (ListMavenRelocationsResponseObject, error)
+	// Relocate a Maven artifact
+	// (POST /registry/{registry_ref}/maven/relocations)
+	CreateMavenRelocation(ctx context.Context, request CreateMavenRelocationRequestObject) (CreateMavenRelocationResponseObject, error)
+	// Delete a Maven relocation
+	// (DELETE /registry/{registry_ref}/maven/relocations/{relocation_id})
+	DeleteMavenRelocation(ctx context.Context, request DeleteMavenRelocationRequestObject) (DeleteMavenRelocationResponseObject, error)
+	// List the members of a virtual registry
+	// (GET /registry/{registry_ref}/members)
+	ListRegistryMembers(ctx context.Context, request ListRegistryMembersRequestObject) (ListRegistryMembersResponseObject, error)
+	// Reorder the members of a virtual registry
+	// (PUT /registry/{registry_ref}/members)
+	ReorderRegistryMembers(ctx context.Context, request ReorderRegistryMembersRequestObject) (ReorderRegistryMembersResponseObject, error)
+	// List mirror syncs
+	// (GET /registry/{registry_ref}/mirror-syncs)
+	ListMirrorSyncs(ctx context.Context, request ListMirrorSyncsRequestObject) (ListMirrorSyncsResponseObject, error)
+	// Create a mirror sync
+	// (POST /registry/{registry_ref}/mirror-syncs)
+	CreateMirrorSync(ctx context.Context, request CreateMirrorSyncRequestObject) (CreateMirrorSyncResponseObject, error)
+	// Delete a mirror sync
+	// (DELETE /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+	DeleteMirrorSync(ctx context.Context, request DeleteMirrorSyncRequestObject) (DeleteMirrorSyncResponseObject, error)
+	// Get a mirror sync
+	// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+	GetMirrorSync(ctx context.Context, request GetMirrorSyncRequestObject) (GetMirrorSyncResponseObject, error)
+	// Update a mirror sync
+	// (PUT /registry/{registry_ref}/mirror-syncs/{mirror_sync_id})
+	UpdateMirrorSync(ctx context.Context, request UpdateMirrorSyncRequestObject) (UpdateMirrorSyncResponseObject, error)
+	// List mirror sync runs
+	// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs)
+	ListMirrorSyncRuns(ctx context.Context, request ListMirrorSyncRunsRequestObject) (ListMirrorSyncRunsResponseObject, error)
+	// Run a mirror sync
+	// (POST /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs)
+	RunMirrorSync(ctx context.Context, request RunMirrorSyncRequestObject) (RunMirrorSyncResponseObject, error)
+	// Get a mirror sync run
+	// (GET /registry/{registry_ref}/mirror-syncs/{mirror_sync_id}/runs/{mirror_sync_run_id})
+	GetMirrorSyncRun(ctx context.Context, request GetMirrorSyncRunRequestObject) (GetMirrorSyncRunResponseObject, error)
+	// Simulate Registry Policies
+	// (POST /registry/{registry_ref}/policies/simulate)
+	SimulateRegistryPolicies(ctx context.Context, request SimulateRegistryPoliciesRequestObject) (SimulateRegistryPoliciesResponseObject, error)
+	// List registry operation queues
+	// (GET /registry/{registry_ref}/queues)
+	ListRegistryQueues(ctx context.Context, request ListRegistryQueuesRequestObject) (ListRegistryQueuesResponseObject, error)
+	// Pause a registry operation queue
+	// (POST /registry/{registry_ref}/queues/{queue}/pause)
+	PauseRegistryQueue(ctx context.Context, request PauseRegistryQueueRequestObject) (PauseRegistryQueueResponseObject, error)
+	// Resume a registry operation queue
+	// (POST /registry/{registry_ref}/queues/{queue}/resume)
+	ResumeRegistryQueue(ctx context.Context, request ResumeRegistryQueueRequestObject) (ResumeRegistryQueueResponseObject, error)
+	// Trace how a coordinate resolves
+	// (GET /registry/{registry_ref}/resolve)
+	GetResolveTrace(ctx context.Context, request GetResolveTraceRequestObject) (GetResolveTraceResponseObject, error)
+	// Trace how an artifact path resolves
+	// (GET /registry/{registry_ref}/resolve/path)
+	ResolveArtifactPath(ctx context.Context, request ResolveArtifactPathRequestObject) (ResolveArtifactPathResponseObject, error)
+	// List scan results
+	// (GET /registry/{registry_ref}/scans)
+	ListScanResults(ctx context.Context, request ListScanResultsRequestObject) (ListScanResultsResponseObject, error)
+	// Report a scan result
+	// (POST /registry/{registry_ref}/scans)
+	IngestScanResult(ctx context.Context, request IngestScanResultRequestObject) (IngestScanResultResponseObject, error)
+	// Get a scan result
+	// (GET /registry/{registry_ref}/scans/{scan_id})
+	GetScanResult(ctx context.Context, request GetScanResultRequestObject) (GetScanResultResponseObject, error)
+	// List the vulnerability allowlist
+	// (GET /registry/{registry_ref}/security/allowlist)
+	ListVulnerabilityAllowlist(ctx context.Context, request ListVulnerabilityAllowlistRequestObject) (ListVulnerabilityAllowlistResponseObject, error)
+	// Allowlist a vulnerability
+	// (POST /registry/{registry_ref}/security/allowlist)
+	CreateVulnerabilityAllowlistEntry(ctx context.Context, request CreateVulnerabilityAllowlistEntryRequestObject) (CreateVulnerabilityAllowlistEntryResponseObject, error)
+	// Remove a vulnerability from the allowlist
+	// (DELETE /registry/{registry_ref}/security/allowlist/{allowlist_entry_id})
+	DeleteVulnerabilityAllowlistEntry(ctx context.Context, request DeleteVulnerabilityAllowlistEntryRequestObject) (DeleteVulnerabilityAllowlistEntryResponseObject, error)
+	// Get the security posture of a registry
+	// (GET /registry/{registry_ref}/security/posture)
+	GetRegistrySecurityPosture(ctx context.Context, request GetRegistrySecurityPostureRequestObject) (GetRegistrySecurityPostureResponseObject, error)
+	// Delete cache eviction policy
+	// (DELETE /registry/{registry_ref}/upstream/cache-eviction)
+	DeleteCacheEvictionPolicy(ctx context.Context, request DeleteCacheEvictionPolicyRequestObject) (DeleteCacheEvictionPolicyResponseObject, error)
+	// Get cache eviction policy
+	// (GET /registry/{registry_ref}/upstream/cache-eviction)
+	GetCacheEvictionPolicy(ctx context.Context, request GetCacheEvictionPolicyRequestObject) (GetCacheEvictionPolicyResponseObject, error)
+	// Set cache eviction policy
+	// (PUT /registry/{registry_ref}/upstream/cache-eviction)
+	SetCacheEvictionPolicy(ctx context.Context, request SetCacheEvictionPolicyRequestObject) (SetCacheEvictionPolicyResponseObject, error)
+	// Rotate Upstream Credentials
+	// (PUT /registry/{registry_ref}/upstream/credentials)
+	RotateUpstreamCredentials(ctx context.Context, request RotateUpstreamCredentialsRequestObject) (RotateUpstreamCredentialsResponseObject, error)
+	// List package rule violations
+	// (GET /registry/{registry_ref}/upstream/package-rule-violations)
+	ListPackageRuleViolations(ctx context.Context, request ListPackageRuleViolationsRequestObject) (ListPackageRuleViolationsResponseObject, error)
+	// List package rules
+	// (GET /registry/{registry_ref}/upstream/package-rules)
+	ListPackageRules(ctx context.Context, request ListPackageRulesRequestObject) (ListPackageRulesResponseObject, error)
+	// Create a package rule
+	// (POST /registry/{registry_ref}/upstream/package-rules)
+	CreatePackageRule(ctx context.Context, request CreatePackageRuleRequestObject) (CreatePackageRuleResponseObject, error)
+	// Delete a package rule
+	// (DELETE /registry/{registry_ref}/upstream/package-rules/{rule_id})
+	DeletePackageRule(ctx context.Context, request DeletePackageRuleRequestObject) (DeletePackageRuleResponseObject, error)
+	// Prewarm upstream cache
+	// (POST /registry/{registry_ref}/upstream/prewarm)
+	PrewarmUpstreamCache(ctx context.Context, request PrewarmUpstreamCacheRequestObject) (PrewarmUpstreamCacheResponseObject, error)
+	// Get upstream cache prewarm
+	// (GET /registry/{registry_ref}/upstream/prewarm/{batch})
+	GetUpstreamCachePrewarm(ctx context.Context, request GetUpstreamCachePrewarmRequestObject) (GetUpstreamCachePrewarmResponseObject, error)
+	// Get upstream status
+	// (GET /registry/{registry_ref}/upstream/status)
+	GetUpstreamStatus(ctx context.Context, request GetUpstreamStatusRequestObject) (GetUpstreamStatusResponseObject, error)
+	// Set failover upstream URLs
+	// (PUT /registry/{registry_ref}/upstream/urls)
+	SetUpstreamURLs(ctx context.Context, request SetUpstreamURLsRequestObject) (SetUpstreamURLsResponseObject, error)
+	// List active VEX statements
+	// (GET /registry/{registry_ref}/vex)
+	ListVexStatements(ctx context.Context, request ListVexStatementsRequestObject) (ListVexStatementsResponseObject, error)
+	// Attach a VEX document
+	// (POST /registry/{registry_ref}/vex)
+	UploadVexDocument(ctx context.Context, request UploadVexDocumentRequestObject) (UploadVexDocumentResponseObject, error)
+	// Delete a VEX document
+	// (DELETE /registry/{registry_ref}/vex/{vex_document_id})
+	DeleteVexDocument(ctx context.Context, request DeleteVexDocumentRequestObject) (DeleteVexDocumentResponseObject, error)
+	// ListWebhooks
+	// (GET /registry/{registry_ref}/webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// CreateWebhook
+	// (POST /registry/{registry_ref}/webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// DeleteWebhook
+	// (DELETE /registry/{registry_ref}/webhooks/{webhook_identifier})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// GetWebhook
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier})
+	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
+	// UpdateWebhook
+	// (PUT /registry/{registry_ref}/webhooks/{webhook_identifier})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// ListWebhookExecutions
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions)
+	ListWebhookExecutions(ctx context.Context, request ListWebhookExecutionsRequestObject) (ListWebhookExecutionsResponseObject, error)
+	// GetWebhookExecution
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id})
+	GetWebhookExecution(ctx context.Context, request GetWebhookExecutionRequestObject) (GetWebhookExecutionResponseObject, error)
+	// ReTriggerWebhookExecution
+	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
+	ReTriggerWebhookExecution(ctx context.Context, request ReTriggerWebhookExecutionRequestObject) (ReTriggerWebhookExecutionResponseObject, error)
+	// Get Artifact Stats
+	// (GET /spaces/{space_ref}/artifact/stats)
+	GetArtifactStatsForSpace(ctx context.Context, request GetArtifactStatsForSpaceRequestObject) (GetArtifactStatsForSpaceResponseObject, error)
+	// List Artifacts
+	// (GET /spaces/{space_ref}/artifacts)
+	GetAllArtifacts(ctx context.Context, request GetAllArtifactsRequestObject) (GetAllArtifactsResponseObject, error)
+	// Export Artifacts
+	// (GET /spaces/{space_ref}/artifacts/export)
+	ExportArtifacts(ctx context.Context, request ExportArtifactsRequestObject) (ExportArtifactsResponseObject, error)
+	// List claims mappings
+	// (GET /spaces/{space_ref}/claim-mappings)
+	ListClaimMappings(ctx context.Context, request ListClaimMappingsRequestObject) (ListClaimMappingsResponseObject, error)
+	// Map an identity provider claim to a registry role
+	// (POST /spaces/{space_ref}/claim-mappings)
+	CreateClaimMapping(ctx context.Context, request CreateClaimMappingRequestObject) (CreateClaimMappingResponseObject, error)
+	// Delete a claims mapping
+	// (DELETE /spaces/{space_ref}/claim-mappings/{claim_mapping_id})
+	DeleteClaimMapping(ctx context.Context, request DeleteClaimMappingRequestObject) (DeleteClaimMappingResponseObject, error)
+	// List default registries
+	// (GET /spaces/{space_ref}/default-registries)
+	ListDefaultRegistries(ctx context.Context, request ListDefaultRegistriesRequestObject) (ListDefaultRegistriesResponseObject, error)
+	// Unset the default registry
+	// (DELETE /spaces/{space_ref}/default-registries/{package_type})
+	DeleteDefaultRegistry(ctx context.Context, request DeleteDefaultRegistryRequestObject) (DeleteDefaultRegistryResponseObject, error)
+	// Resolve the default registry
+	// (GET /spaces/{space_ref}/default-registries/{package_type})
+	ResolveDefaultRegistry(ctx context.Context, request ResolveDefaultRegistryRequestObject) (ResolveDefaultRegistryResponseObject, error)
+	// Set the default registry
+	// (PUT /spaces/{space_ref}/default-registries/{package_type})
+	SetDefaultRegistry(ctx context.Context, request SetDefaultRegistryRequestObject) (SetDefaultRegistryResponseObject, error)
+	// List Registries
+	// (GET /spaces/{space_ref}/registries)
+	GetAllRegistries(ctx context.Context, request GetAllRegistriesRequestObject) (GetAllRegistriesResponseObject, error)
+	// Find the artifacts affected by a vulnerable package
+	// (GET /spaces/{space_ref}/security/impact)
+	GetPackageImpact(ctx context.Context, request GetPackageImpactRequestObject) (GetPackageImpactResponseObject, error)
+	// Compare Versions
+	// (POST /versions/compare)
+	CompareVersions(ctx context.Context, request CompareVersionsRequestObject) (CompareVersionsResponseObject, error)
+}
 
-func (response GetDockerArtifactDetails404JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-	return json.NewEncoder(w).Encode(response)
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type GetDockerArtifactDetails500JSONResponse struct {
-	InternalServerErrorJSONResponse
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-func (response GetDockerArtifactDetails500JSONResponse) VisitGetDockerArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
 
-	return json.NewEncoder(w).Encode(response)
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-type GetDockerArtifactLayersRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetDockerArtifactLayersParams
+// CreateRegistry operation middleware
+func (sh *strictHandler) CreateRegistry(w http.ResponseWriter, r *http.Request, params CreateRegistryParams) {
+	var request CreateRegistryRequestObject
+
+	request.Params = params
+
+	var body CreateRegistryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRegistry(ctx, request.(CreateRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRegistry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRegistryResponseObject); ok {
+		if err := validResponse.VisitCreateRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactLayersResponseObject interface {
-	VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error
+// CreateRegistries operation middleware
+func (sh *strictHandler) CreateRegistries(w http.ResponseWriter, r *http.Request) {
+	var request CreateRegistriesRequestObject
+
+	var body CreateRegistriesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateRegistries(ctx, request.(CreateRegistriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateRegistries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateRegistriesResponseObject); ok {
+		if err := validResponse.VisitCreateRegistriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyRegistryConfig operation middleware
+func (sh *strictHandler) ApplyRegistryConfig(w http.ResponseWriter, r *http.Request) {
+	var request ApplyRegistryConfigRequestObject
+
+	var body ApplyRegistryConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyRegistryConfig(ctx, request.(ApplyRegistryConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyRegistryConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyRegistryConfigResponseObject); ok {
+		if err := validResponse.VisitApplyRegistryConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactLayers200JSONResponse struct {
-	DockerLayersResponseJSONResponse
+// ExchangeIdentityToken operation middleware
+func (sh *strictHandler) ExchangeIdentityToken(w http.ResponseWriter, r *http.Request) {
+	var request ExchangeIdentityTokenRequestObject
+
+	var body ExchangeIdentityTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExchangeIdentityToken(ctx, request.(ExchangeIdentityTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExchangeIdentityToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExchangeIdentityTokenResponseObject); ok {
+		if err := validResponse.VisitExchangeIdentityTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetDockerArtifactLayers200JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// OnboardRegistry operation middleware
+func (sh *strictHandler) OnboardRegistry(w http.ResponseWriter, r *http.Request) {
+	var request OnboardRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body OnboardRegistryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetDockerArtifactLayers400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.OnboardRegistry(ctx, request.(OnboardRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "OnboardRegistry")
+	}
 
-func (response GetDockerArtifactLayers400JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(OnboardRegistryResponseObject); ok {
+		if err := validResponse.VisitOnboardRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactLayers401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetDockerArtifactLayers401JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ValidateRegistryIdentifier operation middleware
+func (sh *strictHandler) ValidateRegistryIdentifier(w http.ResponseWriter, r *http.Request) {
+	var request ValidateRegistryIdentifierRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ValidateRegistryIdentifierJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetDockerArtifactLayers403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ValidateRegistryIdentifier(ctx, request.(ValidateRegistryIdentifierRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ValidateRegistryIdentifier")
+	}
 
-func (response GetDockerArtifactLayers403JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ValidateRegistryIdentifierResponseObject); ok {
+		if err := validResponse.VisitValidateRegistryIdentifierResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactLayers404JSONResponse struct{ NotFoundJSONResponse }
+// EstimateRegistryImport operation middleware
+func (sh *strictHandler) EstimateRegistryImport(w http.ResponseWriter, r *http.Request) {
+	var request EstimateRegistryImportRequestObject
 
-func (response GetDockerArtifactLayers404JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var body EstimateRegistryImportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EstimateRegistryImport(ctx, request.(EstimateRegistryImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EstimateRegistryImport")
+	}
 
-type GetDockerArtifactLayers500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EstimateRegistryImportResponseObject); ok {
+		if err := validResponse.VisitEstimateRegistryImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetDockerArtifactLayers500JSONResponse) VisitGetDockerArtifactLayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CheckUpstreamConnectivity operation middleware
+func (sh *strictHandler) CheckUpstreamConnectivity(w http.ResponseWriter, r *http.Request) {
+	var request CheckUpstreamConnectivityRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CheckUpstreamConnectivityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetDockerArtifactManifestRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetDockerArtifactManifestParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckUpstreamConnectivity(ctx, request.(CheckUpstreamConnectivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckUpstreamConnectivity")
+	}
 
-type GetDockerArtifactManifestResponseObject interface {
-	VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetDockerArtifactManifest200JSONResponse struct {
-	DockerArtifactManifestResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckUpstreamConnectivityResponseObject); ok {
+		if err := validResponse.VisitCheckUpstreamConnectivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetDockerArtifactManifest200JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ResolvePermalink operation middleware
+func (sh *strictHandler) ResolvePermalink(w http.ResponseWriter, r *http.Request, permalinkCode PermalinkCodePathParam) {
+	var request ResolvePermalinkRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.PermalinkCode = permalinkCode
 
-type GetDockerArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolvePermalink(ctx, request.(ResolvePermalinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolvePermalink")
+	}
 
-func (response GetDockerArtifactManifest400JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolvePermalinkResponseObject); ok {
+		if err := validResponse.VisitResolvePermalinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetDockerArtifactManifest401JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// DeleteRegistry operation middleware
+func (sh *strictHandler) DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request DeleteRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type GetDockerArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRegistry(ctx, request.(DeleteRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRegistry")
+	}
 
-func (response GetDockerArtifactManifest403JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteRegistryResponseObject); ok {
+		if err := validResponse.VisitDeleteRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetDockerArtifactManifest404JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetRegistry operation middleware
+func (sh *strictHandler) GetRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type GetDockerArtifactManifest500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRegistry(ctx, request.(GetRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRegistry")
+	}
 
-func (response GetDockerArtifactManifest500JSONResponse) VisitGetDockerArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRegistryResponseObject); ok {
+		if err := validResponse.VisitGetRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactManifestsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-}
+// ModifyRegistry operation middleware
+func (sh *strictHandler) ModifyRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ModifyRegistryRequestObject
 
-type GetDockerArtifactManifestsResponseObject interface {
-	VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
 
-type GetDockerArtifactManifests200JSONResponse struct {
-	DockerManifestsResponseJSONResponse
-}
+	var body ModifyRegistryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetDockerArtifactManifests200JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ModifyRegistry(ctx, request.(ModifyRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ModifyRegistry")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetDockerArtifactManifests400JSONResponse struct{ BadRequestJSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ModifyRegistryResponseObject); ok {
+		if err := validResponse.VisitModifyRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetDockerArtifactManifests400JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ListAccessGrants operation middleware
+func (sh *strictHandler) ListAccessGrants(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListAccessGrantsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type GetDockerArtifactManifests401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAccessGrants(ctx, request.(ListAccessGrantsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAccessGrants")
+	}
 
-func (response GetDockerArtifactManifests401JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAccessGrantsResponseObject); ok {
+		if err := validResponse.VisitListAccessGrantsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactManifests403JSONResponse struct{ UnauthorizedJSONResponse }
+// CreateAccessGrant operation middleware
+func (sh *strictHandler) CreateAccessGrant(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreateAccessGrantRequestObject
 
-func (response GetDockerArtifactManifests403JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateAccessGrantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetDockerArtifactManifests404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAccessGrant(ctx, request.(CreateAccessGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAccessGrant")
+	}
 
-func (response GetDockerArtifactManifests404JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAccessGrantResponseObject); ok {
+		if err := validResponse.VisitCreateAccessGrantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetDockerArtifactManifests500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// RevokeAccessGrant operation middleware
+func (sh *strictHandler) RevokeAccessGrant(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, accessGrantId AccessGrantIdPathParam) {
+	var request RevokeAccessGrantRequestObject
 
-func (response GetDockerArtifactManifests500JSONResponse) VisitGetDockerArtifactManifestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.AccessGrantId = accessGrantId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAccessGrant(ctx, request.(RevokeAccessGrantRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAccessGrant")
+	}
 
-type GetArtifactFilesRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-	Params      GetArtifactFilesParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetArtifactFilesResponseObject interface {
-	VisitGetArtifactFilesResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeAccessGrantResponseObject); ok {
+		if err := validResponse.VisitRevokeAccessGrantResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactFiles200JSONResponse struct{ FileDetailResponseJSONResponse }
-
-func (response GetArtifactFiles200JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// SearchDockerManifestsByAnnotation operation middleware
+func (sh *strictHandler) SearchDockerManifestsByAnnotation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchDockerManifestsByAnnotationParams) {
+	var request SearchDockerManifestsByAnnotationRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetArtifactFiles400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchDockerManifestsByAnnotation(ctx, request.(SearchDockerManifestsByAnnotationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchDockerManifestsByAnnotation")
+	}
 
-func (response GetArtifactFiles400JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchDockerManifestsByAnnotationResponseObject); ok {
+		if err := validResponse.VisitSearchDockerManifestsByAnnotationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactFiles401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetArtifactFiles401JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ListArtifactLabels operation middleware
+func (sh *strictHandler) ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams) {
+	var request ListArtifactLabelsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetArtifactFiles403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListArtifactLabels(ctx, request.(ListArtifactLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListArtifactLabels")
+	}
 
-func (response GetArtifactFiles403JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListArtifactLabelsResponseObject); ok {
+		if err := validResponse.VisitListArtifactLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactFiles404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetArtifactFiles404JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetArtifactStatsForRegistry operation middleware
+func (sh *strictHandler) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetArtifactStatsForRegistryParams) {
+	var request GetArtifactStatsForRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetArtifactFiles500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactStatsForRegistry(ctx, request.(GetArtifactStatsForRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactStatsForRegistry")
+	}
 
-func (response GetArtifactFiles500JSONResponse) VisitGetArtifactFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactStatsForRegistryResponseObject); ok {
+		if err := validResponse.VisitGetArtifactStatsForRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHelmArtifactDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-}
+// DeleteArtifact operation middleware
+func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
+	var request DeleteArtifactRequestObject
 
-type GetHelmArtifactDetailsResponseObject interface {
-	VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
 
-type GetHelmArtifactDetails200JSONResponse struct {
-	HelmArtifactDetailResponseJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArtifact(ctx, request.(DeleteArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArtifact")
+	}
 
-func (response GetHelmArtifactDetails200JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArtifactResponseObject); ok {
+		if err := validResponse.VisitDeleteArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHelmArtifactDetails400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response GetHelmArtifactDetails400JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// HeadArtifact operation middleware
+func (sh *strictHandler) HeadArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
+	var request HeadArtifactRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
 
-type GetHelmArtifactDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HeadArtifact(ctx, request.(HeadArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeadArtifact")
+	}
 
-func (response GetHelmArtifactDetails401JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HeadArtifactResponseObject); ok {
+		if err := validResponse.VisitHeadArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHelmArtifactDetails403JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response GetHelmArtifactDetails403JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetArtifactBadge operation middleware
+func (sh *strictHandler) GetArtifactBadge(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, badge BadgePathParam, params GetArtifactBadgeParams) {
+	var request GetArtifactBadgeRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Badge = badge
+	request.Params = params
 
-type GetHelmArtifactDetails404JSONResponse struct{ NotFoundJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactBadge(ctx, request.(GetArtifactBadgeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactBadge")
+	}
 
-func (response GetHelmArtifactDetails404JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactBadgeResponseObject); ok {
+		if err := validResponse.VisitGetArtifactBadgeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHelmArtifactDetails500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// GetArtifactFeed operation middleware
+func (sh *strictHandler) GetArtifactFeed(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactFeedParams) {
+	var request GetArtifactFeedRequestObject
 
-func (response GetHelmArtifactDetails500JSONResponse) VisitGetHelmArtifactDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactFeed(ctx, request.(GetArtifactFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactFeed")
+	}
 
-type GetHelmArtifactManifestRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetHelmArtifactManifestResponseObject interface {
-	VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactFeedResponseObject); ok {
+		if err := validResponse.VisitGetArtifactFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHelmArtifactManifest200JSONResponse struct {
-	HelmArtifactManifestResponseJSONResponse
-}
+// UpdateArtifactLabels operation middleware
+func (sh *strictHandler) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
+	var request UpdateArtifactLabelsRequestObject
 
-func (response GetHelmArtifactManifest200JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateArtifactLabelsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetHelmArtifactManifest400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArtifactLabels(ctx, request.(UpdateArtifactLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArtifactLabels")
+	}
 
-func (response GetHelmArtifactManifest400JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateArtifactLabelsResponseObject); ok {
+		if err := validResponse.VisitUpdateArtifactLabelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHelmArtifactManifest401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetHelmArtifactManifest401JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetArtifactStats operation middleware
+func (sh *strictHandler) GetArtifactStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactStatsParams) {
+	var request GetArtifactStatsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type GetHelmArtifactManifest403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactStats(ctx, request.(GetArtifactStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactStats")
+	}
 
-func (response GetHelmArtifactManifest403JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactStatsResponseObject); ok {
+		if err := validResponse.VisitGetArtifactStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetHelmArtifactManifest404JSONResponse struct{ NotFoundJSONResponse }
+// GetArtifactSummary operation middleware
+func (sh *strictHandler) GetArtifactSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
+	var request GetArtifactSummaryRequestObject
 
-func (response GetHelmArtifactManifest404JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactSummary(ctx, request.(GetArtifactSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactSummary")
+	}
 
-type GetHelmArtifactManifest500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactSummaryResponseObject); ok {
+		if err := validResponse.VisitGetArtifactSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetHelmArtifactManifest500JSONResponse) VisitGetHelmArtifactManifestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// DeleteArtifactVersion operation middleware
+func (sh *strictHandler) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request DeleteArtifactVersionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type GetArtifactVersionSummaryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Version     VersionPathParam     `json:"version"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArtifactVersion(ctx, request.(DeleteArtifactVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArtifactVersion")
+	}
 
-type GetArtifactVersionSummaryResponseObject interface {
-	VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetArtifactVersionSummary200JSONResponse struct {
-	ArtifactVersionSummaryResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteArtifactVersionResponseObject); ok {
+		if err := validResponse.VisitDeleteArtifactVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetArtifactVersionSummary200JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// HeadArtifactVersion operation middleware
+func (sh *strictHandler) HeadArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request HeadArtifactVersionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type GetArtifactVersionSummary400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HeadArtifactVersion(ctx, request.(HeadArtifactVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeadArtifactVersion")
+	}
 
-func (response GetArtifactVersionSummary400JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HeadArtifactVersionResponseObject); ok {
+		if err := validResponse.VisitHeadArtifactVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactVersionSummary401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetArtifactVersionSummary401JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetArtifactDetails operation middleware
+func (sh *strictHandler) GetArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactDetailsParams) {
+	var request GetArtifactDetailsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetArtifactVersionSummary403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactDetails(ctx, request.(GetArtifactDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactDetails")
+	}
 
-func (response GetArtifactVersionSummary403JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactDetailsResponseObject); ok {
+		if err := validResponse.VisitGetArtifactDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactVersionSummary404JSONResponse struct{ NotFoundJSONResponse }
+// GetDockerArtifactDetails operation middleware
+func (sh *strictHandler) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactDetailsParams) {
+	var request GetDockerArtifactDetailsRequestObject
 
-func (response GetArtifactVersionSummary404JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactDetails(ctx, request.(GetDockerArtifactDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactDetails")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetArtifactVersionSummary500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactDetailsResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetArtifactVersionSummary500JSONResponse) VisitGetArtifactVersionSummaryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetDockerArtifactLayers operation middleware
+func (sh *strictHandler) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactLayersParams) {
+	var request GetDockerArtifactLayersRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetAllArtifactVersionsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Artifact    ArtifactPathParam    `json:"artifact"`
-	Params      GetAllArtifactVersionsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactLayers(ctx, request.(GetDockerArtifactLayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactLayers")
+	}
 
-type GetAllArtifactVersionsResponseObject interface {
-	VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAllArtifactVersions200JSONResponse struct {
-	ListArtifactVersionResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactLayersResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactLayersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllArtifactVersions200JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetDockerArtifactManifest operation middleware
+func (sh *strictHandler) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactManifestParams) {
+	var request GetDockerArtifactManifestRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetAllArtifactVersions400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactManifest(ctx, request.(GetDockerArtifactManifestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactManifest")
+	}
 
-func (response GetAllArtifactVersions400JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactManifestResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactManifestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifactVersions401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetAllArtifactVersions401JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetDockerArtifactManifests operation middleware
+func (sh *strictHandler) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetDockerArtifactManifestsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type GetAllArtifactVersions403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDockerArtifactManifests(ctx, request.(GetDockerArtifactManifestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDockerArtifactManifests")
+	}
 
-func (response GetAllArtifactVersions403JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDockerArtifactManifestsResponseObject); ok {
+		if err := validResponse.VisitGetDockerArtifactManifestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifactVersions404JSONResponse struct{ NotFoundJSONResponse }
+// ExportVersionEvidence operation middleware
+func (sh *strictHandler) ExportVersionEvidence(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request ExportVersionEvidenceRequestObject
 
-func (response GetAllArtifactVersions404JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportVersionEvidence(ctx, request.(ExportVersionEvidenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportVersionEvidence")
+	}
 
-type GetAllArtifactVersions500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportVersionEvidenceResponseObject); ok {
+		if err := validResponse.VisitExportVersionEvidenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllArtifactVersions500JSONResponse) VisitGetAllArtifactVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// HeadArtifactFile operation middleware
+func (sh *strictHandler) HeadArtifactFile(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params HeadArtifactFileParams) {
+	var request HeadArtifactFileRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetAllArtifactsByRegistryRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      GetAllArtifactsByRegistryParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HeadArtifactFile(ctx, request.(HeadArtifactFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HeadArtifactFile")
+	}
 
-type GetAllArtifactsByRegistryResponseObject interface {
-	VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAllArtifactsByRegistry200JSONResponse struct {
-	ListRegistryArtifactResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HeadArtifactFileResponseObject); ok {
+		if err := validResponse.VisitHeadArtifactFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllArtifactsByRegistry200JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetArtifactFiles operation middleware
+func (sh *strictHandler) GetArtifactFiles(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactFilesParams) {
+	var request GetArtifactFilesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+	request.Params = params
 
-type GetAllArtifactsByRegistry400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactFiles(ctx, request.(GetArtifactFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactFiles")
+	}
 
-func (response GetAllArtifactsByRegistry400JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactFilesResponseObject); ok {
+		if err := validResponse.VisitGetArtifactFilesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifactsByRegistry401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response GetAllArtifactsByRegistry401JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetHelmArtifactDetails operation middleware
+func (sh *strictHandler) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetHelmArtifactDetailsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type GetAllArtifactsByRegistry403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHelmArtifactDetails(ctx, request.(GetHelmArtifactDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHelmArtifactDetails")
+	}
 
-func (response GetAllArtifactsByRegistry403JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHelmArtifactDetailsResponseObject); ok {
+		if err := validResponse.VisitGetHelmArtifactDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifactsByRegistry404JSONResponse struct{ NotFoundJSONResponse }
+// GetHelmArtifactManifest operation middleware
+func (sh *strictHandler) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetHelmArtifactManifestRequestObject
 
-func (response GetAllArtifactsByRegistry404JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHelmArtifactManifest(ctx, request.(GetHelmArtifactManifestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHelmArtifactManifest")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAllArtifactsByRegistry500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHelmArtifactManifestResponseObject); ok {
+		if err := validResponse.VisitGetHelmArtifactManifestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllArtifactsByRegistry500JSONResponse) VisitGetAllArtifactsByRegistryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetModelArtifactDetails operation middleware
+func (sh *strictHandler) GetModelArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetModelArtifactDetailsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type GetClientSetupDetailsRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      GetClientSetupDetailsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetModelArtifactDetails(ctx, request.(GetModelArtifactDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetModelArtifactDetails")
+	}
 
-type GetClientSetupDetailsResponseObject interface {
-	VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetClientSetupDetails200JSONResponse struct {
-	ClientSetupDetailsResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetModelArtifactDetailsResponseObject); ok {
+		if err := validResponse.VisitGetModelArtifactDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetClientSetupDetails200JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateArtifactVersionPermalink operation middleware
+func (sh *strictHandler) CreateArtifactVersionPermalink(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request CreateArtifactVersionPermalinkRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type GetClientSetupDetails400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateArtifactVersionPermalink(ctx, request.(CreateArtifactVersionPermalinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateArtifactVersionPermalink")
+	}
 
-func (response GetClientSetupDetails400JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateArtifactVersionPermalinkResponseObject); ok {
+		if err := validResponse.VisitCreateArtifactVersionPermalinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetClientSetupDetails401JSONResponse struct{ UnauthenticatedJSONResponse }
+// PurgeArtifactVersion operation middleware
+func (sh *strictHandler) PurgeArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request PurgeArtifactVersionRequestObject
 
-func (response GetClientSetupDetails401JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PurgeArtifactVersionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetClientSetupDetails403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PurgeArtifactVersion(ctx, request.(PurgeArtifactVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PurgeArtifactVersion")
+	}
 
-func (response GetClientSetupDetails403JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PurgeArtifactVersionResponseObject); ok {
+		if err := validResponse.VisitPurgeArtifactVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetClientSetupDetails404JSONResponse struct{ NotFoundJSONResponse }
+// GetArtifactVersionStorage operation middleware
+func (sh *strictHandler) GetArtifactVersionStorage(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetArtifactVersionStorageRequestObject
 
-func (response GetClientSetupDetails404JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactVersionStorage(ctx, request.(GetArtifactVersionStorageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactVersionStorage")
+	}
 
-type GetClientSetupDetails500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactVersionStorageResponseObject); ok {
+		if err := validResponse.VisitGetArtifactVersionStorageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetClientSetupDetails500JSONResponse) VisitGetClientSetupDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetArtifactVersionSummary operation middleware
+func (sh *strictHandler) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
+	var request GetArtifactVersionSummaryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Version = version
 
-type ListWebhooksRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Params      ListWebhooksParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArtifactVersionSummary(ctx, request.(GetArtifactVersionSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArtifactVersionSummary")
+	}
 
-type ListWebhooksResponseObject interface {
-	VisitListWebhooksResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListWebhooks200JSONResponse struct {
-	ListWebhooksResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetArtifactVersionSummaryResponseObject); ok {
+		if err := validResponse.VisitGetArtifactVersionSummaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetAllArtifactVersions operation middleware
+func (sh *strictHandler) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetAllArtifactVersionsParams) {
+	var request GetAllArtifactVersionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Artifact = artifact
+	request.Params = params
 
-type ListWebhooks400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllArtifactVersions(ctx, request.(GetAllArtifactVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllArtifactVersions")
+	}
 
-func (response ListWebhooks400JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAllArtifactVersionsResponseObject); ok {
+		if err := validResponse.VisitGetAllArtifactVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListWebhooks401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetAllArtifactsByRegistry operation middleware
+func (sh *strictHandler) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetAllArtifactsByRegistryParams) {
+	var request GetAllArtifactsByRegistryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type ListWebhooks403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllArtifactsByRegistry(ctx, request.(GetAllArtifactsByRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllArtifactsByRegistry")
+	}
 
-func (response ListWebhooks403JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAllArtifactsByRegistryResponseObject); ok {
+		if err := validResponse.VisitGetAllArtifactsByRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListWebhooks500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// ExportRegistryArtifacts operation middleware
+func (sh *strictHandler) ExportRegistryArtifacts(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ExportRegistryArtifactsParams) {
+	var request ExportRegistryArtifactsRequestObject
 
-func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportRegistryArtifacts(ctx, request.(ExportRegistryArtifactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportRegistryArtifacts")
+	}
 
-type CreateWebhookRequestObject struct {
-	RegistryRef RegistryRefPathParam `json:"registry_ref"`
-	Body        *CreateWebhookJSONRequestBody
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateWebhookResponseObject interface {
-	VisitCreateWebhookResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportRegistryArtifactsResponseObject); ok {
+		if err := validResponse.VisitExportRegistryArtifactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
-
-func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// SearchArtifactClasses operation middleware
+func (sh *strictHandler) SearchArtifactClasses(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchArtifactClassesParams) {
+	var request SearchArtifactClassesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type CreateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchArtifactClasses(ctx, request.(SearchArtifactClassesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchArtifactClasses")
+	}
 
-func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchArtifactClassesResponseObject); ok {
+		if err := validResponse.VisitSearchArtifactClassesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetClientSetupDetails operation middleware
+func (sh *strictHandler) GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams) {
+	var request GetClientSetupDetailsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type CreateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientSetupDetails(ctx, request.(GetClientSetupDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientSetupDetails")
+	}
 
-func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientSetupDetailsResponseObject); ok {
+		if err := validResponse.VisitGetClientSetupDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateWebhook500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// CloneRegistry operation middleware
+func (sh *strictHandler) CloneRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CloneRegistryRequestObject
 
-func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CloneRegistryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type DeleteWebhookRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CloneRegistry(ctx, request.(CloneRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CloneRegistry")
+	}
 
-type DeleteWebhookResponseObject interface {
-	VisitDeleteWebhookResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type DeleteWebhook200JSONResponse struct{ SuccessJSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CloneRegistryResponseObject); ok {
+		if err := validResponse.VisitCloneRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response DeleteWebhook200JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ExportRegistryConfig operation middleware
+func (sh *strictHandler) ExportRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ExportRegistryConfigRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type DeleteWebhook400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportRegistryConfig(ctx, request.(ExportRegistryConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportRegistryConfig")
+	}
 
-func (response DeleteWebhook400JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportRegistryConfigResponseObject); ok {
+		if err := validResponse.VisitExportRegistryConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ListRegistryConfigRevisions operation middleware
+func (sh *strictHandler) ListRegistryConfigRevisions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListRegistryConfigRevisionsParams) {
+	var request ListRegistryConfigRevisionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type DeleteWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRegistryConfigRevisions(ctx, request.(ListRegistryConfigRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRegistryConfigRevisions")
+	}
 
-func (response DeleteWebhook403JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRegistryConfigRevisionsResponseObject); ok {
+		if err := validResponse.VisitListRegistryConfigRevisionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteWebhook404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetRegistryConfigRevision operation middleware
+func (sh *strictHandler) GetRegistryConfigRevision(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, revision ConfigRevisionPathParam) {
+	var request GetRegistryConfigRevisionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Revision = revision
 
-type DeleteWebhook500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRegistryConfigRevision(ctx, request.(GetRegistryConfigRevisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRegistryConfigRevision")
+	}
 
-func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRegistryConfigRevisionResponseObject); ok {
+		if err := validResponse.VisitGetRegistryConfigRevisionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWebhookRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
-}
+// RollbackRegistryConfig operation middleware
+func (sh *strictHandler) RollbackRegistryConfig(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, revision ConfigRevisionPathParam) {
+	var request RollbackRegistryConfigRequestObject
 
-type GetWebhookResponseObject interface {
-	VisitGetWebhookResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.Revision = revision
 
-type GetWebhook200JSONResponse struct{ WebhookResponseJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RollbackRegistryConfig(ctx, request.(RollbackRegistryConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RollbackRegistryConfig")
+	}
 
-func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RollbackRegistryConfigResponseObject); ok {
+		if err := validResponse.VisitRollbackRegistryConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWebhook400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response GetWebhook400JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// SearchArtifactContents operation middleware
+func (sh *strictHandler) SearchArtifactContents(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchArtifactContentsParams) {
+	var request SearchArtifactContentsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchArtifactContents(ctx, request.(SearchArtifactContentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchArtifactContents")
+	}
 
-func (response GetWebhook401JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchArtifactContentsResponseObject); ok {
+		if err := validResponse.VisitSearchArtifactContentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response GetWebhook403JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// ListDeletionCertificates operation middleware
+func (sh *strictHandler) ListDeletionCertificates(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListDeletionCertificatesParams) {
+	var request ListDeletionCertificatesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetWebhook500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDeletionCertificates(ctx, request.(ListDeletionCertificatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDeletionCertificates")
+	}
 
-func (response GetWebhook500JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDeletionCertificatesResponseObject); ok {
+		if err := validResponse.VisitListDeletionCertificatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateWebhookRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
-	Body              *UpdateWebhookJSONRequestBody
-}
+// GetDeletionCertificate operation middleware
+func (sh *strictHandler) GetDeletionCertificate(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, certificateId CertificateIdPathParam) {
+	var request GetDeletionCertificateRequestObject
 
-type UpdateWebhookResponseObject interface {
-	VisitUpdateWebhookResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.CertificateId = certificateId
 
-type UpdateWebhook201JSONResponse struct{ WebhookResponseJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeletionCertificate(ctx, request.(GetDeletionCertificateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeletionCertificate")
+	}
 
-func (response UpdateWebhook201JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDeletionCertificateResponseObject); ok {
+		if err := validResponse.VisitGetDeletionCertificateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateWebhook400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetRegistryDownloadOrigins operation middleware
+func (sh *strictHandler) GetRegistryDownloadOrigins(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetRegistryDownloadOriginsParams) {
+	var request GetRegistryDownloadOriginsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type UpdateWebhook401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRegistryDownloadOrigins(ctx, request.(GetRegistryDownloadOriginsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRegistryDownloadOrigins")
+	}
 
-func (response UpdateWebhook401JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRegistryDownloadOriginsResponseObject); ok {
+		if err := validResponse.VisitGetRegistryDownloadOriginsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateWebhook403JSONResponse struct{ UnauthorizedJSONResponse }
+// GetRegistryFeed operation middleware
+func (sh *strictHandler) GetRegistryFeed(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetRegistryFeedParams) {
+	var request GetRegistryFeedRequestObject
 
-func (response UpdateWebhook403JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.RegistryRef = registryRef
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRegistryFeed(ctx, request.(GetRegistryFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRegistryFeed")
+	}
 
-type UpdateWebhook500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRegistryFeedResponseObject); ok {
+		if err := validResponse.VisitGetRegistryFeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// DeleteHelmPushPolicy operation middleware
+func (sh *strictHandler) DeleteHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request DeleteHelmPushPolicyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type ListWebhookExecutionsRequestObject struct {
-	RegistryRef       RegistryRefPathParam       `json:"registry_ref"`
-	WebhookIdentifier WebhookIdentifierPathParam `json:"webhook_identifier"`
-	Params            ListWebhookExecutionsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteHelmPushPolicy(ctx, request.(DeleteHelmPushPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteHelmPushPolicy")
+	}
 
-type ListWebhookExecutionsResponseObject interface {
-	VisitListWebhookExecutionsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListWebhookExecutions200JSONResponse struct {
-	ListWebhooksExecutionResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteHelmPushPolicyResponseObject); ok {
+		if err := validResponse.VisitDeleteHelmPushPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListWebhookExecutions200JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetHelmPushPolicy operation middleware
+func (sh *strictHandler) GetHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetHelmPushPolicyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type ListWebhookExecutions400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHelmPushPolicy(ctx, request.(GetHelmPushPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHelmPushPolicy")
+	}
 
-func (response ListWebhookExecutions400JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHelmPushPolicyResponseObject); ok {
+		if err := validResponse.VisitGetHelmPushPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListWebhookExecutions401JSONResponse struct{ UnauthenticatedJSONResponse }
-
-func (response ListWebhookExecutions401JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// SetHelmPushPolicy operation middleware
+func (sh *strictHandler) SetHelmPushPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request SetHelmPushPolicyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type ListWebhookExecutions403JSONResponse struct{ UnauthorizedJSONResponse }
+	var body SetHelmPushPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response ListWebhookExecutions403JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetHelmPushPolicy(ctx, request.(SetHelmPushPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetHelmPushPolicy")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListWebhookExecutions500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetHelmPushPolicyResponseObject); ok {
+		if err := validResponse.VisitSetHelmPushPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response ListWebhookExecutions500JSONResponse) VisitListWebhookExecutionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// SearchImageLayerContents operation middleware
+func (sh *strictHandler) SearchImageLayerContents(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params SearchImageLayerContentsParams) {
+	var request SearchImageLayerContentsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Params = params
 
-type GetWebhookExecutionRequestObject struct {
-	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
-	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
-	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchImageLayerContents(ctx, request.(SearchImageLayerContentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchImageLayerContents")
+	}
 
-type GetWebhookExecutionResponseObject interface {
-	VisitGetWebhookExecutionResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetWebhookExecution200JSONResponse struct {
-	WebhookExecutionResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchImageLayerContentsResponseObject); ok {
+		if err := validResponse.VisitSearchImageLayerContentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetWebhookExecution200JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListLegalHolds operation middleware
+func (sh *strictHandler) ListLegalHolds(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListLegalHoldsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
 
-type GetWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListLegalHolds(ctx, request.(ListLegalHoldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListLegalHolds")
+	}
 
-func (response GetWebhookExecution400JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListLegalHoldsResponseObject); ok {
+		if err := validResponse.VisitListLegalHoldsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
+// CreateLegalHold operation middleware
+func (sh *strictHandler) CreateLegalHold(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreateLegalHoldRequestObject
 
-func (response GetWebhookExecution401JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateLegalHoldJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLegalHold(ctx, request.(CreateLegalHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLegalHold")
+	}
 
-func (response GetWebhookExecution403JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLegalHoldResponseObject); ok {
+		if err := validResponse.VisitCreateLegalHoldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetWebhookExecution500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// DeleteLegalHold operation middleware
+func (sh *strictHandler) DeleteLegalHold(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, legalHoldId LegalHoldIdPathParam) {
+	var request DeleteLegalHoldRequestObject
 
-func (response GetWebhookExecution500JSONResponse) VisitGetWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.RegistryRef = registryRef
+	request.LegalHoldId = legalHoldId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLegalHold(ctx, request.(DeleteLegalHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLegalHold")
+	}
 
-type ReTriggerWebhookExecutionRequestObject struct {
-	RegistryRef        RegistryRefPathParam        `json:"registry_ref"`
-	WebhookIdentifier  WebhookIdentifierPathParam  `json:"webhook_identifier"`
-	WebhookExecutionId WebhookExecutionIdPathParam `json:"webhook_execution_id"`
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLegalHoldResponseObject); ok {
+		if err := validResponse.VisitDeleteLegalHoldResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReTriggerWebhookExecutionResponseObject interface {
-	VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error
-}
+// ListMavenRelocations operation middleware
+func (sh *strictHandler) ListMavenRelocations(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListMavenRelocationsRequestObject
 
-type ReTriggerWebhookExecution200JSONResponse struct {
-	WebhookExecutionResponseJSONResponse
-}
+	request.RegistryRef = registryRef
 
-func (response ReTriggerWebhookExecution200JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMavenRelocations(ctx, request.(ListMavenRelocationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMavenRelocations")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMavenRelocationsResponseObject); ok {
+		if err := validResponse.VisitListMavenRelocationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReTriggerWebhookExecution400JSONResponse struct{ BadRequestJSONResponse }
+// CreateMavenRelocation operation middleware
+func (sh *strictHandler) CreateMavenRelocation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreateMavenRelocationRequestObject
 
-func (response ReTriggerWebhookExecution400JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateMavenRelocationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type ReTriggerWebhookExecution401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateMavenRelocation(ctx, request.(CreateMavenRelocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateMavenRelocation")
+	}
 
-func (response ReTriggerWebhookExecution401JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateMavenRelocationResponseObject); ok {
+		if err := validResponse.VisitCreateMavenRelocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ReTriggerWebhookExecution403JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response ReTriggerWebhookExecution403JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// DeleteMavenRelocation operation middleware
+func (sh *strictHandler) DeleteMavenRelocation(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, relocationId RelocationIdPathParam) {
+	var request DeleteMavenRelocationRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.RelocationId = relocationId
 
-type ReTriggerWebhookExecution500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMavenRelocation(ctx, request.(DeleteMavenRelocationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMavenRelocation")
+	}
 
-func (response ReTriggerWebhookExecution500JSONResponse) VisitReTriggerWebhookExecutionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteMavenRelocationResponseObject); ok {
+		if err := validResponse.VisitDeleteMavenRelocationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactStatsForSpaceRequestObject struct {
-	SpaceRef SpaceRefPathParam `json:"space_ref"`
-	Params   GetArtifactStatsForSpaceParams
-}
+// ListRegistryMembers operation middleware
+func (sh *strictHandler) ListRegistryMembers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListRegistryMembersRequestObject
 
-type GetArtifactStatsForSpaceResponseObject interface {
-	VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
 
-type GetArtifactStatsForSpace200JSONResponse struct {
-	ArtifactStatsResponseJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRegistryMembers(ctx, request.(ListRegistryMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRegistryMembers")
+	}
 
-func (response GetArtifactStatsForSpace200JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRegistryMembersResponseObject); ok {
+		if err := validResponse.VisitListRegistryMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactStatsForSpace400JSONResponse struct{ BadRequestJSONResponse }
+// ReorderRegistryMembers operation middleware
+func (sh *strictHandler) ReorderRegistryMembers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ReorderRegistryMembersRequestObject
 
-func (response GetArtifactStatsForSpace400JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ReorderRegistryMembersJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetArtifactStatsForSpace401JSONResponse struct{ UnauthenticatedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReorderRegistryMembers(ctx, request.(ReorderRegistryMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReorderRegistryMembers")
+	}
 
-func (response GetArtifactStatsForSpace401JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReorderRegistryMembersResponseObject); ok {
+		if err := validResponse.VisitReorderRegistryMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactStatsForSpace403JSONResponse struct{ UnauthorizedJSONResponse }
+// ListMirrorSyncs operation middleware
+func (sh *strictHandler) ListMirrorSyncs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListMirrorSyncsRequestObject
 
-func (response GetArtifactStatsForSpace403JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMirrorSyncs(ctx, request.(ListMirrorSyncsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMirrorSyncs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMirrorSyncsResponseObject); ok {
+		if err := validResponse.VisitListMirrorSyncsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetArtifactStatsForSpace404JSONResponse struct{ NotFoundJSONResponse }
+// CreateMirrorSync operation middleware
+func (sh *strictHandler) CreateMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreateMirrorSyncRequestObject
 
-func (response GetArtifactStatsForSpace404JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateMirrorSyncJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetArtifactStatsForSpace500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateMirrorSync(ctx, request.(CreateMirrorSyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateMirrorSync")
+	}
 
-func (response GetArtifactStatsForSpace500JSONResponse) VisitGetArtifactStatsForSpaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateMirrorSyncResponseObject); ok {
+		if err := validResponse.VisitCreateMirrorSyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifactsRequestObject struct {
-	SpaceRef SpaceRefPathParam `json:"space_ref"`
-	Params   GetAllArtifactsParams
-}
+// DeleteMirrorSync operation middleware
+func (sh *strictHandler) DeleteMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	var request DeleteMirrorSyncRequestObject
 
-type GetAllArtifactsResponseObject interface {
-	VisitGetAllArtifactsResponse(w http.ResponseWriter) error
-}
+	request.RegistryRef = registryRef
+	request.MirrorSyncId = mirrorSyncId
 
-type GetAllArtifacts200JSONResponse struct {
-	ListArtifactResponseJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMirrorSync(ctx, request.(DeleteMirrorSyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMirrorSync")
+	}
 
-func (response GetAllArtifacts200JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteMirrorSyncResponseObject); ok {
+		if err := validResponse.VisitDeleteMirrorSyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifacts400JSONResponse struct{ BadRequestJSONResponse }
+// GetMirrorSync operation middleware
+func (sh *strictHandler) GetMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	var request GetMirrorSyncRequestObject
 
-func (response GetAllArtifacts400JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.RegistryRef = registryRef
+	request.MirrorSyncId = mirrorSyncId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMirrorSync(ctx, request.(GetMirrorSyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMirrorSync")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMirrorSyncResponseObject); ok {
+		if err := validResponse.VisitGetMirrorSyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifacts401JSONResponse struct{ UnauthenticatedJSONResponse }
+// UpdateMirrorSync operation middleware
+func (sh *strictHandler) UpdateMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	var request UpdateMirrorSyncRequestObject
 
-func (response GetAllArtifacts401JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
+	request.MirrorSyncId = mirrorSyncId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body UpdateMirrorSyncJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetAllArtifacts403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateMirrorSync(ctx, request.(UpdateMirrorSyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateMirrorSync")
+	}
 
-func (response GetAllArtifacts403JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateMirrorSyncResponseObject); ok {
+		if err := validResponse.VisitUpdateMirrorSyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllArtifacts404JSONResponse struct{ NotFoundJSONResponse }
+// ListMirrorSyncRuns operation middleware
+func (sh *strictHandler) ListMirrorSyncRuns(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	var request ListMirrorSyncRunsRequestObject
 
-func (response GetAllArtifacts404JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
+	request.MirrorSyncId = mirrorSyncId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMirrorSyncRuns(ctx, request.(ListMirrorSyncRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMirrorSyncRuns")
+	}
 
-type GetAllArtifacts500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMirrorSyncRunsResponseObject); ok {
+		if err := validResponse.VisitListMirrorSyncRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllArtifacts500JSONResponse) VisitGetAllArtifactsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// RunMirrorSync operation middleware
+func (sh *strictHandler) RunMirrorSync(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam) {
+	var request RunMirrorSyncRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.MirrorSyncId = mirrorSyncId
 
-type GetAllRegistriesRequestObject struct {
-	SpaceRef SpaceRefPathParam `json:"space_ref"`
-	Params   GetAllRegistriesParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunMirrorSync(ctx, request.(RunMirrorSyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunMirrorSync")
+	}
 
-type GetAllRegistriesResponseObject interface {
-	VisitGetAllRegistriesResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetAllRegistries200JSONResponse struct {
-	ListRegistryResponseJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunMirrorSyncResponseObject); ok {
+		if err := validResponse.VisitRunMirrorSyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllRegistries200JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetMirrorSyncRun operation middleware
+func (sh *strictHandler) GetMirrorSyncRun(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, mirrorSyncId MirrorSyncIdPathParam, mirrorSyncRunId MirrorSyncRunIdPathParam) {
+	var request GetMirrorSyncRunRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.MirrorSyncId = mirrorSyncId
+	request.MirrorSyncRunId = mirrorSyncRunId
 
-type GetAllRegistries400JSONResponse struct{ BadRequestJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMirrorSyncRun(ctx, request.(GetMirrorSyncRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMirrorSyncRun")
+	}
 
-func (response GetAllRegistries400JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMirrorSyncRunResponseObject); ok {
+		if err := validResponse.VisitGetMirrorSyncRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllRegistries401JSONResponse struct{ UnauthenticatedJSONResponse }
+// SimulateRegistryPolicies operation middleware
+func (sh *strictHandler) SimulateRegistryPolicies(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request SimulateRegistryPoliciesRequestObject
 
-func (response GetAllRegistries401JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SimulateRegistryPoliciesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetAllRegistries403JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SimulateRegistryPolicies(ctx, request.(SimulateRegistryPoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SimulateRegistryPolicies")
+	}
 
-func (response GetAllRegistries403JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SimulateRegistryPoliciesResponseObject); ok {
+		if err := validResponse.VisitSimulateRegistryPoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAllRegistries404JSONResponse struct{ NotFoundJSONResponse }
+// ListRegistryQueues operation middleware
+func (sh *strictHandler) ListRegistryQueues(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListRegistryQueuesRequestObject
 
-func (response GetAllRegistries404JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.RegistryRef = registryRef
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRegistryQueues(ctx, request.(ListRegistryQueuesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRegistryQueues")
+	}
 
-type GetAllRegistries500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRegistryQueuesResponseObject); ok {
+		if err := validResponse.VisitListRegistryQueuesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAllRegistries500JSONResponse) VisitGetAllRegistriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PauseRegistryQueue operation middleware
+func (sh *strictHandler) PauseRegistryQueue(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, queue QueuePathParam) {
+	var request PauseRegistryQueueRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.RegistryRef = registryRef
+	request.Queue = queue
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Create Registry.
-	// (POST /registry)
-	CreateRegistry(ctx context.Context, request CreateRegistryRequestObject) (CreateRegistryResponseObject, error)
-	// Delete a Registry
-	// (DELETE /registry/{registry_ref})
-	DeleteRegistry(ctx context.Context, request DeleteRegistryRequestObject) (DeleteRegistryResponseObject, error)
-	// Returns Registry Details
-	// (GET /registry/{registry_ref})
-	GetRegistry(ctx context.Context, request GetRegistryRequestObject) (GetRegistryResponseObject, error)
-	// Updates a Registry
-	// (PUT /registry/{registry_ref})
-	ModifyRegistry(ctx context.Context, request ModifyRegistryRequestObject) (ModifyRegistryResponseObject, error)
-	// List Artifact Labels
-	// (GET /registry/{registry_ref}/artifact/labels)
-	ListArtifactLabels(ctx context.Context, request ListArtifactLabelsRequestObject) (ListArtifactLabelsResponseObject, error)
-	// Get Artifact Stats
-	// (GET /registry/{registry_ref}/artifact/stats)
-	GetArtifactStatsForRegistry(ctx context.Context, request GetArtifactStatsForRegistryRequestObject) (GetArtifactStatsForRegistryResponseObject, error)
-	// Delete Artifact
-	// (DELETE /registry/{registry_ref}/artifact/{artifact})
-	DeleteArtifact(ctx context.Context, request DeleteArtifactRequestObject) (DeleteArtifactResponseObject, error)
-	// Update Artifact Labels
-	// (PUT /registry/{registry_ref}/artifact/{artifact}/labels)
-	UpdateArtifactLabels(ctx context.Context, request UpdateArtifactLabelsRequestObject) (UpdateArtifactLabelsResponseObject, error)
-	// Get Artifact Stats
-	// (GET /registry/{registry_ref}/artifact/{artifact}/stats)
-	GetArtifactStats(ctx context.Context, request GetArtifactStatsRequestObject) (GetArtifactStatsResponseObject, error)
-	// Get Artifact Summary
-	// (GET /registry/{registry_ref}/artifact/{artifact}/summary)
-	GetArtifactSummary(ctx context.Context, request GetArtifactSummaryRequestObject) (GetArtifactSummaryResponseObject, error)
-	// Delete an Artifact Version
-	// (DELETE /registry/{registry_ref}/artifact/{artifact}/version/{version})
-	DeleteArtifactVersion(ctx context.Context, request DeleteArtifactVersionRequestObject) (DeleteArtifactVersionResponseObject, error)
-	// Describe Artifact Details
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/details)
-	GetArtifactDetails(ctx context.Context, request GetArtifactDetailsRequestObject) (GetArtifactDetailsResponseObject, error)
-	// Describe Docker Artifact Detail
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/details)
-	GetDockerArtifactDetails(ctx context.Context, request GetDockerArtifactDetailsRequestObject) (GetDockerArtifactDetailsResponseObject, error)
-	// Describe Docker Artifact Layers
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/layers)
-	GetDockerArtifactLayers(ctx context.Context, request GetDockerArtifactLayersRequestObject) (GetDockerArtifactLayersResponseObject, error)
-	// Describe Docker Artifact Manifest
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifest)
-	GetDockerArtifactManifest(ctx context.Context, request GetDockerArtifactManifestRequestObject) (GetDockerArtifactManifestResponseObject, error)
-	// Describe Docker Artifact Manifests
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/docker/manifests)
-	GetDockerArtifactManifests(ctx context.Context, request GetDockerArtifactManifestsRequestObject) (GetDockerArtifactManifestsResponseObject, error)
-	// Describe Artifact files
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/files)
-	GetArtifactFiles(ctx context.Context, request GetArtifactFilesRequestObject) (GetArtifactFilesResponseObject, error)
-	// Describe Helm Artifact Detail
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/details)
-	GetHelmArtifactDetails(ctx context.Context, request GetHelmArtifactDetailsRequestObject) (GetHelmArtifactDetailsResponseObject, error)
-	// Describe Helm Artifact Manifest
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/helm/manifest)
-	GetHelmArtifactManifest(ctx context.Context, request GetHelmArtifactManifestRequestObject) (GetHelmArtifactManifestResponseObject, error)
-	// Get Artifact Version Summary
-	// (GET /registry/{registry_ref}/artifact/{artifact}/version/{version}/summary)
-	GetArtifactVersionSummary(ctx context.Context, request GetArtifactVersionSummaryRequestObject) (GetArtifactVersionSummaryResponseObject, error)
-	// List Artifact Versions
-	// (GET /registry/{registry_ref}/artifact/{artifact}/versions)
-	GetAllArtifactVersions(ctx context.Context, request GetAllArtifactVersionsRequestObject) (GetAllArtifactVersionsResponseObject, error)
-	// List Artifacts for Registry
-	// (GET /registry/{registry_ref}/artifacts)
-	GetAllArtifactsByRegistry(ctx context.Context, request GetAllArtifactsByRegistryRequestObject) (GetAllArtifactsByRegistryResponseObject, error)
-	// Returns CLI Client Setup Details
-	// (GET /registry/{registry_ref}/client-setup-details)
-	GetClientSetupDetails(ctx context.Context, request GetClientSetupDetailsRequestObject) (GetClientSetupDetailsResponseObject, error)
-	// ListWebhooks
-	// (GET /registry/{registry_ref}/webhooks)
-	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
-	// CreateWebhook
-	// (POST /registry/{registry_ref}/webhooks)
-	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
-	// DeleteWebhook
-	// (DELETE /registry/{registry_ref}/webhooks/{webhook_identifier})
-	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
-	// GetWebhook
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier})
-	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
-	// UpdateWebhook
-	// (PUT /registry/{registry_ref}/webhooks/{webhook_identifier})
-	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
-	// ListWebhookExecutions
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions)
-	ListWebhookExecutions(ctx context.Context, request ListWebhookExecutionsRequestObject) (ListWebhookExecutionsResponseObject, error)
-	// GetWebhookExecution
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id})
-	GetWebhookExecution(ctx context.Context, request GetWebhookExecutionRequestObject) (GetWebhookExecutionResponseObject, error)
-	// ReTriggerWebhookExecution
-	// (GET /registry/{registry_ref}/webhooks/{webhook_identifier}/executions/{webhook_execution_id}/retrigger)
-	ReTriggerWebhookExecution(ctx context.Context, request ReTriggerWebhookExecutionRequestObject) (ReTriggerWebhookExecutionResponseObject, error)
-	// Get Artifact Stats
-	// (GET /spaces/{space_ref}/artifact/stats)
-	GetArtifactStatsForSpace(ctx context.Context, request GetArtifactStatsForSpaceRequestObject) (GetArtifactStatsForSpaceResponseObject, error)
-	// List Artifacts
-	// (GET /spaces/{space_ref}/artifacts)
-	GetAllArtifacts(ctx context.Context, request GetAllArtifactsRequestObject) (GetAllArtifactsResponseObject, error)
-	// List Registries
-	// (GET /spaces/{space_ref}/registries)
-	GetAllRegistries(ctx context.Context, request GetAllRegistriesRequestObject) (GetAllRegistriesResponseObject, error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseRegistryQueue(ctx, request.(PauseRegistryQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseRegistryQueue")
+	}
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	response, err := handler(r.Context(), w, r, request)
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseRegistryQueueResponseObject); ok {
+		if err := validResponse.VisitPauseRegistryQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// ResumeRegistryQueue operation middleware
+func (sh *strictHandler) ResumeRegistryQueue(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, queue QueuePathParam) {
+	var request ResumeRegistryQueueRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	request.RegistryRef = registryRef
+	request.Queue = queue
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeRegistryQueue(ctx, request.(ResumeRegistryQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeRegistryQueue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeRegistryQueueResponseObject); ok {
+		if err := validResponse.VisitResumeRegistryQueueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CreateRegistry operation middleware
-func (sh *strictHandler) CreateRegistry(w http.ResponseWriter, r *http.Request, params CreateRegistryParams) {
-	var request CreateRegistryRequestObject
+// GetResolveTrace operation middleware
+func (sh *strictHandler) GetResolveTrace(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetResolveTraceParams) {
+	var request GetResolveTraceRequestObject
 
+	request.RegistryRef = registryRef
 	request.Params = params
 
-	var body CreateRegistryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateRegistry(ctx, request.(CreateRegistryRequestObject))
+		return sh.ssi.GetResolveTrace(ctx, request.(GetResolveTraceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateRegistry")
+		handler = middleware(handler, "GetResolveTrace")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateRegistryResponseObject); ok {
-		if err := validResponse.VisitCreateRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetResolveTraceResponseObject); ok {
+		if err := validResponse.VisitGetResolveTraceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4796,25 +18299,26 @@ func (sh *strictHandler) CreateRegistry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteRegistry operation middleware
-func (sh *strictHandler) DeleteRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request DeleteRegistryRequestObject
+// ResolveArtifactPath operation middleware
+func (sh *strictHandler) ResolveArtifactPath(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ResolveArtifactPathParams) {
+	var request ResolveArtifactPathRequestObject
 
 	request.RegistryRef = registryRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteRegistry(ctx, request.(DeleteRegistryRequestObject))
+		return sh.ssi.ResolveArtifactPath(ctx, request.(ResolveArtifactPathRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteRegistry")
+		handler = middleware(handler, "ResolveArtifactPath")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteRegistryResponseObject); ok {
-		if err := validResponse.VisitDeleteRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ResolveArtifactPathResponseObject); ok {
+		if err := validResponse.VisitResolveArtifactPathResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4822,25 +18326,26 @@ func (sh *strictHandler) DeleteRegistry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetRegistry operation middleware
-func (sh *strictHandler) GetRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request GetRegistryRequestObject
+// ListScanResults operation middleware
+func (sh *strictHandler) ListScanResults(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListScanResultsParams) {
+	var request ListScanResultsRequestObject
 
 	request.RegistryRef = registryRef
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRegistry(ctx, request.(GetRegistryRequestObject))
+		return sh.ssi.ListScanResults(ctx, request.(ListScanResultsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRegistry")
+		handler = middleware(handler, "ListScanResults")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRegistryResponseObject); ok {
-		if err := validResponse.VisitGetRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListScanResultsResponseObject); ok {
+		if err := validResponse.VisitListScanResultsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4848,13 +18353,13 @@ func (sh *strictHandler) GetRegistry(w http.ResponseWriter, r *http.Request, reg
 	}
 }
 
-// ModifyRegistry operation middleware
-func (sh *strictHandler) ModifyRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
-	var request ModifyRegistryRequestObject
+// IngestScanResult operation middleware
+func (sh *strictHandler) IngestScanResult(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request IngestScanResultRequestObject
 
 	request.RegistryRef = registryRef
 
-	var body ModifyRegistryJSONRequestBody
+	var body IngestScanResultJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -4862,18 +18367,18 @@ func (sh *strictHandler) ModifyRegistry(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ModifyRegistry(ctx, request.(ModifyRegistryRequestObject))
+		return sh.ssi.IngestScanResult(ctx, request.(IngestScanResultRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ModifyRegistry")
+		handler = middleware(handler, "IngestScanResult")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ModifyRegistryResponseObject); ok {
-		if err := validResponse.VisitModifyRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(IngestScanResultResponseObject); ok {
+		if err := validResponse.VisitIngestScanResultResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4881,26 +18386,26 @@ func (sh *strictHandler) ModifyRegistry(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListArtifactLabels operation middleware
-func (sh *strictHandler) ListArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListArtifactLabelsParams) {
-	var request ListArtifactLabelsRequestObject
+// GetScanResult operation middleware
+func (sh *strictHandler) GetScanResult(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, scanId ScanIdPathParam) {
+	var request GetScanResultRequestObject
 
 	request.RegistryRef = registryRef
-	request.Params = params
+	request.ScanId = scanId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListArtifactLabels(ctx, request.(ListArtifactLabelsRequestObject))
+		return sh.ssi.GetScanResult(ctx, request.(GetScanResultRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListArtifactLabels")
+		handler = middleware(handler, "GetScanResult")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListArtifactLabelsResponseObject); ok {
-		if err := validResponse.VisitListArtifactLabelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetScanResultResponseObject); ok {
+		if err := validResponse.VisitGetScanResultResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4908,26 +18413,25 @@ func (sh *strictHandler) ListArtifactLabels(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetArtifactStatsForRegistry operation middleware
-func (sh *strictHandler) GetArtifactStatsForRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetArtifactStatsForRegistryParams) {
-	var request GetArtifactStatsForRegistryRequestObject
+// ListVulnerabilityAllowlist operation middleware
+func (sh *strictHandler) ListVulnerabilityAllowlist(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListVulnerabilityAllowlistRequestObject
 
 	request.RegistryRef = registryRef
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactStatsForRegistry(ctx, request.(GetArtifactStatsForRegistryRequestObject))
+		return sh.ssi.ListVulnerabilityAllowlist(ctx, request.(ListVulnerabilityAllowlistRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactStatsForRegistry")
+		handler = middleware(handler, "ListVulnerabilityAllowlist")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactStatsForRegistryResponseObject); ok {
-		if err := validResponse.VisitGetArtifactStatsForRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListVulnerabilityAllowlistResponseObject); ok {
+		if err := validResponse.VisitListVulnerabilityAllowlistResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4935,26 +18439,32 @@ func (sh *strictHandler) GetArtifactStatsForRegistry(w http.ResponseWriter, r *h
 	}
 }
 
-// DeleteArtifact operation middleware
-func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
-	var request DeleteArtifactRequestObject
+// CreateVulnerabilityAllowlistEntry operation middleware
+func (sh *strictHandler) CreateVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreateVulnerabilityAllowlistEntryRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
+
+	var body CreateVulnerabilityAllowlistEntryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArtifact(ctx, request.(DeleteArtifactRequestObject))
+		return sh.ssi.CreateVulnerabilityAllowlistEntry(ctx, request.(CreateVulnerabilityAllowlistEntryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArtifact")
+		handler = middleware(handler, "CreateVulnerabilityAllowlistEntry")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArtifactResponseObject); ok {
-		if err := validResponse.VisitDeleteArtifactResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateVulnerabilityAllowlistEntryResponseObject); ok {
+		if err := validResponse.VisitCreateVulnerabilityAllowlistEntryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4962,33 +18472,52 @@ func (sh *strictHandler) DeleteArtifact(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateArtifactLabels operation middleware
-func (sh *strictHandler) UpdateArtifactLabels(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
-	var request UpdateArtifactLabelsRequestObject
+// DeleteVulnerabilityAllowlistEntry operation middleware
+func (sh *strictHandler) DeleteVulnerabilityAllowlistEntry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, allowlistEntryId AllowlistEntryIdPathParam) {
+	var request DeleteVulnerabilityAllowlistEntryRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
+	request.AllowlistEntryId = allowlistEntryId
 
-	var body UpdateArtifactLabelsJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteVulnerabilityAllowlistEntry(ctx, request.(DeleteVulnerabilityAllowlistEntryRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteVulnerabilityAllowlistEntry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteVulnerabilityAllowlistEntryResponseObject); ok {
+		if err := validResponse.VisitDeleteVulnerabilityAllowlistEntryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRegistrySecurityPosture operation middleware
+func (sh *strictHandler) GetRegistrySecurityPosture(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetRegistrySecurityPostureRequestObject
+
+	request.RegistryRef = registryRef
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateArtifactLabels(ctx, request.(UpdateArtifactLabelsRequestObject))
+		return sh.ssi.GetRegistrySecurityPosture(ctx, request.(GetRegistrySecurityPostureRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateArtifactLabels")
+		handler = middleware(handler, "GetRegistrySecurityPosture")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateArtifactLabelsResponseObject); ok {
-		if err := validResponse.VisitUpdateArtifactLabelsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetRegistrySecurityPostureResponseObject); ok {
+		if err := validResponse.VisitGetRegistrySecurityPostureResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -4996,27 +18525,25 @@ func (sh *strictHandler) UpdateArtifactLabels(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetArtifactStats operation middleware
-func (sh *strictHandler) GetArtifactStats(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetArtifactStatsParams) {
-	var request GetArtifactStatsRequestObject
+// DeleteCacheEvictionPolicy operation middleware
+func (sh *strictHandler) DeleteCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request DeleteCacheEvictionPolicyRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactStats(ctx, request.(GetArtifactStatsRequestObject))
+		return sh.ssi.DeleteCacheEvictionPolicy(ctx, request.(DeleteCacheEvictionPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactStats")
+		handler = middleware(handler, "DeleteCacheEvictionPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactStatsResponseObject); ok {
-		if err := validResponse.VisitGetArtifactStatsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteCacheEvictionPolicyResponseObject); ok {
+		if err := validResponse.VisitDeleteCacheEvictionPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5024,26 +18551,25 @@ func (sh *strictHandler) GetArtifactStats(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetArtifactSummary operation middleware
-func (sh *strictHandler) GetArtifactSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam) {
-	var request GetArtifactSummaryRequestObject
+// GetCacheEvictionPolicy operation middleware
+func (sh *strictHandler) GetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetCacheEvictionPolicyRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactSummary(ctx, request.(GetArtifactSummaryRequestObject))
+		return sh.ssi.GetCacheEvictionPolicy(ctx, request.(GetCacheEvictionPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactSummary")
+		handler = middleware(handler, "GetCacheEvictionPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactSummaryResponseObject); ok {
-		if err := validResponse.VisitGetArtifactSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCacheEvictionPolicyResponseObject); ok {
+		if err := validResponse.VisitGetCacheEvictionPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5051,27 +18577,32 @@ func (sh *strictHandler) GetArtifactSummary(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// DeleteArtifactVersion operation middleware
-func (sh *strictHandler) DeleteArtifactVersion(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
-	var request DeleteArtifactVersionRequestObject
+// SetCacheEvictionPolicy operation middleware
+func (sh *strictHandler) SetCacheEvictionPolicy(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request SetCacheEvictionPolicyRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
+
+	var body SetCacheEvictionPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArtifactVersion(ctx, request.(DeleteArtifactVersionRequestObject))
+		return sh.ssi.SetCacheEvictionPolicy(ctx, request.(SetCacheEvictionPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArtifactVersion")
+		handler = middleware(handler, "SetCacheEvictionPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteArtifactVersionResponseObject); ok {
-		if err := validResponse.VisitDeleteArtifactVersionResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetCacheEvictionPolicyResponseObject); ok {
+		if err := validResponse.VisitSetCacheEvictionPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5079,28 +18610,32 @@ func (sh *strictHandler) DeleteArtifactVersion(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetArtifactDetails operation middleware
-func (sh *strictHandler) GetArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactDetailsParams) {
-	var request GetArtifactDetailsRequestObject
+// RotateUpstreamCredentials operation middleware
+func (sh *strictHandler) RotateUpstreamCredentials(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request RotateUpstreamCredentialsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+
+	var body RotateUpstreamCredentialsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactDetails(ctx, request.(GetArtifactDetailsRequestObject))
+		return sh.ssi.RotateUpstreamCredentials(ctx, request.(RotateUpstreamCredentialsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactDetails")
+		handler = middleware(handler, "RotateUpstreamCredentials")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactDetailsResponseObject); ok {
-		if err := validResponse.VisitGetArtifactDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RotateUpstreamCredentialsResponseObject); ok {
+		if err := validResponse.VisitRotateUpstreamCredentialsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5108,28 +18643,26 @@ func (sh *strictHandler) GetArtifactDetails(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetDockerArtifactDetails operation middleware
-func (sh *strictHandler) GetDockerArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactDetailsParams) {
-	var request GetDockerArtifactDetailsRequestObject
+// ListPackageRuleViolations operation middleware
+func (sh *strictHandler) ListPackageRuleViolations(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListPackageRuleViolationsParams) {
+	var request ListPackageRuleViolationsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactDetails(ctx, request.(GetDockerArtifactDetailsRequestObject))
+		return sh.ssi.ListPackageRuleViolations(ctx, request.(ListPackageRuleViolationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactDetails")
+		handler = middleware(handler, "ListPackageRuleViolations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactDetailsResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPackageRuleViolationsResponseObject); ok {
+		if err := validResponse.VisitListPackageRuleViolationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5137,28 +18670,25 @@ func (sh *strictHandler) GetDockerArtifactDetails(w http.ResponseWriter, r *http
 	}
 }
 
-// GetDockerArtifactLayers operation middleware
-func (sh *strictHandler) GetDockerArtifactLayers(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactLayersParams) {
-	var request GetDockerArtifactLayersRequestObject
+// ListPackageRules operation middleware
+func (sh *strictHandler) ListPackageRules(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request ListPackageRulesRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactLayers(ctx, request.(GetDockerArtifactLayersRequestObject))
+		return sh.ssi.ListPackageRules(ctx, request.(ListPackageRulesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactLayers")
+		handler = middleware(handler, "ListPackageRules")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactLayersResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactLayersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPackageRulesResponseObject); ok {
+		if err := validResponse.VisitListPackageRulesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5166,28 +18696,32 @@ func (sh *strictHandler) GetDockerArtifactLayers(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetDockerArtifactManifest operation middleware
-func (sh *strictHandler) GetDockerArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetDockerArtifactManifestParams) {
-	var request GetDockerArtifactManifestRequestObject
+// CreatePackageRule operation middleware
+func (sh *strictHandler) CreatePackageRule(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request CreatePackageRuleRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+
+	var body CreatePackageRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactManifest(ctx, request.(GetDockerArtifactManifestRequestObject))
+		return sh.ssi.CreatePackageRule(ctx, request.(CreatePackageRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactManifest")
+		handler = middleware(handler, "CreatePackageRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactManifestResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactManifestResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePackageRuleResponseObject); ok {
+		if err := validResponse.VisitCreatePackageRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5195,27 +18729,26 @@ func (sh *strictHandler) GetDockerArtifactManifest(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetDockerArtifactManifests operation middleware
-func (sh *strictHandler) GetDockerArtifactManifests(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
-	var request GetDockerArtifactManifestsRequestObject
+// DeletePackageRule operation middleware
+func (sh *strictHandler) DeletePackageRule(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, ruleId RuleIdPathParam) {
+	var request DeletePackageRuleRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
+	request.RuleId = ruleId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDockerArtifactManifests(ctx, request.(GetDockerArtifactManifestsRequestObject))
+		return sh.ssi.DeletePackageRule(ctx, request.(DeletePackageRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDockerArtifactManifests")
+		handler = middleware(handler, "DeletePackageRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDockerArtifactManifestsResponseObject); ok {
-		if err := validResponse.VisitGetDockerArtifactManifestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePackageRuleResponseObject); ok {
+		if err := validResponse.VisitDeletePackageRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5223,28 +18756,32 @@ func (sh *strictHandler) GetDockerArtifactManifests(w http.ResponseWriter, r *ht
 	}
 }
 
-// GetArtifactFiles operation middleware
-func (sh *strictHandler) GetArtifactFiles(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam, params GetArtifactFilesParams) {
-	var request GetArtifactFilesRequestObject
+// PrewarmUpstreamCache operation middleware
+func (sh *strictHandler) PrewarmUpstreamCache(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request PrewarmUpstreamCacheRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
-	request.Params = params
+
+	var body PrewarmUpstreamCacheJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactFiles(ctx, request.(GetArtifactFilesRequestObject))
+		return sh.ssi.PrewarmUpstreamCache(ctx, request.(PrewarmUpstreamCacheRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactFiles")
+		handler = middleware(handler, "PrewarmUpstreamCache")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactFilesResponseObject); ok {
-		if err := validResponse.VisitGetArtifactFilesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PrewarmUpstreamCacheResponseObject); ok {
+		if err := validResponse.VisitPrewarmUpstreamCacheResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5252,27 +18789,26 @@ func (sh *strictHandler) GetArtifactFiles(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetHelmArtifactDetails operation middleware
-func (sh *strictHandler) GetHelmArtifactDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
-	var request GetHelmArtifactDetailsRequestObject
+// GetUpstreamCachePrewarm operation middleware
+func (sh *strictHandler) GetUpstreamCachePrewarm(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, batch PrewarmBatchPathParam) {
+	var request GetUpstreamCachePrewarmRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
+	request.Batch = batch
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHelmArtifactDetails(ctx, request.(GetHelmArtifactDetailsRequestObject))
+		return sh.ssi.GetUpstreamCachePrewarm(ctx, request.(GetUpstreamCachePrewarmRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHelmArtifactDetails")
+		handler = middleware(handler, "GetUpstreamCachePrewarm")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHelmArtifactDetailsResponseObject); ok {
-		if err := validResponse.VisitGetHelmArtifactDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUpstreamCachePrewarmResponseObject); ok {
+		if err := validResponse.VisitGetUpstreamCachePrewarmResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5280,27 +18816,25 @@ func (sh *strictHandler) GetHelmArtifactDetails(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetHelmArtifactManifest operation middleware
-func (sh *strictHandler) GetHelmArtifactManifest(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
-	var request GetHelmArtifactManifestRequestObject
+// GetUpstreamStatus operation middleware
+func (sh *strictHandler) GetUpstreamStatus(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request GetUpstreamStatusRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHelmArtifactManifest(ctx, request.(GetHelmArtifactManifestRequestObject))
+		return sh.ssi.GetUpstreamStatus(ctx, request.(GetUpstreamStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHelmArtifactManifest")
+		handler = middleware(handler, "GetUpstreamStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHelmArtifactManifestResponseObject); ok {
-		if err := validResponse.VisitGetHelmArtifactManifestResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetUpstreamStatusResponseObject); ok {
+		if err := validResponse.VisitGetUpstreamStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5308,27 +18842,32 @@ func (sh *strictHandler) GetHelmArtifactManifest(w http.ResponseWriter, r *http.
 	}
 }
 
-// GetArtifactVersionSummary operation middleware
-func (sh *strictHandler) GetArtifactVersionSummary(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, version VersionPathParam) {
-	var request GetArtifactVersionSummaryRequestObject
+// SetUpstreamURLs operation middleware
+func (sh *strictHandler) SetUpstreamURLs(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam) {
+	var request SetUpstreamURLsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
-	request.Version = version
+
+	var body SetUpstreamURLsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArtifactVersionSummary(ctx, request.(GetArtifactVersionSummaryRequestObject))
+		return sh.ssi.SetUpstreamURLs(ctx, request.(SetUpstreamURLsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArtifactVersionSummary")
+		handler = middleware(handler, "SetUpstreamURLs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetArtifactVersionSummaryResponseObject); ok {
-		if err := validResponse.VisitGetArtifactVersionSummaryResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetUpstreamURLsResponseObject); ok {
+		if err := validResponse.VisitSetUpstreamURLsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5336,27 +18875,26 @@ func (sh *strictHandler) GetArtifactVersionSummary(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetAllArtifactVersions operation middleware
-func (sh *strictHandler) GetAllArtifactVersions(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, artifact ArtifactPathParam, params GetAllArtifactVersionsParams) {
-	var request GetAllArtifactVersionsRequestObject
+// ListVexStatements operation middleware
+func (sh *strictHandler) ListVexStatements(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params ListVexStatementsParams) {
+	var request ListVexStatementsRequestObject
 
 	request.RegistryRef = registryRef
-	request.Artifact = artifact
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllArtifactVersions(ctx, request.(GetAllArtifactVersionsRequestObject))
+		return sh.ssi.ListVexStatements(ctx, request.(ListVexStatementsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllArtifactVersions")
+		handler = middleware(handler, "ListVexStatements")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllArtifactVersionsResponseObject); ok {
-		if err := validResponse.VisitGetAllArtifactVersionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListVexStatementsResponseObject); ok {
+		if err := validResponse.VisitListVexStatementsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5364,26 +18902,33 @@ func (sh *strictHandler) GetAllArtifactVersions(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetAllArtifactsByRegistry operation middleware
-func (sh *strictHandler) GetAllArtifactsByRegistry(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetAllArtifactsByRegistryParams) {
-	var request GetAllArtifactsByRegistryRequestObject
+// UploadVexDocument operation middleware
+func (sh *strictHandler) UploadVexDocument(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params UploadVexDocumentParams) {
+	var request UploadVexDocumentRequestObject
 
 	request.RegistryRef = registryRef
 	request.Params = params
 
+	var body UploadVexDocumentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllArtifactsByRegistry(ctx, request.(GetAllArtifactsByRegistryRequestObject))
+		return sh.ssi.UploadVexDocument(ctx, request.(UploadVexDocumentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllArtifactsByRegistry")
+		handler = middleware(handler, "UploadVexDocument")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllArtifactsByRegistryResponseObject); ok {
-		if err := validResponse.VisitGetAllArtifactsByRegistryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UploadVexDocumentResponseObject); ok {
+		if err := validResponse.VisitUploadVexDocumentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5391,26 +18936,26 @@ func (sh *strictHandler) GetAllArtifactsByRegistry(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetClientSetupDetails operation middleware
-func (sh *strictHandler) GetClientSetupDetails(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, params GetClientSetupDetailsParams) {
-	var request GetClientSetupDetailsRequestObject
+// DeleteVexDocument operation middleware
+func (sh *strictHandler) DeleteVexDocument(w http.ResponseWriter, r *http.Request, registryRef RegistryRefPathParam, vexDocumentId VexDocumentIdPathParam) {
+	var request DeleteVexDocumentRequestObject
 
 	request.RegistryRef = registryRef
-	request.Params = params
+	request.VexDocumentId = vexDocumentId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetClientSetupDetails(ctx, request.(GetClientSetupDetailsRequestObject))
+		return sh.ssi.DeleteVexDocument(ctx, request.(DeleteVexDocumentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetClientSetupDetails")
+		handler = middleware(handler, "DeleteVexDocument")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetClientSetupDetailsResponseObject); ok {
-		if err := validResponse.VisitGetClientSetupDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteVexDocumentResponseObject); ok {
+		if err := validResponse.VisitDeleteVexDocumentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5704,6 +19249,233 @@ func (sh *strictHandler) GetAllArtifacts(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// ExportArtifacts operation middleware
+func (sh *strictHandler) ExportArtifacts(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params ExportArtifactsParams) {
+	var request ExportArtifactsRequestObject
+
+	request.SpaceRef = spaceRef
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportArtifacts(ctx, request.(ExportArtifactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportArtifacts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportArtifactsResponseObject); ok {
+		if err := validResponse.VisitExportArtifactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListClaimMappings operation middleware
+func (sh *strictHandler) ListClaimMappings(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request ListClaimMappingsRequestObject
+
+	request.SpaceRef = spaceRef
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClaimMappings(ctx, request.(ListClaimMappingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClaimMappings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClaimMappingsResponseObject); ok {
+		if err := validResponse.VisitListClaimMappingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateClaimMapping operation middleware
+func (sh *strictHandler) CreateClaimMapping(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request CreateClaimMappingRequestObject
+
+	request.SpaceRef = spaceRef
+
+	var body CreateClaimMappingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateClaimMapping(ctx, request.(CreateClaimMappingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateClaimMapping")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateClaimMappingResponseObject); ok {
+		if err := validResponse.VisitCreateClaimMappingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteClaimMapping operation middleware
+func (sh *strictHandler) DeleteClaimMapping(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, claimMappingId ClaimMappingIdPathParam) {
+	var request DeleteClaimMappingRequestObject
+
+	request.SpaceRef = spaceRef
+	request.ClaimMappingId = claimMappingId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteClaimMapping(ctx, request.(DeleteClaimMappingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteClaimMapping")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteClaimMappingResponseObject); ok {
+		if err := validResponse.VisitDeleteClaimMappingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDefaultRegistries operation middleware
+func (sh *strictHandler) ListDefaultRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam) {
+	var request ListDefaultRegistriesRequestObject
+
+	request.SpaceRef = spaceRef
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDefaultRegistries(ctx, request.(ListDefaultRegistriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDefaultRegistries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDefaultRegistriesResponseObject); ok {
+		if err := validResponse.VisitListDefaultRegistriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteDefaultRegistry operation middleware
+func (sh *strictHandler) DeleteDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam) {
+	var request DeleteDefaultRegistryRequestObject
+
+	request.SpaceRef = spaceRef
+	request.PackageType = packageType
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteDefaultRegistry(ctx, request.(DeleteDefaultRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteDefaultRegistry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteDefaultRegistryResponseObject); ok {
+		if err := validResponse.VisitDeleteDefaultRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResolveDefaultRegistry operation middleware
+func (sh *strictHandler) ResolveDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam) {
+	var request ResolveDefaultRegistryRequestObject
+
+	request.SpaceRef = spaceRef
+	request.PackageType = packageType
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveDefaultRegistry(ctx, request.(ResolveDefaultRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveDefaultRegistry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolveDefaultRegistryResponseObject); ok {
+		if err := validResponse.VisitResolveDefaultRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetDefaultRegistry operation middleware
+func (sh *strictHandler) SetDefaultRegistry(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, packageType PackageTypePathParam) {
+	var request SetDefaultRegistryRequestObject
+
+	request.SpaceRef = spaceRef
+	request.PackageType = packageType
+
+	var body SetDefaultRegistryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetDefaultRegistry(ctx, request.(SetDefaultRegistryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetDefaultRegistry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetDefaultRegistryResponseObject); ok {
+		if err := validResponse.VisitSetDefaultRegistryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetAllRegistries operation middleware
 func (sh *strictHandler) GetAllRegistries(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetAllRegistriesParams) {
 	var request GetAllRegistriesRequestObject
@@ -5731,100 +19503,625 @@ func (sh *strictHandler) GetAllRegistries(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// GetPackageImpact operation middleware
+func (sh *strictHandler) GetPackageImpact(w http.ResponseWriter, r *http.Request, spaceRef SpaceRefPathParam, params GetPackageImpactParams) {
+	var request GetPackageImpactRequestObject
+
+	request.SpaceRef = spaceRef
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPackageImpact(ctx, request.(GetPackageImpactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPackageImpact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPackageImpactResponseObject); ok {
+		if err := validResponse.VisitGetPackageImpactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CompareVersions operation middleware
+func (sh *strictHandler) CompareVersions(w http.ResponseWriter, r *http.Request) {
+	var request CompareVersionsRequestObject
+
+	var body CompareVersionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CompareVersions(ctx, request.(CompareVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompareVersions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CompareVersionsResponseObject); ok {
+		if err := validResponse.VisitCompareVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xd3XLbOpJ+FRZ3LxnLZya7F75TZDlRHTvRyk6mUqdcLpiEJE4okgcA7WhSevct/JEg",
-	"CZCgJEtyzKs4In4aja8bDaC78cv1k1WaxDAm2L345aYAgRUkELH/XYNHGOEp/Y3+N4DYR2FKwiR2L/jH",
-	"M9dzQ/q/vzOI1q7nxmAF3Qs3oh9dz8X+Eq4ArRwSuGKNknVKS2CCwnjhbjz5A0AIrN3NxnNncBFigtaT",
-	"AMYknIcQGUiQBZ2ipIEeBBcPoVpoJ8Lu1ilsI4mWMRBD+KeCBBhnK/fiL/fbZHb3dXjteu7X6e3dbDy8",
-	"ce+9Kl0bzwWIhHPgEwMNQ/aZGHqXlUsUNPVBloZ+PoMVdJK5I4vmYEgBWWo7RPDvLEQwcC8IymAzAf4y",
-	"jIJvEOEwiQ0EjGgR54mXccLYB5gRdJn4PyDK6cImlKpdtLAjCBcQmxh+yT6aeuFVO45+jpLVJSAmmNFP",
-	"Z85VglaAOO+cm5vB5eXg+/fv3w000OZaRhgBAjGR3NCIO/3siO/OVRgRiMziTws/PJlZ+5gkEQQx6zkF",
-	"/g+wgDZSNeVFm6RLtPZQk7IOgp6CBfycrR4h0oAuQwjGxKFlnJgXMlGyKFMQwDnIIuJe/OG5czZ37oUb",
-	"xuR/37s5EWFM4AKinIzb8D9QI3qsX4p1NionhcgR3ekowbQRLSX/OLcjBUE/Qzh8Ms3Qv5aQLCFySOJE",
-	"ISYO4jMWQuzkVaP1mVE9iyJ6IucgwtDTQUd0s57BeYOi+hqHf2dQ0rR2qH4yKCtZ5gHBeUeRxRAgf3kH",
-	"kYYC/s2hH0084EUeCK3f0lGCyFUIo0DTT/7J0EmCyMNcFGjr4wsKdAJQfGroIxEFGvtIgQ+tZo6VbJo2",
-	"VmCbORMk/B8dgi0NpnErNDT1SZI9KnaStPT21LiCFoufrvEnq6Ux76HVUBiKBVmuIobJLLrtMpXP8HGZ",
-	"JD/GP6Gf0X4nQTuuRB0HykpOYSQaiBNVHvIqD2GwHaWqeWtLqDV5JWPXnrgNLwwx+ZAEIWTLpZw1ZvDP",
-	"+Ff6u5/EBMbsT5CmUegDSvPg35ibD0Un/01l4sL9r0Gx1xjwr3igbZzRUeaDoIquL1kaAAJz685hew3s",
-	"Kvb5vomstttA3zxBjo8gIzAOJK1yVaFE/ovP0L5prDTbmUQBHLGq4jSJcXn6LyEBYTQTnzrRnaIkhYgI",
-	"PAWAWMOCd0rZhgkgGW6rd8tLSRxz0P8lK3u872JPlTz+G/oGZvFxUsAtICnQFjCKGNwq2D0oY26z1Qpw",
-	"QJ0KZ5gcOvKzyiDaNz40g2ifp8Qe2hTWs4fPZY8gXJAkqRSWwnFYVO78BDgVlA828qMPhXEfQLDvpWWM",
-	"UIJ05H0AgYPkguO5oyiEMbmFJEu53j6UzNc7PuZcsfWVUeRgSpK6ZPCTqaMsqbquTxDSQU5YmeAbEIdz",
-	"iMlRuCU7P0F+rRTSONHXYA0RPiifeJcnaZNQwgreyIk8LHvyXk+TNVdhBPemiuZhJNhTvpPgx4LJ3PkE",
-	"UAwxLg4DrlgNrzifbeJLQWv94JY3MUoyTnWZgLslZQEBkTizzc9OXc+FP8EqjaDduSw/lu3QCy1e7uX8",
-	"3LqfSRzAn/p+fOUgWm3evnH92TJtOzafL6vMqje7R3R7Aks7oZw3sfHcTzBaHWXdrXd8AlpgCaOVbs1V",
-	"iT3wiqvr+uQ4pa62k5hAFIPoFqIniLiR/OImt+zUwaxXB/KCnnsdYnKMA4lav8c2vdk6ozkcVAk9Am9O",
-	"ii1VfoiN7hHYIi8gToE7YjeNVZ8GySl5BHwEBFW7PkkkFUfkB+fLSfBDPeGnxInjeJxfRx2QMbW+j7Hv",
-	"YFwRlwq4uGArn4Cq1B6BQSeBnGeFmM8JuUqyOHh5O4Ia+TiFfjgPYUDnJMmQD51ngJ04Ic6cUVG6UjvI",
-	"7JyKTPP7MY/vJfT3eLeZ70OMd2DIPgZoMzJBqTNTJO9rDDKyhDGhxMIDAK7aYU5DgsL/HI4A0VtxD3to",
-	"BV3t9ghIr3s9qDo5v0g+JDtOVN7VS3FBALsSZ/L0J1zfQh9B8idc1wcPZBmtxyEot6D4L1uUvk2BDyeB",
-	"UlQ5gNGVnQKy1DaMJf0tBOTlGrsulzJ0Wp03DQX3G88dxkm8XiUMD8p1oDhAMbg8+8QRBTw3COn3VRgD",
-	"wvflK5CmlIKLX+7ll9Gf41mXi5JREs/Dheu5H8efx7PJyFT3I4whCn1D5U/j6xv7Y6K82s3w2/izqd4N",
-	"eIKxoeL0+92nL8aa0zVZJvqqG0+ief255FXL/G43npvE8Mvcvfir+5VT3kPXUzPLik0z0FbXzMu2mk28",
-	"vPcqqoErm2BItLImvn7QK44geY6jBAT5+bPFUe8qCZiZZ+iQe45pPqhz3qKVp2V4YHGwXGvyqXAvb9YL",
-	"4QosIMOeV/IH5PbXlPu8ZYhKukpmXfF7Rv+18qSIg6JufuIqxaKBJgpuIAFyUTTor7xIFTRy4nGXme8+",
-	"KFoHkxuBmEPhJc2iaJSsViDWd4lqEUGNxYzrnTX8Yo48Tb/VQIlKr03Tz12SanNfuxDj5UwA6DL/so68",
-	"6LGowm6vbkmClPshi2pZ2qmfTRObxDWyBaNEyW4KditJKvSRrslt5KxFK28rTA161FZRCmhbaCsZDWTW",
-	"Wiz2KWe0GaGdJmMeRrCbGvx9VZphqT2EPqu4x9VjGbirSg0tJrFtlrHtp2JHo6NVbDKylFRV5KQ48aDj",
-	"FhFpMtrzK4ZoCjB+TlDgerpNpboNqgeCeu4ogiDO0mkShb6G/+Kzw7+zfW5Nhc7yOK3adMCfaYjgJVhj",
-	"vei2Cc0UwXn4s5tSlLEknavqFhSNW6CGR8xRjxVyZKkqJ1YgjD9BEJh3x81f+Rm8OhpLb8ZbXrfV9lQI",
-	"VMlROr9v5o/sqJk/slTz7nry+XryeWwzOgLTfK96N/xwa6pzBx6rFeo7VNJpa6ono22bpyOktr1bbosU",
-	"YqHaxBRo13pi0lCVwbbNMi1SM6n4UrYdihm3+FKokfnlbhypdJRzpo0LyuLcwgxHFvV0e0b9RgNEmWFZ",
-	"bqeL4WqLOcIEpltPUGeVmjPbQGmpUHXto1uc0Hc9lx3XAALvkh8w1i5yWr/lVlsjPwc88s7ghax8e/uy",
-	"q+HIT1VO5eym4QSxDjv2OzNz9E7l9YW9mYmbVoJyP7VWPOYl67ZF0UQzW/OSZkYxP+xxTKx2z9xp26Tp",
-	"d9hsyBZa6MStG31ezLhfEIk19BvytchhY6ULa9zTLFMJHiLf4kZDUGUevISC0Sa1nqlmZWbmzlZ6zjh+",
-	"W1jkeVAiORzRZDurGphUFOm2nVypTXcASXX2zJuY7RSljhm5b2lVUgOD+/aSkJS7hjqskOK17b4/V+ZX",
-	"wYQJjcMgCOmfIJJq1AGPSUYcsoTC/VRD8gpiDBYG8hAEmO6H2Z8iKBmEEQxcr1W1sNHI1rXM+kkQKKzs",
-	"SpoScfXNCjn5NqnM1x+GS9sGo06l8QfbufPCOgKVEIYaffSb0XZZQv8HzlYdzxntTJ4mU8K40e909iRy",
-	"0BSjqHeuEqvjXNPdXpM5sOD12u2BUgtW9oDGu7+uqmC0arVMc4fKhjvBfZqtvWG6k2FqvJ9uwqEu6mIf",
-	"Rqk2dKIFhi9tkJZ83xvCwWQZbJQIbFPdNn6sdhXbR5GdXBRZBWYFDtpwdi3PY6xjD3mGy7rpeBAEbHNr",
-	"2KPGEjUN7hi6+BMLFZPHhxg11TdZoGNrnTRX9Xa2V2CvX4Hlbu9ddFfD1V0PgGPHQRf5L7efUyu1IKFj",
-	"1gcVNCqUtcHxBO23Kmm9GvyN1GAel2UhMoWkFBFUvRo8NTX4bDGj+pm00gZK9EijzsvbbUOeEjK5HQaV",
-	"SEeNQ41N462NduFMKcyo148nrR+VSdbB1Byc0HTktKK12s+cZIGJ/sxugZIsndgeR03LZ4fVsKs5RNgh",
-	"iSOO2BSvABGXIyNe8gCWIuxGxNDo/AQaQjCaGJSyagfmkNneN1mE9dtPEEXJMwymgBCI4m6nCI9R4v/Y",
-	"sq5fdTq09DZRa+mazafKxvwrvMBajsYbD/Q9N2z2vj0hv27T8bf+sqWU/ln43tgdbBsN7Fcds1J73OIQ",
-	"LuD7cfF+SU/uijjVpvg2exSqUuQK8JnO/BYikoHISZDzNcUEQbBS9VSTc2j+sI2BhbK93C9UvoljKC9I",
-	"2ZNXaLW15tIVWuueoDbui+qbQvYenbVdv/1CYhbXfIPWKcSoRcNu51O3f7XcqiJ2uPE03WRK+bs13Wh2",
-	"B4jlMiBUvjqmyqJAm2lCljFQsrc7jmVZ7IJQuveZ0fKtd+Qay8HWZmiz+GlFukvKH+IJz6DzVCwmmVCo",
-	"ujXEoNc7PZ3muar7fAOhpfTNIoZCujB3pUyEQ4gIBy1ReeKLaq6/gCVLwU44LzlLPQPsYJ7UZZ4xzsUJ",
-	"Ub2rv45G49tb13OvhpPrrzPa+3g2+zLTdq8GNWj2t+BR+Jxjnc/58vCBLzX4aaIyWobh+NK8qCzY4NGe",
-	"3BLf7AhF4WKhc0tTREQUKSZzOLubXA1Hdw+j2Xh4N2Hb4fy3my+Xk6vJqPb75fh6zH7TTXjFbjHsjjPE",
-	"o9a0YWOyiSlKfuouoEDGl0Q7s6sUCddmdRUhca0l6xF1m/uNx4izUaJ5YB97DytDPlQfa+TuoMvs0fXc",
-	"UYYJe2Bv+IzHPp09dlozgjFBgO66putpqJ0Lq9U8J7imfD3357uSQnonnBQLNUgnXOVvPQ2VTQoa3J55",
-	"BlsknMkwRAaX3MqY85J0xsp2eQfAyp2KzaVpVsH0jtGH8mzaaJnP4EKc88qiOyQK2YOlDmPwGJWM4/yl",
-	"Pc+FhVetvYZUXXF158/NkAtjDP0MQT1BoUiEq//Kd8Jqbi/2kKD1mbmosEPylENKlVg0OixdYpXRTIqF",
-	"k6Xde5EGk5KfQolTDAk5ZbLvzaLEJybflrRJ1ae7u6kULUfWq4rYYxLonb2XBdbtlXYz5UVCtY6ki4p7",
-	"ob3Ismb4NBJRBTYpO+oS02Da1NLOaS3W2fhuNhl+uB4/cIuV2rB3w+sHs/1au+qy17jOWKFFq3ttdatY",
-	"fCyLQxnQodk2WjaBCkGw1mn5Sz1IwaK9RsxTBKLt1SmCQll9mVsPVNSgqkKv7UUBG5tO0Xz5M4xbZ6yp",
-	"P+XXJZzgta24b2Spqy5ekiel1cqwounzTobxPJFpNIU3lXit03xI+84J4BOMKJqw6OPCXRKS4ovB4Pn5",
-	"+WzJq56FCRtaSKLmBofTiRL1cOH+cXZ+ds5OxlMYgzR0L9x/sp/4eSbj6wAp95Rpolt2R+IhybyjM5c1",
-	"ydUhnWlRRL3HBAisIGGzaNgZFkUGmid6N/fqM6VrEwRKL5nWH/GsvHP5j/M/zA2JcoNaOuSN574/P2+v",
-	"qDzLxqpY9KXJmPv+/J+29YpEt/9jQ5/uMQuW9VTG6MqZVueZgAWdQlfZVN3TSjluBr/U97Q3HD4RJBoj",
-	"6JL9rgDJCXmEIPD9JIv5s6X0/4vwCcYOD7IrA403sTXQtG+Jc6iVYGLBTZkb+hWg4/35+/ZKeV7y/cGp",
-	"Nt8mPHnuAmoUzwySDMW4gIsIyO0Om4+QnAJmXqNqORZ4TJNvxlCaaTD0lWV5xjspHXanuH4JAO19fetB",
-	"uFcQ1tGzxZI4kJfug+JGUKvvrkNMqnFrdVurFg2H94RIr7VeChbwM3OEtC3NrsUtymIIkL+8g2hb1Wp+",
-	"G6uHtxHeOsApAC/iPizxjWX2Wy28P0JSSYB7pluoS6l0rxK0Z73bjsU5SlaXgEDrCiRRim+FXv0r6j1y",
-	"jcitY2kX3P6Sf9lsX2TrZ4bNiRLudBi8SuL7Hc2hdjTKFO8Bc4pZ0GDCthsGvNyRTAMTCDtauNpE/ptd",
-	"VGpvDHSydfdpDigQ379lcExk9zZEb0M0gb1IemgBd164GfBFdsRXZVFU6O9B2RWU+bzvA5biYmjwS/zR",
-	"xdiVif3bjN5vSkr9k1XOMgN9by8f6gYgrgHppTA9UJJctivf4kzZqHuVBwNeE6Lb6/jLMAq+yYq7K3nO",
-	"qF7H20gFBeQj1OHwhYSCOTJbyYY+y7pWRHSZu39DQeFJjXcRER2jekHpICjG1P9SXCoF9io1RaJxa6HJ",
-	"s3m3yEyR9bsXGZ3IcP70orKDqOQQO4SoqIllrYVFSVPbIi5qQtteYJrWGMmpXnR2EB0FbocUHryV9GB7",
-	"8cFvYnteeV6il4Q9SMKLryPzMIKWe3detGHnfiUK/GZLxQs64SSIfEGBXcO08FUIo+Ag7j3Fgxq9HG9z",
-	"wCCF5WWOF5YwWlkdLuieydDKcP3thbexaNXH3eO9A94Nz7BI1Jc+7xH6Vtse49scjeB/rVuendHf72B2",
-	"xr9m//ICEtDptrvyPnjjrXfl7fG3IAD6ofci0PHevP4K/R7tnmb/feyAKGLxJFVqDD5NUTSsPi1y0kh/",
-	"k9sPzXMyvVB2jS9Q8L2tOHaVPcyiu5QIgib5wx/WB4814E6WvfC1CV81ZXEvfR2lryYJnaPYeMLEdyxh",
-	"4ru2zb6M3hxdT5zSq/YyhPcRYBg4SSxTxMvUizUBVTIGHu8goKsVuL0FWB9uD3X7YGET3LbBu/quSSPG",
-	"r8WrHjLhkOlYq/T8zW8QsnnaK4bk9BtM41EBmkR+/hMLltemepGQboMyTxSi5DQ8UkB8JTvTVvle8jbe",
-	"aLqXYhY1QLFRkINf4q+HImeSXR6YomudT/l+4dWudvJkYXIQvYP4gRzEGyHYkhymTVV9hOTVA+ntqqjS",
-	"7OkXsmwHcPCYx5PDR78KHhBiVQzscxUclN8DtFJkeb7SfK9M7bmm3cS49B7h0SH8cpuSnTcDarboN7wr",
-	"KAHmhfBefM9/ewiDzfZi0LCyl1L8vgL8P1fIngR7shDeMr71cDgsugd5JuMmnPMS2gTVZYTPoEht2+O8",
-	"x3lx1mkGhQHtLL8uHvxi/x4iZxdL8Lx1GuA+08ZbyrTBsGKB1M53v23+FvgwAJ3VnvF8M4f37aXLz5la",
-	"DTJ/p24XEVYdOnoJ7nqX3EF6UXHdZie+xf2cSX7L7zu9vADXIWcv9J0q/f7ijqCfIRw+7Sy7fRLjjrJb",
-	"Epq68LKnOWgDXIyqW5bca4Q/UjEAaTh4+oPNn2ir9nL4dMKf2WR3TJ6TsVM2z4koMUglRryToRBIgaRv",
-	"bQGJaAIouki0UKinxgbk8/9OMnd45KWusVp0m3WbSxitdC1WXK/N7WlZ9lzc54r2cht/c7/5/wAAAP//",
-	"knNw4uTjAAA=",
+	"H4sIAAAAAAAC/+z9e3PbOLYojn4VlO6pmr3rKHK6p2fuOdl16m7FVhLv9mvLTnrmTvdJQSQkYUKRHAC0",
+	"renK77P/CgsPgiTAh6TY7mn90x2LeCwsLCwsrOevoyjb5FlKUsFHb34d5ZjhDRGEwV8XeEESfiN/k3/G",
+	"hEeM5oJm6eiN+jgZjUdU/vWPgrDtaDxK8YaM3owS+XE0HvFoTTZYdqaCbGBQsc1lCy4YTVejr2PzA2YM",
+	"b0dfv45Hc7KiXLDteUxSQZeUsAAIpiEqWwbgYWT1mbqN9gLsbpuTLpBkmwAwQn0qQSBpsRm9+dvo0/n8",
+	"7uP0YjQefby5vZvPppejX8Z1uL6ORziKCOfvGU7FeXyDxToAzMeU/qMgSDVHK9kelViwe5djsS6hU60/",
+	"Q+vPNB6NR4z8o6CMxKM3ghXEBXyZsQ0Wozcjmoo//zCysNJUkBVhCtgkyR4SysUshT3thve+SFLC8IIm",
+	"VGyR7Y+IHKDHAkyHz9DhIGtI00xgCeWPZBsAfmrboC9kO0ZkspqgjK0mWU7SKEsFpilhfEI3eEUmPCtY",
+	"FCKQL2TbCrKHIuzkn3BShIhz9ogjgcq26F42DgBhvrVOywRd4kiEUAKfRWAC07n3HEG6ucIbgrIlMk1D",
+	"hFFOOAS3CxyvyGmWZCE2BN/k/GJN0IZwjldkjBjJExzRdAU/R9BmRe9JihZb+AkQbLrBJBM0o2JNGMJI",
+	"ghzrXtkS8TUlScwnNBujhH4haMHoai1WjJAUySYMp3LSTPZdk0fVM8Sd4eOox6qBx/deOjD9MVoxspVr",
+	"jMkSF4lovSJOB0ESAOKOPAoLA1kKxGlcRWx9NzRoCuIJmm1ysUUiQwnB9wRRgbJC9L/aAiBf4sfpKnQU",
+	"r4rNgqitJVGWxhxFCSWp4AinMcpZ9kgJRxu8RRGO1qRcClpmbIz++Pp1DxRvAIIKrBv8SDfytvlff/7h",
+	"9evxaENT9fdrL+ODKVtO3lsASWSIkTQOcmQYpfXU/Q9GlqM3o//PSSmOnKiv/ATmgOvUQnQrtkkIs/Ct",
+	"tvvLBIse+OKy62gQXDAbABYRyV5ohAXpc8nFJCHyF+T0677bnMaHuNeiNU3iT4RxmqWhEy6boHvVBtE0",
+	"whywe5ZFXySn0jyVB3mNM0XHqYkSTDeXOM9puuqDQmgvDwn06IE82f6zbn4Q9CWY8/+W6w3RIt3kkhgZ",
+	"+keBEwlbDJzdkCcMMEYbLKK1ZPcStzTlJOVU0HuSbANINX8OucaiLF3S1ZzcU7XbQeyaJgZIZkRaNULB",
+	"QHYI4JjpzvvjNksFSUUbdm8ws3xfQmH+vaQJeSKkxnRFeEj8OYOPoYOhug6cj0gJ7jQrUhG8kAt5iUg0",
+	"wMNGXhdIrClHchrChUSFIDhWVw+7lycHI0YikopE3zZS8ChSMUYPaxqt4RZK8AotyJqmsb7qhRwrWkux",
+	"I3j2AdrPMJbv6C+yLCE4hYXJPZNiXNt+XzknR+0xIwmWeypvIPmr4UaGX4UAk70/w7+HoX/Jss0ZFqGr",
+	"R36aoHdA3egVurw8OTs7+etf//rXEBgs23TwRLq8ylJyKWn5A8Fx8Ck8u8Mry1XUHhqebY+xenNYnKxh",
+	"vBKa8+UrOdcrmKwLrE0OInn0Ba9Ij+0yT7pEnlToFNoa/Xngxih45jgNQvOphMAgBmRmRFOAMFWExLep",
+	"wI8GbJiSjBG5J2xr+9ElIlJiDC0Bxu2FwFsYPwSxnk4BYbdRCfq3s8tPs3kfmQZ69xZq9KQKMAfST+6b",
+	"vAPF0Ma5jv+jlBLQAxVr9Gn2F8QFFmQj50a8yHNGOIdLXCDMtBjfIoRXVAQdqFa8Si/Mp8eSn5HB9jua",
+	"CMLCwr9s/Pk+LM+4TC3BW8LaONp0wbOkEL7rK2OICg5/IM2pDnVpJWSFkw9ZEvcRsqAxWmdJ3C1gQdvP",
+	"su0hpKsNYSsSh7SPlOtrrqQt+wKXTyv4E6N7ykSBk1KIwUmWrhQZSvxmD+kEzeB428vjCyG5GrtccUMY",
+	"ouIPHHGRMRIjGrxo1Bq66GRDGcvY7TaN+uyIai05VdS9JarxZ9n4IHtiIZ0X6VBgWZEOA5gV6SGA1ldK",
+	"H62tvtHatLd6tM8NLe4ARXIFoiAKDTCyewBbNWB2e2DflNBo6FZE6Sc88mXBGEkFkm1QqhqF8FS7BPVF",
+	"NXrz3bjntq3ILf0naVOeKBk3Jwzp6bxXIP1nAJLvX/cEhbANTmj65TSL23bsdp0xgaJMqZ8wsv1C22e+",
+	"f5Z9BrLxnJEHzDZvpbjWAtN5hYdhtJDtXW0pR3ok4GSlBBlU5ijxcAio/yhIQVqfnPr8ZTlRz0sEXQIg",
+	"wLedyd1M9t9yFCmkAoiMRAXj9D7EIn5aE1DNSi0h5cJcBJRwZLsmYZHQNPHT4RInnIx9V4O5b+Zk2c1s",
+	"TWMQJ4IPdNXms8TQsF1kJMki2J0+nP8SS1132aeb9ZdtD8H1GeFZck+m7SYK95FiDsQY/TxasazIz+M3",
+	"5rfz+OcRvKVhWZNuk8YwxAKo72jS9ZbCSiA0ryolho5LwEB7vCIpYTTq1s3JsUa9QGu9pUrR1cyIMFcC",
+	"Ul4kCYmRfOhWxCdtHvt5FGWbExxtyAnO85PvJq/l/199N3k9+TtmP4/Cj0Sx3g3H7crOTwahQr6mGVI6",
+	"mvrqgo8S+yoYBFiR9FIY66seyfY9zlKRHERDzCPc67TLdogRLq0qncDJxgcBjmAWre8I88ClviH5Mfg0",
+	"hiafhezfvkc8Y+KdtMB55rGfApNkTHxe6gZdc1yz2Cd0lZ9a5sh0g9Y5chyRXlcJtGy7R6DBDpeIAaHt",
+	"WdyAIbRuB4a2OUV2QKWdyDpmu+/DYjpZSK8ZOi3i5vYz6o3AZu7Gue7J41kWFRvSzw1Fan1i3b6bR9yT",
+	"x8+m9SF4xQNZrLPsy+yRREVfEUb3QcR06gZbd/lsu3TB3kSrHsL1fuoLaG/wKr5Q/YH7qhoTLt5mMSXw",
+	"2p2Wzkhz9U3+qs038p84zxOq5LqTv3OlgusnqXuGBhiqONAQSclcuTgJsskzhqVKBwaQX7AVPUZfxyNz",
+	"LMCr4OBQ+wZvh7vIYywc8Ql8DLiE9G2RfJnbN8BhAfWN3Q5nxIiEs3z7SBBP5WNxdk8j2f4mS2h0cEhb",
+	"pmgHmBNRPmgR0SOgHIYAgTpFRc4FI3gDXhdbu6Ib9Sj+Jkupjd2+Bv06d9YRhNsxoB8cbs/Y7XBvcC7B",
+	"VKxGbCWY9zQmTNntq4cSsSwhcgln6kn8rUg+MHw/IjJeQxZoeJCBECJB/0CSzU3B19/mCPhH7wd4XvC1",
+	"S/NIDlZhiOd6k+6yLyQ9NOjewdshJ4/KtCwJ6PwMCdkTXrkOycCPALxk92LGBd1gQQ4OvXf0DvB1a6B/",
+	"6C/hvDB2l0OD2Bi4g6EkUq7GjmlHQgf6g7lVwRwaxsDw7ZBqjZAEVqs3jIJFAlzaIg4Na2PkXtdixuxF",
+	"7to9JKxaxT4vkoPTp2foXtDiikYBgCzYyurL9IPh4NC2zNEO9hqzWHnNwbGqu3qMHP/80yRLD45n7+Ad",
+	"mJZNa6KnHQacqaZ5nmy/GaTNKdrhlVNuEQ54fI28oRmnaxJ9+VYrCEzTgXXZ1F2F89hxlnCpzMTfCvTa",
+	"8F18DrQ33eZrdwHX6SLDLP4Gsl54hvZlZKp9gOKV5HJLN0XyTW6YrnnaYeeqPUExw0uhpCVK9Db4lvOt",
+	"wO8BrhSEDBdPY3PpuEDeRjidgzb20GA2R+4ibSn+IOxqiCWEH/Xr5ZvwD+/gvbhG+ayqAMkI8BCc8G8G",
+	"ajlFF0YzIbcbnoNlp+Cj0EzwcX7xzYB3x+73JJE9mvCiJaZyKfdgaJXQaxHhNNvkmB38TveP3r6CNAOb",
+	"/T/V2YtUVyOFcAWz1YvuAjCOYyo/4eSGZTlhAhRtSjWnFXLZ4u8k8gJ6nZNUKlozhk5vp+8qSleAzXWc",
+	"m5pIuYPjtXWWDiHENJfXnzuOBP8npb88NLy1YQezXq1WNYbGPEu5Rzmqfh8EdO5QwK+jGIshOlOJMC6w",
+	"KHgnU1etvn51lcF/M53HauJfepCfWbx6q6eV0FNX8QqxMwGMQIDkCb9f/c/HTVJFh1X0L2iKwW7i0VDX",
+	"XHI+vdeBU9nSfTeMxtoBGpADWrlXp1kqWFabs6mgl77W7W2+Oks9IwLT5Kl2vzLpcxKA1MYTUT7TYoCI",
+	"u0Qwe6RccBcztbg+16OAQOPqrv3llRnqlfLifdXl5VvziXct+m077kykZ3gFgRZtrml6Kt4+V7fp6msF",
+	"Y1KYCtCSII/iJOL3ex+Z09tPdaD5WLnMYqTQj1j2AAxQPnDlv3PC3Jd4zQbypLR/W2w2WInBL4X4wZ6D",
+	"zGcXQXJu/tQIknO+JPTIobgfPWovjxTES5Bq2rPnQVF18heAqbgaj2o5vYO4t/jgmvcZYxnzgfcWxybi",
+	"rmnGfZKdqk2pn93PKQ86DoCUcC1Gg8/xoki+BE3JT4Itz8wvAV372K2fDm96ymd/b/T3be9pQn8aFDpT",
+	"Pj8Kq0H1CiWUpOKWiCJXzwr+ZIipT/zc6FE5OhCXILkvmoZbwZPgpzbr89NO3UFCoUbluTgtM1c8GXoa",
+	"M78EFDXTfgCaQHx5Fp2Bb+oXKNDFFrAqwJc4pUvCxbNgy0z+AvG1cUBTQF/gLVgknxBPasoX+SKXgJW4",
+	"MRv5tOixs75M1Mykv14akbdFGid92PbqnzTfW/1kZkULmLamt0Wlr7qrv1XwvDqjPM84FV4d4DsTS2+z",
+	"Q8EE3co/A9GrW7pKSdwSHLgmypDIiw2vzgJpDTj0n7THg8s53xES98A3FtnmIFryqcg2aElIXAstq9m/",
+	"UcbcvfjmOnS5Ywe7C2W0G/fkYFDxnNkSfcAsJZyXQRPvoMe4jOxuO5glrM2QbzVEQGUs1dwiEzjR8dQ2",
+	"rnkE+X42eUL6xUyrkOkBs8jm1Vlev+49z3kak0f/PJETJO4O339wf9y3HDsNx367yGoOe0D2Ota0tBeb",
+	"VUNoIu9jCpEduswgKuVUs//th+mr7//051pAoxxxgOnDvynyV3dA0OdsBeHVkfsZOqRj8rMIwc2JX8CV",
+	"vJZu2h4B2AX2icVf39QvDlOu6Fv3m38yLJWTvgRF4uAQgCfBU2XOF+CuYMIOYht04IsveBrUVCZ9CSRk",
+	"gxqyZTWu4TwVhKU4uSXsnjBlEvnmBhYzKSQxJAwR1bASZfEkG2Xne37lUjWgQ6bIcryBDvmy7SUQ1zyR",
+	"6hLxc5MzjiDhn+uh5PH2BSSqhOkkfmolgX/y50aeuV25SrEKCURTJ6u8RZu+jk8TzDl5UpxVZ35uhP0X",
+	"vscq1S/hiKaQnbw0UJVIRCohRQN/ClnPgkA99XNjEN5KO6DuKd2PGvM+N9KUD20zpNwF9Blw86LQUseH",
+	"jUh7crR8Kp3nnh07FeVfDVOutfrJRYq6qfylyRRV4zmvBYhL9FVttpQ8OQo9RuOXhsWaGZkSPyIb1t2n",
+	"vCF9078M3DXtywGx9lz62YOB7hkkjObkL0rGgBgEbQkLixn26ffkp7jy6Hxp57d8hgYor5YL4MmxV5v/",
+	"JeKwnj0zhEk3H/PT49Gd/SVikRUGcbV8DFXcPSPiXiLWHFwFyM7JOfGJZsnBT3HX7eGd/0Wo2d0knfcW",
+	"tKC7aW0xT06IztwvkRJddLYj0cizz/CwrU/9Ih+41ZwgpsQTfwY01SB4GUEJGhhbQcfNe+JngZUs4k9+",
+	"biuzv8STW0vkztuR+Axk+CJOaR0fZXKNJ6eocuqXSE5O8hBeD+TWuPtEHm9tTaGnxp47+Qs2dtUKLwUQ",
+	"GUje8MQY9UIBNaRfIoJDNau9bE+nm+A2GfET8r/G3M9xAwNqdNIMXqZXrsYAu9A+A4JexAXx4ADTyKD4",
+	"JCjx6Eqe18mhrhmpp4J8GqxUHvDPi5CaaqOilHlydGhF0PNihBWpV+tzmcUkeRb3Us/ML8BrciOh8jmY",
+	"XmXiXVak8bd345KOzTwnkaqNbGqUogfMUZoJtAQoFESXWQyt/N7RtmtM4/QPpjQt4jSNiBvRoPKYyB9U",
+	"MX4IRPjWcQxazXKuqpQ+DcnV5jTOes9IcktTPbj04MDLJYkEiWUhU+ypEtvInfuUqDNasedlZo0svaZm",
+	"3FMhw8z3IhSCBphQVBgk6IwpkwN6ysypL6ZctK4JFctshFUecGGEi86j7U22+yQb45/5BeT5kPsi1woM",
+	"vSuTsFEHPgPKVDatl0DWBCCBuuutike/DvVZkGcmf35hxpQFUPD0VOGaxZxlD2mS4fia0RV9Mn14YPYX",
+	"4UCiQUKZgimMukZy7idFXW32l4A6lUI4Ww7IPf48VgM1/Ys0Pe+S/PxJ6a6c+AVcszrhunPRtidcf1JM",
+	"1ad/bnyZDO9xj+TuYFd7YnxZW95zE1XVdNeWAf9J8fPcqFH56sYqitufdt+AekuiglGxvcm4KNhTE1Jt",
+	"9hdhv9MgoVzB5CMqeIvdMSgP+kT4Kqd8ZtWIkDCgtUyqi6Isk/eLoi2AkNdLOjwJeqoW4eeV7mvFI24L",
+	"iNrbAwGHWE6fdWhI0dwxbH1McSHWJBUSWPIEStb6hBaGjNF/Ph0AerZm8Y8nIefKnC/rueAvOqJmfmLs",
+	"mOW+gCsDhjDPeYMgVTgk5AlYqedB+ZOJvI15nxuBxtgRVSDSYA7Ku29G2jHxjIlarmWeuTeF9rM02UJR",
+	"GAn19em5nZgfNDGNWcU+uWlMymuRMbwiT0tZetKXcS4BFFSNTPCr4iuVcJ4IX3bG55da+hXfAZ+mp0JP",
+	"u1fVc1sNDEAkDpb+eWqfqfq0z4CmZhl6103K1i56SnS80Le4W4dJA1CrwtRctRoqnopeN4HMMpdTRnjv",
+	"9jTu2TAnbEO5corvq9GFNUmD+Y3t7FPr5oymEc1x4rlK5b5gTRnNW9bdMxqP3KGqELuIGTtIbW7teOSp",
+	"698kRm26uaRpIXx5Gz9kDyjJ0pXy5ZBjoQRzwccIC7TJuEB/fI1ivIW7/gWhv/YyOj+zIi4nDGUMEhrR",
+	"CDLTZEUKxmq1PJ2qRmSTZkrP/rsY3sA6ysNb9yORSiZGxI9k29w6bNp4qQ1XRyjtOH1a3+Y4Iuex09TZ",
+	"QV/bGyzW3oG5gb8DANuudepqq8CkdRbogeAXieIkpympOospm2uTfNTv6v6EbtZdpFF4a1w/YCQnaew5",
+	"WGfwgaRGQ+4EYo0R5khf0DRF05sfz6/OZn9xU6Q2EFg/DJXJPO0TGhF9jzW+bTBNBaZpYK+UydL7SS+g",
+	"/9FWu6A9gWSKTu/BLpLkNNtscBp7Zy1Y4qeD5rlqTNdMVFvd4LxYJJSvSWylJxatqSAR6Hzru1356ANV",
+	"hr9f4Y3/I025wElCYvPS6sFP+Rp//6c/dx+DGtgWDjuClw3Vs2J5yFjlQzfZqpTfHxXcyVTVPBTuty4C",
+	"cZp+HVfFiAYCY/s8bnyCVANBzAu8qtJsx/mqX9l2cAuDHnNcWWsLjg0u/GVJfWutFiSVb/pyJO2XazYl",
+	"YzrVAoVswl4osnS7yUDQdIpfQWovzxlxkm2B8oMmwKpEhjD6O2bNIpCeY3JPeuQO/ztmvlvYjuzDDIBl",
+	"tro2fpEkW/SPAifKE9adCrqNEZmsJihjq4nOczy5LgRh/+M8TQnzCwR1TwkvUPdlycT2g+oZz1lvOdDY",
+	"YtFdsZfCqmnGfNupMwqrxF+ax92Tkm4gRcfhdlW3HMMfamytuFqaKfngbc+1OFCdWwoJtbzJsEoHjD12",
+	"lXtVYh9TeSYY4ZzEiIcSN/eTl+9DpTY/+WtsKpxuavrANrQegP4A9RobbRSovdiaBKi/I91AclH5fUNT",
+	"LFSaU1MpSb4zL27Or2btEoVXrhuPTq9Pr6c312e3od6nWZThPIt5cIDLm+vb2Tzcf5NnnLBg96vpVbhv",
+	"itNwx7NpS8cYhzrOWyZk4fnm07tZSz8RwvDZ7G04J9Yi1On69McwTn2Vc2zX99OL6V/+Gnw54gQ/bkNd",
+	"Z5dBOnhPNjzY7Wo2Pz8N90wJo1Go83WwXxbo8mF2cdk/n7rT7S/hXo+hTteXs7fz2U/BntmGLBh5CHS/",
+	"nH6aXbWGuIU6Xp/NLgZE9diOVzdB3FzlIdRcfXw/uwt2K1ZEBDrefAwS902xCHa6uQlPd1PkeXi+v959",
+	"uA4i9GYr1lkIo/MwYuZBxNz+dP4uCOntA12GAL2bzefTd9fz4Jx3hDEsr7vAAJ+m7+fTq+DcnzDoZryd",
+	"v1o5ZKuEPvMKvZPXkHyvpuR6OXrzt+H1uewMQ6sa9OzYxiu6+oaPU1fPFrrp6ho6U539goeqG0UbvlPH",
+	"8CXVOWW2U7fQ9dbVb74jTlsEnU7cBCWN7p4t8k2PaWO8U8929tHVO8y5uiFuEwe7+cLjbge0WOxK8rvt",
+	"aouY1A1r8P7q6trO1AdH+vbdlDYR5usv4za7VVPVoL6+9evgTZCHrRXV47230eG3gQnTkAbLvfP6xV2a",
+	"65GTyGqfaq4d+gvCcayCR8W6ofJGJGU0WhPWu4RXFfN6Em+YhH5de5/db7deexW4o+z8xO6wzDlqvvIZ",
+	"bB7MN+oRLJXR1e3ofhEbHIR2QD7yFbbNXois4gyit0KG96Z2Q5q2CG159usYBSuIB1JiCo30p8XSZE3S",
+	"YiMxd/vx9HR2ezsaj95Nzy8+zmdSZjy/nF1/vHPQE0B7qjAedAcdjyoJ4YO2Tp2bfXc1rx6gDYJLIrBB",
+	"c0DHYZs0tkezCz6EXwxflOzDK2H+T8Flumw4PRVulcO2l55VU5VX3ZVgQbjNFV+btW37pRuGz4heL3mo",
+	"2oUIYMj+mz4DTEamC3+7VbW/zXbWLTu6mWH8X2gKhStVee4xElliL4WPnLBX0xX8Dl4BZhJpOKMMDCI9",
+	"c88biMz8ClceMoY6i9plb8Dyi3wQvr62bbeuuNtjw3XLYeLFThyh3dK1G79wj0NjsRfwGd2HFNI5jb6U",
+	"4kNSbc0Fw4KstqabOWpe54sO4WhX7tRyzfe9xzVyerB/3bLlGgCVvd3xlvM7hCqk6WHYvfIMd0SChQTN",
+	"w0FvzCf0bxk/cY3Zfzu5x4ziVPzy70BAAq8Q5QjfY5pAjpNlxgZ5TjzVTfVE0m2R0n8U5KxBMyFmD95Q",
+	"JEZZGkEiEM3uwbBPU139IC6svyN6oGmcPYxlVb+kkBG7EEG0IbG9A3pB+hTXs+4SZNvamaFxVkPcu8vJ",
+	"poMVt7jg7PGcCy3uOiUooSlBukXD6UgaCfUfSALE0cOaRmsUkyjBjKAs9ZpKtfdN3W1wQ3I5UHUSX/89",
+	"n1+dHLoQa7+AMy2jteQmQ8+xfbJIieYGc/6QsXjk9chzvSbGI1VcczyaPvBzvPG8auynJiA/3aKIESBx",
+	"nFhvMFVy8Q8ckfSesizdgHTFi2iNMIfakCkXGI4py8C0DO7wD2Sh8yGIraquqbZWzjI7ndt4Hj5BV5mA",
+	"ihuUQ7yBdnISa7KZNGidZQmZMs/1Lz8gzNWRt2m/POupLEOsyfYPDNwxY6TyBSVb9LAmKaJCrnmTi0BN",
+	"9bc4XpFbsVXeW2a/lgkWks8kWLzi/yiwcnPK2CuxJq8Wsot3T2AwP318wklBEF9nD6l+Wdv3thqvJJaS",
+	"HF2exyOc+ictki82w4YgGx3/2Xix2rd3zVN9va3ISjKPGyRjU8LkWNKBbEMFR1FCcFrkZTD+A2FENuZE",
+	"+E4j7XerHT6avI6TgEc7dS+CFpVAYLgmVyxElCnPFECfPA5Z6uAWPKkWRfIFMa1YKLf9dD6b3s3OtFoD",
+	"/nH74/nNzeysc9uDWgossg2NFKBQoGn0ZokTTuoeX2qzZTBHTXSWm5wxlMpVqC+bcqMXWSYJQl0xDAKG",
+	"lk2kQAkoqWPSk9RGp+kYFWlCOHdzsHAiOJBc9pC2uNTQAd6hdWR1KWjKJVWm66KP8vjVE5nJ3yUSCY7W",
+	"LSQxRloyylis/KQUxgy9eN98fuF+iWkS+qYzc/dGX4DNdGHRTGPV2yMLlg+TkMhxdk9BU6lLcTfDP2Sj",
+	"27BgCwkqHU8paK98mXixMb/KFrzSJHbqpGZL7eMEDLDaSpWdypYoE2tixZy+QQvyMQQrDKXFTAuZUMct",
+	"5McRUR3UnQYyEOAGkm7KARFJlxmLSDwJzvmOERL3xNiOE/dc/bxIp8IPhKAb0jrNGOEFJ6lAdImoMJfV",
+	"loie82/wYx/KsbstxZmEbqgAPXltdgqT68+THR4mNUrueSKC7H7w4uqR2hCVXV2yd1kbmtKNvLa+61xi",
+	"BabgAm8YecBs01ySpcT+BSed8dz6LvWn+QKLyOPjWT4ODXagYe1YSMHH8GTwVp50+kKq+cbOirqwMXU8",
+	"VGsc0OYb8QpWAVFP5dzFPEsrq5Gv9AQS8C4IWhIheZxXY9ZP9nJXUKYmUNF+fUP26sekXO64DEMsh+xC",
+	"ZFg+cqmriaxyXt7kjCJTyFKu6vZMTVr1Qxv8eK4+fvf69Ws4SObvjou0P9mEpNOf1gTuq8reU45ykkpN",
+	"yxiYrd7/2qLkHahubbnsKpkY+fVmdnV2fvVe+ntOTz+4gqxPfK2UhW3St/zaZiQ/fChnX01clpDekmam",
+	"QpDu5duvZ6ymWrnpo+frCs50kRmkdYvTKlVcVaMW6EZHLaxYVuR80t+fvS7ulvKtgIThWCf1Vk98SDYL",
+	"gZHofKke6GPfZ3iTUMGdJ4Mfpn32pRagJ7GA4KMEAEqJjtEqyRYox0IQlnKEmZQlc5UCtpv3e3fVv5Pw",
+	"wi4l3zpo8Bmp76BgaViHyjrFjceC0qqSG7WK5vDvK2uEhZMY4RWmKRegDE/xhvAJujTlVQVeKWSk5J4w",
+	"xMgmuy/NM0qImwzSmKuQ5DO85f53S5ep4IaRJX0cZpMSPTSjlZ0x+lGtqhk+59euvfdrkeaFPRw6/3pF",
+	"JbOdoOn7md4F7tQET2Lg+zhFJXrH6Or67vPNx4uL2ZntAvsp1ligNb4nkM9/QUiKpB1DBZAqsxsXzkg2",
+	"ZNtcBdP30iejHD5wA1CSilsiivxMx7t66F22QdAInQWiYjeYph8gw00oGrj9qxgUP+6AHfQ2aojAFkAX",
+	"HGdyPytoTNSOH9OqPeDl/OqiJeDFnVSQvPTJnr4Nhjfc4UW9Q9ONWgzyn/aD0enY6QGk4YO33pVS+jAJ",
+	"vQVeS7AIGRVqi+3aZdlk3HgPgIlxNyoGbEF/H29c74eR2kQWM11YcIymHchApunY56Ll9+sJC2TdcAWi",
+	"+jv3iAuS77xBfW+QJrIDkFYa1c1V8gVBIxlhRFLCsCDKHhXm4v6Zfqz4+FRsHpS7Tj2LrTO5jt+CMLW7",
+	"6fnVbH5mo4HGo5vzm9F49HZ+/dMtNLq++zCbd0BWdf5pMVfXsmLBBVt1VGqevMr6+zkj7erOW7W+9+/Z",
+	"EEYtIHU4/HN4mVarM3tb0ovI9ER5FlvXnfaUFyqzxTChbq2txsPN5a7nyI4h9DHJk2y7kWQvMFsRUabl",
+	"yEByM5P44udrTiM155UsBvMsWN91kgeqU+qUlsPm3VZa8vswvbbIjPbNVR2DOU0gHQkjOEZLlm1s+wkk",
+	"uqpvvspAOoBpGrCh3y6ZTFqJ5gvZSgP+UIe3ktQO6TcE53kQhTZ2WQ9yRu73G0d4uT9cLHW3kIR+kaS7",
+	"YOAzwtCGCNztznGVQQmhf3q9x1rpVxGC11tDmXVwg2Sbr+aNjjMfRi3Bh6pRVfRaRyi2qv0QppB6OaJ5",
+	"j6RCygHK1Ibxc8ROalwSRtLI92I1n0pDpgQL2IDE0Ine4v8sOGEn0RqnKUn8SicF4BBukOJ0DtPZ1fUT",
+	"o2THtzTF0gtM0URjXeqzZXOakhy8G3hhpdKaKLhxVzWfdtmKLB+e3aV5weg6c628ZSCb40QImq72gqxh",
+	"gTdgjuuo+SW0bbX99tBjWXOotmMYUtNZp6NFZYc5WhQ0EerWomKg7/XgVFYeEvTgnIUppWGGtyTXoUoO",
+	"hlV2cJwY973zabrMTiB5ENz6kAwSfsOLrBB+SaDr3o7J/UfmZ9JxFn1kYf69qzfloL2M8Z5ZyYaKb7UZ",
+	"fXvnbJgk7biencyKqIgXi5g2I8Cglxda+HIFbgx9w0jKzHb9WU5r/jM+IO2ZWl/nIbJ4sBPrpfpPEtvh",
+	"6pYZVuy29Hsb9XiOeL2J84wJfuh0fikh0i18k9ME1+Z2vcXaHjhzpLVZ9deNQQswEddl0f/eWa0IDyxQ",
+	"UJH4lycY8TAc9XMpweQZpyJj22qRWsydIySyMeIsOomyVDC6KFNxq4q3pazZn9z7ZyYMpytoZeKYrTIU",
+	"MfAE7JIafU+zzhVEWJBVxgY/5YNKgM6cDTYx5naX16BJBI6DLZYEi4IdVDWx9ytzB/HdELT/c9ESr3Wp",
+	"PIFKYyiaF2VEltcw6idXZ6fC6U21uyTQaIMkpaau2TeFt+NY33EZQzG59zGM4HNNSdw48bMyfT9svLnw",
+	"NNaQ08gwEUvSW/0o/r/fTV774FLqo3AQU220ur8ajB0tV/9WpPTx30fjvoGs5arGCrEOIny3XShhSRvD",
+	"icmC4nSn9Lfd6U6rs864oBusXLV0Q+ULR1P0I33b13ew9e4bLBiekcUwsbC6JpwLfZ1wRFLH18K5oJaZ",
+	"zM9fWuT16s0V2+981uD0HM/KPrqXoNGvpJr0MYrBOWFRCN8DuDPlrR3M+7Uy9uCMuX2z39ZWUII03i0x",
+	"7plyzZ870RBVrNB0TRj1+gu7Pl3axd8pZMqhTAs8sRBOI8JFxqoOOQzr7jh1fqWCk2Q5CXj67xpMNjDW",
+	"UQcTBL+HHnWwBG8kgpu+s/RHCqPN62i0lyOji7xAPKK7/OpinaWNHZrocoSskVfQP6yG9041nmo91qK5",
+	"WBsll8Ksy3jkr2WozaR31lAJiX9FCZGJcpJs0VxGS9rmmHJgjJo7tLNmO8WZ02vIu7LBNXQqZxigCkzH",
+	"Is+qcNeI+uP8/ewMLZJsod2SY9VzjG4/TOf2E2YEfSG5AHUkHHrrHsQFTRJUcKKi9dBb6GAjIKDrf3+c",
+	"fZydfX53Pf/8/lRt+gqzheT3UZYkOrWMmprDOCaqR02mh6pN5bqPwjpk0BNAPRqPKlN6TbyAI1nES27/",
+	"EirlNQkClj/gTi5py/eCkQXgeOHxo7z9MH31/Z/+7NQyF3Jg+3cJ4ljeizERJBJI4E1OGHX1jjp40ung",
+	"5UN6l3s7wur2b7fD2p8bpDW9o+Wh56U8C81VbAzEzMCvQPSg4QPPslpjIAobdDPMT9AYruckMq+ltlAa",
+	"MyVTzeEn1+7vvOR7RpL0LxJT2hAOGJodrB1y+Czi4Jjsvayq91mp1HATWmGlz63Q01ifyuY2uoTtEq1z",
+	"9Lzc0pNwszskX7d7sooCh8/A8o2SmPRPXPHsGSkCUuBz5GFryfra+gxWVNn5/G3fkq+dAHXW3SizipmW",
+	"TVfbcoh2tNqWYURd4C1hqo5dd84jaMxDjo/PR4GNSCUFT8eqeWeyJ9UsnCwkLOVCCYQBAk99Lzx3bcan",
+	"LFr3eRmv2rfcEFbQ4bvvvj9D0ZeduHcQc89DnvYRkhi0agC7t6xls8om9W3quLzcoQcQa52KPBS7F/v3",
+	"IWNmQirr/CcOVONdC5EjCMRE0Gg80jVgRm9GP7z+wS/SB07F1PpTlJk7pa0cpFaYw+dGuCGc41UAPCcO",
+	"VIex6gC/7lgmtRozuhdZj4Lh0pW+prLSpTGhEdKtGrp8sh3que3CKLubxj4ApR4zJCTKb0HJkPlChqeO",
+	"1g9hJEx+aZSz7J7GcLerGjjUupFk3vo/RsYdalfrJXa2iXPy2RbI3OhoO8FPXL6Wcsj6Xlpk1aMPbA2L",
+	"mHLx+WFNSAKVEeWfw+wtvsQqOWEqnQrfckE2+2G54v7U6tZlPITkAtGCSAchDmaVIjUFsLXzEKCgZbKw",
+	"R5IWvK1XWGhS7+CwD0HjnErOby3U2LdvndjS54qrwbom4YFZtH2Qt7n2+dSOFV+6Iah5juvVJBdWGr7y",
+	"MDfPYJcbVksS9banxDTlVKYpVN1dzVyXL8lO9vy6XX5HZ/kSTF7xqFDDcySdKI16jJaF8hlOXa/hEnn7",
+	"m/X7xg7s7NBds+SpbXu1Mtvm9X8x6+7l/9Jh09+hRmGTRkPVNNoolBWL7Yps+DfyMNnJU0QuZD9HkT70",
+	"MnAlNkAkbPnXassV2YwBr4DgXAoh8NdKadp2cAdRHG1eLLbBq0V+LHm+BsNyeS0L/Fy8fv1H8n/Q95P/",
+	"7/4xKbVd6nQSWZFNg6bCPvl93DiiLOWCYeqo9RtuHP+PWjP6bvL92K7/u8n3kz/6MOCPnGBFCnmQlLMK",
+	"SbJcO2Ls4LsRjDptKxfUdoBXql8fb422M+Pd4Ww4NBnaZDFExffzHhnKGrJ2xrDKgifkfVbPYr3KdHJK",
+	"KIdpfptssnj4MfXjr+18zJtOSGpyXd8V0Nh8wacK5IAj596Z83OlYC01r3ZCL9F6ireHU4+UpdJVSoMI",
+	"p2ihC8+TGAkifWAxo8nWNUSaW/XzPSUPbr6mz0aIq/xY5I2flNXCa7FsVvfyqFVIsum2UbQWrT28GeJo",
+	"aHhBhoZgjbg2VrmWZPUNjAwuMGETQ5Wov7WBQc52U/B1MIOkfJpNc6qZd0eyrB+LBWEpEYSj6c25k1ZF",
+	"m/bXmAngJhCkpHiNThCn0uFg+HcjWZDDSGPKsXKYmVcym3r0ihkvxQ8nj6r8UztZKB9ZgGpTcKGzuJgc",
+	"LvL5MDAlj8q9GEYSzKUXfk+zxKR51Ukc1fLl3pB4XHE5w1FEcmFSPWP0gFlK05UfR1+KBQnetnfVfTI3",
+	"r4EMnGjd7I1iTbb6Z0gxZTZ10qICiSGJc2Bf4kzIhXwhW/2IhbaTLd4kjQ3hZKCzQ8XpbEDWTN1vl9SU",
+	"tTUHSHTcPEolvXR5plVPaThxWedhncPZ4gc5rBCsBjp1LunEyWjl0B94tGBu6FU7OT1QTl7E8S4Tuyvb",
+	"8oTKNGuqtzxp0nTgJFvGrNLb6XQYNjGHs89L/AdZhY87aPXH5ql4hEHdd5Pv//cE3a1LNl6hJ4k0TSaM",
+	"rDCLlbNbxZNIEhBoip+Kq5TQM6JdwycQDcAnG7LJ2KBcbP7L/nEHwePR6oIZSQjmJKz0yT35j67vblTS",
+	"M11cobsMRKe+BvOzLOK+bPjqRquoCGuhXSaUS6/FS4e76XwSmn7ZN7S7Tc+4oY8T8sgr4SJVHWNjTV4V",
+	"iQdx7teSfanN9uluK0qKvSiyrQRnG1majvKPTZHgThX5IhMi8R1U/aF2+MeQ+j8nrAyklbcGIypktGcJ",
+	"MwPlW5gjpJtswuT8ZeDS6xyNd9Nf9gktq+FFYjegzDZIt8psgfO6b3m/6JQahpriA0kS7LFDq99hQrWB",
+	"cMytKWyMcLrVeb614iDPCmZS48qPuUr72GLHDbsJmxZmzQoE/7mzgdb1I6dj2twRxui1cbyuB6eq9sIf",
+	"Au2+lXu8hgX2HK7cDUnz49QWnGGbP//wmWdptsGdik05WYmHsdlRB83uAnyS5rkuYaPymDVIRKWy5L19",
+	"qUGD1F+JqMr9gO7Kq+lnNI1ojv36BWFADii5dFGegkN9MnlR6TpD9p4yd6/K+8W74y/UlC5gYwdFdvmd",
+	"iA5K9DS+86/q/EytB1HOCydqTY9qTf3dazBTeIGUkjA4lp0qL32PS4e2jaecxgRhBP5SOj8ciOEtHnl1",
+	"/wn5uzmkVY9333FXowcd+7omUICusyS2nJb6+YrR3dYWvuBZUogypMcMYXK/mtXv6nXOvfGZt07xCTPb",
+	"rlZ4r6e6AbvqATcaGwU0gOUnlhxHgsT+0Ef5qxLEy/pJtmqVw+Lla2a5BA2I1cE1dW8hFWcv1PbBQh4q",
+	"amdWeb7xeozBz7V1Ghpzl2YIe1xW4QImxLJitZYtIe2L301wn1CGHczZvSkGxg7gLGPCRBa3xRyb0irA",
+	"PGQn9a6sVKDh4BwYlxm1ifSPy+GFHJtE6PLjWFVVlKhPlJMwX2OmmGVlkCyNSLP0GjFQ3Yb05pUWQ4QC",
+	"8qgNEv43lVmAUrdoUMeIZ7Ycjy6o4q7c+7oqnRtaKpvqCeambfUJ1Ww4dLW6250mPa+MFCiQaWKhJEaY",
+	"A9+4uulWNjbb6rbdiTFW0ebHkQN4dZEeTHmJpfbjyBBG9xkKCgx7mJc6C5NVat/JSihS1NcWNERT/9Wp",
+	"RKwKpdgf27xxvHo/96s86YpHjBvkUZahEjjJVojqwgkTpG27sfZItFrDUtmn+2gvBR3w8aFYDFPxKZ2S",
+	"B5Xwe72cUGWydbGQd8ElvifpKUkFk0k5MJcX/Ufd3mYb71cW9OP8wsxIHgVh0j26DEvWYd2AUCgqXrbW",
+	"q/DNwwkLuKu3VIfrMtdduCVmb3WNao8MpL+oMpY6RpRtaEp8ta6ruYon6GJ6K9Pt336YnUGRbPX+g/Lp",
+	"jEQklXdxXoACyyoobmeXn2ZzaLimq7U7vKngoN8OJMqU5+0fuKpLp27+GM1n72d/8Y4ArCyyxp1KnV1d",
+	"gcI1uzvwyyBggGw0HsH4XlP6BVnh5EOWxE12MbRIjG7fOzb2IKGfLQGcwyIzSz2oDbYsEeAuzkubBotd",
+	"vHePNTTV2vqjJHIpRo5tse2MmZhhG37uFohxLXU1NTDITGuS9KjG0kCYFzGUCx06ROKWIJgpSqgCF5vW",
+	"ZRnBpqQryCYgFEi8iEzgxIlapjpa1oaOfNczUc3QwJrGSr2KCrwiA4CXzavAv37dC3zZ8RweCt55ooIx",
+	"kgoY3x2+/+D+HEHVgHFAm1Lf1ubpU/PO4D9IWY77ToieTBsedP7hfbr31TRbHw4icIwF9gaayN2f+cV8",
+	"4wxgSRzuB0szSuo3BeGVbopyE3w0QaeqtiY04GiDtyjBK7Qga5rG7v0nUwSvKkWgnIeBHr7VTl7CJ3WT",
+	"BiCs6q7KLFloQ5OEchJlaez3B3iaU3w8bj2PW3tpPPe4nSaYc9J6bP4L38uqStDO6v+CJzEqBxx0yAAQ",
+	"3wk7ktaLIi2zv52EpdO9tFGWKhvcTVLOUMNoSnU8UtXLpyqzxV1kdWFKGIVoyhMvvyDJc8mdiZp8WJzL",
+	"kWj6EY1GbhfJBL2fmpKhfUoFBcywy137aIP4lp7kKHce5c5/NbnTk6Cu9SzFur2b/c0jIew5Wv9EeFXQ",
+	"j5LFy5cs3J0OUWXD/6G/2Kr8FxJ/Uh5o2VtsbUBxJK8XT15qh0N0pU1vskjxJ3Dt7hIdrFewDOC8L7s8",
+	"j/R6pIIQFYxH93vuZy+O4KOfTt8NZ5oQXbqZtXs/pFrKqB/J8bnJkbVE0fTd014kaUgn/DjxuxJR0k2O",
+	"L9AGUAft+CY7vsn+1d5khsaVt8ncLSsZOka29qST3XtJVwWz8UjYDVo43hYv7bYYWjrUTyM9mL+ZKER8",
+	"Ovlhr2tr7vhxmW5H4nppxPXQY0f9O9mLEjXBdJKeHbeL8maPJCo6JfkWGkSkHGHcCKTpM3jnoEMwY9dz",
+	"VB+8+MvZ2WQfmV7eXdxeehPqXhaiwAm6u7it11IrL94Jkt6D5jtHWAc8udpPxOkqVa7yWdqoZfMHXmmr",
+	"EtBRIelUyqgq0w8HUdaoVvkYTS8u4DO5JxBLr98aGIK+XA/Hs/Pb6dsLcG+UkI7Go+nFhde1EZxkBwe0",
+	"bmSvHnn1dINA9ecVy4r8vG/yF4B0TpIssskUDzTbAC9LJ9FxoHzetB0K1eh9CyyqxaegX+aedaHAj9Pg",
+	"YuwirQ6cZ0VdaTZqexR09KxuVY15629IRuitnWxrKjOCySnTtr+17Gjyw9DRghmtL9UH5V2O+Dp7AL/v",
+	"KEt5sSHMyu1ZIl+VGYtpigXxP+h8FDMIGSJrGff9LghpHTFo8NUfAgM6XrWKcxmfXNPCRJ5U6uK0O9bu",
+	"SsFeqqWMZex2m0b7+3eTVAbHxv4cdr3ZjPwnu8fJJU0LEQomSjAX86Kzvli5OtkYCic/yn6dGX420BHx",
+	"bRohyhErUiS7jlWk+h840mvtWcSoO02sdtX3g8XIqpBB9OQxZ4RbamNEJeswkWgbLKK1m33JhoJh5rY+",
+	"QME92V5Fk4S1vmWYXz1iBeUse9zWi2hS7sAY4k4+jt4ApYLwJkWVlOoSRNWdv4PXW7pytYv9IpXvyoJd",
+	"Gi3Gj9n8LT30dRiwWZp3y4gpltBad0COFsmAKxnzt+ikAy6wKHj/g2UQcKv6lUkLDnt1C0hOoIEbvj23",
+	"dlXh/HOAdo5yksqA27FO5mCQpf7mX2ieE0hKVYlowwkjON6iNY4RFShjusyDZPANnNtafLOrs/Or9zIO",
+	"569Xpyog58fzmxv417vpuRRivXJrydVCQobDisML9jA5LFQZSX1ixkiwgvjyK7mcvcmwmwRpGoUnHqM/",
+	"v0YbNUZlxo2qfT1686du1uo7CeZ7ZTNNhoyELhhm25N0RdPHMXpY02gtYcIJzwAVqbyaaVrZ7gk6X6VQ",
+	"PvBhLd8C9QU5+eC+Ma83y/i/9/+/n3+O/+fPP0+c//2PCZr6b4D2jFlPwdrHMqyu1qkM0S7DjSRwBv0q",
+	"krT2muy+I9qvh3b+oWWM3jYhuYOKSUk4CY7WKt5Kw1xAuqJEkqIoWFpmZOQ0XSXQYNJXMeO5g7pqlvSR",
+	"4XrdKfLEagaHpQrgYZ0lpKRGzU15gzhUDj2Iqac8dELUwF0KEhgf8tu1cNrm+pY0hfRivRHSW27VV0NP",
+	"uAN3BxZuQc8a+vwr4gKzITs89IKfF2l5t0sO13ONXTthkkl0DlQnImB9Ju2KPF15GebqmYbR1Yqw/uu9",
+	"0x28oqYVQMyweh0WMyUdWELuKkLiQ3XrvS1PH5VZManK2pghoIGx/JCC6OIwW/sGsDKJXwSZf7y60sLI",
+	"x9PT2eysvxRyV6K4HWiQoBS5Gkq3coGRhZ2bNGMI8sTJxGYu0LenH2ZnH5WK73J69XEaUO9lMUm6soBf",
+	"XiBo9+IygQ/z8oM1QO4czx2gZhsYMLBkeEMeMvYlVBCVJKeYxS+rXOpvJUm5k5vI9PElJR+3eAJ6qLuH",
+	"8vryAsHWdWZhdGimOpj+YM7rA6GrtagnZRyNd6W02mTmk2UPEvhS0Mi3ImPR2qvEdCm0OuoGsy/yTJpB",
+	"57Pp2eVssombqxiWidEkYTQHHrI3qERb1ZH7FBf6Gtp0kyKrRVnXlKk0Uft3s76ZWt6WgLs12FSJ+WAJ",
+	"tmDVl36ncr8qYO2JCa+IkFR0pvdFXrLcV59XfeZqezBKVTeVN+Hk+x8kns5v7n8wdddPfvhf+qc/m7R/",
+	"jYNUliTrz/v1vMFUXSHbSEr/UZCzwRPWEatnH9dg90/gRXc+vG5Cmm+GF5fZPYFvZ/EyysXdwHRnOyd4",
+	"9QF4VTjK+/5YLCDT/oCiX82VGxxvwZTQXwYBiM+qvXep8tWaCJdlEkEfAxdwl3wgBu+ooDrbbd8tG1I7",
+	"S+1WmSelnr7TzwCgHl/QDAWfg3Wz/vbd5PXk9Rj9ex/N+i/da1SbHF4oJdyzVCBVbOpClPf/QWpJ1XfB",
+	"t6kw8buw3HFXg8zgEyxvY2XQg0XE9YUmSdmLdyK5skAfurX8q/JGtphIaRolhZY37oskJQzy1LpZrIJ0",
+	"1lKMe2iokZPD04N2lWho8HAqWaY3phkWFCx8rb+3uRP4E1+GcgC7Fl54y0Y4TYPp6+4pk+40baUoPqkm",
+	"bi45Tti9UW7YyUxqz6Y3jexiUoTSgUneO3Nz2iyuLqYbeLUba+jFt/RO4lbFRTqUrUPiW9Swu9CN5bCN",
+	"LzBF56PVpKBTjQNpsTQu1Vx25HGHZ7UTu+NBVWTu1J7hP9PIePQNVRN33eErcy/s7m4UfE4MtVRrJjgP",
+	"7KpPzacxOcQk3ERr02pydXrx8WyGoEYNd7Oqca0iS4jSbhI+Roski74YTmDbpRkyw7jNJ2j2F/UrdOsY",
+	"3FWm6dFG45EewatLc1YXdmzanfx6k1PNmSfJFnpNMcIrTFMuynu6lrnuTfnlHF76lxVHPq5eeTzKVO11",
+	"+R6xCNTvPUmS1gjVTJtXRzNUL4AJJ23v5Z5rks1rS+qcXPbxzl0/EzWVGNncE6ZFSR8stYqbGpwxIpPV",
+	"BP08cgqy6uqsEfr+51EnuL09oDSpdZzDMrqxqS0J+xE71ge6IZWTpF0CgP57+/4sKePilpB0QN2DvZln",
+	"ggfO2VKyN5h7Uu7fecAYZNgR7DGY6YAxkbhKvzOQpOkywNdiREVPNAcTYdop6htpAFqoc6KpFyz6VPAy",
+	"ASZE0QXtlb7rw5YINnlCtf7EJYXKHrUQ8p2/djFZEgbOl6VUbx2gr09/hKSul9NPs6vReHTz17sP1/If",
+	"72dXs/n56Wg8+jC7uByNR1c38N+P72d38PnydjQenc6nd/I+eH89Go/OZm+lcQjaTS9uzq/kl9Prq+kV",
+	"/P/y5voW5jq9vjqbjsaju9l8Pn13PZftb386f3cH306vpzfXZ7cw8V/AMfutmgigml5M//JX+PXmBgD5",
+	"NH0/n17Jf11en80uZLfry9nb+ewn/+VE2AbLok6eIt7mUy2Rr8tq6pzB5xl/u86YQPJbo/yQchlJyT1h",
+	"nkjJxmtkn/z7XELhX6jMu8yIKgdQ+pV+PEc8YoSkNagnvbM/n+I0S2mEEzez86BhextFAPPuIo1JJFBj",
+	"wdC9mcB7iKDe3S2VRZDkii4lWwkrTwzXWWzBJwN6kVhXzWuSiis0VwdUYa+e4NZGuSA9yGjcl63fQAnB",
+	"/SaV4+hahKPxvhmWc1v9tQqOK7BECcFpkdv6g6UGRRvvuT+f+/65m5t00pqP+KZYDFa65sXCXixddrW6",
+	"Sqs6aF2dVNm3srxbWSFfSqY2z3p/rWaXvE3Se8qy1NTp761mrzHNsx99xfwb1rUS+63681bTW4yBP8NX",
+	"JOFNV+5kyk2pVIe2Vg8IOGlnOY2Glj/x0Fee76DXV91MEf7O8o6g3m/X7g+1oNRMqAAIr9QfVENzBJdi",
+	"Vy3CBmKgFBUPKfbbbAISbCxourqF1PmeY2VbIJVdvwo3L3KoazvoBGlDxM1OxJqr3QzU7DNw9bARu6Ui",
+	"mp+KzQaHIw4OQMpsRWrZBYPKgZKV112EtpVzSrkK+iOxlscVApaEkTQy1X0Iw7xgpMxkcS5DMhiJMhaT",
+	"GGknRSfIsFtub7sStmKdDY8TzKFbX1tc0AjJ6KIM9e2n/4SJz5y+BzRVdtvYNFa5BGCYrc30VAsYSNNf",
+	"gztXwYSHAAlJymsUuTjXsag6eQo2W1q7670J2oLKZfnR/6Zz4QS/Z22zW0iQPj9YOOWf3gcGQNdu7ehG",
+	"8Bp//6c/d4tVdo3OinyHZw7uCkMdL+DB7EnysYczRSByXHuGcOuzo/3TR6F8SF7F7u01+uN3f/7zq+8Q",
+	"TvI1fvW9WQE8GY0PDTWyMHiKgF5BxuRAxCjxFjQ6kEdHHz+OGqJCe+mPBwilgGruoK7P7oRA9OcPWmGz",
+	"U1/9ALmxb5VerPS00ss3rL0H+uc56eEw2iGe03Y1wS75oX11g7ym0HpwClRR0mWMVJUirgssqep7oPNC",
+	"Ec6FvLGVYv7ftD4dwgbUo/vf5c0vUQc19kBa52SDU0GjVu1CEir61LYf/kpRUNHlnsgfqJOTK+jVfHN9",
+	"KWXeJNtytKGcG/lNKROdPmOk71MwJNyeXqKNHtwIxYBBZY/QRbt0wM7fQZ3jj7vq8LLdiISf4vaUuDez",
+	"S0RSyaPiYE6GZnoHFU10TxhMbw0DoDmVs0ovRbmdkCgiYzJ1gz/OXLftdG42CSskN883F1mEk9soy30r",
+	"kmYbsOFwfXv+52YbZSyXerqMOzYxuQQdisOz5N6tA2gjM6jgJFlCJgql35NxStQ0pYJXI5io0vwNScq+",
+	"qyM0FxnDK3KjSmN7apzBZ1VdVtXP9mThWCTZgk/QWa2EG8syoWOtSkbTpjFslxS0Mpy6yjvo4fN4brt2",
+	"Gtnywu4wtklIgBjkir8bP+XiUh/QAJN2eJC3Rdrh17ID2fTUM5tmN/5Kuf4N9mppq6usjdy22adJloZN",
+	"zbULsrMmf0oeWmoWejrox0Dby5u2uAzRRnykC4F3NHDgItNqaCHAOXqzxAkn44azeV51SeLj0mYlWZYb",
+	"PFVbj7qa4fwDI9SlbW1N5Xpz7/3TXfMz09rtBgYQTZvbUAnvbAB8LtCm4BDBXqQxYWWoqMOvMPd27mG1",
+	"s3vZSpSBV/9tsVCfEM9JJG9JeDAa766M2dqbrmAcUznGhqZYqPf/Bue5hO7Nr6OPN7d389n0MnSuG7U8",
+	"P53P72QgVMglSYFSCqD6PG3VO1UtWerSUnK9HL35W4eDU2209tY1WL/+UmfKogcjM3hTnKy2faLr6lBT",
+	"l345xlR6Op8pW+fHmzP1j7PZxezO7wNTGyzPk22YQbGtDh1uP8QqDljXMQfboS0mu8HG+2ez8/HDEkhP",
+	"Kk2R+XjQFm8SX1BLLQlnNXiVo79OLy+gxix5zDPmfcm2VHWFSXvsnUI3B1Q2tG4adeBnAGtWnrAWyuoa",
+	"Nli+yTOm6xBv8BcpCkr7ANvKOMWmoU+Nv2NeSwWd1wxjqaS5vQcrRq8nGdtVdCNbQ7yjh5f30A2+MO3e",
+	"yX1SOQhhz6IE083/ucdJUXpDEQbvL3/EFiOljnxIMlLdq4nj0t7mornFJak68uzR7+jaUzbb45D2UIJ7",
+	"6KfnAZ2TUNXqG8xqOQSr59FxXZnP3p/f3s2lM8hPs7cfrq9/HI1HN7P55fnt7fn1VQ+2bJPIenQX6ssu",
+	"yYUdBlDDu+U8xKYvBv5iH1PmxwVZZozUfLQPwUTaVUlDay4zB3/DC9/rvrXKyL35jtmiMlgbJ0kPeSSY",
+	"R7jBwJbCx32qpKBPC4LGlU30sRe1r33H1FTgDupGWAqrMAtPWUO6WlITt7842DWa3mtGVzRtVcBny+qb",
+	"onZwF1up5lEr2CrPuKbivENnH5paKWgygFF7WiqDXj8XFa2+DuWUCOj5oXR73yPpDSP1GrJW/uS85WIX",
+	"W2MiGAMIFbgo6w+Tz8DSFexRtwcYeB0kth3W8no4XZPoS1hD6nJ8LFmg8XbSaW9SVLlCa2f1HtNERjE1",
+	"x3/Q46dZOYG588rH4Fq/Bpuap1piwg5diN9w/bDe1tb3B9FYYWh616C5WhHuV2QsGXG7o5gwWlFUlt/G",
+	"SBuYIDmhwF+IPyQJJzQO47M6JuQYITKgLWMbEnuQF35Fm6nGzjYOIKngu6pjs7rfRK5YpMR/Z89oOujp",
+	"0lNrcEmkQ3lLXBlG99U4MKuU5g4jNgkRhyGE0YxR4THZ3WScusLiBqAsM53xLFGWaMmC2VjlbYFnlUDf",
+	"BVLt7PuO9+mI7Qq6UcyDZKMWx9sSmdnwTd22LkSPDWoAHzbtbQIBEZvJ7pF3Brb29YVU3kHLa1gHbklq",
+	"iA68M15+F8X6N7Fjdujd90g+49eGl0/121CeC7fB4bLQDD9udcs/75TJTK2eLI3gnW2sgfJ20+chJnGh",
+	"sk0pL9U0zh7GiDyagGRGeLEhZVKUvXLs+BR/9Zw5FS4ih2k7WdfpIsMs1mrXAIM294M2c2e2D8JJlq7K",
+	"u96qtKUSiio/fCqaJ1B9NYa35sycCMlrOUrIUiCpDbTcCDic0nSB+M2J0G9NykBi3mxIKqVIUJEMMkcy",
+	"x7+jD1EF9QejcWOJ/fagr8FnqEfEPod+kJHDMXC0ZdYcvRmmMi973ig7cxMa08ANDM+WHtlijKhOXiqT",
+	"gJpelEtK2v0WC8hF/a279UiN5gqvCxFlKqQgZngpVDABrDN1fUh5vWDFnTaEXZ+eu9jBjCAiT4nKCFs9",
+	"ypRBfAQfgynNHVllQXLGkcEiK+VM0JTPtM9SczV2SBWWpoIgtEi6xvfEhKeNUZFLIvvu9evXvSs8eqNe",
+	"wh5VgWugjITsC2w/1q4dfDtwUgkZocRMpzp/U6xo+IZhpRXcfnixxNhr0rL1eLCmzu1bWW2NJOzX8sOg",
+	"QxyOFW+4AVbXqw64blVSnMjssnWMQc0ncLyXO6EPBt2qDYbaYsb7uCX6QGiQlgPCaHwQT8avLZv63wUp",
+	"PEqYtzj6kmQrxWz/IdvIfy5w9EU6+aUx0lEXDYbc4JHG0aWPzAHAgNFaWquTmHBxoxK2T1fkVoW7eRyD",
+	"ypRIqo/J8g51lvqdzupkvuDx1vA7z7yg5ATM9Y7CK3iovob6NhwutXMSEj36AEje+vQKjKYRzSHTOxZ6",
+	"0HKmnsMrLHnctWsFspwcuAuCcpZFhPdehE6X2z2LSlk9aHS/j5RZVzm33dRfuk7glTddxH97Dl6pE7Un",
+	"0LGxraLPpuDEaCz/km5AI+tS+HlDV9YupznPCBydBUnFZwqF0drscAN4/u/S9fvo3P2Snbvn4HLNv61z",
+	"9xh9IUS6ejnq3bxYJJSvIW2bTfiOrqWHsg5Q1APJpA71Rx0NVWd+WU7gaG4wUqQJ4bzS0NTt+y27ipcb",
+	"K5XCqpm9PB6p27PhOY5uzHz2kamg06EAwanVIcSqZnca9k2XMgAor31kdXVzGSCqp3Bnr2hZGvMcztnd",
+	"ZigSBG/4SY63GwmWTE0EDhbmlJej4BSRR8pByLAYV1ekxKjQAxt3D+nkaioAGoNBeTP/R3XjJK2UCf/s",
+	"xsrqeXaMIhXUFM3R9zJw0oT4C+v1saj01MjMM5/tVf6qvOVKBYtMoD2bg433npIH5ObAHqPT66u7+fnb",
+	"j3fXqgmU1lGRldDycnp+dTc9v5o5n9Wzs2SPk4qTkJxN5ZwxA0O2GzNMq3hyS6KCUbG9ybi8tDzkpBtA",
+	"HZd6upeupwzIOCpX0CmjgkY4eUdB5uNtYmak29rcnTRRfNcZT8egakBKwqBwY1O27SOWjkdmqtN70gpS",
+	"DEQfiRbYIpbxSm4RPgyET6ZXCxhlFib50g7B0j97yq1K2Tn8nQROGoxE8hrkkk5NJZl+c0tmy+D1sBNd",
+	"LHVjVI4jufYnkMiwgKwKfQ0rqU5bOhj90g6B1eKZ8sTtOyFd7TEfXaUYjuqQPGCts0DqvkFEW2OrTtfG",
+	"6nwY9tB87SS2Usi4la+08fCu7GGyo8RIKcdMiJUDMmZlCF+4QiCEwLBpE5EwLoMZ/HwZBBut3Pf5EmGB",
+	"1jjPSVrWWytdODA3dnjNIZVrp6k9694bby9kYrSz0Xh0cX06vfj84fxuNB5dXd99fnf98Ur+fjo9/TDT",
+	"v6t/S8dTZwX6m/3T7Tybz6/nTgm/lsXeCuLJZvkhe4AEv3ZxIsu+oBwz0fTKaBoashKB7e/RCro7EvwN",
+	"Cxe728UgrQnsoy/5mJNyzBVmFyrHB0YRjkAw4nyym29zBfKxxWFrhiiNwTuGI5+LfMofCPOrxs7DTu7K",
+	"ngsWAykPkhoZu0VdU0kj0NT7UmqtU7SkSSikUKx9nttiXfcIrUGmNYeM27p+cEQFw5GKwZAjBwqNknxI",
+	"SEV5cjxPj/0q7yhQvJu9QyULVmaO7c7+0pppRQ3SatIegMF8o19dofzsfTO7eFaP81IudPNsmi7ejFBO",
+	"brBB78y2xEbh0kxpnLGeeWNqqGq+gW4u7Qq13sbI5ynCLFpTQSItstR9r5yPoRMazB3TNzlLDQQ7ph3B",
+	"R+pSMj41ZOPjBaZ0QwEpq9xsmlDMk6TaDZcKjm7fXl8G7TxNWvamnjQzOreAJeu9Ek0CHCEUaLHKw705",
+	"L6TdmRc4SbZOnQVJ91uouWe1KUpKDr8OZ2kPN5tP7vjTatevFZGwvfJeBU5V27b6qHTZe+kdAnxcLUpx",
+	"cr2kppJxSR+trBvOrVnNFQ3/hpxvEiIYIeAz0xY21rxRqdolUPOcfpq9+v719z+8+uPr//1DS0rWfQpI",
+	"cHJPjINt22ZK0ro1bSvvwfbN0w8/iaTq0w9XH3+77Fuotk0bF3287VU+9JNt2KqRstgLncZQxOdt+Qit",
+	"ZfZtz9LaxzulrRxK6MluHmOGDCXO66V+lf5GV/qtvqJ7XeAud/KV0ft2lW31LoFPCB9C6brHDlVqRZYl",
+	"gTScWfKpL6eHCARbxQTGrI7gAlZBYTWErYaBdmp1DKB125KlV7X/XZRrkRu8HHl5H1e5OISAlLYAfR/3",
+	"prNSEPD5R9kTUk9g51YtNsVxnOW1HqjDn4Eh6r1dVHouSQ+eS/fuN5U5DTWFlpNgWWHbG2I06MTUD0vg",
+	"eIROwK1zHdbV6uqLQ/569x0Vzen8/O78FJRGH87ff5Ba/dnZ+cdL0Nn8JDUvVz9eXf/kDwT2MJ4WHaDV",
+	"qOaEIXsPtejzew2WLSvilk+FXz2naWaKdxF7ipXxL1JLGKRR78lc13S17tk0yR56ttyQmBabno3bxB8P",
+	"Wtu03ofCYZF+SbOHnQKfLfo1ai0yFP7KsSsLr4rw3hNFIKlAlxJXG+c5EUWOuOqDtLFvqNb2/OpC1Xi4",
+	"m7699R+zQIXy8zTWjgG0GqsAldKKKCKcLwvQKqeZcMt5y3rjt7e62vjH+cwqU/3Tg7X3WiGpGSgQlt4I",
+	"Y4E8u19oGnfePO68P8oOcDqigLO69I5RG2koUluxwRxMUpPemP/xzcnJooi+EHHyhWzHptRQ7qje5Pt9",
+	"QAWhO093O25cuaONIxj8IQ3ZaEGkG7wkq0kgR7oJ9QnRwR1svW2GVMl8M6PCiXmgXV/eyHQ7ZxI6XYre",
+	"O62pINycSX4pMz+bkCQs8AJzMul9kfd40lQIwDxurHPCaYJ5ABmCEuasHZ5yQgVgpBoRt3fTq7PpHPDw",
+	"/mJ6ej6b+/GgPSG82tr6vvvJL5YxxCw4Oolv23HdOrim7ckOJjU4g7Z0jhVDTHlnZ+F2w7wss3FMm8rK",
+	"66t35+/B9eBi+tfZHPxrmhlB5PcEbwnTHh7WbmgODR+jd+cXM3vGHMcdo5x1zU9qWinJyEkltzu/mHWz",
+	"uNAxu5nPbmdXd3ojdJWiymNA42yMIHvK1XuUpcRmHVb+e9bNJFsiLVrZASNpYtBB7UmWSR94qH1KYYWY",
+	"u3NoqtJR8Er5gChUp9AN5QhFjpaYJiR20aLXIUU9BWaXlPdAl8PrJ3DZy9FKt9dP6HLRtBTQ/90i57/U",
+	"3XwvlxbdaFshAb2g2CksnLpFBNrrXHxkCfda3ZzoZ9PWHVUZfUrFt0r7MUCBH2V5qCK8tKW3ZyjQ2f1A",
+	"yX1fh0UFZFScBjqyFihgxi1a4creNSQOefSDuwd0F1SNwsj1YnqGuNy8MPq1P4HhQm883vuRZ0H2LfcO",
+	"L26lJOk3Ut/hBbpVgqb8Xj85a4LjUPEqJZjyAR7YlKRCwUIifx2Drx0LCLGG6jI052+sRuBFf3AreOsH",
+	"KGEMy3tyMDsTpqepCCMvq5xl9zSWvLnL6EgesfQgHOhF3kdKbizJSMp+UdUtEaVXkjHrlCLWxC4qJP5C",
+	"EK6fcSZYSEgG7KAB/kZPeqOHCBSAEVmU+RhonhQrmiLTohY9aXZpt7IzbbeBxiDESkg8miP/2cypax6i",
+	"gtfKIwWKb8W+PQONkNke6Yt5OZts4kr+HQWIX5BfpTRd/Ui2vsKR52dmmPc379EXsq1izNw+UoCGewK4",
+	"vXeaYqFgGEjiqjxQEzBdqV59rhKsy6Ytnju9URxpV1Fwy/3jP1NOFtPL67OPF/LVfDO//nR+FnCADVO3",
+	"J4+2ulpBVVfyGrsRi4ImwtREMaP4TN1BE3fwwsx4yPLNi43nUw2vGYcS2tF65Mxju3uxm30hnqtZyJ8R",
+	"+NyIrOqBBPErC4IZpIGRvetL5yRiRHQVc4RGt3L3z11/i4rhxTbpl4q7MbHMAnfH6GpFWJsGSegmpVg+",
+	"nd+dv5ue3n2GFLnnUD7U/nZ5fXb+7vy08Tskz1W/vZ3ezj6fX07fz6qtfZRpsh1MC9+zNmIE1oMTrg0m",
+	"ZifG2p9E8W+n/pjSsRZibbRg/dIaf+SE3WDOHzIWd2Y1nqZZut1kBe9uCaqvH4l0PWdE/Ei2nV0UUXYO",
+	"/MDP8Ual+rMZI/wZ2UrTUyQbgGE4db07fTWp6D+7RPHyTEQRyYUO9HR2DPRO2KAKmvHSuddp6DVQJ1jI",
+	"N80l762C5jxcYhVH6/Z0cpUVMcLzLI29ec+MBuLUWyv2w93djdF6+Yes3VtDs96YqqhmQWN3v1ys+fhd",
+	"hVCCsZrfMmFKFSVDcrHJ0fXxdAnC/thaH68GC/xe9y7VgWBnMtiUrYuFpF6I3LOBexjCqBrp1/sV0/X4",
+	"szbT4DuNbKbh5vCcsIDbSktilq7In9qyAi8QoyCT938j/djHWp6aUPKxO5F4Q2/ASKq0aeDd64uYg4oF",
+	"hMlouDKf6vYPjKAlcWrVT9D/n7BMR1lBPJ6NmYHGOrXFBJ2q2GZZNrQ0MhlFOSsrPfMiWksCUORhIspk",
+	"GKHAbIGTRNVduNnenKsljFGcES5VYhCh01cfjfU92CdDENyZuk+f0zo17VRuYMN+P+ZxaynjGlvXNdEx",
+	"FzptfQzp8SqWbk5TfdpJnoFAJl8UMvJw9EawgviiPHXkbCtxmEZ2sxsE0tgpgVd8rENwy+5qh1LtrVuA",
+	"MbHcQF3gE+V4JZtRXiM5SLTpozf1Gwf1Z4rIPYF0jqpQZb/9z5bLhKbe0DR2D+mOyqT+QLjBk2Iv2iwV",
+	"OBIq8cQYTq5y03YbU46K1N4q/njikp3aavOGV8qYiYILUAVOH/gsYlKn6zBPWeo9y1YJgR9l6EW++TuX",
+	"z5btDR39EmaiHc6uhqIbHG08enxVsX++UjnR3pQRKS7TK8nb49b/JEey98ocsD8QnIh1yErwYTa9uPvw",
+	"VyDrj1f2L5uC2kiF8q81jKQERKsA/ji/GFvTAOSXlQpXydKgHYnRlogJmsqGkoKcSSB9NkZl6qIoSzmJ",
+	"CiGflsoQoCdz7QG6O1gB3H+HLQIGEyUOmlUL7kmosnDh1YHfqaVzX/jJ41byOrmATNo8dH5UKjjKGZWO",
+	"6YALiMqe9PW5Mmv4OL8oTYutCdfKVek1tBFJOWwAO2EnVM26VBS6iWIvRRU/m3B2+h2mScEqRaVdTxSg",
+	"ub7YqdC6Dug8VWQ47V/Mi4tZ0BNg6ItD77g/Jc8AYd6MMzb7YVHTsa3hnLcdhC0yIGEENCyyenpbLSMJ",
+	"RqWENBUq/vW719B5j2y3YVJ1H9+NxTydRmWIbG1byhf4J7xiOB1umtT90CJ7tKagkOK+VDA2Rlxkj6Re",
+	"Yn8M8YQ5YY5tII3rESq9GJSG8m32aNSHg9XT93qhLVX6NfgSFT2qn/tsKh44myyvFoRTBdP9aqGRRkar",
+	"9RwjssnFVl+HrEhB54RTb2E3dcMVHi3r7Yfpq+//9GdkWjir7+/sYzfWQKrBKWVgHc0bGJW7KYl3zGpl",
+	"l+gO5zvj2kB5qjIBfQPlAzN1i2raL/mzYXApBgGEb1OBH0v32TXZmLiRv303eT3+fvL63+F8Qtogv78M",
+	"dOo8OToTkWpci1DfkYvaITqxTHlLTA5HXIUs0RRhHunUdHADNAMVsQg51h0SDz1GOE+XWSeGNEzjXqiC",
+	"EZt+qPI0JFKpFqz0TtO5objm9Z/a/v5YGlOLodmzdyRbCZcarWWNt3aTgkYAyWylzUyl/RIZkuee5YyI",
+	"Mu+I67c5u/w0U5lXPs2uoFDTzQ8/vIYiem/Pp/KX+ce3f30/u5S+nfMb6cU9vbhR7qXvZ1ez+fmpV6A3",
+	"ECvfoiZ/UGsb4HxTcRkNJy5qSz50Bg5Ogdoyrh9+1SGqUURAO33iB0gXBjoIMCpO/F4dAict/njlnWE9",
+	"ICUDrjlb8smgzBl9VIoKXdUQahdNLuhju19+6nw8yyJQvnjY1OwvKNZfERZCqTlE1ho1o3ThbcWwDhIK",
+	"pnt3hsG9Uw2HxFsNi3gsEcS1VXwB6ch0/jZvSCXnxQA0WF/7/rUdWuOwdO9aonALVC30qjp5gIbe2e2o",
+	"xeXB78qQ7VKTw8aub2ZXn2Z/keqr2+m7AEN6vDVg+PKPGQfnWkxwGRGuleOwFid604GmXjNLfTjvSzJl",
+	"h9YX0i5Uu8lxJCrLbwz794LrtIDBMNmhUaNj0DtzgTd5TxRUUN/j6qw0txC687poHXlxbDEaIMuQWq43",
+	"yTh0mmbiM14uSaSixpx/QvQ0RJnEhH2m6T3hgq5wrR6lQ86V8r0DrDy6Y6PYjM/Q01mw4LJaZ6dZpqDM",
+	"62MSF1ZK8lUSL06QGQ6zZm5FedUqx+xmikN7cctL3Aanb3PyH07tBF0cCRTvXCnhJYfNHlK3+rb8aVOC",
+	"AUpSs4aBKpMmMbUkA2jemxWSauaQ08sE43yWEpO0wVmKspThVKeWkxEVZO/I6qG1KFuuXwCrK3begR2K",
+	"weWYc2mpcuLqS/KroAzixpRVx6vfVNPzw4dad3PSNll1IAuEW9kR5mocsQqLu+gS/7VKn3aDf+lNwuGS",
+	"L72ijO3dKqVCM6ZnR0FtUwl5MvEc3IRGuXoYEMwn6HypVD3jfok0ZPmwSuVMJ+GFV8Cv0NGQEOIqKFxk",
+	"uclS7sBkdbrLon/+vAYF1s/XNoAL7QHUkmejH812ybu1tCt9Mn2E3y+9Sd5H0D+pstThomxzstKXjGna",
+	"ykL3Lt7bFbdCUmnZDSgdyKNg+AP47/d/Ws/KTr6HdUcucwpWIhbQn8iFsRQn/q9KHzV7BDNT5iQKaQNX",
+	"b4PspTtIrwNdLi6wCeHYjie0SWgHzQG+7KqDb1PCyV3YrpWfGyXXbCCfITlns1uOktqYQPqM5qkCTzvd",
+	"FZl+jQpMWexPFbYuab2/50E75DzPdDK3gaDrjgeBvXxrBT4Zt0XPpnYsz5uLp9Qg6jr9iJhT6Y3yns/u",
+	"5ucy1ftnkzLz3fRuevE5HPPtAFH4r6Ugx0UzBxYv7+3LW/WDqGfzcLB5b1mQlQehN09TPaBzSYu9e+su",
+	"qvuu7JQRzayul70XqnsY99wmt9cN+piEHM6n6bGnFqWF/HeuS/jbunF/J1dd/fIyOKncVoEbrXl5fQW0",
+	"KgOS9rMrkx221Od9hWJyTxJJTVzP8Wa0FiLnb05OHh4eJmvVdUIzJ1lby4DTm3NHJf9m9N3k9eS17Jrl",
+	"JMU5Hb0Z/RF+UuleAa8nbs3PPPNdu6equiW2E8mXjK0sdB7bJnMnKT5meEME7GIg6KJscgJ+4XOy/O+C",
+	"sO2N/H309RfL/97qO9A3SNmEkjK3sIcNwmK/f/1deCDdzhmk5IY/vH7d3fEtjp2Jf+gz18dU6ugloUVw",
+	"E0G/P/btpx3+v45Hf+oD37kWp8GJlCkXqK9uNlWz0+4+C7ziUHOhVPT9IjtZujlZFMmXLuLhCCN4l5eZ",
+	"xt16JGPEM5UB2aOeizCUIbI6PV5W47W+3xvpoZRtaFR6q0WaapOkZoPT6sBU671k77FSDj5QThDB0dpR",
+	"LpazZWmp8kvjmvsk9JIjUm7z/XUcE6UzHUzjb4vkSzed9yHXykC/c1pXm9FN7GXNLz+5T6HqMXcz1FeT",
+	"ipBHnSQWc/TX6eUFqK0QMMCxIjXjElXSIHgZlYEEVBf7KfJYtaaipF+V1kPLPcqbPScMCljp4iWVsTGT",
+	"CrEYqhhDpa5mHpSxipMwYEEOEQceeawnSK57a5qAJr26bNCk6lABjtJMrGm6mqAztlX+SurMqMl1I+O6",
+	"vsFf9MCb5omCeWsl1va4ONQIetA9zpZ3vN/dEYN1O3JDlSZ6nTclhYntiTBRuv5zN3s0ZINTdH6mwnJV",
+	"LmBbv87MTmJElF+L5PdmhtJDUplInCIMcijjBs4JK6u9w4mRxEk2mCbq6EELyhF4IZK4etxYlhCONjjP",
+	"3WiNKMF0Y8+mhb6s/FSDRSsl1XwkjfOMpuWB1JKtdEfUNi+HKHTliOohMsg716i40zHNg49RZYC9DlBt",
+	"pGc5Ogc6BQa7Fcr00VivA5HZUvl9ZK4yutKQLCPS+5ZAEKoNPNTmP9f4qQMhZRelaLUuuqYApJWCrLFV",
+	"xQapgumKoStbhZ1HFbkgkjjhnvSISNdqfc5TYmdmfm1RdZD3gDvc746V68U7zHwgtZ6U7+lXkQlRD5Dv",
+	"GuKPTFC2K0rh1I0QtkRdjm1TnymBiherFeE6DfqSkWrTpYrhg0zJTUr8JB0qnVdtrW7MjkRZjlKJv95L",
+	"ymiM+fsT5uW6XUmjWvZwEJ1upID+SlLNBgvSInLoForHmdSIqnspw+vsadrPBPwp1GLQ9em54+tihQGb",
+	"Wg7aS1k6T6yDRmU84L94xT0XugbNEggAtdONDj3L8fa50mtD/e6I1CxdUgE1OzKINs1NO5SDlok+DAuF",
+	"KF+iym+aZlRoG76i6BWV0dJOzLeSN50fyrq44C++dN0odJwR+ECptKIedYhs6KQcSIkMfVOG+MGU6k1r",
+	"sROh1kb6vTLTSnaYbjLNCQQ7pF/4ya/235+jLCZfJVAr4q2HEFMGuUZNPOk54hEj5XvLevc4AWIY2fEV",
+	"SboJM1Rv0MLpesn1GHxQNvJ1xgRAC+kh0EPGQMtgg+I/nqNNdk88zFUXIrsxMAzWdlvopRlWWkFcjbdD",
+	"rH98/X0fGUCh8LdAnz+8/qG701Um3skktgckaL1jLuE4JG3sKA2K/tX86zMjy6+KehMiPNb9M/i9In8o",
+	"KsKRciQ0rFHx1C9k26AqNcTOFhRmFbnLForqxf5uVab2I0GFCaqx3yEOOQ7xPfU4tuSiYoX5cLJ5T8RL",
+	"oJnfoh3h+biRf/PDNJQXHhpSCXv4XkznUjq+bb8FAR3ccHskwoMSYZN6egl51SvxRGXzewWqbh6U8i4o",
+	"108KQeSzB0u7E/RUSnLuL4a7xvcEpTrbvHY3R2nG0IKQFDFyLzPSN8UzOZtKuPhegfWMbLEOy5Eyuynz",
+	"AsybEYToV6ikhT96H8EK5VLoYzSNaI6TqiU0rdKc0sgndEPBakNtMgCMluQBrbNChSrJDBcGMNVHVZZF",
+	"GUNxwXRiTTljTFJdZQEWYMw2dUcC+yIHgkYEs4QS1iRspdV3yOkZ+bUDxV669co4x7PRdTYAUU0uCi4E",
+	"7FB8/ORX9edn+PMzjVufPrM0BpurPrF+Dl+mzLO2S8+zWtL/4cl73NkPl3Oex8fX0xMIwHKnFdGUNLIT",
+	"3aZpJoCE+AknJt94hxBSKtgl91UVfWgaE509yTE3rfG9ZudSV19OVhqerGitjJ6QXVDalbQvganxlbHV",
+	"JMtJCt6hNCWMT2DeCSP3lHtt8rewHJVb0ZQe4W+3UwvE0x0PO+WPZLtDr08SKb375bIcMyQM7Nsackzs",
+	"IZ8pQElssXy8ibrPsCJPk161PFIys4FLogOVbCdG33uS4AVJ2t8UpQf0BTQOvAV0I9XmyU7NrnTc3VYx",
+	"ujvC9nqVuFg5EnzPZ0mN4Pahby5wy5P5PXEmk7kiPMT9nthdhBbvMnZgTU43LUqr9RkW/dm7yJzmO1Fv",
+	"Zc1Hyu3xaGjQ0j50+6v5Vx+DiBl9EjB3TJ0UTk8jy+gJj1L+U9lInC320ZwKZA14MLgODNYQDP7vfGzd",
+	"w5WjoXKD5yZvapPgZMDcb5bcDOAzWPuR6fX1YbBsTyHuMHzvZIHjFTn5Ff7X5tuQQsE1nKLbT+8RtC4f",
+	"jlWf2rHO4/aQJhmOdfIZbb2BQvllCWXnKIwRFgjLb4tEOUGIDJHNQuVLUoXZ+AS9lTOrvmbR8jtUzIyU",
+	"oyR3K2qIzKnXY8KplB7ThQWA5N5UOnpx0NrERsn6lCoKhAu3kcVAQtRrOyvU916l3+3qJPymCAKkbH2c",
+	"rggyCVOUQ/K9dugsHUcpQ7M7vGqVrWCC5+QY3Z2Atob3uBXbhAzrAnLvsC6nWZKxHWbZod8l7HrvPnR5",
+	"laXkUsZwqHDqQ7BoIBeXQ/+xJwe81ElIjly9XZTFmpVWeeGBWPuSkLgPR5fBpkg2ruVb5ypLPiMRSUWy",
+	"RXnBmwlLx+U1AJFyWguqbEEqnkjXzDYzKNffUPC1w6zeERK/bF41RNNx0AMqUXM8l9/uXLr0eviDWaoD",
+	"W5xhuhWCqt0zqQRDr4Ghtteq6m4Ph5mjEnAnr5lDqgEdEj+8RvBl3wRH3eHvV3d4YqfoRe6qcTvB6wF/",
+	"q6odDf+RKIcSpd33Q5ClFuNPftX/GKLkRrpqRZey+1NZSuTlMme9/qOe/MliCdIGIR1MZa438xCq898T",
+	"8eq1HpXuOyrdNf4Oq3xvcOgTTbf9RIky1iIoSZRNflMk3t0nWtMk/mQ67i+yKEQdD0YfHi8JckF8dPiN",
+	"DgU4ZvU6G9qHq88RUU3/5Q+KKjywzxHxIep4UAYcFD9ROsel1uCgpybBW8KGHZoL1aXzzNh2xyPjPTIK",
+	"P8ejssdRsST2FEfFeP4OOizG07r7uDgtjwem9Y4xmDoenT2OjkNuT3l4+E6nh/c/Pvx38V6vBcscT8IB",
+	"TsI3v0eIjJNKIxI8AjNImMxVDh9IG4y+pBA7u5A6LJ+e699MIS8+Vk5ojMAY40rNTfC4GIO7GIR3lbXv",
+	"KlXCIEgM/C+WhDHCuEqMefv2+pKPIdCLpDiNCMJCEK6j0aAXp6sUi4IR/u8Ic4TR6p8UEr8KzFQJ/nsC",
+	"0+MipiJj2snOfElsxJqvTjygA5FU5304/TA7/fH24+XthK/x93/68xjpUrDW1WQWf/+nP333v5FBODQA",
+	"bJKtzZ4EhKKSIOlk5mXSXF/eWIlWoyYzG/l7YDVmsW+LNE7IkdP0yYIraQWozFLgArCni6A2dN63JCoY",
+	"FduDsJklVYVleqnOofhfzY8lqEQ3XrtKjd6uPX9Hk9/c+ejuI7F1hTekUb1jsIsWTchR276jtl0i71ur",
+	"2uVO91S0q6Ztroq6wb/YYfiGcZ8ZE9csJqxv43eUJPGTRJTKvTwqOXe3BpjD8m1O7Zokm16WgA8k2fSy",
+	"A8iGv3ErwE503lz3kd4H0LuPvhyqr3w+IOn30lFWYWvTULpE8FvVT+5N/Ud1497071E2foMTsMlikvTi",
+	"/qpSB7SrPMn0Q+jyAsFYOnqFCq7+RhHUhUhjXTLdd2QuZcPf44XhWfjxxAw4MYC/liuj+v0wJ6bMER3M",
+	"rz93itvY5pVDM0Y4ydKVOiuyWYTTLKURTky2cnmAbLpzHV67zphAURZXdSK1IoRLyriAiojy0KVEaux0",
+	"8StIbS4HtunNTXZBvsZMxQVHa6xTXwkafSFSlSH/gIzpKpW4CljzpmM3EC0zJiFgXNZXzB5Uj3tKHrwq",
+	"EJ25sOpCuHv+9N8iI7CrPR7/3qUZce1sNZVx3+zJlBds1VL65ZwxAm0XyVZVR1SF5+rwvYHDCPfi2MTz",
+	"VwwLEnpVNQbD8ZUNFkm24CjNdPE2c+TgBHOn9GiUsdiW7jJaSBkRD/3toBAar2C0lfBWmC3wiqAoSxIS",
+	"QVE4ZHgaRhGRywDaMece+gNbe1jTaC25xReSC4SXgrAKZ5CV77KUTIz3J0dFGoNSNSErnKB1lkDK0z/I",
+	"pJAGribLuJEb8K/gdDwwuM+37r1i/M70zp2Wm3pkQd0s6IMUamPrmv9kjIeLjOEV6ZHGUlUf97IdeQjz",
+	"9ZZLcSPZ6vI7iKZjN/FFkqlykLZimpoZLXD0hUjDqKxoNbY/RwnmJpFGnuhSkjoXiDKixmRRrFbSDGL6",
+	"QPlNPimNyTWuZAFTPAYLvMCcuJWDpA2UxC5fVAyJsnp92TRGym1PdpXcDIpgKvTEG5rKncAiY61BZPrA",
+	"3epN+B0FOeglH1lDv/AzQ+HmEPGnFlAGxU/qPe4VR2no4fnDKZ/sCPiXfjwKAyMxa1R2WNLvqi4hy/zL",
+	"S6IOTSBKPklqm85fftaU37JVsbs1ecSROM2KVOxdcKMuPR/P8bDkts6R2PUEDz2uqpSGk7627cjyt9sn",
+	"T3SrMn0cz2v4vHZ32RC2IvG+x9tsvaGG4/keeL4bZ2142QUz0gkBr75O391mvkdeL/BZr72AOTq9/TRG",
+	"qgIpicsCyfadaAvUKyjUAJDPMuSqWqcd/lL5x0s55fsf2TJ1rdqA42Ht6Sl7uOMKqhsyoELKf+F7jHQv",
+	"RFNOY3XOVJWUGP0ds/px3WChMxciTqFieYptBa3/SmN6kWVfihy04Bj9o8AJJNVzW8kiKTjH0ZpMkgwU",
+	"SfL/P/x9EmVM/iT7T9yhaha2kpNIHbOuwL6ZoE+UiQInBlZqs91ipkpgV4ahrKyVnbPskfpM2qr6hdmh",
+	"U4WpJ+MlsDOus+1LrKpSxc3x3PcuqeI7fNi5r4aK5FFCSSpecSKK/FWXH4gxAp1enKNT6IhuZUdboFZe",
+	"vGCFznH0RWrAxDYnPnld9YbOz+fwMVSPtPs111zukeT7l8INkdtut12WtphulW2ZI4xS8lDeX6WfRsOs",
+	"ECUEp0WO8iyhESVWclW1v2xaXufChmTkWS7vNwil0iY+achgZEkYSSN9PVWMGohnBYuckpQ05YJgyKga",
+	"Zfm2vNJ+Iot1ln3hxkwCa/ZYUU/l7y+ovK+GZ4+CkccavwPcKCS296zwq45Drwde9eQ0XnN/nV5eOL6D",
+	"nAhB0xUfN87X2ApgMsrKUnoau+VbJ+iWRIzow2ZOlfJwgMKrUrxkY+0etdiq0nxd78JTtdoXUEhdQXKk",
+	"8t7PpZLMq4S4O9GfmOKMfSpb27aGl7echrGrDwFvPicjvPbkU0UzzKhog2NinPLkobb1VP017+pUZNbx",
+	"0ovf7a0UrC34eH566gYbJPwtj9PJr+afXzsfIrg8A30OVt3pttJWHxpwLwG/NSrMxeS1EvqJ6ume+ZVp",
+	"D32xqFGPB6Rv0RGXDJ/ocJywLEmkO1b4NTPN84SSoPyVy7FUGScNvb5DyhOj/DmNN6n6xotEIFy+kUI1",
+	"iucavm8iPj3vAZGIPT4y+jzhsyQBn8FDnwoBoPTWWYOTtU9ZrdOpuNW+taNg9YnysM44QTkWa6TLdKuB",
+	"/yEVrVpFDfroV1HGyKvvJ9/9MPk7Zi9IDa1x9pTnT074W9FEa/QcD3VvVXTlTO2jgzbhA6+c0II+ryq3",
+	"uZH/1qVntv2tvM6c8lvqaeV/IHm84v+FX0e+1R6PQc+nkaHdCjEe6gyc/Or89ZnG3Q+i2rFQl5hzJrwv",
+	"GQ8BPN0tUc55Hu+d4u8YzbL7E8ZHybsQsg41e5UxuqIt2rG3jOAvvFLattPtRzY0UWpQYZZtdZireMjY",
+	"F9NdGTX5WL5SlpjJ/6knjLGrKNhk83JqyhFJZa3cWIXbigxBOS8q77goKTi9J62KgDM91LVe+L9wlf3A",
+	"ko+HrX8xREN4mhZrlL7Lq+jbVSdtOZJj+Z0XCx2RLzLliQfDM4L1nDHElUOVZz5BstYmDOM8dgaXnB6r",
+	"AFhuQtjlZOp9hFNTM1VkX4iNYIOXmplcmYWGlXw2RP+kVVSPBVF/DwVR9zr3kMVIHtZXYCPdthUluxVZ",
+	"LlPUpsJchdEaM8Frhx0yzYRLCoOcRWSjm4Kvb9Ssz2gWPdYR61tHDDZW7jXKza556W3c45kB6YZ70hAw",
+	"XEl2JDZl/ieh7Fkvg6aqkBwlm35crT99eQs1X9BU9CapZcaU3wb02KJNwQXiRIxNEHleJIkJDYgpN7lo",
+	"XBVrGqMfiwVhKeiLpjfnpTAkpMTyQEBQ2GT38lnw05ooWUItTj0ZlhmLpO8WgApijYYdZAoiEWWybKTU",
+	"Tbl9T7NEWWNUAnIt0TxQTqrfjbgiz54KiMfoJ6xK863hojeSHGDe7JlPI/wND9hAp6/6+dojicXxqA7X",
+	"4PY/qm2ihzKVHMAMU9pc4Fd1fmtOYiqb/4JnSSGUKUbbXU4Kzk4WND2JCpZADIF6B8B0bgxBQhecJxOe",
+	"Tf7YMMzoOatWGchC7JtZcYda7hycoiLPCVOrqSymmrHiG5p7zuVsUOPnyQ0+sOoXbu5poufILnYz+Li2",
+	"0h3UhJDu6ZU8bX0sPWVyqIZRx2/BuZAdPsDozyhDViE5UlpPm4qz28Eix36Hl5tE6Y6cIVAmxSUTj9Kw",
+	"CGZMNshSYlKlGflvIi/I2HRUoqL51shQprj/Fm0ITvlY6YvhGmlEB8hhXBvlGBWpoCrcHcCFTIsJwVxe",
+	"E8qgka4qQMsmi0RW25EBBPKKowKtMXfxFsqyaKnxGSU/C8Nerv7OKMdj1XWs4FxUjsV+LPvkV/jjs/zD",
+	"2CVDKqe5oubqqVROzvZUlnkMtTbXnCvQFYQUUIcm5h4ijpnxPD7qrJ4g/gsoZ1+63eB7kp4wYrNx9XLY",
+	"r+Tukj9dymGqZpBuOQQ6zZ2pn1kaqcNzZJ49ZRK1+6yykyHNllc0ucS5ccQFQ7RxWsI1wqrTldF9ud2o",
+	"QKCRQiJTtuqy8ZqkymzGHWDRzfUlPGPlQJBhtRwMkkLDa3ZRUClfc0GTBMUkJymIMBmovDaIEZ4l96Ri",
+	"DJRjSqFJOgK7AEopRy3rAYOfPhTjkz0l3DL/ogItXVXS4gG3hwySIMZR4S4iJNLUSPoZBZsaJHuJN42x",
+	"jue0x3UhsUUaR2oXzVbj0jj5tfyjS+RRVjZ5DCWF9zuHPl4QEny+DcmPe/QzUx7ln6ez2eHG5bMTQROp",
+	"Zusj+9RVjTqtb6moRPdV7eXW3A3cEY+k1WVsfC8yFqtE4Ns/QPB7KmNISOwkCLdDUcFJsmwPXLzUa3kB",
+	"kbgalCN/HhBKqElRV7Go0dJAi+EtEbxKYu3DI1whQJqOtWGCcaF7KmlIKlRc2UQLVVQYiQs2TxkdpVZf",
+	"l9zVQ9SFOMiHl2xRlkbkPyyEEhYcxyTWR0wJdYstKvIYN/Q+nggrAqv+hsdix0wO9lTsYdU7nrAdJKCe",
+	"p2CnC4TKGV/xbRr1uUVUcwTNG8fBTTSPuUCsSAPvZxjlFuZ87qdzCcqRFntye5cIhj6Y57qQgI5fNbVQ",
+	"MHhLcCoyBoXJcVoVWGRenFoYq2bNVMJ8jxPt3i3Hs3np9AzStisXVsnRU9LqxlYqkPwakvNI/X0Cvqbu",
+	"hDhhBMdbuETg8axl/piuCLdPfAO3foI7/fVlA12Vit9tLw0ARcoIjtbSXz34MLYE+5xvYgvEfs9hZ5jj",
+	"2etdIck5f/uy/JNf1V+f5V/9HsC2FIgVZOzBlefHROnJT2vKzYGGlkWpd3XW4Jxcc8LMHBDKS6DsUPDV",
+	"fOjz0CNBqp3y+GB+ygdzD7rv4eHqjFJmpgrLK7KS5W+bxI5cdnjYXC9Sy4uAgAN67obSZTt2hZGxlVyA",
+	"bXLhVF5rMEeHx1JRckfHZUGlkPKkA/woH50vjUt+A3HjeBAOfBAU4XxjceNEygQ93p1/el2J8g9IEkrr",
+	"0zMdgEMNxRPGdR6SszeXcSTs4W9YoKah79hbAdEAWHb2UCLCa53EVRlSH4Vl9045TUa49AsuEtAV2hYq",
+	"tiHNHiboHSRgpmr8MrNTkcpHqGT9S5pSvvYx/nmRvmTB5ftB/Lo42kr7aAqL9Cn4dfVXVqS9k1gEDot1",
+	"11elJWUTSIUpxaWKvNMhns+L9LkJfUjHeZEeVLo/HpKdBHxJlbscFOP9e8LppkiwaElAPpMRbSCwxwwv",
+	"hd+B2ESyZcw6Auu3AjfRldXA/obbs4o4k4qZh7XWTTZmesiKxKlyG5dN7WSqCcCgktRmhVAPjG7j1a3G",
+	"hbHz3Oh5X4D5SgWSaQD3LbEcHvR4AjsDTzSNlLkDHSoZfAz/UZCiV2Ix1VCeGpm6cMXkqpCl3kYGDKg0",
+	"1SyaDqkxyQPXr3ZVEnZDV3qUSrllOU+SrfQxI2Kt3SVAqZnjgvvkNtcl4r/V2p7ZRlaF5kjhPZ8Y1u5j",
+	"91eT4O5UfvIr/P/rCRBP+L65kZ+5fjRkEeEcfD6XUIaJFASirCuMHJ0LspEF/kmOFkS2hoaxY6hSPSnX",
+	"lDuWT5ZyaeBeQR0VfpGmui54zkuP0kehUgzkGU09D3MAvEJvTybQwfIO5UAEoB9PSo8YFrnhbkBw7bAc",
+	"4KwwwotNy2GZw3f/aVGkHjo0zTc3DHWk39+Tc47c8QMTsHa8DMo0Z2RRrBBJY+CiOqcFTr7U/RXSGLhu",
+	"w/Wz4r+JIaMGijPrEwrlXcZA7vAMV++MzdjKMFQgnPIHwkhsD0Xp9Ayh+muwVDxgjvgXmuckRv9mHjU6",
+	"01/Lc2eM0kygpdyqMYpwBOoC7hSJQT+8/uHfJ+gqE+DnQblNvKYGhD7xG9teOeVlqcx/xrKF8f/A6MNs",
+	"ema8/gLpwWAr7hiOyBN6ZMOk06E1zXS/T5XSZr27ybQI+/GOElVH1tHNOgBRaJ09IOyeHr0bfA/GcZJj",
+	"EU7ccYslw+JIMByp6p3l7GN7nuUQ2hOrjOThiIo/VFPwuBznDfp5BBkE3gi8+nkkT6L+4T+Vj9TPIxgf",
+	"fuJj9PNIvsJyANdW8DuPTW2+kyVNiO6iQyjSGP08Mi197YCvAZNyixVy7WMCGOfr7IFrJXzp2OtxKi79",
+	"tSwKrO87YLoANq/YqFb1qwG5VzSonmmxfmp+sr9wcDzgOx5w5xDBwdrnkPMI97KZyna6sAevneRBhtLb",
+	"CKdzNcyTUaxiF/sqLhzIj/TaU2vhUo1DnbckKhgV21bX3kY9GeCrMGLtJrHl+lTxPsG1E+0ETdMt9EgJ",
+	"QwoUKPVGBdeD8rGuUQPjUpMEWlWoVT7Bqk+Tms/TFXGp4hmV0iUQe3nQusMcCbz7sabLBjpE7qfxTv57",
+	"8qv8Xy/DZ2W60uVwSSES22/TPDiNdrNcCeQB/FmPBDnYFrkfNepmJ/CeTigXQYIsRYP7IkkJwwuaUAHm",
+	"R9PXedDbHEkNM2OZCIk85pQB5/XZ5uV8n5yZtlML4jNbNPxQHSl2QLinS0LbkoCGiQwW9RB/XBlRkmF7",
+	"hq8lZPmyKb7KIOXFVksTJg0XThWlbqH2g0wAqViv7OqSfuNYMIISshRIGsA1COa8IbmiQpeWtN/uCXyD",
+	"KhYwvCtOjaGtHHSD2ReV81WFqeqbAFl8GEM7l2/1Gqqr6TRkq78XXNf2MPXL1XJDMUR+8p+lz1swPHQo",
+	"9xCPWhZ6POzdh91irH46D3FNnfxq//mZyB3pTjkmI6lV/Hbg0G7HFdFKncP2dGPf/ix0i164Mu0xqOhJ",
+	"ngKSmJq3jlFpdlxovUhd3xBBeWy6WjGysrGwpl81FkRlZHV9vrCjb634g9nsxUXK6SqVJvoiVW9psIqs",
+	"8T1BEaNyz5L6ZSeDU76Ya0fXKTcvd7ACmUe5vF4AQ5Gg9wR9mv1FAbwh+sqD1hooFbaLIziIrfVXDHJv",
+	"NNJeQBaQGkjHG6N/DZSGlBSqh9LzTBlD3glY9l6RexqpQ9R9XQA49J8EJXRDrRgH49RDtlWYVuimOJVd",
+	"ZnrmY3mU307kqNprQzP710gBauqmo7Hj116hPymieGGyvLVUn9rgVF2SYkNSIeNBdHLgLPVXr3thtOoB",
+	"58hN+3HTgcQbKMCyoWIQ7U7Q1PWS/Xu2UCCYxO24WlIOzMEwXFzNpdpwPVEVt/XMhVgx8qBoXIJo3utU",
+	"2qOE9nqfICCcysiYEbQkAuazIpudC7pJZ5XUJifTIEr/wwmyKWZRkSoPGCexuBw6le94tWCfh+7tNz9f",
+	"A1/O3uO1h3P78bjuXH9l0HHtJ/EwAsYynMAGdgedOx08eXRcFHMksqqHmLyxgjEfvLy93Fr/yrfCnZWR",
+	"JWEkjUAOZERqvpS7hap4hCumOqARkw+3kjxHmfmgGBR42pcaM1CLqbHBIZmC+1iRl5DjJFGAU58vRiaw",
+	"IB/1XKcOgp/vDHugOUiAyvHg9lAGAD0gswWoShG7H12tvX7FioS8Kgt/9TDQWOOL8weSw3D/fW1cKMe1",
+	"aq+axkisHTzQnORE7pH5wq29B86Umco831XGrAIq/OmKGnYdfoPPjRphXiTkU7nif9ky+97lHs9cT0OS",
+	"S9no3iWXwxy6AUet7XR1Uvqzh2i5sBypbwfqG5wAYhrHKv1DQlBMIhqrsFsp5VSYd41Pq1eLSqRcEb3k",
+	"i8sA5JQCouA3NfvL6cXHs5maDdIbQtJaMIPSWhZOqRY4vyrbK6/+VFtKIflgOcIEvbW+9xpoXdNOVeke",
+	"SzEt1XNsVeFM/c5bkGXGyNjoLCgrrxQl5mmvLMydkx2ySDr0+4wymAPFXmbHyjjHw9g7saF7IA93B5z8",
+	"Kv/XZV1UukJeg2KYhvjwVNzDt7tIyNFi+JRpCA9HpYw8YLYJRya+05dFqfiyWXBbdHdl7iHFllUWdKkB",
+	"U9EdNOUCpxHRHLw6woJE2YZUMtFChFbBt+qdX1XCVf14K9VstG9LxjbYWl3cmIwxsqEiyMSE6DARAPNM",
+	"3kvK3CiL2o4RxIq8MfO/0U+Uv71Rg9J09UstUiTFG/Kfuhl8SvMNLAJUgkZ/KIv81XSd9lJDC3lTOkWa",
+	"udToJKWXHGU6Z40nlFntrn3Xyw17bh2hhil8uX3fUzloBzrebp0RzgpV5RGLNCXszThOfgXy7Jd6qUyt",
+	"JCqnGAyzhsyNskBkaFGekCrT8dqbKlSuV/x0j34131u5iINYqo7UPchEVaVslNvt353CFbX2IuyP84uQ",
+	"UoymaIlpkklrDkTpKfewnFEJveypLkfHUltaXp1CBmuCE7FWiSvs1SB7V148ysQF5dvajsitWtozKgyq",
+	"kBypfCCVc7OBu5N3wfoac4C6fXoESAwJlC2yQEUmCZKpxlSn+zKtC8xQioIb7UGmaH5bykvl7WAPi2vz",
+	"MRJeOYeM5UJkk4utKqUTUy7FSW7PpNeyaohTgvUCzDESjL3sMMfDtosZ1bJtS/aaHgafuXvy2EMJXPNi",
+	"pCkiyyWJ1EukNXDW9tLlMJX3Y9VjP2KZriUSZ1GhpsBCKD2alq3C1QEhYIU83lrwfmNRuBXYjwegp3La",
+	"6147MLpGkRh4AVznJJVjZQyd3k7fwbiGGCUJ9gvOvVsTBxrD8+04UPkszxNaknU9CL2aTEFb+PUTHZ4i",
+	"drBKhItNfex1ePuYy9Qvn8jjme78jCdkaNBLCfR+kS7uOMcj1hnZAkcD4co5GO6WfE8eT369J4+fzRDd",
+	"WmZzJKsn0JqDuorEPgeR35dzHjXNT6lp3o84H8hinWVful/RcN9kS/ST6oAkkdKENyhQtvvJDPrSPTq6",
+	"2/KMiWsWE9a38TtKkrhXY4JZtL4jbB+xyWD6t8TODygAOYRm6N7+1JaDRJF0Fykrk6Nu9YzPTA3BXle/",
+	"HeN3Ryf1XfQQSh8GefKr/tdnK/myHrZihFE5te+uPix5dbMdvYpzu4jjXf1Ed3UrCXZEFHWxqvdE/OYJ",
+	"6ffLoiq757/Iij2IQxXpenH0cbwFn5DE6jRwyFvwhDySqGj3Wq/T6sx0MVQLD4y218SsnOQlkPALdDM3",
+	"e2kx9ft+FVQI5hvRe/nd/tYr3VvwGLTc7Lbtb4T+H2pg768WqiPidy0quOTwtNR9wohgdLUirI3OVYsm",
+	"pXuSHt+ptkc6P9J5mXonTBQBauc5jgg/+RX+rwjdJgHnAouwcCI9N0y8N5JmSH++TdMEWrzL2G2+S75/",
+	"AG8ohUrV/xmEQPTsIDKn+U5EWFnt0VzUz/+nSkWuOh6Is5tSu4LRcJKArdNMFKDUJLENnoZATSSxy0N/",
+	"J8r77tYqU5auuNFvkeACf7fN+5948ogjcZoV6d6+GIZ0joe+pxuGe9YGH/gT8phnLJyQdwafqxUmQuU9",
+	"KdG+2jARwhyd3n5SHhlM1rPAjCDlKuXmRImxwAvMCeRTUKAq970NTRIzi51RjqFA1l5+WSFQDrEVTV6k",
+	"gP/t8KJ/IY6x16Wv9u3IALoZgMLUTiwgSjDdvNrgPKfpqk8UunqlCahPdU9jwhAMwZEZw7ADmMTvJHgq",
+	"e1yaOQ9wHne+ZiqQHEmt511T2/GhEemXOOcIq1F01pxsic7PkMi+kJQjynlRll8zKXxIjIgEL2eUe8hw",
+	"jMhkNZF+eZSRSGRsq+LwxuA0iFiWEJSllfp3Dp2iTIZcLFGald8pRyt6T9Ix9EsSXdrDLFBdaZbq4UrS",
+	"1bNjldMLp3ZRcjDyCGmKdEyeAwi0CAWcuxR6sKMyNCTPgWEv20d1oONp6zptlziXVBTguZqyDRlJEm9z",
+	"PO/k/ie/wt+f9d/9A9Gr/GCC5hXKLg+0it2A0r0qaCknbEO5kulURj3I3qCqNQTTmx74RHQLNpEz49Gx",
+	"8CkdC6uUNZC6Y7LERSJelTy7h3yjO7mvGU4EytLyshhDeqm8VrrPL+qcqeEccJ9T3GlAc2TCPUWeJlns",
+	"TYwnv2ry+SzJp5XVfkw58dNnTYyp1cTR+QtUZJ3K+VO2pemaMNoyrPyWEswIFwinEeEiYyGmXKWs7dPw",
+	"5cqD88iUv/E5ACL0EsvgFNWqpmQ1HVROc5LQlFQfkCgvFgnl60aVJ5fCpSBks+iiOJNpoVK8IY0KBA0q",
+	"r7N28w4Qa8IgvVWapcDvex4GvbTf+mmowX+8JXrVV5c7P/B8eH3qbvfh9SoazeTCrYSjKR2sGWtTcCGT",
+	"Z3jqCfuOGK2ekkpBK30c9JPYQK0C7LgNsCsWWq8L5T+gxkeahdZIGcoeUu8avdHYL+3EDXxhNw7cHoHc",
+	"x8O7UyT3kIMbkPF6PzSMBbUcNGRCPezD4dtY/QylDer0r29xZSQqGKf3ZN9X2/EkD3yszX2PtC5LiK2G",
+	"RTc5jvoUJ63kpgpbQFUlCadSFUdLuWp585YB5e4tJy2a+r41CRoSgphUHo8RoWJNmLSr4hTdvr2+RBZV",
+	"8l7G1WTBAIcuMzdGOMnSlbKr2pJdXPZa0oRwqCuhJYeNmwyiuq5SDDAZhmoCBAWdCbs3Q3l5m05Bea6Q",
+	"/SS8TW2snnhgrzlOB/e5jdZkM7RTpczfPpyjguAj6+hmHbLYau1cY8itYnIvOmdRH69wrLM+2RyAwaq2",
+	"nt8adpWxDU7oP+UzE7IiyVNlLEmly0PBbX0Lk/+7zPBJooxvufAdtVM1vzbjS4a4Q+oH6KtH2ks2rQxF",
+	"+bO5lR4qrlOhBDnYNfRgf/rlK/SBMRRvq2tDrKxZsGT0ZnSCc3py/x0cez1avc/05hzeVRGYCMeogMia",
+	"sUpfVVFRpnhDyknkb1/HodFWROghsONJoEconQtaB0CxDqXJlijWiVGbg+mUqTuMuSbJxjeizLy6y3iX",
+	"F2iTxSTxjXkJH/oM6t2HhzIwXA9onYXDI6WGGwAb0MzDcoFyKEte4aEYAa8QOc4/CgLKLlO308l1Uw5p",
+	"OdjXX77+vwMAqLPDtF/MAwA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -11,26 +11,188 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ArtifactDetailSectionStatus.
+const (
+	ArtifactDetailSectionStatusFAILURE ArtifactDetailSectionStatus = "FAILURE"
+	ArtifactDetailSectionStatusSUCCESS ArtifactDetailSectionStatus = "SUCCESS"
+	ArtifactDetailSectionStatusTIMEOUT ArtifactDetailSectionStatus = "TIMEOUT"
+)
+
 // Defines values for AuthType.
 const (
 	AuthTypeAccessKeySecretKey AuthType = "AccessKeySecretKey"
 	AuthTypeAnonymous          AuthType = "Anonymous"
+	AuthTypeAwsIam             AuthType = "AwsIam"
+	AuthTypeToken              AuthType = "Token"
 	AuthTypeUserPassword       AuthType = "UserPassword"
 )
 
+// Defines values for BadgeStyle.
+const (
+	BadgeStyleFlat        BadgeStyle = "flat"
+	BadgeStyleFlatSquare  BadgeStyle = "flat-square"
+	BadgeStyleForTheBadge BadgeStyle = "for-the-badge"
+)
+
+// Defines values for BadgeType.
+const (
+	BadgeTypeDownloads BadgeType = "downloads"
+	BadgeTypeScan      BadgeType = "scan"
+	BadgeTypeVersion   BadgeType = "version"
+)
+
+// Defines values for BulkRegistryItemStatus.
+const (
+	BulkRegistryItemStatusCREATED BulkRegistryItemStatus = "CREATED"
+	BulkRegistryItemStatusFAILED  BulkRegistryItemStatus = "FAILED"
+	BulkRegistryItemStatusSKIPPED BulkRegistryItemStatus = "SKIPPED"
+)
+
+// Defines values for CachePrewarmStatus.
+const (
+	CachePrewarmStatusCACHED  CachePrewarmStatus = "CACHED"
+	CachePrewarmStatusFAILED  CachePrewarmStatus = "FAILED"
+	CachePrewarmStatusPENDING CachePrewarmStatus = "PENDING"
+)
+
+// Defines values for CleanupPolicyType.
+const (
+	CleanupPolicyTypeAGE       CleanupPolicyType = "AGE"
+	CleanupPolicyTypeNOTPULLED CleanupPolicyType = "NOT_PULLED"
+)
+
 // Defines values for ClientSetupStepType.
 const (
 	ClientSetupStepTypeGenerateToken ClientSetupStepType = "GenerateToken"
 	ClientSetupStepTypeStatic        ClientSetupStepType = "Static"
 )
 
+// Defines values for ClientType.
+const (
+	ClientTypeBROWSER    ClientType = "BROWSER"
+	ClientTypeCONTAINERD ClientType = "CONTAINERD"
+	ClientTypeDOCKER     ClientType = "DOCKER"
+	ClientTypeMAVEN      ClientType = "MAVEN"
+	ClientTypeOTHER      ClientType = "OTHER"
+	ClientTypePIP        ClientType = "PIP"
+)
+
+// Defines values for DeletedBlobDisposition.
+const (
+	DeletedBlobDispositionPURGED      DeletedBlobDisposition = "PURGED"
+	DeletedBlobDispositionQUEUEDFORGC DeletedBlobDisposition = "QUEUED_FOR_GC"
+	DeletedBlobDispositionSHARED      DeletedBlobDisposition = "SHARED"
+)
+
+// Defines values for GrantablePermission.
+const (
+	GrantablePermissionArtifactsDelete   GrantablePermission = "artifacts_delete"
+	GrantablePermissionArtifactsDownload GrantablePermission = "artifacts_download"
+	GrantablePermissionArtifactsUpload   GrantablePermission = "artifacts_upload"
+	GrantablePermissionRegistryView      GrantablePermission = "registry_view"
+)
+
+// Defines values for LatestVersionStrategy.
+const (
+	LatestVersionStrategyLASTPUSHED LatestVersionStrategy = "LAST_PUSHED"
+	LatestVersionStrategyREGEX      LatestVersionStrategy = "REGEX"
+	LatestVersionStrategySEMVER     LatestVersionStrategy = "SEMVER"
+)
+
+// Defines values for MTLSMode.
+const (
+	MTLSModeALL      MTLSMode = "ALL"
+	MTLSModeDISABLED MTLSMode = "DISABLED"
+	MTLSModePUSH     MTLSMode = "PUSH"
+)
+
+// Defines values for MirrorSyncArtifactStatus.
+const (
+	MirrorSyncArtifactStatusFAILED  MirrorSyncArtifactStatus = "FAILED"
+	MirrorSyncArtifactStatusPENDING MirrorSyncArtifactStatus = "PENDING"
+	MirrorSyncArtifactStatusSKIPPED MirrorSyncArtifactStatus = "SKIPPED"
+	MirrorSyncArtifactStatusSYNCED  MirrorSyncArtifactStatus = "SYNCED"
+)
+
+// Defines values for MirrorSyncRunStatus.
+const (
+	MirrorSyncRunStatusFAILED    MirrorSyncRunStatus = "FAILED"
+	MirrorSyncRunStatusPENDING   MirrorSyncRunStatus = "PENDING"
+	MirrorSyncRunStatusRUNNING   MirrorSyncRunStatus = "RUNNING"
+	MirrorSyncRunStatusSUCCEEDED MirrorSyncRunStatus = "SUCCEEDED"
+)
+
+// Defines values for MirrorSyncTrigger.
+const (
+	MirrorSyncTriggerMANUAL    MirrorSyncTrigger = "MANUAL"
+	MirrorSyncTriggerSCHEDULED MirrorSyncTrigger = "SCHEDULED"
+)
+
+// Defines values for PackageRuleAction.
+const (
+	PackageRuleActionEXCLUDE PackageRuleAction = "EXCLUDE"
+	PackageRuleActionINCLUDE PackageRuleAction = "INCLUDE"
+)
+
 // Defines values for PackageType.
 const (
-	PackageTypeDOCKER  PackageType = "DOCKER"
-	PackageTypeGENERIC PackageType = "GENERIC"
-	PackageTypeHELM    PackageType = "HELM"
-	PackageTypeMAVEN   PackageType = "MAVEN"
-	PackageTypePYTHON  PackageType = "PYTHON"
+	PackageTypeALPINE    PackageType = "ALPINE"
+	PackageTypeCOCOAPODS PackageType = "COCOAPODS"
+	PackageTypeCOMPOSER  PackageType = "COMPOSER"
+	PackageTypeCONAN     PackageType = "CONAN"
+	PackageTypeCONDA     PackageType = "CONDA"
+	PackageTypeCRAN      PackageType = "CRAN"
+	PackageTypeCRATE     PackageType = "CRATE"
+	PackageTypeDEB       PackageType = "DEB"
+	PackageTypeDOCKER    PackageType = "DOCKER"
+	PackageTypeGALAXY    PackageType = "GALAXY"
+	PackageTypeGEMS      PackageType = "GEMS"
+	PackageTypeGENERIC   PackageType = "GENERIC"
+	PackageTypeGO        PackageType = "GO"
+	PackageTypeHELM      PackageType = "HELM"
+	PackageTypeHEX       PackageType = "HEX"
+	PackageTypeHOMEBREW  PackageType = "HOMEBREW"
+	PackageTypeMAVEN     PackageType = "MAVEN"
+	PackageTypeMODEL     PackageType = "MODEL"
+	PackageTypeNPM       PackageType = "NPM"
+	PackageTypeNUGET     PackageType = "NUGET"
+	PackageTypePUB       PackageType = "PUB"
+	PackageTypePUPPET    PackageType = "PUPPET"
+	PackageTypePYTHON    PackageType = "PYTHON"
+	PackageTypeRPM       PackageType = "RPM"
+	PackageTypeSWIFT     PackageType = "SWIFT"
+	PackageTypeTERRAFORM PackageType = "TERRAFORM"
+	PackageTypeVAGRANT   PackageType = "VAGRANT"
+)
+
+// Defines values for RegistryConfigAction.
+const (
+	RegistryConfigActionCREATE RegistryConfigAction = "CREATE"
+	RegistryConfigActionDELETE RegistryConfigAction = "DELETE"
+	RegistryConfigActionUPDATE RegistryConfigAction = "UPDATE"
+)
+
+// Defines values for RegistryConfigResource.
+const (
+	RegistryConfigResourcePERMISSION RegistryConfigResource = "PERMISSION"
+	RegistryConfigResourceREGISTRY   RegistryConfigResource = "REGISTRY"
+	RegistryConfigResourceWEBHOOK    RegistryConfigResource = "WEBHOOK"
+)
+
+// Defines values for RegistryQueueName.
+const (
+	RegistryQueueNameCleanup          RegistryQueueName = "cleanup"
+	RegistryQueueNameContentIndex     RegistryQueueName = "content_index"
+	RegistryQueueNameGcBlob           RegistryQueueName = "gc_blob"
+	RegistryQueueNameGcManifest       RegistryQueueName = "gc_manifest"
+	RegistryQueueNameStorageMigration RegistryQueueName = "storage_migration"
+)
+
+// Defines values for RegistryRole.
+const (
+	RegistryRoleCONTRIBUTOR RegistryRole = "CONTRIBUTOR"
+	RegistryRoleMAINTAINER  RegistryRole = "MAINTAINER"
+	RegistryRoleREADER      RegistryRole = "READER"
 )
 
 // Defines values for RegistryType.
@@ -39,6 +201,28 @@ const (
 	RegistryTypeVIRTUAL  RegistryType = "VIRTUAL"
 )
 
+// Defines values for ResolveOutcome.
+const (
+	ResolveOutcomeBLOCKED          ResolveOutcome = "BLOCKED"
+	ResolveOutcomeCACHEHIT         ResolveOutcome = "CACHE_HIT"
+	ResolveOutcomeCACHEMISS        ResolveOutcome = "CACHE_MISS"
+	ResolveOutcomeERROR            ResolveOutcome = "ERROR"
+	ResolveOutcomeLOCALHIT         ResolveOutcome = "LOCAL_HIT"
+	ResolveOutcomeNOTFOUND         ResolveOutcome = "NOT_FOUND"
+	ResolveOutcomeSKIPPED          ResolveOutcome = "SKIPPED"
+	ResolveOutcomeUPSTREAMHIT      ResolveOutcome = "UPSTREAM_HIT"
+	ResolveOutcomeUPSTREAMNOTFOUND ResolveOutcome = "UPSTREAM_NOT_FOUND"
+)
+
+// Defines values for ScanSeverity.
+const (
+	ScanSeverityCRITICAL ScanSeverity = "CRITICAL"
+	ScanSeverityHIGH     ScanSeverity = "HIGH"
+	ScanSeverityLOW      ScanSeverity = "LOW"
+	ScanSeverityMEDIUM   ScanSeverity = "MEDIUM"
+	ScanSeverityUNKNOWN  ScanSeverity = "UNKNOWN"
+)
+
 // Defines values for SectionType.
 const (
 	SectionTypeINLINE SectionType = "INLINE"
@@ -52,11 +236,32 @@ const (
 	StatusSUCCESS Status = "SUCCESS"
 )
 
+// Defines values for StorageObjectKind.
+const (
+	StorageObjectKindCONFIG StorageObjectKind = "CONFIG"
+	StorageObjectKindFILE   StorageObjectKind = "FILE"
+	StorageObjectKindLAYER  StorageObjectKind = "LAYER"
+)
+
+// Defines values for StorageObjectStatus.
+const (
+	StorageObjectStatusMISSING StorageObjectStatus = "MISSING"
+	StorageObjectStatusPRESENT StorageObjectStatus = "PRESENT"
+	StorageObjectStatusUNKNOWN StorageObjectStatus = "UNKNOWN"
+)
+
+// Defines values for TerraformArtifactKind.
+const (
+	TerraformArtifactKindMODULE   TerraformArtifactKind = "MODULE"
+	TerraformArtifactKindPROVIDER TerraformArtifactKind = "PROVIDER"
+)
+
 // Defines values for Trigger.
 const (
-	TriggerARTIFACTCREATION     Trigger = "ARTIFACT_CREATION"
-	TriggerARTIFACTDELETION     Trigger = "ARTIFACT_DELETION"
-	TriggerARTIFACTMODIFICATION Trigger = "ARTIFACT_MODIFICATION"
+	TriggerARTIFACTCREATION      Trigger = "ARTIFACT_CREATION"
+	TriggerARTIFACTDELETION      Trigger = "ARTIFACT_DELETION"
+	TriggerARTIFACTMODIFICATION  Trigger = "ARTIFACT_MODIFICATION"
+	TriggerBASEIMAGEMODIFICATION Trigger = "BASE_IMAGE_MODIFICATION"
 )
 
 // Defines values for UpstreamConfigSource.
@@ -64,10 +269,45 @@ const (
 	UpstreamConfigSourceAwsEcr       UpstreamConfigSource = "AwsEcr"
 	UpstreamConfigSourceCustom       UpstreamConfigSource = "Custom"
 	UpstreamConfigSourceDockerhub    UpstreamConfigSource = "Dockerhub"
+	UpstreamConfigSourceGoogleMaven  UpstreamConfigSource = "GoogleMaven"
 	UpstreamConfigSourceMavenCentral UpstreamConfigSource = "MavenCentral"
+	UpstreamConfigSourceNpmjs        UpstreamConfigSource = "Npmjs"
 	UpstreamConfigSourcePyPi         UpstreamConfigSource = "PyPi"
 )
 
+// Defines values for UpstreamHealthStatus.
+const (
+	UpstreamHealthStatusHEALTHY   UpstreamHealthStatus = "HEALTHY"
+	UpstreamHealthStatusUNHEALTHY UpstreamHealthStatus = "UNHEALTHY"
+	UpstreamHealthStatusUNKNOWN   UpstreamHealthStatus = "UNKNOWN"
+)
+
+// Defines values for VersionScheme.
+const (
+	VersionSchemeALPINE   VersionScheme = "ALPINE"
+	VersionSchemeDEBIAN   VersionScheme = "DEBIAN"
+	VersionSchemeGENERIC  VersionScheme = "GENERIC"
+	VersionSchemeMAVEN    VersionScheme = "MAVEN"
+	VersionSchemePEP440   VersionScheme = "PEP440"
+	VersionSchemeRPM      VersionScheme = "RPM"
+	VersionSchemeRUBYGEMS VersionScheme = "RUBYGEMS"
+	VersionSchemeSEMVER   VersionScheme = "SEMVER"
+)
+
+// Defines values for VexFormat.
+const (
+	VexFormatCSAF    VexFormat = "CSAF"
+	VexFormatOPENVEX VexFormat = "OPENVEX"
+)
+
+// Defines values for VexStatus.
+const (
+	VexStatusAffected           VexStatus = "affected"
+	VexStatusFixed              VexStatus = "fixed"
+	VexStatusNotAffected        VexStatus = "not_affected"
+	VexStatusUnderInvestigation VexStatus = "under_investigation"
+)
+
 // Defines values for WebhookExecResult.
 const (
 	WebhookExecResultFATALERROR     WebhookExecResult = "FATAL_ERROR"
@@ -87,6 +327,27 @@ const (
 	GetAllRegistriesParamsTypeVIRTUAL  GetAllRegistriesParamsType = "VIRTUAL"
 )
 
+// AccessGrant defines model for AccessGrant.
+type AccessGrant struct {
+	CreatedAt   int64                 `json:"createdAt"`
+	ExpiresAt   int64                 `json:"expiresAt"`
+	Id          int64                 `json:"id"`
+	Permissions []GrantablePermission `json:"permissions"`
+	Principal   string                `json:"principal"`
+	Reason      *string               `json:"reason,omitempty"`
+}
+
+// AccessGrantRequest defines model for AccessGrantRequest.
+type AccessGrantRequest struct {
+	// DurationMinutes How long the grant lasts, at most 30 days.
+	DurationMinutes int64                 `json:"durationMinutes"`
+	Permissions     []GrantablePermission `json:"permissions"`
+
+	// Principal UID of the user or service account to grant access to.
+	Principal string  `json:"principal"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
 // AccessKeySecretKey defines model for AccessKeySecretKey.
 type AccessKeySecretKey struct {
 	AccessKey                 *string `json:"accessKey,omitempty"`
@@ -98,9 +359,72 @@ type AccessKeySecretKey struct {
 	SecretKeySpacePath        *string `json:"secretKeySpacePath,omitempty"`
 }
 
+// AlpineArtifactDetailConfig Config for alpine package artifact details
+type AlpineArtifactDetailConfig struct {
+	// Depends Dependencies of the package, as listed in APKINDEX
+	Depends     *[]string            `json:"depends,omitempty"`
+	Description *string              `json:"description,omitempty"`
+	License     *string              `json:"license,omitempty"`
+	Maintainer  *string              `json:"maintainer,omitempty"`
+	Origin      *string              `json:"origin,omitempty"`
+	Packages    *[]AlpinePackageFile `json:"packages,omitempty"`
+	PullCommand *string              `json:"pullCommand,omitempty"`
+	Url         *string              `json:"url,omitempty"`
+}
+
+// AlpinePackageFile Alpine package published for an architecture
+type AlpinePackageFile struct {
+	Architecture  string `json:"architecture"`
+	FileName      string `json:"fileName"`
+	InstalledSize *int64 `json:"installedSize,omitempty"`
+	Sha256        string `json:"sha256"`
+}
+
+// AnnotatedManifest Docker manifest with its annotations
+type AnnotatedManifest struct {
+	// Annotations OCI annotations of a manifest or image index
+	Annotations Annotations `json:"annotations"`
+	CreatedAt   *string     `json:"createdAt,omitempty"`
+	Digest      string      `json:"digest"`
+	ImageName   string      `json:"imageName"`
+	Tags        []string    `json:"tags"`
+}
+
+// Annotations OCI annotations of a manifest or image index
+type Annotations map[string]string
+
 // Anonymous defines model for Anonymous.
 type Anonymous interface{}
 
+// ArtifactClass A Java class compiled into a jar of an artifact
+type ArtifactClass struct {
+	// Archive File name of the jar
+	Archive  string `json:"archive"`
+	Artifact string `json:"artifact"`
+
+	// ClassName Fully qualified name of the class, e.g. org.example.Outer$Inner
+	ClassName          string `json:"className"`
+	RegistryIdentifier string `json:"registryIdentifier"`
+	Version            string `json:"version"`
+}
+
+// ArtifactContent A file inside an archive or image layer of an artifact
+type ArtifactContent struct {
+	// Archive File name of the archive, the layer digest for images
+	Archive  string `json:"archive"`
+	Artifact string `json:"artifact"`
+
+	// Path Path of the file inside the archive
+	Path               string `json:"path"`
+	RegistryIdentifier string `json:"registryIdentifier"`
+
+	// Size Uncompressed size of the file in bytes
+	Size int64 `json:"size"`
+
+	// Version Version of the artifact, the manifest digest for images
+	Version string `json:"version"`
+}
+
 // ArtifactDetail Artifact Detail
 type ArtifactDetail struct {
 	CreatedAt     *string `json:"createdAt,omitempty"`
@@ -111,11 +435,28 @@ type ArtifactDetail struct {
 
 	// PackageType refers to package
 	PackageType PackageType `json:"packageType"`
-	Size        *string     `json:"size,omitempty"`
-	Version     string      `json:"version"`
-	union       json.RawMessage
+
+	// Sections Sections added by the artifact detail enrichers
+	Sections *[]ArtifactDetailSection `json:"sections,omitempty"`
+	Size     *string                  `json:"size,omitempty"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	Version   string `json:"version"`
+	union     json.RawMessage
+}
+
+// ArtifactDetailSection Section of details added to an artifact detail by an enricher
+type ArtifactDetailSection struct {
+	Data   *map[string]interface{}     `json:"data,omitempty"`
+	Error  *string                     `json:"error,omitempty"`
+	Name   string                      `json:"name"`
+	Status ArtifactDetailSectionStatus `json:"status"`
 }
 
+// ArtifactDetailSectionStatus defines model for ArtifactDetailSection.Status.
+type ArtifactDetailSectionStatus string
+
 // ArtifactLabelRequest defines model for ArtifactLabelRequest.
 type ArtifactLabelRequest struct {
 	Labels []string `json:"labels"`
@@ -138,10 +479,13 @@ type ArtifactMetadata struct {
 
 // ArtifactStats Harness Artifact Stats
 type ArtifactStats struct {
-	DownloadCount    *int64 `json:"downloadCount,omitempty"`
-	DownloadSize     *int64 `json:"downloadSize,omitempty"`
-	TotalStorageSize *int64 `json:"totalStorageSize,omitempty"`
-	UploadSize       *int64 `json:"uploadSize,omitempty"`
+	DownloadCount *int64 `json:"downloadCount,omitempty"`
+	DownloadSize  *int64 `json:"downloadSize,omitempty"`
+
+	// DownloadsByClientType Downloads by the kind of client, told by the User-Agent, most downloaded first
+	DownloadsByClientType *[]ClientTypeDownloadStats `json:"downloadsByClientType,omitempty"`
+	TotalStorageSize      *int64                     `json:"totalStorageSize,omitempty"`
+	UploadSize            *int64                     `json:"uploadSize,omitempty"`
 }
 
 // ArtifactSummary Harness Artifact Summary
@@ -150,7 +494,10 @@ type ArtifactSummary struct {
 	DownloadsCount *int64    `json:"downloadsCount,omitempty"`
 	ImageName      string    `json:"imageName"`
 	Labels         *[]string `json:"labels,omitempty"`
-	ModifiedAt     *string   `json:"modifiedAt,omitempty"`
+
+	// LatestVersion Latest version of the artifact, picked by the latest version strategy of the registry.
+	LatestVersion *string `json:"latestVersion,omitempty"`
+	ModifiedAt    *string `json:"modifiedAt,omitempty"`
 
 	// PackageType refers to package
 	PackageType PackageType `json:"packageType"`
@@ -165,33 +512,183 @@ type ArtifactVersionMetadata struct {
 	Name           string  `json:"name"`
 
 	// PackageType refers to package
-	PackageType        *PackageType `json:"packageType,omitempty"`
-	PullCommand        *string      `json:"pullCommand,omitempty"`
-	RegistryIdentifier string       `json:"registryIdentifier"`
-	RegistryPath       string       `json:"registryPath"`
-	Size               *string      `json:"size,omitempty"`
+	PackageType *PackageType `json:"packageType,omitempty"`
+
+	// Platforms Platforms (os/architecture[/variant]) the tag is available for
+	Platforms          *[]string `json:"platforms,omitempty"`
+	PullCommand        *string   `json:"pullCommand,omitempty"`
+	RegistryIdentifier string    `json:"registryIdentifier"`
+	RegistryPath       string    `json:"registryPath"`
+	Size               *string   `json:"size,omitempty"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+
+	// UniqueDownloadsCount Downloads counted once per client within the deduplication window, excluding resumed downloads
+	UniqueDownloadsCount *int64 `json:"uniqueDownloadsCount,omitempty"`
 }
 
 // ArtifactVersionSummary Docker Artifact Version Summary
 type ArtifactVersionSummary struct {
-	ImageName string `json:"imageName"`
+	Description *string `json:"description,omitempty"`
+	ImageName   string  `json:"imageName"`
+	License     *string `json:"license,omitempty"`
 
 	// PackageType refers to package
 	PackageType PackageType `json:"packageType"`
-	Version     string      `json:"version"`
+
+	// Summary One line summary of the package, for package types which declare one
+	Summary *string `json:"summary,omitempty"`
+
+	// Url Homepage of the package
+	Url     *string `json:"url,omitempty"`
+	Version string  `json:"version"`
 }
 
 // AuthType Authentication type
 type AuthType string
 
+// AwsIam AWS credentials of the server's environment, such as an instance profile or a web identity token, for AWS ECR upstreams. Nothing is stored for them.
+type AwsIam struct {
+	// RoleArn role assumed with the credentials of the environment, they're used directly when it's empty
+	RoleArn *string `json:"roleArn,omitempty"`
+}
+
+// BadgeStyle defines model for BadgeStyle.
+type BadgeStyle string
+
+// BadgeType Value shown by an artifact badge
+type BadgeType string
+
+// BulkRegistryItemResult defines model for BulkRegistryItemResult.
+type BulkRegistryItemResult struct {
+	// Error why the registry wasn't created, or why its cleanup policies weren't set
+	Error      *string `json:"error,omitempty"`
+	Identifier string  `json:"identifier"`
+
+	// Registry Harness Artifact Registry
+	Registry *Registry `json:"registry,omitempty"`
+
+	// Status Outcome of creating one registry of a bulk request
+	Status BulkRegistryItemStatus `json:"status"`
+}
+
+// BulkRegistryItemStatus Outcome of creating one registry of a bulk request
+type BulkRegistryItemStatus string
+
+// BulkRegistryRequest defines model for BulkRegistryRequest.
+type BulkRegistryRequest struct {
+	// Atomic create all of the registries or none of them
+	Atomic *bool `json:"atomic,omitempty"`
+
+	// ParentRef space to create the registries in, unless a registry sets its own
+	ParentRef  string            `json:"parentRef"`
+	Registries []RegistryRequest `json:"registries"`
+}
+
+// BulkRegistryResult Result of each registry of a bulk request, in the order of the request
+type BulkRegistryResult struct {
+	Created int                      `json:"created"`
+	Failed  int                      `json:"failed"`
+	Results []BulkRegistryItemResult `json:"results"`
+}
+
+// CacheEvictionPolicy defines model for CacheEvictionPolicy.
+type CacheEvictionPolicy struct {
+	// CacheSizeBytes The size of the cache, the sum of the sizes of the cached manifests of images, or of the cached files of other packages.
+	CacheSizeBytes int64 `json:"cacheSizeBytes"`
+
+	// LastEvicted The number of artifacts evicted when the policy was last enforced.
+	LastEvicted *int `json:"lastEvicted,omitempty"`
+
+	// LastFreedBytes The size of the artifacts evicted when the policy was last enforced.
+	LastFreedBytes *int64 `json:"lastFreedBytes,omitempty"`
+
+	// LastRunAt The time the policy was last enforced, absent if it wasn't yet.
+	LastRunAt *int64 `json:"lastRunAt,omitempty"`
+
+	// MaxSizeBytes The size the cache is limited to, absent if it isn't limited.
+	MaxSizeBytes *int64 `json:"maxSizeBytes,omitempty"`
+}
+
+// CacheEvictionPolicyRequest defines model for CacheEvictionPolicyRequest.
+type CacheEvictionPolicyRequest struct {
+	// MaxSizeBytes The size the cache of the upstream proxy is limited to.
+	MaxSizeBytes int64 `json:"maxSizeBytes"`
+}
+
+// CachePrewarm defines model for CachePrewarm.
+type CachePrewarm struct {
+	Artifacts []CachePrewarmArtifact `json:"artifacts"`
+
+	// Batch Identifier of the batch the artifacts were requested in.
+	Batch string `json:"batch"`
+}
+
+// CachePrewarmArtifact defines model for CachePrewarmArtifact.
+type CachePrewarmArtifact struct {
+	Coordinate string `json:"coordinate"`
+
+	// Error The reason the artifact couldn't be fetched.
+	Error *string `json:"error,omitempty"`
+
+	// Status Whether the artifact is pending, was fetched into the cache or failed to be fetched.
+	Status    CachePrewarmStatus `json:"status"`
+	UpdatedAt int64              `json:"updatedAt"`
+}
+
+// CachePrewarmRequest defines model for CachePrewarmRequest.
+type CachePrewarmRequest struct {
+	// Artifacts The coordinates of the artifacts to fetch into the cache.
+	Artifacts []string `json:"artifacts"`
+}
+
+// CachePrewarmStatus Whether the artifact is pending, was fetched into the cache or failed to be fetched.
+type CachePrewarmStatus string
+
+// ClaimMapping defines model for ClaimMapping.
+type ClaimMapping struct {
+	Claim              string  `json:"claim"`
+	CreatedAt          int64   `json:"createdAt"`
+	Id                 int64   `json:"id"`
+	RegistryIdentifier *string `json:"registryIdentifier,omitempty"`
+
+	// Role Role on a registry. READER can view and download, CONTRIBUTOR can also upload and MAINTAINER can also delete artifacts.
+	Role  RegistryRole `json:"role"`
+	Value string       `json:"value"`
+}
+
+// ClaimMappingRequest defines model for ClaimMappingRequest.
+type ClaimMappingRequest struct {
+	// Claim Name of the claim, e.g. groups.
+	Claim string `json:"claim"`
+
+	// RegistryIdentifier Registry of the space the role applies to. If empty, the role applies to all its registries.
+	RegistryIdentifier *string `json:"registryIdentifier,omitempty"`
+
+	// Role Role on a registry. READER can view and download, CONTRIBUTOR can also upload and MAINTAINER can also delete artifacts.
+	Role RegistryRole `json:"role"`
+
+	// Value Claim value to match, glob patterns are supported.
+	Value string `json:"value"`
+}
+
 // CleanupPolicy Cleanup Policy for Harness Artifact Registries
 type CleanupPolicy struct {
-	ExpireDays    *int      `json:"expireDays,omitempty"`
-	Name          *string   `json:"name,omitempty"`
-	PackagePrefix *[]string `json:"packagePrefix,omitempty"`
-	VersionPrefix *[]string `json:"versionPrefix,omitempty"`
+	// ExcludePattern Glob patterns matched against tag names. Matching tags are never removed by the policy.
+	ExcludePattern *[]string `json:"excludePattern,omitempty"`
+	ExpireDays     *int      `json:"expireDays,omitempty"`
+	Name           *string   `json:"name,omitempty"`
+	PackagePrefix  *[]string `json:"packagePrefix,omitempty"`
+
+	// Type Rule applied by a cleanup policy. AGE removes versions older than expireDays, NOT_PULLED removes tags that have not been pulled in the last expireDays days.
+	Type          *CleanupPolicyType `json:"type,omitempty"`
+	VersionPrefix *[]string          `json:"versionPrefix,omitempty"`
 }
 
+// CleanupPolicyType Rule applied by a cleanup policy. AGE removes versions older than expireDays, NOT_PULLED removes tags that have not been pulled in the last expireDays days.
+type CleanupPolicyType string
+
 // ClientSetupDetails Client Setup Details
 type ClientSetupDetails struct {
 	MainHeader string               `json:"mainHeader"`
@@ -232,20 +729,250 @@ type ClientSetupStepConfig struct {
 // ClientSetupStepType ClientSetupStepType type
 type ClientSetupStepType string
 
+// ClientType Kind of client an artifact is downloaded by
+type ClientType string
+
+// ClientTypeDownloadStats Downloads of an artifact by a kind of client
+type ClientTypeDownloadStats struct {
+	// ClientType Kind of client an artifact is downloaded by
+	ClientType          ClientType `json:"clientType"`
+	DownloadCount       int64      `json:"downloadCount"`
+	UniqueDownloadCount int64      `json:"uniqueDownloadCount"`
+}
+
+// CocoapodsArtifactDetailConfig Config for cocoapods pod version details
+type CocoapodsArtifactDetailConfig struct {
+	Dependencies *[]string `json:"dependencies,omitempty"`
+	Homepage     *string   `json:"homepage,omitempty"`
+	License      *string   `json:"license,omitempty"`
+
+	// Platforms deployment targets of the pod by platform
+	Platforms *map[string]string `json:"platforms,omitempty"`
+
+	// PullCommand Podfile line installing the version
+	PullCommand *string `json:"pullCommand,omitempty"`
+	Summary     *string `json:"summary,omitempty"`
+}
+
+// ComposerArtifactDetailConfig Config for composer package artifact details, as read from composer.json
+type ComposerArtifactDetailConfig struct {
+	Authors     *[]ComposerAuthor  `json:"authors,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Homepage    *string            `json:"homepage,omitempty"`
+	Keywords    *[]string          `json:"keywords,omitempty"`
+	License     *[]string          `json:"license,omitempty"`
+	PullCommand *string            `json:"pullCommand,omitempty"`
+	Require     *map[string]string `json:"require,omitempty"`
+	RequireDev  *map[string]string `json:"requireDev,omitempty"`
+
+	// Type Type of the package, like library or metapackage
+	Type              *string `json:"type,omitempty"`
+	VersionNormalized *string `json:"versionNormalized,omitempty"`
+}
+
+// ComposerAuthor Author of a composer package
+type ComposerAuthor struct {
+	Email    *string `json:"email,omitempty"`
+	Homepage *string `json:"homepage,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Role     *string `json:"role,omitempty"`
+}
+
+// ConanArtifactDetailConfig Config for conan recipe artifact details
+type ConanArtifactDetailConfig struct {
+	LatestRevision *string `json:"latestRevision,omitempty"`
+	PullCommand    *string `json:"pullCommand,omitempty"`
+
+	// Reference Reference of the recipe, as name/version@user/channel
+	Reference *string                `json:"reference,omitempty"`
+	Revisions *[]ConanRecipeRevision `json:"revisions,omitempty"`
+}
+
+// ConanBinaryPackage Binary package of a conan recipe revision, as of its latest revision
+type ConanBinaryPackage struct {
+	LatestRevision string             `json:"latestRevision"`
+	Options        *map[string]string `json:"options,omitempty"`
+	PackageId      string             `json:"packageId"`
+	Requires       *[]string          `json:"requires,omitempty"`
+	Settings       *map[string]string `json:"settings,omitempty"`
+}
+
+// ConanRecipeRevision Revision of a conan recipe along with the binary packages built from it
+type ConanRecipeRevision struct {
+	CreatedAt string                `json:"createdAt"`
+	Packages  *[]ConanBinaryPackage `json:"packages,omitempty"`
+	Revision  string                `json:"revision"`
+}
+
+// CondaArtifactDetailConfig Config for conda package artifact details, as read from info/index.json and info/about.json
+type CondaArtifactDetailConfig struct {
+	Description *string             `json:"description,omitempty"`
+	DevUrl      *string             `json:"devUrl,omitempty"`
+	DocUrl      *string             `json:"docUrl,omitempty"`
+	Home        *string             `json:"home,omitempty"`
+	License     *string             `json:"license,omitempty"`
+	Packages    *[]CondaPackageFile `json:"packages,omitempty"`
+	PullCommand *string             `json:"pullCommand,omitempty"`
+	Summary     *string             `json:"summary,omitempty"`
+}
+
+// CondaPackageFile Conda package build published for a platform subdir
+type CondaPackageFile struct {
+	Build       string    `json:"build"`
+	BuildNumber *int64    `json:"buildNumber,omitempty"`
+	Depends     *[]string `json:"depends,omitempty"`
+	FileName    string    `json:"fileName"`
+	Size        *int64    `json:"size,omitempty"`
+	Subdir      string    `json:"subdir"`
+}
+
+// CranArtifactDetailConfig Config for CRAN package version details
+type CranArtifactDetailConfig struct {
+	Depends          *string `json:"depends,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	Imports          *string `json:"imports,omitempty"`
+	License          *string `json:"license,omitempty"`
+	Maintainer       *string `json:"maintainer,omitempty"`
+	NeedsCompilation *bool   `json:"needsCompilation,omitempty"`
+
+	// PullCommand R command installing the package from the registry
+	PullCommand *string `json:"pullCommand,omitempty"`
+	Suggests    *string `json:"suggests,omitempty"`
+	Title       *string `json:"title,omitempty"`
+
+	// Trees trees of the repository the version was published to, src/contrib for the source package
+	Trees *[]string `json:"trees,omitempty"`
+	Url   *string   `json:"url,omitempty"`
+}
+
+// CrateArtifactDetailConfig Config for cargo crate artifact details
+type CrateArtifactDetailConfig struct {
+	Authors       *[]string          `json:"authors,omitempty"`
+	Categories    *[]string          `json:"categories,omitempty"`
+	Dependencies  *[]CrateDependency `json:"dependencies,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	Documentation *string            `json:"documentation,omitempty"`
+	Features      *[]string          `json:"features,omitempty"`
+	Homepage      *string            `json:"homepage,omitempty"`
+	Keywords      *[]string          `json:"keywords,omitempty"`
+	License       *string            `json:"license,omitempty"`
+	PullCommand   *string            `json:"pullCommand,omitempty"`
+	Repository    *string            `json:"repository,omitempty"`
+
+	// RustVersion Minimum supported Rust version
+	RustVersion *string `json:"rustVersion,omitempty"`
+}
+
+// CrateDependency Dependency of a crate
+type CrateDependency struct {
+	// Kind normal, build or dev
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	Optional bool   `json:"optional"`
+
+	// Requirement Version requirement of the dependency, like ^1.0
+	Requirement string `json:"requirement"`
+
+	// Target Platform the dependency is limited to, like cfg(unix)
+	Target *string `json:"target,omitempty"`
+}
+
+// DebArtifactDetailConfig Config for debian package artifact details
+type DebArtifactDetailConfig struct {
+	Depends *string `json:"depends,omitempty"`
+
+	// InstalledSize Estimated installed size in KiB
+	InstalledSize *int64            `json:"installedSize,omitempty"`
+	Maintainer    *string           `json:"maintainer,omitempty"`
+	Packages      *[]DebPackageFile `json:"packages,omitempty"`
+
+	// PullCommand apt sources entry of the repository followed by the install command
+	PullCommand *string `json:"pullCommand,omitempty"`
+}
+
+// DebPackageFile Debian package published to a component of a distribution
+type DebPackageFile struct {
+	Architecture string `json:"architecture"`
+	Component    string `json:"component"`
+	Distribution string `json:"distribution"`
+	FileName     string `json:"fileName"`
+	Sha256       string `json:"sha256"`
+}
+
+// DefaultRegistry defines model for DefaultRegistry.
+type DefaultRegistry struct {
+	// Inherited Whether the default registry is set on an ancestor of the space rather than the space itself.
+	Inherited bool `json:"inherited"`
+
+	// PackageType refers to package
+	PackageType        PackageType `json:"packageType"`
+	RegistryIdentifier string      `json:"registryIdentifier"`
+	RegistryRef        string      `json:"registryRef"`
+	RegistryUrl        string      `json:"registryUrl"`
+
+	// SpaceRef Path of the space the default registry is set on.
+	SpaceRef  string `json:"spaceRef"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+// DefaultRegistryRequest defines model for DefaultRegistryRequest.
+type DefaultRegistryRequest struct {
+	// RegistryRef Reference of the registry, the path of its space followed by its identifier.
+	RegistryRef string `json:"registryRef"`
+}
+
+// DeletedBlob defines model for DeletedBlob.
+type DeletedBlob struct {
+	// Disposition PURGED blobs were deleted, SHARED blobs are kept as other versions still use them. Blobs of images are QUEUED_FOR_GC, the garbage collection deletes them unless other images still use them.
+	Disposition DeletedBlobDisposition `json:"disposition"`
+	Digest      string                 `json:"digest"`
+	Size        int64                  `json:"size"`
+}
+
+// DeletedBlobDisposition PURGED blobs were deleted, SHARED blobs are kept as other versions still use them. Blobs of images are QUEUED_FOR_GC, the garbage collection deletes them unless other images still use them.
+type DeletedBlobDisposition string
+
+// DeletionCertificate defines model for DeletionCertificate.
+type DeletionCertificate struct {
+	Blobs []DeletedBlob `json:"blobs"`
+
+	// Checksum SHA-256 of the contents of the certificate, to detect tampering with the stored certificate.
+	Checksum  string `json:"checksum"`
+	DeletedAt int64  `json:"deletedAt"`
+	DeletedBy int64  `json:"deletedBy"`
+
+	// DeletedItems The paths of the deleted files, or the digest and tags of the deleted image manifest.
+	DeletedItems []string `json:"deletedItems"`
+
+	// DownloadRecords The number of deleted records of downloads of the version.
+	DownloadRecords    int64       `json:"downloadRecords"`
+	Id                 int64       `json:"id"`
+	Package            string      `json:"package"`
+	PackageType        PackageType `json:"packageType"`
+	Reason             string      `json:"reason"`
+	RegistryIdentifier string      `json:"registryIdentifier"`
+	Version            string      `json:"version"`
+}
+
 // DockerArtifactDetail Docker Artifact Detail
 type DockerArtifactDetail struct {
-	CreatedAt      *string `json:"createdAt,omitempty"`
-	DownloadsCount *int64  `json:"downloadsCount,omitempty"`
-	ImageName      string  `json:"imageName"`
-	ModifiedAt     *string `json:"modifiedAt,omitempty"`
+	// Annotations OCI annotations of a manifest or image index
+	Annotations    *Annotations `json:"annotations,omitempty"`
+	CreatedAt      *string      `json:"createdAt,omitempty"`
+	DownloadsCount *int64       `json:"downloadsCount,omitempty"`
+	ImageName      string       `json:"imageName"`
+	ModifiedAt     *string      `json:"modifiedAt,omitempty"`
 
 	// PackageType refers to package
 	PackageType  PackageType `json:"packageType"`
 	PullCommand  *string     `json:"pullCommand,omitempty"`
 	RegistryPath string      `json:"registryPath"`
 	Size         *string     `json:"size,omitempty"`
-	Url          string      `json:"url"`
-	Version      string      `json:"version"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	Url       string `json:"url"`
+	Version   string `json:"version"`
 }
 
 // DockerArtifactDetailConfig Config for docker artifact details
@@ -262,6 +989,9 @@ type DockerArtifactManifest struct {
 type DockerLayerEntry struct {
 	Command string  `json:"command"`
 	Size    *string `json:"size,omitempty"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
 }
 
 // DockerLayersSummary Harness Layers Summary
@@ -273,11 +1003,16 @@ type DockerLayersSummary struct {
 
 // DockerManifestDetails Harness Artifact Layers
 type DockerManifestDetails struct {
-	CreatedAt      *string `json:"createdAt,omitempty"`
-	Digest         string  `json:"digest"`
-	DownloadsCount *int64  `json:"downloadsCount,omitempty"`
-	OsArch         string  `json:"osArch"`
-	Size           *string `json:"size,omitempty"`
+	// Annotations OCI annotations of a manifest or image index
+	Annotations    *Annotations `json:"annotations,omitempty"`
+	CreatedAt      *string      `json:"createdAt,omitempty"`
+	Digest         string       `json:"digest"`
+	DownloadsCount *int64       `json:"downloadsCount,omitempty"`
+	OsArch         string       `json:"osArch"`
+	Size           *string      `json:"size,omitempty"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
 }
 
 // DockerManifests Harness Manifests
@@ -307,11 +1042,82 @@ type ExtraHeader struct {
 
 // FileDetail File Detail
 type FileDetail struct {
+	// Arch Architecture a terraform provider archive is built for
+	Arch            *string  `json:"arch,omitempty"`
 	Checksums       []string `json:"checksums"`
 	CreatedAt       string   `json:"createdAt"`
 	DownloadCommand string   `json:"downloadCommand"`
-	Name            string   `json:"name"`
-	Size            string   `json:"size"`
+
+	// FileType Distribution type of python package files, like bdist_wheel or sdist
+	FileType *string `json:"fileType,omitempty"`
+	Name     string  `json:"name"`
+
+	// Os Operating system a terraform provider archive is built for
+	Os *string `json:"os,omitempty"`
+
+	// PackageId Binary package a conan file belongs to, unset for recipe files
+	PackageId *string `json:"packageId,omitempty"`
+
+	// PackageRevision Package revision a conan file belongs to
+	PackageRevision *string `json:"packageRevision,omitempty"`
+
+	// PythonVersion Python version a python package file is built for
+	PythonVersion *string `json:"pythonVersion,omitempty"`
+
+	// RequiresPython Python versions a python package file supports
+	RequiresPython *string `json:"requiresPython,omitempty"`
+
+	// Revision Recipe revision a conan file belongs to
+	Revision *string `json:"revision,omitempty"`
+	Size     string  `json:"size"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+}
+
+// GalaxyArtifactDetailConfig Config for Ansible Galaxy collection version details
+type GalaxyArtifactDetailConfig struct {
+	Authors *[]string `json:"authors,omitempty"`
+
+	// Dependencies collections the version depends on, with their version range
+	Dependencies  *map[string]string `json:"dependencies,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	Documentation *string            `json:"documentation,omitempty"`
+	Homepage      *string            `json:"homepage,omitempty"`
+	License       *[]string          `json:"license,omitempty"`
+
+	// PullCommand ansible-galaxy command installing the version from the registry
+	PullCommand *string   `json:"pullCommand,omitempty"`
+	Repository  *string   `json:"repository,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+}
+
+// GemsArtifactDetailConfig Config for rubygems artifact details
+type GemsArtifactDetailConfig struct {
+	Authors      *[]string         `json:"authors,omitempty"`
+	Dependencies *[]GemsDependency `json:"dependencies,omitempty"`
+	Description  *string           `json:"description,omitempty"`
+	Homepage     *string           `json:"homepage,omitempty"`
+	Licenses     *[]string         `json:"licenses,omitempty"`
+
+	// Platform Platform of the gem, ruby for pure ruby gems
+	Platform    *string `json:"platform,omitempty"`
+	PullCommand *string `json:"pullCommand,omitempty"`
+
+	// RequiredRubyVersion Ruby versions the gem supports, like >= 2.7
+	RequiredRubyVersion *string `json:"requiredRubyVersion,omitempty"`
+	Summary             *string `json:"summary,omitempty"`
+}
+
+// GemsDependency Dependency of a gem
+type GemsDependency struct {
+	Name string `json:"name"`
+
+	// Requirement Version constraints of the dependency, like ~> 1.2, >= 1.2.3
+	Requirement string `json:"requirement"`
+
+	// Type runtime or development
+	Type string `json:"type"`
 }
 
 // GenericArtifactDetailConfig Config for generic artifact details
@@ -319,6 +1125,25 @@ type GenericArtifactDetailConfig struct {
 	Description *string `json:"description,omitempty"`
 }
 
+// GoArtifactDetailConfig Config for go module artifact details
+type GoArtifactDetailConfig struct {
+	Dependencies *[]GoDependency `json:"dependencies,omitempty"`
+
+	// GoVersion Go version of the go directive of the go.mod
+	GoVersion   *string `json:"goVersion,omitempty"`
+	PullCommand *string `json:"pullCommand,omitempty"`
+}
+
+// GoDependency Requirement of the go.mod of a module
+type GoDependency struct {
+	Indirect bool   `json:"indirect"`
+	Path     string `json:"path"`
+	Version  string `json:"version"`
+}
+
+// GrantablePermission Registry permission that can be granted temporarily.
+type GrantablePermission string
+
 // HelmArtifactDetail Helm Artifact Detail
 type HelmArtifactDetail struct {
 	Artifact       *string `json:"artifact,omitempty"`
@@ -331,8 +1156,11 @@ type HelmArtifactDetail struct {
 	PullCommand  *string     `json:"pullCommand,omitempty"`
 	RegistryPath string      `json:"registryPath"`
 	Size         *string     `json:"size,omitempty"`
-	Url          string      `json:"url"`
-	Version      string      `json:"version"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	Url       string `json:"url"`
+	Version   string `json:"version"`
 }
 
 // HelmArtifactDetailConfig Config for helm artifact details
@@ -345,22 +1173,269 @@ type HelmArtifactManifest struct {
 	Manifest string `json:"manifest"`
 }
 
-// ListArtifact A list of Artifacts
-type ListArtifact struct {
-	// Artifacts A list of Artifact
-	Artifacts []ArtifactMetadata `json:"artifacts"`
+// HelmPushPolicy defines model for HelmPushPolicy.
+type HelmPushPolicy struct {
+	// CheckApiVersions Whether the Kubernetes API versions of the chart templates that were removed are reported.
+	CheckApiVersions bool `json:"checkApiVersions"`
 
-	// ItemCount The total number of items
-	ItemCount *int64 `json:"itemCount,omitempty"`
+	// DisallowedRegistries The hosts of the registries the images of a chart must not be pulled from.
+	DisallowedRegistries []string `json:"disallowedRegistries"`
 
-	// PageCount The total number of pages
-	PageCount *int64 `json:"pageCount,omitempty"`
+	// Enforce Whether charts that violate the policy are rejected, rather than accepted with a warning.
+	Enforce bool `json:"enforce"`
 
-	// PageIndex The current page
-	PageIndex *int64 `json:"pageIndex,omitempty"`
+	// KubeVersion The Kubernetes version charts target, absent if they target all versions.
+	KubeVersion *string `json:"kubeVersion,omitempty"`
 
-	// PageSize The number of items per page
-	PageSize *int `json:"pageSize,omitempty"`
+	// RequiredValues The dotted keys the values.yaml of a chart must set.
+	RequiredValues []string `json:"requiredValues"`
+
+	// UpdatedAt The time the policy was last updated.
+	UpdatedAt int64 `json:"updatedAt"`
+}
+
+// HelmPushPolicyRequest defines model for HelmPushPolicyRequest.
+type HelmPushPolicyRequest struct {
+	// CheckApiVersions Reports the Kubernetes API versions of the chart templates that were removed, as errors if removed by kubeVersion and as warnings otherwise.
+	CheckApiVersions *bool `json:"checkApiVersions,omitempty"`
+
+	// DisallowedRegistries The hosts of the registries the images of a chart must not be pulled from, such as docker.io. Images without a registry are pulled from docker.io.
+	DisallowedRegistries *[]string `json:"disallowedRegistries,omitempty"`
+
+	// Enforce Rejects the charts that violate the policy, rather than accepting them with a warning.
+	Enforce *bool `json:"enforce,omitempty"`
+
+	// KubeVersion The Kubernetes version charts target, such as 1.29. The removed API versions are errors regardless of the version if unset.
+	KubeVersion *string `json:"kubeVersion,omitempty"`
+
+	// RequiredValues The dotted keys the values.yaml of a chart must set, such as resources.limits.memory.
+	RequiredValues *[]string `json:"requiredValues,omitempty"`
+}
+
+// HexArtifactDetailConfig Config for hex package release details
+type HexArtifactDetailConfig struct {
+	// App OTP application of the package
+	App         *string `json:"app,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// HasDocs whether documentation was published for the release
+	HasDocs  *bool              `json:"hasDocs,omitempty"`
+	Licenses *[]string          `json:"licenses,omitempty"`
+	Links    *map[string]string `json:"links,omitempty"`
+
+	// PullCommand mix.exs dependency installing the release
+	PullCommand *string `json:"pullCommand,omitempty"`
+
+	// Requirements requirements of the release with their version requirement
+	Requirements *[]string `json:"requirements,omitempty"`
+}
+
+// HomebrewArtifactDetailConfig Config for Homebrew formula version details
+type HomebrewArtifactDetailConfig struct {
+	// Bottles bottles of the version, one per platform and rebuild
+	Bottles *[]HomebrewBottle `json:"bottles,omitempty"`
+
+	// Desc description of the formula
+	Desc     *string `json:"desc,omitempty"`
+	Homepage *string `json:"homepage,omitempty"`
+	License  *string `json:"license,omitempty"`
+
+	// PullCommand brew command installing the formula from the tap of the registry
+	PullCommand *string `json:"pullCommand,omitempty"`
+}
+
+// HomebrewBottle defines model for HomebrewBottle.
+type HomebrewBottle struct {
+	// Cellar cellar the bottle was built for, any if it can be poured into any prefix
+	Cellar string `json:"cellar"`
+
+	// Checksum SHA-256 checksum of the bottle
+	Checksum string `json:"checksum"`
+
+	// Rebuild rebuild of the bottle, 0 unless the version was rebuilt
+	Rebuild   int   `json:"rebuild"`
+	SizeBytes int64 `json:"sizeBytes"`
+
+	// Tag platform the bottle was built for, such as arm64_sonoma
+	Tag string `json:"tag"`
+}
+
+// IdentityToken defines model for IdentityToken.
+type IdentityToken struct {
+	ExpiresAt int64         `json:"expiresAt"`
+	Grants    []AccessGrant `json:"grants"`
+	Principal string        `json:"principal"`
+
+	// Token Registry token, usable as password for the package clients.
+	Token string `json:"token"`
+}
+
+// IdentityTokenRequest defines model for IdentityTokenRequest.
+type IdentityTokenRequest struct {
+	// IdToken ID token issued by the identity provider.
+	IdToken string `json:"idToken"`
+}
+
+// ImageLayerContent A file inside a layer of an image
+type ImageLayerContent struct {
+	// Digest Digest of the image manifest
+	Digest string `json:"digest"`
+	Image  string `json:"image"`
+
+	// Layer Digest of the layer holding the file
+	Layer string `json:"layer"`
+
+	// Path Absolute path of the file in the image
+	Path               string `json:"path"`
+	RegistryIdentifier string `json:"registryIdentifier"`
+
+	// Size Size of the file in bytes
+	Size int64 `json:"size"`
+}
+
+// ImpactedFile File of a registry with the checksum of an affected artifact
+type ImpactedFile struct {
+	Path               string `json:"path"`
+	RegistryIdentifier string `json:"registryIdentifier"`
+}
+
+// ImpactedImage Image of a registry holding an affected manifest, directly or through an index
+type ImpactedImage struct {
+	Image              string   `json:"image"`
+	RegistryIdentifier string   `json:"registryIdentifier"`
+	Tags               []string `json:"tags"`
+}
+
+// ImportEstimate Estimated size of an import. The sizes of the sampled tags are extrapolated to all tags, counting layers shared by sampled tags once.
+type ImportEstimate struct {
+	EstimatedSize      string `json:"estimatedSize"`
+	EstimatedSizeBytes int64  `json:"estimatedSizeBytes"`
+
+	// Exact whether all tags were sampled, so the size isn't extrapolated
+	Exact               bool  `json:"exact"`
+	RepositoryCount     int   `json:"repositoryCount"`
+	SampledRepositories int   `json:"sampledRepositories"`
+	SampledSizeBytes    int64 `json:"sampledSizeBytes"`
+	SampledTags         int   `json:"sampledTags"`
+
+	// TagCount tags of all repositories, extrapolated from the sampled repositories
+	TagCount int64 `json:"tagCount"`
+}
+
+// ImportEstimateRequest defines model for ImportEstimateRequest.
+type ImportEstimateRequest struct {
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
+
+	// ParentRef space the registry would be created in
+	ParentRef string  `json:"parentRef"`
+	Password  *string `json:"password,omitempty"`
+
+	// Repositories repositories to import, all repositories of the catalog if empty. Required for registries without a catalog, like Docker Hub.
+	Repositories *[]string `json:"repositories,omitempty"`
+
+	// Source source of the upstream, like Dockerhub or MavenCentral, as in UpstreamConfig
+	Source *string `json:"source,omitempty"`
+
+	// Url URL of the external registry, defaults to the URL of the source
+	Url      *string `json:"url,omitempty"`
+	UserName *string `json:"userName,omitempty"`
+}
+
+// LatestVersionStrategy Strategy used to determine the latest version of an artifact. LAST_PUSHED picks the most recently pushed version, SEMVER the highest version by the package ecosystem's ordering and REGEX the highest version extracted with latestVersionPattern.
+type LatestVersionStrategy string
+
+// ListAnnotatedManifests A list of annotated manifests
+type ListAnnotatedManifests struct {
+	// ItemCount The total number of items
+	ItemCount *int64              `json:"itemCount,omitempty"`
+	Manifests []AnnotatedManifest `json:"manifests"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// LegalHold defines model for LegalHold.
+type LegalHold struct {
+	CreatedAt int64   `json:"createdAt"`
+	CreatedBy int64   `json:"createdBy"`
+	Id        int64   `json:"id"`
+	Package   string  `json:"package"`
+	Reason    string  `json:"reason"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// LegalHoldRequest defines model for LegalHoldRequest.
+type LegalHoldRequest struct {
+	Package string `json:"package"`
+	Reason  string `json:"reason"`
+
+	// Version The version to hold, the tag or digest of images. If empty, all versions of the package are held.
+	Version *string `json:"version,omitempty"`
+}
+
+// ListArtifact A list of Artifacts
+type ListArtifact struct {
+	// Artifacts A list of Artifact
+	Artifacts []ArtifactMetadata `json:"artifacts"`
+
+	// CountExact Whether itemCount and pageCount were counted for this request. Cached counts may lag behind the latest changes.
+	CountExact *bool `json:"countExact,omitempty"`
+
+	// CountedAt The time itemCount was counted at, in unix milliseconds.
+	CountedAt *string `json:"countedAt,omitempty"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListArtifactClasses A list of Java classes inside artifacts
+type ListArtifactClasses struct {
+	Classes []ArtifactClass `json:"classes"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListArtifactContents A list of files inside artifacts
+type ListArtifactContents struct {
+	Contents []ArtifactContent `json:"contents"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
 }
 
 // ListArtifactLabel A list of Harness Artifact Labels
@@ -384,6 +1459,62 @@ type ListArtifactVersion struct {
 	// ArtifactVersions A list of Artifact versions
 	ArtifactVersions *[]ArtifactVersionMetadata `json:"artifactVersions,omitempty"`
 
+	// CountExact Whether itemCount and pageCount were counted for this request. Cached counts may lag behind the latest changes.
+	CountExact *bool `json:"countExact,omitempty"`
+
+	// CountedAt The time itemCount was counted at, in unix milliseconds.
+	CountedAt *string `json:"countedAt,omitempty"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListDeletionCertificates A list of deletion certificates
+type ListDeletionCertificates struct {
+	// Certificates A list of deletion certificates
+	Certificates []DeletionCertificate `json:"certificates"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListImageLayerContents A list of files inside image layers
+type ListImageLayerContents struct {
+	Files []ImageLayerContent `json:"files"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+}
+
+// ListPackageRuleViolations A list of package rule violations
+type ListPackageRuleViolations struct {
 	// ItemCount The total number of items
 	ItemCount *int64 `json:"itemCount,omitempty"`
 
@@ -395,6 +1526,9 @@ type ListArtifactVersion struct {
 
 	// PageSize The number of items per page
 	PageSize *int `json:"pageSize,omitempty"`
+
+	// Violations A list of package rule violations
+	Violations []PackageRuleViolation `json:"violations"`
 }
 
 // ListRegistry A list of Harness Artifact Registries
@@ -420,6 +1554,12 @@ type ListRegistryArtifact struct {
 	// Artifacts A list of Artifact
 	Artifacts []RegistryArtifactMetadata `json:"artifacts"`
 
+	// CountExact Whether itemCount and pageCount were counted for this request. Cached counts may lag behind the latest changes.
+	CountExact *bool `json:"countExact,omitempty"`
+
+	// CountedAt The time itemCount was counted at, in unix milliseconds.
+	CountedAt *string `json:"countedAt,omitempty"`
+
 	// ItemCount The total number of items
 	ItemCount *int64 `json:"itemCount,omitempty"`
 
@@ -433,6 +1573,22 @@ type ListRegistryArtifact struct {
 	PageSize *int `json:"pageSize,omitempty"`
 }
 
+// ListRegistryConfigRevisions A list of revisions of the configuration of a registry
+type ListRegistryConfigRevisions struct {
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize  *int                     `json:"pageSize,omitempty"`
+	Revisions []RegistryConfigRevision `json:"revisions"`
+}
+
 // ListWebhooks A list of Harness Registries webhooks
 type ListWebhooks struct {
 	// ItemCount The total number of items
@@ -469,795 +1625,3506 @@ type ListWebhooksExecutions struct {
 	PageSize *int `json:"pageSize,omitempty"`
 }
 
+// MTLSMode Mutual TLS requirement of a registry. PUSH requires a client certificate signed by one of the registry's certificate authorities for uploads and deletions, ALL for every artifact access.
+type MTLSMode string
+
 // MavenArtifactDetailConfig Config for maven artifact details
 type MavenArtifactDetailConfig struct {
 	ArtifactId *string `json:"artifactId,omitempty"`
 	GroupId    *string `json:"groupId,omitempty"`
 }
 
-// PackageType refers to package
-type PackageType string
-
-// PythonArtifactDetailConfig Config for python artifact details
-type PythonArtifactDetailConfig struct {
-	ArtifactId *string `json:"artifactId,omitempty"`
-	GroupId    *string `json:"groupId,omitempty"`
+// MavenRelocation defines model for MavenRelocation.
+type MavenRelocation struct {
+	ArtifactId       string  `json:"artifactId"`
+	GroupId          string  `json:"groupId"`
+	Id               int64   `json:"id"`
+	Message          *string `json:"message,omitempty"`
+	TargetArtifactId string  `json:"targetArtifactId"`
+	TargetGroupId    string  `json:"targetGroupId"`
+	TargetVersion    *string `json:"targetVersion,omitempty"`
+	UpdatedAt        int64   `json:"updatedAt"`
 }
 
-// Registry Harness Artifact Registry
-type Registry struct {
-	AllowedPattern *[]string        `json:"allowedPattern,omitempty"`
-	BlockedPattern *[]string        `json:"blockedPattern,omitempty"`
-	CleanupPolicy  *[]CleanupPolicy `json:"cleanupPolicy,omitempty"`
+// MavenRelocationRequest defines model for MavenRelocationRequest.
+type MavenRelocationRequest struct {
+	// ArtifactId Artifact ID the artifact moved from.
+	ArtifactId string `json:"artifactId"`
 
-	// Config SubConfig specific for Virtual or Upstream Registry
-	Config      *RegistryConfig `json:"config,omitempty"`
-	CreatedAt   *string         `json:"createdAt,omitempty"`
-	Description *string         `json:"description,omitempty"`
-	Identifier  string          `json:"identifier"`
-	Labels      *[]string       `json:"labels,omitempty"`
-	ModifiedAt  *string         `json:"modifiedAt,omitempty"`
+	// GroupId Group ID the artifact moved from.
+	GroupId string `json:"groupId"`
 
-	// PackageType refers to package
-	PackageType PackageType `json:"packageType"`
-	Url         string      `json:"url"`
-}
+	// Message Message Maven shows to consumers of the old coordinates.
+	Message *string `json:"message,omitempty"`
 
-// RegistryArtifactMetadata Artifact Metadata
-type RegistryArtifactMetadata struct {
-	DownloadsCount *int64    `json:"downloadsCount,omitempty"`
-	Labels         *[]string `json:"labels,omitempty"`
-	LastModified   *string   `json:"lastModified,omitempty"`
-	LatestVersion  string    `json:"latestVersion"`
-	Name           string    `json:"name"`
+	// TargetArtifactId Artifact ID the artifact moved to.
+	TargetArtifactId string `json:"targetArtifactId"`
 
-	// PackageType refers to package
-	PackageType        *PackageType `json:"packageType,omitempty"`
-	RegistryIdentifier string       `json:"registryIdentifier"`
-	RegistryPath       string       `json:"registryPath"`
-}
+	// TargetGroupId Group ID the artifact moved to.
+	TargetGroupId string `json:"targetGroupId"`
 
-// RegistryConfig SubConfig specific for Virtual or Upstream Registry
-type RegistryConfig struct {
-	// Type refers to type of registry i.e virtual or upstream
-	Type  RegistryType `json:"type"`
-	union json.RawMessage
+	// TargetVersion Version the artifact moved to. If empty, every version moved to the same version.
+	TargetVersion *string `json:"targetVersion,omitempty"`
 }
 
-// RegistryMetadata Harness Artifact Registry Metadata
-type RegistryMetadata struct {
-	ArtifactsCount *int64    `json:"artifactsCount,omitempty"`
-	Description    *string   `json:"description,omitempty"`
-	DownloadsCount *int64    `json:"downloadsCount,omitempty"`
-	Identifier     string    `json:"identifier"`
-	Labels         *[]string `json:"labels,omitempty"`
-	LastModified   *string   `json:"lastModified,omitempty"`
+// MirrorSync defines model for MirrorSync.
+type MirrorSync struct {
+	CreatedAt       int64          `json:"createdAt"`
+	Enabled         bool           `json:"enabled"`
+	Id              int64          `json:"id"`
+	IntervalMinutes int            `json:"intervalMinutes"`
+	LastRun         *MirrorSyncRun `json:"lastRun,omitempty"`
 
-	// PackageType refers to package
-	PackageType  PackageType `json:"packageType"`
-	Path         *string     `json:"path,omitempty"`
-	RegistrySize *string     `json:"registrySize,omitempty"`
+	// NextRunAt The time the mirror sync is run next, if it's enabled.
+	NextRunAt  int64  `json:"nextRunAt"`
+	Repository string `json:"repository"`
 
-	// Type refers to type of registry i.e virtual or upstream
-	Type RegistryType `json:"type"`
-	Url  string       `json:"url"`
+	// TagPattern The regular expression the replicated tags match, absent if all tags are replicated.
+	TagPattern *string `json:"tagPattern,omitempty"`
+	UpdatedAt  int64   `json:"updatedAt"`
+
+	// UpstreamRegistry Identifier of the upstream proxy the repository is replicated from.
+	UpstreamRegistry string `json:"upstreamRegistry"`
 }
 
-// RegistryRequest defines model for RegistryRequest.
-type RegistryRequest struct {
-	AllowedPattern *[]string        `json:"allowedPattern,omitempty"`
-	BlockedPattern *[]string        `json:"blockedPattern,omitempty"`
-	CleanupPolicy  *[]CleanupPolicy `json:"cleanupPolicy,omitempty"`
+// MirrorSyncArtifact defines model for MirrorSyncArtifact.
+type MirrorSyncArtifact struct {
+	// Digest The digest of the manifest of the tag in the upstream.
+	Digest *string `json:"digest,omitempty"`
 
-	// Config SubConfig specific for Virtual or Upstream Registry
-	Config      *RegistryConfig `json:"config,omitempty"`
-	Description *string         `json:"description,omitempty"`
-	Identifier  string          `json:"identifier"`
-	Labels      *[]string       `json:"labels,omitempty"`
+	// Error The reason the tag couldn't be replicated.
+	Error *string `json:"error,omitempty"`
 
-	// PackageType refers to package
-	PackageType PackageType `json:"packageType"`
-	ParentRef   *string     `json:"parentRef,omitempty"`
+	// Status Whether the tag is pending, was replicated, was skipped as the registry already had it or failed to be replicated.
+	Status    MirrorSyncArtifactStatus `json:"status"`
+	Tag       string                   `json:"tag"`
+	UpdatedAt int64                    `json:"updatedAt"`
 }
 
-// RegistryType refers to type of registry i.e virtual or upstream
-type RegistryType string
+// MirrorSyncArtifactStatus Whether the tag is pending, was replicated, was skipped as the registry already had it or failed to be replicated.
+type MirrorSyncArtifactStatus string
 
-// SectionType refers to client setup section type
-type SectionType string
+// MirrorSyncRequest defines model for MirrorSyncRequest.
+type MirrorSyncRequest struct {
+	// Enabled Whether the mirror sync is run at its interval, true if unset.
+	Enabled *bool `json:"enabled,omitempty"`
 
-// Status Indicates if the request was successful or not
-type Status string
+	// IntervalMinutes The interval the mirror sync is run at, 60 minutes if unset.
+	IntervalMinutes *int `json:"intervalMinutes,omitempty"`
 
-// TabSetupStep Tab Setup step
-type TabSetupStep struct {
-	Header   *string               `json:"header,omitempty"`
-	Sections *[]ClientSetupSection `json:"sections,omitempty"`
-}
+	// Repository The repository replicated, such as library/nginx, which is also its name in the registry. Ignored when a mirror sync is updated.
+	Repository string `json:"repository"`
 
-// TabSetupStepConfig Tab Setup step config
-type TabSetupStepConfig struct {
-	Tabs *[]TabSetupStep `json:"tabs,omitempty"`
+	// TagPattern The regular expression the replicated tags match, such as ^v?\d+\.\d+\.\d+$. All tags are replicated if unset.
+	TagPattern *string `json:"tagPattern,omitempty"`
+
+	// UpstreamRegistry Identifier of the upstream proxy the repository is replicated from, an upstream proxy of the same package type in the space of the registry.
+	UpstreamRegistry string `json:"upstreamRegistry"`
 }
 
-// Trigger refers to trigger
-type Trigger string
+// MirrorSyncRun defines model for MirrorSyncRun.
+type MirrorSyncRun struct {
+	// Artifacts The status of each tag of the run, only returned with a single run.
+	Artifacts *[]MirrorSyncArtifact `json:"artifacts,omitempty"`
+	CreatedAt int64                 `json:"createdAt"`
 
-// UpstreamConfig Configuration for Harness Artifact UpstreamProxies
-type UpstreamConfig struct {
-	Auth *UpstreamConfig_Auth `json:"auth,omitempty"`
+	// Error The reason the run failed as a whole, such as the tags of the upstream not being listed.
+	Error *string `json:"error,omitempty"`
 
-	// AuthType Authentication type
-	AuthType AuthType              `json:"authType"`
-	Source   *UpstreamConfigSource `json:"source,omitempty"`
-	Url      *string               `json:"url,omitempty"`
-}
+	// Failed The number of tags that failed to be replicated.
+	Failed     int    `json:"failed"`
+	FinishedAt *int64 `json:"finishedAt,omitempty"`
+	Id         int64  `json:"id"`
 
-// UpstreamConfig_Auth defines model for UpstreamConfig.Auth.
-type UpstreamConfig_Auth struct {
-	union json.RawMessage
-}
+	// Skipped The number of tags the registry already had at the digest of the upstream.
+	Skipped   int    `json:"skipped"`
+	StartedAt *int64 `json:"startedAt,omitempty"`
 
-// UpstreamConfigSource defines model for UpstreamConfig.Source.
-type UpstreamConfigSource string
+	// Status Whether the run is waiting to start, running, replicated all tags or failed.
+	Status MirrorSyncRunStatus `json:"status"`
 
-// UserPassword defines model for UserPassword.
-type UserPassword struct {
-	SecretIdentifier *string `json:"secretIdentifier,omitempty"`
-	SecretSpaceId    *int    `json:"secretSpaceId,omitempty"`
-	SecretSpacePath  *string `json:"secretSpacePath,omitempty"`
-	UserName         string  `json:"userName"`
-}
+	// Synced The number of tags replicated.
+	Synced int `json:"synced"`
 
-// VirtualConfig Configuration for Harness Virtual Artifact Registries
-type VirtualConfig struct {
-	UpstreamProxies *[]string `json:"upstreamProxies,omitempty"`
+	// Tags The number of tags of the upstream matching the tag pattern.
+	Tags int `json:"tags"`
+
+	// Trigger Whether the run was started at the interval of the mirror sync or on demand.
+	Trigger MirrorSyncTrigger `json:"trigger"`
 }
 
-// Webhook Harness Regstries Webhook
-type Webhook struct {
-	CreatedAt    *string        `json:"createdAt,omitempty"`
-	CreatedBy    *int64         `json:"createdBy,omitempty"`
-	Description  *string        `json:"description,omitempty"`
-	Enabled      bool           `json:"enabled"`
-	ExtraHeaders *[]ExtraHeader `json:"extraHeaders,omitempty"`
-	Identifier   string         `json:"identifier"`
-	Insecure     bool           `json:"insecure"`
-	Internal     *bool          `json:"internal,omitempty"`
+// MirrorSyncRunStatus Whether the run is waiting to start, running, replicated all tags or failed.
+type MirrorSyncRunStatus string
 
-	// LatestExecutionResult refers to webhook execution
-	LatestExecutionResult *WebhookExecResult `json:"latestExecutionResult,omitempty"`
-	ModifiedAt            *string            `json:"modifiedAt,omitempty"`
-	Name                  string             `json:"name"`
-	SecretIdentifier      *string            `json:"secretIdentifier,omitempty"`
-	SecretSpaceId         *int               `json:"secretSpaceId,omitempty"`
-	SecretSpacePath       *string            `json:"secretSpacePath,omitempty"`
-	Triggers              *[]Trigger         `json:"triggers,omitempty"`
-	Url                   string             `json:"url"`
-	Version               *int64             `json:"version,omitempty"`
-}
+// MirrorSyncTrigger Whether the run was started at the interval of the mirror sync or on demand.
+type MirrorSyncTrigger string
 
-// WebhookExecRequest Harness Regstries HTTP Webhook Request
-type WebhookExecRequest struct {
-	Body    *string `json:"body,omitempty"`
-	Headers *string `json:"headers,omitempty"`
-	Url     *string `json:"url,omitempty"`
-}
+// ModelArtifactDetail ML Model Artifact Detail
+type ModelArtifactDetail struct {
+	Artifact       string      `json:"artifact"`
+	CreatedAt      *string     `json:"createdAt,omitempty"`
+	DownloadsCount *int64      `json:"downloadsCount,omitempty"`
+	Files          []ModelFile `json:"files"`
+	Formats        *[]string   `json:"formats,omitempty"`
+	Framework      *string     `json:"framework,omitempty"`
+	ModelCard      *string     `json:"modelCard,omitempty"`
+	ModifiedAt     *string     `json:"modifiedAt,omitempty"`
 
-// WebhookExecResponse Harness Regstries HTTP Webhook Response
-type WebhookExecResponse struct {
-	Body       *string `json:"body,omitempty"`
-	Headers    *string `json:"headers,omitempty"`
-	Status     *string `json:"status,omitempty"`
-	StatusCode *int    `json:"statusCode,omitempty"`
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
+	PullCommand *string     `json:"pullCommand,omitempty"`
+	Size        *string     `json:"size,omitempty"`
+
+	// SizeBytes Size in bytes
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+	Url       string `json:"url"`
+	Version   string `json:"version"`
 }
 
-// WebhookExecResult refers to webhook execution
-type WebhookExecResult string
+// ModelArtifactDetailConfig Config for ML model version details
+type ModelArtifactDetailConfig struct {
+	// Formats formats of the weights of the version
+	Formats *[]string `json:"formats,omitempty"`
 
-// WebhookExecution Harness Regstries Webhook Execution
-type WebhookExecution struct {
-	Created  *int64  `json:"created,omitempty"`
-	Duration *int64  `json:"duration,omitempty"`
-	Error    *string `json:"error,omitempty"`
-	Id       *int64  `json:"id,omitempty"`
+	// Framework framework of the model, such as pytorch
+	Framework *string `json:"framework,omitempty"`
 
-	// Request Harness Regstries HTTP Webhook Request
-	Request *WebhookExecRequest `json:"request,omitempty"`
+	// ModelCard markdown of the README.md of the version
+	ModelCard *string `json:"modelCard,omitempty"`
 
-	// Response Harness Regstries HTTP Webhook Response
-	Response *WebhookExecResponse `json:"response,omitempty"`
+	// PullCommand command downloading a file of the version from the registry
+	PullCommand *string `json:"pullCommand,omitempty"`
+}
 
-	// Result refers to webhook execution
-	Result        *WebhookExecResult `json:"result,omitempty"`
-	RetriggerOf   *int64             `json:"retriggerOf,omitempty"`
-	Retriggerable *bool              `json:"retriggerable,omitempty"`
+// ModelFile defines model for ModelFile.
+type ModelFile struct {
+	CreatedAt *string `json:"createdAt,omitempty"`
 
-	// TriggerType refers to trigger
-	TriggerType *Trigger `json:"triggerType,omitempty"`
-	WebhookId   *int64   `json:"webhookId,omitempty"`
+	// Format format of the weights in the file, unset for other files
+	Format    *string `json:"format,omitempty"`
+	Name      string  `json:"name"`
+	Size      string  `json:"size"`
+	SizeBytes int64   `json:"sizeBytes"`
 }
 
-// WebhookRequest defines model for WebhookRequest.
-type WebhookRequest struct {
-	Description      *string        `json:"description,omitempty"`
-	Enabled          bool           `json:"enabled"`
-	ExtraHeaders     *[]ExtraHeader `json:"extraHeaders,omitempty"`
-	Identifier       string         `json:"identifier"`
-	Insecure         bool           `json:"insecure"`
-	Name             string         `json:"name"`
-	SecretIdentifier *string        `json:"secretIdentifier,omitempty"`
-	SecretSpaceId    *int           `json:"secretSpaceId,omitempty"`
-	SecretSpacePath  *string        `json:"secretSpacePath,omitempty"`
-	Triggers         *[]Trigger     `json:"triggers,omitempty"`
-	Url              string         `json:"url"`
+// NetworkDownloadStats Downloads from a network, the /24 of IPv4 or the /48 of IPv6 clients
+type NetworkDownloadStats struct {
+	DownloadCount       int64   `json:"downloadCount"`
+	Network             string  `json:"network"`
+	Region              *string `json:"region,omitempty"`
+	UniqueDownloadCount int64   `json:"uniqueDownloadCount"`
 }
 
-// LabelsParam defines model for LabelsParam.
-type LabelsParam []string
-
-// RegistryIdentifierParam defines model for RegistryIdentifierParam.
-type RegistryIdentifierParam []string
-
-// RegistryTypeParam defines model for RegistryTypeParam.
-type RegistryTypeParam string
+// NpmArtifactDetailConfig Config for npm artifact details
+type NpmArtifactDetailConfig struct {
+	Dependencies *map[string]string `json:"dependencies,omitempty"`
+	Description  *string            `json:"description,omitempty"`
+	DistTags     *[]string          `json:"distTags,omitempty"`
+	Homepage     *string            `json:"homepage,omitempty"`
+	License      *string            `json:"license,omitempty"`
+}
 
-// ArtifactParam defines model for artifactParam.
-type ArtifactParam string
+// NugetArtifactDetailConfig Config for nuget artifact details
+type NugetArtifactDetailConfig struct {
+	Authors          *string                 `json:"authors,omitempty"`
+	DependencyGroups *[]NugetDependencyGroup `json:"dependencyGroups,omitempty"`
+	Description      *string                 `json:"description,omitempty"`
+	License          *string                 `json:"license,omitempty"`
+	ProjectUrl       *string                 `json:"projectUrl,omitempty"`
+	PullCommand      *string                 `json:"pullCommand,omitempty"`
+	Tags             *[]string               `json:"tags,omitempty"`
+	Title            *string                 `json:"title,omitempty"`
+}
 
-// ArtifactPathParam defines model for artifactPathParam.
-type ArtifactPathParam string
+// NugetDependency Dependency of a nuget package
+type NugetDependency struct {
+	Id string `json:"id"`
 
-// ChildVersionParam defines model for childVersionParam.
-type ChildVersionParam string
+	// Range Version range of the dependency, like [1.0.0, )
+	Range *string `json:"range,omitempty"`
+}
 
-// DigestParam defines model for digestParam.
-type DigestParam string
+// NugetDependencyGroup Dependencies of a nuget package for a target framework
+type NugetDependencyGroup struct {
+	Dependencies []NugetDependency `json:"dependencies"`
 
-// FromDateParam defines model for fromDateParam.
-type FromDateParam string
+	// TargetFramework Target framework of the group, empty for dependencies of all frameworks
+	TargetFramework *string `json:"targetFramework,omitempty"`
+}
 
-// LatestVersion defines model for latestVersion.
-type LatestVersion bool
+// PackageImpact Artifact including a vulnerable version of a package
+type PackageImpact struct {
+	Digest         string          `json:"digest"`
+	Files          []ImpactedFile  `json:"files"`
+	Images         []ImpactedImage `json:"images"`
+	PackageName    string          `json:"packageName"`
+	PackageVersion string          `json:"packageVersion"`
 
-// PackageTypeParam defines model for packageTypeParam.
-type PackageTypeParam []string
+	// RegistryIdentifier Registry the artifact was scanned in
+	RegistryIdentifier string `json:"registryIdentifier"`
 
-// PageNumber defines model for pageNumber.
-type PageNumber int64
+	// VirtualRegistries Virtual registries serving the artifact through one of the registries holding it
+	VirtualRegistries []string `json:"virtualRegistries"`
+}
 
-// PageSize defines model for pageSize.
-type PageSize int64
+// PackageImpactReport defines model for PackageImpactReport.
+type PackageImpactReport struct {
+	Artifacts   []PackageImpact `json:"artifacts"`
+	PackageName string          `json:"packageName"`
+	Range       string          `json:"range"`
 
-// RecursiveParam defines model for recursiveParam.
-type RecursiveParam bool
+	// Scheme refers to the rules used to interpret a version
+	Scheme VersionScheme `json:"scheme"`
+}
 
-// RegistryRefPathParam defines model for registryRefPathParam.
-type RegistryRefPathParam string
+// PackageRule defines model for PackageRule.
+type PackageRule struct {
+	// Action INCLUDE allows the packages the rule matches, blocking the packages no INCLUDE rule matches. EXCLUDE blocks the packages the rule matches.
+	Action       PackageRuleAction `json:"action"`
+	CreatedAt    int64             `json:"createdAt"`
+	Description  *string           `json:"description,omitempty"`
+	Group        *string           `json:"group,omitempty"`
+	Id           int64             `json:"id"`
+	Name         *string           `json:"name,omitempty"`
+	UpdatedAt    int64             `json:"updatedAt"`
+	VersionRange *string           `json:"versionRange,omitempty"`
+}
 
-// SearchTerm defines model for searchTerm.
-type SearchTerm string
+// PackageRuleAction INCLUDE allows the packages the rule matches, blocking the packages no INCLUDE rule matches. EXCLUDE blocks the packages the rule matches.
+type PackageRuleAction string
 
-// SortField defines model for sortField.
-type SortField string
+// PackageRuleRequest defines model for PackageRuleRequest.
+type PackageRuleRequest struct {
+	// Action INCLUDE allows the packages the rule matches, blocking the packages no INCLUDE rule matches. EXCLUDE blocks the packages the rule matches.
+	Action      PackageRuleAction `json:"action"`
+	Description *string           `json:"description,omitempty"`
 
-// SortOrder defines model for sortOrder.
-type SortOrder string
+	// Group Glob matched against the group of the package: the groupId of Maven artifacts, the scope of npm packages or the namespace of images. If empty, the rule matches any group.
+	Group *string `json:"group,omitempty"`
 
-// SpaceRefPathParam defines model for spaceRefPathParam.
-type SpaceRefPathParam string
+	// Name Glob matched against the name of the package. If empty, the rule matches any name.
+	Name *string `json:"name,omitempty"`
 
-// SpaceRefQueryParam defines model for spaceRefQueryParam.
-type SpaceRefQueryParam string
+	// VersionRange Semver range matched against the version of the package, e.g. ">= 1.2, < 2". If empty, the rule matches any version.
+	VersionRange *string `json:"versionRange,omitempty"`
+}
 
-// ToDateParam defines model for toDateParam.
-type ToDateParam string
+// PackageRuleViolation defines model for PackageRuleViolation.
+type PackageRuleViolation struct {
+	// Count The number of times the package was requested.
+	Count       int64   `json:"count"`
+	FirstSeenAt int64   `json:"firstSeenAt"`
+	Group       *string `json:"group,omitempty"`
+	Id          int64   `json:"id"`
+	LastSeenAt  int64   `json:"lastSeenAt"`
+	Name        string  `json:"name"`
+	Reason      string  `json:"reason"`
 
-// VersionParam defines model for versionParam.
-type VersionParam string
+	// RuleId The EXCLUDE rule that blocked the package. Empty if no INCLUDE rule matched it.
+	RuleId *int64 `json:"ruleId,omitempty"`
 
-// VersionPathParam defines model for versionPathParam.
-type VersionPathParam string
+	// Version Empty if the package was blocked by name, e.g. when its versions were listed.
+	Version *string `json:"version,omitempty"`
+}
 
-// WebhookExecutionIdPathParam defines model for webhookExecutionIdPathParam.
-type WebhookExecutionIdPathParam string
+// PackageType refers to package
+type PackageType string
 
-// WebhookIdentifierPathParam defines model for webhookIdentifierPathParam.
-type WebhookIdentifierPathParam string
+// Permalink Permalink of an artifact version.
+type Permalink struct {
+	// Code Short code of the version, which never changes.
+	Code               string `json:"code"`
+	Package            string `json:"package"`
+	RegistryIdentifier string `json:"registryIdentifier"`
 
-// ArtifactDetailResponse defines model for ArtifactDetailResponse.
-type ArtifactDetailResponse struct {
-	// Data Artifact Detail
-	Data ArtifactDetail `json:"data"`
+	// Shortlink URL redirected to the UI screen of the version.
+	Shortlink string `json:"shortlink"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// Url Canonical URL of the UI screen of the version.
+	Url     string `json:"url"`
+	Version string `json:"version"`
 }
 
-// ArtifactLabelResponse defines model for ArtifactLabelResponse.
-type ArtifactLabelResponse struct {
-	// Data Harness Artifact Summary
-	Data ArtifactSummary `json:"data"`
+// PolicySimulationMatch Version matched by a simulated policy
+type PolicySimulationMatch struct {
+	// CreatedAt time in unix milliseconds the version was created
+	CreatedAt *int64 `json:"createdAt,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// LastPulledAt time in unix milliseconds the version was last pulled
+	LastPulledAt *int64 `json:"lastPulledAt,omitempty"`
+	Package      string `json:"package"`
+
+	// Policy name of the cleanup policy, empty for patterns
+	Policy  *string `json:"policy,omitempty"`
+	Reason  string  `json:"reason"`
+	Version string  `json:"version"`
 }
 
-// ArtifactStatsResponse defines model for ArtifactStatsResponse.
-type ArtifactStatsResponse struct {
-	// Data Harness Artifact Stats
-	Data ArtifactStats `json:"data"`
+// PubArtifactDetailConfig Config for pub package version details
+type PubArtifactDetailConfig struct {
+	// Dependencies dependencies of the version with their constraint or source
+	Dependencies *[]string `json:"dependencies,omitempty"`
+	Description  *string   `json:"description,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// Environment SDK constraints of the version
+	Environment *map[string]string `json:"environment,omitempty"`
+	Homepage    *string            `json:"homepage,omitempty"`
+
+	// PullCommand dart command adding the version as a dependency
+	PullCommand *string   `json:"pullCommand,omitempty"`
+	Repository  *string   `json:"repository,omitempty"`
+	Topics      *[]string `json:"topics,omitempty"`
 }
 
-// ArtifactSummaryResponse defines model for ArtifactSummaryResponse.
-type ArtifactSummaryResponse struct {
-	// Data Harness Artifact Summary
-	Data ArtifactSummary `json:"data"`
+// PuppetArtifactDetailConfig Config for Puppet module release details
+type PuppetArtifactDetailConfig struct {
+	Author *string `json:"author,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
-}
+	// Dependencies modules the release depends on, with their version requirement
+	Dependencies *map[string]string `json:"dependencies,omitempty"`
+	IssuesUrl    *string            `json:"issuesUrl,omitempty"`
+	License      *string            `json:"license,omitempty"`
 
-// ArtifactVersionSummaryResponse defines model for ArtifactVersionSummaryResponse.
-type ArtifactVersionSummaryResponse struct {
-	// Data Docker Artifact Version Summary
-	Data ArtifactVersionSummary `json:"data"`
+	// OperatingSystems operating systems the release supports
+	OperatingSystems *[]string `json:"operatingSystems,omitempty"`
+	ProjectPage      *string   `json:"projectPage,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// PullCommand puppet command installing the release from the registry
+	PullCommand *string   `json:"pullCommand,omitempty"`
+	Source      *string   `json:"source,omitempty"`
+	Summary     *string   `json:"summary,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
 }
 
-// BadRequest defines model for BadRequest.
-type BadRequest Error
-
-// ClientSetupDetailsResponse defines model for ClientSetupDetailsResponse.
-type ClientSetupDetailsResponse struct {
-	// Data Client Setup Details
-	Data ClientSetupDetails `json:"data"`
+// PurgeArtifactVersionRequest defines model for PurgeArtifactVersionRequest.
+type PurgeArtifactVersionRequest struct {
+	// Reason Why the version is deleted, e.g. the reference of an erasure request. It's recorded on the certificate.
+	Reason string `json:"reason"`
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// PythonArtifactDetailConfig Config for python artifact details
+type PythonArtifactDetailConfig struct {
+	Author         *string               `json:"author,omitempty"`
+	Distributions  *[]PythonDistribution `json:"distributions,omitempty"`
+	Homepage       *string               `json:"homepage,omitempty"`
+	License        *string               `json:"license,omitempty"`
+	PullCommand    *string               `json:"pullCommand,omitempty"`
+	RequiresDist   *[]string             `json:"requiresDist,omitempty"`
+	RequiresPython *string               `json:"requiresPython,omitempty"`
+	Summary        *string               `json:"summary,omitempty"`
 }
 
-// DockerArtifactDetailResponse defines model for DockerArtifactDetailResponse.
-type DockerArtifactDetailResponse struct {
-	// Data Docker Artifact Detail
-	Data DockerArtifactDetail `json:"data"`
+// PythonDistribution Wheel or source distribution uploaded for a python package version
+type PythonDistribution struct {
+	FileName string `json:"fileName"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// FileType Distribution type, like bdist_wheel or sdist
+	FileType       string  `json:"fileType"`
+	PythonVersion  *string `json:"pythonVersion,omitempty"`
+	RequiresPython *string `json:"requiresPython,omitempty"`
+	Sha256         *string `json:"sha256,omitempty"`
 }
 
-// DockerArtifactManifestResponse defines model for DockerArtifactManifestResponse.
-type DockerArtifactManifestResponse struct {
-	// Data Docker Artifact Manifest
-	Data DockerArtifactManifest `json:"data"`
+// RegionDownloadStats Downloads from a country
+type RegionDownloadStats struct {
+	DownloadCount int64 `json:"downloadCount"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// NetworkCount Networks downloaded from
+	NetworkCount int `json:"networkCount"`
+
+	// Region ISO 3166-1 alpha-2 country code, unset if the clients weren't located
+	Region              *string `json:"region,omitempty"`
+	UniqueDownloadCount int64   `json:"uniqueDownloadCount"`
 }
 
-// DockerLayersResponse defines model for DockerLayersResponse.
-type DockerLayersResponse struct {
-	// Data Harness Layers Summary
-	Data DockerLayersSummary `json:"data"`
+// Registry Harness Artifact Registry
+type Registry struct {
+	AllowedPattern *[]string        `json:"allowedPattern,omitempty"`
+	BlockedPattern *[]string        `json:"blockedPattern,omitempty"`
+	CleanupPolicy  *[]CleanupPolicy `json:"cleanupPolicy,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
-}
+	// Config SubConfig specific for Virtual or Upstream Registry
+	Config      *RegistryConfig `json:"config,omitempty"`
+	CreatedAt   *string         `json:"createdAt,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	Identifier  string          `json:"identifier"`
+	Labels      *[]string       `json:"labels,omitempty"`
 
-// DockerManifestsResponse defines model for DockerManifestsResponse.
-type DockerManifestsResponse struct {
-	// Data Harness Manifests
-	Data DockerManifests `json:"data"`
+	// LatestVersionPattern Regular expression used by the REGEX strategy. The first capture group (or the whole match) is compared as a semantic version.
+	LatestVersionPattern *string `json:"latestVersionPattern,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
-}
+	// LatestVersionStrategy Strategy used to determine the latest version of an artifact. LAST_PUSHED picks the most recently pushed version, SEMVER the highest version by the package ecosystem's ordering and REGEX the highest version extracted with latestVersionPattern.
+	LatestVersionStrategy *LatestVersionStrategy `json:"latestVersionStrategy,omitempty"`
 
-// FileDetailResponse defines model for FileDetailResponse.
-type FileDetailResponse struct {
-	// Files A list of Harness Artifact Files
-	Files []FileDetail `json:"files"`
+	// MavenStrictMetadata Whether POM deploys missing the name, description, licenses or SCM metadata required by Maven Central are rejected.
+	MavenStrictMetadata *bool   `json:"mavenStrictMetadata,omitempty"`
+	ModifiedAt          *string `json:"modifiedAt,omitempty"`
 
-	// ItemCount The total number of items
-	ItemCount *int64 `json:"itemCount,omitempty"`
+	// MtlsCaCertificates PEM encoded certificate authorities client certificates are verified against when mtlsMode is PUSH or ALL.
+	MtlsCaCertificates *string `json:"mtlsCaCertificates,omitempty"`
 
-	// PageCount The total number of pages
-	PageCount *int64 `json:"pageCount,omitempty"`
+	// MtlsMode Mutual TLS requirement of a registry. PUSH requires a client certificate signed by one of the registry's certificate authorities for uploads and deletions, ALL for every artifact access.
+	MtlsMode *MTLSMode `json:"mtlsMode,omitempty"`
 
-	// PageIndex The current page
-	PageIndex *int64 `json:"pageIndex,omitempty"`
+	// NpmLocalScopes npm scopes, like @mycorp, whose packages are only resolved from the registry itself and never proxied from its upstream proxies.
+	NpmLocalScopes *[]string `json:"npmLocalScopes,omitempty"`
 
-	// PageSize The number of items per page
-	PageSize *int `json:"pageSize,omitempty"`
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// StoragePrefix Storage path prefix of the registry's blobs. Defaults to the root space identifier.
+	StoragePrefix *string `json:"storagePrefix,omitempty"`
+	Url           string  `json:"url"`
 }
 
-// HelmArtifactDetailResponse defines model for HelmArtifactDetailResponse.
-type HelmArtifactDetailResponse struct {
-	// Data Helm Artifact Detail
-	Data HelmArtifactDetail `json:"data"`
-
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// RegistryArtifactMetadata Artifact Metadata
+type RegistryArtifactMetadata struct {
+	DownloadsCount *int64    `json:"downloadsCount,omitempty"`
+	Labels         *[]string `json:"labels,omitempty"`
+	LastModified   *string   `json:"lastModified,omitempty"`
+	LatestVersion  string    `json:"latestVersion"`
+	Name           string    `json:"name"`
+
+	// PackageType refers to package
+	PackageType        *PackageType `json:"packageType,omitempty"`
+	RegistryIdentifier string       `json:"registryIdentifier"`
+	RegistryPath       string       `json:"registryPath"`
 }
 
-// HelmArtifactManifestResponse defines model for HelmArtifactManifestResponse.
-type HelmArtifactManifestResponse struct {
-	// Data Helm Artifact Manifest
-	Data HelmArtifactManifest `json:"data"`
+// RegistryCloneRequest defines model for RegistryCloneRequest.
+type RegistryCloneRequest struct {
+	// Description description of the new registry, defaults to the description of the source registry
+	Description *string `json:"description,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
-}
+	// Identifier identifier of the new registry
+	Identifier string `json:"identifier"`
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError Error
+	// IncludeArtifacts copy the artifacts, versions and tags of the source registry. The blobs are shared with the source registry.
+	IncludeArtifacts *bool `json:"includeArtifacts,omitempty"`
 
-// ListArtifactLabelResponse defines model for ListArtifactLabelResponse.
-type ListArtifactLabelResponse struct {
-	// Data A list of Harness Artifact Labels
-	Data ListArtifactLabel `json:"data"`
+	// ParentRef space to create the new registry in, defaults to the space of the source registry. It must be under the same root space as the source registry.
+	ParentRef *string `json:"parentRef,omitempty"`
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// RegistryConfig SubConfig specific for Virtual or Upstream Registry
+type RegistryConfig struct {
+	// Type refers to type of registry i.e virtual or upstream
+	Type  RegistryType `json:"type"`
+	union json.RawMessage
 }
 
-// ListArtifactResponse defines model for ListArtifactResponse.
-type ListArtifactResponse struct {
-	// Data A list of Artifacts
-	Data ListArtifact `json:"data"`
+// RegistryConfigAction defines model for RegistryConfigAction.
+type RegistryConfigAction string
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// RegistryConfigApplyRequest defines model for RegistryConfigApplyRequest.
+type RegistryConfigApplyRequest struct {
+	// DryRun return the changes without making them
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// ParentRef space to apply the configuration to
+	ParentRef string `json:"parentRef"`
+
+	// Yaml configuration of the registry as YAML, as exported
+	Yaml string `json:"yaml"`
 }
 
-// ListArtifactVersionResponse defines model for ListArtifactVersionResponse.
-type ListArtifactVersionResponse struct {
-	// Data A list of Artifact versions
-	Data ListArtifactVersion `json:"data"`
+// RegistryConfigApplyResult Changes that applying a registry configuration made, or would make on a dry run
+type RegistryConfigApplyResult struct {
+	Changes            []RegistryConfigChange `json:"changes"`
+	DryRun             bool                   `json:"dryRun"`
+	RegistryIdentifier string                 `json:"registryIdentifier"`
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// RegistryConfigChange defines model for RegistryConfigChange.
+type RegistryConfigChange struct {
+	Action RegistryConfigAction `json:"action"`
+
+	// Identifier identifier of the registry or webhook, or claim=value of the permission
+	Identifier string `json:"identifier"`
+
+	// Resource Part of a registry configuration
+	Resource RegistryConfigResource `json:"resource"`
 }
 
-// ListRegistryArtifactResponse defines model for ListRegistryArtifactResponse.
-type ListRegistryArtifactResponse struct {
-	// Data A list of Artifacts
-	Data ListRegistryArtifact `json:"data"`
+// RegistryConfigExport defines model for RegistryConfigExport.
+type RegistryConfigExport struct {
+	RegistryIdentifier string `json:"registryIdentifier"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// Yaml configuration of the registry as YAML
+	Yaml string `json:"yaml"`
 }
 
-// ListRegistryResponse defines model for ListRegistryResponse.
-type ListRegistryResponse struct {
-	// Data A list of Harness Artifact Registries
-	Data ListRegistry `json:"data"`
+// RegistryConfigResource Part of a registry configuration
+type RegistryConfigResource string
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// RegistryConfigRevision Revision of the configuration of a registry
+type RegistryConfigRevision struct {
+	// Changes changes the revision made to the revision before it
+	Changes   []RegistryConfigChange `json:"changes"`
+	CreatedAt string                 `json:"createdAt"`
+	CreatedBy *int64                 `json:"createdBy,omitempty"`
+	Revision  int64                  `json:"revision"`
 }
 
-// ListWebhooksExecutionResponse defines model for ListWebhooksExecutionResponse.
-type ListWebhooksExecutionResponse struct {
-	// Data A list of Harness Registries webhooks executions
-	Data ListWebhooksExecutions `json:"data"`
+// RegistryConfigRevisionDetail defines model for RegistryConfigRevisionDetail.
+type RegistryConfigRevisionDetail struct {
+	// Embedded struct due to allOf(#/components/schemas/RegistryConfigRevision)
+	RegistryConfigRevision `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// After configuration as YAML after the revision
+	After string `json:"after"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// Before configuration as YAML before the revision, unset for the first revision
+	Before *string `json:"before,omitempty"`
 }
 
-// ListWebhooksResponse defines model for ListWebhooksResponse.
-type ListWebhooksResponse struct {
-	// Data A list of Harness Registries webhooks
-	Data ListWebhooks `json:"data"`
+// RegistryDownloadOrigins Downloads of the artifacts of a registry by where they were downloaded from
+type RegistryDownloadOrigins struct {
+	// DownloadCount Downloads whose origin was recorded
+	DownloadCount int64 `json:"downloadCount"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// Networks The networks downloaded from most
+	Networks []NetworkDownloadStats `json:"networks"`
+
+	// Regions Downloads by country, most downloaded first
+	Regions []RegionDownloadStats `json:"regions"`
 }
 
-// NotFound defines model for NotFound.
-type NotFound Error
+// RegistryIdentifierCheck Whether a registry can be created with an identifier
+type RegistryIdentifierCheck struct {
+	// Available whether no registry of the root space has the identifier
+	Available  bool   `json:"available"`
+	Identifier string `json:"identifier"`
 
-// RegistryResponse defines model for RegistryResponse.
-type RegistryResponse struct {
-	// Data Harness Artifact Registry
-	Data Registry `json:"data"`
+	// Reason why a registry can't be created with the identifier
+	Reason *string `json:"reason,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// Suggestion free identifier derived from the identifier, set if it's taken
+	Suggestion *string `json:"suggestion,omitempty"`
+
+	// Valid whether the identifier is well formed
+	Valid bool `json:"valid"`
 }
 
-// Success defines model for Success.
-type Success struct {
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// RegistryIdentifierCheckRequest defines model for RegistryIdentifierCheckRequest.
+type RegistryIdentifierCheckRequest struct {
+	Identifier string `json:"identifier"`
+
+	// ParentRef space the registry would be created in
+	ParentRef string `json:"parentRef"`
 }
 
-// Unauthenticated defines model for Unauthenticated.
-type Unauthenticated Error
+// RegistryMember Registry a virtual registry resolves artifacts from.
+type RegistryMember struct {
+	Identifier string `json:"identifier"`
 
-// Unauthorized defines model for Unauthorized.
-type Unauthorized Error
+	// Priority Position of the member in the resolution order, starting at 1.
+	Priority int `json:"priority"`
 
-// WebhookExecutionResponse defines model for WebhookExecutionResponse.
-type WebhookExecutionResponse struct {
-	// Data Harness Regstries Webhook Execution
-	Data WebhookExecution `json:"data"`
+	// Type refers to type of registry i.e virtual or upstream
+	Type RegistryType `json:"type"`
+}
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+// RegistryMembersRequest defines model for RegistryMembersRequest.
+type RegistryMembersRequest struct {
+	// Members Identifiers of all members of the registry, in the order to consult them.
+	Members []string `json:"members"`
 }
 
-// WebhookResponse defines model for WebhookResponse.
-type WebhookResponse struct {
-	// Data Harness Regstries Webhook
-	Data Webhook `json:"data"`
+// RegistryMetadata Harness Artifact Registry Metadata
+type RegistryMetadata struct {
+	ArtifactsCount *int64    `json:"artifactsCount,omitempty"`
+	Description    *string   `json:"description,omitempty"`
+	DownloadsCount *int64    `json:"downloadsCount,omitempty"`
+	Identifier     string    `json:"identifier"`
+	Labels         *[]string `json:"labels,omitempty"`
+	LastModified   *string   `json:"lastModified,omitempty"`
 
-	// Status Indicates if the request was successful or not
-	Status Status `json:"status"`
+	// PackageType refers to package
+	PackageType  PackageType `json:"packageType"`
+	Path         *string     `json:"path,omitempty"`
+	RegistrySize *string     `json:"registrySize,omitempty"`
+
+	// RegistrySizeBytes Size in bytes
+	RegistrySizeBytes *int64 `json:"registrySizeBytes,omitempty"`
+
+	// Type refers to type of registry i.e virtual or upstream
+	Type RegistryType `json:"type"`
+
+	// UniqueDownloadsCount Downloads counted once per client within the deduplication window, excluding resumed downloads
+	UniqueDownloadsCount *int64 `json:"uniqueDownloadsCount,omitempty"`
+	Url                  string `json:"url"`
 }
 
-// CreateRegistryParams defines parameters for CreateRegistry.
-type CreateRegistryParams struct {
-	// SpaceRef Unique space path
-	SpaceRef *SpaceRefQueryParam `form:"space_ref,omitempty" json:"space_ref,omitempty"`
+// RegistryOnboarding Registry created by the onboarding along with the defaults applied to it
+type RegistryOnboarding struct {
+	// AppliedDefaults settings left out of the request that were set to their recommended value
+	AppliedDefaults []string `json:"appliedDefaults"`
+
+	// Registry Harness Artifact Registry
+	Registry Registry `json:"registry"`
 }
 
-// ListArtifactLabelsParams defines parameters for ListArtifactLabels.
-type ListArtifactLabelsParams struct {
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+// RegistryOnboardingRequest defines model for RegistryOnboardingRequest.
+type RegistryOnboardingRequest struct {
+	Description *string `json:"description,omitempty"`
+	Identifier  string  `json:"identifier"`
 
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
 
-	// SearchTerm search Term.
-	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
-}
+	// ParentRef space to create the registry in
+	ParentRef string `json:"parentRef"`
 
-// GetArtifactStatsForRegistryParams defines parameters for GetArtifactStatsForRegistry.
-type GetArtifactStatsForRegistryParams struct {
-	// From Date. Format - MM/DD/YYYY
-	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+	// Upstream Configuration for Harness Artifact UpstreamProxies
+	Upstream *UpstreamConfig `json:"upstream,omitempty"`
 
-	// To Date. Format - MM/DD/YYYY
-	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+	// UpstreamProxies upstream registries of a virtual registry, ignored if upstream is set
+	UpstreamProxies *[]string `json:"upstreamProxies,omitempty"`
 }
 
-// GetArtifactStatsParams defines parameters for GetArtifactStats.
-type GetArtifactStatsParams struct {
-	// From Date. Format - MM/DD/YYYY
-	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+// RegistryPolicySimulation Outcome of draft policies on the versions of a registry. Tags of OCI registries are evaluated, along with their pulls, and versions of other registries by age only.
+type RegistryPolicySimulation struct {
+	// Blocked versions the patterns would have blocked, up to 1000
+	Blocked []PolicySimulationMatch `json:"blocked"`
 
-	// To Date. Format - MM/DD/YYYY
-	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+	// BlockedCount number of versions the patterns would have blocked
+	BlockedCount int64 `json:"blockedCount"`
+
+	// Deleted versions the cleanup policies would delete, up to 1000
+	Deleted []PolicySimulationMatch `json:"deleted"`
+
+	// DeletedCount number of versions the cleanup policies would delete
+	DeletedCount int64 `json:"deletedCount"`
+
+	// Evaluated number of versions evaluated
+	Evaluated int64 `json:"evaluated"`
 }
 
-// GetArtifactDetailsParams defines parameters for GetArtifactDetails.
-type GetArtifactDetailsParams struct {
-	// ChildVersion Child version incase of Docker artifacts.
-	ChildVersion *ChildVersionParam `form:"childVersion,omitempty" json:"childVersion,omitempty"`
+// RegistryPolicySimulationRequest defines model for RegistryPolicySimulationRequest.
+type RegistryPolicySimulationRequest struct {
+	// AllowedPattern draft allowed patterns to evaluate, with blockedPattern
+	AllowedPattern *[]string `json:"allowedPattern,omitempty"`
+
+	// BlockedPattern draft blocked patterns to evaluate, with allowedPattern
+	BlockedPattern *[]string `json:"blockedPattern,omitempty"`
+
+	// CleanupPolicy draft cleanup policies to evaluate
+	CleanupPolicy *[]CleanupPolicy `json:"cleanupPolicy,omitempty"`
 }
 
-// GetDockerArtifactDetailsParams defines parameters for GetDockerArtifactDetails.
-type GetDockerArtifactDetailsParams struct {
-	// Digest Digest.
-	Digest DigestParam `form:"digest" json:"digest"`
+// RegistryQueue Backlog of a queue of background operations of a registry
+type RegistryQueue struct {
+	// Name Queue of background registry operations
+	Name RegistryQueueName `json:"name"`
+
+	// OldestPendingAgeSeconds Age of the oldest pending item
+	OldestPendingAgeSeconds *int64 `json:"oldestPendingAgeSeconds,omitempty"`
+
+	// OldestPendingAt Time in unix milliseconds the oldest pending item was queued
+	OldestPendingAt *int64 `json:"oldestPendingAt,omitempty"`
+	Paused          bool   `json:"paused"`
+
+	// PausedAt Time in unix milliseconds the queue was paused
+	PausedAt *int64 `json:"pausedAt,omitempty"`
+
+	// PausedBy Principal that paused the queue
+	PausedBy *int64 `json:"pausedBy,omitempty"`
+
+	// Pending Number of items waiting to be processed
+	Pending int64 `json:"pending"`
+
+	// Running Number of items being processed
+	Running int64 `json:"running"`
 }
 
-// GetDockerArtifactLayersParams defines parameters for GetDockerArtifactLayers.
-type GetDockerArtifactLayersParams struct {
-	// Digest Digest.
-	Digest DigestParam `form:"digest" json:"digest"`
+// RegistryQueueName Queue of background registry operations
+type RegistryQueueName string
+
+// RegistryRequest defines model for RegistryRequest.
+type RegistryRequest struct {
+	AllowedPattern *[]string        `json:"allowedPattern,omitempty"`
+	BlockedPattern *[]string        `json:"blockedPattern,omitempty"`
+	CleanupPolicy  *[]CleanupPolicy `json:"cleanupPolicy,omitempty"`
+
+	// Config SubConfig specific for Virtual or Upstream Registry
+	Config      *RegistryConfig `json:"config,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	Identifier  string          `json:"identifier"`
+	Labels      *[]string       `json:"labels,omitempty"`
+
+	// LatestVersionPattern Regular expression used by the REGEX strategy. The first capture group (or the whole match) is compared as a semantic version.
+	LatestVersionPattern *string `json:"latestVersionPattern,omitempty"`
+
+	// LatestVersionStrategy Strategy used to determine the latest version of an artifact. LAST_PUSHED picks the most recently pushed version, SEMVER the highest version by the package ecosystem's ordering and REGEX the highest version extracted with latestVersionPattern.
+	LatestVersionStrategy *LatestVersionStrategy `json:"latestVersionStrategy,omitempty"`
+
+	// MavenStrictMetadata Rejects POM deploys missing the name, description, licenses or SCM metadata required by Maven Central, keeping artifacts publishable upstream. Only supported by MAVEN virtual registries.
+	MavenStrictMetadata *bool `json:"mavenStrictMetadata,omitempty"`
+
+	// MtlsCaCertificates PEM encoded certificate authorities client certificates are verified against when mtlsMode is PUSH or ALL. Required unless mtlsMode is DISABLED.
+	MtlsCaCertificates *string `json:"mtlsCaCertificates,omitempty"`
+
+	// MtlsMode Mutual TLS requirement of a registry. PUSH requires a client certificate signed by one of the registry's certificate authorities for uploads and deletions, ALL for every artifact access.
+	MtlsMode *MTLSMode `json:"mtlsMode,omitempty"`
+
+	// NpmLocalScopes npm scopes, like @mycorp, whose packages are only resolved from the registry itself, keeping them from being proxied from the upstream proxies. Packages of other scopes are resolved from the registry first and then from its upstream proxies in order. Only supported by NPM virtual registries.
+	NpmLocalScopes *[]string `json:"npmLocalScopes,omitempty"`
+
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
+	ParentRef   *string     `json:"parentRef,omitempty"`
+
+	// StoragePrefix Storage path prefix of the registry's blobs, e.g. "teams/payments". Changing the prefix of an existing registry migrates its blobs to the new location in the background; the registry keeps serving from the old location until the migration completes.
+	StoragePrefix *string `json:"storagePrefix,omitempty"`
 }
 
-// GetDockerArtifactManifestParams defines parameters for GetDockerArtifactManifest.
-type GetDockerArtifactManifestParams struct {
-	// Digest Digest.
-	Digest DigestParam `form:"digest" json:"digest"`
+// RegistryRole Role on a registry. READER can view and download, CONTRIBUTOR can also upload and MAINTAINER can also delete artifacts.
+type RegistryRole string
+
+// RegistrySecurityPosture Security state of the versions of a registry
+type RegistrySecurityPosture struct {
+	// AllowlistedCriticalFindings Number of critical vulnerabilities allowlisted for a version until their expiry
+	AllowlistedCriticalFindings int64 `json:"allowlistedCriticalFindings"`
+
+	// CriticalCves Number of distinct critical vulnerabilities across the versions
+	CriticalCves int64 `json:"criticalCves"`
+
+	// CriticalVersions Number of versions with critical vulnerabilities
+	CriticalVersions int64 `json:"criticalVersions"`
+
+	// LastScannedAt Time in unix milliseconds the most recent scan finished
+	LastScannedAt *int64 `json:"lastScannedAt,omitempty"`
+
+	// SuppressedCriticalFindings Number of critical findings suppressed by VEX statements
+	SuppressedCriticalFindings int64 `json:"suppressedCriticalFindings"`
+
+	// UnscannedVersions Number of versions without a scan result
+	UnscannedVersions int64 `json:"unscannedVersions"`
+
+	// UnsignedVersions Number of versions without a signature
+	UnsignedVersions int64 `json:"unsignedVersions"`
+
+	// Versions Number of image versions
+	Versions int64 `json:"versions"`
 }
 
-// GetArtifactFilesParams defines parameters for GetArtifactFiles.
-type GetArtifactFilesParams struct {
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+// RegistryType refers to type of registry i.e virtual or upstream
+type RegistryType string
 
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+// ResolveOutcome What happened when a registry was consulted for the coordinate.
+type ResolveOutcome string
 
-	// SortOrder sortOrder
-	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+// ResolveStep How one registry took part in the resolution.
+type ResolveStep struct {
+	// Outcome What happened when a registry was consulted for the coordinate.
+	Outcome            ResolveOutcome `json:"outcome"`
+	Reason             string         `json:"reason"`
+	RegistryIdentifier string         `json:"registryIdentifier"`
 
-	// SortField sortField
-	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+	// RegistryType refers to type of registry i.e virtual or upstream
+	RegistryType RegistryType `json:"registryType"`
 
-	// SearchTerm search Term.
-	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+	// UpstreamUrl URL of the upstream probed on a cache miss.
+	UpstreamUrl *string `json:"upstreamUrl,omitempty"`
 }
 
-// GetAllArtifactVersionsParams defines parameters for GetAllArtifactVersions.
-type GetAllArtifactVersionsParams struct {
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+// ResolveTrace defines model for ResolveTrace.
+type ResolveTrace struct {
+	// AnsweredBy Identifier of the registry that would serve the coordinate, absent if none would.
+	AnsweredBy *string `json:"answeredBy,omitempty"`
+	Artifact   string  `json:"artifact"`
+	File       *string `json:"file,omitempty"`
 
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+	// Path Path of the artifact the coordinate was parsed from, when tracing a path.
+	Path    *string       `json:"path,omitempty"`
+	Steps   []ResolveStep `json:"steps"`
+	Version string        `json:"version"`
+}
 
-	// SortOrder sortOrder
-	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+// RpmArtifactDetailConfig Config for rpm package artifact details
+type RpmArtifactDetailConfig struct {
+	License     *string           `json:"license,omitempty"`
+	Packager    *string           `json:"packager,omitempty"`
+	Packages    *[]RpmPackageFile `json:"packages,omitempty"`
+	PullCommand *string           `json:"pullCommand,omitempty"`
 
-	// SortField sortField
-	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+	// Requires Capabilities the package requires, with their version constraints
+	Requires *[]string `json:"requires,omitempty"`
+	Summary  *string   `json:"summary,omitempty"`
+	Url      *string   `json:"url,omitempty"`
+	Vendor   *string   `json:"vendor,omitempty"`
+}
 
-	// SearchTerm search Term.
-	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+// RpmPackageFile RPM package published for an architecture
+type RpmPackageFile struct {
+	Architecture string `json:"architecture"`
+	FileName     string `json:"fileName"`
+	Sha256       string `json:"sha256"`
 }
 
-// GetAllArtifactsByRegistryParams defines parameters for GetAllArtifactsByRegistry.
-type GetAllArtifactsByRegistryParams struct {
-	// Label Label.
-	Label *LabelsParam `form:"label,omitempty" json:"label,omitempty"`
+// ScanComponent Package found in an artifact, an entry of its SBOM
+type ScanComponent struct {
+	Name string `json:"name"`
 
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+	// Purl Package URL of the component
+	Purl    *string `json:"purl,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
 
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+// ScanFinding Issue, usually a vulnerability, reported by a scan
+type ScanFinding struct {
+	// AllowlistEntry Vulnerability allowlisted for a package, or one of its artifacts, until an expiry date
+	AllowlistEntry *VulnerabilityAllowlistEntry `json:"allowlistEntry,omitempty"`
 
-	// SortOrder sortOrder
-	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+	// Allowlisted Whether the vulnerability is allowlisted for the artifact, ignored when reporting a scan
+	Allowlisted *bool `json:"allowlisted,omitempty"`
 
-	// SortField sortField
+	// FixedVersion Version of the package the issue is fixed in
+	FixedVersion *string `json:"fixedVersion,omitempty"`
+
+	// Identifier Identifier of the issue, e.g. CVE-2024-3094
+	Identifier     string  `json:"identifier"`
+	PackageName    *string `json:"packageName,omitempty"`
+	PackageVersion *string `json:"packageVersion,omitempty"`
+
+	// Severity Severity of a scan finding
+	Severity ScanSeverity `json:"severity"`
+
+	// Suppressed Whether the finding is suppressed by a VEX statement, ignored when reporting a scan
+	Suppressed *bool   `json:"suppressed,omitempty"`
+	Title      *string `json:"title,omitempty"`
+	Url        *string `json:"url,omitempty"`
+
+	// VexStatus Status of a vulnerability for an artifact
+	VexStatus *VexStatus `json:"vexStatus,omitempty"`
+}
+
+// ScanResult Scan result of an artifact
+type ScanResult struct {
+	CreatedAt int64  `json:"createdAt"`
+	Digest    string `json:"digest"`
+
+	// Findings Findings of the scan, only returned for a single scan result
+	Findings   *[]ScanFinding `json:"findings,omitempty"`
+	FinishedAt int64          `json:"finishedAt"`
+	Id         int64          `json:"id"`
+
+	// SeverityCounts Number of findings per severity
+	SeverityCounts ScanSeverityCounts `json:"severityCounts"`
+	StartedAt      int64              `json:"startedAt"`
+	Tool           string             `json:"tool"`
+	ToolVersion    string             `json:"toolVersion"`
+}
+
+// ScanResultRequest Result of a scan of an artifact
+type ScanResultRequest struct {
+	// Components Packages found in the artifact, taken from its SBOM
+	Components *[]ScanComponent `json:"components,omitempty"`
+
+	// Digest Digest of the scanned artifact
+	Digest   string         `json:"digest"`
+	Findings *[]ScanFinding `json:"findings,omitempty"`
+
+	// FinishedAt Time in unix milliseconds the scan finished
+	FinishedAt int64 `json:"finishedAt"`
+
+	// StartedAt Time in unix milliseconds the scan started
+	StartedAt int64 `json:"startedAt"`
+
+	// Tool Name of the scanner
+	Tool        string  `json:"tool"`
+	ToolVersion *string `json:"toolVersion,omitempty"`
+}
+
+// ScanSeverity Severity of a scan finding
+type ScanSeverity string
+
+// ScanSeverityCounts Number of findings per severity
+type ScanSeverityCounts struct {
+	// Allowlisted Number of findings of vulnerabilities allowlisted for the artifact, not included in the other counts
+	Allowlisted int64 `json:"allowlisted"`
+	Critical    int64 `json:"critical"`
+	High        int64 `json:"high"`
+	Low         int64 `json:"low"`
+	Medium      int64 `json:"medium"`
+
+	// Suppressed Number of findings suppressed by VEX statements, not included in the other counts
+	Suppressed int64 `json:"suppressed"`
+	Unknown    int64 `json:"unknown"`
+}
+
+// SectionType refers to client setup section type
+type SectionType string
+
+// Status Indicates if the request was successful or not
+type Status string
+
+// StorageObject defines model for StorageObject.
+type StorageObject struct {
+	Digest string  `json:"digest"`
+	Error  *string `json:"error,omitempty"`
+
+	// Kind CONFIG and LAYER are the configuration and layer blobs of image manifests, FILE the files of other packages.
+	Kind StorageObjectKind `json:"kind"`
+
+	// Location The object in the storage backend, e.g. s3://bucket/key, or the path of the file.
+	Location *string `json:"location,omitempty"`
+
+	// Name The path of the file, or the digest of the manifest the blob belongs to.
+	Name string `json:"name"`
+
+	// ReplicationStatus The replication status of the object, e.g. COMPLETED or PENDING.
+	ReplicationStatus *string `json:"replicationStatus,omitempty"`
+
+	// Size The size recorded in the database.
+	Size int64 `json:"size"`
+
+	// Status PRESENT objects were found in the storage, MISSING ones weren't. The location of UNKNOWN objects couldn't be looked up, either as the storage driver can't report it or as the lookup failed.
+	Status StorageObjectStatus `json:"status"`
+
+	// StorageClass The tier the object is stored in, e.g. STANDARD or GLACIER.
+	StorageClass *string `json:"storageClass,omitempty"`
+
+	// StoragePath The path of the object in the storage driver.
+	StoragePath string `json:"storagePath"`
+
+	// StoredSize The size of the object in the storage backend.
+	StoredSize *int64 `json:"storedSize,omitempty"`
+}
+
+// StorageObjectKind CONFIG and LAYER are the configuration and layer blobs of image manifests, FILE the files of other packages.
+type StorageObjectKind string
+
+// StorageObjectStatus PRESENT objects were found in the storage, MISSING ones weren't. The location of UNKNOWN objects couldn't be looked up, either as the storage driver can't report it or as the lookup failed.
+type StorageObjectStatus string
+
+// SwiftArtifactDetailConfig Config for swift package release details
+type SwiftArtifactDetailConfig struct {
+	Description *string          `json:"description,omitempty"`
+	Manifests   *[]SwiftManifest `json:"manifests,omitempty"`
+	Name        string           `json:"name"`
+
+	// PullCommand package dependency on the release
+	PullCommand *string `json:"pullCommand,omitempty"`
+
+	// RepositoryUrls URLs of the repository the release was published from
+	RepositoryUrls *[]string `json:"repositoryUrls,omitempty"`
+	Scope          string    `json:"scope"`
+
+	// Signed whether the source archive was published with a signature
+	Signed *bool `json:"signed,omitempty"`
+}
+
+// SwiftManifest defines model for SwiftManifest.
+type SwiftManifest struct {
+	Filename string `json:"filename"`
+
+	// SwiftVersion Swift version of the manifest, unset for Package.swift
+	SwiftVersion *string `json:"swiftVersion,omitempty"`
+	ToolsVersion *string `json:"toolsVersion,omitempty"`
+}
+
+// TabSetupStep Tab Setup step
+type TabSetupStep struct {
+	Header   *string               `json:"header,omitempty"`
+	Sections *[]ClientSetupSection `json:"sections,omitempty"`
+}
+
+// TabSetupStepConfig Tab Setup step config
+type TabSetupStepConfig struct {
+	Tabs *[]TabSetupStep `json:"tabs,omitempty"`
+}
+
+// TerraformArtifactDetailConfig Config for terraform module and provider artifact details
+type TerraformArtifactDetailConfig struct {
+	Examples *[]string             `json:"examples,omitempty"`
+	Kind     TerraformArtifactKind `json:"kind"`
+
+	// Name name of the module or type of the provider
+	Name      string                       `json:"name"`
+	Namespace string                       `json:"namespace"`
+	Platforms *[]TerraformProviderPlatform `json:"platforms,omitempty"`
+
+	// Protocols plugin protocol versions the provider supports
+	Protocols *[]string `json:"protocols,omitempty"`
+
+	// PullCommand module block or required_providers block using the version
+	PullCommand *string `json:"pullCommand,omitempty"`
+
+	// Readme start of the README.md of the root module
+	Readme *string `json:"readme,omitempty"`
+
+	// SigningKeyId ID of the GPG key the provider release is signed with
+	SigningKeyId *string   `json:"signingKeyId,omitempty"`
+	Submodules   *[]string `json:"submodules,omitempty"`
+
+	// System target system of the module, unset for providers
+	System *string `json:"system,omitempty"`
+}
+
+// TerraformArtifactKind defines model for TerraformArtifactKind.
+type TerraformArtifactKind string
+
+// TerraformProviderPlatform Archive of a terraform provider built for a platform
+type TerraformProviderPlatform struct {
+	Arch     string `json:"arch"`
+	Filename string `json:"filename"`
+	Os       string `json:"os"`
+	Shasum   string `json:"shasum"`
+}
+
+// Token token sent to the upstream as a bearer token
+type Token struct {
+	SecretIdentifier string  `json:"secretIdentifier"`
+	SecretSpaceId    *int    `json:"secretSpaceId,omitempty"`
+	SecretSpacePath  *string `json:"secretSpacePath,omitempty"`
+}
+
+// Trigger refers to trigger
+type Trigger string
+
+// UpstreamAuth credentials of an upstream, their schema depends on the auth type
+type UpstreamAuth struct {
+	union json.RawMessage
+}
+
+// UpstreamCheck Result of checking an upstream
+type UpstreamCheck struct {
+	// Authorized whether the upstream accepted the credentials, or anonymous access without credentials
+	Authorized bool    `json:"authorized"`
+	LatencyMs  int64   `json:"latencyMs"`
+	Message    *string `json:"message,omitempty"`
+
+	// Reachable whether the upstream responded
+	Reachable bool `json:"reachable"`
+
+	// StatusCode HTTP status the upstream responded with
+	StatusCode *int   `json:"statusCode,omitempty"`
+	Url        string `json:"url"`
+}
+
+// UpstreamCheckRequest defines model for UpstreamCheckRequest.
+type UpstreamCheckRequest struct {
+	// PackageType refers to package
+	PackageType PackageType `json:"packageType"`
+
+	// ParentRef space the upstream registry would be created in
+	ParentRef string  `json:"parentRef"`
+	Password  *string `json:"password,omitempty"`
+
+	// Source source of the upstream, like Dockerhub or MavenCentral, as in UpstreamConfig
+	Source *string `json:"source,omitempty"`
+
+	// Url URL of the upstream, defaults to the URL of the source
+	Url      *string `json:"url,omitempty"`
+	UserName *string `json:"userName,omitempty"`
+}
+
+// UpstreamConfig Configuration for Harness Artifact UpstreamProxies
+type UpstreamConfig struct {
+	// ArtifactTtl Seconds files cached from the upstream are served before they're fetched again. Zero keeps them until they're deleted. Content addressed by digest or version, such as Docker blobs, npm tarballs and PyPI files, doesn't expire.
+	ArtifactTtl *int64 `json:"artifactTtl,omitempty"`
+
+	// Auth credentials of an upstream, their schema depends on the auth type
+	Auth *UpstreamAuth `json:"auth,omitempty"`
+
+	// AuthType Authentication type
+	AuthType AuthType `json:"authType"`
+
+	// CredentialsUpdatedAt time the credentials were last changed, in milliseconds since the epoch
+	CredentialsUpdatedAt *string `json:"credentialsUpdatedAt,omitempty"`
+
+	// MetadataTtl Seconds metadata fetched from the upstream, such as Docker tags, Maven metadata files, npm packuments and PyPI project pages, is served before it's fetched again. Zero fetches it on every request.
+	MetadataTtl *int64 `json:"metadataTtl,omitempty"`
+
+	// Offline Serves only the content cached from the upstream without contacting it, for when the upstream is unreachable.
+	Offline *bool                 `json:"offline,omitempty"`
+	Source  *UpstreamConfigSource `json:"source,omitempty"`
+	Url     *string               `json:"url,omitempty"`
+}
+
+// UpstreamConfigSource defines model for UpstreamConfig.Source.
+type UpstreamConfigSource string
+
+// UpstreamCredentials defines model for UpstreamCredentials.
+type UpstreamCredentials struct {
+	// Auth credentials of an upstream, their schema depends on the auth type
+	Auth *UpstreamAuth `json:"auth,omitempty"`
+
+	// AuthType Authentication type
+	AuthType AuthType `json:"authType"`
+}
+
+// UpstreamHealthStatus HEALTHY and UNHEALTHY are the result of the health checks of the URL, UNKNOWN if it wasn't checked yet. A URL is UNHEALTHY after a number of consecutive failed checks.
+type UpstreamHealthStatus string
+
+// UpstreamStatus defines model for UpstreamStatus.
+type UpstreamStatus struct {
+	ActiveUrl string `json:"activeUrl"`
+
+	// Urls The URLs of the upstream proxy in failover order, its primary URL first.
+	Urls []UpstreamURLStatus `json:"urls"`
+}
+
+// UpstreamURLStatus defines model for UpstreamURLStatus.
+type UpstreamURLStatus struct {
+	// Active Whether requests are proxied to the URL.
+	Active              bool `json:"active"`
+	ConsecutiveFailures *int `json:"consecutiveFailures,omitempty"`
+
+	// Health HEALTHY and UNHEALTHY are the result of the health checks of the URL, UNKNOWN if it wasn't checked yet. A URL is UNHEALTHY after a number of consecutive failed checks.
+	Health        UpstreamHealthStatus `json:"health"`
+	LastCheckedAt *int64               `json:"lastCheckedAt,omitempty"`
+	LastError     *string              `json:"lastError,omitempty"`
+	LatencyMs     *int64               `json:"latencyMs,omitempty"`
+	Primary       bool                 `json:"primary"`
+	Url           string               `json:"url"`
+}
+
+// UpstreamURLsRequest defines model for UpstreamURLsRequest.
+type UpstreamURLsRequest struct {
+	// Urls The URLs to fail over to, in the order they're tried. At most 10 URLs.
+	Urls []string `json:"urls"`
+}
+
+// UserPassword defines model for UserPassword.
+type UserPassword struct {
+	SecretIdentifier *string `json:"secretIdentifier,omitempty"`
+	SecretSpaceId    *int    `json:"secretSpaceId,omitempty"`
+	SecretSpacePath  *string `json:"secretSpacePath,omitempty"`
+	UserName         string  `json:"userName"`
+}
+
+// VagrantArtifactDetailConfig Config for Vagrant box version details
+type VagrantArtifactDetailConfig struct {
+	// Providers boxes of the version, one per provider and architecture
+	Providers *[]VagrantBoxProvider `json:"providers,omitempty"`
+
+	// PullCommand vagrant command adding the version of the box from the registry
+	PullCommand *string `json:"pullCommand,omitempty"`
+}
+
+// VagrantBoxProvider defines model for VagrantBoxProvider.
+type VagrantBoxProvider struct {
+	// Architecture architecture the box was built for, empty if it runs on any
+	Architecture *string `json:"architecture,omitempty"`
+
+	// Checksum SHA-256 checksum of the box
+	Checksum string `json:"checksum"`
+
+	// Name provider the box runs on, such as virtualbox
+	Name      string `json:"name"`
+	SizeBytes int64  `json:"sizeBytes"`
+}
+
+// VersionCompareRequest defines model for VersionCompareRequest.
+type VersionCompareRequest struct {
+	// PackageType refers to package
+	PackageType *PackageType `json:"packageType,omitempty"`
+
+	// Range Range in the native syntax of the scheme, e.g. [1.0,2.0) for maven.
+	Range *string `json:"range,omitempty"`
+
+	// Scheme refers to the rules used to interpret a version
+	Scheme   *VersionScheme `json:"scheme,omitempty"`
+	Versions []string       `json:"versions"`
+}
+
+// VersionComparison Versions sorted in ascending order
+type VersionComparison struct {
+	Latest *string `json:"latest,omitempty"`
+
+	// Scheme refers to the rules used to interpret a version
+	Scheme   VersionScheme `json:"scheme"`
+	Versions []VersionInfo `json:"versions"`
+}
+
+// VersionInfo Normalized version
+type VersionInfo struct {
+	InRange    *bool   `json:"inRange,omitempty"`
+	Normalized *string `json:"normalized,omitempty"`
+	Valid      bool    `json:"valid"`
+	Version    string  `json:"version"`
+}
+
+// VersionScheme refers to the rules used to interpret a version
+type VersionScheme string
+
+// VersionStorage defines model for VersionStorage.
+type VersionStorage struct {
+	Objects []StorageObject `json:"objects"`
+	Package string          `json:"package"`
+
+	// StorageDriver The name of the storage driver of the registry, e.g. s3aws or filesystem.
+	StorageDriver string `json:"storageDriver"`
+
+	// TotalSize The sum of the recorded sizes of the objects.
+	TotalSize int64  `json:"totalSize"`
+	Version   string `json:"version"`
+}
+
+// VexDocument VEX document attached to an artifact
+type VexDocument struct {
+	Author    *string `json:"author,omitempty"`
+	CreatedAt int64   `json:"createdAt"`
+	Digest    string  `json:"digest"`
+
+	// Format Format of a VEX document
+	Format VexFormat `json:"format"`
+	Id     int64     `json:"id"`
+
+	// Identifier Identifier of the document assigned by its author
+	Identifier     string `json:"identifier"`
+	IssuedAt       int64  `json:"issuedAt"`
+	StatementCount int64  `json:"statementCount"`
+}
+
+// VexFormat Format of a VEX document
+type VexFormat string
+
+// VexStatement Status of a vulnerability for an artifact stated by a VEX document
+type VexStatement struct {
+	DocumentId         int64  `json:"documentId"`
+	DocumentIdentifier string `json:"documentIdentifier"`
+
+	// Format Format of a VEX document
+	Format          VexFormat `json:"format"`
+	ImpactStatement *string   `json:"impactStatement,omitempty"`
+	Justification   *string   `json:"justification,omitempty"`
+
+	// Status Status of a vulnerability for an artifact
+	Status        VexStatus `json:"status"`
+	Timestamp     int64     `json:"timestamp"`
+	Vulnerability string    `json:"vulnerability"`
+}
+
+// VexStatus Status of a vulnerability for an artifact
+type VexStatus string
+
+// VirtualConfig Configuration for Harness Virtual Artifact Registries
+type VirtualConfig struct {
+	// UpstreamProxies Members of the virtual registry, consulted in order after the registry itself. Members are upstream proxies or other virtual registries of the same package type; a virtual member only serves its own artifacts, its members aren't consulted.
+	UpstreamProxies *[]string `json:"upstreamProxies,omitempty"`
+}
+
+// VulnerabilityAllowlistEntry Vulnerability allowlisted for a package, or one of its artifacts, until an expiry date
+type VulnerabilityAllowlistEntry struct {
+	CreatedAt int64   `json:"createdAt"`
+	CreatedBy int64   `json:"createdBy"`
+	Digest    *string `json:"digest,omitempty"`
+
+	// Expired Whether the expiry date has passed, the findings of the vulnerability count again
+	Expired       bool   `json:"expired"`
+	ExpiresAt     int64  `json:"expiresAt"`
+	Id            int64  `json:"id"`
+	Justification string `json:"justification"`
+	Package       string `json:"package"`
+	Vulnerability string `json:"vulnerability"`
+}
+
+// VulnerabilityAllowlistRequest defines model for VulnerabilityAllowlistRequest.
+type VulnerabilityAllowlistRequest struct {
+	// Digest Digest of the artifact to allowlist the vulnerability for, the manifest of images or the checksum of files. If empty, the vulnerability is allowlisted for all artifacts of the package.
+	Digest *string `json:"digest,omitempty"`
+
+	// ExpiresAt Time in unix milliseconds the vulnerability stops being allowlisted, in the future
+	ExpiresAt int64 `json:"expiresAt"`
+
+	// Justification Why the vulnerability is accepted
+	Justification string `json:"justification"`
+	Package       string `json:"package"`
+
+	// Vulnerability Identifier of the vulnerability, e.g. CVE-2024-3094
+	Vulnerability string `json:"vulnerability"`
+}
+
+// Webhook Harness Regstries Webhook
+type Webhook struct {
+	CreatedAt    *string        `json:"createdAt,omitempty"`
+	CreatedBy    *int64         `json:"createdBy,omitempty"`
+	Description  *string        `json:"description,omitempty"`
+	Enabled      bool           `json:"enabled"`
+	ExtraHeaders *[]ExtraHeader `json:"extraHeaders,omitempty"`
+	Identifier   string         `json:"identifier"`
+	Insecure     bool           `json:"insecure"`
+	Internal     *bool          `json:"internal,omitempty"`
+
+	// LatestExecutionResult refers to webhook execution
+	LatestExecutionResult *WebhookExecResult `json:"latestExecutionResult,omitempty"`
+	ModifiedAt            *string            `json:"modifiedAt,omitempty"`
+	Name                  string             `json:"name"`
+	SecretIdentifier      *string            `json:"secretIdentifier,omitempty"`
+	SecretSpaceId         *int               `json:"secretSpaceId,omitempty"`
+	SecretSpacePath       *string            `json:"secretSpacePath,omitempty"`
+	Triggers              *[]Trigger         `json:"triggers,omitempty"`
+	Url                   string             `json:"url"`
+	Version               *int64             `json:"version,omitempty"`
+}
+
+// WebhookExecRequest Harness Regstries HTTP Webhook Request
+type WebhookExecRequest struct {
+	Body    *string `json:"body,omitempty"`
+	Headers *string `json:"headers,omitempty"`
+	Url     *string `json:"url,omitempty"`
+}
+
+// WebhookExecResponse Harness Regstries HTTP Webhook Response
+type WebhookExecResponse struct {
+	Body       *string `json:"body,omitempty"`
+	Headers    *string `json:"headers,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	StatusCode *int    `json:"statusCode,omitempty"`
+}
+
+// WebhookExecResult refers to webhook execution
+type WebhookExecResult string
+
+// WebhookExecution Harness Regstries Webhook Execution
+type WebhookExecution struct {
+	Created  *int64  `json:"created,omitempty"`
+	Duration *int64  `json:"duration,omitempty"`
+	Error    *string `json:"error,omitempty"`
+	Id       *int64  `json:"id,omitempty"`
+
+	// Request Harness Regstries HTTP Webhook Request
+	Request *WebhookExecRequest `json:"request,omitempty"`
+
+	// Response Harness Regstries HTTP Webhook Response
+	Response *WebhookExecResponse `json:"response,omitempty"`
+
+	// Result refers to webhook execution
+	Result        *WebhookExecResult `json:"result,omitempty"`
+	RetriggerOf   *int64             `json:"retriggerOf,omitempty"`
+	Retriggerable *bool              `json:"retriggerable,omitempty"`
+
+	// TriggerType refers to trigger
+	TriggerType *Trigger `json:"triggerType,omitempty"`
+	WebhookId   *int64   `json:"webhookId,omitempty"`
+}
+
+// WebhookRequest defines model for WebhookRequest.
+type WebhookRequest struct {
+	Description      *string        `json:"description,omitempty"`
+	Enabled          bool           `json:"enabled"`
+	ExtraHeaders     *[]ExtraHeader `json:"extraHeaders,omitempty"`
+	Identifier       string         `json:"identifier"`
+	Insecure         bool           `json:"insecure"`
+	Name             string         `json:"name"`
+	SecretIdentifier *string        `json:"secretIdentifier,omitempty"`
+	SecretSpaceId    *int           `json:"secretSpaceId,omitempty"`
+	SecretSpacePath  *string        `json:"secretSpacePath,omitempty"`
+	Triggers         *[]Trigger     `json:"triggers,omitempty"`
+	Url              string         `json:"url"`
+}
+
+// LabelsParam defines model for LabelsParam.
+type LabelsParam []string
+
+// RegistryIdentifierParam defines model for RegistryIdentifierParam.
+type RegistryIdentifierParam []string
+
+// RegistryTypeParam defines model for RegistryTypeParam.
+type RegistryTypeParam string
+
+// AccessGrantIdPathParam defines model for accessGrantIdPathParam.
+type AccessGrantIdPathParam int64
+
+// AllowlistEntryIdPathParam defines model for allowlistEntryIdPathParam.
+type AllowlistEntryIdPathParam int64
+
+// AnnotationKeyParam defines model for annotationKeyParam.
+type AnnotationKeyParam string
+
+// AnnotationValueParam defines model for annotationValueParam.
+type AnnotationValueParam string
+
+// ArtifactParam defines model for artifactParam.
+type ArtifactParam string
+
+// ArtifactPathParam defines model for artifactPathParam.
+type ArtifactPathParam string
+
+// BadgeColorParam defines model for badgeColorParam.
+type BadgeColorParam string
+
+// BadgeLabelColorParam defines model for badgeLabelColorParam.
+type BadgeLabelColorParam string
+
+// BadgeLabelParam defines model for badgeLabelParam.
+type BadgeLabelParam string
+
+// BadgeMaxAgeParam defines model for badgeMaxAgeParam.
+type BadgeMaxAgeParam int
+
+// BadgePathParam Value shown by an artifact badge
+type BadgePathParam BadgeType
+
+// BadgeStyleParam defines model for badgeStyleParam.
+type BadgeStyleParam BadgeStyle
+
+// CertificateIdPathParam defines model for certificateIdPathParam.
+type CertificateIdPathParam int64
+
+// ChildVersionParam defines model for childVersionParam.
+type ChildVersionParam string
+
+// ClaimMappingIdPathParam defines model for claimMappingIdPathParam.
+type ClaimMappingIdPathParam int64
+
+// ClassQueryParam defines model for classQueryParam.
+type ClassQueryParam string
+
+// ConfigRevisionPathParam defines model for configRevisionPathParam.
+type ConfigRevisionPathParam int64
+
+// ContentQueryParam defines model for contentQueryParam.
+type ContentQueryParam string
+
+// DigestParam defines model for digestParam.
+type DigestParam string
+
+// ExactCountParam defines model for exactCountParam.
+type ExactCountParam bool
+
+// FileNameQueryParam defines model for fileNameQueryParam.
+type FileNameQueryParam string
+
+// FromDateParam defines model for fromDateParam.
+type FromDateParam string
+
+// IfNoneMatchHeaderParam defines model for ifNoneMatchHeaderParam.
+type IfNoneMatchHeaderParam string
+
+// ImpactPackageParam defines model for impactPackageParam.
+type ImpactPackageParam string
+
+// ImpactRangeParam defines model for impactRangeParam.
+type ImpactRangeParam string
+
+// ImpactSchemeParam refers to the rules used to interpret a version
+type ImpactSchemeParam VersionScheme
+
+// ImpactVulnerabilityParam defines model for impactVulnerabilityParam.
+type ImpactVulnerabilityParam string
+
+// LatestVersion defines model for latestVersion.
+type LatestVersion bool
+
+// LayerQueryParam defines model for layerQueryParam.
+type LayerQueryParam string
+
+// LegalHoldIdPathParam defines model for legalHoldIdPathParam.
+type LegalHoldIdPathParam int64
+
+// MergedParam defines model for mergedParam.
+type MergedParam bool
+
+// MirrorSyncIdPathParam defines model for mirrorSyncIdPathParam.
+type MirrorSyncIdPathParam int64
+
+// MirrorSyncRunIdPathParam defines model for mirrorSyncRunIdPathParam.
+type MirrorSyncRunIdPathParam int64
+
+// PackageTypeParam defines model for packageTypeParam.
+type PackageTypeParam []string
+
+// PackageTypePathParam refers to package
+type PackageTypePathParam PackageType
+
+// PageNumber defines model for pageNumber.
+type PageNumber int64
+
+// PageSize defines model for pageSize.
+type PageSize int64
+
+// PermalinkCodePathParam defines model for permalinkCodePathParam.
+type PermalinkCodePathParam string
+
+// PrewarmBatchPathParam defines model for prewarmBatchPathParam.
+type PrewarmBatchPathParam string
+
+// QueuePathParam Queue of background registry operations
+type QueuePathParam RegistryQueueName
+
+// RecursiveParam defines model for recursiveParam.
+type RecursiveParam bool
+
+// RegistryRefPathParam defines model for registryRefPathParam.
+type RegistryRefPathParam string
+
+// RelocationIdPathParam defines model for relocationIdPathParam.
+type RelocationIdPathParam int64
+
+// ResolveArtifactParam defines model for resolveArtifactParam.
+type ResolveArtifactParam string
+
+// ResolveFileParam defines model for resolveFileParam.
+type ResolveFileParam string
+
+// ResolvePathParam defines model for resolvePathParam.
+type ResolvePathParam string
+
+// ResolveVersionParam defines model for resolveVersionParam.
+type ResolveVersionParam string
+
+// RuleIdPathParam defines model for ruleIdPathParam.
+type RuleIdPathParam int64
+
+// ScanIdPathParam defines model for scanIdPathParam.
+type ScanIdPathParam int64
+
+// SearchTerm defines model for searchTerm.
+type SearchTerm string
+
+// SortField defines model for sortField.
+type SortField string
+
+// SortOrder defines model for sortOrder.
+type SortOrder string
+
+// SpaceRefPathParam defines model for spaceRefPathParam.
+type SpaceRefPathParam string
+
+// SpaceRefQueryParam defines model for spaceRefQueryParam.
+type SpaceRefQueryParam string
+
+// ToDateParam defines model for toDateParam.
+type ToDateParam string
+
+// VersionParam defines model for versionParam.
+type VersionParam string
+
+// VersionPathParam defines model for versionPathParam.
+type VersionPathParam string
+
+// VexDocumentIdPathParam defines model for vexDocumentIdPathParam.
+type VexDocumentIdPathParam int64
+
+// WebhookExecutionIdPathParam defines model for webhookExecutionIdPathParam.
+type WebhookExecutionIdPathParam string
+
+// WebhookIdentifierPathParam defines model for webhookIdentifierPathParam.
+type WebhookIdentifierPathParam string
+
+// AccessGrantResponse defines model for AccessGrantResponse.
+type AccessGrantResponse struct {
+	Data AccessGrant `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactDetailResponse defines model for ArtifactDetailResponse.
+type ArtifactDetailResponse struct {
+	// Data Artifact Detail
+	Data ArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactLabelResponse defines model for ArtifactLabelResponse.
+type ArtifactLabelResponse struct {
+	// Data Harness Artifact Summary
+	Data ArtifactSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactStatsResponse defines model for ArtifactStatsResponse.
+type ArtifactStatsResponse struct {
+	// Data Harness Artifact Stats
+	Data ArtifactStats `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactSummaryResponse defines model for ArtifactSummaryResponse.
+type ArtifactSummaryResponse struct {
+	// Data Harness Artifact Summary
+	Data ArtifactSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ArtifactVersionSummaryResponse defines model for ArtifactVersionSummaryResponse.
+type ArtifactVersionSummaryResponse struct {
+	// Data Docker Artifact Version Summary
+	Data ArtifactVersionSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// BadRequest defines model for BadRequest.
+type BadRequest Error
+
+// BulkRegistryResponse defines model for BulkRegistryResponse.
+type BulkRegistryResponse struct {
+	// Data Result of each registry of a bulk request, in the order of the request
+	Data BulkRegistryResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// CacheEvictionPolicyResponse defines model for CacheEvictionPolicyResponse.
+type CacheEvictionPolicyResponse struct {
+	Data CacheEvictionPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// CachePrewarmResponse defines model for CachePrewarmResponse.
+type CachePrewarmResponse struct {
+	Data CachePrewarm `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ClaimMappingResponse defines model for ClaimMappingResponse.
+type ClaimMappingResponse struct {
+	Data ClaimMapping `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ClientSetupDetailsResponse defines model for ClientSetupDetailsResponse.
+type ClientSetupDetailsResponse struct {
+	// Data Client Setup Details
+	Data ClientSetupDetails `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// DefaultRegistryResponse defines model for DefaultRegistryResponse.
+type DefaultRegistryResponse struct {
+	Data DefaultRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// DeletionCertificateResponse defines model for DeletionCertificateResponse.
+type DeletionCertificateResponse struct {
+	Data DeletionCertificate `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// DockerArtifactDetailResponse defines model for DockerArtifactDetailResponse.
+type DockerArtifactDetailResponse struct {
+	// Data Docker Artifact Detail
+	Data DockerArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// DockerArtifactManifestResponse defines model for DockerArtifactManifestResponse.
+type DockerArtifactManifestResponse struct {
+	// Data Docker Artifact Manifest
+	Data DockerArtifactManifest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// DockerLayersResponse defines model for DockerLayersResponse.
+type DockerLayersResponse struct {
+	// Data Harness Layers Summary
+	Data DockerLayersSummary `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// DockerManifestsResponse defines model for DockerManifestsResponse.
+type DockerManifestsResponse struct {
+	// Data Harness Manifests
+	Data DockerManifests `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// FileDetailResponse defines model for FileDetailResponse.
+type FileDetailResponse struct {
+	// Files A list of Harness Artifact Files
+	Files []FileDetail `json:"files"`
+
+	// ItemCount The total number of items
+	ItemCount *int64 `json:"itemCount,omitempty"`
+
+	// PageCount The total number of pages
+	PageCount *int64 `json:"pageCount,omitempty"`
+
+	// PageIndex The current page
+	PageIndex *int64 `json:"pageIndex,omitempty"`
+
+	// PageSize The number of items per page
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// HelmArtifactDetailResponse defines model for HelmArtifactDetailResponse.
+type HelmArtifactDetailResponse struct {
+	// Data Helm Artifact Detail
+	Data HelmArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// HelmArtifactManifestResponse defines model for HelmArtifactManifestResponse.
+type HelmArtifactManifestResponse struct {
+	// Data Helm Artifact Manifest
+	Data HelmArtifactManifest `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// HelmPushPolicyResponse defines model for HelmPushPolicyResponse.
+type HelmPushPolicyResponse struct {
+	Data HelmPushPolicy `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// IdentityTokenResponse defines model for IdentityTokenResponse.
+type IdentityTokenResponse struct {
+	Data IdentityToken `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ImportEstimateResponse defines model for ImportEstimateResponse.
+type ImportEstimateResponse struct {
+	// Data Estimated size of an import. The sizes of the sampled tags are extrapolated to all tags, counting layers shared by sampled tags once.
+	Data ImportEstimate `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError Error
+
+// LegalHoldResponse defines model for LegalHoldResponse.
+type LegalHoldResponse struct {
+	Data LegalHold `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListAccessGrantsResponse defines model for ListAccessGrantsResponse.
+type ListAccessGrantsResponse struct {
+	Data []AccessGrant `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListAnnotatedManifestsResponse defines model for ListAnnotatedManifestsResponse.
+type ListAnnotatedManifestsResponse struct {
+	// Data A list of annotated manifests
+	Data ListAnnotatedManifests `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListArtifactClassesResponse defines model for ListArtifactClassesResponse.
+type ListArtifactClassesResponse struct {
+	// Data A list of Java classes inside artifacts
+	Data ListArtifactClasses `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListArtifactContentsResponse defines model for ListArtifactContentsResponse.
+type ListArtifactContentsResponse struct {
+	// Data A list of files inside artifacts
+	Data ListArtifactContents `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListArtifactLabelResponse defines model for ListArtifactLabelResponse.
+type ListArtifactLabelResponse struct {
+	// Data A list of Harness Artifact Labels
+	Data ListArtifactLabel `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListArtifactResponse defines model for ListArtifactResponse.
+type ListArtifactResponse struct {
+	// Data A list of Artifacts
+	Data ListArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListArtifactVersionResponse defines model for ListArtifactVersionResponse.
+type ListArtifactVersionResponse struct {
+	// Data A list of Artifact versions
+	Data ListArtifactVersion `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListClaimMappingsResponse defines model for ListClaimMappingsResponse.
+type ListClaimMappingsResponse struct {
+	Data []ClaimMapping `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListDefaultRegistriesResponse defines model for ListDefaultRegistriesResponse.
+type ListDefaultRegistriesResponse struct {
+	Data []DefaultRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListDeletionCertificatesResponse defines model for ListDeletionCertificatesResponse.
+type ListDeletionCertificatesResponse struct {
+	// Data A list of deletion certificates
+	Data ListDeletionCertificates `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListImageLayerContentsResponse defines model for ListImageLayerContentsResponse.
+type ListImageLayerContentsResponse struct {
+	// Data A list of files inside image layers
+	Data ListImageLayerContents `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListLegalHoldsResponse defines model for ListLegalHoldsResponse.
+type ListLegalHoldsResponse struct {
+	Data []LegalHold `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListMavenRelocationsResponse defines model for ListMavenRelocationsResponse.
+type ListMavenRelocationsResponse struct {
+	Data []MavenRelocation `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListMirrorSyncRunsResponse defines model for ListMirrorSyncRunsResponse.
+type ListMirrorSyncRunsResponse struct {
+	Data []MirrorSyncRun `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListMirrorSyncsResponse defines model for ListMirrorSyncsResponse.
+type ListMirrorSyncsResponse struct {
+	Data []MirrorSync `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryArtifactResponse defines model for ListRegistryArtifactResponse.
+type ListRegistryArtifactResponse struct {
+	// Data A list of Artifacts
+	Data ListRegistryArtifact `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryConfigRevisionsResponse defines model for ListRegistryConfigRevisionsResponse.
+type ListRegistryConfigRevisionsResponse struct {
+	// Data A list of revisions of the configuration of a registry
+	Data ListRegistryConfigRevisions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryQueuesResponse defines model for ListRegistryQueuesResponse.
+type ListRegistryQueuesResponse struct {
+	Data []RegistryQueue `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListRegistryResponse defines model for ListRegistryResponse.
+type ListRegistryResponse struct {
+	// Data A list of Harness Artifact Registries
+	Data ListRegistry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListScanResultsResponse defines model for ListScanResultsResponse.
+type ListScanResultsResponse struct {
+	Data []ScanResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListVexStatementsResponse defines model for ListVexStatementsResponse.
+type ListVexStatementsResponse struct {
+	Data []VexStatement `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListVulnerabilityAllowlistResponse defines model for ListVulnerabilityAllowlistResponse.
+type ListVulnerabilityAllowlistResponse struct {
+	Data []VulnerabilityAllowlistEntry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListWebhooksExecutionResponse defines model for ListWebhooksExecutionResponse.
+type ListWebhooksExecutionResponse struct {
+	// Data A list of Harness Registries webhooks executions
+	Data ListWebhooksExecutions `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ListWebhooksResponse defines model for ListWebhooksResponse.
+type ListWebhooksResponse struct {
+	// Data A list of Harness Registries webhooks
+	Data ListWebhooks `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// MavenRelocationResponse defines model for MavenRelocationResponse.
+type MavenRelocationResponse struct {
+	Data MavenRelocation `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// MirrorSyncResponse defines model for MirrorSyncResponse.
+type MirrorSyncResponse struct {
+	Data MirrorSync `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// MirrorSyncRunResponse defines model for MirrorSyncRunResponse.
+type MirrorSyncRunResponse struct {
+	Data MirrorSyncRun `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ModelArtifactDetailResponse defines model for ModelArtifactDetailResponse.
+type ModelArtifactDetailResponse struct {
+	// Data ML Model Artifact Detail
+	Data ModelArtifactDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// NotFound defines model for NotFound.
+type NotFound Error
+
+// PackageImpactResponse defines model for PackageImpactResponse.
+type PackageImpactResponse struct {
+	Data PackageImpactReport `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// PermalinkResponse defines model for PermalinkResponse.
+type PermalinkResponse struct {
+	// Data Permalink of an artifact version.
+	Data Permalink `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryConfigApplyResponse defines model for RegistryConfigApplyResponse.
+type RegistryConfigApplyResponse struct {
+	// Data Changes that applying a registry configuration made, or would make on a dry run
+	Data RegistryConfigApplyResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryConfigResponse defines model for RegistryConfigResponse.
+type RegistryConfigResponse struct {
+	Data RegistryConfigExport `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryConfigRevisionResponse defines model for RegistryConfigRevisionResponse.
+type RegistryConfigRevisionResponse struct {
+	Data RegistryConfigRevisionDetail `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryDownloadOriginsResponse defines model for RegistryDownloadOriginsResponse.
+type RegistryDownloadOriginsResponse struct {
+	// Data Downloads of the artifacts of a registry by where they were downloaded from
+	Data RegistryDownloadOrigins `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryIdentifierCheckResponse defines model for RegistryIdentifierCheckResponse.
+type RegistryIdentifierCheckResponse struct {
+	// Data Whether a registry can be created with an identifier
+	Data RegistryIdentifierCheck `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryMembersResponse defines model for RegistryMembersResponse.
+type RegistryMembersResponse struct {
+	Data []RegistryMember `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryOnboardingResponse defines model for RegistryOnboardingResponse.
+type RegistryOnboardingResponse struct {
+	// Data Registry created by the onboarding along with the defaults applied to it
+	Data RegistryOnboarding `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryPolicySimulationResponse defines model for RegistryPolicySimulationResponse.
+type RegistryPolicySimulationResponse struct {
+	// Data Outcome of draft policies on the versions of a registry. Tags of OCI registries are evaluated, along with their pulls, and versions of other registries by age only.
+	Data RegistryPolicySimulation `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryQueueResponse defines model for RegistryQueueResponse.
+type RegistryQueueResponse struct {
+	// Data Backlog of a queue of background operations of a registry
+	Data RegistryQueue `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistryResponse defines model for RegistryResponse.
+type RegistryResponse struct {
+	// Data Harness Artifact Registry
+	Data Registry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// RegistrySecurityPostureResponse defines model for RegistrySecurityPostureResponse.
+type RegistrySecurityPostureResponse struct {
+	// Data Security state of the versions of a registry
+	Data RegistrySecurityPosture `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ResolveTraceResponse defines model for ResolveTraceResponse.
+type ResolveTraceResponse struct {
+	Data ResolveTrace `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// ScanResultResponse defines model for ScanResultResponse.
+type ScanResultResponse struct {
+	// Data Scan result of an artifact
+	Data ScanResult `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// Success defines model for Success.
+type Success struct {
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// Unauthenticated defines model for Unauthenticated.
+type Unauthenticated Error
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized Error
+
+// UpstreamCheckResponse defines model for UpstreamCheckResponse.
+type UpstreamCheckResponse struct {
+	// Data Result of checking an upstream
+	Data UpstreamCheck `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// UpstreamStatusResponse defines model for UpstreamStatusResponse.
+type UpstreamStatusResponse struct {
+	Data UpstreamStatus `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// VersionComparisonResponse defines model for VersionComparisonResponse.
+type VersionComparisonResponse struct {
+	// Data Versions sorted in ascending order
+	Data VersionComparison `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// VersionStorageResponse defines model for VersionStorageResponse.
+type VersionStorageResponse struct {
+	Data VersionStorage `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// VexDocumentResponse defines model for VexDocumentResponse.
+type VexDocumentResponse struct {
+	// Data VEX document attached to an artifact
+	Data VexDocument `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// VulnerabilityAllowlistEntryResponse defines model for VulnerabilityAllowlistEntryResponse.
+type VulnerabilityAllowlistEntryResponse struct {
+	// Data Vulnerability allowlisted for a package, or one of its artifacts, until an expiry date
+	Data VulnerabilityAllowlistEntry `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// WebhookExecutionResponse defines model for WebhookExecutionResponse.
+type WebhookExecutionResponse struct {
+	// Data Harness Regstries Webhook Execution
+	Data WebhookExecution `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	// Data Harness Regstries Webhook
+	Data Webhook `json:"data"`
+
+	// Status Indicates if the request was successful or not
+	Status Status `json:"status"`
+}
+
+// UpstreamCredentialsRequest defines model for UpstreamCredentialsRequest.
+type UpstreamCredentialsRequest UpstreamCredentials
+
+// VexDocumentRequest defines model for VexDocumentRequest.
+type VexDocumentRequest map[string]interface{}
+
+// CreateRegistryParams defines parameters for CreateRegistry.
+type CreateRegistryParams struct {
+	// SpaceRef Unique space path
+	SpaceRef *SpaceRefQueryParam `form:"space_ref,omitempty" json:"space_ref,omitempty"`
+}
+
+// SearchDockerManifestsByAnnotationParams defines parameters for SearchDockerManifestsByAnnotation.
+type SearchDockerManifestsByAnnotationParams struct {
+	// Key Annotation key, e.g. org.opencontainers.image.source
+	Key AnnotationKeyParam `form:"key" json:"key"`
+
+	// Value Exact annotation value
+	Value *AnnotationValueParam `form:"value,omitempty" json:"value,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListArtifactLabelsParams defines parameters for ListArtifactLabels.
+type ListArtifactLabelsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+}
+
+// GetArtifactStatsForRegistryParams defines parameters for GetArtifactStatsForRegistry.
+type GetArtifactStatsForRegistryParams struct {
+	// From Date. Format - MM/DD/YYYY
+	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Date. Format - MM/DD/YYYY
+	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetArtifactBadgeParams defines parameters for GetArtifactBadge.
+type GetArtifactBadgeParams struct {
+	// Style Style of the badge, flat by default.
+	Style *BadgeStyleParam `form:"style,omitempty" json:"style,omitempty"`
+
+	// Label Text of the left side of the badge, replacing the default label. Empty to leave it out.
+	Label *BadgeLabelParam `form:"label,omitempty" json:"label,omitempty"`
+
+	// Color Color of the message, replacing the color given by the value of the badge. Either a named color of shields.io, like brightgreen or orange, or a hex color.
+	Color *BadgeColorParam `form:"color,omitempty" json:"color,omitempty"`
+
+	// LabelColor Color of the label, grey by default.
+	LabelColor *BadgeLabelColorParam `form:"labelColor,omitempty" json:"labelColor,omitempty"`
+
+	// MaxAge Number of seconds clients and proxies may cache the badge for, 300 by default.
+	MaxAge *BadgeMaxAgeParam `form:"maxAge,omitempty" json:"maxAge,omitempty"`
+
+	// IfNoneMatch ETag of the cached version of the resource.
+	IfNoneMatch *IfNoneMatchHeaderParam `json:"If-None-Match,omitempty"`
+}
+
+// GetArtifactFeedParams defines parameters for GetArtifactFeed.
+type GetArtifactFeedParams struct {
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// IfNoneMatch ETag of the cached version of the resource.
+	IfNoneMatch *IfNoneMatchHeaderParam `json:"If-None-Match,omitempty"`
+}
+
+// GetArtifactStatsParams defines parameters for GetArtifactStats.
+type GetArtifactStatsParams struct {
+	// From Date. Format - MM/DD/YYYY
+	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Date. Format - MM/DD/YYYY
+	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetArtifactDetailsParams defines parameters for GetArtifactDetails.
+type GetArtifactDetailsParams struct {
+	// ChildVersion Child version incase of Docker artifacts.
+	ChildVersion *ChildVersionParam `form:"childVersion,omitempty" json:"childVersion,omitempty"`
+}
+
+// GetDockerArtifactDetailsParams defines parameters for GetDockerArtifactDetails.
+type GetDockerArtifactDetailsParams struct {
+	// Digest Digest.
+	Digest DigestParam `form:"digest" json:"digest"`
+}
+
+// GetDockerArtifactLayersParams defines parameters for GetDockerArtifactLayers.
+type GetDockerArtifactLayersParams struct {
+	// Digest Digest.
+	Digest DigestParam `form:"digest" json:"digest"`
+}
+
+// GetDockerArtifactManifestParams defines parameters for GetDockerArtifactManifest.
+type GetDockerArtifactManifestParams struct {
+	// Digest Digest.
+	Digest DigestParam `form:"digest" json:"digest"`
+}
+
+// HeadArtifactFileParams defines parameters for HeadArtifactFile.
+type HeadArtifactFileParams struct {
+	// FileName Name of the file, relative to the artifact version.
+	FileName FileNameQueryParam `form:"file_name" json:"file_name"`
+}
+
+// GetArtifactFilesParams defines parameters for GetArtifactFiles.
+type GetArtifactFilesParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
+	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+}
+
+// GetAllArtifactVersionsParams defines parameters for GetAllArtifactVersions.
+type GetAllArtifactVersionsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
+	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// ExactCount Counts the items for this request instead of serving a recently cached count, which may lag behind the latest changes.
+	ExactCount *ExactCountParam `form:"exact_count,omitempty" json:"exact_count,omitempty"`
+}
+
+// GetAllArtifactsByRegistryParams defines parameters for GetAllArtifactsByRegistry.
+type GetAllArtifactsByRegistryParams struct {
+	// Label Label.
+	Label *LabelsParam `form:"label,omitempty" json:"label,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
+	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// ExactCount Counts the items for this request instead of serving a recently cached count, which may lag behind the latest changes.
+	ExactCount *ExactCountParam `form:"exact_count,omitempty" json:"exact_count,omitempty"`
+
+	// Merged Lists the artifacts of the members of a virtual registry along with its own. Every artifact keeps the identifier of the registry it's stored in.
+	Merged *MergedParam `form:"merged,omitempty" json:"merged,omitempty"`
+}
+
+// ExportRegistryArtifactsParams defines parameters for ExportRegistryArtifacts.
+type ExportRegistryArtifactsParams struct {
+	// Label Label.
+	Label *LabelsParam `form:"label,omitempty" json:"label,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
+	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// Merged Lists the artifacts of the members of a virtual registry along with its own. Every artifact keeps the identifier of the registry it's stored in.
+	Merged *MergedParam `form:"merged,omitempty" json:"merged,omitempty"`
+}
+
+// SearchArtifactClassesParams defines parameters for SearchArtifactClasses.
+type SearchArtifactClassesParams struct {
+	// Query Simple or qualified name of the class, matched case insensitively
+	Query ClassQueryParam `form:"query" json:"query"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetClientSetupDetailsParams defines parameters for GetClientSetupDetails.
+type GetClientSetupDetailsParams struct {
+	// Artifact Artifat
+	Artifact *ArtifactParam `form:"artifact,omitempty" json:"artifact,omitempty"`
+
+	// Version Version
+	Version *VersionParam `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// ListRegistryConfigRevisionsParams defines parameters for ListRegistryConfigRevisions.
+type ListRegistryConfigRevisionsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// SearchArtifactContentsParams defines parameters for SearchArtifactContents.
+type SearchArtifactContentsParams struct {
+	// Query Part of the path of the file, matched case insensitively
+	Query ContentQueryParam `form:"query" json:"query"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListDeletionCertificatesParams defines parameters for ListDeletionCertificates.
+type ListDeletionCertificatesParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetRegistryDownloadOriginsParams defines parameters for GetRegistryDownloadOrigins.
+type GetRegistryDownloadOriginsParams struct {
+	// From Date. Format - MM/DD/YYYY
+	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Date. Format - MM/DD/YYYY
+	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetRegistryFeedParams defines parameters for GetRegistryFeed.
+type GetRegistryFeedParams struct {
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// IfNoneMatch ETag of the cached version of the resource.
+	IfNoneMatch *IfNoneMatchHeaderParam `json:"If-None-Match,omitempty"`
+}
+
+// SearchImageLayerContentsParams defines parameters for SearchImageLayerContents.
+type SearchImageLayerContentsParams struct {
+	// Query Absolute path of the file, or its file name
+	Query LayerQueryParam `form:"query" json:"query"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetResolveTraceParams defines parameters for GetResolveTrace.
+type GetResolveTraceParams struct {
+	// Artifact Name of the artifact, "groupId:artifactId" for Maven.
+	Artifact ResolveArtifactParam `form:"artifact" json:"artifact"`
+
+	// Version Version, tag or digest of the artifact.
+	Version ResolveVersionParam `form:"version" json:"version"`
+
+	// File Name of a file of the version, for Maven and generic artifacts.
+	File *ResolveFileParam `form:"file,omitempty" json:"file,omitempty"`
+}
+
+// ResolveArtifactPathParams defines parameters for ResolveArtifactPath.
+type ResolveArtifactPathParams struct {
+	// Path Path of the artifact as it's pulled from the registry, e.g. "com/acme/app/1.0/app-1.0.jar".
+	Path ResolvePathParam `form:"path" json:"path"`
+}
+
+// ListScanResultsParams defines parameters for ListScanResults.
+type ListScanResultsParams struct {
+	// Digest Digest.
+	Digest DigestParam `form:"digest" json:"digest"`
+}
+
+// ListPackageRuleViolationsParams defines parameters for ListPackageRuleViolations.
+type ListPackageRuleViolationsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListVexStatementsParams defines parameters for ListVexStatements.
+type ListVexStatementsParams struct {
+	// Digest Digest.
+	Digest DigestParam `form:"digest" json:"digest"`
+}
+
+// UploadVexDocumentJSONBody defines parameters for UploadVexDocument.
+type UploadVexDocumentJSONBody map[string]interface{}
+
+// UploadVexDocumentParams defines parameters for UploadVexDocument.
+type UploadVexDocumentParams struct {
+	// Digest Digest.
+	Digest DigestParam `form:"digest" json:"digest"`
+}
+
+// ListWebhooksParams defines parameters for ListWebhooks.
+type ListWebhooksParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
+	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+}
+
+// ListWebhookExecutionsParams defines parameters for ListWebhookExecutions.
+type ListWebhookExecutionsParams struct {
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetArtifactStatsForSpaceParams defines parameters for GetArtifactStatsForSpace.
+type GetArtifactStatsForSpaceParams struct {
+	// From Date. Format - MM/DD/YYYY
+	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Date. Format - MM/DD/YYYY
+	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetAllArtifactsParams defines parameters for GetAllArtifacts.
+type GetAllArtifactsParams struct {
+	// RegIdentifier Registry Identifier
+	RegIdentifier *RegistryIdentifierParam `form:"reg_identifier,omitempty" json:"reg_identifier,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
+	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// LatestVersion Latest Version Filter.
+	LatestVersion *LatestVersion `form:"latest_version,omitempty" json:"latest_version,omitempty"`
+
+	// PackageType Registry Package Type
+	PackageType *PackageTypeParam `form:"package_type,omitempty" json:"package_type,omitempty"`
+
+	// ExactCount Counts the items for this request instead of serving a recently cached count, which may lag behind the latest changes.
+	ExactCount *ExactCountParam `form:"exact_count,omitempty" json:"exact_count,omitempty"`
+}
+
+// ExportArtifactsParams defines parameters for ExportArtifacts.
+type ExportArtifactsParams struct {
+	// RegIdentifier Registry Identifier
+	RegIdentifier *RegistryIdentifierParam `form:"reg_identifier,omitempty" json:"reg_identifier,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
+	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// LatestVersion Latest Version Filter.
+	LatestVersion *LatestVersion `form:"latest_version,omitempty" json:"latest_version,omitempty"`
+
+	// PackageType Registry Package Type
+	PackageType *PackageTypeParam `form:"package_type,omitempty" json:"package_type,omitempty"`
+}
+
+// GetAllRegistriesParams defines parameters for GetAllRegistries.
+type GetAllRegistriesParams struct {
+	// PackageType Registry Package Type
+	PackageType *PackageTypeParam `form:"package_type,omitempty" json:"package_type,omitempty"`
+
+	// Type Registry Type
+	Type *GetAllRegistriesParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Page Current page number
+	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Number of items per page
+	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// SortOrder sortOrder
+	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// SortField sortField
 	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
 
-	// SearchTerm search Term.
-	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+	// SearchTerm search Term.
+	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+
+	// Recursive Whether to list registries recursively.
+	Recursive *RecursiveParam `form:"recursive,omitempty" json:"recursive,omitempty"`
+}
+
+// GetAllRegistriesParamsType defines parameters for GetAllRegistries.
+type GetAllRegistriesParamsType string
+
+// GetPackageImpactParams defines parameters for GetPackageImpact.
+type GetPackageImpactParams struct {
+	// Package Name of the vulnerable package.
+	Package ImpactPackageParam `form:"package" json:"package"`
+
+	// Range Vulnerable version range in the native syntax of the scheme, every version if empty.
+	Range *ImpactRangeParam `form:"range,omitempty" json:"range,omitempty"`
+
+	// Scheme Version scheme of the range, SEMVER by default.
+	Scheme *ImpactSchemeParam `form:"scheme,omitempty" json:"scheme,omitempty"`
+
+	// Vulnerability Vulnerability identifier; artifacts with VEX statements suppressing it are left out.
+	Vulnerability *ImpactVulnerabilityParam `form:"vulnerability,omitempty" json:"vulnerability,omitempty"`
+}
+
+// CreateRegistryJSONRequestBody defines body for CreateRegistry for application/json ContentType.
+type CreateRegistryJSONRequestBody RegistryRequest
+
+// CreateRegistriesJSONRequestBody defines body for CreateRegistries for application/json ContentType.
+type CreateRegistriesJSONRequestBody BulkRegistryRequest
+
+// ApplyRegistryConfigJSONRequestBody defines body for ApplyRegistryConfig for application/json ContentType.
+type ApplyRegistryConfigJSONRequestBody RegistryConfigApplyRequest
+
+// ExchangeIdentityTokenJSONRequestBody defines body for ExchangeIdentityToken for application/json ContentType.
+type ExchangeIdentityTokenJSONRequestBody IdentityTokenRequest
+
+// OnboardRegistryJSONRequestBody defines body for OnboardRegistry for application/json ContentType.
+type OnboardRegistryJSONRequestBody RegistryOnboardingRequest
+
+// ValidateRegistryIdentifierJSONRequestBody defines body for ValidateRegistryIdentifier for application/json ContentType.
+type ValidateRegistryIdentifierJSONRequestBody RegistryIdentifierCheckRequest
+
+// EstimateRegistryImportJSONRequestBody defines body for EstimateRegistryImport for application/json ContentType.
+type EstimateRegistryImportJSONRequestBody ImportEstimateRequest
+
+// CheckUpstreamConnectivityJSONRequestBody defines body for CheckUpstreamConnectivity for application/json ContentType.
+type CheckUpstreamConnectivityJSONRequestBody UpstreamCheckRequest
+
+// ModifyRegistryJSONRequestBody defines body for ModifyRegistry for application/json ContentType.
+type ModifyRegistryJSONRequestBody RegistryRequest
+
+// CreateAccessGrantJSONRequestBody defines body for CreateAccessGrant for application/json ContentType.
+type CreateAccessGrantJSONRequestBody AccessGrantRequest
+
+// UpdateArtifactLabelsJSONRequestBody defines body for UpdateArtifactLabels for application/json ContentType.
+type UpdateArtifactLabelsJSONRequestBody ArtifactLabelRequest
+
+// PurgeArtifactVersionJSONRequestBody defines body for PurgeArtifactVersion for application/json ContentType.
+type PurgeArtifactVersionJSONRequestBody PurgeArtifactVersionRequest
+
+// CloneRegistryJSONRequestBody defines body for CloneRegistry for application/json ContentType.
+type CloneRegistryJSONRequestBody RegistryCloneRequest
+
+// SetHelmPushPolicyJSONRequestBody defines body for SetHelmPushPolicy for application/json ContentType.
+type SetHelmPushPolicyJSONRequestBody HelmPushPolicyRequest
+
+// CreateLegalHoldJSONRequestBody defines body for CreateLegalHold for application/json ContentType.
+type CreateLegalHoldJSONRequestBody LegalHoldRequest
+
+// CreateMavenRelocationJSONRequestBody defines body for CreateMavenRelocation for application/json ContentType.
+type CreateMavenRelocationJSONRequestBody MavenRelocationRequest
+
+// ReorderRegistryMembersJSONRequestBody defines body for ReorderRegistryMembers for application/json ContentType.
+type ReorderRegistryMembersJSONRequestBody RegistryMembersRequest
+
+// CreateMirrorSyncJSONRequestBody defines body for CreateMirrorSync for application/json ContentType.
+type CreateMirrorSyncJSONRequestBody MirrorSyncRequest
+
+// UpdateMirrorSyncJSONRequestBody defines body for UpdateMirrorSync for application/json ContentType.
+type UpdateMirrorSyncJSONRequestBody MirrorSyncRequest
+
+// SimulateRegistryPoliciesJSONRequestBody defines body for SimulateRegistryPolicies for application/json ContentType.
+type SimulateRegistryPoliciesJSONRequestBody RegistryPolicySimulationRequest
+
+// IngestScanResultJSONRequestBody defines body for IngestScanResult for application/json ContentType.
+type IngestScanResultJSONRequestBody ScanResultRequest
+
+// CreateVulnerabilityAllowlistEntryJSONRequestBody defines body for CreateVulnerabilityAllowlistEntry for application/json ContentType.
+type CreateVulnerabilityAllowlistEntryJSONRequestBody VulnerabilityAllowlistRequest
+
+// SetCacheEvictionPolicyJSONRequestBody defines body for SetCacheEvictionPolicy for application/json ContentType.
+type SetCacheEvictionPolicyJSONRequestBody CacheEvictionPolicyRequest
+
+// RotateUpstreamCredentialsJSONRequestBody defines body for RotateUpstreamCredentials for application/json ContentType.
+type RotateUpstreamCredentialsJSONRequestBody UpstreamCredentials
+
+// CreatePackageRuleJSONRequestBody defines body for CreatePackageRule for application/json ContentType.
+type CreatePackageRuleJSONRequestBody PackageRuleRequest
+
+// PrewarmUpstreamCacheJSONRequestBody defines body for PrewarmUpstreamCache for application/json ContentType.
+type PrewarmUpstreamCacheJSONRequestBody CachePrewarmRequest
+
+// SetUpstreamURLsJSONRequestBody defines body for SetUpstreamURLs for application/json ContentType.
+type SetUpstreamURLsJSONRequestBody UpstreamURLsRequest
+
+// UploadVexDocumentJSONRequestBody defines body for UploadVexDocument for application/json ContentType.
+type UploadVexDocumentJSONRequestBody UploadVexDocumentJSONBody
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody WebhookRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody WebhookRequest
+
+// CreateClaimMappingJSONRequestBody defines body for CreateClaimMapping for application/json ContentType.
+type CreateClaimMappingJSONRequestBody ClaimMappingRequest
+
+// SetDefaultRegistryJSONRequestBody defines body for SetDefaultRegistry for application/json ContentType.
+type SetDefaultRegistryJSONRequestBody DefaultRegistryRequest
+
+// CompareVersionsJSONRequestBody defines body for CompareVersions for application/json ContentType.
+type CompareVersionsJSONRequestBody VersionCompareRequest
+
+// AsDockerArtifactDetailConfig returns the union data inside the ArtifactDetail as a DockerArtifactDetailConfig
+func (t ArtifactDetail) AsDockerArtifactDetailConfig() (DockerArtifactDetailConfig, error) {
+	var body DockerArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDockerArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided DockerArtifactDetailConfig
+func (t *ArtifactDetail) FromDockerArtifactDetailConfig(v DockerArtifactDetailConfig) error {
+	t.PackageType = "DOCKER"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDockerArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided DockerArtifactDetailConfig
+func (t *ArtifactDetail) MergeDockerArtifactDetailConfig(v DockerArtifactDetailConfig) error {
+	t.PackageType = "DOCKER"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHelmArtifactDetailConfig returns the union data inside the ArtifactDetail as a HelmArtifactDetailConfig
+func (t ArtifactDetail) AsHelmArtifactDetailConfig() (HelmArtifactDetailConfig, error) {
+	var body HelmArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHelmArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided HelmArtifactDetailConfig
+func (t *ArtifactDetail) FromHelmArtifactDetailConfig(v HelmArtifactDetailConfig) error {
+	t.PackageType = "HELM"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHelmArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided HelmArtifactDetailConfig
+func (t *ArtifactDetail) MergeHelmArtifactDetailConfig(v HelmArtifactDetailConfig) error {
+	t.PackageType = "HELM"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsGenericArtifactDetailConfig returns the union data inside the ArtifactDetail as a GenericArtifactDetailConfig
+func (t ArtifactDetail) AsGenericArtifactDetailConfig() (GenericArtifactDetailConfig, error) {
+	var body GenericArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromGenericArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided GenericArtifactDetailConfig
+func (t *ArtifactDetail) FromGenericArtifactDetailConfig(v GenericArtifactDetailConfig) error {
+	t.PackageType = "GENERIC"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeGenericArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided GenericArtifactDetailConfig
+func (t *ArtifactDetail) MergeGenericArtifactDetailConfig(v GenericArtifactDetailConfig) error {
+	t.PackageType = "GENERIC"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMavenArtifactDetailConfig returns the union data inside the ArtifactDetail as a MavenArtifactDetailConfig
+func (t ArtifactDetail) AsMavenArtifactDetailConfig() (MavenArtifactDetailConfig, error) {
+	var body MavenArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMavenArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided MavenArtifactDetailConfig
+func (t *ArtifactDetail) FromMavenArtifactDetailConfig(v MavenArtifactDetailConfig) error {
+	t.PackageType = "MAVEN"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMavenArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided MavenArtifactDetailConfig
+func (t *ArtifactDetail) MergeMavenArtifactDetailConfig(v MavenArtifactDetailConfig) error {
+	t.PackageType = "MAVEN"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPythonArtifactDetailConfig returns the union data inside the ArtifactDetail as a PythonArtifactDetailConfig
+func (t ArtifactDetail) AsPythonArtifactDetailConfig() (PythonArtifactDetailConfig, error) {
+	var body PythonArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPythonArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided PythonArtifactDetailConfig
+func (t *ArtifactDetail) FromPythonArtifactDetailConfig(v PythonArtifactDetailConfig) error {
+	t.PackageType = "PYTHON"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePythonArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided PythonArtifactDetailConfig
+func (t *ArtifactDetail) MergePythonArtifactDetailConfig(v PythonArtifactDetailConfig) error {
+	t.PackageType = "PYTHON"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNpmArtifactDetailConfig returns the union data inside the ArtifactDetail as a NpmArtifactDetailConfig
+func (t ArtifactDetail) AsNpmArtifactDetailConfig() (NpmArtifactDetailConfig, error) {
+	var body NpmArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNpmArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided NpmArtifactDetailConfig
+func (t *ArtifactDetail) FromNpmArtifactDetailConfig(v NpmArtifactDetailConfig) error {
+	t.PackageType = "NPM"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNpmArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided NpmArtifactDetailConfig
+func (t *ArtifactDetail) MergeNpmArtifactDetailConfig(v NpmArtifactDetailConfig) error {
+	t.PackageType = "NPM"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNugetArtifactDetailConfig returns the union data inside the ArtifactDetail as a NugetArtifactDetailConfig
+func (t ArtifactDetail) AsNugetArtifactDetailConfig() (NugetArtifactDetailConfig, error) {
+	var body NugetArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNugetArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided NugetArtifactDetailConfig
+func (t *ArtifactDetail) FromNugetArtifactDetailConfig(v NugetArtifactDetailConfig) error {
+	t.PackageType = "NUGET"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNugetArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided NugetArtifactDetailConfig
+func (t *ArtifactDetail) MergeNugetArtifactDetailConfig(v NugetArtifactDetailConfig) error {
+	t.PackageType = "NUGET"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsGemsArtifactDetailConfig returns the union data inside the ArtifactDetail as a GemsArtifactDetailConfig
+func (t ArtifactDetail) AsGemsArtifactDetailConfig() (GemsArtifactDetailConfig, error) {
+	var body GemsArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromGemsArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided GemsArtifactDetailConfig
+func (t *ArtifactDetail) FromGemsArtifactDetailConfig(v GemsArtifactDetailConfig) error {
+	t.PackageType = "GEMS"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeGemsArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided GemsArtifactDetailConfig
+func (t *ArtifactDetail) MergeGemsArtifactDetailConfig(v GemsArtifactDetailConfig) error {
+	t.PackageType = "GEMS"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCrateArtifactDetailConfig returns the union data inside the ArtifactDetail as a CrateArtifactDetailConfig
+func (t ArtifactDetail) AsCrateArtifactDetailConfig() (CrateArtifactDetailConfig, error) {
+	var body CrateArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCrateArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided CrateArtifactDetailConfig
+func (t *ArtifactDetail) FromCrateArtifactDetailConfig(v CrateArtifactDetailConfig) error {
+	t.PackageType = "CRATE"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCrateArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided CrateArtifactDetailConfig
+func (t *ArtifactDetail) MergeCrateArtifactDetailConfig(v CrateArtifactDetailConfig) error {
+	t.PackageType = "CRATE"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsGoArtifactDetailConfig returns the union data inside the ArtifactDetail as a GoArtifactDetailConfig
+func (t ArtifactDetail) AsGoArtifactDetailConfig() (GoArtifactDetailConfig, error) {
+	var body GoArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromGoArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided GoArtifactDetailConfig
+func (t *ArtifactDetail) FromGoArtifactDetailConfig(v GoArtifactDetailConfig) error {
+	t.PackageType = "GO"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeGoArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided GoArtifactDetailConfig
+func (t *ArtifactDetail) MergeGoArtifactDetailConfig(v GoArtifactDetailConfig) error {
+	t.PackageType = "GO"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDebArtifactDetailConfig returns the union data inside the ArtifactDetail as a DebArtifactDetailConfig
+func (t ArtifactDetail) AsDebArtifactDetailConfig() (DebArtifactDetailConfig, error) {
+	var body DebArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDebArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided DebArtifactDetailConfig
+func (t *ArtifactDetail) FromDebArtifactDetailConfig(v DebArtifactDetailConfig) error {
+	t.PackageType = "DEB"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDebArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided DebArtifactDetailConfig
+func (t *ArtifactDetail) MergeDebArtifactDetailConfig(v DebArtifactDetailConfig) error {
+	t.PackageType = "DEB"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsRpmArtifactDetailConfig returns the union data inside the ArtifactDetail as a RpmArtifactDetailConfig
+func (t ArtifactDetail) AsRpmArtifactDetailConfig() (RpmArtifactDetailConfig, error) {
+	var body RpmArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromRpmArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided RpmArtifactDetailConfig
+func (t *ArtifactDetail) FromRpmArtifactDetailConfig(v RpmArtifactDetailConfig) error {
+	t.PackageType = "RPM"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeRpmArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided RpmArtifactDetailConfig
+func (t *ArtifactDetail) MergeRpmArtifactDetailConfig(v RpmArtifactDetailConfig) error {
+	t.PackageType = "RPM"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsAlpineArtifactDetailConfig returns the union data inside the ArtifactDetail as a AlpineArtifactDetailConfig
+func (t ArtifactDetail) AsAlpineArtifactDetailConfig() (AlpineArtifactDetailConfig, error) {
+	var body AlpineArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAlpineArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided AlpineArtifactDetailConfig
+func (t *ArtifactDetail) FromAlpineArtifactDetailConfig(v AlpineArtifactDetailConfig) error {
+	t.PackageType = "ALPINE"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAlpineArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided AlpineArtifactDetailConfig
+func (t *ArtifactDetail) MergeAlpineArtifactDetailConfig(v AlpineArtifactDetailConfig) error {
+	t.PackageType = "ALPINE"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsConanArtifactDetailConfig returns the union data inside the ArtifactDetail as a ConanArtifactDetailConfig
+func (t ArtifactDetail) AsConanArtifactDetailConfig() (ConanArtifactDetailConfig, error) {
+	var body ConanArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromConanArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided ConanArtifactDetailConfig
+func (t *ArtifactDetail) FromConanArtifactDetailConfig(v ConanArtifactDetailConfig) error {
+	t.PackageType = "CONAN"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeConanArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided ConanArtifactDetailConfig
+func (t *ArtifactDetail) MergeConanArtifactDetailConfig(v ConanArtifactDetailConfig) error {
+	t.PackageType = "CONAN"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsComposerArtifactDetailConfig returns the union data inside the ArtifactDetail as a ComposerArtifactDetailConfig
+func (t ArtifactDetail) AsComposerArtifactDetailConfig() (ComposerArtifactDetailConfig, error) {
+	var body ComposerArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromComposerArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided ComposerArtifactDetailConfig
+func (t *ArtifactDetail) FromComposerArtifactDetailConfig(v ComposerArtifactDetailConfig) error {
+	t.PackageType = "COMPOSER"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeComposerArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided ComposerArtifactDetailConfig
+func (t *ArtifactDetail) MergeComposerArtifactDetailConfig(v ComposerArtifactDetailConfig) error {
+	t.PackageType = "COMPOSER"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCondaArtifactDetailConfig returns the union data inside the ArtifactDetail as a CondaArtifactDetailConfig
+func (t ArtifactDetail) AsCondaArtifactDetailConfig() (CondaArtifactDetailConfig, error) {
+	var body CondaArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCondaArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided CondaArtifactDetailConfig
+func (t *ArtifactDetail) FromCondaArtifactDetailConfig(v CondaArtifactDetailConfig) error {
+	t.PackageType = "CONDA"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCondaArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided CondaArtifactDetailConfig
+func (t *ArtifactDetail) MergeCondaArtifactDetailConfig(v CondaArtifactDetailConfig) error {
+	t.PackageType = "CONDA"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsTerraformArtifactDetailConfig returns the union data inside the ArtifactDetail as a TerraformArtifactDetailConfig
+func (t ArtifactDetail) AsTerraformArtifactDetailConfig() (TerraformArtifactDetailConfig, error) {
+	var body TerraformArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTerraformArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided TerraformArtifactDetailConfig
+func (t *ArtifactDetail) FromTerraformArtifactDetailConfig(v TerraformArtifactDetailConfig) error {
+	t.PackageType = "TERRAFORM"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTerraformArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided TerraformArtifactDetailConfig
+func (t *ArtifactDetail) MergeTerraformArtifactDetailConfig(v TerraformArtifactDetailConfig) error {
+	t.PackageType = "TERRAFORM"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// GetClientSetupDetailsParams defines parameters for GetClientSetupDetails.
-type GetClientSetupDetailsParams struct {
-	// Artifact Artifat
-	Artifact *ArtifactParam `form:"artifact,omitempty" json:"artifact,omitempty"`
-
-	// Version Version
-	Version *VersionParam `form:"version,omitempty" json:"version,omitempty"`
+// AsSwiftArtifactDetailConfig returns the union data inside the ArtifactDetail as a SwiftArtifactDetailConfig
+func (t ArtifactDetail) AsSwiftArtifactDetailConfig() (SwiftArtifactDetailConfig, error) {
+	var body SwiftArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-// ListWebhooksParams defines parameters for ListWebhooks.
-type ListWebhooksParams struct {
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+// FromSwiftArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided SwiftArtifactDetailConfig
+func (t *ArtifactDetail) FromSwiftArtifactDetailConfig(v SwiftArtifactDetailConfig) error {
+	t.PackageType = "SWIFT"
 
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	// SortOrder sortOrder
-	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+// MergeSwiftArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided SwiftArtifactDetailConfig
+func (t *ArtifactDetail) MergeSwiftArtifactDetailConfig(v SwiftArtifactDetailConfig) error {
+	t.PackageType = "SWIFT"
 
-	// SortField sortField
-	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	// SearchTerm search Term.
-	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// ListWebhookExecutionsParams defines parameters for ListWebhookExecutions.
-type ListWebhookExecutionsParams struct {
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
-
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+// AsCocoapodsArtifactDetailConfig returns the union data inside the ArtifactDetail as a CocoapodsArtifactDetailConfig
+func (t ArtifactDetail) AsCocoapodsArtifactDetailConfig() (CocoapodsArtifactDetailConfig, error) {
+	var body CocoapodsArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-// GetArtifactStatsForSpaceParams defines parameters for GetArtifactStatsForSpace.
-type GetArtifactStatsForSpaceParams struct {
-	// From Date. Format - MM/DD/YYYY
-	From *FromDateParam `form:"from,omitempty" json:"from,omitempty"`
+// FromCocoapodsArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided CocoapodsArtifactDetailConfig
+func (t *ArtifactDetail) FromCocoapodsArtifactDetailConfig(v CocoapodsArtifactDetailConfig) error {
+	t.PackageType = "COCOAPODS"
 
-	// To Date. Format - MM/DD/YYYY
-	To *ToDateParam `form:"to,omitempty" json:"to,omitempty"`
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// GetAllArtifactsParams defines parameters for GetAllArtifacts.
-type GetAllArtifactsParams struct {
-	// RegIdentifier Registry Identifier
-	RegIdentifier *RegistryIdentifierParam `form:"reg_identifier,omitempty" json:"reg_identifier,omitempty"`
-
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
-
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+// MergeCocoapodsArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided CocoapodsArtifactDetailConfig
+func (t *ArtifactDetail) MergeCocoapodsArtifactDetailConfig(v CocoapodsArtifactDetailConfig) error {
+	t.PackageType = "COCOAPODS"
 
-	// SortOrder sortOrder
-	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	// SortField sortField
-	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	// SearchTerm search Term.
-	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+// AsHexArtifactDetailConfig returns the union data inside the ArtifactDetail as a HexArtifactDetailConfig
+func (t ArtifactDetail) AsHexArtifactDetailConfig() (HexArtifactDetailConfig, error) {
+	var body HexArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	// LatestVersion Latest Version Filter.
-	LatestVersion *LatestVersion `form:"latest_version,omitempty" json:"latest_version,omitempty"`
+// FromHexArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided HexArtifactDetailConfig
+func (t *ArtifactDetail) FromHexArtifactDetailConfig(v HexArtifactDetailConfig) error {
+	t.PackageType = "HEX"
 
-	// PackageType Registry Package Type
-	PackageType *PackageTypeParam `form:"package_type,omitempty" json:"package_type,omitempty"`
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// GetAllRegistriesParams defines parameters for GetAllRegistries.
-type GetAllRegistriesParams struct {
-	// PackageType Registry Package Type
-	PackageType *PackageTypeParam `form:"package_type,omitempty" json:"package_type,omitempty"`
+// MergeHexArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided HexArtifactDetailConfig
+func (t *ArtifactDetail) MergeHexArtifactDetailConfig(v HexArtifactDetailConfig) error {
+	t.PackageType = "HEX"
 
-	// Type Registry Type
-	Type *GetAllRegistriesParamsType `form:"type,omitempty" json:"type,omitempty"`
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-	// Page Current page number
-	Page *PageNumber `form:"page,omitempty" json:"page,omitempty"`
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-	// Size Number of items per page
-	Size *PageSize `form:"size,omitempty" json:"size,omitempty"`
+// AsPubArtifactDetailConfig returns the union data inside the ArtifactDetail as a PubArtifactDetailConfig
+func (t ArtifactDetail) AsPubArtifactDetailConfig() (PubArtifactDetailConfig, error) {
+	var body PubArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-	// SortOrder sortOrder
-	SortOrder *SortOrder `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+// FromPubArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided PubArtifactDetailConfig
+func (t *ArtifactDetail) FromPubArtifactDetailConfig(v PubArtifactDetailConfig) error {
+	t.PackageType = "PUB"
 
-	// SortField sortField
-	SortField *SortField `form:"sort_field,omitempty" json:"sort_field,omitempty"`
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-	// SearchTerm search Term.
-	SearchTerm *SearchTerm `form:"search_term,omitempty" json:"search_term,omitempty"`
+// MergePubArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided PubArtifactDetailConfig
+func (t *ArtifactDetail) MergePubArtifactDetailConfig(v PubArtifactDetailConfig) error {
+	t.PackageType = "PUB"
 
-	// Recursive Whether to list registries recursively.
-	Recursive *RecursiveParam `form:"recursive,omitempty" json:"recursive,omitempty"`
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// GetAllRegistriesParamsType defines parameters for GetAllRegistries.
-type GetAllRegistriesParamsType string
+// AsCranArtifactDetailConfig returns the union data inside the ArtifactDetail as a CranArtifactDetailConfig
+func (t ArtifactDetail) AsCranArtifactDetailConfig() (CranArtifactDetailConfig, error) {
+	var body CranArtifactDetailConfig
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-// CreateRegistryJSONRequestBody defines body for CreateRegistry for application/json ContentType.
-type CreateRegistryJSONRequestBody RegistryRequest
+// FromCranArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided CranArtifactDetailConfig
+func (t *ArtifactDetail) FromCranArtifactDetailConfig(v CranArtifactDetailConfig) error {
+	t.PackageType = "CRAN"
 
-// ModifyRegistryJSONRequestBody defines body for ModifyRegistry for application/json ContentType.
-type ModifyRegistryJSONRequestBody RegistryRequest
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-// UpdateArtifactLabelsJSONRequestBody defines body for UpdateArtifactLabels for application/json ContentType.
-type UpdateArtifactLabelsJSONRequestBody ArtifactLabelRequest
+// MergeCranArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided CranArtifactDetailConfig
+func (t *ArtifactDetail) MergeCranArtifactDetailConfig(v CranArtifactDetailConfig) error {
+	t.PackageType = "CRAN"
 
-// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
-type CreateWebhookJSONRequestBody WebhookRequest
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
-type UpdateWebhookJSONRequestBody WebhookRequest
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-// AsDockerArtifactDetailConfig returns the union data inside the ArtifactDetail as a DockerArtifactDetailConfig
-func (t ArtifactDetail) AsDockerArtifactDetailConfig() (DockerArtifactDetailConfig, error) {
-	var body DockerArtifactDetailConfig
+// AsGalaxyArtifactDetailConfig returns the union data inside the ArtifactDetail as a GalaxyArtifactDetailConfig
+func (t ArtifactDetail) AsGalaxyArtifactDetailConfig() (GalaxyArtifactDetailConfig, error) {
+	var body GalaxyArtifactDetailConfig
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromDockerArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided DockerArtifactDetailConfig
-func (t *ArtifactDetail) FromDockerArtifactDetailConfig(v DockerArtifactDetailConfig) error {
-	t.PackageType = "DOCKER"
+// FromGalaxyArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided GalaxyArtifactDetailConfig
+func (t *ArtifactDetail) FromGalaxyArtifactDetailConfig(v GalaxyArtifactDetailConfig) error {
+	t.PackageType = "GALAXY"
 
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeDockerArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided DockerArtifactDetailConfig
-func (t *ArtifactDetail) MergeDockerArtifactDetailConfig(v DockerArtifactDetailConfig) error {
-	t.PackageType = "DOCKER"
+// MergeGalaxyArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided GalaxyArtifactDetailConfig
+func (t *ArtifactDetail) MergeGalaxyArtifactDetailConfig(v GalaxyArtifactDetailConfig) error {
+	t.PackageType = "GALAXY"
 
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -1269,25 +5136,25 @@ func (t *ArtifactDetail) MergeDockerArtifactDetailConfig(v DockerArtifactDetailC
 	return err
 }
 
-// AsHelmArtifactDetailConfig returns the union data inside the ArtifactDetail as a HelmArtifactDetailConfig
-func (t ArtifactDetail) AsHelmArtifactDetailConfig() (HelmArtifactDetailConfig, error) {
-	var body HelmArtifactDetailConfig
+// AsPuppetArtifactDetailConfig returns the union data inside the ArtifactDetail as a PuppetArtifactDetailConfig
+func (t ArtifactDetail) AsPuppetArtifactDetailConfig() (PuppetArtifactDetailConfig, error) {
+	var body PuppetArtifactDetailConfig
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromHelmArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided HelmArtifactDetailConfig
-func (t *ArtifactDetail) FromHelmArtifactDetailConfig(v HelmArtifactDetailConfig) error {
-	t.PackageType = "HELM"
+// FromPuppetArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided PuppetArtifactDetailConfig
+func (t *ArtifactDetail) FromPuppetArtifactDetailConfig(v PuppetArtifactDetailConfig) error {
+	t.PackageType = "PUPPET"
 
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeHelmArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided HelmArtifactDetailConfig
-func (t *ArtifactDetail) MergeHelmArtifactDetailConfig(v HelmArtifactDetailConfig) error {
-	t.PackageType = "HELM"
+// MergePuppetArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided PuppetArtifactDetailConfig
+func (t *ArtifactDetail) MergePuppetArtifactDetailConfig(v PuppetArtifactDetailConfig) error {
+	t.PackageType = "PUPPET"
 
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -1299,25 +5166,25 @@ func (t *ArtifactDetail) MergeHelmArtifactDetailConfig(v HelmArtifactDetailConfi
 	return err
 }
 
-// AsGenericArtifactDetailConfig returns the union data inside the ArtifactDetail as a GenericArtifactDetailConfig
-func (t ArtifactDetail) AsGenericArtifactDetailConfig() (GenericArtifactDetailConfig, error) {
-	var body GenericArtifactDetailConfig
+// AsVagrantArtifactDetailConfig returns the union data inside the ArtifactDetail as a VagrantArtifactDetailConfig
+func (t ArtifactDetail) AsVagrantArtifactDetailConfig() (VagrantArtifactDetailConfig, error) {
+	var body VagrantArtifactDetailConfig
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromGenericArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided GenericArtifactDetailConfig
-func (t *ArtifactDetail) FromGenericArtifactDetailConfig(v GenericArtifactDetailConfig) error {
-	t.PackageType = "GENERIC"
+// FromVagrantArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided VagrantArtifactDetailConfig
+func (t *ArtifactDetail) FromVagrantArtifactDetailConfig(v VagrantArtifactDetailConfig) error {
+	t.PackageType = "VAGRANT"
 
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeGenericArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided GenericArtifactDetailConfig
-func (t *ArtifactDetail) MergeGenericArtifactDetailConfig(v GenericArtifactDetailConfig) error {
-	t.PackageType = "GENERIC"
+// MergeVagrantArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided VagrantArtifactDetailConfig
+func (t *ArtifactDetail) MergeVagrantArtifactDetailConfig(v VagrantArtifactDetailConfig) error {
+	t.PackageType = "VAGRANT"
 
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -1329,25 +5196,25 @@ func (t *ArtifactDetail) MergeGenericArtifactDetailConfig(v GenericArtifactDetai
 	return err
 }
 
-// AsMavenArtifactDetailConfig returns the union data inside the ArtifactDetail as a MavenArtifactDetailConfig
-func (t ArtifactDetail) AsMavenArtifactDetailConfig() (MavenArtifactDetailConfig, error) {
-	var body MavenArtifactDetailConfig
+// AsModelArtifactDetailConfig returns the union data inside the ArtifactDetail as a ModelArtifactDetailConfig
+func (t ArtifactDetail) AsModelArtifactDetailConfig() (ModelArtifactDetailConfig, error) {
+	var body ModelArtifactDetailConfig
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromMavenArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided MavenArtifactDetailConfig
-func (t *ArtifactDetail) FromMavenArtifactDetailConfig(v MavenArtifactDetailConfig) error {
-	t.PackageType = "MAVEN"
+// FromModelArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided ModelArtifactDetailConfig
+func (t *ArtifactDetail) FromModelArtifactDetailConfig(v ModelArtifactDetailConfig) error {
+	t.PackageType = "MODEL"
 
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeMavenArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided MavenArtifactDetailConfig
-func (t *ArtifactDetail) MergeMavenArtifactDetailConfig(v MavenArtifactDetailConfig) error {
-	t.PackageType = "MAVEN"
+// MergeModelArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided ModelArtifactDetailConfig
+func (t *ArtifactDetail) MergeModelArtifactDetailConfig(v ModelArtifactDetailConfig) error {
+	t.PackageType = "MODEL"
 
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -1359,25 +5226,25 @@ func (t *ArtifactDetail) MergeMavenArtifactDetailConfig(v MavenArtifactDetailCon
 	return err
 }
 
-// AsPythonArtifactDetailConfig returns the union data inside the ArtifactDetail as a PythonArtifactDetailConfig
-func (t ArtifactDetail) AsPythonArtifactDetailConfig() (PythonArtifactDetailConfig, error) {
-	var body PythonArtifactDetailConfig
+// AsHomebrewArtifactDetailConfig returns the union data inside the ArtifactDetail as a HomebrewArtifactDetailConfig
+func (t ArtifactDetail) AsHomebrewArtifactDetailConfig() (HomebrewArtifactDetailConfig, error) {
+	var body HomebrewArtifactDetailConfig
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromPythonArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided PythonArtifactDetailConfig
-func (t *ArtifactDetail) FromPythonArtifactDetailConfig(v PythonArtifactDetailConfig) error {
-	t.PackageType = "PYTHON"
+// FromHomebrewArtifactDetailConfig overwrites any union data inside the ArtifactDetail as the provided HomebrewArtifactDetailConfig
+func (t *ArtifactDetail) FromHomebrewArtifactDetailConfig(v HomebrewArtifactDetailConfig) error {
+	t.PackageType = "HOMEBREW"
 
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergePythonArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided PythonArtifactDetailConfig
-func (t *ArtifactDetail) MergePythonArtifactDetailConfig(v PythonArtifactDetailConfig) error {
-	t.PackageType = "PYTHON"
+// MergeHomebrewArtifactDetailConfig performs a merge with any union data inside the ArtifactDetail, using the provided HomebrewArtifactDetailConfig
+func (t *ArtifactDetail) MergeHomebrewArtifactDetailConfig(v HomebrewArtifactDetailConfig) error {
+	t.PackageType = "HOMEBREW"
 
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -1403,16 +5270,60 @@ func (t ArtifactDetail) ValueByDiscriminator() (interface{}, error) {
 		return nil, err
 	}
 	switch discriminator {
+	case "ALPINE":
+		return t.AsAlpineArtifactDetailConfig()
+	case "COCOAPODS":
+		return t.AsCocoapodsArtifactDetailConfig()
+	case "COMPOSER":
+		return t.AsComposerArtifactDetailConfig()
+	case "CONAN":
+		return t.AsConanArtifactDetailConfig()
+	case "CONDA":
+		return t.AsCondaArtifactDetailConfig()
+	case "CRAN":
+		return t.AsCranArtifactDetailConfig()
+	case "CRATE":
+		return t.AsCrateArtifactDetailConfig()
+	case "DEB":
+		return t.AsDebArtifactDetailConfig()
 	case "DOCKER":
 		return t.AsDockerArtifactDetailConfig()
+	case "GALAXY":
+		return t.AsGalaxyArtifactDetailConfig()
+	case "GEMS":
+		return t.AsGemsArtifactDetailConfig()
 	case "GENERIC":
 		return t.AsGenericArtifactDetailConfig()
+	case "GO":
+		return t.AsGoArtifactDetailConfig()
 	case "HELM":
 		return t.AsHelmArtifactDetailConfig()
+	case "HEX":
+		return t.AsHexArtifactDetailConfig()
+	case "HOMEBREW":
+		return t.AsHomebrewArtifactDetailConfig()
 	case "MAVEN":
 		return t.AsMavenArtifactDetailConfig()
+	case "MODEL":
+		return t.AsModelArtifactDetailConfig()
+	case "NPM":
+		return t.AsNpmArtifactDetailConfig()
+	case "NUGET":
+		return t.AsNugetArtifactDetailConfig()
+	case "PUB":
+		return t.AsPubArtifactDetailConfig()
+	case "PUPPET":
+		return t.AsPuppetArtifactDetailConfig()
 	case "PYTHON":
 		return t.AsPythonArtifactDetailConfig()
+	case "RPM":
+		return t.AsRpmArtifactDetailConfig()
+	case "SWIFT":
+		return t.AsSwiftArtifactDetailConfig()
+	case "TERRAFORM":
+		return t.AsTerraformArtifactDetailConfig()
+	case "VAGRANT":
+		return t.AsVagrantArtifactDetailConfig()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -1471,6 +5382,13 @@ func (t ArtifactDetail) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'packageType': %w", err)
 	}
 
+	if t.Sections != nil {
+		object["sections"], err = json.Marshal(t.Sections)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'sections': %w", err)
+		}
+	}
+
 	if t.Size != nil {
 		object["size"], err = json.Marshal(t.Size)
 		if err != nil {
@@ -1478,6 +5396,13 @@ func (t ArtifactDetail) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.SizeBytes != nil {
+		object["sizeBytes"], err = json.Marshal(t.SizeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'sizeBytes': %w", err)
+		}
+	}
+
 	object["version"], err = json.Marshal(t.Version)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'version': %w", err)
@@ -1540,6 +5465,13 @@ func (t *ArtifactDetail) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["sections"]; found {
+		err = json.Unmarshal(raw, &t.Sections)
+		if err != nil {
+			return fmt.Errorf("error reading 'sections': %w", err)
+		}
+	}
+
 	if raw, found := object["size"]; found {
 		err = json.Unmarshal(raw, &t.Size)
 		if err != nil {
@@ -1547,6 +5479,13 @@ func (t *ArtifactDetail) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["sizeBytes"]; found {
+		err = json.Unmarshal(raw, &t.SizeBytes)
+		if err != nil {
+			return fmt.Errorf("error reading 'sizeBytes': %w", err)
+		}
+	}
+
 	if raw, found := object["version"]; found {
 		err = json.Unmarshal(raw, &t.Version)
 		if err != nil {
@@ -1837,22 +5776,22 @@ func (t *RegistryConfig) UnmarshalJSON(b []byte) error {
 	return err
 }
 
-// AsUserPassword returns the union data inside the UpstreamConfig_Auth as a UserPassword
-func (t UpstreamConfig_Auth) AsUserPassword() (UserPassword, error) {
+// AsUserPassword returns the union data inside the UpstreamAuth as a UserPassword
+func (t UpstreamAuth) AsUserPassword() (UserPassword, error) {
 	var body UserPassword
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromUserPassword overwrites any union data inside the UpstreamConfig_Auth as the provided UserPassword
-func (t *UpstreamConfig_Auth) FromUserPassword(v UserPassword) error {
+// FromUserPassword overwrites any union data inside the UpstreamAuth as the provided UserPassword
+func (t *UpstreamAuth) FromUserPassword(v UserPassword) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeUserPassword performs a merge with any union data inside the UpstreamConfig_Auth, using the provided UserPassword
-func (t *UpstreamConfig_Auth) MergeUserPassword(v UserPassword) error {
+// MergeUserPassword performs a merge with any union data inside the UpstreamAuth, using the provided UserPassword
+func (t *UpstreamAuth) MergeUserPassword(v UserPassword) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1863,22 +5802,22 @@ func (t *UpstreamConfig_Auth) MergeUserPassword(v UserPassword) error {
 	return err
 }
 
-// AsAnonymous returns the union data inside the UpstreamConfig_Auth as a Anonymous
-func (t UpstreamConfig_Auth) AsAnonymous() (Anonymous, error) {
+// AsAnonymous returns the union data inside the UpstreamAuth as a Anonymous
+func (t UpstreamAuth) AsAnonymous() (Anonymous, error) {
 	var body Anonymous
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromAnonymous overwrites any union data inside the UpstreamConfig_Auth as the provided Anonymous
-func (t *UpstreamConfig_Auth) FromAnonymous(v Anonymous) error {
+// FromAnonymous overwrites any union data inside the UpstreamAuth as the provided Anonymous
+func (t *UpstreamAuth) FromAnonymous(v Anonymous) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeAnonymous performs a merge with any union data inside the UpstreamConfig_Auth, using the provided Anonymous
-func (t *UpstreamConfig_Auth) MergeAnonymous(v Anonymous) error {
+// MergeAnonymous performs a merge with any union data inside the UpstreamAuth, using the provided Anonymous
+func (t *UpstreamAuth) MergeAnonymous(v Anonymous) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1889,22 +5828,74 @@ func (t *UpstreamConfig_Auth) MergeAnonymous(v Anonymous) error {
 	return err
 }
 
-// AsAccessKeySecretKey returns the union data inside the UpstreamConfig_Auth as a AccessKeySecretKey
-func (t UpstreamConfig_Auth) AsAccessKeySecretKey() (AccessKeySecretKey, error) {
+// AsAccessKeySecretKey returns the union data inside the UpstreamAuth as a AccessKeySecretKey
+func (t UpstreamAuth) AsAccessKeySecretKey() (AccessKeySecretKey, error) {
 	var body AccessKeySecretKey
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromAccessKeySecretKey overwrites any union data inside the UpstreamConfig_Auth as the provided AccessKeySecretKey
-func (t *UpstreamConfig_Auth) FromAccessKeySecretKey(v AccessKeySecretKey) error {
+// FromAccessKeySecretKey overwrites any union data inside the UpstreamAuth as the provided AccessKeySecretKey
+func (t *UpstreamAuth) FromAccessKeySecretKey(v AccessKeySecretKey) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAccessKeySecretKey performs a merge with any union data inside the UpstreamAuth, using the provided AccessKeySecretKey
+func (t *UpstreamAuth) MergeAccessKeySecretKey(v AccessKeySecretKey) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsToken returns the union data inside the UpstreamAuth as a Token
+func (t UpstreamAuth) AsToken() (Token, error) {
+	var body Token
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromToken overwrites any union data inside the UpstreamAuth as the provided Token
+func (t *UpstreamAuth) FromToken(v Token) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeToken performs a merge with any union data inside the UpstreamAuth, using the provided Token
+func (t *UpstreamAuth) MergeToken(v Token) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsAwsIam returns the union data inside the UpstreamAuth as a AwsIam
+func (t UpstreamAuth) AsAwsIam() (AwsIam, error) {
+	var body AwsIam
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAwsIam overwrites any union data inside the UpstreamAuth as the provided AwsIam
+func (t *UpstreamAuth) FromAwsIam(v AwsIam) error {
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeAccessKeySecretKey performs a merge with any union data inside the UpstreamConfig_Auth, using the provided AccessKeySecretKey
-func (t *UpstreamConfig_Auth) MergeAccessKeySecretKey(v AccessKeySecretKey) error {
+// MergeAwsIam performs a merge with any union data inside the UpstreamAuth, using the provided AwsIam
+func (t *UpstreamAuth) MergeAwsIam(v AwsIam) error {
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
@@ -1915,12 +5906,12 @@ func (t *UpstreamConfig_Auth) MergeAccessKeySecretKey(v AccessKeySecretKey) erro
 	return err
 }
 
-func (t UpstreamConfig_Auth) MarshalJSON() ([]byte, error) {
+func (t UpstreamAuth) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *UpstreamConfig_Auth) UnmarshalJSON(b []byte) error {
+func (t *UpstreamAuth) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
@@ -20,6 +20,7 @@ import (
 	middlewareauthn "github.com/harness/gitness/app/api/middleware/authn"
 	"github.com/harness/gitness/registry/app/api/handler/generic"
 	"github.com/harness/gitness/registry/app/api/middleware"
+	"github.com/harness/gitness/registry/utils/ratelimit"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
@@ -29,16 +30,18 @@ type Handler interface {
 	http.Handler
 }
 
-func NewGenericArtifactHandler(handler *generic.Handler) Handler {
+func NewGenericArtifactHandler(handler *generic.Handler, limiter *ratelimit.Limiter) Handler {
 	r := chi.NewRouter()
 
 	var routeHandlers = map[string]http.HandlerFunc{
-		http.MethodPut: handler.PushArtifact,
-		http.MethodGet: handler.PullArtifact,
+		http.MethodPut:  handler.PushArtifact,
+		http.MethodGet:  handler.PullArtifact,
+		http.MethodHead: handler.PullArtifact,
 	}
 	r.Route("/generic", func(r chi.Router) {
 		r.Use(middleware.StoreOriginalURL)
 		r.Use(middlewareauthn.Attempt(handler.Authenticator))
+		r.Use(middleware.RateLimit(limiter))
 		r.Use(middleware.TrackDownloadStatForGenericArtifact(handler))
 		r.Use(middleware.TrackBandwidthStatForGenericArtifacts(handler))
 
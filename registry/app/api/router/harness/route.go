@@ -31,7 +31,22 @@ import (
 	storagedriver "github.com/harness/gitness/registry/app/driver"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/accessgrant"
+	"github.com/harness/gitness/registry/services/cacheprewarm"
+	"github.com/harness/gitness/registry/services/claimsmapping"
+	"github.com/harness/gitness/registry/services/compliance"
+	"github.com/harness/gitness/registry/services/contentindex"
+	"github.com/harness/gitness/registry/services/enrichment"
+	"github.com/harness/gitness/registry/services/evidence"
+	"github.com/harness/gitness/registry/services/mirrorsync"
+	"github.com/harness/gitness/registry/services/onboarding"
+	"github.com/harness/gitness/registry/services/queue"
+	"github.com/harness/gitness/registry/services/resolution"
+	"github.com/harness/gitness/registry/services/security"
+	"github.com/harness/gitness/registry/services/storagemigration"
 	registrywebhook "github.com/harness/gitness/registry/services/webhook"
+	"github.com/harness/gitness/registry/utils/ratelimit"
+	"github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/go-chi/chi/v5"
@@ -47,6 +62,20 @@ var (
 	terminatedPathRegexPrefixesAPI = []string{
 		"^/api/v1/registry/([^/]+)/artifact/",
 	}
+
+	// anonymousPathSuffixesAPI is the list of endpoints that authenticate requests on their own.
+	anonymousPathSuffixesAPI = []string{
+		"/registry/identity/token",
+		// Badges are embedded in READMEs of public spaces, which are rendered anonymously.
+		"/badge/version", "/badge/downloads", "/badge/scan",
+		// Feeds of public spaces are read by feed readers and chat tools without credentials.
+		"/feed",
+	}
+
+	// compressedPathRegexesAPI is the list of list endpoints whose responses are compressed.
+	compressedPathRegexesAPI = []string{
+		"/registries$", "/artifacts$", "/versions$", "/files$", "/docker/manifests$",
+	}
 )
 
 type APIHandler interface {
@@ -74,11 +103,44 @@ func NewAPIHandler(
 	webhooksExecutionRepository store.WebhooksExecutionRepository,
 	webhookService registrywebhook.Service,
 	spacePathStore corestore.SpacePathStore,
+	limiter *ratelimit.Limiter,
+	storageMigrator *storagemigration.Service,
+	queueService *queue.Service,
+	scanStore store.ScanRepository,
+	vexStore store.VexRepository,
+	securityService *security.Service,
+	evidenceService *evidence.Service,
+	resolutionService *resolution.Service,
+	accessGrantService *accessgrant.Service,
+	claimsMappingService *claimsmapping.Service,
+	contentIndexService *contentindex.Service,
+	enrichmentService *enrichment.Service,
+	downloadStatDao store.DownloadStatRepository,
+	onboardingService *onboarding.Service,
+	defaultRegistryStore store.DefaultRegistryRepository,
+	registryConfigRevisionStore store.RegistryConfigRevisionRepository,
+	permalinkStore store.PermalinkRepository,
+	mavenRelocationStore store.MavenRelocationRepository,
+	packageRuleStore store.PackageRuleRepository,
+	upstreamURLStore store.UpstreamURLRepository,
+	legalHoldStore store.LegalHoldRepository,
+	deletionCertificateStore store.DeletionCertificateRepository,
+	complianceService *compliance.Service,
+	cacheEvictionStore store.CacheEvictionRepository,
+	cachePrewarmService *cacheprewarm.Service,
+	vulnerabilityAllowlistStore store.VulnerabilityAllowlistRepository,
+	helmPushPolicyStore store.HelmPushPolicyRepository,
+	mirrorSyncStore store.MirrorSyncRepository,
+	mirrorSyncService *mirrorsync.Service,
+	replica *database.Replica,
 ) APIHandler {
 	r := chi.NewRouter()
 	r.Use(audit.Middleware())
 	r.Use(middlewareauthn.Attempt(authenticator))
-	r.Use(middleware.CheckAuth())
+	r.Use(middleware.CheckAuth(anonymousPathSuffixesAPI...))
+	r.Use(middleware.RateLimit(limiter))
+	r.Use(middleware.ReadReplica(replica))
+	r.Use(middleware.CompressPaths(compressedPathRegexesAPI...))
 	registryMetadataHelper := metadata.NewRegistryMetadataHelper(spacePathStore, spaceFinder, repoDao)
 	apiController := metadata.NewAPIController(
 		repoDao,
@@ -101,6 +163,34 @@ func NewAPIHandler(
 		webhooksExecutionRepository,
 		registryMetadataHelper,
 		&webhookService,
+		storageMigrator,
+		queueService,
+		scanStore,
+		vexStore,
+		securityService,
+		evidenceService,
+		resolutionService,
+		accessGrantService,
+		claimsMappingService,
+		contentIndexService,
+		enrichmentService,
+		downloadStatDao,
+		onboardingService,
+		defaultRegistryStore,
+		registryConfigRevisionStore,
+		permalinkStore,
+		mavenRelocationStore,
+		packageRuleStore,
+		upstreamURLStore,
+		legalHoldStore,
+		deletionCertificateStore,
+		complianceService,
+		cacheEvictionStore,
+		cachePrewarmService,
+		vulnerabilityAllowlistStore,
+		helmPushPolicyStore,
+		mirrorSyncStore,
+		mirrorSyncService,
 	)
 
 	handler := artifact.NewStrictHandler(apiController, []artifact.StrictMiddlewareFunc{})
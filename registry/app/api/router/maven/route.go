@@ -20,6 +20,7 @@ import (
 	middlewareauthn "github.com/harness/gitness/app/api/middleware/authn"
 	"github.com/harness/gitness/registry/app/api/handler/maven"
 	"github.com/harness/gitness/registry/app/api/middleware"
+	"github.com/harness/gitness/registry/utils/ratelimit"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
@@ -29,7 +30,7 @@ type Handler interface {
 	http.Handler
 }
 
-func NewMavenHandler(handler *maven.Handler) Handler {
+func NewMavenHandler(handler *maven.Handler, limiter *ratelimit.Limiter) Handler {
 	r := chi.NewRouter()
 
 	var routeHandlers = map[string]http.HandlerFunc{
@@ -43,6 +44,7 @@ func NewMavenHandler(handler *maven.Handler) Handler {
 		r.Use(middleware.CheckMavenAuthHeader())
 		r.Use(middlewareauthn.Attempt(handler.Authenticator))
 		r.Use(middleware.CheckMavenAuth())
+		r.Use(middleware.RateLimit(limiter))
 		r.Use(middleware.TrackDownloadStatForMavenArtifact(handler))
 		r.Use(middleware.TrackBandwidthStatForMavenArtifacts(handler))
 
@@ -21,6 +21,7 @@ import (
 	"github.com/harness/gitness/registry/app/api/handler/oci"
 	"github.com/harness/gitness/registry/app/api/middleware"
 	"github.com/harness/gitness/registry/app/api/router/utils"
+	"github.com/harness/gitness/registry/utils/ratelimit"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
@@ -38,11 +39,16 @@ func NewHandlerBlock2(h2 http.HandlerFunc, remoteSupport bool) HandlerBlock {
 	}
 }
 
+// compress wraps the handlers of the list endpoints that can return large responses.
+func compress(h http.HandlerFunc) http.HandlerFunc {
+	return middleware.Compress()(h).ServeHTTP
+}
+
 type RegistryOCIHandler interface {
 	http.Handler
 }
 
-func NewOCIHandler(handlerV2 *oci.Handler) RegistryOCIHandler {
+func NewOCIHandler(handlerV2 *oci.Handler, limiter *ratelimit.Limiter) RegistryOCIHandler {
 	r := chi.NewRouter()
 
 	var routeHandlers = map[utils.RouteType]map[string]HandlerBlock{
@@ -65,7 +71,7 @@ func NewOCIHandler(handlerV2 *oci.Handler) RegistryOCIHandler {
 			http.MethodPost:   NewHandlerBlock2(handlerV2.InitiateUploadBlob, false),
 		},
 		utils.Tags: {
-			http.MethodGet: NewHandlerBlock2(handlerV2.GetTags, false),
+			http.MethodGet: NewHandlerBlock2(compress(handlerV2.GetTags), false),
 		},
 		utils.Referrers: {
 			http.MethodGet: NewHandlerBlock2(handlerV2.GetReferrers, false),
@@ -75,6 +81,7 @@ func NewOCIHandler(handlerV2 *oci.Handler) RegistryOCIHandler {
 	r.Route("/v2", func(r chi.Router) {
 		r.Use(middleware.StoreOriginalURL)
 		r.Use(middlewareauthn.Attempt(handlerV2.Authenticator))
+		r.Use(middleware.OciRateLimit(limiter))
 		r.Get("/token", func(w http.ResponseWriter, req *http.Request) {
 			handlerV2.GetToken(w, req)
 		})
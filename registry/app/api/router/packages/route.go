@@ -18,11 +18,34 @@ import (
 	"net/http"
 
 	middlewareauthn "github.com/harness/gitness/app/api/middleware/authn"
+	"github.com/harness/gitness/registry/app/api/handler/alpine"
+	"github.com/harness/gitness/registry/app/api/handler/cargo"
+	"github.com/harness/gitness/registry/app/api/handler/cocoapods"
+	"github.com/harness/gitness/registry/app/api/handler/composer"
+	"github.com/harness/gitness/registry/app/api/handler/conan"
+	"github.com/harness/gitness/registry/app/api/handler/conda"
+	"github.com/harness/gitness/registry/app/api/handler/cran"
+	"github.com/harness/gitness/registry/app/api/handler/debian"
+	"github.com/harness/gitness/registry/app/api/handler/galaxy"
+	"github.com/harness/gitness/registry/app/api/handler/gems"
 	"github.com/harness/gitness/registry/app/api/handler/generic"
+	"github.com/harness/gitness/registry/app/api/handler/gomodule"
+	"github.com/harness/gitness/registry/app/api/handler/hex"
+	"github.com/harness/gitness/registry/app/api/handler/homebrew"
 	"github.com/harness/gitness/registry/app/api/handler/maven"
+	"github.com/harness/gitness/registry/app/api/handler/model"
+	"github.com/harness/gitness/registry/app/api/handler/npm"
+	"github.com/harness/gitness/registry/app/api/handler/nuget"
 	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/api/handler/pub"
+	"github.com/harness/gitness/registry/app/api/handler/puppet"
 	"github.com/harness/gitness/registry/app/api/handler/pypi"
+	"github.com/harness/gitness/registry/app/api/handler/rpm"
+	"github.com/harness/gitness/registry/app/api/handler/swift"
+	"github.com/harness/gitness/registry/app/api/handler/terraform"
+	"github.com/harness/gitness/registry/app/api/handler/vagrant"
 	"github.com/harness/gitness/registry/app/api/middleware"
+	"github.com/harness/gitness/registry/utils/ratelimit"
 	"github.com/harness/gitness/types/enum"
 
 	"github.com/go-chi/chi/v5"
@@ -43,6 +66,29 @@ func NewRouter(
 	mavenHandler *maven.Handler,
 	genericHandler *generic.Handler,
 	pypiHandler pypi.Handler,
+	npmHandler npm.Handler,
+	nugetHandler nuget.Handler,
+	gemsHandler gems.Handler,
+	cargoHandler cargo.Handler,
+	goModuleHandler gomodule.Handler,
+	debianHandler debian.Handler,
+	rpmHandler rpm.Handler,
+	alpineHandler alpine.Handler,
+	conanHandler conan.Handler,
+	composerHandler composer.Handler,
+	condaHandler conda.Handler,
+	terraformHandler terraform.Handler,
+	swiftHandler swift.Handler,
+	cocoapodsHandler cocoapods.Handler,
+	hexHandler hex.Handler,
+	pubHandler pub.Handler,
+	cranHandler cran.Handler,
+	galaxyHandler galaxy.Handler,
+	puppetHandler puppet.Handler,
+	vagrantHandler vagrant.Handler,
+	modelHandler model.Handler,
+	homebrewHandler homebrew.Handler,
+	limiter *ratelimit.Limiter,
 ) Handler {
 	r := chi.NewRouter()
 
@@ -53,6 +99,7 @@ func NewRouter(
 			r.Use(middleware.CheckMavenAuthHeader())
 			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
 			r.Use(middleware.CheckMavenAuth())
+			r.Use(middleware.RateLimit(limiter))
 			r.Use(middleware.TrackDownloadStatForMavenArtifact(mavenHandler))
 			r.Use(middleware.TrackBandwidthStatForMavenArtifacts(mavenHandler))
 			r.Get("/*", mavenHandler.GetArtifact)
@@ -62,6 +109,7 @@ func NewRouter(
 
 		r.Route("/generic", func(r chi.Router) {
 			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
 			r.Use(middleware.TrackDownloadStatForGenericArtifact(genericHandler))
 			r.Use(middleware.TrackBandwidthStatForGenericArtifacts(genericHandler))
 
@@ -71,15 +119,461 @@ func NewRouter(
 
 		r.Route("/python", func(r chi.Router) {
 			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
 			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
 				Post("/*", pypiHandler.UploadPackageFile)
 			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
 				Get("/files/{image}/{version}/{filename}", pypiHandler.DownloadPackageFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/simple", pypiHandler.ListPackages)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/simple/", pypiHandler.ListPackages)
 			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
 				Get("/simple/{image}", pypiHandler.PackageMetadata)
 			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
 				Get("/simple/{image}/", pypiHandler.PackageMetadata)
 		})
+
+		r.Route("/npm", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/*", npmHandler.PublishPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/*", npmHandler.GetPackage)
+		})
+
+		r.Route("/nuget", func(r chi.Router) {
+			r.Use(middleware.CheckNugetAPIKeyHeader())
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/", nugetHandler.PushPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/index.json", nugetHandler.GetServiceIndex)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/package/{id}/index.json", nugetHandler.ListPackageVersions)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/package/{id}/{version}/{filename}", nugetHandler.DownloadPackageFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/registration/{id}/index.json", nugetHandler.GetRegistrationIndex)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/registration/{id}/{leaf}", nugetHandler.GetRegistrationLeaf)
+			r.Route("/v2", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Put("/", nugetHandler.PushPackage)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/", nugetHandler.GetServiceDocument)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/$metadata", nugetHandler.GetServiceMetadata)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/Packages()", nugetHandler.ListPackages)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/Packages()/$count", nugetHandler.ListPackages)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/Packages(Id='{id}',Version='{version}')", nugetHandler.GetPackage)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/Search()", nugetHandler.SearchPackages)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/Search()/$count", nugetHandler.SearchPackages)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/FindPackagesById()", nugetHandler.FindPackagesByID)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/FindPackagesById()/$count", nugetHandler.FindPackagesByID)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/package/{id}/{version}", nugetHandler.DownloadPackage)
+			})
+		})
+
+		r.Route("/rubygems", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Post("/api/v1/gems", gemsHandler.PushGem)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/names", gemsHandler.GetNames)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/versions", gemsHandler.GetVersions)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/info/{name}", gemsHandler.GetInfo)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/gems/{filename}", gemsHandler.DownloadGem)
+		})
+
+		r.Route("/cargo", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/index/config.json", cargoHandler.GetConfig)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/index/*", cargoHandler.GetIndex)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/api/v1/crates/new", cargoHandler.PublishCrate)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/api/v1/crates/{name}/{version}/download", cargoHandler.DownloadCrate)
+		})
+
+		r.Route("/go", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/*", goModuleHandler.PublishModule)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/*", goModuleHandler.GetFile)
+		})
+
+		r.Route("/debian", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/upload/{distribution}/{component}", debianHandler.UploadPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/dists/*", debianHandler.GetDistributionFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/pool/*", debianHandler.DownloadPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/key", debianHandler.GetPublicKey)
+		})
+
+		r.Route("/rpm", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/upload", rpmHandler.UploadPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/repodata/{file}", rpmHandler.GetRepoDataFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/Packages/{filename}", rpmHandler.DownloadPackage)
+		})
+
+		r.Route("/alpine", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/upload", alpineHandler.UploadPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/key", alpineHandler.GetPublicKey)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/{architecture}/APKINDEX.tar.gz", alpineHandler.GetIndex)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/{architecture}/{filename}", alpineHandler.DownloadPackage)
+		})
+
+		r.Route("/conan", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.Get("/v1/ping", conanHandler.Ping)
+			r.Get("/v2/users/authenticate", conanHandler.Authenticate)
+			r.Get("/v2/users/check_credentials", conanHandler.CheckCredentials)
+
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/v2/conans/search", conanHandler.SearchRecipes)
+			r.Route("/v2/conans/{name}/{version}/{user}/{channel}", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/latest", conanHandler.GetLatestRevision)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/revisions", conanHandler.ListRevisions)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/search", conanHandler.SearchPackages)
+				r.Route("/revisions/{revision}", func(r chi.Router) {
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+						Get("/files", conanHandler.ListFiles)
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+						Get("/files/{filename}", conanHandler.DownloadFile)
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+						Put("/files/{filename}", conanHandler.UploadFile)
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+						Get("/search", conanHandler.SearchPackages)
+					r.Route("/packages/{packageID}", func(r chi.Router) {
+						r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+							Get("/latest", conanHandler.GetLatestRevision)
+						r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+							Get("/revisions", conanHandler.ListRevisions)
+						r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+							Get("/revisions/{packageRevision}/files", conanHandler.ListFiles)
+						r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+							Get("/revisions/{packageRevision}/files/{filename}", conanHandler.DownloadFile)
+						r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+							Put("/revisions/{packageRevision}/files/{filename}", conanHandler.UploadFile)
+					})
+				})
+			})
+		})
+
+		r.Route("/composer", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/upload", composerHandler.UploadPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/packages.json", composerHandler.GetRepository)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/p/{file}", composerHandler.GetProviderInclude)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/p/{vendor}/{file}", composerHandler.GetProviderFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/p2/{vendor}/{file}", composerHandler.GetMetadataFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/files/{vendor}/{name}/{version}/{filename}", composerHandler.DownloadPackage)
+		})
+
+		r.Route("/conda", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/upload", condaHandler.UploadPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/channeldata.json", condaHandler.GetChannelData)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/{subdir}/repodata.json", condaHandler.GetRepoData)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/{subdir}/current_repodata.json", condaHandler.GetRepoData)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/{subdir}/{filename}", condaHandler.DownloadPackage)
+		})
+
+		r.Route("/terraform", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/.well-known/terraform.json", terraformHandler.GetServiceDiscovery)
+			r.Route("/v1/modules/{namespace}/{name}/{system}", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/versions", terraformHandler.ListVersions)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Put("/{version}", terraformHandler.UploadModule)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{version}/download", terraformHandler.GetDownloadURL)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{version}/archive.tar.gz", terraformHandler.DownloadArchive)
+			})
+			r.Route("/v1/providers/{namespace}/{type}", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/versions", terraformHandler.ListProviderVersions)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Put("/{version}", terraformHandler.UploadProvider)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{version}/download/{os}/{arch}", terraformHandler.GetProviderPackage)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{version}/files/{filename}", terraformHandler.DownloadProviderFile)
+			})
+		})
+
+		r.Route("/swift", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.Use(swiftHandler.APIVersion)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Post("/login", swiftHandler.Login)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/identifiers", swiftHandler.LookupIdentifiers)
+			r.Route("/{scope}/{name}", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/", swiftHandler.ListReleases)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{version}", swiftHandler.GetRelease)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Put("/{version}", swiftHandler.Publish)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{version}/Package.swift", swiftHandler.GetManifest)
+			})
+		})
+
+		r.Route("/cocoapods", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/CocoaPods-version.yml", cocoapodsHandler.GetVersionFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/all_pods.txt", cocoapodsHandler.ListPods)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/all_pods_versions_{a}_{b}_{c}.txt", cocoapodsHandler.ListShardVersions)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/deprecated_podspecs.txt", cocoapodsHandler.ListDeprecatedPodspecs)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/Specs/{a}/{b}/{c}/{name}/{version}/{file}", cocoapodsHandler.GetPodspec)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/pods/{name}/{version}/{file}", cocoapodsHandler.DownloadArchive)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/pods", cocoapodsHandler.Push)
+		})
+
+		r.Route("/hex", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/public_key", hexHandler.GetPublicKey)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/names", hexHandler.GetNames)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/versions", hexHandler.GetVersions)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/packages/{name}", hexHandler.GetPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/tarballs/{file}", hexHandler.DownloadTarball)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/docs/{file}", hexHandler.DownloadDocs)
+
+			r.Route("/api", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Post("/publish", hexHandler.Publish)
+				r.Route("/packages/{name}", func(r chi.Router) {
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+						Get("/", hexHandler.GetPackageInfo)
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+						Post("/releases", hexHandler.Publish)
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+						Get("/releases/{version}", hexHandler.GetReleaseInfo)
+					r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+						Post("/releases/{version}/docs", hexHandler.PublishDocs)
+				})
+			})
+		})
+
+		r.Route("/pub", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/packages/{package}/versions/{file}", pubHandler.DownloadArchive)
+
+			r.Route("/api/packages", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Get("/versions/new", pubHandler.NewUpload)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Post("/versions/upload", pubHandler.Upload)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Get("/versions/finalize", pubHandler.FinalizeUpload)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{package}", pubHandler.GetPackage)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/{package}/versions/{version}", pubHandler.GetVersion)
+			})
+		})
+
+		r.Route("/cran", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/upload/*", cranHandler.UploadPackage)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/src/*", cranHandler.GetSourceFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/bin/*", cranHandler.GetBinaryFile)
+		})
+
+		r.Route("/galaxy", func(r chi.Router) {
+			r.Use(middleware.CheckGalaxyTokenHeader())
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			// ansible-galaxy discovers the API at the server URL, then under api/.
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/", galaxyHandler.GetAPIVersions)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/api/", galaxyHandler.GetAPIVersions)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/download/{file}", galaxyHandler.DownloadArchive)
+
+			r.Route("/api/v3", func(r chi.Router) {
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Post("/artifacts/collections/", galaxyHandler.Upload)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+					Get("/imports/collections/{task}/", galaxyHandler.GetImportTask)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/collections/{namespace}/{name}/", galaxyHandler.GetCollection)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/collections/{namespace}/{name}/versions/", galaxyHandler.ListVersions)
+				r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+					Get("/collections/{namespace}/{name}/versions/{version}/", galaxyHandler.GetVersion)
+			})
+		})
+
+		// puppet and r10k resolve the URIs of the Forge API against the registry URL.
+		r.Route("/puppet/v3", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/modules/{module}", puppetHandler.GetModule)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/releases", puppetHandler.ListReleases)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Post("/releases", puppetHandler.Publish)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/releases/{release}", puppetHandler.GetRelease)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/files/{file}", puppetHandler.DownloadArchive)
+		})
+
+		// vagrant resolves boxes named org/name from their catalog at the registry URL followed by
+		// the name.
+		r.Route("/vagrant/{org}/{name}", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/", vagrantHandler.GetCatalog)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/{version}/{provider}", vagrantHandler.DownloadBox)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/{version}/{provider}", vagrantHandler.UploadBox)
+		})
+
+		// Files too large for a single request are uploaded in chunks, each appended at the
+		// Upload-Offset of the upload, so that an interrupted upload resumes where it stopped.
+		r.Route("/model/{name}/{version}/files/{filename}", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/", modelHandler.DownloadFile)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/", modelHandler.UploadFile)
+			r.Route("/uploads", func(r chi.Router) {
+				r.Use(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload))
+				r.Post("/", modelHandler.StartUpload)
+				r.Head("/{upload_id}", modelHandler.GetUploadOffset)
+				r.Patch("/{upload_id}", modelHandler.AppendUpload)
+				r.Put("/{upload_id}", modelHandler.CompleteUpload)
+				r.Delete("/{upload_id}", modelHandler.CancelUpload)
+			})
+		})
+
+		// brew downloads bottles from the root_url of the formulae the registry generates, which
+		// taps are filled with from the tarball of the registry.
+		r.Route("/homebrew", func(r chi.Router) {
+			r.Use(middlewareauthn.Attempt(packageHandler.GetAuthenticator()))
+			r.Use(middleware.RateLimit(limiter))
+			r.Use(middleware.StoreDownloadStat)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/tap.tar.gz", homebrewHandler.GetTapArchive)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/formula.json", homebrewHandler.GetIndex)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/Formula/{formula}", homebrewHandler.GetFormula)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsDownload)).
+				Get("/bottles/{filename}", homebrewHandler.DownloadBottle)
+			r.With(middleware.RequestPackageAccess(packageHandler, enum.PermissionArtifactsUpload)).
+				Put("/bottles/{filename}", homebrewHandler.UploadBottle)
+		})
 	})
 
 	return r
@@ -43,7 +43,9 @@ func (r *RegistryRouter) IsEligibleTraffic(req *http.Request) bool {
 	}
 	if utils.HasAnyPrefix(urlPath, []string{RegistryMount, "/v2/", "/registry/", "/maven/", "/generic/", "/pkg/"}) ||
 		(strings.HasPrefix(urlPath, APIMount+"/v1/spaces/") &&
-			utils.HasAnySuffix(urlPath, []string{"/artifacts", "/registries"})) {
+			(utils.HasAnySuffix(urlPath, []string{"/artifacts", "/registries"}) ||
+				strings.Contains(urlPath, "/claim-mappings") ||
+				strings.Contains(urlPath, "/default-registries"))) {
 		return true
 	}
 
@@ -20,13 +20,20 @@ import (
 	"net/http"
 
 	"github.com/harness/gitness/app/api/middleware/address"
+	middlewareauthn "github.com/harness/gitness/app/api/middleware/authn"
 	"github.com/harness/gitness/app/api/middleware/logging"
+	middlewareprincipal "github.com/harness/gitness/app/api/middleware/principal"
+	"github.com/harness/gitness/app/auth/authn"
+	"github.com/harness/gitness/audit"
 	"github.com/harness/gitness/registry/app/api/handler/swagger"
+	"github.com/harness/gitness/registry/app/api/middleware"
 	generic2 "github.com/harness/gitness/registry/app/api/router/generic"
 	"github.com/harness/gitness/registry/app/api/router/harness"
 	"github.com/harness/gitness/registry/app/api/router/maven"
 	"github.com/harness/gitness/registry/app/api/router/oci"
 	"github.com/harness/gitness/registry/app/api/router/packages"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
+	"github.com/harness/gitness/registry/utils/drain"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/hlog"
@@ -43,6 +50,11 @@ func GetAppRouter(
 	mavenHandler maven.Handler,
 	genericHandler generic2.Handler,
 	packageHandler packages.Handler,
+	blobServer *blobserve.Server,
+	authenticator authn.Authenticator,
+	clientCertHeader string,
+	auditService audit.Service,
+	drainer *drain.Drainer,
 ) AppRouter {
 	r := chi.NewRouter()
 	r.Use(hlog.URLHandler("http.url"))
@@ -50,17 +62,20 @@ func GetAppRouter(
 	r.Use(logging.HLogRequestIDHandler())
 	r.Use(logging.HLogAccessLogHandler())
 	r.Use(address.Handler("", ""))
+	r.Use(middleware.ClientCertificate(clientCertHeader, auditService))
 
 	r.Group(func(r chi.Router) {
 		r.Handle(fmt.Sprintf("%s/*", baseURL), appHandler)
-		r.Handle("/v2/*", ociHandler)
+		r.With(middleware.OciDrain(drainer)).Handle("/v2/*", ociHandler)
 		// deprecated
-		r.Handle("/maven/*", mavenHandler)
+		r.With(middleware.Drain(drainer)).Handle("/maven/*", mavenHandler)
 		// deprecated
-		r.Handle("/generic/*", genericHandler)
+		r.With(middleware.Drain(drainer)).Handle("/generic/*", genericHandler)
 
-		r.Mount("/pkg/", packageHandler)
+		r.With(middleware.Drain(drainer)).Mount("/pkg/", packageHandler)
 		r.Handle("/registry/swagger*", swagger.GetSwaggerHandler("/registry"))
+		r.With(middlewareauthn.Attempt(authenticator), middlewareprincipal.RestrictToAdmin()).
+			Get("/registry/blob-serving/stats", blobserve.HandleStats(blobServer))
 	})
 
 	// Walk through all routes and print them
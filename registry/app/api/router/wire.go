@@ -22,21 +22,61 @@ import (
 	corestore "github.com/harness/gitness/app/store"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/audit"
+	"github.com/harness/gitness/registry/app/api/handler/alpine"
+	"github.com/harness/gitness/registry/app/api/handler/cargo"
+	"github.com/harness/gitness/registry/app/api/handler/cocoapods"
+	"github.com/harness/gitness/registry/app/api/handler/composer"
+	"github.com/harness/gitness/registry/app/api/handler/conan"
+	"github.com/harness/gitness/registry/app/api/handler/conda"
+	"github.com/harness/gitness/registry/app/api/handler/cran"
+	"github.com/harness/gitness/registry/app/api/handler/debian"
+	"github.com/harness/gitness/registry/app/api/handler/galaxy"
+	"github.com/harness/gitness/registry/app/api/handler/gems"
 	"github.com/harness/gitness/registry/app/api/handler/generic"
+	"github.com/harness/gitness/registry/app/api/handler/gomodule"
+	"github.com/harness/gitness/registry/app/api/handler/hex"
+	"github.com/harness/gitness/registry/app/api/handler/homebrew"
 	"github.com/harness/gitness/registry/app/api/handler/maven"
+	"github.com/harness/gitness/registry/app/api/handler/model"
+	"github.com/harness/gitness/registry/app/api/handler/npm"
+	"github.com/harness/gitness/registry/app/api/handler/nuget"
 	hoci "github.com/harness/gitness/registry/app/api/handler/oci"
 	"github.com/harness/gitness/registry/app/api/handler/packages"
+	"github.com/harness/gitness/registry/app/api/handler/pub"
+	"github.com/harness/gitness/registry/app/api/handler/puppet"
 	"github.com/harness/gitness/registry/app/api/handler/pypi"
+	"github.com/harness/gitness/registry/app/api/handler/rpm"
+	"github.com/harness/gitness/registry/app/api/handler/swift"
+	"github.com/harness/gitness/registry/app/api/handler/terraform"
+	"github.com/harness/gitness/registry/app/api/handler/vagrant"
 	generic2 "github.com/harness/gitness/registry/app/api/router/generic"
 	"github.com/harness/gitness/registry/app/api/router/harness"
 	mavenRouter "github.com/harness/gitness/registry/app/api/router/maven"
 	"github.com/harness/gitness/registry/app/api/router/oci"
 	packagerrouter "github.com/harness/gitness/registry/app/api/router/packages"
 	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/accessgrant"
+	"github.com/harness/gitness/registry/services/cacheprewarm"
+	"github.com/harness/gitness/registry/services/claimsmapping"
+	"github.com/harness/gitness/registry/services/compliance"
+	"github.com/harness/gitness/registry/services/contentindex"
+	"github.com/harness/gitness/registry/services/enrichment"
+	"github.com/harness/gitness/registry/services/evidence"
+	"github.com/harness/gitness/registry/services/mirrorsync"
+	"github.com/harness/gitness/registry/services/onboarding"
+	"github.com/harness/gitness/registry/services/queue"
+	"github.com/harness/gitness/registry/services/resolution"
+	"github.com/harness/gitness/registry/services/security"
+	"github.com/harness/gitness/registry/services/storagemigration"
 	registrywebhook "github.com/harness/gitness/registry/services/webhook"
+	"github.com/harness/gitness/registry/utils/drain"
+	"github.com/harness/gitness/registry/utils/ratelimit"
+	"github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 )
@@ -47,8 +87,14 @@ func AppRouterProvider(
 	mavenHandler mavenRouter.Handler,
 	genericHandler generic2.Handler,
 	handler packagerrouter.Handler,
+	blobServer *blobserve.Server,
+	authenticator authn.Authenticator,
+	appConfig *types.Config,
+	auditService audit.Service,
+	drainer *drain.Drainer,
 ) AppRouter {
-	return GetAppRouter(ocir, appHandler, config.APIURL, mavenHandler, genericHandler, handler)
+	return GetAppRouter(ocir, appHandler, config.APIURL, mavenHandler, genericHandler, handler, blobServer,
+		authenticator, appConfig.Registry.MTLS.ClientCertHeader, auditService, drainer)
 }
 
 func APIHandlerProvider(
@@ -71,6 +117,36 @@ func APIHandlerProvider(
 	webhooksExecutionRepository store.WebhooksExecutionRepository,
 	webhookService *registrywebhook.Service,
 	spacePathStore corestore.SpacePathStore,
+	limiter *ratelimit.Limiter,
+	storageMigrator *storagemigration.Service,
+	queueService *queue.Service,
+	scanStore store.ScanRepository,
+	vexStore store.VexRepository,
+	securityService *security.Service,
+	evidenceService *evidence.Service,
+	resolutionService *resolution.Service,
+	accessGrantService *accessgrant.Service,
+	claimsMappingService *claimsmapping.Service,
+	contentIndexService *contentindex.Service,
+	enrichmentService *enrichment.Service,
+	downloadStatDao store.DownloadStatRepository,
+	onboardingService *onboarding.Service,
+	defaultRegistryStore store.DefaultRegistryRepository,
+	registryConfigRevisionStore store.RegistryConfigRevisionRepository,
+	permalinkStore store.PermalinkRepository,
+	mavenRelocationStore store.MavenRelocationRepository,
+	packageRuleStore store.PackageRuleRepository,
+	upstreamURLStore store.UpstreamURLRepository,
+	legalHoldStore store.LegalHoldRepository,
+	deletionCertificateStore store.DeletionCertificateRepository,
+	complianceService *compliance.Service,
+	cacheEvictionStore store.CacheEvictionRepository,
+	cachePrewarmService *cacheprewarm.Service,
+	vulnerabilityAllowlistStore store.VulnerabilityAllowlistRepository,
+	helmPushPolicyStore store.HelmPushPolicyRepository,
+	mirrorSyncStore store.MirrorSyncRepository,
+	mirrorSyncService *mirrorsync.Service,
+	replica *database.Replica,
 ) harness.APIHandler {
 	return harness.NewAPIHandler(
 		repoDao,
@@ -93,19 +169,49 @@ func APIHandlerProvider(
 		webhooksExecutionRepository,
 		*webhookService,
 		spacePathStore,
+		limiter,
+		storageMigrator,
+		queueService,
+		scanStore,
+		vexStore,
+		securityService,
+		evidenceService,
+		resolutionService,
+		accessGrantService,
+		claimsMappingService,
+		contentIndexService,
+		enrichmentService,
+		downloadStatDao,
+		onboardingService,
+		defaultRegistryStore,
+		registryConfigRevisionStore,
+		permalinkStore,
+		mavenRelocationStore,
+		packageRuleStore,
+		upstreamURLStore,
+		legalHoldStore,
+		deletionCertificateStore,
+		complianceService,
+		cacheEvictionStore,
+		cachePrewarmService,
+		vulnerabilityAllowlistStore,
+		helmPushPolicyStore,
+		mirrorSyncStore,
+		mirrorSyncService,
+		replica,
 	)
 }
 
-func OCIHandlerProvider(handlerV2 *hoci.Handler) oci.RegistryOCIHandler {
-	return oci.NewOCIHandler(handlerV2)
+func OCIHandlerProvider(handlerV2 *hoci.Handler, limiter *ratelimit.Limiter) oci.RegistryOCIHandler {
+	return oci.NewOCIHandler(handlerV2, limiter)
 }
 
-func MavenHandlerProvider(handler *maven.Handler) mavenRouter.Handler {
-	return mavenRouter.NewMavenHandler(handler)
+func MavenHandlerProvider(handler *maven.Handler, limiter *ratelimit.Limiter) mavenRouter.Handler {
+	return mavenRouter.NewMavenHandler(handler, limiter)
 }
 
-func GenericHandlerProvider(handler *generic.Handler) generic2.Handler {
-	return generic2.NewGenericArtifactHandler(handler)
+func GenericHandlerProvider(handler *generic.Handler, limiter *ratelimit.Limiter) generic2.Handler {
+	return generic2.NewGenericArtifactHandler(handler, limiter)
 }
 
 func PackageHandlerProvider(
@@ -113,9 +219,52 @@ func PackageHandlerProvider(
 	mavenHandler *maven.Handler,
 	genericHandler *generic.Handler,
 	pypiHandler pypi.Handler,
+	npmHandler npm.Handler,
+	nugetHandler nuget.Handler,
+	gemsHandler gems.Handler,
+	cargoHandler cargo.Handler,
+	goModuleHandler gomodule.Handler,
+	debianHandler debian.Handler,
+	rpmHandler rpm.Handler,
+	alpineHandler alpine.Handler,
+	conanHandler conan.Handler,
+	composerHandler composer.Handler,
+	condaHandler conda.Handler,
+	terraformHandler terraform.Handler,
+	swiftHandler swift.Handler,
+	cocoapodsHandler cocoapods.Handler,
+	hexHandler hex.Handler,
+	pubHandler pub.Handler,
+	cranHandler cran.Handler,
+	galaxyHandler galaxy.Handler,
+	puppetHandler puppet.Handler,
+	vagrantHandler vagrant.Handler,
+	modelHandler model.Handler,
+	homebrewHandler homebrew.Handler,
+	limiter *ratelimit.Limiter,
 ) packagerrouter.Handler {
-	return packagerrouter.NewRouter(handler, mavenHandler, genericHandler, pypiHandler)
+	return packagerrouter.NewRouter(handler, mavenHandler, genericHandler, pypiHandler, npmHandler,
+		nugetHandler, gemsHandler, cargoHandler, goModuleHandler, debianHandler, rpmHandler,
+		alpineHandler, conanHandler, composerHandler, condaHandler, terraformHandler, swiftHandler,
+		cocoapodsHandler, hexHandler, pubHandler, cranHandler, galaxyHandler, puppetHandler, vagrantHandler,
+		modelHandler, homebrewHandler, limiter)
+}
+
+// RateLimiterProvider returns the limiter shared by all registry routes, or nil if rate limiting is disabled.
+func RateLimiterProvider(config *types.Config) *ratelimit.Limiter {
+	return ratelimit.New(ratelimit.Config{
+		Limit:       config.Registry.RateLimit.Requests,
+		Window:      config.Registry.RateLimit.Window,
+		Quota:       config.Registry.RateLimit.Quota,
+		QuotaWindow: config.Registry.RateLimit.QuotaWindow,
+	})
+}
+
+// DrainerProvider returns the drainer tracking the transfers of all registry routes, which is drained
+// on shutdown.
+func DrainerProvider() *drain.Drainer {
+	return drain.New()
 }
 
 var WireSet = wire.NewSet(APIHandlerProvider, OCIHandlerProvider, AppRouterProvider,
-	MavenHandlerProvider, GenericHandlerProvider, PackageHandlerProvider)
+	MavenHandlerProvider, GenericHandlerProvider, PackageHandlerProvider, RateLimiterProvider, DrainerProvider)
@@ -21,22 +21,68 @@ import (
 	"github.com/harness/gitness/app/services/refcache"
 	corestore "github.com/harness/gitness/app/store"
 	urlprovider "github.com/harness/gitness/app/url"
+	alpine2 "github.com/harness/gitness/registry/app/api/handler/alpine"
+	cargo2 "github.com/harness/gitness/registry/app/api/handler/cargo"
+	cocoapods2 "github.com/harness/gitness/registry/app/api/handler/cocoapods"
+	composer2 "github.com/harness/gitness/registry/app/api/handler/composer"
+	conan2 "github.com/harness/gitness/registry/app/api/handler/conan"
+	conda2 "github.com/harness/gitness/registry/app/api/handler/conda"
+	cran2 "github.com/harness/gitness/registry/app/api/handler/cran"
+	debian2 "github.com/harness/gitness/registry/app/api/handler/debian"
+	galaxy2 "github.com/harness/gitness/registry/app/api/handler/galaxy"
+	gems2 "github.com/harness/gitness/registry/app/api/handler/gems"
 	"github.com/harness/gitness/registry/app/api/handler/generic"
+	gomodule2 "github.com/harness/gitness/registry/app/api/handler/gomodule"
+	hex2 "github.com/harness/gitness/registry/app/api/handler/hex"
+	homebrew2 "github.com/harness/gitness/registry/app/api/handler/homebrew"
 	mavenhandler "github.com/harness/gitness/registry/app/api/handler/maven"
+	model2 "github.com/harness/gitness/registry/app/api/handler/model"
+	npm2 "github.com/harness/gitness/registry/app/api/handler/npm"
+	nuget2 "github.com/harness/gitness/registry/app/api/handler/nuget"
 	ocihandler "github.com/harness/gitness/registry/app/api/handler/oci"
 	"github.com/harness/gitness/registry/app/api/handler/packages"
+	pub2 "github.com/harness/gitness/registry/app/api/handler/pub"
+	puppet2 "github.com/harness/gitness/registry/app/api/handler/puppet"
 	pypi2 "github.com/harness/gitness/registry/app/api/handler/pypi"
+	rpm2 "github.com/harness/gitness/registry/app/api/handler/rpm"
+	swift2 "github.com/harness/gitness/registry/app/api/handler/swift"
+	terraform2 "github.com/harness/gitness/registry/app/api/handler/terraform"
+	vagrant2 "github.com/harness/gitness/registry/app/api/handler/vagrant"
 	"github.com/harness/gitness/registry/app/api/router"
 	storagedriver "github.com/harness/gitness/registry/app/driver"
 	"github.com/harness/gitness/registry/app/driver/factory"
 	"github.com/harness/gitness/registry/app/driver/filesystem"
 	"github.com/harness/gitness/registry/app/driver/s3-aws"
 	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/alpine"
+	"github.com/harness/gitness/registry/app/pkg/blobserve"
+	"github.com/harness/gitness/registry/app/pkg/cargo"
+	"github.com/harness/gitness/registry/app/pkg/cocoapods"
+	"github.com/harness/gitness/registry/app/pkg/composer"
+	"github.com/harness/gitness/registry/app/pkg/conan"
+	"github.com/harness/gitness/registry/app/pkg/conda"
+	"github.com/harness/gitness/registry/app/pkg/cran"
+	"github.com/harness/gitness/registry/app/pkg/debian"
 	"github.com/harness/gitness/registry/app/pkg/docker"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/pkg/galaxy"
+	"github.com/harness/gitness/registry/app/pkg/gems"
 	generic2 "github.com/harness/gitness/registry/app/pkg/generic"
+	"github.com/harness/gitness/registry/app/pkg/gomodule"
+	"github.com/harness/gitness/registry/app/pkg/hex"
+	"github.com/harness/gitness/registry/app/pkg/homebrew"
+	"github.com/harness/gitness/registry/app/pkg/lease"
 	"github.com/harness/gitness/registry/app/pkg/maven"
+	"github.com/harness/gitness/registry/app/pkg/model"
+	"github.com/harness/gitness/registry/app/pkg/npm"
+	"github.com/harness/gitness/registry/app/pkg/nuget"
+	"github.com/harness/gitness/registry/app/pkg/pub"
+	"github.com/harness/gitness/registry/app/pkg/puppet"
 	"github.com/harness/gitness/registry/app/pkg/pypi"
+	"github.com/harness/gitness/registry/app/pkg/rpm"
+	"github.com/harness/gitness/registry/app/pkg/swift"
+	"github.com/harness/gitness/registry/app/pkg/terraform"
+	"github.com/harness/gitness/registry/app/pkg/vagrant"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/registry/config"
@@ -79,6 +125,7 @@ func NewHandlerProvider(
 	controller *docker.Controller, spaceFinder refcache.SpaceFinder, spaceStore corestore.SpaceStore,
 	tokenStore corestore.TokenStore, userCtrl *usercontroller.Controller, authenticator authn.Authenticator,
 	urlProvider urlprovider.Provider, authorizer authz.Authorizer, config *types.Config,
+	blobServer *blobserve.Server,
 ) *ocihandler.Handler {
 	return ocihandler.NewHandler(
 		controller,
@@ -90,13 +137,14 @@ func NewHandlerProvider(
 		urlProvider,
 		authorizer,
 		config.Registry.HTTP.RelativeURL,
+		blobServer,
 	)
 }
 
 func NewMavenHandlerProvider(
 	controller *maven.Controller, spaceStore corestore.SpaceStore,
 	tokenStore corestore.TokenStore, userCtrl *usercontroller.Controller, authenticator authn.Authenticator,
-	authorizer authz.Authorizer,
+	authorizer authz.Authorizer, blobServer *blobserve.Server,
 ) *mavenhandler.Handler {
 	return mavenhandler.NewHandler(
 		controller,
@@ -105,13 +153,14 @@ func NewMavenHandlerProvider(
 		userCtrl,
 		authenticator,
 		authorizer,
+		blobServer,
 	)
 }
 
 func NewPackageHandlerProvider(
 	registryDao store.RegistryRepository, spaceStore corestore.SpaceStore, tokenStore corestore.TokenStore,
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator,
-	urlProvider urlprovider.Provider, authorizer authz.Authorizer,
+	urlProvider urlprovider.Provider, authorizer authz.Authorizer, blobServer *blobserve.Server,
 ) packages.Handler {
 	return packages.NewHandler(
 		registryDao,
@@ -121,6 +170,7 @@ func NewPackageHandlerProvider(
 		authenticator,
 		urlProvider,
 		authorizer,
+		blobServer,
 	)
 }
 
@@ -131,10 +181,164 @@ func NewPypiHandlerProvider(
 	return pypi2.NewHandler(controller, packageHandler)
 }
 
+func NewNpmHandlerProvider(
+	controller npm.Controller,
+	packageHandler packages.Handler,
+) npm2.Handler {
+	return npm2.NewHandler(controller, packageHandler)
+}
+
+func NewNugetHandlerProvider(
+	controller nuget.Controller,
+	packageHandler packages.Handler,
+) nuget2.Handler {
+	return nuget2.NewHandler(controller, packageHandler)
+}
+
+func NewGemsHandlerProvider(
+	controller gems.Controller,
+	packageHandler packages.Handler,
+) gems2.Handler {
+	return gems2.NewHandler(controller, packageHandler)
+}
+
+func NewCargoHandlerProvider(
+	controller cargo.Controller,
+	packageHandler packages.Handler,
+) cargo2.Handler {
+	return cargo2.NewHandler(controller, packageHandler)
+}
+
+func NewGoModuleHandlerProvider(
+	controller gomodule.Controller,
+	packageHandler packages.Handler,
+) gomodule2.Handler {
+	return gomodule2.NewHandler(controller, packageHandler)
+}
+
+func NewDebianHandlerProvider(
+	controller debian.Controller,
+	packageHandler packages.Handler,
+) debian2.Handler {
+	return debian2.NewHandler(controller, packageHandler)
+}
+
+func NewRpmHandlerProvider(
+	controller rpm.Controller,
+	packageHandler packages.Handler,
+) rpm2.Handler {
+	return rpm2.NewHandler(controller, packageHandler)
+}
+
+func NewAlpineHandlerProvider(
+	controller alpine.Controller,
+	packageHandler packages.Handler,
+) alpine2.Handler {
+	return alpine2.NewHandler(controller, packageHandler)
+}
+
+func NewConanHandlerProvider(
+	controller conan.Controller,
+	packageHandler packages.Handler,
+) conan2.Handler {
+	return conan2.NewHandler(controller, packageHandler)
+}
+
+func NewComposerHandlerProvider(
+	controller composer.Controller,
+	packageHandler packages.Handler,
+) composer2.Handler {
+	return composer2.NewHandler(controller, packageHandler)
+}
+
+func NewCondaHandlerProvider(
+	controller conda.Controller,
+	packageHandler packages.Handler,
+) conda2.Handler {
+	return conda2.NewHandler(controller, packageHandler)
+}
+
+func NewTerraformHandlerProvider(
+	controller terraform.Controller,
+	packageHandler packages.Handler,
+) terraform2.Handler {
+	return terraform2.NewHandler(controller, packageHandler)
+}
+
+func NewSwiftHandlerProvider(
+	controller swift.Controller,
+	packageHandler packages.Handler,
+) swift2.Handler {
+	return swift2.NewHandler(controller, packageHandler)
+}
+
+func NewCocoapodsHandlerProvider(
+	controller cocoapods.Controller,
+	packageHandler packages.Handler,
+) cocoapods2.Handler {
+	return cocoapods2.NewHandler(controller, packageHandler)
+}
+
+func NewHexHandlerProvider(
+	controller hex.Controller,
+	packageHandler packages.Handler,
+) hex2.Handler {
+	return hex2.NewHandler(controller, packageHandler)
+}
+
+func NewPubHandlerProvider(
+	controller pub.Controller,
+	packageHandler packages.Handler,
+) pub2.Handler {
+	return pub2.NewHandler(controller, packageHandler)
+}
+
+func NewCranHandlerProvider(
+	controller cran.Controller,
+	packageHandler packages.Handler,
+) cran2.Handler {
+	return cran2.NewHandler(controller, packageHandler)
+}
+
+func NewGalaxyHandlerProvider(
+	controller galaxy.Controller,
+	packageHandler packages.Handler,
+) galaxy2.Handler {
+	return galaxy2.NewHandler(controller, packageHandler)
+}
+
+func NewPuppetHandlerProvider(
+	controller puppet.Controller,
+	packageHandler packages.Handler,
+) puppet2.Handler {
+	return puppet2.NewHandler(controller, packageHandler)
+}
+
+func NewVagrantHandlerProvider(
+	controller vagrant.Controller,
+	packageHandler packages.Handler,
+) vagrant2.Handler {
+	return vagrant2.NewHandler(controller, packageHandler)
+}
+
+func NewModelHandlerProvider(
+	controller model.Controller,
+	packageHandler packages.Handler,
+) model2.Handler {
+	return model2.NewHandler(controller, packageHandler)
+}
+
+func NewHomebrewHandlerProvider(
+	controller homebrew.Controller,
+	packageHandler packages.Handler,
+) homebrew2.Handler {
+	return homebrew2.NewHandler(controller, packageHandler)
+}
+
 func NewGenericHandlerProvider(
 	spaceStore corestore.SpaceStore, controller *generic2.Controller, tokenStore corestore.TokenStore,
 	userCtrl *usercontroller.Controller, authenticator authn.Authenticator, urlProvider urlprovider.Provider,
-	authorizer authz.Authorizer,
+	authorizer authz.Authorizer, blobServer *blobserve.Server,
 ) *generic.Handler {
 	return generic.NewGenericArtifactHandler(
 		spaceStore,
@@ -144,6 +348,7 @@ func NewGenericHandlerProvider(
 		authenticator,
 		urlProvider,
 		authorizer,
+		blobServer,
 	)
 }
 
@@ -154,12 +359,58 @@ var WireSet = wire.NewSet(
 	NewGenericHandlerProvider,
 	NewPackageHandlerProvider,
 	NewPypiHandlerProvider,
+	NewNpmHandlerProvider,
+	NewNugetHandlerProvider,
+	NewGemsHandlerProvider,
+	NewCargoHandlerProvider,
+	NewGoModuleHandlerProvider,
+	NewDebianHandlerProvider,
+	NewRpmHandlerProvider,
+	NewAlpineHandlerProvider,
+	NewConanHandlerProvider,
+	NewComposerHandlerProvider,
+	NewCondaHandlerProvider,
+	NewTerraformHandlerProvider,
+	NewSwiftHandlerProvider,
+	NewCocoapodsHandlerProvider,
+	NewHexHandlerProvider,
+	NewPubHandlerProvider,
+	NewCranHandlerProvider,
+	NewGalaxyHandlerProvider,
+	NewPuppetHandlerProvider,
+	NewVagrantHandlerProvider,
+	NewModelHandlerProvider,
+	NewHomebrewHandlerProvider,
 	database.WireSet,
 	pkg.WireSet,
+	blobserve.WireSet,
 	docker.WireSet,
 	filemanager.WireSet,
+	lease.WireSet,
 	maven.WireSet,
 	pypi.WireSet,
+	npm.WireSet,
+	nuget.WireSet,
+	gems.WireSet,
+	cargo.WireSet,
+	gomodule.WireSet,
+	debian.WireSet,
+	rpm.WireSet,
+	alpine.WireSet,
+	conan.WireSet,
+	composer.WireSet,
+	conda.WireSet,
+	terraform.WireSet,
+	swift.WireSet,
+	cocoapods.WireSet,
+	hex.WireSet,
+	pub.WireSet,
+	cran.WireSet,
+	galaxy.WireSet,
+	puppet.WireSet,
+	vagrant.WireSet,
+	model.WireSet,
+	homebrew.WireSet,
 	router.WireSet,
 	gc.WireSet,
 	generic2.WireSet,
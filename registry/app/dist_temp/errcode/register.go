@@ -147,6 +147,28 @@ var (
 			HTTPStatusCode: http.StatusBadRequest,
 		},
 	)
+
+	// ErrCodePreconditionFailed provides an error when a conditional request header,
+	// like If-None-Match, does not hold.
+	ErrCodePreconditionFailed = register(
+		"errcode", ErrorDescriptor{
+			Value:          "PRECONDITION_FAILED",
+			Message:        "precondition failed",
+			Description:    "Returned when a precondition of a conditional request does not hold",
+			HTTPStatusCode: http.StatusPreconditionFailed,
+		},
+	)
+
+	// ErrCodeConflict provides an error when the resource to create already exists,
+	// like a package version that was pushed before.
+	ErrCodeConflict = register(
+		"errcode", ErrorDescriptor{
+			Value:          "CONFLICT",
+			Message:        "conflict",
+			Description:    "Returned when the request conflicts with an existing resource",
+			HTTPStatusCode: http.StatusConflict,
+		},
+	)
 )
 
 const errGroup = "registry.api.v2"
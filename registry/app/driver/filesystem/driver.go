@@ -85,6 +85,7 @@ type baseEmbed struct {
 // filesystem. All provided paths will be subpaths of the RootDirectory.
 type Driver struct {
 	baseEmbed
+	rootDirectory string
 }
 
 // FromParameters constructs a new Driver with a given parameters map
@@ -134,6 +135,7 @@ func New(params DriverParameters) *Driver {
 				StorageDriver: base.NewRegulator(fsDriver, params.MaxThreads),
 			},
 		},
+		rootDirectory: params.RootDirectory,
 	}
 }
 
@@ -330,6 +332,26 @@ func (d *driver) Walk(
 	return storagedriver.WalkFallback(ctx, d, path, f, options...)
 }
 
+// Locate returns the path of the file the object at the given storage driver path is stored in. Files have
+// no storage class and aren't replicated.
+func (d *Driver) Locate(_ context.Context, subPath string) (*storagedriver.ObjectLocation, error) {
+	fullPath := path.Join(d.rootDirectory, subPath)
+	fi, err := os.Stat(fullPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, storagedriver.PathNotFoundError{Path: subPath, DriverName: driverName}
+		}
+		return nil, err
+	}
+	if fi.IsDir() {
+		return nil, storagedriver.PathNotFoundError{Path: subPath, DriverName: driverName}
+	}
+	return &storagedriver.ObjectLocation{
+		Location: fullPath,
+		Size:     fi.Size(),
+	}, nil
+}
+
 // fullPath returns the absolute path of a key within the Driver's storage.
 func (d *driver) fullPath(subPath string) string {
 	return path.Join(d.rootDirectory, subPath)
@@ -341,6 +363,7 @@ type fileInfo struct {
 }
 
 var _ storagedriver.FileInfo = fileInfo{}
+var _ storagedriver.ObjectLocator = &Driver{}
 
 // Path provides the full path of the target of this file info.
 func (fi fileInfo) Path() string {
@@ -179,6 +179,7 @@ func (factory *s3DriverFactory) Create(parameters map[string]interface{}) (stora
 }
 
 var _ storagedriver.StorageDriver = &driver{}
+var _ storagedriver.ObjectLocator = &Driver{}
 
 type driver struct {
 	S3                          *s3.S3
@@ -1378,6 +1379,38 @@ func (d *Driver) S3BucketKey(path string) string {
 	return d.StorageDriver.(*driver).s3Path(path)
 }
 
+// Locate returns the bucket and key of the object at the given storage driver path, along with its storage
+// class and replication status.
+func (d *Driver) Locate(ctx context.Context, path string) (*storagedriver.ObjectLocation, error) {
+	inner := d.StorageDriver.(*driver)
+	key := inner.s3Path(path)
+	resp, err := inner.S3.HeadObjectWithContext(
+		ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(inner.Bucket),
+			Key:    aws.String(key),
+		},
+	)
+	if err != nil {
+		var reqErr awserr.RequestFailure
+		if errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotFound {
+			return nil, storagedriver.PathNotFoundError{Path: path, DriverName: driverName}
+		}
+		return nil, parseError(path, err)
+	}
+
+	location := &storagedriver.ObjectLocation{
+		Location: "s3://" + inner.Bucket + "/" + key,
+		Size:     aws.Int64Value(resp.ContentLength),
+		// S3 leaves out the storage class of objects in the standard tier.
+		StorageClass:      s3.StorageClassStandard,
+		ReplicationStatus: aws.StringValue(resp.ReplicationStatus),
+	}
+	if resp.StorageClass != nil {
+		location.StorageClass = *resp.StorageClass
+	}
+	return location, nil
+}
+
 func parseError(path string, err error) error {
 	var s3Err awserr.Error
 	if ok := errors.As(err, &s3Err); ok && s3Err.Code() == "NoSuchKey" {
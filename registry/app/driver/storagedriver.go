@@ -133,6 +133,25 @@ type StorageDeleter interface {
 	Delete(ctx context.Context, path string) error
 }
 
+// ObjectLocation describes where an object is physically stored.
+type ObjectLocation struct {
+	// Location is the URL of the object in the storage backend, e.g. "s3://bucket/key", or the path of the
+	// file it's stored in.
+	Location string
+	Size     int64
+	// StorageClass is the tier the object is stored in, empty if the backend has no tiers.
+	StorageClass string
+	// ReplicationStatus is the status of the replication of the object, empty if it isn't replicated.
+	ReplicationStatus string
+}
+
+// ObjectLocator is implemented by the storage drivers that can report where an object is physically stored.
+type ObjectLocator interface {
+	// Locate returns the physical location of the object stored at "path", PathNotFoundError if there is
+	// no such object.
+	Locate(ctx context.Context, path string) (*ObjectLocation, error)
+}
+
 // FileWriter provides an abstraction for an opened writable file-like object in
 // the storage backend. The FileWriter must flush all content written to it on
 // the call to Close, but is only required to make its content readable on a
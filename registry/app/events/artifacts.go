@@ -28,6 +28,7 @@ const ArtifactsCategory = "artifacts"
 const ArtifactCreatedEvent events.EventType = "artifact-created"
 const ArtifactUpdatedEvent events.EventType = "artifact-updated"
 const ArtifactDeletedEvent events.EventType = "artifact-deleted"
+const BaseImageUpdatedEvent events.EventType = "base-image-updated"
 
 type ArtifactCreatedPayload struct {
 	RegistryID   int64                `json:"registry_id"`
@@ -136,6 +137,39 @@ func (r *Reader) RegisterArtifactUpdated(
 	return events.ReaderRegisterEvent(r.innerReader, ArtifactUpdatedEvent, fn, opts...)
 }
 
+// BaseImageUpdatedPayload is reported for an artifact whose base image tag was updated.
+type BaseImageUpdatedPayload struct {
+	RegistryID   int64                `json:"registry_id"`
+	PrincipalID  int64                `json:"principal_id"`
+	ArtifactType artifact.PackageType `json:"artifact_type"`
+	Artifact     *DockerArtifact      `json:"artifact"`
+	BaseImage    BaseImageChange      `json:"base_image"`
+}
+
+type BaseImageChange struct {
+	Name      string `json:"name"`
+	Tag       string `json:"tag"`
+	OldDigest string `json:"old_digest"`
+	NewDigest string `json:"new_digest"`
+}
+
+func (r *Reporter) BaseImageUpdated(ctx context.Context, payload *BaseImageUpdatedPayload) {
+	eventID, err := events.ReporterSendEvent(r.innerReporter, ctx, BaseImageUpdatedEvent, payload)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msgf("failed to send base image updated event")
+		return
+	}
+
+	log.Ctx(ctx).Debug().Msgf("reported base image updated event with id '%s'", eventID)
+}
+
+func (r *Reader) RegisterBaseImageUpdated(
+	fn events.HandlerFunc[*BaseImageUpdatedPayload],
+	opts ...events.HandlerOption,
+) error {
+	return events.ReaderRegisterEvent(r.innerReader, BaseImageUpdatedEvent, fn, opts...)
+}
+
 type ArtifactDeletedPayload struct {
 	RegistryID   int64                `json:"registry_id"`
 	PrincipalID  int64                `json:"principal_id"`
@@ -0,0 +1,256 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package alpine reads the .PKGINFO of Alpine packages (.apk) and the checksum of their control
+// segment, which APKINDEX entries are identified by.
+// Source: https://wiki.alpinelinux.org/wiki/Apk_spec
+package alpine
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha1" //nolint:gosec // apk identifies packages by the SHA-1 of their control segment.
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxPkgInfoSize is the largest .PKGINFO read from a package.
+	maxPkgInfoSize = 1 << 20
+
+	pkgInfoFile     = ".PKGINFO"
+	signaturePrefix = ".SIGN."
+	// checksumPrefix marks the checksums of APKINDEX as base64 encoded SHA-1.
+	checksumPrefix = "Q1"
+
+	// NoArch is the architecture of the packages which are installed on every architecture.
+	NoArch = "noarch"
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid alpine package")
+
+	namePattern         = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9+_.-]*$`)
+	versionPattern      = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*[a-z]?(_[a-z]+[0-9]*)*(~[0-9a-f]+)?-r[0-9]+$`)
+	architecturePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Metadata is the .PKGINFO of a package. Checksum is the checksum of the control segment, as
+// listed in the C: field of APKINDEX.
+type Metadata struct {
+	Name          string   `json:"name"`
+	Version       string   `json:"version"`
+	Architecture  string   `json:"architecture"`
+	Description   string   `json:"description,omitempty"`
+	URL           string   `json:"url,omitempty"`
+	License       string   `json:"license,omitempty"`
+	Origin        string   `json:"origin,omitempty"`
+	Maintainer    string   `json:"maintainer,omitempty"`
+	Commit        string   `json:"commit,omitempty"`
+	BuildDate     int64    `json:"build_date,omitempty"`
+	InstalledSize int64    `json:"installed_size,omitempty"`
+	Depends       []string `json:"depends,omitempty"`
+	Provides      []string `json:"provides,omitempty"`
+	InstallIf     []string `json:"install_if,omitempty"`
+	Checksum      string   `json:"checksum"`
+}
+
+// hashingReader passes the bytes read through to a hash, so the checksum of each gzip stream can
+// be computed while it's read. It's a byte reader, so gzip doesn't read ahead of the stream end.
+type hashingReader struct {
+	r    *bufio.Reader
+	hash hash.Hash
+}
+
+func (c *hashingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.hash.Write(p[:n])
+	return n, err
+}
+
+func (c *hashingReader) ReadByte() (byte, error) {
+	b, err := c.r.ReadByte()
+	if err == nil {
+		c.hash.Write([]byte{b})
+	}
+	return b, err
+}
+
+// ReadPackage reads the .PKGINFO of an .apk, which is the concatenation of gzip streams of an
+// optional signature segment, the control segment and the data segment, each a tar archive.
+func ReadPackage(r io.Reader) (*Metadata, error) {
+	cr := &hashingReader{r: bufio.NewReader(r)}
+	var z *gzip.Reader
+	for segment := 0; segment < 2; segment++ {
+		//nolint:gosec // see the import.
+		cr.hash = sha1.New()
+		var err error
+		if z == nil {
+			z, err = gzip.NewReader(cr)
+		} else {
+			err = z.Reset(cr)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		z.Multistream(false)
+
+		pkgInfo, signed, err := readSegment(z)
+		if err != nil {
+			return nil, err
+		}
+		// the trailer of the stream is part of the checksum
+		if _, err := io.Copy(io.Discard, z); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		if pkgInfo != nil {
+			metadata, err := ParsePkgInfo(pkgInfo)
+			if err != nil {
+				return nil, err
+			}
+			metadata.Checksum = checksumPrefix + base64.StdEncoding.EncodeToString(cr.hash.Sum(nil))
+			return metadata, nil
+		}
+		if !signed {
+			break
+		}
+	}
+	return nil, fmt.Errorf("%w: %s is missing", ErrInvalidPackage, pkgInfoFile)
+}
+
+// readSegment returns the .PKGINFO of a segment if it's the control segment, or whether it's the
+// signature segment.
+func readSegment(r io.Reader) ([]byte, bool, error) {
+	tr := tar.NewReader(r)
+	signed := false
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, signed, nil
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		switch {
+		case strings.HasPrefix(h.Name, signaturePrefix):
+			signed = true
+		case h.Name == pkgInfoFile:
+			if h.Size > maxPkgInfoSize {
+				return nil, false, fmt.Errorf("%w: %s is too large", ErrInvalidPackage, pkgInfoFile)
+			}
+			pkgInfo, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, false, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+			}
+			return pkgInfo, false, nil
+		}
+	}
+}
+
+// ParsePkgInfo parses the "key = value" lines of a .PKGINFO.
+func ParsePkgInfo(pkgInfo []byte) (*Metadata, error) {
+	m := &Metadata{}
+	for _, line := range strings.Split(string(pkgInfo), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: invalid line %q of %s", ErrInvalidPackage, line, pkgInfoFile)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		switch key {
+		case "pkgname":
+			m.Name = value
+		case "pkgver":
+			m.Version = value
+		case "arch":
+			m.Architecture = value
+		case "pkgdesc":
+			m.Description = value
+		case "url":
+			m.URL = value
+		case "license":
+			m.License = value
+		case "origin":
+			m.Origin = value
+		case "maintainer":
+			m.Maintainer = value
+		case "commit":
+			m.Commit = value
+		case "builddate":
+			m.BuildDate, _ = strconv.ParseInt(value, 10, 64)
+		case "size":
+			m.InstalledSize, _ = strconv.ParseInt(value, 10, 64)
+		case "depend":
+			m.Depends = append(m.Depends, value)
+		case "provides":
+			m.Provides = append(m.Provides, value)
+		case "install_if":
+			m.InstallIf = append(m.InstallIf, strings.Fields(value)...)
+		}
+	}
+	return m, nil
+}
+
+// Validate checks the fields APKINDEX entries are keyed by.
+func (m *Metadata) Validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("%w: invalid package name %q", ErrInvalidPackage, m.Name)
+	}
+	if !versionPattern.MatchString(m.Version) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidPackage, m.Version)
+	}
+	if !architecturePattern.MatchString(m.Architecture) {
+		return fmt.Errorf("%w: invalid architecture %q", ErrInvalidPackage, m.Architecture)
+	}
+	return nil
+}
+
+// Filename returns the name of the .apk of a package, which is the same on all architectures.
+func (m *Metadata) Filename() string {
+	return m.Name + "-" + m.Version + ".apk"
+}
+
+// ParseFilename splits the name of an .apk into the name and the version of the package.
+func ParseFilename(filename string) (string, string, error) {
+	invalid := fmt.Errorf("%s isn't the name of an alpine package", filename)
+	base, found := strings.CutSuffix(filename, ".apk")
+	if !found || path.Base(filename) != filename {
+		return "", "", invalid
+	}
+	parts := strings.Split(base, "-")
+	n := len(parts)
+	if n < 3 || parts[0] == "" || !strings.HasPrefix(parts[n-1], "r") {
+		return "", "", invalid
+	}
+	return strings.Join(parts[:n-2], "-"), parts[n-2] + "-" + parts[n-1], nil
+}
+
+// ValidateArchitecture checks the architecture of an index requested.
+func ValidateArchitecture(architecture string) error {
+	if !architecturePattern.MatchString(architecture) {
+		return fmt.Errorf("invalid architecture %q", architecture)
+	}
+	return nil
+}
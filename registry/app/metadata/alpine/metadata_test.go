@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1" //nolint:gosec // see metadata.go.
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPkgInfo = `# Generated by abuild 3.12.0-r0
+pkgname = hello
+pkgver = 2.12-r1
+pkgdesc = Prints a friendly greeting
+url = https://www.gnu.org/software/hello/
+builddate = 1700000000
+size = 61440
+arch = x86_64
+origin = hello
+maintainer = Jane Doe <jane@example.com>
+license = GPL-3.0-or-later
+depend = so:libc.musl-x86_64.so.1
+depend = busybox>=1.36
+provides = cmd:hello=2.12-r1
+`
+
+// buildSegment returns a gzip stream of a tar archive of the files, optionally without the end of
+// archive marker, as signature and control segments are written.
+func buildSegment(t *testing.T, files map[string]string, endMarker bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	if endMarker {
+		require.NoError(t, tw.Close())
+	} else {
+		require.NoError(t, tw.Flush())
+	}
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadPackage(t *testing.T) {
+	control := buildSegment(t, map[string]string{pkgInfoFile: testPkgInfo}, false)
+	data := buildSegment(t, map[string]string{"usr/bin/hello": "binary"}, true)
+	//nolint:gosec // see metadata.go.
+	sum := sha1.Sum(control)
+	checksum := "Q1" + base64.StdEncoding.EncodeToString(sum[:])
+
+	for name, signature := range map[string][]byte{
+		"unsigned": nil,
+		"signed":   buildSegment(t, map[string]string{".SIGN.RSA.key.rsa.pub": "signature"}, false),
+	} {
+		t.Run(name, func(t *testing.T) {
+			apk := append(append(append([]byte{}, signature...), control...), data...)
+			m, err := ReadPackage(bytes.NewReader(apk))
+			require.NoError(t, err)
+			assert.Equal(t, "hello", m.Name)
+			assert.Equal(t, "2.12-r1", m.Version)
+			assert.Equal(t, "x86_64", m.Architecture)
+			assert.Equal(t, "GPL-3.0-or-later", m.License)
+			assert.Equal(t, int64(61440), m.InstalledSize)
+			assert.Equal(t, int64(1700000000), m.BuildDate)
+			assert.Equal(t, []string{"so:libc.musl-x86_64.so.1", "busybox>=1.36"}, m.Depends)
+			assert.Equal(t, []string{"cmd:hello=2.12-r1"}, m.Provides)
+			assert.Equal(t, checksum, m.Checksum)
+			assert.NoError(t, m.Validate())
+			assert.Equal(t, "hello-2.12-r1.apk", m.Filename())
+		})
+	}
+}
+
+func TestReadPackageInvalid(t *testing.T) {
+	_, err := ReadPackage(bytes.NewReader([]byte("not a package")))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+
+	data := buildSegment(t, map[string]string{"usr/bin/hello": "binary"}, true)
+	_, err = ReadPackage(bytes.NewReader(data))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+}
+
+func TestValidate(t *testing.T) {
+	valid := Metadata{Name: "py3-foo", Version: "1.0.2_rc1-r0", Architecture: "aarch64"}
+	assert.NoError(t, valid.Validate())
+
+	for _, m := range []Metadata{
+		{Name: "", Version: "1.0-r0", Architecture: "x86_64"},
+		{Name: "foo", Version: "1.0", Architecture: "x86_64"},
+		{Name: "foo", Version: "1.0-r0", Architecture: "x86/64"},
+	} {
+		assert.ErrorIs(t, m.Validate(), ErrInvalidPackage, m)
+	}
+}
+
+func TestParseFilename(t *testing.T) {
+	name, version, err := ParseFilename("py3-hello-world-2.12-r1.apk")
+	require.NoError(t, err)
+	assert.Equal(t, "py3-hello-world", name)
+	assert.Equal(t, "2.12-r1", version)
+
+	for _, filename := range []string{"hello-2.12.apk", "hello-2.12-r1.deb", "x86_64/hello-2.12-r1.apk", "-r1.apk"} {
+		_, _, err := ParseFilename(filename)
+		assert.Error(t, err, filename)
+	}
+}
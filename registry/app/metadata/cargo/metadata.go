@@ -0,0 +1,215 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// maxMetadataSize bounds the JSON metadata of a publish request.
+const maxMetadataSize = 4 << 20
+
+var namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]{0,63}$`)
+
+// Metadata is the metadata of a crate version sent by `cargo publish`.
+// Source: https://doc.rust-lang.org/cargo/reference/registry-web-api.html#publish
+type Metadata struct {
+	Name          string              `json:"name"`
+	Version       string              `json:"vers"`
+	Dependencies  []Dependency        `json:"deps"`
+	Features      map[string][]string `json:"features"`
+	Authors       []string            `json:"authors,omitempty"`
+	Description   string              `json:"description,omitempty"`
+	Documentation string              `json:"documentation,omitempty"`
+	Homepage      string              `json:"homepage,omitempty"`
+	Readme        string              `json:"readme,omitempty"`
+	Keywords      []string            `json:"keywords,omitempty"`
+	Categories    []string            `json:"categories,omitempty"`
+	License       string              `json:"license,omitempty"`
+	LicenseFile   string              `json:"license_file,omitempty"`
+	Repository    string              `json:"repository,omitempty"`
+	Links         string              `json:"links,omitempty"`
+	RustVersion   string              `json:"rust_version,omitempty"`
+}
+
+type Dependency struct {
+	Name               string   `json:"name"`
+	VersionReq         string   `json:"version_req"`
+	Features           []string `json:"features"`
+	Optional           bool     `json:"optional"`
+	DefaultFeatures    bool     `json:"default_features"`
+	Target             string   `json:"target,omitempty"`
+	Kind               string   `json:"kind"`
+	Registry           string   `json:"registry,omitempty"`
+	ExplicitNameInToml string   `json:"explicit_name_in_toml,omitempty"`
+}
+
+// IndexEntry is a line of the index file of a crate, describing one version.
+// Source: https://doc.rust-lang.org/cargo/reference/registry-index.html#json-schema
+type IndexEntry struct {
+	Name        string              `json:"name"`
+	Version     string              `json:"vers"`
+	Deps        []IndexDependency   `json:"deps"`
+	Checksum    string              `json:"cksum"`
+	Features    map[string][]string `json:"features"`
+	Features2   map[string][]string `json:"features2,omitempty"`
+	Yanked      bool                `json:"yanked"`
+	Links       *string             `json:"links"`
+	V           int                 `json:"v"`
+	RustVersion string              `json:"rust_version,omitempty"`
+}
+
+type IndexDependency struct {
+	Name            string   `json:"name"`
+	Req             string   `json:"req"`
+	Features        []string `json:"features"`
+	Optional        bool     `json:"optional"`
+	DefaultFeatures bool     `json:"default_features"`
+	Target          *string  `json:"target"`
+	Kind            string   `json:"kind"`
+	Registry        *string  `json:"registry,omitempty"`
+	Package         *string  `json:"package,omitempty"`
+}
+
+// ReadPublish reads the metadata of a publish request and returns it along with the size of the
+// .crate, which the reader is positioned at. The body is the length prefixed JSON metadata
+// followed by the length prefixed .crate, the lengths are 32 bit little endian integers.
+func ReadPublish(r io.Reader) (*Metadata, int64, error) {
+	var length uint32
+	if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+		return nil, 0, fmt.Errorf("failed to read metadata length: %w", err)
+	}
+	if length > maxMetadataSize {
+		return nil, 0, errors.New("metadata is too large")
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, 0, fmt.Errorf("failed to read metadata: %w", err)
+	}
+	var md Metadata
+	if err := json.Unmarshal(data, &md); err != nil {
+		return nil, 0, fmt.Errorf("invalid metadata: %w", err)
+	}
+	if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+		return nil, 0, fmt.Errorf("failed to read crate length: %w", err)
+	}
+	return &md, int64(length), nil
+}
+
+// Validate checks the name and version of a crate, versions must be semver without a leading v.
+func (m *Metadata) Validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("invalid crate name %q", m.Name)
+	}
+	if _, err := semver.StrictNewVersion(m.Version); err != nil {
+		return fmt.Errorf("invalid crate version %q: %w", m.Version, err)
+	}
+	return nil
+}
+
+// IndexPath returns the path of the index file of a crate, derived from the length of its name.
+func IndexPath(name string) string {
+	name = strings.ToLower(name)
+	switch len(name) {
+	case 1:
+		return "1/" + name
+	case 2:
+		return "2/" + name
+	case 3:
+		return "3/" + name[:1] + "/" + name
+	default:
+		return name[:2] + "/" + name[2:4] + "/" + name
+	}
+}
+
+// Filename returns the name of the .crate of a version.
+func Filename(name, version string) string {
+	return name + "-" + version + ".crate"
+}
+
+// ToIndexEntry returns the index line of a version. Dependencies renamed in the manifest are
+// listed under the new name, with the name of the crate as the package. Features using the
+// "dep:" or "?/" syntax are listed in features2, which requires version 2 of the schema.
+func (m *Metadata) ToIndexEntry(checksum string) IndexEntry {
+	entry := IndexEntry{
+		Name:        m.Name,
+		Version:     m.Version,
+		Deps:        make([]IndexDependency, 0, len(m.Dependencies)),
+		Checksum:    checksum,
+		Features:    map[string][]string{},
+		V:           1,
+		RustVersion: m.RustVersion,
+	}
+	if m.Links != "" {
+		links := m.Links
+		entry.Links = &links
+	}
+	for _, d := range m.Dependencies {
+		dep := IndexDependency{
+			Name:            d.Name,
+			Req:             d.VersionReq,
+			Features:        d.Features,
+			Optional:        d.Optional,
+			DefaultFeatures: d.DefaultFeatures,
+			Kind:            d.Kind,
+		}
+		if dep.Features == nil {
+			dep.Features = []string{}
+		}
+		if d.Target != "" {
+			target := d.Target
+			dep.Target = &target
+		}
+		if d.Registry != "" {
+			registry := d.Registry
+			dep.Registry = &registry
+		}
+		if d.ExplicitNameInToml != "" {
+			pkg := d.Name
+			dep.Name = d.ExplicitNameInToml
+			dep.Package = &pkg
+		}
+		entry.Deps = append(entry.Deps, dep)
+	}
+	for feature, values := range m.Features {
+		if usesNewFeatureSyntax(values) {
+			if entry.Features2 == nil {
+				entry.Features2 = map[string][]string{}
+			}
+			entry.Features2[feature] = values
+			entry.V = 2
+			continue
+		}
+		entry.Features[feature] = values
+	}
+	return entry
+}
+
+func usesNewFeatureSyntax(values []string) bool {
+	for _, v := range values {
+		if strings.HasPrefix(v, "dep:") || strings.Contains(v, "?/") {
+			return true
+		}
+	}
+	return false
+}
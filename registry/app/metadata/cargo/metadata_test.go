@@ -0,0 +1,92 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func publishBody(t *testing.T, metadata string, crate []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, uint32(len(metadata))))
+	buf.WriteString(metadata)
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, uint32(len(crate))))
+	buf.Write(crate)
+	return buf.Bytes()
+}
+
+func TestReadPublish(t *testing.T) {
+	body := publishBody(t, `{
+		"name": "acme_log", "vers": "0.3.1", "license": "MIT",
+		"deps": [
+			{"name": "serde", "version_req": "^1.0", "features": ["derive"], "optional": true,
+			 "default_features": true, "kind": "normal"},
+			{"name": "tokio", "version_req": "^1", "kind": "dev", "default_features": true,
+			 "explicit_name_in_toml": "rt", "target": "cfg(unix)"}
+		],
+		"features": {"default": ["std"], "std": [], "json": ["dep:serde", "serde?/std"]},
+		"links": "acme"
+	}`, []byte("crate"))
+
+	r := bytes.NewReader(body)
+	md, size, err := ReadPublish(r)
+	require.NoError(t, err)
+	require.NoError(t, md.Validate())
+	assert.Equal(t, "acme_log", md.Name)
+	assert.Equal(t, "0.3.1", md.Version)
+	assert.Equal(t, "MIT", md.License)
+	assert.Equal(t, int64(5), size)
+	crate, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "crate", string(crate))
+
+	entry := md.ToIndexEntry("abc")
+	assert.Equal(t, 2, entry.V)
+	assert.Equal(t, map[string][]string{"default": {"std"}, "std": {}}, entry.Features)
+	assert.Equal(t, map[string][]string{"json": {"dep:serde", "serde?/std"}}, entry.Features2)
+	require.Len(t, entry.Deps, 2)
+	assert.Nil(t, entry.Deps[0].Package)
+	assert.Nil(t, entry.Deps[0].Target)
+	assert.Equal(t, "rt", entry.Deps[1].Name)
+	assert.Equal(t, "tokio", *entry.Deps[1].Package)
+	assert.Equal(t, "cfg(unix)", *entry.Deps[1].Target)
+	assert.Equal(t, []string{}, entry.Deps[1].Features)
+
+	line, err := json.Marshal(entry)
+	require.NoError(t, err)
+	assert.Contains(t, string(line), `"cksum":"abc"`)
+	assert.Contains(t, string(line), `"links":"acme"`)
+}
+
+func TestValidate(t *testing.T) {
+	assert.Error(t, (&Metadata{Name: "1acme", Version: "1.0.0"}).Validate())
+	assert.Error(t, (&Metadata{Name: "acme", Version: "v1.0.0"}).Validate())
+	assert.NoError(t, (&Metadata{Name: "acme-log", Version: "1.0.0-rc.1+build.5"}).Validate())
+}
+
+func TestIndexPath(t *testing.T) {
+	assert.Equal(t, "1/a", IndexPath("a"))
+	assert.Equal(t, "2/io", IndexPath("io"))
+	assert.Equal(t, "3/s/syn", IndexPath("syn"))
+	assert.Equal(t, "se/rd/serde_json", IndexPath("Serde_JSON"))
+}
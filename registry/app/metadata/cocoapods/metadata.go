@@ -0,0 +1,174 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// VersionFile is the file CocoaPods identifies a CDN source by.
+	VersionFile = "CocoaPods-version.yml"
+	// SpecsDir is the directory of the CDN source the podspecs are served under.
+	SpecsDir = "Specs"
+
+	// prefixLength is the number of characters each level of the Specs directory is named after,
+	// out of the MD5 of the name of the pod.
+	prefixLength = 1
+	prefixLevels = 3
+	podspecExt   = ".podspec.json"
+)
+
+var (
+	ErrInvalidPod = errors.New("invalid pod")
+
+	// namePattern matches the names trunk accepts, which are used as directory names.
+	namePattern    = regexp.MustCompile(`^[A-Za-z0-9_+][A-Za-z0-9_+.-]*$`)
+	versionPattern = regexp.MustCompile(`^[0-9]+(?:\.[0-9]+)*(?:-[0-9A-Za-z.-]+)?$`)
+
+	// archiveExtensions are the archives a pod can be pushed with, which CocoaPods tells apart by
+	// the extension of their URL.
+	archiveExtensions = []string{".zip", ".tar.gz", ".tgz", ".tar"}
+)
+
+// Podspec is what the registry keeps of the JSON podspec of a pushed version.
+// Source: https://guides.cocoapods.org/syntax/podspec.html
+type Podspec struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+	Homepage    string `json:"homepage,omitempty"`
+	License     string `json:"license,omitempty"`
+	// Platforms are the deployment targets of the pod by platform, empty for any version.
+	Platforms map[string]string `json:"platforms,omitempty"`
+	// Dependencies are the names of the pods the pod depends on.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// ValidateName checks the name of a pod.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidPod, name)
+	}
+	return nil
+}
+
+// ValidateVersion checks the version of a pod.
+func ValidateVersion(version string) error {
+	if !versionPattern.MatchString(version) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidPod, version)
+	}
+	return nil
+}
+
+// ReadPodspec reads a JSON podspec, as pod ipc spec converts a Ruby podspec to, returning what the
+// registry keeps of it along with the whole podspec.
+func ReadPodspec(data []byte) (*Podspec, map[string]any, error) {
+	raw := map[string]any{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("%w: podspec must be JSON: %w", ErrInvalidPod, err)
+	}
+	spec := &Podspec{
+		Name:        stringField(raw, "name"),
+		Version:     stringField(raw, "version"),
+		Summary:     stringField(raw, "summary"),
+		Description: stringField(raw, "description"),
+		Homepage:    stringField(raw, "homepage"),
+		License:     stringField(raw, "license"),
+	}
+	if err := ValidateName(spec.Name); err != nil {
+		return nil, nil, err
+	}
+	if err := ValidateVersion(spec.Version); err != nil {
+		return nil, nil, err
+	}
+	if license, ok := raw["license"].(map[string]any); ok {
+		spec.License = stringField(license, "type")
+	}
+	if platforms, ok := raw["platforms"].(map[string]any); ok {
+		spec.Platforms = map[string]string{}
+		for platform, target := range platforms {
+			spec.Platforms[platform], _ = target.(string)
+		}
+	}
+	if dependencies, ok := raw["dependencies"].(map[string]any); ok {
+		for dependency := range dependencies {
+			spec.Dependencies = append(spec.Dependencies, dependency)
+		}
+		sort.Strings(spec.Dependencies)
+	}
+	return spec, raw, nil
+}
+
+// RewriteSource points the source of a podspec to the archive the pod was pushed with, so that
+// CocoaPods downloads it from the registry.
+func RewriteSource(raw map[string]any, archiveURL, sha256 string) ([]byte, error) {
+	raw["source"] = map[string]any{"http": archiveURL, "sha256": sha256}
+	data, err := json.MarshalIndent(raw, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode podspec: %w", err)
+	}
+	return data, nil
+}
+
+// ArchiveExtension returns the extension of an archive a pod is pushed with.
+func ArchiveExtension(filename string) (string, error) {
+	for _, ext := range archiveExtensions {
+		if strings.HasSuffix(strings.ToLower(filename), ext) {
+			return ext, nil
+		}
+	}
+	return "", fmt.Errorf("%w: archive must be one of %s", ErrInvalidPod, strings.Join(archiveExtensions, ", "))
+}
+
+// PodspecFilename is the name of the podspec of a pod in the Specs directory.
+func PodspecFilename(name string) string {
+	return name + podspecExt
+}
+
+// ShardPrefix returns the directories the podspecs of a pod are under in the Specs directory,
+// which are also the suffix of the shard of the version lists the pod is in.
+func ShardPrefix(name string) []string {
+	sum := md5.Sum([]byte(name)) //nolint:gosec
+	digest := hex.EncodeToString(sum[:])
+	prefix := make([]string, 0, prefixLevels)
+	for i := 0; i < prefixLevels; i++ {
+		prefix = append(prefix, digest[i*prefixLength:(i+1)*prefixLength])
+	}
+	return prefix
+}
+
+// VersionFileContent is the CocoaPods-version.yml of the source, which tells CocoaPods how the
+// Specs directory is sharded.
+func VersionFileContent() string {
+	lengths := make([]string, 0, prefixLevels)
+	for i := 0; i < prefixLevels; i++ {
+		lengths = append(lengths, fmt.Sprint(prefixLength))
+	}
+	return "---\nmin: 1.0.0\nlast: 1.0.0\nprefix_lengths:\n- " + strings.Join(lengths, "\n- ") + "\n"
+}
+
+func stringField(raw map[string]any, key string) string {
+	value, _ := raw[key].(string)
+	return value
+}
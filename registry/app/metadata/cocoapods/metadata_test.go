@@ -0,0 +1,77 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPodspec(t *testing.T) {
+	spec, raw, err := ReadPodspec([]byte(`{
+		"name": "AFNetworking",
+		"version": "4.0.1",
+		"summary": "A delightful networking framework.",
+		"license": {"type": "MIT", "file": "LICENSE"},
+		"platforms": {"ios": "9.0", "osx": "10.10"},
+		"source": {"git": "https://github.com/AFNetworking/AFNetworking.git", "tag": "4.0.1"},
+		"dependencies": {"Reachability": [], "Alamofire": ["~> 5.0"]}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, &Podspec{
+		Name:         "AFNetworking",
+		Version:      "4.0.1",
+		Summary:      "A delightful networking framework.",
+		License:      "MIT",
+		Platforms:    map[string]string{"ios": "9.0", "osx": "10.10"},
+		Dependencies: []string{"Alamofire", "Reachability"},
+	}, spec)
+
+	data, err := RewriteSource(raw, "https://pkg/cocoapods/pods/AFNetworking/4.0.1/AFNetworking-4.0.1.zip", "abc")
+	require.NoError(t, err)
+	var rewritten map[string]any
+	require.NoError(t, json.Unmarshal(data, &rewritten))
+	assert.Equal(t, map[string]any{
+		"http":   "https://pkg/cocoapods/pods/AFNetworking/4.0.1/AFNetworking-4.0.1.zip",
+		"sha256": "abc",
+	}, rewritten["source"])
+	assert.Equal(t, "AFNetworking", rewritten["name"])
+}
+
+func TestReadPodspecInvalid(t *testing.T) {
+	for _, data := range []string{
+		`Pod::Spec.new do |s|`,
+		`{"name": "Bad Name", "version": "1.0.0"}`,
+		`{"name": "Good", "version": "latest"}`,
+	} {
+		_, _, err := ReadPodspec([]byte(data))
+		assert.ErrorIs(t, err, ErrInvalidPod, data)
+	}
+}
+
+func TestShardPrefix(t *testing.T) {
+	assert.Equal(t, []string{"a", "7", "5"}, ShardPrefix("AFNetworking"))
+}
+
+func TestArchiveExtension(t *testing.T) {
+	ext, err := ArchiveExtension("pod.TAR.GZ")
+	require.NoError(t, err)
+	assert.Equal(t, ".tar.gz", ext)
+	_, err = ArchiveExtension("pod.rar")
+	assert.ErrorIs(t, err, ErrInvalidPod)
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package composer reads the composer.json of PHP packages, which are zip archives, and normalizes
+// their versions the way Composer compares them.
+// Source: https://getcomposer.org/doc/04-schema.md
+package composer
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// maxComposerJSONSize is the largest composer.json read from a package.
+	maxComposerJSONSize = 1 << 20
+
+	composerJSONFile = "composer.json"
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid composer package")
+
+	namePattern = regexp.MustCompile(`^[a-z0-9]([_.-]?[a-z0-9]+)*/[a-z0-9](([_.]|-{1,2})?[a-z0-9]+)*$`)
+	// versionPattern matches the versions of releases, like 1.2.3, v2.0-beta1 or 1.0.0-RC2.
+	versionPattern = regexp.MustCompile(
+		`(?i)^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.(\d+))?` +
+			`(?:[._-]?(stable|beta|b|rc|alpha|a|patch|pl|p)(?:[.-]?(\d+))?)?$`)
+	devPattern = regexp.MustCompile(`^dev-[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+	stabilities = map[string]string{
+		"stable": "", "beta": "beta", "b": "beta", "rc": "RC", "alpha": "alpha", "a": "alpha",
+		"patch": "patch", "pl": "patch", "p": "patch",
+	}
+)
+
+// Metadata is the composer.json of a package, the fields Composer resolves and installs packages
+// by are kept as they are.
+type Metadata struct {
+	Name        string            `json:"name"`
+	Version     string            `json:"version,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Type        string            `json:"type,omitempty"`
+	Keywords    []string          `json:"keywords,omitempty"`
+	Homepage    string            `json:"homepage,omitempty"`
+	License     Licenses          `json:"license,omitempty"`
+	Authors     []Author          `json:"authors,omitempty"`
+	Require     map[string]string `json:"require,omitempty"`
+	RequireDev  map[string]string `json:"require-dev,omitempty"`
+	Conflict    map[string]string `json:"conflict,omitempty"`
+	Replace     map[string]string `json:"replace,omitempty"`
+	Provide     map[string]string `json:"provide,omitempty"`
+	Suggest     map[string]string `json:"suggest,omitempty"`
+	Autoload    json.RawMessage   `json:"autoload,omitempty"`
+	AutoloadDev json.RawMessage   `json:"autoload-dev,omitempty"`
+	Bin         []string          `json:"bin,omitempty"`
+	Extra       json.RawMessage   `json:"extra,omitempty"`
+	Support     map[string]string `json:"support,omitempty"`
+}
+
+type Author struct {
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Homepage string `json:"homepage,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+// Licenses are the licenses of a package, which composer.json has as a string or an array.
+type Licenses []string
+
+func (l *Licenses) UnmarshalJSON(data []byte) error {
+	var license string
+	if err := json.Unmarshal(data, &license); err == nil {
+		*l = Licenses{license}
+		return nil
+	}
+	var licenses []string
+	if err := json.Unmarshal(data, &licenses); err != nil {
+		return fmt.Errorf("license has to be a string or an array of strings: %w", err)
+	}
+	*l = licenses
+	return nil
+}
+
+// ReadPackage reads the composer.json of a zip archive, which is at the root of the archive, or
+// in its top level directory as in the archives of source hosts.
+func ReadPackage(r io.ReaderAt, size int64) (*Metadata, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	var found *zip.File
+	for _, f := range archive.File {
+		if path.Base(f.Name) != composerJSONFile || f.FileInfo().IsDir() {
+			continue
+		}
+		depth := strings.Count(strings.Trim(f.Name, "/"), "/")
+		if depth > 1 {
+			continue
+		}
+		if found == nil || depth < strings.Count(strings.Trim(found.Name, "/"), "/") {
+			found = f
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: %s not found", ErrInvalidPackage, composerJSONFile)
+	}
+	if found.UncompressedSize64 > maxComposerJSONSize {
+		return nil, fmt.Errorf("%w: %s is too large", ErrInvalidPackage, composerJSONFile)
+	}
+	rc, err := found.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	defer rc.Close()
+	content, err := io.ReadAll(io.LimitReader(rc, maxComposerJSONSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	return ParseComposerJSON(content)
+}
+
+func ParseComposerJSON(content []byte) (*Metadata, error) {
+	var metadata Metadata
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return nil, fmt.Errorf("%w: invalid %s: %w", ErrInvalidPackage, composerJSONFile, err)
+	}
+	return &metadata, nil
+}
+
+// Validate validates the name of a package, the version is validated by NormalizeVersion.
+func (m *Metadata) Validate() error {
+	return ValidateName(m.Name)
+}
+
+// Filename returns the name the archive of a version is stored and downloaded by.
+func Filename(name, version string) string {
+	return strings.ReplaceAll(name, "/", "-") + "-" + version + ".zip"
+}
+
+// NormalizeVersion returns the version_normalized of a version, which pads releases to four
+// numbers and spells stabilities out, 1.2-b3 is normalized to 1.2.0.0-beta3. Branches, like
+// dev-main, are kept as they are.
+func NormalizeVersion(version string) (string, error) {
+	if devPattern.MatchString(version) {
+		return version, nil
+	}
+	m := versionPattern.FindStringSubmatch(version)
+	if m == nil {
+		return "", fmt.Errorf("%w: invalid version %q", ErrInvalidPackage, version)
+	}
+	parts := make([]string, 4)
+	for i := range parts {
+		parts[i] = m[i+1]
+		if parts[i] == "" {
+			parts[i] = "0"
+		}
+	}
+	normalized := strings.Join(parts, ".")
+	if stability := stabilities[strings.ToLower(m[5])]; stability != "" {
+		normalized += "-" + stability + m[6]
+	}
+	return normalized, nil
+}
+
+// ValidateName validates the vendor/package name of a request.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid name %q, expected vendor/package in lower case", ErrInvalidPackage, name)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testComposerJSON = `{
+	"name": "acme/hello",
+	"description": "Prints a friendly greeting",
+	"type": "library",
+	"license": "MIT",
+	"authors": [{"name": "Jane Doe", "email": "jane@example.com"}],
+	"require": {"php": ">=8.1", "psr/log": "^3.0"},
+	"autoload": {"psr-4": {"Acme\\Hello\\": "src/"}}
+}`
+
+func buildZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestReadPackage(t *testing.T) {
+	for name, files := range map[string]map[string]string{
+		"root": {"composer.json": testComposerJSON, "src/Hello.php": "<?php"},
+		"top level directory": {
+			"hello-1.0.0/composer.json":        testComposerJSON,
+			"hello-1.0.0/vendor/composer.json": `{"name": "other/package"}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			archive := buildZip(t, files)
+			m, err := ReadPackage(bytes.NewReader(archive), int64(len(archive)))
+			require.NoError(t, err)
+			require.NoError(t, m.Validate())
+			assert.Equal(t, "acme/hello", m.Name)
+			assert.Equal(t, "library", m.Type)
+			assert.Equal(t, Licenses{"MIT"}, m.License)
+			assert.Equal(t, "^3.0", m.Require["psr/log"])
+			assert.JSONEq(t, `{"psr-4": {"Acme\\Hello\\": "src/"}}`, string(m.Autoload))
+		})
+	}
+
+	archive := buildZip(t, map[string]string{"a/b/composer.json": testComposerJSON})
+	_, err := ReadPackage(bytes.NewReader(archive), int64(len(archive)))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+}
+
+func TestParseComposerJSON_Licenses(t *testing.T) {
+	m, err := ParseComposerJSON([]byte(`{"name": "acme/hello", "license": ["MIT", "GPL-3.0-only"]}`))
+	require.NoError(t, err)
+	assert.Equal(t, Licenses{"MIT", "GPL-3.0-only"}, m.License)
+
+	_, err = ParseComposerJSON([]byte(`{"name": "acme/hello", "license": 1}`))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+}
+
+func TestValidate(t *testing.T) {
+	for _, name := range []string{"acme/hello", "acme-corp/hello.world", "a1/b--c"} {
+		assert.NoError(t, (&Metadata{Name: name}).Validate(), name)
+	}
+	for _, name := range []string{"", "hello", "Acme/Hello", "acme/hello/world", "-acme/hello"} {
+		assert.ErrorIs(t, (&Metadata{Name: name}).Validate(), ErrInvalidPackage, name)
+	}
+}
+
+func TestNormalizeVersion(t *testing.T) {
+	for version, expected := range map[string]string{
+		"1.2.3":        "1.2.3.0",
+		"v1.2":         "1.2.0.0",
+		"2":            "2.0.0.0",
+		"1.0.0-beta1":  "1.0.0.0-beta1",
+		"1.0.0-RC2":    "1.0.0.0-RC2",
+		"1.0-b3":       "1.0.0.0-beta3",
+		"1.0.0-stable": "1.0.0.0",
+		"dev-main":     "dev-main",
+	} {
+		normalized, err := NormalizeVersion(version)
+		require.NoError(t, err, version)
+		assert.Equal(t, expected, normalized, version)
+	}
+	for _, version := range []string{"", "latest", "1.2.3.4.5", "1.0-foo"} {
+		_, err := NormalizeVersion(version)
+		assert.ErrorIs(t, err, ErrInvalidPackage, version)
+	}
+}
+
+func TestFilename(t *testing.T) {
+	assert.Equal(t, "acme-hello-1.0.0.zip", Filename("acme/hello", "1.0.0"))
+}
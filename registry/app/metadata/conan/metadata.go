@@ -0,0 +1,197 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conan parses the references of Conan recipes and the conaninfo.txt of their binary
+// packages.
+// Source: https://docs.conan.io/2/reference/conanfile/attributes.html
+package conan
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// FileInfo is the file of a binary package describing the settings, options and requirements
+	// it was built with.
+	FileInfo = "conaninfo.txt"
+	// FileManifest is the last file uploaded to a revision, which lists the checksums of the others.
+	FileManifest = "conanmanifest.txt"
+	// FilePackage is the archive of the binaries of a package, its download counts as a download of the
+	// recipe version.
+	FilePackage = "conan_package.tgz"
+
+	// emptyUserChannel is how references without user and channel are spelled in the URLs.
+	emptyUserChannel = "_"
+)
+
+var (
+	ErrInvalidReference = errors.New("invalid conan reference")
+
+	namePattern     = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_+.-]{1,100}$`)
+	versionPattern  = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_+.-]{0,100}$`)
+	userPattern     = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_+.-]{1,50}$`)
+	revisionPattern = regexp.MustCompile(`^[a-f0-9]{1,64}$`)
+	filenamePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*$`)
+)
+
+// Reference identifies a recipe, User and Channel are optional but set together.
+type Reference struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	User    string `json:"user,omitempty"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// ParseReference returns the reference of the path segments of a recipe, where "_" stands for
+// no user and channel.
+func ParseReference(name, version, user, channel string) (Reference, error) {
+	if user == emptyUserChannel {
+		user = ""
+	}
+	if channel == emptyUserChannel {
+		channel = ""
+	}
+	ref := Reference{Name: name, Version: version, User: user, Channel: channel}
+	if !namePattern.MatchString(name) {
+		return Reference{}, fmt.Errorf("%w: invalid name %q", ErrInvalidReference, name)
+	}
+	if !versionPattern.MatchString(version) {
+		return Reference{}, fmt.Errorf("%w: invalid version %q", ErrInvalidReference, version)
+	}
+	if (user == "") != (channel == "") {
+		return Reference{}, fmt.Errorf("%w: user and channel have to be set together", ErrInvalidReference)
+	}
+	if user != "" && (!userPattern.MatchString(user) || !userPattern.MatchString(channel)) {
+		return Reference{}, fmt.Errorf("%w: invalid user or channel %s/%s", ErrInvalidReference, user, channel)
+	}
+	return ref, nil
+}
+
+// ParseImageName returns the reference of a recipe stored as an image, which is the inverse of
+// ImageName.
+func ParseImageName(image, version string) (Reference, error) {
+	name, userChannel, found := strings.Cut(image, "@")
+	if !found {
+		return ParseReference(name, version, "", "")
+	}
+	user, channel, _ := strings.Cut(userChannel, ":")
+	return ParseReference(name, version, user, channel)
+}
+
+// ImageName returns the name the recipes of a reference are stored under. User and channel are
+// joined with ':' rather than '/', as the name is part of the storage paths.
+func (r Reference) ImageName() string {
+	if r.User == "" {
+		return r.Name
+	}
+	return r.Name + "@" + r.User + ":" + r.Channel
+}
+
+// String returns the reference as Conan spells it, name/version[@user/channel].
+func (r Reference) String() string {
+	if r.User == "" {
+		return r.Name + "/" + r.Version
+	}
+	return r.Name + "/" + r.Version + "@" + r.User + "/" + r.Channel
+}
+
+// URLPath returns the path segments of the reference in the API, name/version/user/channel.
+func (r Reference) URLPath() string {
+	user, channel := r.User, r.Channel
+	if user == "" {
+		user, channel = emptyUserChannel, emptyUserChannel
+	}
+	return r.Name + "/" + r.Version + "/" + user + "/" + channel
+}
+
+// ValidateRevision validates a recipe or package revision, which are hashes.
+func ValidateRevision(revision string) error {
+	if !revisionPattern.MatchString(revision) {
+		return fmt.Errorf("%w: invalid revision %q", ErrInvalidReference, revision)
+	}
+	return nil
+}
+
+// ValidatePackageID validates the ID of a binary package, which is a hash of its conaninfo.txt.
+func ValidatePackageID(packageID string) error {
+	if !revisionPattern.MatchString(packageID) {
+		return fmt.Errorf("%w: invalid package ID %q", ErrInvalidReference, packageID)
+	}
+	return nil
+}
+
+// ValidateFilename validates the name of a file of a revision, which are stored flat.
+func ValidateFilename(filename string) error {
+	if !filenamePattern.MatchString(filename) {
+		return fmt.Errorf("%w: invalid file name %q", ErrInvalidReference, filename)
+	}
+	return nil
+}
+
+// Info is the conaninfo.txt of a binary package, in the form the package search returns it.
+type Info struct {
+	Settings map[string]string `json:"settings"`
+	Options  map[string]string `json:"options"`
+	Requires []string          `json:"requires,omitempty"`
+}
+
+// ParseInfo parses a conaninfo.txt, an INI like file of [settings], [options] and [requires]
+// sections. Other sections don't affect which package a client picks and are skipped.
+func ParseInfo(content []byte) (*Info, error) {
+	info := &Info{
+		Settings: make(map[string]string),
+		Options:  make(map[string]string),
+	}
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("invalid %s: malformed section %q", FileInfo, line)
+			}
+			section = line[1 : len(line)-1]
+			continue
+		}
+		switch section {
+		case "settings", "options":
+			key, value, found := strings.Cut(line, "=")
+			if !found {
+				return nil, fmt.Errorf("invalid %s: malformed %s %q", FileInfo, section, line)
+			}
+			key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+			if section == "settings" {
+				info.Settings[key] = value
+			} else {
+				info.Options[key] = value
+			}
+		case "requires":
+			info.Requires = append(info.Requires, line)
+		case "":
+			return nil, fmt.Errorf("invalid %s: %q is outside of a section", FileInfo, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", FileInfo, err)
+	}
+	return info, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	ref, err := ParseReference("zlib", "1.3.1", "_", "_")
+	require.NoError(t, err)
+	assert.Equal(t, Reference{Name: "zlib", Version: "1.3.1"}, ref)
+	assert.Equal(t, "zlib/1.3.1", ref.String())
+	assert.Equal(t, "zlib", ref.ImageName())
+	assert.Equal(t, "zlib/1.3.1/_/_", ref.URLPath())
+
+	ref, err = ParseReference("zlib", "1.3.1", "acme", "stable")
+	require.NoError(t, err)
+	assert.Equal(t, "zlib/1.3.1@acme/stable", ref.String())
+	assert.Equal(t, "zlib@acme:stable", ref.ImageName())
+	assert.Equal(t, "zlib/1.3.1/acme/stable", ref.URLPath())
+
+	parsed, err := ParseImageName(ref.ImageName(), ref.Version)
+	require.NoError(t, err)
+	assert.Equal(t, ref, parsed)
+
+	for _, tc := range [][4]string{
+		{"z", "1.0", "_", "_"},
+		{"zlib", "../1.0", "_", "_"},
+		{"zlib", "1.0", "acme", "_"},
+		{"zlib", "1.0", "acme", "sta/ble"},
+	} {
+		_, err := ParseReference(tc[0], tc[1], tc[2], tc[3])
+		assert.ErrorIs(t, err, ErrInvalidReference, tc)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, ValidateRevision("f1a6c6b4b4bd3a4e1a7d2c6a1a95c7e9"))
+	assert.Error(t, ValidateRevision("latest"))
+	assert.NoError(t, ValidatePackageID("da39a3ee5e6b4b0d3255bfef95601890afd80709"))
+	assert.Error(t, ValidatePackageID(""))
+	assert.NoError(t, ValidateFilename("conan_package.tgz"))
+	assert.Error(t, ValidateFilename("../conanfile.py"))
+	assert.Error(t, ValidateFilename(".hidden"))
+}
+
+func TestParseInfo(t *testing.T) {
+	info, err := ParseInfo([]byte(`[settings]
+arch=x86_64
+build_type=Release
+os=Linux
+
+[options]
+fPIC=True
+shared = False
+
+[requires]
+zlib/1.3.Z
+
+[conf]
+tools.info.package_id:confs=[]
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"arch": "x86_64", "build_type": "Release", "os": "Linux"}, info.Settings)
+	assert.Equal(t, map[string]string{"fPIC": "True", "shared": "False"}, info.Options)
+	assert.Equal(t, []string{"zlib/1.3.Z"}, info.Requires)
+
+	_, err = ParseInfo([]byte("arch=x86_64\n"))
+	assert.Error(t, err)
+	_, err = ParseInfo([]byte("[settings]\narch\n"))
+	assert.Error(t, err)
+}
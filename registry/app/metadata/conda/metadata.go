@@ -0,0 +1,264 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conda reads the info/index.json and info/about.json of conda packages, which are
+// either .tar.bz2 archives or .conda zip archives holding the info files in a zstd compressed
+// tarball.
+// Source: https://docs.conda.io/projects/conda-build/en/stable/resources/package-spec.html
+package conda
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/bzip2"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// maxInfoFileSize is the largest info file read from a package.
+	maxInfoFileSize = 1 << 20
+
+	indexFile = "info/index.json"
+	aboutFile = "info/about.json"
+
+	ExtensionConda  = ".conda"
+	ExtensionTarBz2 = ".tar.bz2"
+
+	// NoArch is the subdir of the packages which are installed on every platform.
+	NoArch = "noarch"
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid conda package")
+
+	namePattern    = regexp.MustCompile(`^[a-z0-9_][a-z0-9_.-]*$`)
+	versionPattern = regexp.MustCompile(`^[A-Za-z0-9_.+!]+$`)
+	buildPattern   = regexp.MustCompile(`^[A-Za-z0-9_.+]+$`)
+	subdirPattern  = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9_]+)?$`)
+
+	zipMagic   = []byte("PK\x03\x04")
+	bzip2Magic = []byte("BZh")
+)
+
+// Metadata is the index.json of a package along with the description of its about.json.
+type Metadata struct {
+	Name          string   `json:"name"`
+	Version       string   `json:"version"`
+	Build         string   `json:"build"`
+	BuildNumber   int64    `json:"build_number"`
+	Subdir        string   `json:"subdir"`
+	Noarch        Noarch   `json:"noarch,omitempty"`
+	Depends       []string `json:"depends,omitempty"`
+	Constrains    []string `json:"constrains,omitempty"`
+	License       string   `json:"license,omitempty"`
+	LicenseFamily string   `json:"license_family,omitempty"`
+	Platform      string   `json:"platform,omitempty"`
+	Arch          string   `json:"arch,omitempty"`
+	Timestamp     int64    `json:"timestamp,omitempty"`
+	Features      string   `json:"features,omitempty"`
+	TrackFeatures string   `json:"track_features,omitempty"`
+
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+	Home        string `json:"home,omitempty"`
+	DevURL      string `json:"dev_url,omitempty"`
+	DocURL      string `json:"doc_url,omitempty"`
+}
+
+// Noarch is the kind of a noarch package, python or generic. Packages built by old conda-build
+// versions mark generic packages with true.
+type Noarch string
+
+func (n *Noarch) UnmarshalJSON(data []byte) error {
+	var flag bool
+	if err := json.Unmarshal(data, &flag); err == nil {
+		*n = ""
+		if flag {
+			*n = "generic"
+		}
+		return nil
+	}
+	var kind string
+	if err := json.Unmarshal(data, &kind); err != nil {
+		return err
+	}
+	*n = Noarch(kind)
+	return nil
+}
+
+type about struct {
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	Home        string `json:"home"`
+	DevURL      string `json:"dev_url"`
+	DocURL      string `json:"doc_url"`
+}
+
+// ReadPackage reads the metadata of a package and returns it along with the extension of its
+// format, which is told from the magic bytes of the package.
+func ReadPackage(r io.ReaderAt, size int64) (*Metadata, string, error) {
+	magic := make([]byte, len(zipMagic))
+	if _, err := r.ReadAt(magic, 0); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	var (
+		files     map[string][]byte
+		extension string
+		err       error
+	)
+	switch {
+	case bytes.Equal(magic, zipMagic):
+		files, err = readConda(r, size)
+		extension = ExtensionConda
+	case bytes.HasPrefix(magic, bzip2Magic):
+		files, err = readInfoTar(bzip2.NewReader(io.NewSectionReader(r, 0, size)))
+		extension = ExtensionTarBz2
+	default:
+		return nil, "", fmt.Errorf("%w: not a .conda or .tar.bz2 package", ErrInvalidPackage)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	index, ok := files[indexFile]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %s not found", ErrInvalidPackage, indexFile)
+	}
+	metadata := &Metadata{}
+	if err := json.Unmarshal(index, metadata); err != nil {
+		return nil, "", fmt.Errorf("%w: invalid %s: %w", ErrInvalidPackage, indexFile, err)
+	}
+	if content, ok := files[aboutFile]; ok {
+		var a about
+		// about.json is informational, so a malformed one leaves the description empty
+		if json.Unmarshal(content, &a) == nil {
+			metadata.Summary, metadata.Description = a.Summary, a.Description
+			metadata.Home, metadata.DevURL, metadata.DocURL = a.Home, a.DevURL, a.DocURL
+		}
+	}
+	return metadata, extension, nil
+}
+
+// readConda reads the info files from the info-*.tar.zst of a .conda package.
+func readConda(r io.ReaderAt, size int64) (map[string][]byte, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	for _, f := range archive.File {
+		if !strings.HasPrefix(f.Name, "info-") || !strings.HasSuffix(f.Name, ".tar.zst") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		defer rc.Close()
+		decoder, err := zstd.NewReader(rc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		defer decoder.Close()
+		return readInfoTar(decoder)
+	}
+	return nil, fmt.Errorf("%w: info tarball not found", ErrInvalidPackage)
+}
+
+// readInfoTar reads index.json and about.json from a tarball.
+func readInfoTar(r io.Reader) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		name := strings.TrimPrefix(hdr.Name, "./")
+		if name != indexFile && name != aboutFile {
+			continue
+		}
+		if hdr.Size > maxInfoFileSize {
+			return nil, fmt.Errorf("%w: %s is too large", ErrInvalidPackage, name)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		files[name] = content
+		if len(files) == 2 {
+			return files, nil
+		}
+	}
+}
+
+func (m *Metadata) Validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidPackage, m.Name)
+	}
+	if !versionPattern.MatchString(m.Version) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidPackage, m.Version)
+	}
+	if !buildPattern.MatchString(m.Build) {
+		return fmt.Errorf("%w: invalid build %q", ErrInvalidPackage, m.Build)
+	}
+	return ValidateSubdir(m.Subdir)
+}
+
+// ValidateSubdir checks a platform subdir, like linux-64, osx-arm64 or noarch.
+func ValidateSubdir(subdir string) error {
+	if !subdirPattern.MatchString(subdir) {
+		return fmt.Errorf("%w: invalid subdir %q", ErrInvalidPackage, subdir)
+	}
+	return nil
+}
+
+// Filename returns the file name of the package in the format of the extension.
+func (m *Metadata) Filename(extension string) string {
+	return m.Name + "-" + m.Version + "-" + m.Build + extension
+}
+
+// ParseFilename splits the file name of a package into its name, version, build and extension.
+// Names may contain dashes, versions and builds don't.
+func ParseFilename(filename string) (string, string, string, string, error) {
+	var extension string
+	switch {
+	case strings.HasSuffix(filename, ExtensionConda):
+		extension = ExtensionConda
+	case strings.HasSuffix(filename, ExtensionTarBz2):
+		extension = ExtensionTarBz2
+	default:
+		return "", "", "", "", fmt.Errorf("%w: invalid file name %q", ErrInvalidPackage, filename)
+	}
+	base := strings.TrimSuffix(filename, extension)
+	i := strings.LastIndex(base, "-")
+	if i <= 0 {
+		return "", "", "", "", fmt.Errorf("%w: invalid file name %q", ErrInvalidPackage, filename)
+	}
+	j := strings.LastIndex(base[:i], "-")
+	if j <= 0 {
+		return "", "", "", "", fmt.Errorf("%w: invalid file name %q", ErrInvalidPackage, filename)
+	}
+	return base[:j], base[j+1 : i], base[i+1:], extension, nil
+}
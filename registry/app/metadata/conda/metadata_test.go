@@ -0,0 +1,124 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conda
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTarBz2 is six-1.16.0-pyhd3eb1b0_1.tar.bz2 with its info files and a module, compressed
+// with bzip2 as the standard library only decompresses it.
+const testTarBz2 = "QlpoOTFBWSZTWcHXLyAAAQj/oM2AACBQB/+QQCZkCv//32oABAgIMAE4SBqaKep6mnk0nkgDTRoAGgD1MjGA0AAA0AAAAAAY" +
+	"kg00mJiTyaIyA0yGQGnqYnQY+4qLkVyCAJ/pCASLL1+G8zguzqozl5IIRBWg5gEkkUWLCnc2Gix8JePDxtwj9plmpe53GDw9" +
+	"A8kBtQZCfUBuVSE+h2/GUXY47GrrpN5IGzSWAAG8eriB3o7kVWK9T5PPhkvjO2mEcFEr9c5aRGiZxpLDUwxg+1SSAQpYhTEm" +
+	"xYYUQnukbMJlVP0nWn77cD7giaOSrgVxE5ZFh9D7cMZ63VxJWHSxDpI0xELxYATSVD5HK0qoBjVcKqxUhohlayJOTiY2iwQT" +
+	"gzbOQnEMFQGEq6qJMWhdloNJhGwSVqwh1834DWJbjEFYBk0lUKKOq2JS2iluZz65ukwpVuS1uiEg3v8gCB/i7kinChIYOuXk" +
+	"AA=="
+
+const testIndex = `{"name": "numpy", "version": "1.26.4", "build": "py312h8753938_0", "build_number": 0,
+"subdir": "linux-64", "depends": ["libgcc-ng >=12", "python >=3.12,<3.13.0a0"],
+"constrains": ["numpy-base <0a0"], "license": "BSD-3-Clause", "noarch": false}`
+
+// buildConda returns a .conda package whose info tarball holds the files.
+func buildConda(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var tarball bytes.Buffer
+	tw := tar.NewWriter(&tarball)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	compressed := encoder.EncodeAll(tarball.Bytes(), nil)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("metadata.json")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`{"conda_pkg_format_version": 2}`))
+	require.NoError(t, err)
+	w, err = zw.Create("info-numpy-1.26.4-py312h8753938_0.tar.zst")
+	require.NoError(t, err)
+	_, err = w.Write(compressed)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestReadPackageConda(t *testing.T) {
+	content := buildConda(t, map[string]string{
+		"info/index.json": testIndex,
+		"info/about.json": `{"summary": "Array processing for numbers", "home": "https://numpy.org"}`,
+	})
+	metadata, extension, err := ReadPackage(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	require.NoError(t, metadata.Validate())
+	assert.Equal(t, ExtensionConda, extension)
+	assert.Equal(t, "numpy", metadata.Name)
+	assert.Equal(t, "1.26.4", metadata.Version)
+	assert.Equal(t, "linux-64", metadata.Subdir)
+	assert.Equal(t, Noarch(""), metadata.Noarch)
+	assert.Equal(t, []string{"libgcc-ng >=12", "python >=3.12,<3.13.0a0"}, metadata.Depends)
+	assert.Equal(t, []string{"numpy-base <0a0"}, metadata.Constrains)
+	assert.Equal(t, "Array processing for numbers", metadata.Summary)
+	assert.Equal(t, "numpy-1.26.4-py312h8753938_0.conda", metadata.Filename(extension))
+
+	content = buildConda(t, map[string]string{"info/about.json": `{}`})
+	_, _, err = ReadPackage(bytes.NewReader(content), int64(len(content)))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+}
+
+func TestReadPackageTarBz2(t *testing.T) {
+	content, err := base64.StdEncoding.DecodeString(testTarBz2)
+	require.NoError(t, err)
+	metadata, extension, err := ReadPackage(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+	require.NoError(t, metadata.Validate())
+	assert.Equal(t, ExtensionTarBz2, extension)
+	assert.Equal(t, "six", metadata.Name)
+	assert.Equal(t, NoArch, metadata.Subdir)
+	assert.Equal(t, Noarch("python"), metadata.Noarch)
+	assert.Equal(t, int64(1640000000000), metadata.Timestamp)
+	assert.Equal(t, "https://github.com/benjaminp/six", metadata.Home)
+	assert.Equal(t, "six-1.16.0-pyhd3eb1b0_1.tar.bz2", metadata.Filename(extension))
+
+	_, _, err = ReadPackage(bytes.NewReader([]byte("not a package")), 13)
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+}
+
+func TestParseFilename(t *testing.T) {
+	name, version, build, extension, err := ParseFilename("ca-certificates-2024.2.2-hbcca054_0.conda")
+	require.NoError(t, err)
+	assert.Equal(t, "ca-certificates", name)
+	assert.Equal(t, "2024.2.2", version)
+	assert.Equal(t, "hbcca054_0", build)
+	assert.Equal(t, ExtensionConda, extension)
+
+	for _, filename := range []string{"six.tar.bz2", "six-1.16.0.conda", "six-1.16.0-0.zip"} {
+		_, _, _, _, err = ParseFilename(filename)
+		assert.ErrorIs(t, err, ErrInvalidPackage, filename)
+	}
+}
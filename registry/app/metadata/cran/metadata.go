@@ -0,0 +1,367 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cran reads the DESCRIPTION of R packages and formats the entries of the PACKAGES
+// indices of CRAN-like repositories.
+// Source: https://cran.r-project.org/doc/manuals/r-release/R-exts.html#The-DESCRIPTION-file
+package cran
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DescriptionFile is the file describing a package, at the root of its directory in an archive.
+	DescriptionFile = "DESCRIPTION"
+	// SourceTree is the directory of the repository source packages are published to.
+	SourceTree = "src/contrib"
+
+	// maxDescriptionSize is the largest DESCRIPTION read from a package.
+	maxDescriptionSize = 1 << 20
+)
+
+// Format is the format of a package archive, which is the extension of its file.
+type Format string
+
+const (
+	// FormatSource is the gzipped tarball of a source package.
+	FormatSource Format = ".tar.gz"
+	// FormatTgz is the gzipped tarball of a binary package for macOS or Linux.
+	FormatTgz Format = ".tgz"
+	// FormatZip is the format of binary packages for Windows.
+	FormatZip Format = ".zip"
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid R package")
+
+	namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9.]*[A-Za-z0-9]$`)
+	// versionPattern matches versions of at least two integers separated by dots or dashes.
+	versionPattern  = regexp.MustCompile(`^[0-9]+([.-][0-9]+)+$`)
+	segmentPattern  = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+	rVersionPattern = regexp.MustCompile(`^[0-9]+\.[0-9]+$`)
+	// builtPattern matches the R version of the Built field of binary packages, as
+	// "R 4.3.2; x86_64-w64-mingw32; 2023-11-01 10:00:00 UTC; windows".
+	builtPattern = regexp.MustCompile(`^R ([0-9]+\.[0-9]+)`)
+)
+
+// Description is the DESCRIPTION of a package, along with the fields of binary packages.
+type Description struct {
+	Package          string `json:"package"`
+	Version          string `json:"version"`
+	Title            string `json:"title,omitempty"`
+	Description      string `json:"description,omitempty"`
+	License          string `json:"license,omitempty"`
+	Maintainer       string `json:"maintainer,omitempty"`
+	URL              string `json:"url,omitempty"`
+	BugReports       string `json:"bug_reports,omitempty"`
+	Priority         string `json:"priority,omitempty"`
+	Depends          string `json:"depends,omitempty"`
+	Imports          string `json:"imports,omitempty"`
+	LinkingTo        string `json:"linking_to,omitempty"`
+	Suggests         string `json:"suggests,omitempty"`
+	Enhances         string `json:"enhances,omitempty"`
+	OSType           string `json:"os_type,omitempty"`
+	NeedsCompilation string `json:"needs_compilation,omitempty"`
+	// Built is set for binary packages, with the version of R and the platform they were built for.
+	Built string `json:"built,omitempty"`
+}
+
+// Tree is a directory of the repository which has a PACKAGES index, either the source packages
+// or the binary packages of a platform for a version of R.
+type Tree struct {
+	Path     string
+	Platform string
+	RVersion string
+}
+
+// Binary reports whether the tree holds binary packages.
+func (t Tree) Binary() bool {
+	return t.Platform != ""
+}
+
+// ParseTree parses the path of a tree, which is src/contrib or bin/<platform>/contrib/<R version>,
+// where the platform is windows, macosx or a subdirectory of them such as macosx/big-sur-arm64.
+func ParseTree(treePath string) (Tree, error) {
+	treePath = strings.Trim(treePath, "/")
+	if treePath == SourceTree {
+		return Tree{Path: SourceTree}, nil
+	}
+	parts := strings.Split(treePath, "/")
+	n := len(parts)
+	if n < 4 || n > 5 || parts[0] != "bin" || parts[n-2] != "contrib" || !rVersionPattern.MatchString(parts[n-1]) {
+		return Tree{}, fmt.Errorf("%s isn't src/contrib or bin/<platform>/contrib/<R version>", treePath)
+	}
+	for _, segment := range parts[1 : n-2] {
+		if !segmentPattern.MatchString(segment) {
+			return Tree{}, fmt.Errorf("invalid platform %q", strings.Join(parts[1:n-2], "/"))
+		}
+	}
+	return Tree{Path: treePath, Platform: strings.Join(parts[1:n-2], "/"), RVersion: parts[n-1]}, nil
+}
+
+// Filename returns the name of the archive of a package.
+func Filename(name, version string, format Format) string {
+	return name + "_" + version + string(format)
+}
+
+// ParseFilename splits the name of an archive into the package name, its version and format.
+func ParseFilename(filename string) (string, string, Format, error) {
+	for _, format := range []Format{FormatSource, FormatTgz, FormatZip} {
+		base, found := strings.CutSuffix(filename, string(format))
+		if !found {
+			continue
+		}
+		name, version, found := strings.Cut(base, "_")
+		if found && namePattern.MatchString(name) && versionPattern.MatchString(version) {
+			return name, version, format, nil
+		}
+	}
+	return "", "", "", fmt.Errorf("%s isn't the name of an R package", filename)
+}
+
+// ReadPackage reads the DESCRIPTION of a package archive, which is a gzipped tarball for source
+// packages and binary packages of macOS and Linux, or a zip for binary packages of Windows. Binary
+// packages are told from source packages by the Built field of their DESCRIPTION.
+func ReadPackage(r io.ReaderAt, size int64) (*Description, Format, error) {
+	magic := make([]byte, 4)
+	if _, err := r.ReadAt(magic, 0); err != nil {
+		return nil, "", fmt.Errorf("%w: archive is too short", ErrInvalidPackage)
+	}
+	var data []byte
+	var format Format
+	var err error
+	switch {
+	case magic[0] == 0x1f && magic[1] == 0x8b:
+		format = FormatSource
+		data, err = readTarball(io.NewSectionReader(r, 0, size))
+	case string(magic) == "PK\x03\x04":
+		format = FormatZip
+		data, err = readZip(r, size)
+	default:
+		return nil, "", fmt.Errorf("%w: archive is neither a gzipped tarball nor a zip", ErrInvalidPackage)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	description, err := ParseDescription(data)
+	if err != nil {
+		return nil, "", err
+	}
+	if format == FormatSource && description.Built != "" {
+		format = FormatTgz
+	}
+	return description, format, nil
+}
+
+// isDescription reports whether a file of an archive is the DESCRIPTION of the package, which is
+// the only directory at the root of the archive.
+func isDescription(name string) bool {
+	dir, file := path.Split(path.Clean(name))
+	return file == DescriptionFile && dir != "" && !strings.Contains(strings.TrimSuffix(dir, "/"), "/")
+}
+
+func readTarball(r io.Reader) ([]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: %s not found in archive", ErrInvalidPackage, DescriptionFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		if header.Typeflag != tar.TypeReg || !isDescription(header.Name) {
+			continue
+		}
+		return readDescription(tr)
+	}
+}
+
+func readZip(r io.ReaderAt, size int64) ([]byte, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || !isDescription(f.Name) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		defer rc.Close()
+		return readDescription(rc)
+	}
+	return nil, fmt.Errorf("%w: %s not found in archive", ErrInvalidPackage, DescriptionFile)
+}
+
+func readDescription(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxDescriptionSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	if len(data) > maxDescriptionSize {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrInvalidPackage, DescriptionFile, maxDescriptionSize)
+	}
+	return data, nil
+}
+
+// ParseDescription parses a DESCRIPTION, which is in the Debian control file format. Values of
+// fields spanning several lines are joined by spaces.
+func ParseDescription(data []byte) (*Description, error) {
+	fields := map[string]string{}
+	var last string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxDescriptionSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case strings.TrimSpace(line) == "":
+		case line[0] == ' ' || line[0] == '\t':
+			if last == "" {
+				return nil, fmt.Errorf("%w: continuation line without a field", ErrInvalidPackage)
+			}
+			fields[last] = strings.TrimSpace(fields[last] + " " + strings.TrimSpace(line))
+		default:
+			name, value, found := strings.Cut(line, ":")
+			if !found || name == "" || strings.ContainsAny(name, " \t") {
+				return nil, fmt.Errorf("%w: malformed line %q in %s", ErrInvalidPackage, line, DescriptionFile)
+			}
+			last = name
+			fields[name] = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+
+	d := &Description{
+		Package:          fields["Package"],
+		Version:          fields["Version"],
+		Title:            fields["Title"],
+		Description:      fields["Description"],
+		License:          fields["License"],
+		Maintainer:       fields["Maintainer"],
+		URL:              fields["URL"],
+		BugReports:       fields["BugReports"],
+		Priority:         fields["Priority"],
+		Depends:          fields["Depends"],
+		Imports:          fields["Imports"],
+		LinkingTo:        fields["LinkingTo"],
+		Suggests:         fields["Suggests"],
+		Enhances:         fields["Enhances"],
+		OSType:           fields["OS_type"],
+		NeedsCompilation: fields["NeedsCompilation"],
+		Built:            fields["Built"],
+	}
+	if !namePattern.MatchString(d.Package) {
+		return nil, fmt.Errorf("%w: invalid package name %q", ErrInvalidPackage, d.Package)
+	}
+	if !versionPattern.MatchString(d.Version) {
+		return nil, fmt.Errorf("%w: invalid version %q", ErrInvalidPackage, d.Version)
+	}
+	return d, nil
+}
+
+// ValidateTree checks that a package can be published to a tree: source packages to the source
+// tree, and binary packages to a tree of the version of R they were built for.
+func (d *Description) ValidateTree(tree Tree, format Format) error {
+	if !tree.Binary() {
+		if format != FormatSource {
+			return fmt.Errorf("%w: %s only holds source packages", ErrInvalidPackage, SourceTree)
+		}
+		return nil
+	}
+	match := builtPattern.FindStringSubmatch(d.Built)
+	if format == FormatSource || match == nil {
+		return fmt.Errorf("%w: %s only holds binary packages", ErrInvalidPackage, tree.Path)
+	}
+	if match[1] != tree.RVersion {
+		return fmt.Errorf("%w: package was built for R %s, not R %s", ErrInvalidPackage, match[1], tree.RVersion)
+	}
+	return nil
+}
+
+// IndexEntry formats the entry of the package in a PACKAGES index, with the MD5 of its archive.
+func (d *Description) IndexEntry(md5 string) string {
+	var sb strings.Builder
+	for _, field := range []struct{ name, value string }{
+		{"Package", d.Package},
+		{"Version", d.Version},
+		{"Priority", d.Priority},
+		{"Depends", d.Depends},
+		{"Imports", d.Imports},
+		{"LinkingTo", d.LinkingTo},
+		{"Suggests", d.Suggests},
+		{"Enhances", d.Enhances},
+		{"License", d.License},
+		{"OS_type", d.OSType},
+		{"MD5sum", md5},
+		{"NeedsCompilation", d.NeedsCompilation},
+		{"Built", d.Built},
+	} {
+		if field.value != "" {
+			sb.WriteString(field.name + ": " + field.value + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// CompareVersions compares R versions by their integers, returning -1, 0 or 1.
+func CompareVersions(a, b string) int {
+	split := func(v string) []string {
+		return strings.FieldsFunc(v, func(r rune) bool { return r == '.' || r == '-' })
+	}
+	pa, pb := split(a), split(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var na, nb int
+		if i < len(pa) {
+			na, _ = strconv.Atoi(pa[i])
+		}
+		if i < len(pb) {
+			nb, _ = strconv.Atoi(pb[i])
+		}
+		if na != nb {
+			if na < nb {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case len(pa) < len(pb):
+		return -1
+	case len(pa) > len(pb):
+		return 1
+	}
+	return 0
+}
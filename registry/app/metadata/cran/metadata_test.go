@@ -0,0 +1,167 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cran
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDescription = `Package: tidyfoo
+Type: Package
+Title: Tidy Tools for Foo
+Version: 1.2-3
+Authors@R: person("Ada", "Lovelace", email = "ada@example.com",
+    role = c("aut", "cre"))
+Description: Tools to tidy foo data,
+    spanning several lines.
+License: MIT + file LICENSE
+Depends: R (>= 4.1.0)
+Imports: dplyr (>= 1.1.0),
+    rlang
+Suggests: testthat
+NeedsCompilation: no
+`
+
+func testTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0o644, Size: int64(len(contents)), Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func testZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, contents := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestReadPackage(t *testing.T) {
+	archive := testTarball(t, map[string]string{
+		"tidyfoo/DESCRIPTION":            testDescription,
+		"tidyfoo/R/foo.R":                "foo <- function() NULL",
+		"tidyfoo/inst/extra/DESCRIPTION": "Package: other\nVersion: 1.0\n",
+	})
+	d, format, err := ReadPackage(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	assert.Equal(t, FormatSource, format)
+	assert.Equal(t, "tidyfoo", d.Package)
+	assert.Equal(t, "1.2-3", d.Version)
+	assert.Equal(t, "Tidy Tools for Foo", d.Title)
+	assert.Equal(t, "Tools to tidy foo data, spanning several lines.", d.Description)
+	assert.Equal(t, "dplyr (>= 1.1.0), rlang", d.Imports)
+	assert.Equal(t, "Package: tidyfoo\nVersion: 1.2-3\nDepends: R (>= 4.1.0)\nImports: dplyr (>= 1.1.0), rlang\n"+
+		"Suggests: testthat\nLicense: MIT + file LICENSE\nMD5sum: abc\nNeedsCompilation: no\n", d.IndexEntry("abc"))
+
+	source, err := ParseTree("src/contrib")
+	require.NoError(t, err)
+	assert.NoError(t, d.ValidateTree(source, format))
+	binary, err := ParseTree("bin/windows/contrib/4.3")
+	require.NoError(t, err)
+	assert.Error(t, d.ValidateTree(binary, format))
+}
+
+func TestReadBinaryPackage(t *testing.T) {
+	description := testDescription + "Built: R 4.3.2; ; 2024-01-10 10:00:00 UTC; windows\n"
+	archive := testZip(t, map[string]string{"tidyfoo/DESCRIPTION": description})
+	d, format, err := ReadPackage(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	assert.Equal(t, FormatZip, format)
+
+	tree, err := ParseTree("bin/windows/contrib/4.3")
+	require.NoError(t, err)
+	assert.NoError(t, d.ValidateTree(tree, format))
+	tree, err = ParseTree("bin/windows/contrib/4.4")
+	require.NoError(t, err)
+	assert.Error(t, d.ValidateTree(tree, format))
+	source, err := ParseTree("src/contrib")
+	require.NoError(t, err)
+	assert.Error(t, d.ValidateTree(source, format))
+
+	archive = testTarball(t, map[string]string{"tidyfoo/DESCRIPTION": description})
+	_, format, err = ReadPackage(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	assert.Equal(t, FormatTgz, format)
+}
+
+func TestReadPackageInvalid(t *testing.T) {
+	for name, archive := range map[string][]byte{
+		"not an archive": []byte("tidyfoo"),
+		"no description": testTarball(t, map[string]string{"tidyfoo/R/foo.R": ""}),
+		"invalid name":   testTarball(t, map[string]string{"x/DESCRIPTION": "Package: tidy_foo\nVersion: 1.0\n"}),
+		"invalid version": testTarball(t, map[string]string{
+			"x/DESCRIPTION": "Package: tidyfoo\nVersion: 1.0a\n",
+		}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ReadPackage(bytes.NewReader(archive), int64(len(archive)))
+			assert.True(t, errors.Is(err, ErrInvalidPackage), err)
+		})
+	}
+}
+
+func TestParseTree(t *testing.T) {
+	tree, err := ParseTree("bin/macosx/big-sur-arm64/contrib/4.3")
+	require.NoError(t, err)
+	assert.Equal(t, Tree{Path: "bin/macosx/big-sur-arm64/contrib/4.3", Platform: "macosx/big-sur-arm64",
+		RVersion: "4.3"}, tree)
+	for _, path := range []string{"src", "bin/windows/contrib", "bin/windows/contrib/4", "bin/../contrib/4.3"} {
+		_, err := ParseTree(path)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestParseFilename(t *testing.T) {
+	name, version, format, err := ParseFilename("tidyfoo_1.2-3.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []any{"tidyfoo", "1.2-3", FormatSource}, []any{name, version, format})
+	_, _, format, err = ParseFilename("tidyfoo_1.2-3.zip")
+	require.NoError(t, err)
+	assert.Equal(t, FormatZip, format)
+	_, _, _, err = ParseFilename("tidyfoo.tar.gz")
+	assert.Error(t, err)
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, -1, CompareVersions("1.2-3", "1.10.0"))
+	assert.Equal(t, 0, CompareVersions("1.2-3", "1.2.3"))
+	assert.Equal(t, -1, CompareVersions("1.0", "1.0.0"))
+	assert.Equal(t, 1, CompareVersions("2.0", "1.99.99"))
+}
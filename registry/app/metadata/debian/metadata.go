@@ -0,0 +1,324 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package debian reads the control file of Debian binary packages (.deb) and validates the
+// distributions and components they are published to.
+// Source: https://www.debian.org/doc/debian-policy/ch-controlfields.html
+package debian
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/harness/gitness/registry/utils/versioning"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// maxControlSize is the largest control file read from a package.
+	maxControlSize = 1 << 20
+
+	arMagic       = "!<arch>\n"
+	arHeaderSize  = 60
+	debianBinary  = "debian-binary"
+	controlPrefix = "control.tar"
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid debian package")
+
+	namePattern         = regexp.MustCompile(`^[a-z0-9][a-z0-9+.-]+$`)
+	architecturePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	// distributionPattern matches the names of distributions and components, which are path segments
+	// of the repository.
+	distributionPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+)
+
+// Metadata is the control file of a binary package. Control is the control file as read from
+// the package, which the entries of Packages indices are made of.
+type Metadata struct {
+	Package       string `json:"package"`
+	Version       string `json:"version"`
+	Architecture  string `json:"architecture"`
+	Maintainer    string `json:"maintainer,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Section       string `json:"section,omitempty"`
+	Priority      string `json:"priority,omitempty"`
+	Homepage      string `json:"homepage,omitempty"`
+	Source        string `json:"source,omitempty"`
+	InstalledSize int64  `json:"installed_size,omitempty"`
+	Depends       string `json:"depends,omitempty"`
+	Control       string `json:"control"`
+}
+
+// Field is a field of a control file, in the order of the file.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// ReadPackage reads the control file of a .deb, which is an ar archive of debian-binary, the
+// control.tar and the data.tar. The control.tar can be uncompressed or compressed with gzip or zstd.
+func ReadPackage(r io.Reader) (*Metadata, error) {
+	magic := make([]byte, len(arMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != arMagic {
+		return nil, fmt.Errorf("%w: not an ar archive", ErrInvalidPackage)
+	}
+	br := bufio.NewReader(r)
+	first := true
+	for {
+		name, size, err := readArHeader(br)
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: control.tar is missing", ErrInvalidPackage)
+		}
+		if err != nil {
+			return nil, err
+		}
+		member := io.LimitReader(br, size)
+		switch {
+		case first:
+			if name != debianBinary {
+				return nil, fmt.Errorf("%w: first member is %s, not %s", ErrInvalidPackage, name, debianBinary)
+			}
+			format, err := io.ReadAll(io.LimitReader(member, 16))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+			}
+			if !strings.HasPrefix(string(format), "2.") {
+				return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalidPackage, strings.TrimSpace(string(format)))
+			}
+			first = false
+		case strings.HasPrefix(name, controlPrefix):
+			control, err := readControlTar(member, strings.TrimPrefix(name, controlPrefix))
+			if err != nil {
+				return nil, err
+			}
+			return ParseControl(control)
+		}
+		if _, err := io.Copy(io.Discard, member); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		// members are padded to an even size
+		if _, err := io.Copy(io.Discard, io.LimitReader(br, size%2)); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+	}
+}
+
+// readArHeader reads the header of the next member, whose content follows it.
+func readArHeader(r io.Reader) (string, int64, error) {
+	header := make([]byte, arHeaderSize)
+	n, err := io.ReadFull(r, header)
+	if n == 0 && errors.Is(err, io.EOF) {
+		return "", 0, io.EOF
+	}
+	if err != nil || string(header[58:60]) != "`\n" {
+		return "", 0, fmt.Errorf("%w: truncated ar header", ErrInvalidPackage)
+	}
+	// GNU ar terminates names with a slash
+	name := strings.TrimSuffix(strings.TrimSpace(string(header[0:16])), "/")
+	size, err := strconv.ParseInt(strings.TrimSpace(string(header[48:58])), 10, 64)
+	if err != nil || size < 0 {
+		return "", 0, fmt.Errorf("%w: invalid size of member %s", ErrInvalidPackage, name)
+	}
+	return name, size, nil
+}
+
+func readControlTar(r io.Reader, compression string) ([]byte, error) {
+	switch compression {
+	case "":
+	case ".gz":
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		defer gz.Close()
+		r = gz
+	case ".zst":
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		defer zr.Close()
+		r = zr
+	default:
+		return nil, fmt.Errorf("%w: control.tar%s isn't supported, build the package with "+
+			"dpkg-deb -Zgzip or -Zzstd", ErrInvalidPackage, compression)
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: control file is missing", ErrInvalidPackage)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+		}
+		if header.Typeflag != tar.TypeReg || path.Clean(header.Name) != "control" {
+			continue
+		}
+		if header.Size > maxControlSize {
+			return nil, fmt.Errorf("%w: control file exceeds %d bytes", ErrInvalidPackage, maxControlSize)
+		}
+		return io.ReadAll(tr)
+	}
+}
+
+// ParseControl parses the paragraph of a control file.
+func ParseControl(control []byte) (*Metadata, error) {
+	fields, err := ParseFields(control)
+	if err != nil {
+		return nil, err
+	}
+	m := &Metadata{Control: FormatFields(fields)}
+	for _, f := range fields {
+		switch f.Name {
+		case "Package":
+			m.Package = f.Value
+		case "Version":
+			m.Version = f.Value
+		case "Architecture":
+			m.Architecture = f.Value
+		case "Maintainer":
+			m.Maintainer = f.Value
+		case "Description":
+			m.Description = f.Value
+		case "Section":
+			m.Section = f.Value
+		case "Priority":
+			m.Priority = f.Value
+		case "Homepage":
+			m.Homepage = f.Value
+		case "Source":
+			m.Source = f.Value
+		case "Depends":
+			m.Depends = f.Value
+		case "Installed-Size":
+			// the size is informational, a malformed one doesn't make the package unusable
+			m.InstalledSize, _ = strconv.ParseInt(f.Value, 10, 64)
+		}
+	}
+	return m, nil
+}
+
+// ParseFields parses the fields of a control paragraph. Values of multiline fields keep their
+// continuation lines, which start with a space.
+func ParseFields(control []byte) ([]Field, error) {
+	var fields []Field
+	scanner := bufio.NewScanner(bytes.NewReader(control))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxControlSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case strings.TrimSpace(line) == "":
+			if len(fields) > 0 {
+				// binary packages have a single paragraph
+				return fields, nil
+			}
+		case line[0] == ' ' || line[0] == '\t':
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("%w: continuation line without a field", ErrInvalidPackage)
+			}
+			fields[len(fields)-1].Value += "\n " + strings.TrimSpace(line)
+		case line[0] == '#':
+		default:
+			name, value, found := strings.Cut(line, ":")
+			if !found || strings.ContainsAny(name, " \t") || name == "" {
+				return nil, fmt.Errorf("%w: malformed line %q", ErrInvalidPackage, line)
+			}
+			fields = append(fields, Field{Name: name, Value: strings.TrimSpace(value)})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	return fields, nil
+}
+
+// FormatFields formats fields as a control paragraph, without the blank line ending it.
+func FormatFields(fields []Field) string {
+	var sb strings.Builder
+	for _, f := range fields {
+		sb.WriteString(f.Name)
+		sb.WriteString(":")
+		if f.Value != "" && !strings.HasPrefix(f.Value, "\n") {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(f.Value)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Validate checks the fields apt needs to install the package.
+func (m *Metadata) Validate() error {
+	if !namePattern.MatchString(m.Package) {
+		return fmt.Errorf("%w: invalid package name %q", ErrInvalidPackage, m.Package)
+	}
+	if !versioning.Valid(versioning.SchemeDebian, m.Version) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidPackage, m.Version)
+	}
+	if !architecturePattern.MatchString(m.Architecture) {
+		return fmt.Errorf("%w: invalid architecture %q", ErrInvalidPackage, m.Architecture)
+	}
+	return nil
+}
+
+// ValidateDistribution checks the name of a distribution or a component.
+func ValidateDistribution(name string) error {
+	if !distributionPattern.MatchString(name) {
+		return fmt.Errorf("invalid distribution or component %q", name)
+	}
+	return nil
+}
+
+// Filename returns the name of the .deb of a package, which leaves out the epoch of the version.
+func Filename(name, version, architecture string) string {
+	if i := strings.Index(version, ":"); i >= 0 {
+		version = version[i+1:]
+	}
+	return name + "_" + version + "_" + architecture + ".deb"
+}
+
+// ParseFilename splits the name of a .deb into the package name, the version without epoch and
+// the architecture.
+func ParseFilename(filename string) (string, string, string, error) {
+	base, found := strings.CutSuffix(filename, ".deb")
+	parts := strings.Split(base, "_")
+	if !found || len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("%s isn't the name of a debian package", filename)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// PoolPath returns the path of a .deb in the pool of the repository, which groups packages by
+// component and the first letter of their name, or its first four letters for libraries.
+func PoolPath(component, name, filename string) string {
+	prefix := name[:1]
+	if strings.HasPrefix(name, "lib") && len(name) > 3 {
+		prefix = name[:4]
+	}
+	return "pool/" + component + "/" + prefix + "/" + name + "/" + filename
+}
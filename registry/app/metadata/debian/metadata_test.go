@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testControl = `Package: hello
+Version: 1:2.10-3
+Architecture: amd64
+Maintainer: Jane Doe <jane@example.com>
+Installed-Size: 280
+Depends: libc6 (>= 2.34)
+Section: devel
+Priority: optional
+Description: example package
+ Prints a friendly greeting.
+ .
+ Second paragraph.
+`
+
+// buildDeb builds a .deb whose members are given in order as name and content pairs.
+func buildDeb(members ...[2][]byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(arMagic)
+	for _, m := range members {
+		fmt.Fprintf(&buf, "%-16s%-12s%-6s%-6s%-8s%-10d`\n", string(m[0])+"/", "0", "0", "0", "100644", len(m[1]))
+		buf.Write(m[1])
+		if len(m[1])%2 == 1 {
+			buf.WriteByte('\n')
+		}
+	}
+	return buf.Bytes()
+}
+
+func controlTarGz(t *testing.T, control string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0o755}))
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./control", Typeflag: tar.TypeReg, Mode: 0o644,
+		Size: int64(len(control))}))
+	_, err := tw.Write([]byte(control))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadPackage(t *testing.T) {
+	deb := buildDeb(
+		[2][]byte{[]byte(debianBinary), []byte("2.0\n")},
+		[2][]byte{[]byte("control.tar.gz"), controlTarGz(t, testControl)},
+		[2][]byte{[]byte("data.tar.xz"), []byte("data")},
+	)
+	m, err := ReadPackage(bytes.NewReader(deb))
+	require.NoError(t, err)
+	require.NoError(t, m.Validate())
+	assert.Equal(t, "hello", m.Package)
+	assert.Equal(t, "1:2.10-3", m.Version)
+	assert.Equal(t, "amd64", m.Architecture)
+	assert.Equal(t, int64(280), m.InstalledSize)
+	assert.Equal(t, "libc6 (>= 2.34)", m.Depends)
+	assert.Equal(t, "example package\n Prints a friendly greeting.\n .\n Second paragraph.", m.Description)
+	assert.Equal(t, testControl, m.Control)
+}
+
+func TestReadPackageInvalid(t *testing.T) {
+	_, err := ReadPackage(bytes.NewReader([]byte("PK\x03\x04 not a deb")))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+
+	_, err = ReadPackage(bytes.NewReader(buildDeb(
+		[2][]byte{[]byte(debianBinary), []byte("2.0\n")},
+		[2][]byte{[]byte("control.tar.xz"), []byte("xz")},
+	)))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+	assert.ErrorContains(t, err, "control.tar.xz isn't supported")
+
+	_, err = ReadPackage(bytes.NewReader(buildDeb(
+		[2][]byte{[]byte("control.tar.gz"), controlTarGz(t, testControl)},
+	)))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+
+	_, err = ReadPackage(bytes.NewReader(buildDeb(
+		[2][]byte{[]byte(debianBinary), []byte("2.0\n")},
+	)))
+	assert.ErrorContains(t, err, "control.tar is missing")
+}
+
+func TestValidate(t *testing.T) {
+	m, err := ParseControl([]byte("Package: Hello\nVersion: 1.0\nArchitecture: all\n"))
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Validate(), ErrInvalidPackage)
+
+	m, err = ParseControl([]byte("Package: hello\nVersion: 1.0\nArchitecture: all\n"))
+	require.NoError(t, err)
+	assert.NoError(t, m.Validate())
+
+	_, err = ParseControl([]byte(" orphan continuation\n"))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+}
+
+func TestFilename(t *testing.T) {
+	filename := Filename("hello", "1:2.10-3", "amd64")
+	assert.Equal(t, "hello_2.10-3_amd64.deb", filename)
+	name, version, arch, err := ParseFilename(filename)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello", "2.10-3", "amd64"}, []string{name, version, arch})
+	_, _, _, err = ParseFilename("hello-2.10.deb")
+	assert.Error(t, err)
+
+	assert.Equal(t, "pool/main/h/hello/"+filename, PoolPath("main", "hello", filename))
+	assert.Equal(t, "pool/main/libs/libssl3/libssl3_3.0_amd64.deb",
+		PoolPath("main", "libssl3", "libssl3_3.0_amd64.deb"))
+}
@@ -0,0 +1,201 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/harness/gitness/registry/utils/versioning"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// ManifestFile is the file describing a collection at the root of its archive.
+	ManifestFile = "MANIFEST.json"
+	// maxManifestSize bounds the manifest read from an archive.
+	maxManifestSize = 1 << 20
+	archiveSuffix   = ".tar.gz"
+)
+
+var (
+	ErrInvalidCollection = errors.New("invalid collection")
+
+	// namePattern matches the namespaces and names Galaxy accepts.
+	namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// CollectionInfo is the description of a collection from the galaxy.yml it was built from.
+// Source: https://docs.ansible.com/ansible/latest/dev_guide/collections_galaxy_meta.html
+type CollectionInfo struct {
+	Namespace     string            `json:"namespace"`
+	Name          string            `json:"name"`
+	Version       string            `json:"version"`
+	Authors       []string          `json:"authors,omitempty"`
+	Readme        string            `json:"readme,omitempty"`
+	Tags          []string          `json:"tags,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	License       []string          `json:"license,omitempty"`
+	LicenseFile   string            `json:"license_file,omitempty"`
+	Dependencies  map[string]string `json:"dependencies,omitempty"`
+	Repository    string            `json:"repository,omitempty"`
+	Documentation string            `json:"documentation,omitempty"`
+	Homepage      string            `json:"homepage,omitempty"`
+	Issues        string            `json:"issues,omitempty"`
+}
+
+// FQCN returns the fully qualified name of the collection, which it is stored under.
+func (c CollectionInfo) FQCN() string {
+	return c.Namespace + "." + c.Name
+}
+
+// Manifest is the MANIFEST.json ansible-galaxy builds collection archives with.
+type Manifest struct {
+	CollectionInfo CollectionInfo `json:"collection_info"`
+	Format         int            `json:"format"`
+}
+
+// ValidateName validates the namespace or the name of a collection.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) || strings.Contains(name, "__") {
+		return fmt.Errorf("%w: %q isn't a valid namespace or name", ErrInvalidCollection, name)
+	}
+	return nil
+}
+
+// ValidateVersion validates a version, which Galaxy requires to be a semantic version.
+func ValidateVersion(version string) error {
+	if _, err := semver.StrictNewVersion(version); err != nil {
+		return fmt.Errorf("%w: %q isn't a semantic version", ErrInvalidCollection, version)
+	}
+	return nil
+}
+
+// SplitFQCN splits the fully qualified name of a collection into its namespace and name.
+func SplitFQCN(fqcn string) (string, string, error) {
+	namespace, name, ok := strings.Cut(fqcn, ".")
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q isn't a fully qualified collection name", ErrInvalidCollection, fqcn)
+	}
+	if err := ValidateName(namespace); err != nil {
+		return "", "", err
+	}
+	if err := ValidateName(name); err != nil {
+		return "", "", err
+	}
+	return namespace, name, nil
+}
+
+// ArchiveFilename returns the name ansible-galaxy builds the archive of a version with.
+func ArchiveFilename(namespace, name, version string) string {
+	return namespace + "-" + name + "-" + version + archiveSuffix
+}
+
+// ParseArchiveFilename returns the namespace, the name and the version of an archive. Namespaces
+// and names can't hold hyphens, so the version is what follows the second one.
+func ParseArchiveFilename(filename string) (string, string, string, error) {
+	stem, ok := strings.CutSuffix(filename, archiveSuffix)
+	parts := strings.SplitN(stem, "-", 3)
+	if !ok || len(parts) != 3 {
+		return "", "", "", fmt.Errorf("%w: %q isn't the archive of a collection", ErrInvalidCollection, filename)
+	}
+	for _, part := range parts[:2] {
+		if err := ValidateName(part); err != nil {
+			return "", "", "", err
+		}
+	}
+	if err := ValidateVersion(parts[2]); err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// ReadArchive reads the manifest at the root of a collection archive.
+func ReadArchive(r io.Reader) (*Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: archive isn't gzipped: %w", ErrInvalidCollection, err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: %s not found in archive", ErrInvalidCollection, ManifestFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read archive: %w", ErrInvalidCollection, err)
+		}
+		if header.Typeflag != tar.TypeReg || path.Clean(header.Name) != ManifestFile {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxManifestSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalidCollection, ManifestFile, err)
+		}
+		if len(data) > maxManifestSize {
+			return nil, fmt.Errorf("%w: %s is too large", ErrInvalidCollection, ManifestFile)
+		}
+		return ParseManifest(data)
+	}
+}
+
+// ParseManifest parses a manifest, which must name the collection and its version.
+func ParseManifest(data []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%w: invalid %s: %w", ErrInvalidCollection, ManifestFile, err)
+	}
+	info := manifest.CollectionInfo
+	if err := ValidateName(info.Namespace); err != nil {
+		return nil, err
+	}
+	if err := ValidateName(info.Name); err != nil {
+		return nil, err
+	}
+	if err := ValidateVersion(info.Version); err != nil {
+		return nil, err
+	}
+	for dependency := range info.Dependencies {
+		if _, _, err := SplitFQCN(dependency); err != nil {
+			return nil, fmt.Errorf("invalid dependency: %w", err)
+		}
+	}
+	return &manifest, nil
+}
+
+// HighestVersion returns the version Galaxy installs by default: the highest that isn't a
+// pre-release, or the highest of all if they all are.
+func HighestVersion(versions []string) string {
+	sorted := append([]string(nil), versions...)
+	versioning.Sort(versioning.SchemeSemver, sorted)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if v, err := semver.NewVersion(sorted[i]); err == nil && v.Prerelease() == "" {
+			return sorted[i]
+		}
+	}
+	if len(sorted) == 0 {
+		return ""
+	}
+	return sorted[len(sorted)-1]
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `{
+  "collection_info": {
+    "namespace": "acme",
+    "name": "net_tools",
+    "version": "1.2.0",
+    "authors": ["Jane Doe <jane@example.com>"],
+    "readme": "README.md",
+    "tags": ["networking"],
+    "description": "Modules to manage network devices.",
+    "license": ["GPL-3.0-or-later"],
+    "license_file": null,
+    "dependencies": {"ansible.utils": ">=2.0.0", "ansible.netcommon": "*"},
+    "repository": "https://github.com/acme/net_tools",
+    "documentation": null,
+    "homepage": null,
+    "issues": null
+  },
+  "file_manifest_file": {"name": "FILES.json", "ftype": "file", "format": 1},
+  "format": 1
+}`
+
+func testArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0o644, Size: int64(len(contents)), Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadArchive(t *testing.T) {
+	manifest, err := ReadArchive(bytes.NewReader(testArchive(t, map[string]string{
+		"FILES.json":                   `{"files": []}`,
+		"./MANIFEST.json":              testManifest,
+		"plugins/modules/ping.py":      "",
+		"tests/fixtures/MANIFEST.json": "{}",
+	})))
+	require.NoError(t, err)
+
+	info := manifest.CollectionInfo
+	assert.Equal(t, "acme.net_tools", info.FQCN())
+	assert.Equal(t, "1.2.0", info.Version)
+	assert.Equal(t, []string{"Jane Doe <jane@example.com>"}, info.Authors)
+	assert.Equal(t, []string{"GPL-3.0-or-later"}, info.License)
+	assert.Equal(t, "https://github.com/acme/net_tools", info.Repository)
+	assert.Equal(t, map[string]string{"ansible.utils": ">=2.0.0", "ansible.netcommon": "*"}, info.Dependencies)
+	assert.Equal(t, 1, manifest.Format)
+}
+
+func TestReadArchiveInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{name: "missing manifest", files: map[string]string{"FILES.json": "{}"}},
+		{name: "invalid namespace", files: map[string]string{ManifestFile: `{"collection_info": {
+			"namespace": "Acme", "name": "net_tools", "version": "1.0.0"}}`}},
+		{name: "invalid version", files: map[string]string{ManifestFile: `{"collection_info": {
+			"namespace": "acme", "name": "net_tools", "version": "1.0"}}`}},
+		{name: "invalid dependency", files: map[string]string{ManifestFile: `{"collection_info": {
+			"namespace": "acme", "name": "net_tools", "version": "1.0.0", "dependencies": {"utils": "*"}}}`}},
+		{name: "invalid json", files: map[string]string{ManifestFile: "{"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadArchive(bytes.NewReader(testArchive(t, tt.files)))
+			assert.True(t, errors.Is(err, ErrInvalidCollection), err)
+		})
+	}
+}
+
+func TestParseArchiveFilename(t *testing.T) {
+	namespace, name, version, err := ParseArchiveFilename("acme-net_tools-2.0.0-rc.1.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme", "net_tools", "2.0.0-rc.1"}, []string{namespace, name, version})
+	assert.Equal(t, "acme-net_tools-2.0.0-rc.1.tar.gz", ArchiveFilename(namespace, name, version))
+
+	for _, filename := range []string{
+		"acme-net_tools.tar.gz", "acme-net_tools-1.0.0.zip", "acme-net__tools-1.0.0.tar.gz",
+	} {
+		_, _, _, err = ParseArchiveFilename(filename)
+		assert.Error(t, err, filename)
+	}
+}
+
+func TestHighestVersion(t *testing.T) {
+	assert.Equal(t, "1.10.0", HighestVersion([]string{"1.2.0", "1.10.0", "2.0.0-beta.1"}))
+	assert.Equal(t, "2.0.0-beta.2", HighestVersion([]string{"2.0.0-beta.1", "2.0.0-beta.2"}))
+	assert.Equal(t, "1.2.0", HighestVersion([]string{"1.2.0", "nightly"}))
+	assert.Equal(t, "", HighestVersion(nil))
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gems
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxGemspecSize bounds the uncompressed gemspec read from a gem.
+const maxGemspecSize = 4 << 20
+
+// PlatformRuby is the platform of pure ruby gems.
+const PlatformRuby = "ruby"
+
+// ErrNoGemspec is returned by ExtractGemspec for gems without a metadata.gz.
+var ErrNoGemspec = errors.New("metadata.gz not found in gem")
+
+var (
+	namePattern     = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	versionPattern  = regexp.MustCompile(`^[0-9]+(\.[0-9A-Za-z]+)*(-[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+	platformPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// Metadata is the subset of the gemspec of a gem the registry shows and serves to clients.
+// Source: https://guides.rubygems.org/specification-reference/
+type Metadata struct {
+	Name                    string            `json:"name"`
+	Version                 string            `json:"version"`
+	Platform                string            `json:"platform,omitempty"`
+	Summary                 string            `json:"summary,omitempty"`
+	Description             string            `json:"description,omitempty"`
+	Authors                 []string          `json:"authors,omitempty"`
+	Homepage                string            `json:"homepage,omitempty"`
+	Licenses                []string          `json:"licenses,omitempty"`
+	Metadata                map[string]string `json:"metadata,omitempty"`
+	Dependencies            []Dependency      `json:"dependencies,omitempty"`
+	RequiredRubyVersion     []string          `json:"requiredRubyVersion,omitempty"`
+	RequiredRubygemsVersion []string          `json:"requiredRubygemsVersion,omitempty"`
+}
+
+// Dependency is a runtime or development dependency of a gem. Requirements are constraints like
+// "~> 1.2", all of which must be satisfied.
+type Dependency struct {
+	Name         string   `json:"name"`
+	Requirements []string `json:"requirements"`
+	Type         string   `json:"type"`
+}
+
+// gemspec is the YAML serialization of a Gem::Specification. The ruby object tags are ignored,
+// the fields are decoded by name only.
+type gemspec struct {
+	Name    string `yaml:"name"`
+	Version struct {
+		Version string `yaml:"version"`
+	} `yaml:"version"`
+	Platform     string            `yaml:"platform"`
+	Summary      string            `yaml:"summary"`
+	Description  string            `yaml:"description"`
+	Authors      []string          `yaml:"authors"`
+	Homepage     string            `yaml:"homepage"`
+	Licenses     []string          `yaml:"licenses"`
+	Metadata     map[string]string `yaml:"metadata"`
+	Dependencies []struct {
+		Name        string      `yaml:"name"`
+		Requirement requirement `yaml:"requirement"`
+		Type        string      `yaml:"type"`
+	} `yaml:"dependencies"`
+	RequiredRubyVersion     requirement `yaml:"required_ruby_version"`
+	RequiredRubygemsVersion requirement `yaml:"required_rubygems_version"`
+}
+
+type requirement struct {
+	Requirements []constraint `yaml:"requirements"`
+}
+
+// constraint is serialized as a pair of the operator and a Gem::Version, like [">=", {version: 1.0}].
+type constraint struct {
+	Operator string
+	Version  string
+}
+
+func (c *constraint) UnmarshalYAML(node *yaml.Node) error {
+	var pair []yaml.Node
+	if err := node.Decode(&pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("invalid version constraint at line %d", node.Line)
+	}
+	var version struct {
+		Version string `yaml:"version"`
+	}
+	if err := pair[0].Decode(&c.Operator); err != nil {
+		return err
+	}
+	if err := pair[1].Decode(&version); err != nil {
+		return err
+	}
+	c.Version = version.Version
+	return nil
+}
+
+func (r requirement) strings() []string {
+	result := make([]string, 0, len(r.Requirements))
+	for _, c := range r.Requirements {
+		result = append(result, c.Operator+" "+c.Version)
+	}
+	return result
+}
+
+// ParseGemspec parses the YAML gemspec of the metadata.gz of a gem.
+func ParseGemspec(data []byte) (*Metadata, error) {
+	var spec gemspec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("invalid gemspec: %w", err)
+	}
+	md := &Metadata{
+		Name:                    strings.TrimSpace(spec.Name),
+		Version:                 strings.TrimSpace(spec.Version.Version),
+		Platform:                strings.TrimSpace(spec.Platform),
+		Summary:                 strings.TrimSpace(spec.Summary),
+		Description:             strings.TrimSpace(spec.Description),
+		Authors:                 spec.Authors,
+		Homepage:                strings.TrimSpace(spec.Homepage),
+		Licenses:                spec.Licenses,
+		Metadata:                spec.Metadata,
+		RequiredRubyVersion:     spec.RequiredRubyVersion.strings(),
+		RequiredRubygemsVersion: spec.RequiredRubygemsVersion.strings(),
+	}
+	if md.Platform == "" {
+		md.Platform = PlatformRuby
+	}
+	for _, d := range spec.Dependencies {
+		// dependency types are ruby symbols, serialized as ":runtime"
+		md.Dependencies = append(md.Dependencies, Dependency{
+			Name:         d.Name,
+			Requirements: d.Requirement.strings(),
+			Type:         strings.TrimPrefix(d.Type, ":"),
+		})
+	}
+	if md.Name == "" || md.Version == "" {
+		return nil, errors.New("gemspec must have a name and a version")
+	}
+	return md, nil
+}
+
+// ExtractGemspec reads the metadata of a gem. A gem is a tar archive holding the gzipped gemspec
+// as metadata.gz next to the gzipped data.tar.gz with the files of the gem.
+func ExtractGemspec(r io.Reader) (*Metadata, error) {
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, ErrNoGemspec
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid gem: %w", err)
+		}
+		if header.Name != "metadata.gz" {
+			continue
+		}
+		gz, err := gzip.NewReader(tr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metadata.gz: %w", err)
+		}
+		data, err := io.ReadAll(io.LimitReader(gz, maxGemspecSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metadata.gz: %w", err)
+		}
+		if len(data) > maxGemspecSize {
+			return nil, errors.New("gemspec is too large")
+		}
+		return ParseGemspec(data)
+	}
+}
+
+// Validate checks the name, version and platform of a gem, which end up in file names and in the
+// lines of the compact index.
+func (m *Metadata) Validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("invalid gem name %q", m.Name)
+	}
+	if !versionPattern.MatchString(m.Version) {
+		return fmt.Errorf("invalid gem version %q", m.Version)
+	}
+	if !platformPattern.MatchString(m.Platform) {
+		return fmt.Errorf("invalid gem platform %q", m.Platform)
+	}
+	return nil
+}
+
+// FullVersion returns the version of a gem with the platform appended for platform specific gems,
+// like 1.16.0-x86_64-linux. Gems for several platforms share the version, the full version tells
+// them apart.
+func FullVersion(version, platform string) string {
+	if platform == "" || platform == PlatformRuby {
+		return version
+	}
+	return version + "-" + platform
+}
+
+// Filename returns the name of the .gem of a full version, like nokogiri-1.16.0-x86_64-linux.gem.
+func Filename(name, fullVersion string) string {
+	return name + "-" + fullVersion + ".gem"
+}
@@ -0,0 +1,120 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gems
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGemspec = `--- !ruby/object:Gem::Specification
+name: rake
+version: !ruby/object:Gem::Version
+  version: 13.0.6
+platform: ruby
+authors:
+- Hiroshi SHIBATA
+dependencies:
+- !ruby/object:Gem::Dependency
+  name: minitest
+  requirement: !ruby/object:Gem::Requirement
+    requirements:
+    - - "~>"
+      - !ruby/object:Gem::Version
+        version: '5.0'
+    - - ">="
+      - !ruby/object:Gem::Version
+        version: 5.0.1
+  type: :development
+  prerelease: false
+description: Rake is a Make-like program implemented in Ruby.
+homepage: https://github.com/ruby/rake
+licenses:
+- MIT
+metadata:
+  source_code_uri: https://github.com/ruby/rake
+required_ruby_version: !ruby/object:Gem::Requirement
+  requirements:
+  - - ">="
+    - !ruby/object:Gem::Version
+      version: '2.2'
+summary: Rake is a Make-like program
+`
+
+func gem(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content))}))
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestExtractGemspec(t *testing.T) {
+	data := gem(t, map[string][]byte{
+		"metadata.gz": gzipped(t, testGemspec),
+		"data.tar.gz": gzipped(t, ""),
+	})
+
+	md, err := ExtractGemspec(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "rake", md.Name)
+	assert.Equal(t, "13.0.6", md.Version)
+	assert.Equal(t, PlatformRuby, md.Platform)
+	assert.Equal(t, []string{"Hiroshi SHIBATA"}, md.Authors)
+	assert.Equal(t, []string{"MIT"}, md.Licenses)
+	assert.Equal(t, []string{">= 2.2"}, md.RequiredRubyVersion)
+	assert.Empty(t, md.RequiredRubygemsVersion)
+	assert.Equal(t, "https://github.com/ruby/rake", md.Metadata["source_code_uri"])
+	assert.Equal(t, []Dependency{
+		{Name: "minitest", Requirements: []string{"~> 5.0", ">= 5.0.1"}, Type: "development"},
+	}, md.Dependencies)
+	require.NoError(t, md.Validate())
+
+	_, err = ExtractGemspec(bytes.NewReader(gem(t, map[string][]byte{"data.tar.gz": gzipped(t, "")})))
+	assert.ErrorIs(t, err, ErrNoGemspec)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, (&Metadata{Name: "nokogiri", Version: "1.16.0.rc1", Platform: "x86_64-linux"}).Validate())
+	assert.Error(t, (&Metadata{Name: "../rake", Version: "1.0.0", Platform: PlatformRuby}).Validate())
+	assert.Error(t, (&Metadata{Name: "rake", Version: "1.0 beta", Platform: PlatformRuby}).Validate())
+	assert.Error(t, (&Metadata{Name: "rake", Version: "1.0.0", Platform: "x86 linux"}).Validate())
+}
+
+func TestFilename(t *testing.T) {
+	assert.Equal(t, "rake-13.0.6.gem", Filename("rake", FullVersion("13.0.6", PlatformRuby)))
+	assert.Equal(t, "nokogiri-1.16.0-x86_64-linux.gem",
+		Filename("nokogiri", FullVersion("1.16.0", "x86_64-linux")))
+}
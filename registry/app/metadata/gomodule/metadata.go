@@ -0,0 +1,249 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gomodule reads the go.mod and the zip of Go module versions, as served by a GOPROXY.
+// Source: https://go.dev/ref/mod#goproxy-protocol
+package gomodule
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// maxGoModSize is the largest go.mod read from a module zip.
+const maxGoModSize = 16 << 20
+
+var (
+	ErrInvalidPath    = errors.New("invalid module path")
+	ErrInvalidVersion = errors.New("invalid module version")
+
+	pathElementPattern = regexp.MustCompile(`^[A-Za-z0-9_.~-]+$`)
+	majorSuffixPattern = regexp.MustCompile(`/v([0-9]+)$`)
+	// gopkg.in paths always have a major suffix, which can be v0 and v1.
+	gopkgInSuffixPattern = regexp.MustCompile(`\.v([0-9]+)(-unstable)?$`)
+)
+
+type Metadata struct {
+	Module    string    `json:"module"`
+	Version   string    `json:"version"`
+	GoVersion string    `json:"go_version,omitempty"`
+	Requires  []Require `json:"requires,omitempty"`
+}
+
+type Require struct {
+	Path     string `json:"path"`
+	Version  string `json:"version"`
+	Indirect bool   `json:"indirect,omitempty"`
+}
+
+// Info is the .info of a version.
+type Info struct {
+	Version string    `json:"Version"`
+	Time    time.Time `json:"Time"`
+}
+
+// EscapePath returns the form of a module path used in proxy URLs and file paths, with upper case
+// letters replaced by "!" and the lower case letter.
+func EscapePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// UnescapePath reverses EscapePath.
+func UnescapePath(escaped string) (string, error) {
+	var b strings.Builder
+	bang := false
+	for _, r := range escaped {
+		switch {
+		case bang:
+			if !unicode.IsLower(r) {
+				return "", fmt.Errorf("%w: invalid escape in %q", ErrInvalidPath, escaped)
+			}
+			b.WriteRune(unicode.ToUpper(r))
+			bang = false
+		case r == '!':
+			bang = true
+		case unicode.IsUpper(r):
+			return "", fmt.Errorf("%w: unescaped upper case letter in %q", ErrInvalidPath, escaped)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if bang {
+		return "", fmt.Errorf("%w: invalid escape in %q", ErrInvalidPath, escaped)
+	}
+	return b.String(), nil
+}
+
+// ValidatePath checks the module path is made of valid path elements, and a major version suffix,
+// if any, is at least v2.
+func ValidatePath(path string) error {
+	if path == "" || strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+		return fmt.Errorf("%w: %q", ErrInvalidPath, path)
+	}
+	for _, element := range strings.Split(path, "/") {
+		if !pathElementPattern.MatchString(element) || strings.Trim(element, ".") == "" {
+			return fmt.Errorf("%w: invalid element %q in %q", ErrInvalidPath, element, path)
+		}
+	}
+	if m := majorSuffixPattern.FindStringSubmatch(path); m != nil && (m[1] == "0" || m[1] == "1" ||
+		strings.HasPrefix(m[1], "0")) {
+		return fmt.Errorf("%w: invalid major version suffix in %q", ErrInvalidPath, path)
+	}
+	return nil
+}
+
+// ValidateVersion checks the version is a canonical semantic version with the "v" prefix, and its
+// major version matches the major version suffix of the module path.
+func ValidateVersion(path, version string) error {
+	if !strings.HasPrefix(version, "v") {
+		return fmt.Errorf("%w: %q has no v prefix", ErrInvalidVersion, version)
+	}
+	v, err := semver.StrictNewVersion(version[1:])
+	if err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrInvalidVersion, version, err)
+	}
+	incompatible := v.Metadata() == "incompatible"
+	if v.Metadata() != "" && !incompatible {
+		return fmt.Errorf("%w: %q has build metadata", ErrInvalidVersion, version)
+	}
+
+	if strings.HasPrefix(path, "gopkg.in/") {
+		m := gopkgInSuffixPattern.FindStringSubmatch(path)
+		if m == nil || m[1] != fmt.Sprint(v.Major()) {
+			return fmt.Errorf("%w: %s doesn't match the major version suffix of %s", ErrInvalidVersion, version, path)
+		}
+		return nil
+	}
+
+	major := uint64(0)
+	if m := majorSuffixPattern.FindStringSubmatch(path); m != nil {
+		_, _ = fmt.Sscan(m[1], &major)
+	}
+	switch {
+	case incompatible && (major != 0 || v.Major() < 2):
+		return fmt.Errorf("%w: +incompatible is only allowed for v2 or later without a major suffix",
+			ErrInvalidVersion)
+	case major >= 2 && v.Major() != major:
+		return fmt.Errorf("%w: %s doesn't match the major version suffix of %s", ErrInvalidVersion, version, path)
+	case major == 0 && v.Major() >= 2 && !incompatible:
+		return fmt.Errorf("%w: %s requires the /v%d suffix in the module path", ErrInvalidVersion, version,
+			v.Major())
+	}
+	return nil
+}
+
+// ParseGoMod reads the module path, go version and requirements of a go.mod.
+func ParseGoMod(data []byte) (*Metadata, error) {
+	m := &Metadata{}
+	inRequire := false
+	for _, line := range strings.Split(string(data), "\n") {
+		line, comment, _ := strings.Cut(line, "//")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if inRequire {
+			if fields[0] == ")" {
+				inRequire = false
+				continue
+			}
+			if len(fields) >= 2 {
+				m.Requires = append(m.Requires, newRequire(fields[0], fields[1], comment))
+			}
+			continue
+		}
+		switch fields[0] {
+		case "module":
+			if len(fields) >= 2 {
+				m.Module = strings.Trim(fields[1], `"`)
+			}
+		case "go":
+			if len(fields) >= 2 {
+				m.GoVersion = fields[1]
+			}
+		case "require":
+			switch {
+			case len(fields) >= 2 && fields[1] == "(":
+				inRequire = true
+			case len(fields) >= 3:
+				m.Requires = append(m.Requires, newRequire(fields[1], fields[2], comment))
+			}
+		}
+	}
+	if m.Module == "" {
+		return nil, errors.New("go.mod has no module directive")
+	}
+	return m, nil
+}
+
+func newRequire(path, version, comment string) Require {
+	return Require{
+		Path:     strings.Trim(path, `"`),
+		Version:  version,
+		Indirect: strings.TrimSpace(comment) == "indirect",
+	}
+}
+
+// ReadZip checks all files of a module zip are under the "<module>@<version>/" directory, and
+// returns its go.mod. Modules without a go.mod get one with only the module directive, like the
+// go command synthesizes.
+func ReadZip(r io.ReaderAt, size int64, path, version string) ([]byte, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid module zip: %w", err)
+	}
+	prefix := path + "@" + version + "/"
+	var goMod []byte
+	for _, f := range zr.File {
+		if !strings.HasPrefix(f.Name, prefix) {
+			return nil, fmt.Errorf("file %s of the module zip isn't under %s", f.Name, prefix)
+		}
+		if f.Name != prefix+"go.mod" {
+			continue
+		}
+		if f.UncompressedSize64 > maxGoModSize {
+			return nil, fmt.Errorf("go.mod is larger than %d bytes", maxGoModSize)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open go.mod: %w", err)
+		}
+		goMod, err = io.ReadAll(io.LimitReader(rc, maxGoModSize))
+		_ = rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read go.mod: %w", err)
+		}
+	}
+	if goMod == nil {
+		goMod = []byte("module " + path + "\n")
+	}
+	return goMod, nil
+}
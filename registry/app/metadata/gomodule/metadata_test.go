@@ -0,0 +1,122 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapePath(t *testing.T) {
+	assert.Equal(t, "github.com/!azure/azure-sdk-for-go", EscapePath("github.com/Azure/azure-sdk-for-go"))
+	path, err := UnescapePath("github.com/!azure/azure-sdk-for-go")
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/Azure/azure-sdk-for-go", path)
+
+	_, err = UnescapePath("github.com/Azure/sdk")
+	assert.ErrorIs(t, err, ErrInvalidPath)
+	_, err = UnescapePath("github.com/!1")
+	assert.ErrorIs(t, err, ErrInvalidPath)
+}
+
+func TestValidateVersion(t *testing.T) {
+	for _, c := range []struct {
+		path, version string
+		valid         bool
+	}{
+		{"example.com/mod", "v1.2.3", true},
+		{"example.com/mod", "v0.1.0-rc.1", true},
+		{"example.com/mod", "1.2.3", false},
+		{"example.com/mod", "v1.2", false},
+		{"example.com/mod", "v2.0.0", false},
+		{"example.com/mod", "v2.0.0+incompatible", true},
+		{"example.com/mod/v2", "v2.1.0", true},
+		{"example.com/mod/v2", "v3.0.0", false},
+		{"gopkg.in/yaml.v3", "v3.0.1", true},
+		{"gopkg.in/yaml.v3", "v2.0.0", false},
+	} {
+		err := ValidateVersion(c.path, c.version)
+		if c.valid {
+			assert.NoError(t, err, "%s@%s", c.path, c.version)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidVersion, "%s@%s", c.path, c.version)
+		}
+	}
+	assert.NoError(t, ValidatePath("example.com/mod/v2"))
+	assert.ErrorIs(t, ValidatePath("example.com/mod/v1"), ErrInvalidPath)
+	assert.ErrorIs(t, ValidatePath("example.com//mod"), ErrInvalidPath)
+}
+
+func TestParseGoMod(t *testing.T) {
+	m, err := ParseGoMod([]byte(`// Module comment
+module example.com/mod
+
+go 1.22
+
+require github.com/google/uuid v1.6.0
+
+require (
+	golang.org/x/text v0.14.0
+	golang.org/x/sys v0.18.0 // indirect
+)
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "example.com/mod", m.Module)
+	assert.Equal(t, "1.22", m.GoVersion)
+	assert.Equal(t, []Require{
+		{Path: "github.com/google/uuid", Version: "v1.6.0"},
+		{Path: "golang.org/x/text", Version: "v0.14.0"},
+		{Path: "golang.org/x/sys", Version: "v0.18.0", Indirect: true},
+	}, m.Requires)
+
+	_, err = ParseGoMod([]byte("go 1.22\n"))
+	assert.Error(t, err)
+}
+
+func TestReadZip(t *testing.T) {
+	archive := func(files map[string]string) *bytes.Reader {
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for name, content := range files {
+			w, err := zw.Create(name)
+			require.NoError(t, err)
+			_, err = w.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, zw.Close())
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	r := archive(map[string]string{
+		"example.com/mod@v1.0.0/go.mod": "module example.com/mod\n",
+		"example.com/mod@v1.0.0/mod.go": "package mod\n",
+	})
+	goMod, err := ReadZip(r, r.Size(), "example.com/mod", "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "module example.com/mod\n", string(goMod))
+
+	r = archive(map[string]string{"example.com/mod@v1.0.0/mod.go": "package mod\n"})
+	goMod, err = ReadZip(r, r.Size(), "example.com/mod", "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "module example.com/mod\n", string(goMod))
+
+	r = archive(map[string]string{"mod.go": "package mod\n"})
+	_, err = ReadZip(r, r.Size(), "example.com/mod", "v1.0.0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// TermContentType is the media type of the responses of the API of Hex, which clients decode with
+// erlang:binary_to_term/1.
+const TermContentType = "application/vnd.hex+erlang"
+
+// Tags of the external term format.
+const (
+	termVersion   = 131
+	newFloatExt   = 70
+	smallIntExt   = 97
+	intExt        = 98
+	nilExt        = 106
+	listExt       = 108
+	binaryExt     = 109
+	mapExt        = 116
+	smallAtomUTF8 = 119
+)
+
+// EncodeTerm encodes a value in the external term format, as it's marshaled to JSON: objects are
+// encoded to maps with binary keys, strings to binaries, arrays to lists, and true, false and
+// null to the atoms true, false and nil.
+func EncodeTerm(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode term: %w", err)
+	}
+	var value any
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to encode term: %w", err)
+	}
+	return appendTerm([]byte{termVersion}, value)
+}
+
+func appendTerm(b []byte, value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return appendAtom(b, "nil"), nil
+	case bool:
+		if v {
+			return appendAtom(b, "true"), nil
+		}
+		return appendAtom(b, "false"), nil
+	case string:
+		b = append(b, binaryExt)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(v)))
+		return append(b, v...), nil
+	case float64:
+		switch {
+		case v != math.Trunc(v) || v < math.MinInt32 || v > math.MaxInt32:
+			b = append(b, newFloatExt)
+			return binary.BigEndian.AppendUint64(b, math.Float64bits(v)), nil
+		case v >= 0 && v <= math.MaxUint8:
+			return append(b, smallIntExt, byte(v)), nil
+		default:
+			b = append(b, intExt)
+			return binary.BigEndian.AppendUint32(b, uint32(int32(v))), nil
+		}
+	case []any:
+		if len(v) == 0 {
+			return append(b, nilExt), nil
+		}
+		b = append(b, listExt)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(v)))
+		var err error
+		for _, element := range v {
+			if b, err = appendTerm(b, element); err != nil {
+				return nil, err
+			}
+		}
+		return append(b, nilExt), nil
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		b = append(b, mapExt)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(keys)))
+		var err error
+		for _, key := range keys {
+			b, _ = appendTerm(b, key)
+			if b, err = appendTerm(b, v[key]); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("failed to encode term of type %T", value)
+	}
+}
+
+func appendAtom(b []byte, name string) []byte {
+	b = append(b, smallAtomUTF8, byte(len(name)))
+	return append(b, name...)
+}
@@ -0,0 +1,300 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// tarballVersion is the version of the format of the tarballs the registry accepts.
+	tarballVersion = "3"
+
+	versionFile  = "VERSION"
+	checksumFile = "CHECKSUM"
+	metadataFile = "metadata.config"
+	contentsFile = "contents.tar.gz"
+
+	// maxMetadataSize is the largest VERSION, CHECKSUM or metadata.config of a tarball.
+	maxMetadataSize = 1 << 20
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid package")
+
+	// namePattern matches the names of packages, which are the names of their OTP applications.
+	namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// Metadata is what the registry keeps of the metadata.config of a release.
+// Source: https://github.com/hexpm/specifications/blob/main/package_tarball.md
+type Metadata struct {
+	Name        string            `json:"name"`
+	Version     string            `json:"version"`
+	App         string            `json:"app,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Licenses    []string          `json:"licenses,omitempty"`
+	Links       map[string]string `json:"links,omitempty"`
+	BuildTools  []string          `json:"build_tools,omitempty"`
+	// Elixir is the requirement on the version of Elixir, if any.
+	Elixir       string        `json:"elixir,omitempty"`
+	Requirements []Requirement `json:"requirements,omitempty"`
+	// InnerChecksum is the hex encoded SHA-256 of the VERSION, metadata.config and
+	// contents.tar.gz of the tarball, in this order.
+	InnerChecksum string `json:"inner_checksum"`
+}
+
+// Requirement is a dependency of a release on another package.
+type Requirement struct {
+	Name        string `json:"name"`
+	App         string `json:"app,omitempty"`
+	Requirement string `json:"requirement"`
+	Optional    bool   `json:"optional,omitempty"`
+	// Repository is the repository the package is fetched from, empty for the repository of the
+	// release.
+	Repository string `json:"repository,omitempty"`
+}
+
+// ValidateName checks the name of a package.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidPackage, name)
+	}
+	return nil
+}
+
+// ValidateVersion checks that a version is a semantic version.
+func ValidateVersion(version string) error {
+	if _, err := semver.StrictNewVersion(version); err != nil {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidPackage, version)
+	}
+	return nil
+}
+
+// TarballFilename is the name of the tarball of a release.
+func TarballFilename(name, version string) string {
+	return name + "-" + version + ".tar"
+}
+
+// DocsFilename is the name of the documentation tarball of a release.
+func DocsFilename(name, version string) string {
+	return name + "-" + version + ".tar.gz"
+}
+
+// ReadTarball reads the metadata of a release from its tarball, checking its inner checksum.
+func ReadTarball(r io.ReaderAt, size int64) (*Metadata, error) {
+	files := map[string][]byte{}
+	err := walkTarball(r, size, func(name string, content io.Reader) error {
+		if name == contentsFile {
+			return nil
+		}
+		data, err := io.ReadAll(io.LimitReader(content, maxMetadataSize+1))
+		if err != nil {
+			return err
+		}
+		if len(data) > maxMetadataSize {
+			return fmt.Errorf("%w: %s is too large", ErrInvalidPackage, name)
+		}
+		files[name] = data
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if v := strings.TrimSpace(string(files[versionFile])); v != tarballVersion {
+		return nil, fmt.Errorf("%w: unsupported tarball version %q", ErrInvalidPackage, v)
+	}
+	if _, ok := files[metadataFile]; !ok {
+		return nil, fmt.Errorf("%w: %s is missing", ErrInvalidPackage, metadataFile)
+	}
+
+	// The contents are hashed in a second pass, after the files before them in the checksum.
+	hash := sha256.New()
+	hash.Write(files[versionFile])
+	hash.Write(files[metadataFile])
+	found := false
+	err = walkTarball(r, size, func(name string, content io.Reader) error {
+		if name != contentsFile {
+			return nil
+		}
+		found = true
+		_, err := io.Copy(hash, content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s is missing", ErrInvalidPackage, contentsFile)
+	}
+	innerChecksum := hex.EncodeToString(hash.Sum(nil))
+	checksum, ok := files[checksumFile]
+	if ok && !strings.EqualFold(strings.TrimSpace(string(checksum)), innerChecksum) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidPackage)
+	}
+
+	metadata, err := parseMetadata(string(files[metadataFile]))
+	if err != nil {
+		return nil, err
+	}
+	metadata.InnerChecksum = innerChecksum
+	return metadata, nil
+}
+
+func walkTarball(r io.ReaderAt, size int64, fn func(name string, content io.Reader) error) error {
+	tr := tar.NewReader(io.NewSectionReader(r, 0, size))
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: tarball must be a tar archive: %w", ErrInvalidPackage, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := fn(header.Name, tr); err != nil {
+			return fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+	}
+}
+
+// parseMetadata reads the key-value tuples of metadata.config.
+//
+//nolint:gocognit
+func parseMetadata(data string) (*Metadata, error) {
+	terms, err := parseTerms(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	metadata := &Metadata{}
+	for _, term := range terms {
+		key, value, ok := pair(term)
+		if !ok {
+			continue
+		}
+		switch key {
+		case "name":
+			metadata.Name, _ = value.(string)
+		case "version":
+			metadata.Version, _ = value.(string)
+		case "app":
+			metadata.App, _ = value.(string)
+		case "description":
+			metadata.Description, _ = value.(string)
+		case "elixir":
+			metadata.Elixir, _ = value.(string)
+		case "licenses":
+			metadata.Licenses = stringList(value)
+		case "build_tools":
+			metadata.BuildTools = stringList(value)
+		case "links":
+			metadata.Links = map[string]string{}
+			for _, link := range list(value) {
+				if name, url, ok := pair(link); ok {
+					metadata.Links[name], _ = url.(string)
+				}
+			}
+		case "requirements":
+			metadata.Requirements = requirements(value)
+		}
+	}
+	if err := ValidateName(metadata.Name); err != nil {
+		return nil, err
+	}
+	if err := ValidateVersion(metadata.Version); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// requirements reads the requirements of a release, either as a list of property lists with the
+// name of the package, or as a list of tuples of the name and the properties of the requirement.
+func requirements(value any) []Requirement {
+	var reqs []Requirement
+	for _, elem := range list(value) {
+		properties := elem
+		requirement := Requirement{}
+		if name, props, ok := pair(elem); ok {
+			requirement.Name = name
+			properties = props
+		}
+		for _, property := range list(properties) {
+			key, v, ok := pair(property)
+			if !ok {
+				continue
+			}
+			switch key {
+			case "name":
+				requirement.Name, _ = v.(string)
+			case "app":
+				requirement.App, _ = v.(string)
+			case "requirement":
+				requirement.Requirement, _ = v.(string)
+			case "repository":
+				requirement.Repository, _ = v.(string)
+			case "optional":
+				requirement.Optional = v == atom("true")
+			}
+		}
+		if requirement.Name != "" {
+			reqs = append(reqs, requirement)
+		}
+	}
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].Name < reqs[j].Name })
+	return reqs
+}
+
+// pair returns the key and the value of a 2-tuple whose key is a string or an atom.
+func pair(term any) (string, any, bool) {
+	t, ok := term.(tuple)
+	if !ok || len(t) != 2 {
+		return "", nil, false
+	}
+	switch key := t[0].(type) {
+	case string:
+		return key, t[1], true
+	case atom:
+		return string(key), t[1], true
+	default:
+		return "", nil, false
+	}
+}
+
+func list(term any) []any {
+	l, _ := term.([]any)
+	return l
+}
+
+func stringList(term any) []string {
+	var values []string
+	for _, elem := range list(term) {
+		if s, ok := elem.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,144 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const testMetadata = `{<<"name">>,<<"plug_auth">>}.
+{<<"version">>,<<"1.2.0">>}.
+{<<"app">>,<<"plug_auth">>}.
+{<<"description">>,<<"Authentication \"plugs\"">>}.
+{<<"licenses">>,[<<"Apache-2.0">>]}.
+{<<"links">>,[{<<"GitHub">>,<<"https://github.com/acme/plug_auth">>}]}.
+{<<"build_tools">>,[<<"mix">>]}.
+{<<"elixir">>,<<"~> 1.14">>}.
+{<<"requirements">>,
+ [[{<<"name">>,<<"plug">>},
+   {<<"app">>,<<"plug">>},
+   {<<"optional">>,false},
+   {<<"requirement">>,<<"~> 1.14">>},
+   {<<"repository">>,<<"hexpm">>}],
+  [{<<"name">>,<<"jason">>},
+   {<<"app">>,<<"jason">>},
+   {<<"optional">>,true},
+   {<<"requirement">>,<<"~> 1.0">>},
+   {<<"repository">>,<<"hexpm">>}]]}.
+`
+
+func testTarball(t *testing.T, metadata string) []byte {
+	t.Helper()
+	contents := []byte("contents")
+	hash := sha256.New()
+	hash.Write([]byte(tarballVersion))
+	hash.Write([]byte(metadata))
+	hash.Write(contents)
+
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, f := range []struct{ name, content string }{
+		{versionFile, tarballVersion},
+		{checksumFile, hex.EncodeToString(hash.Sum(nil))},
+		{metadataFile, metadata},
+		{contentsFile, string(contents)},
+	} {
+		require.NoError(t, w.WriteHeader(&tar.Header{Name: f.name, Mode: 0o644, Size: int64(len(f.content))}))
+		_, err := w.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestReadTarball(t *testing.T) {
+	tarball := testTarball(t, testMetadata)
+	metadata, err := ReadTarball(bytes.NewReader(tarball), int64(len(tarball)))
+	require.NoError(t, err)
+	assert.Equal(t, "plug_auth", metadata.Name)
+	assert.Equal(t, "1.2.0", metadata.Version)
+	assert.Equal(t, `Authentication "plugs"`, metadata.Description)
+	assert.Equal(t, []string{"Apache-2.0"}, metadata.Licenses)
+	assert.Equal(t, map[string]string{"GitHub": "https://github.com/acme/plug_auth"}, metadata.Links)
+	assert.Equal(t, "~> 1.14", metadata.Elixir)
+	assert.Equal(t, []Requirement{
+		{Name: "jason", App: "jason", Requirement: "~> 1.0", Optional: true, Repository: "hexpm"},
+		{Name: "plug", App: "plug", Requirement: "~> 1.14", Repository: "hexpm"},
+	}, metadata.Requirements)
+	assert.Len(t, metadata.InnerChecksum, 64)
+}
+
+func TestReadTarballInvalid(t *testing.T) {
+	tarball := testTarball(t, `{<<"name">>,<<"Plug">>}.{<<"version">>,<<"1.0.0">>}.`)
+	_, err := ReadTarball(bytes.NewReader(tarball), int64(len(tarball)))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+
+	tarball = testTarball(t, `{<<"name">>,<<"plug">>`)
+	_, err = ReadTarball(bytes.NewReader(tarball), int64(len(tarball)))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+}
+
+func TestParseTermsLegacyRequirements(t *testing.T) {
+	metadata, err := parseMetadata(`{<<"name">>,<<"plug">>}. {<<"version">>,<<"1.0.0">>}.
+% requirements written by older clients
+{<<"requirements">>,[{<<"mime">>,[{<<"app">>,<<"mime">>},{<<"optional">>,false},
+  {<<"requirement">>,<<"~> 1.0">>}]}]}.`)
+	require.NoError(t, err)
+	assert.Equal(t, []Requirement{{Name: "mime", App: "mime", Requirement: "~> 1.0"}}, metadata.Requirements)
+}
+
+func TestEncodeSigned(t *testing.T) {
+	payload := EncodeVersions("acme", []PackageVersions{{Name: "plug", Versions: []string{"1.0.0", "1.1.0"}}})
+	resource, err := EncodeSigned(payload, []byte("signature"))
+	require.NoError(t, err)
+
+	r, err := gzip.NewReader(bytes.NewReader(resource))
+	require.NoError(t, err)
+	signed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	fields := map[protowire.Number][]byte{}
+	for len(signed) > 0 {
+		num, typ, n := protowire.ConsumeTag(signed)
+		require.Equal(t, protowire.BytesType, typ)
+		value, m := protowire.ConsumeBytes(signed[n:])
+		require.GreaterOrEqual(t, m, 0)
+		fields[num] = value
+		signed = signed[n+m:]
+	}
+	assert.Equal(t, payload, fields[1])
+	assert.Equal(t, []byte("signature"), fields[2])
+}
+
+func TestEncodeTerm(t *testing.T) {
+	term, err := EncodeTerm(map[string]any{"ok": true, "url": "u", "n": 300, "tags": []string{}})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{
+		131, 116, 0, 0, 0, 4,
+		109, 0, 0, 0, 1, 'n', 98, 0, 0, 1, 44,
+		109, 0, 0, 0, 2, 'o', 'k', 119, 4, 't', 'r', 'u', 'e',
+		109, 0, 0, 0, 4, 't', 'a', 'g', 's', 106,
+		109, 0, 0, 0, 3, 'u', 'r', 'l', 109, 0, 0, 0, 1, 'u',
+	}, term)
+}
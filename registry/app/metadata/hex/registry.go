@@ -0,0 +1,144 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The registry resources are the protocol buffers messages of
+// https://github.com/hexpm/specifications/blob/main/registry-v2.md, signed and gzipped.
+
+// PackageName is a package of the names resource.
+type PackageName struct {
+	Name      string
+	UpdatedAt time.Time
+}
+
+// PackageVersions is a package of the versions resource, with its versions from the lowest to the
+// highest.
+type PackageVersions struct {
+	Name     string
+	Versions []string
+}
+
+// Release is a release of the package resource.
+type Release struct {
+	Version       string
+	InnerChecksum []byte
+	OuterChecksum []byte
+	Dependencies  []Requirement
+}
+
+// EncodeNames encodes the names resource, listing the packages of a repository.
+func EncodeNames(repository string, packages []PackageName) []byte {
+	var b []byte
+	for _, p := range packages {
+		var pkg []byte
+		pkg = appendString(pkg, 1, p.Name)
+		if !p.UpdatedAt.IsZero() {
+			var ts []byte
+			ts = protowire.AppendTag(ts, 1, protowire.VarintType)
+			ts = protowire.AppendVarint(ts, uint64(p.UpdatedAt.Unix()))
+			if nanos := p.UpdatedAt.Nanosecond(); nanos > 0 {
+				ts = protowire.AppendTag(ts, 2, protowire.VarintType)
+				ts = protowire.AppendVarint(ts, uint64(nanos))
+			}
+			pkg = appendBytes(pkg, 2, ts)
+		}
+		b = appendBytes(b, 1, pkg)
+	}
+	return appendString(b, 2, repository)
+}
+
+// EncodeVersions encodes the versions resource, listing the versions of the packages of a
+// repository. No version is retired.
+func EncodeVersions(repository string, packages []PackageVersions) []byte {
+	var b []byte
+	for _, p := range packages {
+		var pkg []byte
+		pkg = appendString(pkg, 1, p.Name)
+		for _, version := range p.Versions {
+			pkg = appendString(pkg, 2, version)
+		}
+		b = appendBytes(b, 1, pkg)
+	}
+	return appendString(b, 2, repository)
+}
+
+// EncodePackage encodes the package resource, describing the releases of a package.
+func EncodePackage(repository, name string, releases []Release) []byte {
+	var b []byte
+	for _, r := range releases {
+		var release []byte
+		release = appendString(release, 1, r.Version)
+		release = appendBytes(release, 2, r.InnerChecksum)
+		for _, d := range r.Dependencies {
+			var dependency []byte
+			dependency = appendString(dependency, 1, d.Name)
+			dependency = appendString(dependency, 2, d.Requirement)
+			if d.Optional {
+				dependency = protowire.AppendTag(dependency, 3, protowire.VarintType)
+				dependency = protowire.AppendVarint(dependency, 1)
+			}
+			if d.App != "" && d.App != d.Name {
+				dependency = appendString(dependency, 4, d.App)
+			}
+			if d.Repository != "" {
+				dependency = appendString(dependency, 5, d.Repository)
+			}
+			release = appendBytes(release, 3, dependency)
+		}
+		if len(r.OuterChecksum) > 0 {
+			release = appendBytes(release, 5, r.OuterChecksum)
+		}
+		b = appendBytes(b, 1, release)
+	}
+	b = appendString(b, 2, name)
+	return appendString(b, 3, repository)
+}
+
+// EncodeSigned wraps the payload of a resource with its signature, which is omitted if empty, and
+// gzips it as the resource is served.
+func EncodeSigned(payload, signature []byte) ([]byte, error) {
+	b := appendBytes(nil, 1, payload)
+	if len(signature) > 0 {
+		b = appendBytes(b, 2, signature)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return nil, fmt.Errorf("failed to compress resource: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress resource: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendBytes(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
@@ -0,0 +1,211 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// errInvalidTerm is returned for a metadata.config that isn't made of the terms it's written with.
+var errInvalidTerm = errors.New("invalid term")
+
+// tuple is an Erlang tuple of a parsed term, told apart from a list.
+type tuple []any
+
+// atom is an Erlang atom of a parsed term, told apart from a string or a binary.
+type atom string
+
+// parseTerms parses the terms of a file in the format of Erlang's file:consult/1, each followed by
+// a dot, as metadata.config is written. Strings and binaries are parsed to strings, lists to
+// []any and integers to int64.
+func parseTerms(data string) ([]any, error) {
+	p := &termParser{data: data}
+	var terms []any
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return terms, nil
+		}
+		term, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(".") {
+			return nil, p.errorf("expected '.'")
+		}
+		terms = append(terms, term)
+	}
+}
+
+type termParser struct {
+	data string
+	pos  int
+}
+
+//nolint:gocognit,cyclop
+func (p *termParser) term() (any, error) {
+	p.skipSpace()
+	if p.pos >= len(p.data) {
+		return nil, p.errorf("unexpected end")
+	}
+	switch c := p.data[p.pos]; {
+	case c == '{':
+		p.pos++
+		elems, err := p.sequence('}')
+		if err != nil {
+			return nil, err
+		}
+		return tuple(elems), nil
+	case c == '[':
+		p.pos++
+		return p.sequence(']')
+	case c == '"':
+		return p.quoted('"')
+	case c == '\'':
+		s, err := p.quoted('\'')
+		if err != nil {
+			return nil, err
+		}
+		return atom(s), nil
+	case strings.HasPrefix(p.data[p.pos:], "<<"):
+		p.pos += 2
+		p.skipSpace()
+		s := ""
+		if p.pos < len(p.data) && p.data[p.pos] == '"' {
+			var err error
+			if s, err = p.quoted('"'); err != nil {
+				return nil, err
+			}
+			p.skipSpace()
+			p.consume("/utf8")
+			p.skipSpace()
+		}
+		if !p.consume(">>") {
+			return nil, p.errorf("expected '>>'")
+		}
+		return s, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		start := p.pos
+		p.pos++
+		for p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+			p.pos++
+		}
+		n, err := strconv.ParseInt(p.data[start:p.pos], 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid integer")
+		}
+		return n, nil
+	case c >= 'a' && c <= 'z':
+		start := p.pos
+		for p.pos < len(p.data) && (isAtomChar(rune(p.data[p.pos]))) {
+			p.pos++
+		}
+		return atom(p.data[start:p.pos]), nil
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+// sequence parses the comma separated terms of a tuple or a list up to its closing character.
+func (p *termParser) sequence(end byte) ([]any, error) {
+	elems := []any{}
+	p.skipSpace()
+	if p.consume(string(end)) {
+		return elems, nil
+	}
+	for {
+		elem, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+		p.skipSpace()
+		if p.consume(string(end)) {
+			return elems, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected ',' or %q", end)
+		}
+	}
+}
+
+func (p *termParser) quoted(quote byte) (string, error) {
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return b.String(), nil
+		case '\\':
+			if p.pos >= len(p.data) {
+				return "", p.errorf("unterminated escape")
+			}
+			e := p.data[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			default:
+				b.WriteByte(e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// skipSpace skips the whitespace and the comments before the next term.
+func (p *termParser) skipSpace() {
+	for p.pos < len(p.data) {
+		switch c := p.data[p.pos]; {
+		case c == '%':
+			for p.pos < len(p.data) && p.data[p.pos] != '\n' {
+				p.pos++
+			}
+		case unicode.IsSpace(rune(c)):
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *termParser) consume(s string) bool {
+	if strings.HasPrefix(p.data[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *termParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at offset %d: %s", errInvalidTerm, p.pos, fmt.Sprintf(format, args...))
+}
+
+func isAtomChar(c rune) bool {
+	return c == '_' || c == '@' || unicode.IsLetter(c) || unicode.IsDigit(c)
+}
@@ -0,0 +1,308 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package homebrew
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// bottleExtension ends the filenames of bottles, after the tag and the rebuild.
+	bottleExtension = ".tar.gz"
+	// CellarAny is the cellar of bottles that can be poured into any prefix.
+	CellarAny = "any"
+	// CellarAnySkipRelocation is the cellar of bottles that can be poured into any prefix without
+	// replacing the placeholders of paths in their files.
+	CellarAnySkipRelocation = "any_skip_relocation"
+
+	// maxFormulaSize bounds the formula read from a bottle.
+	maxFormulaSize = 1 << 20
+)
+
+var (
+	ErrInvalidBottle = errors.New("invalid bottle")
+
+	// namePattern matches the names of formulae, such as python@3.12 or gtk+3.
+	namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9@._+-]*$`)
+	// versionPattern matches the versions of formulae, followed by the revision if any, such as 1.2.3_1.
+	versionPattern = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z._+-]*$`)
+	// tagPattern matches the platforms bottles are built for, such as arm64_sonoma or x86_64_linux.
+	tagPattern    = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	cellarPattern = regexp.MustCompile(`^/[A-Za-z0-9/._-]+$`)
+	classPattern  = regexp.MustCompile(`^class\s+\w+\s*<\s*Formula\b`)
+
+	descPattern     = regexp.MustCompile(`(?m)^\s*desc\s+"((?:[^"\\]|\\.)*)"`)
+	homepagePattern = regexp.MustCompile(`(?m)^\s*homepage\s+"((?:[^"\\]|\\.)*)"`)
+	licensePattern  = regexp.MustCompile(`(?m)^\s*license\s+"((?:[^"\\]|\\.)*)"`)
+)
+
+// Formula is the formula bottles of a version were built from, which is served with a bottle
+// block listing them.
+// Source: https://docs.brew.sh/Formula-Cookbook
+type Formula struct {
+	Desc     string `json:"desc,omitempty"`
+	Homepage string `json:"homepage,omitempty"`
+	License  string `json:"license,omitempty"`
+	// Source is the Ruby source of the formula, as stored in the bottles.
+	Source string `json:"source"`
+}
+
+// Bottle is the build of a version of a formula for a platform.
+type Bottle struct {
+	Tag      string `json:"tag"`
+	Rebuild  int    `json:"rebuild,omitempty"`
+	Cellar   string `json:"cellar"`
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	Sha256   string `json:"sha256"`
+}
+
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid formula name %q", ErrInvalidBottle, name)
+	}
+	return nil
+}
+
+func ValidateVersion(version string) error {
+	if !versionPattern.MatchString(version) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidBottle, version)
+	}
+	return nil
+}
+
+// ValidateCellar checks the cellar of a bottle, which is either CellarAny, CellarAnySkipRelocation
+// or the absolute path of the cellar the bottle was built for.
+func ValidateCellar(cellar string) error {
+	if cellar != CellarAny && cellar != CellarAnySkipRelocation && !cellarPattern.MatchString(cellar) {
+		return fmt.Errorf("%w: invalid cellar %q", ErrInvalidBottle, cellar)
+	}
+	return nil
+}
+
+// BottleFilename returns the filename brew downloads the bottle of a version for a platform by.
+func BottleFilename(name, version, tag string, rebuild int) string {
+	filename := name + "--" + version + "." + tag + ".bottle"
+	if rebuild > 0 {
+		filename += "." + strconv.Itoa(rebuild)
+	}
+	return filename + bottleExtension
+}
+
+// ParseBottleFilename returns the name, the version, the tag and the rebuild of a bottle from its
+// filename, such as wget--1.24.5.arm64_sonoma.bottle.1.tar.gz.
+func ParseBottleFilename(filename string) (string, string, string, int, error) {
+	invalid := fmt.Errorf("%w: invalid filename %q", ErrInvalidBottle, filename)
+	name, rest, ok := strings.Cut(filename, "--")
+	if !ok {
+		return "", "", "", 0, invalid
+	}
+	rest, ok = strings.CutSuffix(rest, bottleExtension)
+	if !ok {
+		return "", "", "", 0, invalid
+	}
+	rebuild := 0
+	if i := strings.LastIndex(rest, ".bottle."); i >= 0 {
+		n, err := strconv.Atoi(rest[i+len(".bottle."):])
+		if err != nil || n <= 0 {
+			return "", "", "", 0, invalid
+		}
+		rest, rebuild = rest[:i], n
+	} else if rest, ok = strings.CutSuffix(rest, ".bottle"); !ok {
+		return "", "", "", 0, invalid
+	}
+	i := strings.LastIndex(rest, ".")
+	if i < 0 {
+		return "", "", "", 0, invalid
+	}
+	version, tag := rest[:i], rest[i+1:]
+	if err := ValidateName(name); err != nil {
+		return "", "", "", 0, err
+	}
+	if err := ValidateVersion(version); err != nil {
+		return "", "", "", 0, err
+	}
+	if !tagPattern.MatchString(tag) {
+		return "", "", "", 0, fmt.Errorf("%w: invalid tag %q", ErrInvalidBottle, tag)
+	}
+	return name, version, tag, rebuild, nil
+}
+
+// ReadBottle reads the formula a bottle was built from, which brew stores in the .brew directory
+// of the keg of the version.
+func ReadBottle(r io.Reader, name, version string) (*Formula, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bottle isn't gzipped: %w", ErrInvalidBottle, err)
+	}
+	defer gz.Close()
+	formulaPath := path.Join(name, version, ".brew", name+".rb")
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: %s not found in bottle", ErrInvalidBottle, formulaPath)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read bottle: %w", ErrInvalidBottle, err)
+		}
+		if header.Typeflag != tar.TypeReg || path.Clean(header.Name) != formulaPath {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxFormulaSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalidBottle, formulaPath, err)
+		}
+		if len(data) > maxFormulaSize {
+			return nil, fmt.Errorf("%w: %s is too large", ErrInvalidBottle, formulaPath)
+		}
+		return ParseFormula(string(data))
+	}
+}
+
+// ParseFormula reads the description, the homepage and the license of a formula, when they're
+// string literals.
+func ParseFormula(source string) (*Formula, error) {
+	if findClass(strings.Split(source, "\n")) < 0 {
+		return nil, fmt.Errorf("%w: formula doesn't define a Formula class", ErrInvalidBottle)
+	}
+	return &Formula{
+		Desc:     findString(descPattern, source),
+		Homepage: findString(homepagePattern, source),
+		License:  findString(licensePattern, source),
+		Source:   source,
+	}, nil
+}
+
+// GenerateFormula returns the source of the formula with a bottle block listing the bottles,
+// which brew downloads from rootURL. The bottle block the formula was built with is dropped.
+func GenerateFormula(formula Formula, rootURL string, bottles []Bottle) (string, error) {
+	lines := removeBottleBlock(strings.Split(formula.Source, "\n"))
+	class := findClass(lines)
+	if class < 0 {
+		return "", fmt.Errorf("%w: formula doesn't define a Formula class", ErrInvalidBottle)
+	}
+	bottles = CurrentBottles(bottles)
+	if len(bottles) == 0 {
+		return strings.Join(lines, "\n"), nil
+	}
+	block := []string{"  bottle do", "    root_url " + strconv.Quote(rootURL)}
+	if bottles[0].Rebuild > 0 {
+		block = append(block, "    rebuild "+strconv.Itoa(bottles[0].Rebuild))
+	}
+	for _, b := range bottles {
+		block = append(block, fmt.Sprintf("    sha256 cellar: %s, %s: %q", cellarLiteral(b.Cellar), b.Tag, b.Sha256))
+	}
+	block = append(block, "  end", "")
+
+	result := make([]string, 0, len(lines)+len(block))
+	result = append(result, lines[:class+1]...)
+	result = append(result, block...)
+	result = append(result, lines[class+1:]...)
+	return strings.Join(result, "\n"), nil
+}
+
+// CurrentBottles returns the bottles of the highest rebuild, as brew looks up the bottles of all
+// platforms with the single rebuild of the bottle block.
+func CurrentBottles(bottles []Bottle) []Bottle {
+	rebuild := 0
+	for _, b := range bottles {
+		rebuild = max(rebuild, b.Rebuild)
+	}
+	current := make([]Bottle, 0, len(bottles))
+	for _, b := range bottles {
+		if b.Rebuild == rebuild {
+			current = append(current, b)
+		}
+	}
+	return current
+}
+
+// FindBottle returns the bottle of the bottles built for the tag with the rebuild.
+func FindBottle(bottles []Bottle, tag string, rebuild int) (Bottle, bool) {
+	for _, b := range bottles {
+		if b.Tag == tag && b.Rebuild == rebuild {
+			return b, true
+		}
+	}
+	return Bottle{}, false
+}
+
+func cellarLiteral(cellar string) string {
+	if cellar == CellarAny || cellar == CellarAnySkipRelocation {
+		return ":" + cellar
+	}
+	return strconv.Quote(cellar)
+}
+
+func findClass(lines []string) int {
+	for i, line := range lines {
+		if classPattern.MatchString(line) {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeBottleBlock removes the bottle block of a formula, and the bottle statements of formulae
+// written before bottle blocks were required.
+func removeBottleBlock(lines []string) []string {
+	result := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, "bottle :") {
+			continue
+		}
+		if trimmed != "bottle do" {
+			result = append(result, lines[i])
+			continue
+		}
+		indent := lines[i][:len(lines[i])-len(strings.TrimLeft(lines[i], " \t"))]
+		j := i + 1
+		for j < len(lines) && strings.TrimRight(lines[j], " \t") != indent+"end" {
+			j++
+		}
+		if j == len(lines) {
+			result = append(result, lines[i])
+			continue
+		}
+		i = j
+		// drop the blank line separating the block from the next statement
+		if i+1 < len(lines) && strings.TrimSpace(lines[i+1]) == "" {
+			i++
+		}
+	}
+	return result
+}
+
+func findString(pattern *regexp.Regexp, source string) string {
+	match := pattern.FindStringSubmatch(source)
+	if match == nil {
+		return ""
+	}
+	s, err := strconv.Unquote(`"` + match[1] + `"`)
+	if err != nil {
+		return match[1]
+	}
+	return s
+}
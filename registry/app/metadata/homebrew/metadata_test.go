@@ -0,0 +1,151 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package homebrew
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFormula = `class Acmectl < Formula
+  desc "Command-line client for \"Acme\" services"
+  homepage "https://acme.example.com/acmectl"
+  url "https://acme.example.com/acmectl-1.4.0.tar.gz"
+  sha256 "0000000000000000000000000000000000000000000000000000000000000000"
+  license "Apache-2.0"
+
+  bottle do
+    sha256 cellar: :any_skip_relocation, arm64_sonoma: "1111111111111111111111111111111111111111"
+  end
+
+  depends_on "go" => :build
+
+  def install
+    system "go", "build", *std_go_args
+  end
+end
+`
+
+func testBottle(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0o644, Size: int64(len(contents)), Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadBottle(t *testing.T) {
+	formula, err := ReadBottle(bytes.NewReader(testBottle(t, map[string]string{
+		"acmectl/1.4.0/bin/acmectl":          "binary",
+		"acmectl/1.4.0/.brew/acmectl.rb":     testFormula,
+		"acmectl/1.4.0/INSTALL_RECEIPT.json": "{}",
+	})), "acmectl", "1.4.0")
+	require.NoError(t, err)
+	assert.Equal(t, `Command-line client for "Acme" services`, formula.Desc)
+	assert.Equal(t, "https://acme.example.com/acmectl", formula.Homepage)
+	assert.Equal(t, "Apache-2.0", formula.License)
+	assert.Equal(t, testFormula, formula.Source)
+
+	_, err = ReadBottle(bytes.NewReader(testBottle(t, map[string]string{
+		"acmectl/1.3.0/.brew/acmectl.rb": testFormula,
+	})), "acmectl", "1.4.0")
+	assert.True(t, errors.Is(err, ErrInvalidBottle))
+
+	_, err = ReadBottle(bytes.NewReader([]byte("not a bottle")), "acmectl", "1.4.0")
+	assert.True(t, errors.Is(err, ErrInvalidBottle))
+}
+
+func TestParseBottleFilename(t *testing.T) {
+	name, version, tag, rebuild, err := ParseBottleFilename("python@3.12--3.12.4_1.arm64_sonoma.bottle.2.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, "python@3.12", name)
+	assert.Equal(t, "3.12.4_1", version)
+	assert.Equal(t, "arm64_sonoma", tag)
+	assert.Equal(t, 2, rebuild)
+	assert.Equal(t, "python@3.12--3.12.4_1.arm64_sonoma.bottle.2.tar.gz", BottleFilename(name, version, tag, rebuild))
+
+	name, version, tag, rebuild, err = ParseBottleFilename("acmectl--1.4.0.x86_64_linux.bottle.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []any{"acmectl", "1.4.0", "x86_64_linux", 0}, []any{name, version, tag, rebuild})
+
+	for _, filename := range []string{
+		"acmectl-1.4.0.x86_64_linux.bottle.tar.gz",
+		"acmectl--1.4.0.x86_64_linux.tar.gz",
+		"acmectl--1.4.0.x86_64_linux.bottle.0.tar.gz",
+		"acmectl--1.4.0.bottle.tar.gz",
+		"Acmectl--1.4.0.x86_64_linux.bottle.tar.gz",
+	} {
+		_, _, _, _, err = ParseBottleFilename(filename)
+		assert.Error(t, err, filename)
+	}
+}
+
+func TestGenerateFormula(t *testing.T) {
+	formula, err := ParseFormula(testFormula)
+	require.NoError(t, err)
+	source, err := GenerateFormula(*formula, "https://pkg.example.com/root/brew/homebrew/bottles", []Bottle{
+		{Tag: "arm64_sonoma", Cellar: CellarAny, Sha256: "aa"},
+		{Tag: "arm64_sonoma", Rebuild: 1, Cellar: CellarAny, Sha256: "bb"},
+		{Tag: "x86_64_linux", Rebuild: 1, Cellar: "/home/linuxbrew/.linuxbrew/Cellar", Sha256: "cc"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `class Acmectl < Formula
+  bottle do
+    root_url "https://pkg.example.com/root/brew/homebrew/bottles"
+    rebuild 1
+    sha256 cellar: :any, arm64_sonoma: "bb"
+    sha256 cellar: "/home/linuxbrew/.linuxbrew/Cellar", x86_64_linux: "cc"
+  end
+
+  desc "Command-line client for \"Acme\" services"
+  homepage "https://acme.example.com/acmectl"
+  url "https://acme.example.com/acmectl-1.4.0.tar.gz"
+  sha256 "0000000000000000000000000000000000000000000000000000000000000000"
+  license "Apache-2.0"
+
+  depends_on "go" => :build
+
+  def install
+    system "go", "build", *std_go_args
+  end
+end
+`, source)
+
+	_, err = ParseFormula("puts 'not a formula'")
+	assert.True(t, errors.Is(err, ErrInvalidBottle))
+}
+
+func TestValidateCellar(t *testing.T) {
+	assert.NoError(t, ValidateCellar(CellarAny))
+	assert.NoError(t, ValidateCellar(CellarAnySkipRelocation))
+	assert.NoError(t, ValidateCellar("/opt/homebrew/Cellar"))
+	assert.Error(t, ValidateCellar("any\"; system \"rm"))
+	assert.Error(t, ValidateCellar(""))
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	// FormatSafetensors is the format of safetensors weights, such as those of Hugging Face models.
+	FormatSafetensors = "safetensors"
+	// FormatONNX is the format of ONNX models.
+	FormatONNX = "onnx"
+	// FormatGGUF is the format of GGUF models, which llama.cpp runs.
+	FormatGGUF = "gguf"
+
+	// ModelCardFilename is the name of the file the model card of a version is read from, as on
+	// Hugging Face.
+	ModelCardFilename = "README.md"
+	// MaxModelCardSize is the size of the largest model card stored along with a version.
+	MaxModelCardSize = 1 << 20
+)
+
+var (
+	ErrInvalidModel = errors.New("invalid model")
+
+	namePattern      = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+	versionPattern   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._+-]*$`)
+	filenamePattern  = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+	frameworkPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+	formatsByExtension = map[string]string{
+		".safetensors": FormatSafetensors,
+		".onnx":        FormatONNX,
+		".gguf":        FormatGGUF,
+	}
+)
+
+// ValidateName checks the name of a model.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidModel, name)
+	}
+	return nil
+}
+
+// ValidateVersion checks a version of a model, such as 1.0.0 or 2024-06-01.
+func ValidateVersion(version string) error {
+	if !versionPattern.MatchString(version) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidModel, version)
+	}
+	return nil
+}
+
+// ValidateFilename checks the name of a file of a version, such as model-00001-of-00002.safetensors.
+func ValidateFilename(filename string) error {
+	if !filenamePattern.MatchString(filename) {
+		return fmt.Errorf("%w: invalid filename %q", ErrInvalidModel, filename)
+	}
+	return nil
+}
+
+// ValidateFramework checks the framework of a model, such as pytorch or transformers, which is
+// optional.
+func ValidateFramework(framework string) error {
+	if framework != "" && !frameworkPattern.MatchString(framework) {
+		return fmt.Errorf("%w: invalid framework %q", ErrInvalidModel, framework)
+	}
+	return nil
+}
+
+// Format returns the format of the weights in a file, or false for other files of a model such
+// as its configuration or tokenizer.
+func Format(filename string) (string, bool) {
+	format, ok := formatsByExtension[strings.ToLower(path.Ext(filename))]
+	return format, ok
+}
+
+// AddFormat adds the format of the weights in a file to the formats of a version.
+func AddFormat(formats []string, filename string) []string {
+	format, ok := Format(filename)
+	if !ok || slices.Contains(formats, format) {
+		return formats
+	}
+	return append(formats, format)
+}
+
+// IsModelCard reports whether a file is the model card of a version.
+func IsModelCard(filename string) bool {
+	return strings.EqualFold(filename, ModelCardFilename)
+}
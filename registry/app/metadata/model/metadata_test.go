@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, ValidateName("llama-3-8b"))
+	assert.ErrorIs(t, ValidateName("../llama"), ErrInvalidModel)
+	assert.NoError(t, ValidateVersion("1.0.0+q4"))
+	assert.ErrorIs(t, ValidateVersion(""), ErrInvalidModel)
+	assert.NoError(t, ValidateFilename("model-00001-of-00002.safetensors"))
+	assert.ErrorIs(t, ValidateFilename("weights/model.onnx"), ErrInvalidModel)
+	assert.NoError(t, ValidateFramework(""))
+	assert.NoError(t, ValidateFramework("llama.cpp"))
+	assert.ErrorIs(t, ValidateFramework("PyTorch"), ErrInvalidModel)
+}
+
+func TestFormat(t *testing.T) {
+	format, ok := Format("model-00001-of-00002.safetensors")
+	assert.True(t, ok)
+	assert.Equal(t, FormatSafetensors, format)
+	format, ok = Format("Model.ONNX")
+	assert.True(t, ok)
+	assert.Equal(t, FormatONNX, format)
+	_, ok = Format("config.json")
+	assert.False(t, ok)
+}
+
+func TestAddFormat(t *testing.T) {
+	formats := AddFormat(nil, "config.json")
+	assert.Empty(t, formats)
+	formats = AddFormat(formats, "model-00001-of-00002.safetensors")
+	formats = AddFormat(formats, "model-00002-of-00002.safetensors")
+	formats = AddFormat(formats, "model-q4.gguf")
+	assert.Equal(t, []string{FormatSafetensors, FormatGGUF}, formats)
+}
+
+func TestIsModelCard(t *testing.T) {
+	assert.True(t, IsModelCard("README.md"))
+	assert.True(t, IsModelCard("readme.md"))
+	assert.False(t, IsModelCard("README.txt"))
+}
@@ -0,0 +1,188 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// maxPackageJSONSize bounds the package.json read from a tarball.
+const maxPackageJSONSize = 4 << 20
+
+// ErrNoPackageJSON is returned by ExtractPackageJSON for tarballs without a package.json at their root.
+var ErrNoPackageJSON = errors.New("package.json not found in tarball")
+
+// PackageMetadata is the subset of package.json the npm client needs to resolve and install a version.
+// Source: https://docs.npmjs.com/cli/configuring-npm/package-json
+type PackageMetadata struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Description          string            `json:"description,omitempty"`
+	Keywords             []string          `json:"keywords,omitempty"`
+	Homepage             string            `json:"homepage,omitempty"`
+	License              string            `json:"license,omitempty"`
+	Author               json.RawMessage   `json:"author,omitempty"`
+	Repository           json.RawMessage   `json:"repository,omitempty"`
+	Bugs                 json.RawMessage   `json:"bugs,omitempty"`
+	Main                 string            `json:"main,omitempty"`
+	Bin                  json.RawMessage   `json:"bin,omitempty"`
+	Dependencies         map[string]string `json:"dependencies,omitempty"`
+	DevDependencies      map[string]string `json:"devDependencies,omitempty"`
+	PeerDependencies     map[string]string `json:"peerDependencies,omitempty"`
+	OptionalDependencies map[string]string `json:"optionalDependencies,omitempty"`
+	BundleDependencies   json.RawMessage   `json:"bundleDependencies,omitempty"`
+	PeerDependenciesMeta json.RawMessage   `json:"peerDependenciesMeta,omitempty"`
+	Engines              map[string]string `json:"engines,omitempty"`
+	OS                   []string          `json:"os,omitempty"`
+	CPU                  []string          `json:"cpu,omitempty"`
+	Deprecated           string            `json:"deprecated,omitempty"`
+	HasInstallScript     bool              `json:"hasInstallScript,omitempty"`
+}
+
+// ParsePackageJSON parses a package.json, deriving the fields npm computes at publish time.
+func ParsePackageJSON(data []byte) (*PackageMetadata, error) {
+	var raw struct {
+		PackageMetadata
+		License json.RawMessage   `json:"license,omitempty"`
+		Scripts map[string]string `json:"scripts,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid package.json: %w", err)
+	}
+	md := raw.PackageMetadata
+	md.License = parseLicense(raw.License)
+	for _, script := range []string{"preinstall", "install", "postinstall"} {
+		if raw.Scripts[script] != "" {
+			md.HasInstallScript = true
+		}
+	}
+	if md.Name == "" || md.Version == "" {
+		return nil, errors.New("package.json must have a name and a version")
+	}
+	return &md, nil
+}
+
+// parseLicense accepts both the SPDX expression and the deprecated {"type": ...} object form.
+func parseLicense(raw json.RawMessage) string {
+	var license string
+	if json.Unmarshal(raw, &license) == nil {
+		return license
+	}
+	var object struct {
+		Type string `json:"type"`
+	}
+	if json.Unmarshal(raw, &object) == nil {
+		return object.Type
+	}
+	return ""
+}
+
+// ExtractPackageJSON reads the metadata of a package from the package.json in its tarball. npm packs
+// packages into a single top level directory, usually "package".
+func ExtractPackageJSON(tarball io.Reader) (*PackageMetadata, error) {
+	gz, err := gzip.NewReader(tarball)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tarball: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, ErrNoPackageJSON
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid tarball: %w", err)
+		}
+		name := strings.TrimPrefix(path.Clean(header.Name), "/")
+		dir, file := path.Split(name)
+		if header.Typeflag != tar.TypeReg || file != "package.json" || strings.Count(dir, "/") != 1 {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxPackageJSONSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read package.json: %w", err)
+		}
+		if len(data) > maxPackageJSONSize {
+			return nil, errors.New("package.json is too large")
+		}
+		return ParsePackageJSON(data)
+	}
+}
+
+// ValidatePackageName checks a package name against the rules of the npm registry for new packages.
+func ValidatePackageName(name string) error {
+	if name == "" || len(name) > 214 {
+		return fmt.Errorf("invalid package name %q: length must be between 1 and 214", name)
+	}
+	if strings.ToLower(name) != name {
+		return fmt.Errorf("invalid package name %q: must be lowercase", name)
+	}
+	scope, pkg := "", name
+	if strings.HasPrefix(name, "@") {
+		var ok bool
+		scope, pkg, ok = strings.Cut(name[1:], "/")
+		if !ok || scope == "" || !isURLSafe(scope) {
+			return fmt.Errorf("invalid package name %q: invalid scope", name)
+		}
+	}
+	if pkg == "" || strings.HasPrefix(pkg, ".") || strings.HasPrefix(pkg, "_") || !isURLSafe(pkg) {
+		return fmt.Errorf("invalid package name %q", name)
+	}
+	return nil
+}
+
+// ValidateScope checks an npm scope, like @mycorp.
+func ValidateScope(scope string) error {
+	name, ok := strings.CutPrefix(scope, "@")
+	if !ok || name == "" || strings.ToLower(name) != name || !isURLSafe(name) {
+		return fmt.Errorf("invalid npm scope %q", scope)
+	}
+	return nil
+}
+
+// Scope returns the scope of a package name, like @mycorp for @mycorp/utils, or empty for unscoped packages.
+func Scope(name string) string {
+	if !strings.HasPrefix(name, "@") {
+		return ""
+	}
+	scope, _, _ := strings.Cut(name, "/")
+	return scope
+}
+
+func isURLSafe(s string) bool {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && !strings.ContainsRune("-._~", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// TarballName returns the file name npm uses for the tarball of a version, without the scope.
+func TarballName(name, version string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name + "-" + version + ".tgz"
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestExtractPackageJSON(t *testing.T) {
+	md, err := ExtractPackageJSON(bytes.NewReader(tarball(t, map[string]string{
+		"package/lib/package.json": `{"name": "nested", "version": "0.0.1"}`,
+		"package/package.json": `{
+			"name": "@acme/left-pad", "version": "1.2.0", "license": {"type": "MIT"},
+			"dependencies": {"lodash": "^4.17.0"}, "scripts": {"postinstall": "node setup.js"}
+		}`,
+	})))
+	require.NoError(t, err)
+	assert.Equal(t, "@acme/left-pad", md.Name)
+	assert.Equal(t, "1.2.0", md.Version)
+	assert.Equal(t, "MIT", md.License)
+	assert.Equal(t, map[string]string{"lodash": "^4.17.0"}, md.Dependencies)
+	assert.True(t, md.HasInstallScript)
+
+	_, err = ExtractPackageJSON(bytes.NewReader(tarball(t, map[string]string{"package/index.js": ""})))
+	assert.ErrorIs(t, err, ErrNoPackageJSON)
+
+	_, err = ExtractPackageJSON(bytes.NewReader(tarball(t, map[string]string{"package/package.json": `{}`})))
+	assert.Error(t, err)
+
+	_, err = ExtractPackageJSON(bytes.NewReader([]byte("not a tarball")))
+	assert.Error(t, err)
+}
+
+func TestValidatePackageName(t *testing.T) {
+	for _, name := range []string{"left-pad", "@acme/left-pad", "lodash.merge", "a~b"} {
+		assert.NoError(t, ValidatePackageName(name), name)
+	}
+	for _, name := range []string{"", "Left-Pad", "@acme", "@/pkg", ".hidden", "_private", "a b", "@acme/a/b"} {
+		assert.Error(t, ValidatePackageName(name), name)
+	}
+}
+
+func TestTarballName(t *testing.T) {
+	assert.Equal(t, "left-pad-1.2.0.tgz", TarballName("left-pad", "1.2.0"))
+	assert.Equal(t, "left-pad-1.2.0.tgz", TarballName("@acme/left-pad", "1.2.0"))
+}
+
+func TestScope(t *testing.T) {
+	assert.Equal(t, "@acme", Scope("@acme/left-pad"))
+	assert.Empty(t, Scope("left-pad"))
+	assert.NoError(t, ValidateScope("@acme"))
+	for _, scope := range []string{"", "@", "acme", "@Acme", "@acme/left-pad"} {
+		assert.Error(t, ValidateScope(scope), scope)
+	}
+}
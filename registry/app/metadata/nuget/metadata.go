@@ -0,0 +1,236 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxNuspecSize bounds the .nuspec read from a package.
+const maxNuspecSize = 4 << 20
+
+// ErrNoNuspec is returned by ExtractNuspec for packages without a .nuspec at their root.
+var ErrNoNuspec = errors.New(".nuspec not found in package")
+
+var packageIDPattern = regexp.MustCompile(`^\w+([.-]\w+)*$`)
+
+// Metadata is the subset of the .nuspec manifest of a package the registry shows and serves to clients.
+// Source: https://learn.microsoft.com/en-us/nuget/reference/nuspec
+type Metadata struct {
+	ID                       string            `json:"id"`
+	Version                  string            `json:"version"`
+	Title                    string            `json:"title,omitempty"`
+	Description              string            `json:"description,omitempty"`
+	Summary                  string            `json:"summary,omitempty"`
+	Authors                  string            `json:"authors,omitempty"`
+	Owners                   string            `json:"owners,omitempty"`
+	ProjectURL               string            `json:"projectUrl,omitempty"`
+	IconURL                  string            `json:"iconUrl,omitempty"`
+	LicenseURL               string            `json:"licenseUrl,omitempty"`
+	LicenseExpression        string            `json:"licenseExpression,omitempty"`
+	RequireLicenseAcceptance bool              `json:"requireLicenseAcceptance,omitempty"`
+	ReleaseNotes             string            `json:"releaseNotes,omitempty"`
+	Copyright                string            `json:"copyright,omitempty"`
+	Tags                     []string          `json:"tags,omitempty"`
+	DependencyGroups         []DependencyGroup `json:"dependencyGroups,omitempty"`
+}
+
+// DependencyGroup lists the dependencies of a package for a target framework.
+type DependencyGroup struct {
+	TargetFramework string       `json:"targetFramework,omitempty"`
+	Dependencies    []Dependency `json:"dependencies"`
+}
+
+type Dependency struct {
+	ID    string `json:"id"`
+	Range string `json:"range,omitempty"`
+}
+
+type nuspecDependency struct {
+	ID      string `xml:"id,attr"`
+	Version string `xml:"version,attr"`
+}
+
+// nuspec is the package manifest. The XML namespace depends on the schema version the package
+// was packed with, so elements are matched by their local name only.
+type nuspec struct {
+	Metadata struct {
+		ID                       string `xml:"id"`
+		Version                  string `xml:"version"`
+		Title                    string `xml:"title"`
+		Description              string `xml:"description"`
+		Summary                  string `xml:"summary"`
+		Authors                  string `xml:"authors"`
+		Owners                   string `xml:"owners"`
+		ProjectURL               string `xml:"projectUrl"`
+		IconURL                  string `xml:"iconUrl"`
+		LicenseURL               string `xml:"licenseUrl"`
+		RequireLicenseAcceptance bool   `xml:"requireLicenseAcceptance"`
+		ReleaseNotes             string `xml:"releaseNotes"`
+		Copyright                string `xml:"copyright"`
+		Tags                     string `xml:"tags"`
+		License                  struct {
+			Type  string `xml:"type,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"license"`
+		Dependencies struct {
+			Groups []struct {
+				TargetFramework string             `xml:"targetFramework,attr"`
+				Dependencies    []nuspecDependency `xml:"dependency"`
+			} `xml:"group"`
+			Dependencies []nuspecDependency `xml:"dependency"`
+		} `xml:"dependencies"`
+	} `xml:"metadata"`
+}
+
+// ParseNuspec parses a .nuspec manifest.
+func ParseNuspec(data []byte) (*Metadata, error) {
+	var spec nuspec
+	if err := xml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("invalid .nuspec: %w", err)
+	}
+	m := spec.Metadata
+	md := &Metadata{
+		ID:                       strings.TrimSpace(m.ID),
+		Version:                  strings.TrimSpace(m.Version),
+		Title:                    strings.TrimSpace(m.Title),
+		Description:              strings.TrimSpace(m.Description),
+		Summary:                  strings.TrimSpace(m.Summary),
+		Authors:                  strings.TrimSpace(m.Authors),
+		Owners:                   strings.TrimSpace(m.Owners),
+		ProjectURL:               strings.TrimSpace(m.ProjectURL),
+		IconURL:                  strings.TrimSpace(m.IconURL),
+		LicenseURL:               strings.TrimSpace(m.LicenseURL),
+		RequireLicenseAcceptance: m.RequireLicenseAcceptance,
+		ReleaseNotes:             strings.TrimSpace(m.ReleaseNotes),
+		Copyright:                strings.TrimSpace(m.Copyright),
+		Tags:                     strings.Fields(m.Tags),
+	}
+	if m.License.Type == "expression" {
+		md.LicenseExpression = strings.TrimSpace(m.License.Value)
+	}
+	for _, g := range m.Dependencies.Groups {
+		md.DependencyGroups = append(md.DependencyGroups, DependencyGroup{
+			TargetFramework: g.TargetFramework,
+			Dependencies:    toDependencies(g.Dependencies),
+		})
+	}
+	// the flat list of dependencies predates groups and applies to all frameworks
+	if len(m.Dependencies.Dependencies) > 0 {
+		md.DependencyGroups = append(md.DependencyGroups, DependencyGroup{
+			Dependencies: toDependencies(m.Dependencies.Dependencies),
+		})
+	}
+	if md.ID == "" || md.Version == "" {
+		return nil, errors.New(".nuspec must have an id and a version")
+	}
+	return md, nil
+}
+
+func toDependencies(deps []nuspecDependency) []Dependency {
+	result := make([]Dependency, 0, len(deps))
+	for _, d := range deps {
+		result = append(result, Dependency{ID: d.ID, Range: d.Version})
+	}
+	return result
+}
+
+// ExtractNuspec reads the metadata of a package from the .nuspec at the root of the .nupkg, and
+// returns the raw manifest as well so it can be served as is.
+func ExtractNuspec(r io.ReaderAt, size int64) (*Metadata, []byte, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid package: %w", err)
+	}
+	for _, f := range zr.File {
+		if strings.Contains(f.Name, "/") || !strings.HasSuffix(strings.ToLower(f.Name), ".nuspec") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open .nuspec: %w", err)
+		}
+		data, err := io.ReadAll(io.LimitReader(rc, maxNuspecSize+1))
+		rc.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read .nuspec: %w", err)
+		}
+		if len(data) > maxNuspecSize {
+			return nil, nil, errors.New(".nuspec is too large")
+		}
+		md, err := ParseNuspec(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		return md, data, nil
+	}
+	return nil, nil, ErrNoNuspec
+}
+
+// ValidatePackageID checks a package id against the rules of nuget.org.
+func ValidatePackageID(id string) error {
+	if len(id) > 100 || !packageIDPattern.MatchString(id) {
+		return fmt.Errorf("invalid package id %q", id)
+	}
+	return nil
+}
+
+// NormalizeVersion returns the normalized form of a NuGet version: build metadata is dropped,
+// leading zeros are removed, missing components are zero and a zero fourth component is dropped.
+// Source: https://learn.microsoft.com/en-us/nuget/concepts/package-versioning#normalized-version-numbers
+func NormalizeVersion(version string) (string, error) {
+	v, _, _ := strings.Cut(strings.TrimSpace(version), "+")
+	numbers, label, hasLabel := strings.Cut(v, "-")
+	parts := strings.Split(numbers, ".")
+	if len(parts) > 4 || (hasLabel && label == "") {
+		return "", fmt.Errorf("invalid version %q", version)
+	}
+	normalized := make([]string, 0, 4)
+	for _, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid version %q", version)
+		}
+		normalized = append(normalized, strconv.FormatUint(n, 10))
+	}
+	for len(normalized) < 3 {
+		normalized = append(normalized, "0")
+	}
+	if len(normalized) == 4 && normalized[3] == "0" {
+		normalized = normalized[:3]
+	}
+	result := strings.Join(normalized, ".")
+	if hasLabel {
+		result += "-" + label
+	}
+	return result, nil
+}
+
+// PackageFilename returns the name of the .nupkg of a version in the package base address.
+func PackageFilename(id, version string) string {
+	return strings.ToLower(id + "." + version + ".nupkg")
+}
+
+// NuspecFilename returns the name of the .nuspec of a package in the package base address.
+func NuspecFilename(id string) string {
+	return strings.ToLower(id) + ".nuspec"
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNuspec = `<?xml version="1.0" encoding="utf-8"?>
+<package xmlns="http://schemas.microsoft.com/packaging/2013/05/nuspec.xsd">
+  <metadata>
+    <id>Acme.Logging</id>
+    <version>1.2.0</version>
+    <authors>Acme</authors>
+    <description>Structured logging.</description>
+    <license type="expression">MIT</license>
+    <tags>logging diagnostics</tags>
+    <dependencies>
+      <group targetFramework="net8.0">
+        <dependency id="Acme.Core" version="[2.0.0, )" />
+      </group>
+    </dependencies>
+  </metadata>
+</package>`
+
+func nupkg(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestExtractNuspec(t *testing.T) {
+	data := nupkg(t, map[string]string{
+		"lib/net8.0/other.nuspec": "<package/>",
+		"Acme.Logging.nuspec":     testNuspec,
+	})
+	md, raw, err := ExtractNuspec(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	assert.Equal(t, testNuspec, string(raw))
+	assert.Equal(t, "Acme.Logging", md.ID)
+	assert.Equal(t, "1.2.0", md.Version)
+	assert.Equal(t, "MIT", md.LicenseExpression)
+	assert.Equal(t, []string{"logging", "diagnostics"}, md.Tags)
+	assert.Equal(t, []DependencyGroup{{
+		TargetFramework: "net8.0",
+		Dependencies:    []Dependency{{ID: "Acme.Core", Range: "[2.0.0, )"}},
+	}}, md.DependencyGroups)
+
+	data = nupkg(t, map[string]string{"lib/net8.0/Acme.Logging.dll": "dll"})
+	_, _, err = ExtractNuspec(bytes.NewReader(data), int64(len(data)))
+	assert.ErrorIs(t, err, ErrNoNuspec)
+}
+
+func TestNormalizeVersion(t *testing.T) {
+	for version, expected := range map[string]string{
+		"1.0":              "1.0.0",
+		"1.00.01":          "1.0.1",
+		"1.0.0.0":          "1.0.0",
+		"1.0.0.4":          "1.0.0.4",
+		"1.0.0-Beta.1+abc": "1.0.0-Beta.1",
+	} {
+		normalized, err := NormalizeVersion(version)
+		require.NoError(t, err, version)
+		assert.Equal(t, expected, normalized, version)
+	}
+	for _, version := range []string{"", "1.0.0.0.0", "1.x", "1.0-"} {
+		_, err := NormalizeVersion(version)
+		assert.Error(t, err, version)
+	}
+}
+
+func TestValidatePackageID(t *testing.T) {
+	assert.NoError(t, ValidatePackageID("Acme.Logging-Extensions_2"))
+	assert.Error(t, ValidatePackageID(""))
+	assert.Error(t, ValidatePackageID("Acme..Logging"))
+	assert.Error(t, ValidatePackageID("Acme/Logging"))
+}
@@ -0,0 +1,247 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/harness/gitness/registry/utils/versioning"
+
+	"github.com/Masterminds/semver/v3"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// PubspecFile is the file describing a package at the root of its archive.
+	PubspecFile = "pubspec.yaml"
+	// maxPubspecSize bounds the pubspec read from an archive.
+	maxPubspecSize = 1 << 20
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid package")
+
+	// namePattern matches the names pub accepts, lowercase Dart identifiers.
+	namePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+)
+
+// Pubspec is what the registry shows of the pubspec of a published version.
+// Source: https://dart.dev/tools/pub/pubspec
+type Pubspec struct {
+	Name          string            `json:"name"`
+	Version       string            `json:"version"`
+	Description   string            `json:"description,omitempty"`
+	Homepage      string            `json:"homepage,omitempty"`
+	Repository    string            `json:"repository,omitempty"`
+	IssueTracker  string            `json:"issue_tracker,omitempty"`
+	Documentation string            `json:"documentation,omitempty"`
+	Topics        []string          `json:"topics,omitempty"`
+	Environment   map[string]string `json:"environment,omitempty"`
+	// Dependencies are the names of the dependencies followed by their constraint or source, as
+	// "http ^1.2.0" or "flutter (sdk: flutter)", sorted by name.
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// Archive is a package archive with its pubspec, along with the pubspec converted to the JSON the
+// repository API serves.
+type Archive struct {
+	Pubspec
+	Raw json.RawMessage
+}
+
+// ValidateName validates the name of a package.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: %q isn't a valid package name", ErrInvalidPackage, name)
+	}
+	return nil
+}
+
+// ValidateVersion validates a version, which pub requires to be a semantic version.
+func ValidateVersion(version string) error {
+	if _, err := semver.StrictNewVersion(version); err != nil {
+		return fmt.Errorf("%w: %q isn't a semantic version", ErrInvalidPackage, version)
+	}
+	return nil
+}
+
+// ArchiveFilename returns the name the archive of a version is stored and served with.
+func ArchiveFilename(name, version string) string {
+	return name + "-" + version + ".tar.gz"
+}
+
+// ReadArchive reads the pubspec at the root of a gzipped package archive.
+func ReadArchive(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: archive isn't gzipped: %w", ErrInvalidPackage, err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: %s not found in archive", ErrInvalidPackage, PubspecFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read archive: %w", ErrInvalidPackage, err)
+		}
+		if header.Typeflag != tar.TypeReg || path.Clean(header.Name) != PubspecFile {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxPubspecSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalidPackage, PubspecFile, err)
+		}
+		if len(data) > maxPubspecSize {
+			return nil, fmt.Errorf("%w: %s is too large", ErrInvalidPackage, PubspecFile)
+		}
+		return ParsePubspec(data)
+	}
+}
+
+// ParsePubspec parses a pubspec, which must name the package and its version.
+func ParsePubspec(data []byte) (*Archive, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: invalid %s: %w", ErrInvalidPackage, PubspecFile, err)
+	}
+	raw, err := json.Marshal(jsonValue(doc))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid %s: %w", ErrInvalidPackage, PubspecFile, err)
+	}
+
+	archive := &Archive{
+		Pubspec: Pubspec{
+			Name:          stringValue(doc["name"]),
+			Version:       stringValue(doc["version"]),
+			Description:   strings.TrimSpace(stringValue(doc["description"])),
+			Homepage:      stringValue(doc["homepage"]),
+			Repository:    stringValue(doc["repository"]),
+			IssueTracker:  stringValue(doc["issue_tracker"]),
+			Documentation: stringValue(doc["documentation"]),
+		},
+		Raw: raw,
+	}
+	if err = ValidateName(archive.Name); err != nil {
+		return nil, err
+	}
+	if err = ValidateVersion(archive.Version); err != nil {
+		return nil, err
+	}
+	if topics, ok := doc["topics"].([]any); ok {
+		for _, topic := range topics {
+			archive.Topics = append(archive.Topics, stringValue(topic))
+		}
+	}
+	if environment, ok := doc["environment"].(map[string]any); ok {
+		archive.Environment = make(map[string]string, len(environment))
+		for key, value := range environment {
+			archive.Environment[key] = stringValue(value)
+		}
+	}
+	if dependencies, ok := doc["dependencies"].(map[string]any); ok {
+		for name, source := range dependencies {
+			archive.Dependencies = append(archive.Dependencies, name+" "+dependencySource(source))
+		}
+		sort.Strings(archive.Dependencies)
+	}
+	return archive, nil
+}
+
+// dependencySource describes the constraint of a dependency, or its source for dependencies
+// that aren't hosted.
+func dependencySource(source any) string {
+	switch s := source.(type) {
+	case nil:
+		return "any"
+	case map[string]any:
+		if version, ok := s["version"]; ok {
+			return stringValue(version)
+		}
+		if sdk, ok := s["sdk"]; ok {
+			return "(sdk: " + stringValue(sdk) + ")"
+		}
+		keys := make([]string, 0, len(s))
+		for key := range s {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return "(" + strings.Join(keys, ", ") + ")"
+	default:
+		return stringValue(s)
+	}
+}
+
+// jsonValue converts a decoded YAML value to one JSON can encode, with string keys only.
+func jsonValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, element := range v {
+			m[key] = jsonValue(element)
+		}
+		return m
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, element := range v {
+			m[fmt.Sprint(key)] = jsonValue(element)
+		}
+		return m
+	case []any:
+		l := make([]any, len(v))
+		for i, element := range v {
+			l[i] = jsonValue(element)
+		}
+		return l
+	default:
+		return v
+	}
+}
+
+func stringValue(value any) string {
+	if value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// LatestVersion returns the version pub considers the latest: the highest that isn't a
+// pre-release, or the highest of all if they all are.
+func LatestVersion(versions []string) string {
+	sorted := append([]string(nil), versions...)
+	versioning.Sort(versioning.SchemeSemver, sorted)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if v, err := semver.NewVersion(sorted[i]); err == nil && v.Prerelease() == "" {
+			return sorted[i]
+		}
+	}
+	if len(sorted) == 0 {
+		return ""
+	}
+	return sorted[len(sorted)-1]
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPubspec = `name: shelf_auth
+version: 1.2.0
+description: >
+  Authentication middleware for shelf.
+homepage: https://example.com/shelf_auth
+repository: https://github.com/acme/shelf_auth
+topics:
+  - server
+  - auth
+environment:
+  sdk: ^3.0.0
+dependencies:
+  shelf: ^1.4.0
+  meta:
+  collection:
+    version: ">=1.17.0 <2.0.0"
+  flutter:
+    sdk: flutter
+  path_tools:
+    git: https://github.com/acme/path_tools
+`
+
+func testArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0o644, Size: int64(len(contents)), Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadArchive(t *testing.T) {
+	archive, err := ReadArchive(bytes.NewReader(testArchive(t, map[string]string{
+		"./pubspec.yaml":       testPubspec,
+		"lib/shelf_auth.dart":  "library shelf_auth;",
+		"example/pubspec.yaml": "name: example\nversion: 0.0.1\n",
+	})))
+	require.NoError(t, err)
+
+	assert.Equal(t, "shelf_auth", archive.Name)
+	assert.Equal(t, "1.2.0", archive.Version)
+	assert.Equal(t, "Authentication middleware for shelf.", archive.Description)
+	assert.Equal(t, "https://github.com/acme/shelf_auth", archive.Repository)
+	assert.Equal(t, []string{"server", "auth"}, archive.Topics)
+	assert.Equal(t, map[string]string{"sdk": "^3.0.0"}, archive.Environment)
+	assert.Equal(t, []string{
+		"collection >=1.17.0 <2.0.0",
+		"flutter (sdk: flutter)",
+		"meta any",
+		"path_tools (git)",
+		"shelf ^1.4.0",
+	}, archive.Dependencies)
+	assert.JSONEq(t, `{
+		"name": "shelf_auth",
+		"version": "1.2.0",
+		"description": "Authentication middleware for shelf.\n",
+		"homepage": "https://example.com/shelf_auth",
+		"repository": "https://github.com/acme/shelf_auth",
+		"topics": ["server", "auth"],
+		"environment": {"sdk": "^3.0.0"},
+		"dependencies": {
+			"shelf": "^1.4.0",
+			"meta": null,
+			"collection": {"version": ">=1.17.0 <2.0.0"},
+			"flutter": {"sdk": "flutter"},
+			"path_tools": {"git": "https://github.com/acme/path_tools"}
+		}
+	}`, string(archive.Raw))
+}
+
+func TestReadArchiveInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{name: "missing pubspec", files: map[string]string{"lib/a.dart": ""}},
+		{name: "invalid name", files: map[string]string{"pubspec.yaml": "name: shelf-auth\nversion: 1.0.0\n"}},
+		{name: "invalid version", files: map[string]string{"pubspec.yaml": "name: shelf_auth\nversion: 1.0\n"}},
+		{name: "invalid yaml", files: map[string]string{"pubspec.yaml": "name: [shelf_auth\n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadArchive(bytes.NewReader(testArchive(t, tt.files)))
+			assert.True(t, errors.Is(err, ErrInvalidPackage), err)
+		})
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	assert.Equal(t, "1.10.0", LatestVersion([]string{"1.2.0", "1.10.0", "2.0.0-beta.1"}))
+	assert.Equal(t, "2.0.0-beta.2", LatestVersion([]string{"2.0.0-beta.1", "2.0.0-beta.2"}))
+	assert.Equal(t, "1.2.0", LatestVersion([]string{"1.2.0", "nightly"}))
+	assert.Equal(t, "", LatestVersion(nil))
+}
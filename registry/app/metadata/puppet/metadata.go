@@ -0,0 +1,250 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/harness/gitness/registry/utils/versioning"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// MetadataFile is the file describing a module in the top directory of its archive.
+	MetadataFile = "metadata.json"
+	// maxMetadataSize bounds the metadata read from an archive.
+	maxMetadataSize = 1 << 20
+	archiveSuffix   = ".tar.gz"
+)
+
+var (
+	ErrInvalidModule = errors.New("invalid module")
+
+	// ownerPattern and namePattern match the owners and names of modules the Forge accepts.
+	ownerPattern = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	namePattern  = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// Metadata is the metadata.json of a module.
+// Source: https://www.puppet.com/docs/puppet/latest/modules_metadata.html
+type Metadata struct {
+	Name                   string            `json:"name"`
+	Version                string            `json:"version"`
+	Author                 string            `json:"author,omitempty"`
+	Summary                string            `json:"summary,omitempty"`
+	License                string            `json:"license,omitempty"`
+	Source                 string            `json:"source,omitempty"`
+	ProjectPage            string            `json:"project_page,omitempty"`
+	IssuesURL              string            `json:"issues_url,omitempty"`
+	Dependencies           []Dependency      `json:"dependencies"`
+	Requirements           []Dependency      `json:"requirements,omitempty"`
+	OperatingSystemSupport []OperatingSystem `json:"operatingsystem_support,omitempty"`
+	Tags                   []string          `json:"tags,omitempty"`
+}
+
+// Dependency is a module, or a requirement such as puppet itself, and the versions it's
+// compatible with.
+type Dependency struct {
+	Name               string `json:"name"`
+	VersionRequirement string `json:"version_requirement,omitempty"`
+}
+
+type OperatingSystem struct {
+	OperatingSystem string   `json:"operatingsystem"`
+	Releases        []string `json:"operatingsystemrelease,omitempty"`
+}
+
+// ValidateOwner validates the owner, the user or organization, of a module.
+func ValidateOwner(owner string) error {
+	if !ownerPattern.MatchString(owner) {
+		return fmt.Errorf("%w: %q isn't a valid owner", ErrInvalidModule, owner)
+	}
+	return nil
+}
+
+// ValidateName validates the name of a module without its owner.
+func ValidateName(name string) error {
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: %q isn't a valid module name", ErrInvalidModule, name)
+	}
+	return nil
+}
+
+// ValidateVersion validates a version, which the Forge requires to be a semantic version.
+func ValidateVersion(version string) error {
+	if _, err := semver.StrictNewVersion(version); err != nil {
+		return fmt.Errorf("%w: %q isn't a semantic version", ErrInvalidModule, version)
+	}
+	return nil
+}
+
+// Slug returns the slug a module is stored and looked up under, such as puppetlabs-stdlib.
+func Slug(owner, name string) string {
+	return owner + "-" + name
+}
+
+// SplitSlug splits the slug of a module into its owner and name. Modules are also named with a
+// slash, as in puppetlabs/stdlib.
+func SplitSlug(slug string) (string, string, error) {
+	owner, name, ok := strings.Cut(slug, "-")
+	if !ok {
+		owner, name, ok = strings.Cut(slug, "/")
+	}
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q isn't the slug of a module", ErrInvalidModule, slug)
+	}
+	if err := ValidateOwner(owner); err != nil {
+		return "", "", err
+	}
+	if err := ValidateName(name); err != nil {
+		return "", "", err
+	}
+	return owner, name, nil
+}
+
+// ReleaseSlug returns the slug of a version of a module, such as puppetlabs-stdlib-9.0.0.
+func ReleaseSlug(owner, name, version string) string {
+	return Slug(owner, name) + "-" + version
+}
+
+// ParseReleaseSlug returns the owner, the name and the version of a release. Owners and names
+// can't hold hyphens, so the version is what follows the second one.
+func ParseReleaseSlug(slug string) (string, string, string, error) {
+	parts := strings.SplitN(slug, "-", 3)
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("%w: %q isn't the slug of a release", ErrInvalidModule, slug)
+	}
+	if err := ValidateOwner(parts[0]); err != nil {
+		return "", "", "", err
+	}
+	if err := ValidateName(parts[1]); err != nil {
+		return "", "", "", err
+	}
+	if err := ValidateVersion(parts[2]); err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// ArchiveFilename returns the name puppet module build and the Forge name the archive of a
+// release with.
+func ArchiveFilename(owner, name, version string) string {
+	return ReleaseSlug(owner, name, version) + archiveSuffix
+}
+
+// ParseArchiveFilename returns the owner, the name and the version of an archive.
+func ParseArchiveFilename(filename string) (string, string, string, error) {
+	slug, ok := strings.CutSuffix(filename, archiveSuffix)
+	if !ok {
+		return "", "", "", fmt.Errorf("%w: %q isn't the archive of a module", ErrInvalidModule, filename)
+	}
+	return ParseReleaseSlug(slug)
+}
+
+// ReadArchive reads the metadata in the top directory of a module archive.
+func ReadArchive(r io.Reader) (*Metadata, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: archive isn't gzipped: %w", ErrInvalidModule, err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: %s not found in archive", ErrInvalidModule, MetadataFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read archive: %w", ErrInvalidModule, err)
+		}
+		dir, file := path.Split(path.Clean(header.Name))
+		if header.Typeflag != tar.TypeReg || file != MetadataFile || strings.Count(dir, "/") != 1 {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxMetadataSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalidModule, MetadataFile, err)
+		}
+		if len(data) > maxMetadataSize {
+			return nil, fmt.Errorf("%w: %s is too large", ErrInvalidModule, MetadataFile)
+		}
+		return ParseMetadata(data)
+	}
+}
+
+// ParseMetadata parses the metadata of a module, which must name it and its version. The name is
+// normalized to the slug of the module.
+func ParseMetadata(data []byte) (*Metadata, error) {
+	var metadata Metadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("%w: invalid %s: %w", ErrInvalidModule, MetadataFile, err)
+	}
+	owner, name, err := SplitSlug(metadata.Name)
+	if err != nil {
+		return nil, err
+	}
+	metadata.Name = Slug(owner, name)
+	if err = ValidateVersion(metadata.Version); err != nil {
+		return nil, err
+	}
+	for i, dependency := range metadata.Dependencies {
+		owner, name, err := SplitSlug(dependency.Name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dependency: %w", err)
+		}
+		metadata.Dependencies[i].Name = Slug(owner, name)
+	}
+	if metadata.Dependencies == nil {
+		metadata.Dependencies = []Dependency{}
+	}
+	return &metadata, nil
+}
+
+// Owner returns the owner in the normalized name of a module.
+func (m Metadata) Owner() string {
+	owner, _, _ := strings.Cut(m.Name, "-")
+	return owner
+}
+
+// ModuleName returns the name of a module without its owner.
+func (m Metadata) ModuleName() string {
+	_, name, _ := strings.Cut(m.Name, "-")
+	return name
+}
+
+// CurrentVersion returns the version the Forge presents as current: the highest that isn't a
+// pre-release, or the highest of all if they all are.
+func CurrentVersion(versions []string) string {
+	sorted := append([]string(nil), versions...)
+	versioning.Sort(versioning.SchemeSemver, sorted)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if v, err := semver.NewVersion(sorted[i]); err == nil && v.Prerelease() == "" {
+			return sorted[i]
+		}
+	}
+	if len(sorted) == 0 {
+		return ""
+	}
+	return sorted[len(sorted)-1]
+}
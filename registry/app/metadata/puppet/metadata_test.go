@@ -0,0 +1,125 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMetadata = `{
+  "name": "acme/ntp",
+  "version": "1.2.0",
+  "author": "acme",
+  "summary": "Manages the NTP service.",
+  "license": "Apache-2.0",
+  "source": "https://github.com/acme/puppet-ntp",
+  "dependencies": [
+    {"name": "puppetlabs/stdlib", "version_requirement": ">= 4.13.1 < 10.0.0"}
+  ],
+  "requirements": [{"name": "puppet", "version_requirement": ">= 7.0.0 < 9.0.0"}],
+  "operatingsystem_support": [{"operatingsystem": "Debian", "operatingsystemrelease": ["11", "12"]}]
+}`
+
+func testArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, contents := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0o644, Size: int64(len(contents)), Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadArchive(t *testing.T) {
+	metadata, err := ReadArchive(bytes.NewReader(testArchive(t, map[string]string{
+		"acme-ntp-1.2.0/manifests/init.pp":               "class ntp {}",
+		"acme-ntp-1.2.0/metadata.json":                   testMetadata,
+		"acme-ntp-1.2.0/spec/fixtures/ntp/metadata.json": "{}",
+	})))
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme-ntp", metadata.Name)
+	assert.Equal(t, "acme", metadata.Owner())
+	assert.Equal(t, "ntp", metadata.ModuleName())
+	assert.Equal(t, "1.2.0", metadata.Version)
+	assert.Equal(t, []Dependency{{Name: "puppetlabs-stdlib", VersionRequirement: ">= 4.13.1 < 10.0.0"}},
+		metadata.Dependencies)
+	assert.Equal(t, []string{"11", "12"}, metadata.OperatingSystemSupport[0].Releases)
+}
+
+func TestReadArchiveInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{
+		{name: "missing metadata", files: map[string]string{"acme-ntp-1.0.0/README.md": ""}},
+		{name: "metadata outside the top directory", files: map[string]string{MetadataFile: testMetadata}},
+		{name: "invalid name", files: map[string]string{"m/" + MetadataFile: `{"name": "ntp", "version": "1.0.0"}`}},
+		{name: "invalid version", files: map[string]string{
+			"m/" + MetadataFile: `{"name": "acme-ntp", "version": "1"}`}},
+		{name: "invalid dependency", files: map[string]string{"m/" + MetadataFile: `{"name": "acme-ntp",
+			"version": "1.0.0", "dependencies": [{"name": "stdlib"}]}`}},
+		{name: "invalid json", files: map[string]string{"m/" + MetadataFile: "{"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadArchive(bytes.NewReader(testArchive(t, tt.files)))
+			assert.True(t, errors.Is(err, ErrInvalidModule), err)
+		})
+	}
+}
+
+func TestParseArchiveFilename(t *testing.T) {
+	owner, name, version, err := ParseArchiveFilename("acme-ntp-2.0.0-rc.1.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme", "ntp", "2.0.0-rc.1"}, []string{owner, name, version})
+	assert.Equal(t, "acme-ntp-2.0.0-rc.1.tar.gz", ArchiveFilename(owner, name, version))
+
+	for _, filename := range []string{"acme-ntp.tar.gz", "acme-ntp-1.0.0.zip", "acme-Ntp-1.0.0.tar.gz"} {
+		_, _, _, err = ParseArchiveFilename(filename)
+		assert.Error(t, err, filename)
+	}
+}
+
+func TestSplitSlug(t *testing.T) {
+	for _, slug := range []string{"acme-ntp", "acme/ntp"} {
+		owner, name, err := SplitSlug(slug)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"acme", "ntp"}, []string{owner, name})
+	}
+	_, _, err := SplitSlug("ntp")
+	assert.Error(t, err)
+}
+
+func TestCurrentVersion(t *testing.T) {
+	assert.Equal(t, "1.10.0", CurrentVersion([]string{"1.2.0", "1.10.0", "2.0.0-beta.1"}))
+	assert.Equal(t, "2.0.0-beta.2", CurrentVersion([]string{"2.0.0-beta.1", "2.0.0-beta.2"}))
+	assert.Equal(t, "1.2.0", CurrentVersion([]string{"1.2.0", "nightly"}))
+	assert.Equal(t, "", CurrentVersion(nil))
+}
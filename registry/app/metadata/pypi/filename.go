@@ -0,0 +1,112 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	FileTypeWheel = "bdist_wheel"
+	FileTypeSdist = "sdist"
+	FileTypeEgg   = "bdist_egg"
+)
+
+var nameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// distributionExtensions maps the extensions of the accepted distribution files to their type.
+var distributionExtensions = []struct {
+	extension string
+	fileType  string
+}{
+	{".whl", FileTypeWheel},
+	{".tar.gz", FileTypeSdist},
+	{".zip", FileTypeSdist},
+	{".egg", FileTypeEgg},
+}
+
+// NormalizeName returns the normalized form of a project name as defined by PEP 503, which is
+// how the simple repository API addresses projects.
+func NormalizeName(name string) string {
+	return strings.ToLower(nameSeparators.ReplaceAllString(name, "-"))
+}
+
+// FileType returns the distribution type of a file by its extension, empty if it isn't a
+// distribution file.
+func FileType(filename string) string {
+	for _, d := range distributionExtensions {
+		if strings.HasSuffix(strings.ToLower(filename), d.extension) {
+			return d.fileType
+		}
+	}
+	return ""
+}
+
+// ValidateFilename checks that the file is a distribution of the project.
+func ValidateFilename(name, filename string) error {
+	if filename == "" || strings.ContainsAny(filename, "/\\") {
+		return fmt.Errorf("invalid file name %q", filename)
+	}
+	if FileType(filename) == "" {
+		return fmt.Errorf("file %q is not a wheel, source or egg distribution", filename)
+	}
+	if !strings.HasPrefix(NormalizeName(filename), NormalizeName(name)+"-") {
+		return fmt.Errorf("file %q is not a distribution of %s", filename, name)
+	}
+	return nil
+}
+
+// WheelPythonTag returns the python tag of a wheel, like "py3" for "pkg-1.0-py3-none-any.whl".
+func WheelPythonTag(filename string) string {
+	if FileType(filename) != FileTypeWheel {
+		return ""
+	}
+	// {distribution}-{version}(-{build tag})?-{python tag}-{abi tag}-{platform tag}.whl
+	parts := strings.Split(strings.TrimSuffix(filename, ".whl"), "-")
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[len(parts)-3]
+}
+
+// VersionFromFilename returns the version of a distribution file of the project.
+func VersionFromFilename(name, filename string) (string, bool) {
+	if ValidateFilename(name, filename) != nil {
+		return "", false
+	}
+	stem := filename
+	for _, d := range distributionExtensions {
+		if strings.HasSuffix(strings.ToLower(stem), d.extension) {
+			stem = stem[:len(stem)-len(d.extension)]
+			break
+		}
+	}
+	var version string
+	switch FileType(filename) {
+	case FileTypeWheel, FileTypeEgg:
+		// {distribution}-{version}-..., where the distribution escapes hyphens to underscores
+		if parts := strings.Split(stem, "-"); len(parts) >= 2 {
+			version = parts[1]
+		}
+	default:
+		// {name}-{version}, where the name may contain hyphens but the version doesn't
+		if i := strings.LastIndex(stem, "-"); i >= 0 {
+			version = stem[i+1:]
+		}
+	}
+	return version, version != ""
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, "friendly-bard", NormalizeName("Friendly-Bard"))
+	assert.Equal(t, "friendly-bard", NormalizeName("FRIENDLY_BARD"))
+	assert.Equal(t, "friendly-bard", NormalizeName("friendly.bard"))
+	assert.Equal(t, "friendly-bard", NormalizeName("Friendly-._Bard"))
+}
+
+func TestFileType(t *testing.T) {
+	assert.Equal(t, FileTypeWheel, FileType("pkg-1.0-py3-none-any.whl"))
+	assert.Equal(t, FileTypeSdist, FileType("pkg-1.0.tar.gz"))
+	assert.Equal(t, FileTypeSdist, FileType("pkg-1.0.zip"))
+	assert.Equal(t, FileTypeEgg, FileType("pkg-1.0-py3.8.egg"))
+	assert.Equal(t, "", FileType("pkg-1.0.exe"))
+}
+
+func TestValidateFilename(t *testing.T) {
+	assert.NoError(t, ValidateFilename("Foo_Bar", "foo_bar-1.0-py3-none-any.whl"))
+	assert.NoError(t, ValidateFilename("foo-bar", "foo-bar-1.0.tar.gz"))
+	assert.Error(t, ValidateFilename("foo", "foobar-1.0.tar.gz"))
+	assert.Error(t, ValidateFilename("foo", "foo-1.0.exe"))
+	assert.Error(t, ValidateFilename("foo", "../foo-1.0.tar.gz"))
+	assert.Error(t, ValidateFilename("foo", ""))
+}
+
+func TestWheelPythonTag(t *testing.T) {
+	assert.Equal(t, "py3", WheelPythonTag("pkg-1.0-py3-none-any.whl"))
+	assert.Equal(t, "cp312", WheelPythonTag("pkg-1.0-1-cp312-cp312-manylinux_2_17_x86_64.whl"))
+	assert.Equal(t, "", WheelPythonTag("pkg-1.0.tar.gz"))
+}
+
+func TestVersionFromFilename(t *testing.T) {
+	for filename, version := range map[string]string{
+		"foo_bar-1.0.post1-py3-none-any.whl": "1.0.post1",
+		"foo-bar-2.0rc1.tar.gz":              "2.0rc1",
+		"Foo.Bar-1.2.zip":                    "1.2",
+		"foo_bar-0.9-py3.8.egg":              "0.9",
+	} {
+		v, ok := VersionFromFilename("foo-bar", filename)
+		assert.True(t, ok, filename)
+		assert.Equal(t, version, v, filename)
+	}
+	_, ok := VersionFromFilename("foo-bar", "other-1.0.tar.gz")
+	assert.False(t, ok)
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	anchorPattern    = regexp.MustCompile(`(?is)<a\s([^>]*)>(.*?)</a>`)
+	attributePattern = regexp.MustCompile(`(?is)([a-z][a-z0-9-]*)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+)
+
+// Link is a distribution file listed by a project page of the simple repository API.
+type Link struct {
+	Filename       string
+	URL            string
+	Sha256         string
+	RequiresPython string
+	Yanked         bool
+}
+
+// ParseSimplePage returns the files listed by a project page of the simple repository API. The
+// digest fragment of the file URLs is split off into Sha256.
+// Source: https://peps.python.org/pep-0503/
+func ParseSimplePage(page string) []Link {
+	var links []Link
+	for _, anchor := range anchorPattern.FindAllStringSubmatch(page, -1) {
+		attributes := map[string]string{}
+		for _, attribute := range attributePattern.FindAllStringSubmatch(anchor[1], -1) {
+			attributes[strings.ToLower(attribute[1])] = html.UnescapeString(attribute[2] + attribute[3])
+		}
+		href, ok := attributes["href"]
+		if !ok {
+			continue
+		}
+		link := Link{
+			Filename:       strings.TrimSpace(html.UnescapeString(anchor[2])),
+			URL:            href,
+			RequiresPython: attributes["data-requires-python"],
+		}
+		_, link.Yanked = attributes["data-yanked"]
+		if url, fragment, found := strings.Cut(href, "#"); found {
+			link.URL = url
+			if digest, ok := strings.CutPrefix(fragment, "sha256="); ok {
+				link.Sha256 = digest
+			}
+		}
+		links = append(links, link)
+	}
+	return links
+}
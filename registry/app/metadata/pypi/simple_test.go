@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSimplePage(t *testing.T) {
+	page := `<!DOCTYPE html>
+<html><body>
+<h1>Links for foo-bar</h1>
+<a href="https://files.example.com/packages/ab/foo_bar-1.0-py3-none-any.whl#sha256=abc123"
+   data-requires-python="&gt;=3.8">foo_bar-1.0-py3-none-any.whl</a><br/>
+<a href='../../packages/foo-bar-1.0.tar.gz' data-yanked="">foo-bar-1.0.tar.gz</a>
+<a name="anchor">no link</a>
+</body></html>`
+
+	assert.Equal(t, []Link{
+		{
+			Filename:       "foo_bar-1.0-py3-none-any.whl",
+			URL:            "https://files.example.com/packages/ab/foo_bar-1.0-py3-none-any.whl",
+			Sha256:         "abc123",
+			RequiresPython: ">=3.8",
+		},
+		{
+			Filename: "foo-bar-1.0.tar.gz",
+			URL:      "../../packages/foo-bar-1.0.tar.gz",
+			Yanked:   true,
+		},
+	}, ParseSimplePage(page))
+}
@@ -0,0 +1,465 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rpm reads the headers of RPM packages, which the repodata of YUM repositories is made of.
+// Source: https://rpm-software-management.github.io/rpm/manual/format.html
+package rpm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	leadSize = 96
+	// maxHeaderSize bounds the index and the data of a header.
+	maxHeaderSize = 64 << 20
+
+	typeInt16       = 3
+	typeInt32       = 4
+	typeInt64       = 5
+	typeString      = 6
+	typeStringArray = 8
+	typeI18NString  = 9
+
+	tagName            = 1000
+	tagVersion         = 1001
+	tagRelease         = 1002
+	tagEpoch           = 1003
+	tagSummary         = 1004
+	tagDescription     = 1005
+	tagBuildTime       = 1006
+	tagBuildHost       = 1007
+	tagSize            = 1009
+	tagVendor          = 1011
+	tagLicense         = 1014
+	tagPackager        = 1015
+	tagGroup           = 1016
+	tagURL             = 1020
+	tagArch            = 1022
+	tagFileModes       = 1030
+	tagFileFlags       = 1037
+	tagSourceRPM       = 1044
+	tagArchiveSize     = 1046
+	tagProvideName     = 1047
+	tagRequireFlags    = 1048
+	tagRequireName     = 1049
+	tagRequireVersion  = 1050
+	tagConflictFlags   = 1053
+	tagConflictName    = 1054
+	tagConflictVersion = 1055
+	tagObsoleteName    = 1090
+	tagProvideFlags    = 1112
+	tagProvideVersion  = 1113
+	tagObsoleteFlags   = 1114
+	tagObsoleteVersion = 1115
+	tagDirIndexes      = 1116
+	tagBaseNames       = 1117
+	tagDirNames        = 1118
+	tagLongSize        = 5009
+
+	senseLess       = 0x02
+	senseGreater    = 0x04
+	senseEqual      = 0x08
+	sensePrereq     = 0x40
+	senseScriptPre  = 0x200
+	senseScriptPost = 0x400
+
+	fileFlagGhost = 0x40
+	fileModeType  = 0o170000
+	fileModeDir   = 0o040000
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid rpm package")
+
+	leadMagic   = []byte{0xed, 0xab, 0xee, 0xdb}
+	headerMagic = []byte{0x8e, 0xad, 0xe8, 0x01}
+
+	namePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._+-]*$`)
+	// primaryFilePattern matches the files listed in primary.xml besides filelists.xml, which
+	// dependencies on paths are commonly resolved with.
+	primaryFilePattern = regexp.MustCompile(`^(.*bin/.*|/etc/.*|/usr/lib/sendmail)$`)
+)
+
+// Metadata is the header of a binary package. HeaderStart and HeaderEnd are the byte range of the
+// header in the package, which dnf fetches on its own for delta downloads.
+type Metadata struct {
+	Name          string  `json:"name"`
+	Epoch         string  `json:"epoch,omitempty"`
+	Version       string  `json:"version"`
+	Release       string  `json:"release"`
+	Architecture  string  `json:"architecture"`
+	Summary       string  `json:"summary,omitempty"`
+	Description   string  `json:"description,omitempty"`
+	License       string  `json:"license,omitempty"`
+	URL           string  `json:"url,omitempty"`
+	Vendor        string  `json:"vendor,omitempty"`
+	Packager      string  `json:"packager,omitempty"`
+	Group         string  `json:"group,omitempty"`
+	BuildHost     string  `json:"build_host,omitempty"`
+	SourceRPM     string  `json:"source_rpm,omitempty"`
+	BuildTime     int64   `json:"build_time,omitempty"`
+	InstalledSize int64   `json:"installed_size,omitempty"`
+	ArchiveSize   int64   `json:"archive_size,omitempty"`
+	HeaderStart   int64   `json:"header_start"`
+	HeaderEnd     int64   `json:"header_end"`
+	Provides      []Entry `json:"provides,omitempty"`
+	Requires      []Entry `json:"requires,omitempty"`
+	Conflicts     []Entry `json:"conflicts,omitempty"`
+	Obsoletes     []Entry `json:"obsoletes,omitempty"`
+	Files         []File  `json:"files,omitempty"`
+}
+
+// Entry is a capability a package provides, requires, conflicts with or obsoletes. Flags is the
+// comparison of the version, as written in repodata: LT, GT, EQ, LE or GE.
+type Entry struct {
+	Name    string `json:"name"`
+	Flags   string `json:"flags,omitempty"`
+	Epoch   string `json:"epoch,omitempty"`
+	Version string `json:"version,omitempty"`
+	Release string `json:"release,omitempty"`
+	Pre     bool   `json:"pre,omitempty"`
+}
+
+// File is a file of a package. Type is "dir" for directories and "ghost" for files which aren't
+// part of the payload, and empty otherwise.
+type File struct {
+	Path string `json:"path"`
+	Type string `json:"type,omitempty"`
+}
+
+type header struct {
+	index map[int32]indexEntry
+	data  []byte
+}
+
+type indexEntry struct {
+	typ    int32
+	offset int32
+	count  int32
+}
+
+// ReadPackage reads the header of a package, which follows the lead and the signature header.
+func ReadPackage(r io.Reader) (*Metadata, error) {
+	br := bufio.NewReader(r)
+	lead := make([]byte, leadSize)
+	if _, err := io.ReadFull(br, lead); err != nil || !bytes.Equal(lead[:4], leadMagic) {
+		return nil, fmt.Errorf("%w: not an rpm package", ErrInvalidPackage)
+	}
+	_, sigSize, err := readHeader(br)
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %w", ErrInvalidPackage, err)
+	}
+	// the signature header is padded to a multiple of 8 bytes
+	padding := (8 - sigSize%8) % 8
+	if _, err := io.CopyN(io.Discard, br, padding); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPackage, err)
+	}
+	h, size, err := readHeader(br)
+	if err != nil {
+		return nil, fmt.Errorf("%w: header: %w", ErrInvalidPackage, err)
+	}
+
+	start := leadSize + sigSize + padding
+	m := &Metadata{
+		Name:          h.string(tagName),
+		Version:       h.string(tagVersion),
+		Release:       h.string(tagRelease),
+		Architecture:  h.string(tagArch),
+		Summary:       h.string(tagSummary),
+		Description:   h.string(tagDescription),
+		License:       h.string(tagLicense),
+		URL:           h.string(tagURL),
+		Vendor:        h.string(tagVendor),
+		Packager:      h.string(tagPackager),
+		Group:         h.string(tagGroup),
+		BuildHost:     h.string(tagBuildHost),
+		SourceRPM:     h.string(tagSourceRPM),
+		BuildTime:     h.int(tagBuildTime),
+		InstalledSize: h.int(tagLongSize),
+		ArchiveSize:   h.int(tagArchiveSize),
+		HeaderStart:   start,
+		HeaderEnd:     start + size,
+	}
+	if m.InstalledSize == 0 {
+		m.InstalledSize = h.int(tagSize)
+	}
+	if epochs := h.ints(tagEpoch); len(epochs) > 0 {
+		m.Epoch = strconv.FormatInt(epochs[0], 10)
+	}
+	m.Provides = h.entries(tagProvideName, tagProvideFlags, tagProvideVersion)
+	m.Requires = h.entries(tagRequireName, tagRequireFlags, tagRequireVersion)
+	m.Conflicts = h.entries(tagConflictName, tagConflictFlags, tagConflictVersion)
+	m.Obsoletes = h.entries(tagObsoleteName, tagObsoleteFlags, tagObsoleteVersion)
+	m.Files = h.files()
+	return m, nil
+}
+
+// readHeader reads a header structure, returning it along with its size in bytes.
+func readHeader(r io.Reader) (*header, int64, error) {
+	intro := make([]byte, 16)
+	if _, err := io.ReadFull(r, intro); err != nil {
+		return nil, 0, err
+	}
+	if !bytes.Equal(intro[:4], headerMagic) {
+		return nil, 0, errors.New("bad magic")
+	}
+	count := int64(binary.BigEndian.Uint32(intro[8:12]))
+	dataSize := int64(binary.BigEndian.Uint32(intro[12:16]))
+	if count*16+dataSize > maxHeaderSize {
+		return nil, 0, fmt.Errorf("header exceeds %d bytes", maxHeaderSize)
+	}
+	index := make([]byte, count*16)
+	if _, err := io.ReadFull(r, index); err != nil {
+		return nil, 0, err
+	}
+	h := &header{index: make(map[int32]indexEntry, count), data: make([]byte, dataSize)}
+	if _, err := io.ReadFull(r, h.data); err != nil {
+		return nil, 0, err
+	}
+	for i := int64(0); i < count; i++ {
+		e := index[i*16 : i*16+16]
+		entry := indexEntry{
+			typ:    int32(binary.BigEndian.Uint32(e[4:8])),   //nolint:gosec
+			offset: int32(binary.BigEndian.Uint32(e[8:12])),  //nolint:gosec
+			count:  int32(binary.BigEndian.Uint32(e[12:16])), //nolint:gosec
+		}
+		if entry.offset < 0 || int64(entry.offset) > dataSize || entry.count < 0 {
+			return nil, 0, fmt.Errorf("entry %d is out of bounds", i)
+		}
+		h.index[int32(binary.BigEndian.Uint32(e[0:4]))] = entry //nolint:gosec
+	}
+	return h, 16 + count*16 + dataSize, nil
+}
+
+func (h *header) strings(tag int32) []string {
+	e, ok := h.index[tag]
+	if !ok || (e.typ != typeString && e.typ != typeStringArray && e.typ != typeI18NString) {
+		return nil
+	}
+	data := h.data[e.offset:]
+	values := make([]string, 0, e.count)
+	for i := int32(0); i < e.count; i++ {
+		end := bytes.IndexByte(data, 0)
+		if end < 0 {
+			break
+		}
+		values = append(values, string(data[:end]))
+		data = data[end+1:]
+	}
+	return values
+}
+
+// string returns the first value, which for I18N strings is the untranslated one.
+func (h *header) string(tag int32) string {
+	if values := h.strings(tag); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (h *header) ints(tag int32) []int64 {
+	e, ok := h.index[tag]
+	if !ok {
+		return nil
+	}
+	var width int64
+	switch e.typ {
+	case typeInt16:
+		width = 2
+	case typeInt32:
+		width = 4
+	case typeInt64:
+		width = 8
+	default:
+		return nil
+	}
+	data := h.data[e.offset:]
+	if int64(len(data)) < int64(e.count)*width {
+		return nil
+	}
+	values := make([]int64, e.count)
+	for i := range values {
+		v := data[int64(i)*width:]
+		switch width {
+		case 2:
+			values[i] = int64(binary.BigEndian.Uint16(v))
+		case 4:
+			values[i] = int64(binary.BigEndian.Uint32(v))
+		default:
+			values[i] = int64(binary.BigEndian.Uint64(v)) //nolint:gosec
+		}
+	}
+	return values
+}
+
+func (h *header) int(tag int32) int64 {
+	if values := h.ints(tag); len(values) > 0 {
+		return values[0]
+	}
+	return 0
+}
+
+// entries reads the capabilities of the parallel name, flags and version arrays. Requirements on
+// rpmlib features are left out, as createrepo does.
+func (h *header) entries(nameTag, flagsTag, versionTag int32) []Entry {
+	names := h.strings(nameTag)
+	flags := h.ints(flagsTag)
+	versions := h.strings(versionTag)
+	var entries []Entry
+	for i, name := range names {
+		if strings.HasPrefix(name, "rpmlib(") {
+			continue
+		}
+		entry := Entry{Name: name}
+		var flag int64
+		if i < len(flags) {
+			flag = flags[i]
+		}
+		entry.Pre = flag&(sensePrereq|senseScriptPre|senseScriptPost) != 0
+		if i < len(versions) && versions[i] != "" {
+			entry.Flags = flagsString(flag)
+			entry.Epoch, entry.Version, entry.Release = SplitEVR(versions[i])
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func flagsString(flag int64) string {
+	switch flag & (senseLess | senseGreater | senseEqual) {
+	case senseLess:
+		return "LT"
+	case senseGreater:
+		return "GT"
+	case senseEqual:
+		return "EQ"
+	case senseLess | senseEqual:
+		return "LE"
+	case senseGreater | senseEqual:
+		return "GE"
+	default:
+		return ""
+	}
+}
+
+func (h *header) files() []File {
+	baseNames := h.strings(tagBaseNames)
+	dirNames := h.strings(tagDirNames)
+	dirIndexes := h.ints(tagDirIndexes)
+	modes := h.ints(tagFileModes)
+	flags := h.ints(tagFileFlags)
+	files := make([]File, 0, len(baseNames))
+	for i, base := range baseNames {
+		if i >= len(dirIndexes) || dirIndexes[i] < 0 || dirIndexes[i] >= int64(len(dirNames)) {
+			continue
+		}
+		f := File{Path: dirNames[dirIndexes[i]] + base}
+		switch {
+		case i < len(modes) && modes[i]&fileModeType == fileModeDir:
+			f.Type = "dir"
+		case i < len(flags) && flags[i]&fileFlagGhost != 0:
+			f.Type = "ghost"
+		}
+		files = append(files, f)
+	}
+	return files
+}
+
+// Validate checks the fields of the header which the repodata is keyed by.
+func (m *Metadata) Validate() error {
+	if !namePattern.MatchString(m.Name) {
+		return fmt.Errorf("%w: invalid package name %q", ErrInvalidPackage, m.Name)
+	}
+	if m.Version == "" || strings.ContainsAny(m.Version, "-:/ ") {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidPackage, m.Version)
+	}
+	if m.Release == "" || strings.ContainsAny(m.Release, "-:/ ") {
+		return fmt.Errorf("%w: invalid release %q", ErrInvalidPackage, m.Release)
+	}
+	if !namePattern.MatchString(m.Architecture) {
+		return fmt.Errorf("%w: invalid architecture %q", ErrInvalidPackage, m.Architecture)
+	}
+	if m.SourceRPM == "" {
+		return fmt.Errorf("%w: source packages aren't supported", ErrInvalidPackage)
+	}
+	return nil
+}
+
+// FullVersion returns the [epoch:]version-release of the package, which versions are listed by.
+func (m *Metadata) FullVersion() string {
+	v := m.Version + "-" + m.Release
+	if m.Epoch != "" && m.Epoch != "0" {
+		v = m.Epoch + ":" + v
+	}
+	return v
+}
+
+// Filename returns the conventional name of the package file, which leaves out the epoch.
+func (m *Metadata) Filename() string {
+	return m.Name + "-" + m.Version + "-" + m.Release + "." + m.Architecture + ".rpm"
+}
+
+// PrimaryFiles returns the files listed in primary.xml.
+func (m *Metadata) PrimaryFiles() []File {
+	var files []File
+	for _, f := range m.Files {
+		if primaryFilePattern.MatchString(f.Path) {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// SplitEVR splits a [epoch:]version[-release] string.
+func SplitEVR(evr string) (string, string, string) {
+	var epoch, release string
+	if i := strings.Index(evr, ":"); i >= 0 {
+		epoch, evr = evr[:i], evr[i+1:]
+	}
+	if i := strings.LastIndex(evr, "-"); i >= 0 {
+		evr, release = evr[:i], evr[i+1:]
+	}
+	return epoch, evr, release
+}
+
+// ParseFilename splits the name of a package file into the name, version, release and
+// architecture of the package.
+func ParseFilename(filename string) (string, string, string, string, error) {
+	invalid := fmt.Errorf("%s isn't the name of an rpm package", filename)
+	if path.Base(filename) != filename || !strings.HasSuffix(filename, ".rpm") {
+		return "", "", "", "", invalid
+	}
+	nevr := strings.TrimSuffix(filename, ".rpm")
+	i := strings.LastIndex(nevr, ".")
+	if i <= 0 {
+		return "", "", "", "", invalid
+	}
+	nevr, arch := nevr[:i], nevr[i+1:]
+	parts := strings.Split(nevr, "-")
+	if len(parts) < 3 {
+		return "", "", "", "", invalid
+	}
+	n := len(parts)
+	return strings.Join(parts[:n-2], "-"), parts[n-2], parts[n-1], arch, nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testTag struct {
+	tag   int32
+	typ   int32
+	value any
+}
+
+// buildHeader builds a header structure of the tags, whose values are strings, string slices or
+// int32 and uint16 slices.
+func buildHeader(tags []testTag) []byte {
+	var index, data bytes.Buffer
+	for _, t := range tags {
+		var count int
+		switch v := t.value.(type) {
+		case string:
+			count = 1
+			data.WriteString(v + "\x00")
+		case []string:
+			count = len(v)
+			for _, s := range v {
+				data.WriteString(s + "\x00")
+			}
+		case []int32:
+			for data.Len()%4 != 0 {
+				data.WriteByte(0)
+			}
+			count = len(v)
+			_ = binary.Write(&data, binary.BigEndian, v)
+		case []uint16:
+			for data.Len()%2 != 0 {
+				data.WriteByte(0)
+			}
+			count = len(v)
+			_ = binary.Write(&data, binary.BigEndian, v)
+		}
+		offset := data.Len() - dataLen(t.value)
+		_ = binary.Write(&index, binary.BigEndian, []int32{t.tag, t.typ, int32(offset), int32(count)})
+	}
+	var buf bytes.Buffer
+	buf.Write(headerMagic)
+	buf.Write([]byte{0, 0, 0, 0})
+	_ = binary.Write(&buf, binary.BigEndian, []uint32{uint32(len(tags)), uint32(data.Len())})
+	buf.Write(index.Bytes())
+	buf.Write(data.Bytes())
+	return buf.Bytes()
+}
+
+func dataLen(value any) int {
+	switch v := value.(type) {
+	case string:
+		return len(v) + 1
+	case []string:
+		n := 0
+		for _, s := range v {
+			n += len(s) + 1
+		}
+		return n
+	case []int32:
+		return 4 * len(v)
+	case []uint16:
+		return 2 * len(v)
+	}
+	return 0
+}
+
+func buildRPM(tags []testTag) []byte {
+	lead := make([]byte, leadSize)
+	copy(lead, leadMagic)
+	var buf bytes.Buffer
+	buf.Write(lead)
+	// a signature header of 36 bytes, padded to 40
+	buf.Write(buildHeader([]testTag{{tag: 1000, typ: typeInt32, value: []int32{42}}}))
+	buf.Write([]byte{0, 0, 0, 0})
+	buf.Write(buildHeader(tags))
+	buf.WriteString("payload")
+	return buf.Bytes()
+}
+
+func TestReadPackage(t *testing.T) {
+	header := []testTag{
+		{tagName, typeString, "hello"},
+		{tagVersion, typeString, "2.10"},
+		{tagRelease, typeString, "3.el9"},
+		{tagEpoch, typeInt32, []int32{1}},
+		{tagSummary, typeI18NString, []string{"Prints a greeting"}},
+		{tagLicense, typeString, "GPLv3+"},
+		{tagArch, typeString, "x86_64"},
+		{tagSourceRPM, typeString, "hello-2.10-3.el9.src.rpm"},
+		{tagSize, typeInt32, []int32{2048}},
+		{tagRequireName, typeStringArray, []string{"glibc", "rpmlib(CompressedFileNames)", "/bin/sh"}},
+		{tagRequireFlags, typeInt32, []int32{senseGreater | senseEqual, senseLess | senseEqual, sensePrereq}},
+		{tagRequireVersion, typeStringArray, []string{"2.34", "3.0.4-1", ""}},
+		{tagProvideName, typeStringArray, []string{"hello"}},
+		{tagProvideFlags, typeInt32, []int32{senseEqual}},
+		{tagProvideVersion, typeStringArray, []string{"1:2.10-3.el9"}},
+		{tagDirNames, typeStringArray, []string{"/usr/bin/", "/usr/share/", "/usr/share/hello/"}},
+		{tagBaseNames, typeStringArray, []string{"hello", "hello", "README"}},
+		{tagDirIndexes, typeInt32, []int32{0, 1, 2}},
+		{tagFileModes, typeInt16, []uint16{0o100755, 0o40755, 0o100644}},
+	}
+	data := buildRPM(header)
+	m, err := ReadPackage(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.NoError(t, m.Validate())
+
+	assert.Equal(t, "hello", m.Name)
+	assert.Equal(t, "1:2.10-3.el9", m.FullVersion())
+	assert.Equal(t, "hello-2.10-3.el9.x86_64.rpm", m.Filename())
+	assert.Equal(t, "Prints a greeting", m.Summary)
+	assert.Equal(t, int64(2048), m.InstalledSize)
+	assert.Equal(t, int64(leadSize+40), m.HeaderStart)
+	assert.Equal(t, int64(len(data)-len("payload")), m.HeaderEnd)
+
+	assert.Equal(t, []Entry{
+		{Name: "glibc", Flags: "GE", Version: "2.34"},
+		{Name: "/bin/sh", Pre: true},
+	}, m.Requires)
+	assert.Equal(t, []Entry{{Name: "hello", Flags: "EQ", Epoch: "1", Version: "2.10", Release: "3.el9"}}, m.Provides)
+	assert.Equal(t, []File{
+		{Path: "/usr/bin/hello"},
+		{Path: "/usr/share/hello", Type: "dir"},
+		{Path: "/usr/share/hello/README"},
+	}, m.Files)
+	assert.Equal(t, []File{{Path: "/usr/bin/hello"}}, m.PrimaryFiles())
+}
+
+func TestReadPackageInvalid(t *testing.T) {
+	_, err := ReadPackage(bytes.NewReader([]byte("!<arch>\n")))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+
+	data := buildRPM([]testTag{{tagName, typeString, "hello"}})
+	_, err = ReadPackage(bytes.NewReader(data[:len(data)-20]))
+	assert.ErrorIs(t, err, ErrInvalidPackage)
+
+	m, err := ReadPackage(bytes.NewReader(buildRPM([]testTag{
+		{tagName, typeString, "hello"},
+		{tagVersion, typeString, "1.0"},
+		{tagRelease, typeString, "1"},
+		{tagArch, typeString, "noarch"},
+	})))
+	require.NoError(t, err)
+	assert.ErrorContains(t, m.Validate(), "source packages")
+}
+
+func TestSplitEVR(t *testing.T) {
+	epoch, version, release := SplitEVR("2:1.0.1-4.fc40")
+	assert.Equal(t, []string{"2", "1.0.1", "4.fc40"}, []string{epoch, version, release})
+	epoch, version, release = SplitEVR("1.0")
+	assert.Equal(t, []string{"", "1.0", ""}, []string{epoch, version, release})
+}
+
+func TestParseFilename(t *testing.T) {
+	name, version, release, arch, err := ParseFilename("python3-libs-3.9.18-3.el9.x86_64.rpm")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"python3-libs", "3.9.18", "3.el9", "x86_64"}, []string{name, version, release, arch})
+
+	for _, filename := range []string{"hello.rpm", "hello-1.0.noarch.rpm", "../hello-1.0-1.noarch.rpm", "hello-1.0-1.deb"} {
+		_, _, _, _, err = ParseFilename(filename)
+		assert.Error(t, err, filename)
+	}
+}
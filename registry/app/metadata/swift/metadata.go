@@ -0,0 +1,301 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// ManifestName is the name of the manifest every release has.
+	ManifestName = "Package.swift"
+	// SourceArchiveResource is the name of the source archive among the resources of a release.
+	SourceArchiveResource = "source-archive"
+	// SourceArchiveType is the media type of source archives.
+	SourceArchiveType = "application/zip"
+
+	// maxManifestSize is the largest manifest of a release.
+	maxManifestSize = 1 << 20
+
+	maxScopeLength = 39
+	maxNameLength  = 100
+)
+
+var (
+	ErrInvalidPackage = errors.New("invalid swift package")
+
+	// scopePattern and namePattern match the scopes and names of package identifiers, which
+	// separate alphanumeric characters with single hyphens, or underscores in names.
+	scopePattern = regexp.MustCompile(`^[a-zA-Z0-9](?:-?[a-zA-Z0-9])*$`)
+	namePattern  = regexp.MustCompile(`^[a-zA-Z0-9](?:[-_]?[a-zA-Z0-9])*$`)
+
+	versionedManifestPattern = regexp.MustCompile(`^Package@swift-([0-9]+(?:\.[0-9]+){0,2})\.swift$`)
+	toolsVersionPattern      = regexp.MustCompile(`^//\s*swift-tools-version\s*:\s*([0-9]+(?:\.[0-9]+){0,2})`)
+	swiftVersionPattern      = regexp.MustCompile(`^[0-9]+(?:\.[0-9]+){0,2}$`)
+)
+
+// Release is a published version of a package, identified by scope and name.
+// Source: https://github.com/swiftlang/swift-package-manager/blob/main/Documentation/PackageRegistry/Registry.md
+type Release struct {
+	Scope   string `json:"scope"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Manifests are the manifests at the root of the package, Package.swift first.
+	Manifests []Manifest `json:"manifests"`
+	// Metadata is the metadata the release was published with.
+	Metadata *PackageMetadata `json:"metadata,omitempty"`
+	// Signature is the signature of the source archive, base64 encoded.
+	Signature       string `json:"signature,omitempty"`
+	SignatureFormat string `json:"signature_format,omitempty"`
+}
+
+// Manifest is Package.swift or one of the manifests of the package for a Swift version.
+type Manifest struct {
+	// SwiftVersion is the Swift version of the manifest, empty for Package.swift.
+	SwiftVersion string `json:"swift_version,omitempty"`
+	ToolsVersion string `json:"tools_version,omitempty"`
+}
+
+// PackageMetadata is the metadata of a release, in the shape clients publish and read it.
+type PackageMetadata struct {
+	Author                  *Author  `json:"author,omitempty"`
+	Description             string   `json:"description,omitempty"`
+	LicenseURL              string   `json:"licenseURL,omitempty"`
+	ReadmeURL               string   `json:"readmeURL,omitempty"`
+	RepositoryURLs          []string `json:"repositoryURLs,omitempty"`
+	OriginalPublicationTime string   `json:"originalPublicationTime,omitempty"`
+}
+
+type Author struct {
+	Name         string        `json:"name"`
+	Email        string        `json:"email,omitempty"`
+	Description  string        `json:"description,omitempty"`
+	URL          string        `json:"url,omitempty"`
+	Organization *Organization `json:"organization,omitempty"`
+}
+
+type Organization struct {
+	Name        string `json:"name"`
+	Email       string `json:"email,omitempty"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+// ValidateIdentifier checks the scope and the name of a package identifier.
+func ValidateIdentifier(scope, name string) error {
+	if len(scope) > maxScopeLength || !scopePattern.MatchString(scope) {
+		return fmt.Errorf("%w: invalid scope %q", ErrInvalidPackage, scope)
+	}
+	if len(name) > maxNameLength || !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidPackage, name)
+	}
+	return nil
+}
+
+// ValidateVersion checks a release version is a semantic version.
+func ValidateVersion(version string) error {
+	if _, err := semver.StrictNewVersion(version); err != nil {
+		return fmt.Errorf("%w: invalid version %q: %w", ErrInvalidPackage, version, err)
+	}
+	return nil
+}
+
+// ValidateSwiftVersion checks the Swift version a manifest is requested for.
+func ValidateSwiftVersion(swiftVersion string) error {
+	if !swiftVersionPattern.MatchString(swiftVersion) {
+		return fmt.Errorf("%w: invalid swift version %q", ErrInvalidPackage, swiftVersion)
+	}
+	return nil
+}
+
+// Identifier is the identifier of the package of the release.
+func (r *Release) Identifier() string {
+	return r.Scope + "." + r.Name
+}
+
+// ImageName is the name the releases of a package are stored under. Package identifiers are
+// case-insensitive, so it's lowercase.
+func ImageName(scope, name string) string {
+	return strings.ToLower(scope + "." + name)
+}
+
+// ParseImageName returns the scope and the name of a package from the name its releases are
+// stored under.
+func ParseImageName(image string) (scope, name string, err error) {
+	scope, name, ok := strings.Cut(image, ".")
+	if !ok {
+		return "", "", fmt.Errorf("%w: invalid package %q", ErrInvalidPackage, image)
+	}
+	return scope, name, ValidateIdentifier(scope, name)
+}
+
+// ArchiveFilename is the name of the source archive of the release when it's downloaded.
+func (r *Release) ArchiveFilename() string {
+	return r.Name + "-" + r.Version + ".zip"
+}
+
+// Manifest returns the manifest of the release for a Swift version, or Package.swift for an
+// empty version.
+func (r *Release) Manifest(swiftVersion string) (Manifest, bool) {
+	for _, manifest := range r.Manifests {
+		if manifest.SwiftVersion == swiftVersion {
+			return manifest, true
+		}
+	}
+	return Manifest{}, false
+}
+
+// Filename is the name of the manifest in the package.
+func (m Manifest) Filename() string {
+	if m.SwiftVersion == "" {
+		return ManifestName
+	}
+	return "Package@swift-" + m.SwiftVersion + ".swift"
+}
+
+// ParseManifestFilename returns the manifest a file of a package is, if any.
+func ParseManifestFilename(filename string) (Manifest, bool) {
+	if filename == ManifestName {
+		return Manifest{}, true
+	}
+	match := versionedManifestPattern.FindStringSubmatch(filename)
+	if match == nil {
+		return Manifest{}, false
+	}
+	return Manifest{SwiftVersion: match[1]}, true
+}
+
+// ReadSourceArchive reads the manifests at the root of a package from its source archive, a zip
+// file that holds the package either at its top or in its single top-level directory, the way
+// swift package archive-source creates it.
+func ReadSourceArchive(r io.ReaderAt, size int64) ([]Manifest, map[string][]byte, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: source archive must be a zip file: %w", ErrInvalidPackage, err)
+	}
+	root, err := packageRoot(zr.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var manifests []Manifest
+	contents := map[string][]byte{}
+	for _, file := range zr.File {
+		dir, filename := path.Split(file.Name)
+		if dir != root || file.FileInfo().IsDir() {
+			continue
+		}
+		manifest, ok := ParseManifestFilename(filename)
+		if !ok {
+			continue
+		}
+		content, err := readManifest(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		manifest.ToolsVersion = ToolsVersion(content)
+		manifests = append(manifests, manifest)
+		contents[manifest.Filename()] = content
+	}
+	if _, ok := contents[ManifestName]; !ok {
+		return nil, nil, fmt.Errorf("%w: source archive has no %s at the root of the package",
+			ErrInvalidPackage, ManifestName)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		if manifests[i].SwiftVersion == "" || manifests[j].SwiftVersion == "" {
+			return manifests[i].SwiftVersion == ""
+		}
+		return lessSwiftVersion(manifests[i].SwiftVersion, manifests[j].SwiftVersion)
+	})
+	return manifests, contents, nil
+}
+
+// lessSwiftVersion compares Swift versions by their numeric components.
+func lessSwiftVersion(a, b string) bool {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		if len(aParts[i]) != len(bParts[i]) {
+			return len(aParts[i]) < len(bParts[i])
+		}
+		if aParts[i] != bParts[i] {
+			return aParts[i] < bParts[i]
+		}
+	}
+	return len(aParts) < len(bParts)
+}
+
+// packageRoot returns the directory of the source archive the package is at, rejecting entries
+// that would be extracted outside of the archive.
+func packageRoot(files []*zip.File) (string, error) {
+	topLevel := map[string]bool{}
+	for _, file := range files {
+		name := path.Clean(file.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return "", fmt.Errorf("%w: %s is outside of the source archive", ErrInvalidPackage, file.Name)
+		}
+		if name == ManifestName {
+			return "", nil
+		}
+		top, _, _ := strings.Cut(name, "/")
+		topLevel[top] = true
+	}
+	if len(topLevel) != 1 {
+		return "", fmt.Errorf("%w: source archive has no %s at the root of the package",
+			ErrInvalidPackage, ManifestName)
+	}
+	for top := range topLevel {
+		return top + "/", nil
+	}
+	return "", nil
+}
+
+func readManifest(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > maxManifestSize {
+		return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrInvalidPackage, file.Name, maxManifestSize)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalidPackage, file.Name, err)
+	}
+	defer rc.Close()
+	content, err := io.ReadAll(io.LimitReader(rc, maxManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalidPackage, file.Name, err)
+	}
+	if len(content) > maxManifestSize {
+		return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrInvalidPackage, file.Name, maxManifestSize)
+	}
+	return content, nil
+}
+
+// ToolsVersion returns the Swift tools version a manifest declares on its first line, or an empty
+// string if it doesn't declare one.
+func ToolsVersion(manifest []byte) string {
+	line, _, _ := strings.Cut(string(manifest), "\n")
+	match := toolsVersionPattern.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildArchive(t *testing.T, files map[string]string) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadSourceArchive(t *testing.T) {
+	archive := buildArchive(t, map[string]string{
+		"LinkedList/Package.swift":              "// swift-tools-version:5.7\nimport PackageDescription\n",
+		"LinkedList/Package@swift-5.10.swift":   "// swift-tools-version: 5.10\n",
+		"LinkedList/Package@swift-4.swift":      "// swift-tools-version:4.0\n",
+		"LinkedList/Sources/LinkedList/a.swift": "",
+		"LinkedList/Tests/Package.swift":        "",
+	})
+	manifests, contents, err := ReadSourceArchive(archive, archive.Size())
+	require.NoError(t, err)
+	assert.Equal(t, []Manifest{
+		{ToolsVersion: "5.7"},
+		{SwiftVersion: "4", ToolsVersion: "4.0"},
+		{SwiftVersion: "5.10", ToolsVersion: "5.10"},
+	}, manifests)
+	assert.Len(t, contents, 3)
+	assert.Equal(t, "// swift-tools-version:4.0\n", string(contents["Package@swift-4.swift"]))
+}
+
+func TestReadSourceArchiveInvalid(t *testing.T) {
+	for name, archive := range map[string]*bytes.Reader{
+		"not zipped":  bytes.NewReader([]byte("Package.swift")),
+		"no manifest": buildArchive(t, map[string]string{"LinkedList/Sources/a.swift": ""}),
+		"two roots":   buildArchive(t, map[string]string{"a/Package.swift": "", "b/Package.swift": ""}),
+		"outside":     buildArchive(t, map[string]string{"../Package.swift": ""}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := ReadSourceArchive(archive, archive.Size())
+			assert.ErrorIs(t, err, ErrInvalidPackage)
+		})
+	}
+}
+
+func TestValidateIdentifier(t *testing.T) {
+	require.NoError(t, ValidateIdentifier("mona", "Linked_List"))
+	assert.Error(t, ValidateIdentifier("mona--x", "LinkedList"))
+	assert.Error(t, ValidateIdentifier("mona", "-LinkedList"))
+	assert.Error(t, ValidateIdentifier("mona", "Linked.List"))
+	require.NoError(t, ValidateVersion("1.1.1-beta.1"))
+	assert.Error(t, ValidateVersion("1.1"))
+
+	assert.Equal(t, "mona.linkedlist", ImageName("Mona", "LinkedList"))
+	scope, name, err := ParseImageName("mona.linkedlist")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mona", "linkedlist"}, []string{scope, name})
+	assert.Equal(t, "Package@swift-5.7.swift", Manifest{SwiftVersion: "5.7"}.Filename())
+}
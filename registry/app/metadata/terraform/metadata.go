@@ -0,0 +1,199 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+const (
+	// ModulesService is the key of the module registry protocol in service discovery documents.
+	ModulesService = "modules.v1"
+	// ArchiveName is the name the archive of a version is downloaded by.
+	ArchiveName = "archive.tar.gz"
+
+	// maxReadmeSize is the largest part of the README of a module that is kept.
+	maxReadmeSize = 64 << 10
+
+	readmeFile    = "README.md"
+	modulesDir    = "modules"
+	examplesDir   = "examples"
+	terraformExt  = ".tf"
+	terraformJSON = ".tf.json"
+)
+
+var (
+	ErrInvalidModule = errors.New("invalid terraform module")
+
+	// namePattern matches the namespaces and names of module addresses, as the public registry does.
+	namePattern   = regexp.MustCompile(`^[0-9A-Za-z](?:[0-9A-Za-z-_]{0,62}[0-9A-Za-z])?$`)
+	systemPattern = regexp.MustCompile(`^[0-9a-z]{1,64}$`)
+)
+
+// Module is a published version of a module, addressed by namespace, name and target system.
+// Source: https://developer.hashicorp.com/terraform/internals/module-registry-protocol
+type Module struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	System    string `json:"system"`
+	Version   string `json:"version"`
+	// Readme is the start of the README.md of the root module.
+	Readme string `json:"readme,omitempty"`
+	// Submodules are the directories under modules/ that hold a module.
+	Submodules []string `json:"submodules,omitempty"`
+	// Examples are the directories under examples/ that hold a module.
+	Examples []string `json:"examples,omitempty"`
+}
+
+// ValidateAddress checks the parts of a module address, as Terraform rejects addresses that
+// don't match them.
+func ValidateAddress(namespace, name, system string) error {
+	if !namePattern.MatchString(namespace) {
+		return fmt.Errorf("%w: invalid namespace %q", ErrInvalidModule, namespace)
+	}
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidModule, name)
+	}
+	if !systemPattern.MatchString(system) {
+		return fmt.Errorf("%w: invalid system %q", ErrInvalidModule, system)
+	}
+	return nil
+}
+
+// ValidateVersion checks a module version is a semantic version without a v prefix, the only
+// versions Terraform constrains.
+func ValidateVersion(version string) error {
+	if _, err := semver.StrictNewVersion(version); err != nil {
+		return fmt.Errorf("%w: invalid version %q: %w", ErrInvalidModule, version, err)
+	}
+	return nil
+}
+
+// ImageName is the name the versions of a module are stored under.
+func (m *Module) ImageName() string {
+	return m.Namespace + "/" + m.Name + "/" + m.System
+}
+
+// Filename is the name of the archive of a version when it's downloaded.
+func (m *Module) Filename() string {
+	return fmt.Sprintf("%s-%s-%s-%s.tar.gz", m.Namespace, m.Name, m.System, m.Version)
+}
+
+// ParseImageName returns the address of a module from the name its versions are stored under.
+func ParseImageName(image string) (namespace, name, system string, err error) {
+	parts := strings.Split(image, "/")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("%w: invalid module %q", ErrInvalidModule, image)
+	}
+	return parts[0], parts[1], parts[2], ValidateAddress(parts[0], parts[1], parts[2])
+}
+
+// ReadModule reads a module from a gzipped tarball, which holds the root module at its top. It fills
+// in the README, submodules and examples of the module.
+func ReadModule(r io.Reader, module *Module) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("%w: module must be a gzipped tarball: %w", ErrInvalidModule, err)
+	}
+	defer gz.Close()
+
+	hasRoot := false
+	submodules, examples := map[string]bool{}, map[string]bool{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: failed to read module: %w", ErrInvalidModule, err)
+		}
+		name, err := cleanEntryName(hdr.Name)
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+		default:
+			return fmt.Errorf("%w: %s isn't a regular file", ErrInvalidModule, name)
+		}
+
+		dir, file := path.Split(name)
+		dir = strings.TrimSuffix(dir, "/")
+		switch {
+		case dir == "" && file == readmeFile:
+			readme, err := io.ReadAll(io.LimitReader(tr, maxReadmeSize))
+			if err != nil {
+				return fmt.Errorf("%w: failed to read %s: %w", ErrInvalidModule, readmeFile, err)
+			}
+			module.Readme = string(readme)
+		case !isTerraformFile(file):
+		case dir == "":
+			hasRoot = true
+		default:
+			parent, child := path.Split(dir)
+			switch strings.TrimSuffix(parent, "/") {
+			case modulesDir:
+				submodules[child] = true
+			case examplesDir:
+				examples[child] = true
+			}
+		}
+	}
+	if !hasRoot {
+		return fmt.Errorf("%w: no .tf files at the top of the module", ErrInvalidModule)
+	}
+	module.Submodules = sortedKeys(submodules)
+	module.Examples = sortedKeys(examples)
+	return nil
+}
+
+// cleanEntryName returns the path of an entry relative to the top of the module, rejecting entries
+// that would be extracted outside of it.
+func cleanEntryName(name string) (string, error) {
+	cleaned := path.Clean(strings.TrimPrefix(name, "./"))
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("%w: %s is outside of the module", ErrInvalidModule, name)
+	}
+	return cleaned, nil
+}
+
+func isTerraformFile(name string) bool {
+	return strings.HasSuffix(name, terraformExt) || strings.HasSuffix(name, terraformJSON)
+}
+
+func sortedKeys(set map[string]bool) []string {
+	if len(set) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
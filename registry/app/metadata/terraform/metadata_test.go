@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadModule(t *testing.T) {
+	archive := buildTarball(t, map[string]string{
+		"./main.tf":                 `resource "aws_vpc" "this" {}`,
+		"./README.md":               "# VPC",
+		"modules/subnets/main.tf":   `resource "aws_subnet" "this" {}`,
+		"modules/subnets/README.md": "# Subnets",
+		"examples/simple/main.tf":   `module "vpc" { source = "../.." }`,
+		"examples/notes.txt":        "not a module",
+	})
+	module := &Module{Namespace: "acme", Name: "vpc", System: "aws", Version: "1.2.0"}
+	require.NoError(t, ReadModule(bytes.NewReader(archive), module))
+	assert.Equal(t, "# VPC", module.Readme)
+	assert.Equal(t, []string{"subnets"}, module.Submodules)
+	assert.Equal(t, []string{"simple"}, module.Examples)
+	assert.Equal(t, "acme/vpc/aws", module.ImageName())
+	assert.Equal(t, "acme-vpc-aws-1.2.0.tar.gz", module.Filename())
+}
+
+func TestReadModuleInvalid(t *testing.T) {
+	for name, archive := range map[string][]byte{
+		"not gzipped":   []byte("main.tf"),
+		"no root files": buildTarball(t, map[string]string{"vpc/main.tf": ""}),
+		"outside":       buildTarball(t, map[string]string{"main.tf": "", "../main.tf": ""}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, ReadModule(bytes.NewReader(archive), &Module{}), ErrInvalidModule)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, ValidateAddress("acme", "vpc", "aws"))
+	assert.Error(t, ValidateAddress("acme.io", "vpc", "aws"))
+	assert.Error(t, ValidateAddress("acme", "vpc", "AWS"))
+	require.NoError(t, ValidateVersion("1.2.0-beta.1"))
+	assert.Error(t, ValidateVersion("v1.2.0"))
+	assert.Error(t, ValidateVersion("1.2"))
+
+	namespace, name, system, err := ParseImageName("acme/vpc/aws")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme", "vpc", "aws"}, []string{namespace, name, system})
+	_, _, _, err = ParseImageName("acme/vpc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,308 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	//nolint:staticcheck // the OpenPGP package is frozen, not insecure, and reads the keys providers are signed with.
+	"golang.org/x/crypto/openpgp"
+)
+
+// ProvidersService is the key of the provider registry protocol in service discovery documents.
+const ProvidersService = "providers.v1"
+
+// defaultProtocol is the plugin protocol of providers whose release has no manifest, as it's the
+// one the public registry assumes.
+const defaultProtocol = "5.0"
+
+var (
+	ErrInvalidProvider = errors.New("invalid terraform provider")
+
+	typePattern     = regexp.MustCompile(`^[0-9a-z](?:[0-9a-z-]{0,62}[0-9a-z])?$`)
+	platformPattern = regexp.MustCompile(`^[0-9a-z]+_[0-9a-z]+$`)
+	shasumPattern   = regexp.MustCompile(`^[0-9a-f]{64}$`)
+)
+
+// Provider is a published version of a provider, addressed by namespace and type, with the
+// release files goreleaser and the HashiCorp provider scaffolding produce.
+// Source: https://developer.hashicorp.com/terraform/internals/provider-registry-protocol
+type Provider struct {
+	Namespace string `json:"namespace"`
+	Type      string `json:"type"`
+	Version   string `json:"version"`
+	// Protocols are the plugin protocol versions the provider supports.
+	Protocols []string   `json:"protocols"`
+	Platforms []Platform `json:"platforms"`
+	// SigningKey is the key the SHA256SUMS of the release is signed with.
+	SigningKey SigningKey `json:"signing_key"`
+}
+
+// Platform is the zip archive of a provider built for an operating system and architecture.
+type Platform struct {
+	OS       string `json:"os"`
+	Arch     string `json:"arch"`
+	Filename string `json:"filename"`
+	Shasum   string `json:"shasum"`
+}
+
+// SigningKey is an ASCII armored GPG public key, which Terraform verifies provider releases with.
+type SigningKey struct {
+	KeyID      string `json:"key_id"`
+	ASCIIArmor string `json:"ascii_armor"`
+}
+
+// ProviderRelease holds the files of a provider release, other than its zip archives which are
+// given by their checksums.
+type ProviderRelease struct {
+	SigningKey string
+	Shasums    []byte
+	Signature  []byte
+	// Manifest is optional, it names the protocol versions of the provider.
+	Manifest []byte
+	// Archives maps the filenames of the zip archives to their SHA-256 checksums.
+	Archives map[string]string
+}
+
+// ValidateProviderAddress checks the parts of a provider address, as Terraform rejects addresses
+// that don't match them.
+func ValidateProviderAddress(namespace, typ string) error {
+	if !namePattern.MatchString(namespace) {
+		return fmt.Errorf("%w: invalid namespace %q", ErrInvalidProvider, namespace)
+	}
+	if !typePattern.MatchString(typ) {
+		return fmt.Errorf("%w: invalid type %q", ErrInvalidProvider, typ)
+	}
+	return nil
+}
+
+// ImageName is the name the versions of a provider are stored under, which unlike the names of
+// modules has two parts.
+func (p *Provider) ImageName() string {
+	return p.Namespace + "/" + p.Type
+}
+
+// ShasumsFilename is the name of the checksums file of the release.
+func (p *Provider) ShasumsFilename() string {
+	return p.filePrefix() + "SHA256SUMS"
+}
+
+// SignatureFilename is the name of the detached signature of the checksums file.
+func (p *Provider) SignatureFilename() string {
+	return p.ShasumsFilename() + ".sig"
+}
+
+// ManifestFilename is the name of the manifest of the release.
+func (p *Provider) ManifestFilename() string {
+	return p.filePrefix() + "manifest.json"
+}
+
+// Platform returns the archive of the provider for the operating system and architecture.
+func (p *Provider) Platform(os, arch string) (Platform, bool) {
+	for _, platform := range p.Platforms {
+		if platform.OS == os && platform.Arch == arch {
+			return platform, true
+		}
+	}
+	return Platform{}, false
+}
+
+// PlatformOf returns the platform a file of the release is the archive for.
+func (p *Provider) PlatformOf(filename string) (Platform, bool) {
+	for _, platform := range p.Platforms {
+		if platform.Filename == filename {
+			return platform, true
+		}
+	}
+	return Platform{}, false
+}
+
+func (p *Provider) filePrefix() string {
+	return "terraform-provider-" + p.Type + "_" + p.Version + "_"
+}
+
+// IsProviderImage reports whether the versions stored under the name are of a provider rather
+// than a module.
+func IsProviderImage(image string) bool {
+	return strings.Count(image, "/") == 1
+}
+
+// ParseProviderImageName returns the address of a provider from the name its versions are stored
+// under.
+func ParseProviderImageName(image string) (namespace, typ string, err error) {
+	parts := strings.Split(image, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("%w: invalid provider %q", ErrInvalidProvider, image)
+	}
+	return parts[0], parts[1], ValidateProviderAddress(parts[0], parts[1])
+}
+
+// ReadProvider verifies the release with its signing key and fills in the protocols, platforms
+// and signing key of the provider. Every archive of the release must be listed in the signed
+// checksums with the checksum it was uploaded with, and vice versa.
+func ReadProvider(release ProviderRelease, provider *Provider) error {
+	keyID, err := verifySignature(release.SigningKey, release.Shasums, release.Signature)
+	if err != nil {
+		return err
+	}
+	shasums, err := parseShasums(release.Shasums)
+	if err != nil {
+		return err
+	}
+	protocols := []string{defaultProtocol}
+	if len(release.Manifest) > 0 {
+		if protocols, err = parseProtocols(release.Manifest); err != nil {
+			return err
+		}
+	}
+
+	var platforms []Platform
+	for filename, shasum := range shasums {
+		if !strings.HasSuffix(filename, ".zip") {
+			continue
+		}
+		platform, ok := provider.parseArchiveName(filename)
+		if !ok {
+			return fmt.Errorf("%w: %s isn't an archive of version %s of %s", ErrInvalidProvider, filename,
+				provider.Version, provider.Type)
+		}
+		uploaded, ok := release.Archives[filename]
+		if !ok {
+			return fmt.Errorf("%w: archive %s is missing", ErrInvalidProvider, filename)
+		}
+		if uploaded != shasum {
+			return fmt.Errorf("%w: checksum of %s doesn't match the SHA256SUMS", ErrInvalidProvider, filename)
+		}
+		platform.Shasum = shasum
+		platforms = append(platforms, platform)
+	}
+	for filename := range release.Archives {
+		if _, ok := shasums[filename]; !ok {
+			return fmt.Errorf("%w: archive %s isn't listed in the SHA256SUMS", ErrInvalidProvider, filename)
+		}
+	}
+	if len(platforms) == 0 {
+		return fmt.Errorf("%w: no archives", ErrInvalidProvider)
+	}
+	sort.Slice(platforms, func(i, j int) bool {
+		return platforms[i].OS+"_"+platforms[i].Arch < platforms[j].OS+"_"+platforms[j].Arch
+	})
+
+	provider.Protocols = protocols
+	provider.Platforms = platforms
+	provider.SigningKey = SigningKey{KeyID: keyID, ASCIIArmor: strings.TrimSpace(release.SigningKey)}
+	return nil
+}
+
+// IsReleaseFile reports whether the file belongs to the release of the provider version.
+func (p *Provider) IsReleaseFile(filename string) bool {
+	if filename == p.ShasumsFilename() || filename == p.SignatureFilename() || filename == p.ManifestFilename() {
+		return true
+	}
+	return p.IsArchive(filename)
+}
+
+// IsArchive reports whether a file of the release is the archive of a platform.
+func (p *Provider) IsArchive(filename string) bool {
+	_, ok := p.parseArchiveName(filename)
+	return ok
+}
+
+// parseArchiveName returns the platform of an archive named
+// terraform-provider-<type>_<version>_<os>_<arch>.zip.
+func (p *Provider) parseArchiveName(filename string) (Platform, bool) {
+	platform, ok := strings.CutPrefix(filename, p.filePrefix())
+	if !ok {
+		return Platform{}, false
+	}
+	platform, ok = strings.CutSuffix(platform, ".zip")
+	if !ok || !platformPattern.MatchString(platform) {
+		return Platform{}, false
+	}
+	os, arch, _ := strings.Cut(platform, "_")
+	return Platform{OS: os, Arch: arch, Filename: filename}, true
+}
+
+// verifySignature checks the detached signature, binary or ASCII armored, of the checksums with
+// the public key and returns the ID of the key.
+func verifySignature(signingKey string, shasums, signature []byte) (string, error) {
+	if strings.TrimSpace(signingKey) == "" {
+		return "", fmt.Errorf("%w: signing key is required", ErrInvalidProvider)
+	}
+	if len(shasums) == 0 || len(signature) == 0 {
+		return "", fmt.Errorf("%w: SHA256SUMS and its signature are required", ErrInvalidProvider)
+	}
+	keyRing, err := openpgp.ReadArmoredKeyRing(strings.NewReader(signingKey))
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to read signing key: %w", ErrInvalidProvider, err)
+	}
+	if len(keyRing) != 1 {
+		return "", fmt.Errorf("%w: signing key must contain one key, found %d", ErrInvalidProvider, len(keyRing))
+	}
+	_, err = openpgp.CheckDetachedSignature(keyRing, bytes.NewReader(shasums), bytes.NewReader(signature))
+	if err != nil {
+		_, err = openpgp.CheckArmoredDetachedSignature(keyRing, bytes.NewReader(shasums),
+			bytes.NewReader(signature))
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: SHA256SUMS signature doesn't verify with the signing key: %w",
+			ErrInvalidProvider, err)
+	}
+	return strings.ToUpper(keyRing[0].PrimaryKey.KeyIdString()), nil
+}
+
+// parseShasums parses the lines of a SHA256SUMS, a checksum and a filename separated by spaces.
+func parseShasums(data []byte) (map[string]string, error) {
+	shasums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !shasumPattern.MatchString(fields[0]) {
+			return nil, fmt.Errorf("%w: invalid SHA256SUMS line %q", ErrInvalidProvider, line)
+		}
+		shasums[strings.TrimPrefix(fields[1], "*")] = fields[0]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: failed to read SHA256SUMS: %w", ErrInvalidProvider, err)
+	}
+	return shasums, nil
+}
+
+// parseProtocols returns the protocol versions of a release manifest.
+func parseProtocols(manifest []byte) ([]string, error) {
+	var m struct {
+		Metadata struct {
+			ProtocolVersions []string `json:"protocol_versions"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("%w: failed to parse manifest: %w", ErrInvalidProvider, err)
+	}
+	if len(m.Metadata.ProtocolVersions) == 0 {
+		return nil, fmt.Errorf("%w: manifest names no protocol versions", ErrInvalidProvider)
+	}
+	return m.Metadata.ProtocolVersions, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	//nolint:staticcheck // the OpenPGP package is frozen, not insecure, and signs the test releases.
+	"golang.org/x/crypto/openpgp"
+	//nolint:staticcheck // see above.
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+const (
+	linuxShasum  = "1111111111111111111111111111111111111111111111111111111111111111"
+	darwinShasum = "2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+func signedRelease(t *testing.T, shasums string) ProviderRelease {
+	t.Helper()
+	entity, err := openpgp.NewEntity("Acme", "", "releases@acme.io", nil)
+	require.NoError(t, err)
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	var signature bytes.Buffer
+	require.NoError(t, openpgp.DetachSign(&signature, entity, strings.NewReader(shasums), nil))
+	return ProviderRelease{
+		SigningKey: key.String(),
+		Shasums:    []byte(shasums),
+		Signature:  signature.Bytes(),
+		Manifest:   []byte(`{"version":1,"metadata":{"protocol_versions":["6.0"]}}`),
+		Archives: map[string]string{
+			"terraform-provider-cloud_1.0.0_linux_amd64.zip":  linuxShasum,
+			"terraform-provider-cloud_1.0.0_darwin_arm64.zip": darwinShasum,
+		},
+	}
+}
+
+func TestReadProvider(t *testing.T) {
+	release := signedRelease(t, linuxShasum+"  terraform-provider-cloud_1.0.0_linux_amd64.zip\n"+
+		darwinShasum+"  terraform-provider-cloud_1.0.0_darwin_arm64.zip\n")
+	provider := &Provider{Namespace: "acme", Type: "cloud", Version: "1.0.0"}
+	require.NoError(t, ReadProvider(release, provider))
+	assert.Equal(t, []string{"6.0"}, provider.Protocols)
+	assert.Equal(t, []Platform{
+		{
+			OS: "darwin", Arch: "arm64", Filename: "terraform-provider-cloud_1.0.0_darwin_arm64.zip",
+			Shasum: darwinShasum,
+		},
+		{
+			OS: "linux", Arch: "amd64", Filename: "terraform-provider-cloud_1.0.0_linux_amd64.zip",
+			Shasum: linuxShasum,
+		},
+	}, provider.Platforms)
+	assert.Len(t, provider.SigningKey.KeyID, 16)
+	assert.Equal(t, "acme/cloud", provider.ImageName())
+	assert.Equal(t, "terraform-provider-cloud_1.0.0_SHA256SUMS.sig", provider.SignatureFilename())
+	assert.True(t, provider.IsReleaseFile("terraform-provider-cloud_1.0.0_manifest.json"))
+	assert.False(t, provider.IsReleaseFile("terraform-provider-cloud_1.0.1_linux_amd64.zip"))
+}
+
+func TestReadProviderInvalid(t *testing.T) {
+	shasums := linuxShasum + "  terraform-provider-cloud_1.0.0_linux_amd64.zip\n"
+	for name, modify := range map[string]func(*ProviderRelease){
+		"unlisted archive": func(*ProviderRelease) {},
+		"checksum mismatch": func(r *ProviderRelease) {
+			delete(r.Archives, "terraform-provider-cloud_1.0.0_darwin_arm64.zip")
+			r.Archives["terraform-provider-cloud_1.0.0_linux_amd64.zip"] = darwinShasum
+		},
+		"bad signature": func(r *ProviderRelease) {
+			delete(r.Archives, "terraform-provider-cloud_1.0.0_darwin_arm64.zip")
+			r.Shasums = []byte(darwinShasum + "  terraform-provider-cloud_1.0.0_linux_amd64.zip\n")
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			release := signedRelease(t, shasums)
+			modify(&release)
+			provider := &Provider{Namespace: "acme", Type: "cloud", Version: "1.0.0"}
+			assert.ErrorIs(t, ReadProvider(release, provider), ErrInvalidProvider)
+		})
+	}
+}
+
+func TestParseProviderImageName(t *testing.T) {
+	namespace, typ, err := ParseProviderImageName("acme/cloud")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acme", "cloud"}, []string{namespace, typ})
+	assert.True(t, IsProviderImage("acme/cloud"))
+	assert.False(t, IsProviderImage("acme/vpc/aws"))
+	_, _, err = ParseProviderImageName("acme/Cloud")
+	assert.Error(t, err)
+}
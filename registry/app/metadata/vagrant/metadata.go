@@ -0,0 +1,116 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vagrant
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// BoxExtension is the extension the files of boxes are stored with.
+const BoxExtension = ".box"
+
+var (
+	ErrInvalidBox = errors.New("invalid box")
+
+	// namePattern matches the organizations and the names of boxes, which Vagrant joins with a slash.
+	namePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+	// versionPattern matches the versions Vagrant compares numerically, such as 1.2.0.
+	versionPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+	// providerPattern matches the names of providers and architectures, such as vmware_desktop.
+	providerPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Provider is a box of a version built for a provider, and an architecture unless it runs on any.
+type Provider struct {
+	Name         string `json:"name"`
+	Architecture string `json:"architecture,omitempty"`
+	Filename     string `json:"filename"`
+	Size         int64  `json:"size"`
+	Sha256       string `json:"sha256"`
+}
+
+// BoxName returns the name Vagrant refers to a box of an organization by, such as hashicorp/bionic64.
+func BoxName(org, name string) string {
+	return org + "/" + name
+}
+
+// ValidateName checks the organization and the name of a box.
+func ValidateName(org, name string) error {
+	if !namePattern.MatchString(org) {
+		return fmt.Errorf("%w: invalid organization %q", ErrInvalidBox, org)
+	}
+	if !namePattern.MatchString(name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidBox, name)
+	}
+	return nil
+}
+
+func ValidateVersion(version string) error {
+	if !versionPattern.MatchString(version) {
+		return fmt.Errorf("%w: invalid version %q", ErrInvalidBox, version)
+	}
+	return nil
+}
+
+// ValidateProvider checks the provider of a box and its architecture, which is empty for boxes
+// that run on any.
+func ValidateProvider(provider, architecture string) error {
+	if !providerPattern.MatchString(provider) {
+		return fmt.Errorf("%w: invalid provider %q", ErrInvalidBox, provider)
+	}
+	if architecture != "" && !providerPattern.MatchString(architecture) {
+		return fmt.Errorf("%w: invalid architecture %q", ErrInvalidBox, architecture)
+	}
+	return nil
+}
+
+// BoxFilename returns the name of the file of the box of a version for a provider.
+func BoxFilename(name, version, provider, architecture string) string {
+	parts := []string{name, version, provider}
+	if architecture != "" {
+		parts = append(parts, architecture)
+	}
+	return strings.Join(parts, "-") + BoxExtension
+}
+
+// ParseBoxFilename returns the provider and the architecture of the box of a version of the box
+// named name from its filename.
+func ParseBoxFilename(name, version, filename string) (string, string, error) {
+	rest, ok := strings.CutPrefix(filename, name+"-"+version+"-")
+	if ok {
+		rest, ok = strings.CutSuffix(rest, BoxExtension)
+	}
+	if !ok {
+		return "", "", fmt.Errorf("%w: invalid filename %q", ErrInvalidBox, filename)
+	}
+	provider, architecture, _ := strings.Cut(rest, "-")
+	if err := ValidateProvider(provider, architecture); err != nil {
+		return "", "", err
+	}
+	return provider, architecture, nil
+}
+
+// FindProvider returns the box of the providers built for the provider and the architecture.
+func FindProvider(providers []Provider, provider, architecture string) (Provider, bool) {
+	for _, p := range providers {
+		if p.Name == provider && p.Architecture == architecture {
+			return p, true
+		}
+	}
+	return Provider{}, false
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vagrant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, ValidateName("acme", "ubuntu-22.04"))
+	assert.ErrorIs(t, ValidateName("acme", "../ubuntu"), ErrInvalidBox)
+	assert.ErrorIs(t, ValidateName("", "ubuntu"), ErrInvalidBox)
+
+	assert.NoError(t, ValidateVersion("20240101.1.0"))
+	assert.ErrorIs(t, ValidateVersion("1.0.0-beta"), ErrInvalidBox)
+
+	assert.NoError(t, ValidateProvider("vmware_desktop", ""))
+	assert.NoError(t, ValidateProvider("virtualbox", "arm64"))
+	assert.ErrorIs(t, ValidateProvider("VirtualBox", ""), ErrInvalidBox)
+	assert.ErrorIs(t, ValidateProvider("libvirt", "x86/64"), ErrInvalidBox)
+}
+
+func TestBoxFilename(t *testing.T) {
+	assert.Equal(t, "ubuntu-1.2.0-virtualbox.box", BoxFilename("ubuntu", "1.2.0", "virtualbox", ""))
+	assert.Equal(t, "ubuntu-1.2.0-libvirt-arm64.box", BoxFilename("ubuntu", "1.2.0", "libvirt", "arm64"))
+}
+
+func TestParseBoxFilename(t *testing.T) {
+	provider, architecture, err := ParseBoxFilename("ubuntu", "1.2.0", "ubuntu-1.2.0-libvirt-arm64.box")
+	assert.NoError(t, err)
+	assert.Equal(t, "libvirt", provider)
+	assert.Equal(t, "arm64", architecture)
+
+	provider, architecture, err = ParseBoxFilename("ubuntu", "1.2.0", "ubuntu-1.2.0-virtualbox.box")
+	assert.NoError(t, err)
+	assert.Equal(t, "virtualbox", provider)
+	assert.Empty(t, architecture)
+
+	_, _, err = ParseBoxFilename("ubuntu", "1.2.0", "debian-1.2.0-virtualbox.box")
+	assert.ErrorIs(t, err, ErrInvalidBox)
+}
+
+func TestFindProvider(t *testing.T) {
+	providers := []Provider{
+		{Name: "virtualbox", Filename: "a.box"},
+		{Name: "virtualbox", Architecture: "arm64", Filename: "b.box"},
+	}
+	p, ok := FindProvider(providers, "virtualbox", "arm64")
+	assert.True(t, ok)
+	assert.Equal(t, "b.box", p.Filename)
+	_, ok = FindProvider(providers, "libvirt", "")
+	assert.False(t, ok)
+}
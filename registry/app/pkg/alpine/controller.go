@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"context"
+	"io"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the APK repositories of alpine registries.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	signer               *Signer
+	locker               *lease.Locker
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	UploadPackage(ctx context.Context, info ArtifactInfo, file io.ReaderAt, size int64) (
+		*commons.ResponseHeaders,
+		errcode.Error,
+	)
+	// GetIndex serves the APKINDEX.tar.gz of an architecture.
+	GetIndex(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	// GetPublicKey returns the name apk looks the key up by and the key the indices are signed with.
+	GetPublicKey(ctx context.Context) (string, []byte, errcode.Error)
+	DownloadPackage(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+}
+
+// NewController creates a new alpine controller, indices are left unsigned if signer is nil.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	signer *Signer,
+	locker *lease.Locker,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		signer:               signer,
+		locker:               locker,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
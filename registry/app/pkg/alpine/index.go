@@ -0,0 +1,346 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/alpine"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+const (
+	fileIndexContent = "APKINDEX"
+	fileDescription  = "DESCRIPTION"
+	signaturePrefix  = ".SIGN.RSA."
+)
+
+// architectures are the architectures Alpine is released for, whose indices list the noarch
+// packages even if no package was published for them.
+var architectures = []string{
+	"aarch64", "armhf", "armv7", "loongarch64", "ppc64le", "riscv64", "s390x", "x86", "x86_64",
+}
+
+// GetIndex serves the index of an architecture, which is stored on each upload.
+func (c *controller) GetIndex(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	if err := alpine.ValidateArchitecture(info.Architecture); err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, "/"+info.Architecture+"/"+FileIndex,
+		types.Registry{
+			ID:   reg.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) GetPublicKey(_ context.Context) (string, []byte, errcode.Error) {
+	if c.signer == nil {
+		return "", nil, errcode.ErrCodeNameUnknown.WithMessage("the repository isn't signed")
+	}
+	key, err := c.signer.PublicKey()
+	if err != nil {
+		return "", nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return c.signer.Name(), key, errcode.Error{}
+}
+
+// DownloadPackage serves a package of an architecture by its file name, noarch packages are
+// served for all architectures.
+func (c *controller) DownloadPackage(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	name, version, err := alpine.ParseFilename(info.Filename)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := alpine.ValidateArchitecture(info.Architecture); err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	metadata, err := c.getMetadata(ctx, reg.ID, name, version)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	architecture := ""
+	for _, p := range metadata.Packages {
+		if p.Filename == info.Filename && (p.Architecture == info.Architecture || p.Architecture == alpine.NoArch) {
+			architecture = p.Architecture
+		}
+	}
+	if architecture == "" {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("%s not found for %s", info.Filename, info.Architecture))
+	}
+
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+packagePath(name, version, architecture, info.Filename),
+		types.Registry{
+			ID:   reg.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, name, version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// updateIndices regenerates the indices listing the packages of an architecture from the published
+// packages and stores them, holding the index lease of the registry like the rpm repodata.
+func (c *controller) updateIndices(
+	ctx context.Context,
+	registry *types.Registry,
+	info ArtifactInfo,
+	architecture string,
+) error {
+	ctx, unlock, err := c.locker.LockIndex(ctx, registry.ID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	packages, err := c.listPackages(ctx, registry.ID)
+	if err != nil {
+		return err
+	}
+	indexed := []string{architecture}
+	if architecture == alpine.NoArch {
+		indexed = indexedArchitectures(packages)
+	}
+	description := registry.Name
+	if registry.Description != "" {
+		description = registry.Description
+	}
+	for _, arch := range indexed {
+		index, err := buildIndex(packagesOf(packages, arch), description, c.signer, time.Now())
+		if err != nil {
+			return err
+		}
+		_, err = c.fileManager.UploadFile(ctx, arch+"/"+FileIndex, info.RegIdentifier, registry.ID,
+			info.RootParentID, info.StorageRoot(), nil, bytes.NewReader(index), FileIndex)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listPackages returns the published packages of a registry, ordered by name and version.
+func (c *controller) listPackages(ctx context.Context, registryID int64) ([]database.AlpineFile, error) {
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registryID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	var packages []database.AlpineFile
+	for _, name := range names {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range *artifacts {
+			var metadata database.AlpineMetadata
+			if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+				return nil, fmt.Errorf("failed to parse metadata of %s %s: %w", name, a.Version, err)
+			}
+			packages = append(packages, metadata.Packages...)
+		}
+	}
+	return packages, nil
+}
+
+// indexedArchitectures returns the architectures with an index, which are the architectures Alpine
+// is released for, any other architecture packages were published for, and noarch.
+func indexedArchitectures(packages []database.AlpineFile) []string {
+	seen := make(map[string]bool)
+	for _, arch := range architectures {
+		seen[arch] = true
+	}
+	for _, p := range packages {
+		seen[p.Architecture] = true
+	}
+	seen[alpine.NoArch] = true
+	result := make([]string, 0, len(seen))
+	for arch := range seen {
+		result = append(result, arch)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// packagesOf returns the packages installable on an architecture.
+func packagesOf(packages []database.AlpineFile, architecture string) []database.AlpineFile {
+	var result []database.AlpineFile
+	for _, p := range packages {
+		if p.Architecture == architecture || p.Architecture == alpine.NoArch {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// buildIndex returns the APKINDEX.tar.gz of the packages. Signed indices are prefixed with a
+// signature segment holding the signature of the gzipped index, like signed packages are.
+func buildIndex(
+	packages []database.AlpineFile,
+	description string,
+	signer *Signer,
+	modified time.Time,
+) ([]byte, error) {
+	index, err := writeSegment([]segmentFile{
+		{name: fileDescription, content: []byte(description)},
+		{name: fileIndexContent, content: buildIndexContent(packages)},
+	}, modified, true)
+	if err != nil {
+		return nil, err
+	}
+	if signer == nil {
+		return index, nil
+	}
+	signature, err := signer.Sign(index)
+	if err != nil {
+		return nil, err
+	}
+	// the signature segment is written without the end of archive marker, so apk reads the
+	// segments as a single archive
+	signatureSegment, err := writeSegment([]segmentFile{
+		{name: signaturePrefix + signer.Name(), content: signature},
+	}, modified, false)
+	if err != nil {
+		return nil, err
+	}
+	return append(signatureSegment, index...), nil
+}
+
+// buildIndexContent returns the entries of the packages, one block of fields per package.
+func buildIndexContent(packages []database.AlpineFile) []byte {
+	var buf bytes.Buffer
+	field := func(key, value string) {
+		if value != "" {
+			buf.WriteString(key + ":" + value + "\n")
+		}
+	}
+	for _, p := range packages {
+		field("C", p.Checksum)
+		field("P", p.Name)
+		field("V", p.Version)
+		field("A", p.Architecture)
+		field("S", strconv.FormatInt(p.Size, 10))
+		field("I", strconv.FormatInt(p.InstalledSize, 10))
+		field("T", p.Description)
+		field("U", p.URL)
+		field("L", p.License)
+		field("o", p.Origin)
+		field("m", p.Maintainer)
+		if p.BuildDate > 0 {
+			field("t", strconv.FormatInt(p.BuildDate, 10))
+		}
+		field("c", p.Commit)
+		field("D", strings.Join(p.Depends, " "))
+		field("p", strings.Join(p.Provides, " "))
+		field("i", strings.Join(p.InstallIf, " "))
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+type segmentFile struct {
+	name    string
+	content []byte
+}
+
+// writeSegment returns a gzipped tar archive of the files.
+func writeSegment(files []segmentFile, modified time.Time, endMarker bool) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    f.name,
+			Mode:    0o644,
+			Size:    int64(len(f.content)),
+			ModTime: modified,
+			Format:  tar.FormatUSTAR,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to write index: %w", err)
+		}
+		if _, err := tw.Write(f.content); err != nil {
+			return nil, fmt.Errorf("failed to write index: %w", err)
+		}
+	}
+	closeTar := tw.Flush
+	if endMarker {
+		closeTar = tw.Close
+	}
+	if err := closeTar(); err != nil {
+		return nil, fmt.Errorf("failed to write index: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress index: %w", err)
+	}
+	return buf.Bytes(), nil
+}
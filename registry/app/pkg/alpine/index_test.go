@@ -0,0 +1,143 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // see sign.go.
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/metadata/alpine"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPackages() []database.AlpineFile {
+	return []database.AlpineFile{
+		{
+			Metadata: alpine.Metadata{
+				Name: "hello", Version: "2.12-r1", Architecture: "x86_64", Description: "Prints a greeting",
+				License: "GPL-3.0-or-later", InstalledSize: 61440, BuildDate: 1700000000,
+				Depends: []string{"so:libc.musl-x86_64.so.1", "busybox"}, Checksum: "Q1abc=",
+			},
+			Filename: "hello-2.12-r1.apk",
+			Size:     20480,
+		},
+		{
+			Metadata: alpine.Metadata{
+				Name: "hello-doc", Version: "2.12-r1", Architecture: alpine.NoArch, Checksum: "Q1def=",
+			},
+			Filename: "hello-doc-2.12-r1.apk",
+			Size:     1024,
+		},
+	}
+}
+
+// readSegments returns the files of each gzip stream of an index.
+func readSegments(t *testing.T, index []byte) ([]map[string]string, [][]byte) {
+	t.Helper()
+	r := bytes.NewReader(index)
+	var segments []map[string]string
+	var raw [][]byte
+	for r.Len() > 0 {
+		start := len(index) - r.Len()
+		z, err := gzip.NewReader(r)
+		require.NoError(t, err)
+		z.Multistream(false)
+		tr := tar.NewReader(z)
+		files := make(map[string]string)
+		for {
+			h, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[h.Name] = string(content)
+		}
+		_, err = io.Copy(io.Discard, z)
+		require.NoError(t, err)
+		segments = append(segments, files)
+		raw = append(raw, index[start:len(index)-r.Len()])
+	}
+	return segments, raw
+}
+
+func TestBuildIndex(t *testing.T) {
+	index, err := buildIndex(testPackages(), "my registry", nil, time.Unix(1700000000, 0))
+	require.NoError(t, err)
+	segments, _ := readSegments(t, index)
+	require.Len(t, segments, 1)
+	assert.Equal(t, "my registry", segments[0][fileDescription])
+	assert.Equal(t, "C:Q1abc=\nP:hello\nV:2.12-r1\nA:x86_64\nS:20480\nI:61440\nT:Prints a greeting\n"+
+		"L:GPL-3.0-or-later\nt:1700000000\nD:so:libc.musl-x86_64.so.1 busybox\n\n"+
+		"C:Q1def=\nP:hello-doc\nV:2.12-r1\nA:noarch\nS:1024\nI:0\n\n", segments[0][fileIndexContent])
+}
+
+func TestBuildIndexSigned(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	signer, err := ParseSigningKey(string(pemKey), "test.rsa.pub")
+	require.NoError(t, err)
+
+	index, err := buildIndex(testPackages(), "my registry", signer, time.Unix(1700000000, 0))
+	require.NoError(t, err)
+	segments, raw := readSegments(t, index)
+	require.Len(t, segments, 2)
+	signature, ok := segments[0][".SIGN.RSA.test.rsa.pub"]
+	require.True(t, ok)
+	//nolint:gosec // see sign.go.
+	digest := sha1.Sum(raw[1])
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, digest[:], []byte(signature)))
+
+	publicKey, err := signer.PublicKey()
+	require.NoError(t, err)
+	assert.Contains(t, string(publicKey), "BEGIN PUBLIC KEY")
+}
+
+func TestParseSigningKey(t *testing.T) {
+	signer, err := ParseSigningKey("", "test.rsa.pub")
+	assert.NoError(t, err)
+	assert.Nil(t, signer)
+
+	_, err = ParseSigningKey("not a key", "test.rsa.pub")
+	assert.Error(t, err)
+}
+
+func TestIndexedArchitectures(t *testing.T) {
+	packages := testPackages()
+	assert.Len(t, packagesOf(packages, "x86_64"), 2)
+	assert.Len(t, packagesOf(packages, "aarch64"), 1)
+
+	packages = append(packages, database.AlpineFile{Metadata: alpine.Metadata{Architecture: "mips64"}})
+	indexed := indexedArchitectures(packages)
+	assert.Contains(t, indexed, "mips64")
+	assert.Contains(t, indexed, "x86_64")
+	assert.Contains(t, indexed, alpine.NoArch)
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // apk verifies the .SIGN.RSA. signatures of indices with SHA-1.
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Signer signs the indices of the repositories, which apk verifies with the public key installed
+// in /etc/apk/keys under the name of the key.
+type Signer struct {
+	key  *rsa.PrivateKey
+	name string
+}
+
+// ParseSigningKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key, returning nil if key
+// is empty.
+func ParseSigningKey(key, name string) (*Signer, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, nil //nolint:nilnil
+	}
+	if name == "" || path.Base(name) != name {
+		return nil, fmt.Errorf("invalid alpine key name %q", name)
+	}
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("alpine signing key must be PEM encoded")
+	}
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("alpine signing key must be a private key, found %s", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read alpine signing key: %w", err)
+	}
+	rsaKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("alpine signing key must be an RSA key")
+	}
+	return &Signer{key: rsaKey, name: name}, nil
+}
+
+// Name returns the name of the key, which the signature of an index refers to.
+func (s *Signer) Name() string {
+	return s.name
+}
+
+// Sign returns the signature of the control segment of an index.
+func (s *Signer) Sign(content []byte) ([]byte, error) {
+	//nolint:gosec // see the import.
+	digest := sha1.Sum(content)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA1, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign index: %w", err)
+	}
+	return signature, nil
+}
+
+// PublicKey returns the PEM encoded public key, which is installed in /etc/apk/keys.
+func (s *Signer) PublicKey() ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(&s.key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize public key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
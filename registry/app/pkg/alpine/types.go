@@ -0,0 +1,30 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+// FileIndex is the index of an architecture, which apk fetches from <repository>/<arch>/.
+const FileIndex = "APKINDEX.tar.gz"
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Architecture is the architecture directory requested.
+	Architecture string
+	// Filename is the .apk requested for download.
+	Filename string
+}
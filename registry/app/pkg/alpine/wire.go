@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alpine
+
+import (
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+func ControllerProvider(
+	config *types.Config,
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	locker *lease.Locker,
+	downloadStatRecorder *downloadstats.Recorder,
+) (Controller, error) {
+	signer, err := ParseSigningKey(config.Registry.Alpine.SigningKey, config.Registry.Alpine.KeyName)
+	if err != nil {
+		return nil, err
+	}
+	return NewController(registryDao, imageDao, artifactDao, fileManager, tx, signer, locker,
+		downloadStatRecorder), nil
+}
+
+var ControllerSet = wire.NewSet(ControllerProvider)
+var WireSet = wire.NewSet(ControllerSet)
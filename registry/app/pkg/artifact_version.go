@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	store2 "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ErrVersionExists is returned by ClaimArtifactVersion when the version has already been published.
+var ErrVersionExists = errors.New("artifact version already exists")
+
+// ClaimArtifactVersion creates the image and the artifact version in a single transaction, before any
+// file of the version is uploaded. It fails with ErrVersionExists if the version already exists, so of
+// several concurrent create-only uploads of the same version exactly one succeeds.
+func ClaimArtifactVersion(
+	ctx context.Context,
+	tx dbtx.Transactor,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	image *types.Image,
+	artifact *types.Artifact,
+) error {
+	return tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := imageDao.CreateOrUpdate(ctx, image); err != nil {
+			return fmt.Errorf("failed to create image %s: %w", image.Name, err)
+		}
+		artifact.ImageID = image.ID
+		if err := artifactDao.Create(ctx, artifact); err != nil {
+			if errors.Is(err, store2.ErrDuplicate) {
+				return fmt.Errorf("%w: %s@%s", ErrVersionExists, image.Name, artifact.Version)
+			}
+			return fmt.Errorf("failed to create artifact %s@%s: %w", image.Name, artifact.Version, err)
+		}
+		return nil
+	})
+}
+
+// releaseTimeout bounds the release of a claimed version, which outlives the request.
+const releaseTimeout = 30 * time.Second
+
+// ReleaseArtifactVersion removes a version claimed with ClaimArtifactVersion whose upload failed,
+// so that it can be published again. The release isn't canceled with the request, as a client that
+// disconnects would otherwise leave the version behind without files, and it keeps the version if
+// a concurrent upload has added a file to it since.
+func ReleaseArtifactVersion(ctx context.Context, artifactDao store.ArtifactRepository, artifact *types.Artifact) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseTimeout)
+	defer cancel()
+
+	deleted, err := artifactDao.DeleteEmptyByID(ctx, artifact.ID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to release claimed artifact version %s", artifact.Version)
+		return
+	}
+	if !deleted {
+		log.Ctx(ctx).Info().Msgf("kept claimed artifact version %s as it has files", artifact.Version)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type releaseArtifactRepository struct {
+	store.ArtifactRepository
+	ctxErr  error
+	deleted int64
+}
+
+func (r *releaseArtifactRepository) DeleteEmptyByID(ctx context.Context, id int64) (bool, error) {
+	r.ctxErr = ctx.Err()
+	r.deleted = id
+	return true, nil
+}
+
+func TestReleaseArtifactVersionAfterDisconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	repo := &releaseArtifactRepository{}
+
+	ReleaseArtifactVersion(ctx, repo, &types.Artifact{ID: 7, Version: "1.0.0"})
+
+	assert.EqualValues(t, 7, repo.deleted)
+	assert.NoError(t, repo.ctxErr, "the release isn't canceled with the request")
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobserve
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/registry/app/storage"
+
+	"github.com/rs/zerolog/log"
+)
+
+const defaultWriteBufferSize = 256 * 1024
+
+// Config tunes how blobs are streamed to the clients.
+type Config struct {
+	// WriteBufferSize is the size of the chunks written to the response when the content
+	// has to be copied through user space, e.g. for HTTP/2 or TLS connections.
+	WriteBufferSize int
+	// ZeroCopy hands files of the filesystem storage over to net/http, which sends them
+	// with sendfile(2) on plain HTTP/1.1 connections.
+	ZeroCopy bool
+}
+
+// Server streams blob content to http responses and records the throughput of every download.
+type Server struct {
+	config  Config
+	buffers sync.Pool
+	stats   *Stats
+}
+
+func NewServer(config Config) *Server {
+	if config.WriteBufferSize <= 0 {
+		config.WriteBufferSize = defaultWriteBufferSize
+	}
+	s := &Server{
+		config: config,
+		stats:  newStats(),
+	}
+	s.buffers.New = func() any {
+		buf := make([]byte, s.config.WriteBufferSize)
+		return &buf
+	}
+	return s
+}
+
+// Stats returns the throughput recorded since the server was created.
+func (s *Server) Stats() *Stats {
+	return s.stats
+}
+
+// ServeContent works like http.ServeContent, including the support for range and conditional
+// requests, but copies the content with the configured buffer size or, if possible, without
+// copying it at all.
+func (s *Server) ServeContent(w http.ResponseWriter, r *http.Request, name string, content io.ReadSeeker) {
+	rw := &responseWriter{ResponseWriter: w, server: s, ctx: r.Context(), proto: r.Proto}
+	http.ServeContent(rw, r, name, time.Time{}, content)
+}
+
+// Copy writes n bytes of src to w, or everything until EOF if n is negative.
+func (s *Server) Copy(w http.ResponseWriter, r *http.Request, src io.Reader, n int64) (int64, error) {
+	rw := &responseWriter{ResponseWriter: w, server: s, ctx: r.Context(), proto: r.Proto}
+	if n >= 0 {
+		src = io.LimitReader(src, n)
+	}
+	return rw.ReadFrom(src)
+}
+
+func (s *Server) copy(w io.Writer, src io.Reader) (int64, Mode, error) {
+	buf, _ := s.buffers.Get().(*[]byte)
+	defer s.buffers.Put(buf)
+
+	if lr, ok := src.(*io.LimitedReader); ok {
+		if fr, ok := lr.R.(*storage.FileReader); ok {
+			written, zeroCopy, err := fr.WriteToN(w, lr.N, *buf, s.config.ZeroCopy)
+			lr.N -= written
+			if zeroCopy {
+				return written, ModeZeroCopy, err
+			}
+			return written, ModeBuffered, err
+		}
+	}
+	written, err := io.CopyBuffer(struct{ io.Writer }{w}, src, *buf)
+	return written, ModeBuffered, err
+}
+
+// responseWriter intercepts the io.Copy calls of http.ServeContent.
+type responseWriter struct {
+	http.ResponseWriter
+	server *Server
+	ctx    context.Context
+	proto  string
+}
+
+func (w *responseWriter) ReadFrom(src io.Reader) (int64, error) {
+	start := time.Now()
+	written, mode, err := w.server.copy(w.ResponseWriter, src)
+	w.server.stats.record(mode, w.proto, written, time.Since(start))
+	if err != nil {
+		log.Ctx(w.ctx).Debug().Err(err).Msgf("blob download aborted after %d bytes", written)
+	}
+	return written, err
+}
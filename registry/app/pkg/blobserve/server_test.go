@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobserve
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+	"github.com/harness/gitness/registry/app/storage"
+
+	"github.com/rs/zerolog/hlog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlob(t *testing.T) (*filesystem.Driver, string) {
+	t.Helper()
+	d := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 25})
+	content := strings.Repeat("0123456789", 10000)
+	require.NoError(t, d.PutContent(context.Background(), "/blob", []byte(content)))
+	return d, content
+}
+
+func blobHandler(t *testing.T, s *Server, d *filesystem.Driver, size int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fr, err := storage.NewFileReader(r.Context(), d, "/blob", int64(size))
+		require.NoError(t, err)
+		defer fr.Close()
+		s.ServeContent(w, r, "blob", fr)
+	})
+}
+
+func get(t *testing.T, handler http.Handler, rangeHeader string) (int, string) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	if rangeHeader != "" {
+		req.Header.Set("Range", rangeHeader)
+	}
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestServeContent(t *testing.T) {
+	d, content := newTestBlob(t)
+
+	s := NewServer(Config{WriteBufferSize: 4096, ZeroCopy: true})
+	code, body := get(t, blobHandler(t, s, d, len(content)), "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, content, body)
+
+	code, body = get(t, blobHandler(t, s, d, len(content)), "bytes=10-29")
+	assert.Equal(t, http.StatusPartialContent, code)
+	assert.Equal(t, content[10:30], body)
+
+	stats := s.Stats().Snapshot()
+	require.Len(t, stats, 1)
+	assert.Equal(t, ModeZeroCopy, stats[0].Mode)
+	assert.Equal(t, "HTTP/1.1", stats[0].Protocol)
+	assert.Equal(t, int64(2), stats[0].Downloads)
+	assert.Equal(t, int64(len(content)+20), stats[0].Bytes)
+
+	s = NewServer(Config{WriteBufferSize: 4096})
+	code, body = get(t, blobHandler(t, s, d, len(content)), "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, content, body)
+
+	stats = s.Stats().Snapshot()
+	require.Len(t, stats, 1)
+	assert.Equal(t, ModeBuffered, stats[0].Mode)
+	assert.Equal(t, int64(len(content)), stats[0].LargestDownload)
+}
+
+func TestServeContentWrappedResponseWriter(t *testing.T) {
+	d, content := newTestBlob(t)
+
+	// the access log of the router wraps the response writer, but passes io.ReaderFrom on.
+	s := NewServer(Config{WriteBufferSize: 4096, ZeroCopy: true})
+	var logged int
+	accessLog := hlog.AccessHandler(func(_ *http.Request, _, size int, _ time.Duration) {
+		logged = size
+	})
+	code, body := get(t, accessLog(blobHandler(t, s, d, len(content))), "bytes=100-")
+	assert.Equal(t, http.StatusPartialContent, code)
+	assert.Equal(t, content[100:], body)
+	assert.Equal(t, len(content)-100, logged)
+
+	stats := s.Stats().Snapshot()
+	require.Len(t, stats, 1)
+	assert.Equal(t, ModeZeroCopy, stats[0].Mode)
+	assert.Equal(t, int64(len(content)-100), stats[0].Bytes)
+
+	// a writer that hides io.ReaderFrom falls back to the buffered copy.
+	s = NewServer(Config{WriteBufferSize: 4096, ZeroCopy: true})
+	hideReaderFrom := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(struct{ http.ResponseWriter }{w}, r)
+		})
+	}
+	code, body = get(t, hideReaderFrom(blobHandler(t, s, d, len(content))), "bytes=100-")
+	assert.Equal(t, http.StatusPartialContent, code)
+	assert.Equal(t, content[100:], body)
+
+	stats = s.Stats().Snapshot()
+	require.Len(t, stats, 1)
+	assert.Equal(t, ModeBuffered, stats[0].Mode)
+	assert.Equal(t, int64(len(content)-100), stats[0].Bytes)
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blobserve
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/api/render"
+)
+
+// Mode is the way the content of a download was written to the connection.
+type Mode string
+
+const (
+	// ModeBuffered copies the content through a buffer in user space.
+	ModeBuffered Mode = "buffered"
+	// ModeZeroCopy hands a file over to the connection, see Config.ZeroCopy.
+	ModeZeroCopy Mode = "zero_copy"
+)
+
+// Throughput summarizes the downloads served with one mode over one protocol.
+type Throughput struct {
+	Mode            Mode    `json:"mode"`
+	Protocol        string  `json:"protocol"`
+	Downloads       int64   `json:"downloads"`
+	Bytes           int64   `json:"bytes"`
+	Seconds         float64 `json:"seconds"`
+	BytesPerSecond  float64 `json:"bytes_per_second"`
+	LargestDownload int64   `json:"largest_download"`
+}
+
+type statsKey struct {
+	mode  Mode
+	proto string
+}
+
+type counters struct {
+	downloads int64
+	bytes     int64
+	duration  time.Duration
+	largest   int64
+}
+
+// Stats accumulates the throughput of the blob downloads per mode and protocol, so that
+// the effect of the buffer sizes and of zero-copy can be compared before and after a change.
+type Stats struct {
+	mu       sync.Mutex
+	counters map[statsKey]*counters
+}
+
+func newStats() *Stats {
+	return &Stats{counters: map[statsKey]*counters{}}
+}
+
+func (s *Stats) record(mode Mode, proto string, written int64, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := statsKey{mode: mode, proto: proto}
+	c, ok := s.counters[key]
+	if !ok {
+		c = &counters{}
+		s.counters[key] = c
+	}
+	c.downloads++
+	c.bytes += written
+	c.duration += duration
+	if written > c.largest {
+		c.largest = written
+	}
+}
+
+// Snapshot returns the current throughput ordered by mode and protocol.
+func (s *Stats) Snapshot() []Throughput {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]Throughput, 0, len(s.counters))
+	for key, c := range s.counters {
+		t := Throughput{
+			Mode:            key.mode,
+			Protocol:        key.proto,
+			Downloads:       c.downloads,
+			Bytes:           c.bytes,
+			Seconds:         c.duration.Seconds(),
+			LargestDownload: c.largest,
+		}
+		if t.Seconds > 0 {
+			t.BytesPerSecond = float64(c.bytes) / t.Seconds
+		}
+		result = append(result, t)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Mode != result[j].Mode {
+			return result[i].Mode < result[j].Mode
+		}
+		return result[i].Protocol < result[j].Protocol
+	})
+	return result
+}
+
+// HandleStats writes the throughput snapshot of the server.
+func HandleStats(server *Server) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		render.JSON(w, http.StatusOK, server.Stats().Snapshot())
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/cargo"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// GetConfig returns the index configuration. Registries always require authentication, so cargo
+// sends its token for index requests as well.
+func (c *controller) GetConfig(ctx context.Context, info ArtifactInfo) *Config {
+	registryURL := c.registryURL(ctx, info)
+	return &Config{
+		DL:           registryURL + "/api/v1/crates",
+		API:          registryURL,
+		AuthRequired: true,
+	}
+}
+
+// GetIndex returns the index file of a crate, one JSON line per version.
+func (c *controller) GetIndex(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error) {
+	artifacts, errc := c.getArtifacts(ctx, info)
+	if errc.Code != 0 {
+		return nil, errc
+	}
+	index, err := buildIndex(artifacts)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return index, errcode.Error{}
+}
+
+// DownloadCrate serves the .crate of a version.
+func (c *controller) DownloadCrate(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+
+	path := "/" + info.Image + "/" + info.Version + "/" + cargo.Filename(info.Image, info.Version)
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
+		ID:   reg.ID,
+		Name: info.RegIdentifier,
+	}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, info.Image, info.Version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) getArtifacts(ctx context.Context, info ArtifactInfo) ([]types.Artifact, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, info.Image)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) || (err == nil && len(*artifacts) == 0) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("crate " + info.Image + " not found")
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return *artifacts, errcode.Error{}
+}
+
+// buildIndex returns the index lines of the versions of a crate in ascending order.
+func buildIndex(artifacts []types.Artifact) ([]byte, error) {
+	sorted := make([]types.Artifact, len(artifacts))
+	copy(sorted, artifacts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, sorted[i].Version, sorted[j].Version) < 0
+	})
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, a := range sorted {
+		var metadata database.CrateMetadata
+		if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+		}
+		if err := encoder.Encode(metadata.ToIndexEntry(metadata.Sha256)); err != nil {
+			return nil, fmt.Errorf("failed to encode version %s: %w", a.Version, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
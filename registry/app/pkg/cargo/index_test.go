@@ -0,0 +1,204 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+	"github.com/harness/gitness/registry/app/metadata/cargo"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildIndex(t *testing.T) {
+	artifact := func(version, sha256 string, md cargo.Metadata) types.Artifact {
+		md.Name = "Demo"
+		md.Version = version
+		metadata, err := json.Marshal(&database.CrateMetadata{Sha256: sha256, Metadata: md})
+		require.NoError(t, err)
+		return types.Artifact{Version: version, Metadata: metadata}
+	}
+	artifacts := []types.Artifact{
+		artifact("1.10.0", "ccc", cargo.Metadata{
+			Dependencies: []cargo.Dependency{{Name: "serde", VersionReq: "^1.0", Kind: "normal"}},
+		}),
+		artifact("1.2.0", "bbb", cargo.Metadata{}),
+		artifact("1.2.0-beta.1", "aaa", cargo.Metadata{}),
+	}
+
+	index, err := buildIndex(artifacts)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(index), "\n"), "\n")
+	require.Len(t, lines, 3)
+
+	var versions []string
+	for _, line := range lines {
+		var entry cargo.IndexEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		assert.Equal(t, "Demo", entry.Name)
+		versions = append(versions, entry.Version+" "+entry.Checksum)
+	}
+	assert.Equal(t, []string{"1.2.0-beta.1 aaa", "1.2.0 bbb", "1.10.0 ccc"}, versions)
+	assert.Contains(t, lines[2], `"deps":[{"name":"serde","req":"^1.0"`)
+}
+
+type fakeRegistryRepository struct {
+	store.RegistryRepository
+	registry *types.Registry
+}
+
+func (f *fakeRegistryRepository) GetByRootParentIDAndName(
+	_ context.Context, _ int64, name string,
+) (*types.Registry, error) {
+	if name != f.registry.Name {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return f.registry, nil
+}
+
+type fakeNodesRepository struct {
+	store.NodesRepository
+	blobIDs map[string]string
+}
+
+func (f *fakeNodesRepository) GetByPathAndRegistryID(
+	_ context.Context, registryID int64, path string,
+) (*types.Node, error) {
+	blobID, ok := f.blobIDs[path]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return &types.Node{RegistryID: registryID, NodePath: path, IsFile: true, BlobID: blobID}, nil
+}
+
+type fakeGenericBlobRepository struct {
+	store.GenericBlobRepository
+}
+
+func (f *fakeGenericBlobRepository) FindByID(_ context.Context, id string) (*types.GenericBlob, error) {
+	return &types.GenericBlob{ID: id, Sha256: "abc", Size: 3}, nil
+}
+
+type fakeImageRepository struct {
+	store.ImageRepository
+}
+
+func (f *fakeImageRepository) GetByName(_ context.Context, registryID int64, name string) (*types.Image, error) {
+	return &types.Image{ID: 5, Name: name, RegistryID: registryID}, nil
+}
+
+type fakeArtifactRepository struct {
+	store.ArtifactRepository
+	artifacts map[string]int64
+}
+
+func (f *fakeArtifactRepository) GetByName(_ context.Context, imageID int64, version string) (*types.Artifact, error) {
+	id, ok := f.artifacts[version]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return &types.Artifact{ID: id, ImageID: imageID, Version: version}, nil
+}
+
+type fakeDownloadStatRepository struct {
+	store.DownloadStatRepository
+	created []*types.DownloadStat
+}
+
+func (f *fakeDownloadStatRepository) Create(_ context.Context, downloadStat *types.DownloadStat) error {
+	f.created = append(f.created, downloadStat)
+	return nil
+}
+
+func newDownloadController(t *testing.T, downloadStats *fakeDownloadStatRepository) *controller {
+	t.Helper()
+	d := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+	storageService, err := storage.NewStorageService(d)
+	require.NoError(t, err)
+	app := filemanager.NewApp(context.Background(), &gitnesstypes.Config{}, storageService)
+	nodes := &fakeNodesRepository{blobIDs: map[string]string{"/demo/1.0.0/demo-1.0.0.crate": "blob-1"}}
+	recorder, err := downloadstats.NewRecorder(downloadstats.Config{}, nil, downloadStats)
+	require.NoError(t, err)
+	return &controller{
+		fileManager:          filemanager.NewFileManager(app, nil, &fakeGenericBlobRepository{}, nodes, nil),
+		registryDao:          &fakeRegistryRepository{registry: &types.Registry{ID: 2, Name: "crates"}},
+		imageDao:             &fakeImageRepository{},
+		artifactDao:          &fakeArtifactRepository{artifacts: map[string]int64{"1.0.0": 7}},
+		downloadStatRecorder: recorder,
+	}
+}
+
+func TestDownloadCrateRecordsDownload(t *testing.T) {
+	info := func(version string) ArtifactInfo {
+		return ArtifactInfo{
+			ArtifactInfo: &pkg.ArtifactInfo{
+				BaseInfo:      &pkg.BaseInfo{RootIdentifier: "acme", RootParentID: 1},
+				RegIdentifier: "crates",
+				Image:         "demo",
+			},
+			Version: version,
+		}
+	}
+
+	t.Run("download", func(t *testing.T) {
+		downloadStats := &fakeDownloadStatRepository{}
+		c := newDownloadController(t, downloadStats)
+		ctx := request.WithDownloadStat(context.Background(), &types.DownloadStat{Client: "ip:10.0.0.1"})
+
+		headers, fileReader, _, errc := c.DownloadCrate(ctx, info("1.0.0"))
+		require.True(t, commons.IsEmptyError(errc), errc.Error())
+		assert.Equal(t, http.StatusOK, headers.Code)
+		assert.NotNil(t, fileReader)
+		require.Len(t, downloadStats.created, 1)
+		assert.EqualValues(t, 7, downloadStats.created[0].ArtifactID)
+		assert.Equal(t, "ip:10.0.0.1", downloadStats.created[0].Client)
+	})
+
+	t.Run("missing crate", func(t *testing.T) {
+		downloadStats := &fakeDownloadStatRepository{}
+		c := newDownloadController(t, downloadStats)
+		ctx := request.WithDownloadStat(context.Background(), &types.DownloadStat{Client: "ip:10.0.0.1"})
+
+		_, _, _, errc := c.DownloadCrate(ctx, info("2.0.0"))
+		assert.False(t, commons.IsEmptyError(errc))
+		assert.Empty(t, downloadStats.created)
+	})
+
+	t.Run("without download stat", func(t *testing.T) {
+		downloadStats := &fakeDownloadStatRepository{}
+		c := newDownloadController(t, downloadStats)
+
+		_, _, _, errc := c.DownloadCrate(context.Background(), info("1.0.0"))
+		require.True(t, commons.IsEmptyError(errc), errc.Error())
+		assert.Empty(t, downloadStats.created, "only GET requests carry a download stat")
+	})
+}
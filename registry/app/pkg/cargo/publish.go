@@ -0,0 +1,145 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/cargo"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// PublishCrate stores a crate published by `cargo publish`; published versions are immutable,
+// like on crates.io. Crates are stored under their lowercase name, which the index is looked up by.
+func (c *controller) PublishCrate(
+	ctx context.Context,
+	info ArtifactInfo,
+	body io.Reader,
+) (*commons.ResponseHeaders, *PublishResponse, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	metadata, size, err := cargo.ReadPublish(body)
+	if err != nil {
+		return responseHeaders, nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := metadata.Validate(); err != nil {
+		return responseHeaders, nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	name := strings.ToLower(metadata.Name)
+	version := metadata.Version
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeCRATE {
+		return responseHeaders, nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a cargo registry", registry.Name))
+	}
+	published, err := c.isPublished(ctx, registry.ID, name, version)
+	if err != nil {
+		return responseHeaders, nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if published {
+		return responseHeaders, nil, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("crate %s %s was published before", metadata.Name, version))
+	}
+
+	filename := cargo.Filename(name, version)
+	fileInfo, err := c.fileManager.UploadFile(ctx, name+"/"+version+"/"+filename, info.RegIdentifier, registry.ID,
+		info.RootParentID, info.StorageRoot(), nil, io.LimitReader(body, size), filename)
+	if err != nil {
+		return responseHeaders, nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if fileInfo.Size != size {
+		return responseHeaders, nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("crate is truncated, read %d of %d bytes", fileInfo.Size, size))
+	}
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(&database.CrateMetadata{
+				Files: []database.File{{
+					Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+				}},
+				FileCount: 1,
+				Sha256:    fileInfo.Sha256,
+				Metadata:  *metadata,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return responseHeaders, nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, &PublishResponse{Warnings: PublishWarnings{
+		InvalidCategories: []string{},
+		InvalidBadges:     []string{},
+		Other:             []string{},
+	}}, errcode.Error{}
+}
+
+func (c *controller) isPublished(ctx context.Context, registryID int64, name, version string) (bool, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find crate %s: %w", name, err)
+	}
+	_, err = c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find version %s of crate %s: %w", version, name, err)
+	}
+	return true, nil
+}
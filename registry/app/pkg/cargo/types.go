@@ -0,0 +1,45 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Version is the version of the crate requested for download, empty for index requests.
+	Version string
+}
+
+// Config is the config.json at the root of the index, telling cargo where to download crates from
+// and where the web API is.
+// Source: https://doc.rust-lang.org/cargo/reference/registry-index.html#index-configuration
+type Config struct {
+	DL           string `json:"dl"`
+	API          string `json:"api"`
+	AuthRequired bool   `json:"auth-required"`
+}
+
+// PublishResponse is returned for a published crate, the registry doesn't raise any warnings.
+type PublishResponse struct {
+	Warnings PublishWarnings `json:"warnings"`
+}
+
+type PublishWarnings struct {
+	InvalidCategories []string `json:"invalid_categories"`
+	InvalidBadges     []string `json:"invalid_badges"`
+	Other             []string `json:"other"`
+}
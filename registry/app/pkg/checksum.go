@@ -0,0 +1,60 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+const (
+	ChecksumExtensionMD5    = ".md5"
+	ChecksumExtensionSHA1   = ".sha1"
+	ChecksumExtensionSHA256 = ".sha256"
+	ChecksumExtensionSHA512 = ".sha512"
+)
+
+// SplitChecksumFile splits the name of a checksum sidecar file, such as lib.jar.sha256, into the
+// name of the file it holds the checksum of and the extension of the checksum. It reports false
+// for a file that isn't a checksum sidecar file.
+func SplitChecksumFile(filename string) (string, string, bool) {
+	ext := strings.ToLower(filepath.Ext(filename))
+	switch ext {
+	case ChecksumExtensionMD5, ChecksumExtensionSHA1, ChecksumExtensionSHA256, ChecksumExtensionSHA512:
+	default:
+		return "", "", false
+	}
+	base := filename[:len(filename)-len(ext)]
+	if base == "" {
+		return "", "", false
+	}
+	return base, ext, true
+}
+
+// Checksum returns the hex encoded checksum of the file for the extension of a checksum sidecar
+// file, or an empty string if the extension isn't the one of a checksum.
+func (f FileInfo) Checksum(ext string) string {
+	switch strings.ToLower(ext) {
+	case ChecksumExtensionMD5:
+		return f.MD5
+	case ChecksumExtensionSHA1:
+		return f.Sha1
+	case ChecksumExtensionSHA256:
+		return f.Sha256
+	case ChecksumExtensionSHA512:
+		return f.Sha512
+	}
+	return ""
+}
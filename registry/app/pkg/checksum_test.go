@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitChecksumFile(t *testing.T) {
+	base, ext, ok := SplitChecksumFile("lib-1.0.0.jar.sha256")
+	assert.True(t, ok)
+	assert.Equal(t, "lib-1.0.0.jar", base)
+	assert.Equal(t, ChecksumExtensionSHA256, ext)
+
+	base, ext, ok = SplitChecksumFile("tool.tar.gz.MD5")
+	assert.True(t, ok)
+	assert.Equal(t, "tool.tar.gz", base)
+	assert.Equal(t, ChecksumExtensionMD5, ext)
+
+	_, _, ok = SplitChecksumFile("lib-1.0.0.jar")
+	assert.False(t, ok)
+	_, _, ok = SplitChecksumFile(".sha1")
+	assert.False(t, ok)
+}
+
+func TestFileInfoChecksum(t *testing.T) {
+	info := FileInfo{MD5: "m", Sha1: "s1", Sha256: "s256", Sha512: "s512"}
+	assert.Equal(t, "m", info.Checksum(ChecksumExtensionMD5))
+	assert.Equal(t, "s1", info.Checksum(ChecksumExtensionSHA1))
+	assert.Equal(t, "s256", info.Checksum(ChecksumExtensionSHA256))
+	assert.Equal(t, "s512", info.Checksum(ChecksumExtensionSHA512))
+	assert.Empty(t, info.Checksum(".asc"))
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"context"
+
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the package registry service of cocoapods registries, which are served as
+// CDN sources.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	urlProvider          urlprovider.Provider
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	// ListPods lists the names of the pods of the registry, as all_pods.txt.
+	ListPods(ctx context.Context, info ArtifactInfo) ([]string, errcode.Error)
+	// ListShardVersions lists the versions of the pods in a shard of the Specs directory.
+	ListShardVersions(ctx context.Context, info ArtifactInfo, prefix []string) ([]PodVersions, errcode.Error)
+	// GetPodspec serves the podspec of a version from the Specs directory, under the shard of the pod.
+	GetPodspec(ctx context.Context, info ArtifactInfo, prefix []string, filename string) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	DownloadArchive(ctx context.Context, info ArtifactInfo, filename string) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	Push(ctx context.Context, info ArtifactInfo, request PushRequest) (*commons.ResponseHeaders, errcode.Error)
+}
+
+// NewController creates a new CocoaPods controller.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	urlProvider urlprovider.Provider,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		urlProvider:          urlProvider,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
+
+func (c *controller) registryURL(ctx context.Context, info ArtifactInfo) string {
+	return c.urlProvider.PackageURL(ctx, info.RootIdentifier+"/"+info.RegIdentifier, "cocoapods")
+}
@@ -0,0 +1,226 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/cocoapods"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+const podspecContentType = "application/json"
+
+func (c *controller) ListPods(ctx context.Context, info ArtifactInfo) ([]string, errcode.Error) {
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, reg.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return names, errcode.Error{}
+}
+
+func (c *controller) ListShardVersions(
+	ctx context.Context,
+	info ArtifactInfo,
+	prefix []string,
+) ([]PodVersions, errcode.Error) {
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, reg.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	pods := []PodVersions{}
+	for _, name := range names {
+		if !slices.Equal(cocoapods.ShardPrefix(name), prefix) {
+			continue
+		}
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, reg.ID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if len(*artifacts) == 0 {
+			continue
+		}
+		pod := PodVersions{Name: name, Versions: make([]string, 0, len(*artifacts))}
+		for _, a := range *artifacts {
+			pod.Versions = append(pod.Versions, a.Version)
+		}
+		versioning.Sort(versioning.SchemeSemver, pod.Versions)
+		pods = append(pods, pod)
+	}
+	return pods, errcode.Error{}
+}
+
+func (c *controller) GetPodspec(ctx context.Context, info ArtifactInfo, prefix []string, filename string) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	if !slices.Equal(cocoapods.ShardPrefix(info.Name), prefix) || filename != cocoapods.PodspecFilename(info.Name) {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("podspec %s not found", filename))
+	}
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	fileReader, redirectURL, errc := c.download(ctx, info, version.registryID, filename)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	responseHeaders.Headers["Content-Type"] = podspecContentType
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) DownloadArchive(ctx context.Context, info ArtifactInfo, filename string) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	if filename != version.Archive {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("archive %s of %s %s not found", filename, info.Name, info.Version))
+	}
+	fileReader, redirectURL, errc := c.download(ctx, info, version.registryID, filename)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, version.registryID, info.Name, info.Version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+type pushedVersion struct {
+	database.CocoapodsMetadata
+	registryID int64
+}
+
+// findVersion returns a pushed version of a pod.
+func (c *controller) findVersion(ctx context.Context, info ArtifactInfo) (*pushedVersion, errcode.Error) {
+	if err := cocoapods.ValidateName(info.Name); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := cocoapods.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	metadata, err := c.getMetadata(ctx, reg.ID, info.Name, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of %s not found", info.Version, info.Name))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return &pushedVersion{CocoapodsMetadata: *metadata, registryID: reg.ID}, errcode.Error{}
+}
+
+func (c *controller) download(
+	ctx context.Context,
+	info ArtifactInfo,
+	registryID int64,
+	filename string,
+) (*storage.FileReader, string, errcode.Error) {
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.Name, info.Version, filename),
+		types.Registry{
+			ID:   registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	return fileReader, redirectURL, errcode.Error{}
+}
+
+// getMetadata returns the metadata of a version, or gitnessstore.ErrResourceNotFound if it wasn't
+// pushed.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*database.CocoapodsMetadata, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, name, err)
+	}
+	metadata := &database.CocoapodsMetadata{}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s of %s: %w", version, name, err)
+	}
+	return metadata, nil
+}
+
+// filePath returns the path a file of a version is stored at.
+func filePath(name, version, filename string) string {
+	return name + "/" + version + "/" + filename
+}
+
+// archiveURL returns the URL the archive of a version is downloaded from, which the podspec
+// served by the registry points to.
+func archiveURL(baseURL, name, version, filename string) string {
+	return baseURL + "/pods/" + name + "/" + version + "/" + filename
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/utils/versioning"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodVersions(t *testing.T) {
+	versions := []string{"4.0.1", "3.2.1", "4.0.0-beta.1", "4.0.0"}
+	versioning.Sort(versioning.SchemeSemver, versions)
+	pod := PodVersions{Name: "AFNetworking", Versions: versions}
+	assert.Equal(t, "AFNetworking/3.2.1/4.0.0-beta.1/4.0.0/4.0.1", pod.String())
+}
+
+func TestArchiveURL(t *testing.T) {
+	assert.Equal(t, "https://pkg/cocoapods/pods/AFNetworking/4.0.1/AFNetworking-4.0.1.zip",
+		archiveURL("https://pkg/cocoapods", "AFNetworking", "4.0.1", "AFNetworking-4.0.1.zip"))
+}
@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"io"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Name    string
+	Version string
+}
+
+// PushRequest is a version of a pod pushed with its JSON podspec and the archive of its sources.
+type PushRequest struct {
+	Podspec         []byte
+	Archive         io.Reader
+	ArchiveFilename string
+}
+
+// PodVersions lists the versions of a pod, as a line of the version lists of the CDN.
+type PodVersions struct {
+	Name     string
+	Versions []string
+}
+
+// String formats the versions of a pod as the line of a version list, such as
+// AFNetworking/4.0.0/4.0.1.
+func (p PodVersions) String() string {
+	return strings.Join(append([]string{p.Name}, p.Versions...), "/")
+}
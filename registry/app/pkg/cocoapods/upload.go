@@ -0,0 +1,141 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cocoapods
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/cocoapods"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// Push stores a version of a pod with its archive, and its podspec with a source pointing to the
+// archive in the registry. Versions are immutable, as CocoaPods caches them by version.
+func (c *controller) Push(
+	ctx context.Context,
+	info ArtifactInfo,
+	request PushRequest,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	spec, raw, err := cocoapods.ReadPodspec(request.Podspec)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	ext, err := cocoapods.ArchiveExtension(request.ArchiveFilename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeCOCOAPODS {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a cocoapods registry", registry.Name))
+	}
+	_, err = c.getMetadata(ctx, registry.ID, spec.Name, spec.Version)
+	if err == nil {
+		return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("version %s of %s was pushed before", spec.Version, spec.Name))
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	metadata := database.CocoapodsMetadata{Podspec: *spec, Archive: spec.Name + "-" + spec.Version + ext}
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(spec.Name, spec.Version, metadata.Archive),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		request.Archive, metadata.Archive)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata.Sha256 = fileInfo.Sha256
+	metadata.Files = append(metadata.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+	})
+
+	podspec, err := cocoapods.RewriteSource(raw,
+		archiveURL(c.registryURL(ctx, info), spec.Name, spec.Version, metadata.Archive), metadata.Sha256)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	filename := cocoapods.PodspecFilename(spec.Name)
+	fileInfo, err = c.fileManager.UploadFile(ctx, filePath(spec.Name, spec.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		bytes.NewReader(podspec), filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata.Files = append(metadata.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+	})
+	metadata.FileCount = int64(len(metadata.Files))
+
+	if err = c.publish(ctx, registry.ID, spec.Name, spec.Version, metadata); err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// publish creates a version whose files were uploaded.
+func (c *controller) publish(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+	metadata database.CocoapodsMetadata,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+}
@@ -17,6 +17,7 @@ package commons
 import (
 	"net/http"
 	"reflect"
+	"strings"
 
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 )
@@ -31,6 +32,7 @@ const (
 	HeaderDockerContentDigest = "Docker-Content-Digest"
 	HeaderDockerUploadUUID    = "Docker-Upload-UUID"
 	HeaderEtag                = "Etag"
+	HeaderIfMatch             = "If-Match"
 	HeaderIfNoneMatch         = "If-None-Match"
 	HeaderLink                = "Link"
 	HeaderLocation            = "Location"
@@ -95,3 +97,30 @@ func (r *ResponseHeaders) WriteHeadersToResponse(w http.ResponseWriter) {
 		}
 	}
 }
+
+// IsCreateOnly reports whether the request carries "If-None-Match: *", asking for the write to
+// succeed only if the target does not exist yet.
+func IsCreateOnly(r *http.Request) bool {
+	for _, value := range r.Header.Values(HeaderIfNoneMatch) {
+		for _, tag := range strings.Split(value, ",") {
+			if strings.TrimSpace(tag) == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseEntityTags splits the value of a conditional request header like If-Match into its entity tags,
+// with the quotes and weak validator prefixes removed.
+func ParseEntityTags(value string) []string {
+	var tags []string
+	for _, tag := range strings.Split(value, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		tag = strings.Trim(tag, `"`)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
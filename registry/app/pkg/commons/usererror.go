@@ -42,3 +42,13 @@ func New(status int, message string, detail interface{}) *Error {
 func NotFoundError(message string, detail interface{}) *Error {
 	return New(http.StatusNotFound, message, detail)
 }
+
+// BadRequestError returns a new user facing bad request error.
+func BadRequestError(message string, detail interface{}) *Error {
+	return New(http.StatusBadRequest, message, detail)
+}
+
+// PreconditionFailedError returns a new user facing precondition failed error.
+func PreconditionFailedError(message string, detail interface{}) *Error {
+	return New(http.StatusPreconditionFailed, message, detail)
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"context"
+	"io"
+
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the Composer repositories of composer registries.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	urlProvider          urlprovider.Provider
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	UploadPackage(ctx context.Context, info ArtifactInfo, file io.ReaderAt, size int64) (
+		*commons.ResponseHeaders,
+		errcode.Error,
+	)
+	GetRepository(ctx context.Context, info ArtifactInfo) (*Repository, errcode.Error)
+	// GetProviderInclude returns the provider include, which is served as is as Composer verifies its hash.
+	GetProviderInclude(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error)
+	// GetProviderFile returns the provider file of a package, read by Composer 1.
+	GetProviderFile(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error)
+	// GetMetadataFile returns the versions of a package, read by Composer 2.
+	GetMetadataFile(ctx context.Context, info ArtifactInfo) (*MetadataFile, errcode.Error)
+	DownloadPackage(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+}
+
+// NewController creates a new Composer controller.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	urlProvider urlprovider.Provider,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		urlProvider:          urlProvider,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
+
+func (c *controller) registryURL(ctx context.Context, info ArtifactInfo) string {
+	return c.urlProvider.PackageURL(ctx, info.RootIdentifier+"/"+info.RegIdentifier, "composer")
+}
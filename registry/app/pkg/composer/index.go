@@ -0,0 +1,241 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/composer"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// The indices are generated from the published versions on each request, so the hashes of the
+// provider include and the provider files change as soon as a version is published.
+
+func (c *controller) GetRepository(ctx context.Context, info ArtifactInfo) (*Repository, errcode.Error) {
+	include, errc := c.GetProviderInclude(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	baseURL := c.registryURL(ctx, info)
+	return &Repository{
+		Packages:     map[string]any{},
+		MetadataURL:  baseURL + "/p2/%package%.json",
+		ProvidersURL: baseURL + "/p/%package%$%hash%.json",
+		ProviderIncludes: map[string]Hash{
+			providerIncludePattern: {Sha256: sha256Hex(include)},
+		},
+	}, errcode.Error{}
+}
+
+func (c *controller) GetProviderInclude(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registry.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	baseURL := c.registryURL(ctx, info)
+	include := ProviderInclude{Providers: make(map[string]Hash, len(names))}
+	for _, name := range names {
+		artifacts, err := c.listVersions(ctx, registry.ID, name)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if len(artifacts) == 0 {
+			continue
+		}
+		providerFile, err := buildProviderFile(baseURL, name, artifacts)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		include.Providers[name] = Hash{Sha256: sha256Hex(providerFile)}
+	}
+	content, err := json.Marshal(include)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return content, errcode.Error{}
+}
+
+func (c *controller) GetProviderFile(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error) {
+	artifacts, errc := c.getVersions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	providerFile, err := buildProviderFile(c.registryURL(ctx, info), info.Image, artifacts)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return providerFile, errcode.Error{}
+}
+
+// GetMetadataFile returns the stable versions of a package, or its development versions, which
+// Composer 2 fetches from <package>~dev.json.
+func (c *controller) GetMetadataFile(ctx context.Context, info ArtifactInfo) (*MetadataFile, errcode.Error) {
+	artifacts, errc := c.getVersions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions, err := buildVersions(c.registryURL(ctx, info), info.Image, artifacts)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	requested := make([]Version, 0, len(versions))
+	for _, v := range versions {
+		if isDev(v.Version) == info.Dev {
+			requested = append(requested, v)
+		}
+	}
+	return &MetadataFile{Packages: map[string][]Version{info.Image: requested}}, errcode.Error{}
+}
+
+func (c *controller) DownloadPackage(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	if err := composer.ValidateName(info.Image); err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if info.Filename != composer.Filename(info.Image, info.Version) {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("%s not found for version %s of %s", info.Filename, info.Version, info.Image))
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+packagePath(info.Image, info.Version, info.Filename),
+		types.Registry{
+			ID:   reg.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, info.Image, info.Version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// getVersions returns the versions of the requested package, failing if it wasn't published.
+func (c *controller) getVersions(ctx context.Context, info ArtifactInfo) ([]types.Artifact, errcode.Error) {
+	if err := composer.ValidateName(info.Image); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	artifacts, err := c.listVersions(ctx, registry.ID, info.Image)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(artifacts) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("package " + info.Image + " not found")
+	}
+	return artifacts, errcode.Error{}
+}
+
+func (c *controller) listVersions(ctx context.Context, registryID int64, name string) ([]types.Artifact, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of package %s: %w", name, err)
+	}
+	return *artifacts, nil
+}
+
+func buildProviderFile(baseURL, name string, artifacts []types.Artifact) ([]byte, error) {
+	versions, err := buildVersions(baseURL, name, artifacts)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[string]Version, len(versions))
+	for _, v := range versions {
+		byVersion[v.Version] = v
+	}
+	return json.Marshal(ProviderFile{Packages: map[string]map[string]Version{name: byVersion}})
+}
+
+// buildVersions returns the versions of a package in the order they were published.
+func buildVersions(baseURL, name string, artifacts []types.Artifact) ([]Version, error) {
+	sorted := make([]types.Artifact, len(artifacts))
+	copy(sorted, artifacts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].ID < sorted[j].ID
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	versions := make([]Version, 0, len(sorted))
+	for _, a := range sorted {
+		var metadata database.ComposerMetadata
+		if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+		}
+		filename := composer.Filename(name, a.Version)
+		versions = append(versions, Version{
+			Metadata:          metadata.Metadata,
+			Version:           a.Version,
+			VersionNormalized: metadata.VersionNormalized,
+			Dist: Dist{
+				Type:   "zip",
+				URL:    baseURL + "/files/" + name + "/" + a.Version + "/" + filename,
+				Shasum: metadata.Sha1,
+			},
+			Time: a.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return versions, nil
+}
+
+func isDev(version string) bool {
+	return strings.HasPrefix(version, "dev-")
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
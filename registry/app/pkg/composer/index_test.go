@@ -0,0 +1,90 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/metadata/composer"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBaseURL = "https://registry.example.com/pkg/root/php/composer"
+
+func testArtifact(t *testing.T, id int64, version, normalized string, createdAt time.Time) types.Artifact {
+	t.Helper()
+	metadata, err := json.Marshal(database.ComposerMetadata{
+		VersionNormalized: normalized,
+		Sha1:              "sha1-" + version,
+		Metadata: composer.Metadata{
+			Name:    "acme/hello",
+			Version: version,
+			Require: map[string]string{"php": ">=8.1"},
+		},
+	})
+	require.NoError(t, err)
+	return types.Artifact{ID: id, Version: version, Metadata: metadata, CreatedAt: createdAt}
+}
+
+func TestBuildVersions(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	artifacts := []types.Artifact{
+		testArtifact(t, 2, "1.1.0", "1.1.0.0", now.Add(time.Hour)),
+		testArtifact(t, 1, "1.0.0", "1.0.0.0", now),
+	}
+
+	versions, err := buildVersions(testBaseURL, "acme/hello", artifacts)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "1.0.0", versions[0].Version)
+	assert.Equal(t, "1.0.0.0", versions[0].VersionNormalized)
+	assert.Equal(t, Dist{
+		Type:   "zip",
+		URL:    testBaseURL + "/files/acme/hello/1.0.0/acme-hello-1.0.0.zip",
+		Shasum: "sha1-1.0.0",
+	}, versions[0].Dist)
+	assert.Equal(t, "2024-05-01T12:00:00Z", versions[0].Time)
+	assert.Equal(t, "1.1.0", versions[1].Version)
+
+	content, err := json.Marshal(versions[0])
+	require.NoError(t, err)
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(content, &entry))
+	assert.Equal(t, "acme/hello", entry["name"])
+	assert.Equal(t, "1.0.0", entry["version"])
+	assert.Equal(t, map[string]any{"php": ">=8.1"}, entry["require"])
+}
+
+func TestBuildProviderFile(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	artifacts := []types.Artifact{testArtifact(t, 1, "1.0.0", "1.0.0.0", now)}
+
+	content, err := buildProviderFile(testBaseURL, "acme/hello", artifacts)
+	require.NoError(t, err)
+	var providerFile ProviderFile
+	require.NoError(t, json.Unmarshal(content, &providerFile))
+	require.Contains(t, providerFile.Packages, "acme/hello")
+	assert.Equal(t, "1.0.0.0", providerFile.Packages["acme/hello"]["1.0.0"].VersionNormalized)
+
+	again, err := buildProviderFile(testBaseURL, "acme/hello", artifacts)
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex(content), sha256Hex(again), "provider files have to be stable for their hashes")
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"github.com/harness/gitness/registry/app/metadata/composer"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+// providerIncludePattern is the provider include listing the hashes of the provider files of all
+// packages, %hash% is replaced by the hash of its content.
+const providerIncludePattern = "p/provider-latest$%hash%.json"
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Version is the version of the package, for uploads it overrides the version of composer.json.
+	Version string
+	// Filename is the archive requested for download.
+	Filename string
+	// Dev tells whether the development versions of a package are requested from the metadata url.
+	Dev bool
+}
+
+// Repository is the packages.json at the root of the repository. Composer 2 fetches the versions of
+// a package from the metadata url, Composer 1 from the provider files the provider includes list.
+// Source: https://getcomposer.org/doc/05-repositories.md#packages
+type Repository struct {
+	Packages         map[string]any  `json:"packages"`
+	MetadataURL      string          `json:"metadata-url"`
+	ProvidersURL     string          `json:"providers-url"`
+	ProviderIncludes map[string]Hash `json:"provider-includes"`
+}
+
+type Hash struct {
+	Sha256 string `json:"sha256"`
+}
+
+// ProviderInclude lists the hashes of the provider files of the packages.
+type ProviderInclude struct {
+	Providers map[string]Hash `json:"providers"`
+}
+
+// Version is a version of a package as Composer resolves it, composer.json along with where to
+// download the archive from.
+type Version struct {
+	composer.Metadata
+	Version           string `json:"version"`
+	VersionNormalized string `json:"version_normalized"`
+	Dist              Dist   `json:"dist"`
+	Time              string `json:"time"`
+}
+
+type Dist struct {
+	Type   string `json:"type"`
+	URL    string `json:"url"`
+	Shasum string `json:"shasum"`
+}
+
+// ProviderFile is the provider file of a package, its versions keyed by version.
+type ProviderFile struct {
+	Packages map[string]map[string]Version `json:"packages"`
+}
+
+// MetadataFile is the file of the metadata url of a package, its versions as a list.
+type MetadataFile struct {
+	Packages map[string][]Version `json:"packages"`
+}
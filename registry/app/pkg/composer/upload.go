@@ -0,0 +1,154 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/composer"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// UploadPackage publishes the zip archive of a version. The version is read from composer.json
+// unless it's given with the upload, as composer.json of most packages doesn't have one.
+func (c *controller) UploadPackage(
+	ctx context.Context,
+	info ArtifactInfo,
+	file io.ReaderAt,
+	size int64,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	metadata, err := composer.ReadPackage(file, size)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := metadata.Validate(); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if info.Version != "" {
+		metadata.Version = info.Version
+	}
+	if metadata.Version == "" {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			"version is required, as composer.json doesn't have one")
+	}
+	normalized, err := composer.NormalizeVersion(metadata.Version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	name, version := metadata.Name, metadata.Version
+	filename := composer.Filename(name, version)
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeCOMPOSER {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a composer registry", registry.Name))
+	}
+	exists, err := c.versionExists(ctx, registry.ID, name, version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if exists {
+		return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("version %s of %s was published before", version, name))
+	}
+
+	fileInfo, err := c.fileManager.UploadFile(ctx, packagePath(name, version, filename), info.RegIdentifier,
+		registry.ID, info.RootParentID, info.StorageRoot(), nil, io.NewSectionReader(file, 0, size), filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	composerMetadata := &database.ComposerMetadata{
+		Files: []database.File{{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		}},
+		FileCount:         1,
+		VersionNormalized: normalized,
+		Sha1:              fileInfo.Sha1,
+		Metadata:          *metadata,
+	}
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(composerMetadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+func (c *controller) versionExists(ctx context.Context, registryID int64, name, version string) (bool, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find package %s: %w", name, err)
+	}
+	_, err = c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find version %s of package %s: %w", version, name, err)
+	}
+	return true, nil
+}
+
+// packagePath returns the path the archive of a version is stored at.
+func packagePath(name, version, filename string) string {
+	return name + "/" + version + "/" + filename
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"context"
+	"io"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the recipes and binary packages of conan registries, following the Conan v2
+// REST API.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	// UploadFile stores a file of a recipe revision, or of a package revision if the package is set.
+	UploadFile(ctx context.Context, info ArtifactInfo, file io.Reader) (*commons.ResponseHeaders, errcode.Error)
+	DownloadFile(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	// GetLatestRevision returns the latest recipe revision, or the latest package revision if the
+	// package is set.
+	GetLatestRevision(ctx context.Context, info ArtifactInfo) (*Revision, errcode.Error)
+	// ListRevisions lists the recipe revisions, or the package revisions if the package is set,
+	// the latest first.
+	ListRevisions(ctx context.Context, info ArtifactInfo) (*RevisionsResponse, errcode.Error)
+	ListFiles(ctx context.Context, info ArtifactInfo) (*FilesResponse, errcode.Error)
+	// SearchRecipes lists the references matching a pattern, where '*' matches any characters.
+	SearchRecipes(ctx context.Context, info ArtifactInfo, pattern string, ignoreCase bool) (
+		*SearchResponse,
+		errcode.Error,
+	)
+	// SearchPackages returns the conaninfo.txt of the latest revision of each binary package of a
+	// recipe revision, or of the latest recipe revision if the revision isn't set.
+	SearchPackages(ctx context.Context, info ArtifactInfo) (map[string]*conan.Info, errcode.Error)
+}
+
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"strings"
+
+	"github.com/harness/gitness/registry/app/metadata/conan"
+)
+
+const (
+	recipeFolder  = "export"
+	packageFolder = "package"
+)
+
+// revisionPath returns the path the files of a recipe revision are stored under.
+func revisionPath(ref conan.Reference, revision string) string {
+	return ref.ImageName() + "/" + ref.Version + "/" + revision
+}
+
+// fileRelativePath returns the path of a file relative to its recipe revision, recipe files are
+// stored in export and the files of binary packages in package/<package id>/<package revision>.
+func fileRelativePath(info ArtifactInfo) string {
+	if info.PackageID == "" {
+		return recipeFolder + "/" + info.Filename
+	}
+	return packageFolder + "/" + info.PackageID + "/" + info.PackageRevision + "/" + info.Filename
+}
+
+// FilePath is a file of a recipe version as addressed by the API, PackageID and PackageRevision
+// are empty for recipe files.
+type FilePath struct {
+	Revision        string
+	PackageID       string
+	PackageRevision string
+	Filename        string
+}
+
+// ParseFilePath parses the path of a file relative to its recipe version, as the files of a version
+// are listed.
+func ParseFilePath(relativePath string) (FilePath, bool) {
+	parts := strings.Split(relativePath, "/")
+	switch {
+	case len(parts) == 3 && parts[1] == recipeFolder:
+		return FilePath{Revision: parts[0], Filename: parts[2]}, true
+	case len(parts) == 5 && parts[1] == packageFolder:
+		return FilePath{Revision: parts[0], PackageID: parts[2], PackageRevision: parts[3], Filename: parts[4]}, true
+	default:
+		return FilePath{}, false
+	}
+}
+
+// URLPath returns the path of the file in the API relative to the recipe, which is
+// <name>/<version>/<user>/<channel>.
+func (p FilePath) URLPath() string {
+	if p.PackageID == "" {
+		return "revisions/" + p.Revision + "/files/" + p.Filename
+	}
+	return "revisions/" + p.Revision + "/packages/" + p.PackageID + "/revisions/" + p.PackageRevision +
+		"/files/" + p.Filename
+}
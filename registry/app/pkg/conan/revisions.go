@@ -0,0 +1,335 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+)
+
+// timeLayout is the ISO 8601 layout of revision times, which Conan parses with fromisoformat.
+const timeLayout = "2006-01-02T15:04:05.000-07:00"
+
+func (c *controller) GetLatestRevision(ctx context.Context, info ArtifactInfo) (*Revision, errcode.Error) {
+	revisions, errc := c.ListRevisions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	return &revisions.Revisions[0], errcode.Error{}
+}
+
+func (c *controller) ListRevisions(ctx context.Context, info ArtifactInfo) (*RevisionsResponse, errcode.Error) {
+	_, metadata, errc := c.getRecipe(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+
+	response := &RevisionsResponse{Reference: info.Reference.String()}
+	if info.PackageID == "" {
+		for _, r := range metadata.Revisions {
+			response.Revisions = append(response.Revisions, toRevision(r.Revision, r.Time))
+		}
+	} else {
+		recipe, pkg, errc := findPackageOf(metadata, info)
+		if !commons.IsEmptyError(errc) {
+			return nil, errc
+		}
+		response.Reference += "#" + recipe.Revision + ":" + pkg.PackageID
+		for _, r := range pkg.Revisions {
+			response.Revisions = append(response.Revisions, toRevision(r.Revision, r.Time))
+		}
+	}
+	if len(response.Revisions) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("%s has no revisions", response.Reference))
+	}
+	// the layout sorts chronologically, as times are UTC
+	sort.SliceStable(response.Revisions, func(i, j int) bool {
+		return response.Revisions[i].Time > response.Revisions[j].Time
+	})
+	return response, errcode.Error{}
+}
+
+func (c *controller) ListFiles(ctx context.Context, info ArtifactInfo) (*FilesResponse, errcode.Error) {
+	_, metadata, errc := c.getRecipe(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	files, errc := filesOf(metadata, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	response := &FilesResponse{Files: make(map[string]struct{}, len(files))}
+	for _, f := range files {
+		response.Files[f] = struct{}{}
+	}
+	return response, errcode.Error{}
+}
+
+func (c *controller) DownloadFile(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	registry, metadata, errc := c.getRecipe(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	files, errc := filesOf(metadata, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	found := false
+	for _, f := range files {
+		found = found || f == info.Filename
+	}
+	if !found {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("file %s wasn't uploaded", info.Filename))
+	}
+
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+revisionPath(info.Reference, info.Revision)+"/"+fileRelativePath(info),
+		types.Registry{
+			ID:   registry.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	if info.Filename == conan.FilePackage {
+		pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, registry.ID, info.Reference.ImageName(),
+			info.Reference.Version)
+	}
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) SearchRecipes(
+	ctx context.Context,
+	info ArtifactInfo,
+	pattern string,
+	ignoreCase bool,
+) (*SearchResponse, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	matcher, err := compilePattern(pattern, ignoreCase)
+	if err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registry.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	versions, err := c.artifactDao.GetVersionsByImageNames(ctx, registry.ID, names)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	response := &SearchResponse{Results: []string{}}
+	for _, name := range names {
+		for _, version := range versions[name] {
+			ref, err := conan.ParseImageName(name, version)
+			if err != nil {
+				continue
+			}
+			if matcher.MatchString(ref.String()) {
+				response.Results = append(response.Results, ref.String())
+			}
+		}
+	}
+	sort.Strings(response.Results)
+	return response, errcode.Error{}
+}
+
+func (c *controller) SearchPackages(ctx context.Context, info ArtifactInfo) (map[string]*conan.Info, errcode.Error) {
+	_, metadata, errc := c.getRecipe(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	recipe := latestRecipeRevision(metadata)
+	if info.Revision != "" {
+		recipe = findRecipeRevision(metadata, info.Revision)
+		if recipe == nil {
+			return nil, errcode.ErrCodeNameUnknown.WithMessage(
+				fmt.Sprintf("recipe revision %s#%s wasn't uploaded", info.Reference, info.Revision))
+		}
+	}
+
+	response := make(map[string]*conan.Info, len(recipe.Packages))
+	for i := range recipe.Packages {
+		var latest *database.ConanPackageRevision
+		for j, r := range recipe.Packages[i].Revisions {
+			if r.Info != nil && (latest == nil || r.Time > latest.Time) {
+				latest = &recipe.Packages[i].Revisions[j]
+			}
+		}
+		if latest != nil {
+			response[recipe.Packages[i].PackageID] = latest.Info
+		}
+	}
+	return response, errcode.Error{}
+}
+
+// getRecipe returns the registry and the metadata of the recipe of a request, failing if it
+// wasn't uploaded.
+func (c *controller) getRecipe(ctx context.Context, info ArtifactInfo) (
+	*types.Registry,
+	*database.ConanMetadata,
+	errcode.Error,
+) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	metadata, err := c.getMetadata(ctx, registry.ID, info.Reference)
+	if err != nil {
+		return nil, nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(metadata.Revisions) == 0 {
+		return nil, nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("recipe %s wasn't uploaded", info.Reference))
+	}
+	return registry, metadata, errcode.Error{}
+}
+
+// filesOf returns the files of the recipe revision, or package revision, of a request.
+func filesOf(metadata *database.ConanMetadata, info ArtifactInfo) ([]string, errcode.Error) {
+	if info.PackageID == "" {
+		recipe := findRecipeRevision(metadata, info.Revision)
+		if recipe == nil {
+			return nil, errcode.ErrCodeNameUnknown.WithMessage(
+				fmt.Sprintf("recipe revision %s#%s wasn't uploaded", info.Reference, info.Revision))
+		}
+		return recipe.Files, errcode.Error{}
+	}
+	recipe, pkg, errc := findPackageOf(metadata, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	for _, r := range pkg.Revisions {
+		if r.Revision == info.PackageRevision {
+			return r.Files, errcode.Error{}
+		}
+	}
+	return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("package revision %s#%s:%s#%s wasn't uploaded",
+		info.Reference, recipe.Revision, pkg.PackageID, info.PackageRevision))
+}
+
+func findPackageOf(metadata *database.ConanMetadata, info ArtifactInfo) (
+	*database.ConanRecipeRevision,
+	*database.ConanPackage,
+	errcode.Error,
+) {
+	recipe := findRecipeRevision(metadata, info.Revision)
+	if recipe == nil {
+		return nil, nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("recipe revision %s#%s wasn't uploaded", info.Reference, info.Revision))
+	}
+	pkg := findPackage(recipe, info.PackageID)
+	if pkg == nil {
+		return nil, nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("package %s#%s:%s wasn't uploaded", info.Reference, info.Revision, info.PackageID))
+	}
+	return recipe, pkg, errcode.Error{}
+}
+
+func findRecipeRevision(metadata *database.ConanMetadata, revision string) *database.ConanRecipeRevision {
+	for i := range metadata.Revisions {
+		if metadata.Revisions[i].Revision == revision {
+			return &metadata.Revisions[i]
+		}
+	}
+	return nil
+}
+
+func latestRecipeRevision(metadata *database.ConanMetadata) *database.ConanRecipeRevision {
+	var latest *database.ConanRecipeRevision
+	for i := range metadata.Revisions {
+		if latest == nil || metadata.Revisions[i].Time > latest.Time {
+			latest = &metadata.Revisions[i]
+		}
+	}
+	return latest
+}
+
+func findPackage(recipe *database.ConanRecipeRevision, packageID string) *database.ConanPackage {
+	for i := range recipe.Packages {
+		if recipe.Packages[i].PackageID == packageID {
+			return &recipe.Packages[i]
+		}
+	}
+	return nil
+}
+
+func findPackageRevision(
+	recipe *database.ConanRecipeRevision,
+	packageID, revision string,
+) *database.ConanPackageRevision {
+	pkg := findPackage(recipe, packageID)
+	if pkg == nil {
+		return nil
+	}
+	for i := range pkg.Revisions {
+		if pkg.Revisions[i].Revision == revision {
+			return &pkg.Revisions[i]
+		}
+	}
+	return nil
+}
+
+func toRevision(revision string, t int64) Revision {
+	return Revision{Revision: revision, Time: time.UnixMilli(t).UTC().Format(timeLayout)}
+}
+
+// compilePattern compiles a search pattern into a regular expression of the whole reference, a
+// pattern without '/' matches the names of the recipes.
+func compilePattern(pattern string, ignoreCase bool) (*regexp.Regexp, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+	if !strings.Contains(pattern, "/") && !strings.HasSuffix(pattern, "*") {
+		pattern += "/*"
+	}
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	expr := "^" + strings.Join(parts, ".*") + "$"
+	if ignoreCase {
+		expr = "(?i)" + expr
+	}
+	return regexp.Compile(expr)
+}
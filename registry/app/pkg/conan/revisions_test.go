@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePath(t *testing.T) {
+	info := ArtifactInfo{Revision: "abc", Filename: "conanfile.py"}
+	p, ok := ParseFilePath(info.Revision + "/" + fileRelativePath(info))
+	require.True(t, ok)
+	assert.Equal(t, FilePath{Revision: "abc", Filename: "conanfile.py"}, p)
+	assert.Equal(t, "revisions/abc/files/conanfile.py", p.URLPath())
+
+	info = ArtifactInfo{Revision: "abc", PackageID: "def", PackageRevision: "123", Filename: "conan_package.tgz"}
+	p, ok = ParseFilePath(info.Revision + "/" + fileRelativePath(info))
+	require.True(t, ok)
+	assert.Equal(t, "revisions/abc/packages/def/revisions/123/files/conan_package.tgz", p.URLPath())
+
+	_, ok = ParseFilePath("abc/conanfile.py")
+	assert.False(t, ok)
+}
+
+func TestAddFile(t *testing.T) {
+	metadata := &database.ConanMetadata{}
+	recipe := ArtifactInfo{Revision: "r1", Filename: "conanfile.py"}
+	addFile(metadata, recipe, nil, database.File{Filename: "r1/export/conanfile.py", Size: 10, CreatedAt: 1})
+	addFile(metadata, recipe, nil, database.File{Filename: "r1/export/conanfile.py", Size: 12, CreatedAt: 2})
+	info := &conan.Info{Settings: map[string]string{"os": "Linux"}}
+	pkg := ArtifactInfo{Revision: "r1", PackageID: "p1", PackageRevision: "pr1", Filename: conan.FileInfo}
+	addFile(metadata, pkg, info, database.File{Filename: "r1/package/p1/pr1/conaninfo.txt", CreatedAt: 3})
+	addFile(metadata, ArtifactInfo{Revision: "r2", Filename: "conanfile.py"}, nil,
+		database.File{Filename: "r2/export/conanfile.py", CreatedAt: 4})
+
+	require.Len(t, metadata.Revisions, 2)
+	assert.Equal(t, int64(3), metadata.FileCount)
+	assert.Equal(t, int64(12), metadata.Files[0].Size)
+	assert.Equal(t, []string{"conanfile.py"}, metadata.Revisions[0].Files)
+	assert.Equal(t, int64(2), metadata.Revisions[0].Time)
+	assert.Equal(t, "r2", latestRecipeRevision(metadata).Revision)
+
+	prev := findPackageRevision(&metadata.Revisions[0], "p1", "pr1")
+	require.NotNil(t, prev)
+	assert.Equal(t, info, prev.Info)
+	assert.Equal(t, []string{conan.FileInfo}, prev.Files)
+}
+
+func TestCompilePattern(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		ignoreCase bool
+		ref        string
+		match      bool
+	}{
+		{"zlib", false, "zlib/1.3.1", true},
+		{"zlib", false, "zlibx/1.3.1", false},
+		{"zlib*", false, "zlibx/1.3.1", true},
+		{"zlib/1.*", false, "zlib/1.3.1@acme/stable", true},
+		{"ZLIB", true, "zlib/1.3.1", true},
+		{"ZLIB", false, "zlib/1.3.1", false},
+		{"", false, "zlib/1.3.1", true},
+		{"z.ib", false, "zlib/1.3.1", false},
+	}
+	for _, tc := range tests {
+		matcher, err := compilePattern(tc.pattern, tc.ignoreCase)
+		require.NoError(t, err)
+		assert.Equal(t, tc.match, matcher.MatchString(tc.ref), tc.pattern)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+// Capabilities are the server capabilities announced on ping, Conan 2 requires revisions.
+const Capabilities = "revisions"
+
+// ArtifactInfo addresses a recipe, or one of its revisions, binary packages or files, by the
+// path segments of the request.
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Reference conan.Reference
+	// Revision is the recipe revision.
+	Revision string
+	// PackageID and PackageRevision are set for requests of binary packages.
+	PackageID       string
+	PackageRevision string
+	Filename        string
+}
+
+type Revision struct {
+	Revision string `json:"revision"`
+	Time     string `json:"time"`
+}
+
+type RevisionsResponse struct {
+	// Reference is the recipe, or the recipe revision and package ID for package revisions.
+	Reference string     `json:"reference"`
+	Revisions []Revision `json:"revisions"`
+}
+
+// FilesResponse lists the files of a revision, the values are empty objects.
+type FilesResponse struct {
+	Files map[string]struct{} `json:"files"`
+}
+
+type SearchResponse struct {
+	Results []string `json:"results"`
+}
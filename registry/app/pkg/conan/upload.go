@@ -0,0 +1,209 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// maxInfoSize is the largest conaninfo.txt accepted.
+const maxInfoSize = 1 << 20
+
+// UploadFile stores a file of a revision. Revisions are hashes of their content, so uploading a
+// file again replaces it, and bumps the time of the revision, which makes it the latest. The
+// conaninfo.txt of a binary package is parsed, as the package search returns it.
+func (c *controller) UploadFile(
+	ctx context.Context,
+	info ArtifactInfo,
+	file io.Reader,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeCONAN {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a conan registry", registry.Name))
+	}
+
+	var packageInfo *conan.Info
+	if info.PackageID != "" {
+		metadata, err := c.getMetadata(ctx, registry.ID, info.Reference)
+		if err != nil {
+			return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if findRecipeRevision(metadata, info.Revision) == nil {
+			return responseHeaders, errcode.ErrCodeNameUnknown.WithMessage(
+				fmt.Sprintf("recipe revision %s#%s wasn't uploaded", info.Reference, info.Revision))
+		}
+		if info.Filename == conan.FileInfo {
+			content, err := io.ReadAll(io.LimitReader(file, maxInfoSize+1))
+			if err != nil {
+				return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage("failed to read file: " + err.Error())
+			}
+			if len(content) > maxInfoSize {
+				return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(conan.FileInfo + " is too large")
+			}
+			if packageInfo, err = conan.ParseInfo(content); err != nil {
+				return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+			}
+			file = bytes.NewReader(content)
+		}
+	}
+
+	relativePath := fileRelativePath(info)
+	fileInfo, err := c.fileManager.UploadFile(ctx, revisionPath(info.Reference, info.Revision)+"/"+relativePath,
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil, file, info.Filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			metadata, err := c.getMetadata(ctx, registry.ID, info.Reference)
+			if err != nil {
+				return err
+			}
+			addFile(metadata, info, packageInfo, database.File{
+				Size:      fileInfo.Size,
+				Filename:  info.Revision + "/" + relativePath,
+				CreatedAt: time.Now().UnixMilli(),
+			})
+
+			image := &types.Image{
+				Name:       info.Reference.ImageName(),
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", image.Name, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", image.Name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  info.Reference.Version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", image.Name, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// addFile adds a file to the metadata, creating the revisions it belongs to, and replacing the
+// file if it was uploaded before.
+func addFile(metadata *database.ConanMetadata, info ArtifactInfo, packageInfo *conan.Info, file database.File) {
+	now := file.CreatedAt
+	recipe := findRecipeRevision(metadata, info.Revision)
+	if recipe == nil {
+		metadata.Revisions = append(metadata.Revisions, database.ConanRecipeRevision{Revision: info.Revision})
+		recipe = &metadata.Revisions[len(metadata.Revisions)-1]
+	}
+	if info.PackageID == "" {
+		recipe.Time = now
+		recipe.Files = addFilename(recipe.Files, info.Filename)
+	} else {
+		prev := findPackageRevision(recipe, info.PackageID, info.PackageRevision)
+		if prev == nil {
+			pkg := findPackage(recipe, info.PackageID)
+			if pkg == nil {
+				recipe.Packages = append(recipe.Packages, database.ConanPackage{PackageID: info.PackageID})
+				pkg = &recipe.Packages[len(recipe.Packages)-1]
+			}
+			pkg.Revisions = append(pkg.Revisions, database.ConanPackageRevision{Revision: info.PackageRevision})
+			prev = &pkg.Revisions[len(pkg.Revisions)-1]
+		}
+		prev.Time = now
+		prev.Files = addFilename(prev.Files, info.Filename)
+		if packageInfo != nil {
+			prev.Info = packageInfo
+		}
+	}
+
+	for i := range metadata.Files {
+		if metadata.Files[i].Filename == file.Filename {
+			metadata.Files[i] = file
+			return
+		}
+	}
+	metadata.Files = append(metadata.Files, file)
+	metadata.FileCount = int64(len(metadata.Files))
+}
+
+func addFilename(files []string, filename string) []string {
+	for _, f := range files {
+		if f == filename {
+			return files
+		}
+	}
+	return append(files, filename)
+}
+
+// getMetadata returns the metadata of a recipe version, which is empty if it wasn't uploaded before.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	ref conan.Reference,
+) (*database.ConanMetadata, error) {
+	metadata := &database.ConanMetadata{Reference: ref}
+	image, err := c.imageDao.GetByName(ctx, registryID, ref.ImageName())
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recipe %s: %w", ref, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, ref.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recipe %s: %w", ref, err)
+	}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of recipe %s: %w", ref, err)
+	}
+	return metadata, nil
+}
@@ -0,0 +1,236 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/conda"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// The channel data and the repodata of the subdirs are generated from the published packages on
+// each request. Conda fetches the repodata of noarch and of its platform, so the repodata of a
+// subdir without packages is served empty.
+
+func (c *controller) GetChannelData(ctx context.Context, info ArtifactInfo) (*ChannelData, errcode.Error) {
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	packages, err := c.listPackages(ctx, reg.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return buildChannelData(packages), errcode.Error{}
+}
+
+func (c *controller) GetRepoData(ctx context.Context, info ArtifactInfo) (*RepoData, errcode.Error) {
+	if err := conda.ValidateSubdir(info.Subdir); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	packages, err := c.listPackages(ctx, reg.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return buildRepoData(packages, info.Subdir), errcode.Error{}
+}
+
+// DownloadPackage serves a package of a subdir by its file name.
+func (c *controller) DownloadPackage(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	name, version, _, _, err := conda.ParseFilename(info.Filename)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := conda.ValidateSubdir(info.Subdir); err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	metadata, err := c.getMetadata(ctx, reg.ID, name, version)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	found := false
+	for _, p := range metadata.Packages {
+		if p.Filename == info.Filename && p.Subdir == info.Subdir {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("%s not found for %s", info.Filename, info.Subdir))
+	}
+
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+packagePath(name, version, info.Subdir, info.Filename),
+		types.Registry{
+			ID:   reg.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, name, version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// listPackages returns the published packages of a registry, ordered by name and in the order
+// their versions were published.
+func (c *controller) listPackages(ctx context.Context, registryID int64) ([]database.CondaFile, error) {
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registryID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	var packages []database.CondaFile
+	for _, name := range names {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range *artifacts {
+			var metadata database.CondaMetadata
+			if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+				return nil, fmt.Errorf("failed to parse metadata of %s %s: %w", name, a.Version, err)
+			}
+			packages = append(packages, metadata.Packages...)
+		}
+	}
+	return packages, nil
+}
+
+// buildRepoData returns the repodata of the packages of a subdir.
+func buildRepoData(packages []database.CondaFile, subdir string) *RepoData {
+	repoData := &RepoData{
+		Info:            RepoDataInfo{Subdir: subdir},
+		Packages:        map[string]PackageRecord{},
+		PackagesConda:   map[string]PackageRecord{},
+		Removed:         []string{},
+		RepoDataVersion: repoDataVersion,
+	}
+	for _, p := range packages {
+		if p.Subdir != subdir {
+			continue
+		}
+		depends := p.Depends
+		if depends == nil {
+			depends = []string{}
+		}
+		record := PackageRecord{
+			Name:          p.Name,
+			Version:       p.Version,
+			Build:         p.Build,
+			BuildNumber:   p.BuildNumber,
+			Depends:       depends,
+			Constrains:    p.Constrains,
+			License:       p.License,
+			LicenseFamily: p.LicenseFamily,
+			Subdir:        p.Subdir,
+			Noarch:        p.Noarch,
+			Features:      p.Features,
+			TrackFeatures: p.TrackFeatures,
+			Timestamp:     p.Timestamp,
+			Size:          p.Size,
+			Md5:           p.Md5,
+			Sha256:        p.Sha256,
+		}
+		if p.Filename == p.Metadata.Filename(conda.ExtensionConda) {
+			repoData.PackagesConda[p.Filename] = record
+		} else {
+			repoData.Packages[p.Filename] = record
+		}
+	}
+	return repoData
+}
+
+// buildChannelData describes each package by its latest version, the one built last, or the
+// one published last if the builds have no timestamp.
+func buildChannelData(packages []database.CondaFile) *ChannelData {
+	channelData := &ChannelData{
+		ChannelDataVersion: channelDataVersion,
+		Packages:           map[string]ChannelPackage{},
+	}
+	subdirs := map[string]bool{conda.NoArch: true}
+	packageSubdirs := map[string]map[string]bool{}
+	for _, p := range packages {
+		subdirs[p.Subdir] = true
+		if packageSubdirs[p.Name] == nil {
+			packageSubdirs[p.Name] = map[string]bool{}
+		}
+		packageSubdirs[p.Name][p.Subdir] = true
+
+		if latest, ok := channelData.Packages[p.Name]; ok && latest.Timestamp > p.Timestamp {
+			continue
+		}
+		channelData.Packages[p.Name] = ChannelPackage{
+			Description: p.Description,
+			DevURL:      p.DevURL,
+			DocURL:      p.DocURL,
+			Home:        p.Home,
+			License:     p.License,
+			Summary:     p.Summary,
+			Version:     p.Version,
+			Timestamp:   p.Timestamp,
+		}
+	}
+	for name, channelPackage := range channelData.Packages {
+		channelPackage.Subdirs = sortedKeys(packageSubdirs[name])
+		channelData.Packages[name] = channelPackage
+	}
+	channelData.Subdirs = sortedKeys(subdirs)
+	return channelData
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
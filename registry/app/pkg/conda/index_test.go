@@ -0,0 +1,82 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conda
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/conda"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPackages() []database.CondaFile {
+	numpy := conda.Metadata{
+		Name: "numpy", Version: "1.26.3", Build: "py312h8753938_0", Subdir: "linux-64",
+		Depends: []string{"python >=3.12,<3.13.0a0"}, Timestamp: 100, Summary: "Array processing",
+	}
+	newer := numpy
+	newer.Version, newer.Timestamp, newer.Summary = "1.26.4", 200, "Array processing for numbers"
+	osx := newer
+	osx.Subdir = "osx-arm64"
+	six := conda.Metadata{
+		Name: "six", Version: "1.16.0", Build: "pyhd3eb1b0_1", Subdir: conda.NoArch, Noarch: "python",
+	}
+	return []database.CondaFile{
+		{Metadata: newer, Filename: newer.Filename(conda.ExtensionConda), Size: 10, Sha256: "a"},
+		{Metadata: newer, Filename: newer.Filename(conda.ExtensionTarBz2), Size: 12, Sha256: "b"},
+		{Metadata: numpy, Filename: numpy.Filename(conda.ExtensionConda), Size: 9, Sha256: "c"},
+		{Metadata: osx, Filename: osx.Filename(conda.ExtensionConda), Size: 11, Sha256: "d"},
+		{Metadata: six, Filename: six.Filename(conda.ExtensionTarBz2), Size: 3, Sha256: "e"},
+	}
+}
+
+func TestBuildRepoData(t *testing.T) {
+	repoData := buildRepoData(testPackages(), "linux-64")
+	assert.Equal(t, "linux-64", repoData.Info.Subdir)
+	assert.Equal(t, repoDataVersion, repoData.RepoDataVersion)
+	require.Len(t, repoData.PackagesConda, 2)
+	require.Len(t, repoData.Packages, 1)
+	record := repoData.PackagesConda["numpy-1.26.4-py312h8753938_0.conda"]
+	assert.Equal(t, "1.26.4", record.Version)
+	assert.Equal(t, int64(10), record.Size)
+	assert.Equal(t, []string{"python >=3.12,<3.13.0a0"}, record.Depends)
+	assert.Equal(t, "b", repoData.Packages["numpy-1.26.4-py312h8753938_0.tar.bz2"].Sha256)
+
+	repoData = buildRepoData(testPackages(), conda.NoArch)
+	require.Len(t, repoData.Packages, 1)
+	record = repoData.Packages["six-1.16.0-pyhd3eb1b0_1.tar.bz2"]
+	assert.Equal(t, conda.Noarch("python"), record.Noarch)
+	assert.Equal(t, []string{}, record.Depends)
+
+	repoData = buildRepoData(testPackages(), "win-64")
+	assert.Empty(t, repoData.Packages)
+	assert.Empty(t, repoData.PackagesConda)
+}
+
+func TestBuildChannelData(t *testing.T) {
+	channelData := buildChannelData(testPackages())
+	assert.Equal(t, []string{"linux-64", "noarch", "osx-arm64"}, channelData.Subdirs)
+	require.Len(t, channelData.Packages, 2)
+	numpy := channelData.Packages["numpy"]
+	assert.Equal(t, "1.26.4", numpy.Version)
+	assert.Equal(t, "Array processing for numbers", numpy.Summary)
+	assert.Equal(t, []string{"linux-64", "osx-arm64"}, numpy.Subdirs)
+	assert.Equal(t, []string{"noarch"}, channelData.Packages["six"].Subdirs)
+
+	assert.Equal(t, []string{"noarch"}, buildChannelData(nil).Subdirs)
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conda
+
+import (
+	"github.com/harness/gitness/registry/app/metadata/conda"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+const (
+	repoDataVersion    = 1
+	channelDataVersion = 1
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Subdir is the platform subdir requested, like linux-64 or noarch.
+	Subdir string
+	// Filename is the package requested for download.
+	Filename string
+}
+
+// RepoData is the repodata.json of a subdir, which lists its packages by file name, .tar.bz2
+// packages under packages and .conda packages under packages.conda.
+type RepoData struct {
+	Info            RepoDataInfo             `json:"info"`
+	Packages        map[string]PackageRecord `json:"packages"`
+	PackagesConda   map[string]PackageRecord `json:"packages.conda"`
+	Removed         []string                 `json:"removed"`
+	RepoDataVersion int                      `json:"repodata_version"`
+}
+
+type RepoDataInfo struct {
+	Subdir string `json:"subdir"`
+}
+
+type PackageRecord struct {
+	Name          string       `json:"name"`
+	Version       string       `json:"version"`
+	Build         string       `json:"build"`
+	BuildNumber   int64        `json:"build_number"`
+	Depends       []string     `json:"depends"`
+	Constrains    []string     `json:"constrains,omitempty"`
+	License       string       `json:"license,omitempty"`
+	LicenseFamily string       `json:"license_family,omitempty"`
+	Subdir        string       `json:"subdir"`
+	Noarch        conda.Noarch `json:"noarch,omitempty"`
+	Features      string       `json:"features,omitempty"`
+	TrackFeatures string       `json:"track_features,omitempty"`
+	Timestamp     int64        `json:"timestamp,omitempty"`
+	Size          int64        `json:"size"`
+	Md5           string       `json:"md5"`
+	Sha256        string       `json:"sha256"`
+}
+
+// ChannelData is the channeldata.json of the channel, which describes each package by its
+// latest version and lists the subdirs of the channel.
+type ChannelData struct {
+	ChannelDataVersion int                       `json:"channeldata_version"`
+	Packages           map[string]ChannelPackage `json:"packages"`
+	Subdirs            []string                  `json:"subdirs"`
+}
+
+type ChannelPackage struct {
+	Description string   `json:"description,omitempty"`
+	DevURL      string   `json:"dev_url,omitempty"`
+	DocURL      string   `json:"doc_url,omitempty"`
+	Home        string   `json:"home,omitempty"`
+	License     string   `json:"license,omitempty"`
+	Summary     string   `json:"summary,omitempty"`
+	Subdirs     []string `json:"subdirs"`
+	Version     string   `json:"version"`
+	Timestamp   int64    `json:"timestamp,omitempty"`
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/conda"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// UploadPackage publishes a .conda or .tar.bz2 package. The builds of a version are stored per
+// subdir, as a build may be published for several platforms under the same file name, and each
+// file of a subdir can be published once.
+func (c *controller) UploadPackage(
+	ctx context.Context,
+	info ArtifactInfo,
+	file io.ReaderAt,
+	size int64,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	metadata, extension, err := conda.ReadPackage(file, size)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := metadata.Validate(); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	name, version, filename := metadata.Name, metadata.Version, metadata.Filename(extension)
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeCONDA {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a conda registry", registry.Name))
+	}
+	existing, err := c.getMetadata(ctx, registry.ID, name, version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	for _, p := range existing.Packages {
+		if p.Filename == filename && p.Subdir == metadata.Subdir {
+			return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+				fmt.Sprintf("%s was published for %s before", filename, metadata.Subdir))
+		}
+	}
+
+	fileInfo, err := c.fileManager.UploadFile(ctx, packagePath(name, version, metadata.Subdir, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil, io.NewSectionReader(file, 0, size),
+		filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	existing.Files = append(existing.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+	})
+	existing.FileCount = int64(len(existing.Files))
+	existing.Packages = append(existing.Packages, database.CondaFile{
+		Metadata: *metadata,
+		Filename: filename,
+		Size:     fileInfo.Size,
+		Md5:      fileInfo.MD5,
+		Sha256:   fileInfo.Sha256,
+	})
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(existing)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// getMetadata returns the metadata of a version, which is empty if it wasn't published before.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*database.CondaMetadata, error) {
+	metadata := &database.CondaMetadata{}
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find package %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of package %s: %w", version, name, err)
+	}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s of package %s: %w", version, name, err)
+	}
+	return metadata, nil
+}
+
+// packagePath returns the path a package is stored at, which includes the subdir as builds of
+// several platforms may have the same file name.
+func packagePath(name, version, subdir, filename string) string {
+	return name + "/" + version + "/" + subdir + "/" + filename
+}
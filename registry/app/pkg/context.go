@@ -28,6 +28,16 @@ type BaseInfo struct {
 	ParentID        int64
 	RootIdentifier  string
 	RootParentID    int64
+	// StoragePrefix is the custom storage path prefix of the registry, if configured.
+	StoragePrefix string
+}
+
+// StorageRoot returns the path prefix under which the blobs of the registry are stored.
+func (b *BaseInfo) StorageRoot() string {
+	if b.StoragePrefix != "" {
+		return b.StoragePrefix
+	}
+	return b.RootIdentifier
 }
 
 type ArtifactInfo struct {
@@ -44,6 +54,8 @@ type RegistryInfo struct {
 	URLBuilder  *v2.URLBuilder
 	Path        string
 	PackageType artifact.PackageType
+	// IfMatch is the If-Match header of a tag update, listing the digests the tag is expected to point to.
+	IfMatch string
 }
 
 type FileInfo struct {
@@ -54,6 +66,9 @@ type FileInfo struct {
 	MD5       string
 	Filename  string
 	CreatedAt time.Time
+	// Deduplicated reports that the content of an uploaded file was stored already, so only a
+	// reference to it was added.
+	Deduplicated bool
 }
 
 func (r *RegistryInfo) SetReference(ref string) {
@@ -73,6 +88,10 @@ type MavenArtifactInfo struct {
 	Version       string
 	FileName      string
 	Path          string
+	// CreateOnly is set for uploads with "If-None-Match: *" which must fail if the version already has the file.
+	CreateOnly bool
+	// StrictMetadata rejects POMs missing the metadata Maven Central requires.
+	StrictMetadata bool
 }
 
 type GenericArtifactInfo struct {
@@ -81,6 +100,8 @@ type GenericArtifactInfo struct {
 	Version     string
 	RegistryID  int64
 	Description string
+	// CreateOnly is set for uploads with "If-None-Match: *" which must fail if the version exists.
+	CreateOnly bool
 }
 
 func (a *MavenArtifactInfo) SetMavenRepoKey(key string) {
@@ -16,6 +16,7 @@ package pkg
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	store2 "github.com/harness/gitness/registry/app/store"
@@ -70,14 +71,35 @@ func (c *CoreController) GetOrderedRepos(
 	var result []types.Registry
 	if registry, err := c.RegistryDao.GetByParentIDAndName(ctx, artInfo.ParentID, repoKey); err == nil {
 		result = append(result, *registry)
-		proxies := registry.UpstreamProxies
-		if len(proxies) > 0 {
-			upstreamRepos, _ := c.RegistryDao.GetByIDIn(ctx, proxies)
-			result = append(result, *upstreamRepos...)
-		}
+		upstreamRepos, _ := GetUpstreamRegistries(ctx, c.RegistryDao, registry)
+		result = append(result, upstreamRepos...)
 	} else {
 		return result, err
 	}
 
 	return result, nil
 }
+
+// GetUpstreamRegistries returns the upstream proxies of the registry in the order they're configured in.
+func GetUpstreamRegistries(
+	ctx context.Context,
+	registryDao store2.RegistryRepository,
+	registry *types.Registry,
+) ([]types.Registry, error) {
+	if len(registry.UpstreamProxies) == 0 {
+		return nil, nil
+	}
+	repos, err := registryDao.GetByIDIn(ctx, registry.UpstreamProxies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream proxies of registry %s: %w", registry.Name, err)
+	}
+	upstreams := make([]types.Registry, 0, len(*repos))
+	for _, id := range registry.UpstreamProxies {
+		for _, repo := range *repos {
+			if repo.ID == id {
+				upstreams = append(upstreams, repo)
+			}
+		}
+	}
+	return upstreams, nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cran
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/cran"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+func (c *controller) GetIndex(ctx context.Context, info ArtifactInfo, compressed bool) ([]byte, errcode.Error) {
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	packages, err := c.listPackages(ctx, registry.ID, info.Tree.Path)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	index := buildIndex(packages)
+	if compressed {
+		if index, err = compress(index); err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+	}
+	return index, errcode.Error{}
+}
+
+// DownloadPackage serves an archive of a tree by its filename, including the versions the
+// PACKAGES index no longer lists.
+func (c *controller) DownloadPackage(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	name, version, _, err := cran.ParseFilename(info.Filename)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	metadata, err := c.getMetadata(ctx, registry.ID, name, version)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	found := false
+	for _, p := range metadata.Packages {
+		found = found || (p.Tree == info.Tree.Path && p.Filename == info.Filename)
+	}
+	if !found {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			info.Tree.Path + "/" + info.Filename + " not found")
+	}
+
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(name, version, info.Tree.Path, info.Filename),
+		types.Registry{
+			ID:   registry.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, registry.ID, name, version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + info.Filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) getRegistry(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeCRAN {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a cran registry", registry.Name))
+	}
+	return registry, errcode.Error{}
+}
+
+// listPackages returns the archives of the latest version of each package published to a tree.
+func (c *controller) listPackages(
+	ctx context.Context,
+	registryID int64,
+	tree string,
+) ([]database.CranFile, error) {
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registryID)
+	if err != nil {
+		return nil, err
+	}
+	var packages []database.CranFile
+	for _, name := range names {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var latest *database.CranFile
+		for _, a := range *artifacts {
+			var metadata database.CranMetadata
+			if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+				return nil, fmt.Errorf("failed to parse metadata of %s %s: %w", name, a.Version, err)
+			}
+			for i, p := range metadata.Packages {
+				if p.Tree == tree && (latest == nil || cran.CompareVersions(p.Version, latest.Version) > 0) {
+					latest = &metadata.Packages[i]
+				}
+			}
+		}
+		if latest != nil {
+			packages = append(packages, *latest)
+		}
+	}
+	return packages, nil
+}
+
+// buildIndex returns the PACKAGES index of a tree, whose entries are sorted by package name.
+func buildIndex(packages []database.CranFile) []byte {
+	sort.SliceStable(packages, func(i, j int) bool {
+		return packages[i].Package < packages[j].Package
+	})
+	var buf bytes.Buffer
+	for _, p := range packages {
+		buf.WriteString(strings.TrimRight(p.IndexEntry(p.MD5), "\n"))
+		buf.WriteString("\n\n")
+	}
+	return buf.Bytes()
+}
+
+func compress(index []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(index); err != nil {
+		return nil, fmt.Errorf("failed to compress index: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress index: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// getMetadata returns the metadata of a version, which is empty if it wasn't published before.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*database.CranMetadata, error) {
+	metadata := &database.CranMetadata{}
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find package %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of package %s: %w", version, name, err)
+	}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s of package %s: %w", version, name, err)
+	}
+	return metadata, nil
+}
+
+// filePath returns the path an archive is stored at, under the tree it was published to as
+// binary packages of the same version share their filename.
+func filePath(name, version, tree, filename string) string {
+	return name + "/" + version + "/" + tree + "/" + filename
+}
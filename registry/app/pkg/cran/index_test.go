@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cran
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/cran"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildIndex(t *testing.T) {
+	index := buildIndex([]database.CranFile{
+		{
+			Description: cran.Description{Package: "zoo", Version: "1.8-12", License: "GPL-2"},
+			MD5:         "f00",
+		},
+		{
+			Description: cran.Description{
+				Package: "tidyfoo", Version: "1.2-3", Imports: "rlang", NeedsCompilation: "no",
+				Built: "R 4.3.2; ; 2024-01-10 10:00:00 UTC; windows",
+			},
+			MD5: "ba5",
+		},
+	})
+	assert.Equal(t, "Package: tidyfoo\nVersion: 1.2-3\nImports: rlang\nMD5sum: ba5\nNeedsCompilation: no\n"+
+		"Built: R 4.3.2; ; 2024-01-10 10:00:00 UTC; windows\n\n"+
+		"Package: zoo\nVersion: 1.8-12\nLicense: GPL-2\nMD5sum: f00\n\n", string(index))
+	assert.Empty(t, buildIndex(nil))
+}
+
+func TestFilePath(t *testing.T) {
+	assert.Equal(t, "tidyfoo/1.2-3/bin/windows/contrib/4.3/tidyfoo_1.2-3.zip",
+		filePath("tidyfoo", "1.2-3", "bin/windows/contrib/4.3", "tidyfoo_1.2-3.zip"))
+}
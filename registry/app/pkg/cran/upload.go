@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cran
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/cran"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+)
+
+// UploadPackage publishes an archive to a tree. A version holds its source package and a binary
+// package per binary tree, each of which is immutable once published.
+func (c *controller) UploadPackage(
+	ctx context.Context,
+	info ArtifactInfo,
+	file io.ReaderAt,
+	size int64,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	description, format, err := cran.ReadPackage(file, size)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := description.ValidateTree(info.Tree, format); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	name, version := description.Package, description.Version
+	filename := cran.Filename(name, version, format)
+
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, errc
+	}
+	metadata, err := c.getMetadata(ctx, registry.ID, name, version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	for _, p := range metadata.Packages {
+		if p.Tree == info.Tree.Path {
+			return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+				fmt.Sprintf("%s %s was published to %s before", name, version, info.Tree.Path))
+		}
+	}
+
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(name, version, info.Tree.Path, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		io.NewSectionReader(file, 0, size), filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata.Packages = append(metadata.Packages, database.CranFile{
+		Description: *description,
+		Tree:        info.Tree.Path,
+		Filename:    filename,
+		Size:        fileInfo.Size,
+		MD5:         fileInfo.MD5,
+		Sha256:      fileInfo.Sha256,
+	})
+	metadata.Files = append(metadata.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+	})
+	metadata.FileCount = int64(len(metadata.Files))
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"context"
+	"io"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the APT repository of debian registries.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	signer               *Signer
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	UploadPackage(ctx context.Context, info ArtifactInfo, file io.ReaderAt, size int64) (
+		*commons.ResponseHeaders,
+		errcode.Error,
+	)
+	// GetReleaseFile returns the Release, InRelease or Release.gpg of a distribution.
+	GetReleaseFile(ctx context.Context, info ArtifactInfo, file string) ([]byte, errcode.Error)
+	// GetPackages returns the Packages index of an architecture of a component, gzipped if compressed.
+	GetPackages(ctx context.Context, info ArtifactInfo, compressed bool) ([]byte, errcode.Error)
+	GetPublicKey(ctx context.Context) ([]byte, errcode.Error)
+	DownloadPackage(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+}
+
+// NewController creates a new debian controller, Release files are left unsigned if signer is nil.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	signer *Signer,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		signer:               signer,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
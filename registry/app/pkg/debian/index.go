@@ -0,0 +1,311 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5" //nolint:gosec // apt still reads the MD5Sum of Release files.
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/debian"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// architectureAll is the architecture of packages which install on every architecture, they are
+// listed in the Packages index of each architecture.
+const architectureAll = "all"
+
+// GetReleaseFile generates the Release of a distribution, which lists the checksums of its indices,
+// and signs it for InRelease and Release.gpg. The Release is generated from the published
+// packages alone, so the Release.gpg verifies the Release served by a later request.
+func (c *controller) GetReleaseFile(ctx context.Context, info ArtifactInfo, file string) ([]byte, errcode.Error) {
+	if file != FileRelease && c.signer == nil {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("the repository isn't signed, use the Release")
+	}
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	debs, modified, err := c.listDebs(ctx, registry.ID, info.Distribution)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(debs) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("distribution " + info.Distribution + " not found")
+	}
+	release, err := buildRelease(registry.Name, info.Distribution, debs, modified)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	switch file {
+	case FileInRelease:
+		release, err = c.signer.ClearSign(release)
+	case FileReleaseGPG:
+		release, err = c.signer.DetachSign(release)
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return release, errcode.Error{}
+}
+
+func (c *controller) GetPackages(ctx context.Context, info ArtifactInfo, compressed bool) ([]byte, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	debs, _, err := c.listDebs(ctx, registry.ID, info.Distribution)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(debs) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("distribution " + info.Distribution + " not found")
+	}
+	packages := buildPackages(debs, info.Component, info.Architecture)
+	if compressed {
+		if packages, err = compress(packages); err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+	}
+	return packages, errcode.Error{}
+}
+
+func (c *controller) GetPublicKey(_ context.Context) ([]byte, errcode.Error) {
+	if c.signer == nil {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("the repository isn't signed")
+	}
+	key, err := c.signer.PublicKey()
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return key, errcode.Error{}
+}
+
+// DownloadPackage serves a .deb of the pool by its filename.
+func (c *controller) DownloadPackage(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	name, _, _, err := debian.ParseFilename(info.Filename)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, reg.ID, name)
+	if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	var version string
+	if artifacts != nil {
+		for _, a := range *artifacts {
+			var metadata database.DebMetadata
+			if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+				return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+			}
+			for _, f := range metadata.Files {
+				if f.Filename == info.Filename {
+					version = a.Version
+				}
+			}
+		}
+	}
+	if version == "" {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(info.Filename + " not found")
+	}
+
+	path := "/" + name + "/" + version + "/" + info.Filename
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
+		ID:   reg.ID,
+		Name: info.RegIdentifier,
+	}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, name, version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// listDebs returns the .debs published to a distribution along with the time the last of them
+// was published.
+func (c *controller) listDebs(
+	ctx context.Context,
+	registryID int64,
+	distribution string,
+) ([]database.DebFile, time.Time, error) {
+	var modified time.Time
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registryID)
+	if err != nil {
+		return nil, modified, err
+	}
+	var debs []database.DebFile
+	for _, name := range names {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, modified, err
+		}
+		for _, a := range *artifacts {
+			var metadata database.DebMetadata
+			if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+				return nil, modified, fmt.Errorf("failed to parse metadata of %s %s: %w", name, a.Version, err)
+			}
+			for _, d := range metadata.Debs {
+				if d.Distribution != distribution {
+					continue
+				}
+				debs = append(debs, d)
+				if a.UpdatedAt.After(modified) {
+					modified = a.UpdatedAt
+				}
+			}
+		}
+	}
+	return debs, modified, nil
+}
+
+// buildPackages returns the Packages index of an architecture of a component, whose entries are
+// the control files of the packages along with their pool file and checksums.
+func buildPackages(debs []database.DebFile, component, architecture string) []byte {
+	var selected []database.DebFile
+	for _, d := range debs {
+		if d.Component == component && (d.Architecture == architecture || d.Architecture == architectureAll) {
+			selected = append(selected, d)
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		if selected[i].Package != selected[j].Package {
+			return selected[i].Package < selected[j].Package
+		}
+		if cmp := versioning.Compare(versioning.SchemeDebian, selected[i].Version,
+			selected[j].Version); cmp != 0 {
+			return cmp < 0
+		}
+		return selected[i].Architecture < selected[j].Architecture
+	})
+
+	var buf bytes.Buffer
+	for _, d := range selected {
+		buf.WriteString(strings.TrimRight(d.Control, "\n"))
+		fmt.Fprintf(&buf, "\nFilename: %s\nSize: %d\nMD5sum: %s\nSHA1: %s\nSHA256: %s\n\n",
+			debian.PoolPath(d.Component, d.Package, d.Filename), d.Size, d.MD5, d.Sha1, d.Sha256)
+	}
+	return buf.Bytes()
+}
+
+// buildRelease returns the Release of a distribution, listing the Packages indices of each
+// component and architecture the distribution has packages for.
+func buildRelease(origin, distribution string, debs []database.DebFile, modified time.Time) ([]byte, error) {
+	components, architectures := map[string]bool{}, map[string]bool{}
+	for _, d := range debs {
+		components[d.Component] = true
+		if d.Architecture != architectureAll {
+			architectures[d.Architecture] = true
+		}
+	}
+	if len(architectures) == 0 {
+		architectures[architectureAll] = true
+	}
+
+	var md5sums, sha256sums strings.Builder
+	for _, component := range sortedKeys(components) {
+		for _, architecture := range sortedKeys(architectures) {
+			packages := buildPackages(debs, component, architecture)
+			compressed, err := compress(packages)
+			if err != nil {
+				return nil, err
+			}
+			dir := component + "/binary-" + architecture + "/"
+			for _, index := range []struct {
+				name    string
+				content []byte
+			}{
+				{FilePackages, packages},
+				{FilePackagesGz, compressed},
+			} {
+				md5sum := md5.Sum(index.content) //nolint:gosec
+				sha256sum := sha256.Sum256(index.content)
+				fmt.Fprintf(&md5sums, " %s %16d %s\n", hex.EncodeToString(md5sum[:]), len(index.content),
+					dir+index.name)
+				fmt.Fprintf(&sha256sums, " %s %16d %s\n", hex.EncodeToString(sha256sum[:]), len(index.content),
+					dir+index.name)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Origin: %s\nLabel: %s\nSuite: %s\nCodename: %s\n", origin, origin, distribution, distribution)
+	fmt.Fprintf(&buf, "Date: %s\n", modified.UTC().Format(time.RFC1123))
+	fmt.Fprintf(&buf, "Architectures: %s\n", strings.Join(sortedKeys(architectures), " "))
+	fmt.Fprintf(&buf, "Components: %s\n", strings.Join(sortedKeys(components), " "))
+	fmt.Fprintf(&buf, "MD5Sum:\n%sSHA256:\n%s", md5sums.String(), sha256sums.String())
+	return buf.Bytes(), nil
+}
+
+// compress gzips an index, the output only depends on the index so that it matches its checksum
+// in the Release.
+func compress(index []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := gz.Write(index); err != nil {
+		return nil, fmt.Errorf("failed to compress index: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress index: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/metadata/debian"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	//nolint:staticcheck // see sign.go.
+	"golang.org/x/crypto/openpgp"
+	//nolint:staticcheck // see sign.go.
+	"golang.org/x/crypto/openpgp/armor"
+	//nolint:staticcheck // see sign.go.
+	"golang.org/x/crypto/openpgp/clearsign"
+)
+
+func testDeb(name, version, architecture, component string) database.DebFile {
+	control := fmt.Sprintf("Package: %s\nVersion: %s\nArchitecture: %s\nDescription: test\n",
+		name, version, architecture)
+	return database.DebFile{
+		Metadata: debian.Metadata{
+			Package: name, Version: version, Architecture: architecture, Control: control,
+		},
+		Distribution: "stable",
+		Component:    component,
+		Filename:     debian.Filename(name, version, architecture),
+		Size:         42,
+		MD5:          "md5",
+		Sha1:         "sha1",
+		Sha256:       "sha256",
+	}
+}
+
+func TestBuildPackages(t *testing.T) {
+	debs := []database.DebFile{
+		testDeb("hello", "1.10", "amd64", "main"),
+		testDeb("hello", "1.9", "amd64", "main"),
+		testDeb("hello", "1.9", "arm64", "main"),
+		testDeb("docs", "1.0", "all", "main"),
+		testDeb("extra", "1.0", "amd64", "contrib"),
+	}
+	packages := string(buildPackages(debs, "main", "amd64"))
+	paragraphs := strings.Split(strings.TrimSuffix(packages, "\n\n"), "\n\n")
+	require.Len(t, paragraphs, 3)
+	assert.Contains(t, paragraphs[0], "Package: docs\n")
+	assert.Contains(t, paragraphs[1], "Version: 1.9\n")
+	assert.Contains(t, paragraphs[2], "Version: 1.10\n")
+	assert.Contains(t, paragraphs[2], "Filename: pool/main/h/hello/hello_1.10_amd64.deb\nSize: 42\n"+
+		"MD5sum: md5\nSHA1: sha1\nSHA256: sha256")
+	assert.Empty(t, buildPackages(debs, "contrib", "arm64"))
+}
+
+func TestBuildRelease(t *testing.T) {
+	debs := []database.DebFile{
+		testDeb("hello", "1.0", "amd64", "main"),
+		testDeb("docs", "1.0", "all", "main"),
+	}
+	modified := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	release, err := buildRelease("debs", "stable", debs, modified)
+	require.NoError(t, err)
+	text := string(release)
+	assert.Contains(t, text, "Suite: stable\nCodename: stable\nDate: Fri, 01 Mar 2024 12:00:00 UTC\n"+
+		"Architectures: amd64\nComponents: main\n")
+
+	packages := buildPackages(debs, "main", "amd64")
+	sum := sha256.Sum256(packages)
+	assert.Contains(t, text, fmt.Sprintf(" %s %16d main/binary-amd64/Packages\n",
+		hex.EncodeToString(sum[:]), len(packages)))
+
+	compressed, err := compress(packages)
+	require.NoError(t, err)
+	again, err := compress(packages)
+	require.NoError(t, err)
+	assert.Equal(t, compressed, again)
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	assert.Equal(t, packages, decompressed)
+
+	onlyAll, err := buildRelease("debs", "stable", debs[1:], modified)
+	require.NoError(t, err)
+	assert.Contains(t, string(onlyAll), "Architectures: all\n")
+	assert.Contains(t, string(onlyAll), "main/binary-all/Packages.gz\n")
+}
+
+func TestSigner(t *testing.T) {
+	signer, err := ParseSigningKey("")
+	require.NoError(t, err)
+	assert.Nil(t, signer)
+
+	entity, err := openpgp.NewEntity("registry", "", "registry@example.com", nil)
+	require.NoError(t, err)
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivate(w, nil))
+	require.NoError(t, w.Close())
+
+	signer, err = ParseSigningKey(key.String())
+	require.NoError(t, err)
+	publicKey, err := signer.PublicKey()
+	require.NoError(t, err)
+	keyRing, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(publicKey))
+	require.NoError(t, err)
+
+	release := []byte("Origin: debs\nSuite: stable\n")
+	inRelease, err := signer.ClearSign(release)
+	require.NoError(t, err)
+	block, _ := clearsign.Decode(inRelease)
+	require.NotNil(t, block)
+	assert.Equal(t, release, block.Plaintext)
+	_, err = openpgp.CheckDetachedSignature(keyRing, bytes.NewReader(block.Bytes), block.ArmoredSignature.Body)
+	assert.NoError(t, err)
+
+	signature, err := signer.DetachSign(release)
+	require.NoError(t, err)
+	_, err = openpgp.CheckArmoredDetachedSignature(keyRing, bytes.NewReader(release), bytes.NewReader(signature))
+	assert.NoError(t, err)
+
+	_, err = ParseSigningKey(string(publicKey))
+	assert.Error(t, err)
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	//nolint:staticcheck // the OpenPGP package is frozen, not insecure, and is what apt keys need.
+	"golang.org/x/crypto/openpgp"
+	//nolint:staticcheck // see above.
+	"golang.org/x/crypto/openpgp/armor"
+	//nolint:staticcheck // see above.
+	"golang.org/x/crypto/openpgp/clearsign"
+)
+
+// Signer signs the Release files of the repositories, which apt verifies with the public key.
+type Signer struct {
+	entity *openpgp.Entity
+}
+
+// ParseSigningKey parses an ASCII armored OpenPGP private key, returning nil if key is empty.
+func ParseSigningKey(key string) (*Signer, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, nil //nolint:nilnil
+	}
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read debian signing key: %w", err)
+	}
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("debian signing key must contain one key, found %d", len(entities))
+	}
+	entity := entities[0]
+	if entity.PrivateKey == nil {
+		return nil, errors.New("debian signing key must be a private key")
+	}
+	if entity.PrivateKey.Encrypted {
+		return nil, errors.New("debian signing key must not be protected by a passphrase")
+	}
+	return &Signer{entity: entity}, nil
+}
+
+// ClearSign returns the InRelease for a Release file.
+func (s *Signer) ClearSign(release []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := clearsign.Encode(&buf, s.entity.PrivateKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign release: %w", err)
+	}
+	if _, err := w.Write(release); err != nil {
+		return nil, fmt.Errorf("failed to sign release: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to sign release: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// DetachSign returns the Release.gpg for a Release file.
+func (s *Signer) DetachSign(release []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&buf, s.entity, bytes.NewReader(release), nil); err != nil {
+		return nil, fmt.Errorf("failed to sign release: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// PublicKey returns the ASCII armored public key, which apt is configured with in signed-by.
+func (s *Signer) PublicKey() ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.entity.Serialize(w); err != nil {
+		return nil, fmt.Errorf("failed to serialize public key: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
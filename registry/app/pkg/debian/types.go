@@ -0,0 +1,38 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+const (
+	FileRelease    = "Release"
+	FileInRelease  = "InRelease"
+	FileReleaseGPG = "Release.gpg"
+	FilePackages   = "Packages"
+	FilePackagesGz = "Packages.gz"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Distribution and Component are the suite and the section of the repository requested.
+	Distribution string
+	Component    string
+	// Architecture is the architecture of a Packages index.
+	Architecture string
+	// Filename is the .deb requested for download.
+	Filename string
+}
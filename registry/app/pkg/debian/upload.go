@@ -0,0 +1,180 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debian
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/debian"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// UploadPackage publishes a .deb to a component of a distribution. Like in Debian archives, the
+// pool holds a single file per name, so a .deb can be published to further distributions and
+// components, but a different .deb of the same name is rejected.
+func (c *controller) UploadPackage(
+	ctx context.Context,
+	info ArtifactInfo,
+	file io.ReaderAt,
+	size int64,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	metadata, err := debian.ReadPackage(io.NewSectionReader(file, 0, size))
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := metadata.Validate(); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	sha256sum, err := checksum(file, size)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	name, version := metadata.Package, metadata.Version
+	filename := debian.Filename(name, version, metadata.Architecture)
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeDEB {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a debian registry", registry.Name))
+	}
+	existing, err := c.getMetadata(ctx, registry.ID, name, version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	deb := database.DebFile{
+		Metadata:     *metadata,
+		Distribution: info.Distribution,
+		Component:    info.Component,
+		Filename:     filename,
+	}
+	var stored *database.DebFile
+	for i, d := range existing.Debs {
+		if d.Filename != filename {
+			continue
+		}
+		if d.Sha256 != sha256sum {
+			return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+				fmt.Sprintf("a different %s was published before", filename))
+		}
+		if d.Distribution == deb.Distribution && d.Component == deb.Component {
+			return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+				fmt.Sprintf("%s was published to %s/%s before", filename, deb.Distribution, deb.Component))
+		}
+		stored = &existing.Debs[i]
+	}
+	if stored != nil {
+		deb.Size, deb.MD5, deb.Sha1, deb.Sha256 = stored.Size, stored.MD5, stored.Sha1, stored.Sha256
+	} else {
+		fileInfo, err := c.fileManager.UploadFile(ctx, name+"/"+version+"/"+filename, info.RegIdentifier,
+			registry.ID, info.RootParentID, info.StorageRoot(), nil, io.NewSectionReader(file, 0, size), filename)
+		if err != nil {
+			return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		deb.Size, deb.MD5, deb.Sha1, deb.Sha256 = fileInfo.Size, fileInfo.MD5, fileInfo.Sha1, fileInfo.Sha256
+		existing.Files = append(existing.Files, database.File{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		})
+		existing.FileCount = int64(len(existing.Files))
+	}
+	existing.Debs = append(existing.Debs, deb)
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(existing)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// getMetadata returns the metadata of a version, which is empty if it wasn't published before.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*database.DebMetadata, error) {
+	metadata := &database.DebMetadata{}
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find package %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return metadata, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of package %s: %w", version, name, err)
+	}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s of package %s: %w", version, name, err)
+	}
+	return metadata, nil
+}
+
+func checksum(file io.ReaderAt, size int64) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, io.NewSectionReader(file, 0, size)); err != nil {
+		return "", fmt.Errorf("failed to read package: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
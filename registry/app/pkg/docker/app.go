@@ -47,6 +47,7 @@ type App struct {
 
 	Config         *types.Config
 	storageService *registrystorage.Service
+	uploadSessions store.UploadSessionRepository
 }
 
 // NewApp takes a configuration and returns a configured app.
@@ -54,12 +55,13 @@ func NewApp(
 	ctx context.Context, storageDeleter storagedriver.StorageDeleter,
 	blobRepo store.BlobRepository, spaceStore corestore.SpaceStore,
 	cfg *types.Config, storageService *registrystorage.Service,
-	gcService gc.Service,
+	gcService gc.Service, uploadSessions store.UploadSessionRepository,
 ) *App {
 	app := &App{
 		Context:        ctx,
 		Config:         cfg,
 		storageService: storageService,
+		uploadSessions: uploadSessions,
 	}
 	app.configureSecret(cfg)
 	gcService.Start(ctx, spaceStore, blobRepo, storageDeleter, cfg)
@@ -78,6 +80,8 @@ func GetStorageService(cfg *types.Config, driver storagedriver.StorageDriver) *r
 		log.Info().Msg("backend redirection disabled")
 	}
 
+	options = append(options, registrystorage.WithReadAheadSize(cfg.Registry.BlobServing.ReadAheadSize))
+
 	storageService, err := registrystorage.NewStorageService(driver, options...)
 	if err != nil {
 		panic("could not create storage service: " + err.Error())
@@ -102,8 +106,9 @@ func (app *App) configureSecret(configuration *types.Config) {
 		configuration.Registry.HTTP.Secret = string(secretBytes[:])
 		dcontext.GetLogger(app, log.Warn()).
 			Msg(
-				"No HTTP secret provided - generated random secret. This may cause problems with uploads if" +
-					" multiple registries are behind a load-balancer. To provide a shared secret," +
+				"No HTTP secret provided - generated random secret. Uploads are resumed from their sessions" +
+					" stored in the database, but uploads started by an older version may fail if multiple" +
+					" registries are behind a load-balancer. To provide a shared secret," +
 					" set the GITNESS_REGISTRY_HTTP_SECRET environment variable.",
 			)
 	}
@@ -119,7 +124,7 @@ func (app *App) GetBlobsContext(c context.Context, info pkg.RegistryInfo) *Conte
 		Digest:  digest.Digest(info.Digest),
 	}
 	context.URLBuilder = info.URLBuilder
-	blobStore := app.storageService.OciBlobsStore(c, info.RegIdentifier, info.RootIdentifier)
+	blobStore := app.storageService.OciBlobsStore(c, info.RegIdentifier, info.StorageRoot())
 	context.OciBlobStore = blobStore
 
 	return context
@@ -0,0 +1,104 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	registryevents "github.com/harness/gitness/registry/app/events"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/types"
+
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// parseBaseImageName splits an image reference like "host/root/registry/image:tag" into its
+// repository and tag. References without a tag, e.g. pinned by digest only, are not returned.
+func parseBaseImageName(name string) (repository string, tag string, ok bool) {
+	name, _, _ = strings.Cut(name, "@")
+	i := strings.LastIndex(name, ":")
+	if i <= 0 || strings.Contains(name[i+1:], "/") || i == len(name)-1 {
+		return "", "", false
+	}
+	return strings.ToLower(name[:i]), name[i+1:], true
+}
+
+// recordBaseImage stores the base image declared in the annotations of the manifest so that
+// dependents can be notified once the base image tag gets updated. Failures are only logged
+// as the relationship is informational and must not fail the push.
+func (l *manifestService) recordBaseImage(ctx context.Context, m *types.Manifest) {
+	repository, tag, ok := parseBaseImageName(m.Annotations[v1.AnnotationBaseImageName])
+	if !ok {
+		return
+	}
+	err := l.baseImageDao.Upsert(ctx, &types.BaseImage{
+		RegistryID:     m.RegistryID,
+		ManifestID:     m.ID,
+		ImageName:      m.ImageName,
+		BaseRepository: repository,
+		BaseTag:        tag,
+		BaseDigest:     m.Annotations[v1.AnnotationBaseImageDigest],
+	})
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to record base image of manifest %s", m.Digest)
+	}
+}
+
+// reportBaseImageUpdated reports a base image updated event for every tag built from the image
+// tag that was just updated to the given digest.
+func (l *manifestService) reportBaseImageUpdated(
+	ctx context.Context,
+	info pkg.RegistryInfo,
+	principalID int64,
+	regIdentifier string,
+	tag string,
+	digest string,
+) {
+	repository := strings.ToLower(GetRepoURLWithoutProtocol(
+		l.urlProvider.RegistryURL(ctx, info.RootIdentifier, regIdentifier) + "/" + info.Image))
+	dependents, err := l.baseImageDao.ListDependents(ctx, repository, tag)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to list dependents of base image %s:%s", repository, tag)
+		return
+	}
+
+	for _, dependent := range dependents {
+		if dependent.BaseDigest == digest {
+			continue
+		}
+		payload := &registryevents.BaseImageUpdatedPayload{
+			RegistryID:   dependent.RegistryID,
+			PrincipalID:  principalID,
+			ArtifactType: artifact.PackageTypeDOCKER,
+			Artifact: &registryevents.DockerArtifact{
+				BaseArtifact: registryevents.BaseArtifact{
+					Name: dependent.ImageName,
+					Ref:  dependent.ImageName + ":" + dependent.Tag,
+				},
+				Tag: dependent.Tag,
+			},
+			BaseImage: registryevents.BaseImageChange{
+				Name:      repository,
+				Tag:       tag,
+				OldDigest: dependent.BaseDigest,
+				NewDigest: digest,
+			},
+		}
+		l.artifactEventReporter.BaseImageUpdated(ctx, payload)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBaseImageName(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		tag        string
+		ok         bool
+	}{
+		{"localhost:3000/Root/base/alpine:3.19", "localhost:3000/root/base/alpine", "3.19", true},
+		{"docker.io/library/alpine:3.19@sha256:abcd", "docker.io/library/alpine", "3.19", true},
+		{"localhost:3000/root/base/alpine", "", "", false},
+		{"docker.io/library/alpine@sha256:abcd", "", "", false},
+		{"", "", "", false},
+	}
+	for _, tt := range tests {
+		repository, tag, ok := parseBaseImageName(tt.name)
+		assert.Equal(t, tt.ok, ok, tt.name)
+		assert.Equal(t, tt.repository, repository, tt.name)
+		assert.Equal(t, tt.tag, tag, tt.name)
+	}
+}
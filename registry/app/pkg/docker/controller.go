@@ -34,6 +34,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
 	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -51,11 +52,12 @@ type Controller struct {
 }
 
 type DBStore struct {
-	BlobRepo         store.BlobRepository
-	ImageDao         store.ImageRepository
-	ArtifactDao      store.ArtifactRepository
-	BandwidthStatDao store.BandwidthStatRepository
-	DownloadStatDao  store.DownloadStatRepository
+	BlobRepo             store.BlobRepository
+	ImageDao             store.ImageRepository
+	ArtifactDao          store.ArtifactRepository
+	BandwidthStatDao     store.BandwidthStatRepository
+	DownloadStatRecorder *downloadstats.Recorder
+	TagDao               store.TagRepository
 }
 
 type TagsAPIResponse struct {
@@ -93,14 +95,16 @@ func NewDBStore(
 	imageDao store.ImageRepository,
 	artifactDao store.ArtifactRepository,
 	bandwidthStatDao store.BandwidthStatRepository,
-	downloadStatDao store.DownloadStatRepository,
+	downloadStatRecorder *downloadstats.Recorder,
+	tagDao store.TagRepository,
 ) *DBStore {
 	return &DBStore{
-		BlobRepo:         blobRepo,
-		ImageDao:         imageDao,
-		ArtifactDao:      artifactDao,
-		BandwidthStatDao: bandwidthStatDao,
-		DownloadStatDao:  downloadStatDao,
+		BlobRepo:             blobRepo,
+		ImageDao:             imageDao,
+		ArtifactDao:          artifactDao,
+		BandwidthStatDao:     bandwidthStatDao,
+		DownloadStatRecorder: downloadStatRecorder,
+		TagDao:               tagDao,
 	}
 }
 
@@ -174,6 +178,7 @@ func (c *Controller) HeadManifest(
 	f := func(registry registrytypes.Registry, imageName string, a pkg.Artifact) Response {
 		art.SetRepoKey(registry.Name)
 		art.ParentID = registry.ParentID
+		art.StoragePrefix = registry.StoragePrefix
 		// Need to reassign original imageName to art because we are updating image name based on upstream proxy source inside
 		art.Image = imageName
 		headers, desc, man, e := a.(Registry).ManifestExist(ctx, art, acceptHeaders, ifNoneMatchHeader)
@@ -203,6 +208,7 @@ func (c *Controller) PullManifest(
 	f := func(registry registrytypes.Registry, imageName string, a pkg.Artifact) Response {
 		art.SetRepoKey(registry.Name)
 		art.ParentID = registry.ParentID
+		art.StoragePrefix = registry.StoragePrefix
 		// Need to reassign original imageName to art because we are updating image name based on upstream proxy source inside
 		art.Image = imageName
 		headers, desc, man, e := a.(Registry).PullManifest(ctx, art, acceptHeaders, ifNoneMatchHeader)
@@ -275,6 +281,7 @@ func (c *Controller) GetBlob(ctx context.Context, info pkg.RegistryInfo) Respons
 	f := func(registry registrytypes.Registry, imageName string, a pkg.Artifact) Response {
 		info.SetRepoKey(registry.Name)
 		info.ParentID = registry.ParentID
+		info.StoragePrefix = registry.StoragePrefix
 		info.Image = imageName
 		headers, body, size, readCloser, redirectURL, errs := a.(Registry).GetBlob(ctx, info)
 		return &GetBlobResponse{errs, headers, body, size, readCloser, redirectURL}
@@ -406,6 +413,7 @@ func (c *Controller) CancelBlobUpload(
 		log.Ctx(ctx).Error().Stack().Err(err).Msgf("error encountered canceling upload: %v", err)
 		errors = append(errors, errcode.ErrCodeUnknown.WithDetail(err))
 	}
+	blobCtx.endUploadSession(ctx, blobCtx.UUID)
 
 	responseHeaders.Code = http.StatusNoContent
 	return responseHeaders, errors
@@ -464,7 +472,7 @@ func (c *Controller) GetReferrers(
 
 func ResumeBlobUpload(ctx *Context, stateToken string) []error {
 	var errs []error
-	state, err := hmacKey(ctx.App.Config.Registry.HTTP.Secret).unpackUploadState(stateToken)
+	state, err := ctx.App.uploadState(ctx, ctx.UUID, stateToken)
 	if err != nil {
 		log.Ctx(ctx).Info().Msgf("error resolving upload: %v", err)
 		errs = append(errs, errcode.ErrCodeBlobUploadInvalid.WithDetail(err))
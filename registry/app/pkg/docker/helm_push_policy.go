@@ -0,0 +1,106 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/manifest"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/utils/chartpolicy"
+	store2 "github.com/harness/gitness/store"
+)
+
+// warningHeader is the header the violations of a push policy that don't block the push are reported in,
+// which OCI clients show to the user.
+const warningHeader = "Warning"
+
+// checkHelmPushPolicy lints the chart of a Helm manifest against the push policy of the registry. It returns
+// the violations as warnings, or an error with the violation report if the policy is enforced and the chart
+// breaks it.
+func (r *LocalRegistry) checkHelmPushPolicy(
+	ctx context.Context,
+	artInfo pkg.RegistryInfo,
+	m manifest.Manifest,
+) ([]string, error) {
+	if artInfo.PackageType != artifact.PackageTypeHELM {
+		return nil, nil
+	}
+	m2, ok := m.(manifest.ManifestV2)
+	if !ok || m2.Config().MediaType != chartpolicy.MediaTypeConfig {
+		return nil, nil
+	}
+	registry, err := r.registryDao.GetByParentIDAndName(ctx, artInfo.ParentID, artInfo.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	policy, err := r.helmPushPolicyDao.Get(ctx, registry.ID)
+	if errors.Is(err, store2.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	var layer *manifest.Descriptor
+	for _, l := range m2.Layers() {
+		if l.MediaType == chartpolicy.MediaTypeChart {
+			layer = &l
+			break
+		}
+	}
+	if layer == nil {
+		return nil, nil
+	}
+	if layer.Size > chartpolicy.MaxChartSize {
+		return []string{fmt.Sprintf("chart is larger than %d bytes and wasn't checked against the push policy",
+			chartpolicy.MaxChartSize)}, nil
+	}
+	content, err := r.App.GetBlobsContext(ctx, artInfo).OciBlobStore.Get(ctx, artInfo.StorageRoot(), layer.Digest)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(fmt.Errorf("failed to read chart: %w", err))
+	}
+
+	report := chartpolicy.Lint(content, policy)
+	messages := make([]string, 0, len(report.Violations))
+	for _, v := range report.Violations {
+		message := v.Message
+		if v.File != "" {
+			message = v.File + ": " + message
+		}
+		messages = append(messages, message)
+	}
+	if policy.Enforce && report.HasErrors() {
+		return nil, errcode.ErrCodeDenied.
+			WithMessage("chart violates the push policy of the registry: " + strings.Join(messages, "; ")).
+			WithDetail(report)
+	}
+	return messages, nil
+}
+
+// warningHeaderValue formats the warnings as the value of a Warning header.
+func warningHeaderValue(warnings []string) string {
+	values := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		values = append(values, "299 - "+strconv.Quote(w))
+	}
+	return strings.Join(values, ", ")
+}
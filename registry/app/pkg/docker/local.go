@@ -65,6 +65,9 @@ const (
 	imageClass  = "image"
 )
 
+// dbConfigSizeLimit is the size up to which the config of a manifest is stored with it in the database.
+const dbConfigSizeLimit = 256 << 10
+
 type storageType int
 
 const (
@@ -103,6 +106,9 @@ type S3Store interface {
 
 var errInvalidSecret = fmt.Errorf("invalid secret")
 
+// errBlobNotMountable is returned when a blob can't be mounted because the registries use different storage locations.
+var errBlobNotMountable = errors.New("blob is stored in a different storage location")
+
 type hmacKey string
 
 func NewLocalRegistry(
@@ -111,41 +117,46 @@ func NewLocalRegistry(
 	blobRepo store.BlobRepository, mtRepository store.MediaTypesRepository,
 	tagDao store.TagRepository, imageDao store.ImageRepository, artifactDao store.ArtifactRepository,
 	bandwidthStatDao store.BandwidthStatRepository, downloadStatDao store.DownloadStatRepository,
-	gcService gc.Service, tx dbtx.Transactor,
+	gcService gc.Service, tx dbtx.Transactor, legalHoldDao store.LegalHoldRepository,
+	helmPushPolicyDao store.HelmPushPolicyRepository,
 ) Registry {
 	return &LocalRegistry{
-		App:              app,
-		ms:               ms,
-		registryDao:      registryDao,
-		manifestDao:      manifestDao,
-		registryBlobDao:  registryBlobDao,
-		blobRepo:         blobRepo,
-		mtRepository:     mtRepository,
-		tagDao:           tagDao,
-		imageDao:         imageDao,
-		artifactDao:      artifactDao,
-		bandwidthStatDao: bandwidthStatDao,
-		downloadStatDao:  downloadStatDao,
-		gcService:        gcService,
-		tx:               tx,
+		App:               app,
+		ms:                ms,
+		registryDao:       registryDao,
+		manifestDao:       manifestDao,
+		registryBlobDao:   registryBlobDao,
+		blobRepo:          blobRepo,
+		mtRepository:      mtRepository,
+		tagDao:            tagDao,
+		imageDao:          imageDao,
+		artifactDao:       artifactDao,
+		bandwidthStatDao:  bandwidthStatDao,
+		downloadStatDao:   downloadStatDao,
+		gcService:         gcService,
+		tx:                tx,
+		legalHoldDao:      legalHoldDao,
+		helmPushPolicyDao: helmPushPolicyDao,
 	}
 }
 
 type LocalRegistry struct {
-	App              *App
-	ms               ManifestService
-	registryDao      store.RegistryRepository
-	manifestDao      store.ManifestRepository
-	registryBlobDao  store.RegistryBlobRepository
-	blobRepo         store.BlobRepository
-	mtRepository     store.MediaTypesRepository
-	tagDao           store.TagRepository
-	imageDao         store.ImageRepository
-	artifactDao      store.ArtifactRepository
-	bandwidthStatDao store.BandwidthStatRepository
-	downloadStatDao  store.DownloadStatRepository
-	gcService        gc.Service
-	tx               dbtx.Transactor
+	App               *App
+	ms                ManifestService
+	registryDao       store.RegistryRepository
+	manifestDao       store.ManifestRepository
+	registryBlobDao   store.RegistryBlobRepository
+	blobRepo          store.BlobRepository
+	mtRepository      store.MediaTypesRepository
+	tagDao            store.TagRepository
+	imageDao          store.ImageRepository
+	artifactDao       store.ArtifactRepository
+	bandwidthStatDao  store.BandwidthStatRepository
+	downloadStatDao   store.DownloadStatRepository
+	gcService         gc.Service
+	tx                dbtx.Transactor
+	legalHoldDao      store.LegalHoldRepository
+	helmPushPolicyDao store.HelmPushPolicyRepository
 }
 
 func (r *LocalRegistry) Base() error {
@@ -378,6 +389,18 @@ func (r *LocalRegistry) fetchBlobInternal(
 	var dgst digest.Digest
 	blobs := ctx.OciBlobStore
 
+	// Blobs are shared in the database across the registries of a root space, but a registry with a
+	// custom storage prefix only has the blobs that were pushed to its own storage location.
+	if info.StoragePrefix != "" {
+		if _, err := blobs.Stat(ctx, info.StorageRoot(), ctx.Digest); err != nil {
+			if errors.Is(err, storage.ErrBlobUnknown) {
+				err = errcode.ErrCodeBlobUnknown.WithDetail(ctx.Digest)
+			}
+			errs = append(errs, errcode.FromUnknownError(err))
+			return responseHeaders, nil, -1, nil, "", errs
+		}
+	}
+
 	if err := r.dbBlobLinkExists(ctx, ctx.Digest, info.RegIdentifier, info); err != nil {
 		errs = append(errs, errcode.FromUnknownError(err))
 		return responseHeaders, nil, -1, nil, "", errs
@@ -386,7 +409,7 @@ func (r *LocalRegistry) fetchBlobInternal(
 	headers := make(map[string]string)
 	fileReader, redirectURL, size, err := blobs.ServeBlobInternal(
 		ctx.Context,
-		info.RootIdentifier,
+		info.StorageRoot(),
 		dgst,
 		headers,
 		method,
@@ -641,6 +664,10 @@ func (r *LocalRegistry) appendPutError(err error, errList []error) []error {
 		errList = append(errList, errcode.ErrCodeDigestInvalid.WithDetail(err))
 		return errList
 	}
+	if errors.Is(err, errTagPreconditionFailed) {
+		errList = append(errList, errcode.ErrCodePreconditionFailed.WithDetail(err))
+		return errList
+	}
 
 	switch {
 	case errors.As(err, &manifest.VerificationErrors{}):
@@ -686,6 +713,26 @@ func (r *LocalRegistry) appendPutError(err error, errList []error) []error {
 	return errList
 }
 
+// getConfigPayload returns the config of a manifest to store with it, so that it can be listed without
+// reading the config blob. It returns nil for configs larger than dbConfigSizeLimit.
+func (r *LocalRegistry) getConfigPayload(
+	ctx context.Context,
+	artInfo pkg.RegistryInfo,
+	m manifest.Manifest,
+) types.Payload {
+	m2, ok := m.(manifest.ManifestV2)
+	if !ok || m2.Config().Size > dbConfigSizeLimit {
+		return nil
+	}
+	payload, err := r.App.GetBlobsContext(ctx, artInfo).OciBlobStore.Get(ctx, artInfo.StorageRoot(),
+		m2.Config().Digest)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to read config %s of manifest", m2.Config().Digest)
+		return nil
+	}
+	return payload
+}
+
 func (r *LocalRegistry) PutManifest(
 	ctx context.Context,
 	artInfo pkg.RegistryInfo,
@@ -740,11 +787,20 @@ func (r *LocalRegistry) PutManifest(
 		log.Ctx(ctx).Debug().Msg("Putting a Docker Manifest!")
 	}
 
+	warnings, err := r.checkHelmPushPolicy(ctx, artInfo, unmarshalManifest)
+	if err != nil {
+		errs = append(errs, err)
+		return responseHeaders, errs
+	}
+	if len(warnings) > 0 {
+		responseHeaders.Headers[warningHeader] = warningHeaderValue(warnings)
+	}
+
 	// We don't need to store manifest file in S3 storage
 	// manifestServicePut(ctx, _manifest, options...)
 
 	if err = r.ms.DBPut(
-		ctx, unmarshalManifest, d, artInfo.RegIdentifier,
+		ctx, unmarshalManifest, r.getConfigPayload(ctx, artInfo, unmarshalManifest), d, artInfo.RegIdentifier,
 		responseHeaders, artInfo,
 	); err != nil {
 		errs = r.appendPutError(err, errs)
@@ -878,6 +934,10 @@ func (r *LocalRegistry) InitBlobUpload(
 	digest := digest.Digest(mountDigest)
 	if mountDigest != "" && fromRepo != "" {
 		err := r.dbMountBlob(blobCtx, fromRepo, artInfo.RegIdentifier, digest, artInfo)
+		if errors.Is(err, errBlobNotMountable) {
+			// fall back to a regular upload, as described by the distribution spec
+			return r.InitBlobUpload(ctx2, artInfo, "", "")
+		}
 		if err != nil {
 			e := fmt.Errorf("failed to mount blob in database: %w", err)
 			errList = append(errList, errcode.FromUnknownError(e))
@@ -1055,10 +1115,12 @@ func (r *LocalRegistry) PushBlob(
 	}
 
 	desc, err := ctx.Upload.Commit(
-		ctx, artInfo.RootIdentifier, manifest.Descriptor{
+		ctx, artInfo.StorageRoot(), manifest.Descriptor{
 			Digest: dgst,
 		},
 	)
+	// the upload can't be resumed after the commit, it was either moved to the blob or cancelled
+	ctx.endUploadSession(ctx, ctx.UUID)
 
 	if err != nil {
 		switch {
@@ -1190,6 +1252,15 @@ func (r *LocalRegistry) DeleteManifest(
 
 	responseHeaders = &commons.ResponseHeaders{}
 
+	if err := r.checkLegalHold(ctx, artInfo); err != nil {
+		if errors.Is(err, pkg.ErrLegalHold) {
+			errs = append(errs, errcode.ErrCodeDenied.WithMessage(err.Error()))
+		} else {
+			errs = append(errs, errcode.ErrCodeUnknown.WithDetail(err))
+		}
+		return errs, responseHeaders
+	}
+
 	// TODO: If Tag is not empty, we just untag the tag, nothing more!
 	if tag != "" {
 		log.Debug().Msg("DeleteImageTag")
@@ -1228,6 +1299,24 @@ func (r *LocalRegistry) DeleteManifest(
 	return errs, responseHeaders
 }
 
+// checkLegalHold returns an error wrapping pkg.ErrLegalHold if a legal hold blocks deleting the tag or
+// manifest.
+func (r *LocalRegistry) checkLegalHold(ctx context.Context, artInfo pkg.RegistryInfo) error {
+	registry, err := r.registryDao.GetByParentIDAndName(ctx, artInfo.ParentID, artInfo.RegIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to get registry %s: %w", artInfo.RegIdentifier, err)
+	}
+	reference := artInfo.Tag
+	if reference == "" {
+		reference = artInfo.Digest
+	}
+	versions, err := pkg.ManifestVersions(ctx, r.manifestDao, r.tagDao, registry.ID, artInfo.Image, reference)
+	if err != nil {
+		return err
+	}
+	return pkg.CheckLegalHold(ctx, r.legalHoldDao, registry.ID, artInfo.Image, versions...)
+}
+
 func (r *LocalRegistry) DeleteBlob(
 	ctx *Context,
 	artInfo pkg.RegistryInfo,
@@ -1449,6 +1538,11 @@ func blobUploadResponse(
 	context.Upload.Close()
 	context.State.Offset = context.Upload.Size()
 
+	if err := context.saveUploadSession(context, context.State); err != nil {
+		log.Ctx(context).Error().Err(err).Msg("error saving upload session")
+		return err
+	}
+
 	token, err := hmacKey(
 		context.Config.Registry.HTTP.Secret,
 	).packUploadState(
@@ -1747,6 +1841,10 @@ func (r *LocalRegistry) dbMountBlob(
 		)
 	}
 
+	if sourceRepo.StoragePrefix != destRepo.StoragePrefix {
+		return errBlobNotMountable
+	}
+
 	b, err := r.ms.DBFindRepositoryBlob(
 		ctx, manifest.Descriptor{Digest: d},
 		sourceRepo.ID, fromImageName,
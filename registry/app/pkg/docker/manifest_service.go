@@ -53,6 +53,9 @@ import (
 
 const ociPrefix = "oci://"
 
+// errTagPreconditionFailed is returned when a tag update with If-Match finds the tag pointing elsewhere.
+var errTagPreconditionFailed = errors.New("tag does not point to the expected digest")
+
 type manifestService struct {
 	registryDao             store.RegistryRepository
 	manifestDao             store.ManifestRepository
@@ -70,6 +73,7 @@ type manifestService struct {
 	reporter                event.Reporter
 	artifactEventReporter   registryevents.Reporter
 	urlProvider             urlprovider.Provider
+	baseImageDao            store.BaseImageRepository
 }
 
 func NewManifestService(
@@ -79,7 +83,7 @@ func NewManifestService(
 	layerDao store.LayerRepository, manifestRefDao store.ManifestReferenceRepository,
 	tx dbtx.Transactor, gcService gc.Service, reporter event.Reporter, spaceFinder refcache.SpaceFinder,
 	ociImageIndexMappingDao store.OCIImageIndexMappingRepository, artifactEventReporter registryevents.Reporter,
-	urlProvider urlprovider.Provider,
+	urlProvider urlprovider.Provider, baseImageDao store.BaseImageRepository,
 ) ManifestService {
 	return &manifestService{
 		registryDao:             registryDao,
@@ -98,6 +102,7 @@ func NewManifestService(
 		ociImageIndexMappingDao: ociImageIndexMappingDao,
 		artifactEventReporter:   artifactEventReporter,
 		urlProvider:             urlProvider,
+		baseImageDao:            baseImageDao,
 	}
 }
 
@@ -115,6 +120,7 @@ type ManifestService interface {
 	DBPut(
 		ctx context.Context,
 		mfst manifest.Manifest,
+		configPayload types.Payload,
 		d digest.Digest,
 		repoKey string,
 		headers *commons.ResponseHeaders,
@@ -171,7 +177,7 @@ func (l *manifestService) handleTagError(
 		// This should be extremely rare, if it ever occurs, but if it does, we should recreate the manifest
 		// and tag it, instead of returning a "manifest not found response" to clients. It's expected that
 		// this route handles the creation of a manifest if it doesn't exist already.
-		if err = l.DBPut(ctx, mfst, "", repoKey, headers, info); err != nil {
+		if err = l.DBPut(ctx, mfst, nil, "", repoKey, headers, info); err != nil {
 			return fmt.Errorf("failed to recreate manifest in database: %w", err)
 		}
 		if err = l.dbTagManifest(ctx, d, tag, imageName, info); err != nil {
@@ -220,6 +226,10 @@ func (l *manifestService) dbTagManifest(
 			return formatFailedToTagErr(err)
 		}
 
+		if info.IfMatch != "" {
+			return l.updateTagIfMatch(ctx, dbRegistry.ID, dbManifest.ID, imageName, tagName, info.IfMatch)
+		}
+
 		// Create or update artifact and tag records
 		if err := l.upsertTag(ctx, dbRegistry.ID, dbManifest.ID, imageName, tagName); err != nil {
 			return formatFailedToTagErr(err)
@@ -249,6 +259,7 @@ func (l *manifestService) dbTagManifest(
 			updatePayload := l.getArtifactUpdatedPayload(ctx, info, session.Principal.ID,
 				reg.ID, reg.Name, tagName, existingDigest.String(), dgst.String())
 			l.artifactEventReporter.ArtifactUpdated(ctx, &updatePayload)
+			l.reportBaseImageUpdated(ctx, info, session.Principal.ID, reg.Name, tagName, dgst.String())
 		}
 	} else {
 		log.Ctx(ctx).Err(err).Msg("Failed to find spacePath, not publishing event")
@@ -429,6 +440,52 @@ func (l *manifestService) upsertTag(
 	return l.tagDao.CreateOrUpdate(ctx, tag)
 }
 
+// updateTagIfMatch re-points an existing tag to the manifest only if the tag still points to one of the
+// digests listed in ifMatch, or to any manifest for "*", failing with errTagPreconditionFailed otherwise.
+func (l *manifestService) updateTagIfMatch(
+	ctx context.Context,
+	registryID,
+	manifestID int64,
+	imageName,
+	tagName string,
+	ifMatch string,
+) error {
+	var expectedIDs []int64
+	anyManifest := false
+	for _, etag := range commons.ParseEntityTags(ifMatch) {
+		if etag == "*" {
+			anyManifest = true
+			break
+		}
+		d, err := types.NewDigest(digest.Digest(etag))
+		if err != nil {
+			continue
+		}
+		m, err := l.manifestDao.FindManifestByDigest(ctx, registryID, imageName, d)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return formatFailedToTagErr(err)
+		}
+		expectedIDs = append(expectedIDs, m.ID)
+	}
+	if anyManifest {
+		expectedIDs = nil
+	} else if len(expectedIDs) == 0 {
+		return fmt.Errorf("%w: tag %s is not at %s", errTagPreconditionFailed, tagName, ifMatch)
+	}
+
+	updated, err := l.tagDao.UpdateManifestIfMatch(ctx, registryID, imageName, tagName, manifestID, expectedIDs)
+	if err != nil {
+		return formatFailedToTagErr(err)
+	}
+	if !updated {
+		return fmt.Errorf("%w: tag %s is not at %s", errTagPreconditionFailed, tagName, ifMatch)
+	}
+	return nil
+}
+
 // Retrieves the spacePath and packageType.
 func (l *manifestService) getSpacePathAndPackageType(
 	ctx context.Context,
@@ -470,6 +527,7 @@ func (l *manifestService) reportEventAsync(
 func (l *manifestService) DBPut(
 	ctx context.Context,
 	mfst manifest.Manifest,
+	configPayload types.Payload,
 	d digest.Digest,
 	repoKey string,
 	headers *commons.ResponseHeaders,
@@ -480,7 +538,7 @@ func (l *manifestService) DBPut(
 		return err
 	}
 
-	err = l.dbPutManifest(ctx, mfst, payload, d, repoKey, headers, info)
+	err = l.dbPutManifest(ctx, mfst, payload, configPayload, d, repoKey, headers, info)
 	var mtErr util.UnknownMediaTypeError
 	if errors.As(err, &mtErr) {
 		return errcode.ErrorCodeManifestInvalid.WithDetail(mtErr.Error())
@@ -492,6 +550,7 @@ func (l *manifestService) dbPutManifest(
 	ctx context.Context,
 	manifest manifest.Manifest,
 	payload []byte,
+	configPayload types.Payload,
 	d digest.Digest,
 	repoKey string,
 	headers *commons.ResponseHeaders,
@@ -500,13 +559,13 @@ func (l *manifestService) dbPutManifest(
 	switch reqManifest := manifest.(type) {
 	case *schema2.DeserializedManifest:
 		log.Ctx(ctx).Debug().Msgf("Putting schema2 manifest %s to database", d.String())
-		if err := l.dbPutManifestSchema2(ctx, reqManifest, payload, d, repoKey, headers, info); err != nil {
+		if err := l.dbPutManifestSchema2(ctx, reqManifest, payload, configPayload, d, repoKey, headers, info); err != nil {
 			return err
 		}
 		return l.upsertImageAndArtifact(ctx, d, repoKey, info)
 	case *ocischema.DeserializedManifest:
 		log.Ctx(ctx).Debug().Msgf("Putting ocischema manifest %s to database", d.String())
-		if err := l.dbPutManifestOCI(ctx, reqManifest, payload, d, repoKey, headers, info); err != nil {
+		if err := l.dbPutManifestOCI(ctx, reqManifest, payload, configPayload, d, repoKey, headers, info); err != nil {
 			return err
 		}
 		return l.upsertImageAndArtifact(ctx, d, repoKey, info)
@@ -590,18 +649,20 @@ func (l *manifestService) dbPutManifestSchema2(
 	ctx context.Context,
 	manifest *schema2.DeserializedManifest,
 	payload []byte,
+	configPayload types.Payload,
 	d digest.Digest,
 	repoKey string,
 	headers *commons.ResponseHeaders,
 	info pkg.RegistryInfo,
 ) error {
-	return l.dbPutManifestV2(ctx, manifest, payload, false, d, repoKey, headers, info)
+	return l.dbPutManifestV2(ctx, manifest, payload, configPayload, false, d, repoKey, headers, info)
 }
 
 func (l *manifestService) dbPutManifestV2(
 	ctx context.Context,
 	mfst manifest.ManifestV2,
 	payload []byte,
+	configPayload types.Payload,
 	nonConformant bool,
 	digest digest.Digest,
 	repoKey string,
@@ -643,23 +704,9 @@ func (l *manifestService) dbPutManifestV2(
 		MediaType: mfst.Config().MediaType,
 		Digest:    dbCfgBlob.Digest,
 		BlobID:    dbCfgBlob.ID,
+		Payload:   configPayload,
 	}
 
-	//TODO: check if we need to store the config payload in the database
-
-	// skip retrieval and caching of config payload if its size is over the limit
-	/*if dbCfgBlob.Size <= datastore.ConfigSizeLimit {
-		// Since filesystem writes may be optional, We cannot be sure that the
-		// repository scoped filesystem blob service will have a link to the
-		// configuration blob; however, since we check for repository scoped access
-		// via the database above, we may retrieve the blob directly common storage.
-		cfgPayload, err := imh.blobProvider.Get(imh, dbCfgBlob.Digest)
-		if err != nil {
-			return err
-		}
-		cfg.Payload = cfgPayload
-	}*/
-
 	m := &types.Manifest{
 		RegistryID:    dbRepo.ID,
 		TotalSize:     mfst.TotalSize(),
@@ -703,6 +750,7 @@ func (l *manifestService) dbPutManifestV2(
 	}
 
 	dbManifest = m
+	l.recordBaseImage(ctx, dbManifest)
 
 	// find and associate distributable manifest layer blobs
 	for _, reqLayer := range mfst.DistributableLayers() {
@@ -833,12 +881,13 @@ func (l *manifestService) dbPutManifestOCI(
 	ctx context.Context,
 	manifest *ocischema.DeserializedManifest,
 	payload []byte,
+	configPayload types.Payload,
 	d digest.Digest,
 	repoKey string,
 	headers *commons.ResponseHeaders,
 	info pkg.RegistryInfo,
 ) error {
-	return l.dbPutManifestV2(ctx, manifest, payload, false, d, repoKey, headers, info)
+	return l.dbPutManifestV2(ctx, manifest, payload, configPayload, false, d, repoKey, headers, info)
 }
 
 func (l *manifestService) dbPutManifestList(
@@ -1119,6 +1168,7 @@ func (l *manifestService) dbPutImageIndex(
 			if err := l.manifestDao.CreateOrFind(ctx, mi); err != nil {
 				return err
 			}
+			l.recordBaseImage(ctx, mi)
 
 			// Associate manifests to the manifest list.
 			for _, m := range mm {
@@ -1175,7 +1225,7 @@ func (l *manifestService) dbPutBuildkitIndex(
 	// Therefore, we keep behavioral consistency for
 	// the outside world by preserving the index payload and digest while
 	//  storing things internally as an OCI manifest.
-	return l.dbPutManifestV2(ctx, m, payload, true, digest, repoKey, headers, info)
+	return l.dbPutManifestV2(ctx, m, payload, nil, true, digest, repoKey, headers, info)
 }
 
 func (l *manifestService) dbFindManifestListManifest(
@@ -0,0 +1,106 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDigestManifestRepository struct {
+	store.ManifestRepository
+	ids map[types.Digest]int64
+}
+
+func (f *fakeDigestManifestRepository) FindManifestByDigest(
+	_ context.Context, _ int64, _ string, d types.Digest,
+) (*types.Manifest, error) {
+	id, ok := f.ids[d]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return &types.Manifest{ID: id}, nil
+}
+
+type fakeIfMatchTagRepository struct {
+	store.TagRepository
+	manifestID int64
+}
+
+func (f *fakeIfMatchTagRepository) UpdateManifestIfMatch(
+	_ context.Context, _ int64, _ string, _ string, manifestID int64, expectedManifestIDs []int64,
+) (bool, error) {
+	if len(expectedManifestIDs) > 0 && !slices.Contains(expectedManifestIDs, f.manifestID) {
+		return false, nil
+	}
+	f.manifestID = manifestID
+	return true, nil
+}
+
+func newIfMatchManifestService(t *testing.T, digests ...digest.Digest) (*manifestService, *fakeIfMatchTagRepository) {
+	t.Helper()
+	ids := make(map[types.Digest]int64, len(digests))
+	for i, dgst := range digests {
+		d, err := types.NewDigest(dgst)
+		require.NoError(t, err)
+		ids[d] = int64(i + 1)
+	}
+	tags := &fakeIfMatchTagRepository{manifestID: 1}
+	return &manifestService{manifestDao: &fakeDigestManifestRepository{ids: ids}, tagDao: tags}, tags
+}
+
+func TestUpdateTagIfMatch(t *testing.T) {
+	ctx := context.Background()
+	v1 := digest.FromString("v1")
+	v2 := digest.FromString("v2")
+	v3 := digest.FromString("v3")
+
+	t.Run("matching digest", func(t *testing.T) {
+		l, tags := newIfMatchManifestService(t, v1, v2, v3)
+		require.NoError(t, l.updateTagIfMatch(ctx, 1, 3, "app", "latest", `"`+v2.String()+`", "`+v1.String()+`"`))
+		assert.EqualValues(t, 3, tags.manifestID)
+	})
+
+	t.Run("stale digest", func(t *testing.T) {
+		l, tags := newIfMatchManifestService(t, v1, v2, v3)
+		require.NoError(t, l.updateTagIfMatch(ctx, 1, 2, "app", "latest", v1.String()))
+
+		err := l.updateTagIfMatch(ctx, 1, 3, "app", "latest", v1.String())
+		require.ErrorIs(t, err, errTagPreconditionFailed)
+		assert.EqualValues(t, 2, tags.manifestID, "the concurrent update is kept")
+	})
+
+	t.Run("unknown digest", func(t *testing.T) {
+		l, tags := newIfMatchManifestService(t, v1, v2)
+		err := l.updateTagIfMatch(ctx, 1, 2, "app", "latest", v3.String()+", not-a-digest")
+		require.ErrorIs(t, err, errTagPreconditionFailed)
+		assert.EqualValues(t, 1, tags.manifestID)
+	})
+
+	t.Run("any digest", func(t *testing.T) {
+		l, tags := newIfMatchManifestService(t, v1, v2)
+		require.NoError(t, l.updateTagIfMatch(ctx, 1, 2, "app", "latest", "*"))
+		assert.EqualValues(t, 2, tags.manifestID)
+	})
+}
@@ -53,6 +53,8 @@ const (
 func NewRemoteRegistry(
 	local *LocalRegistry, app *App, upstreamProxyConfigRepo store.UpstreamProxyConfigRepository,
 	spaceFinder refcache.SpaceFinder, secretService secret.Service, proxyCtl proxy2.Controller,
+	packageRuleStore store.PackageRuleRepository,
+	upstreamURLStore store.UpstreamURLRepository,
 ) Registry {
 	cache := proxy2.GetManifestCache(local, local.ms)
 	listCache := proxy2.GetManifestListCache(local)
@@ -72,6 +74,8 @@ func NewRemoteRegistry(
 		secretService:           secretService,
 		manifestCacheHandlerMap: registry,
 		proxyCtl:                proxyCtl,
+		packageRuleStore:        packageRuleStore,
+		upstreamURLStore:        upstreamURLStore,
 	}
 }
 
@@ -87,6 +91,8 @@ type RemoteRegistry struct {
 	secretService           secret.Service
 	proxyCtl                proxy2.Controller
 	manifestCacheHandlerMap map[string]proxy2.ManifestCacheHandler
+	packageRuleStore        store.PackageRuleRepository
+	upstreamURLStore        store.UpstreamURLRepository
 }
 
 func (r *RemoteRegistry) Base() error {
@@ -177,6 +183,7 @@ func (r *RemoteRegistry) ManifestExist(
 		errs = append(errs, err)
 		return responseHeaders, descriptor, manifestResult, errs
 	}
+	proxy2.FailOver(ctx, r.upstreamURLStore, upstreamProxy)
 	remoteHelper, err := proxy2.NewRemoteHelper(ctx, r.spaceFinder, r.secretService, artInfo.RegIdentifier,
 		*upstreamProxy)
 	if err != nil {
@@ -189,8 +196,16 @@ func (r *RemoteRegistry) ManifestExist(
 		errs = append(errs, err)
 		return responseHeaders, descriptor, manifestResult, errs
 	}
+	err = proxy2.CheckPackageRules(ctx, r.packageRuleStore, *upstreamProxy,
+		proxy2.ImageCoordinate(artInfo.Image, registryInfo.Tag))
+	if err != nil {
+		errs = append(errs, err)
+		return responseHeaders, descriptor, manifestResult, errs
+	}
 
-	useLocal, man, err := r.proxyCtl.UseLocalManifest(ctx, registryInfo, remoteHelper, acceptHeaders, ifNoneMatchHeader)
+	useLocal, man, err := r.proxyCtl.UseLocalManifest(
+		ctx, registryInfo, remoteHelper, *upstreamProxy, acceptHeaders, ifNoneMatchHeader,
+	)
 
 	if err != nil {
 		errs = append(errs, err)
@@ -260,6 +275,7 @@ func (r *RemoteRegistry) PullManifest(
 		errs = append(errs, err)
 		return responseHeaders, descriptor, manifestResult, errs
 	}
+	proxy2.FailOver(ctx, r.upstreamURLStore, upstreamProxy)
 	remoteHelper, err := proxy2.NewRemoteHelper(ctx, r.spaceFinder, r.secretService, artInfo.RegIdentifier,
 		*upstreamProxy)
 	if err != nil {
@@ -272,8 +288,16 @@ func (r *RemoteRegistry) PullManifest(
 		errs = append(errs, err)
 		return responseHeaders, descriptor, manifestResult, errs
 	}
+	err = proxy2.CheckPackageRules(ctx, r.packageRuleStore, *upstreamProxy,
+		proxy2.ImageCoordinate(artInfo.Image, registryInfo.Tag))
+	if err != nil {
+		errs = append(errs, err)
+		return responseHeaders, descriptor, manifestResult, errs
+	}
 
-	useLocal, man, err := r.proxyCtl.UseLocalManifest(ctx, registryInfo, remoteHelper, acceptHeaders, ifNoneMatchHeader)
+	useLocal, man, err := r.proxyCtl.UseLocalManifest(
+		ctx, registryInfo, remoteHelper, *upstreamProxy, acceptHeaders, ifNoneMatchHeader,
+	)
 
 	if err != nil {
 		errs = append(errs, err)
@@ -381,6 +405,12 @@ func (r *RemoteRegistry) fetchBlobInternal(
 	upstreamProxy, err := r.upstreamProxyConfigRepo.GetByRegistryIdentifier(ctx, info.ParentID, repoKey)
 	if err != nil {
 		errs = append(errs, err)
+		return responseHeaders, fr, size, readCloser, redirectURL, errs
+	}
+	proxy2.FailOver(ctx, r.upstreamURLStore, upstreamProxy)
+	if upstreamProxy.Offline {
+		errs = append(errs, errors.New("Blob not found"))
+		return responseHeaders, fr, size, readCloser, redirectURL, errs
 	}
 
 	// This is start of proxy Code.
@@ -0,0 +1,67 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+// saveUploadSession stores the state of an upload in the database, so its next chunk can be sent
+// to any replica behind the load balancer.
+func (app *App) saveUploadSession(ctx context.Context, state BlobUploadState) error {
+	err := app.uploadSessions.Upsert(ctx, &types.UploadSession{
+		ID:     state.UUID,
+		Path:   state.Path,
+		Name:   state.Name,
+		Offset: state.Offset,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save upload session %s: %w", state.UUID, err)
+	}
+	return nil
+}
+
+// uploadState returns the state of an upload from its session. The state token is only used for the
+// uploads started before the sessions were stored, as it's signed with a secret that's random per
+// replica unless GITNESS_REGISTRY_HTTP_SECRET is set.
+func (app *App) uploadState(ctx context.Context, uuid string, stateToken string) (BlobUploadState, error) {
+	session, err := app.uploadSessions.Get(ctx, uuid)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return hmacKey(app.Config.Registry.HTTP.Secret).unpackUploadState(stateToken)
+	}
+	if err != nil {
+		return BlobUploadState{}, fmt.Errorf("failed to find upload session %s: %w", uuid, err)
+	}
+	return BlobUploadState{
+		Name:   session.Name,
+		Path:   session.Path,
+		UUID:   session.ID,
+		Offset: session.Offset,
+	}, nil
+}
+
+// endUploadSession removes the state of a completed or cancelled upload.
+func (app *App) endUploadSession(ctx context.Context, uuid string) {
+	if err := app.uploadSessions.Delete(ctx, uuid); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to remove upload session %s", uuid)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUploadSessions struct {
+	sessions map[string]types.UploadSession
+}
+
+func (f *fakeUploadSessions) Upsert(_ context.Context, session *types.UploadSession) error {
+	f.sessions[session.ID] = *session
+	return nil
+}
+
+func (f *fakeUploadSessions) Get(_ context.Context, id string) (*types.UploadSession, error) {
+	session, ok := f.sessions[id]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return &session, nil
+}
+
+func (f *fakeUploadSessions) Delete(_ context.Context, id string) error {
+	delete(f.sessions, id)
+	return nil
+}
+
+func (f *fakeUploadSessions) DeleteUpdatedBefore(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func newTestApp(secret string, sessions *fakeUploadSessions) *App {
+	cfg := &gitnesstypes.Config{}
+	cfg.Registry.HTTP.Secret = secret
+	return &App{
+		Context:        context.Background(),
+		Config:         cfg,
+		uploadSessions: sessions,
+	}
+}
+
+func TestUploadStateFromSession(t *testing.T) {
+	ctx := context.Background()
+	sessions := &fakeUploadSessions{sessions: map[string]types.UploadSession{}}
+	state := BlobUploadState{Path: "/root/docker", UUID: "6d3e9c9e", Offset: 512}
+
+	// the upload is resumed on a replica with another secret than the one it started on
+	started := newTestApp("first", sessions)
+	require.NoError(t, started.saveUploadSession(ctx, state))
+	token, err := hmacKey("first").packUploadState(state)
+	require.NoError(t, err)
+
+	resumed := newTestApp("second", sessions)
+	resumedState, err := resumed.uploadState(ctx, state.UUID, token)
+	require.NoError(t, err)
+	assert.Equal(t, state, resumedState)
+
+	resumed.endUploadSession(ctx, state.UUID)
+	_, err = resumed.uploadState(ctx, state.UUID, token)
+	assert.Error(t, err, "the token isn't valid on the replica once the session is removed")
+}
+
+func TestUploadStateFromToken(t *testing.T) {
+	ctx := context.Background()
+	app := newTestApp("secret", &fakeUploadSessions{sessions: map[string]types.UploadSession{}})
+	state := BlobUploadState{Path: "/root/docker", UUID: "6d3e9c9e", Offset: 512}
+
+	token, err := hmacKey("secret").packUploadState(state)
+	require.NoError(t, err)
+
+	resumed, err := app.uploadState(ctx, state.UUID, token)
+	require.NoError(t, err, "uploads started before the sessions were stored resume from their token")
+	assert.Equal(t, state, resumed)
+}
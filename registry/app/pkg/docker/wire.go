@@ -29,6 +29,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/services/downloadstats"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
@@ -44,11 +45,13 @@ func LocalRegistryProvider(
 	mtRepository store.MediaTypesRepository,
 	tagDao store.TagRepository, imageDao store.ImageRepository, artifactDao store.ArtifactRepository,
 	bandwidthStatDao store.BandwidthStatRepository, downloadStatDao store.DownloadStatRepository,
-	gcService gc.Service, tx dbtx.Transactor,
+	gcService gc.Service, tx dbtx.Transactor, legalHoldDao store.LegalHoldRepository,
+	helmPushPolicyDao store.HelmPushPolicyRepository,
 ) *LocalRegistry {
 	registry, ok := NewLocalRegistry(
 		app, ms, manifestDao, registryDao, registryBlobDao, blobRepo,
 		mtRepository, tagDao, imageDao, artifactDao, bandwidthStatDao, downloadStatDao, gcService, tx,
+		legalHoldDao, helmPushPolicyDao,
 	).(*LocalRegistry)
 	if !ok {
 		return nil
@@ -65,20 +68,22 @@ func ManifestServiceProvider(
 	ociImageIndexMappingDao store.OCIImageIndexMappingRepository,
 	artifactEventReporter *registryevents.Reporter,
 	urlProvider url.Provider,
+	baseImageDao store.BaseImageRepository,
 ) ManifestService {
 	return NewManifestService(
 		registryDao, manifestDao, blobRepo, mtRepository, tagDao, imageDao,
 		artifactDao, layerDao, manifestRefDao, tx, gcService, reporter, spaceFinder,
-		ociImageIndexMappingDao, *artifactEventReporter, urlProvider,
+		ociImageIndexMappingDao, *artifactEventReporter, urlProvider, baseImageDao,
 	)
 }
 
 func RemoteRegistryProvider(
 	local *LocalRegistry, app *App, upstreamProxyConfigRepo store.UpstreamProxyConfigRepository,
 	spaceFinder refcache.SpaceFinder, secretService secret.Service, proxyCtrl proxy2.Controller,
+	packageRuleStore store.PackageRuleRepository, upstreamURLStore store.UpstreamURLRepository,
 ) *RemoteRegistry {
 	registry, ok := NewRemoteRegistry(local, app, upstreamProxyConfigRepo, spaceFinder, secretService,
-		proxyCtrl).(*RemoteRegistry)
+		proxyCtrl, packageRuleStore, upstreamURLStore).(*RemoteRegistry)
 	if !ok {
 		return nil
 	}
@@ -101,9 +106,10 @@ func DBStoreProvider(
 	imageDao store.ImageRepository,
 	artifactDao store.ArtifactRepository,
 	bandwidthStatDao store.BandwidthStatRepository,
-	downloadStatDao store.DownloadStatRepository,
+	downloadStatRecorder *downloadstats.Recorder,
+	tagDao store.TagRepository,
 ) *DBStore {
-	return NewDBStore(blobRepo, imageDao, artifactDao, bandwidthStatDao, downloadStatDao)
+	return NewDBStore(blobRepo, imageDao, artifactDao, bandwidthStatDao, downloadStatRecorder, tagDao)
 }
 
 func StorageServiceProvider(cfg *types.Config, driver storagedriver.StorageDriver) *storage.Service {
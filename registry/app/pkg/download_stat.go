@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/request"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RecordDownload records a download of the version of an image, by the client of the download stat stored
+// in the context by the StoreDownloadStat middleware. Failures are only logged, they don't fail the download.
+func RecordDownload(
+	ctx context.Context,
+	recorder *downloadstats.Recorder,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	registryID int64,
+	imageName string,
+	version string,
+) {
+	downloadStat, ok := request.DownloadStatFrom(ctx)
+	if !ok || recorder == nil {
+		return
+	}
+	if err := recordDownload(ctx, recorder, imageDao, artifactDao, registryID, imageName, version,
+		*downloadStat); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to record download of %s:%s", imageName, version)
+	}
+}
+
+func recordDownload(
+	ctx context.Context,
+	recorder *downloadstats.Recorder,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	registryID int64,
+	imageName string,
+	version string,
+	downloadStat types.DownloadStat,
+) error {
+	image, err := imageDao.GetByName(ctx, registryID, imageName)
+	if err != nil {
+		return fmt.Errorf("failed to get image: %w", err)
+	}
+	artifact, err := artifactDao.GetByName(ctx, image.ID, version)
+	if err != nil {
+		return fmt.Errorf("failed to get artifact: %w", err)
+	}
+	downloadStat.ArtifactID = artifact.ID
+	return recorder.Record(ctx, &downloadStat)
+}
@@ -16,6 +16,7 @@ package filemanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -26,6 +27,7 @@ import (
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/uuid"
@@ -94,36 +96,52 @@ func (f *FileManager) UploadFile(
 			"location with name : %s with error : %w", filename, err)
 	}
 	fileInfo.Filename = filename
+	return f.saveFile(ctx, blobContext, tmpPath, filePath, regID, rootParentID, rootIdentifier, fileInfo)
+}
 
-	// Moving the file to permanent path in file storage
+// saveFile moves an uploaded file from its temporary path to the blob of its content, and saves
+// the blob and the nodes of the path of the file.
+func (f *FileManager) saveFile(
+	ctx context.Context,
+	blobContext *Context,
+	tmpPath string,
+	filePath string,
+	regID int64,
+	rootParentID int64,
+	rootIdentifier string,
+	fileInfo pkg.FileInfo,
+) (pkg.FileInfo, error) {
+	filename := fileInfo.Filename
 	fileStoragePath := path.Join(rootPathString, rootIdentifier, files, fileInfo.Sha256)
-	err = blobContext.genericBlobStore.Move(ctx, tmpPath, fileStoragePath)
-
+	blobID, err := f.findBlob(ctx, blobContext, fileStoragePath, rootParentID, fileInfo.Sha256)
 	if err != nil {
-		log.Error().Msgf("failed to Move the file on permanent location "+
-			"with name : %s with error : %s", filename, err.Error())
-		return pkg.FileInfo{}, fmt.Errorf("failed to Move the file on permanent"+
-			" location with name : %s with error : %w", filename, err)
+		return pkg.FileInfo{}, err
 	}
+	fileInfo.Deduplicated = blobID != ""
 
-	// Saving in the generic blobs table
-	var blobID = ""
-	gb := &types.GenericBlob{
-		RootParentID: rootParentID,
-		Sha1:         fileInfo.Sha1,
-		Sha256:       fileInfo.Sha256,
-		Sha512:       fileInfo.Sha512,
-		MD5:          fileInfo.MD5,
-		Size:         fileInfo.Size,
+	if fileInfo.Deduplicated {
+		if err := blobContext.genericBlobStore.Delete(ctx, tmpPath); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to delete the uploaded copy of blob with sha256 : %s",
+				fileInfo.Sha256)
+		}
+	} else {
+		// Moving the file to permanent path in file storage
+		err = blobContext.genericBlobStore.Move(ctx, tmpPath, fileStoragePath)
+
+		if err != nil {
+			log.Error().Msgf("failed to Move the file on permanent location "+
+				"with name : %s with error : %s", filename, err.Error())
+			return pkg.FileInfo{}, fmt.Errorf("failed to Move the file on permanent"+
+				" location with name : %s with error : %w", filename, err)
+		}
 	}
-	err = f.genericBlobDao.Create(ctx, gb)
-	if err != nil {
-		log.Error().Msgf("failed to save generic blob in db with "+
-			"sha256 : %s, err: %s", fileInfo.Sha256, err.Error())
-		return pkg.FileInfo{}, fmt.Errorf("failed to save generic blob"+
-			" in db with sha256 : %s, err: %w", fileInfo.Sha256, err)
+
+	if blobID == "" {
+		blobID, err = f.createBlob(ctx, rootParentID, fileInfo)
+		if err != nil {
+			return pkg.FileInfo{}, err
+		}
 	}
-	blobID = gb.ID
 	// Saving the nodes
 	err = f.tx.WithTx(ctx, func(ctx context.Context) error {
 		err = f.createNodes(ctx, filePath, blobID, regID)
@@ -141,6 +159,56 @@ func (f *FileManager) UploadFile(
 	return fileInfo, nil
 }
 
+// findBlob returns the id of the stored blob with the content of an uploaded file, or an empty
+// id if the content isn't stored under the storage root of the registry yet. Registries of a
+// root space share blobs but may keep their files under different storage prefixes, so the
+// upload is only deduplicated when the content exists at the storage path of the registry.
+func (f *FileManager) findBlob(
+	ctx context.Context,
+	blobContext *Context,
+	fileStoragePath string,
+	rootParentID int64,
+	sha256 string,
+) (string, error) {
+	blob, err := f.genericBlobDao.FindBySha256AndRootParentID(ctx, sha256, rootParentID)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find generic blob with sha256 : %s, err: %w", sha256, err)
+	}
+	exists, err := blobContext.genericBlobStore.Exists(ctx, fileStoragePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to check the content of generic blob with sha256 : %s, err: %w", sha256, err)
+	}
+	if !exists {
+		return "", nil
+	}
+	return blob.ID, nil
+}
+
+// createBlob saves the generic blob of an uploaded file. The row of the blob is shared by the
+// registries of the root space, so a blob saved already with the same content is returned instead.
+func (f *FileManager) createBlob(ctx context.Context, rootParentID int64, fileInfo pkg.FileInfo) (string, error) {
+	// Saving in the generic blobs table
+	gb := &types.GenericBlob{
+		RootParentID: rootParentID,
+		Sha1:         fileInfo.Sha1,
+		Sha256:       fileInfo.Sha256,
+		Sha512:       fileInfo.Sha512,
+		MD5:          fileInfo.MD5,
+		Size:         fileInfo.Size,
+	}
+	err := f.genericBlobDao.Create(ctx, gb)
+	if err != nil {
+		log.Error().Msgf("failed to save generic blob in db with "+
+			"sha256 : %s, err: %s", fileInfo.Sha256, err.Error())
+		return "", fmt.Errorf("failed to save generic blob"+
+			" in db with sha256 : %s, err: %w", fileInfo.Sha256, err)
+	}
+	return gb.ID, nil
+}
+
 func (f *FileManager) createNodes(ctx context.Context, filePath string, blobID string, regID int64) error {
 	segments := strings.Split(filePath, rootPathString)
 	parentID := ""
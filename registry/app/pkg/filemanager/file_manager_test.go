@@ -0,0 +1,161 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...interface{}) error {
+	return txFn(ctx)
+}
+
+type fakeGenericBlobRepository struct {
+	store.GenericBlobRepository
+	blobs map[string]*types.GenericBlob
+}
+
+func (f *fakeGenericBlobRepository) FindBySha256AndRootParentID(
+	_ context.Context, sha256 string, rootParentID int64,
+) (*types.GenericBlob, error) {
+	blob, ok := f.blobs[fmt.Sprintf("%d/%s", rootParentID, sha256)]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return blob, nil
+}
+
+func (f *fakeGenericBlobRepository) FindByID(_ context.Context, id string) (*types.GenericBlob, error) {
+	for _, blob := range f.blobs {
+		if blob.ID == id {
+			return blob, nil
+		}
+	}
+	return nil, gitnessstore.ErrResourceNotFound
+}
+
+func (f *fakeGenericBlobRepository) Create(_ context.Context, gb *types.GenericBlob) error {
+	key := fmt.Sprintf("%d/%s", gb.RootParentID, gb.Sha256)
+	if blob, ok := f.blobs[key]; ok {
+		gb.ID = blob.ID
+		return nil
+	}
+	gb.ID = fmt.Sprintf("blob-%d", len(f.blobs)+1)
+	f.blobs[key] = gb
+	return nil
+}
+
+type fakeNodesRepository struct {
+	store.NodesRepository
+	files map[string]string
+}
+
+func (f *fakeNodesRepository) Create(_ context.Context, node *types.Node) error {
+	node.ID = node.NodePath
+	if node.IsFile {
+		f.files[fmt.Sprintf("%d%s", node.RegistryID, node.NodePath)] = node.BlobID
+	}
+	return nil
+}
+
+func (f *fakeNodesRepository) GetByPathAndRegistryID(
+	_ context.Context, registryID int64, path string,
+) (*types.Node, error) {
+	blobID, ok := f.files[fmt.Sprintf("%d%s", registryID, path)]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return &types.Node{RegistryID: registryID, NodePath: path, IsFile: true, BlobID: blobID}, nil
+}
+
+func newTestFileManager(t *testing.T) (FileManager, driver.StorageDriver, *fakeGenericBlobRepository,
+	*fakeNodesRepository) {
+	t.Helper()
+	d := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+	storageService, err := storage.NewStorageService(d)
+	require.NoError(t, err)
+	blobs := &fakeGenericBlobRepository{blobs: map[string]*types.GenericBlob{}}
+	nodes := &fakeNodesRepository{files: map[string]string{}}
+	app := &App{Context: context.Background(), Config: &gitnesstypes.Config{}, storageService: storageService}
+	return NewFileManager(app, nil, blobs, nodes, fakeTransactor{}), d, blobs, nodes
+}
+
+func TestUploadFileDeduplicatesWithinStorageRoot(t *testing.T) {
+	ctx := context.Background()
+	fm, d, blobs, nodes := newTestFileManager(t)
+
+	first, err := fm.UploadFile(ctx, "/a/1.0/a.bin", "reg-a", 1, 10, "acme", nil,
+		strings.NewReader("content"), "a.bin")
+	require.NoError(t, err)
+	assert.False(t, first.Deduplicated)
+
+	second, err := fm.UploadFile(ctx, "/b/1.0/b.bin", "reg-b", 2, 10, "acme", nil,
+		strings.NewReader("content"), "b.bin")
+	require.NoError(t, err)
+	assert.True(t, second.Deduplicated)
+	assert.Equal(t, first.Sha256, second.Sha256)
+	assert.EqualValues(t, 7, second.Size)
+
+	assert.Len(t, blobs.blobs, 1)
+	assert.Equal(t, nodes.files["1/a/1.0/a.bin"], nodes.files["2/b/1.0/b.bin"])
+	tmpFiles, err := d.List(ctx, "/acme/tmp")
+	require.NoError(t, err)
+	assert.Empty(t, tmpFiles, "the uploaded copy of deduplicated content is deleted")
+}
+
+func TestUploadFileStoresContentUnderEachStorageRoot(t *testing.T) {
+	ctx := context.Background()
+	fm, d, blobs, nodes := newTestFileManager(t)
+
+	first, err := fm.UploadFile(ctx, "/a/1.0/a.bin", "reg-a", 1, 10, "acme", nil,
+		strings.NewReader("content"), "a.bin")
+	require.NoError(t, err)
+
+	// a registry with its own storage prefix gets its own copy of the content, sharing the blob
+	second, err := fm.UploadFile(ctx, "/b/1.0/b.bin", "reg-b", 2, 10, "teams/payments", nil,
+		strings.NewReader("content"), "b.bin")
+	require.NoError(t, err)
+	assert.False(t, second.Deduplicated)
+
+	assert.Len(t, blobs.blobs, 1)
+	assert.Equal(t, nodes.files["1/a/1.0/a.bin"], nodes.files["2/b/1.0/b.bin"])
+	for _, root := range []string{"/acme", "/teams/payments"} {
+		content, err := d.GetContent(ctx, root+"/files/"+first.Sha256)
+		require.NoError(t, err)
+		assert.Equal(t, "content", string(content))
+	}
+
+	regInfo := types.Registry{ID: 2, Name: "reg-b"}
+	reader, size, _, err := fm.DownloadFile(ctx, "/b/1.0/b.bin", regInfo, "teams/payments")
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.EqualValues(t, 7, size)
+}
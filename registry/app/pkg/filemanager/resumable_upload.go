@@ -0,0 +1,209 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filemanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	uploads   = "uploads"
+	startedAt = "startedat"
+	data      = "data"
+)
+
+var (
+	// ErrUploadNotFound is returned for uploads that weren't started, or were completed or cancelled.
+	ErrUploadNotFound = errors.New("upload not found")
+	// ErrUploadOffset is returned for chunks that don't start where the content uploaded so far ends.
+	ErrUploadOffset = errors.New("chunk doesn't start at the offset of the upload")
+)
+
+// StartUpload starts a resumable upload of a file, which is uploaded in chunks with AppendUpload
+// until it's saved with CompleteUpload. It returns the ID of the upload.
+func (f *FileManager) StartUpload(ctx context.Context, regName string, rootIdentifier string) (string, error) {
+	blobContext := f.App.GetBlobsContext(ctx, regName, rootIdentifier)
+	uploadID := uuid.NewString()
+
+	marker, err := blobContext.genericBlobStore.Create(ctx, uploadPath(rootIdentifier, regName, uploadID, startedAt))
+	if err != nil {
+		return "", fmt.Errorf("failed to start the upload %s: %w", uploadID, err)
+	}
+	defer marker.Close()
+	_, err = blobContext.genericBlobStore.Write(ctx, marker, nil,
+		strings.NewReader(time.Now().UTC().Format(time.RFC3339)))
+	if err != nil {
+		return "", fmt.Errorf("failed to start the upload %s: %w", uploadID, err)
+	}
+
+	fw, err := blobContext.genericBlobStore.Create(ctx, uploadPath(rootIdentifier, regName, uploadID, data))
+	if err != nil {
+		return "", fmt.Errorf("failed to start the upload %s: %w", uploadID, err)
+	}
+	if err = fw.Close(); err != nil {
+		return "", fmt.Errorf("failed to start the upload %s: %w", uploadID, err)
+	}
+	return uploadID, nil
+}
+
+// UploadOffset returns the size of the content uploaded so far, where the next chunk starts.
+func (f *FileManager) UploadOffset(
+	ctx context.Context,
+	regName string,
+	rootIdentifier string,
+	uploadID string,
+) (int64, error) {
+	blobContext := f.App.GetBlobsContext(ctx, regName, rootIdentifier)
+	fw, err := f.resumeUpload(ctx, blobContext, regName, rootIdentifier, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	defer fw.Close()
+	return fw.Size(), nil
+}
+
+// AppendUpload appends a chunk starting at offset to an upload, returning the size of the content
+// uploaded so far. Chunks that don't start at the offset of the upload fail with ErrUploadOffset.
+func (f *FileManager) AppendUpload(
+	ctx context.Context,
+	regName string,
+	rootIdentifier string,
+	uploadID string,
+	offset int64,
+	chunk io.Reader,
+) (int64, error) {
+	blobContext := f.App.GetBlobsContext(ctx, regName, rootIdentifier)
+	fw, err := f.resumeUpload(ctx, blobContext, regName, rootIdentifier, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	defer fw.Close()
+
+	if fw.Size() != offset {
+		return fw.Size(), fmt.Errorf("%w: the upload is at offset %d", ErrUploadOffset, fw.Size())
+	}
+	if _, err = io.Copy(fw, chunk); err != nil {
+		log.Ctx(ctx).Error().Msgf("failed to append to the upload %s with error : %s", uploadID, err)
+		return fw.Size(), fmt.Errorf("failed to append to the upload %s: %w", uploadID, err)
+	}
+	return fw.Size(), nil
+}
+
+// CompleteUpload saves the content of an upload as the file at filePath.
+func (f *FileManager) CompleteUpload(
+	ctx context.Context,
+	filePath string,
+	regName string,
+	regID int64,
+	rootParentID int64,
+	rootIdentifier string,
+	uploadID string,
+	filename string,
+) (pkg.FileInfo, error) {
+	blobContext := f.App.GetBlobsContext(ctx, regName, rootIdentifier)
+	fw, err := f.resumeUpload(ctx, blobContext, regName, rootIdentifier, uploadID)
+	if err != nil {
+		return pkg.FileInfo{}, err
+	}
+	err = fw.Commit(ctx)
+	_ = fw.Close()
+	if err != nil {
+		return pkg.FileInfo{}, fmt.Errorf("failed to complete the upload %s: %w", uploadID, err)
+	}
+
+	dataPath := uploadPath(rootIdentifier, regName, uploadID, data)
+	fileInfo, err := blobContext.genericBlobStore.Hash(ctx, dataPath)
+	if err != nil {
+		return pkg.FileInfo{}, fmt.Errorf("failed to read the upload %s: %w", uploadID, err)
+	}
+	fileInfo.Filename = filename
+	fileInfo, err = f.saveFile(ctx, blobContext, dataPath, filePath, regID, rootParentID, rootIdentifier, fileInfo)
+	if err != nil {
+		return pkg.FileInfo{}, err
+	}
+	f.deleteUpload(ctx, blobContext, regName, rootIdentifier, uploadID)
+	return fileInfo, nil
+}
+
+// CancelUpload discards an upload along with the content uploaded so far.
+func (f *FileManager) CancelUpload(ctx context.Context, regName string, rootIdentifier string, uploadID string) error {
+	blobContext := f.App.GetBlobsContext(ctx, regName, rootIdentifier)
+	fw, err := f.resumeUpload(ctx, blobContext, regName, rootIdentifier, uploadID)
+	if err != nil {
+		return err
+	}
+	if err = fw.Cancel(ctx); err != nil {
+		return fmt.Errorf("failed to cancel the upload %s: %w", uploadID, err)
+	}
+	f.deleteUpload(ctx, blobContext, regName, rootIdentifier, uploadID)
+	return nil
+}
+
+func (f *FileManager) resumeUpload(
+	ctx context.Context,
+	blobContext *Context,
+	regName string,
+	rootIdentifier string,
+	uploadID string,
+) (driver.FileWriter, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return nil, ErrUploadNotFound
+	}
+	started, err := blobContext.genericBlobStore.Exists(ctx, uploadPath(rootIdentifier, regName, uploadID, startedAt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the upload %s: %w", uploadID, err)
+	}
+	if !started {
+		return nil, ErrUploadNotFound
+	}
+	fw, err := blobContext.genericBlobStore.Resume(ctx, uploadPath(rootIdentifier, regName, uploadID, data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume the upload %s: %w", uploadID, err)
+	}
+	return fw, nil
+}
+
+// deleteUpload deletes what's left of an upload, which is only logged if it fails as deleting
+// the marker of the upload is enough for it to be gone.
+func (f *FileManager) deleteUpload(
+	ctx context.Context,
+	blobContext *Context,
+	regName string,
+	rootIdentifier string,
+	uploadID string,
+) {
+	err := blobContext.genericBlobStore.Delete(ctx, path.Dir(uploadPath(rootIdentifier, regName, uploadID, startedAt)))
+	if err != nil {
+		log.Ctx(ctx).Warn().Msgf("failed to delete the upload %s with error : %s", uploadID, err)
+	}
+}
+
+// uploadPath returns the path of a file of an upload, which is scoped to the registry it was
+// started for.
+func uploadPath(rootIdentifier string, regName string, uploadID string, name string) string {
+	return path.Join(rootPathString, rootIdentifier, tmp, uploads, regName, uploadID, name)
+}
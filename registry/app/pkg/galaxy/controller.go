@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"context"
+	"io"
+
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the package registry service of Ansible Galaxy registries, which serve
+// collections with version 3 of the Galaxy API.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	urlProvider          urlprovider.Provider
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	// GetAPIVersions lists the versions of the Galaxy API the registry serves, which clients
+	// discover the API with.
+	GetAPIVersions(ctx context.Context, info ArtifactInfo) (*APIVersions, errcode.Error)
+	GetCollection(ctx context.Context, info ArtifactInfo) (*Collection, errcode.Error)
+	// ListVersions lists a page of the versions of a collection, from the highest to the lowest.
+	ListVersions(ctx context.Context, info ArtifactInfo, limit, offset int) (*VersionList, errcode.Error)
+	GetVersion(ctx context.Context, info ArtifactInfo) (*Version, errcode.Error)
+	DownloadArchive(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	// Upload publishes a version from its archive, verified against its checksum unless it's
+	// empty, returning the import task clients wait for.
+	Upload(ctx context.Context, info ArtifactInfo, archive io.ReaderAt, size int64, sha256 string) (
+		*TaskRef,
+		errcode.Error,
+	)
+	GetImportTask(ctx context.Context, info ArtifactInfo, taskID string) (*ImportTask, errcode.Error)
+}
+
+// NewController creates a new Galaxy controller.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	urlProvider urlprovider.Provider,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		urlProvider:          urlProvider,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
+
+func (c *controller) registryURL(ctx context.Context, info ArtifactInfo) string {
+	return c.urlProvider.PackageURL(ctx, info.RootIdentifier+"/"+info.RegIdentifier, "galaxy")
+}
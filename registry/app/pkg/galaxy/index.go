@@ -0,0 +1,325 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	galaxymetadata "github.com/harness/gitness/registry/app/metadata/galaxy"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+func (c *controller) GetAPIVersions(ctx context.Context, info ArtifactInfo) (*APIVersions, errcode.Error) {
+	if _, errc := c.getRegistry(ctx, info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	return &APIVersions{
+		Description:       "Ansible Galaxy collections of " + info.RegIdentifier,
+		AvailableVersions: map[string]string{"v3": "v3/"},
+	}, errcode.Error{}
+}
+
+func (c *controller) GetCollection(ctx context.Context, info ArtifactInfo) (*Collection, errcode.Error) {
+	versions, errc := c.findVersions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	names := make([]string, 0, len(versions))
+	created, updated := versions[0].createdAt, versions[0].createdAt
+	for _, v := range versions {
+		names = append(names, v.Version)
+		if v.createdAt.Before(created) {
+			created = v.createdAt
+		}
+		if v.createdAt.After(updated) {
+			updated = v.createdAt
+		}
+	}
+	base := collectionURL(c.registryURL(ctx, info), info.Namespace, info.Name)
+	highest := galaxymetadata.HighestVersion(names)
+	return &Collection{
+		Href:           base,
+		Namespace:      info.Namespace,
+		Name:           info.Name,
+		VersionsURL:    base + "versions/",
+		HighestVersion: VersionRef{Version: highest, Href: versionURL(base, highest)},
+		CreatedAt:      formatTime(created),
+		UpdatedAt:      formatTime(updated),
+	}, errcode.Error{}
+}
+
+func (c *controller) ListVersions(
+	ctx context.Context,
+	info ArtifactInfo,
+	limit, offset int,
+) (*VersionList, errcode.Error) {
+	if limit < 1 || offset < 0 {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage("invalid limit or offset")
+	}
+	versions, errc := c.findVersions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	base := collectionURL(c.registryURL(ctx, info), info.Namespace, info.Name)
+	list := &VersionList{Meta: ListMeta{Count: len(versions)}, Data: []VersionRef{}}
+	for i := len(versions) - 1 - offset; i >= 0 && len(list.Data) < limit; i-- {
+		list.Data = append(list.Data, VersionRef{
+			Version: versions[i].Version, Href: versionURL(base, versions[i].Version),
+		})
+	}
+	list.Links = pageLinks(base+"versions/", len(versions), limit, offset)
+	return list, errcode.Error{}
+}
+
+func (c *controller) GetVersion(ctx context.Context, info ArtifactInfo) (*Version, errcode.Error) {
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	registryURL := c.registryURL(ctx, info)
+	base := collectionURL(registryURL, info.Namespace, info.Name)
+	filename := galaxymetadata.ArchiveFilename(info.Namespace, info.Name, info.Version)
+	var size int64
+	if len(version.Files) > 0 {
+		size = version.Files[0].Size
+	}
+	return &Version{
+		Href:        versionURL(base, version.Version),
+		Namespace:   NamespaceRef{Name: info.Namespace},
+		Name:        info.Name,
+		Version:     version.Version,
+		DownloadURL: downloadURL(registryURL, filename),
+		Artifact:    ArchiveRef{Filename: filename, Sha256: version.Sha256, Size: size},
+		Collection:  CollectionRef{Name: info.Name, Href: base},
+		Metadata:    version.CollectionInfo,
+		Signatures:  []any{},
+		CreatedAt:   formatTime(version.createdAt),
+	}, errcode.Error{}
+}
+
+func (c *controller) DownloadArchive(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	filename := galaxymetadata.ArchiveFilename(info.Namespace, info.Name, info.Version)
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.FQCN(), info.Version, filename),
+		types.Registry{
+			ID:   version.registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, version.registryID,
+		info.FQCN(), info.Version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+type publishedVersion struct {
+	database.GalaxyMetadata
+	registryID int64
+	createdAt  time.Time
+}
+
+func (c *controller) getRegistry(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeGALAXY {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't an Ansible Galaxy registry", registry.Name))
+	}
+	return registry, errcode.Error{}
+}
+
+// findVersions returns the versions of a collection from the lowest to the highest, failing
+// unless one was published.
+func (c *controller) findVersions(ctx context.Context, info ArtifactInfo) ([]*publishedVersion, errcode.Error) {
+	if errc := validateCollection(info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions, err := c.listVersions(ctx, registry.ID, info.FQCN())
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(versions) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("collection %s not found", info.FQCN()))
+	}
+	return versions, errcode.Error{}
+}
+
+// findVersion returns a published version of a collection.
+func (c *controller) findVersion(ctx context.Context, info ArtifactInfo) (*publishedVersion, errcode.Error) {
+	if errc := validateCollection(info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	if err := galaxymetadata.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	version, err := c.getVersion(ctx, registry.ID, info.FQCN(), info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of %s not found", info.Version, info.FQCN()))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return version, errcode.Error{}
+}
+
+func validateCollection(info ArtifactInfo) errcode.Error {
+	if err := galaxymetadata.ValidateName(info.Namespace); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := galaxymetadata.ValidateName(info.Name); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	return errcode.Error{}
+}
+
+// listVersions lists the versions of a collection from the lowest to the highest.
+func (c *controller) listVersions(ctx context.Context, registryID int64, fqcn string) ([]*publishedVersion, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, fqcn)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", fqcn, err)
+	}
+	versions := make([]*publishedVersion, 0, len(*artifacts))
+	for i := range *artifacts {
+		version, err := toPublishedVersion(&(*artifacts)[i], registryID)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, versions[i].Version, versions[j].Version) < 0
+	})
+	return versions, nil
+}
+
+// getVersion returns a version of a collection, or gitnessstore.ErrResourceNotFound if it wasn't
+// published.
+func (c *controller) getVersion(
+	ctx context.Context,
+	registryID int64,
+	fqcn, version string,
+) (*publishedVersion, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, fqcn)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", fqcn, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, fqcn, err)
+	}
+	return toPublishedVersion(a, registryID)
+}
+
+func toPublishedVersion(a *types.Artifact, registryID int64) (*publishedVersion, error) {
+	version := &publishedVersion{registryID: registryID, createdAt: a.CreatedAt}
+	if err := json.Unmarshal(a.Metadata, &version.GalaxyMetadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+	}
+	return version, nil
+}
+
+// pageLinks links to the pages of a list of count items, absolute as ansible-galaxy follows
+// relative links from the root of the server.
+func pageLinks(listURL string, count, limit, offset int) ListLinks {
+	page := func(offset int) *string {
+		link := listURL + "?" + url.Values{
+			"limit": {strconv.Itoa(limit)}, "offset": {strconv.Itoa(offset)},
+		}.Encode()
+		return &link
+	}
+	links := ListLinks{First: page(0), Last: page(max(count-1, 0) / limit * limit)}
+	if offset > 0 {
+		links.Previous = page(max(offset-limit, 0))
+	}
+	if offset+limit < count {
+		links.Next = page(offset + limit)
+	}
+	return links
+}
+
+// collectionURL returns the URL of a collection, which the URLs of its versions are relative to.
+func collectionURL(registryURL, namespace, name string) string {
+	return registryURL + "/api/v3/collections/" + namespace + "/" + name + "/"
+}
+
+func versionURL(collectionURL, version string) string {
+	return collectionURL + "versions/" + version + "/"
+}
+
+// downloadURL returns the URL clients download the archive of a version from.
+func downloadURL(registryURL, filename string) string {
+	return registryURL + "/download/" + filename
+}
+
+// filePath returns the path a file of a version is stored at.
+func filePath(fqcn, version, filename string) string {
+	return fqcn + "/" + version + "/" + filename
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
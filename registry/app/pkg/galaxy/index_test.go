@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionURLs(t *testing.T) {
+	base := collectionURL("https://pkg/galaxy", "acme", "net_tools")
+	assert.Equal(t, "https://pkg/galaxy/api/v3/collections/acme/net_tools/", base)
+	assert.Equal(t, "https://pkg/galaxy/api/v3/collections/acme/net_tools/versions/1.2.0/", versionURL(base, "1.2.0"))
+	assert.Equal(t, "https://pkg/galaxy/download/acme-net_tools-1.2.0.tar.gz",
+		downloadURL("https://pkg/galaxy", "acme-net_tools-1.2.0.tar.gz"))
+	assert.Equal(t, "https://pkg/galaxy/api/v3/imports/collections/acme-net_tools-1.2.0/",
+		importTaskURL("https://pkg/galaxy", taskID("acme-net_tools-1.2.0.tar.gz")))
+}
+
+func TestPageLinks(t *testing.T) {
+	links := pageLinks("https://pkg/versions/", 25, 10, 10)
+	assert.Equal(t, "https://pkg/versions/?limit=10&offset=0", *links.First)
+	assert.Equal(t, "https://pkg/versions/?limit=10&offset=0", *links.Previous)
+	assert.Equal(t, "https://pkg/versions/?limit=10&offset=20", *links.Next)
+	assert.Equal(t, "https://pkg/versions/?limit=10&offset=20", *links.Last)
+
+	links = pageLinks("https://pkg/versions/", 3, 100, 0)
+	assert.Nil(t, links.Previous)
+	assert.Nil(t, links.Next)
+	assert.Equal(t, "https://pkg/versions/?limit=100&offset=0", *links.Last)
+}
+
+func TestFilePath(t *testing.T) {
+	assert.Equal(t, "acme.net_tools/1.2.0/acme-net_tools-1.2.0.tar.gz",
+		filePath("acme.net_tools", "1.2.0", "acme-net_tools-1.2.0.tar.gz"))
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	galaxymetadata "github.com/harness/gitness/registry/app/metadata/galaxy"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Namespace string
+	Name      string
+	Version   string
+}
+
+// FQCN returns the fully qualified name of the collection, which it is stored under.
+func (a ArtifactInfo) FQCN() string {
+	return a.Namespace + "." + a.Name
+}
+
+// APIVersions maps the versions of the Galaxy API to their path, relative to the API root.
+type APIVersions struct {
+	Description       string            `json:"description"`
+	AvailableVersions map[string]string `json:"available_versions"`
+}
+
+// Collection describes a collection along with its highest version.
+type Collection struct {
+	Href           string     `json:"href"`
+	Namespace      string     `json:"namespace"`
+	Name           string     `json:"name"`
+	Deprecated     bool       `json:"deprecated"`
+	VersionsURL    string     `json:"versions_url"`
+	HighestVersion VersionRef `json:"highest_version"`
+	CreatedAt      string     `json:"created_at"`
+	UpdatedAt      string     `json:"updated_at"`
+}
+
+type VersionRef struct {
+	Version string `json:"version"`
+	Href    string `json:"href"`
+}
+
+// VersionList is a page of the versions of a collection, linking to the other pages.
+type VersionList struct {
+	Meta  ListMeta     `json:"meta"`
+	Links ListLinks    `json:"links"`
+	Data  []VersionRef `json:"data"`
+}
+
+type ListMeta struct {
+	Count int `json:"count"`
+}
+
+type ListLinks struct {
+	First    *string `json:"first"`
+	Previous *string `json:"previous"`
+	Next     *string `json:"next"`
+	Last     *string `json:"last"`
+}
+
+// Version describes a version of a collection, with the archive clients install it from and the
+// metadata it was built with.
+type Version struct {
+	Href        string                        `json:"href"`
+	Namespace   NamespaceRef                  `json:"namespace"`
+	Name        string                        `json:"name"`
+	Version     string                        `json:"version"`
+	DownloadURL string                        `json:"download_url"`
+	Artifact    ArchiveRef                    `json:"artifact"`
+	Collection  CollectionRef                 `json:"collection"`
+	Metadata    galaxymetadata.CollectionInfo `json:"metadata"`
+	Signatures  []any                         `json:"signatures"`
+	CreatedAt   string                        `json:"created_at"`
+}
+
+type NamespaceRef struct {
+	Name string `json:"name"`
+}
+
+type ArchiveRef struct {
+	Filename string `json:"filename"`
+	Sha256   string `json:"sha256"`
+	Size     int64  `json:"size"`
+}
+
+type CollectionRef struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+// TaskRef links to the task importing an uploaded archive.
+type TaskRef struct {
+	Task string `json:"task"`
+}
+
+// ImportTask is the state of the import of an archive. Archives are imported as they are
+// uploaded, so tasks are completed once they can be read.
+type ImportTask struct {
+	ID         string   `json:"id"`
+	State      string   `json:"state"`
+	FinishedAt string   `json:"finished_at"`
+	Messages   []string `json:"messages"`
+	Error      any      `json:"error"`
+}
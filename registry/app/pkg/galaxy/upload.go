@@ -0,0 +1,156 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package galaxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	galaxymetadata "github.com/harness/gitness/registry/app/metadata/galaxy"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// taskStateCompleted is the state of the import tasks of published versions.
+const taskStateCompleted = "completed"
+
+// Upload publishes a version read from the manifest of its archive. Versions are immutable, as
+// clients verify archives with the checksum they were published with.
+func (c *controller) Upload(
+	ctx context.Context,
+	info ArtifactInfo,
+	archive io.ReaderAt,
+	size int64,
+	checksum string,
+) (*TaskRef, errcode.Error) {
+	if checksum != "" {
+		hash := sha256.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(archive, 0, size)); err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(hash.Sum(nil)), checksum) {
+			return nil, errcode.ErrCodeInvalidRequest.WithMessage("archive doesn't match its sha256 checksum")
+		}
+	}
+	manifest, err := galaxymetadata.ReadArchive(io.NewSectionReader(archive, 0, size))
+	if err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	collection := manifest.CollectionInfo
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	_, err = c.getVersion(ctx, registry.ID, collection.FQCN(), collection.Version)
+	if err == nil {
+		return nil, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("version %s of %s was published before", collection.Version, collection.FQCN()))
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	filename := galaxymetadata.ArchiveFilename(collection.Namespace, collection.Name, collection.Version)
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(collection.FQCN(), collection.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		io.NewSectionReader(archive, 0, size), filename)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata := database.GalaxyMetadata{
+		Files: []database.File{{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		}},
+		FileCount:      1,
+		Sha256:         fileInfo.Sha256,
+		CollectionInfo: collection,
+	}
+	if err = c.publish(ctx, registry.ID, collection.FQCN(), collection.Version, metadata); err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return &TaskRef{Task: importTaskURL(c.registryURL(ctx, info), taskID(filename))}, errcode.Error{}
+}
+
+// GetImportTask returns the import task of an upload, identified by the archive it imported.
+func (c *controller) GetImportTask(ctx context.Context, info ArtifactInfo, id string) (*ImportTask, errcode.Error) {
+	namespace, name, version, err := galaxymetadata.ParseArchiveFilename(id + ".tar.gz")
+	if err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	info.Namespace, info.Name, info.Version = namespace, name, version
+	published, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	return &ImportTask{
+		ID:         id,
+		State:      taskStateCompleted,
+		FinishedAt: formatTime(published.createdAt),
+		Messages:   []string{},
+	}, errcode.Error{}
+}
+
+// publish creates the version of a collection whose archive was uploaded.
+func (c *controller) publish(
+	ctx context.Context,
+	registryID int64,
+	fqcn, version string,
+	metadata database.GalaxyMetadata,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       fqcn,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", fqcn, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", fqcn, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", fqcn, err)
+			}
+			return nil
+		})
+}
+
+// taskID identifies the import of an archive by its name without the extension.
+func taskID(filename string) string {
+	return strings.TrimSuffix(filename, ".tar.gz")
+}
+
+func importTaskURL(registryURL, id string) string {
+	return registryURL + "/api/v3/imports/collections/" + id + "/"
+}
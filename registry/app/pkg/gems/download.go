@@ -0,0 +1,107 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// DownloadGem serves the .gem of a version.
+func (c *controller) DownloadGem(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	name, version, err := c.resolveFilename(ctx, reg.ID, info.Filename)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if name == "" {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage("file " + info.Filename + " not found")
+	}
+
+	path := "/" + name + "/" + version + "/" + info.Filename
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
+		ID:   reg.ID,
+		Name: info.RegIdentifier,
+	}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, name, version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// resolveFilename finds the gem and full version a .gem file name belongs to. Gem names may contain
+// dashes, so each split of the name before a version is tried. An empty name is returned if the
+// file doesn't belong to any version.
+func (c *controller) resolveFilename(ctx context.Context, registryID int64, filename string) (string, string, error) {
+	for _, candidate := range splitFilename(filename) {
+		image, err := c.imageDao.GetByName(ctx, registryID, candidate[0])
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("failed to find gem %s: %w", candidate[0], err)
+		}
+		_, err = c.artifactDao.GetByName(ctx, image.ID, candidate[1])
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("failed to find version %s of gem %s: %w", candidate[1], candidate[0], err)
+		}
+		return candidate[0], candidate[1], nil
+	}
+	return "", "", nil
+}
+
+// splitFilename returns the possible name and full version pairs of a .gem file name, the version
+// starts with a digit after a dash.
+func splitFilename(filename string) [][2]string {
+	base, ok := strings.CutSuffix(filename, ".gem")
+	if !ok {
+		return nil
+	}
+	var candidates [][2]string
+	for i := 1; i < len(base)-1; i++ {
+		if base[i] == '-' && base[i+1] >= '0' && base[i+1] <= '9' {
+			candidates = append(candidates, [2]string{base[:i], base[i+1:]})
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,193 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gems
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // the compact index identifies info files by their md5
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// The compact index is the plain text index bundler resolves dependencies from.
+// Source: https://guides.rubygems.org/rubygems-org-compact-index-api/
+
+// GetNames returns the names of all gems of the registry.
+func (c *controller) GetNames(ctx context.Context, info ArtifactInfo) (string, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registry.ID)
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return buildNames(names), errcode.Error{}
+}
+
+// GetVersions returns the versions of all gems of the registry along with the checksums of their
+// info files, which bundler uses to tell which info files changed.
+func (c *controller) GetVersions(ctx context.Context, info ArtifactInfo) (string, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registry.ID)
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	gems := make(map[string][]types.Artifact, len(names))
+	for _, name := range names {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if len(*artifacts) > 0 {
+			gems[name] = *artifacts
+		}
+	}
+	versions, err := buildVersions(gems)
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return versions, errcode.Error{}
+}
+
+// GetInfo returns the versions of a gem with their runtime dependencies and requirements.
+func (c *controller) GetInfo(ctx context.Context, info ArtifactInfo) (string, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, info.Image)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) || (err == nil && len(*artifacts) == 0) {
+		return "", errcode.ErrCodeNameUnknown.WithMessage("gem " + info.Image + " not found")
+	}
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	infoFile, err := buildInfo(*artifacts)
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return infoFile, errcode.Error{}
+}
+
+func buildNames(names []string) string {
+	sorted := make([]string, len(names))
+	copy(sorted, names)
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	b.WriteString("---\n")
+	for _, name := range sorted {
+		b.WriteString(name + "\n")
+	}
+	return b.String()
+}
+
+func buildVersions(gems map[string][]types.Artifact) (string, error) {
+	names := make([]string, 0, len(gems))
+	var createdAt time.Time
+	for name, artifacts := range gems {
+		names = append(names, name)
+		for _, a := range artifacts {
+			if createdAt.IsZero() || a.CreatedAt.Before(createdAt) {
+				createdAt = a.CreatedAt
+			}
+		}
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("created_at: " + createdAt.UTC().Format(time.RFC3339) + "\n---\n")
+	for _, name := range names {
+		artifacts := sortedByPush(gems[name])
+		infoFile, err := buildInfo(artifacts)
+		if err != nil {
+			return "", err
+		}
+		versions := make([]string, 0, len(artifacts))
+		for _, a := range artifacts {
+			versions = append(versions, a.Version)
+		}
+		sum := md5.Sum([]byte(infoFile)) //nolint:gosec
+		b.WriteString(name + " " + strings.Join(versions, ",") + " " + hex.EncodeToString(sum[:]) + "\n")
+	}
+	return b.String(), nil
+}
+
+// buildInfo returns the info file of a gem, a line per version in push order like
+// "1.0.0 rack:>= 2.0&< 4|checksum:<sha256>,ruby:>= 2.7".
+func buildInfo(artifacts []types.Artifact) (string, error) {
+	var b strings.Builder
+	b.WriteString("---\n")
+	for _, a := range sortedByPush(artifacts) {
+		var metadata database.GemsMetadata
+		if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+			return "", fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+		}
+		var dependencies []string
+		for _, d := range metadata.Dependencies {
+			if d.Type != "runtime" {
+				continue
+			}
+			dependencies = append(dependencies, d.Name+":"+strings.Join(d.Requirements, "&"))
+		}
+		requirements := []string{"checksum:" + metadata.Sha256}
+		if r := requirement(metadata.RequiredRubyVersion); r != "" {
+			requirements = append(requirements, "ruby:"+r)
+		}
+		if r := requirement(metadata.RequiredRubygemsVersion); r != "" {
+			requirements = append(requirements, "rubygems:"+r)
+		}
+		b.WriteString(a.Version + " " + strings.Join(dependencies, ",") + "|" + strings.Join(requirements, ",") + "\n")
+	}
+	return b.String(), nil
+}
+
+// requirement joins the constraints of a requirement, empty for the default ">= 0".
+func requirement(constraints []string) string {
+	if len(constraints) == 0 || (len(constraints) == 1 && constraints[0] == ">= 0") {
+		return ""
+	}
+	return strings.Join(constraints, "&")
+}
+
+// sortedByPush orders versions the way they were pushed, as the compact index is append only.
+func sortedByPush(artifacts []types.Artifact) []types.Artifact {
+	sorted := make([]types.Artifact, len(artifacts))
+	copy(sorted, artifacts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].ID < sorted[j].ID
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+	return sorted
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gems
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/metadata/gems"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildInfo(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	artifact := func(id int64, version, sha256 string, md gems.Metadata) types.Artifact {
+		metadata, err := json.Marshal(&database.GemsMetadata{Sha256: sha256, Metadata: md})
+		require.NoError(t, err)
+		return types.Artifact{ID: id, Version: version, Metadata: metadata, CreatedAt: created.Add(time.Duration(id))}
+	}
+	artifacts := []types.Artifact{
+		artifact(2, "1.1.0", "bbb", gems.Metadata{
+			Dependencies: []gems.Dependency{
+				{Name: "rack", Requirements: []string{">= 2.0", "< 4"}, Type: "runtime"},
+				{Name: "minitest", Requirements: []string{"~> 5.0"}, Type: "development"},
+				{Name: "json", Requirements: []string{">= 0"}, Type: "runtime"},
+			},
+			RequiredRubyVersion:     []string{">= 2.7"},
+			RequiredRubygemsVersion: []string{">= 0"},
+		}),
+		artifact(1, "1.0.0", "aaa", gems.Metadata{}),
+	}
+
+	info, err := buildInfo(artifacts)
+	require.NoError(t, err)
+	assert.Equal(t, "---\n"+
+		"1.0.0 |checksum:aaa\n"+
+		"1.1.0 rack:>= 2.0&< 4,json:>= 0|checksum:bbb,ruby:>= 2.7\n", info)
+
+	versions, err := buildVersions(map[string][]types.Artifact{"sinatra": artifacts})
+	require.NoError(t, err)
+	assert.Equal(t, "created_at: 2024-01-01T00:00:00Z\n---\n"+
+		"sinatra 1.0.0,1.1.0 971d7b95c7179ec7b92446ebb616f876\n", versions)
+
+	assert.Equal(t, "---\nrack\nsinatra\n", buildNames([]string{"sinatra", "rack"}))
+}
+
+func TestSplitFilename(t *testing.T) {
+	assert.Equal(t, [][2]string{
+		{"net-http", "0.4.1-x86_64-linux"},
+	}, splitFilename("net-http-0.4.1-x86_64-linux.gem"))
+	assert.Equal(t, [][2]string{
+		{"s3", "1-2.0"},
+		{"s3-1", "2.0"},
+	}, splitFilename("s3-1-2.0.gem"))
+	assert.Empty(t, splitFilename("rake-13.0.6.tgz"))
+}
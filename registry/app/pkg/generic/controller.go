@@ -17,7 +17,9 @@ package generic
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"strings"
@@ -29,9 +31,9 @@ import (
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
-	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/services/downloadstats"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types/enum"
@@ -46,12 +48,12 @@ type Controller struct {
 }
 
 type DBStore struct {
-	RegistryDao      store.RegistryRepository
-	ImageDao         store.ImageRepository
-	ArtifactDao      store.ArtifactRepository
-	TagDao           store.TagRepository
-	BandwidthStatDao store.BandwidthStatRepository
-	DownloadStatDao  store.DownloadStatRepository
+	RegistryDao          store.RegistryRepository
+	ImageDao             store.ImageRepository
+	ArtifactDao          store.ArtifactRepository
+	TagDao               store.TagRepository
+	BandwidthStatDao     store.BandwidthStatRepository
+	DownloadStatRecorder *downloadstats.Recorder
 }
 
 func NewController(
@@ -75,14 +77,14 @@ func NewDBStore(
 	imageDao store.ImageRepository,
 	artifactDao store.ArtifactRepository,
 	bandwidthStatDao store.BandwidthStatRepository,
-	downloadStatDao store.DownloadStatRepository,
+	downloadStatRecorder *downloadstats.Recorder,
 ) *DBStore {
 	return &DBStore{
-		RegistryDao:      registryDao,
-		ImageDao:         imageDao,
-		ArtifactDao:      artifactDao,
-		BandwidthStatDao: bandwidthStatDao,
-		DownloadStatDao:  downloadStatDao,
+		RegistryDao:          registryDao,
+		ImageDao:             imageDao,
+		ArtifactDao:          artifactDao,
+		BandwidthStatDao:     bandwidthStatDao,
+		DownloadStatRecorder: downloadStatRecorder,
 	}
 }
 
@@ -91,7 +93,7 @@ const regNameFormat = "registry : [%s]"
 func (c Controller) UploadArtifact(
 	ctx context.Context, info pkg.GenericArtifactInfo,
 	file multipart.File,
-) (*commons.ResponseHeaders, string, errcode.Error) {
+) (*commons.ResponseHeaders, pkg.FileInfo, errcode.Error) {
 	responseHeaders := &commons.ResponseHeaders{
 		Headers: make(map[string]string),
 		Code:    0,
@@ -101,20 +103,35 @@ func (c Controller) UploadArtifact(
 		enum.PermissionArtifactsUpload,
 	)
 	if err != nil {
-		return nil, "", errcode.ErrCodeDenied.WithDetail(err)
+		return nil, pkg.FileInfo{}, errcode.ErrCodeDenied.WithDetail(err)
+	}
+
+	if info.CreateOnly {
+		claimed, err := c.claimVersion(ctx, info)
+		if err != nil {
+			if errors.Is(err, pkg.ErrVersionExists) {
+				return nil, pkg.FileInfo{}, errcode.ErrCodePreconditionFailed.WithDetail(err)
+			}
+			return nil, pkg.FileInfo{}, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		defer func() {
+			if responseHeaders.Code != http.StatusCreated {
+				pkg.ReleaseArtifactVersion(ctx, c.DBStore.ArtifactDao, claimed)
+			}
+		}()
 	}
 
 	err = c.CheckIfFileAlreadyExist(ctx, info)
 
 	if err != nil {
-		return nil, "", errcode.ErrCodeInvalidRequest.WithDetail(err)
+		return nil, pkg.FileInfo{}, errcode.ErrCodeInvalidRequest.WithDetail(err)
 	}
 
 	path := info.Image + "/" + info.Version + "/" + info.FileName
 	fileInfo, err := c.fileManager.UploadFile(ctx, path, info.RegIdentifier, info.RegistryID,
-		info.RootParentID, info.RootIdentifier, file, nil, info.FileName)
+		info.RootParentID, info.StorageRoot(), file, nil, info.FileName)
 	if err != nil {
-		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+		return responseHeaders, pkg.FileInfo{}, errcode.ErrCodeUnknown.WithDetail(err)
 	}
 	err = c.tx.WithTx(
 		ctx, func(ctx context.Context) error {
@@ -165,10 +182,32 @@ func (c Controller) UploadArtifact(
 		})
 
 	if err != nil {
-		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+		return responseHeaders, pkg.FileInfo{}, errcode.ErrCodeUnknown.WithDetail(err)
 	}
 	responseHeaders.Code = http.StatusCreated
-	return responseHeaders, fileInfo.Sha256, errcode.Error{}
+	return responseHeaders, fileInfo, errcode.Error{}
+}
+
+// claimVersion creates the version of a create-only upload before its file is uploaded.
+func (c Controller) claimVersion(ctx context.Context, info pkg.GenericArtifactInfo) (*types.Artifact, error) {
+	metadataJSON, err := json.Marshal(&database.GenericMetadata{Description: info.Description})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metadata for artifact : [%s] with "+
+			regNameFormat, info.Image, info.RegIdentifier)
+	}
+	artifact := &types.Artifact{
+		Version:  info.Version,
+		Metadata: metadataJSON,
+	}
+	err = pkg.ClaimArtifactVersion(ctx, c.tx, c.DBStore.ImageDao, c.DBStore.ArtifactDao, &types.Image{
+		Name:       info.Image,
+		RegistryID: info.RegistryID,
+		Enabled:    true,
+	}, artifact)
+	if err != nil {
+		return nil, err
+	}
+	return artifact, nil
 }
 
 func (c Controller) updateMetadata(
@@ -210,7 +249,7 @@ func (c Controller) updateMetadata(
 
 func (c Controller) PullArtifact(ctx context.Context, info pkg.GenericArtifactInfo) (
 	*commons.ResponseHeaders,
-	*storage.FileReader, string, errcode.Error,
+	io.ReadSeeker, string, errcode.Error,
 ) {
 	responseHeaders := &commons.ResponseHeaders{
 		Headers: make(map[string]string),
@@ -228,12 +267,35 @@ func (c Controller) PullArtifact(ctx context.Context, info pkg.GenericArtifactIn
 	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
 		ID:   info.RegistryID,
 		Name: info.RegIdentifier,
-	}, info.RootIdentifier)
+	}, info.StorageRoot())
 	if err != nil {
+		if checksum, ok := c.generateChecksumFile(ctx, info); ok {
+			responseHeaders.Code = http.StatusOK
+			return responseHeaders, strings.NewReader(checksum), "", errcode.Error{}
+		}
 		return responseHeaders, nil, "", errcode.ErrCodeRootNotFound.WithDetail(err)
 	}
 	responseHeaders.Code = http.StatusOK
-	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+	if redirectURL != "" {
+		return responseHeaders, nil, redirectURL, errcode.Error{}
+	}
+	return responseHeaders, fileReader, "", errcode.Error{}
+}
+
+// generateChecksumFile returns the checksum sidecar file of a stored file, such as tool.tar.gz.sha256,
+// from the checksum recorded when the file was uploaded, for publishers that don't upload them.
+func (c Controller) generateChecksumFile(ctx context.Context, info pkg.GenericArtifactInfo) (string, bool) {
+	base, ext, ok := pkg.SplitChecksumFile(info.FileName)
+	if !ok {
+		return "", false
+	}
+	path := "/" + info.Image + "/" + info.Version + "/" + base
+	fileInfo, err := c.fileManager.GetFileMetadata(ctx, path, info.RegistryID)
+	if err != nil {
+		return "", false
+	}
+	checksum := fileInfo.Checksum(ext)
+	return checksum, checksum != ""
 }
 
 func (c Controller) CheckIfFileAlreadyExist(ctx context.Context, info pkg.GenericArtifactInfo) error {
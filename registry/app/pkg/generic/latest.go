@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// LatestVersion is the version of a download path that resolves to the newest version of the
+// artifact, so that install scripts can download it without first looking the version up.
+const LatestVersion = "latest"
+
+// ResolveLatestVersion returns the version the latest version of an artifact resolves to,
+// picked by the latest version strategy of the registry. A version explicitly named latest is an
+// alias that takes precedence over the strategy. The version is returned as is if the artifact
+// doesn't exist yet.
+func (c Controller) ResolveLatestVersion(
+	ctx context.Context, registry *types.Registry, info pkg.GenericArtifactInfo,
+) (string, error) {
+	image, err := c.DBStore.ImageDao.GetByName(ctx, info.RegistryID, info.Image)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return LatestVersion, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the image for artifact : [%s] with "+
+			regNameFormat, info.Image, info.RegIdentifier)
+	}
+
+	_, err = c.DBStore.ArtifactDao.GetByName(ctx, image.ID, LatestVersion)
+	if err == nil {
+		return LatestVersion, nil
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return "", fmt.Errorf("failed to fetch artifact : [%s] with "+
+			regNameFormat, info.Image, info.RegIdentifier)
+	}
+
+	versions, err := c.DBStore.ArtifactDao.GetVersionsByImageNames(ctx, info.RegistryID, []string{info.Image})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the versions of artifact : [%s] with "+
+			regNameFormat, info.Image, info.RegIdentifier)
+	}
+	if len(versions[info.Image]) == 0 {
+		return LatestVersion, nil
+	}
+	return versioning.LatestForRegistry(registry, versions[info.Image]), nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeImageRepository struct {
+	store.ImageRepository
+	images map[string]*types.Image
+}
+
+func (f *fakeImageRepository) GetByName(_ context.Context, _ int64, name string) (*types.Image, error) {
+	image, ok := f.images[name]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return image, nil
+}
+
+type fakeArtifactRepository struct {
+	store.ArtifactRepository
+	// versions of the images, ordered by push time, most recent first
+	versions map[string][]string
+	ids      map[int64]string
+}
+
+func (f *fakeArtifactRepository) GetByName(_ context.Context, imageID int64, version string) (*types.Artifact, error) {
+	for _, v := range f.versions[f.ids[imageID]] {
+		if v == version {
+			return &types.Artifact{ImageID: imageID, Version: version}, nil
+		}
+	}
+	return nil, gitnessstore.ErrResourceNotFound
+}
+
+func (f *fakeArtifactRepository) GetVersionsByImageNames(
+	_ context.Context, _ int64, imageNames []string,
+) (map[string][]string, error) {
+	versions := make(map[string][]string)
+	for _, name := range imageNames {
+		versions[name] = f.versions[name]
+	}
+	return versions, nil
+}
+
+func TestResolveLatestVersion(t *testing.T) {
+	ctx := context.Background()
+	c := Controller{DBStore: &DBStore{
+		ImageDao: &fakeImageRepository{images: map[string]*types.Image{
+			"cli": {ID: 1, Name: "cli"}, "tool": {ID: 2, Name: "tool"},
+		}},
+		ArtifactDao: &fakeArtifactRepository{
+			versions: map[string][]string{"cli": {"1.9.0", "1.10.0", "1.2.0"}, "tool": {"1.0.0", "latest"}},
+			ids:      map[int64]string{1: "cli", 2: "tool"},
+		},
+	}}
+	info := func(image string) pkg.GenericArtifactInfo {
+		return pkg.GenericArtifactInfo{
+			ArtifactInfo: &pkg.ArtifactInfo{Image: image, RegIdentifier: "files"},
+			Version:      LatestVersion,
+		}
+	}
+	registry := &types.Registry{ID: 1, PackageType: artifact.PackageTypeGENERIC}
+
+	version, err := c.ResolveLatestVersion(ctx, registry, info("cli"))
+	require.NoError(t, err)
+	assert.Equal(t, "1.9.0", version, "the last pushed version by default")
+
+	registry.LatestVersionStrategy = artifact.LatestVersionStrategySEMVER
+	version, err = c.ResolveLatestVersion(ctx, registry, info("cli"))
+	require.NoError(t, err)
+	assert.Equal(t, "1.10.0", version)
+
+	version, err = c.ResolveLatestVersion(ctx, registry, info("tool"))
+	require.NoError(t, err)
+	assert.Equal(t, LatestVersion, version, "a version named latest takes precedence")
+
+	version, err = c.ResolveLatestVersion(ctx, registry, info("missing"))
+	require.NoError(t, err)
+	assert.Equal(t, LatestVersion, version)
+}
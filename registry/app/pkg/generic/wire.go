@@ -19,6 +19,7 @@ import (
 	gitnessstore "github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/wire"
@@ -28,10 +29,10 @@ func DBStoreProvider(
 	imageDao store.ImageRepository,
 	artifactDao store.ArtifactRepository,
 	bandwidthStatDao store.BandwidthStatRepository,
-	downloadStatDao store.DownloadStatRepository,
+	downloadStatRecorder *downloadstats.Recorder,
 	registryDao store.RegistryRepository,
 ) *DBStore {
-	return NewDBStore(registryDao, imageDao, artifactDao, bandwidthStatDao, downloadStatDao)
+	return NewDBStore(registryDao, imageDao, artifactDao, bandwidthStatDao, downloadStatRecorder)
 }
 
 func ControllerProvider(
@@ -0,0 +1,147 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/gomodule"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// ListVersions returns the @v/list of a module, one version per line.
+func (c *controller) ListVersions(ctx context.Context, info ArtifactInfo) (string, errcode.Error) {
+	artifacts, errc := c.getArtifacts(ctx, info)
+	if errc.Code != 0 {
+		return "", errc
+	}
+	var b strings.Builder
+	for _, a := range sortedVersions(artifacts) {
+		b.WriteString(a.Version + "\n")
+	}
+	return b.String(), errcode.Error{}
+}
+
+// GetInfo returns the .info of a version, with the time it was published.
+func (c *controller) GetInfo(ctx context.Context, info ArtifactInfo) (*gomodule.Info, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	image, err := c.imageDao.GetByName(ctx, registry.ID, info.Image)
+	if err != nil {
+		return nil, notFound(err, "module "+info.Image+" not found")
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, info.Version)
+	if err != nil {
+		return nil, notFound(err, "version "+info.Version+" of module "+info.Image+" not found")
+	}
+	return &gomodule.Info{Version: a.Version, Time: a.CreatedAt.UTC()}, errcode.Error{}
+}
+
+// GetLatest returns the .info of the latest version for @latest, which is the highest release
+// version, or the highest pre-release if the module has no release.
+func (c *controller) GetLatest(ctx context.Context, info ArtifactInfo) (*gomodule.Info, errcode.Error) {
+	artifacts, errc := c.getArtifacts(ctx, info)
+	if errc.Code != 0 {
+		return nil, errc
+	}
+	latest := latestVersion(artifacts)
+	return &gomodule.Info{Version: latest.Version, Time: latest.CreatedAt.UTC()}, errcode.Error{}
+}
+
+// DownloadFile serves the .mod or .zip of a version.
+func (c *controller) DownloadFile(ctx context.Context, info ArtifactInfo, extension string) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+
+	path := "/" + info.Image + "/" + info.Version + "/" + info.Version + extension
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
+		ID:   reg.ID,
+		Name: info.RegIdentifier,
+	}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	if extension == ExtensionZip {
+		pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, info.Image, info.Version)
+	}
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) getArtifacts(ctx context.Context, info ArtifactInfo) ([]types.Artifact, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, info.Image)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) || (err == nil && len(*artifacts) == 0) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("module " + info.Image + " not found")
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return *artifacts, errcode.Error{}
+}
+
+func notFound(err error, message string) errcode.Error {
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return errcode.ErrCodeNameUnknown.WithMessage(message)
+	}
+	return errcode.ErrCodeUnknown.WithDetail(err)
+}
+
+func sortedVersions(artifacts []types.Artifact) []types.Artifact {
+	sorted := make([]types.Artifact, len(artifacts))
+	copy(sorted, artifacts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, sorted[i].Version, sorted[j].Version) < 0
+	})
+	return sorted
+}
+
+// latestVersion expects at least one artifact.
+func latestVersion(artifacts []types.Artifact) types.Artifact {
+	sorted := sortedVersions(artifacts)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if !strings.Contains(strings.SplitN(sorted[i].Version, "+", 2)[0], "-") {
+			return sorted[i]
+		}
+	}
+	return sorted[len(sorted)-1]
+}
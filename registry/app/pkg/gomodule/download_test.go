@@ -0,0 +1,44 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatestVersion(t *testing.T) {
+	artifacts := func(versions ...string) []types.Artifact {
+		result := make([]types.Artifact, 0, len(versions))
+		for _, v := range versions {
+			result = append(result, types.Artifact{Version: v})
+		}
+		return result
+	}
+
+	assert.Equal(t, "v1.10.0", latestVersion(artifacts("v1.2.0", "v1.10.0", "v1.11.0-rc.1")).Version)
+	assert.Equal(t, "v0.2.0-beta", latestVersion(artifacts("v0.1.0-alpha", "v0.2.0-beta")).Version)
+	assert.Equal(t, "v2.1.0+incompatible",
+		latestVersion(artifacts("v2.0.0+incompatible", "v2.1.0+incompatible")).Version)
+
+	var versions []string
+	for _, a := range sortedVersions(artifacts("v1.10.0", "v1.2.0", "v1.2.0-rc.1")) {
+		versions = append(versions, a.Version)
+	}
+	assert.Equal(t, []string{"v1.2.0-rc.1", "v1.2.0", "v1.10.0"}, versions)
+}
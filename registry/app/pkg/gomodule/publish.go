@@ -0,0 +1,155 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gomodule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/gomodule"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// PublishModule stores the zip of a module version along with its go.mod, which is read from the
+// zip. Published versions are immutable, as the checksum database expects.
+func (c *controller) PublishModule(
+	ctx context.Context,
+	info ArtifactInfo,
+	file io.ReaderAt,
+	size int64,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	module, version := info.Image, info.Version
+
+	if err := gomodule.ValidateVersion(module, version); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	goMod, err := gomodule.ReadZip(file, size, module, version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	metadata, err := gomodule.ParseGoMod(goMod)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if metadata.Module != module {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("go.mod declares module %s, not %s", metadata.Module, module))
+	}
+	metadata.Version = version
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeGO {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a go registry", registry.Name))
+	}
+	published, err := c.isPublished(ctx, registry.ID, module, version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if published {
+		return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("module %s@%s was published before", module, version))
+	}
+
+	var files []database.File
+	for _, f := range []struct {
+		extension string
+		content   io.Reader
+	}{
+		{ExtensionMod, bytes.NewReader(goMod)},
+		{ExtensionZip, io.NewSectionReader(file, 0, size)},
+	} {
+		filename := version + f.extension
+		fileInfo, err := c.fileManager.UploadFile(ctx, module+"/"+version+"/"+filename, info.RegIdentifier,
+			registry.ID, info.RootParentID, info.StorageRoot(), nil, f.content, filename)
+		if err != nil {
+			return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		files = append(files, database.File{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		})
+	}
+
+	err = c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       module,
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", module, err)
+			}
+
+			metadataJSON, err := json.Marshal(&database.GoModuleMetadata{
+				Files:     files,
+				FileCount: int64(len(files)),
+				Metadata:  *metadata,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", module, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", module, err)
+			}
+			return nil
+		})
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+func (c *controller) isPublished(ctx context.Context, registryID int64, module, version string) (bool, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, module)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find module %s: %w", module, err)
+	}
+	_, err = c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find version %s of module %s: %w", version, module, err)
+	}
+	return true, nil
+}
@@ -69,5 +69,14 @@ func GetRegistryCheckAccess(
 		return err
 	}
 
+	var principal types.Principal
+	if session != nil {
+		principal = session.Principal
+	}
+	if err = VerifyClientCertificate(ctx, registry, space.Path, principal, reqPermissions); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("client certificate check failed for registry %s", registry.Name)
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,100 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"context"
+	"io"
+
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the package registry service of hex registries, serving both the repository
+// the clients fetch packages from and the API they publish them with.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	urlProvider          urlprovider.Provider
+	signer               *Signer
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	GetPublicKey(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error)
+	// GetNames returns the names resource of the repository, signed and gzipped.
+	GetNames(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error)
+	// GetVersions returns the versions resource of the repository, signed and gzipped.
+	GetVersions(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error)
+	// GetPackage returns the resource of a package, signed and gzipped.
+	GetPackage(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error)
+	DownloadTarball(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	DownloadDocs(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	GetPackageInfo(ctx context.Context, info ArtifactInfo) (*PackageInfo, errcode.Error)
+	GetReleaseInfo(ctx context.Context, info ArtifactInfo) (*ReleaseInfo, errcode.Error)
+	// Publish publishes a release from its tarball, replacing the release if replace is set.
+	Publish(ctx context.Context, info ArtifactInfo, tarball io.ReaderAt, size int64, replace bool) (
+		*ReleaseInfo,
+		*commons.ResponseHeaders,
+		errcode.Error,
+	)
+	PublishDocs(ctx context.Context, info ArtifactInfo, docs io.Reader) (*commons.ResponseHeaders, errcode.Error)
+}
+
+// NewController creates a new Hex controller, resources are left unsigned if signer is nil.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	urlProvider urlprovider.Provider,
+	signer *Signer,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		urlProvider:          urlProvider,
+		signer:               signer,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
+
+func (c *controller) registryURL(ctx context.Context, info ArtifactInfo) string {
+	return c.urlProvider.PackageURL(ctx, info.RootIdentifier+"/"+info.RegIdentifier, "hex")
+}
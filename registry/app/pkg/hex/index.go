@@ -0,0 +1,412 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	hexmetadata "github.com/harness/gitness/registry/app/metadata/hex"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+func (c *controller) GetPublicKey(_ context.Context, _ ArtifactInfo) ([]byte, errcode.Error) {
+	if c.signer == nil {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("the repository is unsigned")
+	}
+	publicKey, err := c.signer.PublicKey()
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return publicKey, errcode.Error{}
+}
+
+func (c *controller) GetNames(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error) {
+	packages, errc := c.listPackages(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	names := make([]hexmetadata.PackageName, 0, len(packages))
+	for _, p := range packages {
+		name := hexmetadata.PackageName{Name: p.name}
+		for _, release := range p.releases {
+			if release.updatedAt.After(name.UpdatedAt) {
+				name.UpdatedAt = release.updatedAt
+			}
+		}
+		names = append(names, name)
+	}
+	return c.sign(hexmetadata.EncodeNames(info.RegIdentifier, names))
+}
+
+func (c *controller) GetVersions(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error) {
+	packages, errc := c.listPackages(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions := make([]hexmetadata.PackageVersions, 0, len(packages))
+	for _, p := range packages {
+		pkg := hexmetadata.PackageVersions{Name: p.name}
+		for _, release := range p.releases {
+			pkg.Versions = append(pkg.Versions, release.Version)
+		}
+		versions = append(versions, pkg)
+	}
+	return c.sign(hexmetadata.EncodeVersions(info.RegIdentifier, versions))
+}
+
+func (c *controller) GetPackage(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error) {
+	p, errc := c.findPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	releases := make([]hexmetadata.Release, 0, len(p.releases))
+	for _, release := range p.releases {
+		innerChecksum, err := hex.DecodeString(release.InnerChecksum)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		outerChecksum, err := hex.DecodeString(release.Sha256)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		releases = append(releases, hexmetadata.Release{
+			Version:       release.Version,
+			InnerChecksum: innerChecksum,
+			OuterChecksum: outerChecksum,
+			Dependencies:  release.Requirements,
+		})
+	}
+	return c.sign(hexmetadata.EncodePackage(info.RegIdentifier, p.name, releases))
+}
+
+func (c *controller) DownloadTarball(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	return c.downloadFile(ctx, info, hexmetadata.TarballFilename(info.Name, info.Version), false)
+}
+
+func (c *controller) DownloadDocs(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	return c.downloadFile(ctx, info, hexmetadata.DocsFilename(info.Name, info.Version), true)
+}
+
+func (c *controller) GetPackageInfo(ctx context.Context, info ArtifactInfo) (*PackageInfo, errcode.Error) {
+	p, errc := c.findPackage(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	apiURL := c.registryURL(ctx, info) + "/api"
+	latest := p.releases[len(p.releases)-1]
+	packageInfo := &PackageInfo{
+		Name:       p.name,
+		Repository: info.RegIdentifier,
+		URL:        apiURL + "/packages/" + p.name,
+		HTMLURL:    apiURL + "/packages/" + p.name,
+		Meta: PackageMeta{
+			Description: latest.Description,
+			Licenses:    nonNil(latest.Licenses),
+			Links:       latest.Links,
+		},
+		LatestVersion: latest.Version,
+		InsertedAt:    formatTime(p.releases[0].createdAt),
+		UpdatedAt:     formatTime(latest.updatedAt),
+	}
+	if packageInfo.Meta.Links == nil {
+		packageInfo.Meta.Links = map[string]string{}
+	}
+	// Releases are listed from the highest version, as the API of Hex does.
+	for i := len(p.releases) - 1; i >= 0; i-- {
+		release := p.releases[i]
+		packageInfo.Releases = append(packageInfo.Releases, ReleaseLink{
+			Version:    release.Version,
+			URL:        packageInfo.URL + "/releases/" + release.Version,
+			HasDocs:    release.HasDocs,
+			InsertedAt: formatTime(release.createdAt),
+		})
+		if v, err := semver.NewVersion(release.Version); packageInfo.LatestStableVersion == "" && err == nil &&
+			v.Prerelease() == "" {
+			packageInfo.LatestStableVersion = release.Version
+		}
+	}
+	return packageInfo, errcode.Error{}
+}
+
+func (c *controller) GetReleaseInfo(ctx context.Context, info ArtifactInfo) (*ReleaseInfo, errcode.Error) {
+	release, errc := c.findRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	return c.releaseInfo(ctx, info, release), errcode.Error{}
+}
+
+// releaseInfo describes a release as the API of Hex does.
+func (c *controller) releaseInfo(ctx context.Context, info ArtifactInfo, release *publishedRelease) *ReleaseInfo {
+	baseURL := c.registryURL(ctx, info)
+	packageURL := baseURL + "/api/packages/" + release.Name
+	releaseInfo := &ReleaseInfo{
+		Version:      release.Version,
+		Checksum:     release.Sha256,
+		HasDocs:      release.HasDocs,
+		URL:          packageURL + "/releases/" + release.Version,
+		HTMLURL:      packageURL + "/releases/" + release.Version,
+		PackageURL:   packageURL,
+		Requirements: map[string]Requirement{},
+		Meta: ReleaseMeta{
+			App:        release.App,
+			BuildTools: nonNil(release.BuildTools),
+			Elixir:     release.Elixir,
+		},
+		InsertedAt: formatTime(release.createdAt),
+		UpdatedAt:  formatTime(release.updatedAt),
+	}
+	if release.HasDocs {
+		releaseInfo.DocsHTMLURL = baseURL + "/docs/" + hexmetadata.DocsFilename(release.Name, release.Version)
+	}
+	for _, requirement := range release.Requirements {
+		releaseInfo.Requirements[requirement.Name] = Requirement{
+			App:         requirement.App,
+			Optional:    requirement.Optional,
+			Requirement: requirement.Requirement,
+		}
+	}
+	return releaseInfo
+}
+
+type publishedRelease struct {
+	database.HexMetadata
+	registryID int64
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+type publishedPackage struct {
+	name string
+	// releases are sorted from the lowest to the highest version.
+	releases []*publishedRelease
+}
+
+// listPackages lists the packages of the repository with their releases.
+func (c *controller) listPackages(ctx context.Context, info ArtifactInfo) ([]publishedPackage, errcode.Error) {
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, reg.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	packages := make([]publishedPackage, 0, len(names))
+	for _, name := range names {
+		releases, err := c.listReleases(ctx, reg.ID, name)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if len(releases) > 0 {
+			packages = append(packages, publishedPackage{name: name, releases: releases})
+		}
+	}
+	return packages, errcode.Error{}
+}
+
+// findPackage returns a package of the repository with its releases.
+func (c *controller) findPackage(ctx context.Context, info ArtifactInfo) (*publishedPackage, errcode.Error) {
+	if err := hexmetadata.ValidateName(info.Name); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	releases, err := c.listReleases(ctx, reg.ID, info.Name)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(releases) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("package %s not found", info.Name))
+	}
+	return &publishedPackage{name: info.Name, releases: releases}, errcode.Error{}
+}
+
+// findRelease returns a published release of a package.
+func (c *controller) findRelease(ctx context.Context, info ArtifactInfo) (*publishedRelease, errcode.Error) {
+	if err := hexmetadata.ValidateName(info.Name); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := hexmetadata.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	release, err := c.getRelease(ctx, reg.ID, info.Name, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("release %s of %s not found", info.Version, info.Name))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return release, errcode.Error{}
+}
+
+// listReleases lists the releases of a package from the lowest to the highest version.
+func (c *controller) listReleases(ctx context.Context, registryID int64, name string) ([]*publishedRelease, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list releases of %s: %w", name, err)
+	}
+	releases := make([]*publishedRelease, 0, len(*artifacts))
+	for i := range *artifacts {
+		release, err := toPublishedRelease(&(*artifacts)[i], registryID)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, release)
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, releases[i].Version, releases[j].Version) < 0
+	})
+	return releases, nil
+}
+
+// getRelease returns a release of a package, or gitnessstore.ErrResourceNotFound if it wasn't
+// published.
+func (c *controller) getRelease(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*publishedRelease, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find release %s of %s: %w", version, name, err)
+	}
+	return toPublishedRelease(a, registryID)
+}
+
+func toPublishedRelease(a *types.Artifact, registryID int64) (*publishedRelease, error) {
+	release := &publishedRelease{registryID: registryID, createdAt: a.CreatedAt, updatedAt: a.UpdatedAt}
+	if err := json.Unmarshal(a.Metadata, &release.HexMetadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of release %s: %w", a.Version, err)
+	}
+	return release, nil
+}
+
+func (c *controller) downloadFile(ctx context.Context, info ArtifactInfo, filename string, docs bool) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	release, errc := c.findRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	if docs && !release.HasDocs {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("documentation of release %s of %s not found", info.Version, info.Name))
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.Name, info.Version, filename),
+		types.Registry{
+			ID:   release.registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	if !docs {
+		pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, release.registryID, info.Name,
+			info.Version)
+	}
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// sign wraps the payload of a resource with its signature, if the repository is signed.
+func (c *controller) sign(payload []byte) ([]byte, errcode.Error) {
+	var signature []byte
+	if c.signer != nil {
+		var err error
+		if signature, err = c.signer.Sign(payload); err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+	}
+	resource, err := hexmetadata.EncodeSigned(payload, signature)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return resource, errcode.Error{}
+}
+
+// filePath returns the path a file of a release is stored at.
+func filePath(name, version, filename string) string {
+	return name + "/" + version + "/" + filename
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Signer signs the registry resources, which clients verify with the public key they added the
+// repository with.
+type Signer struct {
+	key *rsa.PrivateKey
+}
+
+// ParseSigningKey parses a PEM encoded PKCS #1 or PKCS #8 RSA private key, returning nil if key
+// is empty.
+func ParseSigningKey(key string) (*Signer, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, nil //nolint:nilnil
+	}
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("hex signing key must be PEM encoded")
+	}
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("hex signing key must be a private key, found %s", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read hex signing key: %w", err)
+	}
+	rsaKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("hex signing key must be an RSA key")
+	}
+	return &Signer{key: rsaKey}, nil
+}
+
+// Sign returns the RSA-SHA512 signature of the payload of a resource.
+func (s *Signer) Sign(payload []byte) ([]byte, error) {
+	digest := sha512.Sum512(payload)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA512, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign resource: %w", err)
+	}
+	return signature, nil
+}
+
+// PublicKey returns the PEM encoded public key, which clients add the repository with.
+func (s *Signer) PublicKey() ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(&s.key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize public key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
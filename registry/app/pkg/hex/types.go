@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Name    string
+	Version string
+}
+
+// PackageInfo describes a package as the API of Hex does.
+type PackageInfo struct {
+	Name       string        `json:"name"`
+	Repository string        `json:"repository"`
+	URL        string        `json:"url"`
+	HTMLURL    string        `json:"html_url"`
+	Meta       PackageMeta   `json:"meta"`
+	Releases   []ReleaseLink `json:"releases"`
+	// LatestVersion is the highest version, and LatestStableVersion the highest one that isn't a
+	// pre-release.
+	LatestVersion       string `json:"latest_version,omitempty"`
+	LatestStableVersion string `json:"latest_stable_version,omitempty"`
+	InsertedAt          string `json:"inserted_at,omitempty"`
+	UpdatedAt           string `json:"updated_at,omitempty"`
+}
+
+type PackageMeta struct {
+	Description string            `json:"description,omitempty"`
+	Licenses    []string          `json:"licenses"`
+	Links       map[string]string `json:"links"`
+}
+
+type ReleaseLink struct {
+	Version    string `json:"version"`
+	URL        string `json:"url"`
+	HasDocs    bool   `json:"has_docs"`
+	InsertedAt string `json:"inserted_at,omitempty"`
+}
+
+// ReleaseInfo describes a release as the API of Hex does.
+type ReleaseInfo struct {
+	Version      string                 `json:"version"`
+	Checksum     string                 `json:"checksum"`
+	HasDocs      bool                   `json:"has_docs"`
+	URL          string                 `json:"url"`
+	HTMLURL      string                 `json:"html_url"`
+	PackageURL   string                 `json:"package_url"`
+	DocsHTMLURL  string                 `json:"docs_html_url,omitempty"`
+	Requirements map[string]Requirement `json:"requirements"`
+	Meta         ReleaseMeta            `json:"meta"`
+	InsertedAt   string                 `json:"inserted_at,omitempty"`
+	UpdatedAt    string                 `json:"updated_at,omitempty"`
+}
+
+type Requirement struct {
+	App         string `json:"app,omitempty"`
+	Optional    bool   `json:"optional"`
+	Requirement string `json:"requirement"`
+}
+
+type ReleaseMeta struct {
+	App        string   `json:"app,omitempty"`
+	BuildTools []string `json:"build_tools"`
+	Elixir     string   `json:"elixir,omitempty"`
+}
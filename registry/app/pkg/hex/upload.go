@@ -0,0 +1,184 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	hexmetadata "github.com/harness/gitness/registry/app/metadata/hex"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// Publish stores a release from its tarball, whose metadata describes the release. Releases are
+// immutable unless replace is set, which keeps the documentation of the replaced release.
+func (c *controller) Publish(
+	ctx context.Context,
+	info ArtifactInfo,
+	tarball io.ReaderAt,
+	size int64,
+	replace bool,
+) (*ReleaseInfo, *commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	meta, err := hexmetadata.ReadTarball(tarball, size)
+	if err != nil {
+		return nil, responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if info.Name != "" && info.Name != meta.Name {
+		return nil, responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("tarball of %s published to package %s", meta.Name, info.Name))
+	}
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeHEX {
+		return nil, responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a hex registry", registry.Name))
+	}
+
+	metadata := database.HexMetadata{Metadata: *meta}
+	existing, err := c.getRelease(ctx, registry.ID, meta.Name, meta.Version)
+	switch {
+	case err == nil && !replace:
+		return nil, responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("release %s of %s was published before", meta.Version, meta.Name))
+	case err == nil:
+		metadata.HasDocs = existing.HasDocs
+		docs := hexmetadata.DocsFilename(meta.Name, meta.Version)
+		for _, file := range existing.Files {
+			if file.Filename == docs {
+				metadata.Files = append(metadata.Files, file)
+			}
+		}
+	case !errors.Is(err, gitnessstore.ErrResourceNotFound):
+		return nil, responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	filename := hexmetadata.TarballFilename(meta.Name, meta.Version)
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(meta.Name, meta.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		io.NewSectionReader(tarball, 0, size), filename)
+	if err != nil {
+		return nil, responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata.Sha256 = fileInfo.Sha256
+	metadata.Files = append(metadata.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+	})
+	metadata.FileCount = int64(len(metadata.Files))
+
+	if err = c.publish(ctx, registry.ID, meta.Name, meta.Version, metadata); err != nil {
+		return nil, responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	release, err := c.getRelease(ctx, registry.ID, meta.Name, meta.Version)
+	if err != nil {
+		return nil, responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return c.releaseInfo(ctx, info, release), responseHeaders, errcode.Error{}
+}
+
+// PublishDocs stores the documentation tarball of a published release, replacing the previous one.
+func (c *controller) PublishDocs(
+	ctx context.Context,
+	info ArtifactInfo,
+	docs io.Reader,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	release, errc := c.findRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, errc
+	}
+
+	filename := hexmetadata.DocsFilename(info.Name, info.Version)
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(info.Name, info.Version, filename),
+		info.RegIdentifier, release.registryID, info.RootParentID, info.StorageRoot(), nil, docs, filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	metadata := release.HexMetadata
+	metadata.HasDocs = true
+	metadata.Files = nil
+	for _, file := range release.Files {
+		if file.Filename != filename {
+			metadata.Files = append(metadata.Files, file)
+		}
+	}
+	metadata.Files = append(metadata.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+	})
+	metadata.FileCount = int64(len(metadata.Files))
+
+	if err = c.publish(ctx, release.registryID, info.Name, info.Version, metadata); err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// publish creates or updates a release whose files were uploaded.
+func (c *controller) publish(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+	metadata database.HexMetadata,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+}
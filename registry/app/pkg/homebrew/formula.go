@@ -0,0 +1,288 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package homebrew
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/homebrew"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+func (c *controller) GetFormula(ctx context.Context, info ArtifactInfo) (string, errcode.Error) {
+	if err := homebrew.ValidateName(info.Name); err != nil {
+		return "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return "", errc
+	}
+	version, err := c.getLatestVersion(ctx, registry.ID, info.Name)
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if version == nil {
+		return "", errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("formula %s not found", info.Name))
+	}
+	source, err := homebrew.GenerateFormula(version.Formula, bottlesURL(c.registryURL(ctx, info)), version.Bottles)
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return source, errcode.Error{}
+}
+
+func (c *controller) GetIndex(ctx context.Context, info ArtifactInfo) ([]FormulaInfo, errcode.Error) {
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions, err := c.listLatestVersions(ctx, registry.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	rootURL := bottlesURL(c.registryURL(ctx, info))
+	index := make([]FormulaInfo, 0, len(versions))
+	for _, v := range versions {
+		index = append(index, formulaInfo(rootURL, v))
+	}
+	return index, errcode.Error{}
+}
+
+func (c *controller) GetTapArchive(ctx context.Context, info ArtifactInfo) ([]byte, errcode.Error) {
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions, err := c.listLatestVersions(ctx, registry.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	rootURL := bottlesURL(c.registryURL(ctx, info))
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, v := range versions {
+		source, err := homebrew.GenerateFormula(v.Formula, rootURL, v.Bottles)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		err = tw.WriteHeader(&tar.Header{
+			Name:     "Formula/" + v.name + ".rb",
+			Mode:     0o644,
+			Size:     int64(len(source)),
+			ModTime:  v.updatedAt,
+			Typeflag: tar.TypeReg,
+		})
+		if err == nil {
+			_, err = tw.Write([]byte(source))
+		}
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(fmt.Errorf("failed to write formula %s: %w", v.name, err))
+		}
+	}
+	if err = tw.Close(); err == nil {
+		err = gz.Close()
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(fmt.Errorf("failed to write tap: %w", err))
+	}
+	return buf.Bytes(), errcode.Error{}
+}
+
+func (c *controller) DownloadBottle(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	version, err := c.getVersion(ctx, registry.ID, info.Name, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of %s not found", info.Version, info.Name))
+	}
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	bottle, ok := homebrew.FindBottle(version.Bottles, info.Tag, info.Rebuild)
+	if !ok {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("bottle %s not found", info.Filename))
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.Name, info.Version, bottle.Filename),
+		types.Registry{
+			ID:   registry.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, registry.ID, info.Name, info.Version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + bottle.Filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+type publishedVersion struct {
+	database.HomebrewMetadata
+	name      string
+	version   string
+	updatedAt time.Time
+}
+
+func (c *controller) getRegistry(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeHOMEBREW {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a Homebrew registry", registry.Name))
+	}
+	return registry, errcode.Error{}
+}
+
+// listLatestVersions returns the latest versions of the formulae of a registry, by name.
+func (c *controller) listLatestVersions(ctx context.Context, registryID int64) ([]*publishedVersion, error) {
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list formulae: %w", err)
+	}
+	versions := make([]*publishedVersion, 0, len(names))
+	for _, name := range names {
+		version, err := c.getLatestVersion(ctx, registryID, name)
+		if err != nil {
+			return nil, err
+		}
+		if version != nil {
+			versions = append(versions, version)
+		}
+	}
+	return versions, nil
+}
+
+// getLatestVersion returns the highest version of a formula with bottles, or nil if the formula has
+// none.
+func (c *controller) getLatestVersion(ctx context.Context, registryID int64, name string) (
+	*publishedVersion,
+	error,
+) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", name, err)
+	}
+	var latest *publishedVersion
+	for i := range *artifacts {
+		version, err := toPublishedVersion(name, &(*artifacts)[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(version.Bottles) == 0 {
+			continue
+		}
+		if latest == nil || versioning.Compare(versioning.SchemeGeneric, version.version, latest.version) > 0 {
+			latest = version
+		}
+	}
+	return latest, nil
+}
+
+// getVersion returns a version of a formula, or gitnessstore.ErrResourceNotFound if no bottle was
+// uploaded for it.
+func (c *controller) getVersion(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*publishedVersion, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, name, err)
+	}
+	return toPublishedVersion(name, a)
+}
+
+func toPublishedVersion(name string, a *types.Artifact) (*publishedVersion, error) {
+	version := &publishedVersion{name: name, version: a.Version, updatedAt: a.UpdatedAt}
+	if err := json.Unmarshal(a.Metadata, &version.HomebrewMetadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+	}
+	return version, nil
+}
+
+func formulaInfo(rootURL string, v *publishedVersion) FormulaInfo {
+	f := FormulaInfo{
+		Name:     v.name,
+		Desc:     v.Formula.Desc,
+		Homepage: v.Formula.Homepage,
+		License:  v.Formula.License,
+		Versions: FormulaVersions{Stable: v.version, Bottle: true},
+	}
+	bottles := homebrew.CurrentBottles(v.Bottles)
+	spec := &BottleSpec{RootURL: rootURL, Files: make(map[string]BottleFile, len(bottles))}
+	for _, b := range bottles {
+		spec.Rebuild = b.Rebuild
+		spec.Files[b.Tag] = BottleFile{Cellar: b.Cellar, URL: rootURL + "/" + b.Filename, Sha256: b.Sha256}
+	}
+	f.Bottle.Stable = spec
+	return f
+}
+
+// bottlesURL returns the URL brew downloads the bottles of the registry from, the root_url of the
+// bottle blocks of its formulae.
+func bottlesURL(registryURL string) string {
+	return registryURL + "/bottles"
+}
+
+// filePath returns the path a file of a version is stored at.
+func filePath(name, version, filename string) string {
+	return name + "/" + version + "/" + filename
+}
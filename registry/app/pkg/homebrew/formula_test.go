@@ -0,0 +1,58 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package homebrew
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/homebrew"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormulaInfo(t *testing.T) {
+	rootURL := bottlesURL("https://pkg.example.com/root/brew/homebrew")
+	info := formulaInfo(rootURL, &publishedVersion{
+		HomebrewMetadata: database.HomebrewMetadata{
+			Formula: homebrew.Formula{Desc: "Acme client", License: "MIT"},
+			Bottles: []homebrew.Bottle{
+				{Tag: "arm64_sonoma", Cellar: homebrew.CellarAny, Filename: "acmectl--1.4.0.arm64_sonoma.bottle.tar.gz",
+					Sha256: "aa"},
+				{Tag: "arm64_sonoma", Rebuild: 1, Cellar: homebrew.CellarAny,
+					Filename: "acmectl--1.4.0.arm64_sonoma.bottle.1.tar.gz", Sha256: "bb"},
+			},
+		},
+		name:    "acmectl",
+		version: "1.4.0",
+	})
+	assert.Equal(t, FormulaInfo{
+		Name:     "acmectl",
+		Desc:     "Acme client",
+		License:  "MIT",
+		Versions: FormulaVersions{Stable: "1.4.0", Bottle: true},
+		Bottle: FormulaBottles{Stable: &BottleSpec{
+			Rebuild: 1,
+			RootURL: "https://pkg.example.com/root/brew/homebrew/bottles",
+			Files: map[string]BottleFile{
+				"arm64_sonoma": {
+					Cellar: homebrew.CellarAny,
+					URL:    rootURL + "/acmectl--1.4.0.arm64_sonoma.bottle.1.tar.gz",
+					Sha256: "bb",
+				},
+			},
+		}},
+	}, info)
+}
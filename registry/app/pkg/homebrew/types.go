@@ -0,0 +1,75 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package homebrew
+
+import (
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Name     string
+	Version  string
+	Tag      string
+	Rebuild  int
+	Filename string
+	// Cellar is the cellar an uploaded bottle was built for.
+	Cellar string
+}
+
+// FormulaInfo is the description of a formula in the index of a tap, following the formula JSON
+// API of brew.
+// Source: https://formulae.brew.sh/docs/api/
+type FormulaInfo struct {
+	Name     string          `json:"name"`
+	Desc     string          `json:"desc,omitempty"`
+	Homepage string          `json:"homepage,omitempty"`
+	License  string          `json:"license,omitempty"`
+	Versions FormulaVersions `json:"versions"`
+	Bottle   FormulaBottles  `json:"bottle"`
+}
+
+type FormulaVersions struct {
+	Stable string `json:"stable"`
+	Bottle bool   `json:"bottle"`
+}
+
+type FormulaBottles struct {
+	Stable *BottleSpec `json:"stable,omitempty"`
+}
+
+type BottleSpec struct {
+	Rebuild int                   `json:"rebuild"`
+	RootURL string                `json:"root_url"`
+	Files   map[string]BottleFile `json:"files"`
+}
+
+type BottleFile struct {
+	Cellar string `json:"cellar"`
+	URL    string `json:"url"`
+	Sha256 string `json:"sha256"`
+}
+
+// UploadedBottle is the description of an uploaded bottle.
+type UploadedBottle struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Tag      string `json:"tag"`
+	Rebuild  int    `json:"rebuild"`
+	Cellar   string `json:"cellar"`
+	URL      string `json:"url"`
+	Sha256   string `json:"sha256"`
+	Filename string `json:"filename"`
+}
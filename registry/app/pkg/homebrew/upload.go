@@ -0,0 +1,171 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package homebrew
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/homebrew"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// CellarParam is the query parameter the cellar an uploaded bottle was built for is given with.
+const CellarParam = "cellar"
+
+var errBottleExists = errors.New("bottle exists")
+
+// UploadBottle stores the bottle of a version for a platform. Bottles are immutable, as brew
+// verifies them with the checksums of the bottle block of the formula.
+func (c *controller) UploadBottle(
+	ctx context.Context,
+	info ArtifactInfo,
+	bottle io.ReaderAt,
+	size int64,
+) (*UploadedBottle, errcode.Error) {
+	if info.Cellar == "" {
+		info.Cellar = homebrew.CellarAny
+	}
+	if err := homebrew.ValidateCellar(info.Cellar); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	formula, err := homebrew.ReadBottle(io.NewSectionReader(bottle, 0, size), info.Name, info.Version)
+	if err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	if errc = c.checkBottleAbsent(ctx, registry.ID, info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+
+	filename := homebrew.BottleFilename(info.Name, info.Version, info.Tag, info.Rebuild)
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(info.Name, info.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		io.NewSectionReader(bottle, 0, size), filename)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	b := homebrew.Bottle{
+		Tag:      info.Tag,
+		Rebuild:  info.Rebuild,
+		Cellar:   info.Cellar,
+		Filename: fileInfo.Filename,
+		Size:     fileInfo.Size,
+		Sha256:   fileInfo.Sha256,
+	}
+	file := database.File{Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli()}
+	err = c.addBottle(ctx, registry.ID, info, *formula, b, file)
+	if errors.Is(err, errBottleExists) {
+		return nil, errcode.ErrCodeConflict.WithMessage(bottleExistsMessage(filename))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return &UploadedBottle{
+		Name:     info.Name,
+		Version:  info.Version,
+		Tag:      b.Tag,
+		Rebuild:  b.Rebuild,
+		Cellar:   b.Cellar,
+		URL:      bottlesURL(c.registryURL(ctx, info)) + "/" + b.Filename,
+		Sha256:   b.Sha256,
+		Filename: b.Filename,
+	}, errcode.Error{}
+}
+
+// checkBottleAbsent fails if the bottle was uploaded before, saving clients from uploading it again.
+func (c *controller) checkBottleAbsent(ctx context.Context, registryID int64, info ArtifactInfo) errcode.Error {
+	version, err := c.getVersion(ctx, registryID, info.Name, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return errcode.Error{}
+	}
+	if err != nil {
+		return errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if _, ok := homebrew.FindBottle(version.Bottles, info.Tag, info.Rebuild); ok {
+		return errcode.ErrCodeConflict.WithMessage(
+			bottleExistsMessage(homebrew.BottleFilename(info.Name, info.Version, info.Tag, info.Rebuild)))
+	}
+	return errcode.Error{}
+}
+
+// addBottle adds the uploaded bottle to the version, creating the version with the formula of the
+// bottle unless it exists.
+func (c *controller) addBottle(
+	ctx context.Context,
+	registryID int64,
+	info ArtifactInfo,
+	formula homebrew.Formula,
+	bottle homebrew.Bottle,
+	file database.File,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       info.Name,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", info.Name, err)
+			}
+
+			metadata := database.HomebrewMetadata{Formula: formula}
+			existing, err := c.artifactDao.GetByName(ctx, image.ID, info.Version)
+			if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+				return fmt.Errorf("failed to find version %s of %s: %w", info.Version, info.Name, err)
+			}
+			if existing != nil {
+				if err = json.Unmarshal(existing.Metadata, &metadata); err != nil {
+					return fmt.Errorf("failed to parse metadata of version %s: %w", info.Version, err)
+				}
+			}
+			if _, ok := homebrew.FindBottle(metadata.Bottles, bottle.Tag, bottle.Rebuild); ok {
+				return errBottleExists
+			}
+			metadata.Bottles = append(metadata.Bottles, bottle)
+			metadata.Files = append(metadata.Files, file)
+			metadata.FileCount = int64(len(metadata.Files))
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", info.Name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  info.Version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", info.Name, err)
+			}
+			return nil
+		})
+}
+
+func bottleExistsMessage(filename string) string {
+	return fmt.Sprintf("bottle %s was uploaded before", filename)
+}
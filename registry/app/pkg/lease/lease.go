@@ -0,0 +1,189 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/contextutil"
+	"github.com/harness/gitness/lock"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	namespaceJob   = "registry:job"
+	namespaceIndex = "registry:index"
+
+	// indexExpiry is how long the lease of the stored indices of a registry outlives the replica
+	// holding it if it dies. The lease is extended while the regeneration runs.
+	indexExpiry = time.Minute
+	// indexWait is how long an index regeneration waits for another one of the same registry.
+	indexWait = 30 * time.Second
+
+	// JobMaintenance is the lease shared by the jobs deleting the data of the registries, like the
+	// cleanup policies and the consistency check, so they never run concurrently.
+	JobMaintenance = "maintenance"
+	// JobGC is the lease of the garbage collector, held by the replica running it for as long as it runs.
+	JobGC = "gc"
+)
+
+var (
+	// ErrHeld is returned when a lease is held by another run, possibly on another replica.
+	ErrHeld = errors.New("lease is held by another run")
+	// ErrLost is the cause of the cancellation of the context of a lease that couldn't be extended,
+	// e.g. because it expired while the replica was unable to reach the lock provider.
+	ErrLost = errors.New("lease was lost")
+)
+
+// Locker coordinates the registry jobs and index regenerations across the replicas of a deployment
+// with leases of the distributed mutex manager. The leases expire on their own, so a replica dying
+// while holding one doesn't block the others forever.
+type Locker struct {
+	mtxManager lock.MutexManager
+}
+
+func NewLocker(mtxManager lock.MutexManager) *Locker {
+	return &Locker{
+		mtxManager: mtxManager,
+	}
+}
+
+// TryLockJob acquires the lease of a job for the given duration without waiting for it,
+// ErrHeld is returned if it's held by another run.
+func (l *Locker) TryLockJob(ctx context.Context, job string, expiry time.Duration) (func(), error) {
+	mutex, err := l.tryLockJob(ctx, job, expiry)
+	if err != nil {
+		return nil, err
+	}
+	return unlockFn(ctx, mutex), nil
+}
+
+// HoldJob acquires the lease of a job like TryLockJob, but extends it until it's released instead
+// of letting it expire. The returned context is canceled if the lease is lost, which must stop the job.
+func (l *Locker) HoldJob(ctx context.Context, job string, expiry time.Duration) (context.Context, func(), error) {
+	mutex, err := l.tryLockJob(ctx, job, expiry)
+	if err != nil {
+		return nil, nil, err
+	}
+	leaseCtx, release := hold(ctx, mutex, expiry)
+	return leaseCtx, release, nil
+}
+
+func (l *Locker) tryLockJob(ctx context.Context, job string, expiry time.Duration) (lock.Mutex, error) {
+	mutex, err := l.mtxManager.NewMutex(
+		job,
+		lock.WithNamespace(namespaceJob),
+		lock.WithExpiry(expiry),
+		lock.WithTries(1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new mutex: %w", err)
+	}
+	if err := mutex.Lock(ctx); err != nil {
+		if isHeld(err) {
+			return nil, ErrHeld
+		}
+		return nil, fmt.Errorf("failed to acquire lease of job %s: %w", job, err)
+	}
+	log.Ctx(ctx).Debug().Msgf("acquired lease of job %s (expiry: %s)", job, expiry)
+	return mutex, nil
+}
+
+// LockIndex acquires the lease of the stored indices of a registry, waiting for the regeneration
+// running on another replica, so the indices are never regenerated from a stale listing of packages.
+// The lease is extended until it's released, and the returned context is canceled if that fails,
+// so the regeneration stops writing indices once another one could have started.
+func (l *Locker) LockIndex(ctx context.Context, registryID int64) (context.Context, func(), error) {
+	key := strconv.FormatInt(registryID, 10)
+	mutex, err := l.mtxManager.NewMutex(
+		key,
+		lock.WithNamespace(namespaceIndex),
+		lock.WithExpiry(indexExpiry),
+		lock.WithTimeoutFactor(indexWait.Seconds()/indexExpiry.Seconds()),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create new mutex: %w", err)
+	}
+	if err := mutex.Lock(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to acquire lease of the indices of registry %d: %w", registryID, err)
+	}
+	leaseCtx, release := hold(ctx, mutex, indexExpiry)
+	return leaseCtx, release, nil
+}
+
+// hold extends the lease of a held mutex every third of its expiry until the returned function is
+// called, which releases it. The returned context is canceled with ErrLost if an extension fails.
+func hold(ctx context.Context, mutex lock.Mutex, expiry time.Duration) (context.Context, func()) {
+	leaseCtx, cancel := context.WithCancelCause(ctx)
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(expiry / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-leaseCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := mutex.Extend(leaseCtx); err != nil {
+				log.Ctx(ctx).Warn().Err(err).Msgf("failed to extend lease %s", mutex.Key())
+				cancel(fmt.Errorf("%w: %s: %w", ErrLost, mutex.Key(), err))
+				return
+			}
+		}
+	}()
+
+	unlock := unlockFn(ctx, mutex)
+	return leaseCtx, func() {
+		close(stop)
+		<-stopped
+		unlock()
+		cancel(nil)
+	}
+}
+
+func unlockFn(ctx context.Context, mutex lock.Mutex) func() {
+	return func() {
+		// always unlock independent of whether source context got canceled or not
+		ctx, cancel := contextutil.WithNewTimeout(ctx, 30*time.Second)
+		defer cancel()
+
+		if err := mutex.Unlock(ctx); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to release lease %s", mutex.Key())
+		}
+	}
+}
+
+func isHeld(err error) bool {
+	var lockErr *lock.Error
+	if !errors.As(err, &lockErr) {
+		return false
+	}
+	switch lockErr.Kind {
+	case lock.ErrorKindLockHeld, lock.ErrorKindCannotLock, lock.ErrorKindMaxRetriesExceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/lock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocker() *Locker {
+	return NewLocker(lock.NewInMemory(lock.Config{
+		Provider:   lock.MemoryProvider,
+		Expiry:     time.Minute,
+		Tries:      8,
+		RetryDelay: 10 * time.Millisecond,
+	}))
+}
+
+func TestTryLockJob(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLocker()
+
+	unlock, err := l.TryLockJob(ctx, JobMaintenance, time.Minute)
+	require.NoError(t, err)
+
+	_, err = l.TryLockJob(ctx, JobMaintenance, time.Minute)
+	assert.ErrorIs(t, err, ErrHeld)
+
+	unlockOther, err := l.TryLockJob(ctx, "other", time.Minute)
+	require.NoError(t, err)
+	unlockOther()
+
+	unlock()
+	unlock, err = l.TryLockJob(ctx, JobMaintenance, time.Minute)
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestTryLockJobExpired(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLocker()
+
+	_, err := l.TryLockJob(ctx, JobMaintenance, 20*time.Millisecond)
+	require.NoError(t, err)
+
+	time.Sleep(50 * time.Millisecond)
+	unlock, err := l.TryLockJob(ctx, JobMaintenance, time.Minute)
+	require.NoError(t, err, "an expired lease is acquired by the next run")
+	unlock()
+}
+
+func TestLockIndex(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLocker()
+
+	_, unlock, err := l.LockIndex(ctx, 1)
+	require.NoError(t, err)
+
+	_, unlockOther, err := l.LockIndex(ctx, 2)
+	require.NoError(t, err)
+	unlockOther()
+
+	acquired := make(chan error, 1)
+	go func() {
+		_, unlock, err := l.LockIndex(ctx, 1)
+		if err == nil {
+			unlock()
+		}
+		acquired <- err
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("the lease of the indices of a registry is acquired while held")
+	case <-time.After(30 * time.Millisecond):
+	}
+	unlock()
+	assert.NoError(t, <-acquired, "the lease is acquired once released")
+}
+
+func TestHoldExtendsLease(t *testing.T) {
+	ctx := context.Background()
+	manager := lock.NewInMemory(lock.Config{Expiry: 60 * time.Millisecond, Tries: 1})
+
+	mutex, err := manager.NewMutex("index")
+	require.NoError(t, err)
+	require.NoError(t, mutex.Lock(ctx))
+	leaseCtx, release := hold(ctx, mutex, 60*time.Millisecond)
+
+	time.Sleep(200 * time.Millisecond)
+	require.NoError(t, leaseCtx.Err(), "the lease is kept while held")
+	other, err := manager.NewMutex("index")
+	require.NoError(t, err)
+	require.Error(t, other.Lock(ctx), "the lease is held past its expiry")
+
+	release()
+	assert.ErrorIs(t, leaseCtx.Err(), context.Canceled)
+	require.NoError(t, other.Lock(ctx), "the lease is acquired once released")
+	require.NoError(t, other.Unlock(ctx))
+}
+
+// lostMutex is a mutex whose lease can't be extended.
+type lostMutex struct {
+	lock.Mutex
+}
+
+func (lostMutex) Extend(context.Context) error {
+	return errors.New("lease expired")
+}
+
+func TestHoldCancelsLostLease(t *testing.T) {
+	ctx := context.Background()
+	manager := lock.NewInMemory(lock.Config{Expiry: 30 * time.Millisecond, Tries: 1})
+
+	mutex, err := manager.NewMutex("index")
+	require.NoError(t, err)
+	require.NoError(t, mutex.Lock(ctx))
+	leaseCtx, release := hold(ctx, lostMutex{Mutex: mutex}, 30*time.Millisecond)
+	defer release()
+
+	select {
+	case <-leaseCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("the context of a lost lease isn't canceled")
+	}
+	assert.ErrorIs(t, context.Cause(leaseCtx), ErrLost)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// ErrLegalHold is returned by deletes of packages and versions under a legal hold.
+var ErrLegalHold = errors.New("deletion is blocked by a legal hold")
+
+// CheckLegalHold returns an error wrapping ErrLegalHold if a legal hold of the registry blocks deleting the
+// versions of the image. Without versions the image is checked as a whole, and without an image the
+// registry is, see store.LegalHoldRepository.FindBlocking.
+func CheckLegalHold(
+	ctx context.Context,
+	legalHoldStore store.LegalHoldRepository,
+	registryID int64,
+	imageName string,
+	versions ...string,
+) error {
+	hold, err := legalHoldStore.FindBlocking(ctx, registryID, imageName, versions)
+	if err != nil {
+		return fmt.Errorf("failed to check legal holds: %w", err)
+	}
+	if hold == nil {
+		return nil
+	}
+	if hold.Version == "" {
+		return fmt.Errorf("%w on package %s: %s", ErrLegalHold, hold.ImageName, hold.Reason)
+	}
+	return fmt.Errorf("%w on version %s of package %s: %s", ErrLegalHold, hold.Version, hold.ImageName, hold.Reason)
+}
+
+// ManifestVersions returns the versions a legal hold may hold an image manifest by: the tag or digest it's
+// referenced by, along with its digest if referenced by a tag, or its tags if referenced by its digest.
+// Unknown references are returned as they are.
+func ManifestVersions(
+	ctx context.Context,
+	manifestStore store.ManifestRepository,
+	tagStore store.TagRepository,
+	registryID int64,
+	imageName string,
+	reference string,
+) ([]string, error) {
+	d, err := digest.Parse(reference)
+	if err != nil {
+		m, err := manifestStore.FindManifestByTagName(ctx, registryID, imageName, reference)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			return []string{reference}, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find manifest of tag %s: %w", reference, err)
+		}
+		return []string{reference, m.Digest.String()}, nil
+	}
+
+	dgst, err := types.NewDigest(d)
+	if err != nil {
+		return nil, err
+	}
+	m, err := manifestStore.FindManifestByDigest(ctx, registryID, imageName, dgst)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return []string{reference}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find manifest %s: %w", reference, err)
+	}
+	tags, err := tagStore.GetTagNamesByManifestIDs(ctx, registryID, []int64{m.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags of manifest %s: %w", reference, err)
+	}
+	return append([]string{reference}, tags[m.ID]...), nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
 	registrytypes "github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types/enum"
@@ -52,14 +53,17 @@ type Controller struct {
 }
 
 type DBStore struct {
-	RegistryDao      store.RegistryRepository
-	ImageDao         store.ImageRepository
-	ArtifactDao      store.ArtifactRepository
-	SpaceStore       corestore.SpaceStore
-	BandwidthStatDao store.BandwidthStatRepository
-	DownloadStatDao  store.DownloadStatRepository
-	NodeDao          store.NodesRepository
-	UpstreamProxyDao store.UpstreamProxyConfigRepository
+	RegistryDao          store.RegistryRepository
+	ImageDao             store.ImageRepository
+	ArtifactDao          store.ArtifactRepository
+	SpaceStore           corestore.SpaceStore
+	BandwidthStatDao     store.BandwidthStatRepository
+	DownloadStatRecorder *downloadstats.Recorder
+	NodeDao              store.NodesRepository
+	UpstreamProxyDao     store.UpstreamProxyConfigRepository
+	MavenRelocationDao   store.MavenRelocationRepository
+	PackageRuleDao       store.PackageRuleRepository
+	UpstreamURLDao       store.UpstreamURLRepository
 }
 
 func NewController(
@@ -86,19 +90,25 @@ func NewDBStore(
 	artifactDao store.ArtifactRepository,
 	spaceStore corestore.SpaceStore,
 	bandwidthStatDao store.BandwidthStatRepository,
-	downloadStatDao store.DownloadStatRepository,
+	downloadStatRecorder *downloadstats.Recorder,
 	nodeDao store.NodesRepository,
 	upstreamProxyDao store.UpstreamProxyConfigRepository,
+	mavenRelocationDao store.MavenRelocationRepository,
+	packageRuleDao store.PackageRuleRepository,
+	upstreamURLDao store.UpstreamURLRepository,
 ) *DBStore {
 	return &DBStore{
-		RegistryDao:      registryDao,
-		SpaceStore:       spaceStore,
-		ImageDao:         imageDao,
-		ArtifactDao:      artifactDao,
-		BandwidthStatDao: bandwidthStatDao,
-		DownloadStatDao:  downloadStatDao,
-		NodeDao:          nodeDao,
-		UpstreamProxyDao: upstreamProxyDao,
+		RegistryDao:          registryDao,
+		SpaceStore:           spaceStore,
+		ImageDao:             imageDao,
+		ArtifactDao:          artifactDao,
+		BandwidthStatDao:     bandwidthStatDao,
+		DownloadStatRecorder: downloadStatRecorder,
+		NodeDao:              nodeDao,
+		UpstreamProxyDao:     upstreamProxyDao,
+		MavenRelocationDao:   mavenRelocationDao,
+		PackageRuleDao:       packageRuleDao,
+		UpstreamURLDao:       upstreamURLDao,
 	}
 }
 
@@ -131,10 +141,14 @@ func (c *Controller) GetArtifact(ctx context.Context, info pkg.MavenArtifactInfo
 			Errors: []error{errcode.ErrCodeDenied},
 		}
 	}
+	if response, ok := c.getRelocatedArtifact(ctx, info); ok {
+		return response
+	}
 
 	f := func(registry registrytypes.Registry, a Artifact) Response {
 		info.SetMavenRepoKey(registry.Name)
 		info.RegistryID = registry.ID
+		info.StoragePrefix = registry.StoragePrefix
 		headers, body, fileReader, redirectURL, e := a.(Registry).GetArtifact(ctx, info)
 		return &GetArtifactResponse{e, headers, redirectURL,
 			body, fileReader}
@@ -152,10 +166,14 @@ func (c *Controller) HeadArtifact(ctx context.Context, info pkg.MavenArtifactInf
 			Errors: []error{errcode.ErrCodeDenied},
 		}
 	}
+	if response, ok := c.headRelocatedArtifact(ctx, info); ok {
+		return response
+	}
 
 	f := func(registry registrytypes.Registry, a Artifact) Response {
 		info.SetMavenRepoKey(registry.Name)
 		info.RegistryID = registry.ID
+		info.StoragePrefix = registry.StoragePrefix
 		headers, e := a.(Registry).HeadArtifact(ctx, info)
 		return &HeadArtifactResponse{e, headers}
 	}
@@ -222,11 +240,8 @@ func (c *Controller) GetOrderedRepos(
 	var result []registrytypes.Registry
 	if registry, err := c.DBStore.RegistryDao.GetByParentIDAndName(ctx, artInfo.ParentID, repoKey); err == nil {
 		result = append(result, *registry)
-		proxies := registry.UpstreamProxies
-		if len(proxies) > 0 {
-			upstreamRepos, _ := c.DBStore.RegistryDao.GetByIDIn(ctx, proxies)
-			result = append(result, *upstreamRepos...)
-		}
+		upstreamRepos, _ := pkg.GetUpstreamRegistries(ctx, c.DBStore.RegistryDao, registry)
+		result = append(result, upstreamRepos...)
 	} else {
 		return result, err
 	}
@@ -15,9 +15,12 @@
 package maven
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -32,6 +35,8 @@ import (
 	"github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -77,48 +82,113 @@ func (r *LocalRegistry) FetchArtifact(ctx context.Context, info pkg.MavenArtifac
 	readCloser io.ReadCloser,
 	redirectURL string,
 	errs []error) {
+	filePath, fileInfo, err := r.getFileMetadata(ctx, info)
+	if err != nil {
+		if isNotFoundError(err) {
+			if headers, checksum, ok := r.generateChecksumFile(ctx, info); ok {
+				if serveFile {
+					readCloser = io.NopCloser(strings.NewReader(checksum))
+				}
+				return headers, nil, readCloser, "", nil
+			}
+		}
+		return processError(err)
+	}
+	var fileReader *storage.FileReader
+	if serveFile {
+		fileReader, _, redirectURL, err = r.fileManager.DownloadFile(ctx, filePath, types.Registry{
+			ID:   info.RegistryID,
+			Name: info.RootIdentifier,
+		}, info.StorageRoot())
+		if err != nil {
+			return processError(err)
+		}
+	}
+	responseHeaders = utils.SetHeaders(info, fileInfo)
+	return responseHeaders, fileReader, nil, redirectURL, nil
+}
+
+// getFileMetadata returns the path and the metadata of the requested file of a stored version.
+func (r *LocalRegistry) getFileMetadata(ctx context.Context, info pkg.MavenArtifactInfo) (
+	string, pkg.FileInfo, error,
+) {
 	filePath := utils.GetFilePath(info)
 	name := info.GroupID + ":" + info.ArtifactID
-	dbImage, err2 := r.DBStore.ImageDao.GetByName(ctx, info.RegistryID, name)
-	if err2 != nil {
-		return processError(err2)
+	dbImage, err := r.DBStore.ImageDao.GetByName(ctx, info.RegistryID, name)
+	if err != nil {
+		return "", pkg.FileInfo{}, err
 	}
 	if utils.IsSnapshotVersion(info) {
 		node, err := r.DBStore.NodeDao.FindByPathAndRegistryID(ctx, info.RegistryID, utils.AddLikeBeforeExtension(info))
 		if err != nil {
-			return processError(err)
+			return "", pkg.FileInfo{}, err
 		}
 		info.FileName = node.Name
 		filePath = utils.GetFilePath(info)
 	}
-	_, err2 = r.DBStore.ArtifactDao.GetByName(ctx, dbImage.ID, info.Version)
-	if err2 != nil {
-		return processError(err2)
+	_, err = r.DBStore.ArtifactDao.GetByName(ctx, dbImage.ID, info.Version)
+	if err != nil {
+		return "", pkg.FileInfo{}, err
 	}
 
 	fileInfo, err := r.fileManager.GetFileMetadata(ctx, filePath, info.RegistryID)
 	if err != nil {
-		return processError(err)
+		return "", pkg.FileInfo{}, err
 	}
-	var fileReader *storage.FileReader
-	if serveFile {
-		fileReader, _, redirectURL, err = r.fileManager.DownloadFile(ctx, filePath, types.Registry{
-			ID:   info.RegistryID,
-			Name: info.RootIdentifier,
-		}, info.RootIdentifier)
-		if err != nil {
-			return processError(err)
-		}
+	return filePath, fileInfo, nil
+}
+
+// generateChecksumFile returns the checksum sidecar file of a stored file from the checksum
+// recorded when the file was uploaded, for publishers that don't upload the sidecar files.
+func (r *LocalRegistry) generateChecksumFile(ctx context.Context, info pkg.MavenArtifactInfo) (
+	*commons.ResponseHeaders, string, bool,
+) {
+	base, ext, ok := pkg.SplitChecksumFile(info.FileName)
+	if !ok {
+		return nil, "", false
 	}
-	responseHeaders = utils.SetHeaders(info, fileInfo)
-	return responseHeaders, fileReader, nil, redirectURL, nil
+	baseInfo := info
+	baseInfo.FileName = base
+	_, fileInfo, err := r.getFileMetadata(ctx, baseInfo)
+	if err != nil {
+		return nil, "", false
+	}
+	checksum := fileInfo.Checksum(ext)
+	if checksum == "" {
+		return nil, "", false
+	}
+	fileInfo.Size = int64(len(checksum))
+	fileInfo.Filename = info.FileName
+	return utils.SetHeaders(info, fileInfo), checksum, true
 }
 
 func (r *LocalRegistry) PutArtifact(ctx context.Context, info pkg.MavenArtifactInfo, fileReader io.Reader) (
 	responseHeaders *commons.ResponseHeaders, errs []error) {
+	if info.StrictMetadata && utils.IsPomFile(info) {
+		pomReader, err := checkCentralMetadata(fileReader)
+		if err != nil {
+			return responseHeaders, []error{err}
+		}
+		fileReader = pomReader
+	}
+	if info.CreateOnly && info.Version != "" {
+		claimed, err := r.claimFile(ctx, info)
+		if err != nil {
+			if errors.Is(err, pkg.ErrVersionExists) {
+				return responseHeaders, []error{commons.PreconditionFailedError(err.Error(), err)}
+			}
+			return responseHeaders, []error{errcode.ErrCodeUnknown.WithDetail(err)}
+		}
+		defer func() {
+			if len(errs) > 0 {
+				r.releaseFile(ctx, info, claimed)
+			}
+		}()
+	}
+
 	filePath := utils.GetFilePath(info)
 	fileInfo, err := r.fileManager.UploadFile(ctx, filePath, info.RegIdentifier,
-		info.RegistryID, info.RootParentID, info.RootIdentifier, nil, fileReader, info.FileName)
+		info.RegistryID, info.RootParentID, info.StorageRoot(), nil, fileReader, info.FileName)
 	if err != nil {
 		return responseHeaders, []error{errcode.ErrCodeUnknown.WithDetail(err)}
 	}
@@ -183,6 +253,129 @@ func (r *LocalRegistry) PutArtifact(ctx context.Context, info pkg.MavenArtifactI
 	return responseHeaders, nil
 }
 
+// checkCentralMetadata reads a POM and fails if it lacks metadata Maven Central requires, returning a
+// reader of the POM to upload.
+func checkCentralMetadata(fileReader io.Reader) (io.Reader, error) {
+	data, err := io.ReadAll(io.LimitReader(fileReader, utils.MaxPomSize+1))
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(data) > utils.MaxPomSize {
+		return nil, commons.BadRequestError("pom exceeds the maximum size", nil)
+	}
+	missing, err := utils.MissingCentralMetadata(data)
+	if err != nil {
+		return nil, commons.BadRequestError(err.Error(), err)
+	}
+	if len(missing) > 0 {
+		return nil, commons.BadRequestError(
+			"pom is missing metadata required by the registry: "+strings.Join(missing, ", "), missing,
+		)
+	}
+	return bytes.NewReader(data), nil
+}
+
+// claimFile records the file of a create-only upload in its version before the file is uploaded. It
+// fails with pkg.ErrVersionExists if the version already has the file. The first file of a new version
+// claims the version itself, so of several concurrent create-only uploads of that file exactly one
+// succeeds, while the other files of the version can still be uploaded after it.
+func (r *LocalRegistry) claimFile(ctx context.Context, info pkg.MavenArtifactInfo) (*types.Artifact, error) {
+	metadataJSON, err := json.Marshal(&database.MavenMetadata{
+		Files:     []database.File{{Filename: info.FileName, CreatedAt: time.Now().UnixMilli()}},
+		FileCount: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	artifact := &types.Artifact{
+		Version:  info.Version,
+		Metadata: metadataJSON,
+	}
+	err = pkg.ClaimArtifactVersion(ctx, r.tx, r.DBStore.ImageDao, r.DBStore.ArtifactDao, &types.Image{
+		Name:       info.GroupID + ":" + info.ArtifactID,
+		RegistryID: info.RegistryID,
+		Enabled:    true,
+	}, artifact)
+	if !errors.Is(err, pkg.ErrVersionExists) {
+		if err != nil {
+			return nil, err
+		}
+		return artifact, nil
+	}
+
+	err = r.tx.WithTx(ctx, func(ctx context.Context) error {
+		dbArtifact, err := r.getArtifact(ctx, info)
+		if err != nil {
+			return err
+		}
+		metadata := &database.MavenMetadata{}
+		if err = json.Unmarshal(dbArtifact.Metadata, metadata); err != nil {
+			return err
+		}
+		for _, file := range metadata.Files {
+			if file.Filename == info.FileName {
+				return fmt.Errorf("%w: %s:%s@%s/%s", pkg.ErrVersionExists, info.GroupID, info.ArtifactID,
+					info.Version, info.FileName)
+			}
+		}
+		metadata.Files = append(metadata.Files, database.File{Filename: info.FileName,
+			CreatedAt: time.Now().UnixMilli()})
+		metadata.FileCount++
+		if dbArtifact.Metadata, err = json.Marshal(metadata); err != nil {
+			return err
+		}
+		artifact = dbArtifact
+		return r.DBStore.ArtifactDao.CreateOrUpdate(ctx, dbArtifact)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
+
+// releaseFile removes a file claimed with claimFile whose upload failed from its version, so that it
+// can be uploaded again, and the version with it once it has no files left.
+func (r *LocalRegistry) releaseFile(ctx context.Context, info pkg.MavenArtifactInfo, claimed *types.Artifact) {
+	err := r.tx.WithTx(ctx, func(ctx context.Context) error {
+		dbArtifact, err := r.DBStore.ArtifactDao.GetByName(ctx, claimed.ImageID, info.Version)
+		if err != nil {
+			return err
+		}
+		metadata := &database.MavenMetadata{}
+		if err = json.Unmarshal(dbArtifact.Metadata, metadata); err != nil {
+			return err
+		}
+		files := make([]database.File, 0, len(metadata.Files))
+		for _, file := range metadata.Files {
+			if file.Filename != info.FileName {
+				files = append(files, file)
+			}
+		}
+		if len(files) == 0 {
+			return r.DBStore.ArtifactDao.DeleteByID(ctx, dbArtifact.ID)
+		}
+		metadata.Files = files
+		metadata.FileCount = int64(len(files))
+		if dbArtifact.Metadata, err = json.Marshal(metadata); err != nil {
+			return err
+		}
+		return r.DBStore.ArtifactDao.CreateOrUpdate(ctx, dbArtifact)
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to release claimed file %s of artifact version %s",
+			info.FileName, info.Version)
+	}
+}
+
+// getArtifact returns the version of the artifact of a request.
+func (r *LocalRegistry) getArtifact(ctx context.Context, info pkg.MavenArtifactInfo) (*types.Artifact, error) {
+	image, err := r.DBStore.ImageDao.GetByName(ctx, info.RegistryID, info.GroupID+":"+info.ArtifactID)
+	if err != nil {
+		return nil, err
+	}
+	return r.DBStore.ArtifactDao.GetByName(ctx, image.ID, info.Version)
+}
+
 func (r *LocalRegistry) updateArtifactMetadata(dbArtifact *types.Artifact, metadata *database.MavenMetadata,
 	info pkg.MavenArtifactInfo, fileInfo pkg.FileInfo) error {
 	var files []database.File
@@ -193,8 +386,9 @@ func (r *LocalRegistry) updateArtifactMetadata(dbArtifact *types.Artifact, metad
 		}
 		fileExist := false
 		files = metadata.Files
-		for _, file := range files {
-			if file.Filename == info.FileName {
+		for i := range files {
+			if files[i].Filename == info.FileName {
+				files[i].Size = fileInfo.Size
 				fileExist = true
 			}
 		}
@@ -216,10 +410,14 @@ func (r *LocalRegistry) updateArtifactMetadata(dbArtifact *types.Artifact, metad
 func processError(err error) (
 	responseHeaders *commons.ResponseHeaders, body *storage.FileReader, readCloser io.ReadCloser,
 	redirectURL string, errs []error) {
-	if strings.Contains(err.Error(), sql.ErrNoRows.Error()) ||
-		strings.Contains(err.Error(), "resource not found") ||
-		strings.Contains(err.Error(), "http status code: 404") {
+	if isNotFoundError(err) {
 		return responseHeaders, nil, nil, "", []error{commons.NotFoundError(err.Error(), err)}
 	}
 	return responseHeaders, nil, nil, "", []error{errcode.ErrCodeUnknown.WithDetail(err)}
 }
+
+func isNotFoundError(err error) bool {
+	return strings.Contains(err.Error(), sql.ErrNoRows.Error()) ||
+		strings.Contains(err.Error(), "resource not found") ||
+		strings.Contains(err.Error(), "http status code: 404")
+}
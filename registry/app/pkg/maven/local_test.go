@@ -0,0 +1,263 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maven
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"testing/iotest"
+
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...interface{}) error {
+	return txFn(ctx)
+}
+
+type fakeImageRepository struct {
+	store.ImageRepository
+	mu     sync.Mutex
+	images map[string]*types.Image
+}
+
+func (f *fakeImageRepository) CreateOrUpdate(_ context.Context, image *types.Image) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if existing, ok := f.images[image.Name]; ok {
+		image.ID = existing.ID
+		return nil
+	}
+	image.ID = int64(len(f.images) + 1)
+	f.images[image.Name] = image
+	return nil
+}
+
+func (f *fakeImageRepository) GetByName(_ context.Context, _ int64, name string) (*types.Image, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	image, ok := f.images[name]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return image, nil
+}
+
+type fakeArtifactRepository struct {
+	store.ArtifactRepository
+	mu        sync.Mutex
+	nextID    int64
+	artifacts map[string]types.Artifact
+}
+
+func artifactKey(imageID int64, version string) string {
+	return fmt.Sprintf("%d@%s", imageID, version)
+}
+
+func (f *fakeArtifactRepository) GetByName(_ context.Context, imageID int64, version string) (
+	*types.Artifact, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	artifact, ok := f.artifacts[artifactKey(imageID, version)]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return &artifact, nil
+}
+
+func (f *fakeArtifactRepository) Create(_ context.Context, artifact *types.Artifact) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := artifactKey(artifact.ImageID, artifact.Version)
+	if _, ok := f.artifacts[key]; ok {
+		return gitnessstore.ErrDuplicate
+	}
+	f.nextID++
+	artifact.ID = f.nextID
+	f.artifacts[key] = *artifact
+	return nil
+}
+
+func (f *fakeArtifactRepository) CreateOrUpdate(_ context.Context, artifact *types.Artifact) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := artifactKey(artifact.ImageID, artifact.Version)
+	if existing, ok := f.artifacts[key]; ok {
+		artifact.ID = existing.ID
+	} else {
+		f.nextID++
+		artifact.ID = f.nextID
+	}
+	f.artifacts[key] = *artifact
+	return nil
+}
+
+func (f *fakeArtifactRepository) DeleteByID(_ context.Context, id int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for key, artifact := range f.artifacts {
+		if artifact.ID == id {
+			delete(f.artifacts, key)
+		}
+	}
+	return nil
+}
+
+func (f *fakeArtifactRepository) files(t *testing.T) []string {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var files []string
+	for _, artifact := range f.artifacts {
+		metadata := &database.MavenMetadata{}
+		require.NoError(t, json.Unmarshal(artifact.Metadata, metadata))
+		for _, file := range metadata.Files {
+			files = append(files, file.Filename)
+		}
+		assert.EqualValues(t, len(metadata.Files), metadata.FileCount)
+	}
+	return files
+}
+
+func newTestLocalRegistry(t *testing.T) (*LocalRegistry, *fakeArtifactRepository) {
+	t.Helper()
+	d := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+	storageService, err := storage.NewStorageService(d)
+	require.NoError(t, err)
+	app := filemanager.NewApp(context.Background(), &gitnesstypes.Config{}, storageService)
+	artifacts := &fakeArtifactRepository{artifacts: map[string]types.Artifact{}}
+	dbStore := &DBStore{
+		ImageDao:    &fakeImageRepository{images: map[string]*types.Image{}},
+		ArtifactDao: artifacts,
+	}
+	fileManager := filemanager.NewFileManager(app, nil, nil, nil, fakeTransactor{})
+	return &LocalRegistry{DBStore: dbStore, tx: fakeTransactor{}, fileManager: fileManager}, artifacts
+}
+
+func createOnlyInfo(fileName string) pkg.MavenArtifactInfo {
+	return pkg.MavenArtifactInfo{
+		BaseInfo:      &pkg.BaseInfo{RootIdentifier: "acme", RootParentID: 1},
+		RegIdentifier: "maven-local",
+		RegistryID:    2,
+		GroupID:       "com.acme",
+		ArtifactID:    "app",
+		Version:       "1.0",
+		FileName:      fileName,
+		CreateOnly:    true,
+	}
+}
+
+func TestClaimFileClaimsEachFileOfVersion(t *testing.T) {
+	ctx := context.Background()
+	r, artifacts := newTestLocalRegistry(t)
+
+	_, err := r.claimFile(ctx, createOnlyInfo("app-1.0.jar"))
+	require.NoError(t, err)
+	_, err = r.claimFile(ctx, createOnlyInfo("app-1.0.pom"))
+	require.NoError(t, err, "the other files of a claimed version can be uploaded")
+	_, err = r.claimFile(ctx, createOnlyInfo("app-1.0.jar.sha1"))
+	require.NoError(t, err)
+
+	_, err = r.claimFile(ctx, createOnlyInfo("app-1.0.jar"))
+	require.ErrorIs(t, err, pkg.ErrVersionExists)
+	assert.ElementsMatch(t, []string{"app-1.0.jar", "app-1.0.pom", "app-1.0.jar.sha1"}, artifacts.files(t))
+}
+
+func TestClaimFileConcurrently(t *testing.T) {
+	ctx := context.Background()
+	r, artifacts := newTestLocalRegistry(t)
+
+	const uploads = 8
+	errs := make([]error, uploads)
+	var wg sync.WaitGroup
+	for i := range uploads {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = r.claimFile(ctx, createOnlyInfo("app-1.0.jar"))
+		}()
+	}
+	wg.Wait()
+
+	claimed := 0
+	for _, err := range errs {
+		if err == nil {
+			claimed++
+			continue
+		}
+		require.ErrorIs(t, err, pkg.ErrVersionExists)
+	}
+	assert.Equal(t, 1, claimed, "exactly one of the concurrent uploads claims the file")
+	assert.Equal(t, []string{"app-1.0.jar"}, artifacts.files(t))
+}
+
+func TestPutArtifactCreateOnlyFileExists(t *testing.T) {
+	ctx := context.Background()
+	r, _ := newTestLocalRegistry(t)
+	_, err := r.claimFile(ctx, createOnlyInfo("app-1.0.jar"))
+	require.NoError(t, err)
+
+	_, errs := r.PutArtifact(ctx, createOnlyInfo("app-1.0.jar"), iotest.ErrReader(errors.New("not read")))
+	require.Len(t, errs, 1)
+	var userErr *commons.Error
+	require.ErrorAs(t, errs[0], &userErr)
+	assert.Equal(t, http.StatusPreconditionFailed, userErr.Status)
+}
+
+func TestPutArtifactReleasesClaimWhenUploadFails(t *testing.T) {
+	ctx := context.Background()
+	failing := iotest.ErrReader(errors.New("connection reset"))
+
+	t.Run("new version", func(t *testing.T) {
+		r, artifacts := newTestLocalRegistry(t)
+		_, errs := r.PutArtifact(ctx, createOnlyInfo("app-1.0.jar"), failing)
+		require.NotEmpty(t, errs)
+		assert.Empty(t, artifacts.artifacts, "the claimed version is removed")
+
+		_, err := r.claimFile(ctx, createOnlyInfo("app-1.0.jar"))
+		require.NoError(t, err, "the file can be uploaded again")
+	})
+
+	t.Run("existing version", func(t *testing.T) {
+		r, artifacts := newTestLocalRegistry(t)
+		_, err := r.claimFile(ctx, createOnlyInfo("app-1.0.pom"))
+		require.NoError(t, err)
+
+		_, errs := r.PutArtifact(ctx, createOnlyInfo("app-1.0.jar"), failing)
+		require.NotEmpty(t, errs)
+		assert.Equal(t, []string{"app-1.0.pom"}, artifacts.files(t), "only the failed file is released")
+
+		_, err = r.claimFile(ctx, createOnlyInfo("app-1.0.jar"))
+		require.NoError(t, err, "the file can be uploaded again")
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maven
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/maven/utils"
+	"github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+// getRelocatedFile returns the relocation POM, or a checksum of it, when the requested file is the
+// POM of a version of an artifact the registry relocated. It reports false for other files.
+func (c *Controller) getRelocatedFile(
+	ctx context.Context,
+	info pkg.MavenArtifactInfo,
+) (*commons.ResponseHeaders, []byte, bool, error) {
+	if info.Version == "" || !utils.IsPomOrChecksumFile(info.FileName) {
+		return nil, nil, false, nil
+	}
+	relocation, err := c.DBStore.MavenRelocationDao.Get(ctx, info.RegistryID, info.GroupID, info.ArtifactID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, nil, false, nil
+	}
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to get relocation of %s:%s: %w", info.GroupID,
+			info.ArtifactID, err)
+	}
+	content, contentType, err := utils.RelocatedFile(info, relocation)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	log.Ctx(ctx).Info().Msgf("Serving relocation of %s:%s:%s to %s:%s", info.GroupID, info.ArtifactID,
+		info.Version, relocation.TargetGroupID, relocation.TargetArtifactID)
+	return &commons.ResponseHeaders{
+		Headers: map[string]string{
+			"Content-Type": contentType,
+			"Filename":     info.FileName,
+		},
+		Code: http.StatusOK,
+	}, content, true, nil
+}
+
+func (c *Controller) getRelocatedArtifact(ctx context.Context, info pkg.MavenArtifactInfo) (Response, bool) {
+	headers, content, ok, err := c.getRelocatedFile(ctx, info)
+	if err != nil {
+		return &GetArtifactResponse{Errors: []error{err}}, true
+	}
+	if !ok {
+		return nil, false
+	}
+	return &GetArtifactResponse{
+		ResponseHeaders: headers,
+		ReadCloser:      io.NopCloser(bytes.NewReader(content)),
+	}, true
+}
+
+func (c *Controller) headRelocatedArtifact(ctx context.Context, info pkg.MavenArtifactInfo) (Response, bool) {
+	headers, content, ok, err := c.getRelocatedFile(ctx, info)
+	if err != nil {
+		return &HeadArtifactResponse{Errors: []error{err}}, true
+	}
+	if !ok {
+		return nil, false
+	}
+	headers.Headers["Content-Length"] = fmt.Sprintf("%d", len(content))
+	return &HeadArtifactResponse{ResponseHeaders: headers}, true
+}
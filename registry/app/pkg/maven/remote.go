@@ -17,11 +17,15 @@ package maven
 import (
 	"context"
 	"io"
+	"strings"
 
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/maven/utils"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy"
 	"github.com/harness/gitness/registry/app/remote/controller/proxy/maven"
 	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/rs/zerolog/log"
@@ -29,6 +33,7 @@ import (
 
 const (
 	ArtifactTypeRemoteRegistry = "Remote Registry"
+	mavenMetadataFile          = "maven-metadata.xml"
 )
 
 func NewRemoteRegistry(dBStore *DBStore, tx dbtx.Transactor, local *LocalRegistry,
@@ -75,20 +80,57 @@ func (r *RemoteRegistry) FetchArtifact(ctx context.Context, info pkg.MavenArtifa
 	redirectURL string, errs []error) {
 	log.Ctx(ctx).Info().Msgf("Maven Proxy: %s", info.RegIdentifier)
 
-	responseHeaders, body, redirectURL, useLocal := r.proxyController.UseLocalFile(ctx, info)
-	if useLocal {
-		return responseHeaders, body, readCloser, redirectURL, errs
-	}
-
 	upstreamProxy, err := r.DBStore.UpstreamProxyDao.GetByRegistryIdentifier(ctx, info.ParentID, info.RegIdentifier)
 	if err != nil {
 		return processError(err)
 	}
+	proxy.FailOver(ctx, r.DBStore.UpstreamURLDao, upstreamProxy)
+	err = proxy.CheckPackageRules(ctx, r.DBStore.PackageRuleDao, *upstreamProxy, proxy.PackageCoordinate{
+		Group:   info.GroupID,
+		Name:    info.ArtifactID,
+		Version: info.Version,
+	})
+	if err != nil {
+		return processError(err)
+	}
+
+	responseHeaders, body, redirectURL, useLocal := r.proxyController.UseLocalFile(ctx, info)
+	if useLocal && (upstreamProxy.Offline || isCachedFileFresh(*upstreamProxy, info)) {
+		return responseHeaders, body, readCloser, redirectURL, errs
+	}
+	if upstreamProxy.Offline {
+		return responseHeaders, nil, nil, "", []error{commons.NotFoundError(
+			"registry "+info.RegIdentifier+" is offline, file "+info.FileName+" isn't cached", nil)}
+	}
 
 	// This is start of proxy Code.
-	responseHeaders, readCloser, err = r.proxyController.ProxyFile(ctx, info, *upstreamProxy, serveFile)
+	proxyHeaders, readCloser, err := r.proxyController.ProxyFile(ctx, info, *upstreamProxy, serveFile)
 	if err != nil {
+		if useLocal {
+			log.Ctx(ctx).Warn().Err(err).Msgf("serving file %s cached from upstream %s", info.FileName,
+				info.RegIdentifier)
+			return responseHeaders, body, nil, redirectURL, errs
+		}
 		return processError(err)
 	}
-	return responseHeaders, nil, readCloser, "", errs
+	if body != nil {
+		_ = body.Close()
+	}
+	if serveFile {
+		proxy.Fetches.Put(upstreamProxy.RegistryID, utils.GetFilePath(info), nil)
+	}
+	return proxyHeaders, nil, readCloser, "", errs
+}
+
+// isCachedFileFresh reports whether the cached file is served without fetching it from the upstream again.
+// Metadata files expire after the metadata TTL of the proxy, other files after the artifact TTL if it's set.
+func isCachedFileFresh(upstreamProxy types.UpstreamProxy, info pkg.MavenArtifactInfo) bool {
+	ttl := upstreamProxy.ArtifactTTL
+	if strings.HasPrefix(info.FileName, mavenMetadataFile) {
+		ttl = upstreamProxy.MetadataTTL
+	} else if ttl == 0 {
+		return true
+	}
+	_, fresh := proxy.Fetches.Get(upstreamProxy.RegistryID, utils.GetFilePath(info), ttl)
+	return fresh
 }
@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+// MaxPomSize is the largest POM read into memory to check its metadata.
+const MaxPomSize = 10 << 20
+
+// Pom holds the parts of a project object model Maven Central requires to be present.
+type Pom struct {
+	XMLName     xml.Name `xml:"project"`
+	Name        string   `xml:"name"`
+	Description string   `xml:"description"`
+	Licenses    []struct {
+		Name string `xml:"name"`
+		URL  string `xml:"url"`
+	} `xml:"licenses>license"`
+	SCM struct {
+		URL                 string `xml:"url"`
+		Connection          string `xml:"connection"`
+		DeveloperConnection string `xml:"developerConnection"`
+	} `xml:"scm"`
+}
+
+// IsPomFile reports whether the file of an upload is a POM, not one of its checksums.
+func IsPomFile(info pkg.MavenArtifactInfo) bool {
+	return strings.EqualFold(filepath.Ext(info.FileName), extensionPom)
+}
+
+// MissingCentralMetadata returns the metadata Maven Central requires that the POM lacks. Values
+// inherited from a parent POM are not resolved, they must be declared by the POM itself.
+func MissingCentralMetadata(data []byte) ([]string, error) {
+	var pom Pom
+	if err := xml.Unmarshal(data, &pom); err != nil {
+		return nil, fmt.Errorf("invalid pom: %w", err)
+	}
+	var missing []string
+	if strings.TrimSpace(pom.Name) == "" {
+		missing = append(missing, "name")
+	}
+	if strings.TrimSpace(pom.Description) == "" {
+		missing = append(missing, "description")
+	}
+	hasLicense := false
+	for _, license := range pom.Licenses {
+		if strings.TrimSpace(license.Name) != "" || strings.TrimSpace(license.URL) != "" {
+			hasLicense = true
+			break
+		}
+	}
+	if !hasLicense {
+		missing = append(missing, "licenses")
+	}
+	if strings.TrimSpace(pom.SCM.URL) == "" && strings.TrimSpace(pom.SCM.Connection) == "" &&
+		strings.TrimSpace(pom.SCM.DeveloperConnection) == "" {
+		missing = append(missing, "scm")
+	}
+	return missing, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/pkg"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const centralPom = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <modelVersion>4.0.0</modelVersion>
+  <groupId>com.example</groupId>
+  <artifactId>lib</artifactId>
+  <version>1.0.0</version>
+  <name>lib</name>
+  <description>An example library</description>
+  <licenses>
+    <license>
+      <name>Apache-2.0</name>
+      <url>https://www.apache.org/licenses/LICENSE-2.0.txt</url>
+    </license>
+  </licenses>
+  <scm>
+    <url>https://github.com/example/lib</url>
+  </scm>
+</project>`
+
+func TestMissingCentralMetadata(t *testing.T) {
+	missing, err := MissingCentralMetadata([]byte(centralPom))
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	missing, err = MissingCentralMetadata([]byte(`<project><name> </name><licenses><license/></licenses></project>`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "description", "licenses", "scm"}, missing)
+
+	_, err = MissingCentralMetadata([]byte(`<metadata/>`))
+	assert.Error(t, err)
+}
+
+func TestIsPomFile(t *testing.T) {
+	assert.True(t, IsPomFile(pkg.MavenArtifactInfo{FileName: "lib-1.0.0.pom"}))
+	assert.False(t, IsPomFile(pkg.MavenArtifactInfo{FileName: "lib-1.0.0.pom.sha1"}))
+	assert.False(t, IsPomFile(pkg.MavenArtifactInfo{FileName: "lib-1.0.0.jar"}))
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"crypto/md5"  //nolint:gosec // Maven clients verify MD5 and SHA-1 checksums
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"path/filepath"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/types"
+)
+
+const pomNamespace = "http://maven.apache.org/POM/4.0.0"
+
+type relocationPom struct {
+	XMLName                xml.Name `xml:"project"`
+	Xmlns                  string   `xml:"xmlns,attr"`
+	ModelVersion           string   `xml:"modelVersion"`
+	GroupID                string   `xml:"groupId"`
+	ArtifactID             string   `xml:"artifactId"`
+	Version                string   `xml:"version"`
+	DistributionManagement struct {
+		Relocation struct {
+			GroupID    string `xml:"groupId"`
+			ArtifactID string `xml:"artifactId"`
+			Version    string `xml:"version,omitempty"`
+			Message    string `xml:"message,omitempty"`
+		} `xml:"relocation"`
+	} `xml:"distributionManagement"`
+}
+
+// IsPomOrChecksumFile reports whether the requested file is a POM or one of its checksums.
+func IsPomOrChecksumFile(fileName string) bool {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case extensionMD5, extensionSHA1, extensionSHA256, extensionSHA512:
+		fileName = strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	}
+	return strings.EqualFold(filepath.Ext(fileName), extensionPom)
+}
+
+// RelocationPom generates the POM served at the coordinates of a version of an artifact that was
+// relocated. Maven resolves the artifact at the coordinates it points to and warns with its message.
+// The relocation keeps the version unless the relocation moved the artifact to another one.
+func RelocationPom(info pkg.MavenArtifactInfo, relocation *types.MavenRelocation) ([]byte, error) {
+	pom := relocationPom{
+		Xmlns:        pomNamespace,
+		ModelVersion: "4.0.0",
+		GroupID:      info.GroupID,
+		ArtifactID:   info.ArtifactID,
+		Version:      info.Version,
+	}
+	pom.DistributionManagement.Relocation.GroupID = relocation.TargetGroupID
+	pom.DistributionManagement.Relocation.ArtifactID = relocation.TargetArtifactID
+	pom.DistributionManagement.Relocation.Version = relocation.TargetVersion
+	pom.DistributionManagement.Relocation.Message = relocation.Message
+
+	data, err := xml.MarshalIndent(pom, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate relocation pom: %w", err)
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// RelocatedFile returns the content and the content type of the requested file of a relocated
+// version: its relocation POM, or the checksum of it.
+func RelocatedFile(info pkg.MavenArtifactInfo, relocation *types.MavenRelocation) ([]byte, string, error) {
+	pom, err := RelocationPom(info, relocation)
+	if err != nil {
+		return nil, "", err
+	}
+	var h hash.Hash
+	switch strings.ToLower(filepath.Ext(info.FileName)) {
+	case extensionMD5:
+		h = md5.New() //nolint:gosec
+	case extensionSHA1:
+		h = sha1.New() //nolint:gosec
+	case extensionSHA256:
+		h = sha256.New()
+	case extensionSHA512:
+		h = sha512.New()
+	default:
+		return pom, contentTypeXML, nil
+	}
+	h.Write(pom)
+	return []byte(hex.EncodeToString(h.Sum(nil))), contentTypePlainText, nil
+}
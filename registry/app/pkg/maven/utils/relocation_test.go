@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const expectedRelocationPom = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <modelVersion>4.0.0</modelVersion>
+  <groupId>com.example</groupId>
+  <artifactId>lib</artifactId>
+  <version>1.0.0</version>
+  <distributionManagement>
+    <relocation>
+      <groupId>org.example</groupId>
+      <artifactId>lib-core</artifactId>
+      <message>lib moved to org.example:lib-core</message>
+    </relocation>
+  </distributionManagement>
+</project>
+`
+
+func TestRelocatedFile(t *testing.T) {
+	info := pkg.MavenArtifactInfo{GroupID: "com.example", ArtifactID: "lib", Version: "1.0.0",
+		FileName: "lib-1.0.0.pom"}
+	relocation := &types.MavenRelocation{
+		TargetGroupID:    "org.example",
+		TargetArtifactID: "lib-core",
+		Message:          "lib moved to org.example:lib-core",
+	}
+
+	content, contentType, err := RelocatedFile(info, relocation)
+	require.NoError(t, err)
+	assert.Equal(t, expectedRelocationPom, string(content))
+	assert.Equal(t, contentTypeXML, contentType)
+
+	info.FileName = "lib-1.0.0.pom.sha1"
+	content, contentType, err = RelocatedFile(info, relocation)
+	require.NoError(t, err)
+	sum := sha1.Sum([]byte(expectedRelocationPom)) //nolint:gosec
+	assert.Equal(t, hex.EncodeToString(sum[:]), string(content))
+	assert.Equal(t, contentTypePlainText, contentType)
+}
+
+func TestIsPomOrChecksumFile(t *testing.T) {
+	assert.True(t, IsPomOrChecksumFile("lib-1.0.0.pom"))
+	assert.True(t, IsPomOrChecksumFile("lib-1.0.0.pom.sha512"))
+	assert.False(t, IsPomOrChecksumFile("lib-1.0.0.jar"))
+	assert.False(t, IsPomOrChecksumFile("lib-1.0.0.jar.md5"))
+}
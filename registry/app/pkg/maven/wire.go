@@ -21,6 +21,7 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/remote/controller/proxy/maven"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
 	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 
@@ -64,15 +65,21 @@ func DBStoreProvider(
 	artifactDao store.ArtifactRepository,
 	spaceStore corestore.SpaceStore,
 	bandwidthStatDao store.BandwidthStatRepository,
-	downloadStatDao store.DownloadStatRepository,
+	downloadStatRecorder *downloadstats.Recorder,
 	nodeDao store.NodesRepository,
 	upstreamProxyDao store.UpstreamProxyConfigRepository,
+	mavenRelocationDao store.MavenRelocationRepository,
+	packageRuleDao store.PackageRuleRepository,
+	upstreamURLDao store.UpstreamURLRepository,
 ) *DBStore {
 	//nolint:errcheck
 	return NewDBStore(registryDao, imageDao, artifactDao, spaceStore, bandwidthStatDao,
-		downloadStatDao,
+		downloadStatRecorder,
 		nodeDao,
-		upstreamProxyDao)
+		upstreamProxyDao,
+		mavenRelocationDao,
+		packageRuleDao,
+		upstreamURLDao)
 }
 
 func ProvideProxyController(
@@ -0,0 +1,85 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"io"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles the package registry service of ML model registries, which host the weights
+// of models along with their configuration, tokenizers and model cards.
+type controller struct {
+	fileManager          filemanager.FileManager
+	tx                   dbtx.Transactor
+	registryDao          store.RegistryRepository
+	imageDao             store.ImageRepository
+	artifactDao          store.ArtifactRepository
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	DownloadFile(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+	// UploadFile adds a file to a version in a single request, creating the version unless it exists.
+	UploadFile(ctx context.Context, info ArtifactInfo, file io.Reader) (*File, errcode.Error)
+	// StartUpload starts a resumable upload of a file, returning the ID of the upload. Its
+	// content is uploaded in chunks with AppendUpload until it's added with CompleteUpload.
+	StartUpload(ctx context.Context, info ArtifactInfo) (string, errcode.Error)
+	// GetUploadOffset returns the size of the content uploaded so far, where the next chunk starts.
+	GetUploadOffset(ctx context.Context, info ArtifactInfo, uploadID string) (int64, errcode.Error)
+	// AppendUpload appends a chunk starting at offset, returning the size of the content uploaded
+	// so far.
+	AppendUpload(
+		ctx context.Context,
+		info ArtifactInfo,
+		uploadID string,
+		offset int64,
+		chunk io.Reader,
+	) (int64, errcode.Error)
+	CompleteUpload(ctx context.Context, info ArtifactInfo, uploadID string) (*File, errcode.Error)
+	CancelUpload(ctx context.Context, info ArtifactInfo, uploadID string) errcode.Error
+}
+
+// NewController creates a new ML model controller.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
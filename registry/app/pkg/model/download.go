@@ -0,0 +1,126 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	modelmetadata "github.com/harness/gitness/registry/app/metadata/model"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+func (c *controller) DownloadFile(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	if errc := validateFile(info); !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.Name, info.Version, info.Filename),
+		types.Registry{
+			ID:   registry.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, registry.ID, info.Name, info.Version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + info.Filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) getRegistry(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeMODEL {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't an ML model registry", registry.Name))
+	}
+	return registry, errcode.Error{}
+}
+
+// getMetadata returns the metadata of a version, or gitnessstore.ErrResourceNotFound if no file
+// was uploaded for it.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*database.ModelMetadata, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, name, err)
+	}
+	var metadata database.ModelMetadata
+	if err = json.Unmarshal(a.Metadata, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s: %w", version, err)
+	}
+	return &metadata, nil
+}
+
+func validateFile(info ArtifactInfo) errcode.Error {
+	if err := modelmetadata.ValidateName(info.Name); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := modelmetadata.ValidateVersion(info.Version); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := modelmetadata.ValidateFilename(info.Filename); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := modelmetadata.ValidateFramework(info.Framework); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	return errcode.Error{}
+}
+
+// filePath returns the path a file of a version is stored at.
+func filePath(name, version, filename string) string {
+	return name + "/" + version + "/" + filename
+}
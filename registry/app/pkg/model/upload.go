@@ -0,0 +1,263 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	modelmetadata "github.com/harness/gitness/registry/app/metadata/model"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// UploadFile stores a file of a version. Files are immutable, as clients verify them with their
+// checksum. The model card is read from README.md as it's uploaded.
+func (c *controller) UploadFile(ctx context.Context, info ArtifactInfo, file io.Reader) (*File, errcode.Error) {
+	registry, errc := c.checkUpload(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+
+	var modelCard string
+	if modelmetadata.IsModelCard(info.Filename) {
+		content, err := io.ReadAll(io.LimitReader(file, modelmetadata.MaxModelCardSize+1))
+		if err != nil {
+			return nil, errcode.ErrCodeInvalidRequest.WithDetail(err)
+		}
+		if len(content) > modelmetadata.MaxModelCardSize {
+			return nil, errcode.ErrCodeSizeInvalid.WithMessage(
+				fmt.Sprintf("the model card exceeds %d bytes", modelmetadata.MaxModelCardSize))
+		}
+		modelCard = string(content)
+		file = bytes.NewReader(content)
+	}
+
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(info.Name, info.Version, info.Filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil, file, info.Filename)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return c.addFile(ctx, registry.ID, info, fileInfo, modelCard)
+}
+
+// StartUpload starts a resumable upload, for files too large to upload in a single request.
+func (c *controller) StartUpload(ctx context.Context, info ArtifactInfo) (string, errcode.Error) {
+	if modelmetadata.IsModelCard(info.Filename) {
+		return "", errcode.ErrCodeInvalidRequest.WithMessage("the model card must be uploaded in a single request")
+	}
+	if _, errc := c.checkUpload(ctx, info); !commons.IsEmptyError(errc) {
+		return "", errc
+	}
+	uploadID, err := c.fileManager.StartUpload(ctx, info.RegIdentifier, info.StorageRoot())
+	if err != nil {
+		return "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return uploadID, errcode.Error{}
+}
+
+func (c *controller) GetUploadOffset(ctx context.Context, info ArtifactInfo, uploadID string) (
+	int64,
+	errcode.Error,
+) {
+	if errc := validateFile(info); !commons.IsEmptyError(errc) {
+		return 0, errc
+	}
+	if _, errc := c.getRegistry(ctx, info); !commons.IsEmptyError(errc) {
+		return 0, errc
+	}
+	offset, err := c.fileManager.UploadOffset(ctx, info.RegIdentifier, info.StorageRoot(), uploadID)
+	if err != nil {
+		return 0, uploadError(err)
+	}
+	return offset, errcode.Error{}
+}
+
+func (c *controller) AppendUpload(
+	ctx context.Context,
+	info ArtifactInfo,
+	uploadID string,
+	offset int64,
+	chunk io.Reader,
+) (int64, errcode.Error) {
+	if errc := validateFile(info); !commons.IsEmptyError(errc) {
+		return 0, errc
+	}
+	if _, errc := c.getRegistry(ctx, info); !commons.IsEmptyError(errc) {
+		return 0, errc
+	}
+	size, err := c.fileManager.AppendUpload(ctx, info.RegIdentifier, info.StorageRoot(), uploadID, offset, chunk)
+	if err != nil {
+		return size, uploadError(err)
+	}
+	return size, errcode.Error{}
+}
+
+func (c *controller) CompleteUpload(ctx context.Context, info ArtifactInfo, uploadID string) (*File, errcode.Error) {
+	registry, errc := c.checkUpload(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	fileInfo, err := c.fileManager.CompleteUpload(ctx, filePath(info.Name, info.Version, info.Filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), uploadID, info.Filename)
+	if err != nil {
+		return nil, uploadError(err)
+	}
+	return c.addFile(ctx, registry.ID, info, fileInfo, "")
+}
+
+func (c *controller) CancelUpload(ctx context.Context, info ArtifactInfo, uploadID string) errcode.Error {
+	if errc := validateFile(info); !commons.IsEmptyError(errc) {
+		return errc
+	}
+	if _, errc := c.getRegistry(ctx, info); !commons.IsEmptyError(errc) {
+		return errc
+	}
+	if err := c.fileManager.CancelUpload(ctx, info.RegIdentifier, info.StorageRoot(), uploadID); err != nil {
+		return uploadError(err)
+	}
+	return errcode.Error{}
+}
+
+var errFileExists = errors.New("file exists")
+
+// checkUpload checks a file can be uploaded, failing if it was uploaded before so that clients
+// don't upload it again.
+func (c *controller) checkUpload(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	if errc := validateFile(info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	metadata, err := c.getMetadata(ctx, registry.ID, info.Name, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return registry, errcode.Error{}
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if hasFile(metadata, info.Filename) {
+		return nil, errcode.ErrCodeConflict.WithMessage(fileExistsMessage(info))
+	}
+	return registry, errcode.Error{}
+}
+
+// addFile adds an uploaded file to the version, creating the version unless it exists.
+func (c *controller) addFile(
+	ctx context.Context,
+	registryID int64,
+	info ArtifactInfo,
+	fileInfo pkg.FileInfo,
+	modelCard string,
+) (*File, errcode.Error) {
+	err := c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       info.Name,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", info.Name, err)
+			}
+
+			var metadata database.ModelMetadata
+			existing, err := c.artifactDao.GetByName(ctx, image.ID, info.Version)
+			if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+				return fmt.Errorf("failed to find version %s of %s: %w", info.Version, info.Name, err)
+			}
+			if existing != nil {
+				if err = json.Unmarshal(existing.Metadata, &metadata); err != nil {
+					return fmt.Errorf("failed to parse metadata of version %s: %w", info.Version, err)
+				}
+			}
+			if hasFile(&metadata, info.Filename) {
+				return errFileExists
+			}
+			metadata.Files = append(metadata.Files, database.File{
+				Size:      fileInfo.Size,
+				Filename:  info.Filename,
+				CreatedAt: time.Now().UnixMilli(),
+			})
+			metadata.FileCount = int64(len(metadata.Files))
+			metadata.Formats = modelmetadata.AddFormat(metadata.Formats, info.Filename)
+			if info.Framework != "" {
+				metadata.Framework = info.Framework
+			}
+			if modelCard != "" {
+				metadata.ModelCard = modelCard
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", info.Name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  info.Version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", info.Name, err)
+			}
+			return nil
+		})
+	if errors.Is(err, errFileExists) {
+		return nil, errcode.ErrCodeConflict.WithMessage(fileExistsMessage(info))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	format, _ := modelmetadata.Format(info.Filename)
+	return &File{
+		Filename: info.Filename,
+		Size:     fileInfo.Size,
+		Sha256:   fileInfo.Sha256,
+		Format:   format,
+	}, errcode.Error{}
+}
+
+func hasFile(metadata *database.ModelMetadata, filename string) bool {
+	return slices.ContainsFunc(metadata.Files, func(file database.File) bool {
+		return file.Filename == filename
+	})
+}
+
+func uploadError(err error) errcode.Error {
+	if errors.Is(err, filemanager.ErrUploadNotFound) {
+		return errcode.ErrCodeBlobUploadUnknown.WithDetail(err)
+	}
+	if errors.Is(err, filemanager.ErrUploadOffset) {
+		return errcode.ErrCodeRangeInvalid.WithMessage(err.Error())
+	}
+	return errcode.ErrCodeUnknown.WithDetail(err)
+}
+
+func fileExistsMessage(info ArtifactInfo) string {
+	return fmt.Sprintf("file %s of version %s of %s was uploaded before", info.Filename, info.Version, info.Name)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/request"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+var (
+	ErrClientCertificateRequired = errors.New("registry requires a TLS client certificate")
+	ErrClientCertificateInvalid  = errors.New("TLS client certificate is not trusted by the registry")
+)
+
+// pushPermissions are the permissions whose requests count as pushes for the PUSH mTLS mode.
+var pushPermissions = []enum.Permission{enum.PermissionArtifactsUpload, enum.PermissionArtifactsDelete}
+
+// ParseCACertificates parses the PEM encoded certificate authorities of a registry.
+func ParseCACertificates(caCertificates string) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(caCertificates)) {
+		return nil, errors.New("no valid PEM encoded certificate found")
+	}
+	return pool, nil
+}
+
+// RequiresClientCertificate reports whether the mTLS mode of the registry applies to a request
+// with the permissions.
+func RequiresClientCertificate(registry *registrytypes.Registry, permissions []enum.Permission) bool {
+	switch registry.MTLSMode {
+	case artifact.MTLSModeALL:
+		return true
+	case artifact.MTLSModePUSH:
+		return isPush(permissions)
+	case artifact.MTLSModeDISABLED:
+		return false
+	default:
+		return false
+	}
+}
+
+// VerifyClientCertificate checks the client certificate of the request against the certificate
+// authorities of the registry if its mTLS mode applies, and records the verified access.
+func VerifyClientCertificate(
+	ctx context.Context,
+	registry *registrytypes.Registry,
+	spacePath string,
+	principal types.Principal,
+	permissions []enum.Permission,
+) error {
+	if !RequiresClientCertificate(registry, permissions) {
+		return nil
+	}
+	cert := request.ClientCertificateFrom(ctx)
+	if cert == nil || len(cert.Chain) == 0 {
+		return ErrClientCertificateRequired
+	}
+	roots, err := ParseCACertificates(registry.MTLSCACertificates)
+	if err != nil {
+		return fmt.Errorf("invalid certificate authorities of registry %s: %w", registry.Name, err)
+	}
+	intermediates := x509.NewCertPool()
+	for _, c := range cert.Chain[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err = cert.Chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("%w: %w", ErrClientCertificateInvalid, err)
+	}
+
+	cert.AddVerification(request.ClientCertificateVerification{
+		SpacePath:    spacePath,
+		RegistryName: registry.Name,
+		Principal:    principal,
+		Push:         isPush(permissions),
+	})
+	return nil
+}
+
+func isPush(permissions []enum.Permission) bool {
+	for _, p := range permissions {
+		if slices.Contains(pushPermissions, p) {
+			return true
+		}
+	}
+	return false
+}
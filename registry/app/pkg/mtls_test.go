@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/request"
+	registrytypes "github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+func newTestCA(t *testing.T, name string) testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return testCA{cert: cert, key: key, pem: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))}
+}
+
+func (ca testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name, Organization: []string{"Acme"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestVerifyClientCertificate(t *testing.T) {
+	ca := newTestCA(t, "registry-ca")
+	registry := &registrytypes.Registry{
+		Name:               "secure",
+		MTLSMode:           artifact.MTLSModePUSH,
+		MTLSCACertificates: ca.pem,
+	}
+	principal := types.Principal{ID: 7, UID: "ci"}
+	upload := []enum.Permission{enum.PermissionArtifactsUpload}
+	download := []enum.Permission{enum.PermissionArtifactsDownload}
+
+	// pulls don't need a certificate in PUSH mode, pushes do
+	ctx := context.Background()
+	require.NoError(t, VerifyClientCertificate(ctx, registry, "acme", principal, download))
+	assert.ErrorIs(t, VerifyClientCertificate(ctx, registry, "acme", principal, upload), ErrClientCertificateRequired)
+
+	cert := &request.ClientCertificate{Chain: []*x509.Certificate{ca.issue(t, "ci", x509.ExtKeyUsageClientAuth)}}
+	ctx = request.WithClientCertificate(ctx, cert)
+	require.NoError(t, VerifyClientCertificate(ctx, registry, "acme", principal, upload))
+	assert.Equal(t, []request.ClientCertificateVerification{
+		{SpacePath: "acme", RegistryName: "secure", Principal: principal, Push: true},
+	}, cert.Verifications())
+	assert.Equal(t, "CN=ci,O=Acme", cert.Identity())
+	assert.Len(t, cert.Fingerprint(), 64)
+
+	registry.MTLSMode = artifact.MTLSModeALL
+	require.NoError(t, VerifyClientCertificate(ctx, registry, "acme", principal, download))
+
+	// certificates of other authorities or for other usages are rejected
+	other := newTestCA(t, "other-ca")
+	ctx = request.WithClientCertificate(context.Background(),
+		&request.ClientCertificate{Chain: []*x509.Certificate{other.issue(t, "ci", x509.ExtKeyUsageClientAuth)}})
+	assert.ErrorIs(t, VerifyClientCertificate(ctx, registry, "acme", principal, download), ErrClientCertificateInvalid)
+
+	ctx = request.WithClientCertificate(context.Background(),
+		&request.ClientCertificate{Chain: []*x509.Certificate{ca.issue(t, "ci", x509.ExtKeyUsageServerAuth)}})
+	assert.ErrorIs(t, VerifyClientCertificate(ctx, registry, "acme", principal, download), ErrClientCertificateInvalid)
+
+	registry.MTLSMode = artifact.MTLSModeDISABLED
+	require.NoError(t, VerifyClientCertificate(context.Background(), registry, "acme", principal, upload))
+}
+
+func TestParseCACertificates(t *testing.T) {
+	_, err := ParseCACertificates(newTestCA(t, "ca").pem)
+	require.NoError(t, err)
+	_, err = ParseCACertificates("not a certificate")
+	assert.Error(t, err)
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"context"
+	"io"
+
+	"github.com/harness/gitness/app/services/refcache"
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/secret"
+	"github.com/harness/gitness/store/database/dbtx"
+)
+
+// Controller handles npm package operations.
+type controller struct {
+	fileManager filemanager.FileManager
+	tx          dbtx.Transactor
+	registryDao store.RegistryRepository
+	imageDao    store.ImageRepository
+	artifactDao store.ArtifactRepository
+	urlProvider urlprovider.Provider
+	// upstreamProxyDao, spaceFinder and secretService resolve packages from upstream proxies.
+	upstreamProxyDao     store.UpstreamProxyConfigRepository
+	spaceFinder          refcache.SpaceFinder
+	secretService        secret.Service
+	packageRuleStore     store.PackageRuleRepository
+	upstreamURLStore     store.UpstreamURLRepository
+	downloadStatRecorder *downloadstats.Recorder
+}
+
+type Controller interface {
+	GetPackageMetadata(ctx context.Context, info ArtifactInfo) (*PackageMetadata, errcode.Error)
+	PublishPackage(ctx context.Context, info ArtifactInfo, body io.Reader) (*commons.ResponseHeaders, errcode.Error)
+	DownloadPackageFile(ctx context.Context, info ArtifactInfo) (
+		*commons.ResponseHeaders,
+		*storage.FileReader,
+		string,
+		errcode.Error,
+	)
+}
+
+// NewController creates a new npm controller.
+func NewController(
+	registryDao store.RegistryRepository,
+	imageDao store.ImageRepository,
+	artifactDao store.ArtifactRepository,
+	fileManager filemanager.FileManager,
+	tx dbtx.Transactor,
+	urlProvider urlprovider.Provider,
+	upstreamProxyDao store.UpstreamProxyConfigRepository,
+	spaceFinder refcache.SpaceFinder,
+	secretService secret.Service,
+	packageRuleStore store.PackageRuleRepository,
+	upstreamURLStore store.UpstreamURLRepository,
+	downloadStatRecorder *downloadstats.Recorder,
+) Controller {
+	return &controller{
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		urlProvider:          urlProvider,
+		upstreamProxyDao:     upstreamProxyDao,
+		spaceFinder:          spaceFinder,
+		secretService:        secretService,
+		packageRuleStore:     packageRuleStore,
+		upstreamURLStore:     upstreamURLStore,
+		downloadStatRecorder: downloadStatRecorder,
+	}
+}
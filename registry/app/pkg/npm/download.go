@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+func (c *controller) DownloadPackageFile(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	version, ok := VersionFromTarballName(info.Image, info.Filename)
+	if !ok {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(
+			"invalid tarball name " + info.Filename)
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+
+	fileReader, redirectURL, err := c.downloadTarball(ctx, info, reg, version)
+	if err == nil {
+		pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, info.Image, version)
+		responseHeaders.Code = http.StatusOK
+		return responseHeaders, fileReader, redirectURL, errcode.Error{}
+	}
+
+	upstreams, uerr := c.upstreamRegistries(ctx, reg, info.Image)
+	if uerr != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(uerr)
+	}
+	for _, upstream := range upstreams {
+		if upstream.Type == artifact.RegistryTypeUPSTREAM {
+			if perr := c.checkPackageRules(ctx, upstream, info.Image, version); perr != nil {
+				log.Ctx(ctx).Warn().Err(perr).Msgf("npm tarball %s isn't served from upstream %s",
+					info.Filename, upstream.Name)
+				continue
+			}
+		}
+		fileReader, redirectURL, perr := c.downloadTarball(ctx, info, &upstream, version)
+		if perr != nil && upstream.Type == artifact.RegistryTypeUPSTREAM {
+			if perr = c.proxyTarball(ctx, info, upstream, version); perr == nil {
+				fileReader, redirectURL, perr = c.downloadTarball(ctx, info, &upstream, version)
+			}
+		}
+		if perr == nil {
+			pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, upstream.ID, info.Image, version)
+			responseHeaders.Code = http.StatusOK
+			return responseHeaders, fileReader, redirectURL, errcode.Error{}
+		}
+		log.Ctx(ctx).Warn().Err(perr).Msgf("failed to proxy npm tarball %s from upstream %s",
+			info.Filename, upstream.Name)
+	}
+	return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+}
+
+// downloadTarball reads the tarball of a version stored in the registry.
+func (c *controller) downloadTarball(
+	ctx context.Context, info ArtifactInfo, registry *types.Registry, version string,
+) (*storage.FileReader, string, error) {
+	path := "/" + info.Image + "/" + version + "/" + info.Filename
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
+		ID:   registry.ID,
+		Name: registry.Name,
+	}, storageRoot(info, registry))
+	return fileReader, redirectURL, err
+}
+
+// VersionFromTarballName returns the version of a tarball named like npm names them.
+func VersionFromTarballName(name, filename string) (string, bool) {
+	prefix := strings.TrimSuffix(npm.TarballName(name, ""), ".tgz")
+	if !strings.HasPrefix(filename, prefix) || !strings.HasSuffix(filename, ".tgz") {
+		return "", false
+	}
+	version := strings.TrimSuffix(strings.TrimPrefix(filename, prefix), ".tgz")
+	return version, version != ""
+}
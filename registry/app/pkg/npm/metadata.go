@@ -0,0 +1,117 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+const latestTag = "latest"
+
+// GetPackageMetadata returns the package document of a package with the tarball URLs of all versions.
+// GetPackageMetadata returns the packument of a package. Packages found in the registry are served from
+// it, others from the first of its upstream proxies that has them, unless their scope is local.
+func (c *controller) GetPackageMetadata(ctx context.Context, info ArtifactInfo) (*PackageMetadata, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	registryURL := c.urlProvider.PackageURL(ctx, info.RootIdentifier+"/"+info.RegIdentifier, "npm")
+
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, info.Image)
+	if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if err == nil && len(*artifacts) > 0 {
+		packageMetadata, err := buildPackageMetadata(info.Image, *artifacts, registryURL)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		return packageMetadata, errcode.Error{}
+	}
+
+	upstreams, err := c.upstreamRegistries(ctx, registry, info.Image)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	for _, upstream := range upstreams {
+		packageMetadata, err := c.proxyPackageMetadata(ctx, info, upstream, registryURL)
+		if err == nil {
+			return packageMetadata, errcode.Error{}
+		}
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to resolve npm package %s from upstream %s",
+			info.Image, upstream.Name)
+	}
+	return nil, errcode.ErrCodeNameUnknown.WithMessage("package " + info.Image + " not found")
+}
+
+func buildPackageMetadata(
+	name string,
+	artifacts []types.Artifact,
+	registryURL string,
+) (*PackageMetadata, error) {
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		return artifacts[i].CreatedAt.Before(artifacts[j].CreatedAt)
+	})
+
+	packageMetadata := &PackageMetadata{
+		Name:     name,
+		DistTags: map[string]string{},
+		Versions: map[string]VersionMetadata{},
+		Time:     map[string]string{},
+	}
+	versions := make([]string, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		metadata := &database.NpmMetadata{}
+		if err := json.Unmarshal(artifact.Metadata, metadata); err != nil {
+			return nil, err
+		}
+		packageMetadata.Versions[artifact.Version] = VersionMetadata{
+			PackageMetadata: metadata.PackageMetadata,
+			Dist: Dist{
+				Tarball:   registryURL + "/" + name + "/-/" + npm.TarballName(name, artifact.Version),
+				Shasum:    metadata.Shasum,
+				Integrity: metadata.Integrity,
+			},
+		}
+		// later publishes move the tags of earlier ones, like npm dist-tags do
+		for _, tag := range metadata.DistTags {
+			packageMetadata.DistTags[tag] = artifact.Version
+		}
+		packageMetadata.Time[artifact.Version] = artifact.CreatedAt.UTC().Format(time.RFC3339Nano)
+		versions = append(versions, artifact.Version)
+	}
+	if _, ok := packageMetadata.DistTags[latestTag]; !ok && len(versions) > 0 {
+		packageMetadata.DistTags[latestTag] = versioning.Latest(versioning.SchemeSemver, versions)
+	}
+	if len(artifacts) > 0 {
+		packageMetadata.Time["created"] = artifacts[0].CreatedAt.UTC().Format(time.RFC3339Nano)
+		packageMetadata.Time["modified"] = artifacts[len(artifacts)-1].CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return packageMetadata, nil
+}
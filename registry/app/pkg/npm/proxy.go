@@ -0,0 +1,288 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/pkg"
+	proxy2 "github.com/harness/gitness/registry/app/remote/controller/proxy"
+	npmproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/npm"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+// maxPackumentSize bounds the packuments read from upstream registries, which list every version of a package.
+const maxPackumentSize = 64 << 20
+
+// upstreamPackument is the subset of the packument of an upstream registry the versions are rebuilt from.
+type upstreamPackument struct {
+	DistTags map[string]string          `json:"dist-tags"`
+	Versions map[string]json.RawMessage `json:"versions"`
+	Time     map[string]string          `json:"time"`
+}
+
+type upstreamVersion struct {
+	Dist Dist `json:"dist"`
+}
+
+// isLocalScope reports whether the package belongs to a scope only resolved from the registry itself.
+func isLocalScope(registry *types.Registry, name string) bool {
+	scope := npm.Scope(name)
+	return scope != "" && slices.Contains(registry.NpmLocalScopes, scope)
+}
+
+// upstreamRegistries returns the upstream proxies the package is resolved from after the registry, in
+// the order they're configured in. Packages of local scopes are never proxied.
+func (c *controller) upstreamRegistries(
+	ctx context.Context, registry *types.Registry, name string,
+) ([]types.Registry, error) {
+	if isLocalScope(registry, name) {
+		return nil, nil
+	}
+	return pkg.GetUpstreamRegistries(ctx, c.registryDao, registry)
+}
+
+func (c *controller) remoteHelper(
+	ctx context.Context, upstream types.Registry,
+) (npmproxy.RemoteInterface, *types.UpstreamProxy, error) {
+	proxy, err := c.upstreamProxyDao.GetByRegistryIdentifier(ctx, upstream.ParentID, upstream.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get upstream proxy %s: %w", upstream.Name, err)
+	}
+	proxy2.FailOver(ctx, c.upstreamURLStore, proxy)
+	remote, err := npmproxy.NewRemoteHelper(ctx, c.spaceFinder, c.secretService, *proxy)
+	if err != nil {
+		return nil, nil, err
+	}
+	return remote, proxy, nil
+}
+
+// proxyPackageMetadata returns the packument of the upstream, pointing the tarballs to the registry. The
+// versions cached from the upstream are served while it is unreachable. A virtual member only serves the
+// versions stored in it.
+func (c *controller) proxyPackageMetadata(
+	ctx context.Context, info ArtifactInfo, upstream types.Registry, registryURL string,
+) (*PackageMetadata, error) {
+	if upstream.Type == artifact.RegistryTypeVIRTUAL {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, upstream.ID, info.Image)
+		if err != nil {
+			return nil, err
+		}
+		if len(*artifacts) == 0 {
+			return nil, fmt.Errorf("package %s: %w", info.Image, gitnessstore.ErrResourceNotFound)
+		}
+		return buildPackageMetadata(info.Image, *artifacts, registryURL)
+	}
+	upstreamProxy, err := c.upstreamProxyDao.GetByRegistryIdentifier(ctx, upstream.ParentID, upstream.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream proxy %s: %w", upstream.Name, err)
+	}
+	proxy2.FailOver(ctx, c.upstreamURLStore, upstreamProxy)
+	coordinate := proxy2.NpmCoordinate(info.Image, "")
+	if err = proxy2.CheckPackageRules(ctx, c.packageRuleStore, *upstreamProxy, coordinate); err != nil {
+		return nil, err
+	}
+	var packageMetadata *PackageMetadata
+	if upstreamProxy.Offline {
+		err = fmt.Errorf("upstream %s is offline", upstream.Name)
+	} else {
+		packageMetadata, err = c.fetchPackageMetadata(ctx, info.Image, *upstreamProxy, registryURL)
+	}
+	if err != nil {
+		artifacts, cacheErr := c.artifactDao.GetByRegistryIDAndImage(ctx, upstream.ID, info.Image)
+		if cacheErr != nil || len(*artifacts) == 0 {
+			return nil, err
+		}
+		log.Ctx(ctx).Warn().Err(err).Msgf("serving the versions of npm package %s cached from upstream %s",
+			info.Image, upstream.Name)
+		if packageMetadata, err = buildPackageMetadata(info.Image, *artifacts, registryURL); err != nil {
+			return nil, err
+		}
+	}
+	if err = c.filterBlockedVersions(ctx, *upstreamProxy, coordinate, packageMetadata); err != nil {
+		return nil, err
+	}
+	return packageMetadata, nil
+}
+
+// filterBlockedVersions removes the versions the package rules of the upstream proxy block from the packument.
+func (c *controller) filterBlockedVersions(
+	ctx context.Context, upstreamProxy types.UpstreamProxy, coordinate proxy2.PackageCoordinate,
+	packageMetadata *PackageMetadata,
+) error {
+	versions := make([]string, 0, len(packageMetadata.Versions))
+	for version := range packageMetadata.Versions {
+		versions = append(versions, version)
+	}
+	allowed, err := proxy2.FilterPackageVersions(ctx, c.packageRuleStore, upstreamProxy, coordinate, versions)
+	if err != nil {
+		return err
+	}
+	if len(allowed) == len(versions) {
+		return nil
+	}
+	if len(allowed) == 0 {
+		return fmt.Errorf("all versions of package %s are blocked by upstream %s: %w", packageMetadata.Name,
+			upstreamProxy.RepoKey, gitnessstore.ErrResourceNotFound)
+	}
+	for _, version := range versions {
+		if !slices.Contains(allowed, version) {
+			delete(packageMetadata.Versions, version)
+			delete(packageMetadata.Time, version)
+		}
+	}
+	for tag, version := range packageMetadata.DistTags {
+		if _, ok := packageMetadata.Versions[version]; !ok {
+			delete(packageMetadata.DistTags, tag)
+		}
+	}
+	return nil
+}
+
+// fetchPackageMetadata returns the rewritten packument of the upstream, which is kept for the metadata TTL
+// of the proxy.
+func (c *controller) fetchPackageMetadata(
+	ctx context.Context, name string, upstreamProxy types.UpstreamProxy, registryURL string,
+) (*PackageMetadata, error) {
+	fetchKey := "packuments/" + name + "/" + registryURL
+	if cached, ok := proxy2.Fetches.Get(upstreamProxy.RegistryID, fetchKey, upstreamProxy.MetadataTTL); ok {
+		packageMetadata := &PackageMetadata{}
+		if err := json.Unmarshal(cached, packageMetadata); err == nil {
+			return packageMetadata, nil
+		}
+	}
+	remote, err := npmproxy.NewRemoteHelper(ctx, c.spaceFinder, c.secretService, upstreamProxy)
+	if err != nil {
+		return nil, err
+	}
+	body, err := remote.GetPackument(name)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var packument upstreamPackument
+	if err := json.NewDecoder(io.LimitReader(body, maxPackumentSize)).Decode(&packument); err != nil {
+		return nil, fmt.Errorf("invalid packument of npm package %s: %w", name, err)
+	}
+	packageMetadata := rewritePackument(name, packument, registryURL)
+	if upstreamProxy.MetadataTTL > 0 {
+		if content, err := json.Marshal(packageMetadata); err == nil {
+			proxy2.Fetches.Put(upstreamProxy.RegistryID, fetchKey, content)
+		}
+	}
+	return packageMetadata, nil
+}
+
+// rewritePackument rebuilds the packument of an upstream with the tarballs served by the registry, skipping
+// the versions it can't parse.
+func rewritePackument(name string, packument upstreamPackument, registryURL string) *PackageMetadata {
+	packageMetadata := &PackageMetadata{
+		Name:     name,
+		DistTags: map[string]string{},
+		Versions: map[string]VersionMetadata{},
+		Time:     map[string]string{},
+	}
+	for version, raw := range packument.Versions {
+		metadata, err := npm.ParsePackageJSON(raw)
+		if err != nil || metadata.Version != version {
+			continue
+		}
+		var v upstreamVersion
+		if err := json.Unmarshal(raw, &v); err != nil {
+			continue
+		}
+		packageMetadata.Versions[version] = VersionMetadata{
+			PackageMetadata: *metadata,
+			Dist: Dist{
+				Tarball:   registryURL + "/" + name + "/-/" + npm.TarballName(name, version),
+				Shasum:    v.Dist.Shasum,
+				Integrity: v.Dist.Integrity,
+			},
+		}
+	}
+	for tag, version := range packument.DistTags {
+		if _, ok := packageMetadata.Versions[version]; ok {
+			packageMetadata.DistTags[tag] = version
+		}
+	}
+	for key, t := range packument.Time {
+		if _, ok := packageMetadata.Versions[key]; ok || key == "created" || key == "modified" {
+			packageMetadata.Time[key] = t
+		}
+	}
+	return packageMetadata
+}
+
+// checkPackageRules returns an error if the package rules of the upstream proxy block the version, which
+// applies to the versions it already cached as well.
+func (c *controller) checkPackageRules(ctx context.Context, upstream types.Registry, name, version string) error {
+	upstreamProxy, err := c.upstreamProxyDao.GetByRegistryIdentifier(ctx, upstream.ParentID, upstream.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get upstream proxy %s: %w", upstream.Name, err)
+	}
+	return proxy2.CheckPackageRules(ctx, c.packageRuleStore, *upstreamProxy, proxy2.NpmCoordinate(name, version))
+}
+
+// proxyTarball caches the tarball of a version from the upstream in the upstream proxy registry.
+func (c *controller) proxyTarball(
+	ctx context.Context, info ArtifactInfo, upstream types.Registry, version string,
+) error {
+	remote, proxy, err := c.remoteHelper(ctx, upstream)
+	if err != nil {
+		return err
+	}
+	if proxy.Offline {
+		return fmt.Errorf("upstream %s is offline", upstream.Name)
+	}
+	_, body, err := remote.GetTarball(info.Image, info.Filename)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	tarball, err := io.ReadAll(io.LimitReader(body, maxPublishDocumentSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to download tarball %s: %w", info.Filename, err)
+	}
+	if len(tarball) > maxPublishDocumentSize {
+		return fmt.Errorf("tarball %s is too large", info.Filename)
+	}
+	metadata, err := npm.ExtractPackageJSON(bytes.NewReader(tarball))
+	if err != nil {
+		return err
+	}
+	if metadata.Name != info.Image || metadata.Version != version {
+		return errors.New("package.json of the upstream tarball doesn't match the requested version")
+	}
+	return c.storeVersion(ctx, info, &upstream, version, tarball, metadata, nil, &database.UpstreamCache{
+		Upstream:  upstream.Name,
+		SourceURL: npmproxy.UpstreamURL(*proxy) + "/" + info.Image + "/-/" + info.Filename,
+		CachedAt:  time.Now().UnixMilli(),
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsLocalScope(t *testing.T) {
+	registry := &types.Registry{NpmLocalScopes: []string{"@mycorp"}}
+	assert.True(t, isLocalScope(registry, "@mycorp/utils"))
+	assert.False(t, isLocalScope(registry, "@other/utils"))
+	assert.False(t, isLocalScope(registry, "mycorp"))
+}
+
+func TestRewritePackument(t *testing.T) {
+	packument := upstreamPackument{
+		DistTags: map[string]string{"latest": "1.1.0", "next": "2.0.0"},
+		Versions: map[string]json.RawMessage{
+			"1.0.0": json.RawMessage(`{"name":"@acme/left-pad","version":"1.0.0","license":{"type":"MIT"},` +
+				`"dist":{"tarball":"https://registry.npmjs.org/@acme/left-pad/-/left-pad-1.0.0.tgz","shasum":"abc"}}`),
+			"1.1.0": json.RawMessage(`{"name":"@acme/left-pad","version":"1.1.0","dependencies":{"x":"^1.0.0"},` +
+				`"dist":{"shasum":"def","integrity":"sha512-xyz"}}`),
+			"2.0.0": json.RawMessage(`{"name":"@acme/left-pad"}`),
+		},
+		Time: map[string]string{"created": "c", "1.0.0": "t1", "2.0.0": "t2"},
+	}
+
+	metadata := rewritePackument("@acme/left-pad", packument, "https://example.com/pkg/root/reg/npm")
+
+	assert.Len(t, metadata.Versions, 2)
+	assert.Equal(t, Dist{
+		Tarball: "https://example.com/pkg/root/reg/npm/@acme/left-pad/-/left-pad-1.0.0.tgz",
+		Shasum:  "abc",
+	}, metadata.Versions["1.0.0"].Dist)
+	assert.Equal(t, "MIT", metadata.Versions["1.0.0"].License)
+	assert.Equal(t, map[string]string{"x": "^1.0.0"}, metadata.Versions["1.1.0"].Dependencies)
+	assert.Equal(t, map[string]string{"latest": "1.1.0"}, metadata.DistTags)
+	assert.Equal(t, map[string]string{"created": "c", "1.0.0": "t1"}, metadata.Time)
+}
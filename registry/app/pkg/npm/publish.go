@@ -0,0 +1,229 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// maxPublishDocumentSize bounds the publish document, which embeds the base64 encoded tarball.
+const maxPublishDocumentSize = 256 << 20
+
+// PublishPackage stores a version published by `npm publish`. Published versions are immutable,
+// like on the public npm registry.
+func (c *controller) PublishPackage(
+	ctx context.Context,
+	info ArtifactInfo,
+	body io.Reader,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	var doc PublishDocument
+	if err := json.NewDecoder(io.LimitReader(body, maxPublishDocumentSize)).Decode(&doc); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage("invalid publish document: " + err.Error())
+	}
+	version, tarball, err := parsePublishDocument(info.Image, doc)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	metadata, err := npm.ExtractPackageJSON(bytes.NewReader(tarball))
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if metadata.Name != info.Image || metadata.Version != version {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(fmt.Sprintf(
+			"package.json of the tarball is for %s@%s, not %s@%s",
+			metadata.Name, metadata.Version, info.Image, version))
+	}
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeNPM {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't an npm registry", registry.Name))
+	}
+	published, err := c.isPublished(ctx, registry.ID, info.Image, version)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if published {
+		return responseHeaders, errcode.ErrCodeDenied.WithMessage(
+			fmt.Sprintf("cannot publish over the previously published version %s@%s", info.Image, version))
+	}
+
+	err = c.storeVersion(ctx, info, registry, version, tarball, metadata, distTagsOf(doc.DistTags, version), nil)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Headers["Content-Type"] = "application/json"
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// storeVersion uploads the tarball of a version to the registry and records the version.
+func (c *controller) storeVersion(
+	ctx context.Context,
+	info ArtifactInfo,
+	registry *types.Registry,
+	version string,
+	tarball []byte,
+	metadata *npm.PackageMetadata,
+	distTags []string,
+	cache *database.UpstreamCache,
+) error {
+	filename := npm.TarballName(info.Image, version)
+	path := info.Image + "/" + version + "/" + filename
+	fileInfo, err := c.fileManager.UploadFile(ctx, path, registry.Name, registry.ID,
+		info.RootParentID, storageRoot(info, registry), nil, bytes.NewReader(tarball), filename)
+	if err != nil {
+		return err
+	}
+
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       info.Image,
+				RegistryID: registry.ID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", info.Image, err)
+			}
+
+			metadataJSON, err := json.Marshal(&database.NpmMetadata{
+				Files: []database.File{{
+					Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+				}},
+				FileCount:       1,
+				DistTags:        distTags,
+				Shasum:          fileInfo.Sha1,
+				Integrity:       integrity(fileInfo.Sha512),
+				Cache:           cache,
+				PackageMetadata: *metadata,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", info.Image, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", info.Image, err)
+			}
+			return nil
+		})
+}
+
+// storageRoot returns the root the files of the registry are stored under.
+func storageRoot(info ArtifactInfo, registry *types.Registry) string {
+	if registry.StoragePrefix != "" {
+		return registry.StoragePrefix
+	}
+	return info.RootIdentifier
+}
+
+func (c *controller) isPublished(ctx context.Context, registryID int64, name, version string) (bool, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find package %s: %w", name, err)
+	}
+	_, err = c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find version %s of package %s: %w", version, name, err)
+	}
+	return true, nil
+}
+
+// parsePublishDocument returns the version and the decoded tarball of a publish document, which
+// npm sends with exactly one version and its tarball attached.
+func parsePublishDocument(name string, doc PublishDocument) (string, []byte, error) {
+	if err := npm.ValidatePackageName(name); err != nil {
+		return "", nil, err
+	}
+	if doc.Name != name {
+		return "", nil, fmt.Errorf("publish document is for package %q, not %q", doc.Name, name)
+	}
+	if len(doc.Versions) != 1 || len(doc.Attachments) != 1 {
+		return "", nil, errors.New("publish document must contain exactly one version and its tarball")
+	}
+	var version string
+	for v := range doc.Versions {
+		version = v
+	}
+	attachment, ok := doc.Attachments[name+"-"+version+".tgz"]
+	if !ok {
+		return "", nil, fmt.Errorf("tarball of version %s is missing", version)
+	}
+	tarball, err := base64.StdEncoding.DecodeString(attachment.Data)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid tarball encoding: %w", err)
+	}
+	if attachment.Length != 0 && int64(len(tarball)) != attachment.Length {
+		return "", nil, fmt.Errorf("tarball has %d bytes, expected %d", len(tarball), attachment.Length)
+	}
+	return version, tarball, nil
+}
+
+// distTagsOf returns the dist-tags of the publish document pointing to the version.
+func distTagsOf(distTags map[string]string, version string) []string {
+	var tags []string
+	for tag, v := range distTags {
+		if v == version {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// integrity returns the subresource integrity string of a hex encoded SHA-512 digest.
+func integrity(sha512Hex string) string {
+	digest, err := hex.DecodeString(sha512Hex)
+	if err != nil || len(digest) == 0 {
+		return ""
+	}
+	return "sha512-" + base64.StdEncoding.EncodeToString(digest)
+}
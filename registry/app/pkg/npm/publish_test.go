@@ -0,0 +1,104 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePublishDocument(t *testing.T) {
+	data := base64.StdEncoding.EncodeToString([]byte("tarball"))
+	doc := PublishDocument{
+		Name:        "@acme/left-pad",
+		Versions:    map[string]json.RawMessage{"1.0.0": json.RawMessage(`{}`)},
+		Attachments: map[string]Attachment{"@acme/left-pad-1.0.0.tgz": {Data: data, Length: 7}},
+	}
+	version, tarball, err := parsePublishDocument("@acme/left-pad", doc)
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", version)
+	assert.Equal(t, []byte("tarball"), tarball)
+
+	_, _, err = parsePublishDocument("@acme/right-pad", doc)
+	assert.Error(t, err)
+
+	doc.Attachments = map[string]Attachment{"@acme/left-pad-1.0.0.tgz": {Data: data, Length: 8}}
+	_, _, err = parsePublishDocument("@acme/left-pad", doc)
+	assert.Error(t, err)
+
+	doc.Attachments = map[string]Attachment{"@acme/left-pad-2.0.0.tgz": {Data: data}}
+	_, _, err = parsePublishDocument("@acme/left-pad", doc)
+	assert.Error(t, err)
+
+	doc.Versions["2.0.0"] = json.RawMessage(`{}`)
+	_, _, err = parsePublishDocument("@acme/left-pad", doc)
+	assert.Error(t, err)
+}
+
+func TestBuildPackageMetadata(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	artifact := func(version string, day int, tags ...string) types.Artifact {
+		metadata, err := json.Marshal(database.NpmMetadata{DistTags: tags, Shasum: "sha-" + version})
+		require.NoError(t, err)
+		return types.Artifact{Version: version, Metadata: metadata, CreatedAt: created.AddDate(0, 0, day)}
+	}
+
+	metadata, err := buildPackageMetadata("@acme/left-pad", []types.Artifact{
+		artifact("1.10.0", 2, "beta"),
+		artifact("1.9.0", 1, "beta"),
+		artifact("1.2.0", 0),
+	}, "https://example.com/pkg/root/reg/npm")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"beta": "1.10.0", "latest": "1.10.0"}, metadata.DistTags)
+	assert.Len(t, metadata.Versions, 3)
+	assert.Equal(t, Dist{
+		Tarball: "https://example.com/pkg/root/reg/npm/@acme/left-pad/-/left-pad-1.9.0.tgz",
+		Shasum:  "sha-1.9.0",
+	}, metadata.Versions["1.9.0"].Dist)
+	assert.Equal(t, "2024-01-01T00:00:00Z", metadata.Time["created"])
+	assert.Equal(t, "2024-01-03T00:00:00Z", metadata.Time["modified"])
+
+	metadata, err = buildPackageMetadata("left-pad", []types.Artifact{
+		artifact("2.0.0", 1, "next"),
+		artifact("1.0.0", 0, "latest"),
+	}, "https://example.com")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"latest": "1.0.0", "next": "2.0.0"}, metadata.DistTags)
+}
+
+func TestVersionFromTarballName(t *testing.T) {
+	version, ok := VersionFromTarballName("@acme/left-pad", "left-pad-1.0.0-rc.1.tgz")
+	assert.True(t, ok)
+	assert.Equal(t, "1.0.0-rc.1", version)
+
+	_, ok = VersionFromTarballName("left-pad", "right-pad-1.0.0.tgz")
+	assert.False(t, ok)
+	_, ok = VersionFromTarballName("left-pad", "left-pad-.tgz")
+	assert.False(t, ok)
+}
+
+func TestIntegrity(t *testing.T) {
+	assert.Equal(t, "sha512-3q2+7w==", integrity("deadbeef"))
+	assert.Equal(t, "", integrity("not hex"))
+}
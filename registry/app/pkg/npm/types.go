@@ -0,0 +1,63 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"encoding/json"
+
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Filename is the tarball requested for download, empty for package metadata requests.
+	Filename string
+}
+
+// Attachment is a tarball embedded in a publish document.
+type Attachment struct {
+	ContentType string `json:"content_type"`
+	Data        string `json:"data"`
+	Length      int64  `json:"length"`
+}
+
+// PublishDocument is the body the npm client sends on `npm publish`.
+type PublishDocument struct {
+	Name        string                     `json:"name"`
+	DistTags    map[string]string          `json:"dist-tags"`
+	Versions    map[string]json.RawMessage `json:"versions"`
+	Attachments map[string]Attachment      `json:"_attachments"`
+}
+
+// Dist locates and verifies the tarball of a version.
+type Dist struct {
+	Tarball   string `json:"tarball"`
+	Shasum    string `json:"shasum"`
+	Integrity string `json:"integrity,omitempty"`
+}
+
+type VersionMetadata struct {
+	npm.PackageMetadata
+	Dist Dist `json:"dist"`
+}
+
+// PackageMetadata is the package document ("packument") the npm client resolves versions from.
+type PackageMetadata struct {
+	Name     string                     `json:"name"`
+	DistTags map[string]string          `json:"dist-tags"`
+	Versions map[string]VersionMetadata `json:"versions"`
+	Time     map[string]string          `json:"time"`
+}
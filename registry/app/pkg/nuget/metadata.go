@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// GetServiceIndex returns the resources of the feed the client needs to push and restore packages.
+func (c *controller) GetServiceIndex(ctx context.Context, info ArtifactInfo) *ServiceIndex {
+	feedURL := c.feedURL(ctx, info)
+	return &ServiceIndex{
+		Version: "3.0.0",
+		Resources: []Resource{
+			{ID: feedURL + "/", Type: "PackagePublish/2.0.0"},
+			{ID: feedURL + "/package/", Type: "PackageBaseAddress/3.0.0"},
+			{ID: feedURL + "/registration/", Type: "RegistrationsBaseUrl"},
+			{ID: feedURL + "/registration/", Type: "RegistrationsBaseUrl/3.6.0",
+				Comment: "Includes SemVer 2.0.0 packages"},
+		},
+	}
+}
+
+// ListPackageVersions returns the lowercase versions of a package in ascending order.
+func (c *controller) ListPackageVersions(ctx context.Context, info ArtifactInfo) (*PackageVersions, errcode.Error) {
+	artifacts, errc := c.getArtifacts(ctx, info)
+	if errc.Code != 0 {
+		return nil, errc
+	}
+	versions := make([]string, 0, len(artifacts))
+	for _, a := range artifacts {
+		versions = append(versions, a.Version)
+	}
+	versioning.Sort(versioning.SchemeSemver, versions)
+	return &PackageVersions{Versions: versions}, errcode.Error{}
+}
+
+// GetRegistrationIndex returns the metadata of all versions of a package.
+func (c *controller) GetRegistrationIndex(ctx context.Context, info ArtifactInfo) (*RegistrationIndex, errcode.Error) {
+	artifacts, errc := c.getArtifacts(ctx, info)
+	if errc.Code != 0 {
+		return nil, errc
+	}
+	index, err := buildRegistrationIndex(c.feedURL(ctx, info), info.Image, artifacts)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return index, errcode.Error{}
+}
+
+// GetRegistrationLeaf returns the metadata of a single version of a package.
+func (c *controller) GetRegistrationLeaf(ctx context.Context, info ArtifactInfo) (*RegistrationLeaf, errcode.Error) {
+	artifacts, errc := c.getArtifacts(ctx, info)
+	if errc.Code != 0 {
+		return nil, errc
+	}
+	for _, a := range artifacts {
+		if a.Version != info.Version {
+			continue
+		}
+		leaf, err := buildRegistrationLeaf(c.feedURL(ctx, info), info.Image, a)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		return leaf, errcode.Error{}
+	}
+	return nil, errcode.ErrCodeNameUnknown.WithMessage("version " + info.Version + " of " + info.Image + " not found")
+}
+
+func (c *controller) getArtifacts(ctx context.Context, info ArtifactInfo) ([]types.Artifact, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, info.Image)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) || (err == nil && len(*artifacts) == 0) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage("package " + info.Image + " not found")
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return *artifacts, errcode.Error{}
+}
+
+func buildRegistrationIndex(feedURL, id string, artifacts []types.Artifact) (*RegistrationIndex, error) {
+	sorted := make([]types.Artifact, len(artifacts))
+	copy(sorted, artifacts)
+	sortArtifacts(sorted)
+
+	leaves := make([]RegistrationLeaf, 0, len(sorted))
+	for _, a := range sorted {
+		leaf, err := buildRegistrationLeaf(feedURL, id, a)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, *leaf)
+	}
+	indexURL := feedURL + "/registration/" + id + "/index.json"
+	return &RegistrationIndex{
+		Count: 1,
+		Items: []RegistrationPage{{
+			ID:    indexURL + "#page/" + sorted[0].Version + "/" + sorted[len(sorted)-1].Version,
+			Count: len(leaves),
+			Lower: sorted[0].Version,
+			Upper: sorted[len(sorted)-1].Version,
+			Items: leaves,
+		}},
+	}, nil
+}
+
+func buildRegistrationLeaf(feedURL, id string, a types.Artifact) (*RegistrationLeaf, error) {
+	metadata := &database.NugetMetadata{}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, err
+	}
+	leafURL := feedURL + "/registration/" + id + "/" + a.Version + ".json"
+	packageContent := feedURL + "/package/" + id + "/" + a.Version + "/" + nuget.PackageFilename(id, a.Version)
+	return &RegistrationLeaf{
+		ID: leafURL,
+		CatalogEntry: CatalogEntry{
+			ID:                       leafURL,
+			PackageID:                metadata.ID,
+			Version:                  a.Version,
+			Title:                    metadata.Title,
+			Description:              metadata.Description,
+			Summary:                  metadata.Summary,
+			Authors:                  metadata.Authors,
+			ProjectURL:               metadata.ProjectURL,
+			IconURL:                  metadata.IconURL,
+			LicenseURL:               metadata.LicenseURL,
+			LicenseExpression:        metadata.LicenseExpression,
+			RequireLicenseAcceptance: metadata.RequireLicenseAcceptance,
+			Tags:                     metadata.Tags,
+			DependencyGroups:         metadata.DependencyGroups,
+			PackageContent:           packageContent,
+			Published:                a.CreatedAt.UTC().Format(time.RFC3339),
+			Listed:                   true,
+		},
+		PackageContent: packageContent,
+	}, nil
+}
+
+func sortArtifacts(artifacts []types.Artifact) {
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, artifacts[i].Version, artifacts[j].Version) < 0
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildRegistrationIndex(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	artifact := func(version string) types.Artifact {
+		metadata, err := json.Marshal(&database.NugetMetadata{
+			Metadata: nuget.Metadata{ID: "Acme.Logging", Version: version, Authors: "Acme"},
+		})
+		require.NoError(t, err)
+		return types.Artifact{Version: version, Metadata: metadata, CreatedAt: created}
+	}
+	feedURL := "https://example.com/pkg/root/reg/nuget"
+
+	index, err := buildRegistrationIndex(feedURL, "acme.logging",
+		[]types.Artifact{artifact("1.10.0"), artifact("1.2.0"), artifact("1.2.0-beta")})
+	require.NoError(t, err)
+	require.Len(t, index.Items, 1)
+	page := index.Items[0]
+	assert.Equal(t, "1.2.0-beta", page.Lower)
+	assert.Equal(t, "1.10.0", page.Upper)
+	require.Len(t, page.Items, 3)
+	assert.Equal(t, "1.2.0", page.Items[1].CatalogEntry.Version)
+
+	leaf := page.Items[2]
+	assert.Equal(t, feedURL+"/registration/acme.logging/1.10.0.json", leaf.ID)
+	assert.Equal(t, feedURL+"/package/acme.logging/1.10.0/acme.logging.1.10.0.nupkg", leaf.PackageContent)
+	assert.Equal(t, "Acme.Logging", leaf.CatalogEntry.PackageID)
+	assert.Equal(t, "Acme", leaf.CatalogEntry.Authors)
+	assert.Equal(t, "2024-01-01T00:00:00Z", leaf.CatalogEntry.Published)
+}
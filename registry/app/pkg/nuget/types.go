@@ -0,0 +1,211 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"encoding/xml"
+
+	"github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Version is the lowercase normalized version of the request, empty for package level requests.
+	Version string
+	// Filename is the .nupkg or .nuspec requested for download.
+	Filename string
+}
+
+// ServiceIndex is the entry point of the NuGet V3 protocol, listing the resources of the feed.
+// Source: https://learn.microsoft.com/en-us/nuget/api/service-index
+type ServiceIndex struct {
+	Version   string     `json:"version"`
+	Resources []Resource `json:"resources"`
+}
+
+type Resource struct {
+	ID      string `json:"@id"`
+	Type    string `json:"@type"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// PackageVersions is the version list of a package in the package base address.
+type PackageVersions struct {
+	Versions []string `json:"versions"`
+}
+
+// RegistrationIndex lists the versions of a package with their metadata. All versions are
+// inlined in a single page.
+// Source: https://learn.microsoft.com/en-us/nuget/api/registration-base-url-resource
+type RegistrationIndex struct {
+	Count int                `json:"count"`
+	Items []RegistrationPage `json:"items"`
+}
+
+type RegistrationPage struct {
+	ID    string             `json:"@id"`
+	Count int                `json:"count"`
+	Lower string             `json:"lower"`
+	Upper string             `json:"upper"`
+	Items []RegistrationLeaf `json:"items"`
+}
+
+type RegistrationLeaf struct {
+	ID             string       `json:"@id"`
+	CatalogEntry   CatalogEntry `json:"catalogEntry"`
+	PackageContent string       `json:"packageContent"`
+}
+
+type CatalogEntry struct {
+	ID                       string                  `json:"@id"`
+	PackageID                string                  `json:"id"`
+	Version                  string                  `json:"version"`
+	Title                    string                  `json:"title,omitempty"`
+	Description              string                  `json:"description,omitempty"`
+	Summary                  string                  `json:"summary,omitempty"`
+	Authors                  string                  `json:"authors,omitempty"`
+	ProjectURL               string                  `json:"projectUrl,omitempty"`
+	IconURL                  string                  `json:"iconUrl,omitempty"`
+	LicenseURL               string                  `json:"licenseUrl,omitempty"`
+	LicenseExpression        string                  `json:"licenseExpression,omitempty"`
+	RequireLicenseAcceptance bool                    `json:"requireLicenseAcceptance"`
+	Tags                     []string                `json:"tags,omitempty"`
+	DependencyGroups         []nuget.DependencyGroup `json:"dependencyGroups,omitempty"`
+	PackageContent           string                  `json:"packageContent"`
+	Published                string                  `json:"published"`
+	Listed                   bool                    `json:"listed"`
+}
+
+// SearchQuery narrows down the packages listed by the V2 feed, which Chocolatey uses.
+type SearchQuery struct {
+	// Term matches the id, the title or a tag of the package, case insensitively.
+	Term string
+	// ID restricts the result to the versions of one package.
+	ID                string
+	IncludePrerelease bool
+	// Latest keeps the latest stable version of each package only.
+	Latest bool
+	// AbsoluteLatest keeps the latest version of each package only, prereleases included.
+	AbsoluteLatest bool
+	Skip           int
+	// Top is the maximum number of entries returned, all entries are returned if it's zero.
+	Top int
+}
+
+// ServiceDocument is the root of the V2 feed, listing its collections.
+// Source: https://www.odata.org/documentation/odata-version-2-0/atom-format/
+type ServiceDocument struct {
+	XMLName   xml.Name `xml:"service"`
+	Base      string   `xml:"xml:base,attr"`
+	Xmlns     string   `xml:"xmlns,attr"`
+	XmlnsAtom string   `xml:"xmlns:atom,attr"`
+	Workspace struct {
+		Title      atomText `xml:"atom:title"`
+		Collection struct {
+			Href  string   `xml:"href,attr"`
+			Title atomText `xml:"atom:title"`
+		} `xml:"collection"`
+	} `xml:"workspace"`
+}
+
+// Feed is an Atom feed of package versions of the V2 feed.
+type Feed struct {
+	XMLName xml.Name `xml:"feed"`
+	Base    string   `xml:"xml:base,attr"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	XmlnsD  string   `xml:"xmlns:d,attr"`
+	XmlnsM  string   `xml:"xmlns:m,attr"`
+	ID      string   `xml:"id"`
+	Title   atomText `xml:"title"`
+	Updated string   `xml:"updated"`
+	Link    atomLink `xml:"link"`
+	// Count is the number of entries matching the query, before $skip and $top are applied.
+	Count   int      `xml:"m:count"`
+	Entries []*Entry `xml:"entry"`
+}
+
+// Entry is a version of a package of the V2 feed.
+type Entry struct {
+	XMLName xml.Name `xml:"entry"`
+	// the namespaces are only set on entries served on their own, a feed declares them already
+	Base     string `xml:"xml:base,attr,omitempty"`
+	Xmlns    string `xml:"xmlns,attr,omitempty"`
+	XmlnsD   string `xml:"xmlns:d,attr,omitempty"`
+	XmlnsM   string `xml:"xmlns:m,attr,omitempty"`
+	ID       string `xml:"id"`
+	Category struct {
+		Term   string `xml:"term,attr"`
+		Scheme string `xml:"scheme,attr"`
+	} `xml:"category"`
+	Links   []atomLink `xml:"link"`
+	Title   atomText   `xml:"title"`
+	Updated string     `xml:"updated"`
+	Author  struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+	Summary atomText `xml:"summary"`
+	Content struct {
+		Type string `xml:"type,attr"`
+		Src  string `xml:"src,attr"`
+	} `xml:"content"`
+	Properties EntryProperties `xml:"m:properties"`
+}
+
+// EntryProperties are the properties of a V2FeedPackage the Chocolatey and NuGet V2 clients read.
+type EntryProperties struct {
+	ID                       string     `xml:"d:Id"`
+	Version                  string     `xml:"d:Version"`
+	NormalizedVersion        string     `xml:"d:NormalizedVersion"`
+	Title                    string     `xml:"d:Title"`
+	Authors                  string     `xml:"d:Authors"`
+	Owners                   string     `xml:"d:Owners"`
+	Description              string     `xml:"d:Description"`
+	Summary                  string     `xml:"d:Summary"`
+	ReleaseNotes             string     `xml:"d:ReleaseNotes"`
+	Copyright                string     `xml:"d:Copyright"`
+	Tags                     string     `xml:"d:Tags"`
+	ProjectURL               string     `xml:"d:ProjectUrl"`
+	IconURL                  string     `xml:"d:IconUrl"`
+	LicenseURL               string     `xml:"d:LicenseUrl"`
+	Dependencies             string     `xml:"d:Dependencies"`
+	RequireLicenseAcceptance typedValue `xml:"d:RequireLicenseAcceptance"`
+	IsLatestVersion          typedValue `xml:"d:IsLatestVersion"`
+	IsAbsoluteLatestVersion  typedValue `xml:"d:IsAbsoluteLatestVersion"`
+	IsPrerelease             typedValue `xml:"d:IsPrerelease"`
+	Listed                   typedValue `xml:"d:Listed"`
+	PackageSize              typedValue `xml:"d:PackageSize"`
+	DownloadCount            typedValue `xml:"d:DownloadCount"`
+	Created                  typedValue `xml:"d:Created"`
+	Published                typedValue `xml:"d:Published"`
+	LastUpdated              typedValue `xml:"d:LastUpdated"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomLink struct {
+	Rel   string `xml:"rel,attr"`
+	Title string `xml:"title,attr,omitempty"`
+	Href  string `xml:"href,attr"`
+}
+
+// typedValue is a property value of an EDM type, other than the default Edm.String.
+type typedValue struct {
+	Type  string `xml:"m:type,attr"`
+	Value string `xml:",chardata"`
+}
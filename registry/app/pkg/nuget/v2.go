@@ -0,0 +1,325 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+const (
+	xmlnsAtom         = "http://www.w3.org/2005/Atom"
+	xmlnsApp          = "http://www.w3.org/2007/app"
+	xmlnsDataServices = "http://schemas.microsoft.com/ado/2007/08/dataservices"
+	xmlnsMetadata     = "http://schemas.microsoft.com/ado/2007/08/dataservices/metadata"
+	packageEntityType = "NuGetGallery.OData.V2FeedPackage"
+)
+
+// GetServiceDocument returns the root of the V2 feed.
+func (c *controller) GetServiceDocument(ctx context.Context, info ArtifactInfo) *ServiceDocument {
+	doc := &ServiceDocument{
+		Base:      c.feedURLV2(ctx, info) + "/",
+		Xmlns:     xmlnsApp,
+		XmlnsAtom: xmlnsAtom,
+	}
+	doc.Workspace.Title = atomText{Type: "text", Value: "Default"}
+	doc.Workspace.Collection.Href = "Packages"
+	doc.Workspace.Collection.Title = atomText{Type: "text", Value: "Packages"}
+	return doc
+}
+
+// SearchPackages returns the versions of the packages of the registry matching the query, ordered
+// by package id and version.
+func (c *controller) SearchPackages(ctx context.Context, info ArtifactInfo, query SearchQuery) (
+	*Feed,
+	errcode.Error,
+) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	var names []string
+	if query.ID != "" {
+		names = []string{strings.ToLower(query.ID)}
+	} else {
+		names, err = c.imageDao.ListNamesByRegistryID(ctx, registry.ID)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+	}
+
+	feedURL := c.feedURLV2(ctx, info)
+	var entries []*Entry
+	for _, name := range names {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		found, err := buildEntries(feedURL, *artifacts, query)
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		entries = append(entries, found...)
+	}
+	return buildFeed(feedURL, entries, query), errcode.Error{}
+}
+
+// GetPackage returns a single version of a package of the V2 feed.
+func (c *controller) GetPackage(ctx context.Context, info ArtifactInfo) (*Entry, errcode.Error) {
+	artifacts, errc := c.getArtifacts(ctx, info)
+	if errc.Code != 0 {
+		return nil, errc
+	}
+	feedURL := c.feedURLV2(ctx, info)
+	entries, err := buildEntries(feedURL, artifacts, SearchQuery{IncludePrerelease: true})
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	for _, entry := range entries {
+		if entry.Properties.NormalizedVersion != info.Version {
+			continue
+		}
+		entry.Base = feedURL + "/"
+		entry.Xmlns = xmlnsAtom
+		entry.XmlnsD = xmlnsDataServices
+		entry.XmlnsM = xmlnsMetadata
+		return entry, errcode.Error{}
+	}
+	return nil, errcode.ErrCodeNameUnknown.WithMessage("version " + info.Version + " of " + info.Image + " not found")
+}
+
+func (c *controller) feedURLV2(ctx context.Context, info ArtifactInfo) string {
+	return c.feedURL(ctx, info) + "/v2"
+}
+
+// buildEntries returns the entries of the versions of a package that match the query, in
+// ascending version order.
+func buildEntries(feedURL string, artifacts []types.Artifact, query SearchQuery) ([]*Entry, error) {
+	sorted := make([]types.Artifact, len(artifacts))
+	copy(sorted, artifacts)
+	sortArtifacts(sorted)
+
+	latest, absoluteLatest := -1, len(sorted)-1
+	for i, a := range sorted {
+		if !isPrerelease(a.Version) {
+			latest = i
+		}
+	}
+
+	entries := make([]*Entry, 0, len(sorted))
+	for i, a := range sorted {
+		switch {
+		case query.Latest && i != latest,
+			query.AbsoluteLatest && i != absoluteLatest,
+			!query.IncludePrerelease && isPrerelease(a.Version):
+			continue
+		}
+		metadata := &database.NugetMetadata{}
+		if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+			return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+		}
+		if !matchesTerm(&metadata.Metadata, query.Term) {
+			continue
+		}
+		entries = append(entries, buildEntry(feedURL, a, metadata, i == latest, i == absoluteLatest))
+	}
+	return entries, nil
+}
+
+func buildEntry(
+	feedURL string,
+	a types.Artifact,
+	metadata *database.NugetMetadata,
+	isLatest, isAbsoluteLatest bool,
+) *Entry {
+	var size int64
+	if len(metadata.Files) > 0 {
+		size = metadata.Files[0].Size
+	}
+	created := a.CreatedAt.UTC().Format(time.RFC3339)
+	location := fmt.Sprintf("Packages(Id='%s',Version='%s')", metadata.ID, a.Version)
+
+	entry := &Entry{
+		ID:      feedURL + "/" + location,
+		Links:   []atomLink{{Rel: "edit", Title: "V2FeedPackage", Href: location}},
+		Title:   atomText{Type: "text", Value: metadata.ID},
+		Updated: created,
+		Summary: atomText{Type: "text", Value: metadata.Summary},
+		Properties: EntryProperties{
+			ID:                       metadata.ID,
+			Version:                  a.Version,
+			NormalizedVersion:        a.Version,
+			Title:                    metadata.Title,
+			Authors:                  metadata.Authors,
+			Owners:                   metadata.Owners,
+			Description:              metadata.Description,
+			Summary:                  metadata.Summary,
+			ReleaseNotes:             metadata.ReleaseNotes,
+			Copyright:                metadata.Copyright,
+			Tags:                     strings.Join(metadata.Tags, " "),
+			ProjectURL:               metadata.ProjectURL,
+			IconURL:                  metadata.IconURL,
+			LicenseURL:               metadata.LicenseURL,
+			Dependencies:             formatDependencies(metadata.DependencyGroups),
+			RequireLicenseAcceptance: boolValue(metadata.RequireLicenseAcceptance),
+			IsLatestVersion:          boolValue(isLatest),
+			IsAbsoluteLatestVersion:  boolValue(isAbsoluteLatest),
+			IsPrerelease:             boolValue(isPrerelease(a.Version)),
+			Listed:                   boolValue(true),
+			PackageSize:              typedValue{Type: "Edm.Int64", Value: strconv.FormatInt(size, 10)},
+			DownloadCount:            typedValue{Type: "Edm.Int64", Value: "0"},
+			Created:                  typedValue{Type: "Edm.DateTime", Value: created},
+			Published:                typedValue{Type: "Edm.DateTime", Value: created},
+			LastUpdated:              typedValue{Type: "Edm.DateTime", Value: created},
+		},
+	}
+	entry.Category.Term = packageEntityType
+	entry.Category.Scheme = xmlnsDataServices + "/scheme"
+	entry.Author.Name = metadata.Authors
+	entry.Content.Type = "application/zip"
+	entry.Content.Src = feedURL + "/package/" + strings.ToLower(metadata.ID) + "/" + a.Version
+	return entry
+}
+
+func buildFeed(feedURL string, entries []*Entry, query SearchQuery) *Feed {
+	count := len(entries)
+	if query.Skip > 0 {
+		entries = entries[min(query.Skip, len(entries)):]
+	}
+	if query.Top > 0 && query.Top < len(entries) {
+		entries = entries[:query.Top]
+	}
+	return &Feed{
+		Base:    feedURL + "/",
+		Xmlns:   xmlnsAtom,
+		XmlnsD:  xmlnsDataServices,
+		XmlnsM:  xmlnsMetadata,
+		ID:      feedURL + "/Packages",
+		Title:   atomText{Type: "text", Value: "Packages"},
+		Updated: time.Now().UTC().Format(time.RFC3339),
+		Link:    atomLink{Rel: "self", Title: "Packages", Href: "Packages"},
+		Count:   count,
+		Entries: entries,
+	}
+}
+
+func matchesTerm(metadata *nuget.Metadata, term string) bool {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" ||
+		strings.Contains(strings.ToLower(metadata.ID), term) ||
+		strings.Contains(strings.ToLower(metadata.Title), term) {
+		return true
+	}
+	for _, tag := range metadata.Tags {
+		if strings.EqualFold(tag, term) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDependencies returns the dependencies of a package in the V2 format, id:range:framework
+// separated by pipes. A framework without dependencies is listed with an empty id and range.
+func formatDependencies(groups []nuget.DependencyGroup) string {
+	var deps []string
+	for _, g := range groups {
+		if len(g.Dependencies) == 0 {
+			deps = append(deps, "::"+g.TargetFramework)
+			continue
+		}
+		for _, d := range g.Dependencies {
+			deps = append(deps, d.ID+":"+d.Range+":"+g.TargetFramework)
+		}
+	}
+	return strings.Join(deps, "|")
+}
+
+func isPrerelease(version string) bool {
+	return strings.Contains(version, "-")
+}
+
+func boolValue(v bool) typedValue {
+	return typedValue{Type: "Edm.Boolean", Value: strconv.FormatBool(v)}
+}
+
+// ServiceMetadata is the $metadata document of the V2 feed, describing the package entity and the
+// Search and FindPackagesById functions clients call.
+const ServiceMetadata = `<?xml version="1.0" encoding="utf-8"?>
+<edmx:Edmx Version="1.0" xmlns:edmx="http://schemas.microsoft.com/ado/2007/06/edmx">
+  <edmx:DataServices m:DataServiceVersion="2.0" m:MaxDataServiceVersion="2.0"
+      xmlns:m="http://schemas.microsoft.com/ado/2007/08/dataservices/metadata">
+    <Schema Namespace="NuGetGallery.OData" xmlns="http://schemas.microsoft.com/ado/2006/04/edm">
+      <EntityType Name="V2FeedPackage" m:HasStream="true">
+        <Key>
+          <PropertyRef Name="Id" />
+          <PropertyRef Name="Version" />
+        </Key>
+        <Property Name="Id" Type="Edm.String" Nullable="false" />
+        <Property Name="Version" Type="Edm.String" Nullable="false" />
+        <Property Name="NormalizedVersion" Type="Edm.String" />
+        <Property Name="Title" Type="Edm.String" />
+        <Property Name="Authors" Type="Edm.String" />
+        <Property Name="Owners" Type="Edm.String" />
+        <Property Name="Description" Type="Edm.String" />
+        <Property Name="Summary" Type="Edm.String" />
+        <Property Name="ReleaseNotes" Type="Edm.String" />
+        <Property Name="Copyright" Type="Edm.String" />
+        <Property Name="Tags" Type="Edm.String" />
+        <Property Name="ProjectUrl" Type="Edm.String" />
+        <Property Name="IconUrl" Type="Edm.String" />
+        <Property Name="LicenseUrl" Type="Edm.String" />
+        <Property Name="Dependencies" Type="Edm.String" />
+        <Property Name="RequireLicenseAcceptance" Type="Edm.Boolean" Nullable="false" />
+        <Property Name="IsLatestVersion" Type="Edm.Boolean" Nullable="false" />
+        <Property Name="IsAbsoluteLatestVersion" Type="Edm.Boolean" Nullable="false" />
+        <Property Name="IsPrerelease" Type="Edm.Boolean" Nullable="false" />
+        <Property Name="Listed" Type="Edm.Boolean" Nullable="false" />
+        <Property Name="PackageSize" Type="Edm.Int64" Nullable="false" />
+        <Property Name="DownloadCount" Type="Edm.Int64" Nullable="false" />
+        <Property Name="Created" Type="Edm.DateTime" Nullable="false" />
+        <Property Name="Published" Type="Edm.DateTime" Nullable="false" />
+        <Property Name="LastUpdated" Type="Edm.DateTime" Nullable="false" />
+      </EntityType>
+      <EntityContainer Name="V2FeedContext" m:IsDefaultEntityContainer="true">
+        <EntitySet Name="Packages" EntityType="NuGetGallery.OData.V2FeedPackage" />
+        <FunctionImport Name="Search" ReturnType="Collection(NuGetGallery.OData.V2FeedPackage)"
+            EntitySet="Packages">
+          <Parameter Name="searchTerm" Type="Edm.String" FixedLength="false" Unicode="false" />
+          <Parameter Name="targetFramework" Type="Edm.String" FixedLength="false" Unicode="false" />
+          <Parameter Name="includePrerelease" Type="Edm.Boolean" Nullable="false" />
+        </FunctionImport>
+        <FunctionImport Name="FindPackagesById" ReturnType="Collection(NuGetGallery.OData.V2FeedPackage)"
+            EntitySet="Packages">
+          <Parameter Name="id" Type="Edm.String" FixedLength="false" Unicode="false" />
+        </FunctionImport>
+      </EntityContainer>
+    </Schema>
+  </edmx:DataServices>
+</edmx:Edmx>
+`
@@ -0,0 +1,96 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nuget
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildEntries(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	artifact := func(version string) types.Artifact {
+		metadata, err := json.Marshal(&database.NugetMetadata{
+			Files:    []database.File{{Size: 42}},
+			Metadata: nuget.Metadata{ID: "Acme.Tools", Version: version, Tags: []string{"cli", "windows"}},
+		})
+		require.NoError(t, err)
+		return types.Artifact{Version: version, Metadata: metadata, CreatedAt: created}
+	}
+	feedURL := "https://example.com/pkg/root/reg/nuget/v2"
+	artifacts := []types.Artifact{artifact("2.0.0-rc.1"), artifact("1.10.0"), artifact("1.2.0")}
+
+	entries, err := buildEntries(feedURL, artifacts, SearchQuery{IncludePrerelease: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "1.2.0", entries[0].Properties.Version)
+	latest := entries[1]
+	assert.Equal(t, "true", latest.Properties.IsLatestVersion.Value)
+	assert.Equal(t, "false", latest.Properties.IsAbsoluteLatestVersion.Value)
+	assert.Equal(t, "42", latest.Properties.PackageSize.Value)
+	assert.Equal(t, "cli windows", latest.Properties.Tags)
+	assert.Equal(t, feedURL+"/package/acme.tools/1.10.0", latest.Content.Src)
+	assert.Equal(t, feedURL+"/Packages(Id='Acme.Tools',Version='1.10.0')", latest.ID)
+	assert.Equal(t, "true", entries[2].Properties.IsAbsoluteLatestVersion.Value)
+
+	entries, err = buildEntries(feedURL, artifacts, SearchQuery{})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	entries, err = buildEntries(feedURL, artifacts, SearchQuery{Latest: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "1.10.0", entries[0].Properties.Version)
+
+	entries, err = buildEntries(feedURL, artifacts, SearchQuery{AbsoluteLatest: true, IncludePrerelease: true})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "2.0.0-rc.1", entries[0].Properties.Version)
+
+	entries, err = buildEntries(feedURL, artifacts, SearchQuery{Term: "WINDOWS"})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	entries, err = buildEntries(feedURL, artifacts, SearchQuery{Term: "linux"})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestBuildFeed(t *testing.T) {
+	entries := []*Entry{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+
+	feed := buildFeed("https://example.com/nuget/v2", entries, SearchQuery{Skip: 1, Top: 1})
+	assert.Equal(t, 3, feed.Count)
+	require.Len(t, feed.Entries, 1)
+	assert.Equal(t, "b", feed.Entries[0].ID)
+
+	feed = buildFeed("https://example.com/nuget/v2", entries, SearchQuery{Skip: 5})
+	assert.Empty(t, feed.Entries)
+}
+
+func TestFormatDependencies(t *testing.T) {
+	assert.Equal(t, "Acme.Core:[1.0.0, ):net45|::net6.0", formatDependencies([]nuget.DependencyGroup{
+		{TargetFramework: "net45", Dependencies: []nuget.Dependency{{ID: "Acme.Core", Range: "[1.0.0, )"}}},
+		{TargetFramework: "net6.0"},
+	}))
+	assert.Empty(t, formatDependencies(nil))
+}
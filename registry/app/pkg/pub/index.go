@@ -0,0 +1,224 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	pubmetadata "github.com/harness/gitness/registry/app/metadata/pub"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+func (c *controller) GetPackage(ctx context.Context, info ArtifactInfo) (*PackageListing, errcode.Error) {
+	if err := pubmetadata.ValidateName(info.Name); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions, err := c.listVersions(ctx, registry.ID, info.Name)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(versions) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("package %s not found", info.Name))
+	}
+
+	names := make([]string, 0, len(versions))
+	for _, v := range versions {
+		names = append(names, v.Version)
+	}
+	latest := pubmetadata.LatestVersion(names)
+	listing := &PackageListing{Name: info.Name, Versions: make([]VersionInfo, 0, len(versions))}
+	for _, v := range versions {
+		versionInfo := c.versionInfo(ctx, info, v)
+		if v.Version == latest {
+			listing.Latest = versionInfo
+		}
+		listing.Versions = append(listing.Versions, versionInfo)
+	}
+	return listing, errcode.Error{}
+}
+
+func (c *controller) GetVersion(ctx context.Context, info ArtifactInfo) (*VersionInfo, errcode.Error) {
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versionInfo := c.versionInfo(ctx, info, version)
+	return &versionInfo, errcode.Error{}
+}
+
+func (c *controller) DownloadArchive(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	filename := pubmetadata.ArchiveFilename(info.Name, info.Version)
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.Name, info.Version, filename),
+		types.Registry{
+			ID:   version.registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, version.registryID, info.Name, info.Version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// versionInfo describes a version as the hosted repository API does.
+func (c *controller) versionInfo(ctx context.Context, info ArtifactInfo, version *publishedVersion) VersionInfo {
+	return VersionInfo{
+		Version:       version.Version,
+		ArchiveURL:    archiveURL(c.registryURL(ctx, info), version.Name, version.Version),
+		ArchiveSha256: version.Sha256,
+		Pubspec:       version.RawPubspec,
+		Published:     version.createdAt.UTC().Format(time.RFC3339),
+	}
+}
+
+type publishedVersion struct {
+	database.PubMetadata
+	registryID int64
+	createdAt  time.Time
+}
+
+func (c *controller) getRegistry(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypePUB {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a pub registry", registry.Name))
+	}
+	return registry, errcode.Error{}
+}
+
+// findVersion returns a published version of a package.
+func (c *controller) findVersion(ctx context.Context, info ArtifactInfo) (*publishedVersion, errcode.Error) {
+	if err := pubmetadata.ValidateName(info.Name); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := pubmetadata.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	version, err := c.getVersion(ctx, registry.ID, info.Name, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of %s not found", info.Version, info.Name))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return version, errcode.Error{}
+}
+
+// listVersions lists the versions of a package from the lowest to the highest.
+func (c *controller) listVersions(ctx context.Context, registryID int64, name string) ([]*publishedVersion, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", name, err)
+	}
+	versions := make([]*publishedVersion, 0, len(*artifacts))
+	for i := range *artifacts {
+		version, err := toPublishedVersion(&(*artifacts)[i], registryID)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, versions[i].Version, versions[j].Version) < 0
+	})
+	return versions, nil
+}
+
+// getVersion returns a version of a package, or gitnessstore.ErrResourceNotFound if it wasn't
+// published.
+func (c *controller) getVersion(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+) (*publishedVersion, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", name, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, name, err)
+	}
+	return toPublishedVersion(a, registryID)
+}
+
+func toPublishedVersion(a *types.Artifact, registryID int64) (*publishedVersion, error) {
+	version := &publishedVersion{registryID: registryID, createdAt: a.CreatedAt}
+	if err := json.Unmarshal(a.Metadata, &version.PubMetadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+	}
+	return version, nil
+}
+
+// archiveURL returns the URL clients download the archive of a version from.
+func archiveURL(registryURL, name, version string) string {
+	return registryURL + "/packages/" + name + "/versions/" + version + ".tar.gz"
+}
+
+// filePath returns the path a file of a version is stored at.
+func filePath(name, version, filename string) string {
+	return name + "/" + version + "/" + filename
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"encoding/json"
+
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Name    string
+	Version string
+}
+
+// PackageListing lists the versions of a package as the hosted repository API does.
+type PackageListing struct {
+	Name     string        `json:"name"`
+	Latest   VersionInfo   `json:"latest"`
+	Versions []VersionInfo `json:"versions"`
+}
+
+// VersionInfo describes a version of a package, with the pubspec it was published with.
+type VersionInfo struct {
+	Version       string          `json:"version"`
+	ArchiveURL    string          `json:"archive_url"`
+	ArchiveSha256 string          `json:"archive_sha256"`
+	Pubspec       json.RawMessage `json:"pubspec"`
+	Published     string          `json:"published"`
+}
+
+// UploadURL tells clients the URL to upload the archive of a new version to, along with the
+// fields to post with the archive.
+type UploadURL struct {
+	URL    string            `json:"url"`
+	Fields map[string]string `json:"fields"`
+}
+
+// SuccessResponse is the message clients show once a version was published.
+type SuccessResponse struct {
+	Success Message `json:"success"`
+}
+
+type Message struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	pubmetadata "github.com/harness/gitness/registry/app/metadata/pub"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+func (c *controller) NewUpload(ctx context.Context, info ArtifactInfo) (*UploadURL, errcode.Error) {
+	if _, errc := c.getRegistry(ctx, info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	return &UploadURL{
+		URL:    c.registryURL(ctx, info) + "/api/packages/versions/upload",
+		Fields: map[string]string{},
+	}, errcode.Error{}
+}
+
+// Upload publishes a version read from the pubspec of its archive. Versions are immutable, as
+// clients cache archives by their checksum.
+func (c *controller) Upload(
+	ctx context.Context,
+	info ArtifactInfo,
+	archive io.ReaderAt,
+	size int64,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	pubspec, err := pubmetadata.ReadArchive(io.NewSectionReader(archive, 0, size))
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, errc
+	}
+	_, err = c.getVersion(ctx, registry.ID, pubspec.Name, pubspec.Version)
+	if err == nil {
+		return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("version %s of %s was published before", pubspec.Version, pubspec.Name))
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	filename := pubmetadata.ArchiveFilename(pubspec.Name, pubspec.Version)
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(pubspec.Name, pubspec.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		io.NewSectionReader(archive, 0, size), filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata := database.PubMetadata{
+		Files: []database.File{{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		}},
+		FileCount:  1,
+		Sha256:     fileInfo.Sha256,
+		Pubspec:    pubspec.Pubspec,
+		RawPubspec: pubspec.Raw,
+	}
+	if err = c.publish(ctx, registry.ID, pubspec.Name, pubspec.Version, metadata); err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	query := url.Values{"package": {pubspec.Name}, "version": {pubspec.Version}}
+	responseHeaders.Headers["Location"] = c.registryURL(ctx, info) + "/api/packages/versions/finalize?" +
+		query.Encode()
+	responseHeaders.Code = http.StatusNoContent
+	return responseHeaders, errcode.Error{}
+}
+
+func (c *controller) FinalizeUpload(ctx context.Context, info ArtifactInfo) (*SuccessResponse, errcode.Error) {
+	if _, errc := c.findVersion(ctx, info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	return &SuccessResponse{
+		Success: Message{Message: fmt.Sprintf("Successfully uploaded %s version %s.", info.Name, info.Version)},
+	}, errcode.Error{}
+}
+
+// publish creates the version of a package whose archive was uploaded.
+func (c *controller) publish(
+	ctx context.Context,
+	registryID int64,
+	name, version string,
+	metadata database.PubMetadata,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       name,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", name, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", name, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", name, err)
+			}
+			return nil
+		})
+}
@@ -0,0 +1,356 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	puppetmetadata "github.com/harness/gitness/registry/app/metadata/puppet"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// timeFormat is the format the Forge API writes times in.
+const timeFormat = "2006-01-02 15:04:05 -0700"
+
+func (c *controller) GetModule(ctx context.Context, info ArtifactInfo) (*Module, errcode.Error) {
+	versions, errc := c.findVersions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	names := make([]string, 0, len(versions))
+	releases := make([]ReleaseRef, 0, len(versions))
+	created, updated := versions[0].createdAt, versions[0].createdAt
+	for i := len(versions) - 1; i >= 0; i-- {
+		v := versions[i]
+		names = append(names, v.Version)
+		releases = append(releases, releaseRef(info, v))
+		if v.createdAt.Before(created) {
+			created = v.createdAt
+		}
+		if v.createdAt.After(updated) {
+			updated = v.createdAt
+		}
+	}
+	current := puppetmetadata.CurrentVersion(names)
+	module := moduleRef(info)
+	result := &Module{
+		URI:       module.URI,
+		Slug:      module.Slug,
+		Name:      module.Name,
+		Owner:     module.Owner,
+		CreatedAt: formatTime(created),
+		UpdatedAt: formatTime(updated),
+		Releases:  releases,
+	}
+	for _, v := range versions {
+		if v.Version == current {
+			result.CurrentRelease = release(info, v)
+		}
+	}
+	return result, errcode.Error{}
+}
+
+func (c *controller) ListReleases(
+	ctx context.Context,
+	info ArtifactInfo,
+	limit, offset int,
+) (*ReleaseList, errcode.Error) {
+	if limit < 1 || offset < 0 {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage("invalid limit or offset")
+	}
+	versions, errc := c.findVersions(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	list := &ReleaseList{
+		Pagination: pagination(info.Slug(), len(versions), limit, offset),
+		Results:    []*Release{},
+	}
+	for i := len(versions) - 1 - offset; i >= 0 && len(list.Results) < limit; i-- {
+		list.Results = append(list.Results, release(info, versions[i]))
+	}
+	return list, errcode.Error{}
+}
+
+func (c *controller) GetRelease(ctx context.Context, info ArtifactInfo) (*Release, errcode.Error) {
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	return release(info, version), errcode.Error{}
+}
+
+func (c *controller) DownloadArchive(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	version, errc := c.findVersion(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	filename := puppetmetadata.ArchiveFilename(info.Owner, info.Name, info.Version)
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.Slug(), info.Version, filename),
+		types.Registry{
+			ID:   version.registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, version.registryID,
+		info.Slug(), info.Version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+type publishedVersion struct {
+	database.PuppetMetadata
+	registryID int64
+	createdAt  time.Time
+}
+
+func (c *controller) getRegistry(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypePUPPET {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a Puppet registry", registry.Name))
+	}
+	return registry, errcode.Error{}
+}
+
+// findVersions returns the versions of a module from the lowest to the highest, failing unless
+// one was published.
+func (c *controller) findVersions(ctx context.Context, info ArtifactInfo) ([]*publishedVersion, errcode.Error) {
+	if errc := validateModule(info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions, err := c.listVersions(ctx, registry.ID, info.Slug())
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(versions) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("module %s not found", info.Slug()))
+	}
+	return versions, errcode.Error{}
+}
+
+// findVersion returns a published version of a module.
+func (c *controller) findVersion(ctx context.Context, info ArtifactInfo) (*publishedVersion, errcode.Error) {
+	if errc := validateModule(info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	if err := puppetmetadata.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	version, err := c.getVersion(ctx, registry.ID, info.Slug(), info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of %s not found", info.Version, info.Slug()))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return version, errcode.Error{}
+}
+
+func validateModule(info ArtifactInfo) errcode.Error {
+	if err := puppetmetadata.ValidateOwner(info.Owner); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := puppetmetadata.ValidateName(info.Name); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	return errcode.Error{}
+}
+
+// listVersions lists the versions of a module from the lowest to the highest.
+func (c *controller) listVersions(ctx context.Context, registryID int64, slug string) ([]*publishedVersion, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, slug)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", slug, err)
+	}
+	versions := make([]*publishedVersion, 0, len(*artifacts))
+	for i := range *artifacts {
+		version, err := toPublishedVersion(&(*artifacts)[i], registryID)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, versions[i].Version, versions[j].Version) < 0
+	})
+	return versions, nil
+}
+
+// getVersion returns a version of a module, or gitnessstore.ErrResourceNotFound if it wasn't
+// published.
+func (c *controller) getVersion(
+	ctx context.Context,
+	registryID int64,
+	slug, version string,
+) (*publishedVersion, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, slug)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", slug, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, slug, err)
+	}
+	return toPublishedVersion(a, registryID)
+}
+
+func toPublishedVersion(a *types.Artifact, registryID int64) (*publishedVersion, error) {
+	version := &publishedVersion{registryID: registryID, createdAt: a.CreatedAt}
+	if err := json.Unmarshal(a.Metadata, &version.PuppetMetadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+	}
+	return version, nil
+}
+
+func moduleRef(info ArtifactInfo) ModuleRef {
+	return ModuleRef{
+		URI:   "/v3/modules/" + info.Slug(),
+		Slug:  info.Slug(),
+		Name:  info.Name,
+		Owner: Owner{URI: "/v3/users/" + info.Owner, Slug: info.Owner, Username: info.Owner},
+	}
+}
+
+func releaseRef(info ArtifactInfo, version *publishedVersion) ReleaseRef {
+	slug := puppetmetadata.ReleaseSlug(info.Owner, info.Name, version.Version)
+	return ReleaseRef{
+		URI:       "/v3/releases/" + slug,
+		Slug:      slug,
+		Version:   version.Version,
+		FileURI:   fileURI(info.Owner, info.Name, version.Version),
+		FileSize:  version.size(),
+		CreatedAt: formatTime(version.createdAt),
+	}
+}
+
+func release(info ArtifactInfo, version *publishedVersion) *Release {
+	ref := releaseRef(info, version)
+	tags := version.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return &Release{
+		URI:        ref.URI,
+		Slug:       ref.Slug,
+		Module:     moduleRef(info),
+		Version:    version.Version,
+		Metadata:   version.Metadata,
+		Tags:       tags,
+		FileURI:    ref.FileURI,
+		FileSize:   ref.FileSize,
+		FileMD5:    version.MD5,
+		FileSha256: version.Sha256,
+		CreatedAt:  ref.CreatedAt,
+		UpdatedAt:  ref.CreatedAt,
+	}
+}
+
+func (v *publishedVersion) size() int64 {
+	if len(v.Files) == 0 {
+		return 0
+	}
+	return v.Files[0].Size
+}
+
+// pagination describes a page of the count releases of a module. Links are relative to the
+// registry, which puppet resolves them against like the URIs of files.
+func pagination(slug string, count, limit, offset int) Pagination {
+	page := func(offset int) string {
+		return "/v3/releases?" + url.Values{
+			"module": {slug}, "limit": {strconv.Itoa(limit)}, "offset": {strconv.Itoa(offset)},
+		}.Encode()
+	}
+	p := Pagination{
+		Limit:   limit,
+		Offset:  offset,
+		First:   page(0),
+		Current: page(offset),
+		Total:   count,
+	}
+	if offset > 0 {
+		previous := page(max(offset-limit, 0))
+		p.Previous = &previous
+	}
+	if offset+limit < count {
+		next := page(offset + limit)
+		p.Next = &next
+	}
+	return p
+}
+
+// fileURI returns the URI clients download the archive of a release from.
+func fileURI(owner, name, version string) string {
+	return "/v3/files/" + puppetmetadata.ArchiveFilename(owner, name, version)
+}
+
+// filePath returns the path a file of a version is stored at.
+func filePath(slug, version, filename string) string {
+	return slug + "/" + version + "/" + filename
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"testing"
+	"time"
+
+	puppetmetadata "github.com/harness/gitness/registry/app/metadata/puppet"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagination(t *testing.T) {
+	p := pagination("acme-ntp", 25, 10, 10)
+	assert.Equal(t, "/v3/releases?limit=10&module=acme-ntp&offset=0", p.First)
+	assert.Equal(t, "/v3/releases?limit=10&module=acme-ntp&offset=10", p.Current)
+	assert.Equal(t, "/v3/releases?limit=10&module=acme-ntp&offset=0", *p.Previous)
+	assert.Equal(t, "/v3/releases?limit=10&module=acme-ntp&offset=20", *p.Next)
+	assert.Equal(t, 25, p.Total)
+
+	p = pagination("acme-ntp", 3, 20, 0)
+	assert.Nil(t, p.Previous)
+	assert.Nil(t, p.Next)
+}
+
+func TestRelease(t *testing.T) {
+	info := ArtifactInfo{Owner: "acme", Name: "ntp"}
+	version := &publishedVersion{
+		PuppetMetadata: database.PuppetMetadata{
+			Files:    []database.File{{Size: 42, Filename: "acme-ntp-1.2.0.tar.gz"}},
+			Sha256:   "abc",
+			MD5:      "def",
+			Metadata: puppetmetadata.Metadata{Name: "acme-ntp", Version: "1.2.0"},
+		},
+		createdAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	r := release(info, version)
+	assert.Equal(t, "/v3/releases/acme-ntp-1.2.0", r.URI)
+	assert.Equal(t, "/v3/modules/acme-ntp", r.Module.URI)
+	assert.Equal(t, "/v3/files/acme-ntp-1.2.0.tar.gz", r.FileURI)
+	assert.Equal(t, int64(42), r.FileSize)
+	assert.Equal(t, "def", r.FileMD5)
+	assert.Equal(t, "2024-03-01 10:00:00 +0000", r.CreatedAt)
+	assert.Equal(t, []string{}, r.Tags)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	puppetmetadata "github.com/harness/gitness/registry/app/metadata/puppet"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Owner   string
+	Name    string
+	Version string
+}
+
+// Slug returns the slug of the module, which it is stored under.
+func (a ArtifactInfo) Slug() string {
+	return puppetmetadata.Slug(a.Owner, a.Name)
+}
+
+type Owner struct {
+	URI      string `json:"uri"`
+	Slug     string `json:"slug"`
+	Username string `json:"username"`
+}
+
+// ModuleRef is the abbreviated form of a module the releases of the module link to.
+type ModuleRef struct {
+	URI          string  `json:"uri"`
+	Slug         string  `json:"slug"`
+	Name         string  `json:"name"`
+	DeprecatedAt *string `json:"deprecated_at"`
+	Owner        Owner   `json:"owner"`
+}
+
+// ReleaseRef is the abbreviated form of a release modules list their releases with.
+type ReleaseRef struct {
+	URI       string  `json:"uri"`
+	Slug      string  `json:"slug"`
+	Version   string  `json:"version"`
+	FileURI   string  `json:"file_uri"`
+	FileSize  int64   `json:"file_size"`
+	CreatedAt string  `json:"created_at"`
+	DeletedAt *string `json:"deleted_at"`
+}
+
+// Module describes a module along with its current release.
+type Module struct {
+	URI            string       `json:"uri"`
+	Slug           string       `json:"slug"`
+	Name           string       `json:"name"`
+	DeprecatedAt   *string      `json:"deprecated_at"`
+	Owner          Owner        `json:"owner"`
+	CreatedAt      string       `json:"created_at"`
+	UpdatedAt      string       `json:"updated_at"`
+	CurrentRelease *Release     `json:"current_release"`
+	Releases       []ReleaseRef `json:"releases"`
+}
+
+// Release describes a version of a module, with the archive clients install it from and the
+// metadata it was built with.
+type Release struct {
+	URI        string                  `json:"uri"`
+	Slug       string                  `json:"slug"`
+	Module     ModuleRef               `json:"module"`
+	Version    string                  `json:"version"`
+	Metadata   puppetmetadata.Metadata `json:"metadata"`
+	Tags       []string                `json:"tags"`
+	FileURI    string                  `json:"file_uri"`
+	FileSize   int64                   `json:"file_size"`
+	FileMD5    string                  `json:"file_md5"`
+	FileSha256 string                  `json:"file_sha256"`
+	CreatedAt  string                  `json:"created_at"`
+	UpdatedAt  string                  `json:"updated_at"`
+	DeletedAt  *string                 `json:"deleted_at"`
+}
+
+// ReleaseList is a page of the releases of a module, linking to the other pages.
+type ReleaseList struct {
+	Pagination Pagination `json:"pagination"`
+	Results    []*Release `json:"results"`
+}
+
+type Pagination struct {
+	Limit    int     `json:"limit"`
+	Offset   int     `json:"offset"`
+	First    string  `json:"first"`
+	Previous *string `json:"previous"`
+	Current  string  `json:"current"`
+	Next     *string `json:"next"`
+	Total    int     `json:"total"`
+}
+
+// PublishedRelease links to a release once its archive is published.
+type PublishedRelease struct {
+	URI  string `json:"uri"`
+	Slug string `json:"slug"`
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package puppet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	puppetmetadata "github.com/harness/gitness/registry/app/metadata/puppet"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// Publish publishes a release read from the metadata of its archive. Releases are immutable, as
+// clients verify archives with the checksums they were published with.
+func (c *controller) Publish(
+	ctx context.Context,
+	info ArtifactInfo,
+	archive io.ReaderAt,
+	size int64,
+) (*PublishedRelease, errcode.Error) {
+	metadata, err := puppetmetadata.ReadArchive(io.NewSectionReader(archive, 0, size))
+	if err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	info.Owner, info.Name, info.Version = metadata.Owner(), metadata.ModuleName(), metadata.Version
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	_, err = c.getVersion(ctx, registry.ID, info.Slug(), info.Version)
+	if err == nil {
+		return nil, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("version %s of %s was published before", info.Version, info.Slug()))
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	filename := puppetmetadata.ArchiveFilename(info.Owner, info.Name, info.Version)
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(info.Slug(), info.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		io.NewSectionReader(archive, 0, size), filename)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	stored := database.PuppetMetadata{
+		Files: []database.File{{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		}},
+		FileCount: 1,
+		Sha256:    fileInfo.Sha256,
+		MD5:       fileInfo.MD5,
+		Metadata:  *metadata,
+	}
+	if err = c.publish(ctx, registry.ID, info.Slug(), info.Version, stored); err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	slug := puppetmetadata.ReleaseSlug(info.Owner, info.Name, info.Version)
+	return &PublishedRelease{URI: "/v3/releases/" + slug, Slug: slug}, errcode.Error{}
+}
+
+// publish creates the version of a module whose archive was uploaded.
+func (c *controller) publish(
+	ctx context.Context,
+	registryID int64,
+	slug, version string,
+	metadata database.PuppetMetadata,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       slug,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", slug, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", slug, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", slug, err)
+			}
+			return nil
+		})
+}
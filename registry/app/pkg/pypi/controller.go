@@ -18,6 +18,7 @@ import (
 	"context"
 	"mime/multipart"
 
+	"github.com/harness/gitness/app/services/refcache"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
@@ -25,6 +26,8 @@ import (
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 )
 
@@ -37,10 +40,19 @@ type controller struct {
 	imageDao    store.ImageRepository
 	artifactDao store.ArtifactRepository
 	urlProvider urlprovider.Provider
+	// spaceFinder and secretService resolve the credentials of upstream proxies.
+	spaceFinder   refcache.SpaceFinder
+	secretService secret.Service
+	// packageRuleStore holds the package rules of upstream proxies.
+	packageRuleStore store.PackageRuleRepository
+	// upstreamURLStore holds the URLs upstream proxies fail over to.
+	upstreamURLStore     store.UpstreamURLRepository
+	downloadStatRecorder *downloadstats.Recorder
 }
 
 type Controller interface {
 	GetPackageMetadata(ctx context.Context, info ArtifactInfo, packageName string) (PackageMetadata, error)
+	ListPackages(ctx context.Context, info ArtifactInfo) ([]Package, error)
 	UploadPackageFile(
 		ctx context.Context,
 		info ArtifactInfo,
@@ -65,14 +77,24 @@ func NewController(
 	fileManager filemanager.FileManager,
 	tx dbtx.Transactor,
 	urlProvider urlprovider.Provider,
+	spaceFinder refcache.SpaceFinder,
+	secretService secret.Service,
+	packageRuleStore store.PackageRuleRepository,
+	upstreamURLStore store.UpstreamURLRepository,
+	downloadStatRecorder *downloadstats.Recorder,
 ) Controller {
 	return &controller{
-		proxyStore:  proxyStore,
-		registryDao: registryDao,
-		imageDao:    imageDao,
-		artifactDao: artifactDao,
-		fileManager: fileManager,
-		tx:          tx,
-		urlProvider: urlProvider,
+		proxyStore:           proxyStore,
+		registryDao:          registryDao,
+		imageDao:             imageDao,
+		artifactDao:          artifactDao,
+		fileManager:          fileManager,
+		tx:                   tx,
+		urlProvider:          urlProvider,
+		spaceFinder:          spaceFinder,
+		secretService:        secretService,
+		packageRuleStore:     packageRuleStore,
+		upstreamURLStore:     upstreamURLStore,
+		downloadStatRecorder: downloadStatRecorder,
 	}
 }
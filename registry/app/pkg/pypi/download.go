@@ -18,11 +18,14 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/storage"
 	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
 )
 
 func (c *controller) DownloadPackageFile(ctx context.Context, info pkg.ArtifactInfo, image, version, filename string) (
@@ -36,16 +39,55 @@ func (c *controller) DownloadPackageFile(ctx context.Context, info pkg.ArtifactI
 		Code:    0,
 	}
 
-	path := "/" + image + "/" + version + "/" + filename
-	reg, _ := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
-
-	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
-		ID:   reg.ID,
-		Name: info.RegIdentifier,
-	}, info.RootIdentifier)
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
 	if err != nil {
-		return responseHeaders, nil, "", errcode.ErrCodeRootNotFound.WithDetail(err)
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
 	}
-	responseHeaders.Code = http.StatusOK
-	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+
+	fileReader, redirectURL, err := c.downloadFile(ctx, info, reg, image, version, filename)
+	if err == nil {
+		pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, image, version)
+		responseHeaders.Code = http.StatusOK
+		return responseHeaders, fileReader, redirectURL, errcode.Error{}
+	}
+
+	upstreams, uerr := pkg.GetUpstreamRegistries(ctx, c.registryDao, reg)
+	if uerr != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(uerr)
+	}
+	for _, upstream := range upstreams {
+		if upstream.Type == artifact.RegistryTypeUPSTREAM {
+			if perr := c.checkPackageRules(ctx, upstream, image, version); perr != nil {
+				log.Ctx(ctx).Warn().Err(perr).Msgf("python file %s isn't served from upstream %s",
+					filename, upstream.Name)
+				continue
+			}
+		}
+		fileReader, redirectURL, perr := c.downloadFile(ctx, info, &upstream, image, version, filename)
+		if perr != nil && upstream.Type == artifact.RegistryTypeUPSTREAM {
+			if perr = c.proxyFile(ctx, info, upstream, image, version, filename); perr == nil {
+				fileReader, redirectURL, perr = c.downloadFile(ctx, info, &upstream, image, version, filename)
+			}
+		}
+		if perr == nil {
+			pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, upstream.ID, image, version)
+			responseHeaders.Code = http.StatusOK
+			return responseHeaders, fileReader, redirectURL, errcode.Error{}
+		}
+		log.Ctx(ctx).Warn().Err(perr).Msgf("failed to proxy python file %s from upstream %s",
+			filename, upstream.Name)
+	}
+	return responseHeaders, nil, "", errcode.ErrCodeRootNotFound.WithDetail(err)
+}
+
+// downloadFile reads a file of a version stored in the registry.
+func (c *controller) downloadFile(
+	ctx context.Context, info pkg.ArtifactInfo, registry *types.Registry, image, version, filename string,
+) (*storage.FileReader, string, error) {
+	path := "/" + image + "/" + version + "/" + filename
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, path, types.Registry{
+		ID:   registry.ID,
+		Name: registry.Name,
+	}, storageRoot(info, registry))
+	return fileReader, redirectURL, err
 }
@@ -20,10 +20,13 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/harness/gitness/registry/app/metadata/pypi"
 	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
 )
 
-// Metadata represents the metadata of a PyPI package.
+// GetPackageMetadata returns the files of all versions of a project for the simple repository
+// API. The project is looked up by its normalized name, so `pip install Foo_Bar` finds foo-bar.
 func (c *controller) GetPackageMetadata(ctx context.Context, info ArtifactInfo, packageName string) (
 	PackageMetadata,
 	error,
@@ -37,39 +40,93 @@ func (c *controller) GetPackageMetadata(ctx context.Context, info ArtifactInfo,
 		return packageMetadata, err
 	}
 
-	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, packageName)
+	name := pypi.NormalizeName(packageName)
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, name)
 	if err != nil {
 		return packageMetadata, err
 	}
-
-	for _, artifact := range *artifacts {
-		metadata := &database.PyPiMetadata{}
-		err = json.Unmarshal(artifact.Metadata, metadata)
+	if len(*artifacts) == 0 && name != packageName {
+		// Projects uploaded before names were normalized are stored as they were typed.
+		name = packageName
+		artifacts, err = c.artifactDao.GetByRegistryIDAndImage(ctx, registry.ID, name)
 		if err != nil {
 			return packageMetadata, err
 		}
+	}
+	if len(*artifacts) == 0 {
+		return c.proxyPackageMetadata(ctx, info, registry, packageName)
+	}
+	packageMetadata.Name = name
+	packageMetadata.Files, err = c.listFiles(ctx, info, name, *artifacts)
+	if err != nil {
+		return packageMetadata, err
+	}
+	return packageMetadata, nil
+}
+
+// listFiles returns the files of the versions of a project stored in a registry, sorted by name.
+func (c *controller) listFiles(
+	ctx context.Context, info ArtifactInfo, name string, artifacts []types.Artifact,
+) ([]File, error) {
+	files := []File{}
+	for _, artifact := range artifacts {
+		metadata := &database.PyPiMetadata{}
+		if err := json.Unmarshal(artifact.Metadata, metadata); err != nil {
+			return nil, err
+		}
 
 		for _, file := range metadata.Files {
 			fileInfo := File{
-				Name: file.Filename,
-				FileURL: c.urlProvider.RegistryURL(ctx) + fmt.Sprintf(
-					"/pkg/%s/%s/python/files/%s/%s/%s",
-					info.RootIdentifier,
-					info.RegIdentifier,
-					packageName,
-					artifact.Version,
-					file.Filename,
-				),
+				Name:           file.Filename,
+				FileURL:        c.fileURL(ctx, info, name, artifact.Version, file.Filename),
 				RequiresPython: metadata.RequiresPython,
 			}
-			packageMetadata.Files = append(packageMetadata.Files, fileInfo)
+			if d, ok := metadata.Distribution(file.Filename); ok {
+				if d.Sha256 != "" {
+					fileInfo.FileURL += "#sha256=" + d.Sha256
+				}
+				fileInfo.RequiresPython = d.RequiresPython
+			}
+			files = append(files, fileInfo)
 		}
 	}
 
 	// Sort files by Name
-	sort.Slice(packageMetadata.Files, func(i, j int) bool {
-		return packageMetadata.Files[i].Name < packageMetadata.Files[j].Name
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
 	})
+	return files, nil
+}
 
-	return packageMetadata, nil
+// fileURL returns the URL the registry serves a file of a project version at.
+func (c *controller) fileURL(ctx context.Context, info ArtifactInfo, name, version, filename string) string {
+	return c.urlProvider.RegistryURL(ctx) + fmt.Sprintf(
+		"/pkg/%s/%s/python/files/%s/%s/%s",
+		info.RootIdentifier,
+		info.RegIdentifier,
+		name,
+		version,
+		filename,
+	)
+}
+
+// ListPackages returns the projects of the registry for the root of the simple repository API.
+func (c *controller) ListPackages(ctx context.Context, info ArtifactInfo) ([]Package, error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registry.ID)
+	if err != nil {
+		return nil, err
+	}
+	packages := make([]Package, 0, len(names))
+	for _, name := range names {
+		packages = append(packages, Package{
+			Name: name,
+			URL: c.urlProvider.RegistryURL(ctx) + fmt.Sprintf(
+				"/pkg/%s/%s/python/simple/%s/", info.RootIdentifier, info.RegIdentifier, name),
+		})
+	}
+	return packages, nil
 }
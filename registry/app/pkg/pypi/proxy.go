@@ -0,0 +1,299 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/metadata/pypi"
+	"github.com/harness/gitness/registry/app/pkg"
+	proxy2 "github.com/harness/gitness/registry/app/remote/controller/proxy"
+	pypiproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/pypi"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+// proxyPackageMetadata returns the files of a project from the first upstream proxy of the registry that
+// has it, pointing them to the registry. The files cached from an upstream are listed while it is
+// unreachable, so builds keep resolving the projects they used before.
+func (c *controller) proxyPackageMetadata(
+	ctx context.Context, info ArtifactInfo, registry *types.Registry, packageName string,
+) (PackageMetadata, error) {
+	name := pypi.NormalizeName(packageName)
+	packageMetadata := PackageMetadata{Name: packageName, Files: []File{}}
+	upstreams, err := pkg.GetUpstreamRegistries(ctx, c.registryDao, registry)
+	if err != nil {
+		return packageMetadata, err
+	}
+	for _, upstream := range upstreams {
+		var files []File
+		if upstream.Type == artifact.RegistryTypeVIRTUAL {
+			files, err = c.memberFiles(ctx, info, upstream, name)
+		} else {
+			files, err = c.proxyFiles(ctx, info, upstream, name)
+		}
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to resolve python project %s from upstream %s",
+				name, upstream.Name)
+			continue
+		}
+		return PackageMetadata{Name: name, Files: files}, nil
+	}
+	return packageMetadata, fmt.Errorf("project %s: %w", packageName, gitnessstore.ErrResourceNotFound)
+}
+
+// proxyFiles returns the files of the project from an upstream proxy, falling back to the files it cached.
+// Projects and versions its package rules block aren't listed.
+func (c *controller) proxyFiles(
+	ctx context.Context, info ArtifactInfo, upstream types.Registry, name string,
+) ([]File, error) {
+	proxy, err := c.proxyStore.GetByRegistryIdentifier(ctx, upstream.ParentID, upstream.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream proxy %s: %w", upstream.Name, err)
+	}
+	err = proxy2.CheckPackageRules(ctx, c.packageRuleStore, *proxy, proxy2.PackageCoordinate{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	files, err := c.upstreamFiles(ctx, info, upstream, name)
+	if err != nil {
+		files, err = c.cachedFiles(ctx, info, upstream, name, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]string, len(files))
+	for i, file := range files {
+		versions[i], _ = pypi.VersionFromFilename(name, file.Name)
+	}
+	allowed, err := proxy2.FilterPackageVersions(ctx, c.packageRuleStore, *proxy,
+		proxy2.PackageCoordinate{Name: name}, versions)
+	if err != nil {
+		return nil, err
+	}
+	if len(allowed) == len(versions) {
+		return files, nil
+	}
+	filtered := []File{}
+	for i, file := range files {
+		if slices.Contains(allowed, versions[i]) {
+			filtered = append(filtered, file)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, fmt.Errorf("all versions of project %s are blocked by upstream %s: %w", name, upstream.Name,
+			gitnessstore.ErrResourceNotFound)
+	}
+	return filtered, nil
+}
+
+// upstreamFiles returns the files the upstream lists for the project, served by the registry.
+func (c *controller) upstreamFiles(
+	ctx context.Context, info ArtifactInfo, upstream types.Registry, name string,
+) ([]File, error) {
+	links, _, err := c.upstreamLinks(ctx, upstream, name)
+	if err != nil {
+		return nil, err
+	}
+	files := []File{}
+	for _, link := range links {
+		version, ok := pypi.VersionFromFilename(name, link.Filename)
+		if !ok {
+			continue
+		}
+		file := File{
+			Name:           link.Filename,
+			FileURL:        c.fileURL(ctx, info, name, version, link.Filename),
+			RequiresPython: link.RequiresPython,
+			Yanked:         link.Yanked,
+		}
+		if link.Sha256 != "" {
+			file.FileURL += "#sha256=" + link.Sha256
+		}
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+func (c *controller) cachedFiles(
+	ctx context.Context, info ArtifactInfo, upstream types.Registry, name string, upstreamErr error,
+) ([]File, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, upstream.ID, name)
+	if err != nil || len(*artifacts) == 0 {
+		return nil, upstreamErr
+	}
+	log.Ctx(ctx).Warn().Err(upstreamErr).Msgf("serving the files of python project %s cached from upstream %s",
+		name, upstream.Name)
+	return c.listFiles(ctx, info, name, *artifacts)
+}
+
+// memberFiles returns the files of the project stored in a virtual member of the registry.
+func (c *controller) memberFiles(
+	ctx context.Context, info ArtifactInfo, member types.Registry, name string,
+) ([]File, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, member.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(*artifacts) == 0 {
+		return nil, fmt.Errorf("project %s: %w", name, gitnessstore.ErrResourceNotFound)
+	}
+	return c.listFiles(ctx, info, name, *artifacts)
+}
+
+// upstreamLinks returns the files of the project page of the upstream, with their URLs resolved. The
+// files are kept for the metadata TTL of the proxy.
+func (c *controller) upstreamLinks(
+	ctx context.Context, upstream types.Registry, name string,
+) ([]pypi.Link, *types.UpstreamProxy, error) {
+	proxy, err := c.proxyStore.GetByRegistryIdentifier(ctx, upstream.ParentID, upstream.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get upstream proxy %s: %w", upstream.Name, err)
+	}
+	if proxy.Offline {
+		return nil, nil, fmt.Errorf("upstream %s is offline", upstream.Name)
+	}
+	proxy2.FailOver(ctx, c.upstreamURLStore, proxy)
+	fetchKey := "projects/" + name
+	if cached, ok := proxy2.Fetches.Get(proxy.RegistryID, fetchKey, proxy.MetadataTTL); ok {
+		var links []pypi.Link
+		if err := json.Unmarshal(cached, &links); err == nil {
+			return links, proxy, nil
+		}
+	}
+	remote, err := pypiproxy.NewRemoteHelper(ctx, c.spaceFinder, c.secretService, *proxy)
+	if err != nil {
+		return nil, nil, err
+	}
+	page, pageURL, err := remote.GetProjectPage(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	links := pypi.ParseSimplePage(page)
+	for i := range links {
+		ref, err := url.Parse(links[i].URL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid URL of file %s: %w", links[i].Filename, err)
+		}
+		links[i].URL = pageURL.ResolveReference(ref).String()
+	}
+	if proxy.MetadataTTL > 0 {
+		if content, err := json.Marshal(links); err == nil {
+			proxy2.Fetches.Put(proxy.RegistryID, fetchKey, content)
+		}
+	}
+	return links, proxy, nil
+}
+
+// checkPackageRules returns an error if the package rules of the upstream proxy block the version, which
+// applies to the files it already cached as well.
+func (c *controller) checkPackageRules(ctx context.Context, upstream types.Registry, name, version string) error {
+	proxy, err := c.proxyStore.GetByRegistryIdentifier(ctx, upstream.ParentID, upstream.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get upstream proxy %s: %w", upstream.Name, err)
+	}
+	return proxy2.CheckPackageRules(ctx, c.packageRuleStore, *proxy,
+		proxy2.PackageCoordinate{Name: name, Version: version})
+}
+
+// proxyFile caches a file of the project from the upstream in the upstream proxy registry. The file is
+// checked against the digest the upstream lists before it is stored.
+func (c *controller) proxyFile(
+	ctx context.Context, info pkg.ArtifactInfo, upstream types.Registry, name, version, filename string,
+) error {
+	links, proxy, err := c.upstreamLinks(ctx, upstream, name)
+	if err != nil {
+		return err
+	}
+	var link *pypi.Link
+	for i := range links {
+		if links[i].Filename == filename {
+			link = &links[i]
+		}
+	}
+	if link == nil {
+		return fmt.Errorf("file %s: %w", filename, gitnessstore.ErrResourceNotFound)
+	}
+	remote, err := pypiproxy.NewRemoteHelper(ctx, c.spaceFinder, c.secretService, *proxy)
+	if err != nil {
+		return err
+	}
+	body, err := remote.GetFile(ctx, link.URL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	file, err := os.CreateTemp("", "pypi-proxy-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+	h := sha256.New()
+	if _, err = io.Copy(io.MultiWriter(file, h), body); err != nil {
+		return fmt.Errorf("failed to download file %s: %w", filename, err)
+	}
+	if digest := hex.EncodeToString(h.Sum(nil)); link.Sha256 != "" && !strings.EqualFold(digest, link.Sha256) {
+		return fmt.Errorf("sha256 digest of file %s is %s, upstream lists %s", filename, digest, link.Sha256)
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read file %s: %w", filename, err)
+	}
+
+	path := name + "/" + version + "/" + filename
+	fileInfo, err := c.fileManager.UploadFile(ctx, path, upstream.Name, upstream.ID,
+		info.RootParentID, storageRoot(info, &upstream), file, nil, filename)
+	if err != nil {
+		return err
+	}
+	fileArtifactInfo := ArtifactInfo{
+		ArtifactInfo: &pkg.ArtifactInfo{BaseInfo: info.BaseInfo, RegIdentifier: upstream.Name, Image: name},
+		Metadata:     pypi.Metadata{Name: name, Version: version, RequiresPython: link.RequiresPython},
+	}
+	return c.recordFile(ctx, fileArtifactInfo, upstream.ID, fileInfo, &database.UpstreamCache{
+		Upstream:  upstream.Name,
+		SourceURL: link.URL,
+		CachedAt:  time.Now().UnixMilli(),
+	})
+}
+
+// storageRoot returns the root the files of the registry are stored under.
+func storageRoot(info pkg.ArtifactInfo, registry *types.Registry) string {
+	if registry.StoragePrefix != "" {
+		return registry.StoragePrefix
+	}
+	return info.RootIdentifier
+}
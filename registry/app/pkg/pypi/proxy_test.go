@@ -0,0 +1,30 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageRoot(t *testing.T) {
+	info := pkg.ArtifactInfo{BaseInfo: &pkg.BaseInfo{RootIdentifier: "root"}}
+	assert.Equal(t, "root", storageRoot(info, &types.Registry{}))
+	assert.Equal(t, "prefix", storageRoot(info, &types.Registry{StoragePrefix: "prefix"}))
+}
@@ -30,9 +30,16 @@ type File struct {
 	FileURL        string
 	Name           string
 	RequiresPython string
+	Yanked         bool
 }
 
 type PackageMetadata struct {
 	Name  string
 	Files []File
 }
+
+// Package is a project listed by the root of the simple repository API.
+type Package struct {
+	Name string
+	URL  string
+}
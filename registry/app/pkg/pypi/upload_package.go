@@ -16,22 +16,30 @@ package pypi
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/pypi"
 	"github.com/harness/gitness/registry/app/pkg"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/store/database"
 	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
 )
 
-// UploadPackageFile FIXME: Extract this upload function for all types of packageTypes
-// uploads the package file to the storage.
+// UploadPackageFile stores a distribution uploaded by `twine upload`. A file of a version can't be
+// replaced once uploaded, like on PyPI.
 func (c *controller) UploadPackageFile(
 	ctx context.Context,
 	info ArtifactInfo,
@@ -43,29 +51,58 @@ func (c *controller) UploadPackageFile(
 		Code:    0,
 	}
 
-	// Fixme: Generalize this check for all package types
-	// err = c.CheckIfFileAlreadyExist(ctx, info)
-	//
-	// if err != nil {
-	//	return nil, "", errcode.ErrCodeInvalidRequest.WithDetail(err)
-	// }
+	if err := validateUpload(info, fileHeader.Filename); err != nil {
+		return responseHeaders, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	info.Image = pypi.NormalizeName(info.Metadata.Name)
 
 	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
 	if err != nil {
-		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+		return responseHeaders, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypePYTHON {
+		return responseHeaders, "", errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a python registry", registry.Name))
 	}
 	fileName := fileHeader.Filename
+	exists, err := c.fileExists(ctx, registry.ID, info.Image, info.Metadata.Version, fileName)
+	if err != nil {
+		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if exists {
+		return responseHeaders, "", errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("file %s already exists", fileName))
+	}
+
+	if info.Metadata.SHA256Digest != "" {
+		if err = verifySha256(file, info.Metadata.SHA256Digest); err != nil {
+			return responseHeaders, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+		}
+	}
+
 	path := info.Image + "/" + info.Metadata.Version + "/" + fileName
 	fileInfo, err := c.fileManager.UploadFile(ctx, path, info.RegIdentifier, registry.ID,
-		info.RootParentID, info.RootIdentifier, file, nil, fileName)
+		info.RootParentID, info.StorageRoot(), file, nil, fileName)
+	if err != nil {
+		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	err = c.recordFile(ctx, info, registry.ID, fileInfo, nil)
 	if err != nil {
 		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
 	}
-	err = c.tx.WithTx(
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, fileInfo.Sha256, errcode.Error{}
+}
+
+// recordFile adds a stored file to its version, creating the project and the version if needed.
+func (c *controller) recordFile(
+	ctx context.Context, info ArtifactInfo, registryID int64, fileInfo pkg.FileInfo, cache *database.UpstreamCache,
+) error {
+	return c.tx.WithTx(
 		ctx, func(ctx context.Context) error {
 			image := &types.Image{
 				Name:       info.Image,
-				RegistryID: registry.ID,
+				RegistryID: registryID,
 				Enabled:    true,
 			}
 			err := c.imageDao.CreateOrUpdate(ctx, image)
@@ -75,7 +112,7 @@ func (c *controller) UploadPackageFile(
 
 			dbArtifact, err := c.artifactDao.GetByName(ctx, image.ID, info.Metadata.Version)
 
-			if err != nil && !strings.Contains(err.Error(), "resource not found") {
+			if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
 				return fmt.Errorf("failed to fetch artifact : [%s] with error: %w", info.Image, err)
 			}
 
@@ -86,6 +123,9 @@ func (c *controller) UploadPackageFile(
 			if err2 != nil {
 				return fmt.Errorf("failed to update metadata for artifact: [%s] with error: %w", info.Image, err2)
 			}
+			if cache != nil {
+				metadata.Distributions[len(metadata.Distributions)-1].Cache = cache
+			}
 
 			metadataJSON, err := json.Marshal(metadata)
 
@@ -103,45 +143,106 @@ func (c *controller) UploadPackageFile(
 			}
 			return nil
 		})
+}
+
+// validateUpload checks the project name, version and file name twine sent with the file.
+func validateUpload(info ArtifactInfo, filename string) error {
+	if info.Metadata.Name == "" || info.Metadata.Version == "" {
+		return errors.New("name and version of the package are required")
+	}
+	if !versioning.Valid(versioning.SchemePEP440, info.Metadata.Version) {
+		return fmt.Errorf("version %q isn't a valid PEP 440 version", info.Metadata.Version)
+	}
+	return pypi.ValidateFilename(info.Metadata.Name, filename)
+}
 
+// verifySha256 checks the file against the digest twine computed and rewinds it for the upload.
+func verifySha256(file multipart.File, expected string) error {
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("sha256 digest of the file is %s, expected %s", actual, expected)
+	}
+	return nil
+}
+
+func (c *controller) fileExists(ctx context.Context, registryID int64, name, version, filename string) (bool, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, name)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
 	if err != nil {
-		return responseHeaders, "", errcode.ErrCodeUnknown.WithDetail(err)
+		return false, fmt.Errorf("failed to find package %s: %w", name, err)
 	}
-	responseHeaders.Code = http.StatusCreated
-	return responseHeaders, fileInfo.Sha256, errcode.Error{}
+	art, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find version %s of package %s: %w", version, name, err)
+	}
+	metadata := &database.PyPiMetadata{}
+	if err = json.Unmarshal(art.Metadata, metadata); err != nil {
+		return false, fmt.Errorf("failed to get metadata of version %s of package %s: %w", version, name, err)
+	}
+	for _, f := range metadata.Files {
+		if f.Filename == filename {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
+// updateMetadata merges the uploaded file into the metadata of the version. Metadata of the
+// version itself is taken from the latest upload, the files and distributions accumulate.
 func (c *controller) updateMetadata(
 	dbArtifact *types.Artifact, metadata *database.PyPiMetadata,
 	info ArtifactInfo, fileInfo pkg.FileInfo,
 ) error {
-	var files []database.File
 	if dbArtifact != nil {
-		err := json.Unmarshal(dbArtifact.Metadata, metadata)
+		existing := &database.PyPiMetadata{}
+		err := json.Unmarshal(dbArtifact.Metadata, existing)
 		if err != nil {
 			return fmt.Errorf("failed to get metadata for artifact: [%s] with registry: [%s] and error: %w", info.Image,
 				info.RegIdentifier, err)
 		}
-		fileExist := false
-		files = metadata.Files
-		for _, file := range files {
-			if file.Filename == fileInfo.Filename {
-				fileExist = true
-			}
-		}
-		if !fileExist {
-			files = append(files, database.File{
-				Size: fileInfo.Size, Filename: fileInfo.Filename,
-				CreatedAt: time.Now().UnixMilli(),
-			})
-			metadata.Files = files
-		}
-	} else {
-		files = append(files, database.File{
-			Size: fileInfo.Size, Filename: fileInfo.Filename,
-			CreatedAt: time.Now().UnixMilli(),
-		})
-		metadata.Files = files
+		metadata.Files = existing.Files
+		metadata.Distributions = existing.Distributions
 	}
+	metadata.Files = append(metadata.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename,
+		CreatedAt: time.Now().UnixMilli(),
+	})
+	metadata.FileCount = int64(len(metadata.Files))
+	metadata.Distributions = append(metadata.Distributions, distributionOf(info.Metadata, fileInfo))
+	// The digests describe the uploaded file only, they are kept per distribution.
+	metadata.MD5Digest = ""
+	metadata.SHA256Digest = ""
+	metadata.Blake2256Digest = ""
+	metadata.FileType = ""
+	metadata.PyVersion = ""
 	return nil
 }
+
+func distributionOf(md pypi.Metadata, fileInfo pkg.FileInfo) database.PyPiDistribution {
+	fileType := md.FileType
+	if fileType == "" {
+		fileType = pypi.FileType(fileInfo.Filename)
+	}
+	pyVersion := md.PyVersion
+	if pyVersion == "" || pyVersion == "source" {
+		pyVersion = pypi.WheelPythonTag(fileInfo.Filename)
+	}
+	return database.PyPiDistribution{
+		Filename:       fileInfo.Filename,
+		FileType:       fileType,
+		PyVersion:      pyVersion,
+		RequiresPython: md.RequiresPython,
+		Sha256:         fileInfo.Sha256,
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/pypi"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateUpload(t *testing.T) {
+	info := ArtifactInfo{Metadata: pypi.Metadata{Name: "Foo_Bar", Version: "1.0.post1"}}
+	assert.NoError(t, validateUpload(info, "foo_bar-1.0.post1-py3-none-any.whl"))
+	assert.Error(t, validateUpload(info, "other-1.0.post1.tar.gz"))
+
+	info.Metadata.Version = "not a version"
+	assert.Error(t, validateUpload(info, "foo_bar-1.0.tar.gz"))
+
+	info.Metadata.Version = ""
+	assert.Error(t, validateUpload(info, "foo_bar-1.0.tar.gz"))
+}
+
+func TestUpdateMetadataAccumulatesDistributions(t *testing.T) {
+	c := &controller{}
+	info := ArtifactInfo{
+		ArtifactInfo: &pkg.ArtifactInfo{},
+		Metadata: pypi.Metadata{
+			Name: "foo", Version: "1.0", RequiresPython: ">=3.8", FileType: "sdist", PyVersion: "source",
+		},
+	}
+	metadata := &database.PyPiMetadata{Metadata: info.Metadata}
+	require.NoError(t, c.updateMetadata(nil, metadata, info, pkg.FileInfo{
+		Filename: "foo-1.0.tar.gz", Size: 10, Sha256: "aa",
+	}))
+	raw, err := json.Marshal(metadata)
+	require.NoError(t, err)
+
+	info.Metadata.FileType = ""
+	info.Metadata.PyVersion = ""
+	metadata = &database.PyPiMetadata{Metadata: info.Metadata}
+	require.NoError(t, c.updateMetadata(&types.Artifact{Metadata: raw}, metadata, info, pkg.FileInfo{
+		Filename: "foo-1.0-py3-none-any.whl", Size: 20, Sha256: "bb",
+	}))
+
+	assert.Equal(t, int64(2), metadata.FileCount)
+	assert.Len(t, metadata.Files, 2)
+	assert.Equal(t, []database.PyPiDistribution{
+		{Filename: "foo-1.0.tar.gz", FileType: "sdist", RequiresPython: ">=3.8", Sha256: "aa"},
+		{Filename: "foo-1.0-py3-none-any.whl", FileType: "bdist_wheel", PyVersion: "py3",
+			RequiresPython: ">=3.8", Sha256: "bb"},
+	}, metadata.Distributions)
+	assert.Empty(t, metadata.SHA256Digest)
+}
@@ -15,9 +15,12 @@
 package pypi
 
 import (
+	"github.com/harness/gitness/app/services/refcache"
 	urlprovider "github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/registry/app/pkg/filemanager"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/downloadstats"
+	"github.com/harness/gitness/secret"
 	"github.com/harness/gitness/store/database/dbtx"
 
 	"github.com/google/wire"
@@ -31,8 +34,14 @@ func ControllerProvider(
 	fileManager filemanager.FileManager,
 	tx dbtx.Transactor,
 	urlProvider urlprovider.Provider,
+	spaceFinder refcache.SpaceFinder,
+	secretService secret.Service,
+	packageRuleStore store.PackageRuleRepository,
+	upstreamURLStore store.UpstreamURLRepository,
+	downloadStatRecorder *downloadstats.Recorder,
 ) Controller {
-	return NewController(proxyStore, registryDao, imageDao, artifactDao, fileManager, tx, urlProvider)
+	return NewController(proxyStore, registryDao, imageDao, artifactDao, fileManager, tx, urlProvider,
+		spaceFinder, secretService, packageRuleStore, upstreamURLStore, downloadStatRecorder)
 }
 
 var ControllerSet = wire.NewSet(ControllerProvider)
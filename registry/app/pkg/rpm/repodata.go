@@ -0,0 +1,476 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/rpm"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+const (
+	namespaceCommon    = "http://linux.duke.edu/metadata/common"
+	namespaceRPM       = "http://linux.duke.edu/metadata/rpm"
+	namespaceFilelists = "http://linux.duke.edu/metadata/filelists"
+	namespaceOther     = "http://linux.duke.edu/metadata/other"
+	namespaceRepo      = "http://linux.duke.edu/metadata/repo"
+
+	checksumSha256 = "sha256"
+)
+
+type repoMD struct {
+	XMLName  xml.Name     `xml:"repomd"`
+	Xmlns    string       `xml:"xmlns,attr"`
+	XmlnsRPM string       `xml:"xmlns:rpm,attr"`
+	Revision int64        `xml:"revision"`
+	Data     []repoMDData `xml:"data"`
+}
+
+type repoMDData struct {
+	Type         string       `xml:"type,attr"`
+	Checksum     repoChecksum `xml:"checksum"`
+	OpenChecksum repoChecksum `xml:"open-checksum"`
+	Location     repoLocation `xml:"location"`
+	Timestamp    int64        `xml:"timestamp"`
+	Size         int          `xml:"size"`
+	OpenSize     int          `xml:"open-size"`
+}
+
+type repoChecksum struct {
+	Type  string `xml:"type,attr"`
+	PkgID string `xml:"pkgid,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+type repoLocation struct {
+	Href string `xml:"href,attr"`
+}
+
+type repoVersion struct {
+	Epoch   string `xml:"epoch,attr"`
+	Version string `xml:"ver,attr"`
+	Release string `xml:"rel,attr"`
+}
+
+type repoFile struct {
+	Type string `xml:"type,attr,omitempty"`
+	Path string `xml:",chardata"`
+}
+
+type primaryMetadata struct {
+	XMLName  xml.Name         `xml:"metadata"`
+	Xmlns    string           `xml:"xmlns,attr"`
+	XmlnsRPM string           `xml:"xmlns:rpm,attr"`
+	Count    int              `xml:"packages,attr"`
+	Packages []primaryPackage `xml:"package"`
+}
+
+type primaryPackage struct {
+	Type         string        `xml:"type,attr"`
+	Name         string        `xml:"name"`
+	Architecture string        `xml:"arch"`
+	Version      repoVersion   `xml:"version"`
+	Checksum     repoChecksum  `xml:"checksum"`
+	Summary      string        `xml:"summary"`
+	Description  string        `xml:"description"`
+	Packager     string        `xml:"packager"`
+	URL          string        `xml:"url"`
+	Time         primaryTime   `xml:"time"`
+	Size         primarySize   `xml:"size"`
+	Location     repoLocation  `xml:"location"`
+	Format       primaryFormat `xml:"format"`
+}
+
+type primaryTime struct {
+	File  int64 `xml:"file,attr"`
+	Build int64 `xml:"build,attr"`
+}
+
+type primarySize struct {
+	Package   int64 `xml:"package,attr"`
+	Installed int64 `xml:"installed,attr"`
+	Archive   int64 `xml:"archive,attr"`
+}
+
+type primaryFormat struct {
+	License     string             `xml:"rpm:license"`
+	Vendor      string             `xml:"rpm:vendor"`
+	Group       string             `xml:"rpm:group"`
+	BuildHost   string             `xml:"rpm:buildhost"`
+	SourceRPM   string             `xml:"rpm:sourcerpm"`
+	HeaderRange primaryHeaderRange `xml:"rpm:header-range"`
+	Provides    *primaryEntries    `xml:"rpm:provides,omitempty"`
+	Requires    *primaryEntries    `xml:"rpm:requires,omitempty"`
+	Conflicts   *primaryEntries    `xml:"rpm:conflicts,omitempty"`
+	Obsoletes   *primaryEntries    `xml:"rpm:obsoletes,omitempty"`
+	Files       []repoFile         `xml:"file"`
+}
+
+type primaryHeaderRange struct {
+	Start int64 `xml:"start,attr"`
+	End   int64 `xml:"end,attr"`
+}
+
+type primaryEntries struct {
+	Entries []primaryEntry `xml:"rpm:entry"`
+}
+
+type primaryEntry struct {
+	Name    string `xml:"name,attr"`
+	Flags   string `xml:"flags,attr,omitempty"`
+	Epoch   string `xml:"epoch,attr,omitempty"`
+	Version string `xml:"ver,attr,omitempty"`
+	Release string `xml:"rel,attr,omitempty"`
+	Pre     string `xml:"pre,attr,omitempty"`
+}
+
+type filelistsMetadata struct {
+	XMLName  xml.Name           `xml:"filelists"`
+	Xmlns    string             `xml:"xmlns,attr"`
+	Count    int                `xml:"packages,attr"`
+	Packages []filelistsPackage `xml:"package"`
+}
+
+type filelistsPackage struct {
+	PkgID        string      `xml:"pkgid,attr"`
+	Name         string      `xml:"name,attr"`
+	Architecture string      `xml:"arch,attr"`
+	Version      repoVersion `xml:"version"`
+	Files        []repoFile  `xml:"file"`
+}
+
+type otherMetadata struct {
+	XMLName  xml.Name       `xml:"otherdata"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	Count    int            `xml:"packages,attr"`
+	Packages []otherPackage `xml:"package"`
+}
+
+type otherPackage struct {
+	PkgID        string      `xml:"pkgid,attr"`
+	Name         string      `xml:"name,attr"`
+	Architecture string      `xml:"arch,attr"`
+	Version      repoVersion `xml:"version"`
+}
+
+// repoDataFile is a file of the repodata along with its content.
+type repoDataFile struct {
+	name    string
+	content []byte
+}
+
+// GetRepoDataFile serves a file of the repodata, which is stored on each upload.
+func (c *controller) GetRepoDataFile(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	switch info.Filename {
+	case FileRepoMD, FilePrimary, FileFilelists, FileOther:
+	default:
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(info.Filename + " not found")
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, "/"+repoDataDir+"/"+info.Filename,
+		types.Registry{
+			ID:   reg.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// DownloadPackage serves a package by its file name, as listed in the location of primary.xml.
+func (c *controller) DownloadPackage(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	name, _, _, _, err := rpm.ParseFilename(info.Filename)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, reg.ID, name)
+	if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	var version string
+	if artifacts != nil {
+		for _, a := range *artifacts {
+			var metadata database.RpmMetadata
+			if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+				return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+			}
+			for _, p := range metadata.Packages {
+				if p.Filename == info.Filename {
+					version = a.Version
+				}
+			}
+		}
+	}
+	if version == "" {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(info.Filename + " not found")
+	}
+
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, "/"+name+"/"+version+"/"+info.Filename,
+		types.Registry{
+			ID:   reg.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, reg.ID, name, version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+// updateRepoData regenerates the repodata from the published packages and stores it. The indices
+// are stored before the repomd.xml, so the repomd.xml never lists an index which isn't stored yet.
+// The regeneration holds the index lease of the registry, so concurrent uploads on other replicas
+// don't store repodata built from a stale listing over the latest one.
+func (c *controller) updateRepoData(ctx context.Context, registry *types.Registry, info ArtifactInfo) error {
+	ctx, unlock, err := c.locker.LockIndex(ctx, registry.ID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	packages, err := c.listPackages(ctx, registry.ID)
+	if err != nil {
+		return err
+	}
+	files, err := buildRepoData(packages, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		_, err := c.fileManager.UploadFile(ctx, repoDataDir+"/"+f.name, info.RegIdentifier, registry.ID,
+			info.RootParentID, info.StorageRoot(), nil, bytes.NewReader(f.content), f.name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listPackages returns the published packages of a registry, ordered by name and version.
+func (c *controller) listPackages(ctx context.Context, registryID int64) ([]database.RpmFile, error) {
+	names, err := c.imageDao.ListNamesByRegistryID(ctx, registryID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	var packages []database.RpmFile
+	for _, name := range names {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, name)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range *artifacts {
+			var metadata database.RpmMetadata
+			if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+				return nil, fmt.Errorf("failed to parse metadata of %s %s: %w", name, a.Version, err)
+			}
+			packages = append(packages, metadata.Packages...)
+		}
+	}
+	return packages, nil
+}
+
+// buildRepoData returns primary.xml, filelists.xml and other.xml of the packages, compressed, followed
+// by the repomd.xml which lists their checksums.
+func buildRepoData(packages []database.RpmFile, revision int64) ([]repoDataFile, error) {
+	primary := primaryMetadata{Xmlns: namespaceCommon, XmlnsRPM: namespaceRPM, Count: len(packages)}
+	filelists := filelistsMetadata{Xmlns: namespaceFilelists, Count: len(packages)}
+	other := otherMetadata{Xmlns: namespaceOther, Count: len(packages)}
+	for _, p := range packages {
+		version := repoVersion{Epoch: p.Epoch, Version: p.Version, Release: p.Release}
+		if version.Epoch == "" {
+			version.Epoch = "0"
+		}
+		primary.Packages = append(primary.Packages, primaryPackage{
+			Type:         "rpm",
+			Name:         p.Name,
+			Architecture: p.Architecture,
+			Version:      version,
+			Checksum:     repoChecksum{Type: checksumSha256, PkgID: "YES", Value: p.Sha256},
+			Summary:      p.Summary,
+			Description:  p.Description,
+			Packager:     p.Packager,
+			URL:          p.URL,
+			Time:         primaryTime{File: p.BuildTime, Build: p.BuildTime},
+			Size:         primarySize{Package: p.Size, Installed: p.InstalledSize, Archive: p.ArchiveSize},
+			Location:     repoLocation{Href: "Packages/" + p.Filename},
+			Format: primaryFormat{
+				License:     p.License,
+				Vendor:      p.Vendor,
+				Group:       p.Group,
+				BuildHost:   p.BuildHost,
+				SourceRPM:   p.SourceRPM,
+				HeaderRange: primaryHeaderRange{Start: p.HeaderStart, End: p.HeaderEnd},
+				Provides:    toPrimaryEntries(p.Provides),
+				Requires:    toPrimaryEntries(p.Requires),
+				Conflicts:   toPrimaryEntries(p.Conflicts),
+				Obsoletes:   toPrimaryEntries(p.Obsoletes),
+				Files:       toRepoFiles(p.PrimaryFiles()),
+			},
+		})
+		filelists.Packages = append(filelists.Packages, filelistsPackage{
+			PkgID:        p.Sha256,
+			Name:         p.Name,
+			Architecture: p.Architecture,
+			Version:      version,
+			Files:        toRepoFiles(p.Files),
+		})
+		other.Packages = append(other.Packages, otherPackage{
+			PkgID:        p.Sha256,
+			Name:         p.Name,
+			Architecture: p.Architecture,
+			Version:      version,
+		})
+	}
+
+	md := repoMD{Xmlns: namespaceRepo, XmlnsRPM: namespaceRPM, Revision: revision}
+	var files []repoDataFile
+	for _, index := range []struct {
+		typ  string
+		name string
+		v    any
+	}{
+		{"primary", FilePrimary, primary},
+		{"filelists", FileFilelists, filelists},
+		{"other", FileOther, other},
+	} {
+		content, err := marshal(index.v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s: %w", index.name, err)
+		}
+		compressed, err := compress(content)
+		if err != nil {
+			return nil, err
+		}
+		md.Data = append(md.Data, repoMDData{
+			Type:         index.typ,
+			Checksum:     repoChecksum{Type: checksumSha256, Value: sha256sum(compressed)},
+			OpenChecksum: repoChecksum{Type: checksumSha256, Value: sha256sum(content)},
+			Location:     repoLocation{Href: repoDataDir + "/" + index.name},
+			Timestamp:    revision,
+			Size:         len(compressed),
+			OpenSize:     len(content),
+		})
+		files = append(files, repoDataFile{name: index.name, content: compressed})
+	}
+	content, err := marshal(md)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s: %w", FileRepoMD, err)
+	}
+	return append(files, repoDataFile{name: FileRepoMD, content: content}), nil
+}
+
+func toPrimaryEntries(entries []rpm.Entry) *primaryEntries {
+	if len(entries) == 0 {
+		return nil
+	}
+	result := &primaryEntries{}
+	for _, e := range entries {
+		entry := primaryEntry{Name: e.Name, Flags: e.Flags, Epoch: e.Epoch, Version: e.Version, Release: e.Release}
+		if entry.Flags != "" && entry.Epoch == "" {
+			entry.Epoch = "0"
+		}
+		if e.Pre {
+			entry.Pre = "1"
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	return result
+}
+
+func toRepoFiles(files []rpm.File) []repoFile {
+	result := make([]repoFile, 0, len(files))
+	for _, f := range files {
+		result = append(result, repoFile{Type: f.Type, Path: f.Path})
+	}
+	return result
+}
+
+func marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func compress(content []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to compress repodata: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress repodata: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func sha256sum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,86 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/rpm"
+	"github.com/harness/gitness/registry/app/store/database"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPackage(name, version, release, architecture string) database.RpmFile {
+	m := rpm.Metadata{
+		Name: name, Version: version, Release: release, Architecture: architecture,
+		Summary: "test", License: "MIT", SourceRPM: name + "-" + version + "-" + release + ".src.rpm",
+		HeaderStart: 4504, HeaderEnd: 9000,
+		Requires: []rpm.Entry{{Name: "glibc", Flags: "GE", Version: "2.34"}, {Name: "/bin/sh", Pre: true}},
+		Files:    []rpm.File{{Path: "/usr/bin/" + name}, {Path: "/usr/share/doc/" + name, Type: "dir"}},
+	}
+	return database.RpmFile{Metadata: m, Filename: m.Filename(), Size: 42, Sha256: "sha-" + architecture}
+}
+
+func decompress(t *testing.T, content []byte) []byte {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	data, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	return data
+}
+
+func TestBuildRepoData(t *testing.T) {
+	packages := []database.RpmFile{
+		testPackage("hello", "2.10", "3.el9", "x86_64"),
+		testPackage("hello", "2.10", "3.el9", "aarch64"),
+	}
+	files, err := buildRepoData(packages, 1700000000)
+	require.NoError(t, err)
+	require.Len(t, files, 4)
+	assert.Equal(t, FileRepoMD, files[3].name)
+
+	var md repoMD
+	require.NoError(t, xml.Unmarshal(files[3].content, &md))
+	assert.Equal(t, int64(1700000000), md.Revision)
+	require.Len(t, md.Data, 3)
+	for i, data := range md.Data {
+		assert.Equal(t, "repodata/"+files[i].name, data.Location.Href)
+		assert.Equal(t, sha256sum(files[i].content), data.Checksum.Value)
+		assert.Equal(t, sha256sum(decompress(t, files[i].content)), data.OpenChecksum.Value)
+		assert.Equal(t, len(files[i].content), data.Size)
+	}
+
+	primary := string(decompress(t, files[0].content))
+	assert.Contains(t, primary, `<metadata xmlns="http://linux.duke.edu/metadata/common" `+
+		`xmlns:rpm="http://linux.duke.edu/metadata/rpm" packages="2">`)
+	assert.Contains(t, primary, `<version epoch="0" ver="2.10" rel="3.el9"></version>`)
+	assert.Contains(t, primary, `<checksum type="sha256" pkgid="YES">sha-aarch64</checksum>`)
+	assert.Contains(t, primary, `<location href="Packages/hello-2.10-3.el9.aarch64.rpm"></location>`)
+	assert.Contains(t, primary, `<rpm:header-range start="4504" end="9000"></rpm:header-range>`)
+	assert.Contains(t, primary, `<rpm:entry name="glibc" flags="GE" epoch="0" ver="2.34"></rpm:entry>`)
+	assert.Contains(t, primary, `<rpm:entry name="/bin/sh" pre="1"></rpm:entry>`)
+	assert.Contains(t, primary, `<file>/usr/bin/hello</file>`)
+	assert.NotContains(t, primary, `/usr/share/doc/hello`)
+
+	filelists := string(decompress(t, files[1].content))
+	assert.Contains(t, filelists, `<package pkgid="sha-x86_64" name="hello" arch="x86_64">`)
+	assert.Contains(t, filelists, `<file type="dir">/usr/share/doc/hello</file>`)
+}
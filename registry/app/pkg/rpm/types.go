@@ -0,0 +1,36 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpm
+
+import (
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+const (
+	FileRepoMD    = "repomd.xml"
+	FilePrimary   = "primary.xml.gz"
+	FileFilelists = "filelists.xml.gz"
+	FileOther     = "other.xml.gz"
+)
+
+// repoDataDir is the directory of the repository the repodata is stored in, next to the
+// directories of the packages.
+const repoDataDir = "repodata"
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	// Filename is the package or the repodata file requested.
+	Filename string
+}
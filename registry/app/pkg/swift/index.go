@@ -0,0 +1,382 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// manifestContentType is the media type manifests are served with.
+const manifestContentType = "text/x-swift"
+
+func (c *controller) ListReleases(ctx context.Context, info ArtifactInfo) (
+	*Releases,
+	*commons.ResponseHeaders,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	if err := swift.ValidateIdentifier(info.Scope, info.Name); err != nil {
+		return nil, responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	versions, errc := c.listVersions(ctx, reg.ID, swift.ImageName(info.Scope, info.Name))
+	if !commons.IsEmptyError(errc) {
+		return nil, responseHeaders, errc
+	}
+	release := &swift.Release{Scope: info.Scope, Name: info.Name}
+	baseURL := c.registryURL(ctx, info)
+	releases := &Releases{Releases: make(map[string]ReleaseLink, len(versions))}
+	for _, version := range versions {
+		release.Version = version
+		releases.Releases[version] = ReleaseLink{URL: releaseURL(baseURL, release)}
+	}
+	release.Version = versions[len(versions)-1]
+	responseHeaders.Headers["Link"] = formatLinks([]link{{url: releaseURL(baseURL, release), rel: "latest-version"}})
+	return releases, responseHeaders, errcode.Error{}
+}
+
+// GetRelease describes a release, linking the latest release and the releases next to it.
+func (c *controller) GetRelease(ctx context.Context, info ArtifactInfo) (
+	*ReleaseMetadata,
+	*commons.ResponseHeaders,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	release, errc := c.findRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, responseHeaders, errc
+	}
+	versions, errc := c.listVersions(ctx, release.registryID, swift.ImageName(info.Scope, info.Name))
+	if !commons.IsEmptyError(errc) {
+		return nil, responseHeaders, errc
+	}
+	responseHeaders.Headers["Link"] = formatLinks(releaseLinks(c.registryURL(ctx, info), &release.Release, versions))
+
+	resource := Resource{
+		Name:     swift.SourceArchiveResource,
+		Type:     swift.SourceArchiveType,
+		Checksum: release.Sha256,
+	}
+	if release.Signature != "" {
+		resource.Signing = &Signing{
+			SignatureBase64Encoded: release.Signature,
+			SignatureFormat:        release.SignatureFormat,
+		}
+	}
+	metadata := &ReleaseMetadata{
+		ID:        release.Identifier(),
+		Version:   release.Version,
+		Resources: []Resource{resource},
+		Metadata:  release.Metadata,
+	}
+	if len(release.Files) > 0 {
+		metadata.PublishedAt = time.UnixMilli(release.Files[0].CreatedAt).UTC().Format(time.RFC3339)
+	}
+	return metadata, responseHeaders, errcode.Error{}
+}
+
+// GetManifest serves a manifest of a release. Package.swift links the manifests for other Swift
+// versions, and a Swift version the release has no manifest for is redirected to Package.swift.
+func (c *controller) GetManifest(ctx context.Context, info ArtifactInfo, swiftVersion string) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	if swiftVersion != "" {
+		if err := swift.ValidateSwiftVersion(swiftVersion); err != nil {
+			return responseHeaders, nil, "", errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+		}
+	}
+	release, errc := c.findRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	baseURL := c.registryURL(ctx, info)
+	manifest, ok := release.Manifest(swiftVersion)
+	if !ok {
+		responseHeaders.Headers["Location"] = releaseURL(baseURL, &release.Release) + "/" + swift.ManifestName
+		responseHeaders.Code = http.StatusSeeOther
+		return responseHeaders, nil, "", errcode.Error{}
+	}
+	if swiftVersion == "" {
+		if links := manifestLinks(baseURL, &release.Release); len(links) > 0 {
+			responseHeaders.Headers["Link"] = formatLinks(links)
+		}
+	}
+
+	fileReader, redirectURL, errc := c.download(ctx, info, release, manifest.Filename())
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	responseHeaders.Headers["Content-Type"] = manifestContentType
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + manifest.Filename()
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) DownloadSourceArchive(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	release, errc := c.findRelease(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	filename := release.ArchiveFilename()
+	fileReader, redirectURL, errc := c.download(ctx, info, release, filename)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, release.registryID,
+		swift.ImageName(release.Scope, release.Name), release.Version)
+	responseHeaders.Headers["Content-Type"] = swift.SourceArchiveType
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + filename
+	if checksum, err := hex.DecodeString(release.Sha256); err == nil {
+		responseHeaders.Headers["Digest"] = "sha-256=" + base64.StdEncoding.EncodeToString(checksum)
+	}
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+func (c *controller) LookupIdentifiers(
+	ctx context.Context,
+	info ArtifactInfo,
+	repositoryURL string,
+) (*Identifiers, errcode.Error) {
+	if repositoryURL == "" {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage("repository url is required")
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	imageNames, err := c.imageDao.ListNamesByRegistryID(ctx, reg.ID)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	repositoryURL = normalizeRepositoryURL(repositoryURL)
+	identifiers := &Identifiers{Identifiers: []string{}}
+	for _, imageName := range imageNames {
+		artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, reg.ID, imageName)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if identifier, ok := publishedFrom(*artifacts, repositoryURL); ok {
+			identifiers.Identifiers = append(identifiers.Identifiers, identifier)
+		}
+	}
+	if len(identifiers.Identifiers) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("no package was published from %s", repositoryURL))
+	}
+	return identifiers, errcode.Error{}
+}
+
+type publishedRelease struct {
+	database.SwiftMetadata
+	registryID int64
+}
+
+// findRelease returns a published release of a package.
+func (c *controller) findRelease(ctx context.Context, info ArtifactInfo) (*publishedRelease, errcode.Error) {
+	if err := swift.ValidateIdentifier(info.Scope, info.Name); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := swift.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	imageName := swift.ImageName(info.Scope, info.Name)
+	metadata, err := c.getMetadata(ctx, reg.ID, imageName, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("release %s of %s not found", info.Version, imageName))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return &publishedRelease{SwiftMetadata: *metadata, registryID: reg.ID}, errcode.Error{}
+}
+
+// listVersions lists the versions of a package from the lowest to the highest.
+func (c *controller) listVersions(ctx context.Context, registryID int64, imageName string) ([]string, errcode.Error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, imageName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) || (err == nil && len(*artifacts) == 0) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("package %s not found", imageName))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	versions := make([]string, 0, len(*artifacts))
+	for _, a := range *artifacts {
+		versions = append(versions, a.Version)
+	}
+	versioning.Sort(versioning.SchemeSemver, versions)
+	return versions, errcode.Error{}
+}
+
+func (c *controller) download(
+	ctx context.Context,
+	info ArtifactInfo,
+	release *publishedRelease,
+	filename string,
+) (*storage.FileReader, string, errcode.Error) {
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(swift.ImageName(release.Scope, release.Name), release.Version, filename),
+		types.Registry{
+			ID:   release.registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	return fileReader, redirectURL, errcode.Error{}
+}
+
+// publishedFrom returns the identifier of a package if one of its releases was published from
+// a repository.
+func publishedFrom(artifacts []types.Artifact, repositoryURL string) (string, bool) {
+	for _, a := range artifacts {
+		metadata := database.SwiftMetadata{}
+		if err := json.Unmarshal(a.Metadata, &metadata); err != nil || metadata.Metadata == nil {
+			continue
+		}
+		for _, u := range metadata.Metadata.RepositoryURLs {
+			if normalizeRepositoryURL(u) == repositoryURL {
+				return metadata.Identifier(), true
+			}
+		}
+	}
+	return "", false
+}
+
+// normalizeRepositoryURL drops what tells apart the URLs a repository is cloned from, so a
+// package is found whichever of them it was published with.
+func normalizeRepositoryURL(repositoryURL string) string {
+	repositoryURL = strings.ToLower(strings.TrimSpace(repositoryURL))
+	repositoryURL = strings.TrimSuffix(repositoryURL, "/")
+	return strings.TrimSuffix(repositoryURL, ".git")
+}
+
+type link struct {
+	url    string
+	rel    string
+	params []string
+}
+
+// releaseLinks links the latest release, and the releases before and after a release, out of
+// versions sorted from the lowest to the highest.
+func releaseLinks(baseURL string, release *swift.Release, versions []string) []link {
+	linked := *release
+	linked.Version = versions[len(versions)-1]
+	links := []link{{url: releaseURL(baseURL, &linked), rel: "latest-version"}}
+	for i, version := range versions {
+		if version != release.Version {
+			continue
+		}
+		if i > 0 {
+			linked.Version = versions[i-1]
+			links = append(links, link{url: releaseURL(baseURL, &linked), rel: "predecessor-version"})
+		}
+		if i < len(versions)-1 {
+			linked.Version = versions[i+1]
+			links = append(links, link{url: releaseURL(baseURL, &linked), rel: "successor-version"})
+		}
+	}
+	return links
+}
+
+// manifestLinks links the manifests of a release for Swift versions.
+func manifestLinks(baseURL string, release *swift.Release) []link {
+	var links []link
+	for _, manifest := range release.Manifests {
+		if manifest.SwiftVersion == "" {
+			continue
+		}
+		params := []string{fmt.Sprintf("filename=%q", manifest.Filename())}
+		if manifest.ToolsVersion != "" {
+			params = append(params, fmt.Sprintf("swift-tools-version=%q", manifest.ToolsVersion))
+		}
+		links = append(links, link{
+			url: releaseURL(baseURL, release) + "/" + swift.ManifestName + "?swift-version=" +
+				url.QueryEscape(manifest.SwiftVersion),
+			rel:    "alternate",
+			params: params,
+		})
+	}
+	return links
+}
+
+// formatLinks formats links as the value of a Link header.
+func formatLinks(links []link) string {
+	values := make([]string, 0, len(links))
+	for _, l := range links {
+		value := fmt.Sprintf("<%s>; rel=%q", l.url, l.rel)
+		for _, param := range l.params {
+			value += "; " + param
+		}
+		values = append(values, value)
+	}
+	return strings.Join(values, ", ")
+}
+
+func releaseURL(baseURL string, release *swift.Release) string {
+	return baseURL + "/" + release.Scope + "/" + release.Name + "/" + release.Version
+}
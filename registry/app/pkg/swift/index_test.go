@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/utils/versioning"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseLinks(t *testing.T) {
+	versions := []string{"1.10.0", "1.2.0", "1.2.0-beta.1", "0.9.1"}
+	versioning.Sort(versioning.SchemeSemver, versions)
+	assert.Equal(t, []string{"0.9.1", "1.2.0-beta.1", "1.2.0", "1.10.0"}, versions)
+
+	release := &swift.Release{Scope: "mona", Name: "LinkedList", Version: "1.2.0"}
+	assert.Equal(t,
+		`<https://pkg/swift/mona/LinkedList/1.10.0>; rel="latest-version", `+
+			`<https://pkg/swift/mona/LinkedList/1.2.0-beta.1>; rel="predecessor-version", `+
+			`<https://pkg/swift/mona/LinkedList/1.10.0>; rel="successor-version"`,
+		formatLinks(releaseLinks("https://pkg/swift", release, versions)))
+
+	release.Version = "0.9.1"
+	assert.Equal(t,
+		`<https://pkg/swift/mona/LinkedList/1.10.0>; rel="latest-version", `+
+			`<https://pkg/swift/mona/LinkedList/1.2.0-beta.1>; rel="successor-version"`,
+		formatLinks(releaseLinks("https://pkg/swift", release, versions)))
+}
+
+func TestManifestLinks(t *testing.T) {
+	release := &swift.Release{
+		Scope:   "mona",
+		Name:    "LinkedList",
+		Version: "1.0.0",
+		Manifests: []swift.Manifest{
+			{ToolsVersion: "5.9"},
+			{SwiftVersion: "5.7", ToolsVersion: "5.7"},
+		},
+	}
+	assert.Equal(t,
+		`<https://pkg/swift/mona/LinkedList/1.0.0/Package.swift?swift-version=5.7>; rel="alternate"; `+
+			`filename="Package@swift-5.7.swift"; swift-tools-version="5.7"`,
+		formatLinks(manifestLinks("https://pkg/swift", release)))
+}
+
+func TestNormalizeRepositoryURL(t *testing.T) {
+	assert.Equal(t, "https://github.com/mona/linkedlist",
+		normalizeRepositoryURL("https://github.com/mona/LinkedList.git"))
+	assert.Equal(t, "https://github.com/mona/linkedlist",
+		normalizeRepositoryURL(" https://github.com/mona/LinkedList/ "))
+}
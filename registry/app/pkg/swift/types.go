@@ -0,0 +1,75 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"io"
+
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Scope   string
+	Name    string
+	Version string
+}
+
+// PublishRequest is a release published with its source archive and optional metadata and
+// signature.
+type PublishRequest struct {
+	SourceArchive io.ReaderAt
+	Size          int64
+	Metadata      []byte
+	// Signature is the signature of the source archive, in SignatureFormat.
+	Signature       []byte
+	SignatureFormat string
+}
+
+// Releases lists the releases of a package, by version.
+type Releases struct {
+	Releases map[string]ReleaseLink `json:"releases"`
+}
+
+type ReleaseLink struct {
+	URL string `json:"url"`
+}
+
+// ReleaseMetadata describes a release and the resources it has, in the shape clients read it.
+type ReleaseMetadata struct {
+	ID          string                 `json:"id"`
+	Version     string                 `json:"version"`
+	Resources   []Resource             `json:"resources"`
+	Metadata    *swift.PackageMetadata `json:"metadata,omitempty"`
+	PublishedAt string                 `json:"publishedAt,omitempty"`
+}
+
+type Resource struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Checksum string   `json:"checksum"`
+	Signing  *Signing `json:"signing,omitempty"`
+}
+
+type Signing struct {
+	SignatureBase64Encoded string `json:"signatureBase64Encoded"`
+	SignatureFormat        string `json:"signatureFormat"`
+}
+
+// Identifiers lists the identifiers of the packages published from a repository.
+type Identifiers struct {
+	Identifiers []string `json:"identifiers"`
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swift
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// Publish publishes a release from its source archive, storing the manifests at the root of the
+// package next to the archive so they are served without opening it. Releases are immutable.
+func (c *controller) Publish(
+	ctx context.Context,
+	info ArtifactInfo,
+	request PublishRequest,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	if err := swift.ValidateIdentifier(info.Scope, info.Name); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := swift.ValidateVersion(info.Version); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	release := swift.Release{
+		Scope:           info.Scope,
+		Name:            info.Name,
+		Version:         info.Version,
+		SignatureFormat: request.SignatureFormat,
+	}
+	if len(request.Metadata) > 0 {
+		release.Metadata = &swift.PackageMetadata{}
+		if err := json.Unmarshal(request.Metadata, release.Metadata); err != nil {
+			return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage("invalid metadata: " + err.Error())
+		}
+	}
+	if len(request.Signature) > 0 {
+		if release.SignatureFormat == "" {
+			return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage("signature format is required")
+		}
+		release.Signature = base64.StdEncoding.EncodeToString(request.Signature)
+	}
+	manifests, contents, err := swift.ReadSourceArchive(request.SourceArchive, request.Size)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	release.Manifests = manifests
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeSWIFT {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a swift registry", registry.Name))
+	}
+	imageName := swift.ImageName(release.Scope, release.Name)
+	_, err = c.getMetadata(ctx, registry.ID, imageName, release.Version)
+	if err == nil {
+		return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("release %s of %s was published before", release.Version, release.Identifier()))
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	metadata := database.SwiftMetadata{Release: release}
+	filename := release.ArchiveFilename()
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(imageName, release.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+		io.NewSectionReader(request.SourceArchive, 0, request.Size), filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata.Sha256 = fileInfo.Sha256
+	metadata.Files = append(metadata.Files, database.File{
+		Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+	})
+	for _, manifest := range manifests {
+		filename := manifest.Filename()
+		fileInfo, err := c.fileManager.UploadFile(ctx, filePath(imageName, release.Version, filename),
+			info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+			bytes.NewReader(contents[filename]), filename)
+		if err != nil {
+			return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		metadata.Files = append(metadata.Files, database.File{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		})
+	}
+	metadata.FileCount = int64(len(metadata.Files))
+	if err = c.publish(ctx, registry.ID, imageName, release.Version, metadata); err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Headers["Location"] = releaseURL(c.registryURL(ctx, info), &release)
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// publish creates a release whose files were uploaded.
+func (c *controller) publish(
+	ctx context.Context,
+	registryID int64,
+	imageName, version string,
+	metadata database.SwiftMetadata,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       imageName,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", imageName, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", imageName, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", imageName, err)
+			}
+			return nil
+		})
+}
+
+// getMetadata returns the metadata of a release, or gitnessstore.ErrResourceNotFound if it wasn't
+// published.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	imageName, version string,
+) (*database.SwiftMetadata, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, imageName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", imageName, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find release %s of %s: %w", version, imageName, err)
+	}
+	metadata := &database.SwiftMetadata{}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of release %s of %s: %w", version, imageName, err)
+	}
+	return metadata, nil
+}
+
+// filePath returns the path a file of a release is stored at.
+func filePath(imageName, version, filename string) string {
+	return imageName + "/" + version + "/" + filename
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/terraform"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// terraformGetHeader is the header the download endpoint points Terraform to the archive with.
+const terraformGetHeader = "X-Terraform-Get"
+
+func (c *controller) GetServiceDiscovery(ctx context.Context, info ArtifactInfo) *ServiceDiscovery {
+	return &ServiceDiscovery{
+		ModulesV1:   c.registryURL(ctx, info) + "/v1/modules/",
+		ProvidersV1: c.registryURL(ctx, info) + "/v1/providers/",
+	}
+}
+
+func (c *controller) ListVersions(ctx context.Context, info ArtifactInfo) (*ModuleVersions, errcode.Error) {
+	if err := terraform.ValidateAddress(info.Namespace, info.Name, info.System); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	imageName := moduleImageName(info)
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, reg.ID, imageName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) || (err == nil && len(*artifacts) == 0) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("module %s not found", imageName))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	versions := make([]string, 0, len(*artifacts))
+	for _, a := range *artifacts {
+		versions = append(versions, a.Version)
+	}
+	return buildVersions(versions), errcode.Error{}
+}
+
+// GetDownloadURL points Terraform to the archive of a version, which it downloads and extracts
+// on its own.
+func (c *controller) GetDownloadURL(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	module, errc := c.findModule(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, errc
+	}
+	responseHeaders.Headers[terraformGetHeader] = fmt.Sprintf("%s/v1/modules/%s/%s/%s",
+		c.registryURL(ctx, info), module.ImageName(), module.Version, terraform.ArchiveName)
+	responseHeaders.Code = http.StatusNoContent
+	return responseHeaders, errcode.Error{}
+}
+
+func (c *controller) DownloadArchive(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	module, errc := c.findModule(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx, "/"+archivePath(&module.Module),
+		types.Registry{
+			ID:   module.registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, module.registryID,
+		moduleImageName(info), info.Version)
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+type publishedModule struct {
+	terraform.Module
+	registryID int64
+}
+
+// findModule returns a published version of a module.
+func (c *controller) findModule(ctx context.Context, info ArtifactInfo) (*publishedModule, errcode.Error) {
+	if err := terraform.ValidateAddress(info.Namespace, info.Name, info.System); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := terraform.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	imageName := moduleImageName(info)
+	metadata, err := c.getMetadata(ctx, reg.ID, imageName, info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of module %s not found", info.Version, imageName))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return &publishedModule{Module: metadata.Module, registryID: reg.ID}, errcode.Error{}
+}
+
+func moduleImageName(info ArtifactInfo) string {
+	module := terraform.Module{Namespace: info.Namespace, Name: info.Name, System: info.System}
+	return module.ImageName()
+}
+
+// buildVersions lists the versions of a module from the lowest to the highest.
+func buildVersions(versions []string) *ModuleVersions {
+	versioning.Sort(versioning.SchemeSemver, versions)
+	list := ModuleVersionList{Versions: make([]ModuleVersion, 0, len(versions))}
+	for _, version := range versions {
+		list.Versions = append(list.Versions, ModuleVersion{Version: version})
+	}
+	return &ModuleVersions{Modules: []ModuleVersionList{list}}
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildVersions(t *testing.T) {
+	versions := buildVersions([]string{"1.10.0", "1.2.0", "1.2.0-beta.1", "0.9.1"})
+	assert.Len(t, versions.Modules, 1)
+	assert.Equal(t, []ModuleVersion{
+		{Version: "0.9.1"}, {Version: "1.2.0-beta.1"}, {Version: "1.2.0"}, {Version: "1.10.0"},
+	}, versions.Modules[0].Versions)
+
+	// versions which aren't semantic versions sort before the others, whatever they're compared to
+	versions = buildVersions([]string{"1.10.0", "b", "1.9.0", "a"})
+	assert.Equal(t, []ModuleVersion{
+		{Version: "a"}, {Version: "b"}, {Version: "1.9.0"}, {Version: "1.10.0"},
+	}, versions.Modules[0].Versions)
+}
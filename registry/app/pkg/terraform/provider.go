@@ -0,0 +1,324 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/terraform"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// maxReleaseMetadataSize is the largest SHA256SUMS, signature or manifest of a provider release.
+const maxReleaseMetadataSize = 1 << 20
+
+// UploadProvider publishes a version of a provider from the files of its release, which must be
+// signed with the signing key. Versions are immutable, as Terraform locks the checksums of the
+// providers it installs.
+func (c *controller) UploadProvider(
+	ctx context.Context,
+	info ArtifactInfo,
+	signingKey string,
+	files []ReleaseFile,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	if err := terraform.ValidateProviderAddress(info.Namespace, info.Type); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := terraform.ValidateVersion(info.Version); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	provider := terraform.Provider{Namespace: info.Namespace, Type: info.Type, Version: info.Version}
+	release, err := readRelease(&provider, signingKey, files)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err = terraform.ReadProvider(release, &provider); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeTERRAFORM {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a terraform registry", registry.Name))
+	}
+	imageName := provider.ImageName()
+	_, err = c.getMetadata(ctx, registry.ID, imageName, provider.Version)
+	if err == nil {
+		return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("version %s of %s was published before", provider.Version, imageName))
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	metadata := database.TerraformMetadata{Provider: &provider}
+	for _, file := range files {
+		fileInfo, err := c.fileManager.UploadFile(ctx, releaseFilePath(&provider, file.Filename),
+			info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil,
+			io.NewSectionReader(file.File, 0, file.Size), file.Filename)
+		if err != nil {
+			return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		metadata.Files = append(metadata.Files, database.File{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		})
+	}
+	metadata.FileCount = int64(len(metadata.Files))
+	if err = c.publish(ctx, registry.ID, imageName, provider.Version, metadata); err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+func (c *controller) ListProviderVersions(ctx context.Context, info ArtifactInfo) (
+	*ProviderVersions,
+	errcode.Error,
+) {
+	if err := terraform.ValidateProviderAddress(info.Namespace, info.Type); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	imageName := providerImageName(info)
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, reg.ID, imageName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) || (err == nil && len(*artifacts) == 0) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("provider %s not found", imageName))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	providers := make([]*terraform.Provider, 0, len(*artifacts))
+	for _, a := range *artifacts {
+		metadata := database.TerraformMetadata{}
+		if err := json.Unmarshal(a.Metadata, &metadata); err != nil {
+			return nil, errcode.ErrCodeUnknown.WithDetail(err)
+		}
+		if metadata.Provider != nil {
+			providers = append(providers, metadata.Provider)
+		}
+	}
+	return buildProviderVersions(providers), errcode.Error{}
+}
+
+// GetProviderPackage points Terraform to the archive of a version for the platform of the request.
+func (c *controller) GetProviderPackage(ctx context.Context, info ArtifactInfo) (
+	*ProviderPackage,
+	errcode.Error,
+) {
+	provider, errc := c.findProvider(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	platform, ok := provider.Platform(info.OS, info.Arch)
+	if !ok {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("version %s of %s has no archive for %s_%s",
+			provider.Version, provider.ImageName(), info.OS, info.Arch))
+	}
+	filesURL := fmt.Sprintf("%s/v1/providers/%s/%s/files/", c.registryURL(ctx, info), provider.ImageName(),
+		provider.Version)
+	return &ProviderPackage{
+		Protocols:           provider.Protocols,
+		OS:                  platform.OS,
+		Arch:                platform.Arch,
+		Filename:            platform.Filename,
+		DownloadURL:         filesURL + platform.Filename,
+		ShasumsURL:          filesURL + provider.ShasumsFilename(),
+		ShasumsSignatureURL: filesURL + provider.SignatureFilename(),
+		Shasum:              platform.Shasum,
+		SigningKeys: SigningKeys{GPGPublicKeys: []GPGPublicKey{{
+			KeyID:      provider.SigningKey.KeyID,
+			ASCIIArmor: provider.SigningKey.ASCIIArmor,
+		}}},
+	}, errcode.Error{}
+}
+
+// DownloadProviderFile downloads an archive, the checksums or their signature of a version.
+func (c *controller) DownloadProviderFile(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	provider, errc := c.findProvider(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	if !provider.IsReleaseFile(info.Filename) {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("file %s not found", info.Filename))
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+releaseFilePath(&provider.Provider, info.Filename),
+		types.Registry{
+			ID:   provider.registryID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	if provider.IsArchive(info.Filename) {
+		pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, provider.registryID,
+			providerImageName(info), info.Version)
+	}
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+type publishedProvider struct {
+	terraform.Provider
+	registryID int64
+}
+
+// findProvider returns a published version of a provider.
+func (c *controller) findProvider(ctx context.Context, info ArtifactInfo) (*publishedProvider, errcode.Error) {
+	if err := terraform.ValidateProviderAddress(info.Namespace, info.Type); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := terraform.ValidateVersion(info.Version); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	reg, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	imageName := providerImageName(info)
+	metadata, err := c.getMetadata(ctx, reg.ID, imageName, info.Version)
+	if err == nil && metadata.Provider == nil {
+		err = gitnessstore.ErrResourceNotFound
+	}
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of provider %s not found", info.Version, imageName))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	return &publishedProvider{Provider: *metadata.Provider, registryID: reg.ID}, errcode.Error{}
+}
+
+// readRelease reads the checksums, signature and manifest of a release and the checksums of its
+// archives, rejecting files that don't belong to the release.
+func readRelease(
+	provider *terraform.Provider,
+	signingKey string,
+	files []ReleaseFile,
+) (terraform.ProviderRelease, error) {
+	release := terraform.ProviderRelease{SigningKey: signingKey, Archives: map[string]string{}}
+	seen := map[string]bool{}
+	for _, file := range files {
+		if !provider.IsReleaseFile(file.Filename) {
+			return release, fmt.Errorf("%w: %s isn't a file of version %s of %s", terraform.ErrInvalidProvider,
+				file.Filename, provider.Version, provider.Type)
+		}
+		if seen[file.Filename] {
+			return release, fmt.Errorf("%w: %s was uploaded more than once", terraform.ErrInvalidProvider,
+				file.Filename)
+		}
+		seen[file.Filename] = true
+
+		r := io.NewSectionReader(file.File, 0, file.Size)
+		var target *[]byte
+		switch file.Filename {
+		case provider.ShasumsFilename():
+			target = &release.Shasums
+		case provider.SignatureFilename():
+			target = &release.Signature
+		case provider.ManifestFilename():
+			target = &release.Manifest
+		default:
+			h := sha256.New()
+			if _, err := io.Copy(h, r); err != nil {
+				return release, fmt.Errorf("failed to read %s: %w", file.Filename, err)
+			}
+			release.Archives[file.Filename] = hex.EncodeToString(h.Sum(nil))
+			continue
+		}
+		if file.Size > maxReleaseMetadataSize {
+			return release, fmt.Errorf("%w: %s is too large", terraform.ErrInvalidProvider, file.Filename)
+		}
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return release, fmt.Errorf("failed to read %s: %w", file.Filename, err)
+		}
+		*target = data
+	}
+	return release, nil
+}
+
+func providerImageName(info ArtifactInfo) string {
+	provider := terraform.Provider{Namespace: info.Namespace, Type: info.Type}
+	return provider.ImageName()
+}
+
+// releaseFilePath returns the path a file of a provider release is stored at.
+func releaseFilePath(provider *terraform.Provider, filename string) string {
+	return provider.ImageName() + "/" + provider.Version + "/" + filename
+}
+
+// buildProviderVersions lists the versions of a provider from the lowest to the highest.
+func buildProviderVersions(providers []*terraform.Provider) *ProviderVersions {
+	byVersion := make(map[string]*terraform.Provider, len(providers))
+	versions := make([]string, 0, len(providers))
+	for _, provider := range providers {
+		byVersion[provider.Version] = provider
+		versions = append(versions, provider.Version)
+	}
+	versioning.Sort(versioning.SchemeSemver, versions)
+	list := &ProviderVersions{Versions: make([]ProviderVersion, 0, len(versions))}
+	for _, version := range versions {
+		provider := byVersion[version]
+		platforms := make([]ProviderPlatform, 0, len(provider.Platforms))
+		for _, platform := range provider.Platforms {
+			platforms = append(platforms, ProviderPlatform{OS: platform.OS, Arch: platform.Arch})
+		}
+		list.Versions = append(list.Versions, ProviderVersion{
+			Version:   version,
+			Protocols: provider.Protocols,
+			Platforms: platforms,
+		})
+	}
+	return list
+}
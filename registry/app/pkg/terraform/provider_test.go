@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/metadata/terraform"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildProviderVersions(t *testing.T) {
+	versions := buildProviderVersions([]*terraform.Provider{
+		{Version: "1.10.0", Protocols: []string{"6.0"}, Platforms: []terraform.Platform{{OS: "linux", Arch: "amd64"}}},
+		{Version: "1.2.0", Protocols: []string{"5.0"}, Platforms: []terraform.Platform{{OS: "darwin", Arch: "arm64"}}},
+	})
+	assert.Equal(t, []ProviderVersion{
+		{Version: "1.2.0", Protocols: []string{"5.0"}, Platforms: []ProviderPlatform{{OS: "darwin", Arch: "arm64"}}},
+		{Version: "1.10.0", Protocols: []string{"6.0"}, Platforms: []ProviderPlatform{{OS: "linux", Arch: "amd64"}}},
+	}, versions.Versions)
+}
+
+func TestReadRelease(t *testing.T) {
+	const zipShasum = "4a70fe9aa6436e02c2dea340fbd1e352e4ef2d8ce6ca52ad25d4b95471fc8bf2"
+	provider := &terraform.Provider{Namespace: "acme", Type: "cloud", Version: "1.0.0"}
+	file := func(name, content string) ReleaseFile {
+		return ReleaseFile{Filename: name, File: bytes.NewReader([]byte(content)), Size: int64(len(content))}
+	}
+
+	release, err := readRelease(provider, "key", []ReleaseFile{
+		file("terraform-provider-cloud_1.0.0_linux_amd64.zip", "zip"),
+		file("terraform-provider-cloud_1.0.0_SHA256SUMS", "sums"),
+		file("terraform-provider-cloud_1.0.0_SHA256SUMS.sig", "sig"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "sums", string(release.Shasums))
+	assert.Equal(t, "sig", string(release.Signature))
+	assert.Equal(t, map[string]string{
+		"terraform-provider-cloud_1.0.0_linux_amd64.zip": zipShasum,
+	}, release.Archives)
+
+	_, err = readRelease(provider, "key", []ReleaseFile{file("terraform-provider-other_1.0.0_linux_amd64.zip", "")})
+	assert.ErrorIs(t, err, terraform.ErrInvalidProvider)
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"io"
+
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Namespace string
+	Name      string
+	System    string
+	Version   string
+	// Type, OS, Arch and Filename address the providers and their release files.
+	Type     string
+	OS       string
+	Arch     string
+	Filename string
+}
+
+// ReleaseFile is a file of a provider release being uploaded.
+type ReleaseFile struct {
+	Filename string
+	File     io.ReaderAt
+	Size     int64
+}
+
+// ServiceDiscovery is the service discovery document of a registry, naming the base URLs of the
+// module and provider registry protocols.
+type ServiceDiscovery struct {
+	ModulesV1   string `json:"modules.v1"`
+	ProvidersV1 string `json:"providers.v1"`
+}
+
+// ModuleVersions lists the versions of a module, in the shape Terraform reads them.
+type ModuleVersions struct {
+	Modules []ModuleVersionList `json:"modules"`
+}
+
+type ModuleVersionList struct {
+	Versions []ModuleVersion `json:"versions"`
+}
+
+type ModuleVersion struct {
+	Version string `json:"version"`
+}
+
+// ProviderVersions lists the versions of a provider with the protocols and platforms of each.
+type ProviderVersions struct {
+	Versions []ProviderVersion `json:"versions"`
+}
+
+type ProviderVersion struct {
+	Version   string             `json:"version"`
+	Protocols []string           `json:"protocols"`
+	Platforms []ProviderPlatform `json:"platforms"`
+}
+
+type ProviderPlatform struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+}
+
+// ProviderPackage points Terraform to the archive of a version for a platform along with the
+// signed checksums it verifies the archive with.
+type ProviderPackage struct {
+	Protocols           []string    `json:"protocols"`
+	OS                  string      `json:"os"`
+	Arch                string      `json:"arch"`
+	Filename            string      `json:"filename"`
+	DownloadURL         string      `json:"download_url"`
+	ShasumsURL          string      `json:"shasums_url"`
+	ShasumsSignatureURL string      `json:"shasums_signature_url"`
+	Shasum              string      `json:"shasum"`
+	SigningKeys         SigningKeys `json:"signing_keys"`
+}
+
+type SigningKeys struct {
+	GPGPublicKeys []GPGPublicKey `json:"gpg_public_keys"`
+}
+
+type GPGPublicKey struct {
+	KeyID      string `json:"key_id"`
+	ASCIIArmor string `json:"ascii_armor"`
+}
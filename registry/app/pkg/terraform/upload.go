@@ -0,0 +1,168 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	"github.com/harness/gitness/registry/app/metadata/terraform"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// UploadModule publishes a version of a module from a gzipped tarball of the module. Versions are
+// immutable, as Terraform caches the modules it downloads by version.
+func (c *controller) UploadModule(
+	ctx context.Context,
+	info ArtifactInfo,
+	file io.ReaderAt,
+	size int64,
+) (*commons.ResponseHeaders, errcode.Error) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+
+	if err := terraform.ValidateAddress(info.Namespace, info.Name, info.System); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := terraform.ValidateVersion(info.Version); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	module := terraform.Module{
+		Namespace: info.Namespace,
+		Name:      info.Name,
+		System:    info.System,
+		Version:   info.Version,
+	}
+	if err := terraform.ReadModule(io.NewSectionReader(file, 0, size), &module); err != nil {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeTERRAFORM {
+		return responseHeaders, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a terraform registry", registry.Name))
+	}
+	imageName := module.ImageName()
+	_, err = c.getMetadata(ctx, registry.ID, imageName, module.Version)
+	if err == nil {
+		return responseHeaders, errcode.ErrCodeConflict.WithMessage(
+			fmt.Sprintf("version %s of %s was published before", module.Version, imageName))
+	}
+	if !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+
+	filename := module.Filename()
+	fileInfo, err := c.fileManager.UploadFile(ctx, archivePath(&module), info.RegIdentifier, registry.ID,
+		info.RootParentID, info.StorageRoot(), nil, io.NewSectionReader(file, 0, size), filename)
+	if err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	metadata := database.TerraformMetadata{
+		Files: []database.File{{
+			Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli(),
+		}},
+		FileCount: 1,
+		Sha256:    fileInfo.Sha256,
+		Module:    module,
+	}
+	if err = c.publish(ctx, registry.ID, imageName, module.Version, metadata); err != nil {
+		return responseHeaders, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	responseHeaders.Code = http.StatusCreated
+	return responseHeaders, errcode.Error{}
+}
+
+// publish creates a version of a module or provider whose files were uploaded.
+func (c *controller) publish(
+	ctx context.Context,
+	registryID int64,
+	imageName, version string,
+	metadata database.TerraformMetadata,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       imageName,
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", imageName, err)
+			}
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", imageName, err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", imageName, err)
+			}
+			return nil
+		})
+}
+
+// getMetadata returns the metadata of a version, or gitnessstore.ErrResourceNotFound if it wasn't
+// published.
+func (c *controller) getMetadata(
+	ctx context.Context,
+	registryID int64,
+	imageName, version string,
+) (*database.TerraformMetadata, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, imageName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", imageName, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, imageName, err)
+	}
+	metadata := &database.TerraformMetadata{}
+	if err := json.Unmarshal(a.Metadata, metadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s of %s: %w", version, imageName, err)
+	}
+	return metadata, nil
+}
+
+// archivePath returns the path the archive of a version is stored at.
+func archivePath(module *terraform.Module) string {
+	return module.ImageName() + "/" + module.Version + "/" + module.Filename()
+}
@@ -0,0 +1,242 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vagrant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	vagrantmetadata "github.com/harness/gitness/registry/app/metadata/vagrant"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+const (
+	// ArchitectureParam is the query parameter boxes built for an architecture are uploaded and
+	// downloaded with.
+	ArchitectureParam = "architecture"
+	checksumType      = "sha256"
+	statusActive      = "active"
+)
+
+func (c *controller) GetCatalog(ctx context.Context, info ArtifactInfo) (*Catalog, errcode.Error) {
+	if err := vagrantmetadata.ValidateName(info.Org, info.Name); err != nil {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	versions, err := c.listVersions(ctx, registry.ID, info.BoxName())
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if len(versions) == 0 {
+		return nil, errcode.ErrCodeNameUnknown.WithMessage(fmt.Sprintf("box %s not found", info.BoxName()))
+	}
+	registryURL := c.registryURL(ctx, info)
+	catalog := &Catalog{Name: info.BoxName(), Versions: make([]CatalogVersion, 0, len(versions))}
+	for i := len(versions) - 1; i >= 0; i-- {
+		catalog.Versions = append(catalog.Versions,
+			catalogVersion(registryURL, info.BoxName(), versions[i].version, versions[i].Providers))
+	}
+	return catalog, errcode.Error{}
+}
+
+func (c *controller) DownloadBox(ctx context.Context, info ArtifactInfo) (
+	*commons.ResponseHeaders,
+	*storage.FileReader,
+	string,
+	errcode.Error,
+) {
+	responseHeaders := &commons.ResponseHeaders{
+		Headers: make(map[string]string),
+		Code:    0,
+	}
+	if errc := validateBox(info); !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return responseHeaders, nil, "", errc
+	}
+	version, err := c.getVersion(ctx, registry.ID, info.BoxName(), info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of %s not found", info.Version, info.BoxName()))
+	}
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	provider, ok := vagrantmetadata.FindProvider(version.Providers, info.Provider, info.Architecture)
+	if !ok {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithMessage(
+			fmt.Sprintf("version %s of %s has no %s box", info.Version, info.BoxName(), providerName(info)))
+	}
+	fileReader, _, redirectURL, err := c.fileManager.DownloadFile(ctx,
+		"/"+filePath(info.BoxName(), info.Version, provider.Filename),
+		types.Registry{
+			ID:   registry.ID,
+			Name: info.RegIdentifier,
+		}, info.StorageRoot())
+	if err != nil {
+		return responseHeaders, nil, "", errcode.ErrCodeNameUnknown.WithDetail(err)
+	}
+	pkg.RecordDownload(ctx, c.downloadStatRecorder, c.imageDao, c.artifactDao, registry.ID, info.BoxName(), info.Version)
+	responseHeaders.Headers["Content-Disposition"] = "attachment; filename=" + provider.Filename
+	responseHeaders.Code = http.StatusOK
+	return responseHeaders, fileReader, redirectURL, errcode.Error{}
+}
+
+type publishedVersion struct {
+	database.VagrantMetadata
+	version string
+}
+
+func (c *controller) getRegistry(ctx context.Context, info ArtifactInfo) (*types.Registry, errcode.Error) {
+	registry, err := c.registryDao.GetByRootParentIDAndName(ctx, info.RootParentID, info.RegIdentifier)
+	if err != nil {
+		return nil, errcode.ErrCodeRegNotFound.WithDetail(err)
+	}
+	if registry.PackageType != artifact.PackageTypeVAGRANT {
+		return nil, errcode.ErrCodeInvalidRequest.WithMessage(
+			fmt.Sprintf("registry %s isn't a Vagrant registry", registry.Name))
+	}
+	return registry, errcode.Error{}
+}
+
+func validateBox(info ArtifactInfo) errcode.Error {
+	if err := vagrantmetadata.ValidateName(info.Org, info.Name); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := vagrantmetadata.ValidateVersion(info.Version); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	if err := vagrantmetadata.ValidateProvider(info.Provider, info.Architecture); err != nil {
+		return errcode.ErrCodeInvalidRequest.WithMessage(err.Error())
+	}
+	return errcode.Error{}
+}
+
+// listVersions lists the versions of a box from the lowest to the highest.
+func (c *controller) listVersions(ctx context.Context, registryID int64, boxName string) ([]*publishedVersion, error) {
+	artifacts, err := c.artifactDao.GetByRegistryIDAndImage(ctx, registryID, boxName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions of %s: %w", boxName, err)
+	}
+	versions := make([]*publishedVersion, 0, len(*artifacts))
+	for i := range *artifacts {
+		version, err := toPublishedVersion(&(*artifacts)[i])
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versioning.Compare(versioning.SchemeSemver, versions[i].version, versions[j].version) < 0
+	})
+	return versions, nil
+}
+
+// getVersion returns a version of a box, or gitnessstore.ErrResourceNotFound if no box was
+// uploaded for it.
+func (c *controller) getVersion(
+	ctx context.Context,
+	registryID int64,
+	boxName, version string,
+) (*publishedVersion, error) {
+	image, err := c.imageDao.GetByName(ctx, registryID, boxName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find %s: %w", boxName, err)
+	}
+	a, err := c.artifactDao.GetByName(ctx, image.ID, version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version %s of %s: %w", version, boxName, err)
+	}
+	return toPublishedVersion(a)
+}
+
+func toPublishedVersion(a *types.Artifact) (*publishedVersion, error) {
+	version := &publishedVersion{version: a.Version}
+	if err := json.Unmarshal(a.Metadata, &version.VagrantMetadata); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata of version %s: %w", a.Version, err)
+	}
+	return version, nil
+}
+
+// catalogVersion lists the boxes of a version. The first box uploaded for a provider is the
+// default for the architectures no box was built for.
+func catalogVersion(
+	registryURL, boxName, version string,
+	providers []vagrantmetadata.Provider,
+) CatalogVersion {
+	v := CatalogVersion{Version: version, Status: statusActive, Providers: make([]CatalogProvider, 0,
+		len(providers))}
+	defaults := make(map[string]bool)
+	for _, p := range providers {
+		v.Providers = append(v.Providers, CatalogProvider{
+			Name:                p.Name,
+			URL:                 boxURL(registryURL, boxName, version, p.Name, p.Architecture),
+			ChecksumType:        checksumType,
+			Checksum:            p.Sha256,
+			Architecture:        p.Architecture,
+			DefaultArchitecture: p.Architecture != "" && !defaults[p.Name],
+		})
+		defaults[p.Name] = true
+	}
+	return v
+}
+
+// boxURL returns the URL Vagrant downloads the box of a version for a provider from.
+func boxURL(registryURL, boxName, version, provider, architecture string) string {
+	u := registryURL + "/" + boxName + "/" + version + "/" + provider
+	if architecture != "" {
+		u += "?" + url.Values{ArchitectureParam: {architecture}}.Encode()
+	}
+	return u
+}
+
+// filePath returns the path a file of a version is stored at.
+func filePath(boxName, version, filename string) string {
+	return boxName + "/" + version + "/" + filename
+}
+
+func providerName(info ArtifactInfo) string {
+	if info.Architecture == "" {
+		return info.Provider
+	}
+	return info.Provider + " " + info.Architecture
+}
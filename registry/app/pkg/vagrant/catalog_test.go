@@ -0,0 +1,53 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vagrant
+
+import (
+	"testing"
+
+	vagrantmetadata "github.com/harness/gitness/registry/app/metadata/vagrant"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalogVersion(t *testing.T) {
+	providers := []vagrantmetadata.Provider{
+		{Name: "virtualbox", Architecture: "amd64", Sha256: "a"},
+		{Name: "virtualbox", Architecture: "arm64", Sha256: "b"},
+		{Name: "libvirt", Sha256: "c"},
+	}
+	v := catalogVersion("https://pkg/vagrant", "acme/ubuntu", "1.2.0", providers)
+	assert.Equal(t, "1.2.0", v.Version)
+	assert.Equal(t, "active", v.Status)
+	assert.Equal(t, []CatalogProvider{
+		{
+			Name: "virtualbox", URL: "https://pkg/vagrant/acme/ubuntu/1.2.0/virtualbox?architecture=amd64",
+			ChecksumType: "sha256", Checksum: "a", Architecture: "amd64", DefaultArchitecture: true,
+		},
+		{
+			Name: "virtualbox", URL: "https://pkg/vagrant/acme/ubuntu/1.2.0/virtualbox?architecture=arm64",
+			ChecksumType: "sha256", Checksum: "b", Architecture: "arm64",
+		},
+		{
+			Name: "libvirt", URL: "https://pkg/vagrant/acme/ubuntu/1.2.0/libvirt",
+			ChecksumType: "sha256", Checksum: "c",
+		},
+	}, v.Providers)
+}
+
+func TestFilePath(t *testing.T) {
+	assert.Equal(t, "acme/ubuntu/1.2.0/ubuntu-1.2.0-libvirt.box",
+		filePath("acme/ubuntu", "1.2.0", "ubuntu-1.2.0-libvirt.box"))
+}
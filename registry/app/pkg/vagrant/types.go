@@ -0,0 +1,60 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vagrant
+
+import (
+	vagrantmetadata "github.com/harness/gitness/registry/app/metadata/vagrant"
+	"github.com/harness/gitness/registry/app/pkg"
+)
+
+type ArtifactInfo struct {
+	*pkg.ArtifactInfo
+	Org          string
+	Name         string
+	Version      string
+	Provider     string
+	Architecture string
+}
+
+// BoxName returns the name of the box, which it is stored under.
+func (a ArtifactInfo) BoxName() string {
+	return vagrantmetadata.BoxName(a.Org, a.Name)
+}
+
+// Catalog is the metadata of a box Vagrant resolves its versions and their providers with.
+// Source: https://developer.hashicorp.com/vagrant/docs/boxes/format#box-metadata
+type Catalog struct {
+	Name     string           `json:"name"`
+	Versions []CatalogVersion `json:"versions"`
+}
+
+type CatalogVersion struct {
+	Version   string            `json:"version"`
+	Status    string            `json:"status"`
+	Providers []CatalogProvider `json:"providers"`
+}
+
+// CatalogProvider links to the box of a version for a provider, with the checksum Vagrant
+// verifies it with.
+type CatalogProvider struct {
+	Name         string `json:"name"`
+	URL          string `json:"url"`
+	ChecksumType string `json:"checksum_type"`
+	Checksum     string `json:"checksum"`
+	Architecture string `json:"architecture,omitempty"`
+	// DefaultArchitecture marks the box of the provider Vagrant falls back to when none was built
+	// for the architecture of the host.
+	DefaultArchitecture bool `json:"default_architecture,omitempty"`
+}
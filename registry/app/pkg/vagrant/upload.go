@@ -0,0 +1,154 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vagrant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/harness/gitness/registry/app/dist_temp/errcode"
+	vagrantmetadata "github.com/harness/gitness/registry/app/metadata/vagrant"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/store/database"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// UploadBox stores the box of a version for a provider. Boxes are immutable, as Vagrant verifies
+// them with the checksum of the catalog.
+func (c *controller) UploadBox(
+	ctx context.Context,
+	info ArtifactInfo,
+	box io.Reader,
+) (*CatalogProvider, errcode.Error) {
+	if errc := validateBox(info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	registry, errc := c.getRegistry(ctx, info)
+	if !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+	if errc = c.checkBoxAbsent(ctx, registry.ID, info); !commons.IsEmptyError(errc) {
+		return nil, errc
+	}
+
+	filename := vagrantmetadata.BoxFilename(info.Name, info.Version, info.Provider, info.Architecture)
+	fileInfo, err := c.fileManager.UploadFile(ctx, filePath(info.BoxName(), info.Version, filename),
+		info.RegIdentifier, registry.ID, info.RootParentID, info.StorageRoot(), nil, box, filename)
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	provider := vagrantmetadata.Provider{
+		Name:         info.Provider,
+		Architecture: info.Architecture,
+		Filename:     fileInfo.Filename,
+		Size:         fileInfo.Size,
+		Sha256:       fileInfo.Sha256,
+	}
+	file := database.File{Size: fileInfo.Size, Filename: fileInfo.Filename, CreatedAt: time.Now().UnixMilli()}
+	err = c.addProvider(ctx, registry.ID, info, provider, file)
+	if errors.Is(err, errBoxExists) {
+		return nil, errcode.ErrCodeConflict.WithMessage(boxExistsMessage(info))
+	}
+	if err != nil {
+		return nil, errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	url := boxURL(c.registryURL(ctx, info), info.BoxName(), info.Version, provider.Name, provider.Architecture)
+	return &CatalogProvider{
+		Name:         provider.Name,
+		URL:          url,
+		ChecksumType: checksumType,
+		Checksum:     provider.Sha256,
+		Architecture: provider.Architecture,
+	}, errcode.Error{}
+}
+
+var errBoxExists = errors.New("box exists")
+
+// checkBoxAbsent fails if the box was uploaded before, saving clients from uploading it again.
+func (c *controller) checkBoxAbsent(ctx context.Context, registryID int64, info ArtifactInfo) errcode.Error {
+	version, err := c.getVersion(ctx, registryID, info.BoxName(), info.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return errcode.Error{}
+	}
+	if err != nil {
+		return errcode.ErrCodeUnknown.WithDetail(err)
+	}
+	if _, ok := vagrantmetadata.FindProvider(version.Providers, info.Provider, info.Architecture); ok {
+		return errcode.ErrCodeConflict.WithMessage(boxExistsMessage(info))
+	}
+	return errcode.Error{}
+}
+
+// addProvider adds the uploaded box to the version, creating the version unless it exists.
+func (c *controller) addProvider(
+	ctx context.Context,
+	registryID int64,
+	info ArtifactInfo,
+	provider vagrantmetadata.Provider,
+	file database.File,
+) error {
+	return c.tx.WithTx(
+		ctx, func(ctx context.Context) error {
+			image := &types.Image{
+				Name:       info.BoxName(),
+				RegistryID: registryID,
+				Enabled:    true,
+			}
+			if err := c.imageDao.CreateOrUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to create image for artifact: [%s], error: %w", info.BoxName(), err)
+			}
+
+			var metadata database.VagrantMetadata
+			existing, err := c.artifactDao.GetByName(ctx, image.ID, info.Version)
+			if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+				return fmt.Errorf("failed to find version %s of %s: %w", info.Version, info.BoxName(), err)
+			}
+			if existing != nil {
+				if err = json.Unmarshal(existing.Metadata, &metadata); err != nil {
+					return fmt.Errorf("failed to parse metadata of version %s: %w", info.Version, err)
+				}
+			}
+			if _, ok := vagrantmetadata.FindProvider(metadata.Providers, provider.Name, provider.Architecture); ok {
+				return errBoxExists
+			}
+			metadata.Providers = append(metadata.Providers, provider)
+			metadata.Files = append(metadata.Files, file)
+			metadata.FileCount = int64(len(metadata.Files))
+
+			metadataJSON, err := json.Marshal(metadata)
+			if err != nil {
+				return fmt.Errorf("failed to parse metadata for artifact: [%s] with error: %w", info.BoxName(), err)
+			}
+			err = c.artifactDao.CreateOrUpdate(ctx, &types.Artifact{
+				ImageID:  image.ID,
+				Version:  info.Version,
+				Metadata: metadataJSON,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create artifact : [%s] with error: %w", info.BoxName(), err)
+			}
+			return nil
+		})
+}
+
+func boxExistsMessage(info ArtifactInfo) string {
+	return fmt.Sprintf("the %s box of version %s of %s was uploaded before", providerName(info), info.Version,
+		info.BoxName())
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	awsecrapi "github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/rs/zerolog/log"
@@ -120,6 +121,10 @@ func getAwsSvc(accessKey, secretKey string, reg types.UpstreamProxy) (*awsecrapi
 			secretKey,
 			"")
 	}
+	// With AwsIam the credentials come from the environment, optionally assuming a role with them.
+	if api.AuthType(reg.RepoAuthType) == api.AuthTypeAwsIam && reg.AwsRoleArn != "" {
+		cred = stscreds.NewCredentials(sess.Copy(&aws.Config{Region: &region}), reg.AwsRoleArn)
+	}
 
 	config := &aws.Config{
 		Credentials: cred,
@@ -147,6 +152,9 @@ func getCreds(
 	if api.AuthType(reg.RepoAuthType) == api.AuthTypeAnonymous {
 		return "", "", true, nil
 	}
+	if api.AuthType(reg.RepoAuthType) == api.AuthTypeAwsIam {
+		return "", "", false, nil
+	}
 	if api.AuthType(reg.RepoAuthType) != api.AuthTypeAccessKeySecretKey {
 		log.Debug().Msgf("invalid auth type: %s", reg.RepoAuthType)
 		return "", "", false, nil
@@ -25,6 +25,7 @@ import (
 	"github.com/harness/gitness/registry/app/common/lib/errors"
 	adp "github.com/harness/gitness/registry/app/remote/adapter"
 	"github.com/harness/gitness/registry/app/remote/clients/registry"
+	"github.com/harness/gitness/registry/app/remote/clients/registry/auth/token"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/secret"
 
@@ -52,6 +53,11 @@ func NewAdapter(
 	adapter := &Adapter{
 		proxy: reg,
 	}
+	if api.AuthType(reg.RepoAuthType) == api.AuthTypeToken {
+		authorizer := token.NewAuthorizer(reg.RepoURL, getSecret(ctx, spaceFinder, service, reg))
+		adapter.Client = registry.NewClientWithAuthorizer(reg.RepoURL, authorizer, false)
+		return adapter
+	}
 	// Get the password: lookup secrets.secret_data using secret_identifier & secret_space_id.
 	password := getPwd(ctx, spaceFinder, service, reg)
 	username, password, url := reg.UserName, password, reg.RepoURL
@@ -72,24 +78,29 @@ func getPwd(
 	ctx context.Context, spaceFinder refcache.SpaceFinder, secretService secret.Service, reg types.UpstreamProxy,
 ) string {
 	if api.AuthType(reg.RepoAuthType) == api.AuthTypeUserPassword {
-		secretSpaceID := reg.SecretSpaceID
-		secretIdentifier := reg.SecretIdentifier
-
-		spacePath, err := spaceFinder.FindByID(ctx, secretSpaceID)
-		if err != nil {
-			log.Error().Msgf("failed to find space path: %v", err)
-			return ""
-		}
-		decryptSecret, err := secretService.DecryptSecret(ctx, spacePath.Path, secretIdentifier)
-		if err != nil {
-			log.Error().Msgf("failed to decrypt secret: %v", err)
-			return ""
-		}
-		return decryptSecret
+		return getSecret(ctx, spaceFinder, secretService, reg)
 	}
 	return ""
 }
 
+// getSecret decrypts the secret referenced by secret_identifier & secret_space_id.
+// It's resolved every time an adapter is created, so a rotated secret is picked up by the next request.
+func getSecret(
+	ctx context.Context, spaceFinder refcache.SpaceFinder, secretService secret.Service, reg types.UpstreamProxy,
+) string {
+	spacePath, err := spaceFinder.FindByID(ctx, reg.SecretSpaceID)
+	if err != nil {
+		log.Error().Msgf("failed to find space path: %v", err)
+		return ""
+	}
+	decryptSecret, err := secretService.DecryptSecret(ctx, spacePath.Path, reg.SecretIdentifier)
+	if err != nil {
+		log.Error().Msgf("failed to decrypt secret: %v", err)
+		return ""
+	}
+	return decryptSecret
+}
+
 // HealthCheck checks health status of a proxy.
 func (a *Adapter) HealthCheck() (string, error) {
 	return "Not implemented", nil
@@ -0,0 +1,58 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	adp "github.com/harness/gitness/registry/app/remote/adapter"
+	"github.com/harness/gitness/registry/app/remote/adapter/native"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/secret"
+
+	"github.com/rs/zerolog/log"
+)
+
+func init() {
+	adapterType := string(artifact.UpstreamConfigSourceNpmjs)
+	if err := adp.RegisterFactory(adapterType, new(factory)); err != nil {
+		log.Error().Stack().Err(err).Msgf("Register adapter factory for %s", adapterType)
+		return
+	}
+}
+
+type factory struct {
+}
+
+// Create ...
+func (f *factory) Create(
+	ctx context.Context, spaceFinder refcache.SpaceFinder, record types.UpstreamProxy, service secret.Service,
+) (adp.Adapter, error) {
+	return &adapter{
+		Adapter: native.NewAdapter(ctx, spaceFinder, service, record),
+	}, nil
+}
+
+var (
+	_ adp.Adapter          = (*adapter)(nil)
+	_ adp.ArtifactRegistry = (*adapter)(nil)
+)
+
+// adapter fetches packuments and tarballs from npm registries, which serve them as plain files.
+type adapter struct {
+	*native.Adapter
+}
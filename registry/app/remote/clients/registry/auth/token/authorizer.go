@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/common/lib"
+)
+
+// NewAuthorizer returns an authorizer which sends a static bearer token to the registry at registryURL.
+// Requests to other hosts, e.g. redirects to blob storage, are left untouched so the token doesn't leak.
+func NewAuthorizer(registryURL, token string) lib.Authorizer {
+	a := &authorizer{token: token}
+	if u, err := url.Parse(registryURL); err == nil {
+		a.host = u.Host
+	}
+	return a
+}
+
+type authorizer struct {
+	host  string
+	token string
+}
+
+func (a *authorizer) Modify(req *http.Request) error {
+	if len(a.token) == 0 || req.URL == nil || !strings.EqualFold(req.URL.Host, a.host) {
+		return nil
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	return nil
+}
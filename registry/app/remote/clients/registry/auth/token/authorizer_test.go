@@ -0,0 +1,35 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModify(t *testing.T) {
+	authorizer := NewAuthorizer("https://registry.example.com/npm", "t0k3n")
+
+	req, _ := http.NewRequest(http.MethodGet, "https://registry.example.com/npm/left-pad", nil)
+	require.NoError(t, authorizer.Modify(req))
+	assert.Equal(t, "Bearer t0k3n", req.Header.Get("Authorization"))
+
+	req, _ = http.NewRequest(http.MethodGet, "https://storage.example.com/blob", nil)
+	require.NoError(t, authorizer.Modify(req))
+	assert.Empty(t, req.Header.Get("Authorization"))
+}
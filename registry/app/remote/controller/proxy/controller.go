@@ -57,6 +57,7 @@ type Controller interface {
 		ctx context.Context,
 		art pkg.RegistryInfo,
 		remote RemoteInterface,
+		proxy types.UpstreamProxy,
 		acceptHeader []string,
 		ifNoneMatchHeader []string,
 	) (bool, *ManifestList, error)
@@ -153,19 +154,32 @@ type ManifestList struct {
 // need to delegate to remote registry
 // the return error should be NotFoundError when it is not found in remote registry
 // the error will be captured by framework and return 404 to client.
+// The remote registry isn't consulted while the proxy is offline or the manifest was checked within the
+// metadata TTL of the proxy.
 func (c *controller) UseLocalManifest(
 	ctx context.Context,
 	art pkg.RegistryInfo,
 	remote RemoteInterface,
+	proxy types.UpstreamProxy,
 	acceptHeaders []string,
 	ifNoneMatchHeader []string,
 ) (bool, *ManifestList, error) {
 	// TODO: get from DB
 	_, d, man, e := c.localRegistry.PullManifest(ctx, art, acceptHeaders, ifNoneMatchHeader)
 	if len(e) > 0 {
+		if proxy.Offline {
+			return false, nil, errors.NotFoundError(fmt.Errorf("registry %v is offline, manifest %v isn't cached",
+				art.RegIdentifier, getReference(art)))
+		}
 		return false, nil, nil
 	}
 
+	fetchKey := "manifests/" + art.Image + "/" + getReference(art)
+	if _, fresh := Fetches.Get(proxy.RegistryID, fetchKey, proxy.MetadataTTL); fresh || proxy.Offline {
+		mediaType, payload, _ := man.Payload()
+		return true, &ManifestList{payload, d.Digest.String(), mediaType}, nil
+	}
+
 	remoteRepo := getRemoteRepo(art)
 	exist, desc, err := remote.ManifestExist(remoteRepo, getReference(art)) // HEAD.
 	// TODO: Check for rate limit error.
@@ -187,6 +201,7 @@ func (c *controller) UseLocalManifest(
 		return false, nil, errors.NotFoundError(fmt.Errorf("registry %v, tag %v not found", art.RegIdentifier, art.Tag))
 	}
 
+	Fetches.Put(proxy.RegistryID, fetchKey, nil)
 	log.Info().Msgf("Manifest: %s", getReference(art))
 	mediaType, payload, _ := man.Payload()
 
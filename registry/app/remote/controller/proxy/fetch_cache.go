@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxFetchCacheEntries bounds the fetch cache, which is keyed by the paths requested from upstreams.
+const maxFetchCacheEntries = 10000
+
+// FetchCache remembers when content was fetched from upstream proxies, along with the content of the
+// metadata that isn't stored in the registry, so it's served without contacting the upstream until the
+// TTL of the proxy elapses. It's kept per instance, content is fetched again after a restart.
+type FetchCache struct {
+	mx      sync.Mutex
+	entries map[string]fetch
+}
+
+type fetch struct {
+	content   []byte
+	fetchedAt time.Time
+}
+
+// Fetches is the fetch cache shared by the upstream proxies of all package types.
+var Fetches = NewFetchCache()
+
+func NewFetchCache() *FetchCache {
+	return &FetchCache{entries: make(map[string]fetch)}
+}
+
+// Get returns the content fetched for the key from the upstream proxy registry, if it was fetched less
+// than ttl ago.
+func (c *FetchCache) Get(registryID int64, key string, ttl time.Duration) ([]byte, bool) {
+	if ttl <= 0 {
+		return nil, false
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	f, ok := c.entries[fetchKey(registryID, key)]
+	if !ok || time.Since(f.fetchedAt) >= ttl {
+		return nil, false
+	}
+	return f.content, true
+}
+
+// Put records that the content for the key was fetched from the upstream proxy registry. The content is
+// nil for files stored in the registry.
+func (c *FetchCache) Put(registryID int64, key string, content []byte) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if len(c.entries) >= maxFetchCacheEntries {
+		clear(c.entries)
+	}
+	c.entries[fetchKey(registryID, key)] = fetch{content: content, fetchedAt: time.Now()}
+}
+
+func fetchKey(registryID int64, key string) string {
+	return strconv.FormatInt(registryID, 10) + ":" + key
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchCache(t *testing.T) {
+	c := NewFetchCache()
+	c.Put(1, "packuments/left-pad", []byte("{}"))
+
+	content, ok := c.Get(1, "packuments/left-pad", time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("{}"), content)
+
+	_, ok = c.Get(1, "packuments/left-pad", 0)
+	assert.False(t, ok, "content isn't served without a TTL")
+	_, ok = c.Get(2, "packuments/left-pad", time.Minute)
+	assert.False(t, ok, "content is kept per registry")
+
+	c.entries[fetchKey(1, "packuments/left-pad")] = fetch{fetchedAt: time.Now().Add(-2 * time.Minute)}
+	_, ok = c.Get(1, "packuments/left-pad", time.Minute)
+	assert.False(t, ok, "content expires after the TTL")
+}
@@ -30,7 +30,10 @@ import (
 	_ "github.com/harness/gitness/registry/app/remote/adapter/maven" // This is required to init maven adapter
 )
 
-const MavenCentralURL = "https://repo1.maven.org/maven2"
+const (
+	MavenCentralURL = "https://repo1.maven.org/maven2"
+	GoogleMavenURL  = "https://maven.google.com"
+)
 
 // RemoteInterface defines operations related to remote repository under proxy.
 type RemoteInterface interface {
@@ -53,8 +56,11 @@ func NewRemoteHelper(
 	ctx context.Context, spaceFinder refcache.SpaceFinder, secretService secret.Service,
 	proxy types.UpstreamProxy,
 ) (RemoteInterface, error) {
-	if proxy.Source == string(api.UpstreamConfigSourceMavenCentral) {
+	switch proxy.Source {
+	case string(api.UpstreamConfigSourceMavenCentral):
 		proxy.RepoURL = MavenCentralURL
+	case string(api.UpstreamConfigSourceGoogleMaven):
+		proxy.RepoURL = GoogleMavenURL
 	}
 	r := &remoteHelper{
 		upstreamProxy: proxy,
@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package npm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/harness/gitness/app/services/refcache"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/remote/adapter"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/secret"
+
+	_ "github.com/harness/gitness/registry/app/remote/adapter/npm" // This is required to init npm adapter
+)
+
+const NpmjsURL = "https://registry.npmjs.org"
+
+var errNotArtifactRegistry = errors.New("npm adapter isn't an artifact registry")
+
+// RemoteInterface defines operations related to npm upstream registries.
+type RemoteInterface interface {
+	// GetPackument downloads the document listing the versions of a package.
+	GetPackument(name string) (io.ReadCloser, error)
+
+	// GetTarball downloads the tarball of a version.
+	GetTarball(name, filename string) (*commons.ResponseHeaders, io.ReadCloser, error)
+}
+
+type remoteHelper struct {
+	registry adapter.ArtifactRegistry
+}
+
+// NewRemoteHelper create a remote interface.
+func NewRemoteHelper(
+	ctx context.Context, spaceFinder refcache.SpaceFinder, secretService secret.Service,
+	proxy types.UpstreamProxy,
+) (RemoteInterface, error) {
+	proxy.RepoURL = UpstreamURL(proxy)
+	factory, err := adapter.GetFactory(string(api.UpstreamConfigSourceNpmjs))
+	if err != nil {
+		return nil, err
+	}
+	adp, err := factory.Create(ctx, spaceFinder, proxy, secretService)
+	if err != nil {
+		return nil, err
+	}
+	reg, ok := adp.(adapter.ArtifactRegistry)
+	if !ok {
+		return nil, errNotArtifactRegistry
+	}
+	return &remoteHelper{registry: reg}, nil
+}
+
+// UpstreamURL returns the URL of the npm registry of the upstream proxy, defaulting it from the source.
+func UpstreamURL(proxy types.UpstreamProxy) string {
+	if proxy.Source == string(api.UpstreamConfigSourceNpmjs) {
+		return NpmjsURL
+	}
+	return strings.TrimSuffix(proxy.RepoURL, "/")
+}
+
+func (r *remoteHelper) GetPackument(name string) (io.ReadCloser, error) {
+	// npm registries expect the slash of scoped names encoded
+	_, body, err := r.registry.GetFile(url.PathEscape(name))
+	return body, err
+}
+
+func (r *remoteHelper) GetTarball(name, filename string) (*commons.ResponseHeaders, io.ReadCloser, error) {
+	return r.registry.GetFile(name + "/-/" + filename)
+}
@@ -0,0 +1,201 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/common/lib/errors"
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/rs/zerolog/log"
+)
+
+// PackageCoordinate is what the package rules of an upstream proxy are matched against. Version is
+// empty when a package is resolved by name, e.g. for its list of versions or for a manifest by digest.
+type PackageCoordinate struct {
+	Group   string
+	Name    string
+	Version string
+}
+
+func (c PackageCoordinate) String() string {
+	s := c.Name
+	if c.Group != "" {
+		s = c.Group + "/" + c.Name
+	}
+	if c.Version != "" {
+		s += "@" + c.Version
+	}
+	return s
+}
+
+// ImageCoordinate splits an image into its namespace and name, the tag is its version. Manifests pulled
+// by digest have no tag.
+func ImageCoordinate(image, tag string) PackageCoordinate {
+	c := PackageCoordinate{Name: image, Version: tag}
+	if i := strings.LastIndex(image, "/"); i >= 0 {
+		c.Group, c.Name = image[:i], image[i+1:]
+	}
+	return c
+}
+
+// NpmCoordinate splits the scope off an npm package, the scope being its group.
+func NpmCoordinate(name, version string) PackageCoordinate {
+	scope := npm.Scope(name)
+	if scope == "" {
+		return PackageCoordinate{Name: name, Version: version}
+	}
+	return PackageCoordinate{Group: scope, Name: name[len(scope)+1:], Version: version}
+}
+
+// ValidatePackageRule checks the globs and the version range of a package rule.
+func ValidatePackageRule(rule *types.PackageRule) error {
+	if rule.Action != types.PackageRuleActionInclude && rule.Action != types.PackageRuleActionExclude {
+		return fmt.Errorf("invalid action %q", rule.Action)
+	}
+	if rule.Group == "" && rule.Name == "" && rule.VersionRange == "" {
+		return fmt.Errorf("a group, name or version range is required")
+	}
+	for _, pattern := range []string{rule.Group, rule.Name} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	if rule.VersionRange != "" {
+		if _, err := semver.NewConstraint(rule.VersionRange); err != nil {
+			return fmt.Errorf("invalid version range %q: %w", rule.VersionRange, err)
+		}
+	}
+	return nil
+}
+
+// MatchPackageRules reports whether the rules block the package and why. A package is blocked if an
+// exclude rule matches it, or if there are include rules and none of them matches it. The exclude rule
+// that blocked the package is returned, nil if it wasn't included.
+//
+// A version range only matches versions it can parse as semver. When the version isn't known, include
+// rules match regardless of their version range and exclude rules with a version range don't match, so
+// that a package is only blocked by name if all of its versions are.
+func MatchPackageRules(rules []*types.PackageRule, coordinate PackageCoordinate) (*types.PackageRule, string, bool) {
+	hasIncludes, included := false, false
+	for _, rule := range rules {
+		if rule.Action == types.PackageRuleActionInclude {
+			hasIncludes = true
+		}
+		if !matchesPackageRule(rule, coordinate) {
+			continue
+		}
+		if rule.Action == types.PackageRuleActionExclude {
+			return rule, fmt.Sprintf("excluded by rule %d", rule.ID), true
+		}
+		included = true
+	}
+	if hasIncludes && !included {
+		return nil, "not included by any rule", true
+	}
+	return nil, "", false
+}
+
+func matchesPackageRule(rule *types.PackageRule, coordinate PackageCoordinate) bool {
+	if !matchesGlob(rule.Group, coordinate.Group) || !matchesGlob(rule.Name, coordinate.Name) {
+		return false
+	}
+	if rule.VersionRange == "" {
+		return true
+	}
+	if coordinate.Version == "" {
+		return rule.Action == types.PackageRuleActionInclude
+	}
+	constraint, err := semver.NewConstraint(rule.VersionRange)
+	if err != nil {
+		return false
+	}
+	version, err := semver.NewVersion(coordinate.Version)
+	if err != nil {
+		return false
+	}
+	return constraint.Check(version)
+}
+
+func matchesGlob(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+// CheckPackageRules returns a not found error if the package rules of the upstream proxy block the
+// package, recording the violation.
+func CheckPackageRules(
+	ctx context.Context,
+	ruleStore store.PackageRuleRepository,
+	upstreamProxy types.UpstreamProxy,
+	coordinate PackageCoordinate,
+) error {
+	rules, err := ruleStore.List(ctx, upstreamProxy.RegistryID)
+	if err != nil {
+		return fmt.Errorf("failed to list package rules of registry %s: %w", upstreamProxy.RepoKey, err)
+	}
+	rule, reason, blocked := MatchPackageRules(rules, coordinate)
+	if !blocked {
+		return nil
+	}
+	violation := &types.PackageRuleViolation{
+		RegistryID: upstreamProxy.RegistryID,
+		Group:      coordinate.Group,
+		Name:       coordinate.Name,
+		Version:    coordinate.Version,
+		Reason:     reason,
+	}
+	if rule != nil {
+		violation.RuleID = rule.ID
+	}
+	if err = ruleStore.RecordViolation(ctx, violation); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to record package rule violation of %s", coordinate)
+	}
+	return errors.NotFoundError(fmt.Errorf("package %s is blocked by registry %s: %s", coordinate,
+		upstreamProxy.RepoKey, reason))
+}
+
+// FilterPackageVersions returns the versions of the package the rules of the upstream proxy don't block.
+// Blocked versions aren't recorded as violations, since they're only listed.
+func FilterPackageVersions(
+	ctx context.Context,
+	ruleStore store.PackageRuleRepository,
+	upstreamProxy types.UpstreamProxy,
+	coordinate PackageCoordinate,
+	versions []string,
+) ([]string, error) {
+	rules, err := ruleStore.List(ctx, upstreamProxy.RegistryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list package rules of registry %s: %w", upstreamProxy.RepoKey, err)
+	}
+	allowed := make([]string, 0, len(versions))
+	for _, version := range versions {
+		coordinate.Version = version
+		if _, _, blocked := MatchPackageRules(rules, coordinate); !blocked {
+			allowed = append(allowed, version)
+		}
+	}
+	return allowed, nil
+}
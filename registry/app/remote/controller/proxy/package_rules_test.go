@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPackageRules(t *testing.T) {
+	exclude := &types.PackageRule{ID: 2, Action: types.PackageRuleActionExclude, Name: "log4j-core",
+		VersionRange: "< 2.17.1"}
+	rules := []*types.PackageRule{
+		{ID: 1, Action: types.PackageRuleActionInclude, Group: "org.apache.*"},
+		exclude,
+	}
+
+	_, _, blocked := MatchPackageRules(rules, PackageCoordinate{Group: "org.apache.logging.log4j",
+		Name: "log4j-core", Version: "2.17.1"})
+	assert.False(t, blocked)
+
+	rule, reason, blocked := MatchPackageRules(rules, PackageCoordinate{Group: "org.apache.logging.log4j",
+		Name: "log4j-core", Version: "2.14.0"})
+	assert.True(t, blocked)
+	assert.Equal(t, exclude, rule)
+	assert.Equal(t, "excluded by rule 2", reason)
+
+	rule, reason, blocked = MatchPackageRules(rules, PackageCoordinate{Group: "com.example", Name: "lib",
+		Version: "1.0.0"})
+	assert.True(t, blocked)
+	assert.Nil(t, rule)
+	assert.Equal(t, "not included by any rule", reason)
+
+	_, _, blocked = MatchPackageRules(rules, PackageCoordinate{Group: "org.apache.logging.log4j",
+		Name: "log4j-core"})
+	assert.False(t, blocked, "a package isn't blocked by name if only some of its versions are")
+
+	_, _, blocked = MatchPackageRules(rules, PackageCoordinate{Group: "org.apache.logging.log4j",
+		Name: "log4j-core", Version: "latest"})
+	assert.False(t, blocked, "version ranges don't match versions that aren't semver")
+
+	_, _, blocked = MatchPackageRules(nil, PackageCoordinate{Name: "lib", Version: "1.0.0"})
+	assert.False(t, blocked)
+}
+
+func TestValidatePackageRule(t *testing.T) {
+	assert.NoError(t, ValidatePackageRule(&types.PackageRule{Action: types.PackageRuleActionInclude,
+		Group: "@mycorp", VersionRange: "^1.2"}))
+	assert.Error(t, ValidatePackageRule(&types.PackageRule{Action: "ALLOW", Name: "lib"}))
+	assert.Error(t, ValidatePackageRule(&types.PackageRule{Action: types.PackageRuleActionExclude}))
+	assert.Error(t, ValidatePackageRule(&types.PackageRule{Action: types.PackageRuleActionExclude, Name: "[lib"}))
+	assert.Error(t, ValidatePackageRule(&types.PackageRule{Action: types.PackageRuleActionExclude,
+		VersionRange: "newest"}))
+}
+
+func TestPackageCoordinates(t *testing.T) {
+	assert.Equal(t, PackageCoordinate{Group: "library", Name: "nginx", Version: "1.25"},
+		ImageCoordinate("library/nginx", "1.25"))
+	assert.Equal(t, PackageCoordinate{Name: "alpine"}, ImageCoordinate("alpine", ""))
+	assert.Equal(t, PackageCoordinate{Group: "@mycorp", Name: "utils", Version: "1.0.0"},
+		NpmCoordinate("@mycorp/utils", "1.0.0"))
+	assert.Equal(t, PackageCoordinate{Name: "left-pad"}, NpmCoordinate("left-pad", ""))
+	assert.Equal(t, "@mycorp/utils@1.0.0", NpmCoordinate("@mycorp/utils", "1.0.0").String())
+}
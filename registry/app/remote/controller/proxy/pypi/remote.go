@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/harness/gitness/app/services/refcache"
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/remote/adapter"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/secret"
+	gitnessstore "github.com/harness/gitness/store"
+
+	_ "github.com/harness/gitness/registry/app/remote/adapter/pypi" // This is required to init pypi adapter
+)
+
+const PyPiURL = "https://pypi.org/simple"
+
+// maxProjectPageSize bounds the project pages read from upstreams, which list every file of a project.
+const maxProjectPageSize = 32 << 20
+
+var (
+	errNotHTTPClient       = errors.New("pypi adapter can't send requests")
+	errProjectPageTooLarge = fmt.Errorf("project page exceeds %d bytes", maxProjectPageSize)
+)
+
+// RemoteInterface defines operations related to PyPI upstream repositories.
+type RemoteInterface interface {
+	// GetProjectPage downloads the simple repository page of a project and returns it with its URL,
+	// which relative file URLs are resolved against.
+	GetProjectPage(ctx context.Context, name string) (string, *url.URL, error)
+
+	// GetFile downloads a distribution file by its URL.
+	GetFile(ctx context.Context, fileURL string) (io.ReadCloser, error)
+}
+
+type httpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type remoteHelper struct {
+	client  httpClient
+	baseURL string
+}
+
+// NewRemoteHelper create a remote interface.
+func NewRemoteHelper(
+	ctx context.Context, spaceFinder refcache.SpaceFinder, secretService secret.Service,
+	proxy types.UpstreamProxy,
+) (RemoteInterface, error) {
+	proxy.RepoURL = UpstreamURL(proxy)
+	factory, err := adapter.GetFactory(string(api.UpstreamConfigSourcePyPi))
+	if err != nil {
+		return nil, err
+	}
+	adp, err := factory.Create(ctx, spaceFinder, proxy, secretService)
+	if err != nil {
+		return nil, err
+	}
+	client, ok := adp.(httpClient)
+	if !ok {
+		return nil, errNotHTTPClient
+	}
+	return &remoteHelper{client: client, baseURL: proxy.RepoURL}, nil
+}
+
+// UpstreamURL returns the simple repository URL of the upstream proxy, defaulting it from the source.
+func UpstreamURL(proxy types.UpstreamProxy) string {
+	if proxy.Source == string(api.UpstreamConfigSourcePyPi) {
+		return PyPiURL
+	}
+	return strings.TrimSuffix(proxy.RepoURL, "/")
+}
+
+func (r *remoteHelper) GetProjectPage(ctx context.Context, name string) (string, *url.URL, error) {
+	pageURL, err := url.Parse(r.baseURL + "/" + url.PathEscape(name) + "/")
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid upstream URL %s: %w", r.baseURL, err)
+	}
+	body, err := r.get(ctx, pageURL.String())
+	if err != nil {
+		return "", nil, err
+	}
+	defer body.Close()
+	page, err := io.ReadAll(io.LimitReader(body, maxProjectPageSize+1))
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read project page of %s: %w", name, err)
+	}
+	if len(page) > maxProjectPageSize {
+		return "", nil, fmt.Errorf("failed to read project page of %s: %w", name, errProjectPageTooLarge)
+	}
+	return string(page), pageURL, nil
+}
+
+func (r *remoteHelper) GetFile(ctx context.Context, fileURL string) (io.ReadCloser, error) {
+	return r.get(ctx, fileURL)
+}
+
+func (r *remoteHelper) get(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%s: %w", u, gitnessstore.ErrResourceNotFound)
+		}
+		return nil, fmt.Errorf("upstream responded to %s with status %d", u, resp.StatusCode)
+	}
+	return resp.Body, nil
+}
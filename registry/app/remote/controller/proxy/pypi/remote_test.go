@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pypi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProjectPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/simple/requests/":
+			_, _ = io.WriteString(w, `<a href="requests-2.32.3.tar.gz">requests-2.32.3.tar.gz</a>`)
+		case "/simple/huge/":
+			_, _ = io.WriteString(w, strings.Repeat("a", maxProjectPageSize+1))
+		case "/simple/broken/":
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = io.WriteString(w, "upstream unavailable")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	r := &remoteHelper{client: srv.Client(), baseURL: srv.URL + "/simple"}
+	ctx := context.Background()
+
+	page, pageURL, err := r.GetProjectPage(ctx, "requests")
+	require.NoError(t, err)
+	assert.Contains(t, page, "requests-2.32.3.tar.gz")
+	assert.Equal(t, srv.URL+"/simple/requests/", pageURL.String())
+
+	_, _, err = r.GetProjectPage(ctx, "missing")
+	assert.ErrorIs(t, err, gitnessstore.ErrResourceNotFound)
+
+	_, _, err = r.GetProjectPage(ctx, "broken")
+	assert.ErrorContains(t, err, "status 502")
+
+	_, _, err = r.GetProjectPage(ctx, "huge")
+	assert.ErrorIs(t, err, errProjectPageTooLarge)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, _, err = r.GetProjectPage(canceled, "requests")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetFileStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	r := &remoteHelper{client: srv.Client(), baseURL: srv.URL}
+
+	body, err := r.GetFile(context.Background(), srv.URL+"/requests-2.32.3.tar.gz")
+	assert.Nil(t, body)
+	assert.ErrorContains(t, err, "status 500")
+}
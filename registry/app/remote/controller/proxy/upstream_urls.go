@@ -0,0 +1,129 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	api "github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy/maven"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy/npm"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy/pypi"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// MaxFailoverURLs is the number of URLs an upstream proxy can fail over to.
+const MaxFailoverURLs = 10
+
+// PrimaryUpstreamURL returns the URL the upstream proxy is configured with, defaulting it from the source.
+func PrimaryUpstreamURL(upstreamProxy types.UpstreamProxy) string {
+	switch upstreamProxy.Source {
+	case string(api.UpstreamConfigSourceDockerhub):
+		return DockerHubURL
+	case string(api.UpstreamConfigSourceMavenCentral):
+		return maven.MavenCentralURL
+	case string(api.UpstreamConfigSourceGoogleMaven):
+		return maven.GoogleMavenURL
+	case string(api.UpstreamConfigSourceNpmjs):
+		return npm.NpmjsURL
+	case string(api.UpstreamConfigSourcePyPi):
+		return pypi.PyPiURL
+	}
+	return upstreamProxy.RepoURL
+}
+
+// UpstreamURLs returns the primary URL of the upstream proxy followed by the URLs it fails over to.
+func UpstreamURLs(
+	ctx context.Context,
+	urlStore store.UpstreamURLRepository,
+	upstreamProxy types.UpstreamProxy,
+) ([]string, error) {
+	failover, err := urlStore.ListURLs(ctx, upstreamProxy.RegistryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upstream urls of registry %s: %w", upstreamProxy.RepoKey, err)
+	}
+	return append([]string{PrimaryUpstreamURL(upstreamProxy)}, failover...), nil
+}
+
+// FailOver points the upstream proxy to the first of its URLs that isn't unhealthy, URLs that weren't
+// checked yet are presumed healthy. The upstream proxy is left on its primary URL if all of them are
+// unhealthy, or if its URLs can't be listed. The URLs it fails over to are custom URLs using the
+// credentials of the primary URL.
+func FailOver(
+	ctx context.Context,
+	urlStore store.UpstreamURLRepository,
+	upstreamProxy *types.UpstreamProxy,
+) {
+	urls, err := UpstreamURLs(ctx, urlStore, *upstreamProxy)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to fail over upstream proxy")
+		return
+	}
+	if len(urls) == 1 {
+		return
+	}
+	health, err := urlStore.ListHealth(ctx, upstreamProxy.RegistryID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("failed to list upstream health of registry %s", upstreamProxy.RepoKey)
+		return
+	}
+
+	active := ActiveUpstreamURL(urls, health)
+	if active != urls[0] {
+		log.Ctx(ctx).Debug().Msgf("registry %s failed over to upstream %s", upstreamProxy.RepoKey, active)
+		upstreamProxy.Source = string(api.UpstreamConfigSourceCustom)
+		upstreamProxy.RepoURL = active
+	}
+}
+
+// ActiveUpstreamURL returns the first of the URLs that isn't unhealthy, the first URL if all of them are.
+func ActiveUpstreamURL(urls []string, health []*types.UpstreamHealth) string {
+	unhealthy := make(map[string]bool, len(health))
+	for _, h := range health {
+		unhealthy[h.URL] = !h.Healthy
+	}
+	for _, u := range urls {
+		if !unhealthy[u] {
+			return u
+		}
+	}
+	return urls[0]
+}
+
+// ValidateFailoverURLs checks the URLs an upstream proxy fails over to are distinct absolute http(s)
+// URLs other than its primary URL.
+func ValidateFailoverURLs(primary string, urls []string) error {
+	if len(urls) > MaxFailoverURLs {
+		return fmt.Errorf("an upstream proxy can fail over to at most %d urls", MaxFailoverURLs)
+	}
+	seen := map[string]bool{strings.TrimSuffix(primary, "/"): true}
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid upstream url %q", u)
+		}
+		if seen[strings.TrimSuffix(u, "/")] {
+			return fmt.Errorf("duplicate upstream url %q", u)
+		}
+		seen[strings.TrimSuffix(u, "/")] = true
+	}
+	return nil
+}
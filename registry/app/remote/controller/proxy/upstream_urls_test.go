@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActiveUpstreamURL(t *testing.T) {
+	urls := []string{"https://primary.example.com", "https://mirror1.example.com", "https://mirror2.example.com"}
+
+	assert.Equal(t, "https://primary.example.com", ActiveUpstreamURL(urls, nil),
+		"urls that weren't checked are presumed healthy")
+	assert.Equal(t, "https://mirror2.example.com", ActiveUpstreamURL(urls, []*types.UpstreamHealth{
+		{URL: "https://primary.example.com", Healthy: false},
+		{URL: "https://mirror1.example.com", Healthy: false},
+		{URL: "https://mirror2.example.com", Healthy: true},
+	}))
+	assert.Equal(t, "https://primary.example.com", ActiveUpstreamURL(urls, []*types.UpstreamHealth{
+		{URL: "https://primary.example.com", Healthy: false},
+		{URL: "https://mirror1.example.com", Healthy: false},
+		{URL: "https://mirror2.example.com", Healthy: false},
+	}), "the primary url is used if all urls are unhealthy")
+}
+
+func TestValidateFailoverURLs(t *testing.T) {
+	primary := "https://registry.npmjs.org/"
+
+	assert.NoError(t, ValidateFailoverURLs(primary, nil))
+	assert.NoError(t, ValidateFailoverURLs(primary, []string{"https://mirror.example.com/npm"}))
+	assert.Error(t, ValidateFailoverURLs(primary, []string{"https://registry.npmjs.org"}),
+		"the primary url can't be a failover url")
+	assert.Error(t, ValidateFailoverURLs(primary, []string{"https://a.example.com", "https://a.example.com/"}))
+	assert.Error(t, ValidateFailoverURLs(primary, []string{"ftp://mirror.example.com"}))
+	assert.Error(t, ValidateFailoverURLs(primary, []string{"mirror.example.com"}))
+
+	tooMany := make([]string, 0, MaxFailoverURLs+1)
+	for i := 0; i <= MaxFailoverURLs; i++ {
+		tooMany = append(tooMany, "https://mirror"+strings.Repeat("x", i)+".example.com")
+	}
+	assert.Error(t, ValidateFailoverURLs(primary, tooMany))
+}
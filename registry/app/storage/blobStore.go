@@ -20,6 +20,7 @@ import (
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -37,6 +38,7 @@ type genericBlobStore struct {
 	driver        driver.StorageDriver
 	rootParentRef string
 	redirect      bool
+	readAheadSize int
 }
 
 func (bs *genericBlobStore) Info() string {
@@ -58,11 +60,7 @@ func (bs *genericBlobStore) Get(ctx context.Context, filePath string, size int64
 		}
 		// Fallback to serving the content directly.
 	}
-	br, err := NewFileReader(ctx, bs.driver, filePath, size)
-	if err != nil {
-		return nil, "", err
-	}
-	return br, "", nil
+	return newFileReader(ctx, bs.driver, filePath, size, bs.readAheadSize), "", nil
 }
 
 var _ GenericBlobStore = &genericBlobStore{}
@@ -94,26 +92,32 @@ func (bs *genericBlobStore) newBlobUpload(
 	return fw, nil
 }
 
+// Resume reopens a blob write session started with Create, appending to the content written
+// before the previous writer was closed. The returned handle reports the size written so far.
+func (bs *genericBlobStore) Resume(ctx context.Context, filePath string) (driver.FileWriter, error) {
+	dcontext.GetLogger(ctx, log.Ctx(ctx).Debug()).Msg("(*genericBlobStore).Resume")
+
+	path, err := pathFor(
+		uploadFilePathSpec{
+			path: filePath,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bs.newBlobUpload(ctx, path, true)
+}
+
 // Write takes a file writer and a multipart form file or file reader,
 // streams the file to the writer, and calculates hashes.
 func (bs *genericBlobStore) Write(ctx context.Context, w driver.FileWriter, file multipart.File,
 	fileReader io.Reader) (pkg.FileInfo, error) {
-	// Create new hash.Hash instances for SHA256 and SHA512
-	sha1Hasher := sha1.New()
-	sha256Hasher := sha256.New()
-	sha512Hasher := sha512.New()
-	md5Hasher := md5.New()
-
-	// Create a MultiWriter to write to both hashers simultaneously
-	mw := io.MultiWriter(sha1Hasher, sha256Hasher, sha512Hasher, md5Hasher, w)
-	// Copy the data from S3 object stream to the MultiWriter
-	var err error
-	var totalBytesWritten int64
+	var src io.Reader = file
 	if fileReader != nil {
-		totalBytesWritten, err = io.Copy(mw, fileReader)
-	} else {
-		totalBytesWritten, err = io.Copy(mw, file)
+		src = fileReader
 	}
+	fileInfo, err := copyWithHashes(w, src)
 	if err != nil {
 		return pkg.FileInfo{}, fmt.Errorf("failed to copy file to s3: %w", err)
 	}
@@ -123,6 +127,71 @@ func (bs *genericBlobStore) Write(ctx context.Context, w driver.FileWriter, file
 		return pkg.FileInfo{}, err
 	}
 
+	return fileInfo, nil
+}
+
+// Hash reads the committed content of a file and calculates its hashes.
+func (bs *genericBlobStore) Hash(ctx context.Context, filePath string) (pkg.FileInfo, error) {
+	dcontext.GetLogger(ctx, log.Ctx(ctx).Debug()).Msg("(*genericBlobStore).Hash")
+
+	path, err := pathFor(
+		uploadFilePathSpec{
+			path: filePath,
+		},
+	)
+	if err != nil {
+		return pkg.FileInfo{}, err
+	}
+
+	r, err := bs.driver.Reader(ctx, path, 0)
+	if err != nil {
+		return pkg.FileInfo{}, err
+	}
+	defer r.Close()
+
+	fileInfo, err := copyWithHashes(io.Discard, r)
+	if err != nil {
+		return pkg.FileInfo{}, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+	return fileInfo, nil
+}
+
+// Exists reports whether content was committed at a path.
+func (bs *genericBlobStore) Exists(ctx context.Context, filePath string) (bool, error) {
+	path, err := pathFor(
+		uploadFilePathSpec{
+			path: filePath,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = bs.driver.Stat(ctx, path)
+	if errors.As(err, &driver.PathNotFoundError{}) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// copyWithHashes streams src to dst, calculating the hashes of the content on the way.
+func copyWithHashes(dst io.Writer, src io.Reader) (pkg.FileInfo, error) {
+	// Create new hash.Hash instances for SHA256 and SHA512
+	sha1Hasher := sha1.New()
+	sha256Hasher := sha256.New()
+	sha512Hasher := sha512.New()
+	md5Hasher := md5.New()
+
+	// Create a MultiWriter to write to both hashers simultaneously
+	mw := io.MultiWriter(sha1Hasher, sha256Hasher, sha512Hasher, md5Hasher, dst)
+	totalBytesWritten, err := io.Copy(mw, src)
+	if err != nil {
+		return pkg.FileInfo{}, err
+	}
+
 	return pkg.FileInfo{
 		Sha1:   fmt.Sprintf("%x", sha1Hasher.Sum(nil)),
 		Sha256: fmt.Sprintf("%x", sha256Hasher.Sum(nil)),
@@ -172,10 +172,15 @@ type GenericBlobStore interface {
 	// identifier. With this approach, one can Close and Resume a BlobWriter
 	// multiple times until the BlobWriter is committed or cancelled.
 	Create(ctx context.Context, filePath string) (driver.FileWriter, error)
+	// Resume reopens a blob writer created with Create that was closed before it was committed.
+	Resume(ctx context.Context, filePath string) (driver.FileWriter, error)
 
 	Write(ctx context.Context, w driver.FileWriter, file multipart.File, fileReader io.Reader) (pkg.FileInfo, error)
 	Move(ctx context.Context, srcPath string, dstPath string) error
 	Delete(ctx context.Context, filePath string) error
 
 	Get(ctx context.Context, filePath string, size int64) (*FileReader, string, error)
+	// Hash calculates the hashes of the content committed at a path.
+	Hash(ctx context.Context, filePath string) (pkg.FileInfo, error)
+	Exists(ctx context.Context, filePath string) (bool, error)
 }
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/harness/gitness/registry/app/driver"
 )
@@ -38,8 +39,9 @@ type FileReader struct {
 	ctx context.Context
 
 	// identifying fields
-	path string
-	size int64 // size is the total size, must be set.
+	path       string
+	size       int64 // size is the total size, must be set.
+	bufferSize int   // bufferSize is the read-ahead of the buffered reader.
 
 	// mutable fields
 	rc     io.ReadCloser // remote read closer
@@ -53,12 +55,22 @@ type FileReader struct {
 // call. The reader operates optimistically, assuming that the file is already
 // there.
 func NewFileReader(ctx context.Context, driver driver.StorageDriver, path string, size int64) (*FileReader, error) {
+	return newFileReader(ctx, driver, path, size, fileReaderBufferSize), nil
+}
+
+func newFileReader(
+	ctx context.Context, driver driver.StorageDriver, path string, size int64, bufferSize int,
+) *FileReader {
+	if bufferSize <= 0 {
+		bufferSize = fileReaderBufferSize
+	}
 	return &FileReader{
-		ctx:    ctx,
-		driver: driver,
-		path:   path,
-		size:   size,
-	}, nil
+		ctx:        ctx,
+		driver:     driver,
+		path:       path,
+		size:       size,
+		bufferSize: bufferSize,
+	}
 }
 
 func (fr *FileReader) Read(p []byte) (n int, err error) {
@@ -113,6 +125,28 @@ func (fr *FileReader) Seek(offset int64, whence int) (int64, error) {
 	return fr.offset, err
 }
 
+// WriteToN copies up to n bytes from the current offset to w using buf. If zeroCopy is set, the
+// driver handed out an *os.File and w implements io.ReaderFrom, the file is passed on to w
+// directly, which lets net/http send it with sendfile(2). The returned flag reports whether
+// the copy went that way.
+func (fr *FileReader) WriteToN(w io.Writer, n int64, buf []byte, zeroCopy bool) (int64, bool, error) {
+	if _, err := fr.reader(); err != nil {
+		return 0, false, err
+	}
+
+	if rf, ok := w.(io.ReaderFrom); ok && zeroCopy && fr.brd != nil && fr.brd.Buffered() == 0 {
+		if file, ok := fr.rc.(*os.File); ok {
+			written, err := rf.ReadFrom(io.LimitReader(file, n))
+			fr.offset += written
+			return written, true, err
+		}
+	}
+
+	// hide io.ReaderFrom of w, else io.CopyBuffer would not use buf.
+	written, err := io.CopyBuffer(struct{ io.Writer }{w}, io.LimitReader(fr, n), buf)
+	return written, false, err
+}
+
 func (fr *FileReader) Close() error {
 	return fr.closeWithErr(fmt.Errorf("FileReader: closed"))
 }
@@ -140,7 +174,7 @@ func (fr *FileReader) reader() (io.Reader, error) {
 	fr.rc = rc
 
 	if fr.brd == nil {
-		fr.brd = bufio.NewReaderSize(fr.rc, fileReaderBufferSize)
+		fr.brd = bufio.NewReaderSize(fr.rc, fr.bufferSize)
 	} else {
 		fr.brd.Reset(fr.rc)
 	}
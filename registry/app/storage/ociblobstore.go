@@ -44,6 +44,7 @@ type ociBlobStore struct {
 	resumableDigestEnabled bool
 	pathFn                 func(pathPrefix string, dgst digest.Digest) (string, error)
 	redirect               bool // allows disabling RedirectURL redirects
+	readAheadSize          int
 	rootParentRef          string
 }
 
@@ -127,13 +128,7 @@ func (bs *ociBlobStore) ServeBlobInternal(
 		// Fallback to serving the content directly.
 	}
 
-	br, err := NewFileReader(ctx, bs.driver, path, desc.Size)
-	if err != nil {
-		if br != nil {
-			br.Close()
-		}
-		return nil, "", size, err
-	}
+	br := newFileReader(ctx, bs.driver, path, desc.Size, bs.readAheadSize)
 
 	headers[commons.HeaderEtag] = fmt.Sprintf(`"%s"`, desc.Digest)
 	// If-None-Match handled by ServeContent
@@ -198,7 +193,7 @@ func (bs *ociBlobStore) Open(
 		return nil, err
 	}
 
-	return NewFileReader(ctx, bs.driver, path, desc.Size)
+	return newFileReader(ctx, bs.driver, path, desc.Size, bs.readAheadSize), nil
 }
 
 // Put stores the content p in the blob store, calculating the digest.
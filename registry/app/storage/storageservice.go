@@ -18,6 +18,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/harness/gitness/registry/app/driver"
 
@@ -28,6 +29,7 @@ type Service struct {
 	deleteEnabled          bool
 	resumableDigestEnabled bool
 	redirect               bool
+	readAheadSize          int
 	driver                 driver.StorageDriver
 }
 
@@ -48,6 +50,18 @@ func EnableDelete(registry *Service) error {
 	return nil
 }
 
+// WithReadAheadSize is a functional option for NewRegistry. It sets the size in bytes
+// of the buffer that blob reads are served from.
+func WithReadAheadSize(size int) Option {
+	return func(registry *Service) error {
+		if size < 0 {
+			return fmt.Errorf("invalid read-ahead size %d", size)
+		}
+		registry.readAheadSize = size
+		return nil
+	}
+}
+
 func NewStorageService(driver driver.StorageDriver, options ...Option) (*Service, error) {
 	registry := &Service{
 		resumableDigestEnabled: true,
@@ -72,6 +86,7 @@ func (storage *Service) OciBlobsStore(ctx context.Context, repoKey string, rootP
 		redirect:               storage.redirect,
 		deleteEnabled:          storage.deleteEnabled,
 		resumableDigestEnabled: storage.resumableDigestEnabled,
+		readAheadSize:          storage.readAheadSize,
 		rootParentRef:          rootParentRef,
 	}
 }
@@ -81,6 +96,7 @@ func (storage *Service) GenericBlobsStore(repoKey string, rootParentRef string)
 		repoKey:       repoKey,
 		driver:        storage.driver,
 		redirect:      storage.redirect,
+		readAheadSize: storage.readAheadSize,
 		rootParentRef: rootParentRef,
 	}
 }
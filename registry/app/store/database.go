@@ -48,6 +48,14 @@ type BlobRepository interface {
 		image string,
 	) (bool, error)
 	TotalSizeByRootParentID(ctx context.Context, id int64) (int64, error)
+	// List returns up to limit blobs with an id greater than afterID, ordered by id.
+	List(ctx context.Context, afterID int64, limit int) ([]*types.Blob, error)
+	// ListUnreferenced returns up to limit blobs with an id greater than afterID created before the
+	// given time that are neither linked to a registry nor referenced by a manifest, ordered by id.
+	ListUnreferenced(ctx context.Context, createdBefore time.Time, afterID int64, limit int) ([]*types.Blob, error)
+	// ListByRegistryID returns up to limit blobs with an id greater than afterID that are linked to
+	// or referenced by the manifests of the registry, ordered by id.
+	ListByRegistryID(ctx context.Context, registryID int64, afterID int64, limit int) ([]*types.Blob, error)
 }
 
 type CleanupPolicyRepository interface {
@@ -58,6 +66,11 @@ type CleanupPolicyRepository interface {
 		ctx context.Context,
 		id int64,
 	) (cleanupPolicies *[]types.CleanupPolicy, err error)
+	// ListByType returns the CleanupPolicies of the given type across all registries
+	ListByType(
+		ctx context.Context,
+		policyType artifact.CleanupPolicyType,
+	) (cleanupPolicies *[]types.CleanupPolicy, err error)
 	// Create a CleanupPolicy
 	Create(
 		ctx context.Context,
@@ -133,6 +146,32 @@ type ManifestRepository interface {
 		ctx context.Context, repoID int64,
 		digest types.Digest,
 	) (types.Manifests, error)
+	// ListLocationsByDigest lists the images of the registries holding the manifest with the digest,
+	// directly or through an index, along with the tags pointing to them.
+	ListLocationsByDigest(
+		ctx context.Context,
+		registryIDs []int64,
+		digest types.Digest,
+	) ([]*types.ManifestLocation, error)
+	// ListManifestsByAnnotation lists the manifests of the registry having an annotation with the
+	// given key and, if not nil, value.
+	ListManifestsByAnnotation(
+		ctx context.Context, repoID int64,
+		key string, value *string, limit int, offset int,
+	) (types.Manifests, error)
+	CountManifestsByAnnotation(
+		ctx context.Context, repoID int64,
+		key string, value *string,
+	) (int64, error)
+	// ListSigningStates lists the manifests of the registry that aren't referrers of another
+	// manifest, with whether a signature referrer exists for them.
+	ListSigningStates(ctx context.Context, repoID int64) ([]*types.ManifestSigningState, error)
+	// ListReferrersCreatedAfter lists the manifests of the registries of the root space that refer
+	// to a subject and were created after the given time.
+	ListReferrersCreatedAfter(
+		ctx context.Context, rootParentID int64,
+		createdAfter time.Time,
+	) (types.Manifests, error)
 }
 
 type ManifestReferenceRepository interface {
@@ -153,6 +192,332 @@ type OCIImageIndexMappingRepository interface {
 	)
 }
 
+type BaseImageRepository interface {
+	// Upsert records the base image a manifest was built from.
+	Upsert(ctx context.Context, baseImage *types.BaseImage) error
+	// ListDependents lists the tags built from the given base image repository and tag.
+	ListDependents(ctx context.Context, baseRepository string, baseTag string) ([]types.ImageDependent, error)
+}
+
+type RegistryQueueRepository interface {
+	// GCManifestStats returns the backlog of the manifest review queue of the registry.
+	GCManifestStats(ctx context.Context, registryID int64) (*types.QueueStats, error)
+	// GCBlobStats returns the backlog of the blob review queue for the blobs linked to the registry.
+	GCBlobStats(ctx context.Context, registryID int64) (*types.QueueStats, error)
+	// ListPaused lists the paused queues of the registry.
+	ListPaused(ctx context.Context, registryID int64) ([]*types.PausedQueue, error)
+	// IsPaused reports whether the queue of the registry is paused.
+	IsPaused(ctx context.Context, registryID int64, queue types.QueueName) (bool, error)
+	// Pause pauses the queue of the registry, pausing a paused queue is a no-op.
+	Pause(ctx context.Context, pausedQueue *types.PausedQueue) error
+	// Resume resumes the queue of the registry.
+	Resume(ctx context.Context, registryID int64, queue types.QueueName) error
+}
+
+type ScanRepository interface {
+	// Create records the result of a scan, the findings of the scan are created separately.
+	Create(ctx context.Context, scan *types.Scan) error
+	CreateFindings(ctx context.Context, findings []*types.ScanFinding) error
+	CreateComponents(ctx context.Context, components []*types.ScanComponent) error
+	Get(ctx context.Context, registryID int64, id int64) (*types.Scan, error)
+	// ListByDigest lists the scans of the artifact with the given digest, the most recent first.
+	ListByDigest(ctx context.Context, registryID int64, digest string) ([]*types.Scan, error)
+	// ListLatestByRegistry lists the most recent scan of each tool for each digest of the registry.
+	ListLatestByRegistry(ctx context.Context, registryID int64) ([]*types.Scan, error)
+	ListFindings(ctx context.Context, scanID int64) ([]*types.ScanFinding, error)
+	// ListPackageOccurrences lists the versions of a package found by the latest scans of the
+	// artifacts of the registries.
+	ListPackageOccurrences(
+		ctx context.Context,
+		registryIDs []int64,
+		packageName string,
+	) ([]*types.PackageOccurrence, error)
+	// ListFindingsBySeverity lists the findings of the given scans with the severity.
+	ListFindingsBySeverity(
+		ctx context.Context,
+		scanIDs []int64,
+		severity types.ScanSeverity,
+	) ([]*types.ScanFinding, error)
+	// CountFindingsBySeverity counts the findings of the given scans per severity, leaving out the
+	// findings with the excluded identifiers.
+	CountFindingsBySeverity(
+		ctx context.Context,
+		scanIDs []int64,
+		excludedIdentifiers []string,
+	) (map[int64]types.ScanSeverityCounts, error)
+	// CountSuppressedFindings counts the findings of the given scans with the suppressed identifiers.
+	CountSuppressedFindings(
+		ctx context.Context,
+		scanIDs []int64,
+		suppressedIdentifiers []string,
+	) (map[int64]int64, error)
+}
+
+type VexRepository interface {
+	// Create records a VEX document with its statements, replacing a previously uploaded version
+	// of the document attached to the same artifact.
+	Create(ctx context.Context, doc *types.VexDocument) error
+	Delete(ctx context.Context, registryID int64, id int64) error
+	// ListStatements lists the statements of all VEX documents attached to the artifact.
+	ListStatements(ctx context.Context, registryID int64, digest string) ([]*types.VexStatement, error)
+	// ListStatementsByRegistry lists the statements of all VEX documents attached to the artifacts
+	// of the registry.
+	ListStatementsByRegistry(ctx context.Context, registryID int64) ([]*types.VexStatement, error)
+	// ListDocuments lists the VEX documents attached to the artifact, including their content.
+	ListDocuments(ctx context.Context, registryID int64, digest string) ([]*types.VexDocument, error)
+}
+
+type AccessGrantRepository interface {
+	Create(ctx context.Context, grant *types.AccessGrant) error
+	// ListActive lists the grants of the registry that are neither expired nor revoked at the given time.
+	ListActive(ctx context.Context, registryID int64, now time.Time) ([]*types.AccessGrant, error)
+	// ListActiveByPrincipal lists the grants of the principal on the registry with the given name
+	// that are neither expired nor revoked at the given time.
+	ListActiveByPrincipal(
+		ctx context.Context,
+		parentID int64,
+		registryName string,
+		principalID int64,
+		now time.Time,
+	) ([]*types.AccessGrant, error)
+	// Revoke ends an active grant of the registry, failing with store.ErrResourceNotFound if there's none.
+	Revoke(ctx context.Context, registryID int64, id int64, revokedBy int64, now time.Time) error
+}
+
+type ClaimMappingRepository interface {
+	Create(ctx context.Context, mapping *types.ClaimMapping) error
+	Delete(ctx context.Context, spaceID int64, id int64) error
+	// List lists the claims mappings of the space.
+	List(ctx context.Context, spaceID int64) ([]*types.ClaimMapping, error)
+	// ListByClaims lists the claims mappings of all spaces that match on one of the claims.
+	ListByClaims(ctx context.Context, claims []string) ([]*types.ClaimMapping, error)
+}
+
+type ArchiveRepository interface {
+	// Create records an indexed archive along with its entries and the Java classes compiled into it.
+	Create(
+		ctx context.Context, archive *types.Archive, entries []types.ArchiveEntry, classes []types.ArchiveClass,
+	) error
+	// CreateFrom records an indexed archive sharing the entries and classes of another archive with the
+	// same digest.
+	CreateFrom(ctx context.Context, archive *types.Archive, sourceID int64) error
+	// FindByDigest finds an archive with the digest that was indexed without error.
+	FindByDigest(ctx context.Context, digest string) (*types.Archive, error)
+	// ListPendingFiles lists up to limit files with one of the extensions that aren't indexed yet,
+	// leaving out the registries whose content index queue is paused.
+	ListPendingFiles(ctx context.Context, extensions []string, limit int) ([]*types.PendingArchive, error)
+	// ListPendingLayers lists up to limit image layers that aren't indexed yet, leaving out the
+	// registries whose content index queue is paused.
+	ListPendingLayers(ctx context.Context, limit int) ([]*types.PendingArchive, error)
+	// PendingStats returns the number of files with one of the extensions and layers of the registry
+	// that aren't indexed yet.
+	PendingStats(ctx context.Context, registryID int64, extensions []string) (*types.QueueStats, error)
+	// Search lists the entries of the archives of the registries whose path contains the query.
+	Search(
+		ctx context.Context, registryIDs []int64, query string, limit int, offset int,
+	) ([]*types.ArchiveEntryMatch, error)
+	CountSearch(ctx context.Context, registryIDs []int64, query string) (int64, error)
+	// SearchClasses lists the classes of the archives of the registries with the simple, partially
+	// or fully qualified class name.
+	SearchClasses(
+		ctx context.Context, registryIDs []int64, className string, limit int, offset int,
+	) ([]*types.ArchiveClassMatch, error)
+	CountSearchClasses(ctx context.Context, registryIDs []int64, className string) (int64, error)
+	// SearchLayers lists the files of the image layers of the registries at an absolute path, like
+	// "/usr/bin/curl", or with a file name, like "libssl.so.3". Files at an absolute path deleted by
+	// an upper layer of the image are left out.
+	SearchLayers(
+		ctx context.Context, registryIDs []int64, query string, limit int, offset int,
+	) ([]*types.ArchiveEntryMatch, error)
+	CountSearchLayers(ctx context.Context, registryIDs []int64, query string) (int64, error)
+}
+
+type RegistryConfigRevisionRepository interface {
+	// Create records a revision of the configuration of the registry, numbered after the latest one.
+	Create(ctx context.Context, revision *types.RegistryConfigRevision) error
+	Get(ctx context.Context, registryID int64, revision int64) (*types.RegistryConfigRevision, error)
+	// GetLatest returns the latest revision of the configuration of the registry.
+	GetLatest(ctx context.Context, registryID int64) (*types.RegistryConfigRevision, error)
+	// List lists the revisions of the configuration of the registry, the latest first.
+	List(ctx context.Context, registryID int64, limit int, offset int) ([]*types.RegistryConfigRevision, error)
+	Count(ctx context.Context, registryID int64) (int64, error)
+}
+
+type PermalinkRepository interface {
+	// Create saves the permalink of the version unless the version already has one.
+	Create(ctx context.Context, permalink *types.Permalink) error
+	// GetByCode returns the permalink with the code, ErrResourceNotFound if there is none.
+	GetByCode(ctx context.Context, code string) (*types.Permalink, error)
+}
+
+type DefaultRegistryRepository interface {
+	// Upsert sets the default registry of the space for the package type, replacing the previous one.
+	Upsert(ctx context.Context, defaultRegistry *types.DefaultRegistry) error
+	Delete(ctx context.Context, spaceID int64, packageType artifact.PackageType) error
+	// List lists the default registries set on the space.
+	List(ctx context.Context, spaceID int64) ([]*types.DefaultRegistry, error)
+	// ListBySpaceIDs lists the default registries for the package type set on any of the spaces.
+	ListBySpaceIDs(
+		ctx context.Context,
+		spaceIDs []int64,
+		packageType artifact.PackageType,
+	) ([]*types.DefaultRegistry, error)
+}
+
+type MavenRelocationRepository interface {
+	// Upsert sets the relocation of the Maven artifact of the registry, replacing the previous one.
+	Upsert(ctx context.Context, relocation *types.MavenRelocation) error
+	Delete(ctx context.Context, registryID int64, id int64) error
+	// Get returns the relocation of the Maven artifact of the registry.
+	Get(ctx context.Context, registryID int64, groupID string, artifactID string) (*types.MavenRelocation, error)
+	// List lists the relocations of the Maven artifacts of the registry.
+	List(ctx context.Context, registryID int64) ([]*types.MavenRelocation, error)
+}
+
+type PackageRuleRepository interface {
+	Create(ctx context.Context, rule *types.PackageRule) error
+	Delete(ctx context.Context, registryID int64, id int64) error
+	// List lists the package rules of the upstream proxy, in the order they were created.
+	List(ctx context.Context, registryID int64) ([]*types.PackageRule, error)
+	// RecordViolation records a package the upstream proxy blocked, counting it on the violation
+	// already recorded for the same version if any.
+	RecordViolation(ctx context.Context, violation *types.PackageRuleViolation) error
+	// ListViolations lists the packages the upstream proxy blocked, the most recently requested first.
+	ListViolations(ctx context.Context, registryID int64, limit int, offset int) ([]*types.PackageRuleViolation, error)
+	CountViolations(ctx context.Context, registryID int64) (int64, error)
+}
+
+type UpstreamURLRepository interface {
+	// ListURLs lists the URLs the upstream proxy fails over to, in the order they're tried after its
+	// primary URL.
+	ListURLs(ctx context.Context, registryID int64) ([]string, error)
+	// ReplaceURLs replaces the URLs the upstream proxy fails over to.
+	ReplaceURLs(ctx context.Context, registryID int64, urls []string, principalID int64) error
+	// ListRegistryIDs lists the upstream proxies that have URLs to fail over to.
+	ListRegistryIDs(ctx context.Context) ([]int64, error)
+	// ListHealth lists the last health checks of the URLs of the upstream proxy.
+	ListHealth(ctx context.Context, registryID int64) ([]*types.UpstreamHealth, error)
+	// UpsertHealth saves the last health check of a URL, replacing the previous one.
+	UpsertHealth(ctx context.Context, health *types.UpstreamHealth) error
+}
+
+type LegalHoldRepository interface {
+	// Create places a legal hold, failing with store.ErrDuplicate if the package or version is held already.
+	Create(ctx context.Context, hold *types.LegalHold) error
+	Delete(ctx context.Context, registryID int64, id int64) error
+	// List lists the legal holds of the registry, in the order they were placed.
+	List(ctx context.Context, registryID int64) ([]*types.LegalHold, error)
+	// FindBlocking returns a legal hold of the registry which blocks deleting the versions of the image,
+	// nil if there's none. A hold of the image blocks deleting any of its versions. If versions is empty,
+	// any hold of the image blocks, and any hold of the registry if the image is empty too.
+	FindBlocking(ctx context.Context, registryID int64, imageName string, versions []string) (*types.LegalHold, error)
+}
+
+type VulnerabilityAllowlistRepository interface {
+	// Save allowlists a vulnerability for a package or version, replacing the justification and expiry of
+	// an existing entry of the vulnerability for the same package or version.
+	Save(ctx context.Context, entry *types.VulnerabilityAllowlistEntry) error
+	Delete(ctx context.Context, registryID int64, id int64) error
+	// List lists the allowlist entries of the registry, the expired ones included, ordered by id.
+	List(ctx context.Context, registryID int64) ([]*types.VulnerabilityAllowlistEntry, error)
+	// ListActive lists the allowlist entries of the registry that didn't expire at the given time.
+	ListActive(ctx context.Context, registryID int64, now time.Time) ([]*types.VulnerabilityAllowlistEntry, error)
+}
+
+type DeletionCertificateRepository interface {
+	Create(ctx context.Context, certificate *types.DeletionCertificate) error
+	Get(ctx context.Context, registryID int64, id int64) (*types.DeletionCertificate, error)
+	// List lists the certificates of the hard deletes of the registry, the most recent first.
+	List(ctx context.Context, registryID int64, limit int, offset int) ([]*types.DeletionCertificate, error)
+	Count(ctx context.Context, registryID int64) (int64, error)
+}
+
+type HelmPushPolicyRepository interface {
+	// Get returns the Helm push policy of the registry, ErrResourceNotFound if it has none.
+	Get(ctx context.Context, registryID int64) (*types.HelmPushPolicy, error)
+	// Upsert saves the Helm push policy of the registry, replacing its checks.
+	Upsert(ctx context.Context, policy *types.HelmPushPolicy) error
+	Delete(ctx context.Context, registryID int64) error
+}
+
+type CacheEvictionRepository interface {
+	// GetPolicy returns the cache eviction policy of the upstream proxy, ErrResourceNotFound if it has none.
+	GetPolicy(ctx context.Context, registryID int64) (*types.CacheEvictionPolicy, error)
+	// UpsertPolicy saves the cache eviction policy of the upstream proxy, replacing its size limit.
+	UpsertPolicy(ctx context.Context, policy *types.CacheEvictionPolicy) error
+	DeletePolicy(ctx context.Context, registryID int64) error
+	ListPolicies(ctx context.Context) ([]*types.CacheEvictionPolicy, error)
+	// UpdateLastRun records the result of enforcing the cache eviction policy of the upstream proxy.
+	UpdateLastRun(ctx context.Context, registryID int64, runAt time.Time, evicted int, freedBytes int64) error
+	// CacheSize returns the size of the artifacts cached by the upstream proxy, the sum of the sizes of its
+	// manifests for images and of its files for other packages.
+	CacheSize(ctx context.Context, registryID int64, oci bool) (int64, error)
+	// ListLeastRecentlyPulled lists up to limit artifacts cached by the upstream proxy, least recently
+	// pulled first. Artifacts that were never pulled count as pulled when they were cached. Manifests
+	// referenced by an index are left to the index.
+	ListLeastRecentlyPulled(
+		ctx context.Context,
+		registryID int64,
+		oci bool,
+		limit int,
+		offset int,
+	) ([]*types.CachedArtifact, error)
+}
+
+type CachePrewarmRepository interface {
+	// CreateArtifacts saves the artifacts requested to be fetched into the cache of an upstream proxy.
+	CreateArtifacts(ctx context.Context, artifacts []*types.CachePrewarmArtifact) error
+	// ListBatch lists the artifacts of the batch in the order they were requested.
+	ListBatch(ctx context.Context, registryID int64, batch string) ([]*types.CachePrewarmArtifact, error)
+	UpdateStatus(ctx context.Context, id int64, status types.CachePrewarmStatus, errMsg string) error
+}
+
+type MirrorSyncRepository interface {
+	Create(ctx context.Context, sync *types.MirrorSync) error
+	// Get returns the mirror sync of the registry, ErrResourceNotFound if the registry has no such sync.
+	Get(ctx context.Context, registryID int64, id int64) (*types.MirrorSync, error)
+	GetByID(ctx context.Context, id int64) (*types.MirrorSync, error)
+	List(ctx context.Context, registryID int64) ([]*types.MirrorSync, error)
+	Update(ctx context.Context, sync *types.MirrorSync) error
+	Delete(ctx context.Context, registryID int64, id int64) error
+	// ListDue lists the enabled mirror syncs whose next run is due at the given time.
+	ListDue(ctx context.Context, now time.Time) ([]*types.MirrorSync, error)
+	SetNextRunAt(ctx context.Context, id int64, nextRunAt time.Time) error
+
+	CreateRun(ctx context.Context, run *types.MirrorSyncRun) error
+	GetRun(ctx context.Context, syncID int64, runID int64) (*types.MirrorSyncRun, error)
+	GetRunByID(ctx context.Context, runID int64) (*types.MirrorSyncRun, error)
+	// ListRuns lists the latest runs of the mirror sync, the most recent first.
+	ListRuns(ctx context.Context, syncID int64, limit int) ([]*types.MirrorSyncRun, error)
+	// HasActiveRun returns whether the mirror sync has a run that's pending or running.
+	HasActiveRun(ctx context.Context, syncID int64) (bool, error)
+	// UpdateRun records the status, counts and times of the run.
+	UpdateRun(ctx context.Context, run *types.MirrorSyncRun) error
+
+	// CreateArtifacts saves the tags replicated by a run, tags the run already has are ignored.
+	CreateArtifacts(ctx context.Context, artifacts []*types.MirrorSyncArtifact) error
+	ListArtifacts(ctx context.Context, runID int64) ([]*types.MirrorSyncArtifact, error)
+	UpdateArtifact(ctx context.Context, artifact *types.MirrorSyncArtifact) error
+}
+
+type UploadSessionRepository interface {
+	// Upsert saves the state of the upload, replacing the previous one.
+	Upsert(ctx context.Context, session *types.UploadSession) error
+	Get(ctx context.Context, id string) (*types.UploadSession, error)
+	// Delete removes the state of a completed or cancelled upload, it's a no-op for unknown uploads.
+	Delete(ctx context.Context, id string) error
+	// DeleteUpdatedBefore removes the state of the uploads abandoned before the given time and returns
+	// the number of removed uploads.
+	DeleteUpdatedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type BackfillRepository interface {
+	// Get returns the progress of the backfill, ErrResourceNotFound if it hasn't started.
+	Get(ctx context.Context, name string) (*types.Backfill, error)
+	// Upsert saves the progress of the backfill, replacing the previous one.
+	Upsert(ctx context.Context, backfill *types.Backfill) error
+}
+
 type LayerRepository interface {
 	AssociateLayerBlob(ctx context.Context, m *types.Manifest, b *types.Blob) error
 }
@@ -198,8 +563,9 @@ type TagRepository interface {
 		latestVersion bool, packageTypes []string,
 	) (int64, error)
 
+	// GetAllArtifactsByRepo returns the latest tags of the images of the registries.
 	GetAllArtifactsByRepo(
-		ctx context.Context, parentID int64, repoKey string,
+		ctx context.Context, registryIDs []int64,
 		sortByField string, sortByOrder string,
 		limit int, offset int, search string, labels []string,
 	) (*[]types.ArtifactMetadata, error)
@@ -211,6 +577,19 @@ type TagRepository interface {
 		imageName string,
 	) (*types.ArtifactMetadata, error)
 
+	// GetTagNamesByManifestIDs returns the tag names pointing to each of the manifests.
+	GetTagNamesByManifestIDs(
+		ctx context.Context, repoID int64,
+		manifestIDs []int64,
+	) (map[int64][]string, error)
+
+	// GetTagNamesByImageNames returns the tag names of the images, ordered by push time with the
+	// most recent first.
+	GetTagNamesByImageNames(
+		ctx context.Context, registryID int64,
+		imageNames []string,
+	) (map[string][]string, error)
+
 	GetLatestTagName(
 		ctx context.Context, parentID int64, repoKey string,
 		imageName string,
@@ -225,7 +604,7 @@ type TagRepository interface {
 	) (*types.TagMetadata, error)
 
 	CountAllArtifactsByRepo(
-		ctx context.Context, parentID int64, repoKey string,
+		ctx context.Context, registryIDs []int64,
 		search string, labels []string,
 	) (int64, error)
 
@@ -250,6 +629,24 @@ type TagRepository interface {
 
 	DeleteTag(ctx context.Context, registryID int64, imageName string, name string) (err error)
 
+	// UpdateLastPulledAt records the time a tag was last pulled by name.
+	UpdateLastPulledAt(
+		ctx context.Context, registryID int64, imageName string, name string,
+		pulledAt time.Time,
+	) error
+
+	// UpdateManifestIfMatch re-points an existing tag to the manifest only if the tag currently
+	// points to one of the expected manifests, or to any manifest if none are given. It reports
+	// whether the tag was updated.
+	UpdateManifestIfMatch(
+		ctx context.Context, registryID int64, imageName string, name string,
+		manifestID int64, expectedManifestIDs []int64,
+	) (bool, error)
+
+	// ListTagsNotPulledSince lists the tags of a registry that were last pulled, or
+	// created if never pulled, before the given time.
+	ListTagsNotPulledSince(ctx context.Context, registryID int64, since time.Time) ([]*types.Tag, error)
+
 	CountAllTagsByRepoAndImage(
 		ctx context.Context, parentID int64, repoKey string,
 		image string, search string,
@@ -335,7 +732,9 @@ type RegistryMetadata struct {
 	Labels        pq.StringArray
 	ArtifactCount int64
 	DownloadCount int64
-	Size          int64
+	// UniqueDownloadCount counts the downloads deduplicated by client, see types.DownloadStat.
+	UniqueDownloadCount int64
+	Size                int64
 }
 
 type RegistryRepository interface {
@@ -345,6 +744,8 @@ type RegistryRepository interface {
 	GetByIDIn(
 		ctx context.Context, ids []int64,
 	) (registries *[]types.Registry, err error)
+	// ListByParentID lists the registries of a space, not including the registries of its sub-spaces.
+	ListByParentID(ctx context.Context, parentID int64) (registries *[]types.Registry, err error)
 	// GetByName gets the repository specified by parent id and name
 	GetByParentIDAndName(
 		ctx context.Context, parentID int64,
@@ -391,6 +792,16 @@ type RegistryRepository interface {
 
 	FetchUpstreamProxyKeys(ctx context.Context, ids []int64) (repokeys []string, err error)
 	Count(ctx context.Context) (int64, error)
+	// CloneArtifacts copies the artifacts, manifests and tags of a registry to another registry
+	// of the same root space, referencing the same blobs.
+	CloneArtifacts(ctx context.Context, sourceID int64, targetID int64) error
+	// UpdateStoragePrefix sets the storage path prefix of the registry, an empty prefix resets it
+	// to the root space identifier.
+	UpdateStoragePrefix(ctx context.Context, registryID int64, prefix string) error
+	// ListStoragePrefixes returns the distinct custom storage prefixes used by the registries of the root space.
+	ListStoragePrefixes(ctx context.Context, rootParentID int64) ([]string, error)
+	// FindRootParentIDsByStoragePrefix returns the root spaces that have registries using the storage prefix.
+	FindRootParentIDsByStoragePrefix(ctx context.Context, prefix string) ([]int64, error)
 }
 
 type RegistryBlobRepository interface {
@@ -428,6 +839,8 @@ type ImageRepository interface {
 		ctx context.Context, parentID int64,
 		repo string, name string,
 	) (*types.Image, error)
+	// ListNamesByRegistryID lists the names of the enabled images of the registry, ordered by name.
+	ListNamesByRegistryID(ctx context.Context, registryID int64) ([]string, error)
 	// Create an Image
 	CreateOrUpdate(ctx context.Context, image *types.Image) error
 	// Update an Image
@@ -445,6 +858,11 @@ type ArtifactRepository interface {
 	GetByName(ctx context.Context, imageID int64, version string) (*types.Artifact, error)
 	// Create an Artifact
 	CreateOrUpdate(ctx context.Context, artifact *types.Artifact) error
+	// Create an Artifact, failing with store.ErrDuplicate if the version already exists.
+	Create(ctx context.Context, artifact *types.Artifact) error
+	DeleteByID(ctx context.Context, id int64) error
+	// DeleteEmptyByID deletes an Artifact that has no files, it reports whether the Artifact was deleted.
+	DeleteEmptyByID(ctx context.Context, id int64) (bool, error)
 	Count(ctx context.Context) (int64, error)
 	GetAllArtifactsByParentID(
 		ctx context.Context, id int64,
@@ -456,19 +874,42 @@ type ArtifactRepository interface {
 		ctx context.Context, parentID int64,
 		registryIDs *[]string, search string, latestVersion bool, packageTypes []string,
 	) (int64, error)
+	// StreamAllArtifactsByParentID streams the artifacts of GetAllArtifactsByParentID without paging.
+	StreamAllArtifactsByParentID(
+		ctx context.Context, parentID int64,
+		registryIDs *[]string, sortByField string, sortByOrder string,
+		search string, latestVersion bool, packageTypes []string,
+	) (<-chan *types.ArtifactMetadata, <-chan error)
+	// GetAllArtifactsByRepo returns the latest versions of the images of the registries.
 	GetAllArtifactsByRepo(
-		ctx context.Context, parentID int64, repoKey string,
+		ctx context.Context, registryIDs []int64,
 		sortByField string, sortByOrder string, limit int, offset int, search string,
 		labels []string,
 	) (*[]types.ArtifactMetadata, error)
+	// StreamAllArtifactsByRepo streams the artifacts of GetAllArtifactsByRepo without paging.
+	StreamAllArtifactsByRepo(
+		ctx context.Context, registryIDs []int64,
+		sortByField string, sortByOrder string, search string,
+		labels []string,
+	) (<-chan *types.ArtifactMetadata, <-chan error)
 	CountAllArtifactsByRepo(
-		ctx context.Context, parentID int64, repoKey string,
+		ctx context.Context, registryIDs []int64,
 		search string, labels []string,
 	) (int64, error)
 	GetLatestArtifactMetadata(
 		ctx context.Context, id int64, identifier string,
 		image string,
 	) (*types.ArtifactMetadata, error)
+	// GetVersionsByImageNames returns the artifact versions of the images, ordered by push time with
+	// the most recent first.
+	GetVersionsByImageNames(
+		ctx context.Context, registryID int64,
+		imageNames []string,
+	) (map[string][]string, error)
+	// BackfillFileCounts fills in the file count column of up to limit artifacts with an ID after the
+	// cursor from their metadata. It returns the ID of the last artifact of the batch and the number of
+	// artifacts in it, which is zero once none are left.
+	BackfillFileCounts(ctx context.Context, cursor int64, limit int) (int64, int, error)
 	GetAllVersionsByRepoAndImage(
 		ctx context.Context, id int64, identifier string, image string,
 		field string, order string, limit int, offset int, term string,
@@ -490,6 +931,25 @@ type ArtifactRepository interface {
 
 type DownloadStatRepository interface {
 	Create(ctx context.Context, downloadStat *types.DownloadStat) error
+	// CreateBatch stores downloads recorded earlier, at their timestamps, deduplicating them against
+	// each other and the stored downloads.
+	CreateBatch(ctx context.Context, downloadStats []*types.DownloadStat) error
+	// CountByClientType counts the downloads of the versions of an image by client type, most
+	// downloaded first. Downloads from from, and before to, are counted, unless they are zero.
+	CountByClientType(
+		ctx context.Context,
+		imageID int64,
+		from, to time.Time,
+	) ([]types.ClientTypeDownloadCount, error)
+	// CountByOrigin counts the downloads of the artifacts of a registry by region and network, most
+	// downloaded first. Downloads whose origin wasn't recorded aren't counted.
+	CountByOrigin(
+		ctx context.Context,
+		registryID int64,
+		from, to time.Time,
+	) ([]types.OriginDownloadCount, error)
+	// DeleteByArtifactID deletes the downloads of an artifact, returning the number of deleted downloads.
+	DeleteByArtifactID(ctx context.Context, artifactID int64) (int64, error)
 }
 
 type BandwidthStatRepository interface {
@@ -566,6 +1026,15 @@ type NodesRepository interface {
 		offset int,
 		search string,
 	) (*[]types.FileNodeMetadata, error)
+
+	// ListFilesBySha256 lists the files of the registries with the given SHA-256 checksum.
+	ListFilesBySha256(ctx context.Context, registryIDs []int64, sha256 string) ([]*types.FileLocation, error)
+	// ListByPath lists the node at the path and the nodes below it, ordered by path.
+	ListByPath(ctx context.Context, registryID int64, path string) ([]*types.Node, error)
+	// DeleteByPath deletes the node at the path and the nodes below it, returning the number of deleted nodes.
+	DeleteByPath(ctx context.Context, registryID int64, path string) (int64, error)
+	// CountByBlobID counts the files of all registries which are stored in the generic blob.
+	CountByBlobID(ctx context.Context, blobID string) (int64, error)
 }
 
 type GenericBlobRepository interface {
@@ -577,6 +1046,16 @@ type GenericBlobRepository interface {
 	Create(ctx context.Context, gb *types.GenericBlob) error
 	DeleteByID(ctx context.Context, id string) error
 	TotalSizeByRootParentID(ctx context.Context, id int64) (int64, error)
+	// List returns up to limit generic blobs with an id greater than afterID, ordered by id.
+	List(ctx context.Context, afterID string, limit int) ([]*types.GenericBlob, error)
+	// ListUnreferenced returns up to limit generic blobs with an id greater than afterID created
+	// before the given time that aren't referenced by any node, ordered by id.
+	ListUnreferenced(
+		ctx context.Context, createdBefore time.Time, afterID string, limit int,
+	) ([]*types.GenericBlob, error)
+	// ListByRegistryID returns up to limit generic blobs with an id greater than afterID that are
+	// referenced by the nodes of the registry, ordered by id.
+	ListByRegistryID(ctx context.Context, registryID int64, afterID string, limit int) ([]*types.GenericBlob, error)
 }
 
 type WebhooksRepository interface {
@@ -0,0 +1,227 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types/enum"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type accessGrantDao struct {
+	db *sqlx.DB
+}
+
+func NewAccessGrantDao(db *sqlx.DB) store.AccessGrantRepository {
+	return &accessGrantDao{
+		db: db,
+	}
+}
+
+type accessGrantDB struct {
+	ID          int64  `db:"grant_id"`
+	RegistryID  int64  `db:"grant_registry_id"`
+	PrincipalID int64  `db:"grant_principal_id"`
+	Permissions string `db:"grant_permissions"`
+	Reason      string `db:"grant_reason"`
+	ExpiresAt   int64  `db:"grant_expires_at"`
+	CreatedAt   int64  `db:"grant_created_at"`
+	CreatedBy   int64  `db:"grant_created_by"`
+}
+
+type accessGrantWithPrincipalDB struct {
+	accessGrantDB
+	PrincipalUID string `db:"principal_uid"`
+}
+
+const accessGrantColumns = `
+		grant_id
+		,grant_registry_id
+		,grant_principal_id
+		,grant_permissions
+		,grant_reason
+		,grant_expires_at
+		,grant_created_at
+		,grant_created_by
+		,principal_uid`
+
+func (dao *accessGrantDao) Create(ctx context.Context, grant *types.AccessGrant) error {
+	const sqlQuery = `
+		INSERT INTO registry_access_grants (
+			grant_registry_id
+			,grant_principal_id
+			,grant_permissions
+			,grant_reason
+			,grant_expires_at
+			,grant_created_at
+			,grant_created_by
+		) VALUES (
+			:grant_registry_id
+			,:grant_principal_id
+			,:grant_permissions
+			,:grant_reason
+			,:grant_expires_at
+			,:grant_created_at
+			,:grant_created_by
+		)
+		RETURNING grant_id`
+
+	if grant.CreatedAt.IsZero() {
+		grant.CreatedAt = time.Now()
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalAccessGrant(grant))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind access grant object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&grant.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *accessGrantDao) ListActive(
+	ctx context.Context,
+	registryID int64,
+	now time.Time,
+) ([]*types.AccessGrant, error) {
+	return dao.listActive(ctx, sq.Eq{"grant_registry_id": registryID}, now)
+}
+
+func (dao *accessGrantDao) ListActiveByPrincipal(
+	ctx context.Context,
+	parentID int64,
+	registryName string,
+	principalID int64,
+	now time.Time,
+) ([]*types.AccessGrant, error) {
+	return dao.listActive(ctx, sq.Eq{
+		"registry_parent_id": parentID,
+		"registry_name":      registryName,
+		"grant_principal_id": principalID,
+	}, now)
+}
+
+func (dao *accessGrantDao) listActive(ctx context.Context, where sq.Eq, now time.Time) ([]*types.AccessGrant, error) {
+	stmt := databaseg.Builder.
+		Select(accessGrantColumns).
+		From("registry_access_grants").
+		Join("registries ON registry_id = grant_registry_id").
+		Join("principals ON principal_id = grant_principal_id").
+		Where(where).
+		Where("grant_revoked_at IS NULL AND grant_expires_at > ?", now.UnixMilli()).
+		OrderBy("grant_expires_at", "grant_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*accessGrantWithPrincipalDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list access grants")
+	}
+
+	grants := make([]*types.AccessGrant, 0, len(dst))
+	for _, d := range dst {
+		grants = append(grants, mapToAccessGrant(d))
+	}
+	return grants, nil
+}
+
+func (dao *accessGrantDao) Revoke(
+	ctx context.Context,
+	registryID int64,
+	id int64,
+	revokedBy int64,
+	now time.Time,
+) error {
+	stmt := databaseg.Builder.Update("registry_access_grants").
+		Set("grant_revoked_at", now.UnixMilli()).
+		Set("grant_revoked_by", revokedBy).
+		Where("grant_registry_id = ? AND grant_id = ?", registryID, id).
+		Where("grant_revoked_at IS NULL AND grant_expires_at > ?", now.UnixMilli())
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the update query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func mapToInternalAccessGrant(in *types.AccessGrant) *accessGrantDB {
+	permissions := make([]string, 0, len(in.Permissions))
+	for _, p := range in.Permissions {
+		permissions = append(permissions, string(p))
+	}
+	return &accessGrantDB{
+		ID:          in.ID,
+		RegistryID:  in.RegistryID,
+		PrincipalID: in.PrincipalID,
+		Permissions: strings.Join(permissions, ","),
+		Reason:      in.Reason,
+		ExpiresAt:   in.ExpiresAt.UnixMilli(),
+		CreatedAt:   in.CreatedAt.UnixMilli(),
+		CreatedBy:   in.CreatedBy,
+	}
+}
+
+func mapToAccessGrant(in *accessGrantWithPrincipalDB) *types.AccessGrant {
+	var permissions []enum.Permission
+	for _, p := range strings.Split(in.Permissions, ",") {
+		if p != "" {
+			permissions = append(permissions, enum.Permission(p))
+		}
+	}
+	return &types.AccessGrant{
+		ID:           in.ID,
+		RegistryID:   in.RegistryID,
+		PrincipalID:  in.PrincipalID,
+		PrincipalUID: in.PrincipalUID,
+		Permissions:  permissions,
+		Reason:       in.Reason,
+		ExpiresAt:    time.UnixMilli(in.ExpiresAt),
+		CreatedAt:    time.UnixMilli(in.CreatedAt),
+		CreatedBy:    in.CreatedBy,
+	}
+}
@@ -0,0 +1,672 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/archive"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// archiveEntryBatchSize bounds the number of entries inserted by a single statement.
+const archiveEntryBatchSize = 500
+
+type archiveDao struct {
+	db *sqlx.DB
+}
+
+func NewArchiveDao(db *sqlx.DB) store.ArchiveRepository {
+	return &archiveDao{
+		db: db,
+	}
+}
+
+type archiveDB struct {
+	ID         int64   `db:"archive_id"`
+	RegistryID int64   `db:"archive_registry_id"`
+	NodeID     *string `db:"archive_node_id"`
+	LayerID    *int64  `db:"archive_layer_id"`
+	Digest     string  `db:"archive_digest"`
+	ImageName  string  `db:"archive_image_name"`
+	Version    string  `db:"archive_version"`
+	Name       string  `db:"archive_name"`
+	Format     string  `db:"archive_format"`
+	EntryCount int64   `db:"archive_entry_count"`
+	Error      string  `db:"archive_error"`
+	IndexedAt  int64   `db:"archive_indexed_at"`
+}
+
+type pendingArchiveDB struct {
+	RegistryID int64   `db:"registry_id"`
+	NodeID     *string `db:"node_id"`
+	LayerID    *int64  `db:"layer_id"`
+	Path       string  `db:"path"`
+	Name       string  `db:"name"`
+	ImageName  string  `db:"image_name"`
+	Version    []byte  `db:"version"`
+	Digest     []byte  `db:"digest"`
+	Sha256     string  `db:"sha256"`
+	MediaType  string  `db:"media_type"`
+	Size       int64   `db:"size"`
+}
+
+type archiveClassMatchDB struct {
+	RegistryID  int64  `db:"archive_registry_id"`
+	ImageName   string `db:"archive_image_name"`
+	Version     string `db:"archive_version"`
+	ArchiveName string `db:"archive_name"`
+	ClassName   string `db:"archive_class_name"`
+}
+
+type archiveEntryMatchDB struct {
+	RegistryID  int64  `db:"archive_registry_id"`
+	ImageName   string `db:"archive_image_name"`
+	Version     string `db:"archive_version"`
+	ArchiveName string `db:"archive_name"`
+	Path        string `db:"archive_entry_path"`
+	Size        int64  `db:"archive_entry_size"`
+}
+
+func (dao *archiveDao) Create(
+	ctx context.Context,
+	archive *types.Archive,
+	entries []types.ArchiveEntry,
+	classes []types.ArchiveClass,
+) error {
+	if err := dao.create(ctx, archive); err != nil {
+		return err
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	for start := 0; start < len(entries); start += archiveEntryBatchSize {
+		end := min(start+archiveEntryBatchSize, len(entries))
+		stmt := databaseg.Builder.Insert("archive_entries").
+			Columns("archive_entry_archive_id", "archive_entry_path", "archive_entry_size")
+		for _, entry := range entries[start:end] {
+			stmt = stmt.Values(archive.ID, entry.Path, entry.Size)
+		}
+		query, args, err := stmt.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to convert query to sql: %w", err)
+		}
+		if _, err = db.ExecContext(ctx, query, args...); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to insert archive entries")
+		}
+	}
+	for start := 0; start < len(classes); start += archiveEntryBatchSize {
+		end := min(start+archiveEntryBatchSize, len(classes))
+		stmt := databaseg.Builder.Insert("archive_classes").
+			Columns("archive_class_archive_id", "archive_class_name", "archive_class_simple_name")
+		for _, class := range classes[start:end] {
+			stmt = stmt.Values(archive.ID, class.Name, class.SimpleName)
+		}
+		query, args, err := stmt.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to convert query to sql: %w", err)
+		}
+		if _, err = db.ExecContext(ctx, query, args...); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to insert archive classes")
+		}
+	}
+	return nil
+}
+
+func (dao *archiveDao) CreateFrom(ctx context.Context, archive *types.Archive, sourceID int64) error {
+	if err := dao.create(ctx, archive); err != nil {
+		return err
+	}
+
+	const sqlQuery = `
+		INSERT INTO archive_entries (
+			archive_entry_archive_id
+			,archive_entry_path
+			,archive_entry_size
+		)
+		SELECT $1, archive_entry_path, archive_entry_size
+		FROM archive_entries
+		WHERE archive_entry_archive_id = $2`
+
+	const sqlQueryClasses = `
+		INSERT INTO archive_classes (
+			archive_class_archive_id
+			,archive_class_name
+			,archive_class_simple_name
+		)
+		SELECT $1, archive_class_name, archive_class_simple_name
+		FROM archive_classes
+		WHERE archive_class_archive_id = $2`
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	if _, err := db.ExecContext(ctx, sqlQuery, archive.ID, sourceID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to copy archive entries")
+	}
+	if _, err := db.ExecContext(ctx, sqlQueryClasses, archive.ID, sourceID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to copy archive classes")
+	}
+	return nil
+}
+
+func (dao *archiveDao) create(ctx context.Context, archive *types.Archive) error {
+	const sqlQuery = `
+		INSERT INTO archives (
+			archive_registry_id
+			,archive_node_id
+			,archive_layer_id
+			,archive_digest
+			,archive_image_name
+			,archive_version
+			,archive_name
+			,archive_format
+			,archive_entry_count
+			,archive_error
+			,archive_indexed_at
+		) VALUES (
+			:archive_registry_id
+			,:archive_node_id
+			,:archive_layer_id
+			,:archive_digest
+			,:archive_image_name
+			,:archive_version
+			,:archive_name
+			,:archive_format
+			,:archive_entry_count
+			,:archive_error
+			,:archive_indexed_at
+		)
+		RETURNING archive_id`
+
+	if archive.IndexedAt.IsZero() {
+		archive.IndexedAt = time.Now()
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalArchive(archive))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind archive object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&archive.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *archiveDao) FindByDigest(ctx context.Context, digest string) (*types.Archive, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(archiveDB{}), ",")).
+		From("archives").
+		Where("archive_digest = ? AND archive_error = ''", digest).
+		OrderBy("archive_id").
+		Limit(1)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	dst := new(archiveDB)
+	if err = db.GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find archive")
+	}
+	return mapToArchive(dst), nil
+}
+
+func (dao *archiveDao) ListPendingFiles(
+	ctx context.Context,
+	extensions []string,
+	limit int,
+) ([]*types.PendingArchive, error) {
+	stmt := pendingFiles(extensions).
+		Columns(
+			"n.node_registry_id AS registry_id", "n.node_id AS node_id", "n.node_path AS path",
+			"n.node_name AS name", "gb.generic_blob_sha_256 AS sha256", "gb.generic_blob_size AS size",
+		).
+		Where(notPaused("n.node_registry_id")).
+		OrderBy("n.node_created_at", "n.node_id").
+		Limit(uint64(limit)) //nolint:gosec
+	return dao.listPending(ctx, stmt)
+}
+
+func (dao *archiveDao) ListPendingLayers(ctx context.Context, limit int) ([]*types.PendingArchive, error) {
+	stmt := pendingLayers().
+		Columns(
+			"l.layer_registry_id AS registry_id", "l.layer_id AS layer_id", "m.manifest_image_name AS image_name",
+			"m.manifest_digest AS version", "b.blob_digest AS digest", "mt.mt_media_type AS media_type",
+			"l.layer_size AS size",
+		).
+		Where(notPaused("l.layer_registry_id")).
+		OrderBy("l.layer_id").
+		Limit(uint64(limit)) //nolint:gosec
+	return dao.listPending(ctx, stmt)
+}
+
+func (dao *archiveDao) listPending(ctx context.Context, stmt sq.SelectBuilder) ([]*types.PendingArchive, error) {
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*pendingArchiveDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list pending archives")
+	}
+
+	pending := make([]*types.PendingArchive, 0, len(dst))
+	for _, d := range dst {
+		p := &types.PendingArchive{
+			RegistryID: d.RegistryID,
+			Path:       d.Path,
+			Name:       d.Name,
+			ImageName:  d.ImageName,
+			MediaType:  d.MediaType,
+			Size:       d.Size,
+		}
+		if d.NodeID != nil {
+			p.NodeID = *d.NodeID
+			p.Digest = "sha256:" + strings.ToLower(d.Sha256)
+		}
+		if d.LayerID != nil {
+			p.LayerID = *d.LayerID
+			p.Digest = util.GetHexEncodedString(d.Digest)
+			p.Version = util.GetHexEncodedString(d.Version)
+			if p.Digest, err = parseDigest(p.Digest); err != nil {
+				return nil, err
+			}
+			if p.Version, err = parseDigest(p.Version); err != nil {
+				return nil, err
+			}
+			p.Name = p.Digest
+		}
+		pending = append(pending, p)
+	}
+	return pending, nil
+}
+
+func (dao *archiveDao) PendingStats(
+	ctx context.Context,
+	registryID int64,
+	extensions []string,
+) (*types.QueueStats, error) {
+	stats := &types.QueueStats{}
+	for _, stmt := range []sq.SelectBuilder{
+		pendingFiles(extensions).
+			Columns("COUNT(*) AS pending", "MIN(n.node_created_at) AS oldest").
+			Where("n.node_registry_id = ?", registryID),
+		pendingLayers().
+			Columns("COUNT(*) AS pending", "MIN(l.layer_created_at) AS oldest").
+			Where("l.layer_registry_id = ?", registryID),
+	} {
+		query, args, err := stmt.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+		}
+
+		db := dbtx.GetAccessor(ctx, dao.db)
+
+		var dst struct {
+			Pending int64  `db:"pending"`
+			Oldest  *int64 `db:"oldest"`
+		}
+		if err = db.GetContext(ctx, &dst, query, args...); err != nil {
+			return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count pending archives")
+		}
+		stats.Pending += dst.Pending
+		if dst.Oldest != nil && (stats.OldestPendingAt == 0 || *dst.Oldest < stats.OldestPendingAt) {
+			stats.OldestPendingAt = *dst.Oldest
+		}
+	}
+	return stats, nil
+}
+
+func (dao *archiveDao) Search(
+	ctx context.Context,
+	registryIDs []int64,
+	query string,
+	limit int,
+	offset int,
+) ([]*types.ArchiveEntryMatch, error) {
+	if len(registryIDs) == 0 {
+		return nil, nil
+	}
+	stmt := searchEntries(registryIDs, query).
+		Columns(
+			"a.archive_registry_id", "a.archive_image_name", "a.archive_version", "a.archive_name",
+			"e.archive_entry_path", "e.archive_entry_size",
+		).
+		OrderBy("a.archive_image_name", "a.archive_version", "a.archive_name", "e.archive_entry_path").
+		Limit(uint64(limit)).  //nolint:gosec
+		Offset(uint64(offset)) //nolint:gosec
+
+	sqlQuery, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*archiveEntryMatchDB
+	if err = db.SelectContext(ctx, &dst, sqlQuery, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to search archive entries")
+	}
+
+	matches := make([]*types.ArchiveEntryMatch, 0, len(dst))
+	for _, d := range dst {
+		matches = append(matches, &types.ArchiveEntryMatch{
+			RegistryID:  d.RegistryID,
+			ImageName:   d.ImageName,
+			Version:     d.Version,
+			ArchiveName: d.ArchiveName,
+			Path:        d.Path,
+			Size:        d.Size,
+		})
+	}
+	return matches, nil
+}
+
+func (dao *archiveDao) CountSearch(ctx context.Context, registryIDs []int64, query string) (int64, error) {
+	if len(registryIDs) == 0 {
+		return 0, nil
+	}
+	sqlQuery, args, err := searchEntries(registryIDs, query).Columns("COUNT(*)").ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var count int64
+	if err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&count); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count archive entries")
+	}
+	return count, nil
+}
+
+func (dao *archiveDao) SearchLayers(
+	ctx context.Context,
+	registryIDs []int64,
+	query string,
+	limit int,
+	offset int,
+) ([]*types.ArchiveEntryMatch, error) {
+	if len(registryIDs) == 0 {
+		return nil, nil
+	}
+	stmt := searchLayerEntries(registryIDs, query).
+		Columns(
+			"a.archive_registry_id", "a.archive_image_name", "a.archive_version", "a.archive_name",
+			"e.archive_entry_path", "e.archive_entry_size",
+		).
+		OrderBy("a.archive_image_name", "a.archive_version", "l.layer_id", "e.archive_entry_path").
+		Limit(uint64(limit)).  //nolint:gosec
+		Offset(uint64(offset)) //nolint:gosec
+
+	sqlQuery, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*archiveEntryMatchDB
+	if err = db.SelectContext(ctx, &dst, sqlQuery, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to search layer entries")
+	}
+
+	matches := make([]*types.ArchiveEntryMatch, 0, len(dst))
+	for _, d := range dst {
+		matches = append(matches, &types.ArchiveEntryMatch{
+			RegistryID:  d.RegistryID,
+			ImageName:   d.ImageName,
+			Version:     d.Version,
+			ArchiveName: d.ArchiveName,
+			Path:        d.Path,
+			Size:        d.Size,
+		})
+	}
+	return matches, nil
+}
+
+func (dao *archiveDao) CountSearchLayers(ctx context.Context, registryIDs []int64, query string) (int64, error) {
+	if len(registryIDs) == 0 {
+		return 0, nil
+	}
+	sqlQuery, args, err := searchLayerEntries(registryIDs, query).Columns("COUNT(*)").ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var count int64
+	if err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&count); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count layer entries")
+	}
+	return count, nil
+}
+
+func (dao *archiveDao) SearchClasses(
+	ctx context.Context,
+	registryIDs []int64,
+	className string,
+	limit int,
+	offset int,
+) ([]*types.ArchiveClassMatch, error) {
+	if len(registryIDs) == 0 {
+		return nil, nil
+	}
+	stmt := searchClasses(registryIDs, className).
+		Columns(
+			"a.archive_registry_id", "a.archive_image_name", "a.archive_version", "a.archive_name",
+			"c.archive_class_name",
+		).
+		OrderBy("c.archive_class_name", "a.archive_image_name", "a.archive_version", "a.archive_name").
+		Limit(uint64(limit)).  //nolint:gosec
+		Offset(uint64(offset)) //nolint:gosec
+
+	sqlQuery, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*archiveClassMatchDB
+	if err = db.SelectContext(ctx, &dst, sqlQuery, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to search archive classes")
+	}
+
+	matches := make([]*types.ArchiveClassMatch, 0, len(dst))
+	for _, d := range dst {
+		matches = append(matches, &types.ArchiveClassMatch{
+			RegistryID:  d.RegistryID,
+			ImageName:   d.ImageName,
+			Version:     d.Version,
+			ArchiveName: d.ArchiveName,
+			ClassName:   d.ClassName,
+		})
+	}
+	return matches, nil
+}
+
+func (dao *archiveDao) CountSearchClasses(ctx context.Context, registryIDs []int64, className string) (int64, error) {
+	if len(registryIDs) == 0 {
+		return 0, nil
+	}
+	sqlQuery, args, err := searchClasses(registryIDs, className).Columns("COUNT(*)").ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var count int64
+	if err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&count); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count archive classes")
+	}
+	return count, nil
+}
+
+// pendingFiles selects the file nodes with one of the extensions that have no archive.
+func pendingFiles(extensions []string) sq.SelectBuilder {
+	names := sq.Or{}
+	for _, ext := range extensions {
+		names = append(names, sq.Like{"LOWER(n.node_name)": "%" + strings.ToLower(ext)})
+	}
+	return databaseg.Builder.Select().
+		From("nodes n").
+		Join("generic_blobs gb ON gb.generic_blob_id = n.node_generic_blob_id").
+		LeftJoin("archives a ON a.archive_node_id = n.node_id").
+		Where("n.node_is_file = ? AND a.archive_id IS NULL", true).
+		Where(names)
+}
+
+// pendingLayers selects the file system layers of images that have no archive.
+func pendingLayers() sq.SelectBuilder {
+	return databaseg.Builder.Select().
+		From("layers l").
+		Join("manifests m ON m.manifest_id = l.layer_manifest_id").
+		Join("blobs b ON b.blob_id = l.layer_blob_id").
+		Join("media_types mt ON mt.mt_id = l.layer_media_type_id").
+		LeftJoin("archives a ON a.archive_layer_id = l.layer_id").
+		Where("a.archive_id IS NULL").
+		Where(sq.Like{"mt.mt_media_type": "%.tar%"})
+}
+
+func notPaused(registryColumn string) sq.Sqlizer {
+	return sq.Expr("NOT EXISTS (SELECT 1 FROM registry_paused_queues pq WHERE pq.rpq_registry_id = "+
+		registryColumn+" AND pq.rpq_queue = ?)", string(types.QueueContentIndex))
+}
+
+func searchEntries(registryIDs []int64, query string) sq.SelectBuilder {
+	return databaseg.Builder.Select().
+		From("archive_entries e").
+		Join("archives a ON a.archive_id = e.archive_entry_archive_id").
+		Where(sq.Eq{"a.archive_registry_id": registryIDs}).
+		Where(sq.Like{"LOWER(e.archive_entry_path)": "%" + strings.ToLower(query) + "%"})
+}
+
+// searchLayerEntries matches the files of image layers. Absolute paths must match the full path of
+// the file, which mustn't be deleted by a whiteout in an upper layer of the same image; layers are
+// stored in the order of the manifest. Other queries match the file name or a trailing part of the path.
+func searchLayerEntries(registryIDs []int64, query string) sq.SelectBuilder {
+	stmt := databaseg.Builder.Select().
+		From("archive_entries e").
+		Join("archives a ON a.archive_id = e.archive_entry_archive_id").
+		Join("layers l ON l.layer_id = a.archive_layer_id").
+		Where(sq.Eq{"a.archive_registry_id": registryIDs})
+	if !strings.HasPrefix(query, "/") {
+		return stmt.Where(sq.Or{
+			sq.Eq{"e.archive_entry_path": query},
+			sq.Like{"e.archive_entry_path": "%/" + query},
+		})
+	}
+	p := archive.LayerPath(query)
+	whiteouts := archive.Whiteouts(p)
+	args := make([]any, 0, len(whiteouts))
+	for _, w := range whiteouts {
+		args = append(args, w)
+	}
+	return stmt.
+		Where(sq.Eq{"e.archive_entry_path": p}).
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM archive_entries we"+
+			" JOIN archives wa ON wa.archive_id = we.archive_entry_archive_id"+
+			" JOIN layers wl ON wl.layer_id = wa.archive_layer_id"+
+			" WHERE wl.layer_manifest_id = l.layer_manifest_id AND wl.layer_id > l.layer_id"+
+			" AND we.archive_entry_path IN ("+sq.Placeholders(len(whiteouts))+"))", args...))
+}
+
+// searchClasses matches the classes by their indexed simple name. Qualified names, like
+// "core.Logger" or "Outer$Inner", must match the full name or one of its package suffixes.
+func searchClasses(registryIDs []int64, className string) sq.SelectBuilder {
+	stmt := databaseg.Builder.Select().
+		From("archive_classes c").
+		Join("archives a ON a.archive_id = c.archive_class_archive_id").
+		Where(sq.Eq{"a.archive_registry_id": registryIDs}).
+		Where("c.archive_class_simple_name = ?", archive.SimpleClassName(className))
+	if strings.ContainsAny(className, ".$") {
+		name := strings.ToLower(className)
+		stmt = stmt.Where(sq.Or{
+			sq.Eq{"LOWER(c.archive_class_name)": name},
+			sq.Like{"LOWER(c.archive_class_name)": "%." + name},
+		})
+	}
+	return stmt
+}
+
+func parseDigest(hexDigest string) (string, error) {
+	d, err := types.Digest(hexDigest).Parse()
+	if err != nil {
+		return "", fmt.Errorf("invalid digest: %w", err)
+	}
+	return d.String(), nil
+}
+
+func mapToInternalArchive(in *types.Archive) *archiveDB {
+	out := &archiveDB{
+		ID:         in.ID,
+		RegistryID: in.RegistryID,
+		Digest:     in.Digest,
+		ImageName:  in.ImageName,
+		Version:    in.Version,
+		Name:       in.Name,
+		Format:     in.Format,
+		EntryCount: in.EntryCount,
+		Error:      in.Error,
+		IndexedAt:  in.IndexedAt.UnixMilli(),
+	}
+	if in.NodeID != "" {
+		out.NodeID = &in.NodeID
+	}
+	if in.LayerID != 0 {
+		out.LayerID = &in.LayerID
+	}
+	return out
+}
+
+func mapToArchive(in *archiveDB) *types.Archive {
+	out := &types.Archive{
+		ID:         in.ID,
+		RegistryID: in.RegistryID,
+		Digest:     in.Digest,
+		ImageName:  in.ImageName,
+		Version:    in.Version,
+		Name:       in.Name,
+		Format:     in.Format,
+		EntryCount: in.EntryCount,
+		Error:      in.Error,
+		IndexedAt:  time.UnixMilli(in.IndexedAt),
+	}
+	if in.NodeID != nil {
+		out.NodeID = *in.NodeID
+	}
+	if in.LayerID != nil {
+		out.LayerID = *in.LayerID
+	}
+	return out
+}
@@ -19,12 +19,32 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
 	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/metadata/alpine"
+	"github.com/harness/gitness/registry/app/metadata/cargo"
+	"github.com/harness/gitness/registry/app/metadata/cocoapods"
+	"github.com/harness/gitness/registry/app/metadata/composer"
+	"github.com/harness/gitness/registry/app/metadata/conan"
+	"github.com/harness/gitness/registry/app/metadata/conda"
+	"github.com/harness/gitness/registry/app/metadata/cran"
+	"github.com/harness/gitness/registry/app/metadata/debian"
+	"github.com/harness/gitness/registry/app/metadata/galaxy"
+	"github.com/harness/gitness/registry/app/metadata/gems"
+	"github.com/harness/gitness/registry/app/metadata/gomodule"
+	"github.com/harness/gitness/registry/app/metadata/hex"
+	"github.com/harness/gitness/registry/app/metadata/homebrew"
+	"github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/metadata/nuget"
+	"github.com/harness/gitness/registry/app/metadata/pub"
+	"github.com/harness/gitness/registry/app/metadata/puppet"
 	"github.com/harness/gitness/registry/app/metadata/pypi"
+	"github.com/harness/gitness/registry/app/metadata/rpm"
+	"github.com/harness/gitness/registry/app/metadata/swift"
+	"github.com/harness/gitness/registry/app/metadata/terraform"
+	"github.com/harness/gitness/registry/app/metadata/vagrant"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
@@ -48,14 +68,17 @@ func NewArtifactDao(db *sqlx.DB) store.ArtifactRepository {
 }
 
 type artifactDB struct {
-	ID        int64            `db:"artifact_id"`
-	Version   string           `db:"artifact_version"`
-	ImageID   int64            `db:"artifact_image_id"`
-	Metadata  *json.RawMessage `db:"artifact_metadata"`
-	CreatedAt int64            `db:"artifact_created_at"`
-	UpdatedAt int64            `db:"artifact_updated_at"`
-	CreatedBy int64            `db:"artifact_created_by"`
-	UpdatedBy int64            `db:"artifact_updated_by"`
+	ID       int64            `db:"artifact_id"`
+	Version  string           `db:"artifact_version"`
+	ImageID  int64            `db:"artifact_image_id"`
+	Metadata *json.RawMessage `db:"artifact_metadata"`
+	// FileCount is the file_count of the metadata, it's NULL for the artifacts the artifact_file_count
+	// backfill hasn't filled in yet.
+	FileCount *int64 `db:"artifact_file_count"`
+	CreatedAt int64  `db:"artifact_created_at"`
+	UpdatedAt int64  `db:"artifact_updated_at"`
+	CreatedBy int64  `db:"artifact_created_by"`
+	UpdatedBy int64  `db:"artifact_updated_by"`
 }
 
 func (a ArtifactDao) GetByName(ctx context.Context, imageID int64, version string) (*types.Artifact, error) {
@@ -118,6 +141,7 @@ func (a ArtifactDao) CreateOrUpdate(ctx context.Context, artifact *types.Artifac
 				,artifact_version
 				,artifact_created_at
 				,artifact_metadata
+				,artifact_file_count
 				,artifact_updated_at
 				,artifact_created_by
 				,artifact_updated_by
@@ -126,12 +150,13 @@ func (a ArtifactDao) CreateOrUpdate(ctx context.Context, artifact *types.Artifac
 						,:artifact_version
 						,:artifact_created_at
 						,:artifact_metadata
+						,:artifact_file_count
 						,:artifact_updated_at
 						,:artifact_created_by
 						,:artifact_updated_by
 		    ) 
             ON CONFLICT (artifact_image_id, artifact_version)
-		    DO UPDATE SET artifact_metadata = :artifact_metadata
+		    DO UPDATE SET artifact_metadata = :artifact_metadata, artifact_file_count = :artifact_file_count
             RETURNING artifact_id`
 
 	db := dbtx.GetAccessor(ctx, a.db)
@@ -146,6 +171,81 @@ func (a ArtifactDao) CreateOrUpdate(ctx context.Context, artifact *types.Artifac
 	return nil
 }
 
+func (a ArtifactDao) Create(ctx context.Context, artifact *types.Artifact) error {
+	const sqlQuery = `
+		INSERT INTO artifacts ( 
+		         artifact_image_id
+				,artifact_version
+				,artifact_created_at
+				,artifact_metadata
+				,artifact_file_count
+				,artifact_updated_at
+				,artifact_created_by
+				,artifact_updated_by
+		    ) VALUES (
+						 :artifact_image_id
+						,:artifact_version
+						,:artifact_created_at
+						,:artifact_metadata
+						,:artifact_file_count
+						,:artifact_updated_at
+						,:artifact_created_by
+						,:artifact_updated_by
+		    ) 
+            RETURNING artifact_id`
+
+	db := dbtx.GetAccessor(ctx, a.db)
+	query, arg, err := db.BindNamed(sqlQuery, a.mapToInternalArtifact(ctx, artifact))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind artifact object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&artifact.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (a ArtifactDao) DeleteByID(ctx context.Context, id int64) error {
+	stmt := databaseg.Builder.Delete("artifacts").
+		Where("artifact_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, a.db)
+
+	_, err = db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	return nil
+}
+
+func (a ArtifactDao) DeleteEmptyByID(ctx context.Context, id int64) (bool, error) {
+	stmt := databaseg.Builder.Delete("artifacts").
+		Where("artifact_id = ? AND artifact_file_count = 0", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, a.db)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	return count > 0, nil
+}
+
 func (a ArtifactDao) Count(ctx context.Context) (int64, error) {
 	stmt := databaseg.Builder.Select("COUNT(*)").
 		From("artifacts")
@@ -182,11 +282,13 @@ func (a ArtifactDao) mapToInternalArtifact(ctx context.Context, in *types.Artifa
 	in.UpdatedAt = time.Now()
 	in.UpdatedBy = session.Principal.ID
 
+	fileCount := metadataFileCount(metadata)
 	return &artifactDB{
 		ID:        in.ID,
 		Version:   in.Version,
 		ImageID:   in.ImageID,
 		Metadata:  &metadata,
+		FileCount: &fileCount,
 		CreatedAt: in.CreatedAt.UnixMilli(),
 		UpdatedAt: in.UpdatedAt.UnixMilli(),
 		CreatedBy: in.CreatedBy,
@@ -194,6 +296,18 @@ func (a ArtifactDao) mapToInternalArtifact(ctx context.Context, in *types.Artifa
 	}
 }
 
+// metadataFileCount returns the file_count of the metadata of an artifact, which is zero for the
+// package types that don't record one.
+func metadataFileCount(metadata json.RawMessage) int64 {
+	var counted struct {
+		FileCount int64 `json:"file_count"`
+	}
+	if err := json.Unmarshal(metadata, &counted); err != nil {
+		return 0
+	}
+	return counted.FileCount
+}
+
 func (a ArtifactDao) mapToArtifact(_ context.Context, dst *artifactDB) (*types.Artifact, error) {
 	createdBy := dst.CreatedBy
 	updatedBy := dst.UpdatedBy
@@ -225,6 +339,50 @@ func (a ArtifactDao) GetAllArtifactsByParentID(
 	latestVersion bool,
 	packageTypes []string,
 ) (*[]types.ArtifactMetadata, error) {
+	q := allArtifactsByParentIDQuery(parentID, registryIDs, sortByField, sortByOrder, search, latestVersion,
+		packageTypes).
+		Limit(util.SafeIntToUInt64(limit)).Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, a.db)
+
+	dst := []*artifactMetadataDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing custom list query")
+	}
+	return a.mapToArtifactMetadataList(ctx, dst)
+}
+
+// StreamAllArtifactsByParentID streams the artifacts of GetAllArtifactsByParentID without paging,
+// so callers can traverse all artifacts of a space without loading them in memory.
+// The error channel receives at most one error, after which both channels are closed.
+func (a ArtifactDao) StreamAllArtifactsByParentID(
+	ctx context.Context,
+	parentID int64,
+	registryIDs *[]string,
+	sortByField string,
+	sortByOrder string,
+	search string,
+	latestVersion bool,
+	packageTypes []string,
+) (<-chan *types.ArtifactMetadata, <-chan error) {
+	return a.streamArtifactMetadata(ctx, allArtifactsByParentIDQuery(parentID, registryIDs, sortByField,
+		sortByOrder, search, latestVersion, packageTypes))
+}
+
+func allArtifactsByParentIDQuery(
+	parentID int64,
+	registryIDs *[]string,
+	sortByField string,
+	sortByOrder string,
+	search string,
+	latestVersion bool,
+	packageTypes []string,
+) sq.SelectBuilder {
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, 
 		i.image_name as name, 
@@ -238,93 +396,36 @@ func (a ArtifactDao) GetAllArtifactsByParentID(
 		Join("images i ON a.artifact_image_id = i.image_id").
 		Join("registries r ON r.registry_id = i.image_registry_id").
 		Where("r.registry_parent_id = ?", parentID).
-		LeftJoin(
-			`( SELECT i.image_name, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id 
-			GROUP BY a.artifact_image_id ) as t1 
-			JOIN images i ON i.image_id = t1.artifact_image_id 
-			JOIN registries r ON r.registry_id = i.image_registry_id 
-			WHERE r.registry_parent_id = ? GROUP BY i.image_name) as t2 
-			ON i.image_name = t2.image_name`, parentID,
-		)
+		JoinClause(sq.Expr("LEFT JOIN (?) AS t2 ON i.image_name = t2.image_name",
+			imageDownloads(inSpace(parentID), artifactFilter{search: search})))
 
+	f := artifactFilter{registryNames: *registryIDs, packageTypes: packageTypes, search: search}
 	if latestVersion {
-		q = q.Join(
-			`(SELECT t.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY t.artifact_image_id
-			ORDER BY t.artifact_updated_at DESC) AS rank FROM artifacts t 
-			JOIN images i ON t.artifact_image_id = i.image_id
-            JOIN registries r ON i.image_registry_id = r.registry_id
-			WHERE r.registry_parent_id = ? ) AS a1 
-			ON a.artifact_id = a1.id`, parentID, // nolint:goconst
-		).
+		q = q.JoinClause(sq.Expr("JOIN (?) AS a1 ON a.artifact_id = a1.id", latestArtifacts(inSpace(parentID), f))).
 			Where("a1.rank = 1")
 	}
-
-	if len(*registryIDs) > 0 {
-		q = q.Where(sq.Eq{"r.registry_name": registryIDs})
-	}
-
-	if len(packageTypes) > 0 {
-		q = q.Where(sq.Eq{"r.registry_package_type": packageTypes})
-	}
-
-	if search != "" {
-		q = q.Where("image_name LIKE ?", sqlPartialMatch(search))
-	}
-	sortField := "i." + sortByField
+	q = f.apply(q, "i.image_name", "i.image_labels")
+	sortField := "modified_at"
 	if sortByField == downloadCount {
 		sortField = downloadCount
+	} else if sortByField == imageName {
+		sortField = name
 	}
-	q = q.OrderBy(sortField + " " + sortByOrder).Limit(util.SafeIntToUInt64(limit)).Offset(util.SafeIntToUInt64(offset))
-
-	sql, args, err := q.ToSql()
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to convert query to sql")
-	}
-
-	db := dbtx.GetAccessor(ctx, a.db)
-
-	dst := []*artifactMetadataDB{}
-	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
-		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing custom list query")
-	}
-	return a.mapToArtifactMetadataList(ctx, dst)
+	return q.OrderBy(sortField + " " + sortByOrder)
 }
 
 func (a ArtifactDao) CountAllArtifactsByParentID(
 	ctx context.Context, parentID int64,
 	registryIDs *[]string, search string, latestVersion bool, packageTypes []string,
 ) (int64, error) {
-	// nolint:goconst
-	q := databaseg.Builder.Select("COUNT(*)").
-		From("artifacts a").
-		Join("images i ON i.image_id = a.artifact_image_id").
-		Join("registries r ON i.image_registry_id = r.registry_id"). // nolint:goconst
-		Where("r.registry_parent_id = ?", parentID)
-
+	f := artifactFilter{registryNames: *registryIDs, packageTypes: packageTypes, search: search}
+	q := f.apply(imagesOf(inSpace(parentID), "COUNT(*)").Join("artifacts a ON a.artifact_image_id = i.image_id"),
+		"i.image_name", "i.image_labels")
 	if latestVersion {
-		q = q.Join(
-			`(SELECT t.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY t.artifact_image_id
-			ORDER BY t.artifact_updated_at DESC) AS rank FROM artifacts t 
-			JOIN images i ON t.artifact_image_id = i.image_id
-            JOIN registries r ON i.image_registry_id = r.registry_id
-			WHERE r.registry_parent_id = ? ) AS a1 
-			ON a.artifact_id = a1.id`, parentID, // nolint:goconst
-		).
+		q = databaseg.Builder.Select("COUNT(*)").
+			FromSelect(latestArtifacts(inSpace(parentID), f), "a1").
 			Where("a1.rank = 1")
 	}
-	if len(*registryIDs) > 0 {
-		q = q.Where(sq.Eq{"r.registry_name": registryIDs})
-	}
-
-	if search != "" {
-		q = q.Where("image_name LIKE ?", sqlPartialMatch(search))
-	}
-
-	if len(packageTypes) > 0 {
-		q = q.Where(sq.Eq{"registry_package_type": packageTypes})
-	}
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -341,10 +442,44 @@ func (a ArtifactDao) CountAllArtifactsByParentID(
 }
 
 func (a ArtifactDao) GetAllArtifactsByRepo(
-	ctx context.Context, parentID int64, repoKey string,
+	ctx context.Context, registryIDs []int64,
 	sortByField string, sortByOrder string, limit int, offset int, search string,
 	labels []string,
 ) (*[]types.ArtifactMetadata, error) {
+	q := allArtifactsByRepoQuery(registryIDs, sortByField, sortByOrder, search, labels).
+		Limit(util.SafeIntToUInt64(limit)).Offset(util.SafeIntToUInt64(offset))
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, a.db)
+
+	dst := []*artifactMetadataDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing custom list query")
+	}
+	return a.mapToArtifactMetadataList(ctx, dst)
+}
+
+// StreamAllArtifactsByRepo streams the artifacts of GetAllArtifactsByRepo without paging.
+// The error channel receives at most one error, after which both channels are closed.
+func (a ArtifactDao) StreamAllArtifactsByRepo(
+	ctx context.Context, registryIDs []int64,
+	sortByField string, sortByOrder string, search string,
+	labels []string,
+) (<-chan *types.ArtifactMetadata, <-chan error) {
+	return a.streamArtifactMetadata(ctx, allArtifactsByRepoQuery(registryIDs, sortByField, sortByOrder,
+		search, labels))
+}
+
+func allArtifactsByRepoQuery(
+	registryIDs []int64,
+	sortByField string, sortByOrder string, search string,
+	labels []string,
+) sq.SelectBuilder {
+	scope := inRegistries(registryIDs)
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, i.image_name as name, 
 		r.registry_package_type as package_type, a.artifact_version as latest_version, 
@@ -352,91 +487,92 @@ func (a ArtifactDao) GetAllArtifactsByRepo(
 		COALESCE(t2.download_count, 0) as download_count `,
 	).
 		From("artifacts a").
-		Join(
-			`(SELECT a.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY a.artifact_image_id
-			ORDER BY a.artifact_updated_at DESC) AS rank FROM artifacts a 
-            JOIN images i ON i.image_id = a.artifact_image_id  
-			JOIN registries r ON i.image_registry_id = r.registry_id  
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? ) AS a1 
-			ON a.artifact_id = a1.id`, parentID, repoKey, // nolint:goconst
-		).
+		JoinClause(sq.Expr("JOIN (?) AS a1 ON a.artifact_id = a1.id", latestArtifacts(scope, artifactFilter{
+			search: search, labels: labels,
+		}))).
 		Join("images i ON i.image_id = a.artifact_image_id").
 		Join("registries r ON i.image_registry_id = r.registry_id").
-		LeftJoin(
-			`( SELECT i.image_name, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a 
-			JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id GROUP BY 
-			a.artifact_image_id ) as t1 
-			JOIN images i ON i.image_id = t1.artifact_image_id 
-			JOIN registries r ON r.registry_id = i.image_registry_id 
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? GROUP BY i.image_name) as t2 
-			ON i.image_name = t2.image_name`, parentID, repoKey,
-		).
-		Where("a1.rank = 1 ")
-
-	if search != "" {
-		q = q.Where("i.image_name LIKE ?", sqlPartialMatch(search))
-	}
-
-	if len(labels) > 0 {
-		sort.Strings(labels)
-		labelsVal := util.GetEmptySQLString(util.ArrToString(labels))
-		labelsVal.String = labelSeparatorStart + labelsVal.String + labelSeparatorEnd
-		q = q.Where("'^_' || i.image_labels || '^_' LIKE ?", labelsVal)
-	}
+		JoinClause(sq.Expr("LEFT JOIN (?) AS t2 ON i.image_name = t2.image_name",
+			imageDownloads(scope, artifactFilter{search: search}))).
+		Where("a1.rank = 1")
 
 	// nolint:goconst
-	sortField := "image_" + sortByField
+	sortField := "i.image_" + sortByField
 	if sortByField == downloadCount {
 		sortField = downloadCount
 	} else if sortByField == imageName {
 		sortField = name
 	}
-	q = q.OrderBy(sortField + " " + sortByOrder).Limit(util.SafeIntToUInt64(limit)).Offset(util.SafeIntToUInt64(offset))
+	return q.OrderBy(sortField + " " + sortByOrder)
+}
 
-	sql, args, err := q.ToSql()
-	if err != nil {
-		return nil, errors.Wrap(err, "Failed to convert query to sql")
-	}
+// streamArtifactMetadata runs the query and sends the mapped rows one at a time, reading the next
+// row only once the previous one was received. Streaming stops when the context is canceled.
+func (a ArtifactDao) streamArtifactMetadata(
+	ctx context.Context,
+	q sq.SelectBuilder,
+) (<-chan *types.ArtifactMetadata, <-chan error) {
+	chArtifacts := make(chan *types.ArtifactMetadata)
+	chErr := make(chan error, 1)
 
-	db := dbtx.GetAccessor(ctx, a.db)
+	go func() {
+		defer close(chArtifacts)
+		defer close(chErr)
 
-	dst := []*artifactMetadataDB{}
-	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
-		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing custom list query")
-	}
-	return a.mapToArtifactMetadataList(ctx, dst)
+		sql, args, err := q.ToSql()
+		if err != nil {
+			chErr <- errors.Wrap(err, "Failed to convert query to sql")
+			return
+		}
+
+		db := dbtx.GetAccessor(ctx, a.db)
+
+		rows, err := db.QueryxContext(ctx, sql, args...)
+		if err != nil {
+			chErr <- databaseg.ProcessSQLErrorf(ctx, err, "Failed to execute stream query")
+			return
+		}
+
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var dst artifactMetadataDB
+			if err = rows.StructScan(&dst); err != nil {
+				chErr <- fmt.Errorf("failed to scan artifact: %w", err)
+				return
+			}
+			artifact, err := a.mapToArtifactMetadata(ctx, &dst)
+			if err != nil {
+				chErr <- err
+				return
+			}
+
+			select {
+			case chArtifacts <- artifact:
+			case <-ctx.Done():
+				chErr <- ctx.Err()
+				return
+			}
+		}
+
+		if err := rows.Err(); err != nil {
+			chErr <- fmt.Errorf("failed to scan artifact: %w", err)
+		}
+	}()
+
+	return chArtifacts, chErr
 }
 
 // nolint:goconst
 func (a ArtifactDao) CountAllArtifactsByRepo(
-	ctx context.Context, parentID int64, repoKey string,
+	ctx context.Context, registryIDs []int64,
 	search string, labels []string,
 ) (int64, error) {
 	q := databaseg.Builder.Select("COUNT(*)").
-		From("artifacts a").
-		Join(
-			`(SELECT a.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY a.artifact_image_id 
-			ORDER BY a.artifact_updated_at DESC) AS rank FROM artifacts a 
-			JOIN registries r ON t.tag_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? ) AS a1 ON a.artifact_id = a1.id`, parentID, repoKey,
-		).
-		Join(
-			"images i ON i.image_id = a.artifact_image_id AND").
-		Join("registries r ON i.image_registry_id = r.registry_id").
-		Where("a1.rank = 1 ")
-
-	if search != "" {
-		q = q.Where("i.image_name LIKE ?", sqlPartialMatch(search))
-	}
-
-	if len(labels) > 0 {
-		sort.Strings(labels)
-		labelsVal := util.GetEmptySQLString(util.ArrToString(labels))
-		labelsVal.String = labelSeparatorStart + labelsVal.String + labelSeparatorEnd
-		q = q.Where("'^_' || i.image_labels || '^_' LIKE ?", labelsVal)
-	}
+		FromSelect(latestArtifacts(inRegistries(registryIDs), artifactFilter{
+			search: search, labels: labels,
+		}), "a1").
+		Where("a1.rank = 1")
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -553,6 +689,34 @@ func (a ArtifactDao) mapToArtifactMetadataList(
 	return &artifacts, nil
 }
 
+func (a ArtifactDao) GetVersionsByImageNames(
+	ctx context.Context, registryID int64,
+	imageNames []string,
+) (map[string][]string, error) {
+	if len(imageNames) == 0 {
+		return map[string][]string{}, nil
+	}
+	q := databaseg.Builder.Select("i.image_name AS name, a.artifact_version AS latest_version").
+		From("artifacts a").
+		Join("images i ON i.image_id = a.artifact_image_id").
+		Where("i.image_registry_id = ?", registryID).
+		Where(sq.Eq{"i.image_name": imageNames}).
+		OrderBy("a.artifact_updated_at DESC")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, a.db)
+
+	dst := []*artifactMetadataDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find artifact versions")
+	}
+	return mapToVersionsByImageName(dst), nil
+}
+
 func (a ArtifactDao) GetAllVersionsByRepoAndImage(
 	ctx context.Context, parentID int64, repoKey string,
 	image string, sortByField string, sortByOrder string, limit int, offset int,
@@ -563,6 +727,7 @@ func (a ArtifactDao) GetAllVersionsByRepoAndImage(
     SELECT 
         a.artifact_image_id, 
         COUNT(d.download_stat_id) AS download_count, 
+        SUM(CASE WHEN d.download_stat_unique THEN 1 ELSE 0 END) AS unique_download_count, 
         i.image_name, 
         i.image_registry_id
     FROM artifacts a
@@ -571,25 +736,28 @@ func (a ArtifactDao) GetAllVersionsByRepoAndImage(
     GROUP BY a.artifact_image_id, i.image_name, i.image_registry_id
 `
 
-	// Build the main query
+	// Build the main query, the file count is read from the metadata of the artifacts the
+	// artifact_file_count backfill hasn't filled in yet
 	q := databaseg.Builder.
 		Select(`
         a.artifact_version AS name, 
         a.artifact_metadata ->> 'size' AS size, 
-        a.artifact_metadata ->> 'file_count' AS file_count, 
+        COALESCE(a.artifact_file_count, CAST(a.artifact_metadata ->> 'file_count' AS BIGINT)) AS file_count, 
         r.registry_package_type AS package_type, 
         a.artifact_updated_at AS modified_at,
-        COALESCE(dc.download_count, 0) AS download_count
+        COALESCE(dc.download_count, 0) AS download_count,
+        COALESCE(dc.unique_download_count, 0) AS unique_download_count
     `)
 
 	if a.db.DriverName() == SQLITE3 {
 		q = databaseg.Builder.Select(`
         a.artifact_version AS name, 
         json_extract(a.artifact_metadata, '$.size') AS size,
-        json_extract(a.artifact_metadata, '$.file_count') AS file_count,
+        COALESCE(a.artifact_file_count, json_extract(a.artifact_metadata, '$.file_count')) AS file_count,
         r.registry_package_type AS package_type, 
         a.artifact_updated_at AS modified_at,
-        COALESCE(dc.download_count, 0) AS download_count
+        COALESCE(dc.download_count, 0) AS download_count,
+        COALESCE(dc.unique_download_count, 0) AS unique_download_count
     `)
 	}
 
@@ -630,6 +798,50 @@ func (a ArtifactDao) GetAllVersionsByRepoAndImage(
 	return a.mapToNonOCIMetadataList(dst)
 }
 
+func (a ArtifactDao) BackfillFileCounts(ctx context.Context, cursor int64, limit int) (int64, int, error) {
+	batch := databaseg.Builder.Select("artifact_id").
+		From("artifacts").
+		Where("artifact_id > ?", cursor).
+		OrderBy("artifact_id").
+		Limit(util.SafeIntToUInt64(limit))
+	stmt := databaseg.Builder.Select("COALESCE(MAX(artifact_id), 0)", "COUNT(*)").
+		FromSelect(batch, "b")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, a.db)
+
+	var last int64
+	var count int
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&last, &count); err != nil {
+		return 0, 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find the artifacts to backfill")
+	}
+	if count == 0 {
+		return cursor, 0, nil
+	}
+
+	fileCount := "CAST(artifact_metadata ->> 'file_count' AS BIGINT)"
+	if a.db.DriverName() == SQLITE3 {
+		fileCount = "json_extract(artifact_metadata, '$.file_count')"
+	}
+	// the batch is updated by a range of the primary key, which only locks the rows of the batch
+	update := databaseg.Builder.Update("artifacts").
+		Set("artifact_file_count", sq.Expr("COALESCE("+fileCount+", 0)")).
+		Where("artifact_id > ? AND artifact_id <= ? AND artifact_file_count IS NULL", cursor, last)
+
+	query, args, err = update.ToSql()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "Failed to convert query to sql")
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return 0, 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to backfill the artifact file counts")
+	}
+	return last, count, nil
+}
+
 func (a ArtifactDao) CountAllVersionsByRepoAndImage(
 	ctx context.Context, parentID int64,
 	repoKey string, image string, search string,
@@ -726,12 +938,13 @@ func (a ArtifactDao) mapToNonOCIMetadata(
 		fileCount = *dst.FileCount
 	}
 	return &types.NonOCIArtifactMetadata{
-		Name:          dst.Name,
-		DownloadCount: dst.DownloadCount,
-		PackageType:   dst.PackageType,
-		Size:          size,
-		FileCount:     fileCount,
-		ModifiedAt:    time.UnixMilli(dst.ModifiedAt),
+		Name:                dst.Name,
+		DownloadCount:       dst.DownloadCount,
+		UniqueDownloadCount: dst.UniqueDownloadCount,
+		PackageType:         dst.PackageType,
+		Size:                size,
+		FileCount:           fileCount,
+		ModifiedAt:          time.UnixMilli(dst.ModifiedAt),
 	}
 }
 
@@ -747,12 +960,13 @@ func (a ArtifactDao) mapToNonOCIMetadataList(
 }
 
 type nonOCIArtifactMetadataDB struct {
-	Name          string               `db:"name"`
-	Size          *string              `db:"size"`
-	PackageType   artifact.PackageType `db:"package_type"`
-	FileCount     *int64               `db:"file_count"`
-	ModifiedAt    int64                `db:"modified_at"`
-	DownloadCount int64                `db:"download_count"`
+	Name                string               `db:"name"`
+	Size                *string              `db:"size"`
+	PackageType         artifact.PackageType `db:"package_type"`
+	FileCount           *int64               `db:"file_count"`
+	ModifiedAt          int64                `db:"modified_at"`
+	DownloadCount       int64                `db:"download_count"`
+	UniqueDownloadCount int64                `db:"unique_download_count"`
 }
 
 type GenericMetadata struct {
@@ -767,10 +981,299 @@ type MavenMetadata struct {
 }
 
 type PyPiMetadata struct {
-	Files []File `json:"files"`
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Distributions describes the wheels and source distributions uploaded for the version.
+	Distributions []PyPiDistribution `json:"distributions,omitempty"`
 	pypi.Metadata
 }
 
+// PyPiDistribution holds the metadata twine sends along with each uploaded file.
+type PyPiDistribution struct {
+	Filename       string `json:"file_name"`
+	FileType       string `json:"file_type"`
+	PyVersion      string `json:"py_version,omitempty"`
+	RequiresPython string `json:"requires_python,omitempty"`
+	Sha256         string `json:"sha256"`
+	// Cache is set on files proxied from an upstream registry, nil for uploaded ones.
+	Cache *UpstreamCache `json:"cache,omitempty"`
+}
+
+// Distribution returns the distribution metadata of the file, if recorded.
+func (m *PyPiMetadata) Distribution(filename string) (PyPiDistribution, bool) {
+	for _, d := range m.Distributions {
+		if d.Filename == filename {
+			return d, true
+		}
+	}
+	return PyPiDistribution{}, false
+}
+
+type NpmMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// DistTags are the dist-tags the version was published with, like "latest" or "beta".
+	DistTags []string `json:"dist_tags,omitempty"`
+	// Shasum and Integrity are the SHA-1 and the subresource integrity of the tarball.
+	Shasum    string `json:"shasum"`
+	Integrity string `json:"integrity"`
+	// Cache is set on versions proxied from an upstream registry, nil for published ones.
+	Cache *UpstreamCache `json:"cache,omitempty"`
+	npm.PackageMetadata
+}
+
+// UpstreamCache records where and when a file proxied from an upstream registry was cached.
+type UpstreamCache struct {
+	// Upstream is the identifier of the upstream proxy registry the file was cached from.
+	Upstream  string `json:"upstream"`
+	SourceURL string `json:"source_url"`
+	// CachedAt is the time the file was cached, in unix milliseconds.
+	CachedAt int64 `json:"cached_at"`
+}
+
+type NugetMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	nuget.Metadata
+}
+
+type GoModuleMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	gomodule.Metadata
+}
+
+type DebMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Debs are the published .debs of the version, one per architecture, distribution and component.
+	Debs []DebFile `json:"debs"`
+}
+
+// DebFile is a .deb published to a component of a distribution. A .deb can be published to
+// several distributions, which share its file in the pool.
+type DebFile struct {
+	debian.Metadata
+	Distribution string `json:"distribution"`
+	Component    string `json:"component"`
+	Filename     string `json:"filename"`
+	Size         int64  `json:"size"`
+	MD5          string `json:"md5"`
+	Sha1         string `json:"sha1"`
+	Sha256       string `json:"sha256"`
+}
+
+type RpmMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Packages are the published packages of the version, one per architecture.
+	Packages []RpmFile `json:"packages"`
+}
+
+// RpmFile is a published package along with the checksums listed in the repodata.
+type RpmFile struct {
+	rpm.Metadata
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	Sha256   string `json:"sha256"`
+}
+
+type AlpineMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Packages are the published packages of the version, one per architecture.
+	Packages []AlpineFile `json:"packages"`
+}
+
+// AlpineFile is a published package along with its size, which APKINDEX lists.
+type AlpineFile struct {
+	alpine.Metadata
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	Sha256   string `json:"sha256"`
+}
+
+type ConanMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	conan.Reference
+	// Revisions are the recipe revisions of the version, each with the binary packages built from it.
+	Revisions []ConanRecipeRevision `json:"revisions"`
+}
+
+// ConanRecipeRevision is a revision of a recipe. Time is when a file of the revision was last
+// uploaded, in milliseconds, the latest revision is the one with the greatest time.
+type ConanRecipeRevision struct {
+	Revision string         `json:"revision"`
+	Time     int64          `json:"time"`
+	Files    []string       `json:"files"`
+	Packages []ConanPackage `json:"packages,omitempty"`
+}
+
+// ConanPackage is a binary package of a recipe revision, identified by the hash of its conaninfo.txt.
+type ConanPackage struct {
+	PackageID string                 `json:"package_id"`
+	Revisions []ConanPackageRevision `json:"revisions"`
+}
+
+type ConanPackageRevision struct {
+	Revision string      `json:"revision"`
+	Time     int64       `json:"time"`
+	Files    []string    `json:"files"`
+	Info     *conan.Info `json:"info,omitempty"`
+}
+
+type ComposerMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// VersionNormalized is the version Composer compares versions by.
+	VersionNormalized string `json:"version_normalized"`
+	// Sha1 is the checksum of the archive, served as the shasum of the dist.
+	Sha1 string `json:"sha1"`
+	composer.Metadata
+}
+
+type CondaMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Packages are the published builds of the version, for all subdirs and in both formats.
+	Packages []CondaFile `json:"packages"`
+}
+
+// CondaFile is a published package along with the size and checksums repodata.json lists.
+type CondaFile struct {
+	conda.Metadata
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	Md5      string `json:"md5"`
+	Sha256   string `json:"sha256"`
+}
+
+type CrateMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the .crate, served in the index.
+	Sha256 string `json:"sha256"`
+	cargo.Metadata
+}
+
+type GemsMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the .gem, served in the compact index.
+	Sha256 string `json:"sha256"`
+	gems.Metadata
+}
+
+type TerraformMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the module archive.
+	Sha256 string `json:"sha256"`
+	terraform.Module
+	// Provider is set for the versions of providers, which have no module.
+	Provider *terraform.Provider `json:"provider,omitempty"`
+}
+
+type SwiftMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the source archive.
+	Sha256 string `json:"sha256"`
+	swift.Release
+}
+
+type CocoapodsMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the archive the pod was pushed with.
+	Sha256 string `json:"sha256"`
+	// Archive is the name the archive is stored and downloaded under.
+	Archive string `json:"archive"`
+	cocoapods.Podspec
+}
+
+type HexMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the tarball, its outer checksum.
+	Sha256 string `json:"sha256"`
+	// HasDocs is set once the documentation of the release was published.
+	HasDocs bool `json:"has_docs,omitempty"`
+	hex.Metadata
+}
+
+type CranMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Packages are the archives of the version, the source package and the binary packages built
+	// for each platform and version of R.
+	Packages []CranFile `json:"packages"`
+}
+
+// CranFile is an archive of a version published to a tree of the repository.
+type CranFile struct {
+	cran.Description
+	Tree     string `json:"tree"`
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	MD5      string `json:"md5"`
+	Sha256   string `json:"sha256"`
+}
+
+type GalaxyMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the archive, which ansible-galaxy verifies it with.
+	Sha256 string `json:"sha256"`
+	galaxy.CollectionInfo
+}
+
+type PuppetMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 and MD5 are the checksums of the archive, which puppet verifies it with.
+	Sha256 string `json:"sha256"`
+	MD5    string `json:"md5"`
+	puppet.Metadata
+}
+
+type ModelMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	Framework string `json:"framework,omitempty"`
+	// Formats are the formats of the weights of the version, such as safetensors.
+	Formats []string `json:"formats,omitempty"`
+	// ModelCard is the markdown of the README.md of the version.
+	ModelCard string `json:"model_card,omitempty"`
+}
+
+type VagrantMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Providers are the boxes of the version, in the order they were uploaded.
+	Providers []vagrant.Provider `json:"providers"`
+}
+
+type HomebrewMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Formula is the formula of the version, read from the first bottle uploaded for it.
+	Formula homebrew.Formula `json:"formula"`
+	// Bottles are the bottles of the version, in the order they were uploaded.
+	Bottles []homebrew.Bottle `json:"bottles"`
+}
+
+type PubMetadata struct {
+	Files     []File `json:"files"`
+	FileCount int64  `json:"file_count"`
+	// Sha256 is the checksum of the archive, which clients verify it with.
+	Sha256 string `json:"sha256"`
+	pub.Pubspec
+	// RawPubspec is the pubspec as the repository API serves it.
+	RawPubspec json.RawMessage `json:"raw_pubspec"`
+}
+
 type File struct {
 	Size      int64  `json:"size"`
 	Filename  string `json:"file_name"`
@@ -0,0 +1,119 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"sort"
+
+	"github.com/harness/gitness/registry/app/store/database/util"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// subqueryBuilder builds the subqueries of the artifact lists. sq.Expr renders the queries it's given with
+// their own placeholders, so these keep ? placeholders for the outer query to number.
+var subqueryBuilder = sq.StatementBuilder.PlaceholderFormat(sq.Question)
+
+// artifactFilter holds the filters of the artifact lists. They only depend on the registry and the image
+// of a row, so they're applied in the subqueries that rank versions and count downloads as well: these
+// then read the rows of the matching images only instead of the rows of the whole space.
+type artifactFilter struct {
+	registryNames []string
+	packageTypes  []string
+	search        string
+	labels        []string
+}
+
+// apply adds the filter to a query joining the registries as r, matching the search against
+// nameColumn and the labels against labelsColumn.
+func (f artifactFilter) apply(q sq.SelectBuilder, nameColumn, labelsColumn string) sq.SelectBuilder {
+	if len(f.registryNames) > 0 {
+		q = q.Where(sq.Eq{"r.registry_name": f.registryNames})
+	}
+	if len(f.packageTypes) > 0 {
+		q = q.Where(sq.Eq{"r.registry_package_type": f.packageTypes})
+	}
+	if f.search != "" {
+		q = q.Where(nameColumn+" LIKE ?", sqlPartialMatch(f.search))
+	}
+	if len(f.labels) > 0 {
+		labels := append([]string(nil), f.labels...)
+		sort.Strings(labels)
+		labelsVal := util.GetEmptySQLString(util.ArrToString(labels))
+		labelsVal.String = labelSeparatorStart + labelsVal.String + labelSeparatorEnd
+		q = q.Where("'^_' || "+labelsColumn+" || '^_' LIKE ?", labelsVal)
+	}
+	return q
+}
+
+// inSpace scopes the artifact lists to the registries of a space.
+func inSpace(parentID int64) sq.Sqlizer {
+	return sq.Eq{"r.registry_parent_id": parentID}
+}
+
+// inRegistries scopes the artifact lists to the given registries.
+func inRegistries(registryIDs []int64) sq.Sqlizer {
+	return sq.Eq{"r.registry_id": registryIDs}
+}
+
+// imagesOf returns a query over the images of the registries in scope, joined as i and r.
+func imagesOf(scope sq.Sqlizer, columns ...string) sq.SelectBuilder {
+	return subqueryBuilder.Select(columns...).
+		From("images i").
+		Join("registries r ON r.registry_id = i.image_registry_id").
+		Where(scope)
+}
+
+// latestArtifacts ranks the versions of the images matching the filter by their last update, the
+// latest version of an image has rank 1.
+func latestArtifacts(scope sq.Sqlizer, f artifactFilter) sq.SelectBuilder {
+	q := imagesOf(scope, "a.artifact_id as id",
+		"ROW_NUMBER() OVER (PARTITION BY a.artifact_image_id ORDER BY a.artifact_updated_at DESC) AS rank").
+		Join("artifacts a ON a.artifact_image_id = i.image_id")
+	return f.apply(q, "i.image_name", "i.image_labels")
+}
+
+// latestTags ranks the tags of the images matching the filter by their last update, the latest tag of an
+// image has rank 1.
+func latestTags(scope sq.Sqlizer, f artifactFilter) sq.SelectBuilder {
+	q := subqueryBuilder.Select("t.tag_id as id",
+		"ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name "+
+			"ORDER BY t.tag_updated_at DESC) AS rank").
+		From("tags t").
+		Join("registries r ON t.tag_registry_id = r.registry_id").
+		Where(scope)
+	if len(f.labels) > 0 {
+		q = q.Join("images i ON i.image_registry_id = t.tag_registry_id AND i.image_name = t.tag_image_name")
+	}
+	return f.apply(q, "t.tag_image_name", "i.image_labels")
+}
+
+// imageDownloads counts the downloads of the images matching the filter by image name, adding up the
+// downloads of the images of the same name in different registries. The filter must only hold the
+// filters of the list that keep all images of a name, like the search, so the counts don't change.
+func imageDownloads(scope sq.Sqlizer, f artifactFilter) sq.SelectBuilder {
+	q := imagesOf(scope, "i.image_name", "COUNT(d.download_stat_id) as download_count").
+		Join("artifacts a ON a.artifact_image_id = i.image_id").
+		Join("download_stats d ON d.download_stat_artifact_id = a.artifact_id")
+	return f.apply(q, "i.image_name", "i.image_labels").GroupBy("i.image_name")
+}
+
+// versionDownloads counts the downloads of the versions of the images in scope by version.
+func versionDownloads(scope sq.Sqlizer) sq.SelectBuilder {
+	return imagesOf(scope, "a.artifact_version", "COUNT(d.download_stat_id) as download_count").
+		Join("artifacts a ON a.artifact_image_id = i.image_id").
+		Join("download_stats d ON d.download_stat_artifact_id = a.artifact_id").
+		GroupBy("a.artifact_version")
+}
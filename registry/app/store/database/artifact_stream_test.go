@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func seedStreamArtifacts(t *testing.T, db *sqlx.DB, names ...string) {
+	t.Helper()
+	mustExec(t, db, `INSERT INTO registries (registry_id, registry_root_parent_id, registry_parent_id, registry_name,
+		registry_type, registry_package_type, registry_created_at, registry_updated_at, registry_created_by,
+		registry_updated_by) VALUES (1, 1, 1, 'generic-local', 'VIRTUAL', 'GENERIC', 1, 2, 1, 1)`)
+	for i, name := range names {
+		image := mustExec(t, db, `INSERT INTO images (image_name, image_registry_id, image_enabled,
+			image_created_at, image_updated_at, image_created_by, image_updated_by) VALUES (?, 1, TRUE, 1, 2, 1, 1)`,
+			name)
+		mustExec(t, db, `INSERT INTO artifacts (artifact_version, artifact_image_id, artifact_metadata,
+			artifact_created_at, artifact_updated_at, artifact_created_by, artifact_updated_by)
+			VALUES ('1.0.0', ?, '{}', 1, ?, 1, 1)`, image, i+1)
+	}
+}
+
+func collectStream(
+	artifacts <-chan *types.ArtifactMetadata,
+	errs <-chan error,
+) ([]string, error) {
+	var names []string
+	for a := range artifacts {
+		names = append(names, a.Name)
+	}
+	return names, <-errs
+}
+
+func TestStreamAllArtifacts(t *testing.T) {
+	db := setupPlanDB(t)
+	seedStreamArtifacts(t, db, "app", "lib", "tool")
+	dao := ArtifactDao{db: db}
+	ctx := context.Background()
+
+	names, err := collectStream(dao.StreamAllArtifactsByParentID(ctx, 1, &[]string{}, "name", "ASC", "",
+		false, nil))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"app", "lib", "tool"}, names)
+
+	names, err = collectStream(dao.StreamAllArtifactsByRepo(ctx, []int64{1}, "name", "DESC", "", nil))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tool", "lib", "app"}, names)
+}
+
+func TestStreamAllArtifactsCancel(t *testing.T) {
+	db := setupPlanDB(t)
+	seedStreamArtifacts(t, db, "app", "lib", "tool")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	artifacts, errs := ArtifactDao{db: db}.StreamAllArtifactsByRepo(ctx, []int64{1}, "name", "ASC", "", nil)
+	first, ok := <-artifacts
+	require.True(t, ok)
+	assert.Equal(t, "app", first.Name)
+
+	cancel()
+	// The stream stops on its next send, reports why and closes both channels.
+	require.ErrorIs(t, <-errs, context.Canceled)
+	_, ok = <-artifacts
+	assert.False(t, ok, "the artifacts channel is closed")
+	_, ok = <-errs
+	assert.False(t, ok, "the error channel is closed")
+}
+
+func TestStreamAllArtifactsError(t *testing.T) {
+	db := setupPlanDB(t)
+	seedStreamArtifacts(t, db, "app")
+
+	names, err := collectStream(ArtifactDao{db: db}.StreamAllArtifactsByParentID(context.Background(), 1,
+		&[]string{}, "name", "SIDEWAYS", "", false, nil))
+	require.Error(t, err, "an invalid query is reported on the error channel")
+	assert.Empty(t, names)
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteEmptyByID(t *testing.T) {
+	db := setupPlanDB(t)
+	ctx := testSessionContext()
+	image := mustExec(t, db, `INSERT INTO images (image_name, image_registry_id, image_enabled,
+		image_created_at, image_updated_at, image_created_by, image_updated_by) VALUES ('app', 1, TRUE, 1, 2, 1, 1)`)
+	claimed := mustExec(t, db, `INSERT INTO artifacts (artifact_version, artifact_image_id, artifact_metadata,
+		artifact_file_count, artifact_created_at, artifact_updated_at, artifact_created_by, artifact_updated_by)
+		VALUES ('1.0.0', ?, '{"desc":""}', 0, 1, 2, 1, 1)`, image)
+	filled := mustExec(t, db, `INSERT INTO artifacts (artifact_version, artifact_image_id, artifact_metadata,
+		artifact_file_count, artifact_created_at, artifact_updated_at, artifact_created_by, artifact_updated_by)
+		VALUES ('2.0.0', ?, '{"files":[{"name":"app.zip"}],"file_count":1}', 1, 1, 2, 1, 1)`, image)
+	dao := ArtifactDao{db: db}
+
+	deleted, err := dao.DeleteEmptyByID(ctx, filled)
+	require.NoError(t, err)
+	assert.False(t, deleted, "a version with files is kept")
+
+	deleted, err = dao.DeleteEmptyByID(ctx, claimed)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	var versions []string
+	require.NoError(t, db.Select(&versions, "SELECT artifact_version FROM artifacts"))
+	assert.Equal(t, []string{"2.0.0"}, versions)
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type backfillDao struct {
+	db *sqlx.DB
+}
+
+func NewBackfillDao(db *sqlx.DB) store.BackfillRepository {
+	return &backfillDao{
+		db: db,
+	}
+}
+
+type backfillDB struct {
+	Name        string        `db:"backfill_name"`
+	Cursor      int64         `db:"backfill_cursor"`
+	Rows        int64         `db:"backfill_rows"`
+	StartedAt   int64         `db:"backfill_started_at"`
+	UpdatedAt   int64         `db:"backfill_updated_at"`
+	CompletedAt sql.NullInt64 `db:"backfill_completed_at"`
+}
+
+func (dao *backfillDao) Get(ctx context.Context, name string) (*types.Backfill, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(backfillDB{}), ",")).
+		From("registry_backfills").
+		Where("backfill_name = ?", name)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	dst := &backfillDB{}
+	if err = db.GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find backfill")
+	}
+
+	backfill := &types.Backfill{
+		Name:      dst.Name,
+		Cursor:    dst.Cursor,
+		Rows:      dst.Rows,
+		StartedAt: time.UnixMilli(dst.StartedAt),
+		UpdatedAt: time.UnixMilli(dst.UpdatedAt),
+	}
+	if dst.CompletedAt.Valid {
+		backfill.CompletedAt = time.UnixMilli(dst.CompletedAt.Int64)
+	}
+	return backfill, nil
+}
+
+func (dao *backfillDao) Upsert(ctx context.Context, backfill *types.Backfill) error {
+	const sqlQuery = `
+		INSERT INTO registry_backfills (
+			backfill_name
+			,backfill_cursor
+			,backfill_rows
+			,backfill_started_at
+			,backfill_updated_at
+			,backfill_completed_at
+		) VALUES (
+			:backfill_name
+			,:backfill_cursor
+			,:backfill_rows
+			,:backfill_started_at
+			,:backfill_updated_at
+			,:backfill_completed_at
+		)
+		ON CONFLICT (backfill_name)
+		DO UPDATE SET
+			backfill_cursor = :backfill_cursor
+			,backfill_rows = :backfill_rows
+			,backfill_updated_at = :backfill_updated_at
+			,backfill_completed_at = :backfill_completed_at`
+
+	now := time.Now()
+	if backfill.StartedAt.IsZero() {
+		backfill.StartedAt = now
+	}
+	backfill.UpdatedAt = now
+
+	var completedAt sql.NullInt64
+	if backfill.Completed() {
+		completedAt = sql.NullInt64{Int64: backfill.CompletedAt.UnixMilli(), Valid: true}
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &backfillDB{
+		Name:        backfill.Name,
+		Cursor:      backfill.Cursor,
+		Rows:        backfill.Rows,
+		StartedAt:   backfill.StartedAt.UnixMilli(),
+		UpdatedAt:   backfill.UpdatedAt.UnixMilli(),
+		CompletedAt: completedAt,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind backfill object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+type baseImageDao struct {
+	db *sqlx.DB
+}
+
+func NewBaseImageDao(db *sqlx.DB) store.BaseImageRepository {
+	return &baseImageDao{
+		db: db,
+	}
+}
+
+type baseImageDB struct {
+	ID             int64  `db:"mbi_id"`
+	RegistryID     int64  `db:"mbi_registry_id"`
+	ManifestID     int64  `db:"mbi_manifest_id"`
+	ImageName      string `db:"mbi_image_name"`
+	BaseRepository string `db:"mbi_base_repository"`
+	BaseTag        string `db:"mbi_base_tag"`
+	BaseDigest     string `db:"mbi_base_digest"`
+	CreatedAt      int64  `db:"mbi_created_at"`
+}
+
+type imageDependentDB struct {
+	RegistryID int64  `db:"mbi_registry_id"`
+	ImageName  string `db:"mbi_image_name"`
+	Tag        string `db:"tag_name"`
+	BaseDigest string `db:"mbi_base_digest"`
+}
+
+// Upsert records the base image of a manifest, replacing any previously recorded one.
+func (dao *baseImageDao) Upsert(ctx context.Context, baseImage *types.BaseImage) error {
+	const sqlQuery = `
+		INSERT INTO manifest_base_images (
+			mbi_registry_id
+			,mbi_manifest_id
+			,mbi_image_name
+			,mbi_base_repository
+			,mbi_base_tag
+			,mbi_base_digest
+			,mbi_created_at
+		) VALUES (
+			:mbi_registry_id
+			,:mbi_manifest_id
+			,:mbi_image_name
+			,:mbi_base_repository
+			,:mbi_base_tag
+			,:mbi_base_digest
+			,:mbi_created_at
+		)
+		ON CONFLICT (mbi_manifest_id)
+		DO UPDATE SET
+			mbi_base_repository = :mbi_base_repository
+			,mbi_base_tag = :mbi_base_tag
+			,mbi_base_digest = :mbi_base_digest
+		RETURNING mbi_id`
+
+	if baseImage.CreatedAt.IsZero() {
+		baseImage.CreatedAt = time.Now()
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalBaseImage(baseImage))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind base image object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&baseImage.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+// ListDependents lists the tags, across all registries, whose manifest was built from
+// the given base image repository and tag.
+func (dao *baseImageDao) ListDependents(
+	ctx context.Context,
+	baseRepository string,
+	baseTag string,
+) ([]types.ImageDependent, error) {
+	stmt := databaseg.Builder.
+		Select("mbi_registry_id", "mbi_image_name", "tag_name", "mbi_base_digest").
+		From("manifest_base_images").
+		Join("tags ON tag_manifest_id = mbi_manifest_id").
+		Where("mbi_base_repository = ? AND mbi_base_tag = ?", baseRepository, baseTag).
+		OrderBy("mbi_registry_id", "mbi_image_name", "tag_name")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	dst := []*imageDependentDB{}
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list dependents of %s:%s",
+			baseRepository, baseTag)
+	}
+
+	dependents := make([]types.ImageDependent, 0, len(dst))
+	for _, d := range dst {
+		dependents = append(dependents, types.ImageDependent{
+			RegistryID: d.RegistryID,
+			ImageName:  d.ImageName,
+			Tag:        d.Tag,
+			BaseDigest: d.BaseDigest,
+		})
+	}
+	return dependents, nil
+}
+
+func mapToInternalBaseImage(in *types.BaseImage) *baseImageDB {
+	return &baseImageDB{
+		ID:             in.ID,
+		RegistryID:     in.RegistryID,
+		ManifestID:     in.ManifestID,
+		ImageName:      in.ImageName,
+		BaseRepository: in.BaseRepository,
+		BaseTag:        in.BaseTag,
+		BaseDigest:     in.BaseDigest,
+		CreatedAt:      in.CreatedAt.UnixMilli(),
+	}
+}
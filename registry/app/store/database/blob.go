@@ -28,6 +28,7 @@ import (
 	"github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 	"github.com/opencontainers/go-digest"
 	errors2 "github.com/pkg/errors"
@@ -173,6 +174,79 @@ func (bd blobDao) FindByDigestAndRepoID(ctx context.Context, d digest.Digest, re
 	return bd.mapToBlob(dst)
 }
 
+func (bd blobDao) List(ctx context.Context, afterID int64, limit int) ([]*types.Blob, error) {
+	stmt := PrimaryQuery.
+		Where("blobs.blob_id > ?", afterID).
+		OrderBy("blobs.blob_id").
+		Limit(uint64(limit))
+
+	return bd.list(ctx, stmt)
+}
+
+func (bd blobDao) ListUnreferenced(
+	ctx context.Context,
+	createdBefore time.Time,
+	afterID int64,
+	limit int,
+) ([]*types.Blob, error) {
+	stmt := PrimaryQuery.
+		Where("blobs.blob_id > ?", afterID).
+		Where("blob_created_at < ?", createdBefore.UnixMilli()).
+		Where("NOT EXISTS (SELECT 1 FROM registry_blobs WHERE rblob_blob_id = blobs.blob_id)").
+		Where("NOT EXISTS (SELECT 1 FROM layers WHERE layer_blob_id = blobs.blob_id)").
+		Where("NOT EXISTS (SELECT 1 FROM manifests WHERE manifest_configuration_blob_id = blobs.blob_id)").
+		OrderBy("blobs.blob_id").
+		Limit(uint64(limit))
+
+	return bd.list(ctx, stmt)
+}
+
+func (bd blobDao) ListByRegistryID(
+	ctx context.Context,
+	registryID int64,
+	afterID int64,
+	limit int,
+) ([]*types.Blob, error) {
+	stmt := PrimaryQuery.
+		Where("blobs.blob_id > ?", afterID).
+		Where(sq.Or{
+			sq.Expr("EXISTS (SELECT 1 FROM registry_blobs WHERE rblob_blob_id = blobs.blob_id "+
+				"AND rblob_registry_id = ?)", registryID),
+			sq.Expr("EXISTS (SELECT 1 FROM layers WHERE layer_blob_id = blobs.blob_id "+
+				"AND layer_registry_id = ?)", registryID),
+			sq.Expr("EXISTS (SELECT 1 FROM manifests WHERE manifest_configuration_blob_id = blobs.blob_id "+
+				"AND manifest_registry_id = ?)", registryID),
+		}).
+		OrderBy("blobs.blob_id").
+		Limit(uint64(limit))
+
+	return bd.list(ctx, stmt)
+}
+
+func (bd blobDao) list(ctx context.Context, stmt sq.SelectBuilder) ([]*types.Blob, error) {
+	db := dbtx.GetAccessor(ctx, bd.db)
+
+	dst := []*blobMetadataDB{}
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors2.Wrap(err, "Failed to convert query to sql")
+	}
+
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list blobs")
+	}
+
+	blobs := make([]*types.Blob, 0, len(dst))
+	for _, b := range dst {
+		blob, err := bd.mapToBlob(b)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
 func (bd blobDao) CreateOrFind(ctx context.Context, b *types.Blob) (*types.Blob, error) {
 	sqlQuery := `INSERT INTO blobs (
                    blob_digest, 
@@ -0,0 +1,300 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type cacheEvictionDao struct {
+	db *sqlx.DB
+}
+
+func NewCacheEvictionDao(db *sqlx.DB) store.CacheEvictionRepository {
+	return &cacheEvictionDao{
+		db: db,
+	}
+}
+
+type cacheEvictionPolicyDB struct {
+	ID             int64 `db:"cevict_id"`
+	RegistryID     int64 `db:"cevict_registry_id"`
+	MaxSizeBytes   int64 `db:"cevict_max_size_bytes"`
+	LastRunAt      int64 `db:"cevict_last_run_at"`
+	LastEvicted    int   `db:"cevict_last_evicted"`
+	LastFreedBytes int64 `db:"cevict_last_freed_bytes"`
+	CreatedAt      int64 `db:"cevict_created_at"`
+	UpdatedAt      int64 `db:"cevict_updated_at"`
+	CreatedBy      int64 `db:"cevict_created_by"`
+	UpdatedBy      int64 `db:"cevict_updated_by"`
+}
+
+type cachedArtifactDB struct {
+	ManifestID   int64  `db:"manifest_id"`
+	ArtifactID   int64  `db:"artifact_id"`
+	ImageName    string `db:"image_name"`
+	Digest       []byte `db:"manifest_digest"`
+	Version      string `db:"version"`
+	Size         int64  `db:"size"`
+	LastPulledAt int64  `db:"last_pulled_at"`
+}
+
+func (dao *cacheEvictionDao) GetPolicy(ctx context.Context, registryID int64) (*types.CacheEvictionPolicy, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(cacheEvictionPolicyDB{}), ",")).
+		From("registry_cache_eviction_policies").
+		Where(sq.Eq{"cevict_registry_id": registryID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := new(cacheEvictionPolicyDB)
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find cache eviction policy")
+	}
+	return mapToCacheEvictionPolicy(dst), nil
+}
+
+func (dao *cacheEvictionDao) UpsertPolicy(ctx context.Context, policy *types.CacheEvictionPolicy) error {
+	const sqlQuery = `
+		INSERT INTO registry_cache_eviction_policies (
+			cevict_registry_id
+			,cevict_max_size_bytes
+			,cevict_created_at
+			,cevict_updated_at
+			,cevict_created_by
+			,cevict_updated_by
+		) VALUES (
+			:cevict_registry_id
+			,:cevict_max_size_bytes
+			,:cevict_created_at
+			,:cevict_updated_at
+			,:cevict_created_by
+			,:cevict_updated_by
+		)
+		ON CONFLICT (cevict_registry_id)
+		DO UPDATE SET
+			cevict_max_size_bytes = :cevict_max_size_bytes
+			,cevict_updated_at = :cevict_updated_at
+			,cevict_updated_by = :cevict_updated_by`
+
+	now := time.Now()
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &cacheEvictionPolicyDB{
+		RegistryID:   policy.RegistryID,
+		MaxSizeBytes: policy.MaxSizeBytes,
+		CreatedAt:    now.UnixMilli(),
+		UpdatedAt:    now.UnixMilli(),
+		CreatedBy:    policy.UpdatedBy,
+		UpdatedBy:    policy.UpdatedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind cache eviction policy object")
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	policy.UpdatedAt = now
+	return nil
+}
+
+func (dao *cacheEvictionDao) DeletePolicy(ctx context.Context, registryID int64) error {
+	query, args, err := databaseg.Builder.Delete("registry_cache_eviction_policies").
+		Where(sq.Eq{"cevict_registry_id": registryID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	result, err := dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *cacheEvictionDao) ListPolicies(ctx context.Context) ([]*types.CacheEvictionPolicy, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(cacheEvictionPolicyDB{}), ",")).
+		From("registry_cache_eviction_policies").
+		OrderBy("cevict_registry_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*cacheEvictionPolicyDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list cache eviction policies")
+	}
+	policies := make([]*types.CacheEvictionPolicy, 0, len(dst))
+	for _, d := range dst {
+		policies = append(policies, mapToCacheEvictionPolicy(d))
+	}
+	return policies, nil
+}
+
+func (dao *cacheEvictionDao) UpdateLastRun(
+	ctx context.Context,
+	registryID int64,
+	runAt time.Time,
+	evicted int,
+	freedBytes int64,
+) error {
+	query, args, err := databaseg.Builder.Update("registry_cache_eviction_policies").
+		Set("cevict_last_run_at", runAt.UnixMilli()).
+		Set("cevict_last_evicted", evicted).
+		Set("cevict_last_freed_bytes", freedBytes).
+		Where(sq.Eq{"cevict_registry_id": registryID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Update query failed")
+	}
+	return nil
+}
+
+func (dao *cacheEvictionDao) CacheSize(ctx context.Context, registryID int64, oci bool) (int64, error) {
+	stmt := databaseg.Builder.
+		Select("COALESCE(SUM(manifest_total_size), 0)").
+		From("manifests").
+		Where(sq.Eq{"manifest_registry_id": registryID})
+	if !oci {
+		stmt = databaseg.Builder.
+			Select("COALESCE(SUM(gb.generic_blob_size), 0)").
+			From("nodes n").
+			Join("generic_blobs gb ON gb.generic_blob_id = n.node_generic_blob_id").
+			Where(sq.Eq{"n.node_registry_id": registryID}).
+			Where("n.node_is_file")
+	}
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var size int64
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, &size, query, args...); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get cache size")
+	}
+	return size, nil
+}
+
+func (dao *cacheEvictionDao) ListLeastRecentlyPulled(
+	ctx context.Context,
+	registryID int64,
+	oci bool,
+	limit int,
+	offset int,
+) ([]*types.CachedArtifact, error) {
+	stmt := databaseg.Builder.
+		Select("m.manifest_id AS manifest_id", "0 AS artifact_id", "m.manifest_image_name AS image_name",
+			"m.manifest_digest AS manifest_digest", "'' AS version", "m.manifest_total_size AS size",
+			"COALESCE(MAX(COALESCE(t.tag_last_pulled_at, t.tag_created_at)), m.manifest_created_at) "+
+				"AS last_pulled_at").
+		From("manifests m").
+		LeftJoin("tags t ON t.tag_manifest_id = m.manifest_id").
+		Where(sq.Eq{"m.manifest_registry_id": registryID}).
+		Where("NOT EXISTS (SELECT 1 FROM manifest_references r WHERE r.manifest_ref_child_id = m.manifest_id)").
+		GroupBy("m.manifest_id", "m.manifest_image_name", "m.manifest_digest", "m.manifest_total_size",
+			"m.manifest_created_at").
+		OrderBy("last_pulled_at", "m.manifest_id")
+	if !oci {
+		stmt = databaseg.Builder.
+			Select("0 AS manifest_id", "a.artifact_id AS artifact_id", "i.image_name AS image_name",
+				"NULL AS manifest_digest", "a.artifact_version AS version", "0 AS size",
+				"COALESCE(MAX(ds.download_stat_timestamp), a.artifact_created_at) AS last_pulled_at").
+			From("artifacts a").
+			Join("images i ON i.image_id = a.artifact_image_id").
+			LeftJoin("download_stats ds ON ds.download_stat_artifact_id = a.artifact_id").
+			Where(sq.Eq{"i.image_registry_id": registryID}).
+			GroupBy("a.artifact_id", "i.image_name", "a.artifact_version", "a.artifact_created_at").
+			OrderBy("last_pulled_at", "a.artifact_id")
+	}
+	stmt = stmt.Limit(util.SafeIntToUInt64(limit)).Offset(util.SafeIntToUInt64(offset))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*cachedArtifactDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list cached artifacts")
+	}
+
+	artifacts := make([]*types.CachedArtifact, 0, len(dst))
+	for _, d := range dst {
+		version := d.Version
+		if oci {
+			dgst, err := types.Digest(util.GetHexEncodedString(d.Digest)).Parse()
+			if err != nil {
+				return nil, err
+			}
+			version = dgst.String()
+		}
+		artifacts = append(artifacts, &types.CachedArtifact{
+			ManifestID:   d.ManifestID,
+			ArtifactID:   d.ArtifactID,
+			ImageName:    d.ImageName,
+			Version:      version,
+			Size:         d.Size,
+			LastPulledAt: time.UnixMilli(d.LastPulledAt),
+		})
+	}
+	return artifacts, nil
+}
+
+func mapToCacheEvictionPolicy(dst *cacheEvictionPolicyDB) *types.CacheEvictionPolicy {
+	policy := &types.CacheEvictionPolicy{
+		ID:             dst.ID,
+		RegistryID:     dst.RegistryID,
+		MaxSizeBytes:   dst.MaxSizeBytes,
+		LastEvicted:    dst.LastEvicted,
+		LastFreedBytes: dst.LastFreedBytes,
+		CreatedAt:      time.UnixMilli(dst.CreatedAt),
+		UpdatedAt:      time.UnixMilli(dst.UpdatedAt),
+		CreatedBy:      dst.CreatedBy,
+		UpdatedBy:      dst.UpdatedBy,
+	}
+	if dst.LastRunAt > 0 {
+		policy.LastRunAt = time.UnixMilli(dst.LastRunAt)
+	}
+	return policy
+}
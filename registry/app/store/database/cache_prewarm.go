@@ -0,0 +1,159 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type cachePrewarmDao struct {
+	db *sqlx.DB
+}
+
+func NewCachePrewarmDao(db *sqlx.DB) store.CachePrewarmRepository {
+	return &cachePrewarmDao{
+		db: db,
+	}
+}
+
+type cachePrewarmArtifactDB struct {
+	ID         int64  `db:"cprewarm_id"`
+	RegistryID int64  `db:"cprewarm_registry_id"`
+	Batch      string `db:"cprewarm_batch"`
+	Coordinate string `db:"cprewarm_coordinate"`
+	Status     string `db:"cprewarm_status"`
+	Error      string `db:"cprewarm_error"`
+	CreatedAt  int64  `db:"cprewarm_created_at"`
+	UpdatedAt  int64  `db:"cprewarm_updated_at"`
+	CreatedBy  int64  `db:"cprewarm_created_by"`
+}
+
+func (dao *cachePrewarmDao) CreateArtifacts(ctx context.Context, artifacts []*types.CachePrewarmArtifact) error {
+	const sqlQuery = `
+		INSERT INTO registry_cache_prewarm_artifacts (
+			cprewarm_registry_id
+			,cprewarm_batch
+			,cprewarm_coordinate
+			,cprewarm_status
+			,cprewarm_error
+			,cprewarm_created_at
+			,cprewarm_updated_at
+			,cprewarm_created_by
+		) VALUES (
+			:cprewarm_registry_id
+			,:cprewarm_batch
+			,:cprewarm_coordinate
+			,:cprewarm_status
+			,:cprewarm_error
+			,:cprewarm_created_at
+			,:cprewarm_updated_at
+			,:cprewarm_created_by
+		) RETURNING cprewarm_id`
+
+	now := time.Now()
+	db := dbtx.GetAccessor(ctx, dao.db)
+	for _, a := range artifacts {
+		a.CreatedAt = now
+		a.UpdatedAt = now
+		query, args, err := db.BindNamed(sqlQuery, &cachePrewarmArtifactDB{
+			RegistryID: a.RegistryID,
+			Batch:      a.Batch,
+			Coordinate: a.Coordinate,
+			Status:     string(a.Status),
+			Error:      a.Error,
+			CreatedAt:  now.UnixMilli(),
+			UpdatedAt:  now.UnixMilli(),
+			CreatedBy:  a.CreatedBy,
+		})
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind cache prewarm artifact object")
+		}
+		if err = db.QueryRowContext(ctx, query, args...).Scan(&a.ID); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+		}
+	}
+	return nil
+}
+
+func (dao *cachePrewarmDao) ListBatch(
+	ctx context.Context,
+	registryID int64,
+	batch string,
+) ([]*types.CachePrewarmArtifact, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(cachePrewarmArtifactDB{}), ",")).
+		From("registry_cache_prewarm_artifacts").
+		Where(sq.Eq{"cprewarm_registry_id": registryID, "cprewarm_batch": batch}).
+		OrderBy("cprewarm_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*cachePrewarmArtifactDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list cache prewarm artifacts")
+	}
+
+	artifacts := make([]*types.CachePrewarmArtifact, 0, len(dst))
+	for _, d := range dst {
+		artifacts = append(artifacts, &types.CachePrewarmArtifact{
+			ID:         d.ID,
+			RegistryID: d.RegistryID,
+			Batch:      d.Batch,
+			Coordinate: d.Coordinate,
+			Status:     types.CachePrewarmStatus(d.Status),
+			Error:      d.Error,
+			CreatedAt:  time.UnixMilli(d.CreatedAt),
+			UpdatedAt:  time.UnixMilli(d.UpdatedAt),
+			CreatedBy:  d.CreatedBy,
+		})
+	}
+	return artifacts, nil
+}
+
+func (dao *cachePrewarmDao) UpdateStatus(
+	ctx context.Context,
+	id int64,
+	status types.CachePrewarmStatus,
+	errMsg string,
+) error {
+	stmt := databaseg.Builder.Update("registry_cache_prewarm_artifacts").
+		Set("cprewarm_status", string(status)).
+		Set("cprewarm_error", errMsg).
+		Set("cprewarm_updated_at", time.Now().UnixMilli()).
+		Where(sq.Eq{"cprewarm_id": id})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update cache prewarm artifact")
+	}
+	return nil
+}
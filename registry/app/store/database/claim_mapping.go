@@ -0,0 +1,172 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type claimMappingDao struct {
+	db *sqlx.DB
+}
+
+func NewClaimMappingDao(db *sqlx.DB) store.ClaimMappingRepository {
+	return &claimMappingDao{
+		db: db,
+	}
+}
+
+type claimMappingDB struct {
+	ID           int64  `db:"claimmap_id"`
+	SpaceID      int64  `db:"claimmap_space_id"`
+	RegistryName string `db:"claimmap_registry_name"`
+	Claim        string `db:"claimmap_claim"`
+	Value        string `db:"claimmap_value"`
+	Role         string `db:"claimmap_role"`
+	CreatedAt    int64  `db:"claimmap_created_at"`
+	CreatedBy    int64  `db:"claimmap_created_by"`
+}
+
+func (dao *claimMappingDao) Create(ctx context.Context, mapping *types.ClaimMapping) error {
+	const sqlQuery = `
+		INSERT INTO registry_claim_mappings (
+			claimmap_space_id
+			,claimmap_registry_name
+			,claimmap_claim
+			,claimmap_value
+			,claimmap_role
+			,claimmap_created_at
+			,claimmap_created_by
+		) VALUES (
+			:claimmap_space_id
+			,:claimmap_registry_name
+			,:claimmap_claim
+			,:claimmap_value
+			,:claimmap_role
+			,:claimmap_created_at
+			,:claimmap_created_by
+		)
+		RETURNING claimmap_id`
+
+	if mapping.CreatedAt.IsZero() {
+		mapping.CreatedAt = time.Now()
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalClaimMapping(mapping))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind claim mapping object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&mapping.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *claimMappingDao) Delete(ctx context.Context, spaceID int64, id int64) error {
+	stmt := databaseg.Builder.Delete("registry_claim_mappings").
+		Where("claimmap_space_id = ? AND claimmap_id = ?", spaceID, id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *claimMappingDao) List(ctx context.Context, spaceID int64) ([]*types.ClaimMapping, error) {
+	return dao.list(ctx, sq.Eq{"claimmap_space_id": spaceID})
+}
+
+func (dao *claimMappingDao) ListByClaims(ctx context.Context, claims []string) ([]*types.ClaimMapping, error) {
+	if len(claims) == 0 {
+		return nil, nil
+	}
+	return dao.list(ctx, sq.Eq{"claimmap_claim": claims})
+}
+
+func (dao *claimMappingDao) list(ctx context.Context, where sq.Eq) ([]*types.ClaimMapping, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(claimMappingDB{}), ",")).
+		From("registry_claim_mappings").
+		Where(where).
+		OrderBy("claimmap_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*claimMappingDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list claim mappings")
+	}
+
+	mappings := make([]*types.ClaimMapping, 0, len(dst))
+	for _, d := range dst {
+		mappings = append(mappings, &types.ClaimMapping{
+			ID:           d.ID,
+			SpaceID:      d.SpaceID,
+			RegistryName: d.RegistryName,
+			Claim:        d.Claim,
+			Value:        d.Value,
+			Role:         types.RegistryRole(d.Role),
+			CreatedAt:    time.UnixMilli(d.CreatedAt),
+			CreatedBy:    d.CreatedBy,
+		})
+	}
+	return mappings, nil
+}
+
+func mapToInternalClaimMapping(in *types.ClaimMapping) *claimMappingDB {
+	return &claimMappingDB{
+		ID:           in.ID,
+		SpaceID:      in.SpaceID,
+		RegistryName: in.RegistryName,
+		Claim:        in.Claim,
+		Value:        in.Value,
+		Role:         string(in.Role),
+		CreatedAt:    in.CreatedAt.UnixMilli(),
+		CreatedBy:    in.CreatedBy,
+	}
+}
@@ -20,14 +20,17 @@ import (
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/app/common/lib/errors"
 	"github.com/harness/gitness/registry/app/pkg/commons"
 	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
 	"github.com/harness/gitness/registry/types"
 	"github.com/harness/gitness/registry/types/enum"
 	databaseg "github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 )
@@ -38,14 +41,15 @@ type CleanupPolicyDao struct {
 }
 
 type CleanupPolicyDB struct {
-	ID             int64  `db:"cp_id"`
-	RegistryID     int64  `db:"cp_registry_id"`
-	Name           string `db:"cp_name"`
-	ExpiryTimeInMs int64  `db:"cp_expiry_time_ms"`
-	CreatedAt      int64  `db:"cp_created_at"`
-	UpdatedAt      int64  `db:"cp_updated_at"`
-	CreatedBy      int64  `db:"cp_created_by"`
-	UpdatedBy      int64  `db:"cp_updated_by"`
+	ID             int64          `db:"cp_id"`
+	RegistryID     int64          `db:"cp_registry_id"`
+	Name           string         `db:"cp_name"`
+	Type           sql.NullString `db:"cp_type"`
+	ExpiryTimeInMs int64          `db:"cp_expiry_time_ms"`
+	CreatedAt      int64          `db:"cp_created_at"`
+	UpdatedAt      int64          `db:"cp_updated_at"`
+	CreatedBy      int64          `db:"cp_created_by"`
+	UpdatedBy      int64          `db:"cp_updated_by"`
 }
 
 type CleanupPolicyPrefixMappingDB struct {
@@ -92,22 +96,7 @@ func (c CleanupPolicyDao) GetByRegistryID(
 	ctx context.Context,
 	id int64,
 ) (cleanupPolicies *[]types.CleanupPolicy, err error) {
-	stmt := databaseg.Builder.Select(
-		"cp_id",
-		"cp_registry_id",
-		"cp_name",
-		"cp_expiry_time_ms",
-		"cp_created_at",
-		"cp_updated_at",
-		"cp_created_by",
-		"cp_updated_by",
-		"cpp_id",
-		"cpp_cleanup_policy_id",
-		"cpp_prefix",
-		"cpp_prefix_type",
-	).
-		From("cleanup_policies").
-		Join("cleanup_policy_prefix_mappings ON cp_id = cpp_cleanup_policy_id").
+	stmt := c.selectCleanupPolicies().
 		Where("cp_registry_id = ?", id)
 
 	db := dbtx.GetAccessor(ctx, c.db)
@@ -136,11 +125,68 @@ func (c CleanupPolicyDao) GetByRegistryID(
 	return c.mapToCleanupPolicies(ctx, rows)
 }
 
+// ListByType returns the cleanup policies of the given type across all registries.
+// Policies created before the type was introduced are treated as age based.
+func (c CleanupPolicyDao) ListByType(
+	ctx context.Context,
+	policyType artifact.CleanupPolicyType,
+) (cleanupPolicies *[]types.CleanupPolicy, err error) {
+	stmt := c.selectCleanupPolicies()
+	if policyType == artifact.CleanupPolicyTypeAGE {
+		stmt = stmt.Where("(cp_type = ? OR cp_type IS NULL)", policyType)
+	} else {
+		stmt = stmt.Where("cp_type = ?", policyType)
+	}
+
+	db := dbtx.GetAccessor(ctx, c.db)
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	rows, err := db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "failed to list cleanup policies of type %s", policyType)
+	}
+
+	defer func(rows *sqlx.Rows) {
+		err := rows.Close()
+		if err != nil {
+			log.Ctx(ctx).Error().Msgf("failed to close rows: %v", err)
+		}
+	}(rows)
+
+	return c.mapToCleanupPolicies(ctx, rows)
+}
+
+// selectCleanupPolicies selects cleanup policies joined with their prefix mappings. Policies without
+// any mapping are returned with empty mapping columns.
+func (c CleanupPolicyDao) selectCleanupPolicies() sq.SelectBuilder {
+	return databaseg.Builder.Select(
+		"cp_id",
+		"cp_registry_id",
+		"cp_name",
+		"cp_type",
+		"cp_expiry_time_ms",
+		"cp_created_at",
+		"cp_updated_at",
+		"cp_created_by",
+		"cp_updated_by",
+		"COALESCE(cpp_id, 0) AS cpp_id",
+		"COALESCE(cpp_cleanup_policy_id, 0) AS cpp_cleanup_policy_id",
+		"COALESCE(cpp_prefix, '') AS cpp_prefix",
+		"COALESCE(cpp_prefix_type, '') AS cpp_prefix_type",
+	).
+		From("cleanup_policies").
+		LeftJoin("cleanup_policy_prefix_mappings ON cp_id = cpp_cleanup_policy_id")
+}
+
 func (c CleanupPolicyDao) Create(ctx context.Context, cleanupPolicy *types.CleanupPolicy) (id int64, err error) {
 	const sqlQuery = `
 		INSERT INTO cleanup_policies (
 			cp_registry_id
 			,cp_name
+			,cp_type
 			,cp_expiry_time_ms
 			,cp_created_at
 			,cp_updated_at
@@ -149,6 +195,7 @@ func (c CleanupPolicyDao) Create(ctx context.Context, cleanupPolicy *types.Clean
 		) values (
 			:cp_registry_id
 			,:cp_name
+			,:cp_type
 			,:cp_expiry_time_ms
 			,:cp_created_at
 			,:cp_updated_at
@@ -320,6 +367,15 @@ func (c CleanupPolicyDao) mapToInternalCleanupPolicyMapping(
 			)
 		}
 	}
+	for _, pattern := range cp.ExcludePattern {
+		result = append(
+			result, CleanupPolicyPrefixMappingDB{
+				CleanupPolicyID: cp.ID,
+				Prefix:          pattern,
+				PrefixType:      enum.PrefixTypeExclude,
+			},
+		)
+	}
 	return &result
 }
 
@@ -343,6 +399,7 @@ func (c CleanupPolicyDao) mapToInternalCleanupPolicy(
 		ID:             cp.ID,
 		RegistryID:     cp.RegistryID,
 		Name:           cp.Name,
+		Type:           util.GetEmptySQLString(string(cp.Type)),
 		ExpiryTimeInMs: cp.ExpiryTime,
 		CreatedAt:      cp.CreatedAt.UnixMilli(),
 		UpdatedAt:      cp.UpdatedAt.UnixMilli(),
@@ -365,15 +422,21 @@ func (c CleanupPolicyDao) mapToCleanupPolicies(
 		}
 
 		if _, exists := cleanupPolicies[cp.ID]; !exists {
+			policyType := artifact.CleanupPolicyTypeAGE
+			if cp.Type.Valid && cp.Type.String != "" {
+				policyType = artifact.CleanupPolicyType(cp.Type.String)
+			}
 			cleanupPolicies[cp.ID] = &types.CleanupPolicy{
-				ID:            cp.ID,
-				RegistryID:    cp.RegistryID,
-				Name:          cp.Name,
-				ExpiryTime:    cp.ExpiryTimeInMs,
-				CreatedAt:     time.UnixMilli(cp.CreatedAt),
-				UpdatedAt:     time.UnixMilli(cp.UpdatedAt),
-				PackagePrefix: make([]string, 0),
-				VersionPrefix: make([]string, 0),
+				ID:             cp.ID,
+				RegistryID:     cp.RegistryID,
+				Name:           cp.Name,
+				Type:           policyType,
+				ExpiryTime:     cp.ExpiryTimeInMs,
+				CreatedAt:      time.UnixMilli(cp.CreatedAt),
+				UpdatedAt:      time.UnixMilli(cp.UpdatedAt),
+				PackagePrefix:  make([]string, 0),
+				VersionPrefix:  make([]string, 0),
+				ExcludePattern: make([]string, 0),
 			}
 		}
 
@@ -384,6 +447,10 @@ func (c CleanupPolicyDao) mapToCleanupPolicies(
 		if cp.PrefixType == enum.PrefixTypeVersion {
 			cleanupPolicies[cp.ID].VersionPrefix = append(cleanupPolicies[cp.ID].VersionPrefix, cp.Prefix)
 		}
+
+		if cp.PrefixType == enum.PrefixTypeExclude {
+			cleanupPolicies[cp.ID].ExcludePattern = append(cleanupPolicies[cp.ID].ExcludePattern, cp.Prefix)
+		}
 	}
 	var result []types.CleanupPolicy
 	for _, cp := range cleanupPolicies {
@@ -0,0 +1,194 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type defaultRegistryDao struct {
+	db *sqlx.DB
+}
+
+func NewDefaultRegistryDao(db *sqlx.DB) store.DefaultRegistryRepository {
+	return &defaultRegistryDao{
+		db: db,
+	}
+}
+
+type defaultRegistryDB struct {
+	ID          int64  `db:"defreg_id"`
+	SpaceID     int64  `db:"defreg_space_id"`
+	PackageType string `db:"defreg_package_type"`
+	RegistryID  int64  `db:"defreg_registry_id"`
+	CreatedAt   int64  `db:"defreg_created_at"`
+	UpdatedAt   int64  `db:"defreg_updated_at"`
+	CreatedBy   int64  `db:"defreg_created_by"`
+	UpdatedBy   int64  `db:"defreg_updated_by"`
+}
+
+func (dao *defaultRegistryDao) Upsert(ctx context.Context, defaultRegistry *types.DefaultRegistry) error {
+	const sqlQuery = `
+		INSERT INTO registry_default_registries (
+			defreg_space_id
+			,defreg_package_type
+			,defreg_registry_id
+			,defreg_created_at
+			,defreg_updated_at
+			,defreg_created_by
+			,defreg_updated_by
+		) VALUES (
+			:defreg_space_id
+			,:defreg_package_type
+			,:defreg_registry_id
+			,:defreg_created_at
+			,:defreg_updated_at
+			,:defreg_created_by
+			,:defreg_updated_by
+		)
+		ON CONFLICT (defreg_space_id, defreg_package_type)
+		DO UPDATE SET
+			defreg_registry_id = :defreg_registry_id
+			,defreg_updated_at = :defreg_updated_at
+			,defreg_updated_by = :defreg_updated_by
+		RETURNING defreg_id, defreg_created_at, defreg_created_by`
+
+	now := time.Now()
+	if defaultRegistry.CreatedAt.IsZero() {
+		defaultRegistry.CreatedAt = now
+	}
+	defaultRegistry.UpdatedAt = now
+	if defaultRegistry.UpdatedBy == 0 {
+		defaultRegistry.UpdatedBy = defaultRegistry.CreatedBy
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalDefaultRegistry(defaultRegistry))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind default registry object")
+	}
+	var createdAt int64
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&defaultRegistry.ID, &createdAt,
+		&defaultRegistry.CreatedBy); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	defaultRegistry.CreatedAt = time.UnixMilli(createdAt)
+	return nil
+}
+
+func (dao *defaultRegistryDao) Delete(
+	ctx context.Context,
+	spaceID int64,
+	packageType artifact.PackageType,
+) error {
+	stmt := databaseg.Builder.Delete("registry_default_registries").
+		Where("defreg_space_id = ? AND defreg_package_type = ?", spaceID, string(packageType))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *defaultRegistryDao) List(ctx context.Context, spaceID int64) ([]*types.DefaultRegistry, error) {
+	return dao.list(ctx, sq.Eq{"defreg_space_id": spaceID})
+}
+
+func (dao *defaultRegistryDao) ListBySpaceIDs(
+	ctx context.Context,
+	spaceIDs []int64,
+	packageType artifact.PackageType,
+) ([]*types.DefaultRegistry, error) {
+	if len(spaceIDs) == 0 {
+		return nil, nil
+	}
+	return dao.list(ctx, sq.Eq{"defreg_space_id": spaceIDs, "defreg_package_type": string(packageType)})
+}
+
+func (dao *defaultRegistryDao) list(ctx context.Context, where sq.Eq) ([]*types.DefaultRegistry, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(defaultRegistryDB{}), ",")).
+		From("registry_default_registries").
+		Where(where).
+		OrderBy("defreg_package_type")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*defaultRegistryDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list default registries")
+	}
+
+	defaultRegistries := make([]*types.DefaultRegistry, 0, len(dst))
+	for _, d := range dst {
+		defaultRegistries = append(defaultRegistries, &types.DefaultRegistry{
+			ID:          d.ID,
+			SpaceID:     d.SpaceID,
+			PackageType: artifact.PackageType(d.PackageType),
+			RegistryID:  d.RegistryID,
+			CreatedAt:   time.UnixMilli(d.CreatedAt),
+			UpdatedAt:   time.UnixMilli(d.UpdatedAt),
+			CreatedBy:   d.CreatedBy,
+			UpdatedBy:   d.UpdatedBy,
+		})
+	}
+	return defaultRegistries, nil
+}
+
+func mapToInternalDefaultRegistry(in *types.DefaultRegistry) *defaultRegistryDB {
+	return &defaultRegistryDB{
+		ID:          in.ID,
+		SpaceID:     in.SpaceID,
+		PackageType: string(in.PackageType),
+		RegistryID:  in.RegistryID,
+		CreatedAt:   in.CreatedAt.UnixMilli(),
+		UpdatedAt:   in.UpdatedAt.UnixMilli(),
+		CreatedBy:   in.CreatedBy,
+		UpdatedBy:   in.UpdatedBy,
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type deletionCertificateDao struct {
+	db *sqlx.DB
+}
+
+func NewDeletionCertificateDao(db *sqlx.DB) store.DeletionCertificateRepository {
+	return &deletionCertificateDao{
+		db: db,
+	}
+}
+
+type deletionCertificateDB struct {
+	ID           int64  `db:"delcert_id"`
+	RegistryID   int64  `db:"delcert_registry_id"`
+	RegistryName string `db:"delcert_registry_name"`
+	PackageType  string `db:"delcert_package_type"`
+	ImageName    string `db:"delcert_image_name"`
+	Version      string `db:"delcert_version"`
+	Reason       string `db:"delcert_reason"`
+	Details      string `db:"delcert_details"`
+	Checksum     string `db:"delcert_checksum"`
+	DeletedAt    int64  `db:"delcert_deleted_at"`
+	DeletedBy    int64  `db:"delcert_deleted_by"`
+}
+
+// deletionCertificateDetails are the contents of a certificate stored as JSON.
+type deletionCertificateDetails struct {
+	DeletedItems    []string            `json:"deleted_items"`
+	Blobs           []types.DeletedBlob `json:"blobs"`
+	DownloadRecords int64               `json:"download_records"`
+}
+
+func (dao *deletionCertificateDao) Create(ctx context.Context, certificate *types.DeletionCertificate) error {
+	const sqlQuery = `
+		INSERT INTO registry_deletion_certificates (
+			delcert_registry_id
+			,delcert_registry_name
+			,delcert_package_type
+			,delcert_image_name
+			,delcert_version
+			,delcert_reason
+			,delcert_details
+			,delcert_checksum
+			,delcert_deleted_at
+			,delcert_deleted_by
+		) VALUES (
+			:delcert_registry_id
+			,:delcert_registry_name
+			,:delcert_package_type
+			,:delcert_image_name
+			,:delcert_version
+			,:delcert_reason
+			,:delcert_details
+			,:delcert_checksum
+			,:delcert_deleted_at
+			,:delcert_deleted_by
+		) RETURNING delcert_id`
+
+	details, err := json.Marshal(deletionCertificateDetails{
+		DeletedItems:    certificate.DeletedItems,
+		Blobs:           certificate.Blobs,
+		DownloadRecords: certificate.DownloadRecords,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal deletion certificate details: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &deletionCertificateDB{
+		RegistryID:   certificate.RegistryID,
+		RegistryName: certificate.RegistryName,
+		PackageType:  certificate.PackageType,
+		ImageName:    certificate.ImageName,
+		Version:      certificate.Version,
+		Reason:       certificate.Reason,
+		Details:      string(details),
+		Checksum:     certificate.Checksum,
+		DeletedAt:    certificate.DeletedAt.UnixMilli(),
+		DeletedBy:    certificate.DeletedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind deletion certificate object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&certificate.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *deletionCertificateDao) Get(
+	ctx context.Context,
+	registryID int64,
+	id int64,
+) (*types.DeletionCertificate, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(deletionCertificateDB{}), ",")).
+		From("registry_deletion_certificates").
+		Where(sq.Eq{"delcert_registry_id": registryID, "delcert_id": id})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := new(deletionCertificateDB)
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find deletion certificate %d", id)
+	}
+	return mapToDeletionCertificate(dst)
+}
+
+func (dao *deletionCertificateDao) List(
+	ctx context.Context,
+	registryID int64,
+	limit int,
+	offset int,
+) ([]*types.DeletionCertificate, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(deletionCertificateDB{}), ",")).
+		From("registry_deletion_certificates").
+		Where(sq.Eq{"delcert_registry_id": registryID}).
+		OrderBy("delcert_deleted_at DESC", "delcert_id DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*deletionCertificateDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list deletion certificates")
+	}
+
+	certificates := make([]*types.DeletionCertificate, 0, len(dst))
+	for _, d := range dst {
+		certificate, err := mapToDeletionCertificate(d)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+	return certificates, nil
+}
+
+func (dao *deletionCertificateDao) Count(ctx context.Context, registryID int64) (int64, error) {
+	stmt := databaseg.Builder.
+		Select("COUNT(*)").
+		From("registry_deletion_certificates").
+		Where(sq.Eq{"delcert_registry_id": registryID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var count int64
+	if err = dbtx.GetAccessor(ctx, dao.db).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
+	}
+	return count, nil
+}
+
+func mapToDeletionCertificate(d *deletionCertificateDB) (*types.DeletionCertificate, error) {
+	var details deletionCertificateDetails
+	if err := json.Unmarshal([]byte(d.Details), &details); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal details of deletion certificate %d: %w", d.ID, err)
+	}
+	return &types.DeletionCertificate{
+		ID:              d.ID,
+		RegistryID:      d.RegistryID,
+		RegistryName:    d.RegistryName,
+		PackageType:     d.PackageType,
+		ImageName:       d.ImageName,
+		Version:         d.Version,
+		Reason:          d.Reason,
+		DeletedItems:    details.DeletedItems,
+		Blobs:           details.Blobs,
+		DownloadRecords: details.DownloadRecords,
+		DeletedAt:       time.UnixMilli(d.DeletedAt),
+		DeletedBy:       d.DeletedBy,
+		Checksum:        d.Checksum,
+	}, nil
+}
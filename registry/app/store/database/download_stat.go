@@ -18,6 +18,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
@@ -26,33 +27,55 @@ import (
 	databaseg "github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 )
 
 type DownloadStatDao struct {
 	db *sqlx.DB
+	// deduplicationWindow is the time repeated downloads of an artifact by a client aren't unique for,
+	// downloads aren't deduplicated if it's zero.
+	deduplicationWindow time.Duration
+	// recordOrigin is whether the network and region of downloads are stored.
+	recordOrigin bool
 }
 
-func NewDownloadStatDao(db *sqlx.DB) store.DownloadStatRepository {
+func NewDownloadStatDao(
+	db *sqlx.DB,
+	deduplicationWindow time.Duration,
+	recordOrigin bool,
+) store.DownloadStatRepository {
 	return &DownloadStatDao{
-		db: db,
+		db:                  db,
+		deduplicationWindow: deduplicationWindow,
+		recordOrigin:        recordOrigin,
 	}
 }
 
 type downloadStatDB struct {
-	ID         int64 `db:"download_stat_id"`
-	ArtifactID int64 `db:"download_stat_artifact_id"`
-	Timestamp  int64 `db:"download_stat_timestamp"`
-	CreatedAt  int64 `db:"download_stat_created_at"`
-	UpdatedAt  int64 `db:"download_stat_updated_at"`
-	CreatedBy  int64 `db:"download_stat_created_by"`
-	UpdatedBy  int64 `db:"download_stat_updated_by"`
+	ID         int64  `db:"download_stat_id"`
+	ArtifactID int64  `db:"download_stat_artifact_id"`
+	Client     string `db:"download_stat_client"`
+	Unique     bool   `db:"download_stat_unique"`
+	ClientType string `db:"download_stat_client_type"`
+	Network    string `db:"download_stat_network"`
+	Region     string `db:"download_stat_region"`
+	Timestamp  int64  `db:"download_stat_timestamp"`
+	CreatedAt  int64  `db:"download_stat_created_at"`
+	UpdatedAt  int64  `db:"download_stat_updated_at"`
+	CreatedBy  int64  `db:"download_stat_created_by"`
+	UpdatedBy  int64  `db:"download_stat_updated_by"`
 }
 
 func (d DownloadStatDao) Create(ctx context.Context, downloadStat *types.DownloadStat) error {
 	const sqlQuery = `
 		INSERT INTO download_stats ( 
 		         download_stat_artifact_id
+				,download_stat_client
+				,download_stat_unique
+				,download_stat_client_type
+				,download_stat_network
+				,download_stat_region
 				,download_stat_timestamp
 				,download_stat_created_at
 				,download_stat_updated_at
@@ -60,6 +83,11 @@ func (d DownloadStatDao) Create(ctx context.Context, downloadStat *types.Downloa
 				,download_stat_updated_by		
 		    ) VALUES (
 						 :download_stat_artifact_id
+						,:download_stat_client
+						,:download_stat_unique
+						,:download_stat_client_type
+						,:download_stat_network
+						,:download_stat_region
 						,:download_stat_timestamp
 						,:download_stat_created_at
 						,:download_stat_updated_at
@@ -69,6 +97,16 @@ func (d DownloadStatDao) Create(ctx context.Context, downloadStat *types.Downloa
         RETURNING download_stat_id`
 
 	db := dbtx.GetAccessor(ctx, d.db)
+	downloadStat.Unique = !downloadStat.Continuation
+	if downloadStat.Unique && d.deduplicationWindow > 0 {
+		downloaded, err := d.downloadedSince(ctx, downloadStat.ArtifactID, downloadStat.Client,
+			time.Now().Add(-d.deduplicationWindow))
+		if err != nil {
+			return err
+		}
+		downloadStat.Unique = !downloaded
+	}
+
 	query, arg, err := db.BindNamed(sqlQuery, d.mapToInternalDownloadStat(ctx, downloadStat))
 	if err != nil {
 		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind download stat object")
@@ -81,26 +119,323 @@ func (d DownloadStatDao) Create(ctx context.Context, downloadStat *types.Downloa
 	return nil
 }
 
+// CreateBatch inserts downloads recorded earlier in one statement per chunk. A download is unique unless
+// it's a continuation, or the client downloaded the artifact within the deduplication window before its
+// timestamp, either in the stored downloads or earlier in the batch. Downloads of artifacts deleted since
+// they were recorded are dropped.
+func (d DownloadStatDao) CreateBatch(ctx context.Context, downloadStats []*types.DownloadStat) error {
+	downloadStats, err := d.withExistingArtifacts(ctx, downloadStats)
+	if err != nil {
+		return err
+	}
+	lastDownloads := map[downloadClient]time.Time{}
+	rows := make([]*downloadStatDB, 0, len(downloadStats))
+	for _, downloadStat := range downloadStats {
+		downloadStat.Unique = !downloadStat.Continuation
+		if d.deduplicationWindow > 0 {
+			key := downloadClient{artifactID: downloadStat.ArtifactID, client: downloadStat.Client}
+			last, ok := lastDownloads[key]
+			if !ok {
+				if last, err = d.lastDownloadedAt(ctx, key.artifactID, key.client); err != nil {
+					return err
+				}
+			}
+			if downloadStat.Unique && !last.IsZero() {
+				downloadStat.Unique = downloadStat.Timestamp.Sub(last) >= d.deduplicationWindow
+			}
+			if downloadStat.Timestamp.After(last) {
+				last = downloadStat.Timestamp
+			}
+			lastDownloads[key] = last
+		}
+		rows = append(rows, d.mapToInternalDownloadStat(ctx, downloadStat))
+	}
+
+	db := dbtx.GetAccessor(ctx, d.db)
+	for start := 0; start < len(rows); start += downloadStatBatchChunkSize {
+		stmt := databaseg.Builder.Insert("download_stats").Columns(
+			"download_stat_artifact_id",
+			"download_stat_client",
+			"download_stat_unique",
+			"download_stat_client_type",
+			"download_stat_network",
+			"download_stat_region",
+			"download_stat_timestamp",
+			"download_stat_created_at",
+			"download_stat_updated_at",
+			"download_stat_created_by",
+			"download_stat_updated_by",
+		)
+		for _, row := range rows[start:min(start+downloadStatBatchChunkSize, len(rows))] {
+			stmt = stmt.Values(row.ArtifactID, row.Client, row.Unique, row.ClientType, row.Network, row.Region,
+				row.Timestamp, row.CreatedAt, row.UpdatedAt, row.CreatedBy, row.UpdatedBy)
+		}
+
+		sql, args, err := stmt.ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to convert query to sql: %w", err)
+		}
+		if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Batch insert query failed")
+		}
+	}
+	return nil
+}
+
+// withExistingArtifacts drops the downloads of artifacts that were hard deleted after they were recorded.
+func (d DownloadStatDao) withExistingArtifacts(
+	ctx context.Context,
+	downloadStats []*types.DownloadStat,
+) ([]*types.DownloadStat, error) {
+	ids := make([]int64, 0, len(downloadStats))
+	seen := map[int64]bool{}
+	for _, downloadStat := range downloadStats {
+		if !seen[downloadStat.ArtifactID] {
+			seen[downloadStat.ArtifactID] = true
+			ids = append(ids, downloadStat.ArtifactID)
+		}
+	}
+
+	existing := make(map[int64]bool, len(ids))
+	for start := 0; start < len(ids); start += downloadStatBatchChunkSize {
+		sql, args, err := databaseg.Builder.Select("artifact_id").
+			From("artifacts").
+			Where(sq.Eq{"artifact_id": ids[start:min(start+downloadStatBatchChunkSize, len(ids))]}).
+			ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+		}
+		var found []int64
+		if err = dbtx.GetAccessor(ctx, d.db).SelectContext(ctx, &found, sql, args...); err != nil {
+			return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find artifacts")
+		}
+		for _, id := range found {
+			existing[id] = true
+		}
+	}
+	if len(existing) == len(ids) {
+		return downloadStats, nil
+	}
+
+	kept := make([]*types.DownloadStat, 0, len(downloadStats))
+	for _, downloadStat := range downloadStats {
+		if existing[downloadStat.ArtifactID] {
+			kept = append(kept, downloadStat)
+		}
+	}
+	return kept, nil
+}
+
+// downloadStatBatchChunkSize is the number of downloads inserted per statement, keeping the number of bind
+// variables below the limits of the databases.
+const downloadStatBatchChunkSize = 500
+
+type downloadClient struct {
+	artifactID int64
+	client     string
+}
+
+// lastDownloadedAt returns when a client last downloaded an artifact, zero if it never did.
+func (d DownloadStatDao) lastDownloadedAt(ctx context.Context, artifactID int64, client string) (time.Time, error) {
+	stmt := databaseg.Builder.Select("MAX(download_stat_timestamp)").
+		From("download_stats").
+		Where("download_stat_artifact_id = ? AND download_stat_client = ?", artifactID, client)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var last sql.NullInt64
+	if err = dbtx.GetAccessor(ctx, d.db).QueryRowContext(ctx, query, args...).Scan(&last); err != nil {
+		return time.Time{}, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get last download of artifact")
+	}
+	if !last.Valid {
+		return time.Time{}, nil
+	}
+	return time.UnixMilli(last.Int64), nil
+}
+
+// downloadedSince reports whether a client downloaded an artifact since a time.
+func (d DownloadStatDao) downloadedSince(
+	ctx context.Context,
+	artifactID int64,
+	client string,
+	since time.Time,
+) (bool, error) {
+	stmt := databaseg.Builder.Select("COUNT(*)").
+		From("download_stats").
+		Where("download_stat_artifact_id = ? AND download_stat_client = ? AND download_stat_timestamp >= ?",
+			artifactID, client, since.UnixMilli())
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var count int64
+	if err = dbtx.GetAccessor(ctx, d.db).QueryRowContext(ctx, sql, args...).Scan(&count); err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count downloads of artifact")
+	}
+	return count > 0, nil
+}
+
+type clientTypeDownloadCountDB struct {
+	ClientType          string `db:"client_type"`
+	DownloadCount       int64  `db:"download_count"`
+	UniqueDownloadCount int64  `db:"unique_download_count"`
+}
+
+func (d DownloadStatDao) CountByClientType(
+	ctx context.Context,
+	imageID int64,
+	from, to time.Time,
+) ([]types.ClientTypeDownloadCount, error) {
+	stmt := databaseg.Builder.Select(
+		"d.download_stat_client_type AS client_type",
+		"COUNT(*) AS download_count",
+		"SUM(CASE WHEN d.download_stat_unique THEN 1 ELSE 0 END) AS unique_download_count",
+	).
+		From("download_stats d").
+		Join("artifacts a ON a.artifact_id = d.download_stat_artifact_id").
+		Where("a.artifact_image_id = ?", imageID).
+		GroupBy("d.download_stat_client_type").
+		OrderBy("download_count DESC", "client_type")
+	if !from.IsZero() {
+		stmt = stmt.Where("d.download_stat_timestamp >= ?", from.UnixMilli())
+	}
+	if !to.IsZero() {
+		stmt = stmt.Where("d.download_stat_timestamp < ?", to.UnixMilli())
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []clientTypeDownloadCountDB
+	if err = dbtx.GetAccessor(ctx, d.db).SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count downloads by client type")
+	}
+	counts := make([]types.ClientTypeDownloadCount, 0, len(dst))
+	for _, c := range dst {
+		counts = append(counts, types.ClientTypeDownloadCount{
+			ClientType:          c.ClientType,
+			DownloadCount:       c.DownloadCount,
+			UniqueDownloadCount: c.UniqueDownloadCount,
+		})
+	}
+	return counts, nil
+}
+
+type originDownloadCountDB struct {
+	Region              string `db:"region"`
+	Network             string `db:"network"`
+	DownloadCount       int64  `db:"download_count"`
+	UniqueDownloadCount int64  `db:"unique_download_count"`
+}
+
+func (d DownloadStatDao) CountByOrigin(
+	ctx context.Context,
+	registryID int64,
+	from, to time.Time,
+) ([]types.OriginDownloadCount, error) {
+	stmt := databaseg.Builder.Select(
+		"d.download_stat_region AS region",
+		"d.download_stat_network AS network",
+		"COUNT(*) AS download_count",
+		"SUM(CASE WHEN d.download_stat_unique THEN 1 ELSE 0 END) AS unique_download_count",
+	).
+		From("download_stats d").
+		Join("artifacts a ON a.artifact_id = d.download_stat_artifact_id").
+		Join("images i ON i.image_id = a.artifact_image_id").
+		Where("i.image_registry_id = ? AND d.download_stat_network <> ''", registryID).
+		GroupBy("d.download_stat_region", "d.download_stat_network").
+		OrderBy("download_count DESC", "region", "network")
+	if !from.IsZero() {
+		stmt = stmt.Where("d.download_stat_timestamp >= ?", from.UnixMilli())
+	}
+	if !to.IsZero() {
+		stmt = stmt.Where("d.download_stat_timestamp < ?", to.UnixMilli())
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []originDownloadCountDB
+	if err = dbtx.GetAccessor(ctx, d.db).SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count downloads by origin")
+	}
+	counts := make([]types.OriginDownloadCount, 0, len(dst))
+	for _, c := range dst {
+		counts = append(counts, types.OriginDownloadCount{
+			Region:              c.Region,
+			Network:             c.Network,
+			DownloadCount:       c.DownloadCount,
+			UniqueDownloadCount: c.UniqueDownloadCount,
+		})
+	}
+	return counts, nil
+}
+
+func (d DownloadStatDao) DeleteByArtifactID(ctx context.Context, artifactID int64) (int64, error) {
+	stmt := databaseg.Builder.Delete("download_stats").
+		Where("download_stat_artifact_id = ?", artifactID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	result, err := dbtx.GetAccessor(ctx, d.db).ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	return count, nil
+}
+
 func (d DownloadStatDao) mapToInternalDownloadStat(ctx context.Context,
 	in *types.DownloadStat) *downloadStatDB {
-	session, _ := request.AuthSessionFrom(ctx)
-	if in.CreatedAt.IsZero() {
-		in.CreatedAt = time.Now()
+	// downloads recorded in batches carry their principal, as they're stored outside of the request.
+	if session, ok := request.AuthSessionFrom(ctx); ok {
+		if in.CreatedBy == 0 {
+			in.CreatedBy = session.Principal.ID
+		}
+		in.UpdatedBy = session.Principal.ID
 	}
 
-	if in.CreatedBy == 0 {
-		in.CreatedBy = session.Principal.ID
+	now := time.Now()
+	if in.CreatedAt.IsZero() {
+		in.CreatedAt = now
 	}
+	if in.Timestamp.IsZero() {
+		in.Timestamp = now
+	}
+	in.UpdatedAt = now
 
-	in.UpdatedAt = time.Now()
+	var network, region string
+	if d.recordOrigin {
+		network, region = in.Network, in.Region
+	}
 
 	return &downloadStatDB{
 		ID:         in.ID,
 		ArtifactID: in.ArtifactID,
-		Timestamp:  time.Now().UnixMilli(),
+		Client:     in.Client,
+		Unique:     in.Unique,
+		ClientType: in.ClientType,
+		Network:    network,
+		Region:     region,
+		Timestamp:  in.Timestamp.UnixMilli(),
 		CreatedAt:  in.CreatedAt.UnixMilli(),
 		UpdatedAt:  in.UpdatedAt.UnixMilli(),
 		CreatedBy:  in.CreatedBy,
-		UpdatedBy:  session.Principal.ID,
+		UpdatedBy:  in.UpdatedBy,
 	}
 }
@@ -27,6 +27,7 @@ import (
 	databaseg "github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -84,8 +85,8 @@ func (g GenericBlobDao) FindBySha256AndRootParentID(ctx context.Context,
 	*types.GenericBlob, error) {
 	q := databaseg.Builder.
 		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(GenericBlob{}), ",")).
-		From("generic_blob").
-		Where("generic_blob_root_parent_id = ? AND generic_blob_sha256 = ?", rootParentID, sha256)
+		From("generic_blobs").
+		Where("generic_blob_root_parent_id = ? AND generic_blob_sha_256 = ?", rootParentID, sha256)
 
 	db := dbtx.GetAccessor(ctx, g.sqlDB)
 
@@ -143,9 +144,98 @@ func (g GenericBlobDao) Create(ctx context.Context, gb *types.GenericBlob) error
 	return nil
 }
 
-func (g GenericBlobDao) DeleteByID(_ context.Context, _ string) error {
-	// TODO implement me
-	panic("implement me")
+func (g GenericBlobDao) List(ctx context.Context, afterID string, limit int) ([]*types.GenericBlob, error) {
+	q := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(GenericBlob{}), ",")).
+		From("generic_blobs").
+		OrderBy("generic_blob_id").
+		Limit(uint64(limit))
+	if afterID != "" {
+		q = q.Where("generic_blob_id > ?", afterID)
+	}
+
+	return g.list(ctx, q)
+}
+
+func (g GenericBlobDao) ListUnreferenced(
+	ctx context.Context,
+	createdBefore time.Time,
+	afterID string,
+	limit int,
+) ([]*types.GenericBlob, error) {
+	q := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(GenericBlob{}), ",")).
+		From("generic_blobs").
+		Where("generic_blob_created_at < ?", createdBefore.UnixMilli()).
+		Where("NOT EXISTS (SELECT 1 FROM nodes WHERE node_generic_blob_id = generic_blobs.generic_blob_id)").
+		OrderBy("generic_blob_id").
+		Limit(uint64(limit))
+	if afterID != "" {
+		q = q.Where("generic_blob_id > ?", afterID)
+	}
+
+	return g.list(ctx, q)
+}
+
+func (g GenericBlobDao) ListByRegistryID(
+	ctx context.Context,
+	registryID int64,
+	afterID string,
+	limit int,
+) ([]*types.GenericBlob, error) {
+	q := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(GenericBlob{}), ",")).
+		From("generic_blobs").
+		Where("EXISTS (SELECT 1 FROM nodes WHERE node_generic_blob_id = generic_blobs.generic_blob_id "+
+			"AND node_registry_id = ?)", registryID).
+		OrderBy("generic_blob_id").
+		Limit(uint64(limit))
+	if afterID != "" {
+		q = q.Where("generic_blob_id > ?", afterID)
+	}
+
+	return g.list(ctx, q)
+}
+
+func (g GenericBlobDao) list(ctx context.Context, q sq.SelectBuilder) ([]*types.GenericBlob, error) {
+	db := dbtx.GetAccessor(ctx, g.sqlDB)
+
+	dst := []*GenericBlob{}
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list generic blobs")
+	}
+
+	blobs := make([]*types.GenericBlob, 0, len(dst))
+	for _, b := range dst {
+		blob, err := g.mapToGenericBlob(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
+func (g GenericBlobDao) DeleteByID(ctx context.Context, id string) error {
+	stmt := databaseg.Builder.Delete("generic_blobs").
+		Where("generic_blob_id = ?", id)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, g.sqlDB)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to delete generic blob with id %s", id)
+	}
+	return nil
 }
 
 func (g GenericBlobDao) mapToGenericBlob(_ context.Context, dst *GenericBlob) (*types.GenericBlob, error) {
@@ -0,0 +1,171 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type helmPushPolicyDao struct {
+	db *sqlx.DB
+}
+
+func NewHelmPushPolicyDao(db *sqlx.DB) store.HelmPushPolicyRepository {
+	return &helmPushPolicyDao{
+		db: db,
+	}
+}
+
+type helmPushPolicyDB struct {
+	ID                   int64          `db:"hpush_id"`
+	RegistryID           int64          `db:"hpush_registry_id"`
+	RequiredValues       sql.NullString `db:"hpush_required_values"`
+	DisallowedRegistries sql.NullString `db:"hpush_disallowed_registries"`
+	CheckAPIVersions     bool           `db:"hpush_check_api_versions"`
+	KubeVersion          string         `db:"hpush_kube_version"`
+	Enforce              bool           `db:"hpush_enforce"`
+	CreatedAt            int64          `db:"hpush_created_at"`
+	UpdatedAt            int64          `db:"hpush_updated_at"`
+	CreatedBy            int64          `db:"hpush_created_by"`
+	UpdatedBy            int64          `db:"hpush_updated_by"`
+}
+
+func (dao *helmPushPolicyDao) Get(ctx context.Context, registryID int64) (*types.HelmPushPolicy, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(helmPushPolicyDB{}), ",")).
+		From("registry_helm_push_policies").
+		Where(sq.Eq{"hpush_registry_id": registryID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := new(helmPushPolicyDB)
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find Helm push policy")
+	}
+	return mapToHelmPushPolicy(dst), nil
+}
+
+func (dao *helmPushPolicyDao) Upsert(ctx context.Context, policy *types.HelmPushPolicy) error {
+	const sqlQuery = `
+		INSERT INTO registry_helm_push_policies (
+			hpush_registry_id
+			,hpush_required_values
+			,hpush_disallowed_registries
+			,hpush_check_api_versions
+			,hpush_kube_version
+			,hpush_enforce
+			,hpush_created_at
+			,hpush_updated_at
+			,hpush_created_by
+			,hpush_updated_by
+		) VALUES (
+			:hpush_registry_id
+			,:hpush_required_values
+			,:hpush_disallowed_registries
+			,:hpush_check_api_versions
+			,:hpush_kube_version
+			,:hpush_enforce
+			,:hpush_created_at
+			,:hpush_updated_at
+			,:hpush_created_by
+			,:hpush_updated_by
+		)
+		ON CONFLICT (hpush_registry_id)
+		DO UPDATE SET
+			hpush_required_values = :hpush_required_values
+			,hpush_disallowed_registries = :hpush_disallowed_registries
+			,hpush_check_api_versions = :hpush_check_api_versions
+			,hpush_kube_version = :hpush_kube_version
+			,hpush_enforce = :hpush_enforce
+			,hpush_updated_at = :hpush_updated_at
+			,hpush_updated_by = :hpush_updated_by`
+
+	now := time.Now()
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &helmPushPolicyDB{
+		RegistryID:           policy.RegistryID,
+		RequiredValues:       util.GetEmptySQLString(util.ArrToString(policy.RequiredValues)),
+		DisallowedRegistries: util.GetEmptySQLString(util.ArrToString(policy.DisallowedRegistries)),
+		CheckAPIVersions:     policy.CheckAPIVersions,
+		KubeVersion:          policy.KubeVersion,
+		Enforce:              policy.Enforce,
+		CreatedAt:            now.UnixMilli(),
+		UpdatedAt:            now.UnixMilli(),
+		CreatedBy:            policy.UpdatedBy,
+		UpdatedBy:            policy.UpdatedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind Helm push policy object")
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	policy.UpdatedAt = now
+	return nil
+}
+
+func (dao *helmPushPolicyDao) Delete(ctx context.Context, registryID int64) error {
+	query, args, err := databaseg.Builder.Delete("registry_helm_push_policies").
+		Where(sq.Eq{"hpush_registry_id": registryID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	result, err := dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func mapToHelmPushPolicy(dst *helmPushPolicyDB) *types.HelmPushPolicy {
+	return &types.HelmPushPolicy{
+		ID:                   dst.ID,
+		RegistryID:           dst.RegistryID,
+		RequiredValues:       util.StringToArr(dst.RequiredValues.String),
+		DisallowedRegistries: util.StringToArr(dst.DisallowedRegistries.String),
+		CheckAPIVersions:     dst.CheckAPIVersions,
+		KubeVersion:          dst.KubeVersion,
+		Enforce:              dst.Enforce,
+		CreatedAt:            time.UnixMilli(dst.CreatedAt),
+		UpdatedAt:            time.UnixMilli(dst.UpdatedAt),
+		CreatedBy:            dst.CreatedBy,
+		UpdatedBy:            dst.UpdatedBy,
+	}
+}
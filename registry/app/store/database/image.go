@@ -217,6 +217,26 @@ func (i ImageDao) GetByName(ctx context.Context, registryID int64, name string)
 	return i.mapToImage(ctx, dst)
 }
 
+func (i ImageDao) ListNamesByRegistryID(ctx context.Context, registryID int64) ([]string, error) {
+	q := databaseg.Builder.Select("image_name").
+		From("images").
+		Where("image_registry_id = ? AND image_enabled = ?", registryID, true).
+		OrderBy("image_name")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, i.db)
+
+	names := []string{}
+	if err = db.SelectContext(ctx, &names, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list images")
+	}
+	return names, nil
+}
+
 func (i ImageDao) CreateOrUpdate(ctx context.Context, image *types.Image) error {
 	const sqlQuery = `
 		INSERT INTO images ( 
@@ -0,0 +1,188 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type legalHoldDao struct {
+	db *sqlx.DB
+}
+
+func NewLegalHoldDao(db *sqlx.DB) store.LegalHoldRepository {
+	return &legalHoldDao{
+		db: db,
+	}
+}
+
+type legalHoldDB struct {
+	ID         int64  `db:"lhold_id"`
+	RegistryID int64  `db:"lhold_registry_id"`
+	ImageName  string `db:"lhold_image_name"`
+	Version    string `db:"lhold_version"`
+	Reason     string `db:"lhold_reason"`
+	CreatedAt  int64  `db:"lhold_created_at"`
+	CreatedBy  int64  `db:"lhold_created_by"`
+}
+
+func (dao *legalHoldDao) Create(ctx context.Context, hold *types.LegalHold) error {
+	const sqlQuery = `
+		INSERT INTO registry_legal_holds (
+			lhold_registry_id
+			,lhold_image_name
+			,lhold_version
+			,lhold_reason
+			,lhold_created_at
+			,lhold_created_by
+		) VALUES (
+			:lhold_registry_id
+			,:lhold_image_name
+			,:lhold_version
+			,:lhold_reason
+			,:lhold_created_at
+			,:lhold_created_by
+		) RETURNING lhold_id`
+
+	hold.CreatedAt = time.Now()
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &legalHoldDB{
+		RegistryID: hold.RegistryID,
+		ImageName:  hold.ImageName,
+		Version:    hold.Version,
+		Reason:     hold.Reason,
+		CreatedAt:  hold.CreatedAt.UnixMilli(),
+		CreatedBy:  hold.CreatedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind legal hold object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&hold.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *legalHoldDao) Delete(ctx context.Context, registryID int64, id int64) error {
+	stmt := databaseg.Builder.Delete("registry_legal_holds").
+		Where("lhold_registry_id = ? AND lhold_id = ?", registryID, id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *legalHoldDao) List(ctx context.Context, registryID int64) ([]*types.LegalHold, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(legalHoldDB{}), ",")).
+		From("registry_legal_holds").
+		Where(sq.Eq{"lhold_registry_id": registryID}).
+		OrderBy("lhold_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*legalHoldDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list legal holds")
+	}
+
+	holds := make([]*types.LegalHold, 0, len(dst))
+	for _, d := range dst {
+		holds = append(holds, mapToLegalHold(d))
+	}
+	return holds, nil
+}
+
+func (dao *legalHoldDao) FindBlocking(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	versions []string,
+) (*types.LegalHold, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(legalHoldDB{}), ",")).
+		From("registry_legal_holds").
+		Where(sq.Eq{"lhold_registry_id": registryID}).
+		OrderBy("lhold_id").
+		Limit(1)
+	if imageName != "" {
+		stmt = stmt.Where(sq.Eq{"lhold_image_name": imageName})
+		if len(versions) > 0 {
+			stmt = stmt.Where(sq.Or{sq.Eq{"lhold_version": ""}, sq.Eq{"lhold_version": versions}})
+		}
+	}
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := new(legalHoldDB)
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, dst, query, args...); err != nil {
+		err = databaseg.ProcessSQLErrorf(ctx, err, "Failed to find legal hold")
+		if errors.Is(err, gitness_store.ErrResourceNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return mapToLegalHold(dst), nil
+}
+
+func mapToLegalHold(d *legalHoldDB) *types.LegalHold {
+	return &types.LegalHold{
+		ID:         d.ID,
+		RegistryID: d.RegistryID,
+		ImageName:  d.ImageName,
+		Version:    d.Version,
+		Reason:     d.Reason,
+		CreatedAt:  time.UnixMilli(d.CreatedAt),
+		CreatedBy:  d.CreatedBy,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
@@ -30,6 +31,7 @@ import (
 	"github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 	"github.com/opencontainers/go-digest"
 	errors2 "github.com/pkg/errors"
@@ -208,12 +210,22 @@ func (dao manifestDao) Count(_ context.Context) (int, error) {
 	panic("implement me")
 }
 
+// LayerBlobs finds the blobs of the layers of a manifest, in the order they were associated with it.
 func (dao manifestDao) LayerBlobs(
-	_ context.Context,
-	_ *types.Manifest,
+	ctx context.Context,
+	m *types.Manifest,
 ) (types.Blobs, error) {
-	// TODO implement me
-	panic("implement me")
+	stmt := PrimaryQuery.
+		Join("layers ON layer_blob_id = blobs.blob_id").
+		Where("layer_registry_id = ?", m.RegistryID).
+		Where("layer_manifest_id = ?", m.ID).
+		OrderBy("layer_id")
+
+	blobs, err := blobDao{db: dao.sqlDB}.list(ctx, stmt)
+	if err != nil {
+		return nil, fmt.Errorf("finding layer blobs of manifest %s: %w", m.Digest, err)
+	}
+	return blobs, nil
 }
 
 // References finds all manifests directly referenced by a manifest (if any).
@@ -270,7 +282,7 @@ func (dao manifestDao) Create(ctx context.Context, m *types.Manifest) error {
 		}
 	}
 	m.ID = manifest.ID
-	return nil
+	return dao.createAnnotations(ctx, m)
 }
 
 func (dao manifestDao) CreateOrFind(ctx context.Context, m *types.Manifest) error {
@@ -310,7 +322,7 @@ func (dao manifestDao) CreateOrFind(ctx context.Context, m *types.Manifest) erro
 	}
 
 	m.ID = manifest.ID
-	return nil
+	return dao.createAnnotations(ctx, m)
 }
 
 func (dao manifestDao) AssociateLayerBlob(
@@ -480,6 +492,256 @@ func (dao manifestDao) ListManifestsBySubjectDigest(
 	return *result, err
 }
 
+func (dao manifestDao) ListReferrersCreatedAfter(
+	ctx context.Context, rootParentID int64,
+	createdAfter time.Time,
+) (types.Manifests, error) {
+	stmt := ReadQuery.
+		Where("manifest_subject_digest IS NOT NULL").
+		Where("manifest_created_at > ?", createdAfter.UnixMilli()).
+		Where("manifest_registry_id IN (SELECT registry_id FROM registries WHERE registry_root_parent_id = ?)",
+			rootParentID)
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+
+	dst := []*manifestMetadataDB{}
+	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list referrers")
+	}
+
+	result, err := dao.mapToManifests(dst)
+	if err != nil {
+		return nil, fmt.Errorf("finding referrers created after %s: %w", createdAfter, err)
+	}
+	return *result, nil
+}
+
+func (dao manifestDao) ListManifestsByAnnotation(
+	ctx context.Context, repoID int64,
+	key string, value *string, limit int, offset int,
+) (types.Manifests, error) {
+	stmt := annotationCondition(
+		ReadQuery.LeftJoin("blobs ON manifest_configuration_blob_id = blob_id"),
+		repoID, key, value,
+	).
+		OrderBy("manifest_created_at DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+
+	dst := []*manifestMetadataDB{}
+	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list manifests")
+	}
+
+	result, err := dao.mapToManifests(dst)
+	if err != nil {
+		return nil, fmt.Errorf("finding manifests by annotation: %w", err)
+	}
+	return *result, nil
+}
+
+func (dao manifestDao) CountManifestsByAnnotation(
+	ctx context.Context, repoID int64,
+	key string, value *string,
+) (int64, error) {
+	stmt := annotationCondition(
+		database.Builder.Select("COUNT(*)").From("manifests"),
+		repoID, key, value,
+	)
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return -1, errors2.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+
+	var count int64
+	if err = db.QueryRowContext(ctx, toSQL, args...).Scan(&count); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "Failed executing count query")
+	}
+	return count, nil
+}
+
+// signatureArtifactTypes are the artifact types of the referrers signing a manifest: cosign and
+// sigstore bundles, and notation signatures.
+var signatureArtifactTypes = []string{
+	"application/vnd.dev.cosign.artifact.sig.v1+json",
+	"application/vnd.dev.sigstore.bundle.v0.3+json",
+	"application/vnd.dev.sigstore.bundle+json;version=0.3",
+	"application/vnd.cncf.notary.signature",
+}
+
+type manifestSigningStateDB struct {
+	ManifestID int64  `db:"manifest_id"`
+	ImageName  string `db:"manifest_image_name"`
+	Digest     []byte `db:"manifest_digest"`
+	Signed     bool   `db:"signed"`
+}
+
+func (dao manifestDao) ListSigningStates(ctx context.Context, repoID int64) ([]*types.ManifestSigningState, error) {
+	signed := sq.Expr(`EXISTS (
+		SELECT 1 FROM manifests s
+		WHERE s.manifest_registry_id = m.manifest_registry_id
+			AND s.manifest_image_name = m.manifest_image_name
+			AND s.manifest_subject_digest = m.manifest_digest
+			AND (s.manifest_artifact_media_type IN (`+sq.Placeholders(len(signatureArtifactTypes))+`)
+				OR s.manifest_configuration_media_type IN (`+sq.Placeholders(len(signatureArtifactTypes))+`))
+		) AS signed`, append(toInterfaces(signatureArtifactTypes), toInterfaces(signatureArtifactTypes)...)...)
+
+	stmt := database.Builder.
+		Select("m.manifest_id", "m.manifest_image_name", "m.manifest_digest").
+		Column(signed).
+		From("manifests m").
+		Where("m.manifest_registry_id = ? AND m.manifest_subject_id IS NULL", repoID).
+		OrderBy("m.manifest_id")
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+
+	dst := []*manifestSigningStateDB{}
+	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list manifest signing states")
+	}
+
+	states := make([]*types.ManifestSigningState, 0, len(dst))
+	for _, d := range dst {
+		dgst, err := types.Digest(util.GetHexEncodedString(d.Digest)).Parse()
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, &types.ManifestSigningState{
+			ManifestID: d.ManifestID,
+			ImageName:  d.ImageName,
+			Digest:     dgst,
+			Signed:     d.Signed,
+		})
+	}
+	return states, nil
+}
+
+type manifestLocationDB struct {
+	RegistryID int64  `db:"manifest_registry_id"`
+	ImageName  string `db:"manifest_image_name"`
+	Tag        string `db:"tag_name"`
+}
+
+// ListLocationsByDigest lists the images of the registries holding the manifest with the digest, either
+// directly or through an index referencing it, along with the tags pointing to the manifest or the index.
+// Images without tags are listed with an empty tag.
+func (dao manifestDao) ListLocationsByDigest(
+	ctx context.Context,
+	registryIDs []int64,
+	digest types.Digest,
+) ([]*types.ManifestLocation, error) {
+	if len(registryIDs) == 0 {
+		return nil, nil
+	}
+	digestBytes, err := util.GetHexDecodedBytes(string(digest))
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := database.Builder.
+		Select("DISTINCT m.manifest_registry_id", "m.manifest_image_name", "COALESCE(t.tag_name, '') AS tag_name").
+		From("manifests m").
+		LeftJoin("manifest_references r ON r.manifest_ref_child_id = m.manifest_id").
+		LeftJoin("tags t ON t.tag_manifest_id = m.manifest_id OR t.tag_manifest_id = r.manifest_ref_parent_id").
+		Where(sq.Eq{"m.manifest_registry_id": registryIDs}).
+		Where("m.manifest_digest = ?", digestBytes).
+		OrderBy("m.manifest_registry_id", "m.manifest_image_name", "tag_name")
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert manifest query to sql: %w", err)
+	}
+
+	dst := []*manifestLocationDB{}
+	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list manifest locations")
+	}
+
+	locations := make([]*types.ManifestLocation, 0, len(dst))
+	for _, d := range dst {
+		locations = append(locations, &types.ManifestLocation{
+			RegistryID: d.RegistryID,
+			ImageName:  d.ImageName,
+			Tag:        d.Tag,
+		})
+	}
+	return locations, nil
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		result = append(result, v)
+	}
+	return result
+}
+
+// annotationCondition limits the manifests of the registry to those having an annotation with the key
+// and, if not nil, value, searching the annotations indexed when the manifests were created.
+func annotationCondition(
+	stmt sq.SelectBuilder,
+	repoID int64, key string, value *string,
+) sq.SelectBuilder {
+	stmt = stmt.Join("manifest_annotations ON mann_manifest_id = manifest_id").
+		Where("mann_registry_id = ? AND mann_key = ?", repoID, key).
+		Where("manifest_registry_id = ?", repoID)
+	if value != nil {
+		stmt = stmt.Where("mann_value = ?", *value)
+	}
+	return stmt
+}
+
+// createAnnotations indexes the annotations of a new manifest, see annotationCondition.
+func (dao manifestDao) createAnnotations(ctx context.Context, m *types.Manifest) error {
+	if m.ID == 0 || len(m.Annotations) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m.Annotations))
+	for key := range m.Annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	stmt := database.Builder.Insert("manifest_annotations").
+		Columns("mann_manifest_id", "mann_registry_id", "mann_key", "mann_value")
+	for _, key := range keys {
+		stmt = stmt.Values(m.ID, m.RegistryID, key, m.Annotations[key])
+	}
+
+	toSQL, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert manifest annotations query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.sqlDB)
+	if _, err = db.ExecContext(ctx, toSQL, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to create manifest annotations")
+	}
+	return nil
+}
+
 // FindManifestByTagName finds a manifest by tag name within a repository.
 func (dao manifestDao) FindManifestByTagName(
 	ctx context.Context, repoID int64,
@@ -620,7 +882,7 @@ func (dao manifestDao) ListManifestsBySubject(
 	dst := []*manifestMetadataDB{}
 	db := dbtx.GetAccessor(ctx, dao.sqlDB)
 
-	if err = db.SelectContext(ctx, dst, toSQL, args...); err != nil {
+	if err = db.SelectContext(ctx, &dst, toSQL, args...); err != nil {
 		err := database.ProcessSQLErrorf(ctx, err, "Failed to find manifest")
 		return nil, err
 	}
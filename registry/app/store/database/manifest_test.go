@@ -0,0 +1,122 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/registry/app/manifest/schema2"
+	"github.com/harness/gitness/registry/types"
+	gitnesstypes "github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSessionContext() context.Context {
+	return request.WithAuthSession(context.Background(), &auth.Session{Principal: gitnesstypes.Principal{ID: 1}})
+}
+
+func createTestManifest(
+	ctx context.Context, t *testing.T, db *sqlx.DB,
+	registryID int64, imageName string, content string, annotations types.JSONB,
+) *types.Manifest {
+	t.Helper()
+	m := &types.Manifest{
+		RegistryID:    registryID,
+		ImageName:     imageName,
+		SchemaVersion: 2,
+		MediaType:     schema2.MediaTypeManifest,
+		Digest:        digest.FromString(content),
+		Payload:       types.Payload(content),
+		Annotations:   annotations,
+	}
+	require.NoError(t, NewManifestDao(db, NewMediaTypesDao(db)).Create(ctx, m))
+	require.NotZero(t, m.ID)
+	return m
+}
+
+func manifestDigests(manifests types.Manifests) []string {
+	digests := make([]string, 0, len(manifests))
+	for _, m := range manifests {
+		digests = append(digests, m.Digest.String())
+	}
+	return digests
+}
+
+func TestListManifestsByAnnotation(t *testing.T) {
+	db := setupPlanDB(t)
+	ctx := testSessionContext()
+	manifests := NewManifestDao(db, NewMediaTypesDao(db))
+
+	release := createTestManifest(ctx, t, db, 1, "app", "release", types.JSONB{
+		"org.opencontainers.image.revision": "abc123",
+		"com.example.channel":               "stable",
+	})
+	nightly := createTestManifest(ctx, t, db, 1, "app", "nightly", types.JSONB{
+		"org.opencontainers.image.revision": "def456",
+		"com.example.channel":               `stable","x":"`,
+	})
+	createTestManifest(ctx, t, db, 1, "app", "plain", nil)
+	createTestManifest(ctx, t, db, 2, "app", "other", types.JSONB{"org.opencontainers.image.revision": "abc123"})
+
+	key := "org.opencontainers.image.revision"
+	found, err := manifests.ListManifestsByAnnotation(ctx, 1, key, nil, 10, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{release.Digest.String(), nightly.Digest.String()}, manifestDigests(found))
+	for _, m := range found {
+		if m.Digest == release.Digest {
+			assert.Equal(t, release.Annotations, m.Annotations)
+		}
+	}
+	count, err := manifests.CountManifestsByAnnotation(ctx, 1, key, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+
+	value := "abc123"
+	found, err = manifests.ListManifestsByAnnotation(ctx, 1, key, &value, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{release.Digest.String()}, manifestDigests(found))
+
+	// the value is matched exactly, not as a part of the stored annotations
+	value = "stable"
+	found, err = manifests.ListManifestsByAnnotation(ctx, 1, "com.example.channel", &value, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{release.Digest.String()}, manifestDigests(found))
+	value = "abc"
+	count, err = manifests.CountManifestsByAnnotation(ctx, 1, key, &value)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	count, err = manifests.CountManifestsByAnnotation(ctx, 1, "org.opencontainers.image", nil)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	found, err = manifests.ListManifestsByAnnotation(ctx, 1, key, nil, 1, 1)
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+}
+
+func TestManifestsByAnnotationQueryPlan(t *testing.T) {
+	db := setupPlanDB(t)
+	value := "abc123"
+	plan := queryPlan(t, db, annotationCondition(ReadQuery, 1, "org.opencontainers.image.revision", &value))
+	assertNoTableScans(t, plan)
+	assertUsesIndex(t, plan, "index_mann_on_registry_id_and_key")
+}
@@ -0,0 +1,221 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type mavenRelocationDao struct {
+	db *sqlx.DB
+}
+
+func NewMavenRelocationDao(db *sqlx.DB) store.MavenRelocationRepository {
+	return &mavenRelocationDao{
+		db: db,
+	}
+}
+
+type mavenRelocationDB struct {
+	ID               int64  `db:"mvnreloc_id"`
+	RegistryID       int64  `db:"mvnreloc_registry_id"`
+	GroupID          string `db:"mvnreloc_group_id"`
+	ArtifactID       string `db:"mvnreloc_artifact_id"`
+	TargetGroupID    string `db:"mvnreloc_target_group_id"`
+	TargetArtifactID string `db:"mvnreloc_target_artifact_id"`
+	TargetVersion    string `db:"mvnreloc_target_version"`
+	Message          string `db:"mvnreloc_message"`
+	CreatedAt        int64  `db:"mvnreloc_created_at"`
+	UpdatedAt        int64  `db:"mvnreloc_updated_at"`
+	CreatedBy        int64  `db:"mvnreloc_created_by"`
+	UpdatedBy        int64  `db:"mvnreloc_updated_by"`
+}
+
+func (dao *mavenRelocationDao) Upsert(ctx context.Context, relocation *types.MavenRelocation) error {
+	const sqlQuery = `
+		INSERT INTO registry_maven_relocations (
+			mvnreloc_registry_id
+			,mvnreloc_group_id
+			,mvnreloc_artifact_id
+			,mvnreloc_target_group_id
+			,mvnreloc_target_artifact_id
+			,mvnreloc_target_version
+			,mvnreloc_message
+			,mvnreloc_created_at
+			,mvnreloc_updated_at
+			,mvnreloc_created_by
+			,mvnreloc_updated_by
+		) VALUES (
+			:mvnreloc_registry_id
+			,:mvnreloc_group_id
+			,:mvnreloc_artifact_id
+			,:mvnreloc_target_group_id
+			,:mvnreloc_target_artifact_id
+			,:mvnreloc_target_version
+			,:mvnreloc_message
+			,:mvnreloc_created_at
+			,:mvnreloc_updated_at
+			,:mvnreloc_created_by
+			,:mvnreloc_updated_by
+		)
+		ON CONFLICT (mvnreloc_registry_id, mvnreloc_group_id, mvnreloc_artifact_id)
+		DO UPDATE SET
+			mvnreloc_target_group_id = :mvnreloc_target_group_id
+			,mvnreloc_target_artifact_id = :mvnreloc_target_artifact_id
+			,mvnreloc_target_version = :mvnreloc_target_version
+			,mvnreloc_message = :mvnreloc_message
+			,mvnreloc_updated_at = :mvnreloc_updated_at
+			,mvnreloc_updated_by = :mvnreloc_updated_by
+		RETURNING mvnreloc_id, mvnreloc_created_at, mvnreloc_created_by`
+
+	now := time.Now()
+	if relocation.CreatedAt.IsZero() {
+		relocation.CreatedAt = now
+	}
+	relocation.UpdatedAt = now
+	if relocation.UpdatedBy == 0 {
+		relocation.UpdatedBy = relocation.CreatedBy
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalMavenRelocation(relocation))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind maven relocation object")
+	}
+	var createdAt int64
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&relocation.ID, &createdAt,
+		&relocation.CreatedBy); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	relocation.CreatedAt = time.UnixMilli(createdAt)
+	return nil
+}
+
+func (dao *mavenRelocationDao) Delete(ctx context.Context, registryID int64, id int64) error {
+	stmt := databaseg.Builder.Delete("registry_maven_relocations").
+		Where("mvnreloc_registry_id = ? AND mvnreloc_id = ?", registryID, id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *mavenRelocationDao) Get(
+	ctx context.Context,
+	registryID int64,
+	groupID string,
+	artifactID string,
+) (*types.MavenRelocation, error) {
+	relocations, err := dao.list(ctx, sq.Eq{
+		"mvnreloc_registry_id": registryID,
+		"mvnreloc_group_id":    groupID,
+		"mvnreloc_artifact_id": artifactID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(relocations) == 0 {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return relocations[0], nil
+}
+
+func (dao *mavenRelocationDao) List(ctx context.Context, registryID int64) ([]*types.MavenRelocation, error) {
+	return dao.list(ctx, sq.Eq{"mvnreloc_registry_id": registryID})
+}
+
+func (dao *mavenRelocationDao) list(ctx context.Context, where sq.Eq) ([]*types.MavenRelocation, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(mavenRelocationDB{}), ",")).
+		From("registry_maven_relocations").
+		Where(where).
+		OrderBy("mvnreloc_group_id", "mvnreloc_artifact_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*mavenRelocationDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list maven relocations")
+	}
+
+	relocations := make([]*types.MavenRelocation, 0, len(dst))
+	for _, d := range dst {
+		relocations = append(relocations, &types.MavenRelocation{
+			ID:               d.ID,
+			RegistryID:       d.RegistryID,
+			GroupID:          d.GroupID,
+			ArtifactID:       d.ArtifactID,
+			TargetGroupID:    d.TargetGroupID,
+			TargetArtifactID: d.TargetArtifactID,
+			TargetVersion:    d.TargetVersion,
+			Message:          d.Message,
+			CreatedAt:        time.UnixMilli(d.CreatedAt),
+			UpdatedAt:        time.UnixMilli(d.UpdatedAt),
+			CreatedBy:        d.CreatedBy,
+			UpdatedBy:        d.UpdatedBy,
+		})
+	}
+	return relocations, nil
+}
+
+func mapToInternalMavenRelocation(in *types.MavenRelocation) *mavenRelocationDB {
+	return &mavenRelocationDB{
+		ID:               in.ID,
+		RegistryID:       in.RegistryID,
+		GroupID:          in.GroupID,
+		ArtifactID:       in.ArtifactID,
+		TargetGroupID:    in.TargetGroupID,
+		TargetArtifactID: in.TargetArtifactID,
+		TargetVersion:    in.TargetVersion,
+		Message:          in.Message,
+		CreatedAt:        in.CreatedAt.UnixMilli(),
+		UpdatedAt:        in.UpdatedAt.UnixMilli(),
+		CreatedBy:        in.CreatedBy,
+		UpdatedBy:        in.UpdatedBy,
+	}
+}
@@ -0,0 +1,531 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type mirrorSyncDao struct {
+	db *sqlx.DB
+}
+
+func NewMirrorSyncDao(db *sqlx.DB) store.MirrorSyncRepository {
+	return &mirrorSyncDao{
+		db: db,
+	}
+}
+
+type mirrorSyncDB struct {
+	ID                 int64  `db:"msync_id"`
+	RegistryID         int64  `db:"msync_registry_id"`
+	UpstreamRegistryID int64  `db:"msync_upstream_registry_id"`
+	Repository         string `db:"msync_repository"`
+	TagPattern         string `db:"msync_tag_pattern"`
+	IntervalMinutes    int    `db:"msync_interval_minutes"`
+	Enabled            bool   `db:"msync_enabled"`
+	NextRunAt          int64  `db:"msync_next_run_at"`
+	CreatedAt          int64  `db:"msync_created_at"`
+	UpdatedAt          int64  `db:"msync_updated_at"`
+	CreatedBy          int64  `db:"msync_created_by"`
+	UpdatedBy          int64  `db:"msync_updated_by"`
+}
+
+type mirrorSyncRunDB struct {
+	ID         int64  `db:"msrun_id"`
+	SyncID     int64  `db:"msrun_sync_id"`
+	Status     string `db:"msrun_status"`
+	Trigger    string `db:"msrun_trigger"`
+	Tags       int    `db:"msrun_tags"`
+	Synced     int    `db:"msrun_synced"`
+	Skipped    int    `db:"msrun_skipped"`
+	Failed     int    `db:"msrun_failed"`
+	Error      string `db:"msrun_error"`
+	StartedAt  int64  `db:"msrun_started_at"`
+	FinishedAt int64  `db:"msrun_finished_at"`
+	CreatedAt  int64  `db:"msrun_created_at"`
+	CreatedBy  int64  `db:"msrun_created_by"`
+}
+
+type mirrorSyncArtifactDB struct {
+	ID        int64  `db:"msart_id"`
+	RunID     int64  `db:"msart_run_id"`
+	Tag       string `db:"msart_tag"`
+	Digest    string `db:"msart_digest"`
+	Status    string `db:"msart_status"`
+	Error     string `db:"msart_error"`
+	UpdatedAt int64  `db:"msart_updated_at"`
+}
+
+func (dao *mirrorSyncDao) Create(ctx context.Context, sync *types.MirrorSync) error {
+	const sqlQuery = `
+		INSERT INTO registry_mirror_syncs (
+			msync_registry_id
+			,msync_upstream_registry_id
+			,msync_repository
+			,msync_tag_pattern
+			,msync_interval_minutes
+			,msync_enabled
+			,msync_next_run_at
+			,msync_created_at
+			,msync_updated_at
+			,msync_created_by
+			,msync_updated_by
+		) VALUES (
+			:msync_registry_id
+			,:msync_upstream_registry_id
+			,:msync_repository
+			,:msync_tag_pattern
+			,:msync_interval_minutes
+			,:msync_enabled
+			,:msync_next_run_at
+			,:msync_created_at
+			,:msync_updated_at
+			,:msync_created_by
+			,:msync_updated_by
+		) RETURNING msync_id`
+
+	now := time.Now()
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &mirrorSyncDB{
+		RegistryID:         sync.RegistryID,
+		UpstreamRegistryID: sync.UpstreamRegistryID,
+		Repository:         sync.Repository,
+		TagPattern:         sync.TagPattern,
+		IntervalMinutes:    sync.IntervalMinutes,
+		Enabled:            sync.Enabled,
+		NextRunAt:          sync.NextRunAt.UnixMilli(),
+		CreatedAt:          now.UnixMilli(),
+		UpdatedAt:          now.UnixMilli(),
+		CreatedBy:          sync.CreatedBy,
+		UpdatedBy:          sync.CreatedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind mirror sync object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&sync.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	sync.CreatedAt = now
+	sync.UpdatedAt = now
+	sync.UpdatedBy = sync.CreatedBy
+	return nil
+}
+
+func (dao *mirrorSyncDao) Get(ctx context.Context, registryID int64, id int64) (*types.MirrorSync, error) {
+	return dao.get(ctx, sq.Eq{"msync_registry_id": registryID, "msync_id": id})
+}
+
+func (dao *mirrorSyncDao) GetByID(ctx context.Context, id int64) (*types.MirrorSync, error) {
+	return dao.get(ctx, sq.Eq{"msync_id": id})
+}
+
+func (dao *mirrorSyncDao) get(ctx context.Context, where sq.Eq) (*types.MirrorSync, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(mirrorSyncDB{}), ",")).
+		From("registry_mirror_syncs").
+		Where(where)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := new(mirrorSyncDB)
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find mirror sync")
+	}
+	return mapToMirrorSync(dst), nil
+}
+
+func (dao *mirrorSyncDao) List(ctx context.Context, registryID int64) ([]*types.MirrorSync, error) {
+	return dao.list(ctx, databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(mirrorSyncDB{}), ",")).
+		From("registry_mirror_syncs").
+		Where(sq.Eq{"msync_registry_id": registryID}).
+		OrderBy("msync_repository"))
+}
+
+func (dao *mirrorSyncDao) ListDue(ctx context.Context, now time.Time) ([]*types.MirrorSync, error) {
+	return dao.list(ctx, databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(mirrorSyncDB{}), ",")).
+		From("registry_mirror_syncs").
+		Where(sq.Eq{"msync_enabled": true}).
+		Where(sq.LtOrEq{"msync_next_run_at": now.UnixMilli()}).
+		OrderBy("msync_next_run_at"))
+}
+
+func (dao *mirrorSyncDao) list(ctx context.Context, stmt sq.SelectBuilder) ([]*types.MirrorSync, error) {
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*mirrorSyncDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list mirror syncs")
+	}
+
+	syncs := make([]*types.MirrorSync, 0, len(dst))
+	for _, d := range dst {
+		syncs = append(syncs, mapToMirrorSync(d))
+	}
+	return syncs, nil
+}
+
+func (dao *mirrorSyncDao) Update(ctx context.Context, sync *types.MirrorSync) error {
+	now := time.Now()
+	stmt := databaseg.Builder.Update("registry_mirror_syncs").
+		Set("msync_upstream_registry_id", sync.UpstreamRegistryID).
+		Set("msync_tag_pattern", sync.TagPattern).
+		Set("msync_interval_minutes", sync.IntervalMinutes).
+		Set("msync_enabled", sync.Enabled).
+		Set("msync_next_run_at", sync.NextRunAt.UnixMilli()).
+		Set("msync_updated_at", now.UnixMilli()).
+		Set("msync_updated_by", sync.UpdatedBy).
+		Where(sq.Eq{"msync_id": sync.ID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update mirror sync")
+	}
+	sync.UpdatedAt = now
+	return nil
+}
+
+func (dao *mirrorSyncDao) Delete(ctx context.Context, registryID int64, id int64) error {
+	query, args, err := databaseg.Builder.Delete("registry_mirror_syncs").
+		Where(sq.Eq{"msync_registry_id": registryID, "msync_id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	result, err := dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *mirrorSyncDao) SetNextRunAt(ctx context.Context, id int64, nextRunAt time.Time) error {
+	stmt := databaseg.Builder.Update("registry_mirror_syncs").
+		Set("msync_next_run_at", nextRunAt.UnixMilli()).
+		Where(sq.Eq{"msync_id": id})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update next run of mirror sync")
+	}
+	return nil
+}
+
+func (dao *mirrorSyncDao) CreateRun(ctx context.Context, run *types.MirrorSyncRun) error {
+	const sqlQuery = `
+		INSERT INTO registry_mirror_sync_runs (
+			msrun_sync_id
+			,msrun_status
+			,msrun_trigger
+			,msrun_created_at
+			,msrun_created_by
+		) VALUES (
+			:msrun_sync_id
+			,:msrun_status
+			,:msrun_trigger
+			,:msrun_created_at
+			,:msrun_created_by
+		) RETURNING msrun_id`
+
+	now := time.Now()
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &mirrorSyncRunDB{
+		SyncID:    run.SyncID,
+		Status:    string(run.Status),
+		Trigger:   string(run.Trigger),
+		CreatedAt: now.UnixMilli(),
+		CreatedBy: run.CreatedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind mirror sync run object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&run.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	run.CreatedAt = now
+	return nil
+}
+
+func (dao *mirrorSyncDao) GetRun(ctx context.Context, syncID int64, runID int64) (*types.MirrorSyncRun, error) {
+	return dao.getRun(ctx, sq.Eq{"msrun_sync_id": syncID, "msrun_id": runID})
+}
+
+func (dao *mirrorSyncDao) GetRunByID(ctx context.Context, runID int64) (*types.MirrorSyncRun, error) {
+	return dao.getRun(ctx, sq.Eq{"msrun_id": runID})
+}
+
+func (dao *mirrorSyncDao) getRun(ctx context.Context, where sq.Eq) (*types.MirrorSyncRun, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(mirrorSyncRunDB{}), ",")).
+		From("registry_mirror_sync_runs").
+		Where(where)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := new(mirrorSyncRunDB)
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find mirror sync run")
+	}
+	return mapToMirrorSyncRun(dst), nil
+}
+
+func (dao *mirrorSyncDao) ListRuns(ctx context.Context, syncID int64, limit int) ([]*types.MirrorSyncRun, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(mirrorSyncRunDB{}), ",")).
+		From("registry_mirror_sync_runs").
+		Where(sq.Eq{"msrun_sync_id": syncID}).
+		OrderBy("msrun_id DESC").
+		Limit(util.SafeIntToUInt64(limit))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*mirrorSyncRunDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list mirror sync runs")
+	}
+
+	runs := make([]*types.MirrorSyncRun, 0, len(dst))
+	for _, d := range dst {
+		runs = append(runs, mapToMirrorSyncRun(d))
+	}
+	return runs, nil
+}
+
+func (dao *mirrorSyncDao) HasActiveRun(ctx context.Context, syncID int64) (bool, error) {
+	stmt := databaseg.Builder.
+		Select("COUNT(*)").
+		From("registry_mirror_sync_runs").
+		Where(sq.Eq{
+			"msrun_sync_id": syncID,
+			"msrun_status": []string{
+				string(types.MirrorSyncRunStatusPending),
+				string(types.MirrorSyncRunStatusRunning),
+			},
+		})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var count int64
+	if err = dbtx.GetAccessor(ctx, dao.db).QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count active mirror sync runs")
+	}
+	return count > 0, nil
+}
+
+func (dao *mirrorSyncDao) UpdateRun(ctx context.Context, run *types.MirrorSyncRun) error {
+	stmt := databaseg.Builder.Update("registry_mirror_sync_runs").
+		Set("msrun_status", string(run.Status)).
+		Set("msrun_tags", run.Tags).
+		Set("msrun_synced", run.Synced).
+		Set("msrun_skipped", run.Skipped).
+		Set("msrun_failed", run.Failed).
+		Set("msrun_error", run.Error).
+		Set("msrun_started_at", unixMilliOrZero(run.StartedAt)).
+		Set("msrun_finished_at", unixMilliOrZero(run.FinishedAt)).
+		Where(sq.Eq{"msrun_id": run.ID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update mirror sync run")
+	}
+	return nil
+}
+
+func (dao *mirrorSyncDao) CreateArtifacts(ctx context.Context, artifacts []*types.MirrorSyncArtifact) error {
+	const sqlQuery = `
+		INSERT INTO registry_mirror_sync_artifacts (
+			msart_run_id
+			,msart_tag
+			,msart_digest
+			,msart_status
+			,msart_error
+			,msart_updated_at
+		) VALUES (
+			:msart_run_id
+			,:msart_tag
+			,:msart_digest
+			,:msart_status
+			,:msart_error
+			,:msart_updated_at
+		)
+		ON CONFLICT (msart_run_id, msart_tag) DO NOTHING`
+
+	now := time.Now()
+	db := dbtx.GetAccessor(ctx, dao.db)
+	for _, a := range artifacts {
+		a.UpdatedAt = now
+		query, args, err := db.BindNamed(sqlQuery, &mirrorSyncArtifactDB{
+			RunID:     a.RunID,
+			Tag:       a.Tag,
+			Digest:    a.Digest,
+			Status:    string(a.Status),
+			Error:     a.Error,
+			UpdatedAt: now.UnixMilli(),
+		})
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind mirror sync artifact object")
+		}
+		if _, err = db.ExecContext(ctx, query, args...); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+		}
+	}
+	return nil
+}
+
+func (dao *mirrorSyncDao) ListArtifacts(ctx context.Context, runID int64) ([]*types.MirrorSyncArtifact, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(mirrorSyncArtifactDB{}), ",")).
+		From("registry_mirror_sync_artifacts").
+		Where(sq.Eq{"msart_run_id": runID}).
+		OrderBy("msart_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*mirrorSyncArtifactDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list mirror sync artifacts")
+	}
+
+	artifacts := make([]*types.MirrorSyncArtifact, 0, len(dst))
+	for _, d := range dst {
+		artifacts = append(artifacts, &types.MirrorSyncArtifact{
+			ID:        d.ID,
+			RunID:     d.RunID,
+			Tag:       d.Tag,
+			Digest:    d.Digest,
+			Status:    types.MirrorSyncArtifactStatus(d.Status),
+			Error:     d.Error,
+			UpdatedAt: time.UnixMilli(d.UpdatedAt),
+		})
+	}
+	return artifacts, nil
+}
+
+func (dao *mirrorSyncDao) UpdateArtifact(ctx context.Context, artifact *types.MirrorSyncArtifact) error {
+	now := time.Now()
+	stmt := databaseg.Builder.Update("registry_mirror_sync_artifacts").
+		Set("msart_digest", artifact.Digest).
+		Set("msart_status", string(artifact.Status)).
+		Set("msart_error", artifact.Error).
+		Set("msart_updated_at", now.UnixMilli()).
+		Where(sq.Eq{"msart_id": artifact.ID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = dbtx.GetAccessor(ctx, dao.db).ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update mirror sync artifact")
+	}
+	artifact.UpdatedAt = now
+	return nil
+}
+
+func mapToMirrorSync(dst *mirrorSyncDB) *types.MirrorSync {
+	return &types.MirrorSync{
+		ID:                 dst.ID,
+		RegistryID:         dst.RegistryID,
+		UpstreamRegistryID: dst.UpstreamRegistryID,
+		Repository:         dst.Repository,
+		TagPattern:         dst.TagPattern,
+		IntervalMinutes:    dst.IntervalMinutes,
+		Enabled:            dst.Enabled,
+		NextRunAt:          time.UnixMilli(dst.NextRunAt),
+		CreatedAt:          time.UnixMilli(dst.CreatedAt),
+		UpdatedAt:          time.UnixMilli(dst.UpdatedAt),
+		CreatedBy:          dst.CreatedBy,
+		UpdatedBy:          dst.UpdatedBy,
+	}
+}
+
+func mapToMirrorSyncRun(dst *mirrorSyncRunDB) *types.MirrorSyncRun {
+	run := &types.MirrorSyncRun{
+		ID:        dst.ID,
+		SyncID:    dst.SyncID,
+		Status:    types.MirrorSyncRunStatus(dst.Status),
+		Trigger:   types.MirrorSyncTrigger(dst.Trigger),
+		Tags:      dst.Tags,
+		Synced:    dst.Synced,
+		Skipped:   dst.Skipped,
+		Failed:    dst.Failed,
+		Error:     dst.Error,
+		CreatedAt: time.UnixMilli(dst.CreatedAt),
+		CreatedBy: dst.CreatedBy,
+	}
+	if dst.StartedAt > 0 {
+		run.StartedAt = time.UnixMilli(dst.StartedAt)
+	}
+	if dst.FinishedAt > 0 {
+		run.FinishedAt = time.UnixMilli(dst.FinishedAt)
+	}
+	return run
+}
+
+// unixMilliOrZero stores the times that weren't reached yet as 0.
+func unixMilliOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
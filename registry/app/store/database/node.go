@@ -18,6 +18,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/harness/gitness/app/api/request"
@@ -28,6 +29,7 @@ import (
 	databaseg "github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/store/database/dbtx"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -330,6 +332,125 @@ func (n NodeDao) mapToNodeMetadata(d *FileNodeMetadataDB) *types.FileNodeMetadat
 	}
 }
 
+type fileLocationDB struct {
+	RegistryID int64  `db:"node_registry_id"`
+	Path       string `db:"node_path"`
+}
+
+// ListFilesBySha256 lists the files of the registries with the given SHA-256 checksum.
+func (n NodeDao) ListFilesBySha256(
+	ctx context.Context,
+	registryIDs []int64,
+	sha256 string,
+) ([]*types.FileLocation, error) {
+	if len(registryIDs) == 0 {
+		return nil, nil
+	}
+	q := databaseg.Builder.
+		Select("n.node_registry_id", "n.node_path").
+		From("nodes n").
+		Join("generic_blobs gb ON gb.generic_blob_id = n.node_generic_blob_id").
+		Where("n.node_is_file = true AND gb.generic_blob_sha_256 = ?", sha256).
+		Where(sq.Eq{"n.node_registry_id": registryIDs}).
+		OrderBy("n.node_registry_id", "n.node_path")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, n.sqlDB)
+
+	dst := []*fileLocationDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list files")
+	}
+
+	files := make([]*types.FileLocation, 0, len(dst))
+	for _, d := range dst {
+		files = append(files, &types.FileLocation{RegistryID: d.RegistryID, Path: d.Path})
+	}
+	return files, nil
+}
+
+func (n NodeDao) ListByPath(ctx context.Context, registryID int64, path string) ([]*types.Node, error) {
+	q := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(Nodes{}), ",")).
+		From("nodes").
+		Where(sq.Eq{"node_registry_id": registryID}).
+		Where(underPath(path)).
+		OrderBy("node_path")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	dst := []*Nodes{}
+	if err = dbtx.GetAccessor(ctx, n.sqlDB).SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list nodes for path %s", path)
+	}
+
+	nodes := make([]*types.Node, 0, len(dst))
+	for _, d := range dst {
+		node, err := n.mapToNode(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func (n NodeDao) DeleteByPath(ctx context.Context, registryID int64, path string) (int64, error) {
+	stmt := databaseg.Builder.Delete("nodes").
+		Where(sq.Eq{"node_registry_id": registryID}).
+		Where(underPath(path))
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	result, err := dbtx.GetAccessor(ctx, n.sqlDB).ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	return count, nil
+}
+
+// underPath matches the node at the path and the nodes below it. The prefix is compared with SUBSTR rather
+// than LIKE, which would treat the underscores of paths as wildcards.
+func underPath(path string) sq.Sqlizer {
+	prefix := strings.TrimSuffix(path, "/") + "/"
+	return sq.Or{
+		sq.Eq{"node_path": strings.TrimSuffix(path, "/")},
+		sq.Expr("SUBSTR(node_path, 1, ?) = ?", len(prefix), prefix),
+	}
+}
+
+func (n NodeDao) CountByBlobID(ctx context.Context, blobID string) (int64, error) {
+	q := databaseg.Builder.
+		Select("COUNT(*)").
+		From("nodes").
+		Where("node_generic_blob_id = ?", blobID)
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	var count int64
+	if err = dbtx.GetAccessor(ctx, n.sqlDB).QueryRowContext(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed executing count query")
+	}
+	return count, nil
+}
+
 func NewNodeDao(sqlDB *sqlx.DB) store.NodesRepository {
 	return &NodeDao{sqlDB: sqlDB}
 }
@@ -0,0 +1,308 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type packageRuleDao struct {
+	db *sqlx.DB
+}
+
+func NewPackageRuleDao(db *sqlx.DB) store.PackageRuleRepository {
+	return &packageRuleDao{
+		db: db,
+	}
+}
+
+type packageRuleDB struct {
+	ID           int64  `db:"pkgrule_id"`
+	RegistryID   int64  `db:"pkgrule_registry_id"`
+	Action       string `db:"pkgrule_action"`
+	Group        string `db:"pkgrule_group"`
+	Name         string `db:"pkgrule_name"`
+	VersionRange string `db:"pkgrule_version_range"`
+	Description  string `db:"pkgrule_description"`
+	CreatedAt    int64  `db:"pkgrule_created_at"`
+	UpdatedAt    int64  `db:"pkgrule_updated_at"`
+	CreatedBy    int64  `db:"pkgrule_created_by"`
+	UpdatedBy    int64  `db:"pkgrule_updated_by"`
+}
+
+type packageRuleViolationDB struct {
+	ID         int64  `db:"pkgviol_id"`
+	RegistryID int64  `db:"pkgviol_registry_id"`
+	RuleID     int64  `db:"pkgviol_rule_id"`
+	Group      string `db:"pkgviol_group"`
+	Name       string `db:"pkgviol_name"`
+	Version    string `db:"pkgviol_version"`
+	Reason     string `db:"pkgviol_reason"`
+	Count      int64  `db:"pkgviol_count"`
+	CreatedAt  int64  `db:"pkgviol_created_at"`
+	LastSeenAt int64  `db:"pkgviol_last_seen_at"`
+}
+
+func (dao *packageRuleDao) Create(ctx context.Context, rule *types.PackageRule) error {
+	const sqlQuery = `
+		INSERT INTO registry_package_rules (
+			pkgrule_registry_id
+			,pkgrule_action
+			,pkgrule_group
+			,pkgrule_name
+			,pkgrule_version_range
+			,pkgrule_description
+			,pkgrule_created_at
+			,pkgrule_updated_at
+			,pkgrule_created_by
+			,pkgrule_updated_by
+		) VALUES (
+			:pkgrule_registry_id
+			,:pkgrule_action
+			,:pkgrule_group
+			,:pkgrule_name
+			,:pkgrule_version_range
+			,:pkgrule_description
+			,:pkgrule_created_at
+			,:pkgrule_updated_at
+			,:pkgrule_created_by
+			,:pkgrule_updated_by
+		) RETURNING pkgrule_id`
+
+	now := time.Now()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+	if rule.UpdatedBy == 0 {
+		rule.UpdatedBy = rule.CreatedBy
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalPackageRule(rule))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind package rule object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&rule.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *packageRuleDao) Delete(ctx context.Context, registryID int64, id int64) error {
+	stmt := databaseg.Builder.Delete("registry_package_rules").
+		Where("pkgrule_registry_id = ? AND pkgrule_id = ?", registryID, id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *packageRuleDao) List(ctx context.Context, registryID int64) ([]*types.PackageRule, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(packageRuleDB{}), ",")).
+		From("registry_package_rules").
+		Where(sq.Eq{"pkgrule_registry_id": registryID}).
+		OrderBy("pkgrule_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*packageRuleDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list package rules")
+	}
+
+	rules := make([]*types.PackageRule, 0, len(dst))
+	for _, d := range dst {
+		rules = append(rules, &types.PackageRule{
+			ID:           d.ID,
+			RegistryID:   d.RegistryID,
+			Action:       types.PackageRuleAction(d.Action),
+			Group:        d.Group,
+			Name:         d.Name,
+			VersionRange: d.VersionRange,
+			Description:  d.Description,
+			CreatedAt:    time.UnixMilli(d.CreatedAt),
+			UpdatedAt:    time.UnixMilli(d.UpdatedAt),
+			CreatedBy:    d.CreatedBy,
+			UpdatedBy:    d.UpdatedBy,
+		})
+	}
+	return rules, nil
+}
+
+func (dao *packageRuleDao) RecordViolation(ctx context.Context, violation *types.PackageRuleViolation) error {
+	const sqlQuery = `
+		INSERT INTO registry_package_rule_violations (
+			pkgviol_registry_id
+			,pkgviol_rule_id
+			,pkgviol_group
+			,pkgviol_name
+			,pkgviol_version
+			,pkgviol_reason
+			,pkgviol_count
+			,pkgviol_created_at
+			,pkgviol_last_seen_at
+		) VALUES (
+			:pkgviol_registry_id
+			,:pkgviol_rule_id
+			,:pkgviol_group
+			,:pkgviol_name
+			,:pkgviol_version
+			,:pkgviol_reason
+			,1
+			,:pkgviol_created_at
+			,:pkgviol_last_seen_at
+		)
+		ON CONFLICT (pkgviol_registry_id, pkgviol_group, pkgviol_name, pkgviol_version)
+		DO UPDATE SET
+			pkgviol_rule_id = :pkgviol_rule_id
+			,pkgviol_reason = :pkgviol_reason
+			,pkgviol_count = registry_package_rule_violations.pkgviol_count + 1
+			,pkgviol_last_seen_at = :pkgviol_last_seen_at`
+
+	now := time.Now()
+	violation.CreatedAt = now
+	violation.LastSeenAt = now
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &packageRuleViolationDB{
+		RegistryID: violation.RegistryID,
+		RuleID:     violation.RuleID,
+		Group:      violation.Group,
+		Name:       violation.Name,
+		Version:    violation.Version,
+		Reason:     violation.Reason,
+		CreatedAt:  now.UnixMilli(),
+		LastSeenAt: now.UnixMilli(),
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind package rule violation object")
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
+
+func (dao *packageRuleDao) ListViolations(
+	ctx context.Context,
+	registryID int64,
+	limit int,
+	offset int,
+) ([]*types.PackageRuleViolation, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(packageRuleViolationDB{}), ",")).
+		From("registry_package_rule_violations").
+		Where(sq.Eq{"pkgviol_registry_id": registryID}).
+		OrderBy("pkgviol_last_seen_at DESC", "pkgviol_id DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*packageRuleViolationDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list package rule violations")
+	}
+
+	violations := make([]*types.PackageRuleViolation, 0, len(dst))
+	for _, d := range dst {
+		violations = append(violations, &types.PackageRuleViolation{
+			ID:         d.ID,
+			RegistryID: d.RegistryID,
+			RuleID:     d.RuleID,
+			Group:      d.Group,
+			Name:       d.Name,
+			Version:    d.Version,
+			Reason:     d.Reason,
+			Count:      d.Count,
+			CreatedAt:  time.UnixMilli(d.CreatedAt),
+			LastSeenAt: time.UnixMilli(d.LastSeenAt),
+		})
+	}
+	return violations, nil
+}
+
+func (dao *packageRuleDao) CountViolations(ctx context.Context, registryID int64) (int64, error) {
+	stmt := databaseg.Builder.Select("COUNT(*)").
+		From("registry_package_rule_violations").
+		Where(sq.Eq{"pkgviol_registry_id": registryID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var count int64
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count package rule violations")
+	}
+	return count, nil
+}
+
+func mapToInternalPackageRule(in *types.PackageRule) *packageRuleDB {
+	return &packageRuleDB{
+		ID:           in.ID,
+		RegistryID:   in.RegistryID,
+		Action:       string(in.Action),
+		Group:        in.Group,
+		Name:         in.Name,
+		VersionRange: in.VersionRange,
+		Description:  in.Description,
+		CreatedAt:    in.CreatedAt.UnixMilli(),
+		UpdatedAt:    in.UpdatedAt.UnixMilli(),
+		CreatedBy:    in.CreatedBy,
+		UpdatedBy:    in.UpdatedBy,
+	}
+}
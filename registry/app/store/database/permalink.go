@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type permalinkDao struct {
+	db *sqlx.DB
+}
+
+func NewPermalinkDao(db *sqlx.DB) store.PermalinkRepository {
+	return &permalinkDao{
+		db: db,
+	}
+}
+
+type permalinkDB struct {
+	ID         int64  `db:"plink_id"`
+	Code       string `db:"plink_code"`
+	RegistryID int64  `db:"plink_registry_id"`
+	Image      string `db:"plink_image"`
+	Version    string `db:"plink_version"`
+	CreatedAt  int64  `db:"plink_created_at"`
+	CreatedBy  int64  `db:"plink_created_by"`
+}
+
+func (dao *permalinkDao) Create(ctx context.Context, permalink *types.Permalink) error {
+	const sqlQuery = `
+		INSERT INTO registry_permalinks (
+			plink_code
+			,plink_registry_id
+			,plink_image
+			,plink_version
+			,plink_created_at
+			,plink_created_by
+		) VALUES (
+			:plink_code
+			,:plink_registry_id
+			,:plink_image
+			,:plink_version
+			,:plink_created_at
+			,:plink_created_by
+		)
+		ON CONFLICT (plink_registry_id, plink_image, plink_version)
+		DO NOTHING`
+
+	now := time.Now()
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &permalinkDB{
+		Code:       permalink.Code,
+		RegistryID: permalink.RegistryID,
+		Image:      permalink.Image,
+		Version:    permalink.Version,
+		CreatedAt:  now.UnixMilli(),
+		CreatedBy:  permalink.CreatedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind permalink object")
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *permalinkDao) GetByCode(ctx context.Context, code string) (*types.Permalink, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(permalinkDB{}), ",")).
+		From("registry_permalinks").
+		Where(sq.Eq{"plink_code": code})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	dst := new(permalinkDB)
+	if err = dbtx.GetAccessor(ctx, dao.db).GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find permalink")
+	}
+	return &types.Permalink{
+		ID:         dst.ID,
+		Code:       dst.Code,
+		RegistryID: dst.RegistryID,
+		Image:      dst.Image,
+		Version:    dst.Version,
+		CreatedAt:  time.UnixMilli(dst.CreatedAt),
+		CreatedBy:  dst.CreatedBy,
+	}, nil
+}
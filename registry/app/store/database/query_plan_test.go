@@ -0,0 +1,268 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/harness/gitness/app/store/database/migrate"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The query plan tests run the artifact list queries through EXPLAIN QUERY PLAN on a SQLite database with
+// all migrations applied, seeded with enough rows and analyzed so the planner picks its plans from the
+// statistics of a large installation. A step scanning a whole table instead of searching it by an index
+// reads every row of the table however selective the filters are, so the tests fail on it.
+
+func setupPlanDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Connect("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, migrate.Migrate(context.Background(), db))
+	return db
+}
+
+// seedPlanRows fills the tables of the artifact lists with rows spread over 200 registries of 20 spaces
+// and analyzes them.
+func seedPlanRows(t *testing.T, db *sqlx.DB) {
+	t.Helper()
+	for _, query := range []string{
+		`INSERT INTO registries (registry_id, registry_root_parent_id, registry_parent_id, registry_name,
+			registry_type, registry_package_type, registry_created_at, registry_updated_at, registry_created_by,
+			registry_updated_by)
+		WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 200)
+		SELECT n, n % 20 + 1, n % 20 + 1, 'reg' || n, 'VIRTUAL',
+			CASE n % 5 WHEN 0 THEN 'NPM' WHEN 1 THEN 'MAVEN' WHEN 2 THEN 'DOCKER' WHEN 3 THEN 'GENERIC'
+			ELSE 'HELM' END, 1, 1, 1, 1 FROM seq`,
+		`INSERT INTO images (image_id, image_name, image_registry_id, image_labels, image_enabled,
+			image_created_at, image_updated_at, image_created_by, image_updated_by)
+		WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 20000)
+		SELECT n, 'image-' || n, n % 200 + 1, CASE WHEN n % 10 = 0 THEN 'team' ELSE NULL END, TRUE,
+			1, n, 1, 1 FROM seq`,
+		`INSERT INTO artifacts (artifact_version, artifact_image_id, artifact_metadata, artifact_created_at,
+			artifact_updated_at, artifact_created_by, artifact_updated_by)
+		WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100000)
+		SELECT '1.0.' || n, n % 20000 + 1, '{}', n, n, 1, 1 FROM seq`,
+		`INSERT INTO tags (tag_name, tag_image_name, tag_registry_id, tag_manifest_id, tag_created_at,
+			tag_updated_at, tag_created_by, tag_updated_by)
+		WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 50000)
+		SELECT 'v' || n, 'image-' || (n % 20000 + 1), (n % 20000 + 1) % 200 + 1, n, n, n, 1, 1 FROM seq`,
+		`INSERT INTO download_stats (download_stat_artifact_id, download_stat_timestamp, download_stat_created_at,
+			download_stat_updated_at, download_stat_created_by, download_stat_updated_by)
+		WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 100000)
+		SELECT n % 5000 + 1, n, n, n, 1, 1 FROM seq`,
+		`ANALYZE`,
+	} {
+		_, err := db.Exec(query)
+		require.NoError(t, err, query)
+	}
+}
+
+var (
+	subqueryStep = regexp.MustCompile(`^(?:MATERIALIZE|CO-ROUTINE) (\S+)`)
+	scanStep     = regexp.MustCompile(`^SCAN (\S+)`)
+)
+
+// queryPlan returns the steps of the plan SQLite chooses for the query, after checking it runs.
+func queryPlan(t *testing.T, db *sqlx.DB, q sq.SelectBuilder) []string {
+	t.Helper()
+	query, args, err := q.ToSql()
+	require.NoError(t, err)
+	rows, err := db.Queryx(query, args...)
+	require.NoError(t, err, query)
+	require.NoError(t, rows.Close())
+
+	rows, err = db.Queryx("EXPLAIN QUERY PLAN "+query, args...)
+	require.NoError(t, err)
+	defer rows.Close()
+	var plan []string
+	for rows.Next() {
+		var id, parent, notUsed int
+		var detail string
+		require.NoError(t, rows.Scan(&id, &parent, &notUsed, &detail))
+		plan = append(plan, detail)
+	}
+	require.NoError(t, rows.Err())
+	return plan
+}
+
+// assertNoTableScans fails for the steps of the plan scanning a table. Scans of the rows of a
+// subquery are fine, the subquery itself is checked by its own steps.
+func assertNoTableScans(t *testing.T, plan []string) {
+	t.Helper()
+	subqueries := map[string]bool{}
+	for _, step := range plan {
+		if m := subqueryStep.FindStringSubmatch(step); m != nil {
+			subqueries[m[1]] = true
+		}
+	}
+	for _, step := range plan {
+		m := scanStep.FindStringSubmatch(step)
+		if m == nil || subqueries[m[1]] || m[1][0] == '(' || m[1] == "CONSTANT" {
+			continue
+		}
+		assert.Failf(t, "query scans a table", "%s\nplan:\n%v", step, plan)
+	}
+}
+
+func assertUsesIndex(t *testing.T, plan []string, index string) {
+	t.Helper()
+	for _, step := range plan {
+		if regexp.MustCompile(`\b` + index + `\b`).MatchString(step) {
+			return
+		}
+	}
+	assert.Failf(t, "query doesn't use index", "%s\nplan:\n%v", index, plan)
+}
+
+func TestArtifactListQueryPlans(t *testing.T) {
+	db := setupPlanDB(t)
+	seedPlanRows(t, db)
+	tags := tagDao{db: db}
+	registries := []string{"reg"}
+	packageTypes := []string{"NPM", "MAVEN"}
+	labels := []string{"team"}
+	registryScope := inRegistries([]int64{1, 2})
+
+	tests := []struct {
+		name    string
+		query   sq.SelectBuilder
+		indexes []string
+	}{
+		{
+			name: "space artifacts by package type and name, sorted by downloads",
+			query: allArtifactsByParentIDQuery(1, &[]string{}, downloadCount, "DESC", "foo", true,
+				packageTypes),
+			indexes: []string{"index_registries_on_parent_id_package_type"},
+		},
+		{
+			name:  "all versions of the space",
+			query: allArtifactsByParentIDQuery(1, &registries, imageName, "ASC", "", false, nil),
+		},
+		{
+			name: "space non OCI artifacts",
+			query: tags.GetAllArtifactOnParentIDQueryForNonOCI(1, true, &[]string{}, packageTypes,
+				"foo"),
+			indexes: []string{"index_registries_on_parent_id_package_type"},
+		},
+		{
+			name:    "space OCI artifacts",
+			query:   tags.GetAllArtifactsQueryByParentIDForOCI(1, true, &registries, []string{"DOCKER"}, "foo"),
+			indexes: []string{"index_tags_on_registry_id_image_name_updated_at"},
+		},
+		{
+			name:    "artifacts of registries by name and label, sorted by downloads",
+			query:   allArtifactsByRepoQuery([]int64{1, 2}, downloadCount, "DESC", "foo", labels),
+			indexes: []string{"index_artifacts_on_image_id_updated_at"},
+		},
+		{
+			name: "latest artifacts of registries",
+			query: databaseg.Builder.Select("COUNT(*)").FromSelect(latestArtifacts(registryScope, artifactFilter{
+				search: "foo", labels: labels,
+			}), "a1").Where("a1.rank = 1"),
+		},
+		{
+			name: "latest tags of registries",
+			query: databaseg.Builder.Select("COUNT(*)").FromSelect(latestTags(registryScope, artifactFilter{
+				search: "foo", labels: labels,
+			}), "a").Where("a.rank = 1"),
+		},
+		{
+			name:  "download counts of registries",
+			query: imageDownloads(registryScope, artifactFilter{search: "foo"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := queryPlan(t, db, tt.query)
+			assertNoTableScans(t, plan)
+			for _, index := range tt.indexes {
+				assertUsesIndex(t, plan, index)
+			}
+		})
+	}
+}
+
+func TestArtifactListQueriesRun(t *testing.T) {
+	db := setupPlanDB(t)
+	ctx := context.Background()
+	tags := tagDao{db: db}
+	artifacts := ArtifactDao{db: db}
+
+	_, err := tags.GetAllArtifactsByParentID(ctx, 1, &[]string{"reg"}, downloadCount, "DESC", 10, 0, "foo", true,
+		[]string{"NPM"})
+	assert.NoError(t, err)
+	_, err = tags.CountAllArtifactsByParentID(ctx, 1, &[]string{"reg"}, "foo", true, []string{"NPM"})
+	assert.NoError(t, err)
+	_, err = tags.CountAllArtifactsByRepo(ctx, []int64{1, 2}, "foo", []string{"team"})
+	assert.NoError(t, err)
+	_, err = artifacts.CountAllArtifactsByParentID(ctx, 1, &[]string{}, "foo", false, []string{"NPM"})
+	assert.NoError(t, err)
+	_, err = artifacts.CountAllArtifactsByRepo(ctx, []int64{1, 2}, "foo", []string{"team"})
+	assert.NoError(t, err)
+	_, _, err = artifacts.BackfillFileCounts(ctx, 0, 100)
+	assert.NoError(t, err)
+}
+
+// The sort fields are the columns the metadata controller maps the sort keys of the API to.
+func TestArtifactListQueriesSortFields(t *testing.T) {
+	db := setupPlanDB(t)
+	ctx := context.Background()
+	tags := tagDao{db: db}
+	artifacts := ArtifactDao{db: db}
+
+	for _, field := range []string{name, imageName, "updated_at", "created_at", downloadCount} {
+		_, err := tags.GetAllArtifactsByParentID(ctx, 1, &[]string{}, field, "ASC", 10, 0, "", true, nil)
+		assert.NoError(t, err, field)
+		_, err = tags.GetAllArtifactsByRepo(ctx, []int64{1}, field, "ASC", 10, 0, "", nil)
+		assert.NoError(t, err, field)
+		_, err = artifacts.GetAllArtifactsByParentID(ctx, 1, &[]string{}, field, "ASC", 10, 0, "", true, nil)
+		assert.NoError(t, err, field)
+		_, err = artifacts.GetAllArtifactsByRepo(ctx, []int64{1}, field, "ASC", 10, 0, "", nil)
+		assert.NoError(t, err, field)
+	}
+	for _, field := range []string{name, "updated_at", "created_at", downloadCount} {
+		_, err := tags.GetAllTagsByRepoAndImage(ctx, 1, "reg", "img", field, "ASC", 10, 0, "")
+		assert.NoError(t, err, field)
+		_, err = artifacts.GetAllVersionsByRepoAndImage(ctx, 1, "reg", "img", field, "ASC", 10, 0, "")
+		assert.NoError(t, err, field)
+	}
+}
+
+func TestTagQueriesRun(t *testing.T) {
+	db := setupPlanDB(t)
+	ctx := context.Background()
+	tags := tagDao{db: db}
+
+	_, err := tags.CountAllTagsByRepoAndImage(ctx, 1, "reg", "img", "v1")
+	assert.NoError(t, err)
+	_, err = tags.HasTagsAfterName(ctx, 1, types.FilterParams{
+		OrderBy: "published_at", PublishedAt: "2024-01-01", LastEntry: "v1", MaxEntries: 10,
+	})
+	assert.NoError(t, err)
+	_, err = tags.LockTagByNameForUpdate(ctx, 1, "v1")
+	assert.NoError(t, err)
+}
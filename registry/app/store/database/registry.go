@@ -57,21 +57,28 @@ func NewRegistryDao(db *sqlx.DB, mtRepository store.MediaTypesRepository) store.
 
 // registryDB holds the record of a registry in DB.
 type registryDB struct {
-	ID              int64                 `db:"registry_id"`
-	Name            string                `db:"registry_name"`
-	ParentID        int64                 `db:"registry_parent_id"`
-	RootParentID    int64                 `db:"registry_root_parent_id"`
-	Description     sql.NullString        `db:"registry_description"`
-	Type            artifact.RegistryType `db:"registry_type"`
-	PackageType     artifact.PackageType  `db:"registry_package_type"`
-	UpstreamProxies sql.NullString        `db:"registry_upstream_proxies"`
-	AllowedPattern  sql.NullString        `db:"registry_allowed_pattern"`
-	BlockedPattern  sql.NullString        `db:"registry_blocked_pattern"`
-	Labels          sql.NullString        `db:"registry_labels"`
-	CreatedAt       int64                 `db:"registry_created_at"`
-	UpdatedAt       int64                 `db:"registry_updated_at"`
-	CreatedBy       int64                 `db:"registry_created_by"`
-	UpdatedBy       int64                 `db:"registry_updated_by"`
+	ID                    int64                 `db:"registry_id"`
+	Name                  string                `db:"registry_name"`
+	ParentID              int64                 `db:"registry_parent_id"`
+	RootParentID          int64                 `db:"registry_root_parent_id"`
+	Description           sql.NullString        `db:"registry_description"`
+	Type                  artifact.RegistryType `db:"registry_type"`
+	PackageType           artifact.PackageType  `db:"registry_package_type"`
+	UpstreamProxies       sql.NullString        `db:"registry_upstream_proxies"`
+	AllowedPattern        sql.NullString        `db:"registry_allowed_pattern"`
+	BlockedPattern        sql.NullString        `db:"registry_blocked_pattern"`
+	Labels                sql.NullString        `db:"registry_labels"`
+	LatestVersionStrategy sql.NullString        `db:"registry_latest_version_strategy"`
+	LatestVersionPattern  sql.NullString        `db:"registry_latest_version_pattern"`
+	StoragePrefix         sql.NullString        `db:"registry_storage_prefix"`
+	MTLSMode              sql.NullString        `db:"registry_mtls_mode"`
+	MTLSCACertificates    sql.NullString        `db:"registry_mtls_ca_certificates"`
+	MavenStrictMetadata   bool                  `db:"registry_maven_strict_metadata"`
+	NpmLocalScopes        sql.NullString        `db:"registry_npm_local_scopes"`
+	CreatedAt             int64                 `db:"registry_created_at"`
+	UpdatedAt             int64                 `db:"registry_updated_at"`
+	CreatedBy             int64                 `db:"registry_created_by"`
+	UpdatedBy             int64                 `db:"registry_updated_by"`
 }
 
 type registryNameID struct {
@@ -236,17 +243,41 @@ func (r registryDao) GetByIDIn(ctx context.Context, ids []int64) (*[]types.Regis
 }
 
 type RegistryMetadataDB struct {
-	RegID         string                `db:"registry_id"`
-	RegIdentifier string                `db:"reg_identifier"`
-	Description   sql.NullString        `db:"description"`
-	PackageType   artifact.PackageType  `db:"package_type"`
-	Type          artifact.RegistryType `db:"type"`
-	LastModified  int64                 `db:"last_modified"`
-	URL           sql.NullString        `db:"url"`
-	ArtifactCount int64                 `db:"artifact_count"`
-	DownloadCount int64                 `db:"download_count"`
-	Size          int64                 `db:"size"`
-	Labels        sql.NullString        `db:"registry_labels"`
+	RegID               string                `db:"registry_id"`
+	RegIdentifier       string                `db:"reg_identifier"`
+	Description         sql.NullString        `db:"description"`
+	PackageType         artifact.PackageType  `db:"package_type"`
+	Type                artifact.RegistryType `db:"type"`
+	LastModified        int64                 `db:"last_modified"`
+	URL                 sql.NullString        `db:"url"`
+	ArtifactCount       int64                 `db:"artifact_count"`
+	DownloadCount       int64                 `db:"download_count"`
+	UniqueDownloadCount int64                 `db:"unique_download_count"`
+	Size                int64                 `db:"size"`
+	Labels              sql.NullString        `db:"registry_labels"`
+}
+
+// ListByParentID lists the registries of a space, not including the registries of its sub-spaces.
+func (r registryDao) ListByParentID(ctx context.Context, parentID int64) (*[]types.Registry, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(registryDB{}), ",")).
+		From("registries").
+		Where("registry_parent_id = ?", parentID).
+		OrderBy("registry_name")
+
+	db := dbtx.GetAccessor(ctx, r.db)
+
+	dst := []*registryDB{}
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list registries")
+	}
+
+	return r.mapToRegistries(ctx, dst)
 }
 
 func (r registryDao) GetAll(
@@ -278,7 +309,8 @@ func (r registryDao) GetAll(
 			ELSE COALESCE(blob_sizes.total_size, 0)
 		END AS size,
 		r.registry_labels,
-		COALESCE(download_stats.download_count, 0) AS download_count
+		COALESCE(download_stats.download_count, 0) AS download_count,
+		COALESCE(download_stats.unique_download_count, 0) AS unique_download_count
 	`
 
 	// Subqueries with optimizations for reduced joins and grouping
@@ -303,7 +335,8 @@ func (r registryDao) GetAll(
 		GROUP BY 1
 	`
 	downloadStatsSubquery := `
-		SELECT i.image_registry_id AS registry_id, COUNT(d.download_stat_id) AS download_count
+		SELECT i.image_registry_id AS registry_id, COUNT(d.download_stat_id) AS download_count,
+			SUM(CASE WHEN d.download_stat_unique THEN 1 ELSE 0 END) AS unique_download_count
 		FROM download_stats d
 		JOIN artifacts a ON d.download_stat_artifact_id = a.artifact_id
 		JOIN images i ON a.artifact_image_id = i.image_id
@@ -550,6 +583,13 @@ func (r registryDao) Create(ctx context.Context, registry *types.Registry) (id i
 			,registry_created_by
 			,registry_updated_by
 			,registry_labels
+			,registry_latest_version_strategy
+			,registry_latest_version_pattern
+			,registry_storage_prefix
+			,registry_mtls_mode
+			,registry_mtls_ca_certificates
+			,registry_maven_strict_metadata
+			,registry_npm_local_scopes
 		) VALUES (
 			:registry_name
 			,:registry_root_parent_id
@@ -565,6 +605,13 @@ func (r registryDao) Create(ctx context.Context, registry *types.Registry) (id i
 			,:registry_created_by
 			,:registry_updated_by
 			,:registry_labels
+			,:registry_latest_version_strategy
+			,:registry_latest_version_pattern
+			,:registry_storage_prefix
+			,:registry_mtls_mode
+			,:registry_mtls_ca_certificates
+			,:registry_maven_strict_metadata
+			,:registry_npm_local_scopes
 		) RETURNING registry_id`
 
 	db := dbtx.GetAccessor(ctx, r.db)
@@ -592,21 +639,28 @@ func mapToInternalRegistry(ctx context.Context, in *types.Registry) *registryDB
 	in.UpdatedBy = session.Principal.ID
 
 	return &registryDB{
-		ID:              in.ID,
-		Name:            in.Name,
-		ParentID:        in.ParentID,
-		RootParentID:    in.RootParentID,
-		Description:     util.GetEmptySQLString(in.Description),
-		Type:            in.Type,
-		PackageType:     in.PackageType,
-		UpstreamProxies: util.GetEmptySQLString(util.Int64ArrToString(in.UpstreamProxies)),
-		AllowedPattern:  util.GetEmptySQLString(util.ArrToString(in.AllowedPattern)),
-		BlockedPattern:  util.GetEmptySQLString(util.ArrToString(in.BlockedPattern)),
-		Labels:          util.GetEmptySQLString(util.ArrToString(in.Labels)),
-		CreatedAt:       in.CreatedAt.UnixMilli(),
-		UpdatedAt:       in.UpdatedAt.UnixMilli(),
-		CreatedBy:       in.CreatedBy,
-		UpdatedBy:       in.UpdatedBy,
+		ID:                    in.ID,
+		Name:                  in.Name,
+		ParentID:              in.ParentID,
+		RootParentID:          in.RootParentID,
+		Description:           util.GetEmptySQLString(in.Description),
+		Type:                  in.Type,
+		PackageType:           in.PackageType,
+		UpstreamProxies:       util.GetEmptySQLString(util.Int64ArrToString(in.UpstreamProxies)),
+		AllowedPattern:        util.GetEmptySQLString(util.ArrToString(in.AllowedPattern)),
+		BlockedPattern:        util.GetEmptySQLString(util.ArrToString(in.BlockedPattern)),
+		Labels:                util.GetEmptySQLString(util.ArrToString(in.Labels)),
+		LatestVersionStrategy: util.GetEmptySQLString(string(in.LatestVersionStrategy)),
+		LatestVersionPattern:  util.GetEmptySQLString(in.LatestVersionPattern),
+		StoragePrefix:         util.GetEmptySQLString(in.StoragePrefix),
+		MTLSMode:              util.GetEmptySQLString(string(in.MTLSMode)),
+		MTLSCACertificates:    util.GetEmptySQLString(in.MTLSCACertificates),
+		MavenStrictMetadata:   in.MavenStrictMetadata,
+		NpmLocalScopes:        util.GetEmptySQLString(util.ArrToString(in.NpmLocalScopes)),
+		CreatedAt:             in.CreatedAt.UnixMilli(),
+		UpdatedAt:             in.UpdatedAt.UnixMilli(),
+		CreatedBy:             in.CreatedBy,
+		UpdatedBy:             in.UpdatedBy,
 	}
 }
 
@@ -662,6 +716,76 @@ func (r registryDao) Update(ctx context.Context, registry *types.Registry) (err
 	return nil
 }
 
+func (r registryDao) UpdateStoragePrefix(ctx context.Context, registryID int64, prefix string) error {
+	stmt := databaseg.Builder.Update("registries").
+		Set("registry_storage_prefix", util.GetEmptySQLString(prefix)).
+		Set("registry_updated_at", time.Now().UnixMilli()).
+		Where("registry_id = ?", registryID)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, r.db)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update registry storage prefix")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of updated rows")
+	}
+	if count == 0 {
+		return gitnessstore.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (r registryDao) ListStoragePrefixes(ctx context.Context, rootParentID int64) ([]string, error) {
+	stmt := databaseg.Builder.Select("DISTINCT registry_storage_prefix").
+		From("registries").
+		Where("registry_root_parent_id = ?", rootParentID).
+		Where("registry_storage_prefix IS NOT NULL AND registry_storage_prefix <> ''").
+		OrderBy("registry_storage_prefix")
+
+	return r.selectStoragePrefixValues(ctx, stmt)
+}
+
+func (r registryDao) FindRootParentIDsByStoragePrefix(ctx context.Context, prefix string) ([]int64, error) {
+	stmt := databaseg.Builder.Select("DISTINCT registry_root_parent_id").
+		From("registries").
+		Where("registry_storage_prefix = ?", prefix)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	var ids []int64
+	db := dbtx.GetAccessor(ctx, r.db)
+	if err = db.SelectContext(ctx, &ids, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find registries by storage prefix")
+	}
+	return ids, nil
+}
+
+func (r registryDao) selectStoragePrefixValues(ctx context.Context, stmt sq.SelectBuilder) ([]string, error) {
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	var prefixes []string
+	db := dbtx.GetAccessor(ctx, r.db)
+	if err = db.SelectContext(ctx, &prefixes, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list storage prefixes")
+	}
+	return prefixes, nil
+}
+
 func (r registryDao) FetchUpstreamProxyIDs(
 	ctx context.Context,
 	repokeys []string,
@@ -728,21 +852,28 @@ func (r registryDao) mapToRegistries(ctx context.Context, dst []*registryDB) (*[
 
 func (r registryDao) mapToRegistry(_ context.Context, dst *registryDB) (*types.Registry, error) {
 	return &types.Registry{
-		ID:              dst.ID,
-		Name:            dst.Name,
-		ParentID:        dst.ParentID,
-		RootParentID:    dst.RootParentID,
-		Description:     dst.Description.String,
-		Type:            dst.Type,
-		PackageType:     dst.PackageType,
-		UpstreamProxies: util.StringToInt64Arr(dst.UpstreamProxies.String),
-		AllowedPattern:  util.StringToArr(dst.AllowedPattern.String),
-		BlockedPattern:  util.StringToArr(dst.BlockedPattern.String),
-		Labels:          util.StringToArr(dst.Labels.String),
-		CreatedAt:       time.UnixMilli(dst.CreatedAt),
-		UpdatedAt:       time.UnixMilli(dst.UpdatedAt),
-		CreatedBy:       dst.CreatedBy,
-		UpdatedBy:       dst.UpdatedBy,
+		ID:                    dst.ID,
+		Name:                  dst.Name,
+		ParentID:              dst.ParentID,
+		RootParentID:          dst.RootParentID,
+		Description:           dst.Description.String,
+		Type:                  dst.Type,
+		PackageType:           dst.PackageType,
+		UpstreamProxies:       util.StringToInt64Arr(dst.UpstreamProxies.String),
+		AllowedPattern:        util.StringToArr(dst.AllowedPattern.String),
+		BlockedPattern:        util.StringToArr(dst.BlockedPattern.String),
+		Labels:                util.StringToArr(dst.Labels.String),
+		LatestVersionStrategy: artifact.LatestVersionStrategy(dst.LatestVersionStrategy.String),
+		LatestVersionPattern:  dst.LatestVersionPattern.String,
+		StoragePrefix:         dst.StoragePrefix.String,
+		MTLSMode:              artifact.MTLSMode(dst.MTLSMode.String),
+		MTLSCACertificates:    dst.MTLSCACertificates.String,
+		MavenStrictMetadata:   dst.MavenStrictMetadata,
+		NpmLocalScopes:        util.StringToArr(dst.NpmLocalScopes.String),
+		CreatedAt:             time.UnixMilli(dst.CreatedAt),
+		UpdatedAt:             time.UnixMilli(dst.UpdatedAt),
+		CreatedBy:             dst.CreatedBy,
+		UpdatedBy:             dst.UpdatedBy,
 	}, nil
 }
 
@@ -760,16 +891,17 @@ func (r registryDao) mapToRegistryMetadataList(
 
 func (r registryDao) mapToRegistryMetadata(_ context.Context, dst *RegistryMetadataDB) *store.RegistryMetadata {
 	return &store.RegistryMetadata{
-		RegID:         dst.RegID,
-		RegIdentifier: dst.RegIdentifier,
-		Description:   dst.Description.String,
-		PackageType:   dst.PackageType,
-		Type:          dst.Type,
-		LastModified:  time.UnixMilli(dst.LastModified),
-		URL:           dst.URL.String,
-		ArtifactCount: dst.ArtifactCount,
-		DownloadCount: dst.DownloadCount,
-		Size:          dst.Size,
-		Labels:        util.StringToArr(dst.Labels.String),
+		RegID:               dst.RegID,
+		RegIdentifier:       dst.RegIdentifier,
+		Description:         dst.Description.String,
+		PackageType:         dst.PackageType,
+		Type:                dst.Type,
+		LastModified:        time.UnixMilli(dst.LastModified),
+		URL:                 dst.URL.String,
+		ArtifactCount:       dst.ArtifactCount,
+		DownloadCount:       dst.DownloadCount,
+		UniqueDownloadCount: dst.UniqueDownloadCount,
+		Size:                dst.Size,
+		Labels:              util.StringToArr(dst.Labels.String),
 	}
 }
@@ -0,0 +1,236 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/google/uuid"
+)
+
+// manifestCloneJoin maps the manifest of the source registry referenced by the given column
+// to the manifest with the same image name and digest in the target registry.
+const manifestCloneJoin = `
+	JOIN manifests sm%[1]s ON sm%[1]s.manifest_id = %[2]s
+	JOIN manifests tm%[1]s ON tm%[1]s.manifest_registry_id = :target
+		AND tm%[1]s.manifest_image_name = sm%[1]s.manifest_image_name
+		AND tm%[1]s.manifest_digest = sm%[1]s.manifest_digest`
+
+// cloneArtifactQueries copy the artifact metadata of a registry, in dependency order. The rows keep
+// their timestamps so age based cleanup policies behave the same on the clone.
+var cloneArtifactQueries = []struct {
+	table string
+	query string
+}{
+	{
+		table: "images",
+		query: `
+		INSERT INTO images (image_name, image_registry_id, image_labels, image_enabled,
+			image_created_at, image_updated_at, image_created_by, image_updated_by)
+		SELECT image_name, :target, image_labels, image_enabled,
+			image_created_at, image_updated_at, image_created_by, image_updated_by
+		FROM images WHERE image_registry_id = :source`,
+	},
+	{
+		table: "artifacts",
+		query: `
+		INSERT INTO artifacts (artifact_version, artifact_image_id, artifact_metadata, artifact_file_count,
+			artifact_created_at, artifact_updated_at, artifact_created_by, artifact_updated_by)
+		SELECT a.artifact_version, ti.image_id, a.artifact_metadata, a.artifact_file_count,
+			a.artifact_created_at, a.artifact_updated_at, a.artifact_created_by, a.artifact_updated_by
+		FROM artifacts a
+		JOIN images si ON si.image_id = a.artifact_image_id
+		JOIN images ti ON ti.image_registry_id = :target AND ti.image_name = si.image_name
+		WHERE si.image_registry_id = :source`,
+	},
+	{
+		table: "manifests",
+		query: `
+		INSERT INTO manifests (manifest_registry_id, manifest_schema_version, manifest_media_type_id,
+			manifest_artifact_media_type, manifest_total_size, manifest_configuration_media_type,
+			manifest_configuration_payload, manifest_configuration_blob_id, manifest_configuration_digest,
+			manifest_digest, manifest_payload, manifest_non_conformant, manifest_non_distributable_layers,
+			manifest_subject_digest, manifest_annotations, manifest_image_name,
+			manifest_created_at, manifest_created_by, manifest_updated_at, manifest_updated_by)
+		SELECT :target, manifest_schema_version, manifest_media_type_id,
+			manifest_artifact_media_type, manifest_total_size, manifest_configuration_media_type,
+			manifest_configuration_payload, manifest_configuration_blob_id, manifest_configuration_digest,
+			manifest_digest, manifest_payload, manifest_non_conformant, manifest_non_distributable_layers,
+			manifest_subject_digest, manifest_annotations, manifest_image_name,
+			manifest_created_at, manifest_created_by, manifest_updated_at, manifest_updated_by
+		FROM manifests WHERE manifest_registry_id = :source`,
+	},
+	{
+		table: "manifests",
+		query: `
+		UPDATE manifests SET manifest_subject_id = (
+			SELECT tsub.manifest_id FROM manifests sm
+			JOIN manifests ssub ON ssub.manifest_id = sm.manifest_subject_id
+			JOIN manifests tsub ON tsub.manifest_registry_id = :target
+				AND tsub.manifest_image_name = ssub.manifest_image_name
+				AND tsub.manifest_digest = ssub.manifest_digest
+			WHERE sm.manifest_registry_id = :source
+				AND sm.manifest_image_name = manifests.manifest_image_name
+				AND sm.manifest_digest = manifests.manifest_digest
+		)
+		WHERE manifest_registry_id = :target AND manifest_subject_digest IS NOT NULL`,
+	},
+	{
+		table: "manifest_annotations",
+		query: `
+		INSERT INTO manifest_annotations (mann_manifest_id, mann_registry_id, mann_key, mann_value)
+		SELECT tm.manifest_id, :target, a.mann_key, a.mann_value
+		FROM manifest_annotations a` + fmt.Sprintf(manifestCloneJoin, "", "a.mann_manifest_id") + `
+		WHERE a.mann_registry_id = :source`,
+	},
+	{
+		table: "layers",
+		query: `
+		INSERT INTO layers (layer_registry_id, layer_manifest_id, layer_media_type_id, layer_blob_id, layer_size,
+			layer_created_at, layer_updated_at, layer_created_by, layer_updated_by)
+		SELECT :target, tm.manifest_id, l.layer_media_type_id, l.layer_blob_id, l.layer_size,
+			l.layer_created_at, l.layer_updated_at, l.layer_created_by, l.layer_updated_by
+		FROM layers l` + fmt.Sprintf(manifestCloneJoin, "", "l.layer_manifest_id") + `
+		WHERE l.layer_registry_id = :source`,
+	},
+	{
+		table: "manifest_references",
+		query: `
+		INSERT INTO manifest_references (manifest_ref_registry_id, manifest_ref_parent_id, manifest_ref_child_id,
+			manifest_ref_created_at, manifest_ref_updated_at, manifest_ref_created_by, manifest_ref_updated_by)
+		SELECT :target, tmparent.manifest_id, tmchild.manifest_id,
+			r.manifest_ref_created_at, r.manifest_ref_updated_at, r.manifest_ref_created_by, r.manifest_ref_updated_by
+		FROM manifest_references r` +
+			fmt.Sprintf(manifestCloneJoin, "parent", "r.manifest_ref_parent_id") +
+			fmt.Sprintf(manifestCloneJoin, "child", "r.manifest_ref_child_id") + `
+		WHERE r.manifest_ref_registry_id = :source`,
+	},
+	{
+		table: "oci_image_index_mappings",
+		query: `
+		INSERT INTO oci_image_index_mappings (oci_mapping_parent_manifest_id, oci_mapping_child_digest,
+			oci_mapping_created_at, oci_mapping_updated_at, oci_mapping_created_by, oci_mapping_updated_by)
+		SELECT tm.manifest_id, o.oci_mapping_child_digest,
+			o.oci_mapping_created_at, o.oci_mapping_updated_at, o.oci_mapping_created_by, o.oci_mapping_updated_by
+		FROM oci_image_index_mappings o` + fmt.Sprintf(manifestCloneJoin, "", "o.oci_mapping_parent_manifest_id") + `
+		WHERE sm.manifest_registry_id = :source`,
+	},
+	{
+		table: "manifest_base_images",
+		query: `
+		INSERT INTO manifest_base_images (mbi_registry_id, mbi_manifest_id, mbi_image_name,
+			mbi_base_repository, mbi_base_tag, mbi_base_digest, mbi_created_at)
+		SELECT :target, tm.manifest_id, b.mbi_image_name,
+			b.mbi_base_repository, b.mbi_base_tag, b.mbi_base_digest, b.mbi_created_at
+		FROM manifest_base_images b` + fmt.Sprintf(manifestCloneJoin, "", "b.mbi_manifest_id") + `
+		WHERE b.mbi_registry_id = :source`,
+	},
+	{
+		table: "tags",
+		query: `
+		INSERT INTO tags (tag_name, tag_image_name, tag_registry_id, tag_manifest_id, tag_last_pulled_at,
+			tag_created_at, tag_updated_at, tag_created_by, tag_updated_by)
+		SELECT t.tag_name, t.tag_image_name, :target, tm.manifest_id, t.tag_last_pulled_at,
+			t.tag_created_at, t.tag_updated_at, t.tag_created_by, t.tag_updated_by
+		FROM tags t` + fmt.Sprintf(manifestCloneJoin, "", "t.tag_manifest_id") + `
+		WHERE t.tag_registry_id = :source`,
+	},
+	{
+		table: "registry_blobs",
+		query: `
+		INSERT INTO registry_blobs (rblob_registry_id, rblob_blob_id, rblob_image_name,
+			rblob_created_at, rblob_updated_at, rblob_created_by, rblob_updated_by)
+		SELECT :target, rblob_blob_id, rblob_image_name,
+			rblob_created_at, rblob_updated_at, rblob_created_by, rblob_updated_by
+		FROM registry_blobs WHERE rblob_registry_id = :source`,
+	},
+}
+
+// CloneArtifacts copies the artifacts of the source registry to the target registry. Blobs aren't
+// copied, the target registry references the blobs of the source registry, so both registries must
+// be under the same root space.
+func (r registryDao) CloneArtifacts(ctx context.Context, sourceID int64, targetID int64) error {
+	db := dbtx.GetAccessor(ctx, r.db)
+	params := map[string]any{"source": sourceID, "target": targetID}
+
+	for _, q := range cloneArtifactQueries {
+		query, args, err := db.BindNamed(q.query, params)
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind clone query for %s", q.table)
+		}
+		if _, err = db.ExecContext(ctx, query, args...); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to clone %s of registry %d", q.table, sourceID)
+		}
+	}
+
+	return r.cloneNodes(ctx, sourceID, targetID)
+}
+
+// cloneNodes copies the file tree of generic and maven registries. The node ids are generated
+// by the application, so the nodes are copied one by one, parents before their children.
+func (r registryDao) cloneNodes(ctx context.Context, sourceID int64, targetID int64) error {
+	stmt := databaseg.Builder.
+		Select("node_id", "node_name", "node_registry_id", "node_is_file", "node_path",
+			"node_generic_blob_id", "node_parent_id", "node_created_at", "node_created_by").
+		From("nodes").
+		Where("node_registry_id = ?", sourceID).
+		OrderBy("node_path")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, r.db)
+
+	nodes := []*Nodes{}
+	if err = db.SelectContext(ctx, &nodes, sql, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to list nodes of registry %d", sourceID)
+	}
+
+	const insertQuery = `
+		INSERT INTO nodes (node_id, node_name, node_registry_id, node_parent_id, node_is_file,
+			node_path, node_generic_blob_id, node_created_at, node_created_by)
+		VALUES (:node_id, :node_name, :node_registry_id, :node_parent_id, :node_is_file,
+			:node_path, :node_generic_blob_id, :node_created_at, :node_created_by)`
+
+	ids := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		id := uuid.NewString()
+		ids[node.ID] = id
+		node.ID = id
+		node.RegistryID = targetID
+		if node.ParentNodeID != nil {
+			parentID, ok := ids[*node.ParentNodeID]
+			if !ok {
+				return fmt.Errorf("parent of node %s of registry %d not found", node.NodePath, sourceID)
+			}
+			node.ParentNodeID = &parentID
+		}
+
+		query, args, err := db.BindNamed(insertQuery, node)
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind node object")
+		}
+		if _, err = db.ExecContext(ctx, query, args...); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to clone node %s", node.NodePath)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustExec(t *testing.T, db *sqlx.DB, query string, args ...any) int64 {
+	t.Helper()
+	res, err := db.Exec(query, args...)
+	require.NoError(t, err)
+	id, err := res.LastInsertId()
+	require.NoError(t, err)
+	return id
+}
+
+func countRows(t *testing.T, db *sqlx.DB, table string, registryColumn string, registryID int64) int {
+	t.Helper()
+	var count int
+	require.NoError(t, db.Get(&count, "SELECT COUNT(*) FROM "+table+" WHERE "+registryColumn+" = ?", registryID))
+	return count
+}
+
+func TestCloneArtifacts(t *testing.T) {
+	db := setupPlanDB(t)
+	ctx := testSessionContext()
+
+	appImage := mustExec(t, db, `INSERT INTO images (image_name, image_registry_id, image_enabled,
+		image_created_at, image_updated_at, image_created_by, image_updated_by) VALUES ('app', 1, TRUE, 1, 2, 1, 1)`)
+	mustExec(t, db, `INSERT INTO images (image_name, image_registry_id, image_enabled,
+		image_created_at, image_updated_at, image_created_by, image_updated_by) VALUES ('lib', 1, TRUE, 1, 2, 1, 1)`)
+	mustExec(t, db, `INSERT INTO artifacts (artifact_version, artifact_image_id, artifact_metadata,
+		artifact_created_at, artifact_updated_at, artifact_created_by, artifact_updated_by)
+		VALUES ('1.0.0', ?, '{"files":[]}', 1, 2, 1, 1)`, appImage)
+
+	image := createTestManifest(ctx, t, db, 1, "app", "image", types.JSONB{"org.opencontainers.image.revision": "abc"})
+	signature := createTestManifest(ctx, t, db, 1, "app", "signature", nil)
+	index := createTestManifest(ctx, t, db, 1, "app", "index", nil)
+	createTestManifest(ctx, t, db, 3, "app", "other registry", nil)
+	mustExec(t, db, `UPDATE manifests SET manifest_subject_id = ?, manifest_subject_digest = ?
+		WHERE manifest_id = ?`, image.ID, image.Digest, signature.ID)
+
+	mustExec(t, db, `INSERT INTO tags (tag_name, tag_image_name, tag_registry_id, tag_manifest_id,
+		tag_created_at, tag_updated_at, tag_created_by, tag_updated_by) VALUES ('latest', 'app', 1, ?, 1, 2, 1, 1)`,
+		index.ID)
+	mustExec(t, db, `INSERT INTO layers (layer_registry_id, layer_manifest_id, layer_media_type_id, layer_blob_id,
+		layer_size, layer_created_at, layer_updated_at, layer_created_by, layer_updated_by)
+		VALUES (1, ?, 1, 1, 10, 1, 2, 1, 1)`, image.ID)
+	mustExec(t, db, `INSERT INTO manifest_references (manifest_ref_registry_id, manifest_ref_parent_id,
+		manifest_ref_child_id, manifest_ref_created_at, manifest_ref_updated_at, manifest_ref_created_by,
+		manifest_ref_updated_by) VALUES (1, ?, ?, 1, 2, 1, 1)`, index.ID, image.ID)
+	mustExec(t, db, `INSERT INTO registry_blobs (rblob_registry_id, rblob_blob_id, rblob_image_name,
+		rblob_created_at, rblob_updated_at, rblob_created_by, rblob_updated_by) VALUES (1, 1, 'app', 1, 2, 1, 1)`)
+
+	for _, node := range []struct{ id, name, parent, path string }{
+		{"root", "com", "", "/com"},
+		{"dir", "acme", "root", "/com/acme"},
+		{"file", "app.jar", "dir", "/com/acme/app.jar"},
+	} {
+		var parent any
+		if node.parent != "" {
+			parent = node.parent
+		}
+		mustExec(t, db, `INSERT INTO nodes (node_id, node_name, node_parent_id, node_registry_id, node_is_file,
+			node_path, node_created_at, node_created_by) VALUES (?, ?, ?, 1, ?, ?, 1, 1)`,
+			node.id, node.name, parent, node.id == "file", node.path)
+	}
+
+	require.NoError(t, registryDao{db: db}.CloneArtifacts(ctx, 1, 2))
+
+	for _, table := range []struct {
+		name, registryColumn string
+		rows                 int
+	}{
+		{"images", "image_registry_id", 2},
+		{"manifests", "manifest_registry_id", 3},
+		{"manifest_annotations", "mann_registry_id", 1},
+		{"tags", "tag_registry_id", 1},
+		{"layers", "layer_registry_id", 1},
+		{"manifest_references", "manifest_ref_registry_id", 1},
+		{"registry_blobs", "rblob_registry_id", 1},
+		{"nodes", "node_registry_id", 3},
+	} {
+		assert.Equal(t, table.rows, countRows(t, db, table.name, table.registryColumn, 1), "source %s", table.name)
+		assert.Equal(t, table.rows, countRows(t, db, table.name, table.registryColumn, 2), "cloned %s", table.name)
+	}
+
+	var artifact struct {
+		ImageRegistryID int64  `db:"image_registry_id"`
+		Metadata        string `db:"artifact_metadata"`
+		UpdatedAt       int64  `db:"artifact_updated_at"`
+	}
+	require.NoError(t, db.Get(&artifact, `SELECT image_registry_id, artifact_metadata, artifact_updated_at
+		FROM artifacts JOIN images ON image_id = artifact_image_id WHERE artifact_image_id <> ?`, appImage))
+	assert.EqualValues(t, 2, artifact.ImageRegistryID, "the artifact references the cloned image")
+	assert.Equal(t, `{"files":[]}`, artifact.Metadata)
+	assert.EqualValues(t, 2, artifact.UpdatedAt, "the timestamps are kept")
+
+	clonedManifest := func(source *types.Manifest) (id int64, subjectID *int64) {
+		t.Helper()
+		var cloned struct {
+			ID        int64  `db:"manifest_id"`
+			SubjectID *int64 `db:"manifest_subject_id"`
+		}
+		require.NoError(t, db.Get(&cloned, `SELECT t.manifest_id, t.manifest_subject_id FROM manifests t
+			JOIN manifests s ON s.manifest_image_name = t.manifest_image_name AND s.manifest_digest = t.manifest_digest
+			WHERE t.manifest_registry_id = 2 AND s.manifest_id = ?`, source.ID))
+		require.NotEqual(t, source.ID, cloned.ID)
+		return cloned.ID, cloned.SubjectID
+	}
+	clonedImage, _ := clonedManifest(image)
+	clonedIndex, _ := clonedManifest(index)
+	_, subjectID := clonedManifest(signature)
+	require.NotNil(t, subjectID)
+	assert.Equal(t, clonedImage, *subjectID, "the subject is the cloned manifest")
+
+	var tagManifestID, layerManifestID, annotationManifestID int64
+	require.NoError(t, db.Get(&tagManifestID, "SELECT tag_manifest_id FROM tags WHERE tag_registry_id = 2"))
+	assert.Equal(t, clonedIndex, tagManifestID)
+	require.NoError(t, db.Get(&layerManifestID, "SELECT layer_manifest_id FROM layers WHERE layer_registry_id = 2"))
+	assert.Equal(t, clonedImage, layerManifestID)
+	require.NoError(t, db.Get(&annotationManifestID,
+		"SELECT mann_manifest_id FROM manifest_annotations WHERE mann_registry_id = 2"))
+	assert.Equal(t, clonedImage, annotationManifestID)
+
+	var reference struct {
+		ParentID int64 `db:"manifest_ref_parent_id"`
+		ChildID  int64 `db:"manifest_ref_child_id"`
+	}
+	require.NoError(t, db.Get(&reference, `SELECT manifest_ref_parent_id, manifest_ref_child_id
+		FROM manifest_references WHERE manifest_ref_registry_id = 2`))
+	assert.Equal(t, clonedIndex, reference.ParentID)
+	assert.Equal(t, clonedImage, reference.ChildID)
+
+	var nodes []*Nodes
+	require.NoError(t, db.Select(&nodes, `SELECT node_id, node_name, node_parent_id, node_registry_id,
+		node_is_file, node_path, node_generic_blob_id, node_created_at, node_created_by
+		FROM nodes WHERE node_registry_id = 2 ORDER BY node_path`))
+	require.Len(t, nodes, 3)
+	for _, node := range nodes {
+		assert.NotContains(t, []string{"root", "dir", "file"}, node.ID, "the node gets a new id")
+	}
+	assert.Nil(t, nodes[0].ParentNodeID)
+	require.NotNil(t, nodes[1].ParentNodeID)
+	assert.Equal(t, nodes[0].ID, *nodes[1].ParentNodeID)
+	require.NotNil(t, nodes[2].ParentNodeID)
+	assert.Equal(t, nodes[1].ID, *nodes[2].ParentNodeID)
+	assert.True(t, nodes[2].IsFile)
+}
@@ -0,0 +1,199 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type registryConfigRevisionDao struct {
+	db *sqlx.DB
+}
+
+func NewRegistryConfigRevisionDao(db *sqlx.DB) store.RegistryConfigRevisionRepository {
+	return &registryConfigRevisionDao{
+		db: db,
+	}
+}
+
+type registryConfigRevisionDB struct {
+	ID         int64  `db:"rcrev_id"`
+	RegistryID int64  `db:"rcrev_registry_id"`
+	Revision   int64  `db:"rcrev_revision"`
+	Config     string `db:"rcrev_config"`
+	CreatedAt  int64  `db:"rcrev_created_at"`
+	CreatedBy  int64  `db:"rcrev_created_by"`
+}
+
+// Create numbers the revision in the insert, so that concurrent revisions of a registry fail on
+// the unique constraint instead of sharing a number.
+func (dao *registryConfigRevisionDao) Create(ctx context.Context, revision *types.RegistryConfigRevision) error {
+	const sqlQuery = `
+		INSERT INTO registry_config_revisions (
+			rcrev_registry_id
+			,rcrev_revision
+			,rcrev_config
+			,rcrev_created_at
+			,rcrev_created_by
+		) VALUES (
+			:rcrev_registry_id
+			,(SELECT COALESCE(MAX(rcrev_revision), 0) + 1 FROM registry_config_revisions
+				WHERE rcrev_registry_id = :rcrev_registry_id)
+			,:rcrev_config
+			,:rcrev_created_at
+			,:rcrev_created_by
+		)
+		RETURNING rcrev_id, rcrev_revision`
+
+	if revision.CreatedAt.IsZero() {
+		revision.CreatedAt = time.Now()
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalRegistryConfigRevision(revision))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind registry config revision object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&revision.ID, &revision.Revision); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *registryConfigRevisionDao) Get(
+	ctx context.Context,
+	registryID int64,
+	revision int64,
+) (*types.RegistryConfigRevision, error) {
+	return dao.get(ctx, dao.selectRevisions().
+		Where(sq.Eq{"rcrev_registry_id": registryID, "rcrev_revision": revision}))
+}
+
+func (dao *registryConfigRevisionDao) GetLatest(
+	ctx context.Context,
+	registryID int64,
+) (*types.RegistryConfigRevision, error) {
+	return dao.get(ctx, dao.selectRevisions().
+		Where(sq.Eq{"rcrev_registry_id": registryID}).
+		OrderBy("rcrev_revision DESC").
+		Limit(1))
+}
+
+func (dao *registryConfigRevisionDao) List(
+	ctx context.Context,
+	registryID int64,
+	limit int,
+	offset int,
+) ([]*types.RegistryConfigRevision, error) {
+	stmt := dao.selectRevisions().
+		Where(sq.Eq{"rcrev_registry_id": registryID}).
+		OrderBy("rcrev_revision DESC").
+		Limit(util.SafeIntToUInt64(limit)).
+		Offset(util.SafeIntToUInt64(offset))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*registryConfigRevisionDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list registry config revisions")
+	}
+
+	revisions := make([]*types.RegistryConfigRevision, 0, len(dst))
+	for _, d := range dst {
+		revisions = append(revisions, mapToRegistryConfigRevision(d))
+	}
+	return revisions, nil
+}
+
+func (dao *registryConfigRevisionDao) Count(ctx context.Context, registryID int64) (int64, error) {
+	stmt := databaseg.Builder.Select("COUNT(*)").
+		From("registry_config_revisions").
+		Where(sq.Eq{"rcrev_registry_id": registryID})
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var count int64
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count registry config revisions")
+	}
+	return count, nil
+}
+
+func (dao *registryConfigRevisionDao) selectRevisions() sq.SelectBuilder {
+	return databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(registryConfigRevisionDB{}), ",")).
+		From("registry_config_revisions")
+}
+
+func (dao *registryConfigRevisionDao) get(
+	ctx context.Context,
+	stmt sq.SelectBuilder,
+) (*types.RegistryConfigRevision, error) {
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	dst := new(registryConfigRevisionDB)
+	if err = db.GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find registry config revision")
+	}
+	return mapToRegistryConfigRevision(dst), nil
+}
+
+func mapToInternalRegistryConfigRevision(in *types.RegistryConfigRevision) *registryConfigRevisionDB {
+	return &registryConfigRevisionDB{
+		ID:         in.ID,
+		RegistryID: in.RegistryID,
+		Revision:   in.Revision,
+		Config:     string(in.Config),
+		CreatedAt:  in.CreatedAt.UnixMilli(),
+		CreatedBy:  in.CreatedBy,
+	}
+}
+
+func mapToRegistryConfigRevision(in *registryConfigRevisionDB) *types.RegistryConfigRevision {
+	return &types.RegistryConfigRevision{
+		ID:         in.ID,
+		RegistryID: in.RegistryID,
+		Revision:   in.Revision,
+		Config:     []byte(in.Config),
+		CreatedAt:  time.UnixMilli(in.CreatedAt),
+		CreatedBy:  in.CreatedBy,
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type registryQueueDao struct {
+	db *sqlx.DB
+}
+
+func NewRegistryQueueDao(db *sqlx.DB) store.RegistryQueueRepository {
+	return &registryQueueDao{
+		db: db,
+	}
+}
+
+type pausedQueueDB struct {
+	RegistryID int64  `db:"rpq_registry_id"`
+	Queue      string `db:"rpq_queue"`
+	PausedAt   int64  `db:"rpq_paused_at"`
+	PausedBy   int64  `db:"rpq_paused_by"`
+}
+
+type queueStatsDB struct {
+	Pending int64         `db:"pending"`
+	Oldest  sql.NullInt64 `db:"oldest"`
+}
+
+// GCManifestStats returns the backlog of the manifest review queue. The review queues
+// are only maintained on postgres, where the garbage collection triggers exist.
+func (dao *registryQueueDao) GCManifestStats(ctx context.Context, registryID int64) (*types.QueueStats, error) {
+	if dao.db.DriverName() == SQLITE3 {
+		return &types.QueueStats{}, nil
+	}
+	stmt := databaseg.Builder.
+		Select("COUNT(*) AS pending", "MIN(created_at) AS oldest").
+		From("gc_manifest_review_queue").
+		Where("registry_id = ?", registryID)
+	return dao.queueStats(ctx, stmt)
+}
+
+// GCBlobStats returns the backlog of the blob review queue for the blobs linked to the registry.
+func (dao *registryQueueDao) GCBlobStats(ctx context.Context, registryID int64) (*types.QueueStats, error) {
+	if dao.db.DriverName() == SQLITE3 {
+		return &types.QueueStats{}, nil
+	}
+	stmt := databaseg.Builder.
+		Select("COUNT(*) AS pending", "MIN(q.created_at) AS oldest").
+		From("gc_blob_review_queue q").
+		Join("registry_blobs rb ON rb.rblob_blob_id = q.blob_id").
+		Where("rb.rblob_registry_id = ?", registryID)
+	return dao.queueStats(ctx, stmt)
+}
+
+func (dao *registryQueueDao) queueStats(
+	ctx context.Context,
+	stmt sq.SelectBuilder,
+) (*types.QueueStats, error) {
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	dst := &queueStatsDB{}
+	if err = db.GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get queue stats")
+	}
+
+	stats := &types.QueueStats{Pending: dst.Pending}
+	if dst.Oldest.Valid {
+		// the review queues record the time in unix seconds
+		stats.OldestPendingAt = time.Unix(dst.Oldest.Int64, 0).UnixMilli()
+	}
+	return stats, nil
+}
+
+func (dao *registryQueueDao) ListPaused(ctx context.Context, registryID int64) ([]*types.PausedQueue, error) {
+	stmt := databaseg.Builder.
+		Select("rpq_registry_id", "rpq_queue", "rpq_paused_at", "rpq_paused_by").
+		From("registry_paused_queues").
+		Where("rpq_registry_id = ?", registryID).
+		OrderBy("rpq_queue")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*pausedQueueDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list paused queues")
+	}
+
+	queues := make([]*types.PausedQueue, 0, len(dst))
+	for _, q := range dst {
+		queues = append(queues, &types.PausedQueue{
+			RegistryID: q.RegistryID,
+			Queue:      types.QueueName(q.Queue),
+			PausedAt:   q.PausedAt,
+			PausedBy:   q.PausedBy,
+		})
+	}
+	return queues, nil
+}
+
+func (dao *registryQueueDao) IsPaused(ctx context.Context, registryID int64, queue types.QueueName) (bool, error) {
+	stmt := databaseg.Builder.
+		Select("1").
+		From("registry_paused_queues").
+		Where("rpq_registry_id = ? AND rpq_queue = ?", registryID, string(queue))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var exists int
+	err = db.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find paused queue")
+	}
+	return true, nil
+}
+
+func (dao *registryQueueDao) Pause(ctx context.Context, pausedQueue *types.PausedQueue) error {
+	const sqlQuery = `
+		INSERT INTO registry_paused_queues (
+			rpq_registry_id
+			,rpq_queue
+			,rpq_paused_at
+			,rpq_paused_by
+		) VALUES (
+			:rpq_registry_id
+			,:rpq_queue
+			,:rpq_paused_at
+			,:rpq_paused_by
+		)
+		ON CONFLICT (rpq_registry_id, rpq_queue) DO NOTHING`
+
+	if pausedQueue.PausedAt == 0 {
+		pausedQueue.PausedAt = time.Now().UnixMilli()
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &pausedQueueDB{
+		RegistryID: pausedQueue.RegistryID,
+		Queue:      string(pausedQueue.Queue),
+		PausedAt:   pausedQueue.PausedAt,
+		PausedBy:   pausedQueue.PausedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind paused queue object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *registryQueueDao) Resume(ctx context.Context, registryID int64, queue types.QueueName) error {
+	stmt := databaseg.Builder.Delete("registry_paused_queues").
+		Where("rpq_registry_id = ? AND rpq_queue = ?", registryID, string(queue))
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	return nil
+}
@@ -0,0 +1,532 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type scanDao struct {
+	db *sqlx.DB
+}
+
+func NewScanDao(db *sqlx.DB) store.ScanRepository {
+	return &scanDao{
+		db: db,
+	}
+}
+
+type scanDB struct {
+	ID          int64  `db:"scan_id"`
+	RegistryID  int64  `db:"scan_registry_id"`
+	Digest      string `db:"scan_digest"`
+	Tool        string `db:"scan_tool"`
+	ToolVersion string `db:"scan_tool_version"`
+	StartedAt   int64  `db:"scan_started_at"`
+	FinishedAt  int64  `db:"scan_finished_at"`
+	CreatedAt   int64  `db:"scan_created_at"`
+	CreatedBy   int64  `db:"scan_created_by"`
+}
+
+type scanFindingDB struct {
+	ID             int64  `db:"finding_id"`
+	ScanID         int64  `db:"finding_scan_id"`
+	Identifier     string `db:"finding_identifier"`
+	Severity       string `db:"finding_severity"`
+	PackageName    string `db:"finding_package_name"`
+	PackageVersion string `db:"finding_package_version"`
+	FixedVersion   string `db:"finding_fixed_version"`
+	Title          string `db:"finding_title"`
+	URL            string `db:"finding_url"`
+}
+
+type scanComponentDB struct {
+	ID      int64  `db:"component_id"`
+	ScanID  int64  `db:"component_scan_id"`
+	Name    string `db:"component_name"`
+	Version string `db:"component_version"`
+	Purl    string `db:"component_purl"`
+}
+
+type packageOccurrenceDB struct {
+	RegistryID     int64  `db:"scan_registry_id"`
+	Digest         string `db:"scan_digest"`
+	PackageName    string `db:"package_name"`
+	PackageVersion string `db:"package_version"`
+}
+
+// latestScanCondition keeps the most recent scan of each tool for each digest of a registry.
+const latestScanCondition = `NOT EXISTS (
+	SELECT 1 FROM registry_scans n
+	WHERE n.scan_registry_id = s.scan_registry_id
+		AND n.scan_digest = s.scan_digest
+		AND n.scan_tool = s.scan_tool
+		AND (n.scan_finished_at > s.scan_finished_at
+			OR (n.scan_finished_at = s.scan_finished_at AND n.scan_id > s.scan_id)))`
+
+type scanSeverityCountDB struct {
+	ScanID   int64  `db:"finding_scan_id"`
+	Severity string `db:"finding_severity"`
+	Count    int64  `db:"count"`
+}
+
+func (dao *scanDao) Create(ctx context.Context, scan *types.Scan) error {
+	const sqlQuery = `
+		INSERT INTO registry_scans (
+			scan_registry_id
+			,scan_digest
+			,scan_tool
+			,scan_tool_version
+			,scan_started_at
+			,scan_finished_at
+			,scan_created_at
+			,scan_created_by
+		) VALUES (
+			:scan_registry_id
+			,:scan_digest
+			,:scan_tool
+			,:scan_tool_version
+			,:scan_started_at
+			,:scan_finished_at
+			,:scan_created_at
+			,:scan_created_by
+		)
+		RETURNING scan_id`
+
+	if scan.CreatedAt.IsZero() {
+		scan.CreatedAt = time.Now()
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, mapToInternalScan(scan))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind scan object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&scan.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *scanDao) CreateFindings(ctx context.Context, findings []*types.ScanFinding) error {
+	const sqlQuery = `
+		INSERT INTO registry_scan_findings (
+			finding_scan_id
+			,finding_identifier
+			,finding_severity
+			,finding_package_name
+			,finding_package_version
+			,finding_fixed_version
+			,finding_title
+			,finding_url
+		) VALUES (
+			:finding_scan_id
+			,:finding_identifier
+			,:finding_severity
+			,:finding_package_name
+			,:finding_package_version
+			,:finding_fixed_version
+			,:finding_title
+			,:finding_url
+		)
+		RETURNING finding_id`
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	for _, finding := range findings {
+		query, args, err := db.BindNamed(sqlQuery, mapToInternalScanFinding(finding))
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind scan finding object")
+		}
+		if err = db.QueryRowContext(ctx, query, args...).Scan(&finding.ID); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+		}
+	}
+	return nil
+}
+
+func (dao *scanDao) CreateComponents(ctx context.Context, components []*types.ScanComponent) error {
+	const sqlQuery = `
+		INSERT INTO registry_scan_components (
+			component_scan_id
+			,component_name
+			,component_version
+			,component_purl
+		) VALUES (
+			:component_scan_id
+			,:component_name
+			,:component_version
+			,:component_purl
+		)
+		RETURNING component_id`
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	for _, component := range components {
+		query, args, err := db.BindNamed(sqlQuery, &scanComponentDB{
+			ScanID:  component.ScanID,
+			Name:    component.Name,
+			Version: component.Version,
+			Purl:    component.Purl,
+		})
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind scan component object")
+		}
+		if err = db.QueryRowContext(ctx, query, args...).Scan(&component.ID); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+		}
+	}
+	return nil
+}
+
+// ListPackageOccurrences lists the versions of a package found by the latest scans of the artifacts
+// of the registries, either as a component or as the package of a finding. Package names are
+// compared case-insensitively.
+func (dao *scanDao) ListPackageOccurrences(
+	ctx context.Context,
+	registryIDs []int64,
+	packageName string,
+) ([]*types.PackageOccurrence, error) {
+	if len(registryIDs) == 0 {
+		return nil, nil
+	}
+	components := databaseg.Builder.
+		Select("DISTINCT s.scan_registry_id", "s.scan_digest",
+			"c.component_name AS package_name", "c.component_version AS package_version").
+		From("registry_scan_components c").
+		Join("registry_scans s ON s.scan_id = c.component_scan_id").
+		Where(sq.Eq{"s.scan_registry_id": registryIDs}).
+		Where("LOWER(c.component_name) = LOWER(?)", packageName).
+		Where(latestScanCondition)
+	findings := databaseg.Builder.
+		Select("DISTINCT s.scan_registry_id", "s.scan_digest",
+			"f.finding_package_name AS package_name", "f.finding_package_version AS package_version").
+		From("registry_scan_findings f").
+		Join("registry_scans s ON s.scan_id = f.finding_scan_id").
+		Where(sq.Eq{"s.scan_registry_id": registryIDs}).
+		Where("LOWER(f.finding_package_name) = LOWER(?)", packageName).
+		Where(latestScanCondition)
+
+	seen := make(map[types.PackageOccurrence]struct{})
+	var occurrences []*types.PackageOccurrence
+	for _, stmt := range []sq.SelectBuilder{components, findings} {
+		query, args, err := stmt.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+		}
+
+		db := dbtx.GetAccessor(ctx, dao.db)
+
+		var dst []*packageOccurrenceDB
+		if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+			return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list package occurrences")
+		}
+		for _, d := range dst {
+			key := types.PackageOccurrence{
+				RegistryID:     d.RegistryID,
+				Digest:         d.Digest,
+				PackageName:    strings.ToLower(d.PackageName),
+				PackageVersion: d.PackageVersion,
+			}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			occurrences = append(occurrences, &types.PackageOccurrence{
+				RegistryID:     d.RegistryID,
+				Digest:         d.Digest,
+				PackageName:    d.PackageName,
+				PackageVersion: d.PackageVersion,
+			})
+		}
+	}
+	return occurrences, nil
+}
+
+func (dao *scanDao) Get(ctx context.Context, registryID int64, id int64) (*types.Scan, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(scanDB{}), ",")).
+		From("registry_scans").
+		Where("scan_registry_id = ? AND scan_id = ?", registryID, id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	dst := new(scanDB)
+	if err = db.GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find scan")
+	}
+	return mapToScan(dst), nil
+}
+
+// ListByDigest lists the scans of the artifact with the given digest, the most recent first.
+func (dao *scanDao) ListByDigest(ctx context.Context, registryID int64, digest string) ([]*types.Scan, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(scanDB{}), ",")).
+		From("registry_scans").
+		Where("scan_registry_id = ? AND scan_digest = ?", registryID, digest).
+		OrderBy("scan_finished_at DESC", "scan_id DESC")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*scanDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list scans")
+	}
+
+	scans := make([]*types.Scan, 0, len(dst))
+	for _, d := range dst {
+		scans = append(scans, mapToScan(d))
+	}
+	return scans, nil
+}
+
+func (dao *scanDao) ListLatestByRegistry(ctx context.Context, registryID int64) ([]*types.Scan, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(scanDB{}), ",")).
+		From("registry_scans s").
+		Where("scan_registry_id = ?", registryID).
+		Where(latestScanCondition).
+		OrderBy("scan_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*scanDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list latest scans")
+	}
+
+	scans := make([]*types.Scan, 0, len(dst))
+	for _, d := range dst {
+		scans = append(scans, mapToScan(d))
+	}
+	return scans, nil
+}
+
+func (dao *scanDao) ListFindings(ctx context.Context, scanID int64) ([]*types.ScanFinding, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(scanFindingDB{}), ",")).
+		From("registry_scan_findings").
+		Where("finding_scan_id = ?", scanID).
+		OrderBy("finding_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*scanFindingDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list scan findings")
+	}
+
+	findings := make([]*types.ScanFinding, 0, len(dst))
+	for _, d := range dst {
+		findings = append(findings, mapToScanFinding(d))
+	}
+	return findings, nil
+}
+
+func (dao *scanDao) ListFindingsBySeverity(
+	ctx context.Context,
+	scanIDs []int64,
+	severity types.ScanSeverity,
+) ([]*types.ScanFinding, error) {
+	if len(scanIDs) == 0 {
+		return nil, nil
+	}
+
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(scanFindingDB{}), ",")).
+		From("registry_scan_findings").
+		Where(sq.Eq{"finding_scan_id": scanIDs, "finding_severity": string(severity)}).
+		OrderBy("finding_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*scanFindingDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list scan findings")
+	}
+
+	findings := make([]*types.ScanFinding, 0, len(dst))
+	for _, d := range dst {
+		findings = append(findings, mapToScanFinding(d))
+	}
+	return findings, nil
+}
+
+func (dao *scanDao) CountFindingsBySeverity(
+	ctx context.Context,
+	scanIDs []int64,
+	excludedIdentifiers []string,
+) (map[int64]types.ScanSeverityCounts, error) {
+	counts := make(map[int64]types.ScanSeverityCounts, len(scanIDs))
+	if len(scanIDs) == 0 {
+		return counts, nil
+	}
+
+	stmt := databaseg.Builder.
+		Select("finding_scan_id", "finding_severity", "COUNT(*) AS count").
+		From("registry_scan_findings").
+		Where(sq.Eq{"finding_scan_id": scanIDs}).
+		GroupBy("finding_scan_id", "finding_severity")
+	if len(excludedIdentifiers) > 0 {
+		stmt = stmt.Where(sq.NotEq{"UPPER(finding_identifier)": excludedIdentifiers})
+	}
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*scanSeverityCountDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count scan findings")
+	}
+
+	for _, d := range dst {
+		if counts[d.ScanID] == nil {
+			counts[d.ScanID] = types.ScanSeverityCounts{}
+		}
+		counts[d.ScanID][types.ScanSeverity(d.Severity)] = d.Count
+	}
+	return counts, nil
+}
+
+func (dao *scanDao) CountSuppressedFindings(
+	ctx context.Context,
+	scanIDs []int64,
+	suppressedIdentifiers []string,
+) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(scanIDs))
+	if len(scanIDs) == 0 || len(suppressedIdentifiers) == 0 {
+		return counts, nil
+	}
+
+	stmt := databaseg.Builder.
+		Select("finding_scan_id", "COUNT(*) AS count").
+		From("registry_scan_findings").
+		Where(sq.Eq{"finding_scan_id": scanIDs}).
+		Where(sq.Eq{"UPPER(finding_identifier)": suppressedIdentifiers}).
+		GroupBy("finding_scan_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*scanSeverityCountDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to count suppressed scan findings")
+	}
+
+	for _, d := range dst {
+		counts[d.ScanID] = d.Count
+	}
+	return counts, nil
+}
+
+func mapToInternalScan(in *types.Scan) *scanDB {
+	return &scanDB{
+		ID:          in.ID,
+		RegistryID:  in.RegistryID,
+		Digest:      in.Digest,
+		Tool:        in.Tool,
+		ToolVersion: in.ToolVersion,
+		StartedAt:   in.StartedAt.UnixMilli(),
+		FinishedAt:  in.FinishedAt.UnixMilli(),
+		CreatedAt:   in.CreatedAt.UnixMilli(),
+		CreatedBy:   in.CreatedBy,
+	}
+}
+
+func mapToScan(in *scanDB) *types.Scan {
+	return &types.Scan{
+		ID:          in.ID,
+		RegistryID:  in.RegistryID,
+		Digest:      in.Digest,
+		Tool:        in.Tool,
+		ToolVersion: in.ToolVersion,
+		StartedAt:   time.UnixMilli(in.StartedAt),
+		FinishedAt:  time.UnixMilli(in.FinishedAt),
+		CreatedAt:   time.UnixMilli(in.CreatedAt),
+		CreatedBy:   in.CreatedBy,
+	}
+}
+
+func mapToInternalScanFinding(in *types.ScanFinding) *scanFindingDB {
+	return &scanFindingDB{
+		ID:             in.ID,
+		ScanID:         in.ScanID,
+		Identifier:     in.Identifier,
+		Severity:       string(in.Severity),
+		PackageName:    in.PackageName,
+		PackageVersion: in.PackageVersion,
+		FixedVersion:   in.FixedVersion,
+		Title:          in.Title,
+		URL:            in.URL,
+	}
+}
+
+func mapToScanFinding(in *scanFindingDB) *types.ScanFinding {
+	return &types.ScanFinding{
+		ID:             in.ID,
+		ScanID:         in.ScanID,
+		Identifier:     in.Identifier,
+		Severity:       types.ScanSeverity(in.Severity),
+		PackageName:    in.PackageName,
+		PackageVersion: in.PackageVersion,
+		FixedVersion:   in.FixedVersion,
+		Title:          in.Title,
+		URL:            in.URL,
+	}
+}
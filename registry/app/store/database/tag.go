@@ -18,7 +18,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"sort"
 	"strings"
 	"time"
 
@@ -70,6 +69,8 @@ type tagDB struct {
 	UpdatedAt  int64         `db:"tag_updated_at"`
 	CreatedBy  sql.NullInt64 `db:"tag_created_by"`
 	UpdatedBy  sql.NullInt64 `db:"tag_updated_by"`
+	// LastPulledAt is only set once the tag has been pulled by name.
+	LastPulledAt sql.NullInt64 `db:"tag_last_pulled_at"`
 }
 
 type artifactMetadataDB struct {
@@ -96,6 +97,9 @@ type tagMetadataDB struct {
 	Payload       []byte               `db:"manifest_payload"`
 	MediaType     string               `db:"mt_media_type"`
 	DownloadCount int64                `db:"download_count"`
+	// UniqueDownloadCount and ConfigPayload are only selected by the list of the tags of an image.
+	UniqueDownloadCount int64  `db:"unique_download_count"`
+	ConfigPayload       []byte `db:"manifest_configuration_payload"`
 }
 
 type tagDetailDB struct {
@@ -168,8 +172,11 @@ func (t tagDao) LockTagByNameForUpdate(
 	stmt := databaseg.Builder.Select("1").
 		From("tags").
 		Where("tag_registry_id = ? AND tag_name = ?", repoID, name).
-		Limit(1).
-		Suffix("FOR UPDATE")
+		Limit(1)
+	// SQLite has no row locks; a write transaction already holds the database lock.
+	if t.db.DriverName() != SQLITE3 {
+		stmt = stmt.Suffix("FOR UPDATE")
+	}
 
 	sqlQuery, args, err := stmt.ToSql()
 	if err != nil {
@@ -300,8 +307,13 @@ func (t tagDao) HasTagsAfterName(
 	if filters.OrderBy != "published_at" {
 		stmt = stmt.Where("tag_name "+comparison+" ?", filters.LastEntry)
 	} else {
+		// SQLite has no GREATEST; its multi-argument MAX is the scalar equivalent.
+		greatest := "GREATEST"
+		if t.db.DriverName() == SQLITE3 {
+			greatest = "MAX"
+		}
 		stmt = stmt.Where(
-			"AND (GREATEST(tag_created_at, tag_updated_at), tag_name) "+comparison+" (? ?)",
+			"("+greatest+"(tag_created_at, tag_updated_at), tag_name) "+comparison+" (?, ?)",
 			filters.PublishedAt, filters.LastEntry,
 		)
 	}
@@ -357,12 +369,13 @@ func (t tagDao) GetAllArtifactsByParentID(
 
 	q2 := t.GetAllArtifactsQueryByParentIDForOCI(parentID, latestVersion, registryIDs, packageTypes, search)
 
-	q1SQL, q1Args, err := q1.ToSql()
+	// Both queries are rendered with ? placeholders, numbered once they're combined.
+	q1SQL, q1Args, err := q1.PlaceholderFormat(sq.Question).ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
 
-	q2SQL, _, err := q2.ToSql()
+	q2SQL, q2Args, err := q2.PlaceholderFormat(sq.Question).ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to convert query to sql")
 	}
@@ -374,7 +387,9 @@ func (t tagDao) GetAllArtifactsByParentID(
 `, q1SQL, q2SQL)
 
 	// Combine query arguments
-	finalArgs := q1Args
+	finalArgs := make([]any, 0, len(q1Args)+len(q2Args))
+	finalArgs = append(finalArgs, q1Args...)
+	finalArgs = append(finalArgs, q2Args...)
 
 	// Apply sorting based on provided field
 	sortField := "modified_at"
@@ -388,6 +403,10 @@ func (t tagDao) GetAllArtifactsByParentID(
 
 	// Add pagination (LIMIT and OFFSET) **after** the WHERE and ORDER BY clauses
 	finalQuery = fmt.Sprintf("%s LIMIT %d OFFSET %d", finalQuery, limit, offset)
+	finalQuery, err = sq.Dollar.ReplacePlaceholders(finalQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
 
 	db := dbtx.GetAccessor(ctx, t.db)
 
@@ -415,43 +434,18 @@ func (t tagDao) GetAllArtifactsQueryByParentIDForOCI(
 		Join("registries r ON t.tag_registry_id = r.registry_id").
 		Where("r.registry_parent_id = ?", parentID).
 		Join(
-			"images i ON i.image_registry_id = t.tag_registry_id AND"+
+			"images i ON i.image_registry_id = t.tag_registry_id AND" +
 				" i.image_name = t.tag_image_name",
 		).
-		LeftJoin(
-			`( SELECT i.image_name, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id 
-			GROUP BY a.artifact_image_id ) as t1 
-			JOIN images i ON i.image_id = t1.artifact_image_id 
-			JOIN registries r ON r.registry_id = i.image_registry_id 
-			WHERE r.registry_parent_id = ? GROUP BY i.image_name) as t2 
-			ON t.tag_image_name = t2.image_name`, parentID,
-		)
+		JoinClause(sq.Expr("LEFT JOIN (?) AS t2 ON t.tag_image_name = t2.image_name",
+			imageDownloads(inSpace(parentID), artifactFilter{search: search})))
 
+	f := artifactFilter{registryNames: *registryIDs, packageTypes: packageTypes, search: search}
 	if latestVersion {
-		q2 = q2.Join(
-			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
-			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
-			JOIN registries r ON t.tag_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? ) AS a 
-			ON t.tag_id = a.id`, parentID, // nolint:goconst
-		).
+		q2 = q2.JoinClause(sq.Expr("JOIN (?) AS a ON t.tag_id = a.id", latestTags(inSpace(parentID), f))).
 			Where("a.rank = 1")
 	}
-
-	if len(*registryIDs) > 0 {
-		q2 = q2.Where(sq.Eq{"r.registry_name": registryIDs})
-	}
-
-	if len(packageTypes) > 0 {
-		q2 = q2.Where(sq.Eq{"r.registry_package_type": packageTypes})
-	}
-
-	if search != "" {
-		q2 = q2.Where("t.tag_image_name LIKE ?", sqlPartialMatch(search))
-	}
-	return q2
+	return f.apply(q2, "t.tag_image_name", "i.image_labels")
 }
 
 func (t tagDao) GetAllArtifactOnParentIDQueryForNonOCI(
@@ -471,42 +465,15 @@ func (t tagDao) GetAllArtifactOnParentIDQueryForNonOCI(
 		Join("images i ON i.image_id = ar.artifact_image_id").
 		Join("registries r ON i.image_registry_id = r.registry_id").
 		Where("r.registry_parent_id = ? AND r.registry_package_type NOT IN ('DOCKER', 'HELM')", parentID).
-		LeftJoin(
-			`( SELECT a.artifact_version, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id 
-			GROUP BY a.artifact_id ) as t1 
-            JOIN artifacts a ON a.artifact_id = t1.artifact_id 
-			JOIN images i ON i.image_id = a.artifact_image_id 
-			JOIN registries r ON r.registry_id = i.image_registry_id 
-			WHERE r.registry_parent_id = ? GROUP BY a.artifact_version) as t2 
-			ON ar.artifact_version = t2.artifact_version`, parentID,
-		)
+		JoinClause(sq.Expr("LEFT JOIN (?) AS t2 ON ar.artifact_version = t2.artifact_version",
+			versionDownloads(inSpace(parentID))))
 
+	f := artifactFilter{registryNames: *registryIDs, packageTypes: packageTypes, search: search}
 	if latestVersion {
-		q1 = q1.Join(
-			`(SELECT ar.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY ar.artifact_image_id 
-			ORDER BY ar.artifact_updated_at DESC) AS rank FROM artifacts ar 
-            JOIN images i ON i.image_id = ar.artifact_image_id 
-			JOIN registries r ON i.image_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? ) AS a 
-			ON ar.artifact_id = a.id`, parentID, // nolint:goconst
-		).
+		q1 = q1.JoinClause(sq.Expr("JOIN (?) AS a ON ar.artifact_id = a.id", latestArtifacts(inSpace(parentID), f))).
 			Where("a.rank = 1")
 	}
-
-	if len(*registryIDs) > 0 {
-		q1 = q1.Where(sq.Eq{"r.registry_name": registryIDs})
-	}
-
-	if len(packageTypes) > 0 {
-		q1 = q1.Where(sq.Eq{"r.registry_package_type": packageTypes})
-	}
-
-	if search != "" {
-		q1 = q1.Where("i.image_name LIKE ?", sqlPartialMatch(search))
-	}
-	return q1
+	return f.apply(q1, "i.image_name", "i.image_labels")
 }
 
 func (t tagDao) CountAllOCIArtifactsByParentID(
@@ -523,26 +490,12 @@ func (t tagDao) CountAllOCIArtifactsByParentID(
 				" AND ar.image_name = t.tag_image_name",
 		)
 
+	f := artifactFilter{registryNames: *registryIDs, packageTypes: packageTypes, search: search}
 	if latestVersion {
-		q = q.Join(
-			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
-			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
-			JOIN registries r ON t.tag_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? ) AS a 
-			ON t.tag_id = a.id`, parentID, // nolint:goconst
-		).Where("a.rank = 1")
-	}
-	if len(*registryIDs) > 0 {
-		q = q.Where(sq.Eq{"r.registry_name": registryIDs})
-	}
-
-	if search != "" {
-		q = q.Where("image_name LIKE ?", sqlPartialMatch(search))
-	}
-
-	if len(packageTypes) > 0 {
-		q = q.Where(sq.Eq{"registry_package_type": packageTypes})
+		q = q.JoinClause(sq.Expr("JOIN (?) AS a ON t.tag_id = a.id", latestTags(inSpace(parentID), f))).
+			Where("a.rank = 1")
 	}
+	q = f.apply(q, "t.tag_image_name", "ar.image_labels")
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -569,28 +522,12 @@ func (t tagDao) CountAllArtifactsByParentID(
 		Join("registries r ON i.image_registry_id = r.registry_id").
 		Where("r.registry_parent_id = ? AND r.registry_package_type NOT IN ('DOCKER', 'HELM')", parentID)
 
+	f := artifactFilter{registryNames: *registryIDs, packageTypes: packageTypes, search: search}
 	if latestVersion {
-		q = q.Join(
-			`(SELECT ar.artifact_id as id, ROW_NUMBER() OVER (PARTITION BY ar.artifact_image_id 
-			ORDER BY ar.artifact_updated_at DESC) AS rank FROM artifacts ar 
-            JOIN images i ON i.image_id = ar.artifact_image_id 
-			JOIN registries r ON i.image_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? ) AS a 
-			ON ar.artifact_id = a.id`, parentID, // nolint:goconst
-		).
+		q = q.JoinClause(sq.Expr("JOIN (?) AS a ON ar.artifact_id = a.id", latestArtifacts(inSpace(parentID), f))).
 			Where("a.rank = 1")
 	}
-	if len(*registryIDs) > 0 {
-		q = q.Where(sq.Eq{"r.registry_name": registryIDs})
-	}
-
-	if search != "" {
-		q = q.Where("image_name LIKE ?", sqlPartialMatch(search))
-	}
-
-	if len(packageTypes) > 0 {
-		q = q.Where(sq.Eq{"registry_package_type": packageTypes})
-	}
+	q = f.apply(q, "i.image_name", "i.image_labels")
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -679,6 +616,97 @@ func (t tagDao) DeleteTag(ctx context.Context, registryID int64, imageName strin
 	return nil
 }
 
+// UpdateLastPulledAt records the time a tag was last pulled by name.
+func (t tagDao) UpdateLastPulledAt(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	name string,
+	pulledAt time.Time,
+) error {
+	stmt := databaseg.Builder.Update("tags").
+		Set("tag_last_pulled_at", pulledAt.UnixMilli()).
+		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ?", registryID, imageName, name)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, t.db)
+
+	if _, err = db.ExecContext(ctx, sql, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to update tag last pulled time")
+	}
+	return nil
+}
+
+// UpdateManifestIfMatch re-points a tag to a manifest as a single compare-and-swap, so that concurrent
+// updates of the same tag based on the same expected manifest can't both succeed.
+func (t tagDao) UpdateManifestIfMatch(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	name string,
+	manifestID int64,
+	expectedManifestIDs []int64,
+) (bool, error) {
+	session, _ := request.AuthSessionFrom(ctx)
+	stmt := databaseg.Builder.Update("tags").
+		Set("tag_manifest_id", manifestID).
+		Set("tag_updated_at", time.Now().UnixMilli()).
+		Set("tag_updated_by", session.Principal.ID).
+		Where("tag_registry_id = ? AND tag_image_name = ? AND tag_name = ?", registryID, imageName, name)
+	if len(expectedManifestIDs) > 0 {
+		stmt = stmt.Where(sq.Eq{"tag_manifest_id": expectedManifestIDs})
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, t.db)
+
+	result, err := db.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "Failed to update tag manifest")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of updated tags")
+	}
+	return count > 0, nil
+}
+
+// ListTagsNotPulledSince lists the tags of a registry that have not been pulled since the given time.
+// Tags that were never pulled are matched on their creation time.
+func (t tagDao) ListTagsNotPulledSince(
+	ctx context.Context,
+	registryID int64,
+	since time.Time,
+) ([]*types.Tag, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(tagDB{}), ",")).
+		From("tags").
+		Where("tag_registry_id = ?", registryID).
+		Where("COALESCE(tag_last_pulled_at, tag_created_at) < ?", since.UnixMilli()).
+		OrderBy("tag_image_name", "tag_name")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, t.db)
+
+	dst := []*tagDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list tags not pulled since %s", since)
+	}
+	return t.mapToTagList(ctx, dst)
+}
+
 func (t tagDao) GetLatestTagMetadata(
 	ctx context.Context,
 	parentID int64,
@@ -765,6 +793,64 @@ func (t tagDao) GetLatestTagMetadata(
 	return t.mapToArtifactMetadata(ctx, dst)
 }
 
+func (t tagDao) GetTagNamesByManifestIDs(
+	ctx context.Context, repoID int64,
+	manifestIDs []int64,
+) (map[int64][]string, error) {
+	tags := make(map[int64][]string)
+	if len(manifestIDs) == 0 {
+		return tags, nil
+	}
+	q := databaseg.Builder.Select("tag_manifest_id, tag_name").
+		From("tags").
+		Where("tag_registry_id = ?", repoID).
+		Where(sq.Eq{"tag_manifest_id": manifestIDs}).
+		OrderBy("tag_updated_at DESC")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, t.db)
+
+	dst := []*tagDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find tag names")
+	}
+	for _, d := range dst {
+		tags[d.ManifestID] = append(tags[d.ManifestID], d.Name)
+	}
+	return tags, nil
+}
+
+func (t tagDao) GetTagNamesByImageNames(
+	ctx context.Context, registryID int64,
+	imageNames []string,
+) (map[string][]string, error) {
+	if len(imageNames) == 0 {
+		return map[string][]string{}, nil
+	}
+	q := databaseg.Builder.Select("tag_image_name AS name, tag_name AS latest_version").
+		From("tags").
+		Where("tag_registry_id = ?", registryID).
+		Where(sq.Eq{"tag_image_name": imageNames}).
+		OrderBy("tag_updated_at DESC")
+
+	sql, args, err := q.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, t.db)
+
+	dst := []*artifactMetadataDB{}
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find tag names")
+	}
+	return mapToVersionsByImageName(dst), nil
+}
+
 func (t tagDao) GetLatestTagName(
 	ctx context.Context,
 	parentID int64,
@@ -852,10 +938,11 @@ func (t tagDao) GetLatestTag(ctx context.Context, repoID int64, imageName string
 }
 
 func (t tagDao) GetAllArtifactsByRepo(
-	ctx context.Context, parentID int64, repoKey string,
+	ctx context.Context, registryIDs []int64,
 	sortByField string, sortByOrder string, limit int, offset int, search string,
 	labels []string,
 ) (*[]types.ArtifactMetadata, error) {
+	scope := inRegistries(registryIDs)
 	q := databaseg.Builder.Select(
 		`r.registry_name as repo_name, t.tag_image_name as name, 
 		r.registry_package_type as package_type, t.tag_name as latest_version, 
@@ -863,41 +950,17 @@ func (t tagDao) GetAllArtifactsByRepo(
 		COALESCE(t2.download_count, 0) as download_count `,
 	).
 		From("tags t").
-		Join(
-			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
-			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
-			JOIN registries r ON t.tag_registry_id = r.registry_id  
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? ) AS a 
-			ON t.tag_id = a.id`, parentID, repoKey, // nolint:goconst
-		).
+		JoinClause(sq.Expr("JOIN (?) AS a ON t.tag_id = a.id", latestTags(scope, artifactFilter{
+			search: search, labels: labels,
+		}))).
 		Join("registries r ON t.tag_registry_id = r.registry_id").
 		Join(
-			"images ar ON ar.image_registry_id = t.tag_registry_id"+
+			"images ar ON ar.image_registry_id = t.tag_registry_id" +
 				" AND ar.image_name = t.tag_image_name",
 		).
-		LeftJoin(
-			`( SELECT i.image_name, SUM(COALESCE(t1.download_count, 0)) as download_count FROM 
-			( SELECT a.artifact_image_id, COUNT(d.download_stat_id) as download_count 
-			FROM artifacts a 
-			JOIN download_stats d ON d.download_stat_artifact_id = a.artifact_id GROUP BY 
-			a.artifact_image_id ) as t1 
-			JOIN images i ON i.image_id = t1.artifact_image_id 
-			JOIN registries r ON r.registry_id = i.image_registry_id 
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? GROUP BY i.image_name) as t2 
-			ON t.tag_image_name = t2.image_name`, parentID, repoKey,
-		).
-		Where("a.rank = 1 ")
-
-	if search != "" {
-		q = q.Where("tag_image_name LIKE ?", sqlPartialMatch(search))
-	}
-
-	if len(labels) > 0 {
-		sort.Strings(labels)
-		labelsVal := util.GetEmptySQLString(util.ArrToString(labels))
-		labelsVal.String = labelSeparatorStart + labelsVal.String + labelSeparatorEnd
-		q = q.Where("'^_' || ar.image_labels || '^_' LIKE ?", labelsVal)
-	}
+		JoinClause(sq.Expr("LEFT JOIN (?) AS t2 ON t.tag_image_name = t2.image_name",
+			imageDownloads(scope, artifactFilter{search: search}))).
+		Where("a.rank = 1")
 
 	sortField := "t.tag_" + sortByField
 	if sortByField == downloadCount {
@@ -921,34 +984,14 @@ func (t tagDao) GetAllArtifactsByRepo(
 
 // nolint:goconst
 func (t tagDao) CountAllArtifactsByRepo(
-	ctx context.Context, parentID int64, repoKey string,
+	ctx context.Context, registryIDs []int64,
 	search string, labels []string,
 ) (int64, error) {
 	q := databaseg.Builder.Select("COUNT(*)").
-		From("tags t").
-		Join(
-			`(SELECT t.tag_id as id, ROW_NUMBER() OVER (PARTITION BY t.tag_registry_id, t.tag_image_name 
-			ORDER BY t.tag_updated_at DESC) AS rank FROM tags t 
-			JOIN registries r ON t.tag_registry_id = r.registry_id 
-			WHERE r.registry_parent_id = ? AND r.registry_name = ? ) AS a ON t.tag_id = a.id`, parentID, repoKey,
-		).
-		Join("registries r ON t.tag_registry_id = r.registry_id").
-		Join(
-			"images ar ON ar.image_registry_id = t.tag_registry_id AND" +
-				" ar.image_name = t.tag_image_name",
-		).
-		Where("a.rank = 1 ")
-
-	if search != "" {
-		q = q.Where("tag_image_name LIKE ?", sqlPartialMatch(search))
-	}
-
-	if len(labels) > 0 {
-		sort.Strings(labels)
-		labelsVal := util.GetEmptySQLString(util.ArrToString(labels))
-		labelsVal.String = labelSeparatorStart + labelsVal.String + labelSeparatorEnd
-		q = q.Where("'^_' || ar.image_labels || '^_' LIKE ?", labelsVal)
-	}
+		FromSelect(latestTags(inRegistries(registryIDs), artifactFilter{
+			search: search, labels: labels,
+		}), "a").
+		Where("a.rank = 1")
 
 	sql, args, err := q.ToSql()
 	if err != nil {
@@ -974,6 +1017,7 @@ func (t tagDao) GetAllTagsByRepoAndImage(
         SELECT 
             a.artifact_image_id, 
             COUNT(d.download_stat_id) AS download_count, 
+            SUM(CASE WHEN d.download_stat_unique THEN 1 ELSE 0 END) AS unique_download_count, 
             i.image_name, 
             i.image_registry_id
         FROM artifacts a
@@ -992,8 +1036,10 @@ func (t tagDao) GetAllTagsByRepoAndImage(
             m.manifest_schema_version, 
             m.manifest_non_conformant, 
             m.manifest_payload, 
+            m.manifest_configuration_payload, 
             mt.mt_media_type, 
-            COALESCE(dc.download_count, 0) AS download_count
+            COALESCE(dc.download_count, 0) AS download_count,
+            COALESCE(dc.unique_download_count, 0) AS unique_download_count
         `).
 		From("tags t").
 		Join("registries r ON t.tag_registry_id = r.registry_id").
@@ -1038,7 +1084,7 @@ func (t tagDao) CountAllTagsByRepoAndImage(
 		Join("registries ON tag_registry_id = registry_id").
 		Join("manifests ON tag_manifest_id = manifest_id").
 		Where(
-			"registry_parent_id = ? AND registry_name = ?"+
+			"registry_parent_id = ? AND registry_name = ? "+
 				"AND tag_image_name = ?", parentID, repoKey, image,
 		)
 
@@ -1142,16 +1188,21 @@ func (t tagDao) mapToTag(_ context.Context, dst *tagDB) (*types.Tag, error) {
 	if dst.UpdatedBy.Valid {
 		updatedBy = dst.UpdatedBy.Int64
 	}
+	var lastPulledAt time.Time
+	if dst.LastPulledAt.Valid {
+		lastPulledAt = time.UnixMilli(dst.LastPulledAt.Int64)
+	}
 	return &types.Tag{
-		ID:         dst.ID,
-		Name:       dst.Name,
-		ImageName:  dst.ImageName,
-		RegistryID: dst.RegistryID,
-		ManifestID: dst.ManifestID,
-		CreatedAt:  time.UnixMilli(dst.CreatedAt),
-		UpdatedAt:  time.UnixMilli(dst.UpdatedAt),
-		CreatedBy:  createdBy,
-		UpdatedBy:  updatedBy,
+		ID:           dst.ID,
+		Name:         dst.Name,
+		ImageName:    dst.ImageName,
+		RegistryID:   dst.RegistryID,
+		ManifestID:   dst.ManifestID,
+		CreatedAt:    time.UnixMilli(dst.CreatedAt),
+		UpdatedAt:    time.UnixMilli(dst.UpdatedAt),
+		CreatedBy:    createdBy,
+		UpdatedBy:    updatedBy,
+		LastPulledAt: lastPulledAt,
 	}, nil
 }
 
@@ -1223,16 +1274,18 @@ func (t tagDao) mapToTagMetadata(
 	dst *tagMetadataDB,
 ) (*types.TagMetadata, error) {
 	return &types.TagMetadata{
-		Name:          dst.Name,
-		Size:          dst.Size,
-		PackageType:   dst.PackageType,
-		DigestCount:   dst.DigestCount,
-		ModifiedAt:    time.UnixMilli(dst.ModifiedAt),
-		SchemaVersion: dst.SchemaVersion,
-		NonConformant: dst.NonConformant,
-		MediaType:     dst.MediaType,
-		Payload:       dst.Payload,
-		DownloadCount: dst.DownloadCount,
+		Name:                dst.Name,
+		Size:                dst.Size,
+		PackageType:         dst.PackageType,
+		DigestCount:         dst.DigestCount,
+		ModifiedAt:          time.UnixMilli(dst.ModifiedAt),
+		SchemaVersion:       dst.SchemaVersion,
+		NonConformant:       dst.NonConformant,
+		MediaType:           dst.MediaType,
+		Payload:             dst.Payload,
+		ConfigPayload:       dst.ConfigPayload,
+		DownloadCount:       dst.DownloadCount,
+		UniqueDownloadCount: dst.UniqueDownloadCount,
 	}, nil
 }
 
@@ -1250,3 +1303,11 @@ func (t tagDao) mapToTagDetail(
 		DownloadCount: dst.DownloadCount,
 	}, nil
 }
+
+func mapToVersionsByImageName(dst []*artifactMetadataDB) map[string][]string {
+	versions := make(map[string][]string)
+	for _, d := range dst {
+		versions[d.Name] = append(versions[d.Name], d.LatestVersion)
+	}
+	return versions
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateManifestIfMatch(t *testing.T) {
+	db := setupPlanDB(t)
+	ctx := testSessionContext()
+	tags := NewTagDao(db)
+
+	v1 := createTestManifest(ctx, t, db, 1, "app", "v1", nil)
+	v2 := createTestManifest(ctx, t, db, 1, "app", "v2", nil)
+	v3 := createTestManifest(ctx, t, db, 1, "app", "v3", nil)
+	require.NoError(t, tags.CreateOrUpdate(ctx, &types.Tag{
+		Name:       "latest",
+		ImageName:  "app",
+		RegistryID: 1,
+		ManifestID: v1.ID,
+	}))
+	manifestOf := func() int64 {
+		t.Helper()
+		tag, err := tags.FindTag(ctx, 1, "app", "latest")
+		require.NoError(t, err)
+		return tag.ManifestID
+	}
+
+	updated, err := tags.UpdateManifestIfMatch(ctx, 1, "app", "latest", v2.ID, []int64{v3.ID, v1.ID})
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, v2.ID, manifestOf())
+
+	// a second update based on the same expected manifest must not overwrite the first one
+	updated, err = tags.UpdateManifestIfMatch(ctx, 1, "app", "latest", v3.ID, []int64{v1.ID})
+	require.NoError(t, err)
+	assert.False(t, updated)
+	assert.Equal(t, v2.ID, manifestOf())
+
+	updated, err = tags.UpdateManifestIfMatch(ctx, 1, "app", "latest", v3.ID, nil)
+	require.NoError(t, err)
+	assert.True(t, updated, "without expected manifests any existing tag is updated")
+	assert.Equal(t, v3.ID, manifestOf())
+
+	updated, err = tags.UpdateManifestIfMatch(ctx, 1, "app", "missing", v1.ID, nil)
+	require.NoError(t, err)
+	assert.False(t, updated, "a missing tag is not created")
+	updated, err = tags.UpdateManifestIfMatch(ctx, 2, "app", "latest", v1.ID, []int64{v3.ID})
+	require.NoError(t, err)
+	assert.False(t, updated, "the tag of another registry is not updated")
+}
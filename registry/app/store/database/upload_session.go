@@ -0,0 +1,153 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type uploadSessionDao struct {
+	db *sqlx.DB
+}
+
+func NewUploadSessionDao(db *sqlx.DB) store.UploadSessionRepository {
+	return &uploadSessionDao{
+		db: db,
+	}
+}
+
+type uploadSessionDB struct {
+	ID        string `db:"upsess_id"`
+	Path      string `db:"upsess_path"`
+	Name      string `db:"upsess_name"`
+	Offset    int64  `db:"upsess_offset"`
+	CreatedAt int64  `db:"upsess_created_at"`
+	UpdatedAt int64  `db:"upsess_updated_at"`
+}
+
+func (dao *uploadSessionDao) Upsert(ctx context.Context, session *types.UploadSession) error {
+	const sqlQuery = `
+		INSERT INTO registry_upload_sessions (
+			upsess_id
+			,upsess_path
+			,upsess_name
+			,upsess_offset
+			,upsess_created_at
+			,upsess_updated_at
+		) VALUES (
+			:upsess_id
+			,:upsess_path
+			,:upsess_name
+			,:upsess_offset
+			,:upsess_created_at
+			,:upsess_updated_at
+		)
+		ON CONFLICT (upsess_id)
+		DO UPDATE SET
+			upsess_offset = :upsess_offset
+			,upsess_updated_at = :upsess_updated_at`
+
+	now := time.Now()
+	if session.CreatedAt.IsZero() {
+		session.CreatedAt = now
+	}
+	session.UpdatedAt = now
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &uploadSessionDB{
+		ID:        session.ID,
+		Path:      session.Path,
+		Name:      session.Name,
+		Offset:    session.Offset,
+		CreatedAt: session.CreatedAt.UnixMilli(),
+		UpdatedAt: session.UpdatedAt.UnixMilli(),
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind upload session object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
+
+func (dao *uploadSessionDao) Get(ctx context.Context, id string) (*types.UploadSession, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(uploadSessionDB{}), ",")).
+		From("registry_upload_sessions").
+		Where("upsess_id = ?", id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	dst := &uploadSessionDB{}
+	if err = db.GetContext(ctx, dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to find upload session")
+	}
+	return &types.UploadSession{
+		ID:        dst.ID,
+		Path:      dst.Path,
+		Name:      dst.Name,
+		Offset:    dst.Offset,
+		CreatedAt: time.UnixMilli(dst.CreatedAt),
+		UpdatedAt: time.UnixMilli(dst.UpdatedAt),
+	}, nil
+}
+
+func (dao *uploadSessionDao) Delete(ctx context.Context, id string) error {
+	_, err := dao.delete(ctx, databaseg.Builder.Delete("registry_upload_sessions").
+		Where("upsess_id = ?", id))
+	return err
+}
+
+func (dao *uploadSessionDao) DeleteUpdatedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return dao.delete(ctx, databaseg.Builder.Delete("registry_upload_sessions").
+		Where("upsess_updated_at < ?", before.UnixMilli()))
+}
+
+func (dao *uploadSessionDao) delete(ctx context.Context, stmt sq.DeleteBuilder) (int64, error) {
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	return count, nil
+}
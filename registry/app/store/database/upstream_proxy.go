@@ -69,6 +69,11 @@ type upstreamProxyConfigDB struct {
 	UserNameSecretIdentifier sql.NullString `db:"upstream_proxy_config_user_name_secret_identifier"`
 	UserNameSecretSpaceID    sql.NullInt64  `db:"upstream_proxy_config_user_name_secret_space_id"`
 	Token                    string         `db:"upstream_proxy_config_token"`
+	AwsRoleArn               string         `db:"upstream_proxy_config_aws_role_arn"`
+	CredentialsUpdatedAt     int64          `db:"upstream_proxy_config_credentials_updated_at"`
+	MetadataTTL              int64          `db:"upstream_proxy_config_metadata_ttl"`
+	ArtifactTTL              int64          `db:"upstream_proxy_config_artifact_ttl"`
+	Offline                  bool           `db:"upstream_proxy_config_offline"`
 	CreatedAt                int64          `db:"upstream_proxy_config_created_at"`
 	UpdatedAt                int64          `db:"upstream_proxy_config_updated_at"`
 	CreatedBy                int64          `db:"upstream_proxy_config_created_by"`
@@ -92,6 +97,14 @@ type upstreamProxyDB struct {
 	UserNameSecretIdentifier sql.NullString       `db:"user_name_secret_identifier"`
 	UserNameSecretSpaceID    sql.NullInt32        `db:"user_name_secret_space_id"`
 	Token                    string               `db:"token"`
+	AwsRoleArn               sql.NullString       `db:"aws_role_arn"`
+	CredentialsUpdatedAt     sql.NullInt64        `db:"credentials_updated_at"`
+	StoragePrefix            sql.NullString       `db:"storage_prefix"`
+	MTLSMode                 sql.NullString       `db:"mtls_mode"`
+	MTLSCACertificates       sql.NullString       `db:"mtls_ca_certificates"`
+	MetadataTTL              sql.NullInt64        `db:"metadata_ttl"`
+	ArtifactTTL              sql.NullInt64        `db:"artifact_ttl"`
+	Offline                  sql.NullBool         `db:"offline"`
 	CreatedAt                int64                `db:"created_at"`
 	UpdatedAt                int64                `db:"updated_at"`
 	CreatedBy                sql.NullInt64        `db:"created_by"`
@@ -116,6 +129,14 @@ func getUpstreamProxyQuery() squirrel.SelectBuilder {
 			" u.upstream_proxy_config_user_name_secret_identifier as user_name_secret_identifier," +
 			" u.upstream_proxy_config_user_name_secret_space_id as user_name_secret_space_id," +
 			" u.upstream_proxy_config_token as token," +
+			" u.upstream_proxy_config_aws_role_arn as aws_role_arn," +
+			" u.upstream_proxy_config_credentials_updated_at as credentials_updated_at," +
+			" u.upstream_proxy_config_metadata_ttl as metadata_ttl," +
+			" u.upstream_proxy_config_artifact_ttl as artifact_ttl," +
+			" u.upstream_proxy_config_offline as offline," +
+			" r.registry_storage_prefix as storage_prefix," +
+			" r.registry_mtls_mode as mtls_mode," +
+			" r.registry_mtls_ca_certificates as mtls_ca_certificates," +
 			" r.registry_created_at as created_at," +
 			" r.registry_updated_at as updated_at ").
 		From("registries r ").
@@ -203,6 +224,11 @@ func (r UpstreamproxyDao) Create(
 			,upstream_proxy_config_user_name_secret_identifier
 			,upstream_proxy_config_user_name_secret_space_id
 			,upstream_proxy_config_token
+			,upstream_proxy_config_aws_role_arn
+			,upstream_proxy_config_credentials_updated_at
+			,upstream_proxy_config_metadata_ttl
+			,upstream_proxy_config_artifact_ttl
+			,upstream_proxy_config_offline
 			,upstream_proxy_config_created_at
 			,upstream_proxy_config_updated_at
 			,upstream_proxy_config_created_by
@@ -218,6 +244,11 @@ func (r UpstreamproxyDao) Create(
 			,:upstream_proxy_config_user_name_secret_identifier
 			,:upstream_proxy_config_user_name_secret_space_id
 			,:upstream_proxy_config_token
+			,:upstream_proxy_config_aws_role_arn
+			,:upstream_proxy_config_credentials_updated_at
+			,:upstream_proxy_config_metadata_ttl
+			,:upstream_proxy_config_artifact_ttl
+			,:upstream_proxy_config_offline
 			,:upstream_proxy_config_created_at
 			,:upstream_proxy_config_updated_at
 			,:upstream_proxy_config_created_by
@@ -381,6 +412,9 @@ func (r UpstreamproxyDao) mapToInternalUpstreamProxy(
 		in.CreatedBy = session.Principal.ID
 	}
 	in.UpdatedBy = session.Principal.ID
+	if in.CredentialsUpdatedAt.IsZero() {
+		in.CredentialsUpdatedAt = in.CreatedAt
+	}
 
 	return &upstreamProxyConfigDB{
 		ID:                       in.ID,
@@ -394,6 +428,11 @@ func (r UpstreamproxyDao) mapToInternalUpstreamProxy(
 		UserNameSecretSpaceID:    util.GetEmptySQLInt64(in.UserNameSecretSpaceID),
 		UserNameSecretIdentifier: util.GetEmptySQLString(in.UserNameSecretIdentifier),
 		Token:                    in.Token,
+		AwsRoleArn:               in.AwsRoleArn,
+		CredentialsUpdatedAt:     in.CredentialsUpdatedAt.UnixMilli(),
+		MetadataTTL:              int64(in.MetadataTTL / time.Second),
+		ArtifactTTL:              int64(in.ArtifactTTL / time.Second),
+		Offline:                  in.Offline,
 		CreatedAt:                in.CreatedAt.UnixMilli(),
 		UpdatedAt:                in.UpdatedAt.UnixMilli(),
 		CreatedBy:                in.CreatedBy,
@@ -468,6 +507,14 @@ func (r UpstreamproxyDao) mapToUpstreamProxy(
 		UserNameSecretSpaceID:    userNameSecretSpaceID,
 		UserNameSecretSpacePath:  userNameSecretSpacePath,
 		Token:                    dst.Token,
+		AwsRoleArn:               dst.AwsRoleArn.String,
+		CredentialsUpdatedAt:     time.UnixMilli(dst.CredentialsUpdatedAt.Int64),
+		StoragePrefix:            dst.StoragePrefix.String,
+		MTLSMode:                 artifact.MTLSMode(dst.MTLSMode.String),
+		MTLSCACertificates:       dst.MTLSCACertificates.String,
+		MetadataTTL:              time.Duration(dst.MetadataTTL.Int64) * time.Second,
+		ArtifactTTL:              time.Duration(dst.ArtifactTTL.Int64) * time.Second,
+		Offline:                  dst.Offline.Bool,
 		CreatedAt:                time.UnixMilli(dst.CreatedAt),
 		UpdatedAt:                time.UnixMilli(dst.UpdatedAt),
 		CreatedBy:                createdBy,
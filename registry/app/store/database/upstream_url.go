@@ -0,0 +1,197 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type upstreamURLDao struct {
+	db *sqlx.DB
+}
+
+func NewUpstreamURLDao(db *sqlx.DB) store.UpstreamURLRepository {
+	return &upstreamURLDao{
+		db: db,
+	}
+}
+
+type upstreamHealthDB struct {
+	ID                  int64  `db:"uphealth_id"`
+	RegistryID          int64  `db:"uphealth_registry_id"`
+	URL                 string `db:"uphealth_url"`
+	Healthy             bool   `db:"uphealth_healthy"`
+	ConsecutiveFailures int    `db:"uphealth_consecutive_failures"`
+	LastError           string `db:"uphealth_last_error"`
+	LatencyMs           int64  `db:"uphealth_latency_ms"`
+	CheckedAt           int64  `db:"uphealth_checked_at"`
+}
+
+func (dao *upstreamURLDao) ListURLs(ctx context.Context, registryID int64) ([]string, error) {
+	stmt := databaseg.Builder.Select("upurl_url").
+		From("registry_upstream_urls").
+		Where(sq.Eq{"upurl_registry_id": registryID}).
+		OrderBy("upurl_position")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var urls []string
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &urls, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list upstream urls")
+	}
+	return urls, nil
+}
+
+func (dao *upstreamURLDao) ReplaceURLs(ctx context.Context, registryID int64, urls []string, principalID int64) error {
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	query, args, err := databaseg.Builder.Delete("registry_upstream_urls").
+		Where(sq.Eq{"upurl_registry_id": registryID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	if len(urls) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	stmt := databaseg.Builder.Insert("registry_upstream_urls").
+		Columns("upurl_registry_id", "upurl_position", "upurl_url", "upurl_created_at", "upurl_created_by")
+	for i, url := range urls {
+		stmt = stmt.Values(registryID, i+1, url, now, principalID)
+	}
+	query, args, err = stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+	return nil
+}
+
+func (dao *upstreamURLDao) ListRegistryIDs(ctx context.Context) ([]int64, error) {
+	stmt := databaseg.Builder.Select("DISTINCT upurl_registry_id").
+		From("registry_upstream_urls").
+		OrderBy("upurl_registry_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var ids []int64
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &ids, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list registries with upstream urls")
+	}
+	return ids, nil
+}
+
+func (dao *upstreamURLDao) ListHealth(ctx context.Context, registryID int64) ([]*types.UpstreamHealth, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(upstreamHealthDB{}), ",")).
+		From("registry_upstream_health").
+		Where(sq.Eq{"uphealth_registry_id": registryID}).
+		OrderBy("uphealth_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*upstreamHealthDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list upstream health")
+	}
+
+	health := make([]*types.UpstreamHealth, 0, len(dst))
+	for _, d := range dst {
+		health = append(health, &types.UpstreamHealth{
+			ID:                  d.ID,
+			RegistryID:          d.RegistryID,
+			URL:                 d.URL,
+			Healthy:             d.Healthy,
+			ConsecutiveFailures: d.ConsecutiveFailures,
+			LastError:           d.LastError,
+			Latency:             time.Duration(d.LatencyMs) * time.Millisecond,
+			CheckedAt:           time.UnixMilli(d.CheckedAt),
+		})
+	}
+	return health, nil
+}
+
+func (dao *upstreamURLDao) UpsertHealth(ctx context.Context, health *types.UpstreamHealth) error {
+	const sqlQuery = `
+		INSERT INTO registry_upstream_health (
+			uphealth_registry_id
+			,uphealth_url
+			,uphealth_healthy
+			,uphealth_consecutive_failures
+			,uphealth_last_error
+			,uphealth_latency_ms
+			,uphealth_checked_at
+		) VALUES (
+			:uphealth_registry_id
+			,:uphealth_url
+			,:uphealth_healthy
+			,:uphealth_consecutive_failures
+			,:uphealth_last_error
+			,:uphealth_latency_ms
+			,:uphealth_checked_at
+		)
+		ON CONFLICT (uphealth_registry_id, uphealth_url)
+		DO UPDATE SET
+			uphealth_healthy = :uphealth_healthy
+			,uphealth_consecutive_failures = :uphealth_consecutive_failures
+			,uphealth_last_error = :uphealth_last_error
+			,uphealth_latency_ms = :uphealth_latency_ms
+			,uphealth_checked_at = :uphealth_checked_at`
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &upstreamHealthDB{
+		RegistryID:          health.RegistryID,
+		URL:                 health.URL,
+		Healthy:             health.Healthy,
+		ConsecutiveFailures: health.ConsecutiveFailures,
+		LastError:           health.LastError,
+		LatencyMs:           health.Latency.Milliseconds(),
+		CheckedAt:           health.CheckedAt.UnixMilli(),
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind upstream health object")
+	}
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
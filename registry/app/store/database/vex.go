@@ -0,0 +1,299 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type vexDao struct {
+	db *sqlx.DB
+}
+
+func NewVexDao(db *sqlx.DB) store.VexRepository {
+	return &vexDao{
+		db: db,
+	}
+}
+
+type vexDocumentDB struct {
+	ID         int64  `db:"vexdoc_id"`
+	RegistryID int64  `db:"vexdoc_registry_id"`
+	Digest     string `db:"vexdoc_digest"`
+	Format     string `db:"vexdoc_format"`
+	Identifier string `db:"vexdoc_identifier"`
+	Author     string `db:"vexdoc_author"`
+	IssuedAt   int64  `db:"vexdoc_issued_at"`
+	Content    string `db:"vexdoc_content"`
+	CreatedAt  int64  `db:"vexdoc_created_at"`
+	CreatedBy  int64  `db:"vexdoc_created_by"`
+}
+
+type vexStatementDB struct {
+	ID              int64  `db:"vexstmt_id"`
+	DocumentID      int64  `db:"vexstmt_document_id"`
+	Vulnerability   string `db:"vexstmt_vulnerability"`
+	Status          string `db:"vexstmt_status"`
+	Justification   string `db:"vexstmt_justification"`
+	ImpactStatement string `db:"vexstmt_impact_statement"`
+	Timestamp       int64  `db:"vexstmt_timestamp"`
+}
+
+type vexStatementWithDocumentDB struct {
+	vexStatementDB
+	DocumentIdentifier string `db:"vexdoc_identifier"`
+	Format             string `db:"vexdoc_format"`
+	Digest             string `db:"vexdoc_digest"`
+}
+
+// Create records a VEX document with its statements, replacing a previously uploaded version
+// of the document attached to the same artifact.
+func (dao *vexDao) Create(ctx context.Context, doc *types.VexDocument) error {
+	stmt := databaseg.Builder.Delete("registry_vex_documents").
+		Where("vexdoc_registry_id = ? AND vexdoc_digest = ? AND vexdoc_identifier = ?",
+			doc.RegistryID, doc.Digest, doc.Identifier)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+
+	const documentQuery = `
+		INSERT INTO registry_vex_documents (
+			vexdoc_registry_id
+			,vexdoc_digest
+			,vexdoc_format
+			,vexdoc_identifier
+			,vexdoc_author
+			,vexdoc_issued_at
+			,vexdoc_content
+			,vexdoc_created_at
+			,vexdoc_created_by
+		) VALUES (
+			:vexdoc_registry_id
+			,:vexdoc_digest
+			,:vexdoc_format
+			,:vexdoc_identifier
+			,:vexdoc_author
+			,:vexdoc_issued_at
+			,:vexdoc_content
+			,:vexdoc_created_at
+			,:vexdoc_created_by
+		)
+		RETURNING vexdoc_id`
+
+	if doc.CreatedAt.IsZero() {
+		doc.CreatedAt = time.Now()
+	}
+
+	query, args, err = db.BindNamed(documentQuery, mapToInternalVexDocument(doc))
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind vex document object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&doc.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	const statementQuery = `
+		INSERT INTO registry_vex_statements (
+			vexstmt_document_id
+			,vexstmt_vulnerability
+			,vexstmt_status
+			,vexstmt_justification
+			,vexstmt_impact_statement
+			,vexstmt_timestamp
+		) VALUES (
+			:vexstmt_document_id
+			,:vexstmt_vulnerability
+			,:vexstmt_status
+			,:vexstmt_justification
+			,:vexstmt_impact_statement
+			,:vexstmt_timestamp
+		)
+		RETURNING vexstmt_id`
+
+	for _, statement := range doc.Statements {
+		statement.DocumentID = doc.ID
+		query, args, err = db.BindNamed(statementQuery, mapToInternalVexStatement(statement))
+		if err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind vex statement object")
+		}
+		if err = db.QueryRowContext(ctx, query, args...).Scan(&statement.ID); err != nil {
+			return databaseg.ProcessSQLErrorf(ctx, err, "Insert query failed")
+		}
+	}
+	return nil
+}
+
+func (dao *vexDao) Delete(ctx context.Context, registryID int64, id int64) error {
+	stmt := databaseg.Builder.Delete("registry_vex_documents").
+		Where("vexdoc_registry_id = ? AND vexdoc_id = ?", registryID, id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+// ListStatements lists the statements of all VEX documents attached to the artifact.
+func (dao *vexDao) ListStatements(
+	ctx context.Context,
+	registryID int64,
+	digest string,
+) ([]*types.VexStatement, error) {
+	return dao.listStatements(ctx, sq.Eq{"vexdoc_registry_id": registryID, "vexdoc_digest": digest})
+}
+
+func (dao *vexDao) ListStatementsByRegistry(ctx context.Context, registryID int64) ([]*types.VexStatement, error) {
+	return dao.listStatements(ctx, sq.Eq{"vexdoc_registry_id": registryID})
+}
+
+func (dao *vexDao) listStatements(ctx context.Context, where sq.Eq) ([]*types.VexStatement, error) {
+	stmt := databaseg.Builder.
+		Select("vexstmt_id", "vexstmt_document_id", "vexstmt_vulnerability", "vexstmt_status",
+			"vexstmt_justification", "vexstmt_impact_statement", "vexstmt_timestamp",
+			"vexdoc_identifier", "vexdoc_format", "vexdoc_digest").
+		From("registry_vex_statements").
+		Join("registry_vex_documents ON vexdoc_id = vexstmt_document_id").
+		Where(where).
+		OrderBy("vexstmt_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*vexStatementWithDocumentDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list vex statements")
+	}
+
+	statements := make([]*types.VexStatement, 0, len(dst))
+	for _, d := range dst {
+		statements = append(statements, &types.VexStatement{
+			ID:                 d.ID,
+			DocumentID:         d.DocumentID,
+			DocumentIdentifier: d.DocumentIdentifier,
+			Format:             types.VexFormat(d.Format),
+			Digest:             d.Digest,
+			Vulnerability:      d.Vulnerability,
+			Status:             types.VexStatus(d.Status),
+			Justification:      d.Justification,
+			ImpactStatement:    d.ImpactStatement,
+			Timestamp:          time.UnixMilli(d.Timestamp),
+		})
+	}
+	return statements, nil
+}
+
+// ListDocuments lists the VEX documents attached to the artifact, including their content.
+func (dao *vexDao) ListDocuments(ctx context.Context, registryID int64, digest string) ([]*types.VexDocument, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(vexDocumentDB{}), ",")).
+		From("registry_vex_documents").
+		Where("vexdoc_registry_id = ? AND vexdoc_digest = ?", registryID, digest).
+		OrderBy("vexdoc_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	var dst []*vexDocumentDB
+	if err = db.SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list vex documents")
+	}
+
+	docs := make([]*types.VexDocument, 0, len(dst))
+	for _, d := range dst {
+		docs = append(docs, &types.VexDocument{
+			ID:         d.ID,
+			RegistryID: d.RegistryID,
+			Digest:     d.Digest,
+			Format:     types.VexFormat(d.Format),
+			Identifier: d.Identifier,
+			Author:     d.Author,
+			IssuedAt:   time.UnixMilli(d.IssuedAt),
+			Content:    []byte(d.Content),
+			CreatedAt:  time.UnixMilli(d.CreatedAt),
+			CreatedBy:  d.CreatedBy,
+		})
+	}
+	return docs, nil
+}
+
+func mapToInternalVexDocument(in *types.VexDocument) *vexDocumentDB {
+	return &vexDocumentDB{
+		ID:         in.ID,
+		RegistryID: in.RegistryID,
+		Digest:     in.Digest,
+		Format:     string(in.Format),
+		Identifier: in.Identifier,
+		Author:     in.Author,
+		IssuedAt:   in.IssuedAt.UnixMilli(),
+		Content:    string(in.Content),
+		CreatedAt:  in.CreatedAt.UnixMilli(),
+		CreatedBy:  in.CreatedBy,
+	}
+}
+
+func mapToInternalVexStatement(in *types.VexStatement) *vexStatementDB {
+	return &vexStatementDB{
+		ID:              in.ID,
+		DocumentID:      in.DocumentID,
+		Vulnerability:   in.Vulnerability,
+		Status:          string(in.Status),
+		Justification:   in.Justification,
+		ImpactStatement: in.ImpactStatement,
+		Timestamp:       in.Timestamp.UnixMilli(),
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/app/store/database/util"
+	"github.com/harness/gitness/registry/types"
+	gitness_store "github.com/harness/gitness/store"
+	databaseg "github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+type vulnerabilityAllowlistDao struct {
+	db *sqlx.DB
+}
+
+func NewVulnerabilityAllowlistDao(db *sqlx.DB) store.VulnerabilityAllowlistRepository {
+	return &vulnerabilityAllowlistDao{
+		db: db,
+	}
+}
+
+type vulnerabilityAllowlistDB struct {
+	ID            int64  `db:"vallow_id"`
+	RegistryID    int64  `db:"vallow_registry_id"`
+	ImageName     string `db:"vallow_image_name"`
+	Digest        string `db:"vallow_digest"`
+	Vulnerability string `db:"vallow_vulnerability"`
+	Justification string `db:"vallow_justification"`
+	ExpiresAt     int64  `db:"vallow_expires_at"`
+	CreatedAt     int64  `db:"vallow_created_at"`
+	CreatedBy     int64  `db:"vallow_created_by"`
+}
+
+func (dao *vulnerabilityAllowlistDao) Save(ctx context.Context, entry *types.VulnerabilityAllowlistEntry) error {
+	const sqlQuery = `
+		INSERT INTO registry_vulnerability_allowlist (
+			vallow_registry_id
+			,vallow_image_name
+			,vallow_digest
+			,vallow_vulnerability
+			,vallow_justification
+			,vallow_expires_at
+			,vallow_created_at
+			,vallow_created_by
+		) VALUES (
+			:vallow_registry_id
+			,:vallow_image_name
+			,:vallow_digest
+			,:vallow_vulnerability
+			,:vallow_justification
+			,:vallow_expires_at
+			,:vallow_created_at
+			,:vallow_created_by
+		)
+		ON CONFLICT (vallow_registry_id, vallow_image_name, vallow_digest, vallow_vulnerability)
+		DO UPDATE SET
+			vallow_justification = :vallow_justification
+			,vallow_expires_at = :vallow_expires_at
+			,vallow_created_at = :vallow_created_at
+			,vallow_created_by = :vallow_created_by
+		RETURNING vallow_id`
+
+	entry.CreatedAt = time.Now()
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+	query, args, err := db.BindNamed(sqlQuery, &vulnerabilityAllowlistDB{
+		RegistryID:    entry.RegistryID,
+		ImageName:     entry.ImageName,
+		Digest:        entry.Digest,
+		Vulnerability: entry.Vulnerability,
+		Justification: entry.Justification,
+		ExpiresAt:     entry.ExpiresAt.UnixMilli(),
+		CreatedAt:     entry.CreatedAt.UnixMilli(),
+		CreatedBy:     entry.CreatedBy,
+	})
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to bind vulnerability allowlist object")
+	}
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&entry.ID); err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Upsert query failed")
+	}
+	return nil
+}
+
+func (dao *vulnerabilityAllowlistDao) Delete(ctx context.Context, registryID int64, id int64) error {
+	stmt := databaseg.Builder.Delete("registry_vulnerability_allowlist").
+		Where("vallow_registry_id = ? AND vallow_id = ?", registryID, id)
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, dao.db)
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "the delete query failed")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return databaseg.ProcessSQLErrorf(ctx, err, "Failed to get number of deleted rows")
+	}
+	if count == 0 {
+		return gitness_store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func (dao *vulnerabilityAllowlistDao) List(
+	ctx context.Context,
+	registryID int64,
+) ([]*types.VulnerabilityAllowlistEntry, error) {
+	return dao.list(ctx, sq.Eq{"vallow_registry_id": registryID})
+}
+
+func (dao *vulnerabilityAllowlistDao) ListActive(
+	ctx context.Context,
+	registryID int64,
+	now time.Time,
+) ([]*types.VulnerabilityAllowlistEntry, error) {
+	return dao.list(ctx, sq.And{
+		sq.Eq{"vallow_registry_id": registryID},
+		sq.Gt{"vallow_expires_at": now.UnixMilli()},
+	})
+}
+
+func (dao *vulnerabilityAllowlistDao) list(
+	ctx context.Context,
+	where sq.Sqlizer,
+) ([]*types.VulnerabilityAllowlistEntry, error) {
+	stmt := databaseg.Builder.
+		Select(util.ArrToStringByDelimiter(util.GetDBTagsFromStruct(vulnerabilityAllowlistDB{}), ",")).
+		From("registry_vulnerability_allowlist").
+		Where(where).
+		OrderBy("vallow_id")
+
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	var dst []*vulnerabilityAllowlistDB
+	if err = dbtx.GetAccessor(ctx, dao.db).SelectContext(ctx, &dst, query, args...); err != nil {
+		return nil, databaseg.ProcessSQLErrorf(ctx, err, "Failed to list vulnerability allowlist")
+	}
+
+	entries := make([]*types.VulnerabilityAllowlistEntry, 0, len(dst))
+	for _, d := range dst {
+		entries = append(entries, &types.VulnerabilityAllowlistEntry{
+			ID:            d.ID,
+			RegistryID:    d.RegistryID,
+			ImageName:     d.ImageName,
+			Digest:        d.Digest,
+			Vulnerability: d.Vulnerability,
+			Justification: d.Justification,
+			ExpiresAt:     time.UnixMilli(d.ExpiresAt),
+			CreatedAt:     time.UnixMilli(d.CreatedAt),
+			CreatedBy:     d.CreatedBy,
+		})
+	}
+	return entries, nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/harness/gitness/app/services/refcache"
 	"github.com/harness/gitness/registry/app/store"
 	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 	"github.com/jmoiron/sqlx"
@@ -55,8 +56,9 @@ func ProvideArtifactDao(db *sqlx.DB) store.ArtifactRepository {
 	return NewArtifactDao(db)
 }
 
-func ProvideDownloadStatDao(db *sqlx.DB) store.DownloadStatRepository {
-	return NewDownloadStatDao(db)
+func ProvideDownloadStatDao(db *sqlx.DB, config *types.Config) store.DownloadStatRepository {
+	return NewDownloadStatDao(db, config.Registry.DownloadStats.DeduplicationWindow,
+		config.Registry.DownloadStats.RecordOrigin)
 }
 
 func ProvideBandwidthStatDao(db *sqlx.DB) store.BandwidthStatRepository {
@@ -87,6 +89,94 @@ func ProvideOCIImageIndexMappingDao(db *sqlx.DB) store.OCIImageIndexMappingRepos
 	return NewOCIImageIndexMappingDao(db)
 }
 
+func ProvideBaseImageDao(db *sqlx.DB) store.BaseImageRepository {
+	return NewBaseImageDao(db)
+}
+
+func ProvideRegistryQueueDao(db *sqlx.DB) store.RegistryQueueRepository {
+	return NewRegistryQueueDao(db)
+}
+
+func ProvideScanDao(db *sqlx.DB) store.ScanRepository {
+	return NewScanDao(db)
+}
+
+func ProvideVexDao(db *sqlx.DB) store.VexRepository {
+	return NewVexDao(db)
+}
+
+func ProvideAccessGrantDao(db *sqlx.DB) store.AccessGrantRepository {
+	return NewAccessGrantDao(db)
+}
+
+func ProvideClaimMappingDao(db *sqlx.DB) store.ClaimMappingRepository {
+	return NewClaimMappingDao(db)
+}
+
+func ProvideArchiveDao(db *sqlx.DB) store.ArchiveRepository {
+	return NewArchiveDao(db)
+}
+
+func ProvideRegistryConfigRevisionDao(db *sqlx.DB) store.RegistryConfigRevisionRepository {
+	return NewRegistryConfigRevisionDao(db)
+}
+
+func ProvidePermalinkDao(db *sqlx.DB) store.PermalinkRepository {
+	return NewPermalinkDao(db)
+}
+
+func ProvideDefaultRegistryDao(db *sqlx.DB) store.DefaultRegistryRepository {
+	return NewDefaultRegistryDao(db)
+}
+
+func ProvideMavenRelocationDao(db *sqlx.DB) store.MavenRelocationRepository {
+	return NewMavenRelocationDao(db)
+}
+
+func ProvidePackageRuleDao(db *sqlx.DB) store.PackageRuleRepository {
+	return NewPackageRuleDao(db)
+}
+
+func ProvideUpstreamURLDao(db *sqlx.DB) store.UpstreamURLRepository {
+	return NewUpstreamURLDao(db)
+}
+
+func ProvideCacheEvictionDao(db *sqlx.DB) store.CacheEvictionRepository {
+	return NewCacheEvictionDao(db)
+}
+
+func ProvideCachePrewarmDao(db *sqlx.DB) store.CachePrewarmRepository {
+	return NewCachePrewarmDao(db)
+}
+
+func ProvideVulnerabilityAllowlistDao(db *sqlx.DB) store.VulnerabilityAllowlistRepository {
+	return NewVulnerabilityAllowlistDao(db)
+}
+
+func ProvideHelmPushPolicyDao(db *sqlx.DB) store.HelmPushPolicyRepository {
+	return NewHelmPushPolicyDao(db)
+}
+
+func ProvideMirrorSyncDao(db *sqlx.DB) store.MirrorSyncRepository {
+	return NewMirrorSyncDao(db)
+}
+
+func ProvideLegalHoldDao(db *sqlx.DB) store.LegalHoldRepository {
+	return NewLegalHoldDao(db)
+}
+
+func ProvideDeletionCertificateDao(db *sqlx.DB) store.DeletionCertificateRepository {
+	return NewDeletionCertificateDao(db)
+}
+
+func ProvideUploadSessionDao(db *sqlx.DB) store.UploadSessionRepository {
+	return NewUploadSessionDao(db)
+}
+
+func ProvideBackfillDao(db *sqlx.DB) store.BackfillRepository {
+	return NewBackfillDao(db)
+}
+
 func ProvideLayerDao(db *sqlx.DB, mtRepository store.MediaTypesRepository) store.LayerRepository {
 	return NewLayersDao(db, mtRepository)
 }
@@ -114,6 +204,28 @@ var WireSet = wire.NewSet(
 	ProvideCleanupPolicyDao,
 	ProvideManifestRefDao,
 	ProvideOCIImageIndexMappingDao,
+	ProvideBaseImageDao,
+	ProvideRegistryQueueDao,
+	ProvideScanDao,
+	ProvideVexDao,
+	ProvideVulnerabilityAllowlistDao,
+	ProvideAccessGrantDao,
+	ProvideClaimMappingDao,
+	ProvideDefaultRegistryDao,
+	ProvideMavenRelocationDao,
+	ProvidePackageRuleDao,
+	ProvideUpstreamURLDao,
+	ProvideCacheEvictionDao,
+	ProvideCachePrewarmDao,
+	ProvideHelmPushPolicyDao,
+	ProvideMirrorSyncDao,
+	ProvideLegalHoldDao,
+	ProvideDeletionCertificateDao,
+	ProvideUploadSessionDao,
+	ProvideBackfillDao,
+	ProvideRegistryConfigRevisionDao,
+	ProvidePermalinkDao,
+	ProvideArchiveDao,
 	ProvideLayerDao,
 	ProvideImageDao,
 	ProvideArtifactDao,
@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corestore "github.com/harness/gitness/app/store"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// leaseExpiry is how long the lease of the garbage collector outlives the replica holding it.
+	leaseExpiry = time.Minute
+	// leaseRetry is how often the replicas not running the garbage collector try to take it over.
+	leaseRetry = time.Minute
+)
+
+// Leased runs the garbage collector of a service on a single replica at a time: the one holding
+// the GC lease. The collector is started with the context of the lease, so it must stop once that
+// context is done, after which another replica may take the lease over.
+type Leased struct {
+	Service
+	locker *lease.Locker
+}
+
+func NewLeased(service Service, locker *lease.Locker) *Leased {
+	return &Leased{
+		Service: service,
+		locker:  locker,
+	}
+}
+
+func (s *Leased) Start(
+	ctx context.Context, spaceStore corestore.SpaceStore,
+	blobRepo store.BlobRepository, storageDeleter storagedriver.StorageDeleter,
+	config *types.Config,
+) {
+	go func() {
+		for {
+			s.run(ctx, spaceStore, blobRepo, storageDeleter, config)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(leaseRetry):
+			}
+		}
+	}()
+}
+
+// run starts the garbage collector if the GC lease is free and holds it until it's lost or ctx is done.
+func (s *Leased) run(
+	ctx context.Context, spaceStore corestore.SpaceStore,
+	blobRepo store.BlobRepository, storageDeleter storagedriver.StorageDeleter,
+	config *types.Config,
+) {
+	leaseCtx, release, err := s.locker.HoldJob(ctx, lease.JobGC, leaseExpiry)
+	if errors.Is(err, lease.ErrHeld) {
+		return
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to acquire the lease of the garbage collector")
+		return
+	}
+	defer release()
+
+	log.Ctx(ctx).Info().Msg("starting the garbage collector")
+	s.Service.Start(leaseCtx, spaceStore, blobRepo, storageDeleter, config)
+	<-leaseCtx.Done()
+	if cause := context.Cause(leaseCtx); errors.Is(cause, lease.ErrLost) {
+		log.Ctx(ctx).Warn().Err(cause).Msg("stopped the garbage collector")
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corestore "github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/lock"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingService struct {
+	Noop
+	started atomic.Int32
+}
+
+func (s *countingService) Start(
+	context.Context, corestore.SpaceStore, store.BlobRepository, storagedriver.StorageDeleter, *types.Config,
+) {
+	s.started.Add(1)
+}
+
+func TestLeasedRunsOnOneReplica(t *testing.T) {
+	locker := lease.NewLocker(lock.NewInMemory(lock.Config{Expiry: time.Minute, Tries: 1}))
+	service := &countingService{}
+	first, second := NewLeased(service, locker), NewLeased(service, locker)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		first.run(ctx, nil, nil, nil, nil)
+	}()
+	require.Eventually(t, func() bool { return service.started.Load() == 1 }, time.Second, 5*time.Millisecond)
+
+	second.run(ctx, nil, nil, nil, nil)
+	assert.EqualValues(t, 1, service.started.Load(), "the collector isn't started while another replica runs it")
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the collector holds the lease after its context is done")
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		assert.Eventually(t, func() bool { return service.started.Load() == 2 }, time.Second, 5*time.Millisecond,
+			"the lease is taken over once released")
+		cancel()
+	}()
+	second.run(ctx, nil, nil, nil, nil)
+}
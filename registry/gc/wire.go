@@ -16,6 +16,7 @@ package gc
 
 import (
 	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg/lease"
 
 	"github.com/google/wire"
 )
@@ -24,8 +25,8 @@ func StorageDeleterProvider(driver storagedriver.StorageDriver) storagedriver.St
 	return driver
 }
 
-func ServiceProvider() Service {
-	return New()
+func ServiceProvider(locker *lease.Locker) Service {
+	return NewLeased(New(), locker)
 }
 
 var WireSet = wire.NewSet(StorageDeleterProvider, ServiceProvider)
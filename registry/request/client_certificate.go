@@ -0,0 +1,84 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package request
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"sync"
+
+	"github.com/harness/gitness/types"
+)
+
+const ClientCertificateKey contextKey = "clientCertificate"
+
+// ClientCertificate is the TLS client certificate chain presented with a request, leaf first.
+// It also collects the registries whose mutual TLS requirement the certificate satisfied, so the
+// accesses can be audited once the request completes.
+type ClientCertificate struct {
+	Chain []*x509.Certificate
+
+	mu            sync.Mutex
+	verifications []ClientCertificateVerification
+}
+
+// ClientCertificateVerification records an access granted based on a verified client certificate.
+type ClientCertificateVerification struct {
+	SpacePath    string
+	RegistryName string
+	Principal    types.Principal
+	Push         bool
+}
+
+// Identity returns the subject of the leaf certificate.
+func (c *ClientCertificate) Identity() string {
+	if c == nil || len(c.Chain) == 0 {
+		return ""
+	}
+	return c.Chain[0].Subject.String()
+}
+
+// Fingerprint returns the hex encoded SHA-256 digest of the leaf certificate.
+func (c *ClientCertificate) Fingerprint() string {
+	if c == nil || len(c.Chain) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(c.Chain[0].Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *ClientCertificate) AddVerification(v ClientCertificateVerification) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.verifications = append(c.verifications, v)
+}
+
+func (c *ClientCertificate) Verifications() []ClientCertificateVerification {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]ClientCertificateVerification(nil), c.verifications...)
+}
+
+// ClientCertificateFrom returns the client certificate of the request, nil if none was presented.
+func ClientCertificateFrom(ctx context.Context) *ClientCertificate {
+	cert, _ := ctx.Value(ClientCertificateKey).(*ClientCertificate)
+	return cert
+}
+
+func WithClientCertificate(parent context.Context, cert *ClientCertificate) context.Context {
+	return context.WithValue(parent, ClientCertificateKey, cert)
+}
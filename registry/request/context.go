@@ -17,12 +17,17 @@ package request
 import (
 	"context"
 
+	"github.com/harness/gitness/registry/types"
+
 	"github.com/rs/zerolog/log"
 )
 
 type contextKey string
 
-const OriginalURLKey contextKey = "originalURL"
+const (
+	OriginalURLKey  contextKey = "originalURL"
+	DownloadStatKey contextKey = "downloadStat"
+)
 
 func OriginalURLFrom(ctx context.Context) string {
 	originalURL, ok := ctx.Value(OriginalURLKey).(string)
@@ -35,3 +40,14 @@ func OriginalURLFrom(ctx context.Context) string {
 func WithOriginalURL(parent context.Context, originalURL string) context.Context {
 	return context.WithValue(parent, OriginalURLKey, originalURL)
 }
+
+// DownloadStatFrom returns the download stat of the request, filled with who downloads, to be recorded by
+// the package controllers once they know the downloaded artifact.
+func DownloadStatFrom(ctx context.Context) (*types.DownloadStat, bool) {
+	downloadStat, ok := ctx.Value(DownloadStatKey).(*types.DownloadStat)
+	return downloadStat, ok && downloadStat != nil
+}
+
+func WithDownloadStat(parent context.Context, downloadStat *types.DownloadStat) context.Context {
+	return context.WithValue(parent, DownloadStatKey, downloadStat)
+}
@@ -0,0 +1,175 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accessgrant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	corestore "github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+)
+
+// MaxDuration is the longest a grant can last.
+const MaxDuration = 30 * 24 * time.Hour
+
+const maxReasonLength = 1024
+
+// ErrInvalidGrant is returned when a grant is requested with invalid parameters.
+var ErrInvalidGrant = errors.New("invalid access grant")
+
+// grantablePermissions are the registry permissions that can be granted temporarily.
+var grantablePermissions = map[enum.Permission]bool{
+	enum.PermissionRegistryView:      true,
+	enum.PermissionArtifactsDownload: true,
+	enum.PermissionArtifactsUpload:   true,
+	enum.PermissionArtifactsDelete:   true,
+}
+
+// Service manages time-boxed access grants of principals on registries, e.g. a few hours of pull
+// access to a restricted registry for a vendor or during an incident.
+type Service struct {
+	grantStore     store.AccessGrantRepository
+	principalStore corestore.PrincipalStore
+	spaceFinder    refcache.SpaceFinder
+}
+
+func NewService(
+	grantStore store.AccessGrantRepository,
+	principalStore corestore.PrincipalStore,
+	spaceFinder refcache.SpaceFinder,
+) *Service {
+	return &Service{
+		grantStore:     grantStore,
+		principalStore: principalStore,
+		spaceFinder:    spaceFinder,
+	}
+}
+
+// Grant gives the principal the permissions on the registry for the given duration.
+func (s *Service) Grant(
+	ctx context.Context,
+	registryID int64,
+	principalUID string,
+	permissions []enum.Permission,
+	duration time.Duration,
+	reason string,
+	grantedBy int64,
+) (*types.AccessGrant, error) {
+	if err := validate(permissions, duration, reason); err != nil {
+		return nil, err
+	}
+	principal, err := s.principalStore.FindByUID(ctx, principalUID)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, fmt.Errorf("%w: principal %q not found", ErrInvalidGrant, principalUID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find principal: %w", err)
+	}
+
+	now := time.Now()
+	grant := &types.AccessGrant{
+		RegistryID:   registryID,
+		PrincipalID:  principal.ID,
+		PrincipalUID: principal.UID,
+		Permissions:  dedupe(permissions),
+		Reason:       strings.TrimSpace(reason),
+		ExpiresAt:    now.Add(duration),
+		CreatedAt:    now,
+		CreatedBy:    grantedBy,
+	}
+	if err = s.grantStore.Create(ctx, grant); err != nil {
+		return nil, fmt.Errorf("failed to create access grant: %w", err)
+	}
+	return grant, nil
+}
+
+// ListActive lists the grants of the registry that haven't expired nor been revoked.
+func (s *Service) ListActive(ctx context.Context, registryID int64) ([]*types.AccessGrant, error) {
+	return s.grantStore.ListActive(ctx, registryID, time.Now())
+}
+
+// Revoke ends a grant before it expires.
+func (s *Service) Revoke(ctx context.Context, registryID int64, grantID int64, revokedBy int64) error {
+	return s.grantStore.Revoke(ctx, registryID, grantID, revokedBy, time.Now())
+}
+
+// IsGranted reports whether the principal holds an active grant that includes the permission on
+// the registry with the given identifier in the space.
+func (s *Service) IsGranted(
+	ctx context.Context,
+	principalID int64,
+	spacePath string,
+	registryIdentifier string,
+	permission enum.Permission,
+) (bool, error) {
+	if !grantablePermissions[permission] {
+		return false, nil
+	}
+	space, err := s.spaceFinder.FindByRef(ctx, spacePath)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to find space: %w", err)
+	}
+	grants, err := s.grantStore.ListActiveByPrincipal(ctx, space.ID, registryIdentifier, principalID, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to list access grants: %w", err)
+	}
+	for _, grant := range grants {
+		if grant.Allows(permission) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func validate(permissions []enum.Permission, duration time.Duration, reason string) error {
+	if len(permissions) == 0 {
+		return fmt.Errorf("%w: at least one permission is required", ErrInvalidGrant)
+	}
+	for _, p := range permissions {
+		if !grantablePermissions[p] {
+			return fmt.Errorf("%w: permission %q can't be granted", ErrInvalidGrant, p)
+		}
+	}
+	if duration <= 0 || duration > MaxDuration {
+		return fmt.Errorf("%w: duration must be positive and at most %s", ErrInvalidGrant, MaxDuration)
+	}
+	if len(reason) > maxReasonLength {
+		return fmt.Errorf("%w: reason must be at most %d characters", ErrInvalidGrant, maxReasonLength)
+	}
+	return nil
+}
+
+func dedupe(permissions []enum.Permission) []enum.Permission {
+	seen := make(map[enum.Permission]bool, len(permissions))
+	result := make([]enum.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		if !seen[p] {
+			seen[p] = true
+			result = append(result, p)
+		}
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accessgrant
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	pull := []enum.Permission{enum.PermissionArtifactsDownload}
+
+	assert.NoError(t, validate(pull, 4*time.Hour, "incident 1234"))
+	assert.NoError(t, validate(pull, MaxDuration, ""))
+
+	assert.ErrorIs(t, validate(nil, time.Hour, ""), ErrInvalidGrant)
+	assert.ErrorIs(t, validate([]enum.Permission{enum.PermissionRegistryDelete}, time.Hour, ""), ErrInvalidGrant)
+	assert.ErrorIs(t, validate(pull, 0, ""), ErrInvalidGrant)
+	assert.ErrorIs(t, validate(pull, MaxDuration+time.Minute, ""), ErrInvalidGrant)
+	assert.ErrorIs(t, validate(pull, time.Hour, strings.Repeat("x", maxReasonLength+1)), ErrInvalidGrant)
+}
+
+func TestDedupe(t *testing.T) {
+	assert.Equal(t,
+		[]enum.Permission{enum.PermissionArtifactsDownload, enum.PermissionRegistryView},
+		dedupe([]enum.Permission{
+			enum.PermissionArtifactsDownload, enum.PermissionRegistryView, enum.PermissionArtifactsDownload,
+		}))
+}
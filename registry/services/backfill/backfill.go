@@ -0,0 +1,101 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+// ArtifactFileCount fills in the artifact_file_count column from the metadata of the artifacts.
+const ArtifactFileCount = "artifact_file_count"
+
+// Backfill fills in a column added by an expand migration for the rows written before it. The rows
+// written after the migration fill in the column themselves, so a backfill only has to go through
+// the rows once.
+type Backfill struct {
+	Name string
+	// Batch fills in up to limit rows after the cursor. It returns the cursor of the last row of the
+	// batch and the number of rows in it, which is zero once none are left.
+	Batch func(ctx context.Context, cursor int64, limit int) (int64, int, error)
+}
+
+// Backfills returns the backfills of the registry tables. A contract migration dropping the fallback
+// of the readers of a column can only be shipped once its backfill has completed everywhere.
+func Backfills(artifactStore store.ArtifactRepository) []Backfill {
+	return []Backfill{
+		{Name: ArtifactFileCount, Batch: artifactStore.BackfillFileCounts},
+	}
+}
+
+// Runner runs backfills in batches, each batch is a short transaction of its own.
+type Runner struct {
+	backfillStore store.BackfillRepository
+	batchSize     int
+	pause         time.Duration
+}
+
+func NewRunner(backfillStore store.BackfillRepository, batchSize int, pause time.Duration) *Runner {
+	return &Runner{
+		backfillStore: backfillStore,
+		batchSize:     batchSize,
+		pause:         pause,
+	}
+}
+
+// Run fills in the rows of the backfill until none are left or the context is done. The progress
+// is saved after every batch, so an interrupted backfill resumes after the last batch it completed.
+func (r *Runner) Run(ctx context.Context, backfill Backfill) (*types.Backfill, error) {
+	progress, err := r.backfillStore.Get(ctx, backfill.Name)
+	switch {
+	case errors.Is(err, gitnessstore.ErrResourceNotFound):
+		progress = &types.Backfill{Name: backfill.Name}
+	case err != nil:
+		return nil, fmt.Errorf("failed to get progress of backfill %s: %w", backfill.Name, err)
+	}
+
+	for !progress.Completed() {
+		if ctx.Err() != nil {
+			return progress, nil
+		}
+
+		cursor, rows, err := backfill.Batch(ctx, progress.Cursor, r.batchSize)
+		if err != nil {
+			return progress, fmt.Errorf("failed to backfill %s after row %d: %w", backfill.Name, progress.Cursor, err)
+		}
+		progress.Cursor = cursor
+		progress.Rows += int64(rows)
+		if rows < r.batchSize {
+			progress.CompletedAt = time.Now()
+		}
+		if err = r.backfillStore.Upsert(ctx, progress); err != nil {
+			return progress, fmt.Errorf("failed to save progress of backfill %s: %w", backfill.Name, err)
+		}
+
+		if !progress.Completed() {
+			select {
+			case <-ctx.Done():
+			case <-time.After(r.pause):
+			}
+		}
+	}
+	return progress, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBackfillStore struct {
+	store.BackfillRepository
+	saved map[string]types.Backfill
+}
+
+func (f *fakeBackfillStore) Get(_ context.Context, name string) (*types.Backfill, error) {
+	b, ok := f.saved[name]
+	if !ok {
+		return nil, gitnessstore.ErrResourceNotFound
+	}
+	return &b, nil
+}
+
+func (f *fakeBackfillStore) Upsert(_ context.Context, b *types.Backfill) error {
+	f.saved[b.Name] = *b
+	return nil
+}
+
+// rowsBackfill fills in the rows with the IDs from 1 to rows.
+func rowsBackfill(rows int64, filled *[]int64) Backfill {
+	return Backfill{
+		Name: "rows",
+		Batch: func(_ context.Context, cursor int64, limit int) (int64, int, error) {
+			n := 0
+			for id := cursor + 1; id <= rows && n < limit; id++ {
+				*filled = append(*filled, id)
+				cursor = id
+				n++
+			}
+			return cursor, n, nil
+		},
+	}
+}
+
+func TestRunnerRun(t *testing.T) {
+	backfills := &fakeBackfillStore{saved: map[string]types.Backfill{}}
+	runner := NewRunner(backfills, 2, time.Millisecond)
+
+	var filled []int64
+	progress, err := runner.Run(context.Background(), rowsBackfill(5, &filled))
+	require.NoError(t, err)
+	assert.True(t, progress.Completed())
+	assert.Equal(t, int64(5), progress.Rows)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, filled)
+
+	// a completed backfill isn't run again
+	filled = nil
+	_, err = runner.Run(context.Background(), rowsBackfill(5, &filled))
+	require.NoError(t, err)
+	assert.Empty(t, filled)
+}
+
+func TestRunnerResumes(t *testing.T) {
+	backfills := &fakeBackfillStore{saved: map[string]types.Backfill{
+		"rows": {Name: "rows", Cursor: 3, Rows: 3},
+	}}
+	runner := NewRunner(backfills, 2, time.Millisecond)
+
+	var filled []int64
+	progress, err := runner.Run(context.Background(), rowsBackfill(5, &filled))
+	require.NoError(t, err)
+	assert.True(t, progress.Completed())
+	assert.Equal(t, int64(5), progress.Rows)
+	assert.Equal(t, []int64{4, 5}, filled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	filled = nil
+	progress, err = NewRunner(&fakeBackfillStore{saved: map[string]types.Backfill{}}, 2, time.Millisecond).
+		Run(ctx, rowsBackfill(5, &filled))
+	require.NoError(t, err)
+	assert.False(t, progress.Completed())
+	assert.Empty(t, filled)
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType        = "gitness:registry:backfill"
+	jobMaxDuration = time.Hour
+)
+
+type Config struct {
+	Enabled   bool
+	Cron      string
+	BatchSize int
+	Pause     time.Duration
+}
+
+// Service runs the backfills of the registry tables in the background. A run that reaches the job's
+// maximum duration stops after its current batch, the next run resumes it.
+type Service struct {
+	config    Config
+	scheduler *job.Scheduler
+	executor  *job.Executor
+	runner    *Runner
+	backfills []Backfill
+}
+
+func NewService(
+	config Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	backfillStore store.BackfillRepository,
+	artifactStore store.ArtifactRepository,
+) *Service {
+	return &Service{
+		config:    config,
+		scheduler: scheduler,
+		executor:  executor,
+		runner:    NewRunner(backfillStore, config.BatchSize, config.Pause),
+		backfills: Backfills(artifactStore),
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	if err := s.executor.Register(jobType, s); err != nil {
+		return fmt.Errorf("failed to register job handler for registry backfills: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.config.Cron, jobMaxDuration); err != nil {
+		return fmt.Errorf("failed to schedule registry backfill job: %w", err)
+	}
+	return nil
+}
+
+// Result is the number of rows each backfill has filled in so far and whether it has completed.
+type Result map[string]Progress
+
+type Progress struct {
+	Rows      int64 `json:"rows"`
+	Completed bool  `json:"completed"`
+}
+
+// Handle runs the backfills one after the other.
+func (s *Service) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	result := Result{}
+	for _, backfill := range s.backfills {
+		progress, err := s.runner.Run(ctx, backfill)
+		if err != nil {
+			return "", err
+		}
+		result[backfill.Name] = Progress{Rows: progress.Rows, Completed: progress.Completed()}
+		if !progress.Completed() {
+			log.Ctx(ctx).Info().Msgf("registry backfill %s: filled in %d rows, resuming in the next run",
+				backfill.Name, progress.Rows)
+		}
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal backfill result: %w", err)
+	}
+	return string(output), nil
+}
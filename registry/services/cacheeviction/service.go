@@ -0,0 +1,395 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheeviction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/services/compliance"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/distribution/distribution/v3/manifest/manifestlist"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType        = "gitness:registry:cache-eviction"
+	jobMaxDuration = 30 * time.Minute
+
+	// manifestGCReviewWindow and manifestGCLockTimeout match the deletes of manifests through the registry API.
+	manifestGCReviewWindow = 1 * time.Hour
+	manifestGCLockTimeout  = 10 * time.Second
+)
+
+type Config struct {
+	Enabled bool
+	Cron    string
+	// BatchSize is the number of eviction candidates listed at a time.
+	BatchSize int
+}
+
+// Service periodically enforces the cache eviction policies of the upstream proxies, evicting the least
+// recently pulled cached artifacts of the upstream proxies whose cache outgrew its size limit. Evicted
+// artifacts are fetched from the upstream again when they're pulled next.
+type Service struct {
+	config            Config
+	scheduler         *job.Scheduler
+	executor          *job.Executor
+	tx                dbtx.Transactor
+	registryDao       store.RegistryRepository
+	evictionStore     store.CacheEvictionRepository
+	manifestStore     store.ManifestRepository
+	tagStore          store.TagRepository
+	artifactStore     store.ArtifactRepository
+	nodesStore        store.NodesRepository
+	genericBlobStore  store.GenericBlobRepository
+	downloadStatStore store.DownloadStatRepository
+	legalHoldStore    store.LegalHoldRepository
+	gcService         gc.Service
+	spaceFinder       refcache.SpaceFinder
+	driver            storagedriver.StorageDriver
+	locker            *lease.Locker
+}
+
+func NewService(
+	config Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	tx dbtx.Transactor,
+	registryDao store.RegistryRepository,
+	evictionStore store.CacheEvictionRepository,
+	manifestStore store.ManifestRepository,
+	tagStore store.TagRepository,
+	artifactStore store.ArtifactRepository,
+	nodesStore store.NodesRepository,
+	genericBlobStore store.GenericBlobRepository,
+	downloadStatStore store.DownloadStatRepository,
+	legalHoldStore store.LegalHoldRepository,
+	gcService gc.Service,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+	locker *lease.Locker,
+) *Service {
+	return &Service{
+		config:            config,
+		scheduler:         scheduler,
+		executor:          executor,
+		tx:                tx,
+		registryDao:       registryDao,
+		evictionStore:     evictionStore,
+		manifestStore:     manifestStore,
+		tagStore:          tagStore,
+		artifactStore:     artifactStore,
+		nodesStore:        nodesStore,
+		genericBlobStore:  genericBlobStore,
+		downloadStatStore: downloadStatStore,
+		legalHoldStore:    legalHoldStore,
+		gcService:         gcService,
+		spaceFinder:       spaceFinder,
+		driver:            driver,
+		locker:            locker,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	if err := s.executor.Register(jobType, s); err != nil {
+		return fmt.Errorf("failed to register job handler for cache eviction: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.config.Cron, jobMaxDuration); err != nil {
+		return fmt.Errorf("failed to schedule cache eviction job: %w", err)
+	}
+	return nil
+}
+
+// Result is the number of artifacts evicted from the caches of the upstream proxies and their size.
+type Result struct {
+	Evicted    int   `json:"evicted"`
+	FreedBytes int64 `json:"freed_bytes"`
+}
+
+// Handle enforces the cache eviction policies of the upstream proxies. The job is skipped while another
+// replica runs a maintenance job.
+func (s *Service) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	unlock, err := s.locker.TryLockJob(ctx, lease.JobMaintenance, jobMaxDuration)
+	if errors.Is(err, lease.ErrHeld) {
+		log.Ctx(ctx).Info().Msg("skipping cache eviction, a registry maintenance job is running")
+		return "skipped, a registry maintenance job is running", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	policies, err := s.evictionStore.ListPolicies(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list cache eviction policies: %w", err)
+	}
+
+	var result Result
+	for _, policy := range policies {
+		evicted, freed, err := s.Enforce(ctx, policy)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to evict the cache of registry %d", policy.RegistryID)
+		}
+		result.Evicted += evicted
+		result.FreedBytes += freed
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cache eviction result: %w", err)
+	}
+	return string(output), nil
+}
+
+// Enforce evicts the least recently pulled artifacts from the cache of the upstream proxy until it fits
+// its size limit again, and returns the number of evicted artifacts and their size. Artifacts under a
+// legal hold are never evicted.
+func (s *Service) Enforce(ctx context.Context, policy *types.CacheEvictionPolicy) (int, int64, error) {
+	registry, err := s.registryDao.Get(ctx, policy.RegistryID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get registry: %w", err)
+	}
+	oci := isOCI(registry.PackageType)
+	size, err := s.evictionStore.CacheSize(ctx, registry.ID, oci)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	evicted, freed, err := s.evict(ctx, registry, oci, size-policy.MaxSizeBytes)
+	if evicted > 0 {
+		log.Ctx(ctx).Info().Msgf("evicted %d artifacts of %d bytes from the cache of registry %s",
+			evicted, freed, registry.Name)
+	}
+	if updateErr := s.evictionStore.UpdateLastRun(ctx, registry.ID, time.Now(), evicted, freed); updateErr != nil {
+		log.Ctx(ctx).Warn().Err(updateErr).Msgf("failed to record cache eviction of registry %s", registry.Name)
+	}
+	return evicted, freed, err
+}
+
+// evict evicts the least recently pulled artifacts until at least excess bytes are freed.
+func (s *Service) evict(
+	ctx context.Context,
+	registry *types.Registry,
+	oci bool,
+	excess int64,
+) (int, int64, error) {
+	evicted := 0
+	var freed int64
+	// held artifacts are kept, and skipped on the next pages.
+	held := 0
+	for freed < excess {
+		candidates, err := s.evictionStore.ListLeastRecentlyPulled(ctx, registry.ID, oci, s.config.BatchSize, held)
+		if err != nil {
+			return evicted, freed, err
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		for _, candidate := range candidates {
+			if freed >= excess {
+				break
+			}
+			var size int64
+			if oci {
+				size, err = s.evictManifest(ctx, registry, candidate)
+			} else {
+				size, err = s.evictFiles(ctx, registry, candidate)
+			}
+			if errors.Is(err, pkg.ErrLegalHold) {
+				held++
+				continue
+			}
+			if err != nil {
+				return evicted, freed, fmt.Errorf("failed to evict %s@%s: %w", candidate.ImageName,
+					candidate.Version, err)
+			}
+			evicted++
+			freed += size
+		}
+	}
+	return evicted, freed, nil
+}
+
+// evictManifest deletes a cached manifest with its tags, the garbage collection deletes the blobs no other
+// manifest uses.
+func (s *Service) evictManifest(
+	ctx context.Context,
+	registry *types.Registry,
+	candidate *types.CachedArtifact,
+) (int64, error) {
+	m, err := s.manifestStore.Get(ctx, candidate.ManifestID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get manifest: %w", err)
+	}
+	var children []int64
+	if m.MediaType == manifestlist.MediaTypeManifestList || m.MediaType == v1.MediaTypeImageIndex {
+		references, err := s.manifestStore.References(ctx, m)
+		if err != nil {
+			return 0, fmt.Errorf("failed to list references of manifest %s: %w", m.Digest, err)
+		}
+		for _, reference := range references {
+			children = append(children, reference.ID)
+		}
+	}
+
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		tags, err := s.tagStore.GetTagNamesByManifestIDs(ctx, registry.ID, []int64{m.ID})
+		if err != nil {
+			return fmt.Errorf("failed to get tags of manifest %s: %w", m.Digest, err)
+		}
+		versions := append([]string{m.Digest.String()}, tags[m.ID]...)
+		if err = pkg.CheckLegalHold(ctx, s.legalHoldStore, registry.ID, m.ImageName, versions...); err != nil {
+			return err
+		}
+
+		// lock the GC reviews of the manifests of an index, as the deletes through the registry API do.
+		if len(children) > 0 {
+			lockCtx, cancel := context.WithTimeout(ctx, manifestGCLockTimeout)
+			defer cancel()
+			if _, err = s.gcService.ManifestFindAndLockNBefore(lockCtx, registry.ID, children,
+				time.Now().Add(manifestGCReviewWindow)); err != nil {
+				return err
+			}
+		}
+
+		if _, err = s.tagStore.DeleteTagByManifestID(ctx, registry.ID, m.ID); err != nil {
+			return fmt.Errorf("failed to delete tags of manifest %s: %w", m.Digest, err)
+		}
+		if _, err = s.manifestStore.DeleteManifest(ctx, registry.ID, m.ImageName, m.Digest); err != nil {
+			return fmt.Errorf("failed to delete manifest %s: %w", m.Digest, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return candidate.Size, nil
+}
+
+// evictFiles deletes the cached files of a version along with the version, and returns their size. The
+// generic blobs no other file is stored in are deleted from the storage once the deletion is committed.
+func (s *Service) evictFiles(
+	ctx context.Context,
+	registry *types.Registry,
+	candidate *types.CachedArtifact,
+) (int64, error) {
+	var size int64
+	var purged []string
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := pkg.CheckLegalHold(ctx, s.legalHoldStore, registry.ID, candidate.ImageName,
+			candidate.Version); err != nil {
+			return err
+		}
+
+		versionPath := compliance.VersionPath(registry.PackageType, candidate.ImageName, candidate.Version)
+		nodes, err := s.nodesStore.ListByPath(ctx, registry.ID, versionPath)
+		if err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+		blobs := map[string]*types.GenericBlob{}
+		for _, node := range nodes {
+			if !node.IsFile || node.BlobID == "" {
+				continue
+			}
+			blob, ok := blobs[node.BlobID]
+			if !ok {
+				if blob, err = s.genericBlobStore.FindByID(ctx, node.BlobID); err != nil {
+					return fmt.Errorf("failed to find blob %s: %w", node.BlobID, err)
+				}
+				blobs[node.BlobID] = blob
+			}
+			size += blob.Size
+		}
+		if _, err = s.nodesStore.DeleteByPath(ctx, registry.ID, versionPath); err != nil {
+			return fmt.Errorf("failed to delete files: %w", err)
+		}
+
+		for blobID, blob := range blobs {
+			references, err := s.nodesStore.CountByBlobID(ctx, blobID)
+			if err != nil {
+				return fmt.Errorf("failed to count files of blob %s: %w", blobID, err)
+			}
+			if references > 0 {
+				continue
+			}
+			if err = s.genericBlobStore.DeleteByID(ctx, blobID); err != nil {
+				return fmt.Errorf("failed to delete blob %s: %w", blobID, err)
+			}
+			purged = append(purged, blob.Sha256)
+		}
+
+		if _, err = s.downloadStatStore.DeleteByArtifactID(ctx, candidate.ArtifactID); err != nil {
+			return fmt.Errorf("failed to delete downloads: %w", err)
+		}
+		if err = s.artifactStore.DeleteByID(ctx, candidate.ArtifactID); err != nil {
+			return fmt.Errorf("failed to delete version: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(purged) > 0 {
+		s.deleteGenericBlobs(ctx, registry, purged)
+	}
+	return size, nil
+}
+
+// deleteGenericBlobs deletes the evicted generic blobs from the storage. The blobs are already gone from the
+// database, failures are only logged, the consistency check reports the files left behind.
+func (s *Service) deleteGenericBlobs(ctx context.Context, registry *types.Registry, sha256s []string) {
+	root := registry.StoragePrefix
+	if root == "" {
+		space, err := s.spaceFinder.FindByID(ctx, registry.RootParentID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to find root space of registry %s, "+
+				"evicted blobs are left in the storage", registry.Name)
+			return
+		}
+		root = space.Identifier
+	}
+	for _, sha := range sha256s {
+		blobPath := path.Join("/", root, "files", sha)
+		if err := s.driver.Delete(ctx, blobPath); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to delete evicted blob %s", blobPath)
+		}
+	}
+}
+
+func isOCI(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM
+}
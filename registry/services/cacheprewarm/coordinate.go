@@ -0,0 +1,92 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheprewarm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+var (
+	// ErrUnsupportedPackageType is returned for registries of package types that can't be prewarmed.
+	ErrUnsupportedPackageType = errors.New("cache prewarming isn't supported for the package type")
+	// ErrInvalidCoordinate is returned for coordinates that don't identify an artifact of the package type.
+	ErrInvalidCoordinate = errors.New("invalid artifact coordinate")
+)
+
+const defaultTag = "latest"
+
+// Coordinate identifies an artifact to fetch into the cache of an upstream proxy.
+type Coordinate struct {
+	// Group is the group id of a Maven artifact.
+	Group string
+	// Name is the image, the artifact id of a Maven artifact or the name of an npm package.
+	Name string
+	// Version is the tag or digest of an image.
+	Version string
+	// Packaging is the packaging of a Maven artifact, jar unless given.
+	Packaging string
+}
+
+// ParseCoordinate parses the coordinate of an artifact of the package type, image:tag or image@digest for
+// images and Helm charts, group:artifact:version[:packaging] for Maven and name@version for npm. Images
+// without a tag or digest default to the latest tag.
+func ParseCoordinate(packageType artifact.PackageType, s string) (Coordinate, error) {
+	s = strings.TrimSpace(s)
+	var c Coordinate
+	switch packageType {
+	case artifact.PackageTypeDOCKER, artifact.PackageTypeHELM:
+		c = parseImage(s)
+	case artifact.PackageTypeMAVEN:
+		parts := strings.Split(s, ":")
+		if len(parts) == 3 || len(parts) == 4 {
+			c = Coordinate{Group: parts[0], Name: parts[1], Version: parts[2], Packaging: "jar"}
+			if len(parts) == 4 {
+				c.Packaging = parts[3]
+			}
+			if c.Group == "" || c.Packaging == "" {
+				c = Coordinate{}
+			}
+		}
+	case artifact.PackageTypeNPM:
+		if i := strings.LastIndex(s, "@"); i > 0 {
+			c = Coordinate{Name: s[:i], Version: s[i+1:]}
+		}
+	default:
+		return Coordinate{}, fmt.Errorf("%w: %s", ErrUnsupportedPackageType, packageType)
+	}
+	if c.Name == "" || c.Version == "" || strings.ContainsAny(c.Name+c.Version, " \t") {
+		return Coordinate{}, fmt.Errorf("%w %q for package type %s", ErrInvalidCoordinate, s, packageType)
+	}
+	return c, nil
+}
+
+func parseImage(s string) Coordinate {
+	if i := strings.Index(s, "@"); i >= 0 {
+		return Coordinate{Name: s[:i], Version: s[i+1:]}
+	}
+	if i := strings.LastIndex(s, ":"); i > strings.LastIndex(s, "/") {
+		return Coordinate{Name: s[:i], Version: s[i+1:]}
+	}
+	return Coordinate{Name: s, Version: defaultTag}
+}
+
+// IsDigest reports whether the version of an image is a digest rather than a tag.
+func (c Coordinate) IsDigest() bool {
+	return strings.Contains(c.Version, ":")
+}
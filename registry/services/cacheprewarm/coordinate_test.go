@@ -0,0 +1,112 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheprewarm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCoordinate(t *testing.T) {
+	tests := []struct {
+		name        string
+		packageType artifact.PackageType
+		coordinate  string
+		want        Coordinate
+		wantErr     error
+	}{
+		{
+			name:        "image with tag",
+			packageType: artifact.PackageTypeDOCKER,
+			coordinate:  "library/nginx:1.27",
+			want:        Coordinate{Name: "library/nginx", Version: "1.27"},
+		},
+		{
+			name:        "image without tag",
+			packageType: artifact.PackageTypeDOCKER,
+			coordinate:  "nginx",
+			want:        Coordinate{Name: "nginx", Version: "latest"},
+		},
+		{
+			name:        "image with digest",
+			packageType: artifact.PackageTypeDOCKER,
+			coordinate:  "nginx@sha256:abc",
+			want:        Coordinate{Name: "nginx", Version: "sha256:abc"},
+		},
+		{
+			name:        "helm chart without tag",
+			packageType: artifact.PackageTypeHELM,
+			coordinate:  "charts/app",
+			want:        Coordinate{Name: "charts/app", Version: "latest"},
+		},
+		{
+			name:        "maven artifact",
+			packageType: artifact.PackageTypeMAVEN,
+			coordinate:  "org.apache.commons:commons-lang3:3.14.0",
+			want:        Coordinate{Group: "org.apache.commons", Name: "commons-lang3", Version: "3.14.0", Packaging: "jar"},
+		},
+		{
+			name:        "maven artifact with packaging",
+			packageType: artifact.PackageTypeMAVEN,
+			coordinate:  "org.example:parent:1.0:pom",
+			want:        Coordinate{Group: "org.example", Name: "parent", Version: "1.0", Packaging: "pom"},
+		},
+		{
+			name:        "maven artifact without version",
+			packageType: artifact.PackageTypeMAVEN,
+			coordinate:  "org.example:parent",
+			wantErr:     ErrInvalidCoordinate,
+		},
+		{
+			name:        "npm package",
+			packageType: artifact.PackageTypeNPM,
+			coordinate:  "lodash@4.17.21",
+			want:        Coordinate{Name: "lodash", Version: "4.17.21"},
+		},
+		{
+			name:        "scoped npm package",
+			packageType: artifact.PackageTypeNPM,
+			coordinate:  "@types/node@20.1.0",
+			want:        Coordinate{Name: "@types/node", Version: "20.1.0"},
+		},
+		{
+			name:        "npm package without version",
+			packageType: artifact.PackageTypeNPM,
+			coordinate:  "@types/node",
+			wantErr:     ErrInvalidCoordinate,
+		},
+		{
+			name:        "unsupported package type",
+			packageType: artifact.PackageTypeGENERIC,
+			coordinate:  "file:1.0",
+			wantErr:     ErrUnsupportedPackageType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCoordinate(tt.packageType, tt.coordinate)
+			if tt.wantErr != nil {
+				assert.True(t, errors.Is(err, tt.wantErr), "unexpected error %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheprewarm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/manifest"
+	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/manifest/schema2"
+	npmmetadata "github.com/harness/gitness/registry/app/metadata/npm"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/app/pkg/maven"
+	"github.com/harness/gitness/registry/app/pkg/npm"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
+
+	v2 "github.com/distribution/distribution/v3/registry/api/v2"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+var manifestMediaTypes = []string{
+	v1.MediaTypeImageIndex,
+	manifestlist.MediaTypeManifestList,
+	v1.MediaTypeImageManifest,
+	schema2.MediaTypeManifest,
+}
+
+// fetchImage pulls the manifest of the image, the manifests of all platforms of an index, and their blobs.
+// The upstream proxy caches the pulled manifests and blobs in the background.
+func (s *Service) fetchImage(
+	ctx context.Context,
+	registry *types.Registry,
+	rootIdentifier string,
+	coordinate Coordinate,
+) error {
+	urlBuilder, err := v2.NewURLBuilderFromString(s.urlProvider.RegistryURL(ctx), false)
+	if err != nil {
+		return fmt.Errorf("failed to create url builder: %w", err)
+	}
+	// the pull path modifies the info it's passed, so every pull gets its own.
+	newInfo := func(ref string) pkg.RegistryInfo {
+		info := pkg.RegistryInfo{
+			ArtifactInfo: &pkg.ArtifactInfo{
+				BaseInfo: &pkg.BaseInfo{
+					PathRoot:       strings.ToLower(rootIdentifier),
+					RootIdentifier: rootIdentifier,
+					RootParentID:   registry.RootParentID,
+					ParentID:       registry.ParentID,
+					StoragePrefix:  registry.StoragePrefix,
+				},
+				RegIdentifier: registry.Name,
+				Image:         coordinate.Name,
+			},
+			Reference:   ref,
+			URLBuilder:  urlBuilder,
+			PackageType: registry.PackageType,
+		}
+		if strings.Contains(ref, ":") {
+			info.Digest = ref
+		} else {
+			info.Tag = ref
+		}
+		return info
+	}
+
+	m, err := s.pullManifest(ctx, newInfo(coordinate.Version))
+	if err != nil {
+		return err
+	}
+	manifests := []manifest.Manifest{m}
+	if mediaType, _, _ := m.Payload(); mediaType == v1.MediaTypeImageIndex ||
+		mediaType == manifestlist.MediaTypeManifestList {
+		manifests = manifests[:0]
+		for _, child := range m.References() {
+			childManifest, err := s.pullManifest(ctx, newInfo(child.Digest.String()))
+			if err != nil {
+				return err
+			}
+			manifests = append(manifests, childManifest)
+		}
+	}
+
+	for _, m := range manifests {
+		for _, blob := range m.References() {
+			response, ok := s.dockerController.GetBlob(ctx, newInfo(blob.Digest.String())).(*docker.GetBlobResponse)
+			if !ok {
+				return fmt.Errorf("failed to pull blob %s", blob.Digest)
+			}
+			closeFile(response.Body, response.ReadCloser)
+			if err = firstError(response.Errors); err != nil {
+				return fmt.Errorf("failed to pull blob %s: %w", blob.Digest, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) pullManifest(ctx context.Context, info pkg.RegistryInfo) (manifest.Manifest, error) {
+	ref := info.Reference
+	response, ok := s.dockerController.PullManifest(ctx, info, manifestMediaTypes, nil).(*docker.GetManifestResponse)
+	if !ok {
+		return nil, fmt.Errorf("failed to pull manifest %s", ref)
+	}
+	if err := firstError(response.Errors); err != nil {
+		return nil, fmt.Errorf("failed to pull manifest %s: %w", ref, err)
+	}
+	if response.Manifest == nil {
+		return nil, fmt.Errorf("manifest %s not found", ref)
+	}
+	return response.Manifest, nil
+}
+
+// fetchMavenArtifact downloads the POM of the Maven artifact and its file of the packaging.
+func (s *Service) fetchMavenArtifact(
+	ctx context.Context,
+	registry *types.Registry,
+	rootIdentifier string,
+	coordinate Coordinate,
+) error {
+	fileNames := []string{coordinate.Name + "-" + coordinate.Version + ".pom"}
+	if coordinate.Packaging != "pom" {
+		fileNames = append(fileNames, coordinate.Name+"-"+coordinate.Version+"."+coordinate.Packaging)
+	}
+	for _, fileName := range fileNames {
+		info := pkg.MavenArtifactInfo{
+			BaseInfo: &pkg.BaseInfo{
+				RootIdentifier: rootIdentifier,
+				RootParentID:   registry.RootParentID,
+				ParentID:       registry.ParentID,
+				StoragePrefix:  registry.StoragePrefix,
+			},
+			RegIdentifier: registry.Name,
+			RegistryID:    registry.ID,
+			GroupID:       coordinate.Group,
+			ArtifactID:    coordinate.Name,
+			Version:       coordinate.Version,
+			FileName:      fileName,
+		}
+		response, ok := s.mavenController.GetArtifact(ctx, info).(*maven.GetArtifactResponse)
+		if !ok {
+			return fmt.Errorf("failed to download %s", fileName)
+		}
+		closeFile(response.Body, response.ReadCloser)
+		if err := firstError(response.Errors); err != nil {
+			return fmt.Errorf("failed to download %s: %w", fileName, err)
+		}
+	}
+	return nil
+}
+
+// fetchNpmPackage downloads the tarball of the version of the npm package.
+func (s *Service) fetchNpmPackage(
+	ctx context.Context,
+	registry *types.Registry,
+	rootIdentifier string,
+	coordinate Coordinate,
+) error {
+	info := npm.ArtifactInfo{
+		ArtifactInfo: &pkg.ArtifactInfo{
+			BaseInfo: &pkg.BaseInfo{
+				RootIdentifier: rootIdentifier,
+				RootParentID:   registry.RootParentID,
+				ParentID:       registry.ParentID,
+				StoragePrefix:  registry.StoragePrefix,
+			},
+			RegIdentifier: registry.Name,
+			Image:         coordinate.Name,
+		},
+		Filename: npmmetadata.TarballName(coordinate.Name, coordinate.Version),
+	}
+	_, fileReader, _, errc := s.npmController.DownloadPackageFile(ctx, info)
+	closeFile(fileReader, nil)
+	if !commons.IsEmptyError(errc) {
+		return fmt.Errorf("failed to download %s: %w", info.Filename, errc)
+	}
+	return nil
+}
+
+func closeFile(fileReader *storage.FileReader, readCloser io.ReadCloser) {
+	if fileReader != nil {
+		_ = fileReader.Close()
+	}
+	if readCloser != nil {
+		_ = readCloser.Close()
+	}
+}
+
+func firstError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.Join(errs...)
+}
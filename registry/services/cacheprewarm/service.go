@@ -0,0 +1,255 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheprewarm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/app/services/refcache"
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/app/pkg/maven"
+	"github.com/harness/gitness/registry/app/pkg/npm"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType        = "gitness:registry:cache-prewarm"
+	jobMaxDuration = 6 * time.Hour
+	jobMaxRetries  = 3
+
+	// MaxArtifacts is the number of artifacts that can be prewarmed at once.
+	MaxArtifacts = 1000
+)
+
+// Input is the data of a cache prewarm job.
+type Input struct {
+	RegistryID int64  `json:"registry_id"`
+	Batch      string `json:"batch"`
+}
+
+// Service fetches artifacts into the caches of upstream proxies ahead of their first pull, so they're
+// served while the upstream is unreachable or busy. The artifacts are fetched in the background through
+// the pull path of the package type, as a pull by the system would.
+type Service struct {
+	scheduler        *job.Scheduler
+	executor         *job.Executor
+	tx               dbtx.Transactor
+	registryDao      store.RegistryRepository
+	prewarmStore     store.CachePrewarmRepository
+	spaceFinder      refcache.SpaceFinder
+	urlProvider      urlprovider.Provider
+	dockerController *docker.Controller
+	mavenController  *maven.Controller
+	npmController    npm.Controller
+}
+
+func NewService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	tx dbtx.Transactor,
+	registryDao store.RegistryRepository,
+	prewarmStore store.CachePrewarmRepository,
+	spaceFinder refcache.SpaceFinder,
+	urlProvider urlprovider.Provider,
+	dockerController *docker.Controller,
+	mavenController *maven.Controller,
+	npmController npm.Controller,
+) *Service {
+	return &Service{
+		scheduler:        scheduler,
+		executor:         executor,
+		tx:               tx,
+		registryDao:      registryDao,
+		prewarmStore:     prewarmStore,
+		spaceFinder:      spaceFinder,
+		urlProvider:      urlProvider,
+		dockerController: dockerController,
+		mavenController:  mavenController,
+		npmController:    npmController,
+	}
+}
+
+func (s *Service) Register(_ context.Context) error {
+	if err := s.executor.Register(jobType, s); err != nil {
+		return fmt.Errorf("failed to register job handler for cache prewarming: %w", err)
+	}
+	return nil
+}
+
+// Prewarm schedules the artifacts to be fetched into the cache of the upstream proxy, and returns them
+// as a new batch. Duplicate coordinates are requested once.
+func (s *Service) Prewarm(
+	ctx context.Context,
+	registry *types.Registry,
+	coordinates []string,
+	principalID int64,
+) ([]*types.CachePrewarmArtifact, error) {
+	if len(coordinates) == 0 {
+		return nil, fmt.Errorf("%w: no artifacts to prewarm", ErrInvalidCoordinate)
+	}
+	if len(coordinates) > MaxArtifacts {
+		return nil, fmt.Errorf("%w: at most %d artifacts can be prewarmed at once", ErrInvalidCoordinate,
+			MaxArtifacts)
+	}
+	batch := uuid.NewString()
+	artifacts := make([]*types.CachePrewarmArtifact, 0, len(coordinates))
+	seen := make(map[string]bool, len(coordinates))
+	for _, coordinate := range coordinates {
+		coordinate = strings.TrimSpace(coordinate)
+		if _, err := ParseCoordinate(registry.PackageType, coordinate); err != nil {
+			return nil, err
+		}
+		if seen[coordinate] {
+			continue
+		}
+		seen[coordinate] = true
+		artifacts = append(artifacts, &types.CachePrewarmArtifact{
+			RegistryID: registry.ID,
+			Batch:      batch,
+			Coordinate: coordinate,
+			Status:     types.CachePrewarmStatusPending,
+			CreatedBy:  principalID,
+		})
+	}
+
+	data, err := json.Marshal(Input{RegistryID: registry.ID, Batch: batch})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cache prewarm input: %w", err)
+	}
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		return s.prewarmStore.CreateArtifacts(ctx, artifacts)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save cache prewarm artifacts: %w", err)
+	}
+	err = s.scheduler.RunJob(ctx, job.Definition{
+		UID:        JobUID(batch),
+		Type:       jobType,
+		MaxRetries: jobMaxRetries,
+		Timeout:    jobMaxDuration,
+		Data:       string(data),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule cache prewarm job: %w", err)
+	}
+	return artifacts, nil
+}
+
+// ListBatch lists the artifacts of the batch with their status.
+func (s *Service) ListBatch(
+	ctx context.Context,
+	registryID int64,
+	batch string,
+) ([]*types.CachePrewarmArtifact, error) {
+	return s.prewarmStore.ListBatch(ctx, registryID, batch)
+}
+
+// JobUID returns the uid of the job prewarming the batch.
+func JobUID(batch string) string {
+	return jobType + ":" + batch
+}
+
+// Result is the number of artifacts of a batch that were cached and that failed.
+type Result struct {
+	Cached int `json:"cached"`
+	Failed int `json:"failed"`
+}
+
+// Handle fetches the pending artifacts of a batch, artifacts fetched before a retry of the job are kept.
+func (s *Service) Handle(ctx context.Context, data string, progress job.ProgressReporter) (string, error) {
+	var input Input
+	if err := json.Unmarshal([]byte(data), &input); err != nil {
+		return "", fmt.Errorf("failed to unmarshal cache prewarm input: %w", err)
+	}
+	registry, err := s.registryDao.Get(ctx, input.RegistryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get registry: %w", err)
+	}
+	artifacts, err := s.prewarmStore.ListBatch(ctx, registry.ID, input.Batch)
+	if err != nil {
+		return "", fmt.Errorf("failed to list cache prewarm artifacts: %w", err)
+	}
+
+	// the pull path authorizes the downloads, which are done on behalf of the system.
+	ctx = request.WithAuthSession(ctx, bootstrap.NewSystemServiceSession())
+
+	var result Result
+	for i, a := range artifacts {
+		switch a.Status {
+		case types.CachePrewarmStatusCached:
+			result.Cached++
+			continue
+		case types.CachePrewarmStatusFailed:
+			result.Failed++
+			continue
+		case types.CachePrewarmStatusPending:
+		}
+
+		status, errMsg := types.CachePrewarmStatusCached, ""
+		if err = s.fetch(ctx, registry, a.Coordinate); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to prewarm %s in the cache of registry %s", a.Coordinate,
+				registry.Name)
+			status, errMsg = types.CachePrewarmStatusFailed, err.Error()
+			result.Failed++
+		} else {
+			result.Cached++
+		}
+		if err = s.prewarmStore.UpdateStatus(ctx, a.ID, status, errMsg); err != nil {
+			return "", fmt.Errorf("failed to update cache prewarm artifact: %w", err)
+		}
+		_ = progress((i+1)*100/len(artifacts), "")
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal cache prewarm result: %w", err)
+	}
+	return string(output), nil
+}
+
+func (s *Service) fetch(ctx context.Context, registry *types.Registry, raw string) error {
+	coordinate, err := ParseCoordinate(registry.PackageType, raw)
+	if err != nil {
+		return err
+	}
+	rootSpace, err := s.spaceFinder.FindByID(ctx, registry.RootParentID)
+	if err != nil {
+		return fmt.Errorf("failed to find root space: %w", err)
+	}
+	switch registry.PackageType {
+	case artifact.PackageTypeDOCKER, artifact.PackageTypeHELM:
+		return s.fetchImage(ctx, registry, rootSpace.Identifier, coordinate)
+	case artifact.PackageTypeMAVEN:
+		return s.fetchMavenArtifact(ctx, registry, rootSpace.Identifier, coordinate)
+	case artifact.PackageTypeNPM:
+		return s.fetchNpmPackage(ctx, registry, rootSpace.Identifier, coordinate)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedPackageType, registry.PackageType)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimsmapping
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	corestore "github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/token"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/golang-jwt/jwt"
+)
+
+var (
+	// ErrNotConfigured is returned by Exchange when no identity provider is configured.
+	ErrNotConfigured = errors.New("external identity exchange isn't configured")
+	// ErrInvalidIDToken is returned by Exchange for ID tokens that can't be trusted or mapped to a user.
+	ErrInvalidIDToken = errors.New("invalid ID token")
+	// ErrInvalidMapping is returned when a claims mapping is created with invalid parameters.
+	ErrInvalidMapping = errors.New("invalid claims mapping")
+)
+
+const tokenIdentifierPrefix = "registry-idp"
+
+// Exchange is the outcome of exchanging an ID token: a personal access token of the user, valid as
+// long as the access grants derived from the claims of the ID token.
+type Exchange struct {
+	Token     string
+	ExpiresAt time.Time
+	Principal string
+	Grants    []*types.AccessGrant
+}
+
+// Service maps the claims of ID tokens issued by an enterprise identity provider, e.g. its groups,
+// to registry roles, so that directory groups drive registry permissions without assigning them by hand.
+// The mappings are evaluated when an ID token is exchanged for a registry token, and the resulting
+// permissions are recorded as access grants expiring with the token.
+type Service struct {
+	verifier       *verifier
+	emailClaim     string
+	tokenLifetime  time.Duration
+	tx             dbtx.Transactor
+	mappingStore   store.ClaimMappingRepository
+	grantStore     store.AccessGrantRepository
+	registryDao    store.RegistryRepository
+	principalStore corestore.PrincipalStore
+	tokenStore     corestore.TokenStore
+}
+
+func NewService(
+	issuer string,
+	audience string,
+	publicKey any,
+	emailClaim string,
+	tokenLifetime time.Duration,
+	tx dbtx.Transactor,
+	mappingStore store.ClaimMappingRepository,
+	grantStore store.AccessGrantRepository,
+	registryDao store.RegistryRepository,
+	principalStore corestore.PrincipalStore,
+	tokenStore corestore.TokenStore,
+) *Service {
+	s := &Service{
+		emailClaim:     emailClaim,
+		tokenLifetime:  tokenLifetime,
+		tx:             tx,
+		mappingStore:   mappingStore,
+		grantStore:     grantStore,
+		registryDao:    registryDao,
+		principalStore: principalStore,
+		tokenStore:     tokenStore,
+	}
+	if issuer != "" {
+		s.verifier = &verifier{issuer: issuer, audience: audience, key: publicKey}
+	}
+	return s
+}
+
+// CreateMapping validates and records a claims mapping.
+func (s *Service) CreateMapping(ctx context.Context, mapping *types.ClaimMapping) error {
+	mapping.Claim = strings.TrimSpace(mapping.Claim)
+	mapping.Value = strings.TrimSpace(mapping.Value)
+	if mapping.Claim == "" || mapping.Value == "" {
+		return fmt.Errorf("%w: claim and value are required", ErrInvalidMapping)
+	}
+	if _, err := path.Match(mapping.Value, ""); err != nil {
+		return fmt.Errorf("%w: invalid value pattern %q", ErrInvalidMapping, mapping.Value)
+	}
+	if mapping.Role.Permissions() == nil {
+		return fmt.Errorf("%w: unknown role %q", ErrInvalidMapping, mapping.Role)
+	}
+	if mapping.RegistryName != "" {
+		_, err := s.registryDao.GetByParentIDAndName(ctx, mapping.SpaceID, mapping.RegistryName)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			return fmt.Errorf("%w: registry %q not found", ErrInvalidMapping, mapping.RegistryName)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find registry: %w", err)
+		}
+	}
+	err := s.mappingStore.Create(ctx, mapping)
+	if errors.Is(err, gitnessstore.ErrDuplicate) {
+		return fmt.Errorf("%w: the claim value is already mapped for the registry", ErrInvalidMapping)
+	}
+	return err
+}
+
+func (s *Service) ListMappings(ctx context.Context, spaceID int64) ([]*types.ClaimMapping, error) {
+	return s.mappingStore.List(ctx, spaceID)
+}
+
+func (s *Service) DeleteMapping(ctx context.Context, spaceID int64, id int64) error {
+	return s.mappingStore.Delete(ctx, spaceID, id)
+}
+
+// Exchange verifies an ID token, grants its user the registry roles mapped from its claims and
+// issues a personal access token for the user expiring with the grants.
+func (s *Service) Exchange(ctx context.Context, rawIDToken string) (*Exchange, error) {
+	if s.verifier == nil {
+		return nil, ErrNotConfigured
+	}
+	now := time.Now()
+	claims, err := s.verifier.verify(rawIDToken, now)
+	if err != nil {
+		return nil, err
+	}
+	email, _ := claims[s.emailClaim].(string)
+	if email == "" {
+		return nil, fmt.Errorf("%w: claim %q is missing", ErrInvalidIDToken, s.emailClaim)
+	}
+	user, err := s.principalStore.FindUserByEmail(ctx, email)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, fmt.Errorf("%w: no user with email %q", ErrInvalidIDToken, email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user.Blocked {
+		return nil, fmt.Errorf("%w: user %q is blocked", ErrInvalidIDToken, user.UID)
+	}
+
+	permissions, err := s.evaluate(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	exchange := &Exchange{ExpiresAt: now.Add(s.tokenLifetime), Principal: user.UID}
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		for _, registryID := range sortedKeys(permissions) {
+			grant := &types.AccessGrant{
+				RegistryID:   registryID,
+				PrincipalID:  user.ID,
+				PrincipalUID: user.UID,
+				Permissions:  permissions[registryID],
+				Reason:       "mapped from the claims of an ID token issued by " + s.verifier.issuer,
+				ExpiresAt:    exchange.ExpiresAt,
+				CreatedAt:    now,
+				CreatedBy:    user.ID,
+			}
+			if err := s.grantStore.Create(ctx, grant); err != nil {
+				return fmt.Errorf("failed to create access grant: %w", err)
+			}
+			exchange.Grants = append(exchange.Grants, grant)
+		}
+		_, jwtToken, err := token.CreatePAT(ctx, s.tokenStore, user.ToPrincipal(), user,
+			token.GenerateIdentifier(tokenIdentifierPrefix), &s.tokenLifetime)
+		if err != nil {
+			return fmt.Errorf("failed to create token: %w", err)
+		}
+		exchange.Token = jwtToken
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return exchange, nil
+}
+
+// evaluate returns the permissions per registry ID that the claims map to.
+func (s *Service) evaluate(ctx context.Context, claims jwt.MapClaims) (map[int64][]enum.Permission, error) {
+	names := make([]string, 0, len(claims))
+	for name := range claims {
+		names = append(names, name)
+	}
+	mappings, err := s.mappingStore.ListByClaims(ctx, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claims mappings: %w", err)
+	}
+
+	permissions := make(map[int64][]enum.Permission)
+	for _, mapping := range mappings {
+		if !Matches(mapping, claims[mapping.Claim]) {
+			continue
+		}
+		registryIDs, err := s.registryIDs(ctx, mapping)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range registryIDs {
+			permissions[id] = union(permissions[id], mapping.Role.Permissions())
+		}
+	}
+	return permissions, nil
+}
+
+func (s *Service) registryIDs(ctx context.Context, mapping *types.ClaimMapping) ([]int64, error) {
+	if mapping.RegistryName != "" {
+		registry, err := s.registryDao.GetByParentIDAndName(ctx, mapping.SpaceID, mapping.RegistryName)
+		if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find registry: %w", err)
+		}
+		return []int64{registry.ID}, nil
+	}
+	registries, err := s.registryDao.ListByParentID(ctx, mapping.SpaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registries: %w", err)
+	}
+	ids := make([]int64, 0, len(*registries))
+	for _, registry := range *registries {
+		ids = append(ids, registry.ID)
+	}
+	return ids, nil
+}
+
+// Matches reports whether the value of a claim, a string or a list of strings like groups,
+// matches the mapping.
+func Matches(mapping *types.ClaimMapping, claim any) bool {
+	var values []string
+	switch v := claim.(type) {
+	case string:
+		values = []string{v}
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	case []string:
+		values = v
+	}
+	for _, value := range values {
+		if ok, _ := path.Match(mapping.Value, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func union(a, b []enum.Permission) []enum.Permission {
+	result := append([]enum.Permission{}, a...)
+	for _, p := range b {
+		found := false
+		for _, existing := range result {
+			if existing == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func sortedKeys(m map[int64][]enum.Permission) []int64 {
+	keys := make([]int64, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
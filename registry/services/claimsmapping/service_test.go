@@ -0,0 +1,93 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimsmapping
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatches(t *testing.T) {
+	mapping := &types.ClaimMapping{Claim: "groups", Value: "platform-*"}
+
+	assert.True(t, Matches(mapping, "platform-admins"))
+	assert.True(t, Matches(mapping, []any{"dev", "platform-oncall"}))
+	assert.False(t, Matches(mapping, []any{"dev", 42}))
+	assert.False(t, Matches(mapping, "platform"))
+	assert.False(t, Matches(mapping, nil))
+}
+
+func TestVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey, err := ParsePublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+	require.NoError(t, err)
+
+	v := &verifier{issuer: "https://idp.example.com", audience: "registry", key: publicKey}
+	now := time.Now()
+	sign := func(claims jwt.MapClaims) string {
+		raw, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(key)
+		require.NoError(t, err)
+		return raw
+	}
+
+	claims, err := v.verify(sign(jwt.MapClaims{
+		"iss":    "https://idp.example.com",
+		"aud":    []string{"registry", "other"},
+		"exp":    now.Add(time.Hour).Unix(),
+		"email":  "jane@example.com",
+		"groups": []string{"platform-admins"},
+	}), now)
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", claims["email"])
+
+	_, err = v.verify(sign(jwt.MapClaims{"iss": "https://idp.example.com", "aud": "registry"}), now)
+	assert.ErrorIs(t, err, ErrInvalidIDToken, "exp is required")
+
+	_, err = v.verify(sign(jwt.MapClaims{
+		"iss": "https://evil.example.com", "aud": "registry", "exp": now.Add(time.Hour).Unix(),
+	}), now)
+	assert.ErrorIs(t, err, ErrInvalidIDToken)
+
+	_, err = v.verify(sign(jwt.MapClaims{
+		"iss": "https://idp.example.com", "aud": "other", "exp": now.Add(time.Hour).Unix(),
+	}), now)
+	assert.ErrorIs(t, err, ErrInvalidIDToken)
+
+	hmac, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": "https://idp.example.com", "aud": "registry", "exp": now.Add(time.Hour).Unix(),
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	_, err = v.verify(hmac, now)
+	assert.ErrorIs(t, err, ErrInvalidIDToken, "the signing method must match the key")
+}
+
+func TestParsePublicKey(t *testing.T) {
+	_, err := ParsePublicKey("not a key")
+	assert.Error(t, err)
+}
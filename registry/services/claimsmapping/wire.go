@@ -0,0 +1,49 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package claimsmapping
+
+import (
+	corestore "github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	tx dbtx.Transactor,
+	mappingStore store.ClaimMappingRepository,
+	grantStore store.AccessGrantRepository,
+	registryDao store.RegistryRepository,
+	principalStore corestore.PrincipalStore,
+	tokenStore corestore.TokenStore,
+) (*Service, error) {
+	identity := config.Registry.ExternalIdentity
+	var publicKey any
+	if identity.Issuer != "" {
+		var err error
+		if publicKey, err = ParsePublicKey(identity.PublicKey); err != nil {
+			return nil, err
+		}
+	}
+	return NewService(identity.Issuer, identity.Audience, publicKey, identity.EmailClaim, identity.TokenLifetime,
+		tx, mappingStore, grantStore, registryDao, principalStore, tokenStore), nil
+}
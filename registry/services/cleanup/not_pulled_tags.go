@@ -0,0 +1,173 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobTypeNotPulledTags        = "gitness:registry:cleanup:not-pulled-tags"
+	jobCronNotPulledTags        = "17 3 * * *" // At 03:17 every day.
+	jobMaxDurationNotPulledTags = 30 * time.Minute
+)
+
+type notPulledTagsCleanupJob struct {
+	cleanupPolicyStore store.CleanupPolicyRepository
+	tagStore           store.TagRepository
+	queueStore         store.RegistryQueueRepository
+	manifestStore      store.ManifestRepository
+	legalHoldStore     store.LegalHoldRepository
+	locker             *lease.Locker
+}
+
+func newNotPulledTagsCleanupJob(
+	cleanupPolicyStore store.CleanupPolicyRepository,
+	tagStore store.TagRepository,
+	queueStore store.RegistryQueueRepository,
+	manifestStore store.ManifestRepository,
+	legalHoldStore store.LegalHoldRepository,
+	locker *lease.Locker,
+) *notPulledTagsCleanupJob {
+	return &notPulledTagsCleanupJob{
+		cleanupPolicyStore: cleanupPolicyStore,
+		tagStore:           tagStore,
+		queueStore:         queueStore,
+		manifestStore:      manifestStore,
+		legalHoldStore:     legalHoldStore,
+		locker:             locker,
+	}
+}
+
+// Handle deletes the tags matched by NOT_PULLED cleanup policies that haven't been pulled
+// within the policy's retention period, except for tags under a legal hold. The job is skipped
+// while another replica runs a maintenance job.
+func (j *notPulledTagsCleanupJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	unlock, err := j.locker.TryLockJob(ctx, lease.JobMaintenance, jobMaxDurationNotPulledTags)
+	if errors.Is(err, lease.ErrHeld) {
+		log.Ctx(ctx).Info().Msg("skipping not pulled tags cleanup, a registry maintenance job is running")
+		return "skipped, a registry maintenance job is running", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	policies, err := j.cleanupPolicyStore.ListByType(ctx, artifact.CleanupPolicyTypeNOTPULLED)
+	if err != nil {
+		return "", fmt.Errorf("failed to list not pulled cleanup policies: %w", err)
+	}
+	if policies == nil || len(*policies) == 0 {
+		return "no not pulled cleanup policies found", nil
+	}
+
+	now := time.Now()
+	deleted := 0
+	for _, policy := range *policies {
+		paused, err := j.queueStore.IsPaused(ctx, policy.RegistryID, types.QueueCleanup)
+		if err != nil {
+			return "", fmt.Errorf("failed to check if cleanup of registry %d is paused: %w", policy.RegistryID, err)
+		}
+		if paused {
+			log.Ctx(ctx).Info().Msgf("skipping cleanup policy %q, the cleanup of registry %d is paused",
+				policy.Name, policy.RegistryID)
+			continue
+		}
+
+		since := now.Add(-time.Duration(policy.ExpiryTime) * time.Millisecond)
+		tags, err := j.tagStore.ListTagsNotPulledSince(ctx, policy.RegistryID, since)
+		if err != nil {
+			return "", fmt.Errorf("failed to list tags of registry %d not pulled since %s: %w",
+				policy.RegistryID, since.Format(time.RFC3339), err)
+		}
+
+		for _, tag := range tags {
+			if !policyMatchesTag(policy, tag) {
+				continue
+			}
+			versions, err := pkg.ManifestVersions(ctx, j.manifestStore, j.tagStore, tag.RegistryID, tag.ImageName,
+				tag.Name)
+			if err != nil {
+				return "", err
+			}
+			err = pkg.CheckLegalHold(ctx, j.legalHoldStore, tag.RegistryID, tag.ImageName, versions...)
+			if errors.Is(err, pkg.ErrLegalHold) {
+				log.Ctx(ctx).Info().Msgf("cleanup policy %q skipped tag %s:%s of registry %d: %s",
+					policy.Name, tag.ImageName, tag.Name, tag.RegistryID, err)
+				continue
+			}
+			if err != nil {
+				return "", err
+			}
+			if err = j.tagStore.DeleteTag(ctx, tag.RegistryID, tag.ImageName, tag.Name); err != nil {
+				return "", fmt.Errorf("failed to delete tag %s:%s of registry %d: %w",
+					tag.ImageName, tag.Name, tag.RegistryID, err)
+			}
+			log.Ctx(ctx).Info().Msgf("cleanup policy %q deleted tag %s:%s of registry %d",
+				policy.Name, tag.ImageName, tag.Name, tag.RegistryID)
+			deleted++
+		}
+	}
+
+	result := "no tags found that weren't pulled within their retention period"
+	if deleted > 0 {
+		result = fmt.Sprintf("deleted %d tags", deleted)
+	}
+
+	log.Ctx(ctx).Info().Msg(result)
+
+	return result, nil
+}
+
+// policyMatchesTag reports whether the tag is in scope of the policy. The package and version prefixes
+// restrict the image and tag names, an empty list matching everything, while a tag name matching any of
+// the exclude patterns is never in scope.
+func policyMatchesTag(policy types.CleanupPolicy, tag *types.Tag) bool {
+	if !hasAnyPrefix(tag.ImageName, policy.PackagePrefix) || !hasAnyPrefix(tag.Name, policy.VersionPrefix) {
+		return false
+	}
+	for _, pattern := range policy.ExcludePattern {
+		if matched, err := path.Match(pattern, tag.Name); err == nil && matched {
+			return false
+		}
+	}
+	return true
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
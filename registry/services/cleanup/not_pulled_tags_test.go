@@ -0,0 +1,39 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyMatchesTag(t *testing.T) {
+	policy := types.CleanupPolicy{
+		PackagePrefix:  []string{"team/"},
+		ExcludePattern: []string{"latest", "v*"},
+	}
+
+	assert.True(t, policyMatchesTag(policy, &types.Tag{ImageName: "team/app", Name: "build-12"}))
+	assert.False(t, policyMatchesTag(policy, &types.Tag{ImageName: "other/app", Name: "build-12"}))
+	assert.False(t, policyMatchesTag(policy, &types.Tag{ImageName: "team/app", Name: "latest"}))
+	assert.False(t, policyMatchesTag(policy, &types.Tag{ImageName: "team/app", Name: "v1.2.0"}))
+
+	policy.VersionPrefix = []string{"pr-"}
+	assert.True(t, policyMatchesTag(policy, &types.Tag{ImageName: "team/app", Name: "pr-42"}))
+	assert.False(t, policyMatchesTag(policy, &types.Tag{ImageName: "team/app", Name: "build-12"}))
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+)
+
+// Service is responsible for executing registry cleanup policies.
+type Service struct {
+	scheduler          *job.Scheduler
+	executor           *job.Executor
+	cleanupPolicyStore store.CleanupPolicyRepository
+	tagStore           store.TagRepository
+	queueStore         store.RegistryQueueRepository
+	uploadSessionStore store.UploadSessionRepository
+	manifestStore      store.ManifestRepository
+	legalHoldStore     store.LegalHoldRepository
+	locker             *lease.Locker
+}
+
+func NewService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	cleanupPolicyStore store.CleanupPolicyRepository,
+	tagStore store.TagRepository,
+	queueStore store.RegistryQueueRepository,
+	uploadSessionStore store.UploadSessionRepository,
+	manifestStore store.ManifestRepository,
+	legalHoldStore store.LegalHoldRepository,
+	locker *lease.Locker,
+) *Service {
+	return &Service{
+		scheduler:          scheduler,
+		executor:           executor,
+		cleanupPolicyStore: cleanupPolicyStore,
+		tagStore:           tagStore,
+		queueStore:         queueStore,
+		uploadSessionStore: uploadSessionStore,
+		manifestStore:      manifestStore,
+		legalHoldStore:     legalHoldStore,
+		locker:             locker,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(
+		jobTypeNotPulledTags,
+		newNotPulledTagsCleanupJob(s.cleanupPolicyStore, s.tagStore, s.queueStore, s.manifestStore,
+			s.legalHoldStore, s.locker),
+	); err != nil {
+		return fmt.Errorf("failed to register job handler for not pulled tags cleanup: %w", err)
+	}
+
+	err := s.scheduler.AddRecurring(
+		ctx,
+		jobTypeNotPulledTags,
+		jobTypeNotPulledTags,
+		jobCronNotPulledTags,
+		jobMaxDurationNotPulledTags,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to schedule not pulled tags cleanup job: %w", err)
+	}
+
+	if err := s.executor.Register(
+		jobTypeUploadSessions,
+		newUploadSessionsCleanupJob(s.uploadSessionStore),
+	); err != nil {
+		return fmt.Errorf("failed to register job handler for upload sessions cleanup: %w", err)
+	}
+
+	err = s.scheduler.AddRecurring(
+		ctx,
+		jobTypeUploadSessions,
+		jobTypeUploadSessions,
+		jobCronUploadSessions,
+		jobMaxDurationUploadSessions,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to schedule upload sessions cleanup job: %w", err)
+	}
+	return nil
+}
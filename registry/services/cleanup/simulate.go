@@ -0,0 +1,68 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+)
+
+// Candidate is a version a cleanup policy is evaluated against without running it.
+type Candidate struct {
+	Image     string
+	Version   string
+	CreatedAt time.Time
+	// LastPulledAt is zero for versions that were never pulled.
+	LastPulledAt time.Time
+	// Tag is set for the tags of OCI registries, the only versions whose pulls are recorded.
+	Tag bool
+}
+
+// Evaluate reports whether the policy would delete the candidate at now, and why. NOT_PULLED
+// policies only delete tags, as the cleanup job does.
+func Evaluate(policy types.CleanupPolicy, candidate Candidate, now time.Time) (string, bool) {
+	if policy.ExpiryTime <= 0 {
+		return "", false
+	}
+	if !policyMatchesTag(policy, &types.Tag{ImageName: candidate.Image, Name: candidate.Version}) {
+		return "", false
+	}
+	since := now.Add(-time.Duration(policy.ExpiryTime) * time.Millisecond)
+
+	//nolint:exhaustive
+	switch policy.Type {
+	case artifact.CleanupPolicyTypeAGE:
+		if candidate.CreatedAt.Before(since) {
+			return fmt.Sprintf("created before %s", since.Format(time.RFC3339)), true
+		}
+	case artifact.CleanupPolicyTypeNOTPULLED:
+		if !candidate.Tag {
+			return "", false
+		}
+		if candidate.LastPulledAt.IsZero() {
+			if candidate.CreatedAt.Before(since) {
+				return fmt.Sprintf("never pulled since created before %s", since.Format(time.RFC3339)), true
+			}
+			return "", false
+		}
+		if candidate.LastPulledAt.Before(since) {
+			return fmt.Sprintf("not pulled since %s", since.Format(time.RFC3339)), true
+		}
+	}
+	return "", false
+}
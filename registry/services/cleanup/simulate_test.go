@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	thirtyDays := (30 * 24 * time.Hour).Milliseconds()
+	old := Candidate{Image: "team/app", Version: "build-12", CreatedAt: now.AddDate(0, -2, 0), Tag: true}
+	recent := Candidate{Image: "team/app", Version: "build-13", CreatedAt: now.AddDate(0, 0, -1), Tag: true}
+
+	age := types.CleanupPolicy{Type: artifact.CleanupPolicyTypeAGE, ExpiryTime: thirtyDays}
+	_, deleted := Evaluate(age, old, now)
+	assert.True(t, deleted)
+	_, deleted = Evaluate(age, recent, now)
+	assert.False(t, deleted)
+
+	notPulled := types.CleanupPolicy{Type: artifact.CleanupPolicyTypeNOTPULLED, ExpiryTime: thirtyDays}
+	reason, deleted := Evaluate(notPulled, old, now)
+	assert.True(t, deleted)
+	assert.Equal(t, "never pulled since created before 2024-05-02T00:00:00Z", reason)
+
+	old.LastPulledAt = now.AddDate(0, 0, -3)
+	_, deleted = Evaluate(notPulled, old, now)
+	assert.False(t, deleted)
+
+	old.Tag = false
+	old.LastPulledAt = time.Time{}
+	_, deleted = Evaluate(notPulled, old, now)
+	assert.False(t, deleted)
+
+	age.ExcludePattern = []string{"build-*"}
+	_, deleted = Evaluate(age, old, now)
+	assert.False(t, deleted)
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobTypeUploadSessions        = "gitness:registry:cleanup:upload-sessions"
+	jobCronUploadSessions        = "41 * * * *" // At minute 41 every hour.
+	jobMaxDurationUploadSessions = 10 * time.Minute
+
+	// uploadSessionMaxAge is how long an upload can go without a chunk before its session is removed,
+	// matching the age after which the distribution registry purges abandoned uploads.
+	uploadSessionMaxAge = 7 * 24 * time.Hour
+)
+
+type uploadSessionsCleanupJob struct {
+	uploadSessionStore store.UploadSessionRepository
+}
+
+func newUploadSessionsCleanupJob(uploadSessionStore store.UploadSessionRepository) *uploadSessionsCleanupJob {
+	return &uploadSessionsCleanupJob{
+		uploadSessionStore: uploadSessionStore,
+	}
+}
+
+// Handle removes the sessions of the blob uploads that were abandoned by their clients.
+func (j *uploadSessionsCleanupJob) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	removed, err := j.uploadSessionStore.DeleteUpdatedBefore(ctx, time.Now().Add(-uploadSessionMaxAge))
+	if err != nil {
+		return "", fmt.Errorf("failed to remove abandoned upload sessions: %w", err)
+	}
+
+	result := fmt.Sprintf("removed %d abandoned upload sessions", removed)
+	log.Ctx(ctx).Info().Msg(result)
+
+	return result, nil
+}
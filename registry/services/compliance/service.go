@@ -0,0 +1,436 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/distribution/distribution/v3/manifest/manifestlist"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// manifestGCReviewWindow and manifestGCLockTimeout match the deletes of manifests through the registry API.
+	manifestGCReviewWindow = 1 * time.Hour
+	manifestGCLockTimeout  = 10 * time.Second
+)
+
+// ErrVersionNotFound is returned when purging a version that doesn't exist.
+var ErrVersionNotFound = errors.New("artifact version not found")
+
+// Service hard deletes artifact versions, leaving a certificate of what was deleted.
+type Service struct {
+	tx                dbtx.Transactor
+	imageStore        store.ImageRepository
+	artifactStore     store.ArtifactRepository
+	nodesStore        store.NodesRepository
+	genericBlobStore  store.GenericBlobRepository
+	manifestStore     store.ManifestRepository
+	tagStore          store.TagRepository
+	downloadStatStore store.DownloadStatRepository
+	legalHoldStore    store.LegalHoldRepository
+	certificateStore  store.DeletionCertificateRepository
+	gcService         gc.Service
+	spaceFinder       refcache.SpaceFinder
+	driver            storagedriver.StorageDriver
+}
+
+func NewService(
+	tx dbtx.Transactor,
+	imageStore store.ImageRepository,
+	artifactStore store.ArtifactRepository,
+	nodesStore store.NodesRepository,
+	genericBlobStore store.GenericBlobRepository,
+	manifestStore store.ManifestRepository,
+	tagStore store.TagRepository,
+	downloadStatStore store.DownloadStatRepository,
+	legalHoldStore store.LegalHoldRepository,
+	certificateStore store.DeletionCertificateRepository,
+	gcService gc.Service,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+) *Service {
+	return &Service{
+		tx:                tx,
+		imageStore:        imageStore,
+		artifactStore:     artifactStore,
+		nodesStore:        nodesStore,
+		genericBlobStore:  genericBlobStore,
+		manifestStore:     manifestStore,
+		tagStore:          tagStore,
+		downloadStatStore: downloadStatStore,
+		legalHoldStore:    legalHoldStore,
+		certificateStore:  certificateStore,
+		gcService:         gcService,
+		spaceFinder:       spaceFinder,
+		driver:            driver,
+	}
+}
+
+// PurgeVersion irreversibly deletes a version of an image and returns the certificate of the deletion.
+// The files of the version are deleted along with the generic blobs no other file is stored in, for images
+// the manifest and its tags are deleted, and the garbage collection deletes the blobs no other manifest
+// uses. The records of the downloads of the version are deleted too. Versions under a legal hold can't be
+// purged, pkg.ErrLegalHold is returned for them.
+func (s *Service) PurgeVersion(
+	ctx context.Context,
+	registry *types.Registry,
+	imageName string,
+	version string,
+	reason string,
+	principalID int64,
+) (*types.DeletionCertificate, error) {
+	certificate := &types.DeletionCertificate{
+		RegistryID:   registry.ID,
+		RegistryName: registry.Name,
+		PackageType:  string(registry.PackageType),
+		ImageName:    imageName,
+		Version:      version,
+		Reason:       reason,
+		DeletedItems: []string{},
+		Blobs:        []types.DeletedBlob{},
+		DeletedBy:    principalID,
+	}
+
+	var purged []string
+	var err error
+	if isOCI(registry.PackageType) {
+		err = s.purgeManifest(ctx, registry, certificate)
+	} else {
+		purged, err = s.purgeFiles(ctx, registry, certificate)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(purged) > 0 {
+		s.deleteGenericBlobs(ctx, registry, purged)
+	}
+	return certificate, nil
+}
+
+// purgeFiles deletes the files of a version, returning the SHA-256 checksums of the generic blobs to delete
+// from the storage once the deletion is committed.
+func (s *Service) purgeFiles(
+	ctx context.Context,
+	registry *types.Registry,
+	certificate *types.DeletionCertificate,
+) ([]string, error) {
+	image, err := s.imageStore.GetByName(ctx, registry.ID, certificate.ImageName)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, ErrVersionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find artifact: %w", err)
+	}
+	art, err := s.artifactStore.GetByName(ctx, image.ID, certificate.Version)
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, ErrVersionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version: %w", err)
+	}
+
+	var purged []string
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := pkg.CheckLegalHold(ctx, s.legalHoldStore, registry.ID, certificate.ImageName,
+			certificate.Version); err != nil {
+			return err
+		}
+
+		versionPath := VersionPath(registry.PackageType, certificate.ImageName, certificate.Version)
+		nodes, err := s.nodesStore.ListByPath(ctx, registry.ID, versionPath)
+		if err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+		var blobIDs []string
+		seen := map[string]bool{}
+		for _, node := range nodes {
+			if !node.IsFile {
+				continue
+			}
+			certificate.DeletedItems = append(certificate.DeletedItems, node.NodePath)
+			if node.BlobID != "" && !seen[node.BlobID] {
+				seen[node.BlobID] = true
+				blobIDs = append(blobIDs, node.BlobID)
+			}
+		}
+		if _, err = s.nodesStore.DeleteByPath(ctx, registry.ID, versionPath); err != nil {
+			return fmt.Errorf("failed to delete files: %w", err)
+		}
+
+		for _, blobID := range blobIDs {
+			blob, err := s.genericBlobStore.FindByID(ctx, blobID)
+			if err != nil {
+				return fmt.Errorf("failed to find blob %s: %w", blobID, err)
+			}
+			references, err := s.nodesStore.CountByBlobID(ctx, blobID)
+			if err != nil {
+				return fmt.Errorf("failed to count files of blob %s: %w", blobID, err)
+			}
+			disposition := types.BlobDispositionShared
+			if references == 0 {
+				if err = s.genericBlobStore.DeleteByID(ctx, blobID); err != nil {
+					return fmt.Errorf("failed to delete blob %s: %w", blobID, err)
+				}
+				disposition = types.BlobDispositionPurged
+				purged = append(purged, blob.Sha256)
+			}
+			certificate.Blobs = append(certificate.Blobs, types.DeletedBlob{
+				Digest:      "sha256:" + blob.Sha256,
+				Size:        blob.Size,
+				Disposition: disposition,
+			})
+		}
+
+		if err = s.deleteArtifact(ctx, art.ID, certificate); err != nil {
+			return err
+		}
+		return s.createCertificate(ctx, certificate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
+
+// purgeManifest deletes the manifest a tag or digest refers to, along with all of its tags.
+func (s *Service) purgeManifest(
+	ctx context.Context,
+	registry *types.Registry,
+	certificate *types.DeletionCertificate,
+) error {
+	m, err := s.findManifest(ctx, registry.ID, certificate.ImageName, certificate.Version)
+	if err != nil {
+		return err
+	}
+	layers, err := s.manifestStore.LayerBlobs(ctx, m)
+	if err != nil {
+		return fmt.Errorf("failed to list layers of manifest %s: %w", m.Digest, err)
+	}
+	var children []int64
+	if m.MediaType == manifestlist.MediaTypeManifestList || m.MediaType == v1.MediaTypeImageIndex {
+		references, err := s.manifestStore.References(ctx, m)
+		if err != nil {
+			return fmt.Errorf("failed to list references of manifest %s: %w", m.Digest, err)
+		}
+		for _, reference := range references {
+			children = append(children, reference.ID)
+		}
+	}
+
+	return s.tx.WithTx(ctx, func(ctx context.Context) error {
+		tags, err := s.tagStore.GetTagNamesByManifestIDs(ctx, registry.ID, []int64{m.ID})
+		if err != nil {
+			return fmt.Errorf("failed to get tags of manifest %s: %w", m.Digest, err)
+		}
+		versions := append([]string{m.Digest.String()}, tags[m.ID]...)
+		if err = pkg.CheckLegalHold(ctx, s.legalHoldStore, registry.ID, certificate.ImageName,
+			versions...); err != nil {
+			return err
+		}
+
+		// lock the GC reviews of the manifests of an index, as the deletes through the registry API do.
+		if len(children) > 0 {
+			lockCtx, cancel := context.WithTimeout(ctx, manifestGCLockTimeout)
+			defer cancel()
+			if _, err = s.gcService.ManifestFindAndLockNBefore(lockCtx, registry.ID, children,
+				time.Now().Add(manifestGCReviewWindow)); err != nil {
+				return err
+			}
+		}
+
+		certificate.DeletedItems = append(certificate.DeletedItems, certificate.ImageName+"@"+m.Digest.String())
+		for _, tag := range tags[m.ID] {
+			certificate.DeletedItems = append(certificate.DeletedItems, certificate.ImageName+":"+tag)
+		}
+		if _, err = s.tagStore.DeleteTagByManifestID(ctx, registry.ID, m.ID); err != nil {
+			return fmt.Errorf("failed to delete tags of manifest %s: %w", m.Digest, err)
+		}
+		found, err := s.manifestStore.DeleteManifest(ctx, registry.ID, certificate.ImageName, m.Digest)
+		if err != nil {
+			return fmt.Errorf("failed to delete manifest %s: %w", m.Digest, err)
+		}
+		if !found {
+			return ErrVersionNotFound
+		}
+		for _, layer := range layers {
+			certificate.Blobs = append(certificate.Blobs, types.DeletedBlob{
+				Digest:      layer.Digest.String(),
+				Size:        layer.Size,
+				Disposition: types.BlobDispositionQueuedForGC,
+			})
+		}
+
+		image, err := s.imageStore.GetByName(ctx, registry.ID, certificate.ImageName)
+		if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+			return fmt.Errorf("failed to find artifact: %w", err)
+		}
+		if image != nil {
+			art, err := s.artifactStore.GetByName(ctx, image.ID, m.Digest.String())
+			if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+				return fmt.Errorf("failed to find version: %w", err)
+			}
+			if art != nil {
+				if err = s.deleteArtifact(ctx, art.ID, certificate); err != nil {
+					return err
+				}
+			}
+		}
+		return s.createCertificate(ctx, certificate)
+	})
+}
+
+func (s *Service) findManifest(
+	ctx context.Context,
+	registryID int64,
+	imageName string,
+	reference string,
+) (*types.Manifest, error) {
+	var m *types.Manifest
+	d, err := digest.Parse(reference)
+	if err != nil {
+		m, err = s.manifestStore.FindManifestByTagName(ctx, registryID, imageName, reference)
+	} else {
+		var dgst types.Digest
+		if dgst, err = types.NewDigest(d); err != nil {
+			return nil, err
+		}
+		m, err = s.manifestStore.FindManifestByDigest(ctx, registryID, imageName, dgst)
+	}
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return nil, ErrVersionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find manifest: %w", err)
+	}
+	return m, nil
+}
+
+// deleteArtifact deletes an artifact version along with the records of its downloads.
+func (s *Service) deleteArtifact(ctx context.Context, artifactID int64, certificate *types.DeletionCertificate) error {
+	downloads, err := s.downloadStatStore.DeleteByArtifactID(ctx, artifactID)
+	if err != nil {
+		return fmt.Errorf("failed to delete downloads: %w", err)
+	}
+	certificate.DownloadRecords += downloads
+	if err = s.artifactStore.DeleteByID(ctx, artifactID); err != nil {
+		return fmt.Errorf("failed to delete version: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) createCertificate(ctx context.Context, certificate *types.DeletionCertificate) error {
+	certificate.DeletedAt = time.Now()
+	checksum, err := Checksum(certificate)
+	if err != nil {
+		return err
+	}
+	certificate.Checksum = checksum
+	if err = s.certificateStore.Create(ctx, certificate); err != nil {
+		return fmt.Errorf("failed to store deletion certificate: %w", err)
+	}
+	return nil
+}
+
+// deleteGenericBlobs deletes the purged generic blobs from the storage. The blobs are already gone from the
+// database, failures are only logged, the consistency check reports the files left behind.
+func (s *Service) deleteGenericBlobs(ctx context.Context, registry *types.Registry, sha256s []string) {
+	root := registry.StoragePrefix
+	if root == "" {
+		space, err := s.spaceFinder.FindByID(ctx, registry.RootParentID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to find root space of registry %s, "+
+				"purged blobs are left in the storage", registry.Name)
+			return
+		}
+		root = space.Identifier
+	}
+	for _, sha := range sha256s {
+		blobPath := path.Join("/", root, "files", sha)
+		if err := s.driver.Delete(ctx, blobPath); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to delete purged blob %s", blobPath)
+		}
+	}
+}
+
+// VersionPath returns the path of the folder the files of a version are stored under. The groupId of Maven
+// artifacts is a path of its own, e.g. "/org/example/app/1.0" for version 1.0 of "org.example:app".
+func VersionPath(packageType artifact.PackageType, imageName string, version string) string {
+	name := imageName
+	if packageType == artifact.PackageTypeMAVEN {
+		name = strings.ReplaceAll(strings.ReplaceAll(name, ".", "/"), ":", "/")
+	}
+	return "/" + name + "/" + version
+}
+
+// Checksum returns the SHA-256 of the contents of a certificate. The deletion time is included in
+// milliseconds, the precision it's stored with.
+func Checksum(certificate *types.DeletionCertificate) (string, error) {
+	content, err := json.Marshal(struct {
+		RegistryID      int64               `json:"registry_id"`
+		RegistryName    string              `json:"registry_name"`
+		PackageType     string              `json:"package_type"`
+		ImageName       string              `json:"image_name"`
+		Version         string              `json:"version"`
+		Reason          string              `json:"reason"`
+		DeletedItems    []string            `json:"deleted_items"`
+		Blobs           []types.DeletedBlob `json:"blobs"`
+		DownloadRecords int64               `json:"download_records"`
+		DeletedAt       int64               `json:"deleted_at"`
+		DeletedBy       int64               `json:"deleted_by"`
+	}{
+		RegistryID:      certificate.RegistryID,
+		RegistryName:    certificate.RegistryName,
+		PackageType:     certificate.PackageType,
+		ImageName:       certificate.ImageName,
+		Version:         certificate.Version,
+		Reason:          certificate.Reason,
+		DeletedItems:    certificate.DeletedItems,
+		Blobs:           certificate.Blobs,
+		DownloadRecords: certificate.DownloadRecords,
+		DeletedAt:       certificate.DeletedAt.UnixMilli(),
+		DeletedBy:       certificate.DeletedBy,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal deletion certificate: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func isOCI(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM
+}
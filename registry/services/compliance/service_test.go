@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionPath(t *testing.T) {
+	assert.Equal(t, "/app/1.0.0", VersionPath(artifact.PackageTypeGENERIC, "app", "1.0.0"))
+	assert.Equal(t, "/org/example/lib/2.1", VersionPath(artifact.PackageTypeMAVEN, "org.example:lib", "2.1"))
+}
+
+func TestChecksum(t *testing.T) {
+	certificate := &types.DeletionCertificate{
+		RegistryID:   1,
+		RegistryName: "releases",
+		PackageType:  string(artifact.PackageTypeGENERIC),
+		ImageName:    "app",
+		Version:      "1.0.0",
+		Reason:       "erasure request 42",
+		DeletedItems: []string{"/app/1.0.0/app.tar.gz"},
+		Blobs: []types.DeletedBlob{
+			{Digest: "sha256:abc", Size: 10, Disposition: types.BlobDispositionPurged},
+		},
+		DownloadRecords: 3,
+		DeletedAt:       time.UnixMilli(1700000000123).Add(456 * time.Microsecond),
+		DeletedBy:       7,
+	}
+
+	checksum, err := Checksum(certificate)
+	require.NoError(t, err)
+
+	stored := *certificate
+	stored.DeletedAt = time.UnixMilli(certificate.DeletedAt.UnixMilli())
+	again, err := Checksum(&stored)
+	require.NoError(t, err)
+	assert.Equal(t, checksum, again, "the checksum must survive storing the deletion time in milliseconds")
+
+	tampered := stored
+	tampered.DeletedItems = []string{"/app/1.0.0/other.tar.gz"}
+	changed, err := Checksum(&tampered)
+	require.NoError(t, err)
+	assert.NotEqual(t, checksum, changed)
+}
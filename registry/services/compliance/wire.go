@@ -0,0 +1,50 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"github.com/harness/gitness/app/services/refcache"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/gc"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	tx dbtx.Transactor,
+	imageStore store.ImageRepository,
+	artifactStore store.ArtifactRepository,
+	nodesStore store.NodesRepository,
+	genericBlobStore store.GenericBlobRepository,
+	manifestStore store.ManifestRepository,
+	tagStore store.TagRepository,
+	downloadStatStore store.DownloadStatRepository,
+	legalHoldStore store.LegalHoldRepository,
+	certificateStore store.DeletionCertificateRepository,
+	gcService gc.Service,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+) *Service {
+	return NewService(
+		tx, imageStore, artifactStore, nodesStore, genericBlobStore, manifestStore, tagStore, downloadStatStore,
+		legalHoldStore, certificateStore, gcService, spaceFinder, driver,
+	)
+}
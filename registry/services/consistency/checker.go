@@ -0,0 +1,325 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	tableBlobs        = "blobs"
+	tableGenericBlobs = "generic_blobs"
+
+	batchSize = 500
+)
+
+type checker struct {
+	autoFix          bool
+	gracePeriod      time.Duration
+	safetyWindow     time.Duration
+	registryDao      store.RegistryRepository
+	blobStore        store.BlobRepository
+	genericBlobStore store.GenericBlobRepository
+	manifestStore    store.ManifestRepository
+	spaceFinder      refcache.SpaceFinder
+	driver           storagedriver.StorageDriver
+	locker           *lease.Locker
+
+	startedAt time.Time
+	// rootIdentifiers caches the identifiers of the root spaces the blobs are stored under.
+	rootIdentifiers map[int64]string
+	// storagePrefixes caches the custom storage prefixes used by the registries of the root spaces.
+	storagePrefixes map[int64][]string
+	// activities caches what was pushed to the root spaces within the safety window.
+	activities map[int64]*rootActivity
+}
+
+// Handle cross-checks the blob metadata against the storage and returns the reconciliation report as JSON.
+// The check is skipped while another replica runs a maintenance job, like a cleanup policy deleting tags,
+// as the blobs it unreferences would be reported and fixed halfway.
+func (c *checker) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	unlock, err := c.locker.TryLockJob(ctx, lease.JobMaintenance, jobMaxDuration)
+	if errors.Is(err, lease.ErrHeld) {
+		log.Ctx(ctx).Info().Msg("skipping registry consistency check, a registry maintenance job is running")
+		return "skipped, a registry maintenance job is running", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	c.rootIdentifiers = map[int64]string{}
+	c.storagePrefixes = map[int64][]string{}
+	c.activities = map[int64]*rootActivity{}
+	report := newReport(c.autoFix, time.Now())
+	c.startedAt = report.StartedAt
+
+	if err := c.checkBlobs(ctx, report); err != nil {
+		return "", err
+	}
+	if err := c.checkGenericBlobs(ctx, report); err != nil {
+		return "", err
+	}
+	if err := c.checkOrphans(ctx, report); err != nil {
+		return "", err
+	}
+	report.FinishedAt = time.Now()
+
+	log.Ctx(ctx).Info().Msgf("registry consistency check: %s", report.Summary())
+
+	result, err := json.Marshal(report)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal consistency report: %w", err)
+	}
+	return string(result), nil
+}
+
+func (c *checker) checkBlobs(ctx context.Context, report *Report) error {
+	var afterID int64
+	for {
+		blobs, err := c.blobStore.List(ctx, afterID, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list blobs: %w", err)
+		}
+		for _, blob := range blobs {
+			afterID = blob.ID
+			report.BlobsChecked++
+
+			root, err := c.rootIdentifier(ctx, blob.RootParentID)
+			if err != nil {
+				return err
+			}
+			prefixes, err := c.rootStoragePrefixes(ctx, blob.RootParentID)
+			if err != nil {
+				return err
+			}
+			blobPaths := make([]string, 0, len(prefixes)+1)
+			for _, prefix := range append([]string{strings.ToLower(root)}, prefixes...) {
+				blobPath, err := storage.PathFn(prefix, blob.Digest)
+				if err != nil {
+					return fmt.Errorf("failed to get path of blob %d: %w", blob.ID, err)
+				}
+				blobPaths = append(blobPaths, blobPath)
+			}
+			issue, err := c.checkStoredCopies(ctx, blobPaths, blob.Size)
+			if err != nil {
+				return err
+			}
+			if issue != nil {
+				issue.Table = tableBlobs
+				issue.ID = fmt.Sprint(blob.ID)
+				issue.RootParentID = blob.RootParentID
+				issue.Digest = blob.Digest.String()
+				report.add(*issue)
+			}
+		}
+		if len(blobs) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (c *checker) checkGenericBlobs(ctx context.Context, report *Report) error {
+	afterID := ""
+	for {
+		blobs, err := c.genericBlobStore.List(ctx, afterID, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list generic blobs: %w", err)
+		}
+		for _, blob := range blobs {
+			afterID = blob.ID
+			report.GenericBlobsChecked++
+
+			root, err := c.rootIdentifier(ctx, blob.RootParentID)
+			if err != nil {
+				return err
+			}
+			prefixes, err := c.rootStoragePrefixes(ctx, blob.RootParentID)
+			if err != nil {
+				return err
+			}
+			blobPaths := []string{genericBlobPath(root, blob.Sha256)}
+			for _, prefix := range prefixes {
+				blobPaths = append(blobPaths, genericBlobPath(prefix, blob.Sha256))
+			}
+			issue, err := c.checkStoredCopies(ctx, blobPaths, blob.Size)
+			if err != nil {
+				return err
+			}
+			if issue != nil {
+				issue.Table = tableGenericBlobs
+				issue.ID = blob.ID
+				issue.RootParentID = blob.RootParentID
+				issue.Digest = "sha256:" + blob.Sha256
+				report.add(*issue)
+			}
+		}
+		if len(blobs) < batchSize {
+			return nil
+		}
+	}
+}
+
+// checkOrphans reports the blob rows that are no longer referenced, and deletes them if auto fix is enabled.
+// Only the rows are deleted, the stored data is left to the garbage collection. Rows that in-flight pushes
+// may still reference are kept, see skipReason.
+func (c *checker) checkOrphans(ctx context.Context, report *Report) error {
+	createdBefore := report.StartedAt.Add(-c.gracePeriod)
+
+	var afterBlobID int64
+	for {
+		blobs, err := c.blobStore.ListUnreferenced(ctx, createdBefore, afterBlobID, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list unreferenced blobs: %w", err)
+		}
+		for _, blob := range blobs {
+			afterBlobID = blob.ID
+			issue := Issue{
+				Kind:         IssueOrphanBlob,
+				Table:        tableBlobs,
+				ID:           fmt.Sprint(blob.ID),
+				RootParentID: blob.RootParentID,
+				Digest:       blob.Digest.String(),
+				Size:         blob.Size,
+			}
+			if c.autoFix {
+				if issue.Skipped, err = c.skipReason(ctx, blob.RootParentID, issue.Digest); err != nil {
+					return err
+				}
+			}
+			if c.autoFix && issue.Skipped == "" {
+				if err = c.blobStore.DeleteByID(ctx, blob.ID); err != nil {
+					return fmt.Errorf("failed to delete unreferenced blob %d: %w", blob.ID, err)
+				}
+				issue.Fixed = true
+			}
+			report.add(issue)
+		}
+		if len(blobs) < batchSize {
+			break
+		}
+	}
+
+	afterGenericBlobID := ""
+	for {
+		blobs, err := c.genericBlobStore.ListUnreferenced(ctx, createdBefore, afterGenericBlobID, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list unreferenced generic blobs: %w", err)
+		}
+		for _, blob := range blobs {
+			afterGenericBlobID = blob.ID
+			issue := Issue{
+				Kind:         IssueOrphanBlob,
+				Table:        tableGenericBlobs,
+				ID:           blob.ID,
+				RootParentID: blob.RootParentID,
+				Digest:       "sha256:" + blob.Sha256,
+				Size:         blob.Size,
+			}
+			if c.autoFix {
+				if issue.Skipped, err = c.skipReason(ctx, blob.RootParentID, issue.Digest); err != nil {
+					return err
+				}
+			}
+			if c.autoFix && issue.Skipped == "" {
+				if err = c.genericBlobStore.DeleteByID(ctx, blob.ID); err != nil {
+					return fmt.Errorf("failed to delete unreferenced generic blob %s: %w", blob.ID, err)
+				}
+				issue.Fixed = true
+			}
+			report.add(issue)
+		}
+		if len(blobs) < batchSize {
+			return nil
+		}
+	}
+}
+
+// checkStoredData compares the data stored at the path against the size recorded in the metadata.
+// It returns nil if the data exists and matches.
+func (c *checker) checkStoredData(ctx context.Context, dataPath string, size int64) (*Issue, error) {
+	info, err := c.driver.Stat(ctx, dataPath)
+	if errors.As(err, &storagedriver.PathNotFoundError{}) {
+		return &Issue{Kind: IssueMissingBlob, Path: dataPath, Size: size}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", dataPath, err)
+	}
+	if info.Size() != size {
+		return &Issue{Kind: IssueSizeMismatch, Path: dataPath, Size: size, StoredSize: info.Size()}, nil
+	}
+	return nil, nil
+}
+
+// checkStoredCopies checks the locations a blob can be stored at, the blob is fine if any of them matches.
+// Registries with a custom storage prefix store their own copy of the blobs, so a blob of the root space
+// doesn't have to exist in all of them.
+func (c *checker) checkStoredCopies(ctx context.Context, dataPaths []string, size int64) (*Issue, error) {
+	var result *Issue
+	for _, dataPath := range dataPaths {
+		issue, err := c.checkStoredData(ctx, dataPath, size)
+		if err != nil || issue == nil {
+			return nil, err
+		}
+		if result == nil || (result.Kind == IssueMissingBlob && issue.Kind != IssueMissingBlob) {
+			result = issue
+		}
+	}
+	return result, nil
+}
+
+func (c *checker) rootStoragePrefixes(ctx context.Context, rootParentID int64) ([]string, error) {
+	if prefixes, ok := c.storagePrefixes[rootParentID]; ok {
+		return prefixes, nil
+	}
+	prefixes, err := c.registryDao.ListStoragePrefixes(ctx, rootParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storage prefixes of root space %d: %w", rootParentID, err)
+	}
+	c.storagePrefixes[rootParentID] = prefixes
+	return prefixes, nil
+}
+
+func (c *checker) rootIdentifier(ctx context.Context, rootParentID int64) (string, error) {
+	if identifier, ok := c.rootIdentifiers[rootParentID]; ok {
+		return identifier, nil
+	}
+	space, err := c.spaceFinder.FindByID(ctx, rootParentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find root space %d: %w", rootParentID, err)
+	}
+	c.rootIdentifiers[rootParentID] = space.Identifier
+	return space.Identifier, nil
+}
+
+// genericBlobPath returns the storage path of a generic blob, matching the path used by the file manager.
+func genericBlobPath(rootIdentifier string, sha256 string) string {
+	return path.Join("/", rootIdentifier, "files", sha256)
+}
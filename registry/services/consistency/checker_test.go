@@ -0,0 +1,181 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckStoredData(t *testing.T) {
+	ctx := context.Background()
+	driver := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+	dataPath := genericBlobPath("root", "abc")
+	require.NoError(t, driver.PutContent(ctx, dataPath, []byte("content")))
+
+	c := &checker{driver: driver}
+
+	issue, err := c.checkStoredData(ctx, dataPath, 7)
+	require.NoError(t, err)
+	assert.Nil(t, issue)
+
+	issue, err = c.checkStoredData(ctx, dataPath, 10)
+	require.NoError(t, err)
+	require.NotNil(t, issue)
+	assert.Equal(t, IssueSizeMismatch, issue.Kind)
+	assert.Equal(t, int64(7), issue.StoredSize)
+
+	issue, err = c.checkStoredData(ctx, genericBlobPath("root", "missing"), 7)
+	require.NoError(t, err)
+	require.NotNil(t, issue)
+	assert.Equal(t, IssueMissingBlob, issue.Kind)
+}
+
+func TestCheckStoredCopies(t *testing.T) {
+	ctx := context.Background()
+	driver := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+	require.NoError(t, driver.PutContent(ctx, genericBlobPath("teams/payments", "abc"), []byte("content")))
+
+	c := &checker{driver: driver}
+
+	issue, err := c.checkStoredCopies(ctx, []string{
+		genericBlobPath("root", "abc"),
+		genericBlobPath("teams/payments", "abc"),
+	}, 7)
+	require.NoError(t, err)
+	assert.Nil(t, issue)
+
+	issue, err = c.checkStoredCopies(ctx, []string{
+		genericBlobPath("root", "abc"),
+		genericBlobPath("teams/payments", "abc"),
+	}, 10)
+	require.NoError(t, err)
+	require.NotNil(t, issue)
+	assert.Equal(t, IssueSizeMismatch, issue.Kind)
+}
+
+func TestReport(t *testing.T) {
+	report := newReport(true, time.Now())
+	assert.Equal(t, "checked 0 blobs and 0 generic blobs, no issues found", report.Summary())
+
+	for i := 0; i < maxReportedIssues+1; i++ {
+		report.add(Issue{Kind: IssueOrphanBlob, Fixed: true})
+	}
+	report.add(Issue{Kind: IssueMissingBlob})
+	report.BlobsChecked = 3
+
+	assert.Len(t, report.Issues, maxReportedIssues)
+	assert.True(t, report.Truncated)
+	assert.Equal(t, maxReportedIssues+1, report.Fixed)
+	assert.Equal(t, "checked 3 blobs and 0 generic blobs, found 1 missing_blob, 1001 orphan_blob, fixed 1001",
+		report.Summary())
+	assert.False(t, IssueMissingBlob.Fixable())
+	assert.True(t, IssueOrphanBlob.Fixable())
+}
+
+func TestHasRecentUploads(t *testing.T) {
+	ctx := context.Background()
+	driver := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+	dirs := uploadDirs("Root", []string{"teams/payments"})
+	assert.Equal(t, []string{
+		"/root/docker/_uploads", "/Root/tmp", "/teams/payments/docker/_uploads", "/teams/payments/tmp",
+	}, dirs)
+
+	c := &checker{driver: driver}
+
+	uploading, err := c.hasRecentUploads(ctx, dirs, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.False(t, uploading)
+
+	require.NoError(t, driver.PutContent(ctx, "/teams/payments/docker/_uploads/app/1234/data", []byte("chunk")))
+
+	uploading, err = c.hasRecentUploads(ctx, dirs, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.True(t, uploading)
+
+	uploading, err = c.hasRecentUploads(ctx, dirs, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, uploading)
+}
+
+func TestReferencedDigests(t *testing.T) {
+	digests := referencedDigests(types.Manifests{
+		{Payload: types.Payload(`{
+			"schemaVersion": 2,
+			"config": {"digest": "sha256:config"},
+			"layers": [{"digest": "sha256:signature"}],
+			"subject": {"digest": "sha256:image"}
+		}`)},
+		{Payload: types.Payload(`{"blobs": [{"digest": "sha256:sbom"}], "subject": {"digest": "sha256:image"}}`)},
+		{Payload: types.Payload(`not json`)},
+	})
+	assert.Equal(t, map[string]bool{
+		"sha256:config":    true,
+		"sha256:signature": true,
+		"sha256:image":     true,
+		"sha256:sbom":      true,
+	}, digests)
+}
+
+func TestReportSkipped(t *testing.T) {
+	report := newReport(true, time.Now())
+	report.add(Issue{Kind: IssueOrphanBlob, Fixed: true})
+	report.add(Issue{Kind: IssueOrphanBlob, Skipped: SkipRecentUpload})
+	report.add(Issue{Kind: IssueOrphanBlob, Skipped: SkipRecentUpload})
+	report.add(Issue{Kind: IssueOrphanBlob, Skipped: SkipRecentReferrer})
+
+	assert.Equal(t, map[SkipReason]int{SkipRecentUpload: 2, SkipRecentReferrer: 1}, report.Skipped)
+	assert.Equal(t, "checked 0 blobs and 0 generic blobs, found 4 orphan_blob, fixed 1, "+
+		"skipped 2 recent_upload, 1 recent_referrer within the safety window", report.Summary())
+}
+
+func TestSkipReasonMetric(t *testing.T) {
+	ctx := context.Background()
+	c := &checker{
+		safetyWindow: time.Hour,
+		activities: map[int64]*rootActivity{
+			1: {uploading: true},
+			2: {referenced: map[string]bool{"sha256:signature": true}},
+		},
+	}
+	uploads := testutil.ToFloat64(skippedOrphans.WithLabelValues(string(SkipRecentUpload)))
+	referrers := testutil.ToFloat64(skippedOrphans.WithLabelValues(string(SkipRecentReferrer)))
+
+	for _, tc := range []struct {
+		rootParentID int64
+		digest       string
+		reason       SkipReason
+	}{
+		{1, "sha256:layer", SkipRecentUpload},
+		{2, "sha256:signature", SkipRecentReferrer},
+		{2, "sha256:layer", ""},
+	} {
+		reason, err := c.skipReason(ctx, tc.rootParentID, tc.digest)
+		require.NoError(t, err)
+		assert.Equal(t, tc.reason, reason)
+	}
+
+	assert.InDelta(t, uploads+1, testutil.ToFloat64(skippedOrphans.WithLabelValues(string(SkipRecentUpload))), 0)
+	assert.InDelta(t, referrers+1,
+		testutil.ToFloat64(skippedOrphans.WithLabelValues(string(SkipRecentReferrer))), 0)
+}
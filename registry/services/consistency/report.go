@@ -0,0 +1,124 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxReportedIssues caps the issues listed in a report, all issues are still counted.
+const maxReportedIssues = 1000
+
+type IssueKind string
+
+const (
+	// IssueMissingBlob is a blob row without data in the storage.
+	IssueMissingBlob IssueKind = "missing_blob"
+	// IssueSizeMismatch is a blob row whose size differs from the size of the stored data.
+	IssueSizeMismatch IssueKind = "size_mismatch"
+	// IssueOrphanBlob is a blob row that isn't referenced by any registry, manifest or file.
+	IssueOrphanBlob IssueKind = "orphan_blob"
+)
+
+// Fixable reports whether issues of the kind can be fixed without losing data that is in use.
+// Orphan rows aren't referenced by anything and are deleted, missing data and size mismatches
+// are left for an operator as the blob contents can't be recovered from the metadata.
+func (k IssueKind) Fixable() bool {
+	return k == IssueOrphanBlob
+}
+
+type Issue struct {
+	Kind         IssueKind `json:"kind"`
+	Table        string    `json:"table"`
+	ID           string    `json:"id"`
+	RootParentID int64     `json:"root_parent_id"`
+	Digest       string    `json:"digest"`
+	Path         string    `json:"path,omitempty"`
+	Size         int64     `json:"size"`
+	StoredSize   int64     `json:"stored_size,omitempty"`
+	Fixed        bool      `json:"fixed,omitempty"`
+	// Skipped is why the issue wasn't fixed although auto fix is enabled, if it's fixable.
+	Skipped SkipReason `json:"skipped,omitempty"`
+}
+
+// Report is the reconciliation report produced by a consistency check.
+type Report struct {
+	StartedAt           time.Time         `json:"started_at"`
+	FinishedAt          time.Time         `json:"finished_at"`
+	AutoFix             bool              `json:"auto_fix"`
+	BlobsChecked        int64             `json:"blobs_checked"`
+	GenericBlobsChecked int64             `json:"generic_blobs_checked"`
+	Counts              map[IssueKind]int `json:"counts"`
+	Fixed               int               `json:"fixed"`
+	Issues              []Issue           `json:"issues"`
+	Truncated           bool              `json:"truncated,omitempty"`
+	// Skipped counts the fixable issues left unfixed as they may be part of in-flight pushes.
+	Skipped map[SkipReason]int `json:"skipped"`
+}
+
+func newReport(autoFix bool, now time.Time) *Report {
+	return &Report{
+		StartedAt: now,
+		AutoFix:   autoFix,
+		Counts:    map[IssueKind]int{},
+		Skipped:   map[SkipReason]int{},
+		Issues:    []Issue{},
+	}
+}
+
+func (r *Report) add(issue Issue) {
+	r.Counts[issue.Kind]++
+	if issue.Fixed {
+		r.Fixed++
+	}
+	if issue.Skipped != "" {
+		r.Skipped[issue.Skipped]++
+	}
+	if len(r.Issues) >= maxReportedIssues {
+		r.Truncated = true
+		return
+	}
+	r.Issues = append(r.Issues, issue)
+}
+
+// Summary returns a single line describing the outcome of the check.
+func (r *Report) Summary() string {
+	if len(r.Counts) == 0 {
+		return fmt.Sprintf("checked %d blobs and %d generic blobs, no issues found",
+			r.BlobsChecked, r.GenericBlobsChecked)
+	}
+
+	counts := make([]string, 0, len(r.Counts))
+	for _, kind := range []IssueKind{IssueMissingBlob, IssueSizeMismatch, IssueOrphanBlob} {
+		if n := r.Counts[kind]; n > 0 {
+			counts = append(counts, fmt.Sprintf("%d %s", n, kind))
+		}
+	}
+	summary := fmt.Sprintf("checked %d blobs and %d generic blobs, found %s, fixed %d",
+		r.BlobsChecked, r.GenericBlobsChecked, strings.Join(counts, ", "), r.Fixed)
+
+	skipped := make([]string, 0, len(r.Skipped))
+	for _, reason := range []SkipReason{SkipRecentUpload, SkipRecentReferrer} {
+		if n := r.Skipped[reason]; n > 0 {
+			skipped = append(skipped, fmt.Sprintf("%d %s", n, reason))
+		}
+	}
+	if len(skipped) > 0 {
+		summary += ", skipped " + strings.Join(skipped, ", ") + " within the safety window"
+	}
+	return summary
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SkipReason is why an orphan blob wasn't deleted although auto fix is enabled.
+type SkipReason string
+
+const (
+	// SkipRecentUpload is an orphan blob of a root space with an upload in progress, which may
+	// complete as the blob and reuse its row.
+	SkipRecentUpload SkipReason = "recent_upload"
+	// SkipRecentReferrer is an orphan blob referenced by a referrer manifest pushed within the
+	// safety window, whose references may not be linked yet.
+	SkipRecentReferrer SkipReason = "recent_referrer"
+)
+
+// skippedOrphans counts the orphan blobs kept within the safety window, by skip reason, so
+// operators can tell how often in-flight pushes hold back the auto fix.
+var skippedOrphans = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "registry",
+	Subsystem: "consistency",
+	Name:      "skipped_orphan_blobs_total",
+	Help:      "Orphan blobs not deleted by the consistency check as they may belong to an in-flight push.",
+}, []string{"reason"})
+
+func init() {
+	prometheus.MustRegister(skippedOrphans)
+}
+
+// rootActivity is what was pushed to a root space within the safety window.
+type rootActivity struct {
+	uploading bool
+	// referenced are the digests of the blobs referenced by recent referrer manifests.
+	referenced map[string]bool
+}
+
+// skipReason returns why the orphan blob must be kept, or "" if it's safe to delete.
+func (c *checker) skipReason(ctx context.Context, rootParentID int64, digest string) (SkipReason, error) {
+	if c.safetyWindow <= 0 {
+		return "", nil
+	}
+	activity, err := c.rootActivity(ctx, rootParentID)
+	if err != nil {
+		return "", err
+	}
+	var reason SkipReason
+	switch {
+	case activity.uploading:
+		reason = SkipRecentUpload
+	case activity.referenced[digest]:
+		reason = SkipRecentReferrer
+	default:
+		return "", nil
+	}
+	skippedOrphans.WithLabelValues(string(reason)).Inc()
+	return reason, nil
+}
+
+func (c *checker) rootActivity(ctx context.Context, rootParentID int64) (*rootActivity, error) {
+	if activity, ok := c.activities[rootParentID]; ok {
+		return activity, nil
+	}
+	root, err := c.rootIdentifier(ctx, rootParentID)
+	if err != nil {
+		return nil, err
+	}
+	prefixes, err := c.rootStoragePrefixes(ctx, rootParentID)
+	if err != nil {
+		return nil, err
+	}
+	since := c.startedAt.Add(-c.safetyWindow)
+
+	activity := &rootActivity{}
+	activity.uploading, err = c.hasRecentUploads(ctx, uploadDirs(root, prefixes), since)
+	if err != nil {
+		return nil, err
+	}
+	referrers, err := c.manifestStore.ListReferrersCreatedAfter(ctx, rootParentID, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list referrers of root space %d: %w", rootParentID, err)
+	}
+	activity.referenced = referencedDigests(referrers)
+	c.activities[rootParentID] = activity
+	return activity, nil
+}
+
+// hasRecentUploads reports whether a file of an upload in the directories was written since the
+// given time, which is when uploads in progress last received a chunk.
+func (c *checker) hasRecentUploads(ctx context.Context, dirs []string, since time.Time) (bool, error) {
+	found := false
+	for _, dir := range dirs {
+		err := c.driver.Walk(ctx, dir, func(fileInfo storagedriver.FileInfo) error {
+			if !fileInfo.IsDir() && fileInfo.ModTime().After(since) {
+				found = true
+				return storagedriver.ErrFilledBuffer
+			}
+			return nil
+		})
+		if found {
+			return true, nil
+		}
+		if err != nil && !errors.As(err, &storagedriver.PathNotFoundError{}) {
+			return false, fmt.Errorf("failed to list uploads in %s: %w", dir, err)
+		}
+	}
+	return false, nil
+}
+
+// uploadDirs returns the directories the uploads in progress of a root space are stored in: the
+// OCI upload sessions, and the temporary files of the file manager uploads.
+func uploadDirs(rootIdentifier string, prefixes []string) []string {
+	dirs := []string{
+		path.Join("/", strings.ToLower(rootIdentifier), "docker", "_uploads"),
+		path.Join("/", rootIdentifier, "tmp"),
+	}
+	for _, prefix := range prefixes {
+		dirs = append(dirs, path.Join("/", prefix, "docker", "_uploads"), path.Join("/", prefix, "tmp"))
+	}
+	return dirs
+}
+
+// manifestPayload holds the descriptors of the blobs and manifests an image manifest, an image
+// index or an artifact manifest references.
+type manifestPayload struct {
+	Config    *descriptor  `json:"config"`
+	Layers    []descriptor `json:"layers"`
+	Blobs     []descriptor `json:"blobs"`
+	Manifests []descriptor `json:"manifests"`
+	Subject   *descriptor  `json:"subject"`
+}
+
+type descriptor struct {
+	Digest string `json:"digest"`
+}
+
+// referencedDigests returns the digests of the blobs and manifests referenced by the payloads of
+// the manifests.
+func referencedDigests(manifests types.Manifests) map[string]bool {
+	digests := map[string]bool{}
+	for _, m := range manifests {
+		var payload manifestPayload
+		if err := json.Unmarshal(m.Payload, &payload); err != nil {
+			continue
+		}
+		descriptors := make([]descriptor, 0, len(payload.Layers)+len(payload.Blobs)+len(payload.Manifests)+2)
+		descriptors = append(descriptors, payload.Layers...)
+		descriptors = append(descriptors, payload.Blobs...)
+		descriptors = append(descriptors, payload.Manifests...)
+		if payload.Config != nil {
+			descriptors = append(descriptors, *payload.Config)
+		}
+		if payload.Subject != nil {
+			descriptors = append(descriptors, *payload.Subject)
+		}
+		for _, d := range descriptors {
+			if d.Digest != "" {
+				digests[d.Digest] = true
+			}
+		}
+	}
+	return digests
+}
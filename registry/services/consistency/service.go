@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+)
+
+const (
+	jobType        = "gitness:registry:consistency-check"
+	jobMaxDuration = 2 * time.Hour
+)
+
+type Config struct {
+	Enabled bool
+	Cron    string
+	// AutoFix enables fixing the issues that are safe to fix automatically, see Issue.Fixable.
+	AutoFix bool
+	// GracePeriod is the minimum age of a blob before it is reported as unreferenced,
+	// so blobs of uploads that are still in progress aren't reported.
+	GracePeriod time.Duration
+	// SafetyWindow is how recent the uploads and the referrer manifests of a root space have to be
+	// for its unreferenced blobs to be kept by auto fix, as in-flight pushes may still reference them.
+	SafetyWindow time.Duration
+}
+
+// Service is responsible for checking the registry metadata against the stored blobs.
+type Service struct {
+	config           Config
+	scheduler        *job.Scheduler
+	executor         *job.Executor
+	registryDao      store.RegistryRepository
+	blobStore        store.BlobRepository
+	genericBlobStore store.GenericBlobRepository
+	manifestStore    store.ManifestRepository
+	spaceFinder      refcache.SpaceFinder
+	driver           storagedriver.StorageDriver
+	locker           *lease.Locker
+}
+
+func NewService(
+	config Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	registryDao store.RegistryRepository,
+	blobStore store.BlobRepository,
+	genericBlobStore store.GenericBlobRepository,
+	manifestStore store.ManifestRepository,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+	locker *lease.Locker,
+) *Service {
+	return &Service{
+		config:           config,
+		scheduler:        scheduler,
+		executor:         executor,
+		registryDao:      registryDao,
+		blobStore:        blobStore,
+		genericBlobStore: genericBlobStore,
+		manifestStore:    manifestStore,
+		spaceFinder:      spaceFinder,
+		driver:           driver,
+		locker:           locker,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	if err := s.executor.Register(jobType, s.newChecker()); err != nil {
+		return fmt.Errorf("failed to register job handler for registry consistency check: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.config.Cron, jobMaxDuration); err != nil {
+		return fmt.Errorf("failed to schedule registry consistency check job: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) newChecker() *checker {
+	return &checker{
+		autoFix:          s.config.AutoFix,
+		gracePeriod:      s.config.GracePeriod,
+		safetyWindow:     s.config.SafetyWindow,
+		registryDao:      s.registryDao,
+		blobStore:        s.blobStore,
+		genericBlobStore: s.genericBlobStore,
+		manifestStore:    s.manifestStore,
+		spaceFinder:      s.spaceFinder,
+		driver:           s.driver,
+		locker:           s.locker,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consistency
+
+import (
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/pkg/lease"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	registryDao store.RegistryRepository,
+	blobStore store.BlobRepository,
+	genericBlobStore store.GenericBlobRepository,
+	manifestStore store.ManifestRepository,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+	locker *lease.Locker,
+) *Service {
+	return NewService(
+		Config{
+			Enabled:      config.Registry.ConsistencyCheck.Enabled,
+			Cron:         config.Registry.ConsistencyCheck.Cron,
+			AutoFix:      config.Registry.ConsistencyCheck.AutoFix,
+			GracePeriod:  config.Registry.ConsistencyCheck.GracePeriod,
+			SafetyWindow: config.Registry.ConsistencyCheck.SafetyWindow,
+		},
+		scheduler,
+		executor,
+		registryDao,
+		blobStore,
+		genericBlobStore,
+		manifestStore,
+		spaceFinder,
+		driver,
+		locker,
+	)
+}
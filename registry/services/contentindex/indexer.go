@@ -0,0 +1,283 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contentindex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/archive"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/rs/zerolog/log"
+)
+
+const batchSize = 100
+
+type indexer struct {
+	config       Config
+	archiveStore store.ArchiveRepository
+	registryDao  store.RegistryRepository
+	spaceFinder  refcache.SpaceFinder
+	driver       storagedriver.StorageDriver
+
+	// registries caches the registries of the archives of a run.
+	registries map[int64]*registryLocation
+}
+
+// registryLocation is a registry along with the storage roots of its blobs.
+type registryLocation struct {
+	*types.Registry
+	oci     string
+	generic string
+}
+
+// Result summarizes a content index run.
+type Result struct {
+	Indexed int `json:"indexed"`
+	Shared  int `json:"shared"`
+	Failed  int `json:"failed"`
+}
+
+// Handle indexes the archives that were pushed since the last run. Archives that can't be read are
+// recorded with the error, so they aren't retried by every run.
+func (x *indexer) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	x.registries = map[int64]*registryLocation{}
+	result := &Result{}
+
+	for {
+		files, err := x.archiveStore.ListPendingFiles(ctx, archive.Extensions(), batchSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to list files to index: %w", err)
+		}
+		layers, err := x.archiveStore.ListPendingLayers(ctx, batchSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to list layers to index: %w", err)
+		}
+		for _, pending := range append(files, layers...) {
+			if err = x.index(ctx, pending, result); err != nil {
+				return "", err
+			}
+		}
+		if len(files) < batchSize && len(layers) < batchSize {
+			break
+		}
+	}
+
+	if result.Indexed+result.Shared+result.Failed > 0 {
+		log.Ctx(ctx).Info().Msgf("registry content index: indexed %d archives, shared %d, failed %d",
+			result.Indexed, result.Shared, result.Failed)
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal content index result: %w", err)
+	}
+	return string(output), nil
+}
+
+func (x *indexer) index(ctx context.Context, pending *types.PendingArchive, result *Result) error {
+	registry, err := x.registry(ctx, pending.RegistryID)
+	if err != nil {
+		return err
+	}
+
+	a := &types.Archive{
+		RegistryID: pending.RegistryID,
+		NodeID:     pending.NodeID,
+		LayerID:    pending.LayerID,
+		Digest:     pending.Digest,
+		ImageName:  pending.ImageName,
+		Version:    pending.Version,
+		Name:       pending.Name,
+	}
+	var blobPath string
+	if pending.LayerID != 0 {
+		a.Format = string(archive.FormatForMediaType(pending.MediaType))
+		dgst, err := digest.Parse(pending.Digest)
+		if err != nil {
+			return fmt.Errorf("invalid digest of layer %d: %w", pending.LayerID, err)
+		}
+		if blobPath, err = storage.PathFn(registry.oci, dgst); err != nil {
+			return fmt.Errorf("failed to get path of layer %d: %w", pending.LayerID, err)
+		}
+	} else {
+		a.Format = string(archive.FormatForName(pending.Name))
+		a.ImageName, a.Version = imageAndVersion(registry.PackageType, pending.Path)
+		blobPath = path.Join("/", registry.generic, "files", strings.TrimPrefix(pending.Digest, "sha256:"))
+	}
+
+	source, err := x.archiveStore.FindByDigest(ctx, a.Digest)
+	switch {
+	case err == nil:
+		a.Format = source.Format
+		a.EntryCount = source.EntryCount
+		if err = x.archiveStore.CreateFrom(ctx, a, source.ID); err != nil {
+			return fmt.Errorf("failed to record archive %s: %w", a.Name, err)
+		}
+		result.Shared++
+		return nil
+	case !errors.Is(err, gitnessstore.ErrResourceNotFound):
+		return fmt.Errorf("failed to find archive %s: %w", a.Digest, err)
+	}
+
+	entries, err := x.list(ctx, blobPath, archive.Format(a.Format), pending.Size)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msgf("registry content index: failed to list archive %s of %s",
+			a.Name, a.ImageName)
+		a.Error = err.Error()
+		result.Failed++
+	} else {
+		result.Indexed++
+	}
+	a.EntryCount = int64(len(entries))
+	if err = x.archiveStore.Create(ctx, a, entries, classesOf(archive.Format(a.Format), entries)); err != nil {
+		return fmt.Errorf("failed to record archive %s: %w", a.Name, err)
+	}
+	return nil
+}
+
+// list reads the entries of the archive at the storage path. Partial listings are returned
+// along with the error.
+func (x *indexer) list(
+	ctx context.Context,
+	blobPath string,
+	format archive.Format,
+	size int64,
+) ([]types.ArchiveEntry, error) {
+	var entries []archive.Entry
+	var err error
+	switch {
+	case format.IsTar():
+		entries, err = x.listTar(ctx, blobPath, format)
+	case format == archive.FormatZip:
+		entries, err = x.listZip(ctx, blobPath, size)
+	default:
+		err = fmt.Errorf("unsupported archive format %q", format)
+	}
+
+	out := make([]types.ArchiveEntry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, types.ArchiveEntry{Path: e.Path, Size: e.Size})
+	}
+	return out, err
+}
+
+// classesOf returns the Java classes compiled into a zip based archive, like a jar or a war.
+func classesOf(format archive.Format, entries []types.ArchiveEntry) []types.ArchiveClass {
+	if format != archive.FormatZip {
+		return nil
+	}
+	var classes []types.ArchiveClass
+	for _, e := range entries {
+		if name, ok := archive.JavaClassName(e.Path); ok {
+			classes = append(classes, types.ArchiveClass{Name: name, SimpleName: archive.SimpleClassName(name)})
+		}
+	}
+	return classes
+}
+
+func (x *indexer) listTar(ctx context.Context, blobPath string, format archive.Format) ([]archive.Entry, error) {
+	reader, err := x.driver.Reader(ctx, blobPath, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", blobPath, err)
+	}
+	defer reader.Close()
+	return archive.ListTar(reader, format, x.config.MaxEntries)
+}
+
+// listZip buffers the archive to a temporary file, as the zip index is at the end of the archive.
+func (x *indexer) listZip(ctx context.Context, blobPath string, size int64) ([]archive.Entry, error) {
+	if size > x.config.MaxArchiveSize {
+		return nil, fmt.Errorf("archive size %d exceeds the limit of %d", size, x.config.MaxArchiveSize)
+	}
+	reader, err := x.driver.Reader(ctx, blobPath, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", blobPath, err)
+	}
+	defer reader.Close()
+
+	tmp, err := os.CreateTemp("", "registry-content-index-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+	written, err := io.Copy(tmp, io.LimitReader(reader, x.config.MaxArchiveSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", blobPath, err)
+	}
+	if written > x.config.MaxArchiveSize {
+		return nil, fmt.Errorf("archive size exceeds the limit of %d", x.config.MaxArchiveSize)
+	}
+	return archive.ListZip(tmp, written, x.config.MaxEntries)
+}
+
+func (x *indexer) registry(ctx context.Context, registryID int64) (*registryLocation, error) {
+	if registry, ok := x.registries[registryID]; ok {
+		return registry, nil
+	}
+	registry, err := x.registryDao.Get(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find registry %d: %w", registryID, err)
+	}
+	root, err := x.spaceFinder.FindByID(ctx, registry.RootParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find root space %d: %w", registry.RootParentID, err)
+	}
+	location := &registryLocation{
+		Registry: registry,
+		oci:      strings.ToLower(root.Identifier),
+		generic:  root.Identifier,
+	}
+	if registry.StoragePrefix != "" {
+		location.oci = registry.StoragePrefix
+		location.generic = registry.StoragePrefix
+	}
+	x.registries[registryID] = location
+	return location, nil
+}
+
+// imageAndVersion derives the artifact name and version from the path of a file node, which the
+// package types lay out as <artifact>/<version>/<file>. Maven artifacts are named by group and
+// artifact id, with the group id laid out as directories.
+func imageAndVersion(packageType artifact.PackageType, filePath string) (string, string) {
+	parts := strings.Split(strings.Trim(path.Dir(filePath), "/"), "/")
+	if len(parts) < 2 {
+		return strings.Join(parts, "/"), ""
+	}
+	version := parts[len(parts)-1]
+	parts = parts[:len(parts)-1]
+	if packageType == artifact.PackageTypeMAVEN && len(parts) > 1 {
+		return strings.Join(parts[:len(parts)-1], ".") + ":" + parts[len(parts)-1], version
+	}
+	return strings.Join(parts, "/"), version
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contentindex
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/archive"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageAndVersion(t *testing.T) {
+	image, version := imageAndVersion(artifact.PackageTypeGENERIC, "/tools/1.0/tools.zip")
+	assert.Equal(t, "tools", image)
+	assert.Equal(t, "1.0", version)
+
+	image, version = imageAndVersion(artifact.PackageTypeNPM, "/@acme/left-pad/1.0.0/left-pad-1.0.0.tgz")
+	assert.Equal(t, "@acme/left-pad", image)
+	assert.Equal(t, "1.0.0", version)
+
+	image, version = imageAndVersion(artifact.PackageTypeMAVEN, "/org/acme/app/2.1/app-2.1.war")
+	assert.Equal(t, "org.acme:app", image)
+	assert.Equal(t, "2.1", version)
+}
+
+func TestListZip(t *testing.T) {
+	ctx := context.Background()
+	driver := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	_, err := zw.Create("WEB-INF/lib/log4j-core-2.14.1.jar")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, driver.PutContent(ctx, "/root/files/abc", buf.Bytes()))
+
+	x := &indexer{driver: driver, config: Config{MaxArchiveSize: 1 << 20, MaxEntries: 10}}
+	entries, err := x.list(ctx, "/root/files/abc", archive.FormatZip, int64(buf.Len()))
+	require.NoError(t, err)
+	assert.Equal(t, []types.ArchiveEntry{{Path: "WEB-INF/lib/log4j-core-2.14.1.jar"}}, entries)
+
+	x.config.MaxArchiveSize = 10
+	_, err = x.list(ctx, "/root/files/abc", archive.FormatZip, int64(buf.Len()))
+	assert.Error(t, err)
+}
+
+func TestClassesOf(t *testing.T) {
+	entries := []types.ArchiveEntry{
+		{Path: "META-INF/MANIFEST.MF"},
+		{Path: "module-info.class"},
+		{Path: "org/apache/logging/log4j/core/Logger.class"},
+		{Path: "org/apache/logging/log4j/core/Logger$PrivateConfig.class"},
+	}
+	assert.Equal(t, []types.ArchiveClass{
+		{Name: "org.apache.logging.log4j.core.Logger", SimpleName: "logger"},
+		{Name: "org.apache.logging.log4j.core.Logger$PrivateConfig", SimpleName: "privateconfig"},
+	}, classesOf(archive.FormatZip, entries))
+	assert.Empty(t, classesOf(archive.FormatTarGzip, entries))
+}
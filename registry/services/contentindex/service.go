@@ -0,0 +1,195 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contentindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/archive"
+)
+
+const (
+	jobType        = "gitness:registry:content-index"
+	jobMaxDuration = time.Hour
+)
+
+var (
+	// ErrInvalidQuery is returned for an empty content search.
+	ErrInvalidQuery = errors.New("search query is required")
+	// ErrInvalidClassName is returned for a class search with a name that isn't a Java class name.
+	ErrInvalidClassName = errors.New("invalid class name")
+)
+
+var classNamePattern = regexp.MustCompile(`^[\p{L}\p{N}_$]+([.$][\p{L}\p{N}_$]+)*$`)
+
+type Config struct {
+	Enabled bool
+	Cron    string
+	// MaxArchiveSize is the size above which zip based archives aren't indexed.
+	MaxArchiveSize int64
+	// MaxEntries is the number of entries indexed per archive, the listing is truncated beyond it.
+	MaxEntries int
+}
+
+// Service indexes the file listings of the archives and image layers of the registries in the
+// background, so the registries can be searched for the artifacts containing a file.
+type Service struct {
+	config       Config
+	scheduler    *job.Scheduler
+	executor     *job.Executor
+	archiveStore store.ArchiveRepository
+	registryDao  store.RegistryRepository
+	spaceFinder  refcache.SpaceFinder
+	driver       storagedriver.StorageDriver
+}
+
+func NewService(
+	config Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	archiveStore store.ArchiveRepository,
+	registryDao store.RegistryRepository,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+) *Service {
+	return &Service{
+		config:       config,
+		scheduler:    scheduler,
+		executor:     executor,
+		archiveStore: archiveStore,
+		registryDao:  registryDao,
+		spaceFinder:  spaceFinder,
+		driver:       driver,
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	if err := s.executor.Register(jobType, s.newIndexer()); err != nil {
+		return fmt.Errorf("failed to register job handler for registry content index: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.config.Cron, jobMaxDuration); err != nil {
+		return fmt.Errorf("failed to schedule registry content index job: %w", err)
+	}
+	return nil
+}
+
+// QueueStats returns the number of archives of the registry waiting to be indexed.
+func (s *Service) QueueStats(ctx context.Context, registryID int64) (*types.QueueStats, error) {
+	if !s.config.Enabled {
+		return &types.QueueStats{}, nil
+	}
+	stats, err := s.archiveStore.PendingStats(ctx, registryID, archive.Extensions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count archives waiting to be indexed: %w", err)
+	}
+	return stats, nil
+}
+
+// Search finds the files inside the indexed archives of the registry whose path contains the query.
+// The archives of the upstream proxies of a virtual registry are searched as well.
+func (s *Service) Search(
+	ctx context.Context,
+	registry *types.Registry,
+	query string,
+	limit int,
+	offset int,
+) ([]*types.ArchiveEntryMatch, int64, error) {
+	if query == "" {
+		return nil, 0, ErrInvalidQuery
+	}
+	registryIDs := append([]int64{registry.ID}, registry.UpstreamProxies...)
+	matches, err := s.archiveStore.Search(ctx, registryIDs, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search archive contents: %w", err)
+	}
+	count, err := s.archiveStore.CountSearch(ctx, registryIDs, query)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count archive contents: %w", err)
+	}
+	return matches, count, nil
+}
+
+// SearchClasses finds the Java classes inside the indexed archives of the registry and its upstream
+// proxies by their simple name, like "Logger", or their partially or fully qualified name, like
+// "org.apache.logging.log4j.core.Logger".
+func (s *Service) SearchClasses(
+	ctx context.Context,
+	registry *types.Registry,
+	className string,
+	limit int,
+	offset int,
+) ([]*types.ArchiveClassMatch, int64, error) {
+	if !classNamePattern.MatchString(className) {
+		return nil, 0, fmt.Errorf("%w: %q", ErrInvalidClassName, className)
+	}
+	registryIDs := append([]int64{registry.ID}, registry.UpstreamProxies...)
+	matches, err := s.archiveStore.SearchClasses(ctx, registryIDs, className, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search archive classes: %w", err)
+	}
+	count, err := s.archiveStore.CountSearchClasses(ctx, registryIDs, className)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count archive classes: %w", err)
+	}
+	return matches, count, nil
+}
+
+// SearchLayers finds the files inside the image layers of the registry and its upstream proxies at
+// an absolute path, like "/usr/bin/curl", or with a file name, like "libssl.so.3".
+func (s *Service) SearchLayers(
+	ctx context.Context,
+	registry *types.Registry,
+	query string,
+	limit int,
+	offset int,
+) ([]*types.ArchiveEntryMatch, int64, error) {
+	if query == "" || query == "/" {
+		return nil, 0, ErrInvalidQuery
+	}
+	registryIDs := append([]int64{registry.ID}, registry.UpstreamProxies...)
+	matches, err := s.archiveStore.SearchLayers(ctx, registryIDs, query, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search image layers: %w", err)
+	}
+	count, err := s.archiveStore.CountSearchLayers(ctx, registryIDs, query)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count image layer files: %w", err)
+	}
+	return matches, count, nil
+}
+
+func (s *Service) newIndexer() *indexer {
+	return &indexer{
+		config:       s.config,
+		archiveStore: s.archiveStore,
+		registryDao:  s.registryDao,
+		spaceFinder:  s.spaceFinder,
+		driver:       s.driver,
+	}
+}
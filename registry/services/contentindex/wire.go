@@ -0,0 +1,54 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package contentindex
+
+import (
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	archiveStore store.ArchiveRepository,
+	registryDao store.RegistryRepository,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+) *Service {
+	return NewService(
+		Config{
+			Enabled:        config.Registry.ContentIndex.Enabled,
+			Cron:           config.Registry.ContentIndex.Cron,
+			MaxArchiveSize: config.Registry.ContentIndex.MaxArchiveSize,
+			MaxEntries:     config.Registry.ContentIndex.MaxEntries,
+		},
+		scheduler,
+		executor,
+		archiveStore,
+		registryDao,
+		spaceFinder,
+		driver,
+	)
+}
@@ -0,0 +1,199 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downloadstats
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// journalEntry is a download as it's logged to the journal, one JSON object per line.
+type journalEntry struct {
+	ArtifactID   int64  `json:"artifact_id"`
+	Client       string `json:"client"`
+	Continuation bool   `json:"continuation,omitempty"`
+	ClientType   string `json:"client_type,omitempty"`
+	Network      string `json:"network,omitempty"`
+	Region       string `json:"region,omitempty"`
+	Timestamp    int64  `json:"timestamp"`
+	PrincipalID  int64  `json:"principal_id,omitempty"`
+}
+
+// segment is a batch of downloads, journaled to path until they're stored.
+type segment struct {
+	path          string
+	downloadStats []*types.DownloadStat
+}
+
+// journal appends the buffered downloads to the file at path, which is renamed to path.<seq> when its
+// downloads are flushed. Writes aren't synced, so the journal survives crashes of the process but
+// not of the host.
+type journal struct {
+	path    string
+	nextSeq int
+	file    *os.File
+}
+
+// openJournal opens the journal at path, returning the downloads of its segments that weren't stored.
+// The file at path is left by an instance that crashed, it becomes the last segment.
+func openJournal(path string) (*journal, []*segment, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, nil, fmt.Errorf("failed to create directory of %s: %w", path, err)
+	}
+
+	seqs, err := segmentSeqs(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	j := &journal{path: path, nextSeq: 1}
+	if len(seqs) > 0 {
+		j.nextSeq = seqs[len(seqs)-1] + 1
+	}
+	if _, err = os.Stat(path); err == nil {
+		if _, err = j.rotate(); err != nil {
+			return nil, nil, err
+		}
+		seqs = append(seqs, j.nextSeq-1)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	segments := make([]*segment, 0, len(seqs))
+	for _, seq := range seqs {
+		seg := &segment{path: j.segmentPath(seq)}
+		if seg.downloadStats, err = readSegment(seg.path); err != nil {
+			return nil, nil, err
+		}
+		segments = append(segments, seg)
+	}
+	return j, segments, nil
+}
+
+func (j *journal) append(downloadStat *types.DownloadStat) error {
+	if j.file == nil {
+		file, err := os.OpenFile(j.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return err
+		}
+		j.file = file
+	}
+
+	line, err := json.Marshal(journalEntry{
+		ArtifactID:   downloadStat.ArtifactID,
+		Client:       downloadStat.Client,
+		Continuation: downloadStat.Continuation,
+		ClientType:   downloadStat.ClientType,
+		Network:      downloadStat.Network,
+		Region:       downloadStat.Region,
+		Timestamp:    downloadStat.Timestamp.UnixMilli(),
+		PrincipalID:  downloadStat.CreatedBy,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = j.file.Write(append(line, '\n'))
+	return err
+}
+
+// rotate renames the journal file to the next segment, returning its path. Downloads appended
+// afterwards go to a new file.
+func (j *journal) rotate() (string, error) {
+	if j.file != nil {
+		if err := j.file.Close(); err != nil {
+			return "", err
+		}
+		j.file = nil
+	}
+
+	path := j.segmentPath(j.nextSeq)
+	if err := os.Rename(j.path, path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// the downloads couldn't be journaled, they're only stored from memory.
+			return "", nil
+		}
+		return "", err
+	}
+	j.nextSeq++
+	return path, nil
+}
+
+func (j *journal) segmentPath(seq int) string {
+	return fmt.Sprintf("%s.%d", j.path, seq)
+}
+
+// segmentSeqs returns the sequence numbers of the segments of the journal at path, in order.
+func segmentSeqs(path string) ([]int, error) {
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list download stats journal segments: %w", err)
+	}
+	seqs := make([]int, 0, len(matches))
+	for _, match := range matches {
+		seq, err := strconv.Atoi(strings.TrimPrefix(match, path+"."))
+		if err != nil || seq <= 0 {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	sort.Ints(seqs)
+	return seqs, nil
+}
+
+// readSegment reads the downloads of a segment. Lines that can't be parsed, like the last line of a file
+// whose write was cut short by a crash, are skipped.
+func readSegment(path string) ([]*types.DownloadStat, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open download stats journal segment %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var downloadStats []*types.DownloadStat
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry journalEntry
+		if err = json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			log.Warn().Err(err).Msgf("skipping invalid line of download stats journal segment %s", path)
+			continue
+		}
+		downloadStats = append(downloadStats, &types.DownloadStat{
+			ArtifactID:   entry.ArtifactID,
+			Client:       entry.Client,
+			Continuation: entry.Continuation,
+			ClientType:   entry.ClientType,
+			Network:      entry.Network,
+			Region:       entry.Region,
+			Timestamp:    time.UnixMilli(entry.Timestamp),
+			CreatedBy:    entry.PrincipalID,
+			UpdatedBy:    entry.PrincipalID,
+		})
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read download stats journal segment %s: %w", path, err)
+	}
+	return downloadStats, nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package downloadstats records the downloads of the registry artifacts. Downloads are buffered in memory
+// and stored in batches, so busy registries don't write a row per request, and they're appended to a
+// journal until they're stored, so the downloads of an instance that crashed are replayed on its restart.
+package downloadstats
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/rs/zerolog/log"
+)
+
+// finalFlushTimeout bounds the flush of the buffered downloads when the recorder stops.
+const finalFlushTimeout = 10 * time.Second
+
+type Config struct {
+	// FlushInterval is how often the buffered downloads are stored, every download is stored by its
+	// request if it's zero.
+	FlushInterval time.Duration
+	// FlushSize is the number of buffered downloads that are stored without waiting for the interval.
+	FlushSize int
+	// JournalPath is the file the buffered downloads are appended to, they aren't journaled if it's empty.
+	JournalPath string
+}
+
+// Recorder records the downloads of the artifacts.
+type Recorder struct {
+	config            Config
+	tx                dbtx.Transactor
+	downloadStatStore store.DownloadStatRepository
+
+	// mu guards the buffered downloads and the journal they're appended to.
+	mu      sync.Mutex
+	pending []*types.DownloadStat
+	journal *journal
+	full    chan struct{}
+
+	// flushMu serializes the flushes, which store the segments in order. A segment stays unflushed,
+	// and its journal file on disk, until its downloads are stored.
+	flushMu   sync.Mutex
+	unflushed []*segment
+}
+
+func NewRecorder(
+	config Config,
+	tx dbtx.Transactor,
+	downloadStatStore store.DownloadStatRepository,
+) (*Recorder, error) {
+	r := &Recorder{
+		config:            config,
+		tx:                tx,
+		downloadStatStore: downloadStatStore,
+		full:              make(chan struct{}, 1),
+	}
+	if config.FlushInterval <= 0 || config.JournalPath == "" {
+		return r, nil
+	}
+
+	// the downloads journaled before a crash or a failed flush are replayed on the first flush; new
+	// downloads are journaled to a new file.
+	j, unflushed, err := openJournal(config.JournalPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open download stats journal: %w", err)
+	}
+	r.journal, r.unflushed = j, unflushed
+	return r, nil
+}
+
+// Record records a download. It's stored with the next flush if downloads are batched.
+func (r *Recorder) Record(ctx context.Context, downloadStat *types.DownloadStat) error {
+	if r.config.FlushInterval <= 0 {
+		return r.downloadStatStore.Create(ctx, downloadStat)
+	}
+
+	downloadStat.Timestamp = time.Now()
+	if session, ok := request.AuthSessionFrom(ctx); ok {
+		downloadStat.CreatedBy = session.Principal.ID
+		downloadStat.UpdatedBy = session.Principal.ID
+	}
+
+	r.mu.Lock()
+	var err error
+	if r.journal != nil {
+		err = r.journal.append(downloadStat)
+	}
+	r.pending = append(r.pending, downloadStat)
+	full := len(r.pending) >= r.config.FlushSize
+	r.mu.Unlock()
+
+	if full {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+	if err != nil {
+		// the download is still stored with the next flush, it's only lost if the instance crashes before.
+		return fmt.Errorf("failed to journal download: %w", err)
+	}
+	return nil
+}
+
+// Register stores the downloads replayed from the journal and starts flushing the buffered downloads
+// until ctx is done, when they're flushed a last time.
+func (r *Recorder) Register(ctx context.Context) error {
+	if r.config.FlushInterval <= 0 {
+		return nil
+	}
+
+	if err := r.Flush(ctx); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to store replayed download stats, retrying with the next flush")
+	}
+
+	go func() {
+		ticker := time.NewTicker(r.config.FlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finalFlushTimeout)
+				if err := r.Flush(flushCtx); err != nil {
+					log.Ctx(ctx).Warn().Err(err).Msg("failed to store download stats on shutdown")
+				}
+				cancel()
+				return
+			case <-ticker.C:
+			case <-r.full:
+			}
+			if err := r.Flush(ctx); err != nil {
+				log.Ctx(ctx).Warn().Err(err).Msg("failed to store download stats, retrying with the next flush")
+			}
+		}
+	}()
+	return nil
+}
+
+// Flush stores the buffered downloads, and the downloads of earlier flushes that failed, in the order
+// they were recorded.
+func (r *Recorder) Flush(ctx context.Context) error {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	if err := r.rotate(); err != nil {
+		return err
+	}
+	for len(r.unflushed) > 0 {
+		seg := r.unflushed[0]
+		if len(seg.downloadStats) > 0 {
+			err := r.tx.WithTx(ctx, func(ctx context.Context) error {
+				return r.downloadStatStore.CreateBatch(ctx, seg.downloadStats)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to store %d download stats: %w", len(seg.downloadStats), err)
+			}
+		}
+		// a crash before the segment is removed replays it, counting its downloads twice.
+		if seg.path != "" {
+			if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+				log.Ctx(ctx).Warn().Err(err).Msgf("failed to remove download stats journal segment %s", seg.path)
+			}
+		}
+		r.unflushed = r.unflushed[1:]
+	}
+	return nil
+}
+
+// rotate moves the buffered downloads, and the journal file they're appended to, to a new segment.
+func (r *Recorder) rotate() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.pending) == 0 {
+		return nil
+	}
+
+	seg := &segment{downloadStats: r.pending}
+	if r.journal != nil {
+		path, err := r.journal.rotate()
+		if err != nil {
+			return fmt.Errorf("failed to rotate download stats journal: %w", err)
+		}
+		seg.path = path
+	}
+	r.unflushed = append(r.unflushed, seg)
+	r.pending = nil
+	return nil
+}
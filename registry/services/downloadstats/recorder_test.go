@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downloadstats
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithTx(ctx context.Context, txFn func(ctx context.Context) error, _ ...interface{}) error {
+	return txFn(ctx)
+}
+
+type fakeDownloadStatRepository struct {
+	store.DownloadStatRepository
+	created []*types.DownloadStat
+	batches int
+	err     error
+}
+
+func (f *fakeDownloadStatRepository) Create(_ context.Context, downloadStat *types.DownloadStat) error {
+	f.created = append(f.created, downloadStat)
+	return nil
+}
+
+func (f *fakeDownloadStatRepository) CreateBatch(_ context.Context, downloadStats []*types.DownloadStat) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.batches++
+	f.created = append(f.created, downloadStats...)
+	return nil
+}
+
+func TestRecorderBatchesDownloads(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeDownloadStatRepository{}
+	journalPath := filepath.Join(t.TempDir(), "download-stats.log")
+	r, err := NewRecorder(Config{FlushInterval: time.Hour, FlushSize: 100, JournalPath: journalPath},
+		fakeTransactor{}, repo)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Record(ctx, &types.DownloadStat{ArtifactID: 1, Client: "ip:10.0.0.1"}))
+	require.NoError(t, r.Record(ctx, &types.DownloadStat{ArtifactID: 2, Client: "ip:10.0.0.2"}))
+	assert.Empty(t, repo.created, "downloads are stored by the flush")
+	assert.FileExists(t, journalPath)
+
+	repo.err = errors.New("database unavailable")
+	require.Error(t, r.Flush(ctx))
+	require.NoError(t, r.Record(ctx, &types.DownloadStat{ArtifactID: 3, Client: "ip:10.0.0.3"}))
+
+	repo.err = nil
+	require.NoError(t, r.Flush(ctx))
+	assert.Equal(t, 2, repo.batches)
+	require.Len(t, repo.created, 3)
+	for i, downloadStat := range repo.created {
+		assert.Equal(t, int64(i+1), downloadStat.ArtifactID, "downloads are stored in the order they're recorded")
+	}
+
+	matches, err := filepath.Glob(journalPath + "*")
+	require.NoError(t, err)
+	assert.Empty(t, matches, "stored segments are removed from the journal")
+}
+
+func TestRecorderReplaysJournal(t *testing.T) {
+	ctx := context.Background()
+	journalPath := filepath.Join(t.TempDir(), "download-stats.log")
+	crashed, err := NewRecorder(Config{FlushInterval: time.Hour, FlushSize: 100, JournalPath: journalPath},
+		fakeTransactor{}, &fakeDownloadStatRepository{})
+	require.NoError(t, err)
+	require.NoError(t, crashed.Record(ctx, &types.DownloadStat{ArtifactID: 1, Client: "ip:10.0.0.1",
+		Continuation: true, ClientType: "docker"}))
+	require.NoError(t, crashed.Record(ctx, &types.DownloadStat{ArtifactID: 2, Client: "ip:10.0.0.2"}))
+
+	// the write of the last download was cut short by the crash.
+	file, err := os.OpenFile(journalPath, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"artifact_id":3,"cli`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	repo := &fakeDownloadStatRepository{}
+	r, err := NewRecorder(Config{FlushInterval: time.Hour, FlushSize: 100, JournalPath: journalPath},
+		fakeTransactor{}, repo)
+	require.NoError(t, err)
+	require.NoError(t, r.Record(ctx, &types.DownloadStat{ArtifactID: 4, Client: "ip:10.0.0.4"}))
+	require.NoError(t, r.Flush(ctx))
+
+	require.Len(t, repo.created, 3)
+	assert.Equal(t, int64(1), repo.created[0].ArtifactID)
+	assert.True(t, repo.created[0].Continuation)
+	assert.Equal(t, "docker", repo.created[0].ClientType)
+	assert.False(t, repo.created[0].Timestamp.IsZero())
+	assert.Equal(t, int64(2), repo.created[1].ArtifactID)
+	assert.Equal(t, int64(4), repo.created[2].ArtifactID)
+}
+
+func TestRecorderWithoutBatching(t *testing.T) {
+	repo := &fakeDownloadStatRepository{}
+	r, err := NewRecorder(Config{}, fakeTransactor{}, repo)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Record(context.Background(), &types.DownloadStat{ArtifactID: 1}))
+	assert.Len(t, repo.created, 1)
+	assert.Zero(t, repo.batches)
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downloadstats
+
+import (
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideRecorder,
+)
+
+func ProvideRecorder(
+	config *types.Config,
+	tx dbtx.Transactor,
+	downloadStatStore store.DownloadStatRepository,
+) (*Recorder, error) {
+	return NewRecorder(
+		Config{
+			FlushInterval: config.Registry.DownloadStats.FlushInterval,
+			FlushSize:     config.Registry.DownloadStats.FlushSize,
+			JournalPath:   config.Registry.DownloadStats.JournalPath,
+		},
+		tx,
+		downloadStatStore,
+	)
+}
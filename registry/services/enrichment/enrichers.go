@@ -0,0 +1,161 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+)
+
+const filesPageLimit = 1000
+
+// scanSummaryEnricher counts the findings of the latest scan of each tool of the files of a version.
+type scanSummaryEnricher struct {
+	scanStore   store.ScanRepository
+	fileManager filemanager.FileManager
+}
+
+func (e *scanSummaryEnricher) Name() string {
+	return "scanSummary"
+}
+
+func (e *scanSummaryEnricher) Enrich(ctx context.Context, target Target) (map[string]any, error) {
+	files, err := versionFiles(ctx, e.fileManager, target)
+	if err != nil {
+		return nil, err
+	}
+	var scanIDs []int64
+	for _, f := range files {
+		if f.Sha256 == "" {
+			continue
+		}
+		scans, err := e.scanStore.ListByDigest(ctx, target.Registry.ID, "sha256:"+f.Sha256)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list scans of %s: %w", f.Name, err)
+		}
+		// scans are the most recent first
+		tools := map[string]bool{}
+		for _, scan := range scans {
+			if !tools[scan.Tool] {
+				tools[scan.Tool] = true
+				scanIDs = append(scanIDs, scan.ID)
+			}
+		}
+	}
+	if len(scanIDs) == 0 {
+		return nil, nil
+	}
+
+	counts, err := e.scanStore.CountFindingsBySeverity(ctx, scanIDs, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count findings: %w", err)
+	}
+	findings := map[string]int64{}
+	for _, c := range counts {
+		for severity, n := range c {
+			findings[strings.ToLower(string(severity))] += n
+		}
+	}
+	return map[string]any{
+		"scans":    len(scanIDs),
+		"findings": findings,
+	}, nil
+}
+
+// provenanceEnricher reports who pushed a version and when, with the checksums of its files.
+type provenanceEnricher struct {
+	fileManager filemanager.FileManager
+}
+
+func (e *provenanceEnricher) Name() string {
+	return "provenance"
+}
+
+func (e *provenanceEnricher) Enrich(ctx context.Context, target Target) (map[string]any, error) {
+	files, err := versionFiles(ctx, e.fileManager, target)
+	if err != nil {
+		return nil, err
+	}
+	checksums := make([]map[string]string, 0, len(files))
+	for _, f := range files {
+		checksums = append(checksums, map[string]string{"name": f.Name, "sha256": f.Sha256})
+	}
+	return map[string]any{
+		"pushedAt":    target.Artifact.CreatedAt.UTC(),
+		"pushedBy":    target.Artifact.CreatedBy,
+		"checksums":   checksums,
+		"repository":  target.Registry.Name,
+		"packageType": string(target.Registry.PackageType),
+	}, nil
+}
+
+// propertiesEnricher reports the labels of the artifact as its custom properties, with the labels
+// of the form key=value split into properties.
+type propertiesEnricher struct{}
+
+func (e *propertiesEnricher) Name() string {
+	return "properties"
+}
+
+func (e *propertiesEnricher) Enrich(_ context.Context, target Target) (map[string]any, error) {
+	if len(target.Image.Labels) == 0 {
+		return nil, nil
+	}
+	properties := map[string]string{}
+	for _, label := range target.Image.Labels {
+		if key, value, ok := strings.Cut(label, "="); ok && key != "" {
+			properties[key] = value
+		}
+	}
+	return map[string]any{
+		"labels":     target.Image.Labels,
+		"properties": properties,
+	}, nil
+}
+
+func versionFiles(
+	ctx context.Context,
+	fileManager filemanager.FileManager,
+	target Target,
+) ([]types.FileNodeMetadata, error) {
+	name := target.Image.Name
+	if target.Registry.PackageType == artifact.PackageTypeMAVEN {
+		name = strings.ReplaceAll(strings.ReplaceAll(name, ".", "/"), ":", "/")
+	}
+	filePathPrefix := "/" + name + "/" + target.Artifact.Version + "%"
+
+	var files []types.FileNodeMetadata
+	for offset := 0; ; offset += filesPageLimit {
+		page, err := fileManager.GetFilesMetadata(ctx, filePathPrefix, target.Registry.ID,
+			"name", "ASC", filesPageLimit, offset, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files: %w", err)
+		}
+		if page == nil {
+			break
+		}
+		files = append(files, *page...)
+		if len(*page) < filesPageLimit {
+			break
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Target is the artifact version an enricher adds details for.
+type Target struct {
+	Registry *types.Registry
+	Image    *types.Image
+	Artifact *types.Artifact
+}
+
+// Enricher adds a section of details to the artifact detail. Enrich returns nil data if the
+// section doesn't apply to the target, the section is left out then.
+type Enricher interface {
+	Name() string
+	Enrich(ctx context.Context, target Target) (map[string]any, error)
+}
+
+type SectionStatus string
+
+const (
+	SectionStatusSuccess SectionStatus = "SUCCESS"
+	SectionStatusFailure SectionStatus = "FAILURE"
+	SectionStatusTimeout SectionStatus = "TIMEOUT"
+)
+
+// Section is the result of an enricher.
+type Section struct {
+	Name   string
+	Status SectionStatus
+	Data   map[string]any
+	Error  string
+}
+
+type Config struct {
+	// Timeout is the time each enricher has to add its section.
+	Timeout time.Duration
+}
+
+type registration struct {
+	enricher Enricher
+	// packageTypes are the package types the enricher runs for, all package types if it's empty.
+	packageTypes []artifact.PackageType
+}
+
+// Service composes the artifact detail from the sections of the registered enrichers, so a new
+// section, like the deployment status of an artifact, is added by registering an enricher.
+type Service struct {
+	config Config
+
+	mu            sync.RWMutex
+	registrations []registration
+}
+
+func NewService(config Config) *Service {
+	return &Service{config: config}
+}
+
+// Register adds an enricher for the package types, or for all package types if none is given.
+// Sections are returned in the order the enrichers were registered.
+func (s *Service) Register(enricher Enricher, packageTypes ...artifact.PackageType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.registrations = append(s.registrations, registration{enricher: enricher, packageTypes: packageTypes})
+}
+
+// Enrich runs the enrichers registered for the package type of the target in parallel. An enricher
+// failing or timing out doesn't fail the others, its section reports the error instead.
+func (s *Service) Enrich(ctx context.Context, target Target) []Section {
+	enrichers := s.enrichers(target.Registry.PackageType)
+	if len(enrichers) == 0 {
+		return nil
+	}
+
+	sections := make([]*Section, len(enrichers))
+	var wg sync.WaitGroup
+	for i, e := range enrichers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sections[i] = s.run(ctx, e, target)
+		}()
+	}
+	wg.Wait()
+
+	result := make([]Section, 0, len(sections))
+	for _, section := range sections {
+		if section != nil {
+			result = append(result, *section)
+		}
+	}
+	return result
+}
+
+func (s *Service) enrichers(packageType artifact.PackageType) []Enricher {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var enrichers []Enricher
+	for _, r := range s.registrations {
+		if len(r.packageTypes) == 0 || contains(r.packageTypes, packageType) {
+			enrichers = append(enrichers, r.enricher)
+		}
+	}
+	return enrichers
+}
+
+type result struct {
+	data map[string]any
+	err  error
+}
+
+// run waits for the enricher at most the timeout, an enricher not returning in time keeps running
+// in the background until it notices its context is done.
+func (s *Service) run(ctx context.Context, enricher Enricher, target Target) *Section {
+	if s.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.Timeout)
+		defer cancel()
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- result{err: fmt.Errorf("enricher panicked: %v", r)}
+			}
+		}()
+		data, err := enricher.Enrich(ctx, target)
+		done <- result{data: data, err: err}
+	}()
+
+	section := &Section{Name: enricher.Name()}
+	select {
+	case r := <-done:
+		switch {
+		case errors.Is(r.err, context.DeadlineExceeded):
+			section.Status = SectionStatusTimeout
+			section.Error = r.err.Error()
+		case r.err != nil:
+			section.Status = SectionStatusFailure
+			section.Error = r.err.Error()
+		case r.data == nil:
+			return nil
+		default:
+			section.Status = SectionStatusSuccess
+			section.Data = r.data
+		}
+	case <-ctx.Done():
+		section.Status = SectionStatusTimeout
+		section.Error = ctx.Err().Error()
+	}
+	if section.Status != SectionStatusSuccess {
+		log.Ctx(ctx).Warn().Str("enricher", section.Name).Str("status", string(section.Status)).
+			Msgf("failed to enrich artifact %s: %s", target.Image.Name, section.Error)
+	}
+	return section
+}
+
+func contains(packageTypes []artifact.PackageType, packageType artifact.PackageType) bool {
+	for _, t := range packageTypes {
+		if t == packageType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type enricherFunc struct {
+	name   string
+	enrich func(ctx context.Context) (map[string]any, error)
+}
+
+func (e enricherFunc) Name() string {
+	return e.name
+}
+
+func (e enricherFunc) Enrich(ctx context.Context, _ Target) (map[string]any, error) {
+	return e.enrich(ctx)
+}
+
+func TestEnrich(t *testing.T) {
+	s := NewService(Config{Timeout: 50 * time.Millisecond})
+	s.Register(enricherFunc{name: "slow", enrich: func(ctx context.Context) (map[string]any, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}})
+	s.Register(enricherFunc{name: "stuck", enrich: func(context.Context) (map[string]any, error) {
+		time.Sleep(time.Second)
+		return map[string]any{}, nil
+	}})
+	s.Register(enricherFunc{name: "failing", enrich: func(context.Context) (map[string]any, error) {
+		return nil, errors.New("unavailable")
+	}})
+	s.Register(enricherFunc{name: "panicking", enrich: func(context.Context) (map[string]any, error) {
+		panic("boom")
+	}})
+	s.Register(enricherFunc{name: "empty", enrich: func(context.Context) (map[string]any, error) {
+		return nil, nil
+	}})
+	s.Register(enricherFunc{name: "npm", enrich: func(context.Context) (map[string]any, error) {
+		return map[string]any{"ok": true}, nil
+	}}, artifact.PackageTypeNPM)
+	s.Register(enricherFunc{name: "maven", enrich: func(context.Context) (map[string]any, error) {
+		return map[string]any{"ok": true}, nil
+	}}, artifact.PackageTypeMAVEN)
+
+	start := time.Now()
+	sections := s.Enrich(context.Background(), Target{
+		Registry: &types.Registry{PackageType: artifact.PackageTypeNPM},
+		Image:    &types.Image{Name: "pkg"},
+		Artifact: &types.Artifact{Version: "1.0.0"},
+	})
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	statuses := map[string]SectionStatus{}
+	var names []string
+	for _, section := range sections {
+		names = append(names, section.Name)
+		statuses[section.Name] = section.Status
+	}
+	assert.Equal(t, []string{"slow", "stuck", "failing", "panicking", "npm"}, names)
+	assert.Equal(t, map[string]SectionStatus{
+		"slow":      SectionStatusTimeout,
+		"stuck":     SectionStatusTimeout,
+		"failing":   SectionStatusFailure,
+		"panicking": SectionStatusFailure,
+		"npm":       SectionStatusSuccess,
+	}, statuses)
+	assert.Equal(t, "unavailable", sections[2].Error)
+	assert.Equal(t, map[string]any{"ok": true}, sections[4].Data)
+}
+
+func TestPropertiesEnricher(t *testing.T) {
+	data, err := (&propertiesEnricher{}).Enrich(context.Background(), Target{
+		Image: &types.Image{Labels: []string{"team=payments", "stable"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"labels":     []string{"team=payments", "stable"},
+		"properties": map[string]string{"team": "payments"},
+	}, data)
+
+	data, err = (&propertiesEnricher{}).Enrich(context.Background(), Target{Image: &types.Image{}})
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+}
@@ -0,0 +1,41 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enrichment
+
+import (
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+// ProvideService returns the service with the enrichers built into the registry registered for
+// all package types.
+func ProvideService(
+	config *types.Config,
+	scanStore store.ScanRepository,
+	fileManager filemanager.FileManager,
+) *Service {
+	s := NewService(Config{Timeout: config.Registry.Enrichment.Timeout})
+	s.Register(&scanSummaryEnricher{scanStore: scanStore, fileManager: fileManager})
+	s.Register(&provenanceEnricher{fileManager: fileManager})
+	s.Register(&propertiesEnricher{})
+	return s
+}
@@ -0,0 +1,394 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evidence
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/pkg/filemanager"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	checksumsFile  = "CHECKSUMS.sha256"
+	signatureFile  = checksumsFile + ".sig"
+	publicKeyFile  = "public.pem"
+	filesPageLimit = 1000
+)
+
+// Service exports the evidence recorded for an artifact version: its manifest or file checksums,
+// the signatures and attestations attached to it, its scan results and its VEX documents.
+type Service struct {
+	manifestStore store.ManifestRepository
+	imageStore    store.ImageRepository
+	artifactStore store.ArtifactRepository
+	scanStore     store.ScanRepository
+	vexStore      store.VexRepository
+	fileManager   filemanager.FileManager
+	signingKey    ed25519.PrivateKey
+}
+
+func NewService(
+	manifestStore store.ManifestRepository,
+	imageStore store.ImageRepository,
+	artifactStore store.ArtifactRepository,
+	scanStore store.ScanRepository,
+	vexStore store.VexRepository,
+	fileManager filemanager.FileManager,
+	signingKey ed25519.PrivateKey,
+) *Service {
+	return &Service{
+		manifestStore: manifestStore,
+		imageStore:    imageStore,
+		artifactStore: artifactStore,
+		scanStore:     scanStore,
+		vexStore:      vexStore,
+		fileManager:   fileManager,
+		signingKey:    signingKey,
+	}
+}
+
+// Bundle is an exported evidence bundle, a gzipped tarball.
+type Bundle struct {
+	Content []byte
+	// Signed tells whether the bundle contains a signature of its checksums.
+	Signed bool
+}
+
+// Summary is the evidence.json entry of a bundle.
+type Summary struct {
+	Registry    string    `json:"registry"`
+	PackageType string    `json:"packageType"`
+	Artifact    string    `json:"artifact"`
+	Version     string    `json:"version"`
+	Digest      string    `json:"digest,omitempty"`
+	ExportedAt  time.Time `json:"exportedAt"`
+	ExportedBy  string    `json:"exportedBy,omitempty"`
+	Referrers   []string  `json:"referrers,omitempty"`
+	Scans       []int64   `json:"scans"`
+	VexDocs     []string  `json:"vexDocuments"`
+}
+
+type scanEvidence struct {
+	ID          int64             `json:"id"`
+	Digest      string            `json:"digest"`
+	Tool        string            `json:"tool"`
+	ToolVersion string            `json:"toolVersion,omitempty"`
+	StartedAt   time.Time         `json:"startedAt"`
+	FinishedAt  time.Time         `json:"finishedAt"`
+	Findings    []findingEvidence `json:"findings"`
+}
+
+type findingEvidence struct {
+	Identifier     string `json:"identifier"`
+	Severity       string `json:"severity"`
+	PackageName    string `json:"packageName,omitempty"`
+	PackageVersion string `json:"packageVersion,omitempty"`
+	FixedVersion   string `json:"fixedVersion,omitempty"`
+	Title          string `json:"title,omitempty"`
+	URL            string `json:"url,omitempty"`
+}
+
+type fileEvidence struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Sha1   string `json:"sha1,omitempty"`
+	Sha256 string `json:"sha256,omitempty"`
+	Sha512 string `json:"sha512,omitempty"`
+	MD5    string `json:"md5,omitempty"`
+}
+
+type entry struct {
+	name    string
+	content []byte
+}
+
+// Export builds the evidence bundle of a version of an artifact of the registry. For OCI registries
+// the version is a tag, for the other package types it's the artifact version.
+func (s *Service) Export(
+	ctx context.Context,
+	registry *types.Registry,
+	image string,
+	version string,
+	exportedBy string,
+) (*Bundle, error) {
+	summary := &Summary{
+		Registry:    registry.Name,
+		PackageType: string(registry.PackageType),
+		Artifact:    image,
+		Version:     version,
+		ExportedAt:  time.Now().UTC(),
+		ExportedBy:  exportedBy,
+		Scans:       []int64{},
+		VexDocs:     []string{},
+	}
+
+	var entries []entry
+	var digests []string
+	if isOCI(registry.PackageType) {
+		m, err := s.manifestStore.FindManifestByTagName(ctx, registry.ID, image, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find manifest: %w", err)
+		}
+		summary.Digest = m.Digest.String()
+		digests = append(digests, summary.Digest)
+		entries = append(entries, entry{name: "manifest.json", content: m.Payload})
+
+		referrers, err := s.referrers(ctx, registry.ID, m.Digest)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range referrers {
+			summary.Referrers = append(summary.Referrers, r.Digest.String())
+			entries = append(entries, entry{
+				name:    "referrers/" + r.Digest.Encoded() + ".json",
+				content: r.Payload,
+			})
+		}
+	} else {
+		files, err := s.files(ctx, registry, image, version)
+		if err != nil {
+			return nil, err
+		}
+		content, err := json.MarshalIndent(files, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal files: %w", err)
+		}
+		entries = append(entries, entry{name: "files.json", content: content})
+		for _, f := range files {
+			if f.Sha256 != "" {
+				digests = append(digests, "sha256:"+f.Sha256)
+			}
+		}
+	}
+
+	for _, d := range digests {
+		scans, err := s.scans(ctx, registry.ID, d)
+		if err != nil {
+			return nil, err
+		}
+		for _, scan := range scans {
+			content, err := json.MarshalIndent(scan, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal scan: %w", err)
+			}
+			summary.Scans = append(summary.Scans, scan.ID)
+			entries = append(entries, entry{name: fmt.Sprintf("scans/%d.json", scan.ID), content: content})
+		}
+
+		docs, err := s.vexStore.ListDocuments(ctx, registry.ID, d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list VEX documents: %w", err)
+		}
+		for _, doc := range docs {
+			summary.VexDocs = append(summary.VexDocs, doc.Identifier)
+			entries = append(entries, entry{name: fmt.Sprintf("vex/%d.json", doc.ID), content: doc.Content})
+		}
+	}
+
+	content, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	entries = append([]entry{{name: "evidence.json", content: content}}, entries...)
+
+	return pack(entries, s.signingKey, summary.ExportedAt)
+}
+
+func (s *Service) referrers(
+	ctx context.Context,
+	registryID int64,
+	subject digest.Digest,
+) (types.Manifests, error) {
+	d, err := types.NewDigest(subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest %q: %w", subject, err)
+	}
+	referrers, err := s.manifestStore.ListManifestsBySubjectDigest(ctx, registryID, d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list referrers: %w", err)
+	}
+	return referrers, nil
+}
+
+func (s *Service) files(
+	ctx context.Context,
+	registry *types.Registry,
+	image string,
+	version string,
+) ([]fileEvidence, error) {
+	img, err := s.imageStore.GetByName(ctx, registry.ID, image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find artifact: %w", err)
+	}
+	art, err := s.artifactStore.GetByName(ctx, img.ID, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find version: %w", err)
+	}
+
+	name := img.Name
+	if registry.PackageType == artifact.PackageTypeMAVEN {
+		name = strings.ReplaceAll(strings.ReplaceAll(name, ".", "/"), ":", "/")
+	}
+	filePathPrefix := "/" + name + "/" + art.Version + "%"
+
+	var files []fileEvidence
+	for offset := 0; ; offset += filesPageLimit {
+		page, err := s.fileManager.GetFilesMetadata(ctx, filePathPrefix, registry.ID,
+			"name", "ASC", filesPageLimit, offset, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list files: %w", err)
+		}
+		if page == nil {
+			break
+		}
+		for _, f := range *page {
+			files = append(files, fileEvidence{
+				Name: f.Name, Path: f.Path, Size: f.Size, Sha1: f.Sha1, Sha256: f.Sha256, Sha512: f.Sha512, MD5: f.MD5,
+			})
+		}
+		if len(*page) < filesPageLimit {
+			break
+		}
+	}
+	return files, nil
+}
+
+func (s *Service) scans(ctx context.Context, registryID int64, d string) ([]*scanEvidence, error) {
+	scans, err := s.scanStore.ListByDigest(ctx, registryID, d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scans: %w", err)
+	}
+	result := make([]*scanEvidence, 0, len(scans))
+	for _, scan := range scans {
+		findings, err := s.scanStore.ListFindings(ctx, scan.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list findings of scan %d: %w", scan.ID, err)
+		}
+		evidence := &scanEvidence{
+			ID:          scan.ID,
+			Digest:      scan.Digest,
+			Tool:        scan.Tool,
+			ToolVersion: scan.ToolVersion,
+			StartedAt:   scan.StartedAt.UTC(),
+			FinishedAt:  scan.FinishedAt.UTC(),
+			Findings:    make([]findingEvidence, 0, len(findings)),
+		}
+		for _, f := range findings {
+			evidence.Findings = append(evidence.Findings, findingEvidence{
+				Identifier:     f.Identifier,
+				Severity:       string(f.Severity),
+				PackageName:    f.PackageName,
+				PackageVersion: f.PackageVersion,
+				FixedVersion:   f.FixedVersion,
+				Title:          f.Title,
+				URL:            f.URL,
+			})
+		}
+		result = append(result, evidence)
+	}
+	return result, nil
+}
+
+// pack writes the entries to a gzipped tarball, followed by a checksum file listing the SHA-256 of
+// every entry and, if a key is given, the signature of the checksum file and the public key.
+func pack(entries []entry, key ed25519.PrivateKey, modTime time.Time) (*Bundle, error) {
+	var checksums strings.Builder
+	for _, e := range entries {
+		sum := sha256.Sum256(e.content)
+		fmt.Fprintf(&checksums, "%s  %s\n", hex.EncodeToString(sum[:]), e.name)
+	}
+	entries = append(entries, entry{name: checksumsFile, content: []byte(checksums.String())})
+
+	signed := key != nil
+	if signed {
+		signature := ed25519.Sign(key, []byte(checksums.String()))
+		publicKey, err := x509.MarshalPKIXPublicKey(key.Public())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal public key: %w", err)
+		}
+		entries = append(entries,
+			entry{name: signatureFile, content: []byte(base64.StdEncoding.EncodeToString(signature))},
+			entry{name: publicKeyFile, content: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})},
+		)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    e.name,
+			Mode:    0o644,
+			Size:    int64(len(e.content)),
+			ModTime: modTime,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to write header of %s: %w", e.name, err)
+		}
+		if _, err := tw.Write(e.content); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", e.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close tarball: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close gzip stream: %w", err)
+	}
+	return &Bundle{Content: buf.Bytes(), Signed: signed}, nil
+}
+
+// ParseSigningKey parses a PEM encoded PKCS #8 Ed25519 private key. An empty key returns nil.
+func ParseSigningKey(key string) (ed25519.PrivateKey, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, nil
+	}
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("evidence signing key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse evidence signing key: %w", err)
+	}
+	signingKey, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("evidence signing key must be an Ed25519 key")
+	}
+	return signingKey, nil
+}
+
+func isOCI(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM
+}
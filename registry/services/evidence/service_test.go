@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evidence
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPack_Signed(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	parsed, err := ParseSigningKey(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	require.NoError(t, err)
+
+	bundle, err := pack([]entry{{name: "evidence.json", content: []byte("{}")}}, parsed, time.Now())
+	require.NoError(t, err)
+	assert.True(t, bundle.Signed)
+
+	files := untar(t, bundle.Content)
+	assert.Equal(t,
+		"44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a  evidence.json\n",
+		string(files[checksumsFile]))
+
+	signature, err := base64.StdEncoding.DecodeString(string(files[signatureFile]))
+	require.NoError(t, err)
+	block, _ := pem.Decode(files[publicKeyFile])
+	require.NotNil(t, block)
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(publicKey.(ed25519.PublicKey), files[checksumsFile], signature))
+}
+
+func TestPack_Unsigned(t *testing.T) {
+	key, err := ParseSigningKey("")
+	require.NoError(t, err)
+
+	bundle, err := pack([]entry{{name: "evidence.json", content: []byte("{}")}}, key, time.Now())
+	require.NoError(t, err)
+	assert.False(t, bundle.Signed)
+
+	files := untar(t, bundle.Content)
+	assert.Contains(t, files, checksumsFile)
+	assert.NotContains(t, files, signatureFile)
+}
+
+func TestParseSigningKey_Invalid(t *testing.T) {
+	_, err := ParseSigningKey("not a key")
+	assert.Error(t, err)
+}
+
+func untar(t *testing.T, content []byte) map[string][]byte {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string][]byte{}
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[h.Name] = data
+	}
+	return files
+}
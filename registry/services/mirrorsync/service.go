@@ -0,0 +1,236 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/app/services/refcache"
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/secret"
+	"github.com/harness/gitness/store/database/dbtx"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType = "gitness:registry:mirror-sync"
+	// jobDispatchMaxDuration bounds the recurring job starting the runs that are due, jobRunMaxDuration
+	// the job of a run.
+	jobDispatchMaxDuration = 5 * time.Minute
+	jobRunMaxDuration      = 6 * time.Hour
+
+	// MinIntervalMinutes is the shortest interval mirror syncs can be run at.
+	MinIntervalMinutes = 5
+	// DefaultIntervalMinutes is the interval of mirror syncs that don't set one.
+	DefaultIntervalMinutes = 60
+)
+
+// ErrRunInProgress is returned when a mirror sync is run while its previous run hasn't finished.
+var ErrRunInProgress = errors.New("the mirror sync is already running")
+
+type Config struct {
+	Enabled bool
+	Cron    string
+}
+
+// Input is the data of the job of a mirror sync run. The recurring job starting the runs that are due
+// has no data.
+type Input struct {
+	RunID int64 `json:"run_id"`
+}
+
+// Service replicates the tags of repositories of upstream proxies into local registries. Each mirror sync
+// is run every interval by a job of its own, which copies the manifests and blobs of the tags the registry
+// doesn't have at the digest of the upstream, and records the outcome per tag.
+type Service struct {
+	config           Config
+	scheduler        *job.Scheduler
+	executor         *job.Executor
+	tx               dbtx.Transactor
+	registryDao      store.RegistryRepository
+	upstreamProxyDao store.UpstreamProxyConfigRepository
+	mirrorSyncStore  store.MirrorSyncRepository
+	manifestStore    store.ManifestRepository
+	localRegistry    *docker.LocalRegistry
+	spaceFinder      refcache.SpaceFinder
+	secretService    secret.Service
+	urlProvider      urlprovider.Provider
+}
+
+func NewService(
+	config Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	tx dbtx.Transactor,
+	registryDao store.RegistryRepository,
+	upstreamProxyDao store.UpstreamProxyConfigRepository,
+	mirrorSyncStore store.MirrorSyncRepository,
+	manifestStore store.ManifestRepository,
+	localRegistry *docker.LocalRegistry,
+	spaceFinder refcache.SpaceFinder,
+	secretService secret.Service,
+	urlProvider urlprovider.Provider,
+) *Service {
+	return &Service{
+		config:           config,
+		scheduler:        scheduler,
+		executor:         executor,
+		tx:               tx,
+		registryDao:      registryDao,
+		upstreamProxyDao: upstreamProxyDao,
+		mirrorSyncStore:  mirrorSyncStore,
+		manifestStore:    manifestStore,
+		localRegistry:    localRegistry,
+		spaceFinder:      spaceFinder,
+		secretService:    secretService,
+		urlProvider:      urlProvider,
+	}
+}
+
+// Register registers the handler of the runs, which can be started on demand, and schedules the recurring
+// job starting the runs that are due if the scheduled syncs are enabled.
+func (s *Service) Register(ctx context.Context) error {
+	if err := s.executor.Register(jobType, s); err != nil {
+		return fmt.Errorf("failed to register job handler for mirror sync: %w", err)
+	}
+	if !s.config.Enabled {
+		return nil
+	}
+	if err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.config.Cron, jobDispatchMaxDuration); err != nil {
+		return fmt.Errorf("failed to schedule mirror sync job: %w", err)
+	}
+	return nil
+}
+
+// Run starts a run of the mirror sync on demand, ErrRunInProgress if its previous run hasn't finished.
+func (s *Service) Run(ctx context.Context, sync *types.MirrorSync, principalID int64) (*types.MirrorSyncRun, error) {
+	return s.start(ctx, sync, types.MirrorSyncTriggerManual, principalID)
+}
+
+// JobUID returns the uid of the job of the mirror sync run.
+func JobUID(runID int64) string {
+	return jobType + ":" + strconv.FormatInt(runID, 10)
+}
+
+func (s *Service) start(
+	ctx context.Context,
+	sync *types.MirrorSync,
+	trigger types.MirrorSyncTrigger,
+	principalID int64,
+) (*types.MirrorSyncRun, error) {
+	run := &types.MirrorSyncRun{
+		SyncID:    sync.ID,
+		Status:    types.MirrorSyncRunStatusPending,
+		Trigger:   trigger,
+		CreatedBy: principalID,
+	}
+	err := s.tx.WithTx(ctx, func(ctx context.Context) error {
+		active, err := s.mirrorSyncStore.HasActiveRun(ctx, sync.ID)
+		if err != nil {
+			return err
+		}
+		if active {
+			return ErrRunInProgress
+		}
+		if err = s.mirrorSyncStore.CreateRun(ctx, run); err != nil {
+			return err
+		}
+		return s.mirrorSyncStore.SetNextRunAt(ctx, sync.ID, NextRunAt(time.Now(), sync.IntervalMinutes))
+	})
+	if errors.Is(err, ErrRunInProgress) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mirror sync run: %w", err)
+	}
+
+	data, err := json.Marshal(Input{RunID: run.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mirror sync input: %w", err)
+	}
+	err = s.scheduler.RunJob(ctx, job.Definition{
+		UID:     JobUID(run.ID),
+		Type:    jobType,
+		Timeout: jobRunMaxDuration,
+		Data:    string(data),
+	})
+	if err != nil {
+		s.fail(ctx, run, fmt.Errorf("failed to schedule run: %w", err))
+		return nil, fmt.Errorf("failed to schedule mirror sync job: %w", err)
+	}
+	return run, nil
+}
+
+// NextRunAt returns the time the mirror sync run at the given time is run next.
+func NextRunAt(now time.Time, intervalMinutes int) time.Time {
+	return now.Add(time.Duration(intervalMinutes) * time.Minute)
+}
+
+// Handle starts the runs of the mirror syncs that are due when run by the recurring job, and replicates the
+// tags of a run otherwise.
+func (s *Service) Handle(ctx context.Context, data string, progress job.ProgressReporter) (string, error) {
+	if data == "" {
+		return "", s.dispatch(ctx)
+	}
+	var input Input
+	if err := json.Unmarshal([]byte(data), &input); err != nil {
+		return "", fmt.Errorf("failed to unmarshal mirror sync input: %w", err)
+	}
+	return s.execute(ctx, input.RunID, progress)
+}
+
+// dispatch starts a run of each mirror sync that's due. Syncs whose previous run hasn't finished are
+// skipped until their next interval.
+func (s *Service) dispatch(ctx context.Context) error {
+	syncs, err := s.mirrorSyncStore.ListDue(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to list due mirror syncs: %w", err)
+	}
+	principalID := bootstrap.NewSystemServiceSession().Principal.ID
+	for _, sync := range syncs {
+		_, err = s.start(ctx, sync, types.MirrorSyncTriggerScheduled, principalID)
+		if errors.Is(err, ErrRunInProgress) {
+			log.Ctx(ctx).Info().Msgf("skipping mirror sync %d of %s, its previous run hasn't finished", sync.ID,
+				sync.Repository)
+			err = s.mirrorSyncStore.SetNextRunAt(ctx, sync.ID, NextRunAt(time.Now(), sync.IntervalMinutes))
+		}
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msgf("failed to start mirror sync %d of %s", sync.ID, sync.Repository)
+		}
+	}
+	return nil
+}
+
+// fail records the run as failed as a whole. The run is recorded even if the job was cancelled, so the sync
+// isn't blocked by a run that never finishes.
+func (s *Service) fail(ctx context.Context, run *types.MirrorSyncRun, cause error) {
+	run.Status = types.MirrorSyncRunStatusFailed
+	run.Error = cause.Error()
+	run.FinishedAt = time.Now()
+	if err := s.mirrorSyncStore.UpdateRun(context.WithoutCancel(ctx), run); err != nil {
+		log.Ctx(ctx).Error().Err(err).Msgf("failed to record failure of mirror sync run %d", run.ID)
+	}
+}
@@ -0,0 +1,357 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorsync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/manifest"
+	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/pkg/commons"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	v2 "github.com/distribution/distribution/v3/registry/api/v2"
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// Result is the number of tags of a run that were replicated, skipped and failed to be replicated.
+type Result struct {
+	Tags    int `json:"tags"`
+	Synced  int `json:"synced"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+// mirror is a repository of an upstream proxy replicated into a local registry.
+type mirror struct {
+	registry    *types.Registry
+	remote      proxy.RemoteInterface
+	remoteImage string
+	image       string
+	urlBuilder  *v2.URLBuilder
+	rootID      string
+}
+
+// execute replicates the tags of the run. A run whose job fails is recorded as failed, rather than retried,
+// so the mirror sync is run again at its next interval.
+func (s *Service) execute(ctx context.Context, runID int64, progress job.ProgressReporter) (string, error) {
+	run, err := s.mirrorSyncStore.GetRunByID(ctx, runID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get mirror sync run: %w", err)
+	}
+	if run.Status != types.MirrorSyncRunStatusPending {
+		return "", nil
+	}
+	run.Status = types.MirrorSyncRunStatusRunning
+	run.StartedAt = time.Now()
+	if err = s.mirrorSyncStore.UpdateRun(ctx, run); err != nil {
+		return "", fmt.Errorf("failed to update mirror sync run: %w", err)
+	}
+
+	result, err := s.replicate(ctx, run, progress)
+	if err != nil {
+		s.fail(ctx, run, err)
+		return "", err
+	}
+	run.Status = types.MirrorSyncRunStatusSucceeded
+	if result.Failed > 0 {
+		run.Status = types.MirrorSyncRunStatusFailed
+	}
+	run.Tags, run.Synced, run.Skipped, run.Failed = result.Tags, result.Synced, result.Skipped, result.Failed
+	run.FinishedAt = time.Now()
+	if err = s.mirrorSyncStore.UpdateRun(ctx, run); err != nil {
+		return "", fmt.Errorf("failed to update mirror sync run: %w", err)
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal mirror sync result: %w", err)
+	}
+	return string(output), nil
+}
+
+func (s *Service) replicate(
+	ctx context.Context,
+	run *types.MirrorSyncRun,
+	progress job.ProgressReporter,
+) (Result, error) {
+	sync, err := s.mirrorSyncStore.GetByID(ctx, run.SyncID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to get mirror sync: %w", err)
+	}
+	m, err := s.newMirror(ctx, sync)
+	if err != nil {
+		return Result{}, err
+	}
+	tags, err := m.remote.ListTags(m.remoteImage)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to list the tags of %s: %w", m.remoteImage, err)
+	}
+	tags, err = FilterTags(tags, sync.TagPattern)
+	if err != nil {
+		return Result{}, err
+	}
+
+	pending := make([]*types.MirrorSyncArtifact, 0, len(tags))
+	for _, tag := range tags {
+		pending = append(pending, &types.MirrorSyncArtifact{
+			RunID:  run.ID,
+			Tag:    tag,
+			Status: types.MirrorSyncArtifactStatusPending,
+		})
+	}
+	if err = s.mirrorSyncStore.CreateArtifacts(ctx, pending); err != nil {
+		return Result{}, fmt.Errorf("failed to save mirror sync artifacts: %w", err)
+	}
+	artifacts, err := s.mirrorSyncStore.ListArtifacts(ctx, run.ID)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to list mirror sync artifacts: %w", err)
+	}
+
+	// the manifests are pushed to the registry on behalf of the system.
+	ctx = request.WithAuthSession(ctx, bootstrap.NewSystemServiceSession())
+
+	result := Result{Tags: len(artifacts)}
+	for i, a := range artifacts {
+		a.Digest, a.Status, a.Error = "", types.MirrorSyncArtifactStatusSynced, ""
+		dgst, skipped, err := s.syncTag(ctx, m, a.Tag)
+		switch {
+		case err != nil:
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to replicate %s:%s into registry %s", m.image, a.Tag,
+				m.registry.Name)
+			a.Status, a.Error = types.MirrorSyncArtifactStatusFailed, err.Error()
+			result.Failed++
+		case skipped:
+			a.Status = types.MirrorSyncArtifactStatusSkipped
+			result.Skipped++
+		default:
+			result.Synced++
+		}
+		a.Digest = dgst.String()
+		if err = s.mirrorSyncStore.UpdateArtifact(ctx, a); err != nil {
+			return Result{}, fmt.Errorf("failed to update mirror sync artifact: %w", err)
+		}
+		_ = progress((i+1)*100/len(artifacts), "")
+	}
+	return result, nil
+}
+
+func (s *Service) newMirror(ctx context.Context, sync *types.MirrorSync) (*mirror, error) {
+	registry, err := s.registryDao.Get(ctx, sync.RegistryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+	upstream, err := s.registryDao.Get(ctx, sync.UpstreamRegistryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream registry: %w", err)
+	}
+	upstreamProxy, err := s.upstreamProxyDao.GetByRegistryIdentifier(ctx, upstream.ParentID, upstream.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream proxy config of %s: %w", upstream.Name, err)
+	}
+	remote, err := proxy.NewRemoteHelper(ctx, s.spaceFinder, s.secretService, upstream.Name, *upstreamProxy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the upstream of %s: %w", upstream.Name, err)
+	}
+	remoteImage, err := remote.GetImageName(ctx, s.spaceFinder, sync.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s in the upstream of %s: %w", sync.Repository, upstream.Name,
+			err)
+	}
+	rootSpace, err := s.spaceFinder.FindByID(ctx, registry.RootParentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find root space: %w", err)
+	}
+	urlBuilder, err := v2.NewURLBuilderFromString(s.urlProvider.RegistryURL(ctx), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create url builder: %w", err)
+	}
+	return &mirror{
+		registry:    registry,
+		remote:      remote,
+		remoteImage: remoteImage,
+		image:       sync.Repository,
+		urlBuilder:  urlBuilder,
+		rootID:      rootSpace.Identifier,
+	}, nil
+}
+
+// info returns the info of a push of the image of the mirror to the registry. The push path modifies the
+// info it's passed, so every push gets its own.
+func (m *mirror) info(tag string, dgst digest.Digest) pkg.RegistryInfo {
+	return pkg.RegistryInfo{
+		ArtifactInfo: &pkg.ArtifactInfo{
+			BaseInfo: &pkg.BaseInfo{
+				PathRoot:       strings.ToLower(m.rootID),
+				RootIdentifier: m.rootID,
+				RootParentID:   m.registry.RootParentID,
+				ParentID:       m.registry.ParentID,
+				StoragePrefix:  m.registry.StoragePrefix,
+			},
+			RegIdentifier: m.registry.Name,
+			Image:         m.image,
+		},
+		Tag:         tag,
+		Digest:      dgst.String(),
+		URLBuilder:  m.urlBuilder,
+		PackageType: m.registry.PackageType,
+	}
+}
+
+// syncTag replicates the tag into the registry, unless the registry already has it at the digest of the
+// upstream. The manifests of the platforms of an index are replicated before the index.
+func (s *Service) syncTag(ctx context.Context, m *mirror, tag string) (digest.Digest, bool, error) {
+	exists, desc, err := m.remote.ManifestExist(m.remoteImage, tag)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to check the upstream manifest: %w", err)
+	}
+	if !exists || desc == nil {
+		return "", false, fmt.Errorf("tag %s not found in the upstream", tag)
+	}
+	local, err := s.manifestStore.FindManifestByTagName(ctx, m.registry.ID, m.image, tag)
+	if err != nil && !errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return "", false, fmt.Errorf("failed to find the local manifest: %w", err)
+	}
+	if err == nil && local.Digest == desc.Digest {
+		return desc.Digest, true, nil
+	}
+
+	man, _, err := m.remote.Manifest(m.remoteImage, tag)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to pull the upstream manifest: %w", err)
+	}
+	if isIndex(man) {
+		for _, child := range man.References() {
+			childManifest, _, err := m.remote.Manifest(m.remoteImage, child.Digest.String())
+			if err != nil {
+				return "", false, fmt.Errorf("failed to pull the upstream manifest %s: %w", child.Digest, err)
+			}
+			if err = s.copyBlobs(ctx, m, childManifest); err != nil {
+				return "", false, err
+			}
+			if err = s.putManifest(ctx, m.info("", child.Digest), childManifest); err != nil {
+				return "", false, err
+			}
+		}
+	} else if err = s.copyBlobs(ctx, m, man); err != nil {
+		return "", false, err
+	}
+	if err = s.putManifest(ctx, m.info(tag, ""), man); err != nil {
+		return "", false, err
+	}
+	return desc.Digest, false, nil
+}
+
+// copyBlobs pushes the blobs of the manifest the registry doesn't have yet.
+func (s *Service) copyBlobs(ctx context.Context, m *mirror, man manifest.Manifest) error {
+	for _, blob := range man.References() {
+		_, fr, _, rc, _, errs := s.localRegistry.HeadBlob(ctx, m.info("", blob.Digest))
+		closeBlob(fr, rc)
+		if len(errs) == 0 {
+			continue
+		}
+		if err := s.pushBlob(ctx, m, blob.Digest); err != nil {
+			return fmt.Errorf("failed to replicate blob %s: %w", blob.Digest, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) pushBlob(ctx context.Context, m *mirror, dgst digest.Digest) error {
+	size, body, err := m.remote.BlobReader(m.remoteImage, dgst.String())
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	info := m.info("", dgst)
+	headers, errs := s.localRegistry.InitBlobUpload(ctx, info, "", "")
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	location, err := url.Parse(headers.Headers["Location"])
+	if err != nil {
+		return fmt.Errorf("failed to parse upload location: %w", err)
+	}
+	info.SetReference(headers.Headers[commons.HeaderDockerUploadUUID])
+	_, errs = s.localRegistry.PushBlob(ctx, info, body, size, location.Query().Get("_state"))
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+func (s *Service) putManifest(ctx context.Context, info pkg.RegistryInfo, man manifest.Manifest) error {
+	mediaType, payload, err := man.Payload()
+	if err != nil {
+		return fmt.Errorf("failed to read manifest payload: %w", err)
+	}
+	_, errs := s.localRegistry.PutManifest(ctx, info, mediaType, io.NopCloser(bytes.NewReader(payload)),
+		int64(len(payload)))
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to push manifest: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// FilterTags returns the tags that match the pattern, all tags if the pattern is empty.
+func FilterTags(tags []string, pattern string) ([]string, error) {
+	if pattern == "" {
+		return tags, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag pattern %q: %w", pattern, err)
+	}
+	matched := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if re.MatchString(tag) {
+			matched = append(matched, tag)
+		}
+	}
+	return matched, nil
+}
+
+func isIndex(man manifest.Manifest) bool {
+	mediaType, _, _ := man.Payload()
+	return mediaType == v1.MediaTypeImageIndex || mediaType == manifestlist.MediaTypeManifestList
+}
+
+func closeBlob(fr *storage.FileReader, rc io.ReadCloser) {
+	if fr != nil {
+		_ = fr.Close()
+	}
+	if rc != nil {
+		_ = rc.Close()
+	}
+}
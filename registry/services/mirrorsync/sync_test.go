@@ -0,0 +1,52 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterTags(t *testing.T) {
+	tags := []string{"latest", "1.25", "1.25.3", "1.25.3-alpine", "v2.0.0", "nightly"}
+
+	matched, err := FilterTags(tags, "")
+	require.NoError(t, err)
+	assert.Equal(t, tags, matched)
+
+	matched, err = FilterTags(tags, `^v?\d+\.\d+\.\d+$`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.25.3", "v2.0.0"}, matched)
+
+	matched, err = FilterTags(tags, "alpine")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.25.3-alpine"}, matched)
+
+	matched, err = FilterTags(tags, "^stable$")
+	require.NoError(t, err)
+	assert.Empty(t, matched)
+
+	_, err = FilterTags(tags, "1.25[")
+	assert.Error(t, err)
+}
+
+func TestNextRunAt(t *testing.T) {
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC), NextRunAt(now, DefaultIntervalMinutes))
+	assert.Equal(t, time.Date(2024, 5, 1, 10, 5, 0, 0, time.UTC), NextRunAt(now, MinIntervalMinutes))
+}
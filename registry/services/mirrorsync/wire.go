@@ -0,0 +1,65 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mirrorsync
+
+import (
+	"github.com/harness/gitness/app/services/refcache"
+	urlprovider "github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/pkg/docker"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/secret"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	tx dbtx.Transactor,
+	registryDao store.RegistryRepository,
+	upstreamProxyDao store.UpstreamProxyConfigRepository,
+	mirrorSyncStore store.MirrorSyncRepository,
+	manifestStore store.ManifestRepository,
+	localRegistry *docker.LocalRegistry,
+	spaceFinder refcache.SpaceFinder,
+	secretService secret.Service,
+	urlProvider urlprovider.Provider,
+) *Service {
+	return NewService(
+		Config{
+			Enabled: config.Registry.MirrorSync.Enabled,
+			Cron:    config.Registry.MirrorSync.Cron,
+		},
+		scheduler,
+		executor,
+		tx,
+		registryDao,
+		upstreamProxyDao,
+		mirrorSyncStore,
+		manifestStore,
+		localRegistry,
+		spaceFinder,
+		secretService,
+		urlProvider,
+	)
+}
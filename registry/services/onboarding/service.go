@@ -0,0 +1,340 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onboarding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	liberrors "github.com/harness/gitness/registry/app/common/lib/errors"
+	"github.com/harness/gitness/registry/app/manifest"
+	"github.com/harness/gitness/registry/app/manifest/manifestlist"
+	"github.com/harness/gitness/registry/app/remote/clients/registry"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy"
+	mavenproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/maven"
+	npmproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/npm"
+	pypiproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/pypi"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ErrEstimateNotSupported is returned for package types whose upstreams have no catalog to
+// estimate an import from.
+var ErrEstimateNotSupported = errors.New("import estimates are only supported for docker and helm upstreams")
+
+type Config struct {
+	// Timeout is the time the check of an upstream has to complete.
+	Timeout time.Duration
+	// EstimateTimeout is the time the estimate of an import has to complete. The estimate is
+	// extrapolated from what was sampled so far when it runs out.
+	EstimateTimeout time.Duration
+	// SampledRepositories is the number of repositories whose tags are listed for an estimate.
+	SampledRepositories int
+	// SampledTags is the number of tags whose manifests are pulled per sampled repository.
+	SampledTags int
+}
+
+// Target is the external repository a registry is onboarded from.
+type Target struct {
+	PackageType artifact.PackageType
+	// Source is the upstream source, the URL of well known sources defaults if none is given.
+	Source   string
+	URL      string
+	UserName string
+	Password string
+}
+
+// Connectivity is the result of checking an upstream.
+type Connectivity struct {
+	URL        string
+	Reachable  bool
+	Authorized bool
+	// StatusCode is the status the upstream responded with, zero if it didn't respond.
+	StatusCode int
+	Latency    time.Duration
+	Message    string
+}
+
+// ImportEstimate is the expected size of importing the repositories of an upstream.
+type ImportEstimate struct {
+	Repositories        int
+	SampledRepositories int
+	Tags                int64
+	SampledTags         int
+	SampledSize         int64
+	Size                int64
+	// Exact is set if every tag was sampled, the size is extrapolated from the samples otherwise.
+	Exact bool
+}
+
+// Service checks external repositories before they're onboarded as registries.
+type Service struct {
+	config     Config
+	httpClient *http.Client
+	newClient  func(url, username, password string) registry.Client
+}
+
+func NewService(config Config) *Service {
+	return &Service{
+		config:     config,
+		httpClient: &http.Client{},
+		newClient: func(url, username, password string) registry.Client {
+			return registry.NewClient(url, username, password, false)
+		},
+	}
+}
+
+// url returns the URL of the target, defaulting it from the source.
+func (t Target) url() string {
+	if t.URL != "" {
+		return strings.TrimSuffix(t.URL, "/")
+	}
+	switch t.Source {
+	case string(artifact.UpstreamConfigSourceDockerhub):
+		return proxy.DockerHubURL
+	case string(artifact.UpstreamConfigSourceMavenCentral):
+		return mavenproxy.MavenCentralURL
+	case string(artifact.UpstreamConfigSourceGoogleMaven):
+		return mavenproxy.GoogleMavenURL
+	case string(artifact.UpstreamConfigSourceNpmjs):
+		return npmproxy.NpmjsURL
+	case string(artifact.UpstreamConfigSourcePyPi):
+		return pypiproxy.PyPiURL
+	default:
+		return ""
+	}
+}
+
+// isOCI reports whether the upstreams of the package type are OCI distribution registries.
+func isOCI(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM
+}
+
+// CheckUpstream checks whether the upstream is reachable and accepts the credentials of the
+// target. OCI registries are pinged on their API root, other upstreams on their URL.
+func (s *Service) CheckUpstream(ctx context.Context, target Target) *Connectivity {
+	result := &Connectivity{URL: target.url()}
+	if result.URL == "" {
+		result.Message = "the upstream has no URL"
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+	start := time.Now()
+	if isOCI(target.PackageType) {
+		s.checkRegistry(ctx, target, result)
+	} else {
+		s.checkHTTP(ctx, target, result)
+	}
+	result.Latency = time.Since(start)
+	if result.Message == "" && ctx.Err() != nil {
+		result.Message = fmt.Sprintf("the upstream didn't respond within %s", s.config.Timeout)
+	}
+	return result
+}
+
+func (s *Service) checkRegistry(ctx context.Context, target Target, result *Connectivity) {
+	client := s.newClient(result.URL, target.UserName, target.Password)
+	_, err := withContext(ctx, func() (struct{}, error) {
+		return struct{}{}, client.Ping()
+	})
+	switch {
+	case err == nil:
+		result.Reachable, result.Authorized, result.StatusCode = true, true, http.StatusOK
+	case liberrors.IsErr(err, liberrors.UnAuthorizedCode):
+		result.Reachable, result.StatusCode = true, http.StatusUnauthorized
+		result.Message = credentialsMessage(target)
+	case liberrors.IsErr(err, liberrors.ForbiddenCode):
+		result.Reachable, result.StatusCode = true, http.StatusForbidden
+		result.Message = credentialsMessage(target)
+	case liberrors.IsErr(err, liberrors.NotFoundCode):
+		result.Reachable, result.StatusCode = true, http.StatusNotFound
+		result.Message = "the upstream has no registry API at the URL"
+	case ctx.Err() == nil:
+		result.Message = err.Error()
+	}
+}
+
+func (s *Service) checkHTTP(ctx context.Context, target Target, result *Connectivity) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, result.URL, nil)
+	if err != nil {
+		result.Message = err.Error()
+		return
+	}
+	if target.UserName != "" {
+		req.SetBasicAuth(target.UserName, target.Password)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() == nil {
+			result.Message = err.Error()
+		}
+		return
+	}
+	defer resp.Body.Close()
+
+	result.Reachable, result.StatusCode = true, resp.StatusCode
+	switch {
+	case resp.StatusCode < http.StatusBadRequest:
+		result.Authorized = true
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		result.Message = credentialsMessage(target)
+	default:
+		result.Message = fmt.Sprintf("the upstream responded with %s, check the URL", resp.Status)
+	}
+}
+
+func credentialsMessage(target Target) string {
+	if target.UserName == "" {
+		return "the upstream requires credentials"
+	}
+	return "the upstream rejected the credentials"
+}
+
+// EstimateImport estimates the size of importing the repositories of an OCI upstream, all
+// repositories of its catalog if none are given. The tags of a sample of the repositories and
+// the manifests of a sample of their tags are fetched, blobs shared between the sampled
+// manifests are counted once, and the size is extrapolated to all tags.
+func (s *Service) EstimateImport(
+	ctx context.Context,
+	target Target,
+	repositories []string,
+) (*ImportEstimate, error) {
+	if !isOCI(target.PackageType) {
+		return nil, ErrEstimateNotSupported
+	}
+	url := target.url()
+	if url == "" {
+		return nil, errors.New("the upstream has no URL")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.EstimateTimeout)
+	defer cancel()
+	client := s.newClient(url, target.UserName, target.Password)
+	if len(repositories) == 0 {
+		var err error
+		repositories, err = withContext(ctx, client.Catalog)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the repositories of the upstream, "+
+				"name the repositories to import if it has no catalog: %w", err)
+		}
+	}
+
+	estimate := &ImportEstimate{Repositories: len(repositories)}
+	blobs := make(map[digest.Digest]struct{})
+	var sampledRepositoryTags int64
+	for _, repository := range repositories[:min(len(repositories), s.config.SampledRepositories)] {
+		if ctx.Err() != nil {
+			break
+		}
+		tags, err := withContext(ctx, func() ([]string, error) {
+			return client.ListTags(repository)
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			return nil, fmt.Errorf("failed to list the tags of %s: %w", repository, err)
+		}
+		estimate.SampledRepositories++
+		sampledRepositoryTags += int64(len(tags))
+
+		for _, tag := range tags[:min(len(tags), s.config.SampledTags)] {
+			references, err := withContext(ctx, func() ([]manifest.Descriptor, error) {
+				return imageReferences(client, repository, tag)
+			})
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				return nil, fmt.Errorf("failed to pull the manifest of %s:%s: %w", repository, tag, err)
+			}
+			estimate.SampledTags++
+			for _, reference := range references {
+				if _, ok := blobs[reference.Digest]; ok {
+					continue
+				}
+				blobs[reference.Digest] = struct{}{}
+				estimate.SampledSize += reference.Size
+			}
+		}
+	}
+
+	estimate.Tags = sampledRepositoryTags
+	if estimate.SampledRepositories > 0 && estimate.SampledRepositories < estimate.Repositories {
+		estimate.Tags = sampledRepositoryTags * int64(estimate.Repositories) / int64(estimate.SampledRepositories)
+	}
+	estimate.Size = estimate.SampledSize
+	if estimate.SampledTags > 0 && int64(estimate.SampledTags) < estimate.Tags {
+		estimate.Size = int64(float64(estimate.SampledSize) * float64(estimate.Tags) / float64(estimate.SampledTags))
+	}
+	estimate.Exact = estimate.SampledRepositories == estimate.Repositories &&
+		int64(estimate.SampledTags) == estimate.Tags
+	return estimate, nil
+}
+
+// imageReferences returns the config and layers of the image of the tag, of the first image of
+// the index if the tag is a multi-platform image.
+func imageReferences(client registry.Client, repository, tag string) ([]manifest.Descriptor, error) {
+	m, _, err := client.PullManifest(repository, tag)
+	if err != nil {
+		return nil, err
+	}
+	mediaType, _, err := m.Payload()
+	if err != nil {
+		return nil, err
+	}
+	if mediaType != manifestlist.MediaTypeManifestList && mediaType != v1.MediaTypeImageIndex {
+		return m.References(), nil
+	}
+
+	children := m.References()
+	if len(children) == 0 {
+		return nil, nil
+	}
+	m, _, err = client.PullManifest(repository, children[0].Digest.String())
+	if err != nil {
+		return nil, err
+	}
+	return m.References(), nil
+}
+
+// withContext runs fn until the context is done. The registry client doesn't take a context, so
+// a call that outlives the context is left to finish on its own and its result is dropped.
+func withContext[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := fn()
+		done <- result{value: value, err: err}
+	}()
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onboarding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sharedLayer = "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+	layerType   = "application/vnd.oci.image.layer.v1.tar+gzip"
+)
+
+// newRegistry serves the catalog and tags, a manifest per tag with a layer shared by all images,
+// and asks for basic auth.
+func newRegistry(t *testing.T, tags map[string][]string) *httptest.Server {
+	t.Helper()
+	var repositories []string
+	for repository := range tags {
+		repositories = append(repositories, repository)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/v2/")
+		switch {
+		case path == "":
+		case path == "_catalog":
+			_ = json.NewEncoder(w).Encode(map[string]any{"repositories": repositories})
+		case strings.HasSuffix(path, "/tags/list"):
+			repository := strings.TrimSuffix(path, "/tags/list")
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": repository, "tags": tags[repository]})
+		case strings.Contains(path, "/manifests/"):
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"schemaVersion": 2,
+				"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+				"config": map[string]any{
+					"mediaType": "application/vnd.oci.image.config.v1+json",
+					"digest":    fakeDigest(path + "/config"),
+					"size":      5,
+				},
+				"layers": []map[string]any{
+					{"mediaType": layerType, "digest": sharedLayer, "size": 100},
+					{"mediaType": layerType, "digest": fakeDigest(path), "size": 10},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func fakeDigest(s string) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(s)))
+}
+
+func newService() *Service {
+	return NewService(Config{
+		Timeout:             time.Second,
+		EstimateTimeout:     5 * time.Second,
+		SampledRepositories: 2,
+		SampledTags:         2,
+	})
+}
+
+func TestCheckUpstream(t *testing.T) {
+	server := newRegistry(t, map[string][]string{"a": {"1"}})
+	s := newService()
+
+	result := s.CheckUpstream(context.Background(), Target{
+		PackageType: artifact.PackageTypeDOCKER, URL: server.URL, UserName: "user", Password: "pass",
+	})
+	assert.True(t, result.Reachable)
+	assert.True(t, result.Authorized)
+	assert.Equal(t, http.StatusOK, result.StatusCode)
+
+	result = s.CheckUpstream(context.Background(), Target{
+		PackageType: artifact.PackageTypeDOCKER, URL: server.URL, UserName: "user", Password: "wrong",
+	})
+	assert.True(t, result.Reachable)
+	assert.False(t, result.Authorized)
+	assert.Equal(t, "the upstream rejected the credentials", result.Message)
+
+	result = s.CheckUpstream(context.Background(), Target{PackageType: artifact.PackageTypeNPM, URL: server.URL})
+	assert.True(t, result.Reachable)
+	assert.False(t, result.Authorized)
+	assert.Equal(t, http.StatusUnauthorized, result.StatusCode)
+	assert.Equal(t, "the upstream requires credentials", result.Message)
+
+	result = s.CheckUpstream(context.Background(), Target{
+		PackageType: artifact.PackageTypeNPM, URL: server.URL + "/v2/_catalog", UserName: "user", Password: "pass",
+	})
+	assert.True(t, result.Authorized)
+
+	server.Close()
+	result = s.CheckUpstream(context.Background(), Target{PackageType: artifact.PackageTypeNPM, URL: server.URL})
+	assert.False(t, result.Reachable)
+	assert.NotEmpty(t, result.Message)
+}
+
+func TestEstimateImport(t *testing.T) {
+	server := newRegistry(t, map[string][]string{
+		"a": {"1", "2", "3"},
+		"b": {"1"},
+	})
+	s := newService()
+	target := Target{PackageType: artifact.PackageTypeDOCKER, URL: server.URL, UserName: "user", Password: "pass"}
+
+	estimate, err := s.EstimateImport(context.Background(), target, []string{"a", "b", "a"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, estimate.Repositories)
+	assert.Equal(t, 2, estimate.SampledRepositories)
+	// 4 tags in 2 of 3 repositories, of which 2 of a and 1 of b are sampled.
+	assert.Equal(t, int64(6), estimate.Tags)
+	assert.Equal(t, 3, estimate.SampledTags)
+	// The shared layer counts once, the config and own layer of each sampled image once each.
+	assert.Equal(t, int64(100+3*15), estimate.SampledSize)
+	assert.Equal(t, int64(145*6/3), estimate.Size)
+	assert.False(t, estimate.Exact)
+
+	s.config.SampledRepositories, s.config.SampledTags = 10, 10
+	estimate, err = s.EstimateImport(context.Background(), target, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, estimate.Repositories)
+	assert.Equal(t, int64(4), estimate.Tags)
+	assert.Equal(t, int64(100+4*15), estimate.Size)
+	assert.True(t, estimate.Exact)
+
+	_, err = s.EstimateImport(context.Background(), Target{PackageType: artifact.PackageTypeNPM, URL: server.URL}, nil)
+	assert.ErrorIs(t, err, ErrEstimateNotSupported)
+}
@@ -0,0 +1,160 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/services/contentindex"
+	"github.com/harness/gitness/registry/services/storagemigration"
+	"github.com/harness/gitness/registry/types"
+)
+
+// ErrUnknownQueue is returned for a queue name that isn't one of types.QueueNames.
+var ErrUnknownQueue = errors.New("unknown registry queue")
+
+// Queue is the state of a queue of background operations of a registry.
+type Queue struct {
+	Name types.QueueName
+	types.QueueStats
+	Paused   bool
+	PausedAt int64
+	PausedBy int64
+}
+
+// Service reports the queues of background registry operations and pauses and resumes them.
+// The workers of the queues check the paused state themselves, garbage collection is left to
+// the garbage collector implementation.
+type Service struct {
+	queueStore         store.RegistryQueueRepository
+	cleanupPolicyStore store.CleanupPolicyRepository
+	storageMigration   *storagemigration.Service
+	contentIndex       *contentindex.Service
+}
+
+func NewService(
+	queueStore store.RegistryQueueRepository,
+	cleanupPolicyStore store.CleanupPolicyRepository,
+	storageMigration *storagemigration.Service,
+	contentIndex *contentindex.Service,
+) *Service {
+	return &Service{
+		queueStore:         queueStore,
+		cleanupPolicyStore: cleanupPolicyStore,
+		storageMigration:   storageMigration,
+		contentIndex:       contentIndex,
+	}
+}
+
+// List returns the queues of the registry in the order of types.QueueNames.
+func (s *Service) List(ctx context.Context, registryID int64) ([]Queue, error) {
+	paused, err := s.queueStore.ListPaused(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list paused queues: %w", err)
+	}
+	pausedByName := make(map[types.QueueName]*types.PausedQueue, len(paused))
+	for _, p := range paused {
+		pausedByName[p.Queue] = p
+	}
+
+	queues := make([]Queue, 0, len(types.QueueNames))
+	for _, name := range types.QueueNames {
+		stats, err := s.stats(ctx, registryID, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get backlog of queue %s: %w", name, err)
+		}
+		queue := Queue{Name: name, QueueStats: *stats}
+		if p, ok := pausedByName[name]; ok {
+			queue.Paused = true
+			queue.PausedAt = p.PausedAt
+			queue.PausedBy = p.PausedBy
+		}
+		queues = append(queues, queue)
+	}
+	return queues, nil
+}
+
+// Get returns a single queue of the registry.
+func (s *Service) Get(ctx context.Context, registryID int64, name types.QueueName) (*Queue, error) {
+	queues, err := s.List(ctx, registryID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range queues {
+		if queues[i].Name == name {
+			return &queues[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownQueue, name)
+}
+
+// Pause pauses the queue of the registry.
+func (s *Service) Pause(ctx context.Context, registryID int64, name types.QueueName, principalID int64) error {
+	if !name.IsValid() {
+		return fmt.Errorf("%w: %s", ErrUnknownQueue, name)
+	}
+	return s.queueStore.Pause(ctx, &types.PausedQueue{
+		RegistryID: registryID,
+		Queue:      name,
+		PausedBy:   principalID,
+	})
+}
+
+// Resume resumes the queue of the registry.
+func (s *Service) Resume(ctx context.Context, registryID int64, name types.QueueName) error {
+	if !name.IsValid() {
+		return fmt.Errorf("%w: %s", ErrUnknownQueue, name)
+	}
+	return s.queueStore.Resume(ctx, registryID, name)
+}
+
+func (s *Service) stats(ctx context.Context, registryID int64, name types.QueueName) (*types.QueueStats, error) {
+	switch name {
+	case types.QueueGCManifest:
+		return s.queueStore.GCManifestStats(ctx, registryID)
+	case types.QueueGCBlob:
+		return s.queueStore.GCBlobStats(ctx, registryID)
+	case types.QueueStorageMigration:
+		return s.storageMigration.QueueStats(ctx, registryID)
+	case types.QueueContentIndex:
+		return s.contentIndex.QueueStats(ctx, registryID)
+	case types.QueueCleanup:
+		return s.cleanupStats(ctx, registryID)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownQueue, name)
+	}
+}
+
+// cleanupStats counts the cleanup policies of the registry that are applied by the next cleanup run.
+func (s *Service) cleanupStats(ctx context.Context, registryID int64) (*types.QueueStats, error) {
+	policies, err := s.cleanupPolicyStore.GetByRegistryID(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleanup policies: %w", err)
+	}
+	stats := &types.QueueStats{}
+	if policies == nil {
+		return stats, nil
+	}
+	for _, policy := range *policies {
+		if policy.Type == artifact.CleanupPolicyTypeNOTPULLED {
+			stats.Pending++
+		}
+	}
+	return stats, nil
+}
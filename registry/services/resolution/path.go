@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolution
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// ParsePath parses the path of an artifact, as it's pulled from a registry of the package type, into the
+// coordinate to resolve: "image:tag" or "image@digest" for images, "group/path/artifactId/version/file" for
+// Maven and "artifact/version/file" for the other package types, where the file is optional and the artifact
+// may be an npm scope followed by a name.
+func ParsePath(packageType artifact.PackageType, path string) (Coordinate, error) {
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	if path == "" {
+		return Coordinate{}, fmt.Errorf("path is required")
+	}
+
+	if isOCI(packageType) {
+		if name, version, ok := strings.Cut(path, "@"); ok && name != "" && version != "" {
+			return Coordinate{Artifact: name, Version: version}, nil
+		}
+		i := strings.LastIndex(path, ":")
+		if i <= strings.LastIndex(path, "/") || i == len(path)-1 {
+			return Coordinate{}, fmt.Errorf("path %q of an image must end with a tag or a digest", path)
+		}
+		return Coordinate{Artifact: path[:i], Version: path[i+1:]}, nil
+	}
+
+	segments := strings.Split(path, "/")
+	if packageType == artifact.PackageTypeMAVEN {
+		if len(segments) < 4 {
+			return Coordinate{}, fmt.Errorf("path %q must be group/path/artifactId/version/file", path)
+		}
+		n := len(segments)
+		return Coordinate{
+			Artifact: strings.Join(segments[:n-3], ".") + ":" + segments[n-3],
+			Version:  segments[n-2],
+			File:     segments[n-1],
+		}, nil
+	}
+
+	artifactSegments := 1
+	if strings.HasPrefix(segments[0], "@") {
+		artifactSegments = 2
+	}
+	if len(segments) <= artifactSegments {
+		return Coordinate{}, fmt.Errorf("path %q must be artifact/version or artifact/version/file", path)
+	}
+	return Coordinate{
+		Artifact: strings.Join(segments[:artifactSegments], "/"),
+		Version:  segments[artifactSegments],
+		File:     strings.Join(segments[artifactSegments+1:], "/"),
+	}, nil
+}
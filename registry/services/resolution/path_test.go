@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolution
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		packageType artifact.PackageType
+		path        string
+		want        Coordinate
+	}{
+		{artifact.PackageTypeDOCKER, "library/alpine:3.20", Coordinate{Artifact: "library/alpine", Version: "3.20"}},
+		{artifact.PackageTypeDOCKER, "localhost:5000/app:1.0", Coordinate{Artifact: "localhost:5000/app", Version: "1.0"}},
+		{artifact.PackageTypeHELM, "charts/app@sha256:abc", Coordinate{Artifact: "charts/app", Version: "sha256:abc"}},
+		{
+			artifact.PackageTypeMAVEN, "/org/example/app/1.0/app-1.0.jar",
+			Coordinate{Artifact: "org.example:app", Version: "1.0", File: "app-1.0.jar"},
+		},
+		{artifact.PackageTypeGENERIC, "tools/1.0/bin/tool", Coordinate{Artifact: "tools", Version: "1.0", File: "bin/tool"}},
+		{artifact.PackageTypeNPM, "@acme/ui/2.1.0", Coordinate{Artifact: "@acme/ui", Version: "2.1.0"}},
+	}
+	for _, tt := range tests {
+		got, err := ParsePath(tt.packageType, tt.path)
+		require.NoError(t, err, tt.path)
+		assert.Equal(t, tt.want, got, tt.path)
+	}
+
+	for _, path := range []string{"", "library/alpine", "localhost:5000/app"} {
+		_, err := ParsePath(artifact.PackageTypeDOCKER, path)
+		assert.Error(t, err, path)
+	}
+	_, err := ParsePath(artifact.PackageTypeMAVEN, "app/1.0/app-1.0.jar")
+	assert.Error(t, err)
+	_, err = ParsePath(artifact.PackageTypeNPM, "@acme/ui")
+	assert.Error(t, err)
+}
@@ -0,0 +1,383 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/registry/app/api/handler/utils"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	liberrors "github.com/harness/gitness/registry/app/common/lib/errors"
+	"github.com/harness/gitness/registry/app/pkg"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy"
+	mavenproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/maven"
+	npmproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/npm"
+	pypiproxy "github.com/harness/gitness/registry/app/remote/controller/proxy/pypi"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/secret"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// Outcome describes what happened when a registry was consulted for a coordinate.
+type Outcome string
+
+const (
+	// OutcomeBlocked means the coordinate was rejected by the allowed or blocked patterns, or by the
+	// package rules of an upstream registry.
+	OutcomeBlocked Outcome = "BLOCKED"
+	// OutcomeLocalHit means the coordinate was found in a virtual registry.
+	OutcomeLocalHit Outcome = "LOCAL_HIT"
+	// OutcomeNotFound means a virtual registry doesn't hold the coordinate.
+	OutcomeNotFound Outcome = "NOT_FOUND"
+	// OutcomeCacheHit means an upstream registry answered from its local cache.
+	OutcomeCacheHit Outcome = "CACHE_HIT"
+	// OutcomeCacheMiss means an upstream registry has no cached copy and its upstream wasn't probed.
+	OutcomeCacheMiss Outcome = "CACHE_MISS"
+	// OutcomeUpstreamHit means the upstream of an upstream registry has the coordinate.
+	OutcomeUpstreamHit Outcome = "UPSTREAM_HIT"
+	// OutcomeUpstreamNotFound means the upstream of an upstream registry answered with a 404.
+	OutcomeUpstreamNotFound Outcome = "UPSTREAM_NOT_FOUND"
+	// OutcomeError means the registry couldn't be consulted.
+	OutcomeError Outcome = "ERROR"
+	// OutcomeSkipped means the registry wasn't consulted because an earlier one answered.
+	OutcomeSkipped Outcome = "SKIPPED"
+)
+
+func (o Outcome) answered() bool {
+	return o == OutcomeLocalHit || o == OutcomeCacheHit || o == OutcomeUpstreamHit
+}
+
+// Coordinate identifies what is being resolved. For Maven the artifact is "groupId:artifactId"
+// and File, when set, is the name of the file within the version.
+type Coordinate struct {
+	Artifact string
+	Version  string
+	File     string
+}
+
+// Step records how one registry took part in the resolution.
+type Step struct {
+	RegistryIdentifier string
+	RegistryType       artifact.RegistryType
+	UpstreamURL        string
+	Outcome            Outcome
+	Reason             string
+}
+
+// Trace is the ordered list of registries consulted for a coordinate.
+type Trace struct {
+	Steps []Step
+	// AnsweredBy is the identifier of the registry that would serve the coordinate, empty if none.
+	AnsweredBy string
+}
+
+// Service explains how a coordinate resolves through a registry and its upstream proxies.
+type Service struct {
+	registryDao      store.RegistryRepository
+	upstreamProxyDao store.UpstreamProxyConfigRepository
+	manifestStore    store.ManifestRepository
+	imageStore       store.ImageRepository
+	artifactStore    store.ArtifactRepository
+	nodesStore       store.NodesRepository
+	spaceFinder      refcache.SpaceFinder
+	secretService    secret.Service
+	packageRuleStore store.PackageRuleRepository
+}
+
+func NewService(
+	registryDao store.RegistryRepository,
+	upstreamProxyDao store.UpstreamProxyConfigRepository,
+	manifestStore store.ManifestRepository,
+	imageStore store.ImageRepository,
+	artifactStore store.ArtifactRepository,
+	nodesStore store.NodesRepository,
+	spaceFinder refcache.SpaceFinder,
+	secretService secret.Service,
+	packageRuleStore store.PackageRuleRepository,
+) *Service {
+	return &Service{
+		registryDao:      registryDao,
+		upstreamProxyDao: upstreamProxyDao,
+		manifestStore:    manifestStore,
+		imageStore:       imageStore,
+		artifactStore:    artifactStore,
+		nodesStore:       nodesStore,
+		spaceFinder:      spaceFinder,
+		secretService:    secretService,
+		packageRuleStore: packageRuleStore,
+	}
+}
+
+// Resolve walks the registries the same way a pull does: the allowed and blocked patterns of the
+// requested registry are evaluated first, then the registry itself and its upstream proxies are
+// consulted in order until one of them has the coordinate. Nothing is downloaded or cached; upstreams
+// are only probed with a HEAD request.
+func (s *Service) Resolve(ctx context.Context, registry *types.Registry, coordinate Coordinate) (*Trace, error) {
+	upstreamRepos, err := pkg.GetUpstreamRegistries(ctx, s.registryDao, registry)
+	if err != nil {
+		return nil, err
+	}
+	repos := append([]types.Registry{*registry}, upstreamRepos...)
+
+	if key, filtered := filterKey(registry.PackageType, coordinate); filtered {
+		if ok, err := utils.MatchArtifactFilter(registry.AllowedPattern, registry.BlockedPattern, key); !ok || err != nil {
+			return blocked(registry, repos[1:], err), nil
+		}
+	}
+
+	trace := &Trace{}
+	for _, repo := range repos {
+		if trace.AnsweredBy != "" {
+			trace.Steps = append(trace.Steps, skipped(repo, "answered by "+trace.AnsweredBy))
+			continue
+		}
+		step := s.consult(ctx, repo, coordinate)
+		if step.Outcome.answered() {
+			trace.AnsweredBy = repo.Name
+		}
+		trace.Steps = append(trace.Steps, step)
+	}
+	return trace, nil
+}
+
+func (s *Service) consult(ctx context.Context, registry types.Registry, coordinate Coordinate) Step {
+	step := Step{RegistryIdentifier: registry.Name, RegistryType: registry.Type}
+
+	if registry.Type == artifact.RegistryTypeUPSTREAM {
+		rules, err := s.packageRuleStore.List(ctx, registry.ID)
+		if err != nil {
+			step.Outcome = OutcomeError
+			step.Reason = fmt.Sprintf("failed to list package rules: %s", err)
+			return step
+		}
+		if _, why, blocked := proxy.MatchPackageRules(rules, ruleCoordinate(registry.PackageType, coordinate)); blocked {
+			step.Outcome = OutcomeBlocked
+			step.Reason = "blocked by package rules; " + why
+			return step
+		}
+	}
+
+	found, err := s.existsLocally(ctx, registry, coordinate)
+	switch {
+	case err != nil:
+		step.Outcome = OutcomeError
+		step.Reason = err.Error()
+		return step
+	case found && registry.Type == artifact.RegistryTypeUPSTREAM:
+		step.Outcome = OutcomeCacheHit
+		step.Reason = "served from the local cache"
+		return step
+	case found:
+		step.Outcome = OutcomeLocalHit
+		step.Reason = "found in registry"
+		return step
+	case registry.Type != artifact.RegistryTypeUPSTREAM:
+		step.Outcome = OutcomeNotFound
+		step.Reason = "not found in registry"
+		return step
+	}
+
+	upstreamProxy, err := s.upstreamProxyDao.GetByRegistryIdentifier(ctx, registry.ParentID, registry.Name)
+	if err != nil {
+		step.Outcome = OutcomeError
+		step.Reason = fmt.Sprintf("cache miss; failed to get upstream config: %s", err)
+		return step
+	}
+	step.UpstreamURL = upstreamURL(*upstreamProxy)
+	if upstreamProxy.Offline {
+		step.Outcome = OutcomeCacheMiss
+		step.Reason = "cache miss; upstream isn't contacted while the registry is offline"
+		return step
+	}
+
+	found, probed, err := s.existsUpstream(ctx, registry, *upstreamProxy, coordinate)
+	switch {
+	case !probed:
+		step.Outcome = OutcomeCacheMiss
+		step.Reason = fmt.Sprintf("cache miss; upstream probing isn't supported for %s", registry.PackageType)
+	case err != nil && liberrors.IsNotFoundErr(err), err == nil && !found:
+		step.Outcome = OutcomeUpstreamNotFound
+		step.Reason = "cache miss; upstream returned 404"
+	case err != nil:
+		step.Outcome = OutcomeError
+		step.Reason = fmt.Sprintf("cache miss; upstream request failed: %s", err)
+	default:
+		step.Outcome = OutcomeUpstreamHit
+		step.Reason = "cache miss; served by upstream"
+	}
+	return step
+}
+
+// existsLocally looks the coordinate up in the registry's own storage, which for an upstream
+// registry is its cache.
+func (s *Service) existsLocally(ctx context.Context, registry types.Registry, coordinate Coordinate) (bool, error) {
+	var err error
+	switch {
+	case isOCI(registry.PackageType) && isDigest(coordinate.Version):
+		_, err = s.manifestStore.FindManifestByDigest(ctx, registry.ID, coordinate.Artifact,
+			types.Digest(coordinate.Version))
+	case isOCI(registry.PackageType):
+		_, err = s.manifestStore.FindManifestByTagName(ctx, registry.ID, coordinate.Artifact, coordinate.Version)
+	default:
+		err = s.versionExists(ctx, registry, coordinate)
+	}
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Service) versionExists(ctx context.Context, registry types.Registry, coordinate Coordinate) error {
+	image, err := s.imageStore.GetByName(ctx, registry.ID, coordinate.Artifact)
+	if err != nil {
+		return err
+	}
+	if _, err = s.artifactStore.GetByName(ctx, image.ID, coordinate.Version); err != nil {
+		return err
+	}
+	if registry.PackageType == artifact.PackageTypeMAVEN && coordinate.File != "" {
+		_, err = s.nodesStore.GetByPathAndRegistryID(ctx, registry.ID, "/"+mavenFilePath(coordinate))
+	}
+	return err
+}
+
+// existsUpstream probes the upstream of an upstream registry. probed is false when the package
+// type can't be probed.
+func (s *Service) existsUpstream(
+	ctx context.Context, registry types.Registry, upstreamProxy types.UpstreamProxy, coordinate Coordinate,
+) (found bool, probed bool, err error) {
+	switch {
+	case isOCI(registry.PackageType):
+		remote, err := proxy.NewRemoteHelper(ctx, s.spaceFinder, s.secretService, registry.Name, upstreamProxy)
+		if err != nil {
+			return false, true, err
+		}
+		image, err := remote.GetImageName(ctx, s.spaceFinder, coordinate.Artifact)
+		if err != nil {
+			return false, true, err
+		}
+		found, _, err = remote.ManifestExist(image, coordinate.Version)
+		return found, true, err
+	case registry.PackageType == artifact.PackageTypeMAVEN:
+		remote, err := mavenproxy.NewRemoteHelper(ctx, s.spaceFinder, s.secretService, upstreamProxy)
+		if err != nil {
+			return false, true, err
+		}
+		_, found, err = remote.HeadFile(mavenFilePath(coordinate))
+		return found, true, err
+	default:
+		return false, false, nil
+	}
+}
+
+// filterKey is the value the handlers of the package type match the patterns against. Generic
+// handlers only filter requests for a file.
+func filterKey(packageType artifact.PackageType, coordinate Coordinate) (string, bool) {
+	key := coordinate.Artifact + ":" + coordinate.Version
+	if packageType != artifact.PackageTypeGENERIC {
+		return key, true
+	}
+	return key + ":" + coordinate.File, coordinate.File != ""
+}
+
+// ruleCoordinate is the coordinate the package rules of an upstream registry are matched against.
+func ruleCoordinate(packageType artifact.PackageType, coordinate Coordinate) proxy.PackageCoordinate {
+	switch {
+	case isOCI(packageType) && isDigest(coordinate.Version):
+		return proxy.ImageCoordinate(coordinate.Artifact, "")
+	case isOCI(packageType):
+		return proxy.ImageCoordinate(coordinate.Artifact, coordinate.Version)
+	case packageType == artifact.PackageTypeMAVEN:
+		groupID, artifactID, _ := strings.Cut(coordinate.Artifact, ":")
+		return proxy.PackageCoordinate{Group: groupID, Name: artifactID, Version: coordinate.Version}
+	case packageType == artifact.PackageTypeNPM:
+		return proxy.NpmCoordinate(coordinate.Artifact, coordinate.Version)
+	default:
+		return proxy.PackageCoordinate{Name: coordinate.Artifact, Version: coordinate.Version}
+	}
+}
+
+// mavenFilePath is the repository layout path of the coordinate, defaulting to the POM when no
+// file is given.
+func mavenFilePath(coordinate Coordinate) string {
+	groupID, artifactID, _ := strings.Cut(coordinate.Artifact, ":")
+	file := coordinate.File
+	if file == "" {
+		file = artifactID + "-" + coordinate.Version + ".pom"
+	}
+	return strings.ReplaceAll(groupID, ".", "/") + "/" + artifactID + "/" + coordinate.Version + "/" + file
+}
+
+func upstreamURL(upstreamProxy types.UpstreamProxy) string {
+	switch upstreamProxy.Source {
+	case string(artifact.UpstreamConfigSourceDockerhub):
+		return proxy.DockerHubURL
+	case string(artifact.UpstreamConfigSourceMavenCentral):
+		return mavenproxy.MavenCentralURL
+	case string(artifact.UpstreamConfigSourceGoogleMaven):
+		return mavenproxy.GoogleMavenURL
+	case string(artifact.UpstreamConfigSourceNpmjs):
+		return npmproxy.NpmjsURL
+	case string(artifact.UpstreamConfigSourcePyPi):
+		return pypiproxy.PyPiURL
+	default:
+		return upstreamProxy.RepoURL
+	}
+}
+
+// blocked records the requested registry rejecting the coordinate, which stops the resolution.
+func blocked(registry *types.Registry, upstreamRepos []types.Registry, err error) *Trace {
+	why := "blocked by registry patterns"
+	if err != nil {
+		why = err.Error()
+	}
+	trace := &Trace{Steps: []Step{{
+		RegistryIdentifier: registry.Name,
+		RegistryType:       registry.Type,
+		Outcome:            OutcomeBlocked,
+		Reason:             why,
+	}}}
+	for _, repo := range upstreamRepos {
+		trace.Steps = append(trace.Steps, skipped(repo, "resolution stopped by "+registry.Name))
+	}
+	return trace
+}
+
+func skipped(registry types.Registry, why string) Step {
+	return Step{
+		RegistryIdentifier: registry.Name,
+		RegistryType:       registry.Type,
+		Outcome:            OutcomeSkipped,
+		Reason:             why,
+	}
+}
+
+func isOCI(packageType artifact.PackageType) bool {
+	return packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM
+}
+
+func isDigest(version string) bool {
+	return digest.Digest(version).Validate() == nil
+}
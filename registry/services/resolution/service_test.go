@@ -0,0 +1,163 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRegistryRepository struct {
+	store.RegistryRepository
+	registries map[int64]types.Registry
+}
+
+func (f fakeRegistryRepository) GetByIDIn(_ context.Context, ids []int64) (*[]types.Registry, error) {
+	var result []types.Registry
+	for _, id := range ids {
+		result = append(result, f.registries[id])
+	}
+	return &result, nil
+}
+
+type fakeUpstreamProxyRepository struct {
+	store.UpstreamProxyConfigRepository
+}
+
+func (fakeUpstreamProxyRepository) GetByRegistryIdentifier(
+	_ context.Context, _ int64, repoKey string,
+) (*types.UpstreamProxy, error) {
+	return &types.UpstreamProxy{RepoKey: repoKey, RepoURL: "https://upstream.example.com"}, nil
+}
+
+type fakeImageRepository struct {
+	store.ImageRepository
+	// images maps a registry ID to the names of the images it holds.
+	images map[int64][]string
+}
+
+func (f fakeImageRepository) GetByName(_ context.Context, registryID int64, name string) (*types.Image, error) {
+	for _, image := range f.images[registryID] {
+		if image == name {
+			return &types.Image{ID: registryID, Name: name}, nil
+		}
+	}
+	return nil, gitnessstore.ErrResourceNotFound
+}
+
+type fakeArtifactRepository struct {
+	store.ArtifactRepository
+}
+
+func (fakeArtifactRepository) GetByName(_ context.Context, imageID int64, version string) (*types.Artifact, error) {
+	return &types.Artifact{ImageID: imageID, Version: version}, nil
+}
+
+type fakePackageRuleRepository struct {
+	store.PackageRuleRepository
+	// rules maps a registry ID to its package rules.
+	rules map[int64][]*types.PackageRule
+}
+
+func (f fakePackageRuleRepository) List(_ context.Context, registryID int64) ([]*types.PackageRule, error) {
+	return f.rules[registryID], nil
+}
+
+func newTestService(registries ...types.Registry) *Service {
+	byID := make(map[int64]types.Registry)
+	for _, r := range registries {
+		byID[r.ID] = r
+	}
+	return &Service{
+		registryDao:      fakeRegistryRepository{registries: byID},
+		upstreamProxyDao: fakeUpstreamProxyRepository{},
+		imageStore:       fakeImageRepository{images: map[int64][]string{1: {"local-only"}, 3: {"cached"}}},
+		artifactStore:    fakeArtifactRepository{},
+		packageRuleStore: fakePackageRuleRepository{rules: map[int64][]*types.PackageRule{
+			3: {{ID: 1, Action: types.PackageRuleActionExclude, Name: "cached", VersionRange: "< 1"}},
+		}},
+	}
+}
+
+func TestResolve(t *testing.T) {
+	virtual := types.Registry{
+		ID: 1, Name: "virtual", Type: artifact.RegistryTypeVIRTUAL, PackageType: artifact.PackageTypeGENERIC,
+		UpstreamProxies: []int64{2, 3}, BlockedPattern: []string{"^blocked"},
+	}
+	empty := types.Registry{
+		ID: 2, Name: "empty", Type: artifact.RegistryTypeVIRTUAL, PackageType: artifact.PackageTypeGENERIC,
+	}
+	upstream := types.Registry{
+		ID: 3, Name: "upstream", Type: artifact.RegistryTypeUPSTREAM, PackageType: artifact.PackageTypeGENERIC,
+	}
+	s := newTestService(virtual, empty, upstream)
+
+	outcomes := func(trace *Trace) []Outcome {
+		var result []Outcome
+		for _, step := range trace.Steps {
+			result = append(result, step.Outcome)
+		}
+		return result
+	}
+
+	trace, err := s.Resolve(context.Background(), &virtual, Coordinate{Artifact: "local-only", Version: "1.0"})
+	require.NoError(t, err)
+	assert.Equal(t, "virtual", trace.AnsweredBy)
+	assert.Equal(t, []Outcome{OutcomeLocalHit, OutcomeSkipped, OutcomeSkipped}, outcomes(trace))
+
+	trace, err = s.Resolve(context.Background(), &virtual, Coordinate{Artifact: "cached", Version: "1.0"})
+	require.NoError(t, err)
+	assert.Equal(t, "upstream", trace.AnsweredBy)
+	assert.Equal(t, []Outcome{OutcomeNotFound, OutcomeNotFound, OutcomeCacheHit}, outcomes(trace))
+
+	trace, err = s.Resolve(context.Background(), &virtual, Coordinate{Artifact: "missing", Version: "1.0"})
+	require.NoError(t, err)
+	assert.Empty(t, trace.AnsweredBy)
+	assert.Equal(t, []Outcome{OutcomeNotFound, OutcomeNotFound, OutcomeCacheMiss}, outcomes(trace))
+	assert.Equal(t, "https://upstream.example.com", trace.Steps[2].UpstreamURL)
+
+	trace, err = s.Resolve(context.Background(), &virtual,
+		Coordinate{Artifact: "blocked-image", Version: "1.0", File: "file.txt"})
+	require.NoError(t, err)
+	assert.Empty(t, trace.AnsweredBy)
+	assert.Equal(t, []Outcome{OutcomeBlocked, OutcomeSkipped, OutcomeSkipped}, outcomes(trace))
+
+	// package rules apply to the cache of an upstream registry
+	trace, err = s.Resolve(context.Background(), &virtual, Coordinate{Artifact: "cached", Version: "0.9.0"})
+	require.NoError(t, err)
+	assert.Empty(t, trace.AnsweredBy)
+	assert.Equal(t, []Outcome{OutcomeNotFound, OutcomeNotFound, OutcomeBlocked}, outcomes(trace))
+	assert.Equal(t, "blocked by package rules; excluded by rule 1", trace.Steps[2].Reason)
+
+	// generic handlers only match the patterns for file requests
+	trace, err = s.Resolve(context.Background(), &virtual, Coordinate{Artifact: "blocked-image", Version: "1.0"})
+	require.NoError(t, err)
+	assert.Equal(t, OutcomeNotFound, trace.Steps[0].Outcome)
+}
+
+func TestMavenFilePath(t *testing.T) {
+	assert.Equal(t, "org/example/app/1.0/app-1.0.pom",
+		mavenFilePath(Coordinate{Artifact: "org.example:app", Version: "1.0"}))
+	assert.Equal(t, "org/example/app/1.0/app-1.0.jar",
+		mavenFilePath(Coordinate{Artifact: "org.example:app", Version: "1.0", File: "app-1.0.jar"}))
+}
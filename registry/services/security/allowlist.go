@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/services/compliance"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// Allowlisted returns the active allowlist entries of the registry covering the artifact with the digest,
+// by the vulnerability they allowlist. An entry of a whole package covers the images of the package holding
+// the manifest with the digest, or the files of the package with the checksum digest.
+func (s *Service) Allowlisted(
+	ctx context.Context,
+	registryID int64,
+	dgst string,
+) (map[string]*types.VulnerabilityAllowlistEntry, error) {
+	entries, err := s.allowlistStore.ListActive(ctx, registryID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vulnerability allowlist: %w", err)
+	}
+
+	var holding func(imageName string) bool
+	allowlisted := make(map[string]*types.VulnerabilityAllowlistEntry)
+	for _, entry := range entries {
+		if entry.Digest == "" {
+			if holding == nil {
+				if holding, err = s.holding(ctx, registryID, dgst); err != nil {
+					return nil, err
+				}
+			}
+			if !holding(entry.ImageName) {
+				continue
+			}
+		} else if entry.Digest != dgst {
+			continue
+		}
+		if _, ok := allowlisted[entry.Vulnerability]; !ok || entry.Digest != "" {
+			allowlisted[entry.Vulnerability] = entry
+		}
+	}
+	return allowlisted, nil
+}
+
+// covering returns the vulnerabilities the entries allowlist for the version of the image with the digest.
+func covering(
+	entries []*types.VulnerabilityAllowlistEntry,
+	imageName string,
+	dgst string,
+) map[string]*types.VulnerabilityAllowlistEntry {
+	allowlisted := make(map[string]*types.VulnerabilityAllowlistEntry)
+	for _, entry := range entries {
+		if entry.Covers(imageName, dgst) {
+			allowlisted[entry.Vulnerability] = entry
+		}
+	}
+	return allowlisted
+}
+
+// holding returns whether an image of the registry holds the manifest with the digest, or for registries
+// of files whether a file of a package has the checksum digest.
+func (s *Service) holding(ctx context.Context, registryID int64, dgst string) (func(string) bool, error) {
+	none := func(string) bool { return false }
+	parsed, err := digest.Parse(dgst)
+	if err != nil {
+		return none, nil //nolint:nilerr // an invalid digest isn't held by any package
+	}
+	registry, err := s.registryDao.Get(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+
+	if registry.PackageType == artifact.PackageTypeDOCKER || registry.PackageType == artifact.PackageTypeHELM {
+		d, err := types.NewDigest(parsed)
+		if err != nil {
+			return nil, err
+		}
+		locations, err := s.manifestStore.ListLocationsByDigest(ctx, []int64{registryID}, d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list images: %w", err)
+		}
+		images := make(map[string]struct{}, len(locations))
+		for _, location := range locations {
+			images[location.ImageName] = struct{}{}
+		}
+		return func(imageName string) bool {
+			_, ok := images[imageName]
+			return ok
+		}, nil
+	}
+
+	if parsed.Algorithm() != digest.SHA256 {
+		return none, nil
+	}
+	files, err := s.nodesStore.ListFilesBySha256(ctx, []int64{registryID}, strings.ToLower(parsed.Encoded()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %w", err)
+	}
+	return func(imageName string) bool {
+		folder := compliance.VersionPath(registry.PackageType, imageName, "")
+		for _, file := range files {
+			if strings.HasPrefix(file.Path, folder) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
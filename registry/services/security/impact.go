@@ -0,0 +1,173 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/versioning"
+	"github.com/harness/gitness/registry/utils/vex"
+
+	"github.com/opencontainers/go-digest"
+)
+
+// ErrInvalidRange is returned for a range that isn't valid in the syntax of the scheme.
+var ErrInvalidRange = errors.New("invalid version range")
+
+// ImpactQuery identifies the vulnerable versions of a package.
+type ImpactQuery struct {
+	PackageName string
+	// Range is expressed in the native syntax of the scheme; an empty range matches every version.
+	Range  string
+	Scheme versioning.Scheme
+	// Vulnerability, if set, leaves out the artifacts whose active VEX statements suppress it.
+	Vulnerability string
+}
+
+// Impact finds the artifacts of the registries of a space that include a vulnerable version of a
+// package, according to the components and findings of their latest scans. For each of them it lists
+// where the artifact is held in the space, since the same digest is usually promoted across registries
+// standing for environments, and the virtual registries serving it.
+func (s *Service) Impact(ctx context.Context, spaceID int64, query ImpactQuery) ([]*types.PackageImpact, error) {
+	registries, err := s.registryDao.ListByParentID(ctx, spaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registries: %w", err)
+	}
+	registryIDs := make([]int64, 0, len(*registries))
+	packageTypes := make(map[int64]artifact.PackageType, len(*registries))
+	for _, registry := range *registries {
+		registryIDs = append(registryIDs, registry.ID)
+		packageTypes[registry.ID] = registry.PackageType
+	}
+
+	occurrences, err := s.scanStore.ListPackageOccurrences(ctx, registryIDs, query.PackageName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list package occurrences: %w", err)
+	}
+
+	var impacts []*types.PackageImpact
+	for _, occurrence := range occurrences {
+		// versions the scanner reported in another syntax can't be compared with the range
+		if !versioning.Valid(query.Scheme, occurrence.PackageVersion) {
+			continue
+		}
+		vulnerable, err := versioning.Satisfies(query.Scheme, occurrence.PackageVersion, query.Range)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidRange, err)
+		}
+		if !vulnerable {
+			continue
+		}
+		if query.Vulnerability != "" {
+			suppressed, err := s.suppresses(ctx, occurrence, query.Vulnerability)
+			if err != nil {
+				return nil, err
+			}
+			if suppressed {
+				continue
+			}
+		}
+
+		impact := &types.PackageImpact{
+			RegistryID:     occurrence.RegistryID,
+			Digest:         occurrence.Digest,
+			PackageName:    occurrence.PackageName,
+			PackageVersion: occurrence.PackageVersion,
+		}
+		if err = s.locate(ctx, impact, registryIDs, packageTypes[occurrence.RegistryID]); err != nil {
+			return nil, err
+		}
+		impact.VirtualRegistryIDs = servingVirtualRegistries(*registries, impact)
+		impacts = append(impacts, impact)
+	}
+	return impacts, nil
+}
+
+func (s *Service) suppresses(
+	ctx context.Context,
+	occurrence *types.PackageOccurrence,
+	vulnerability string,
+) (bool, error) {
+	statements, err := s.vexStore.ListStatements(ctx, occurrence.RegistryID, occurrence.Digest)
+	if err != nil {
+		return false, fmt.Errorf("failed to list VEX statements: %w", err)
+	}
+	_, ok := vex.Suppressed(vex.Active(statements))[vex.NormalizeVulnerability(vulnerability)]
+	return ok, nil
+}
+
+// locate finds the images holding a manifest digest or the files with a checksum digest.
+func (s *Service) locate(
+	ctx context.Context,
+	impact *types.PackageImpact,
+	registryIDs []int64,
+	packageType artifact.PackageType,
+) error {
+	dgst, err := digest.Parse(impact.Digest)
+	if err != nil {
+		return fmt.Errorf("invalid digest %q: %w", impact.Digest, err)
+	}
+	if packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM {
+		d, err := types.NewDigest(dgst)
+		if err != nil {
+			return err
+		}
+		impact.Images, err = s.manifestStore.ListLocationsByDigest(ctx, registryIDs, d)
+		if err != nil {
+			return fmt.Errorf("failed to list images: %w", err)
+		}
+		return nil
+	}
+	if dgst.Algorithm() != digest.SHA256 {
+		return nil
+	}
+	impact.Files, err = s.nodesStore.ListFilesBySha256(ctx, registryIDs, strings.ToLower(dgst.Encoded()))
+	if err != nil {
+		return fmt.Errorf("failed to list files: %w", err)
+	}
+	return nil
+}
+
+// servingVirtualRegistries returns the virtual registries having one of the registries holding the
+// artifact as upstream.
+func servingVirtualRegistries(registries []types.Registry, impact *types.PackageImpact) []int64 {
+	holding := map[int64]struct{}{impact.RegistryID: {}}
+	for _, image := range impact.Images {
+		holding[image.RegistryID] = struct{}{}
+	}
+	for _, file := range impact.Files {
+		holding[file.RegistryID] = struct{}{}
+	}
+
+	var ids []int64
+	for _, registry := range registries {
+		if registry.Type != artifact.RegistryTypeVIRTUAL {
+			continue
+		}
+		if slices.ContainsFunc(registry.UpstreamProxies, func(id int64) bool {
+			_, ok := holding[id]
+			return ok
+		}) {
+			ids = append(ids, registry.ID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	"github.com/harness/gitness/registry/utils/vex"
+)
+
+// Service aggregates the scan results, VEX statements and signatures reported for the
+// artifacts of a registry.
+type Service struct {
+	registryDao    store.RegistryRepository
+	manifestStore  store.ManifestRepository
+	nodesStore     store.NodesRepository
+	scanStore      store.ScanRepository
+	vexStore       store.VexRepository
+	allowlistStore store.VulnerabilityAllowlistRepository
+}
+
+func NewService(
+	registryDao store.RegistryRepository,
+	manifestStore store.ManifestRepository,
+	nodesStore store.NodesRepository,
+	scanStore store.ScanRepository,
+	vexStore store.VexRepository,
+	allowlistStore store.VulnerabilityAllowlistRepository,
+) *Service {
+	return &Service{
+		registryDao:    registryDao,
+		manifestStore:  manifestStore,
+		nodesStore:     nodesStore,
+		scanStore:      scanStore,
+		vexStore:       vexStore,
+		allowlistStore: allowlistStore,
+	}
+}
+
+// Posture computes the security posture of a registry over its image versions, the manifests
+// that aren't referrers of another manifest. The latest scan of each tool is taken into account
+// and critical findings suppressed by the active VEX statements of a version or allowlisted for it
+// until their expiry are ignored.
+func (s *Service) Posture(ctx context.Context, registryID int64) (*types.SecurityPosture, error) {
+	versions, err := s.manifestStore.ListSigningStates(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list versions: %w", err)
+	}
+	scans, err := s.scanStore.ListLatestByRegistry(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scans: %w", err)
+	}
+	statements, err := s.vexStore.ListStatementsByRegistry(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VEX statements: %w", err)
+	}
+	allowlist, err := s.allowlistStore.ListActive(ctx, registryID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vulnerability allowlist: %w", err)
+	}
+
+	scanIDs := make([]int64, 0, len(scans))
+	digestByScan := make(map[int64]string, len(scans))
+	scanned := make(map[string]struct{}, len(scans))
+	posture := &types.SecurityPosture{}
+	for _, scan := range scans {
+		scanIDs = append(scanIDs, scan.ID)
+		digestByScan[scan.ID] = scan.Digest
+		scanned[scan.Digest] = struct{}{}
+		if finishedAt := scan.FinishedAt.UnixMilli(); finishedAt > posture.LastScannedAt {
+			posture.LastScannedAt = finishedAt
+		}
+	}
+	critical, err := s.scanStore.ListFindingsBySeverity(ctx, scanIDs, types.ScanSeverityCritical)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list critical findings: %w", err)
+	}
+
+	suppressed := suppressedByDigest(statements)
+	criticalByDigest := make(map[string]map[string]struct{})
+	for _, finding := range critical {
+		dgst := digestByScan[finding.ScanID]
+		identifier := vex.NormalizeVulnerability(finding.Identifier)
+		if _, ok := suppressed[dgst][identifier]; ok {
+			posture.SuppressedCriticalFindings++
+			continue
+		}
+		if criticalByDigest[dgst] == nil {
+			criticalByDigest[dgst] = make(map[string]struct{})
+		}
+		criticalByDigest[dgst][identifier] = struct{}{}
+	}
+
+	criticalCVEs := make(map[string]struct{})
+	for _, version := range versions {
+		dgst := version.Digest.String()
+		posture.Versions++
+
+		if !version.Signed {
+			posture.UnsignedVersions++
+		}
+		if _, ok := scanned[dgst]; !ok {
+			posture.UnscannedVersions++
+		}
+		allowlisted := covering(allowlist, version.ImageName, dgst)
+		versionCritical := false
+		for identifier := range criticalByDigest[dgst] {
+			if _, ok := allowlisted[identifier]; ok {
+				posture.AllowlistedCriticalFindings++
+				continue
+			}
+			versionCritical = true
+			criticalCVEs[identifier] = struct{}{}
+		}
+		if versionCritical {
+			posture.CriticalVersions++
+		}
+	}
+	posture.CriticalCVEs = int64(len(criticalCVEs))
+	return posture, nil
+}
+
+// suppressedByDigest returns the vulnerabilities suppressed by the active VEX statements of each digest.
+func suppressedByDigest(statements []*types.VexStatement) map[string]map[string]*types.VexStatement {
+	byDigest := make(map[string][]*types.VexStatement)
+	for _, statement := range statements {
+		byDigest[statement.Digest] = append(byDigest[statement.Digest], statement)
+	}
+	suppressed := make(map[string]map[string]*types.VexStatement, len(byDigest))
+	for dgst, digestStatements := range byDigest {
+		suppressed[dgst] = vex.Suppressed(vex.Active(digestStatements))
+	}
+	return suppressed
+}
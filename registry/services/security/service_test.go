@@ -0,0 +1,146 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeManifestRepository struct {
+	store.ManifestRepository
+	versions []*types.ManifestSigningState
+}
+
+func (f *fakeManifestRepository) ListSigningStates(context.Context, int64) ([]*types.ManifestSigningState, error) {
+	return f.versions, nil
+}
+
+type fakeScanRepository struct {
+	store.ScanRepository
+	scans    []*types.Scan
+	findings []*types.ScanFinding
+}
+
+func (f *fakeScanRepository) ListLatestByRegistry(context.Context, int64) ([]*types.Scan, error) {
+	return f.scans, nil
+}
+
+func (f *fakeScanRepository) ListFindingsBySeverity(
+	_ context.Context, scanIDs []int64, severity types.ScanSeverity,
+) ([]*types.ScanFinding, error) {
+	ids := make(map[int64]bool, len(scanIDs))
+	for _, id := range scanIDs {
+		ids[id] = true
+	}
+	var findings []*types.ScanFinding
+	for _, finding := range f.findings {
+		if ids[finding.ScanID] && finding.Severity == severity {
+			findings = append(findings, finding)
+		}
+	}
+	return findings, nil
+}
+
+type fakeVexRepository struct {
+	store.VexRepository
+	statements []*types.VexStatement
+}
+
+func (f *fakeVexRepository) ListStatementsByRegistry(context.Context, int64) ([]*types.VexStatement, error) {
+	return f.statements, nil
+}
+
+type fakeAllowlistRepository struct {
+	store.VulnerabilityAllowlistRepository
+	entries []*types.VulnerabilityAllowlistEntry
+}
+
+func (f *fakeAllowlistRepository) ListActive(
+	context.Context, int64, time.Time,
+) ([]*types.VulnerabilityAllowlistEntry, error) {
+	return f.entries, nil
+}
+
+func TestPosture(t *testing.T) {
+	critical := digest.FromString("critical")
+	allowlisted := digest.FromString("allowlisted")
+	unscanned := digest.FromString("unscanned")
+	lib := digest.FromString("lib")
+	lastScannedAt := time.UnixMilli(1_700_000_100_000)
+
+	s := NewService(nil,
+		&fakeManifestRepository{versions: []*types.ManifestSigningState{
+			{ManifestID: 1, ImageName: "app", Digest: critical, Signed: true},
+			{ManifestID: 2, ImageName: "app", Digest: allowlisted},
+			{ManifestID: 3, ImageName: "app", Digest: unscanned, Signed: true},
+			{ManifestID: 4, ImageName: "lib", Digest: lib, Signed: true},
+		}},
+		nil,
+		&fakeScanRepository{
+			scans: []*types.Scan{
+				{ID: 10, Digest: critical.String(), FinishedAt: time.UnixMilli(1_700_000_000_000)},
+				{ID: 11, Digest: allowlisted.String(), FinishedAt: lastScannedAt},
+				{ID: 12, Digest: lib.String(), FinishedAt: time.UnixMilli(1_700_000_050_000)},
+			},
+			findings: []*types.ScanFinding{
+				{ScanID: 10, Identifier: "CVE-2024-0001", Severity: types.ScanSeverityCritical},
+				{ScanID: 10, Identifier: "CVE-2024-0002", Severity: types.ScanSeverityCritical},
+				{ScanID: 10, Identifier: "CVE-2024-0009", Severity: types.ScanSeverityHigh},
+				{ScanID: 11, Identifier: "CVE-2024-0001", Severity: types.ScanSeverityCritical},
+				{ScanID: 12, Identifier: "cve-2024-0003", Severity: types.ScanSeverityCritical},
+				{ScanID: 12, Identifier: "CVE-2024-0001", Severity: types.ScanSeverityCritical},
+			},
+		},
+		&fakeVexRepository{statements: []*types.VexStatement{
+			{Digest: critical.String(), Vulnerability: "CVE-2024-0002", Status: types.VexStatusNotAffected},
+			{Digest: lib.String(), Vulnerability: "CVE-2024-0003", Status: types.VexStatusAffected},
+		}},
+		&fakeAllowlistRepository{entries: []*types.VulnerabilityAllowlistEntry{
+			{ImageName: "app", Digest: allowlisted.String(), Vulnerability: "CVE-2024-0001"},
+			{ImageName: "lib", Vulnerability: "CVE-2024-0001"},
+		}},
+	)
+
+	posture, err := s.Posture(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, &types.SecurityPosture{
+		Versions:                    4,
+		UnsignedVersions:            1,
+		UnscannedVersions:           1,
+		CriticalVersions:            2,
+		CriticalCVEs:                2,
+		SuppressedCriticalFindings:  1,
+		AllowlistedCriticalFindings: 2,
+		LastScannedAt:               lastScannedAt.UnixMilli(),
+	}, posture)
+}
+
+func TestPostureWithoutVersions(t *testing.T) {
+	s := NewService(nil, &fakeManifestRepository{}, nil, &fakeScanRepository{}, &fakeVexRepository{},
+		&fakeAllowlistRepository{})
+
+	posture, err := s.Posture(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, &types.SecurityPosture{}, posture)
+}
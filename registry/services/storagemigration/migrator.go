@@ -0,0 +1,248 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagemigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/storage"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	batchSize = 500
+	// pausePollInterval is how often a paused migration checks whether its queue was resumed.
+	pausePollInterval = 10 * time.Second
+)
+
+type migrator struct {
+	registryDao      store.RegistryRepository
+	blobStore        store.BlobRepository
+	genericBlobStore store.GenericBlobRepository
+	queueStore       store.RegistryQueueRepository
+	spaceFinder      refcache.SpaceFinder
+	driver           storagedriver.StorageDriver
+}
+
+// Result summarizes a storage migration.
+type Result struct {
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Copied      int    `json:"copied"`
+	CopiedBytes int64  `json:"copied_bytes"`
+	Skipped     int    `json:"skipped"`
+	Missing     int    `json:"missing"`
+}
+
+// location is the storage root of a registry, OCI blobs are stored under the lower case root.
+type location struct {
+	oci     string
+	generic string
+}
+
+func newLocation(rootIdentifier string, prefix string) location {
+	if prefix != "" {
+		return location{oci: prefix, generic: prefix}
+	}
+	return location{oci: strings.ToLower(rootIdentifier), generic: rootIdentifier}
+}
+
+// Handle copies the blobs of the registry to the new storage prefix and switches the registry to it.
+// A second pass after the switch copies the blobs that were pushed while the first pass was running.
+func (m *migrator) Handle(ctx context.Context, data string, progress job.ProgressReporter) (string, error) {
+	var input Input
+	if err := json.Unmarshal([]byte(data), &input); err != nil {
+		return "", fmt.Errorf("failed to unmarshal storage migration input: %w", err)
+	}
+
+	registry, err := m.registryDao.Get(ctx, input.RegistryID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find registry %d: %w", input.RegistryID, err)
+	}
+	root, err := m.spaceFinder.FindByID(ctx, registry.RootParentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find root space %d: %w", registry.RootParentID, err)
+	}
+
+	from := newLocation(root.Identifier, registry.StoragePrefix)
+	to := newLocation(root.Identifier, input.Prefix)
+	result := &Result{From: from.oci, To: to.oci}
+
+	if from != to {
+		if err = m.copyRegistryBlobs(ctx, registry.ID, from, to, result); err != nil {
+			return "", err
+		}
+		_ = progress(50, "")
+	}
+
+	if err = m.registryDao.UpdateStoragePrefix(ctx, registry.ID, input.Prefix); err != nil {
+		return "", fmt.Errorf("failed to update storage prefix of registry %d: %w", registry.ID, err)
+	}
+
+	if from != to {
+		if err = m.copyRegistryBlobs(ctx, registry.ID, from, to, result); err != nil {
+			return "", err
+		}
+	}
+
+	log.Ctx(ctx).Info().Msgf("migrated storage of registry %d from %q to %q: copied %d blobs (%d bytes), "+
+		"skipped %d, missing %d", registry.ID, result.From, result.To, result.Copied, result.CopiedBytes,
+		result.Skipped, result.Missing)
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal storage migration result: %w", err)
+	}
+	return string(output), nil
+}
+
+func (m *migrator) copyRegistryBlobs(
+	ctx context.Context,
+	registryID int64,
+	from location,
+	to location,
+	result *Result,
+) error {
+	var afterID int64
+	for {
+		if err := m.waitWhilePaused(ctx, registryID); err != nil {
+			return err
+		}
+		blobs, err := m.blobStore.ListByRegistryID(ctx, registryID, afterID, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list blobs of registry %d: %w", registryID, err)
+		}
+		for _, blob := range blobs {
+			afterID = blob.ID
+			src, err := storage.PathFn(from.oci, blob.Digest)
+			if err != nil {
+				return fmt.Errorf("failed to get path of blob %d: %w", blob.ID, err)
+			}
+			dst, err := storage.PathFn(to.oci, blob.Digest)
+			if err != nil {
+				return fmt.Errorf("failed to get path of blob %d: %w", blob.ID, err)
+			}
+			if err = m.copyFile(ctx, src, dst, blob.Size, result); err != nil {
+				return err
+			}
+		}
+		if len(blobs) < batchSize {
+			break
+		}
+	}
+
+	afterGenericID := ""
+	for {
+		if err := m.waitWhilePaused(ctx, registryID); err != nil {
+			return err
+		}
+		blobs, err := m.genericBlobStore.ListByRegistryID(ctx, registryID, afterGenericID, batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list generic blobs of registry %d: %w", registryID, err)
+		}
+		for _, blob := range blobs {
+			afterGenericID = blob.ID
+			src := genericBlobPath(from.generic, blob.Sha256)
+			dst := genericBlobPath(to.generic, blob.Sha256)
+			if err = m.copyFile(ctx, src, dst, blob.Size, result); err != nil {
+				return err
+			}
+		}
+		if len(blobs) < batchSize {
+			return nil
+		}
+	}
+}
+
+// waitWhilePaused blocks between batches while the storage migration queue of the registry is paused.
+// The time spent paused counts towards the job timeout.
+func (m *migrator) waitWhilePaused(ctx context.Context, registryID int64) error {
+	for {
+		paused, err := m.queueStore.IsPaused(ctx, registryID, types.QueueStorageMigration)
+		if err != nil {
+			return fmt.Errorf("failed to check if storage migration of registry %d is paused: %w", registryID, err)
+		}
+		if !paused {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pausePollInterval):
+		}
+	}
+}
+
+// copyFile copies the data at src to dst, unless dst already holds data of the expected size.
+// Missing source data is counted but doesn't fail the migration.
+func (m *migrator) copyFile(ctx context.Context, src string, dst string, size int64, result *Result) error {
+	info, err := m.driver.Stat(ctx, dst)
+	if err == nil && info.Size() == size {
+		result.Skipped++
+		return nil
+	}
+	if err != nil && !errors.As(err, &storagedriver.PathNotFoundError{}) {
+		return fmt.Errorf("failed to stat %s: %w", dst, err)
+	}
+
+	reader, err := m.driver.Reader(ctx, src, 0)
+	if errors.As(err, &storagedriver.PathNotFoundError{}) {
+		log.Ctx(ctx).Warn().Msgf("storage migration: blob data missing at %s", src)
+		result.Missing++
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer reader.Close()
+
+	writer, err := m.driver.Writer(ctx, dst, false)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	written, err := io.Copy(writer, reader)
+	if err != nil {
+		_ = writer.Cancel(ctx)
+		return fmt.Errorf("failed to copy %s to %s: %w", src, dst, err)
+	}
+	if err = writer.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit %s: %w", dst, err)
+	}
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", dst, err)
+	}
+
+	result.Copied++
+	result.CopiedBytes += written
+	return nil
+}
+
+// genericBlobPath returns the storage path of a generic blob, matching the path used by the file manager.
+func genericBlobPath(root string, sha256 string) string {
+	return path.Join("/", root, "files", sha256)
+}
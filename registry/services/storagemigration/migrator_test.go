@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagemigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/registry/app/driver/filesystem"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyFile(t *testing.T) {
+	ctx := context.Background()
+	driver := filesystem.New(filesystem.DriverParameters{RootDirectory: t.TempDir(), MaxThreads: 1})
+	m := &migrator{driver: driver}
+
+	src := genericBlobPath("root", "abc")
+	dst := genericBlobPath("teams/payments", "abc")
+	require.NoError(t, driver.PutContent(ctx, src, []byte("content")))
+
+	result := &Result{}
+	require.NoError(t, m.copyFile(ctx, src, dst, 7, result))
+	content, err := driver.GetContent(ctx, dst)
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	// the second pass skips what was already copied and the source data is retained
+	require.NoError(t, m.copyFile(ctx, src, dst, 7, result))
+	_, err = driver.Stat(ctx, src)
+	require.NoError(t, err)
+
+	require.NoError(t, m.copyFile(ctx, genericBlobPath("root", "missing"), genericBlobPath("other", "missing"), 7,
+		result))
+
+	assert.Equal(t, Result{Copied: 1, CopiedBytes: 7, Skipped: 1, Missing: 1}, *result)
+}
+
+func TestNewLocation(t *testing.T) {
+	assert.Equal(t, location{oci: "acme", generic: "Acme"}, newLocation("Acme", ""))
+	assert.Equal(t, location{oci: "teams/payments", generic: "teams/payments"}, newLocation("Acme", "teams/payments"))
+}
@@ -0,0 +1,157 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagemigration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/app/services/refcache"
+	"github.com/harness/gitness/job"
+	storagedriver "github.com/harness/gitness/registry/app/driver"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+	gitnessstore "github.com/harness/gitness/store"
+)
+
+const (
+	jobType        = "gitness:registry:storage-prefix-migration"
+	jobMaxDuration = 12 * time.Hour
+	jobMaxRetries  = 3
+)
+
+// ErrMigrationInProgress is returned when a storage migration of the registry is already running.
+var ErrMigrationInProgress = errors.New("a storage migration of the registry is already in progress")
+
+// Input is the data of a storage migration job.
+type Input struct {
+	RegistryID int64  `json:"registry_id"`
+	Prefix     string `json:"prefix"`
+}
+
+// Service moves the blobs of a registry to a new storage prefix in the background.
+type Service struct {
+	scheduler        *job.Scheduler
+	executor         *job.Executor
+	registryDao      store.RegistryRepository
+	blobStore        store.BlobRepository
+	genericBlobStore store.GenericBlobRepository
+	queueStore       store.RegistryQueueRepository
+	spaceFinder      refcache.SpaceFinder
+	driver           storagedriver.StorageDriver
+}
+
+func NewService(
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	registryDao store.RegistryRepository,
+	blobStore store.BlobRepository,
+	genericBlobStore store.GenericBlobRepository,
+	queueStore store.RegistryQueueRepository,
+	spaceFinder refcache.SpaceFinder,
+	driver storagedriver.StorageDriver,
+) *Service {
+	return &Service{
+		scheduler:        scheduler,
+		executor:         executor,
+		registryDao:      registryDao,
+		blobStore:        blobStore,
+		genericBlobStore: genericBlobStore,
+		queueStore:       queueStore,
+		spaceFinder:      spaceFinder,
+		driver:           driver,
+	}
+}
+
+func (s *Service) Register(_ context.Context) error {
+	if err := s.executor.Register(jobType, s.newMigrator()); err != nil {
+		return fmt.Errorf("failed to register job handler for registry storage migration: %w", err)
+	}
+	return nil
+}
+
+// Migrate schedules the migration of the registry's blobs to the storage prefix.
+// The registry is switched to the new prefix once all of its blobs have been copied,
+// the data under the old prefix is retained as it can be shared with other registries.
+func (s *Service) Migrate(ctx context.Context, registry *types.Registry, prefix string) error {
+	uid := JobUID(registry.ID)
+
+	progress, err := s.scheduler.GetJobProgress(ctx, uid)
+	switch {
+	case errors.Is(err, gitnessstore.ErrResourceNotFound):
+	case err != nil:
+		return fmt.Errorf("failed to get progress of storage migration: %w", err)
+	case !progress.State.IsCompleted():
+		return ErrMigrationInProgress
+	default:
+		if err = s.scheduler.PurgeJobByUID(ctx, uid); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(Input{RegistryID: registry.ID, Prefix: prefix})
+	if err != nil {
+		return fmt.Errorf("failed to marshal storage migration input: %w", err)
+	}
+
+	return s.scheduler.RunJob(ctx, job.Definition{
+		UID:        uid,
+		Type:       jobType,
+		MaxRetries: jobMaxRetries,
+		Timeout:    jobMaxDuration,
+		Data:       string(data),
+	})
+}
+
+// QueueStats returns the backlog of the storage migration queue of the registry.
+func (s *Service) QueueStats(ctx context.Context, registryID int64) (*types.QueueStats, error) {
+	progress, err := s.scheduler.GetJobProgress(ctx, JobUID(registryID))
+	if errors.Is(err, gitnessstore.ErrResourceNotFound) {
+		return &types.QueueStats{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get progress of storage migration: %w", err)
+	}
+
+	stats := &types.QueueStats{}
+	switch progress.State {
+	case job.JobStateScheduled:
+		stats.Pending = 1
+	case job.JobStateRunning:
+		stats.Running = 1
+	case job.JobStateFinished, job.JobStateFailed, job.JobStateCanceled:
+	}
+	return stats, nil
+}
+
+// JobUID returns the uid of the storage migration job of the registry.
+func JobUID(registryID int64) string {
+	return jobType + ":" + strconv.FormatInt(registryID, 10)
+}
+
+func (s *Service) newMigrator() *migrator {
+	return &migrator{
+		registryDao:      s.registryDao,
+		blobStore:        s.blobStore,
+		genericBlobStore: s.genericBlobStore,
+		queueStore:       s.queueStore,
+		spaceFinder:      s.spaceFinder,
+		driver:           s.driver,
+	}
+}
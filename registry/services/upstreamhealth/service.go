@@ -0,0 +1,212 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstreamhealth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/app/remote/controller/proxy"
+	"github.com/harness/gitness/registry/app/store"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType        = "gitness:registry:upstream-health"
+	jobMaxDuration = 10 * time.Minute
+)
+
+type Config struct {
+	Enabled bool
+	Cron    string
+	// Timeout bounds the check of a URL.
+	Timeout time.Duration
+	// FailureThreshold is the number of consecutive failed checks after which a URL is unhealthy.
+	FailureThreshold int
+}
+
+// Service periodically checks the URLs of the upstream proxies that have URLs to fail over to, which
+// fail over to the first URL that isn't unhealthy.
+type Service struct {
+	config          Config
+	scheduler       *job.Scheduler
+	executor        *job.Executor
+	registryDao     store.RegistryRepository
+	upstreamProxies store.UpstreamProxyConfigRepository
+	urlStore        store.UpstreamURLRepository
+	client          *http.Client
+}
+
+func NewService(
+	config Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	registryDao store.RegistryRepository,
+	upstreamProxies store.UpstreamProxyConfigRepository,
+	urlStore store.UpstreamURLRepository,
+) *Service {
+	return &Service{
+		config:          config,
+		scheduler:       scheduler,
+		executor:        executor,
+		registryDao:     registryDao,
+		upstreamProxies: upstreamProxies,
+		urlStore:        urlStore,
+		client:          &http.Client{Timeout: config.Timeout},
+	}
+}
+
+func (s *Service) Register(ctx context.Context) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	if err := s.executor.Register(jobType, s); err != nil {
+		return fmt.Errorf("failed to register job handler for upstream health checks: %w", err)
+	}
+
+	if err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.config.Cron, jobMaxDuration); err != nil {
+		return fmt.Errorf("failed to schedule upstream health check job: %w", err)
+	}
+	return nil
+}
+
+// Result is the number of URLs that were checked and of those found unhealthy.
+type Result struct {
+	Checked   int `json:"checked"`
+	Unhealthy int `json:"unhealthy"`
+}
+
+// Handle checks the URLs of the upstream proxies with URLs to fail over to.
+func (s *Service) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	registryIDs, err := s.urlStore.ListRegistryIDs(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list upstream proxies with failover urls: %w", err)
+	}
+
+	var result Result
+	for _, registryID := range registryIDs {
+		health, err := s.CheckRegistry(ctx, registryID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to check upstream health of registry %d", registryID)
+			continue
+		}
+		for _, h := range health {
+			result.Checked++
+			if !h.Healthy {
+				result.Unhealthy++
+			}
+		}
+	}
+
+	output, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal upstream health check result: %w", err)
+	}
+	return string(output), nil
+}
+
+// CheckRegistry checks the URLs of an upstream proxy and saves their health.
+func (s *Service) CheckRegistry(ctx context.Context, registryID int64) ([]*types.UpstreamHealth, error) {
+	registry, err := s.registryDao.Get(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry: %w", err)
+	}
+	upstreamProxy, err := s.upstreamProxies.GetByRegistryIdentifier(ctx, registry.ParentID, registry.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream proxy: %w", err)
+	}
+	if upstreamProxy.Offline {
+		return nil, nil
+	}
+	urls, err := proxy.UpstreamURLs(ctx, s.urlStore, *upstreamProxy)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.urlStore.ListHealth(ctx, registryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upstream health: %w", err)
+	}
+	previousByURL := make(map[string]*types.UpstreamHealth, len(previous))
+	for _, h := range previous {
+		previousByURL[h.URL] = h
+	}
+
+	health := make([]*types.UpstreamHealth, 0, len(urls))
+	for _, u := range urls {
+		start := time.Now()
+		checkErr := s.probe(ctx, registry.PackageType, u)
+		h := NextHealth(previousByURL[u], checkErr, s.config.FailureThreshold)
+		h.RegistryID = registryID
+		h.URL = u
+		h.Latency = time.Since(start)
+		h.CheckedAt = time.Now()
+		if err = s.urlStore.UpsertHealth(ctx, h); err != nil {
+			return nil, fmt.Errorf("failed to save health of upstream %s: %w", u, err)
+		}
+		health = append(health, h)
+	}
+	return health, nil
+}
+
+// NextHealth returns the health of a URL after a check, given its health before. A URL turns unhealthy
+// once it failed threshold consecutive checks, and healthy again with the first check it passes.
+func NextHealth(previous *types.UpstreamHealth, checkErr error, threshold int) *types.UpstreamHealth {
+	if checkErr == nil {
+		return &types.UpstreamHealth{Healthy: true}
+	}
+	failures := 1
+	if previous != nil {
+		failures = previous.ConsecutiveFailures + 1
+	}
+	return &types.UpstreamHealth{
+		Healthy:             failures < max(threshold, 1),
+		ConsecutiveFailures: failures,
+		LastError:           checkErr.Error(),
+	}
+}
+
+// probe requests the URL of an upstream. Any response other than a server error passes, upstreams
+// commonly answer anonymous requests to their root with 401 or 404.
+func (s *Service) probe(ctx context.Context, packageType artifact.PackageType, upstreamURL string) error {
+	target := strings.TrimSuffix(upstreamURL, "/")
+	if packageType == artifact.PackageTypeDOCKER || packageType == artifact.PackageTypeHELM {
+		target += "/v2/"
+	} else {
+		target += "/"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("upstream responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstreamhealth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextHealth(t *testing.T) {
+	checkErr := errors.New("connection refused")
+
+	h := NextHealth(nil, checkErr, 3)
+	assert.True(t, h.Healthy, "a url stays healthy until it reaches the failure threshold")
+	assert.Equal(t, 1, h.ConsecutiveFailures)
+
+	h = NextHealth(h, checkErr, 3)
+	h = NextHealth(h, checkErr, 3)
+	assert.False(t, h.Healthy)
+	assert.Equal(t, 3, h.ConsecutiveFailures)
+	assert.Equal(t, "connection refused", h.LastError)
+
+	h = NextHealth(h, nil, 3)
+	assert.True(t, h.Healthy, "a url is healthy again with the first check it passes")
+	assert.Zero(t, h.ConsecutiveFailures)
+}
+
+func TestProbe(t *testing.T) {
+	var paths []string
+	status := http.StatusUnauthorized
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	s := NewService(Config{Timeout: time.Second}, nil, nil, nil, nil, nil)
+	ctx := context.Background()
+
+	assert.NoError(t, s.probe(ctx, artifact.PackageTypeDOCKER, server.URL+"/"))
+	assert.NoError(t, s.probe(ctx, artifact.PackageTypeNPM, server.URL))
+	assert.Equal(t, []string{"/v2/", "/"}, paths)
+
+	status = http.StatusBadGateway
+	assert.Error(t, s.probe(ctx, artifact.PackageTypeNPM, server.URL))
+}
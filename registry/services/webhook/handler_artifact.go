@@ -34,6 +34,7 @@ type ArtifactEventPayload struct {
 	Principal          gitnesswebhook.PrincipalInfo       `json:"principal"`
 	ArtifactInfo       *registryevents.ArtifactInfo       `json:"artifact_info"`
 	ArtifactChangeInfo *registryevents.ArtifactChangeInfo `json:"artifact_change_info"`
+	BaseImage          *registryevents.BaseImageChange    `json:"base_image,omitempty"`
 }
 
 type RegistryInfo struct {
@@ -158,6 +159,45 @@ func (s *Service) handleEventArtifactDeleted(
 		})
 }
 
+// handleEventBaseImageUpdated handles base image updated events
+// and triggers base image updated webhooks for the registry of the dependent artifact.
+func (s *Service) handleEventBaseImageUpdated(
+	ctx context.Context,
+	event *events.Event[*registryevents.BaseImageUpdatedPayload],
+) error {
+	return s.triggerForEventWithArtifact(ctx, enum.WebhookTriggerArtifactBaseImageUpdated,
+		event.ID, event.Payload.PrincipalID, event.Payload.RegistryID,
+		func(
+			principal *types.Principal,
+			registry *registrytypes.Registry,
+		) (any, error) {
+			space, err := s.spaceStore.Find(ctx, registry.ParentID)
+			if err != nil {
+				return nil, err
+			}
+			return &ArtifactEventPayload{
+				Trigger: enum.WebhookTriggerArtifactBaseImageUpdated,
+				Registry: RegistryInfo{
+					ID:          registry.ID,
+					Name:        registry.Name,
+					Description: registry.Description,
+					URL:         s.urlProvider.GenerateUIRegistryURL(ctx, space.Path, registry.Name),
+				},
+				Principal: gitnesswebhook.PrincipalInfo{
+					ID:          principal.ID,
+					UID:         principal.UID,
+					DisplayName: principal.DisplayName,
+					Email:       principal.Email,
+					Type:        principal.Type,
+					Created:     principal.Created,
+					Updated:     principal.Updated,
+				},
+				ArtifactInfo: getArtifactInfo(event.Payload.Artifact),
+				BaseImage:    &event.Payload.BaseImage,
+			}, nil
+		})
+}
+
 func getArtifactInfo(eventArtifact registryevents.Artifact) *registryevents.ArtifactInfo {
 	artifactInfo := registryevents.ArtifactInfo{}
 	if dockerArtifact, ok := eventArtifact.(*registryevents.DockerArtifact); ok {
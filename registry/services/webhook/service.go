@@ -98,6 +98,7 @@ func NewService(
 			_ = r.RegisterArtifactCreated(service.handleEventArtifactCreated)
 			_ = r.RegisterArtifactUpdated(service.handleEventArtifactUpdated)
 			_ = r.RegisterArtifactDeleted(service.handleEventArtifactDeleted)
+			_ = r.RegisterBaseImageUpdated(service.handleEventBaseImageUpdated)
 
 			return nil
 		})
@@ -0,0 +1,41 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"slices"
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+// AccessGrant gives a principal temporary permissions on a registry, on top of the access it has
+// through its space memberships, until it expires or is revoked.
+type AccessGrant struct {
+	ID           int64
+	RegistryID   int64
+	PrincipalID  int64
+	PrincipalUID string
+	Permissions  []enum.Permission
+	Reason       string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+	CreatedBy    int64
+}
+
+// Allows reports whether the grant includes the permission.
+func (g *AccessGrant) Allows(permission enum.Permission) bool {
+	return slices.Contains(g.Permissions, permission)
+}
@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// Archive is an archive of a registry whose file listing is indexed, either a file of a package
+// or a layer of an image.
+type Archive struct {
+	ID         int64
+	RegistryID int64
+	// NodeID is the file node of a package archive.
+	NodeID string
+	// LayerID is the image layer, archives are bound to exactly one of NodeID and LayerID.
+	LayerID int64
+	// Digest identifies the content, archives with the same digest share their file listing.
+	Digest    string
+	ImageName string
+	Version   string
+	// Name is the file name of the archive or the digest of the layer.
+	Name       string
+	Format     string
+	EntryCount int64
+	// Error is why the archive couldn't be indexed, the entries are incomplete if set.
+	Error     string
+	IndexedAt time.Time
+}
+
+// ArchiveEntry is a file inside an archive.
+type ArchiveEntry struct {
+	Path string
+	Size int64
+}
+
+// ArchiveClass is a Java class compiled into an archive, like the classes of a jar.
+type ArchiveClass struct {
+	// Name is the binary name of the class, like "org.example.Outer$Inner".
+	Name string
+	// SimpleName is the lowercase name of the class without its package and enclosing classes.
+	SimpleName string
+}
+
+// PendingArchive is an archive of a registry that is waiting to be indexed.
+type PendingArchive struct {
+	RegistryID int64
+	NodeID     string
+	LayerID    int64
+	// Path is the path of the file node, empty for layers.
+	Path      string
+	Name      string
+	ImageName string
+	// Version is the manifest digest of layers, it's derived from Path for files.
+	Version   string
+	Digest    string
+	MediaType string
+	Size      int64
+}
+
+// ArchiveEntryMatch is an entry of an indexed archive matching a content search.
+type ArchiveEntryMatch struct {
+	RegistryID  int64
+	ImageName   string
+	Version     string
+	ArchiveName string
+	Path        string
+	Size        int64
+}
+
+// ArchiveClassMatch is a class of an indexed archive matching a class search.
+type ArchiveClassMatch struct {
+	RegistryID  int64
+	ImageName   string
+	Version     string
+	ArchiveName string
+	ClassName   string
+}
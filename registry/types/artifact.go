@@ -40,4 +40,6 @@ type NonOCIArtifactMetadata struct {
 	FileCount     int64
 	ModifiedAt    time.Time
 	DownloadCount int64
+	// UniqueDownloadCount counts the downloads deduplicated by client, see DownloadStat.
+	UniqueDownloadCount int64
 }
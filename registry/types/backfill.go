@@ -0,0 +1,36 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// Backfill is the progress of an online backfill, which fills in a column added by an expand
+// migration in batches while the registries stay online. Until it completes the readers of the
+// column fall back to the data it's derived from.
+type Backfill struct {
+	Name string
+	// Cursor is the ID of the last row filled in, the next batch starts after it.
+	Cursor    int64
+	Rows      int64
+	StartedAt time.Time
+	UpdatedAt time.Time
+	// CompletedAt is zero while rows are left to fill in.
+	CompletedAt time.Time
+}
+
+// Completed returns whether all rows have been filled in.
+func (b *Backfill) Completed() bool {
+	return !b.CompletedAt.IsZero()
+}
@@ -0,0 +1,38 @@
+//  Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// BaseImage records the image a manifest was built from, as declared by the
+// org.opencontainers.image.base.* annotations of the manifest.
+type BaseImage struct {
+	ID             int64
+	RegistryID     int64
+	ManifestID     int64
+	ImageName      string
+	BaseRepository string
+	BaseTag        string
+	BaseDigest     string
+	CreatedAt      time.Time
+}
+
+// ImageDependent is a tag whose manifest was built from a given base image.
+type ImageDependent struct {
+	RegistryID int64
+	ImageName  string
+	Tag        string
+	BaseDigest string
+}
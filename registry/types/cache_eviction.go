@@ -0,0 +1,47 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// CacheEvictionPolicy limits the size of the cache of an upstream proxy. Once the cache grows past
+// MaxSizeBytes, the least recently pulled cached artifacts are evicted until it fits again.
+type CacheEvictionPolicy struct {
+	ID           int64
+	RegistryID   int64
+	MaxSizeBytes int64
+	// LastRunAt is the time the policy was last enforced, zero if it wasn't yet. LastEvicted and
+	// LastFreedBytes are the number of artifacts evicted then and their size.
+	LastRunAt      time.Time
+	LastEvicted    int
+	LastFreedBytes int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CreatedBy      int64
+	UpdatedBy      int64
+}
+
+// CachedArtifact is an artifact cached by an upstream proxy, the manifest of an image or the files of a
+// version of other packages.
+type CachedArtifact struct {
+	// ManifestID is the manifest of an image, ArtifactID the version of other packages.
+	ManifestID int64
+	ArtifactID int64
+	ImageName  string
+	// Version is the digest of an image manifest.
+	Version      string
+	Size         int64
+	LastPulledAt time.Time
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// CachePrewarmStatus is the state of an artifact requested to be fetched into the cache of an upstream proxy.
+type CachePrewarmStatus string
+
+const (
+	CachePrewarmStatusPending CachePrewarmStatus = "PENDING"
+	CachePrewarmStatusCached  CachePrewarmStatus = "CACHED"
+	CachePrewarmStatusFailed  CachePrewarmStatus = "FAILED"
+)
+
+// CachePrewarmArtifact is an artifact requested to be fetched into the cache of an upstream proxy ahead of
+// its first pull. The artifacts requested together share a Batch, which is fetched by a single job.
+type CachePrewarmArtifact struct {
+	ID         int64
+	RegistryID int64
+	Batch      string
+	// Coordinate identifies the artifact in the format of the package type of the registry, like
+	// image:tag, group:artifact:version or name@version.
+	Coordinate string
+	Status     CachePrewarmStatus
+	// Error is the reason a failed artifact couldn't be fetched.
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	CreatedBy int64
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+// RegistryRole is a set of registry permissions that can be assigned through claims mappings.
+type RegistryRole string
+
+const (
+	RegistryRoleReader      RegistryRole = "READER"
+	RegistryRoleContributor RegistryRole = "CONTRIBUTOR"
+	RegistryRoleMaintainer  RegistryRole = "MAINTAINER"
+)
+
+// Permissions returns the permissions of the role, nil for an unknown role.
+func (r RegistryRole) Permissions() []enum.Permission {
+	switch r {
+	case RegistryRoleReader:
+		return []enum.Permission{enum.PermissionRegistryView, enum.PermissionArtifactsDownload}
+	case RegistryRoleContributor:
+		return []enum.Permission{enum.PermissionRegistryView, enum.PermissionArtifactsDownload,
+			enum.PermissionArtifactsUpload}
+	case RegistryRoleMaintainer:
+		return []enum.Permission{enum.PermissionRegistryView, enum.PermissionArtifactsDownload,
+			enum.PermissionArtifactsUpload, enum.PermissionArtifactsDelete}
+	default:
+		return nil
+	}
+}
+
+// ClaimMapping assigns a registry role to the holders of external identities whose claim matches
+// the value. The role applies to the named registry of the space, or to all its registries when
+// RegistryName is empty.
+type ClaimMapping struct {
+	ID           int64
+	SpaceID      int64
+	RegistryName string
+	Claim        string
+	// Value is matched against the claim, or each of its values for list claims like groups; it
+	// may contain shell glob patterns like "platform-*".
+	Value     string
+	Role      RegistryRole
+	CreatedAt time.Time
+	CreatedBy int64
+}
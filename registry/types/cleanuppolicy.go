@@ -17,21 +17,24 @@ package types
 import (
 	"time"
 
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
 	"github.com/harness/gitness/registry/types/enum"
 )
 
 // CleanupPolicy DTO object.
 type CleanupPolicy struct {
-	ID            int64
-	RegistryID    int64
-	Name          string
-	VersionPrefix []string
-	PackagePrefix []string
-	ExpiryTime    int64
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	CreatedBy     int64
-	UpdatedBy     int64
+	ID             int64
+	RegistryID     int64
+	Name           string
+	Type           artifact.CleanupPolicyType
+	VersionPrefix  []string
+	PackagePrefix  []string
+	ExcludePattern []string
+	ExpiryTime     int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CreatedBy      int64
+	UpdatedBy      int64
 }
 
 // CleanupPolicyPrefix DTO object.
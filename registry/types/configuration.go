@@ -24,6 +24,6 @@ type Configuration struct {
 	// For operational safety reasons,
 	// a payload is only saved in this attribute if its size
 	// does not exceed a predefined
-	// limit (see handlers.dbConfigSizeLimit).
+	// limit (see docker.dbConfigSizeLimit).
 	Payload Payload
 }
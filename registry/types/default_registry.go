@@ -0,0 +1,35 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// DefaultRegistry is the registry of a space that pipelines publish packages of the package type
+// to unless they name a registry. It applies to the subspaces of the space that have no default
+// registry of their own for the package type.
+type DefaultRegistry struct {
+	ID          int64
+	SpaceID     int64
+	PackageType artifact.PackageType
+	RegistryID  int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CreatedBy   int64
+	UpdatedBy   int64
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// BlobDisposition is what a hard delete did with a blob of the deleted version.
+type BlobDisposition string
+
+const (
+	// BlobDispositionPurged blobs were deleted from the storage.
+	BlobDispositionPurged BlobDisposition = "PURGED"
+	// BlobDispositionShared blobs are kept, other versions still use them.
+	BlobDispositionShared BlobDisposition = "SHARED"
+	// BlobDispositionQueuedForGC blobs are deleted by the garbage collection, unless other images still
+	// use them.
+	BlobDispositionQueuedForGC BlobDisposition = "QUEUED_FOR_GC"
+)
+
+// DeletedBlob is a blob of a version that was hard deleted.
+type DeletedBlob struct {
+	Digest      string          `json:"digest"`
+	Size        int64           `json:"size"`
+	Disposition BlobDisposition `json:"disposition"`
+}
+
+// DeletionCertificate records the hard delete of an artifact version. Certificates are kept after the
+// registry is deleted, the registry name and package type are recorded for this reason.
+type DeletionCertificate struct {
+	ID           int64
+	RegistryID   int64
+	RegistryName string
+	PackageType  string
+	ImageName    string
+	Version      string
+	Reason       string
+	// DeletedItems are the paths of the deleted files, or the digest and tags of the deleted manifest.
+	DeletedItems    []string
+	Blobs           []DeletedBlob
+	DownloadRecords int64
+	DeletedAt       time.Time
+	DeletedBy       int64
+	// Checksum is the SHA-256 of the contents of the certificate, see the compliance package.
+	Checksum string
+}
@@ -27,4 +27,32 @@ type DownloadStat struct {
 	UpdatedAt  time.Time
 	CreatedBy  int64
 	UpdatedBy  int64
+	// Client identifies who downloaded the artifact: the principal, or the remote IP of anonymous
+	// downloads. Continuation marks requests which resume a download by a range.
+	Client       string
+	Continuation bool
+	// Unique is set on creation, it's false for continuations and for downloads by a client which
+	// downloaded the artifact within the deduplication window before.
+	Unique bool
+	// ClientType is the kind of client parsed from the User-Agent, see useragent.ClientType.
+	ClientType string
+	// Network and Region are where the artifact was downloaded from, see the origin package. They're
+	// only stored if recording the origin is enabled.
+	Network string
+	Region  string
+}
+
+// ClientTypeDownloadCount is the number of downloads of an artifact by a kind of client.
+type ClientTypeDownloadCount struct {
+	ClientType          string
+	DownloadCount       int64
+	UniqueDownloadCount int64
+}
+
+// OriginDownloadCount is the number of downloads of the artifacts of a registry from a network.
+type OriginDownloadCount struct {
+	Region              string
+	Network             string
+	DownloadCount       int64
+	UniqueDownloadCount int64
 }
@@ -19,4 +19,5 @@ type PrefixType string
 const (
 	PrefixTypeVersion PrefixType = "version"
 	PrefixTypePackage PrefixType = "package"
+	PrefixTypeExclude PrefixType = "exclude"
 )
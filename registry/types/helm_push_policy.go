@@ -0,0 +1,38 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// HelmPushPolicy is the set of checks the charts pushed to a Helm registry are linted against. Charts that
+// violate it are rejected when the policy is enforced, and accepted with a warning otherwise.
+type HelmPushPolicy struct {
+	ID         int64
+	RegistryID int64
+	// RequiredValues are the dotted paths of the keys the values.yaml of a chart must set, e.g.
+	// resources.limits.memory.
+	RequiredValues []string
+	// DisallowedRegistries are the hosts the images a chart deploys must not be pulled from, e.g. docker.io.
+	DisallowedRegistries []string
+	// CheckAPIVersions reports the Kubernetes API versions of the chart templates that are deprecated or
+	// removed. They only block pushes once removed in KubeVersion, or in any Kubernetes version if unset.
+	CheckAPIVersions bool
+	KubeVersion      string
+	Enforce          bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	CreatedBy        int64
+	UpdatedBy        int64
+}
@@ -0,0 +1,31 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// LegalHold keeps a package of a registry, or one of its versions, from being deleted by any means until
+// it's released.
+type LegalHold struct {
+	ID         int64
+	RegistryID int64
+	ImageName  string
+	// Version is the held version, the tag or digest of images. The hold covers all versions of the package
+	// if it's empty.
+	Version   string
+	Reason    string
+	CreatedAt time.Time
+	CreatedBy int64
+}
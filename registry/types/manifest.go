@@ -52,3 +52,20 @@ type Manifest struct {
 
 // Manifests is a slice of Manifest pointers.
 type Manifests []*Manifest
+
+// ManifestLocation is an image of a registry holding a manifest, either directly or through an
+// index referencing it, with one of the tags pointing to it.
+type ManifestLocation struct {
+	RegistryID int64
+	ImageName  string
+	Tag        string
+}
+
+// ManifestSigningState tells whether a manifest has a signature, i.e. a referrer manifest with a
+// signature artifact type pointing to it.
+type ManifestSigningState struct {
+	ManifestID int64
+	ImageName  string
+	Digest     digest.Digest
+	Signed     bool
+}
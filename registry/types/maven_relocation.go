@@ -0,0 +1,36 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// MavenRelocation maps the coordinates of a Maven artifact of a registry to the coordinates it
+// moved to. The registry serves relocation POMs at the old coordinates so that builds that still
+// depend on them keep working, with a warning to move to the new ones.
+type MavenRelocation struct {
+	ID               int64
+	RegistryID       int64
+	GroupID          string
+	ArtifactID       string
+	TargetGroupID    string
+	TargetArtifactID string
+	// TargetVersion is the version the artifact moved to, empty when the versions are unchanged.
+	TargetVersion string
+	Message       string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	CreatedBy     int64
+	UpdatedBy     int64
+}
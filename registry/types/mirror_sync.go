@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// MirrorSyncRunStatus is the state of a run of a mirror sync.
+type MirrorSyncRunStatus string
+
+const (
+	MirrorSyncRunStatusPending   MirrorSyncRunStatus = "PENDING"
+	MirrorSyncRunStatusRunning   MirrorSyncRunStatus = "RUNNING"
+	MirrorSyncRunStatusSucceeded MirrorSyncRunStatus = "SUCCEEDED"
+	MirrorSyncRunStatusFailed    MirrorSyncRunStatus = "FAILED"
+)
+
+// MirrorSyncTrigger is what started a run of a mirror sync.
+type MirrorSyncTrigger string
+
+const (
+	MirrorSyncTriggerScheduled MirrorSyncTrigger = "SCHEDULED"
+	MirrorSyncTriggerManual    MirrorSyncTrigger = "MANUAL"
+)
+
+// MirrorSyncArtifactStatus is the state of a tag replicated by a run of a mirror sync.
+type MirrorSyncArtifactStatus string
+
+const (
+	MirrorSyncArtifactStatusPending MirrorSyncArtifactStatus = "PENDING"
+	MirrorSyncArtifactStatusSynced  MirrorSyncArtifactStatus = "SYNCED"
+	// MirrorSyncArtifactStatusSkipped is a tag the registry already had at the digest of the upstream.
+	MirrorSyncArtifactStatusSkipped MirrorSyncArtifactStatus = "SKIPPED"
+	MirrorSyncArtifactStatusFailed  MirrorSyncArtifactStatus = "FAILED"
+)
+
+// MirrorSync periodically replicates the tags of a repository of an upstream proxy that match TagPattern
+// into the registry, every IntervalMinutes.
+type MirrorSync struct {
+	ID                 int64
+	RegistryID         int64
+	UpstreamRegistryID int64
+	Repository         string
+	// TagPattern is the regular expression the replicated tags match, all tags are replicated if it's empty.
+	TagPattern      string
+	IntervalMinutes int
+	Enabled         bool
+	NextRunAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	CreatedBy       int64
+	UpdatedBy       int64
+}
+
+// MirrorSyncRun is a run of a mirror sync, with the number of tags it replicated, skipped and failed to
+// replicate.
+type MirrorSyncRun struct {
+	ID      int64
+	SyncID  int64
+	Status  MirrorSyncRunStatus
+	Trigger MirrorSyncTrigger
+	Tags    int
+	Synced  int
+	Skipped int
+	Failed  int
+	// Error is the reason the run failed as a whole, like the tags of the upstream not being listed.
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	CreatedAt  time.Time
+	CreatedBy  int64
+}
+
+// MirrorSyncArtifact is a tag replicated by a run of a mirror sync.
+type MirrorSyncArtifact struct {
+	ID     int64
+	RunID  int64
+	Tag    string
+	Digest string
+	Status MirrorSyncArtifactStatus
+	// Error is the reason a failed tag couldn't be replicated.
+	Error     string
+	UpdatedAt time.Time
+}
@@ -38,3 +38,9 @@ type FileNodeMetadata struct {
 	MD5       string
 	CreatedAt int64
 }
+
+// FileLocation is a file of a registry.
+type FileLocation struct {
+	RegistryID int64
+	Path       string
+}
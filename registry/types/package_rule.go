@@ -0,0 +1,65 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// PackageRuleAction is whether a package rule lets packages through or keeps them out.
+type PackageRuleAction string
+
+const (
+	// PackageRuleActionInclude allows the packages the rule matches. Once an upstream proxy has include
+	// rules, packages none of them match are blocked.
+	PackageRuleActionInclude PackageRuleAction = "INCLUDE"
+	// PackageRuleActionExclude blocks the packages the rule matches, even if an include rule matches them.
+	PackageRuleActionExclude PackageRuleAction = "EXCLUDE"
+)
+
+// PackageRule controls which packages an upstream proxy may fetch from its upstream. Empty fields match
+// any package.
+type PackageRule struct {
+	ID         int64
+	RegistryID int64
+	Action     PackageRuleAction
+	// Group is a glob matched against the group of the package: the groupId of Maven artifacts, the
+	// scope of npm packages and the namespace of images.
+	Group string
+	// Name is a glob matched against the name of the package within its group.
+	Name string
+	// VersionRange is a semver constraint, e.g. ">= 1.2, < 2", matched against the version of the package.
+	VersionRange string
+	Description  string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	CreatedBy    int64
+	UpdatedBy    int64
+}
+
+// PackageRuleViolation records a package an upstream proxy refused to serve because of its package
+// rules. Repeated requests for the same package version are counted on the same violation.
+type PackageRuleViolation struct {
+	ID         int64
+	RegistryID int64
+	// RuleID is the exclude rule that blocked the package, zero if no include rule matched it.
+	RuleID  int64
+	Group   string
+	Name    string
+	Version string
+	Reason  string
+	Count   int64
+	// CreatedAt is when the package was first blocked, LastSeenAt when it was last requested.
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
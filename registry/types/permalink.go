@@ -0,0 +1,29 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// Permalink is the short code of a version of an artifact, resolved to the UI screen of the version by a
+// redirect. The code of a version never changes, so it can be shared in chats and tickets.
+type Permalink struct {
+	ID         int64
+	Code       string
+	RegistryID int64
+	Image      string
+	Version    string
+	CreatedAt  time.Time
+	CreatedBy  int64
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// QueueName identifies a queue of background registry operations.
+type QueueName string
+
+const (
+	// QueueGCManifest holds the manifests to be reviewed by the garbage collector.
+	QueueGCManifest QueueName = "gc_manifest"
+	// QueueGCBlob holds the blobs to be reviewed by the garbage collector.
+	QueueGCBlob QueueName = "gc_blob"
+	// QueueStorageMigration holds the storage prefix migration of the registry.
+	QueueStorageMigration QueueName = "storage_migration"
+	// QueueCleanup holds the cleanup policies of the registry applied by the cleanup job.
+	QueueCleanup QueueName = "cleanup"
+	// QueueContentIndex holds the archives of the registry whose file listing isn't indexed yet.
+	QueueContentIndex QueueName = "content_index"
+)
+
+// QueueNames lists the queues in the order they are reported.
+var QueueNames = []QueueName{
+	QueueGCManifest, QueueGCBlob, QueueStorageMigration, QueueCleanup, QueueContentIndex,
+}
+
+// IsValid reports whether the name is one of the known queues.
+func (n QueueName) IsValid() bool {
+	for _, name := range QueueNames {
+		if name == n {
+			return true
+		}
+	}
+	return false
+}
+
+// QueueStats is the backlog of a queue.
+type QueueStats struct {
+	Pending int64
+	Running int64
+	// OldestPendingAt is the time in unix milliseconds the oldest pending item was queued, 0 if unknown.
+	OldestPendingAt int64
+}
+
+// PausedQueue represents a row in the registry_paused_queues table.
+type PausedQueue struct {
+	RegistryID int64
+	Queue      QueueName
+	PausedAt   int64
+	PausedBy   int64
+}
@@ -33,8 +33,20 @@ type Registry struct {
 	AllowedPattern  []string
 	BlockedPattern  []string
 	Labels          []string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	CreatedBy       int64
-	UpdatedBy       int64
+	// LatestVersionStrategy determines how the latest version of an artifact is picked.
+	LatestVersionStrategy artifact.LatestVersionStrategy
+	LatestVersionPattern  string
+	// StoragePrefix overrides the root identifier as the storage path prefix of the registry's blobs.
+	StoragePrefix string
+	// MTLSMode requires client certificates signed by MTLSCACertificates, PEM encoded, for pushes or all access.
+	MTLSMode           artifact.MTLSMode
+	MTLSCACertificates string
+	// MavenStrictMetadata rejects POMs missing the metadata Maven Central requires.
+	MavenStrictMetadata bool
+	// NpmLocalScopes are the npm scopes only resolved from the registry, never from its upstream proxies.
+	NpmLocalScopes []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CreatedBy      int64
+	UpdatedBy      int64
 }
@@ -0,0 +1,29 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// RegistryConfigRevision is the configuration of a registry after a change, as it's exported. The
+// revisions of a registry are numbered from 1 in the order they were made.
+type RegistryConfigRevision struct {
+	ID         int64
+	RegistryID int64
+	Revision   int64
+	// Config is the configuration of the registry as JSON.
+	Config    []byte
+	CreatedAt time.Time
+	CreatedBy int64
+}
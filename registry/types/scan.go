@@ -0,0 +1,122 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+type ScanSeverity string
+
+const (
+	ScanSeverityCritical ScanSeverity = "CRITICAL"
+	ScanSeverityHigh     ScanSeverity = "HIGH"
+	ScanSeverityMedium   ScanSeverity = "MEDIUM"
+	ScanSeverityLow      ScanSeverity = "LOW"
+	ScanSeverityUnknown  ScanSeverity = "UNKNOWN"
+)
+
+// ScanSeverities lists the severities from the most to the least severe.
+var ScanSeverities = []ScanSeverity{
+	ScanSeverityCritical,
+	ScanSeverityHigh,
+	ScanSeverityMedium,
+	ScanSeverityLow,
+	ScanSeverityUnknown,
+}
+
+// IsValid reports whether the severity is one of the known severities.
+func (s ScanSeverity) IsValid() bool {
+	for _, severity := range ScanSeverities {
+		if s == severity {
+			return true
+		}
+	}
+	return false
+}
+
+// Scan is the result reported by a scanner for the artifact with the given digest.
+type Scan struct {
+	ID          int64
+	RegistryID  int64
+	Digest      string
+	Tool        string
+	ToolVersion string
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	CreatedAt   time.Time
+	CreatedBy   int64
+}
+
+// ScanFinding is a single issue, usually a vulnerability, reported by a scan.
+type ScanFinding struct {
+	ID             int64
+	ScanID         int64
+	Identifier     string
+	Severity       ScanSeverity
+	PackageName    string
+	PackageVersion string
+	FixedVersion   string
+	Title          string
+	URL            string
+}
+
+// ScanComponent is a package a scan found in an artifact, an entry of its SBOM.
+type ScanComponent struct {
+	ID      int64
+	ScanID  int64
+	Name    string
+	Version string
+	Purl    string
+}
+
+// PackageOccurrence tells that the latest scan of an artifact found a version of a package in it.
+type PackageOccurrence struct {
+	RegistryID     int64
+	Digest         string
+	PackageName    string
+	PackageVersion string
+}
+
+// PackageImpact is an artifact including a vulnerable version of a package, with the images or files
+// holding it across the registries of the space.
+type PackageImpact struct {
+	RegistryID     int64
+	Digest         string
+	PackageName    string
+	PackageVersion string
+	Images         []*ManifestLocation
+	Files          []*FileLocation
+	// VirtualRegistryIDs are the virtual registries serving the artifact through one of the registries
+	// holding it.
+	VirtualRegistryIDs []int64
+}
+
+// ScanSeverityCounts holds the number of findings of a scan per severity.
+type ScanSeverityCounts map[ScanSeverity]int64
+
+// SecurityPosture summarizes the security state of the versions of a registry.
+type SecurityPosture struct {
+	Versions          int64
+	UnsignedVersions  int64
+	UnscannedVersions int64
+	// CriticalVersions is the number of versions with critical findings not suppressed by VEX statements
+	// nor allowlisted.
+	CriticalVersions int64
+	// CriticalCVEs is the number of distinct vulnerabilities of the critical findings.
+	CriticalCVEs               int64
+	SuppressedCriticalFindings int64
+	// AllowlistedCriticalFindings is the number of critical vulnerabilities allowlisted for a version.
+	AllowlistedCriticalFindings int64
+	LastScannedAt               int64
+}
@@ -22,15 +22,16 @@ import (
 
 // Tag DTO object.
 type Tag struct {
-	ID         int64
-	Name       string
-	ImageName  string
-	RegistryID int64
-	ManifestID int64
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	CreatedBy  int64
-	UpdatedBy  int64
+	ID           int64
+	Name         string
+	ImageName    string
+	RegistryID   int64
+	ManifestID   int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	CreatedBy    int64
+	UpdatedBy    int64
+	LastPulledAt time.Time
 }
 
 type ArtifactMetadata struct {
@@ -50,12 +51,17 @@ type TagMetadata struct {
 	Size          string
 	PackageType   artifact.PackageType
 	DigestCount   int
+	Platforms     []string
 	ModifiedAt    time.Time
 	SchemaVersion int
 	NonConformant bool
 	Payload       Payload
+	// ConfigPayload is the image config stored with the manifest, if its size is within the limit.
+	ConfigPayload Payload
 	MediaType     string
 	DownloadCount int64
+	// UniqueDownloadCount counts the downloads deduplicated by client, see DownloadStat.
+	UniqueDownloadCount int64
 }
 
 type TagDetail struct {
@@ -0,0 +1,32 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// UploadSession is the state of an in-progress chunked blob upload. It's kept in the database
+// rather than in the memory of the replica the upload started on, so any replica behind a load
+// balancer can resume the upload.
+type UploadSession struct {
+	// ID is the UUID of the upload.
+	ID string
+	// Path is the storage path of the blob store the upload is written to.
+	Path string
+	// Name is the repository the blob is linked to once the upload completes.
+	Name      string
+	Offset    int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
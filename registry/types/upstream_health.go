@@ -0,0 +1,30 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// UpstreamHealth is the last health check of an upstream URL of an upstream proxy. A URL is unhealthy
+// once it failed the configured number of consecutive checks, and healthy again after a check passes.
+type UpstreamHealth struct {
+	ID                  int64
+	RegistryID          int64
+	URL                 string
+	Healthy             bool
+	ConsecutiveFailures int
+	LastError           string
+	Latency             time.Duration
+	CheckedAt           time.Time
+}
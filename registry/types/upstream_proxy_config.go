@@ -34,10 +34,21 @@ type UpstreamProxyConfig struct {
 	SecretIdentifier         string
 	SecretSpaceID            int
 	Token                    string
-	CreatedAt                time.Time
-	UpdatedAt                time.Time
-	CreatedBy                int64
-	UpdatedBy                int64
+	// AwsRoleArn is the role assumed with the AWS credentials of the environment for the AwsIam auth type.
+	AwsRoleArn string
+	// CredentialsUpdatedAt is the time the auth type or the secrets it references last changed.
+	CredentialsUpdatedAt time.Time
+	// MetadataTTL is how long metadata fetched from the upstream is served before it's fetched again.
+	MetadataTTL time.Duration
+	// ArtifactTTL is how long files cached from the upstream are served before they're fetched again,
+	// they're kept until they're deleted when it's zero.
+	ArtifactTTL time.Duration
+	// Offline serves only the content cached from the upstream, without contacting it.
+	Offline   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	CreatedBy int64
+	UpdatedBy int64
 }
 
 type UpstreamProxy struct {
@@ -59,6 +70,14 @@ type UpstreamProxy struct {
 	SecretSpaceID            int64
 	SecretSpacePath          string
 	Token                    string
+	AwsRoleArn               string
+	CredentialsUpdatedAt     time.Time
+	StoragePrefix            string
+	MTLSMode                 artifact.MTLSMode
+	MTLSCACertificates       string
+	MetadataTTL              time.Duration
+	ArtifactTTL              time.Duration
+	Offline                  bool
 	CreatedAt                time.Time
 	UpdatedAt                time.Time
 	CreatedBy                int64
@@ -0,0 +1,69 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+type VexFormat string
+
+const (
+	VexFormatOpenVEX VexFormat = "OPENVEX"
+	VexFormatCSAF    VexFormat = "CSAF"
+)
+
+type VexStatus string
+
+const (
+	VexStatusNotAffected        VexStatus = "not_affected"
+	VexStatusAffected           VexStatus = "affected"
+	VexStatusFixed              VexStatus = "fixed"
+	VexStatusUnderInvestigation VexStatus = "under_investigation"
+)
+
+// Suppresses reports whether findings of a vulnerability with the status can be ignored,
+// i.e. the artifact isn't affected by the vulnerability or contains its fix.
+func (s VexStatus) Suppresses() bool {
+	return s == VexStatusNotAffected || s == VexStatusFixed
+}
+
+// VexDocument is an OpenVEX or CSAF document attached to the artifact with the given digest.
+type VexDocument struct {
+	ID         int64
+	RegistryID int64
+	Digest     string
+	Format     VexFormat
+	// Identifier is the identifier of the document assigned by its author.
+	Identifier string
+	Author     string
+	IssuedAt   time.Time
+	Content    []byte
+	CreatedAt  time.Time
+	CreatedBy  int64
+	Statements []*VexStatement
+}
+
+// VexStatement is the status of a vulnerability for an artifact stated by a VEX document.
+type VexStatement struct {
+	ID                 int64
+	DocumentID         int64
+	DocumentIdentifier string
+	Format             VexFormat
+	Digest             string
+	Vulnerability      string
+	Status             VexStatus
+	Justification      string
+	ImpactStatement    string
+	Timestamp          time.Time
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// VulnerabilityAllowlistEntry accepts a vulnerability for a package of a registry, or one of its versions,
+// until the entry expires. Findings of allowlisted vulnerabilities don't count towards policy verdicts.
+type VulnerabilityAllowlistEntry struct {
+	ID         int64
+	RegistryID int64
+	ImageName  string
+	// Digest is the digest of the allowlisted version. The entry covers all versions of the package if it's
+	// empty.
+	Digest string
+	// Vulnerability is the normalized identifier of the vulnerability, e.g. CVE-2024-3094.
+	Vulnerability string
+	Justification string
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+	CreatedBy     int64
+}
+
+// Expired reports whether the entry expired at the given time.
+func (e *VulnerabilityAllowlistEntry) Expired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// Covers reports whether the entry applies to the version of the image with the digest.
+func (e *VulnerabilityAllowlistEntry) Covers(imageName string, digest string) bool {
+	return e.ImageName == imageName && (e.Digest == "" || e.Digest == digest)
+}
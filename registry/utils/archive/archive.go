@@ -0,0 +1,171 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive lists the files inside package archives and image layers.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Format is the container format of an archive.
+type Format string
+
+const (
+	FormatZip     Format = "zip"
+	FormatTar     Format = "tar"
+	FormatTarGzip Format = "tar+gzip"
+	FormatTarZstd Format = "tar+zstd"
+)
+
+// ErrTooManyEntries is returned when an archive has more entries than the listing limit.
+var ErrTooManyEntries = errors.New("archive has too many entries")
+
+// Entry is a regular file inside an archive.
+type Entry struct {
+	Path string
+	Size int64
+}
+
+// zipExtensions are the extensions of the zip based package formats.
+var zipExtensions = []string{".zip", ".jar", ".war", ".ear", ".aar", ".whl", ".egg", ".nupkg"}
+
+// tarExtensions maps the extensions of the tar based package formats to their format.
+var tarExtensions = []struct {
+	extension string
+	format    Format
+}{
+	{".tar.gz", FormatTarGzip},
+	{".tgz", FormatTarGzip},
+	{".tar.zst", FormatTarZstd},
+	{".tar", FormatTar},
+}
+
+// Extensions returns the file extensions of the archives FormatForName recognizes.
+func Extensions() []string {
+	extensions := append([]string{}, zipExtensions...)
+	for _, t := range tarExtensions {
+		extensions = append(extensions, t.extension)
+	}
+	return extensions
+}
+
+// FormatForName returns the format of an archive by its file name, empty if it isn't an archive.
+func FormatForName(name string) Format {
+	name = strings.ToLower(name)
+	for _, ext := range zipExtensions {
+		if strings.HasSuffix(name, ext) {
+			return FormatZip
+		}
+	}
+	for _, t := range tarExtensions {
+		if strings.HasSuffix(name, t.extension) {
+			return t.format
+		}
+	}
+	return ""
+}
+
+// FormatForMediaType returns the format of an image layer by its media type, empty if the layer
+// isn't a file system tarball.
+func FormatForMediaType(mediaType string) Format {
+	switch {
+	case strings.HasSuffix(mediaType, ".tar+gzip"), strings.HasSuffix(mediaType, ".tar.gzip"):
+		return FormatTarGzip
+	case strings.HasSuffix(mediaType, ".tar+zstd"):
+		return FormatTarZstd
+	case strings.HasSuffix(mediaType, ".tar"):
+		return FormatTar
+	default:
+		return ""
+	}
+}
+
+// IsTar reports whether the archive can be listed as a stream with ListTar.
+func (f Format) IsTar() bool {
+	return f == FormatTar || f == FormatTarGzip || f == FormatTarZstd
+}
+
+// ListZip lists the regular files of a zip archive, at most limit of them.
+func ListZip(r io.ReaderAt, size int64, limit int) ([]Entry, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zip archive: %w", err)
+	}
+	var entries []Entry
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if len(entries) == limit {
+			return entries, ErrTooManyEntries
+		}
+		//nolint:gosec // sizes of zip entries fit in an int64
+		entries = append(entries, Entry{Path: f.Name, Size: int64(f.UncompressedSize64)})
+	}
+	return entries, nil
+}
+
+// ListTar lists the regular files of a tar archive, at most limit of them. Only the headers are
+// read, the contents of the files are skipped.
+func ListTar(r io.Reader, format Format, limit int) ([]Entry, error) {
+	switch format {
+	case FormatTar:
+	case FormatTarGzip:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip stream: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	case FormatTarZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read zstd stream: %w", err)
+		}
+		defer zr.Close()
+		r = zr
+	case FormatZip:
+		return nil, fmt.Errorf("%s archives can't be listed as a stream", format)
+	default:
+		return nil, fmt.Errorf("unknown archive format %q", format)
+	}
+
+	var entries []Entry
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return entries, fmt.Errorf("failed to read tar archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if len(entries) == limit {
+			return entries, ErrTooManyEntries
+		}
+		entries = append(entries, Entry{Path: strings.TrimPrefix(hdr.Name, "./"), Size: hdr.Size})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatForName(t *testing.T) {
+	assert.Equal(t, FormatZip, FormatForName("app-1.0.war"))
+	assert.Equal(t, FormatZip, FormatForName("pkg-1.0-py3-none-any.WHL"))
+	assert.Equal(t, FormatTarGzip, FormatForName("left-pad-1.0.0.tgz"))
+	assert.Equal(t, FormatTarGzip, FormatForName("pkg-1.0.tar.gz"))
+	assert.Equal(t, FormatTar, FormatForName("rootfs.tar"))
+	assert.Equal(t, Format(""), FormatForName("pom.xml"))
+}
+
+func TestFormatForMediaType(t *testing.T) {
+	assert.Equal(t, FormatTarGzip, FormatForMediaType("application/vnd.oci.image.layer.v1.tar+gzip"))
+	assert.Equal(t, FormatTarGzip, FormatForMediaType("application/vnd.docker.image.rootfs.diff.tar.gzip"))
+	assert.Equal(t, FormatTarZstd, FormatForMediaType("application/vnd.oci.image.layer.v1.tar+zstd"))
+	assert.Equal(t, Format(""), FormatForMediaType("application/vnd.oci.image.config.v1+json"))
+}
+
+func TestListZip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	_, err := zw.Create("WEB-INF/lib/")
+	require.NoError(t, err)
+	w, err := zw.Create("WEB-INF/lib/log4j-core-2.14.1.jar")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("jar"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	entries, err := ListZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), 10)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{Path: "WEB-INF/lib/log4j-core-2.14.1.jar", Size: 3}}, entries)
+}
+
+func TestListTar(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./usr/", Typeflag: tar.TypeDir, Mode: 0o755}))
+	for _, name := range []string{"./usr/lib/a.so", "./usr/lib/b.so"} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: 2}))
+		_, err := tw.Write([]byte("so"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	entries, err := ListTar(bytes.NewReader(buf.Bytes()), FormatTarGzip, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{{Path: "usr/lib/a.so", Size: 2}, {Path: "usr/lib/b.so", Size: 2}}, entries)
+
+	entries, err = ListTar(bytes.NewReader(buf.Bytes()), FormatTarGzip, 1)
+	require.ErrorIs(t, err, ErrTooManyEntries)
+	assert.Len(t, entries, 1)
+}
+
+func TestJavaClassName(t *testing.T) {
+	for entry, expected := range map[string]string{
+		"org/apache/logging/log4j/core/Logger.class":    "org.apache.logging.log4j.core.Logger",
+		"BOOT-INF/classes/com/acme/App$Config.class":    "com.acme.App$Config",
+		"WEB-INF/classes/com/acme/Servlet.class":        "com.acme.Servlet",
+		"META-INF/versions/11/org/example/Compat.class": "org.example.Compat",
+		"/Default.class": "Default",
+		"META-INF/maven/org.example/example/pom.properties": "",
+		"module-info.class":              "",
+		"org/example/package-info.class": "",
+		"org/example/Readme.txt":         "",
+	} {
+		name, ok := JavaClassName(entry)
+		assert.Equal(t, expected != "", ok, entry)
+		assert.Equal(t, expected, name, entry)
+	}
+	assert.Equal(t, "config", SimpleClassName("com.acme.App$Config"))
+	assert.Equal(t, "default", SimpleClassName("Default"))
+}
+
+func TestWhiteouts(t *testing.T) {
+	assert.Equal(t, "usr/bin/curl", LayerPath("/usr/bin/../bin/curl"))
+	assert.Equal(t, "usr/bin/curl", LayerPath("usr/bin/curl"))
+	assert.Equal(t, []string{
+		".wh.usr",
+		"usr/.wh.bin",
+		"usr/.wh..wh..opq",
+		"usr/bin/.wh.curl",
+		"usr/bin/.wh..wh..opq",
+	}, Whiteouts("usr/bin/curl"))
+	assert.Equal(t, []string{".wh.curl"}, Whiteouts("curl"))
+}
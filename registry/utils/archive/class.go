@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"regexp"
+	"strings"
+)
+
+// classRoots are the directories the class path of the application archive formats starts at.
+var classRoots = []string{"BOOT-INF/classes/", "WEB-INF/classes/"}
+
+// multiReleaseRoot matches the versioned class directories of multi-release jars.
+var multiReleaseRoot = regexp.MustCompile(`^META-INF/versions/\d+/`)
+
+// JavaClassName returns the binary name of the class compiled to the entry of a jar, war or ear,
+// like "org.example.Outer$Inner" for "org/example/Outer$Inner.class". Module and package
+// descriptors aren't classes.
+func JavaClassName(entryPath string) (string, bool) {
+	name, ok := strings.CutSuffix(strings.TrimPrefix(entryPath, "/"), ".class")
+	if !ok || name == "" {
+		return "", false
+	}
+	for _, root := range classRoots {
+		name = strings.TrimPrefix(name, root)
+	}
+	name = multiReleaseRoot.ReplaceAllString(name, "")
+	if strings.HasPrefix(name, "META-INF/") {
+		return "", false
+	}
+	simple := name[strings.LastIndex(name, "/")+1:]
+	if simple == "" || simple == "module-info" || simple == "package-info" {
+		return "", false
+	}
+	return strings.ReplaceAll(name, "/", "."), true
+}
+
+// SimpleClassName returns the lowercase name of a class without its package and enclosing
+// classes, which class searches match on.
+func SimpleClassName(className string) string {
+	if i := strings.LastIndexAny(className, ".$"); i >= 0 {
+		className = className[i+1:]
+	}
+	return strings.ToLower(className)
+}
@@ -0,0 +1,52 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"path"
+	"strings"
+)
+
+const (
+	// whiteoutPrefix marks a file of an image layer that deletes the file of the same name from the
+	// layers below it.
+	whiteoutPrefix = ".wh."
+	// opaqueWhiteout marks a directory of an image layer that hides the contents of the directory in
+	// the layers below it.
+	opaqueWhiteout = whiteoutPrefix + whiteoutPrefix + ".opq"
+)
+
+// LayerPath returns the path of a file in the file system of an image as it is stored in a layer,
+// without the leading slash.
+func LayerPath(p string) string {
+	return strings.TrimPrefix(path.Clean("/"+p), "/")
+}
+
+// Whiteouts returns the whiteout files of the upper layers of an image that hide the file at the
+// layer path p: the whiteouts of the file and of its parent directories, and the opaque whiteouts
+// of its parent directories.
+// Source: https://github.com/opencontainers/image-spec/blob/main/layer.md#whiteouts
+func Whiteouts(p string) []string {
+	parts := strings.Split(p, "/")
+	whiteouts := make([]string, 0, 2*len(parts)-1)
+	for i, part := range parts {
+		parent := strings.Join(parts[:i], "/")
+		whiteouts = append(whiteouts, path.Join(parent, whiteoutPrefix+part))
+		if i > 0 {
+			whiteouts = append(whiteouts, path.Join(parent, opaqueWhiteout))
+		}
+	}
+	return whiteouts
+}
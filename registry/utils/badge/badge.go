@@ -0,0 +1,221 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package badge
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Style string
+
+const (
+	StyleFlat        Style = "flat"
+	StyleFlatSquare  Style = "flat-square"
+	StyleForTheBadge Style = "for-the-badge"
+)
+
+// Colors of the messages of the badges, named as the colors of shields.io.
+const (
+	ColorBrightGreen = "brightgreen"
+	ColorGreen       = "green"
+	ColorYellow      = "yellow"
+	ColorOrange      = "orange"
+	ColorRed         = "red"
+	ColorBlue        = "blue"
+	ColorLightGrey   = "lightgrey"
+	ColorGrey        = "grey"
+)
+
+var namedColors = map[string]string{
+	ColorBrightGreen: "#4c1",
+	ColorGreen:       "#97ca00",
+	"yellowgreen":    "#a4a61d",
+	ColorYellow:      "#dfb317",
+	ColorOrange:      "#fe7d37",
+	ColorRed:         "#e05d44",
+	ColorBlue:        "#007ec6",
+	ColorLightGrey:   "#9f9f9f",
+	ColorGrey:        "#555",
+	"gray":           "#555",
+	"lightgray":      "#9f9f9f",
+}
+
+var hexColorPattern = regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Badge is a label followed by a message on a colored background, as the badges embedded in READMEs.
+type Badge struct {
+	Label      string
+	Message    string
+	Color      string
+	LabelColor string
+	Style      Style
+}
+
+// layout is a badge with the fills and dimensions of its style.
+type layout struct {
+	Badge
+	labelFill    string
+	messageFill  string
+	shaded       bool
+	uppercase    bool
+	height       int
+	fontSize     int
+	padding      int
+	cornerRadius int
+}
+
+// ParseColor returns the fill of a named color or a hex color, with or without a leading '#'.
+func ParseColor(color string) (string, error) {
+	if fill, ok := namedColors[strings.ToLower(color)]; ok {
+		return fill, nil
+	}
+	if hexColorPattern.MatchString(color) {
+		return "#" + strings.TrimPrefix(strings.ToLower(color), "#"), nil
+	}
+	return "", fmt.Errorf("invalid color %q", color)
+}
+
+// Render renders the badge as an SVG image, with the grey label color of shields.io unless
+// LabelColor is set.
+func Render(badge Badge) ([]byte, error) {
+	b := layout{Badge: badge}
+	var err error
+	if b.messageFill, err = ParseColor(b.Color); err != nil {
+		return nil, err
+	}
+	b.labelFill = namedColors[ColorGrey]
+	if b.LabelColor != "" {
+		if b.labelFill, err = ParseColor(b.LabelColor); err != nil {
+			return nil, err
+		}
+	}
+	switch b.Style {
+	case "", StyleFlat:
+		b.height, b.fontSize, b.padding, b.cornerRadius, b.shaded = 20, 11, 6, 3, true
+	case StyleFlatSquare:
+		b.height, b.fontSize, b.padding = 20, 11, 6
+	case StyleForTheBadge:
+		b.height, b.fontSize, b.padding, b.uppercase = 28, 10, 10, true
+	default:
+		return nil, fmt.Errorf("invalid badge style %q", b.Style)
+	}
+	if b.uppercase {
+		b.Label, b.Message = strings.ToUpper(b.Label), strings.ToUpper(b.Message)
+	}
+	return []byte(b.svg()), nil
+}
+
+func (b layout) svg() string {
+	labelWidth := 0
+	if b.Label != "" {
+		labelWidth = textWidth(b.Label, b.fontSize) + 2*b.padding
+	}
+	messageWidth := textWidth(b.Message, b.fontSize) + 2*b.padding
+	width := labelWidth + messageWidth
+	title := b.Message
+	if b.Label != "" {
+		title = b.Label + ": " + b.Message
+	}
+	title = html.EscapeString(title)
+
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" role="img" aria-label="%s">`,
+		width, b.height, title)
+	fmt.Fprintf(&s, `<title>%s</title>`, title)
+	if b.shaded {
+		s.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" ` +
+			`stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	}
+	fmt.Fprintf(&s, `<clipPath id="r"><rect width="%d" height="%d" rx="%d" fill="#fff"/></clipPath>`,
+		width, b.height, b.cornerRadius)
+	s.WriteString(`<g clip-path="url(#r)">`)
+	fmt.Fprintf(&s, `<rect width="%d" height="%d" fill="%s"/>`, labelWidth, b.height, b.labelFill)
+	fmt.Fprintf(&s, `<rect x="%d" width="%d" height="%d" fill="%s"/>`, labelWidth, messageWidth, b.height,
+		b.messageFill)
+	if b.shaded {
+		fmt.Fprintf(&s, `<rect width="%d" height="%d" fill="url(#s)"/>`, width, b.height)
+	}
+	s.WriteString(`</g>`)
+	weight := ""
+	if b.uppercase {
+		weight = ` font-weight="bold"`
+	}
+	fmt.Fprintf(&s, `<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" `+
+		`font-size="%d"%s>`, b.fontSize, weight)
+	baseline := b.height/2 + b.fontSize*4/10
+	if b.Label != "" {
+		b.text(&s, labelWidth/2, baseline, b.Label)
+	}
+	b.text(&s, labelWidth+messageWidth/2, baseline, b.Message)
+	s.WriteString(`</g></svg>`)
+	return s.String()
+}
+
+// text writes a centered text, with the shadow of the flat style.
+func (b layout) text(s *strings.Builder, x, y int, text string) {
+	text = html.EscapeString(text)
+	if b.shaded {
+		fmt.Fprintf(s, `<text x="%d" y="%d" fill="#010101" fill-opacity=".3">%s</text>`, x, y+1, text)
+	}
+	fmt.Fprintf(s, `<text x="%d" y="%d">%s</text>`, x, y, text)
+}
+
+// textWidth estimates the width in pixels of a text in Verdana of the given size, as badges are
+// rendered without measuring their fonts.
+func textWidth(text string, fontSize int) int {
+	var width float64
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune("iljI.,:;'|!() ", r):
+			width += 0.35
+		case strings.ContainsRune("frt-/", r):
+			width += 0.45
+		case strings.ContainsRune("mwMW", r):
+			width += 0.95
+		case r >= 'A' && r <= 'Z':
+			width += 0.7
+		default:
+			width += 0.62
+		}
+	}
+	return int(width*float64(fontSize) + 0.5)
+}
+
+// FormatCount formats a count as shields.io does, as 999, 1.2k or 3.4M.
+func FormatCount(count int64) string {
+	const thousand = 1000
+	units := []string{"k", "M", "G", "T"}
+	if count < thousand {
+		return strconv.FormatInt(count, 10)
+	}
+	value := float64(count)
+	unit := ""
+	for _, u := range units {
+		// Counts rounding up to the next unit are formatted with it, as 1M rather than 1000k.
+		if value < thousand-0.5 {
+			break
+		}
+		value /= thousand
+		unit = u
+	}
+	if value >= 100 {
+		return strconv.FormatFloat(value, 'f', 0, 64) + unit
+	}
+	return strings.TrimSuffix(strconv.FormatFloat(value, 'f', 1, 64), ".0") + unit
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package badge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	svg, err := Render(Badge{Label: "version", Message: "1.2.0 <rc>", Color: ColorBlue})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(svg), "<svg "))
+	assert.Contains(t, string(svg), "<title>version: 1.2.0 &lt;rc&gt;</title>")
+	assert.Contains(t, string(svg), `fill="#007ec6"`)
+	assert.Contains(t, string(svg), `url(#s)`)
+
+	svg, err = Render(Badge{Label: "scan", Message: "passing", Color: "4c1", LabelColor: "#333",
+		Style: StyleForTheBadge})
+	require.NoError(t, err)
+	assert.Contains(t, string(svg), "<title>SCAN: PASSING</title>")
+	assert.Contains(t, string(svg), `fill="#333"`)
+	assert.NotContains(t, string(svg), `url(#s)`)
+
+	_, err = Render(Badge{Message: "x", Color: "not-a-color"})
+	assert.Error(t, err)
+	_, err = Render(Badge{Message: "x", Color: ColorRed, Style: "plastic"})
+	assert.Error(t, err)
+}
+
+func TestFormatCount(t *testing.T) {
+	for count, expected := range map[int64]string{
+		0:             "0",
+		999:           "999",
+		1000:          "1k",
+		1260:          "1.3k",
+		123456:        "123k",
+		999400:        "999k",
+		999600:        "1M",
+		4200000:       "4.2M",
+		7000000000000: "7T",
+	} {
+		assert.Equal(t, expected, FormatCount(count), count)
+	}
+}
@@ -0,0 +1,434 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chartpolicy lints Helm charts against the push policy of a registry.
+package chartpolicy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/harness/gitness/registry/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// MediaTypeConfig is the media type of the configuration of the OCI manifest of a Helm chart.
+	MediaTypeConfig = "application/vnd.cncf.helm.config.v1+json"
+	// MediaTypeChart is the media type of the layer holding the chart archive.
+	MediaTypeChart = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	// MaxChartSize is the size of the largest chart archive that is linted.
+	MaxChartSize = 10 << 20
+
+	maxFileSize = 1 << 20
+	dockerHub   = "docker.io"
+)
+
+// Rule is the check of the push policy a violation breaks.
+type Rule string
+
+const (
+	RuleInvalidChart    Rule = "INVALID_CHART"
+	RuleRequiredValue   Rule = "REQUIRED_VALUE"
+	RuleDisallowedImage Rule = "DISALLOWED_IMAGE"
+	RuleAPIVersion      Rule = "API_VERSION"
+)
+
+// Severity tells whether a violation blocks the push of the chart when the policy is enforced.
+type Severity string
+
+const (
+	SeverityError   Severity = "ERROR"
+	SeverityWarning Severity = "WARNING"
+)
+
+// Violation is a check of the push policy the chart breaks. File is the path of the offending file
+// inside the chart, empty if the violation is about the whole chart.
+type Violation struct {
+	Rule     Rule     `json:"rule"`
+	Severity Severity `json:"severity"`
+	File     string   `json:"file,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// Report lists the violations of the push policy of a chart.
+type Report struct {
+	Chart      string      `json:"chart,omitempty"`
+	Version    string      `json:"version,omitempty"`
+	Violations []Violation `json:"violations"`
+}
+
+// HasErrors tells whether any of the violations blocks the push of the chart.
+func (r *Report) HasErrors() bool {
+	for _, v := range r.Violations {
+		if v.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) add(rule Rule, severity Severity, file string, format string, args ...any) {
+	r.Violations = append(r.Violations, Violation{
+		Rule:     rule,
+		Severity: severity,
+		File:     file,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// removedAPI is a Kubernetes API version that was removed for some kinds, along with the version replacing it.
+type removedAPI struct {
+	apiVersion  string
+	kinds       []string
+	removedIn   int
+	replacement string
+}
+
+// removedAPIs are the API versions that were removed from Kubernetes, with the minor version of Kubernetes 1
+// they were removed in.
+var removedAPIs = []removedAPI{
+	{"extensions/v1beta1", []string{"Deployment", "DaemonSet", "ReplicaSet"}, 16, "apps/v1"},
+	{"extensions/v1beta1", []string{"NetworkPolicy"}, 16, "networking.k8s.io/v1"},
+	{"extensions/v1beta1", []string{"PodSecurityPolicy"}, 16, ""},
+	{"extensions/v1beta1", []string{"Ingress"}, 22, "networking.k8s.io/v1"},
+	{"apps/v1beta1", []string{"Deployment", "StatefulSet"}, 16, "apps/v1"},
+	{"apps/v1beta2", []string{"Deployment", "StatefulSet", "DaemonSet", "ReplicaSet"}, 16, "apps/v1"},
+	{"networking.k8s.io/v1beta1", []string{"Ingress", "IngressClass"}, 22, "networking.k8s.io/v1"},
+	{
+		"rbac.authorization.k8s.io/v1beta1", []string{"Role", "RoleBinding", "ClusterRole", "ClusterRoleBinding"},
+		22, "rbac.authorization.k8s.io/v1",
+	},
+	{"apiextensions.k8s.io/v1beta1", []string{"CustomResourceDefinition"}, 22, "apiextensions.k8s.io/v1"},
+	{
+		"admissionregistration.k8s.io/v1beta1",
+		[]string{"MutatingWebhookConfiguration", "ValidatingWebhookConfiguration"},
+		22, "admissionregistration.k8s.io/v1",
+	},
+	{"scheduling.k8s.io/v1beta1", []string{"PriorityClass"}, 22, "scheduling.k8s.io/v1"},
+	{
+		"storage.k8s.io/v1beta1", []string{"StorageClass", "VolumeAttachment", "CSIDriver", "CSINode"},
+		22, "storage.k8s.io/v1",
+	},
+	{"storage.k8s.io/v1beta1", []string{"CSIStorageCapacity"}, 27, "storage.k8s.io/v1"},
+	{"certificates.k8s.io/v1beta1", []string{"CertificateSigningRequest"}, 22, "certificates.k8s.io/v1"},
+	{"coordination.k8s.io/v1beta1", []string{"Lease"}, 22, "coordination.k8s.io/v1"},
+	{"batch/v1beta1", []string{"CronJob"}, 25, "batch/v1"},
+	{"policy/v1beta1", []string{"PodDisruptionBudget"}, 25, "policy/v1"},
+	{"policy/v1beta1", []string{"PodSecurityPolicy"}, 25, ""},
+	{"discovery.k8s.io/v1beta1", []string{"EndpointSlice"}, 25, "discovery.k8s.io/v1"},
+	{"autoscaling/v2beta1", []string{"HorizontalPodAutoscaler"}, 25, "autoscaling/v2"},
+	{"autoscaling/v2beta2", []string{"HorizontalPodAutoscaler"}, 26, "autoscaling/v2"},
+	{
+		"flowcontrol.apiserver.k8s.io/v1beta1", []string{"FlowSchema", "PriorityLevelConfiguration"},
+		26, "flowcontrol.apiserver.k8s.io/v1",
+	},
+	{
+		"flowcontrol.apiserver.k8s.io/v1beta2", []string{"FlowSchema", "PriorityLevelConfiguration"},
+		29, "flowcontrol.apiserver.k8s.io/v1",
+	},
+	{
+		"flowcontrol.apiserver.k8s.io/v1beta3", []string{"FlowSchema", "PriorityLevelConfiguration"},
+		32, "flowcontrol.apiserver.k8s.io/v1",
+	},
+}
+
+func findRemovedAPI(apiVersion string, kind string) (removedAPI, bool) {
+	for _, api := range removedAPIs {
+		if api.apiVersion == apiVersion && slices.Contains(api.kinds, kind) {
+			return api, true
+		}
+	}
+	return removedAPI{}, false
+}
+
+var (
+	documentSeparator = regexp.MustCompile(`(?m)^---`)
+	apiVersionLine    = regexp.MustCompile(`(?m)^apiVersion:\s*["']?([^\s"'#]+)["']?`)
+	kindLine          = regexp.MustCompile(`(?m)^kind:\s*["']?([^\s"'#]+)["']?`)
+	// imageLine matches the literal images of the templates, the ones set from values are checked in the
+	// values instead.
+	imageLine   = regexp.MustCompile(`(?m)^\s*(?:-\s+)?image:\s*["']?([^\s"'{}#]+)["']?\s*$`)
+	kubeVersion = regexp.MustCompile(`^v?(\d+)\.(\d+)(\.\d+)?$`)
+)
+
+// ParseKubeVersion returns the minor version of a Kubernetes 1 version, such as 1.29 or v1.29.3.
+func ParseKubeVersion(version string) (int, error) {
+	m := kubeVersion.FindStringSubmatch(strings.TrimSpace(version))
+	if m == nil || m[1] != "1" {
+		return 0, fmt.Errorf("invalid Kubernetes version %q", version)
+	}
+	return strconv.Atoi(m[2])
+}
+
+// chart is the content of a chart archive the policy applies to. Subcharts are left out, they are checked
+// when pushed themselves.
+type chart struct {
+	metadata  []byte
+	values    []byte
+	templates map[string][]byte
+}
+
+// Lint checks the chart archive against the push policy and reports its violations. A chart that can't be
+// read is reported as a single INVALID_CHART violation.
+func Lint(archive []byte, policy *types.HelmPushPolicy) *Report {
+	report := &Report{Violations: []Violation{}}
+	c, err := readChart(archive)
+	if err != nil {
+		report.add(RuleInvalidChart, SeverityError, "", "failed to read chart: %s", err)
+		return report
+	}
+
+	var metadata struct {
+		Name    string `yaml:"name"`
+		Version string `yaml:"version"`
+	}
+	if err = yaml.Unmarshal(c.metadata, &metadata); err != nil {
+		report.add(RuleInvalidChart, SeverityError, "Chart.yaml", "invalid Chart.yaml: %s", err)
+		return report
+	}
+	report.Chart = metadata.Name
+	report.Version = metadata.Version
+
+	values := map[string]any{}
+	if err = yaml.Unmarshal(c.values, &values); err != nil {
+		report.add(RuleInvalidChart, SeverityError, "values.yaml", "invalid values.yaml: %s", err)
+		return report
+	}
+
+	for _, key := range policy.RequiredValues {
+		if !isSet(values, key) {
+			report.add(RuleRequiredValue, SeverityError, "values.yaml", "value %s is required but not set", key)
+		}
+	}
+	if len(policy.DisallowedRegistries) > 0 {
+		checkImages(report, c, values, policy.DisallowedRegistries)
+	}
+	if policy.CheckAPIVersions {
+		target, _ := ParseKubeVersion(policy.KubeVersion)
+		checkAPIVersions(report, c, target)
+	}
+	return report
+}
+
+func readChart(archive []byte) (*chart, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	c := &chart{templates: map[string][]byte{}}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		// The files of a chart are inside the directory named after it.
+		_, name, ok := strings.Cut(path.Clean(hdr.Name), "/")
+		if !ok {
+			continue
+		}
+		if name != "Chart.yaml" && name != "values.yaml" && !isTemplate(name) {
+			continue
+		}
+		if hdr.Size > maxFileSize {
+			return nil, fmt.Errorf("%s is larger than %d bytes", name, maxFileSize)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		switch name {
+		case "Chart.yaml":
+			c.metadata = content
+		case "values.yaml":
+			c.values = content
+		default:
+			c.templates[name] = content
+		}
+	}
+	if c.metadata == nil {
+		return nil, errors.New("chart has no Chart.yaml")
+	}
+	return c, nil
+}
+
+func isTemplate(name string) bool {
+	ext := path.Ext(name)
+	return strings.HasPrefix(name, "templates/") && (ext == ".yaml" || ext == ".yml")
+}
+
+// isSet tells whether the dotted key is set to a value other than null or an empty string.
+func isSet(values map[string]any, key string) bool {
+	var current any = values
+	for _, part := range strings.Split(key, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return false
+		}
+		if current, ok = m[part]; !ok {
+			return false
+		}
+	}
+	return current != nil && current != ""
+}
+
+// checkImages reports the images of the values and templates that are pulled from a disallowed registry.
+// Images are set in the values either as a reference or as a map of registry and repository.
+func checkImages(report *Report, c *chart, values map[string]any, disallowed []string) {
+	hosts := make([]string, 0, len(disallowed))
+	for _, host := range disallowed {
+		hosts = append(hosts, normalizeHost(host))
+	}
+	check := func(file string, where string, ref string) {
+		host := imageHost(ref)
+		for _, d := range hosts {
+			if host == d || strings.HasSuffix(host, "."+d) {
+				report.add(RuleDisallowedImage, SeverityError, file,
+					"image %s%s is pulled from disallowed registry %s", ref, where, d)
+				return
+			}
+		}
+	}
+
+	walkImages(values, "", func(key string, ref string) {
+		check("values.yaml", " set by "+key, ref)
+	})
+	for _, name := range sortedKeys(c.templates) {
+		for _, m := range imageLine.FindAllSubmatch(c.templates[name], -1) {
+			check(name, "", string(m[1]))
+		}
+	}
+}
+
+func walkImages(node any, key string, fn func(key string, ref string)) {
+	switch n := node.(type) {
+	case map[string]any:
+		for _, k := range sortedKeys(n) {
+			child := k
+			if key != "" {
+				child = key + "." + k
+			}
+			if k == "image" {
+				if ref := imageRef(n[k]); ref != "" {
+					fn(child, ref)
+					continue
+				}
+			}
+			walkImages(n[k], child, fn)
+		}
+	case []any:
+		for i, v := range n {
+			walkImages(v, fmt.Sprintf("%s[%d]", key, i), fn)
+		}
+	}
+}
+
+func imageRef(value any) string {
+	switch v := value.(type) {
+	case string:
+		if strings.Contains(v, "{{") {
+			return ""
+		}
+		return v
+	case map[string]any:
+		repository, _ := v["repository"].(string)
+		if repository == "" {
+			return ""
+		}
+		if registry, _ := v["registry"].(string); registry != "" {
+			return strings.TrimSuffix(registry, "/") + "/" + repository
+		}
+		return repository
+	}
+	return ""
+}
+
+// imageHost returns the host of the registry an image is pulled from, Docker Hub if the reference has none.
+func imageHost(ref string) string {
+	host, _, ok := strings.Cut(ref, "/")
+	if !ok || (!strings.ContainsAny(host, ".:") && host != "localhost") {
+		return dockerHub
+	}
+	return normalizeHost(host)
+}
+
+func normalizeHost(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	host = strings.TrimPrefix(strings.TrimPrefix(host, "https://"), "http://")
+	host = strings.TrimSuffix(host, "/")
+	if host == "index.docker.io" || host == "registry-1.docker.io" {
+		return dockerHub
+	}
+	return host
+}
+
+// checkAPIVersions reports the resources of the templates whose API version was removed from Kubernetes.
+// They are errors if removed by the target minor version of Kubernetes 1, or if it is 0, and warnings
+// otherwise. Documents that set more than one API version pick it by the capabilities of the cluster and
+// are skipped.
+func checkAPIVersions(report *Report, c *chart, target int) {
+	for _, name := range sortedKeys(c.templates) {
+		for _, doc := range documentSeparator.Split(string(c.templates[name]), -1) {
+			versions := apiVersionLine.FindAllStringSubmatch(doc, -1)
+			kind := kindLine.FindStringSubmatch(doc)
+			if len(versions) != 1 || kind == nil {
+				continue
+			}
+			apiVersion := versions[0][1]
+			removed, ok := findRemovedAPI(apiVersion, kind[1])
+			if !ok {
+				continue
+			}
+			severity := SeverityError
+			if target > 0 && target < removed.removedIn {
+				severity = SeverityWarning
+			}
+			message := fmt.Sprintf("%s %s is removed in Kubernetes 1.%d", kind[1], apiVersion, removed.removedIn)
+			if removed.replacement != "" {
+				message += ", use " + removed.replacement
+			}
+			report.add(RuleAPIVersion, severity, name, "%s", message)
+		}
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chartpolicy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testValues = `
+replicaCount: 1
+resources:
+  limits:
+    memory: 128Mi
+  requests:
+    cpu: ""
+web:
+  image:
+    registry: docker.io
+    repository: bitnami/nginx
+    tag: 1.25.0
+worker:
+  image: quay.io/acme/worker:1.0
+sidecars:
+  - name: proxy
+    image: registry.acme.io/proxy:2.1
+`
+
+const testDeployment = `apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+        - name: web
+          image: "{{ .Values.web.image.registry }}/{{ .Values.web.image.repository }}"
+        - name: metrics
+          image: nginx/nginx-prometheus-exporter:1.1
+`
+
+const testResources = `{{- if .Capabilities.APIVersions.Has "networking.k8s.io/v1/Ingress" }}
+apiVersion: networking.k8s.io/v1
+{{- else }}
+apiVersion: networking.k8s.io/v1beta1
+{{- end }}
+kind: Ingress
+---
+apiVersion: batch/v1beta1
+kind: CronJob
+---
+apiVersion: policy/v1beta1
+kind: PodDisruptionBudget
+`
+
+func testChart(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     "web/" + name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestLint(t *testing.T) {
+	chart := testChart(t, map[string]string{
+		"Chart.yaml":                  "apiVersion: v2\nname: web\nversion: 1.2.0\n",
+		"values.yaml":                 testValues,
+		"templates/deployment.yaml":   testDeployment,
+		"templates/resources.yaml":    testResources,
+		"templates/_helpers.tpl":      "image: docker.io/library/busybox\n",
+		"charts/db/templates/db.yaml": "apiVersion: extensions/v1beta1\nkind: Deployment\n",
+	})
+
+	report := Lint(chart, &types.HelmPushPolicy{
+		RequiredValues:       []string{"resources.limits.memory", "resources.requests.cpu", "ingress.host"},
+		DisallowedRegistries: []string{"docker.io", "https://quay.io/"},
+		CheckAPIVersions:     true,
+		KubeVersion:          "1.24",
+	})
+	assert.Equal(t, "web", report.Chart)
+	assert.Equal(t, "1.2.0", report.Version)
+	assert.Equal(t, []Violation{
+		{RuleRequiredValue, SeverityError, "values.yaml", "value resources.requests.cpu is required but not set"},
+		{RuleRequiredValue, SeverityError, "values.yaml", "value ingress.host is required but not set"},
+		{
+			RuleDisallowedImage, SeverityError, "values.yaml",
+			"image docker.io/bitnami/nginx set by web.image is pulled from disallowed registry docker.io",
+		},
+		{
+			RuleDisallowedImage, SeverityError, "values.yaml",
+			"image quay.io/acme/worker:1.0 set by worker.image is pulled from disallowed registry quay.io",
+		},
+		{
+			RuleDisallowedImage, SeverityError, "templates/deployment.yaml",
+			"image nginx/nginx-prometheus-exporter:1.1 is pulled from disallowed registry docker.io",
+		},
+		{
+			RuleAPIVersion, SeverityWarning, "templates/resources.yaml",
+			"CronJob batch/v1beta1 is removed in Kubernetes 1.25, use batch/v1",
+		},
+		{
+			RuleAPIVersion, SeverityWarning, "templates/resources.yaml",
+			"PodDisruptionBudget policy/v1beta1 is removed in Kubernetes 1.25, use policy/v1",
+		},
+	}, report.Violations)
+	assert.True(t, report.HasErrors())
+
+	report = Lint(chart, &types.HelmPushPolicy{CheckAPIVersions: true})
+	require.Len(t, report.Violations, 2)
+	assert.Equal(t, SeverityError, report.Violations[0].Severity)
+	assert.True(t, report.HasErrors())
+
+	report = Lint(chart, &types.HelmPushPolicy{RequiredValues: []string{"resources.limits.memory"}})
+	assert.Empty(t, report.Violations)
+	assert.False(t, report.HasErrors())
+}
+
+func TestLintInvalidChart(t *testing.T) {
+	report := Lint([]byte("not a chart"), &types.HelmPushPolicy{})
+	require.Len(t, report.Violations, 1)
+	assert.Equal(t, RuleInvalidChart, report.Violations[0].Rule)
+
+	report = Lint(testChart(t, map[string]string{"values.yaml": "a: 1\n"}), &types.HelmPushPolicy{})
+	require.Len(t, report.Violations, 1)
+	assert.Equal(t, "failed to read chart: chart has no Chart.yaml", report.Violations[0].Message)
+}
+
+func TestImageHost(t *testing.T) {
+	assert.Equal(t, "docker.io", imageHost("nginx"))
+	assert.Equal(t, "docker.io", imageHost("bitnami/nginx:1.25"))
+	assert.Equal(t, "docker.io", imageHost("index.docker.io/library/nginx"))
+	assert.Equal(t, "ghcr.io", imageHost("GHCR.io/acme/app"))
+	assert.Equal(t, "localhost", imageHost("localhost/app"))
+	assert.Equal(t, "registry.acme.io:5000", imageHost("registry.acme.io:5000/app"))
+}
+
+func TestParseKubeVersion(t *testing.T) {
+	minor, err := ParseKubeVersion("v1.29.3")
+	require.NoError(t, err)
+	assert.Equal(t, 29, minor)
+	minor, err = ParseKubeVersion("1.25")
+	require.NoError(t, err)
+	assert.Equal(t, 25, minor)
+	_, err = ParseKubeVersion("2.1")
+	assert.Error(t, err)
+	_, err = ParseKubeVersion("latest")
+	assert.Error(t, err)
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drain tracks the in-flight transfers of the registry so that a shutting down instance
+// stops admitting new ones and waits for the running ones to finish.
+package drain
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Drainer admits transfers until it starts draining, and then waits for the admitted transfers.
+type Drainer struct {
+	mu       sync.Mutex
+	draining bool
+	inFlight int
+	idle     chan struct{}
+
+	// abort is canceled when the drain times out, canceling the contexts of the remaining transfers
+	// so they unwind and store their resumable state before the server stops.
+	abort       context.Context
+	cancelAbort context.CancelFunc
+}
+
+func New() *Drainer {
+	abort, cancelAbort := context.WithCancel(context.Background())
+	return &Drainer{
+		idle:        make(chan struct{}),
+		abort:       abort,
+		cancelAbort: cancelAbort,
+	}
+}
+
+// Begin admits a transfer, returning its context and the function to call once it's done.
+// It returns false once the drainer is draining.
+func (d *Drainer) Begin(ctx context.Context) (context.Context, func(), bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return nil, nil, false
+	}
+	d.inFlight++
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(d.abort, cancel)
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			stop()
+			cancel()
+			d.done()
+		})
+	}, true
+}
+
+func (d *Drainer) done() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.inFlight--
+	if d.draining && d.inFlight == 0 {
+		close(d.idle)
+	}
+}
+
+// InFlight returns the number of transfers that are running.
+func (d *Drainer) InFlight() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.inFlight
+}
+
+// Drain stops admitting transfers and waits for the running ones until ctx is done. The contexts of
+// the transfers still running then are canceled, and they're given abortGrace to unwind.
+// It returns the number of transfers that had to be canceled.
+func (d *Drainer) Drain(ctx context.Context, abortGrace time.Duration) int {
+	d.mu.Lock()
+	if !d.draining {
+		d.draining = true
+		if d.inFlight == 0 {
+			close(d.idle)
+		}
+	}
+	d.mu.Unlock()
+
+	select {
+	case <-d.idle:
+		return 0
+	case <-ctx.Done():
+	}
+
+	aborted := d.InFlight()
+	d.cancelAbort()
+
+	timer := time.NewTimer(abortGrace)
+	defer timer.Stop()
+	select {
+	case <-d.idle:
+	case <-timer.C:
+	}
+	return aborted
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainWaitsForTransfers(t *testing.T) {
+	d := New()
+	_, done, ok := d.Begin(context.Background())
+	require.True(t, ok)
+	assert.Equal(t, 1, d.InFlight())
+
+	drained := make(chan int, 1)
+	go func() {
+		drained <- d.Drain(context.Background(), time.Second)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, _, ok := d.Begin(context.Background())
+		return !ok
+	}, time.Second, time.Millisecond, "no transfer is admitted while draining")
+
+	select {
+	case <-drained:
+		t.Fatal("the drain finished with a transfer in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	done()
+	done()
+	assert.Equal(t, 0, <-drained)
+	assert.Equal(t, 0, d.InFlight())
+}
+
+func TestDrainCancelsTransfersOnTimeout(t *testing.T) {
+	d := New()
+	ctx, done, ok := d.Begin(context.Background())
+	require.True(t, ok)
+	go func() {
+		<-ctx.Done()
+		done()
+	}()
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, 1, d.Drain(drainCtx, time.Second))
+	assert.Error(t, ctx.Err())
+	assert.Equal(t, 0, d.InFlight())
+}
+
+func TestDrainIdle(t *testing.T) {
+	assert.Equal(t, 0, New().Drain(context.Background(), time.Second))
+}
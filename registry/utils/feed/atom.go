@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+// Feed is an Atom feed of the versions pushed to a registry or an artifact.
+type Feed struct {
+	ID      string
+	Title   string
+	Link    string
+	Updated time.Time
+	Entries []Entry
+}
+
+// Entry is a version of an artifact in a feed.
+type Entry struct {
+	ID      string
+	Title   string
+	Link    string
+	Summary string
+	Author  string
+	Updated time.Time
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link"`
+	Author  *atomAuthor `xml:"author,omitempty"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link"`
+	Author  *atomAuthor `xml:"author,omitempty"`
+	Summary string      `xml:"summary,omitempty"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// Render writes the feed as an Atom document. The feed is updated as of its most recent entry,
+// unless its Updated time is set.
+func Render(f Feed) ([]byte, error) {
+	if f.ID == "" || f.Title == "" {
+		return nil, fmt.Errorf("feed must have an id and a title")
+	}
+	updated := f.Updated
+	doc := atomFeed{Xmlns: atomNamespace, ID: f.ID, Title: f.Title, Entries: make([]atomEntry, 0, len(f.Entries))}
+	if f.Link != "" {
+		doc.Link = []atomLink{{Href: f.Link, Rel: "alternate"}}
+	}
+	for _, e := range f.Entries {
+		if e.ID == "" || e.Title == "" {
+			return nil, fmt.Errorf("feed entries must have an id and a title")
+		}
+		if e.Updated.After(updated) {
+			updated = e.Updated
+		}
+		entry := atomEntry{ID: e.ID, Title: e.Title, Updated: formatTime(e.Updated), Summary: e.Summary}
+		if e.Link != "" {
+			entry.Link = []atomLink{{Href: e.Link, Rel: "alternate"}}
+		}
+		if e.Author != "" {
+			entry.Author = &atomAuthor{Name: e.Author}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	doc.Updated = formatTime(updated)
+	// Atom requires an author on the feed unless every entry has one.
+	doc.Author = &atomAuthor{Name: f.Title}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	older := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 5, 2, 8, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	out, err := Render(Feed{
+		ID:    "https://example.com/registries/libs",
+		Title: "libs <npm>",
+		Link:  "https://example.com/registries/libs",
+		Entries: []Entry{
+			{ID: "urn:a:1.1.0", Title: "left-pad 1.1.0", Summary: "Pushed 1.1.0", Updated: newer},
+			{ID: "urn:a:1.0.0", Title: "left-pad 1.0.0", Link: "https://example.com/a/1.0.0", Updated: older,
+				Author: "jane"},
+		},
+	})
+	require.NoError(t, err)
+	doc := string(out)
+	assert.True(t, strings.HasPrefix(doc, xml.Header))
+	assert.Contains(t, doc, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	assert.Contains(t, doc, "<title>libs &lt;npm&gt;</title>")
+	assert.Contains(t, doc, "<updated>2024-05-02T06:30:00Z</updated>")
+	assert.Contains(t, doc, `<link href="https://example.com/a/1.0.0" rel="alternate"></link>`)
+	assert.Contains(t, doc, "<summary>Pushed 1.1.0</summary>")
+	assert.Contains(t, doc, "<name>jane</name>")
+
+	var parsed struct {
+		Entries []struct {
+			ID string `xml:"id"`
+		} `xml:"entry"`
+	}
+	require.NoError(t, xml.Unmarshal(out, &parsed))
+	require.Len(t, parsed.Entries, 2)
+	assert.Equal(t, "urn:a:1.1.0", parsed.Entries[0].ID)
+
+	out, err = Render(Feed{ID: "urn:empty", Title: "empty", Updated: older})
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "<updated>2024-05-01T10:00:00Z</updated>")
+
+	_, err = Render(Feed{Title: "no id"})
+	assert.Error(t, err)
+	_, err = Render(Feed{ID: "urn:x", Title: "x", Entries: []Entry{{ID: "urn:x:1"}}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package origin tells coarsely where an artifact is downloaded from: the network of the client,
+// and the country the CDN or load balancer in front of the registry located it in.
+package origin
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ipv4PrefixLength and ipv6PrefixLength are the sizes of the networks clients are grouped by,
+	// which are too large to tell clients apart.
+	ipv4PrefixLength = 24
+	ipv6PrefixLength = 48
+
+	// unknownCountry is the country code CDNs send if they couldn't locate the client.
+	unknownCountry = "XX"
+)
+
+// regionHeaders are the headers CDNs and load balancers send the ISO 3166-1 alpha-2 country code
+// of the client in, they overwrite the headers sent by clients.
+var regionHeaders = []string{
+	"CloudFront-Viewer-Country",
+	"CF-IPCountry",
+	"X-AppEngine-Country",
+}
+
+// Network returns the network of an IP in CIDR notation, the /24 of IPv4 and the /48 of IPv6
+// addresses, or an empty string if it's not an IP.
+func Network(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		mask := net.CIDRMask(ipv4PrefixLength, 8*net.IPv4len)
+		return (&net.IPNet{IP: v4.Mask(mask), Mask: mask}).String()
+	}
+	mask := net.CIDRMask(ipv6PrefixLength, 8*net.IPv6len)
+	return (&net.IPNet{IP: parsed.Mask(mask), Mask: mask}).String()
+}
+
+// Region returns the country code of the client of a request, or an empty string if no CDN or
+// load balancer located it.
+func Region(r *http.Request) string {
+	for _, header := range regionHeaders {
+		code := strings.ToUpper(strings.TrimSpace(r.Header.Get(header)))
+		if len(code) != 2 || code == unknownCountry {
+			continue
+		}
+		if code[0] < 'A' || code[0] > 'Z' || code[1] < 'A' || code[1] > 'Z' {
+			continue
+		}
+		return code
+	}
+	return ""
+}
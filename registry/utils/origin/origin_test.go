@@ -0,0 +1,56 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package origin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetwork(t *testing.T) {
+	tests := map[string]string{
+		"203.0.113.77":        "203.0.113.0/24",
+		"::ffff:203.0.113.77": "203.0.113.0/24",
+		"2001:db8:1:2::1":     "2001:db8:1::/48",
+		"not-an-ip":           "",
+		"":                    "",
+	}
+	for ip, want := range tests {
+		assert.Equal(t, want, Network(ip), ip)
+	}
+}
+
+func TestRegion(t *testing.T) {
+	tests := []struct {
+		headers map[string]string
+		want    string
+	}{
+		{map[string]string{"CloudFront-Viewer-Country": "DE"}, "DE"},
+		{map[string]string{"CF-IPCountry": "us"}, "US"},
+		{map[string]string{"CF-IPCountry": "XX", "X-AppEngine-Country": "FR"}, "FR"},
+		{map[string]string{"CF-IPCountry": "T1"}, ""},
+		{map[string]string{"X-Country": "DE"}, ""},
+		{nil, ""},
+	}
+	for _, tc := range tests {
+		r, _ := http.NewRequest(http.MethodGet, "/", nil)
+		for k, v := range tc.headers {
+			r.Header.Set(k, v)
+		}
+		assert.Equal(t, tc.want, Region(r), tc.headers)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit implements in-memory fixed window request limits used to throttle
+// clients of the registry and to report their remaining budget.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Config describes the limits applied per key. A limit of zero disables it.
+type Config struct {
+	// Limit is the number of requests allowed per Window.
+	Limit  int
+	Window time.Duration
+	// Quota is the number of requests allowed per QuotaWindow.
+	Quota       int
+	QuotaWindow time.Duration
+}
+
+// Usage is the state of the limits of a key after a request was accounted for.
+type Usage struct {
+	Allowed bool
+
+	Limit     int
+	Remaining int
+	Reset     time.Time
+
+	Quota          int
+	QuotaRemaining int
+	QuotaReset     time.Time
+}
+
+// RetryAfter returns how long the client has to wait before the denied request can succeed.
+func (u Usage) RetryAfter(now time.Time) time.Duration {
+	var reset time.Time
+	if u.Limit > 0 && u.Remaining <= 0 {
+		reset = u.Reset
+	}
+	if u.Quota > 0 && u.QuotaRemaining <= 0 && u.QuotaReset.After(reset) {
+		reset = u.QuotaReset
+	}
+	if !reset.After(now) {
+		return 0
+	}
+	return reset.Sub(now)
+}
+
+type window struct {
+	start time.Time
+	count int
+}
+
+// Limiter keeps the request counts of every key in memory. Counts are therefore per instance.
+type Limiter struct {
+	config Config
+	now    func() time.Time
+
+	mu        sync.Mutex
+	windows   map[string]*window
+	quotas    map[string]*window
+	lastSweep time.Time
+}
+
+// New returns a limiter for the given config, or nil if neither a limit nor a quota is configured.
+func New(config Config) *Limiter {
+	if config.Limit <= 0 && config.Quota <= 0 {
+		return nil
+	}
+	return &Limiter{
+		config:  config,
+		now:     time.Now,
+		windows: make(map[string]*window),
+		quotas:  make(map[string]*window),
+	}
+}
+
+// Take accounts for a request of the key and reports whether it is allowed.
+// Denied requests don't consume any of the remaining budget.
+func (l *Limiter) Take(key string) Usage {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	usage := Usage{Allowed: true}
+	var rate, quota *window
+	if l.config.Limit > 0 {
+		rate = current(l.windows, key, now, l.config.Window)
+		usage.Limit = l.config.Limit
+		usage.Reset = rate.start.Add(l.config.Window)
+		usage.Allowed = rate.count < l.config.Limit
+	}
+	if l.config.Quota > 0 {
+		quota = current(l.quotas, key, now, l.config.QuotaWindow)
+		usage.Quota = l.config.Quota
+		usage.QuotaReset = quota.start.Add(l.config.QuotaWindow)
+		usage.Allowed = usage.Allowed && quota.count < l.config.Quota
+	}
+
+	if usage.Allowed {
+		if rate != nil {
+			rate.count++
+		}
+		if quota != nil {
+			quota.count++
+		}
+	}
+	if rate != nil {
+		usage.Remaining = max(l.config.Limit-rate.count, 0)
+	}
+	if quota != nil {
+		usage.QuotaRemaining = max(l.config.Quota-quota.count, 0)
+	}
+	return usage
+}
+
+func current(windows map[string]*window, key string, now time.Time, length time.Duration) *window {
+	w, ok := windows[key]
+	if !ok || !now.Before(w.start.Add(length)) {
+		w = &window{start: now}
+		windows[key] = w
+	}
+	return w
+}
+
+// sweep drops expired windows so keys that stopped sending requests don't accumulate.
+func (l *Limiter) sweep(now time.Time) {
+	interval := max(l.config.Window, l.config.QuotaWindow)
+	if now.Sub(l.lastSweep) < interval {
+		return
+	}
+	l.lastSweep = now
+	for key, w := range l.windows {
+		if !now.Before(w.start.Add(l.config.Window)) {
+			delete(l.windows, key)
+		}
+	}
+	for key, w := range l.quotas {
+		if !now.Before(w.start.Add(l.config.QuotaWindow)) {
+			delete(l.quotas, key)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := New(Config{Limit: 2, Window: time.Minute, Quota: 3, QuotaWindow: time.Hour})
+	l.now = func() time.Time { return now }
+
+	u := l.Take("a")
+	assert.True(t, u.Allowed)
+	assert.Equal(t, 1, u.Remaining)
+	assert.Equal(t, 2, u.QuotaRemaining)
+	assert.Equal(t, now.Add(time.Minute), u.Reset)
+
+	assert.True(t, l.Take("a").Allowed)
+	u = l.Take("a")
+	assert.False(t, u.Allowed)
+	assert.Equal(t, 0, u.Remaining)
+	assert.Equal(t, 1, u.QuotaRemaining)
+	assert.Equal(t, time.Minute, u.RetryAfter(now))
+
+	assert.True(t, l.Take("b").Allowed)
+
+	now = now.Add(time.Minute)
+	u = l.Take("a")
+	assert.True(t, u.Allowed)
+	assert.Equal(t, 0, u.QuotaRemaining)
+
+	u = l.Take("a")
+	assert.False(t, u.Allowed)
+	assert.Equal(t, 1, u.Remaining)
+	assert.Equal(t, 59*time.Minute, u.RetryAfter(now))
+}
+
+func TestNew_Disabled(t *testing.T) {
+	assert.Nil(t, New(Config{}))
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package useragent tells the kind of client downloading an artifact from its User-Agent header.
+package useragent
+
+import (
+	"strings"
+)
+
+// ClientType is the kind of client an artifact is downloaded by.
+type ClientType string
+
+const (
+	ClientTypeDocker ClientType = "DOCKER"
+	// ClientTypeContainerd are the container runtimes pulling images for the kubelet.
+	ClientTypeContainerd ClientType = "CONTAINERD"
+	ClientTypeMaven      ClientType = "MAVEN"
+	ClientTypePip        ClientType = "PIP"
+	ClientTypeBrowser    ClientType = "BROWSER"
+	ClientTypeOther      ClientType = "OTHER"
+)
+
+// clientPrefixes are the products the User-Agent of each client type starts with, lower case.
+var clientPrefixes = []struct {
+	clientType ClientType
+	prefixes   []string
+}{
+	{ClientTypeDocker, []string{"docker/", "docker-client/"}},
+	{ClientTypeContainerd, []string{"containerd/", "kubelet/", "cri-o/"}},
+	{ClientTypeMaven, []string{"apache-maven/", "maven-resolver/", "maven/"}},
+	{ClientTypePip, []string{"pip/"}},
+	// all browsers claim to be Mozilla compatible
+	{ClientTypeBrowser, []string{"mozilla/"}},
+}
+
+// ParseClientType returns the client type of a User-Agent, which is ClientTypeOther if it's unknown.
+func ParseClientType(userAgent string) ClientType {
+	ua := strings.ToLower(strings.TrimSpace(userAgent))
+	for _, c := range clientPrefixes {
+		for _, prefix := range c.prefixes {
+			if strings.HasPrefix(ua, prefix) {
+				return c.clientType
+			}
+		}
+	}
+	return ClientTypeOther
+}
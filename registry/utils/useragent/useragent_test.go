@@ -0,0 +1,41 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package useragent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClientType(t *testing.T) {
+	tests := []struct {
+		userAgent string
+		want      ClientType
+	}{
+		{"docker/24.0.7 go/go1.20.10 git-commit/311b9ff kernel/6.5.0 os/linux arch/amd64 " +
+			"UpstreamClient(Docker-Client/24.0.7 \\(linux\\))", ClientTypeDocker},
+		{"containerd/v1.7.2", ClientTypeContainerd},
+		{"cri-o/1.28.1 go/go1.20.10 os/linux arch/amd64", ClientTypeContainerd},
+		{"Apache-Maven/3.9.5 (Java 17.0.8; Linux 6.5.0)", ClientTypeMaven},
+		{`pip/23.3.1 {"ci":null,"cpu":"x86_64"}`, ClientTypePip},
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:120.0) Gecko/20100101 Firefox/120.0", ClientTypeBrowser},
+		{"curl/8.4.0", ClientTypeOther},
+		{"", ClientTypeOther},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ParseClientType(tt.userAgent), tt.userAgent)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var alpinePattern = regexp.MustCompile(
+	`^([0-9]+(?:\.[0-9]+)*)([a-z]?)((?:_(?:alpha|beta|pre|rc|cvs|svn|git|hg|p)[0-9]*)*)(~[0-9a-f]+)?(-r[0-9]+)?$`)
+
+// alpineSuffixes ranks the suffixes of apk versions, the ones before the empty suffix mark
+// prereleases, which sort before their release, the ones after it sort after the release.
+var alpineSuffixes = []string{"alpha", "beta", "pre", "rc", "", "cvs", "svn", "git", "hg", "p"}
+
+// alpineVersion is a version of an apk, e.g. 1.2.3b_rc1-r2, compared using the apk-tools
+// algorithm.
+type alpineVersion struct {
+	raw      string
+	numbers  []string
+	letter   string
+	suffixes []alpineSuffix
+	revision int64
+}
+
+type alpineSuffix struct {
+	rank   int
+	number int64
+}
+
+func parseAlpine(v string) (version, error) {
+	m := alpinePattern.FindStringSubmatch(v)
+	if m == nil {
+		return nil, fmt.Errorf("%w: %q isn't an apk version", ErrInvalidVersion, v)
+	}
+	a := alpineVersion{raw: v, numbers: strings.Split(m[1], "."), letter: m[2]}
+	for _, s := range strings.Split(m[3], "_")[1:] {
+		name := strings.TrimRight(s, "0123456789")
+		suffix := alpineSuffix{rank: alpineSuffixRank(name)}
+		if digits := s[len(name):]; digits != "" {
+			n, err := strconv.ParseInt(digits, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid suffix in %q", ErrInvalidVersion, v)
+			}
+			suffix.number = n
+		}
+		a.suffixes = append(a.suffixes, suffix)
+	}
+	if m[5] != "" {
+		revision, err := strconv.ParseInt(m[5][len("-r"):], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid revision in %q", ErrInvalidVersion, v)
+		}
+		a.revision = revision
+	}
+	return a, nil
+}
+
+func alpineSuffixRank(name string) int {
+	for i, s := range alpineSuffixes {
+		if s == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (a alpineVersion) String() string {
+	return a.raw
+}
+
+func (a alpineVersion) compare(other version) int {
+	o, ok := other.(alpineVersion)
+	if !ok {
+		return 0
+	}
+	for i := 0; i < len(a.numbers) && i < len(o.numbers); i++ {
+		if c := compareAlpineNumbers(i, a.numbers[i], o.numbers[i]); c != 0 {
+			return c
+		}
+	}
+	if c := compareInts(int64(len(a.numbers)), int64(len(o.numbers))); c != 0 {
+		return c
+	}
+	if c := strings.Compare(a.letter, o.letter); c != 0 {
+		return c
+	}
+	release := alpineSuffix{rank: alpineSuffixRank("")}
+	for i := 0; i < len(a.suffixes) || i < len(o.suffixes); i++ {
+		as, os := release, release
+		if i < len(a.suffixes) {
+			as = a.suffixes[i]
+		}
+		if i < len(o.suffixes) {
+			os = o.suffixes[i]
+		}
+		if c := compareInts(int64(as.rank), int64(os.rank)); c != 0 {
+			return c
+		}
+		if c := compareInts(as.number, os.number); c != 0 {
+			return c
+		}
+	}
+	return compareInts(a.revision, o.revision)
+}
+
+// compareAlpineNumbers compares the numbers of a version, all but the first are compared as decimal
+// fractions if either has a leading zero, so 1.01 < 1.1.
+func compareAlpineNumbers(i int, a, b string) int {
+	if i > 0 && (strings.HasPrefix(a, "0") || strings.HasPrefix(b, "0")) {
+		return strings.Compare(strings.TrimRight(a, "0"), strings.TrimRight(b, "0"))
+	}
+	return compareNumeric(a, b)
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"strings"
+)
+
+// operators are ordered so that longer operators are matched first.
+var operators = []string{"===", "~=", "==", "!=", ">=", "<=", ">>", "<<", "=", ">", "<"}
+
+type clause struct {
+	op      string
+	version string
+}
+
+// parseClauses parses a comma separated list of "<op> <version>" clauses.
+func parseClauses(constraint string) ([]clause, error) {
+	var clauses []clause
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		op := "="
+		for _, candidate := range operators {
+			if strings.HasPrefix(part, candidate) {
+				op = candidate
+				break
+			}
+		}
+		v := strings.TrimSpace(strings.TrimPrefix(part, op))
+		if v == "" {
+			return nil, fmt.Errorf("invalid constraint clause: %q", part)
+		}
+		clauses = append(clauses, clause{op: op, version: v})
+	}
+	if len(clauses) == 0 {
+		return nil, fmt.Errorf("invalid constraint: %q", constraint)
+	}
+	return clauses, nil
+}
+
+// matchOperator evaluates the result of a comparison against an operator.
+func matchOperator(op string, cmp int) (bool, error) {
+	switch op {
+	case "=", "==", "===":
+		return cmp == 0, nil
+	case "!=":
+		return cmp != 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">", ">>":
+		return cmp > 0, nil
+	case "<", "<<":
+		return cmp < 0, nil
+	default:
+		return false, fmt.Errorf("unsupported operator: %q", op)
+	}
+}
+
+// operatorsSatisfy evaluates clauses that all have to match (logical AND).
+func operatorsSatisfy(scheme Scheme, v version, constraint string) (bool, error) {
+	clauses, err := parseClauses(constraint)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range clauses {
+		other, err := parse(scheme, c.version)
+		if err != nil {
+			return false, fmt.Errorf("invalid version %q in constraint: %w", c.version, err)
+		}
+		ok, err := matchOperator(c.op, v.compare(other))
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
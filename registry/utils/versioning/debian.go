@@ -0,0 +1,162 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// debianVersion is a version in the format [epoch:]upstream_version[-debian_revision],
+// compared using the dpkg algorithm.
+type debianVersion struct {
+	epoch    int64
+	upstream string
+	revision string
+}
+
+func parseDebian(v string) (version, error) {
+	d := debianVersion{}
+	if i := strings.Index(v, ":"); i >= 0 {
+		epoch, err := strconv.ParseInt(v[:i], 10, 64)
+		if err != nil || epoch < 0 {
+			return nil, fmt.Errorf("%w: invalid epoch in %q", ErrInvalidVersion, v)
+		}
+		d.epoch = epoch
+		v = v[i+1:]
+	}
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		d.revision = v[i+1:]
+		v = v[:i]
+	}
+	d.upstream = v
+	if d.upstream == "" || !isDigit(d.upstream[0]) {
+		return nil, fmt.Errorf("%w: upstream version must start with a digit in %q", ErrInvalidVersion, v)
+	}
+	for _, c := range d.upstream + d.revision {
+		if !isDebianVersionChar(c) {
+			return nil, fmt.Errorf("%w: invalid character %q", ErrInvalidVersion, c)
+		}
+	}
+	return d, nil
+}
+
+func isDebianVersionChar(c rune) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+		strings.ContainsRune(".+~-:", c)
+}
+
+func (d debianVersion) String() string {
+	s := d.upstream
+	if d.epoch != 0 {
+		s = strconv.FormatInt(d.epoch, 10) + ":" + s
+	}
+	if d.revision != "" {
+		s += "-" + d.revision
+	}
+	return s
+}
+
+func (d debianVersion) compare(other version) int {
+	o, ok := other.(debianVersion)
+	if !ok {
+		return 0
+	}
+	if c := compareInts(d.epoch, o.epoch); c != 0 {
+		return c
+	}
+	if c := dpkgCompare(d.upstream, o.upstream); c != 0 {
+		return c
+	}
+	return dpkgCompare(d.revision, o.revision)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// dpkgOrder ranks characters so that '~' sorts before everything, even the end of the string,
+// and letters sort before non-letters.
+func dpkgOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case isDigit(c):
+		return 0
+	case isAlpha(c):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+// dpkgCompare is a port of verrevcmp from dpkg.
+func dpkgCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		firstDiff := 0
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			ac, bc := dpkgOrder(a, i), dpkgOrder(b, j)
+			if ac != bc {
+				return sign(ac - bc)
+			}
+			i++
+			j++
+		}
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 {
+				firstDiff = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return sign(firstDiff)
+		}
+	}
+	return 0
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
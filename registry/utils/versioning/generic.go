@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"strings"
+)
+
+// genericVersion compares versions naturally: runs of digits are compared numerically and
+// everything else lexically, so "1.10" > "1.9" and "build-12" > "build-2".
+type genericVersion struct {
+	raw   string
+	parts []string
+}
+
+func parseGeneric(v string) genericVersion {
+	v = strings.TrimSpace(v)
+	var parts []string
+	start := 0
+	for i := 1; i <= len(v); i++ {
+		if i == len(v) || isDigit(v[i]) != isDigit(v[i-1]) {
+			parts = append(parts, v[start:i])
+			start = i
+		}
+	}
+	return genericVersion{raw: v, parts: parts}
+}
+
+func (g genericVersion) String() string {
+	return g.raw
+}
+
+func (g genericVersion) compare(other version) int {
+	o, ok := other.(genericVersion)
+	if !ok {
+		return 0
+	}
+	for i := 0; i < len(g.parts) && i < len(o.parts); i++ {
+		a, b := g.parts[i], o.parts[i]
+		aNum, bNum := isDigit(a[0]), isDigit(b[0])
+		var c int
+		switch {
+		case aNum && bNum:
+			c = compareNumeric(a, b)
+		case aNum:
+			c = 1
+		case bNum:
+			c = -1
+		default:
+			c = strings.Compare(a, b)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareInts(int64(len(g.parts)), int64(len(o.parts)))
+}
+
+// compareNumeric compares arbitrarily long digit strings.
+func compareNumeric(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if c := compareInts(int64(len(a)), int64(len(b))); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+)
+
+// CompileLatestVersionPattern validates the pattern used by the REGEX latest version strategy.
+func CompileLatestVersionPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("a latest version pattern is required for the %s strategy",
+			artifact.LatestVersionStrategyREGEX)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latest version pattern %q: %w", pattern, err)
+	}
+	if re.NumSubexp() > 1 {
+		return nil, fmt.Errorf("latest version pattern %q must have at most one capture group", pattern)
+	}
+	return re, nil
+}
+
+// LatestByStrategy picks the latest of the versions according to the strategy. The versions
+// must be ordered by push time, most recent first; it's also the fallback when no version
+// qualifies for the strategy. For the REGEX strategy, versions not matching the pattern are
+// ignored and the extracted part (first capture group or full match) is compared.
+func LatestByStrategy(
+	strategy artifact.LatestVersionStrategy,
+	pattern *regexp.Regexp,
+	scheme Scheme,
+	versions []string,
+) string {
+	if len(versions) == 0 {
+		return ""
+	}
+	switch strategy { //nolint:exhaustive
+	case artifact.LatestVersionStrategySEMVER:
+		latest := ""
+		for _, v := range versions {
+			if Valid(scheme, v) && (latest == "" || Compare(scheme, v, latest) > 0) {
+				latest = v
+			}
+		}
+		if latest != "" {
+			return latest
+		}
+	case artifact.LatestVersionStrategyREGEX:
+		if pattern == nil {
+			break
+		}
+		latest, latestKey := "", ""
+		for _, v := range versions {
+			match := pattern.FindStringSubmatch(v)
+			if match == nil {
+				continue
+			}
+			key := match[len(match)-1]
+			if latest == "" || Compare(SchemeSemver, key, latestKey) > 0 {
+				latest, latestKey = v, key
+			}
+		}
+		if latest != "" {
+			return latest
+		}
+	}
+	return versions[0]
+}
+
+// LatestForRegistry picks the latest of the versions of an artifact according to the latest
+// version strategy configured on its registry. The versions must be ordered by push time, most
+// recent first; an invalid pattern falls back to the last pushed version.
+func LatestForRegistry(registry *types.Registry, versions []string) string {
+	pattern, _ := CompileLatestVersionPattern(registry.LatestVersionPattern)
+	return LatestByStrategy(registry.LatestVersionStrategy, pattern, SchemeForPackageType(registry.PackageType),
+		versions)
+}
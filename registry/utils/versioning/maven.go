@@ -0,0 +1,346 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// The maven implementation follows org.apache.maven.artifact.versioning.ComparableVersion.
+
+var mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+var mavenAliases = map[string]string{
+	"ga":      "",
+	"final":   "",
+	"release": "",
+	"cr":      "rc",
+}
+
+type mavenItem interface {
+	compareItem(other mavenItem) int
+	isNull() bool
+	String() string
+}
+
+type mavenInt struct {
+	value *big.Int
+}
+
+type mavenString struct {
+	value string
+}
+
+type mavenList struct {
+	items []mavenItem
+}
+
+type mavenVersion struct {
+	items *mavenList
+}
+
+func parseMaven(v string) version {
+	v = strings.ToLower(v)
+	root := &mavenList{}
+	list := root
+	stack := []*mavenList{root}
+
+	isDigit := false
+	start := 0
+	pushList := func() {
+		next := &mavenList{}
+		list.items = append(list.items, next)
+		list = next
+		stack = append(stack, next)
+	}
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case c == '.':
+			if i == start {
+				list.items = append(list.items, newMavenInt("0"))
+			} else {
+				list.items = append(list.items, parseMavenItem(isDigit, false, v[start:i]))
+			}
+			start = i + 1
+		case c == '-':
+			if i == start {
+				list.items = append(list.items, newMavenInt("0"))
+			} else {
+				list.items = append(list.items, parseMavenItem(isDigit, false, v[start:i]))
+			}
+			start = i + 1
+			pushList()
+		case c >= '0' && c <= '9':
+			if !isDigit && i > start {
+				list.items = append(list.items, parseMavenItem(false, true, v[start:i]))
+				start = i
+				pushList()
+			}
+			isDigit = true
+		default:
+			if isDigit && i > start {
+				list.items = append(list.items, parseMavenItem(true, false, v[start:i]))
+				start = i
+				pushList()
+			}
+			isDigit = false
+		}
+	}
+	if len(v) > start {
+		list.items = append(list.items, parseMavenItem(isDigit, false, v[start:]))
+	}
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+	return mavenVersion{items: root}
+}
+
+func parseMavenItem(isDigit bool, followedByDigit bool, s string) mavenItem {
+	if isDigit {
+		return newMavenInt(s)
+	}
+	if followedByDigit && len(s) == 1 {
+		switch s {
+		case "a":
+			s = "alpha"
+		case "b":
+			s = "beta"
+		case "m":
+			s = "milestone"
+		}
+	}
+	if alias, ok := mavenAliases[s]; ok {
+		s = alias
+	}
+	return mavenString{value: s}
+}
+
+func newMavenInt(s string) mavenInt {
+	value, ok := new(big.Int).SetString(strings.TrimLeft(s, "0"), 10)
+	if !ok {
+		value = big.NewInt(0)
+	}
+	return mavenInt{value: value}
+}
+
+func (m mavenVersion) compare(other version) int {
+	o, ok := other.(mavenVersion)
+	if !ok {
+		return 0
+	}
+	return m.items.compareItem(o.items)
+}
+
+func (m mavenVersion) String() string {
+	s := m.items.String()
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+func (i mavenInt) isNull() bool {
+	return i.value.Sign() == 0
+}
+
+func (i mavenInt) String() string {
+	return i.value.String()
+}
+
+func (i mavenInt) compareItem(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case mavenInt:
+		return i.value.Cmp(o.value)
+	default:
+		// 1.1 > 1-sp and 1.1 > 1-1
+		return 1
+	}
+}
+
+func comparableQualifier(q string) string {
+	for i, known := range mavenQualifiers {
+		if known == q {
+			return strconv.Itoa(i)
+		}
+	}
+	return strconv.Itoa(len(mavenQualifiers)) + "-" + q
+}
+
+func (s mavenString) isNull() bool {
+	return s.value == ""
+}
+
+func (s mavenString) String() string {
+	return s.value
+}
+
+func (s mavenString) compareItem(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		return strings.Compare(comparableQualifier(s.value), comparableQualifier(""))
+	case mavenString:
+		return strings.Compare(comparableQualifier(s.value), comparableQualifier(o.value))
+	default:
+		// 1.any < 1.1 and 1-any < 1-1
+		return -1
+	}
+}
+
+func (l *mavenList) isNull() bool {
+	return len(l.items) == 0
+}
+
+func (l *mavenList) normalize() {
+	for i := len(l.items) - 1; i >= 0; i-- {
+		item := l.items[i]
+		if item.isNull() {
+			l.items = append(l.items[:i], l.items[i+1:]...)
+			continue
+		}
+		if _, ok := item.(*mavenList); !ok {
+			break
+		}
+	}
+}
+
+func (l *mavenList) String() string {
+	var b strings.Builder
+	for _, item := range l.items {
+		if b.Len() > 0 {
+			if _, ok := item.(*mavenList); ok {
+				b.WriteByte('-')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteString(item.String())
+	}
+	return b.String()
+}
+
+func (l *mavenList) compareItem(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		for _, item := range l.items {
+			if result := item.compareItem(nil); result != 0 {
+				return result
+			}
+		}
+		return 0
+	case mavenInt:
+		return -1
+	case mavenString:
+		return 1
+	case *mavenList:
+		for i := 0; i < len(l.items) || i < len(o.items); i++ {
+			var left, right mavenItem
+			if i < len(l.items) {
+				left = l.items[i]
+			}
+			if i < len(o.items) {
+				right = o.items[i]
+			}
+			var result int
+			switch {
+			case left == nil && right == nil:
+				result = 0
+			case left == nil:
+				result = -right.compareItem(nil)
+			default:
+				result = left.compareItem(right)
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	default:
+		return 0
+	}
+}
+
+// mavenSatisfies evaluates maven version ranges like "[1.0,2.0)", "(,1.5]" or "[1.2]".
+// Multiple ranges separated by commas match if any of them matches. A bare version
+// is treated as an exact match.
+func mavenSatisfies(v version, constraint string) (bool, error) {
+	if !strings.ContainsAny(constraint, "[(") {
+		return v.compare(parseMaven(constraint)) == 0, nil
+	}
+	rest := constraint
+	for rest != "" {
+		rest = strings.TrimLeft(rest, ", ")
+		if rest == "" {
+			break
+		}
+		if rest[0] != '[' && rest[0] != '(' {
+			return false, fmt.Errorf("invalid maven range: %q", constraint)
+		}
+		end := strings.IndexAny(rest, "])")
+		if end < 0 {
+			return false, fmt.Errorf("unbounded maven range: %q", constraint)
+		}
+		ok, err := mavenRestrictionMatches(v, rest[:end+1])
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+		rest = rest[end+1:]
+	}
+	return false, nil
+}
+
+func mavenRestrictionMatches(v version, restriction string) (bool, error) {
+	lowerInclusive := restriction[0] == '['
+	upperInclusive := restriction[len(restriction)-1] == ']'
+	body := restriction[1 : len(restriction)-1]
+
+	bounds := strings.Split(body, ",")
+	switch len(bounds) {
+	case 1:
+		if !lowerInclusive || !upperInclusive {
+			return false, fmt.Errorf("single version range must be inclusive: %q", restriction)
+		}
+		return v.compare(parseMaven(strings.TrimSpace(body))) == 0, nil
+	case 2:
+		lower := strings.TrimSpace(bounds[0])
+		upper := strings.TrimSpace(bounds[1])
+		if lower != "" {
+			cmp := v.compare(parseMaven(lower))
+			if cmp < 0 || (cmp == 0 && !lowerInclusive) {
+				return false, nil
+			}
+		}
+		if upper != "" {
+			cmp := v.compare(parseMaven(upper))
+			if cmp > 0 || (cmp == 0 && !upperInclusive) {
+				return false, nil
+			}
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid maven range: %q", restriction)
+	}
+}
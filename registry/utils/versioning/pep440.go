@@ -0,0 +1,285 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pep440Pattern is the version pattern published in PEP 440, Appendix B.
+var pep440Pattern = regexp.MustCompile(`(?i)^v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_\.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_\.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>(?:-(?P<post_n1>[0-9]+))|(?:[-_\.]?(?P<post_l>post|rev|r)[-_\.]?(?P<post_n2>[0-9]+)?))?` +
+	`(?P<dev>[-_\.]?(?P<dev_l>dev)[-_\.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_\.][a-z0-9]+)*))?$`)
+
+var pep440PreReleases = map[string]string{
+	"a":       "a",
+	"alpha":   "a",
+	"b":       "b",
+	"beta":    "b",
+	"c":       "rc",
+	"rc":      "rc",
+	"pre":     "rc",
+	"preview": "rc",
+}
+
+var pep440PreReleaseOrder = map[string]int64{"a": 0, "b": 1, "rc": 2}
+
+type pep440Version struct {
+	epoch   int64
+	release []int64
+	pre     string
+	preN    int64
+	hasPost bool
+	post    int64
+	hasDev  bool
+	dev     int64
+	local   []string
+}
+
+func parsePEP440(v string) (version, error) {
+	p, err := parsePEP440Version(v)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func parsePEP440Version(v string) (pep440Version, error) {
+	match := pep440Pattern.FindStringSubmatch(v)
+	if match == nil {
+		return pep440Version{}, ErrInvalidVersion
+	}
+	group := func(name string) string {
+		return match[pep440Pattern.SubexpIndex(name)]
+	}
+	number := func(s string) int64 {
+		n, _ := strconv.ParseInt(s, 10, 64)
+		return n
+	}
+
+	p := pep440Version{epoch: number(group("epoch"))}
+	for _, part := range strings.Split(group("release"), ".") {
+		p.release = append(p.release, number(part))
+	}
+	if l := group("pre_l"); l != "" {
+		p.pre = pep440PreReleases[strings.ToLower(l)]
+		p.preN = number(group("pre_n"))
+	}
+	if group("post") != "" {
+		p.hasPost = true
+		p.post = number(group("post_n1") + group("post_n2"))
+	}
+	if group("dev") != "" {
+		p.hasDev = true
+		p.dev = number(group("dev_n"))
+	}
+	if local := group("local"); local != "" {
+		p.local = strings.FieldsFunc(strings.ToLower(local), func(r rune) bool {
+			return r == '-' || r == '_' || r == '.'
+		})
+	}
+	return p, nil
+}
+
+func (p pep440Version) String() string {
+	var b strings.Builder
+	if p.epoch != 0 {
+		fmt.Fprintf(&b, "%d!", p.epoch)
+	}
+	for i, r := range p.release {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(strconv.FormatInt(r, 10))
+	}
+	if p.pre != "" {
+		fmt.Fprintf(&b, "%s%d", p.pre, p.preN)
+	}
+	if p.hasPost {
+		fmt.Fprintf(&b, ".post%d", p.post)
+	}
+	if p.hasDev {
+		fmt.Fprintf(&b, ".dev%d", p.dev)
+	}
+	if len(p.local) > 0 {
+		b.WriteByte('+')
+		b.WriteString(strings.Join(p.local, "."))
+	}
+	return b.String()
+}
+
+// compare implements the ordering of PEP 440, where "1.0.dev1" < "1.0a1" < "1.0" < "1.0.post1".
+func (p pep440Version) compare(other version) int {
+	o, ok := other.(pep440Version)
+	if !ok {
+		return 0
+	}
+	if c := compareInts(p.epoch, o.epoch); c != 0 {
+		return c
+	}
+	if c := compareReleases(p.release, o.release); c != 0 {
+		return c
+	}
+	if c := compareInts(p.preKey(), o.preKey()); c != 0 {
+		return c
+	}
+	if p.pre != "" && o.pre != "" {
+		if c := compareInts(p.preN, o.preN); c != 0 {
+			return c
+		}
+	}
+	if c := compareOptional(p.hasPost, p.post, o.hasPost, o.post, -1); c != 0 {
+		return c
+	}
+	if c := compareOptional(p.hasDev, p.dev, o.hasDev, o.dev, 1); c != 0 {
+		return c
+	}
+	return compareLocal(p.local, o.local)
+}
+
+// preKey places dev-only releases before pre-releases and final releases after them.
+func (p pep440Version) preKey() int64 {
+	switch {
+	case p.pre == "" && !p.hasPost && p.hasDev:
+		return -1
+	case p.pre == "":
+		return int64(len(pep440PreReleaseOrder))
+	default:
+		return pep440PreReleaseOrder[p.pre]
+	}
+}
+
+// compareOptional compares optional numbers where a missing value sorts as the given sign of infinity.
+func compareOptional(hasA bool, a int64, hasB bool, b int64, missing int) int {
+	switch {
+	case hasA && hasB:
+		return compareInts(a, b)
+	case !hasA && !hasB:
+		return 0
+	case !hasA:
+		return missing
+	default:
+		return -missing
+	}
+}
+
+func compareReleases(a, b []int64) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int64
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if c := compareInts(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareLocal(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, errX := strconv.ParseInt(a[i], 10, 64)
+		y, errY := strconv.ParseInt(b[i], 10, 64)
+		var c int
+		switch {
+		case errX == nil && errY == nil:
+			c = compareInts(x, y)
+		case errX == nil:
+			c = 1
+		case errY == nil:
+			c = -1
+		default:
+			c = strings.Compare(a[i], b[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareInts(int64(len(a)), int64(len(b)))
+}
+
+// pep440Satisfies evaluates PEP 440 version specifiers like ">=1.0,<2", "==1.4.*" or "~=2.2".
+func pep440Satisfies(v version, constraint string) (bool, error) {
+	clauses, err := parseClauses(constraint)
+	if err != nil {
+		return false, err
+	}
+	p, ok := v.(pep440Version)
+	if !ok {
+		return false, ErrInvalidVersion
+	}
+	for _, c := range clauses {
+		ok, err := pep440ClauseMatches(p, c)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func pep440ClauseMatches(p pep440Version, c clause) (bool, error) {
+	if (c.op == "==" || c.op == "!=") && strings.HasSuffix(c.version, ".*") {
+		prefix, err := parsePEP440Version(strings.TrimSuffix(c.version, ".*"))
+		if err != nil {
+			return false, fmt.Errorf("invalid version %q in specifier: %w", c.version, err)
+		}
+		return hasReleasePrefix(p, prefix) == (c.op == "=="), nil
+	}
+	if c.op == "===" {
+		return strings.EqualFold(p.String(), c.version), nil
+	}
+
+	other, err := parsePEP440Version(c.version)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q in specifier: %w", c.version, err)
+	}
+	if c.op == "~=" {
+		if len(other.release) < 2 {
+			return false, fmt.Errorf("compatible release specifier requires at least two components: %q", c.version)
+		}
+		prefix := pep440Version{epoch: other.epoch, release: other.release[:len(other.release)-1]}
+		return p.compare(other) >= 0 && hasReleasePrefix(p, prefix), nil
+	}
+	return matchOperator(c.op, p.compare(other))
+}
+
+func hasReleasePrefix(p pep440Version, prefix pep440Version) bool {
+	if p.epoch != prefix.epoch {
+		return false
+	}
+	for i, r := range prefix.release {
+		var current int64
+		if i < len(p.release) {
+			current = p.release[i]
+		}
+		if current != r {
+			return false
+		}
+	}
+	return true
+}
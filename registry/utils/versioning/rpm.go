@@ -0,0 +1,164 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// rpmVersion is a version in the format [epoch:]version[-release], compared using the rpm
+// algorithm.
+type rpmVersion struct {
+	epoch   int64
+	version string
+	release string
+}
+
+func parseRPM(v string) (version, error) {
+	r := rpmVersion{}
+	if i := strings.Index(v, ":"); i >= 0 {
+		epoch, err := strconv.ParseInt(v[:i], 10, 64)
+		if err != nil || epoch < 0 {
+			return nil, fmt.Errorf("%w: invalid epoch in %q", ErrInvalidVersion, v)
+		}
+		r.epoch = epoch
+		v = v[i+1:]
+	}
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		r.release = v[i+1:]
+		v = v[:i]
+	}
+	r.version = v
+	if r.version == "" || strings.ContainsAny(r.version+r.release, ":- \t") {
+		return nil, fmt.Errorf("%w: invalid rpm version %q", ErrInvalidVersion, v)
+	}
+	return r, nil
+}
+
+func (r rpmVersion) String() string {
+	s := r.version
+	if r.epoch != 0 {
+		s = strconv.FormatInt(r.epoch, 10) + ":" + s
+	}
+	if r.release != "" {
+		s += "-" + r.release
+	}
+	return s
+}
+
+func (r rpmVersion) compare(other version) int {
+	o, ok := other.(rpmVersion)
+	if !ok {
+		return 0
+	}
+	if c := compareInts(r.epoch, o.epoch); c != 0 {
+		return c
+	}
+	if c := rpmCompare(r.version, o.version); c != 0 {
+		return c
+	}
+	return rpmCompare(r.release, o.release)
+}
+
+// rpmCompare is a port of rpmvercmp from rpm: versions are compared by their alphanumeric
+// segments, numeric segments are newer than alphabetic ones, '~' sorts before everything, even the
+// end of the version, and '^' sorts after the end of the version but before any other segment.
+func rpmCompare(a, b string) int {
+	if a == b {
+		return 0
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isAlphanumeric(a[i]) && a[i] != '~' && a[i] != '^' {
+			i++
+		}
+		for j < len(b) && !isAlphanumeric(b[j]) && b[j] != '~' && b[j] != '^' {
+			j++
+		}
+
+		if (i < len(a) && a[i] == '~') || (j < len(b) && b[j] == '~') {
+			if i >= len(a) || a[i] != '~' {
+				return 1
+			}
+			if j >= len(b) || b[j] != '~' {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+		if (i < len(a) && a[i] == '^') || (j < len(b) && b[j] == '^') {
+			switch {
+			case i >= len(a):
+				return -1
+			case j >= len(b):
+				return 1
+			case a[i] != '^':
+				return 1
+			case b[j] != '^':
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		numeric := isDigit(a[i])
+		segment := isAlpha
+		if numeric {
+			segment = isDigit
+		}
+		si, sj := i, j
+		for i < len(a) && segment(a[i]) {
+			i++
+		}
+		for j < len(b) && segment(b[j]) {
+			j++
+		}
+		if sj == j {
+			// segments of different types: numeric segments are newer
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+		var c int
+		if numeric {
+			c = compareNumeric(a[si:i], b[sj:j])
+		} else {
+			c = strings.Compare(a[si:i], b[sj:j])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i < len(a):
+		return 1
+	default:
+		return -1
+	}
+}
+
+func isAlphanumeric(c byte) bool {
+	return isDigit(c) || isAlpha(c)
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var (
+	// rubyGemsPattern is the pattern of Gem::Version, with underscores allowed after the hyphen for the
+	// versions of platform gems the registry stores, like 1.16.0-x86_64-linux.
+	rubyGemsPattern        = regexp.MustCompile(`^[0-9]+(\.[0-9a-zA-Z]+)*(-[0-9A-Za-z_-]+(\.[0-9A-Za-z_-]+)*)?$`)
+	rubyGemsSegmentPattern = regexp.MustCompile(`[0-9]+|[a-zA-Z]+`)
+)
+
+// rubyGemsVersion is a version compared like Gem::Version: it's split into numeric and alphabetic
+// segments, and a version with a letter is a prerelease which sorts before its release, e.g.
+// "1.0.0.pre1" < "1.0.0". A hyphen starts a prerelease too, "1.0-rc1" is "1.0.pre.rc1".
+type rubyGemsVersion struct {
+	raw      string
+	segments []string
+	// canonical are the segments without the trailing zeros of the release and the prerelease,
+	// so "1.0" equals "1" and "1.0.a" equals "1.a".
+	canonical []string
+}
+
+func parseRubyGems(v string) (version, error) {
+	if !rubyGemsPattern.MatchString(v) {
+		return nil, fmt.Errorf("%w: %q isn't a RubyGems version", ErrInvalidVersion, v)
+	}
+	segments := rubyGemsSegmentPattern.FindAllString(strings.ReplaceAll(v, "-", ".pre."), -1)
+	release := len(segments)
+	for i, s := range segments {
+		if !isDigit(s[0]) {
+			release = i
+			break
+		}
+	}
+	canonical := append(trimZeroSegments(segments[:release]), trimZeroSegments(segments[release:])...)
+	return rubyGemsVersion{raw: v, segments: segments, canonical: canonical}, nil
+}
+
+func trimZeroSegments(segments []string) []string {
+	end := len(segments)
+	for end > 0 && isZeroSegment(segments[end-1]) {
+		end--
+	}
+	return append([]string(nil), segments[:end]...)
+}
+
+func isZeroSegment(s string) bool {
+	return isDigit(s[0]) && strings.Trim(s, "0") == ""
+}
+
+func (r rubyGemsVersion) String() string {
+	return r.raw
+}
+
+func (r rubyGemsVersion) compare(other version) int {
+	o, ok := other.(rubyGemsVersion)
+	if !ok {
+		return 0
+	}
+	for i := 0; i < len(r.canonical) || i < len(o.canonical); i++ {
+		a, b := segmentAt(r.canonical, i), segmentAt(o.canonical, i)
+		aNum, bNum := isDigit(a[0]), isDigit(b[0])
+		var c int
+		switch {
+		case aNum && bNum:
+			c = compareNumeric(a, b)
+		case aNum:
+			c = 1
+		case bNum:
+			c = -1
+		default:
+			c = strings.Compare(a, b)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// segmentAt returns the segment at the index, versions are padded with zeros.
+func segmentAt(segments []string, i int) string {
+	if i < len(segments) {
+		return segments[i]
+	}
+	return "0"
+}
+
+// bump returns the version the pessimistic constraint "~> v" stops before: the prerelease and the
+// last release segment are dropped and the new last segment incremented, so "1.2.3" bumps to "1.3".
+func (r rubyGemsVersion) bump() (version, error) {
+	release := make([]string, 0, len(r.segments))
+	for _, s := range r.segments {
+		if !isDigit(s[0]) {
+			break
+		}
+		release = append(release, s)
+	}
+	if len(release) > 1 {
+		release = release[:len(release)-1]
+	}
+	last, _ := new(big.Int).SetString(release[len(release)-1], 10)
+	release[len(release)-1] = last.Add(last, big.NewInt(1)).String()
+	return parseRubyGems(strings.Join(release, "."))
+}
+
+// rubyGemsSatisfies evaluates comma separated requirements like ">= 1.0, < 2" or "~> 1.2", all of
+// which have to match.
+func rubyGemsSatisfies(v version, constraint string) (bool, error) {
+	for _, part := range strings.Split(constraint, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		base, pessimistic := strings.CutPrefix(part, "~>")
+		if !pessimistic {
+			ok, err := operatorsSatisfy(SchemeRubyGems, v, part)
+			if err != nil || !ok {
+				return false, err
+			}
+			continue
+		}
+		lower, err := parseRubyGems(strings.TrimSpace(base))
+		if err != nil {
+			return false, fmt.Errorf("invalid version %q in constraint: %w", base, err)
+		}
+		upper, err := lower.(rubyGemsVersion).bump()
+		if err != nil {
+			return false, err
+		}
+		if v.compare(lower) < 0 || v.compare(upper) >= 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+type semverVersion struct {
+	v *semver.Version
+}
+
+// parseSemver accepts semantic versions leniently, e.g. "v1.2" is read as "1.2.0".
+func parseSemver(v string) (version, error) {
+	parsed, err := semver.NewVersion(v)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidVersion, err)
+	}
+	return semverVersion{v: parsed}, nil
+}
+
+func (s semverVersion) compare(other version) int {
+	o, ok := other.(semverVersion)
+	if !ok {
+		return 0
+	}
+	return s.v.Compare(o.v)
+}
+
+func (s semverVersion) String() string {
+	return s.v.String()
+}
+
+func semverSatisfies(v version, constraint string) (bool, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("invalid semver constraint %q: %w", constraint, err)
+	}
+	s, ok := v.(semverVersion)
+	if !ok {
+		return false, ErrInvalidVersion
+	}
+	return c.Check(s.v), nil
+}
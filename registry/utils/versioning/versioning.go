@@ -0,0 +1,213 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package versioning normalizes and compares version strings according to the
+// rules of the different package ecosystems supported by the registry.
+package versioning
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+)
+
+// Scheme identifies the set of rules used to interpret a version string.
+type Scheme string
+
+const (
+	SchemeSemver   Scheme = "SEMVER"
+	SchemeMaven    Scheme = "MAVEN"
+	SchemePEP440   Scheme = "PEP440"
+	SchemeDebian   Scheme = "DEBIAN"
+	SchemeRubyGems Scheme = "RUBYGEMS"
+	SchemeRPM      Scheme = "RPM"
+	SchemeAlpine   Scheme = "ALPINE"
+	SchemeGeneric  Scheme = "GENERIC"
+)
+
+var ErrInvalidVersion = errors.New("invalid version")
+
+// Schemes returns all supported schemes.
+func Schemes() []Scheme {
+	return []Scheme{
+		SchemeSemver, SchemeMaven, SchemePEP440, SchemeDebian, SchemeRubyGems, SchemeRPM, SchemeAlpine,
+		SchemeGeneric,
+	}
+}
+
+// ParseScheme validates the given scheme name.
+func ParseScheme(s string) (Scheme, error) {
+	for _, scheme := range Schemes() {
+		if strings.EqualFold(string(scheme), s) {
+			return scheme, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported version scheme: %q", s)
+}
+
+// SchemeForPackageType returns the version scheme used by the ecosystem of the package type.
+func SchemeForPackageType(packageType artifact.PackageType) Scheme {
+	switch packageType { //nolint:exhaustive
+	case artifact.PackageTypeMAVEN:
+		return SchemeMaven
+	case artifact.PackageTypePYTHON, artifact.PackageTypeCONDA:
+		return SchemePEP440
+	case artifact.PackageTypeDOCKER, artifact.PackageTypeHELM, artifact.PackageTypeNPM,
+		artifact.PackageTypeNUGET, artifact.PackageTypeCRATE, artifact.PackageTypeGO,
+		artifact.PackageTypeCONAN, artifact.PackageTypeCOMPOSER, artifact.PackageTypeTERRAFORM,
+		artifact.PackageTypeSWIFT, artifact.PackageTypeCOCOAPODS, artifact.PackageTypeHEX,
+		artifact.PackageTypePUB, artifact.PackageTypeGALAXY, artifact.PackageTypePUPPET,
+		artifact.PackageTypeVAGRANT:
+		return SchemeSemver
+	case artifact.PackageTypeDEB:
+		return SchemeDebian
+	case artifact.PackageTypeGEMS:
+		return SchemeRubyGems
+	case artifact.PackageTypeRPM:
+		return SchemeRPM
+	case artifact.PackageTypeALPINE:
+		return SchemeAlpine
+	// CRAN versions are numbers separated by "." or "-", Homebrew bottles append a "_1" revision,
+	// and model versions are free-form labels such as "v3" or "2024-05-01"; all compare naturally.
+	case artifact.PackageTypeCRAN, artifact.PackageTypeMODEL, artifact.PackageTypeHOMEBREW:
+		return SchemeGeneric
+	default:
+		return SchemeGeneric
+	}
+}
+
+// version is a parsed version of a given scheme.
+type version interface {
+	compare(other version) int
+	String() string
+}
+
+func parse(scheme Scheme, v string) (version, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil, ErrInvalidVersion
+	}
+	switch scheme {
+	case SchemeSemver:
+		return parseSemver(v)
+	case SchemeMaven:
+		return parseMaven(v), nil
+	case SchemePEP440:
+		return parsePEP440(v)
+	case SchemeDebian:
+		return parseDebian(v)
+	case SchemeRubyGems:
+		return parseRubyGems(v)
+	case SchemeRPM:
+		return parseRPM(v)
+	case SchemeAlpine:
+		return parseAlpine(v)
+	case SchemeGeneric:
+		return parseGeneric(v), nil
+	default:
+		return nil, fmt.Errorf("unsupported version scheme: %q", scheme)
+	}
+}
+
+// Valid reports whether the version can be parsed by the scheme.
+func Valid(scheme Scheme, v string) bool {
+	_, err := parse(scheme, v)
+	return err == nil
+}
+
+// Normalize returns the canonical form of the version for the given scheme.
+func Normalize(scheme Scheme, v string) (string, error) {
+	parsed, err := parse(scheme, v)
+	if err != nil {
+		return "", fmt.Errorf("failed to normalize %q as %s version: %w", v, scheme, err)
+	}
+	return parsed.String(), nil
+}
+
+// Compare compares two versions and returns -1, 0 or 1. Versions that can't be parsed
+// by the scheme sort before valid versions and are compared naturally among themselves.
+func Compare(scheme Scheme, a, b string) int {
+	va, errA := parse(scheme, a)
+	vb, errB := parse(scheme, b)
+	switch {
+	case errA == nil && errB == nil:
+		return va.compare(vb)
+	case errA != nil && errB != nil:
+		return parseGeneric(a).compare(parseGeneric(b))
+	case errA != nil:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Sort sorts the versions in ascending order.
+func Sort(scheme Scheme, versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return Compare(scheme, versions[i], versions[j]) < 0
+	})
+}
+
+// Latest returns the highest of the versions, or an empty string if there are none.
+func Latest(scheme Scheme, versions []string) string {
+	latest := ""
+	for _, v := range versions {
+		if latest == "" || Compare(scheme, v, latest) > 0 {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// Satisfies reports whether the version is part of the range expressed in the
+// native syntax of the scheme, e.g. "^1.2" for semver, "[1.0,2.0)" for maven,
+// ">=1.0,<2" for PEP 440, ">= 1.0, << 2.0" for debian or "~> 1.2" for RubyGems.
+func Satisfies(scheme Scheme, v string, constraint string) (bool, error) {
+	constraint = strings.TrimSpace(constraint)
+	if constraint == "" || constraint == "*" {
+		return true, nil
+	}
+	parsed, err := parse(scheme, v)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %q as %s version: %w", v, scheme, err)
+	}
+	switch scheme {
+	case SchemeSemver:
+		return semverSatisfies(parsed, constraint)
+	case SchemeMaven:
+		return mavenSatisfies(parsed, constraint)
+	case SchemePEP440:
+		return pep440Satisfies(parsed, constraint)
+	case SchemeRubyGems:
+		return rubyGemsSatisfies(parsed, constraint)
+	case SchemeDebian, SchemeRPM, SchemeAlpine, SchemeGeneric:
+		return operatorsSatisfy(scheme, parsed, constraint)
+	default:
+		return false, fmt.Errorf("unsupported version scheme: %q", scheme)
+	}
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
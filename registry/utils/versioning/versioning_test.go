@@ -0,0 +1,243 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package versioning
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/app/api/openapi/contracts/artifact"
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		scheme Scheme
+		a      string
+		b      string
+		want   int
+	}{
+		{SchemeSemver, "1.2.0", "v1.2", 0},
+		{SchemeSemver, "1.10.0", "1.9.0", 1},
+		{SchemeSemver, "1.0.0-rc.1", "1.0.0", -1},
+		{SchemeMaven, "1.0", "1.0.0", 0},
+		{SchemeMaven, "1.0-alpha-1", "1.0", -1},
+		{SchemeMaven, "1.0-SNAPSHOT", "1.0", -1},
+		{SchemeMaven, "1.0a1", "1.0-alpha-1", 0},
+		{SchemeMaven, "1.0-rc1", "1.0-cr1", 0},
+		{SchemeMaven, "1.0.1", "1.0-sp1", 1},
+		{SchemeMaven, "1.0-final", "1.0", 0},
+		{SchemeMaven, "1.10", "1.9", 1},
+		{SchemePEP440, "1.0.dev1", "1.0a1", -1},
+		{SchemePEP440, "1.0a1", "1.0", -1},
+		{SchemePEP440, "1.0", "1.0.post1", -1},
+		{SchemePEP440, "1.0.0", "1.0", 0},
+		{SchemePEP440, "1!0.1", "2.0", 1},
+		{SchemePEP440, "1.0+local.2", "1.0+local.10", -1},
+		{SchemeDebian, "1.0~rc1", "1.0", -1},
+		{SchemeDebian, "1:0.9", "2.0", 1},
+		{SchemeDebian, "1.0-2", "1.0-10", -1},
+		{SchemeDebian, "1.0a", "1.0+", -1},
+		{SchemeRubyGems, "1.0", "1", 0},
+		{SchemeRubyGems, "1.0.0.pre1", "1.0.0", -1},
+		{SchemeRubyGems, "1.0-rc1", "1.0", -1},
+		{SchemeRubyGems, "1.0.a10", "1.0.a9", 1},
+		{SchemeRubyGems, "1.0.a", "1.0.b", -1},
+		{SchemeRubyGems, "1.10", "1.9", 1},
+		{SchemeRubyGems, "1.16.0-x86_64-linux", "1.15.0", 1},
+		{SchemeRPM, "1.0-1.el9", "1.0-2.el9", -1},
+		{SchemeRPM, "1:0.9-1", "2.0-1", 1},
+		{SchemeRPM, "1.10-1", "1.9-1", 1},
+		{SchemeRPM, "1.0~rc1-1", "1.0-1", -1},
+		{SchemeRPM, "1.0^git1-1", "1.0-1", 1},
+		{SchemeRPM, "1.0^git1-1", "1.0.1-1", -1},
+		{SchemeRPM, "1.0a-1", "1.0.1-1", -1},
+		{SchemeRPM, "2.0_01-1", "2.0.01-1", 0},
+		{SchemeAlpine, "1.2.3-r0", "1.2.3-r1", -1},
+		{SchemeAlpine, "1.2.3_rc1-r0", "1.2.3-r0", -1},
+		{SchemeAlpine, "1.2.3_p1-r0", "1.2.3-r5", 1},
+		{SchemeAlpine, "1.2.3a-r0", "1.2.3-r0", 1},
+		{SchemeAlpine, "1.10-r0", "1.9-r0", 1},
+		{SchemeAlpine, "1.01-r0", "1.1-r0", -1},
+		{SchemeAlpine, "1.0_alpha2", "1.0_beta1", -1},
+		{SchemeAlpine, "1.0.1_alpha", "1.0", 1},
+		{SchemeGeneric, "build-12", "build-2", 1},
+		{SchemeGeneric, "2024.01.15", "2024.01.15", 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, Compare(tt.scheme, tt.a, tt.b), "%s: %s vs %s", tt.scheme, tt.a, tt.b)
+		assert.Equal(t, -tt.want, Compare(tt.scheme, tt.b, tt.a), "%s: %s vs %s", tt.scheme, tt.b, tt.a)
+	}
+}
+
+func TestCompare_InvalidVersionsSortFirst(t *testing.T) {
+	assert.Equal(t, -1, Compare(SchemeSemver, "latest", "1.0.0"))
+	assert.Equal(t, 1, Compare(SchemePEP440, "1.0", "not-a-version"))
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		scheme Scheme
+		in     string
+		want   string
+	}{
+		{SchemeSemver, "v1.2", "1.2.0"},
+		{SchemeMaven, "1.0.0-FINAL", "1"},
+		{SchemeMaven, "1.0-Alpha-1", "1-alpha-1"},
+		{SchemePEP440, "1.0-ALPHA.1", "1.0a1"},
+		{SchemePEP440, "v1.0-1", "1.0.post1"},
+		{SchemePEP440, "1.0.0-dev", "1.0.0.dev0"},
+		{SchemeDebian, "0:1.2-1", "1.2-1"},
+		{SchemeRPM, "0:1.2-1.el9", "1.2-1.el9"},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.scheme, tt.in)
+		require.NoError(t, err, "%s: %s", tt.scheme, tt.in)
+		assert.Equal(t, tt.want, got, "%s: %s", tt.scheme, tt.in)
+	}
+
+	_, err := Normalize(SchemePEP440, "one.two")
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+}
+
+func TestLatestAndSort(t *testing.T) {
+	versions := []string{"1.0", "1.10", "1.9", "1.10-SNAPSHOT"}
+	assert.Equal(t, "1.10", Latest(SchemeMaven, versions))
+
+	Sort(SchemeMaven, versions)
+	assert.Equal(t, []string{"1.0", "1.9", "1.10-SNAPSHOT", "1.10"}, versions)
+
+	assert.Equal(t, "", Latest(SchemeSemver, nil))
+}
+
+func TestSatisfies(t *testing.T) {
+	tests := []struct {
+		scheme     Scheme
+		version    string
+		constraint string
+		want       bool
+	}{
+		{SchemeSemver, "1.4.2", "^1.2", true},
+		{SchemeSemver, "2.0.0", "^1.2", false},
+		{SchemeMaven, "1.5", "[1.0,2.0)", true},
+		{SchemeMaven, "2.0", "[1.0,2.0)", false},
+		{SchemeMaven, "1.0", "(,1.0]", true},
+		{SchemeMaven, "1.3", "[1.0,1.2),(1.2,)", true},
+		{SchemeMaven, "1.2", "[1.0,1.2),(1.2,)", false},
+		{SchemeMaven, "1.2", "[1.2]", true},
+		{SchemePEP440, "1.4.5", "~=1.4.2", true},
+		{SchemePEP440, "1.5.0", "~=1.4.2", false},
+		{SchemePEP440, "2.1", ">=2.0,<3,!=2.2", true},
+		{SchemePEP440, "1.4.1", "==1.4.*", true},
+		{SchemeDebian, "1.2-1", ">= 1.0, << 2.0", true},
+		{SchemeDebian, "2.0", ">= 1.0, << 2.0", false},
+		{SchemeRubyGems, "1.4.2", "~> 1.2", true},
+		{SchemeRubyGems, "2.0", "~> 1.2", false},
+		{SchemeRubyGems, "1.2.9", "~> 1.2.3", true},
+		{SchemeRubyGems, "1.3.0", "~> 1.2.3", false},
+		{SchemeRubyGems, "1.3.0.pre", ">= 1.0, < 2", true},
+		{SchemeRPM, "1.2-1.el9", ">= 1.0, < 2.0", true},
+		{SchemeAlpine, "1.2_rc1-r0", "< 1.2", true},
+		{SchemeGeneric, "anything", "", true},
+	}
+	for _, tt := range tests {
+		got, err := Satisfies(tt.scheme, tt.version, tt.constraint)
+		require.NoError(t, err, "%s: %s %s", tt.scheme, tt.version, tt.constraint)
+		assert.Equal(t, tt.want, got, "%s: %s %s", tt.scheme, tt.version, tt.constraint)
+	}
+
+	_, err := Satisfies(SchemeMaven, "1.0", "[1.0")
+	assert.Error(t, err)
+}
+
+func TestSchemeForPackageType(t *testing.T) {
+	tests := []struct {
+		packageType artifact.PackageType
+		want        Scheme
+	}{
+		{artifact.PackageTypeMAVEN, SchemeMaven},
+		{artifact.PackageTypePYTHON, SchemePEP440},
+		{artifact.PackageTypeNPM, SchemeSemver},
+		{artifact.PackageTypeDEB, SchemeDebian},
+		{artifact.PackageTypeGEMS, SchemeRubyGems},
+		{artifact.PackageTypeRPM, SchemeRPM},
+		{artifact.PackageTypeALPINE, SchemeAlpine},
+		{artifact.PackageTypeCONAN, SchemeSemver},
+		{artifact.PackageTypeCOMPOSER, SchemeSemver},
+		{artifact.PackageTypeCONDA, SchemePEP440},
+		{artifact.PackageTypeTERRAFORM, SchemeSemver},
+		{artifact.PackageTypeSWIFT, SchemeSemver},
+		{artifact.PackageTypeCOCOAPODS, SchemeSemver},
+		{artifact.PackageTypeHEX, SchemeSemver},
+		{artifact.PackageTypePUB, SchemeSemver},
+		{artifact.PackageTypeCRAN, SchemeGeneric},
+		{artifact.PackageTypeGALAXY, SchemeSemver},
+		{artifact.PackageTypePUPPET, SchemeSemver},
+		{artifact.PackageTypeVAGRANT, SchemeSemver},
+		{artifact.PackageTypeMODEL, SchemeGeneric},
+		{artifact.PackageTypeHOMEBREW, SchemeGeneric},
+		{artifact.PackageTypeGENERIC, SchemeGeneric},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, SchemeForPackageType(tt.packageType), tt.packageType)
+	}
+}
+
+func TestLatestByStrategy(t *testing.T) {
+	// ordered by push time, most recent first
+	versions := []string{"2024.01.15", "1.2.0", "release-1.10.0", "1.3.0-rc.1", "release-1.9.0"}
+
+	assert.Equal(t, "2024.01.15",
+		LatestByStrategy(artifact.LatestVersionStrategyLASTPUSHED, nil, SchemeSemver, versions))
+	assert.Equal(t, "1.3.0-rc.1",
+		LatestByStrategy(artifact.LatestVersionStrategySEMVER, nil, SchemeSemver, versions))
+
+	pattern, err := CompileLatestVersionPattern(`^release-(\d+\.\d+\.\d+)$`)
+	require.NoError(t, err)
+	assert.Equal(t, "release-1.10.0",
+		LatestByStrategy(artifact.LatestVersionStrategyREGEX, pattern, SchemeSemver, versions))
+
+	pattern, err = CompileLatestVersionPattern(`^\d+\.\d+\.\d+(?:-rc\.\d+)?$`)
+	require.NoError(t, err)
+	assert.Equal(t, "1.3.0-rc.1",
+		LatestByStrategy(artifact.LatestVersionStrategyREGEX, pattern, SchemeSemver, versions[1:]))
+
+	assert.Equal(t, "latest",
+		LatestByStrategy(artifact.LatestVersionStrategySEMVER, nil, SchemeSemver, []string{"latest", "main"}))
+	assert.Equal(t, "", LatestByStrategy(artifact.LatestVersionStrategySEMVER, nil, SchemeSemver, nil))
+
+	_, err = CompileLatestVersionPattern(`(a)(b)`)
+	assert.Error(t, err)
+	_, err = CompileLatestVersionPattern("")
+	assert.Error(t, err)
+}
+
+func TestLatestForRegistry(t *testing.T) {
+	versions := []string{"1.9.0", "1.10.0", "v2-beta"}
+	registry := &types.Registry{PackageType: artifact.PackageTypeGENERIC}
+	assert.Equal(t, "1.9.0", LatestForRegistry(registry, versions))
+
+	registry.LatestVersionStrategy = artifact.LatestVersionStrategySEMVER
+	assert.Equal(t, "1.10.0", LatestForRegistry(registry, versions))
+
+	registry.LatestVersionStrategy = artifact.LatestVersionStrategyREGEX
+	registry.LatestVersionPattern = `^v(\d+)-beta$`
+	assert.Equal(t, "v2-beta", LatestForRegistry(registry, versions))
+
+	registry.LatestVersionPattern = ""
+	assert.Equal(t, "1.9.0", LatestForRegistry(registry, versions), "an invalid pattern picks the last pushed version")
+}
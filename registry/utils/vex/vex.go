@@ -0,0 +1,280 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vex parses OpenVEX and CSAF VEX documents into the statements they make about
+// the vulnerabilities of an artifact.
+package vex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/registry/types"
+)
+
+var ErrUnsupportedDocument = errors.New("document is neither an OpenVEX nor a CSAF VEX document")
+
+type openVEXDocument struct {
+	Context    string             `json:"@context"`
+	ID         string             `json:"@id"`
+	Author     string             `json:"author"`
+	Timestamp  *time.Time         `json:"timestamp"`
+	Statements []openVEXStatement `json:"statements"`
+}
+
+type openVEXStatement struct {
+	Vulnerability   openVEXVulnerability `json:"vulnerability"`
+	Status          string               `json:"status"`
+	Justification   string               `json:"justification"`
+	ImpactStatement string               `json:"impact_statement"`
+	Timestamp       *time.Time           `json:"timestamp"`
+}
+
+// openVEXVulnerability is a vulnerability object of OpenVEX 0.2, or the plain name used by
+// earlier versions of the specification.
+type openVEXVulnerability struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+func (v *openVEXVulnerability) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		v.Name = name
+		return nil
+	}
+	type vulnerability openVEXVulnerability
+	return json.Unmarshal(data, (*vulnerability)(v))
+}
+
+type csafDocument struct {
+	Document struct {
+		Category  string `json:"category"`
+		Publisher struct {
+			Name string `json:"name"`
+		} `json:"publisher"`
+		Tracking struct {
+			ID                 string     `json:"id"`
+			CurrentReleaseDate *time.Time `json:"current_release_date"`
+		} `json:"tracking"`
+	} `json:"document"`
+	Vulnerabilities []csafVulnerability `json:"vulnerabilities"`
+}
+
+type csafVulnerability struct {
+	CVE string `json:"cve"`
+	IDs []struct {
+		Text string `json:"text"`
+	} `json:"ids"`
+	ProductStatus map[string][]string `json:"product_status"`
+	Flags         []struct {
+		Label string `json:"label"`
+	} `json:"flags"`
+	Threats []struct {
+		Category string `json:"category"`
+		Details  string `json:"details"`
+	} `json:"threats"`
+}
+
+// csafStatuses maps the CSAF product status groups to VEX statuses, from the most to the
+// least conservative one. A vulnerability listing several groups gets the most conservative
+// status, as the document is applied to the artifact as a whole.
+var csafStatuses = []struct {
+	group  string
+	status types.VexStatus
+}{
+	{"known_affected", types.VexStatusAffected},
+	{"under_investigation", types.VexStatusUnderInvestigation},
+	{"fixed", types.VexStatusFixed},
+	{"first_fixed", types.VexStatusFixed},
+	{"known_not_affected", types.VexStatusNotAffected},
+}
+
+// Parse parses an OpenVEX or CSAF VEX document. The statements of the document are applied to
+// the artifact the document is attached to, the products named by the document aren't matched.
+func Parse(content []byte) (*types.VexDocument, error) {
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(content, &probe); err != nil {
+		return nil, fmt.Errorf("invalid VEX document: %w", err)
+	}
+	var doc *types.VexDocument
+	var err error
+	switch {
+	case probe["@context"] != nil:
+		doc, err = parseOpenVEX(content)
+	case probe["document"] != nil:
+		doc, err = parseCSAF(content)
+	default:
+		return nil, ErrUnsupportedDocument
+	}
+	if err != nil {
+		return nil, err
+	}
+	doc.Content = content
+	return doc, nil
+}
+
+func parseOpenVEX(content []byte) (*types.VexDocument, error) {
+	var in openVEXDocument
+	if err := json.Unmarshal(content, &in); err != nil {
+		return nil, fmt.Errorf("invalid OpenVEX document: %w", err)
+	}
+	if !strings.HasPrefix(in.Context, "https://openvex.dev/ns") {
+		return nil, ErrUnsupportedDocument
+	}
+	if in.ID == "" || in.Timestamp == nil {
+		return nil, fmt.Errorf("OpenVEX document requires an @id and a timestamp")
+	}
+
+	doc := &types.VexDocument{
+		Format:     types.VexFormatOpenVEX,
+		Identifier: in.ID,
+		Author:     in.Author,
+		IssuedAt:   *in.Timestamp,
+	}
+	for i, s := range in.Statements {
+		status := types.VexStatus(s.Status)
+		if !isValidStatus(status) {
+			return nil, fmt.Errorf("statement %d: invalid status %q", i, s.Status)
+		}
+		if s.Vulnerability.Name == "" {
+			return nil, fmt.Errorf("statement %d: vulnerability is required", i)
+		}
+		timestamp := doc.IssuedAt
+		if s.Timestamp != nil {
+			timestamp = *s.Timestamp
+		}
+		// a statement about a vulnerability applies to all of its aliases as well
+		for _, name := range append([]string{s.Vulnerability.Name}, s.Vulnerability.Aliases...) {
+			doc.Statements = append(doc.Statements, &types.VexStatement{
+				Vulnerability:   NormalizeVulnerability(name),
+				Status:          status,
+				Justification:   s.Justification,
+				ImpactStatement: s.ImpactStatement,
+				Timestamp:       timestamp,
+			})
+		}
+	}
+	return doc, nil
+}
+
+func parseCSAF(content []byte) (*types.VexDocument, error) {
+	var in csafDocument
+	if err := json.Unmarshal(content, &in); err != nil {
+		return nil, fmt.Errorf("invalid CSAF document: %w", err)
+	}
+	if in.Document.Category != "csaf_vex" {
+		return nil, ErrUnsupportedDocument
+	}
+	if in.Document.Tracking.ID == "" || in.Document.Tracking.CurrentReleaseDate == nil {
+		return nil, fmt.Errorf("CSAF document requires a tracking id and current release date")
+	}
+
+	doc := &types.VexDocument{
+		Format:     types.VexFormatCSAF,
+		Identifier: in.Document.Tracking.ID,
+		Author:     in.Document.Publisher.Name,
+		IssuedAt:   *in.Document.Tracking.CurrentReleaseDate,
+	}
+	for i, v := range in.Vulnerabilities {
+		name := v.CVE
+		if name == "" && len(v.IDs) > 0 {
+			name = v.IDs[0].Text
+		}
+		if name == "" {
+			return nil, fmt.Errorf("vulnerability %d: cve or ids are required", i)
+		}
+
+		var status types.VexStatus
+		for _, s := range csafStatuses {
+			if len(v.ProductStatus[s.group]) > 0 {
+				status = s.status
+				break
+			}
+		}
+		if status == "" {
+			continue
+		}
+
+		statement := &types.VexStatement{
+			Vulnerability: NormalizeVulnerability(name),
+			Status:        status,
+			Timestamp:     doc.IssuedAt,
+		}
+		if len(v.Flags) > 0 {
+			statement.Justification = v.Flags[0].Label
+		}
+		for _, threat := range v.Threats {
+			if threat.Category == "impact" {
+				statement.ImpactStatement = threat.Details
+				break
+			}
+		}
+		doc.Statements = append(doc.Statements, statement)
+	}
+	return doc, nil
+}
+
+func isValidStatus(status types.VexStatus) bool {
+	switch status {
+	case types.VexStatusNotAffected, types.VexStatusAffected, types.VexStatusFixed,
+		types.VexStatusUnderInvestigation:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizeVulnerability normalizes a vulnerability identifier so that the identifiers used by
+// VEX documents and scanners can be compared.
+func NormalizeVulnerability(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+// Active returns the statements in effect: the most recent statement about each vulnerability,
+// where a statement of a more recently uploaded document wins if the timestamps are equal.
+func Active(statements []*types.VexStatement) []*types.VexStatement {
+	latest := make(map[string]*types.VexStatement, len(statements))
+	for _, s := range statements {
+		current, ok := latest[s.Vulnerability]
+		if !ok || s.Timestamp.After(current.Timestamp) ||
+			(s.Timestamp.Equal(current.Timestamp) && s.DocumentID > current.DocumentID) {
+			latest[s.Vulnerability] = s
+		}
+	}
+
+	active := make([]*types.VexStatement, 0, len(latest))
+	for _, s := range latest {
+		active = append(active, s)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Vulnerability < active[j].Vulnerability
+	})
+	return active
+}
+
+// Suppressed returns the vulnerabilities whose findings are suppressed by the active statements.
+func Suppressed(active []*types.VexStatement) map[string]*types.VexStatement {
+	suppressed := make(map[string]*types.VexStatement)
+	for _, s := range active {
+		if s.Status.Suppresses() {
+			suppressed[s.Vulnerability] = s
+		}
+	}
+	return suppressed
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vex
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/registry/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOpenVEXDocument = `{
+  "@context": "https://openvex.dev/ns/v0.2.0",
+  "@id": "https://example.com/vex/2024-0001",
+  "author": "Example Security",
+  "timestamp": "2024-04-01T10:00:00Z",
+  "statements": [
+    {
+      "vulnerability": {"name": "GHSA-rv95-896h-c2vc", "aliases": ["cve-2024-3094"]},
+      "status": "not_affected",
+      "justification": "vulnerable_code_not_in_execute_path"
+    },
+    {
+      "vulnerability": "CVE-2023-4863",
+      "status": "affected",
+      "timestamp": "2024-04-02T10:00:00Z"
+    }
+  ]
+}`
+
+const testCSAFDocument = `{
+  "document": {
+    "category": "csaf_vex",
+    "publisher": {"name": "Example PSIRT"},
+    "tracking": {"id": "EX-2024-0002", "current_release_date": "2024-04-03T10:00:00Z"}
+  },
+  "vulnerabilities": [
+    {
+      "cve": "CVE-2023-4863",
+      "product_status": {"fixed": ["app-1.2"]},
+      "threats": [{"category": "impact", "details": "fixed by the rebuild on patched libwebp"}]
+    },
+    {
+      "cve": "CVE-2024-0001",
+      "product_status": {"known_not_affected": ["app-1.2"], "known_affected": ["app-1.1"]}
+    }
+  ]
+}`
+
+func TestParse_OpenVEX(t *testing.T) {
+	doc, err := Parse([]byte(testOpenVEXDocument))
+	require.NoError(t, err)
+	assert.Equal(t, types.VexFormatOpenVEX, doc.Format)
+	assert.Equal(t, "https://example.com/vex/2024-0001", doc.Identifier)
+	require.Len(t, doc.Statements, 3)
+	assert.Equal(t, "GHSA-RV95-896H-C2VC", doc.Statements[0].Vulnerability)
+	assert.Equal(t, "CVE-2024-3094", doc.Statements[1].Vulnerability)
+	assert.Equal(t, types.VexStatusNotAffected, doc.Statements[1].Status)
+	assert.Equal(t, doc.IssuedAt, doc.Statements[1].Timestamp)
+	assert.True(t, doc.Statements[2].Timestamp.After(doc.IssuedAt))
+}
+
+func TestParse_CSAF(t *testing.T) {
+	doc, err := Parse([]byte(testCSAFDocument))
+	require.NoError(t, err)
+	assert.Equal(t, types.VexFormatCSAF, doc.Format)
+	assert.Equal(t, "Example PSIRT", doc.Author)
+	require.Len(t, doc.Statements, 2)
+	assert.Equal(t, types.VexStatusFixed, doc.Statements[0].Status)
+	assert.Equal(t, "fixed by the rebuild on patched libwebp", doc.Statements[0].ImpactStatement)
+	// a vulnerability affecting any product of the document isn't suppressed
+	assert.Equal(t, types.VexStatusAffected, doc.Statements[1].Status)
+}
+
+func TestParse_Unsupported(t *testing.T) {
+	_, err := Parse([]byte(`{"bomFormat": "CycloneDX"}`))
+	assert.ErrorIs(t, err, ErrUnsupportedDocument)
+	_, err = Parse([]byte(`{"@context": "https://openvex.dev/ns/v0.2.0", "statements": []}`))
+	assert.Error(t, err)
+}
+
+func TestActive(t *testing.T) {
+	openVEX, err := Parse([]byte(testOpenVEXDocument))
+	require.NoError(t, err)
+	csaf, err := Parse([]byte(testCSAFDocument))
+	require.NoError(t, err)
+	for _, s := range openVEX.Statements {
+		s.DocumentID = 1
+	}
+	for _, s := range csaf.Statements {
+		s.DocumentID = 2
+	}
+
+	active := Active(append(openVEX.Statements, csaf.Statements...))
+	require.Len(t, active, 4)
+	statuses := map[string]types.VexStatus{}
+	for _, s := range active {
+		statuses[s.Vulnerability] = s.Status
+	}
+	// the CSAF document is more recent than the OpenVEX statement about CVE-2023-4863
+	assert.Equal(t, types.VexStatusFixed, statuses["CVE-2023-4863"])
+
+	suppressed := Suppressed(active)
+	assert.Contains(t, suppressed, "CVE-2024-3094")
+	assert.Contains(t, suppressed, "CVE-2023-4863")
+	assert.NotContains(t, suppressed, "CVE-2024-0001")
+}
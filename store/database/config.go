@@ -14,8 +14,15 @@
 
 package database
 
+import "time"
+
 // Config specifies the config for the database package.
 type Config struct {
 	Driver     string
 	Datasource string
+
+	// ReadReplicaDatasource is the datasource of the read replica, empty if there is none.
+	ReadReplicaDatasource       string
+	ReadReplicaMaxLag           time.Duration
+	ReadReplicaLagCheckInterval time.Duration
 }
@@ -25,10 +25,14 @@ type ctxKeyTx struct{}
 
 // GetAccessor returns Accessor interface from the context if it exists or creates a new one from the provided *sql.DB.
 // It is intended to be used in data layer functions that might or might not be running inside a transaction.
+// Outside of a transaction, reads are served by the read replica of the context if it has one.
 func GetAccessor(ctx context.Context, db *sqlx.DB) Accessor {
 	if a, ok := ctx.Value(ctxKeyTx{}).(Accessor); ok {
 		return a
 	}
+	if replica, ok := ctx.Value(ctxKeyReplica{}).(*sqlx.DB); ok && replica != db {
+		return replicaAccessor{Accessor: New(db), replica: New(replica)}
+	}
 	return New(db)
 }
 
@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtx
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ctxKeyReplica is context key for storing and retrieving the read replica reads are served from.
+type ctxKeyReplica struct{}
+
+// WithReplica returns a context in which the reads running outside of a transaction are served by the
+// read replica. Only SELECT statements are sent to the replica, everything else runs on the primary.
+func WithReplica(ctx context.Context, replica *sqlx.DB) context.Context {
+	if replica == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKeyReplica{}, replica)
+}
+
+// replicaAccessor runs the reads on the replica and the writes on the primary.
+type replicaAccessor struct {
+	Accessor
+	replica Accessor
+}
+
+func (a replicaAccessor) route(query string) Accessor {
+	if isReadQuery(query) {
+		return a.replica
+	}
+	return a.Accessor
+}
+
+func (a replicaAccessor) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return a.route(query).QueryContext(ctx, query, args...)
+}
+
+func (a replicaAccessor) QueryxContext(ctx context.Context, query string, args ...any) (*sqlx.Rows, error) {
+	return a.route(query).QueryxContext(ctx, query, args...)
+}
+
+func (a replicaAccessor) QueryRowxContext(ctx context.Context, query string, args ...any) *sqlx.Row {
+	return a.route(query).QueryRowxContext(ctx, query, args...)
+}
+
+func (a replicaAccessor) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return a.route(query).QueryRowContext(ctx, query, args...)
+}
+
+func (a replicaAccessor) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	return a.route(query).GetContext(ctx, dest, query, args...)
+}
+
+func (a replicaAccessor) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	return a.route(query).SelectContext(ctx, dest, query, args...)
+}
+
+// isReadQuery reports whether the statement only reads. Locking reads need the primary.
+func isReadQuery(query string) bool {
+	query = strings.ToUpper(strings.TrimSpace(query))
+	return strings.HasPrefix(query, "SELECT") && !strings.Contains(query, "FOR UPDATE")
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestDB(t *testing.T, name string) *sqlx.DB {
+	t.Helper()
+	db, err := sqlx.Connect("sqlite3", fmt.Sprintf("file:%s-%s?mode=memory&cache=shared", t.Name(), name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec(`CREATE TABLE items (name TEXT)`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO items (name) VALUES (?)`, name)
+	require.NoError(t, err)
+	return db
+}
+
+func TestGetAccessorWithReplica(t *testing.T) {
+	primary := openTestDB(t, "primary")
+	replica := openTestDB(t, "replica")
+	ctx := WithReplica(context.Background(), replica)
+
+	var name string
+	require.NoError(t, GetAccessor(ctx, primary).GetContext(ctx, &name, `SELECT name FROM items`))
+	assert.Equal(t, "replica", name)
+
+	_, err := GetAccessor(ctx, primary).ExecContext(ctx, `INSERT INTO items (name) VALUES ('written')`)
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, primary.Get(&count, `SELECT COUNT(*) FROM items`))
+	assert.Equal(t, 2, count, "writes run on the primary")
+
+	require.NoError(t, GetAccessor(ctx, primary).QueryRowContext(ctx,
+		`INSERT INTO items (name) VALUES ('returned') RETURNING name`).Scan(&name))
+	assert.Equal(t, "returned", name)
+	require.NoError(t, primary.Get(&count, `SELECT COUNT(*) FROM items`))
+	assert.Equal(t, 3, count, "writes returning rows run on the primary")
+
+	require.NoError(t, GetAccessor(context.Background(), primary).GetContext(ctx, &name,
+		`SELECT name FROM items LIMIT 1`))
+	assert.Equal(t, "primary", name, "reads without a replica in the context run on the primary")
+
+	assert.Equal(t, ctx, WithReplica(ctx, nil))
+}
+
+func TestIsReadQuery(t *testing.T) {
+	assert.True(t, isReadQuery("\n\t\tSELECT registry_id FROM registries"))
+	assert.True(t, isReadQuery("select 1"))
+	assert.False(t, isReadQuery("SELECT registry_id FROM registries WHERE registry_id = $1 FOR UPDATE"))
+	assert.False(t, isReadQuery("INSERT INTO registries (registry_name) VALUES ($1) RETURNING registry_id"))
+	assert.False(t, isReadQuery("UPDATE registries SET registry_name = $1"))
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+)
+
+// postgresReplicationLag is the replay lag of a postgres standby in seconds. A standby that replayed
+// everything it received has no lag, however long ago the primary last wrote.
+const postgresReplicationLag = `
+	SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END`
+
+// Replica is a read replica of the database, used for the reads that tolerate stale data as long as its
+// replication lag stays within the configured bound.
+type Replica struct {
+	db            *sqlx.DB
+	maxLag        time.Duration
+	checkInterval time.Duration
+
+	checking  sync.Mutex
+	checkedAt atomic.Int64
+	healthy   atomic.Bool
+}
+
+// ConnectReplica connects to the read replica. Replicas aren't migrated, they follow the primary.
+func ConnectReplica(
+	ctx context.Context,
+	driver string,
+	datasource string,
+	maxLag time.Duration,
+	checkInterval time.Duration,
+) (*Replica, error) {
+	db, err := Connect(ctx, driver, datasource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the read replica: %w", err)
+	}
+	return &Replica{
+		db:            db,
+		maxLag:        maxLag,
+		checkInterval: checkInterval,
+	}, nil
+}
+
+// DB returns the replica if its replication lag is within the tolerated staleness, nil otherwise. The lag
+// is checked at most once per check interval; requests arriving while it is checked use the last result.
+func (r *Replica) DB(ctx context.Context) *sqlx.DB {
+	if r == nil {
+		return nil
+	}
+	if r.checkDue() && r.checking.TryLock() {
+		if r.checkDue() {
+			r.healthy.Store(r.checkLag(ctx))
+			r.checkedAt.Store(time.Now().UnixNano())
+		}
+		r.checking.Unlock()
+	}
+	if !r.healthy.Load() {
+		return nil
+	}
+	return r.db
+}
+
+func (r *Replica) checkDue() bool {
+	return time.Since(time.Unix(0, r.checkedAt.Load())) >= r.checkInterval
+}
+
+func (r *Replica) checkLag(ctx context.Context) bool {
+	lag, err := r.lag(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to get the replication lag of the read replica, reading from " +
+			"the primary")
+		return false
+	}
+	if r.maxLag > 0 && lag > r.maxLag {
+		log.Ctx(ctx).Warn().Msgf("read replica lags %s behind, more than the tolerated %s, reading from the primary",
+			lag, r.maxLag)
+		return false
+	}
+	return true
+}
+
+func (r *Replica) lag(ctx context.Context) (time.Duration, error) {
+	if r.db.DriverName() != "postgres" {
+		return 0, r.db.PingContext(ctx)
+	}
+	var seconds float64
+	if err := r.db.QueryRowContext(ctx, postgresReplicationLag).Scan(&seconds); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
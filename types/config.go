@@ -130,6 +130,19 @@ type Config struct {
 		Port  int    `envconfig:"GITNESS_HTTP_PORT" default:"3000"`
 		Host  string `envconfig:"GITNESS_HTTP_HOST"`
 		Proto string `envconfig:"GITNESS_HTTP_PROTO" default:"http"`
+
+		// IdleTimeout is how long keep-alive connections are kept open between requests.
+		IdleTimeout time.Duration `envconfig:"GITNESS_HTTP_IDLE_TIMEOUT" default:"2m"`
+
+		// HTTP2 tunes the HTTP/2 connections served over TLS.
+		HTTP2 struct {
+			MaxConcurrentStreams uint32 `envconfig:"GITNESS_HTTP_HTTP2_MAX_CONCURRENT_STREAMS" default:"250"`
+			MaxReadFrameSize     uint32 `envconfig:"GITNESS_HTTP_HTTP2_MAX_READ_FRAME_SIZE" default:"1048576"`
+		}
+
+		// RequestClientCert asks clients for a TLS certificate without requiring one, so that
+		// registries enforcing mutual TLS can verify it against their own certificate authorities.
+		RequestClientCert bool `envconfig:"GITNESS_HTTP_REQUEST_CLIENT_CERT" default:"false"`
 	}
 
 	// Acme defines Acme configuration parameters.
@@ -180,6 +193,16 @@ type Config struct {
 	Database struct {
 		Driver     string `envconfig:"GITNESS_DATABASE_DRIVER" default:"sqlite3"`
 		Datasource string `envconfig:"GITNESS_DATABASE_DATASOURCE" default:"database.sqlite3"`
+
+		// ReadReplica is a replica of the database the list, detail and search APIs of the registry read
+		// from. Writes always go to the primary.
+		ReadReplica struct {
+			Datasource string `envconfig:"GITNESS_DATABASE_READ_REPLICA_DATASOURCE"`
+			// MaxLag is the replication lag reads tolerate, beyond it they're served by the primary.
+			MaxLag time.Duration `envconfig:"GITNESS_DATABASE_READ_REPLICA_MAX_LAG" default:"5s"`
+			// LagCheckInterval is how often the replication lag is checked.
+			LagCheckInterval time.Duration `envconfig:"GITNESS_DATABASE_READ_REPLICA_LAG_CHECK_INTERVAL" default:"10s"`
+		}
 	}
 
 	// BlobStore defines the blob storage configuration parameters.
@@ -516,14 +539,169 @@ type Config struct {
 		}
 
 		HTTP struct {
-			// GITNESS_REGISTRY_HTTP_SECRET is used to encrypt the upload session details during docker push.
-			// If not provided, a random secret will be generated. This may cause problems with uploads if multiple
-			// registries are behind a load-balancer
+			// GITNESS_REGISTRY_HTTP_SECRET is used to sign the upload state tokens during docker push.
+			// If not provided, a random secret will be generated. The uploads are resumed from their sessions
+			// stored in the database, so only the uploads started by older versions need a shared secret.
 			Secret string `envconfig:"GITNESS_REGISTRY_HTTP_SECRET"`
 
 			RelativeURL bool `envconfig:"GITNESS_OCI_RELATIVE_URL" default:"false"`
 		}
 
+		// BlobServing tunes how blob downloads are streamed to clients. Sizes are in bytes, ZeroCopy lets
+		// downloads from the filesystem storage over plain HTTP/1.1 connections use sendfile.
+		BlobServing struct {
+			WriteBufferSize int  `envconfig:"GITNESS_REGISTRY_BLOB_SERVING_WRITE_BUFFER_SIZE" default:"262144"`
+			ReadAheadSize   int  `envconfig:"GITNESS_REGISTRY_BLOB_SERVING_READ_AHEAD_SIZE" default:"4194304"`
+			ZeroCopy        bool `envconfig:"GITNESS_REGISTRY_BLOB_SERVING_ZERO_COPY" default:"true"`
+		}
+
+		// RateLimit throttles the requests of every principal, or client address for anonymous requests,
+		// to the registry API and package endpoints. Limits are tracked per instance, zero disables a limit.
+		RateLimit struct {
+			Requests    int           `envconfig:"GITNESS_REGISTRY_RATE_LIMIT_REQUESTS" default:"0"`
+			Window      time.Duration `envconfig:"GITNESS_REGISTRY_RATE_LIMIT_WINDOW" default:"1m"`
+			Quota       int           `envconfig:"GITNESS_REGISTRY_RATE_LIMIT_QUOTA" default:"0"`
+			QuotaWindow time.Duration `envconfig:"GITNESS_REGISTRY_RATE_LIMIT_QUOTA_WINDOW" default:"24h"`
+		}
+
+		// DrainTimeout bounds how long a shutting down instance waits for the in-flight uploads and
+		// downloads, which are canceled afterwards so they store their resumable state. New transfers are
+		// refused with a 503 while draining, so clients retry them on another instance.
+		DrainTimeout time.Duration `envconfig:"GITNESS_REGISTRY_DRAIN_TIMEOUT" default:"120s"`
+
+		// ConsistencyCheck periodically cross-checks the blob metadata against the storage.
+		// AutoFix deletes the blob rows that are unreferenced for longer than the grace period, unless
+		// their root space had uploads or referrer manifests pushed within the safety window.
+		ConsistencyCheck struct {
+			Enabled      bool          `envconfig:"GITNESS_REGISTRY_CONSISTENCY_CHECK_ENABLED" default:"false"`
+			Cron         string        `envconfig:"GITNESS_REGISTRY_CONSISTENCY_CHECK_CRON" default:"43 2 * * 0"`
+			AutoFix      bool          `envconfig:"GITNESS_REGISTRY_CONSISTENCY_CHECK_AUTO_FIX" default:"false"`
+			GracePeriod  time.Duration `envconfig:"GITNESS_REGISTRY_CONSISTENCY_CHECK_GRACE_PERIOD" default:"24h"`
+			SafetyWindow time.Duration `envconfig:"GITNESS_REGISTRY_CONSISTENCY_CHECK_SAFETY_WINDOW" default:"1h"`
+		}
+
+		// ContentIndex periodically indexes the file listings of the archives and image layers pushed
+		// to the registries for content search. Archives that have to be read from a random position,
+		// like jars, are buffered to a temporary file and skipped above MaxArchiveSize.
+		ContentIndex struct {
+			Enabled        bool   `envconfig:"GITNESS_REGISTRY_CONTENT_INDEX_ENABLED" default:"true"`
+			Cron           string `envconfig:"GITNESS_REGISTRY_CONTENT_INDEX_CRON" default:"*/10 * * * *"`
+			MaxArchiveSize int64  `envconfig:"GITNESS_REGISTRY_CONTENT_INDEX_MAX_ARCHIVE_SIZE" default:"1073741824"`
+			MaxEntries     int    `envconfig:"GITNESS_REGISTRY_CONTENT_INDEX_MAX_ENTRIES" default:"100000"`
+		}
+
+		// Backfill periodically fills in the columns added to the registry tables by expand migrations,
+		// BatchSize rows per short transaction with Pause in between, so upgrades don't lock the tables.
+		// The readers of a column fall back to the data it's derived from until its backfill completes.
+		Backfill struct {
+			Enabled   bool          `envconfig:"GITNESS_REGISTRY_BACKFILL_ENABLED" default:"true"`
+			Cron      string        `envconfig:"GITNESS_REGISTRY_BACKFILL_CRON" default:"*/15 * * * *"`
+			BatchSize int           `envconfig:"GITNESS_REGISTRY_BACKFILL_BATCH_SIZE" default:"1000"`
+			Pause     time.Duration `envconfig:"GITNESS_REGISTRY_BACKFILL_PAUSE" default:"100ms"`
+		}
+
+		// UpstreamHealth periodically checks the URLs of the upstream proxies that have URLs to fail over
+		// to. A URL is unhealthy after FailureThreshold consecutive failed checks, each bounded by Timeout,
+		// and upstream proxies fail over to the first of their URLs that isn't unhealthy.
+		UpstreamHealth struct {
+			Enabled          bool          `envconfig:"GITNESS_REGISTRY_UPSTREAM_HEALTH_ENABLED" default:"true"`
+			Cron             string        `envconfig:"GITNESS_REGISTRY_UPSTREAM_HEALTH_CRON" default:"* * * * *"`
+			Timeout          time.Duration `envconfig:"GITNESS_REGISTRY_UPSTREAM_HEALTH_TIMEOUT" default:"10s"`
+			FailureThreshold int           `envconfig:"GITNESS_REGISTRY_UPSTREAM_HEALTH_FAILURE_THRESHOLD" default:"3"`
+		}
+
+		// CacheEviction periodically evicts the least recently pulled artifacts from the caches of the
+		// upstream proxies that outgrew the size limit of their cache eviction policy, listing BatchSize
+		// eviction candidates at a time.
+		CacheEviction struct {
+			Enabled   bool   `envconfig:"GITNESS_REGISTRY_CACHE_EVICTION_ENABLED" default:"true"`
+			Cron      string `envconfig:"GITNESS_REGISTRY_CACHE_EVICTION_CRON" default:"*/30 * * * *"`
+			BatchSize int    `envconfig:"GITNESS_REGISTRY_CACHE_EVICTION_BATCH_SIZE" default:"100"`
+		}
+
+		// MirrorSync periodically starts the runs of the mirror syncs that are due, which replicate the
+		// tags of upstream repositories into local registries. Syncs can still be run on demand if disabled.
+		MirrorSync struct {
+			Enabled bool   `envconfig:"GITNESS_REGISTRY_MIRROR_SYNC_ENABLED" default:"true"`
+			Cron    string `envconfig:"GITNESS_REGISTRY_MIRROR_SYNC_CRON" default:"*/5 * * * *"`
+		}
+
+		// Enrichment configures the sections added to the artifact detail by the enrichers, each enricher
+		// has Timeout to add its section.
+		Enrichment struct {
+			Timeout time.Duration `envconfig:"GITNESS_REGISTRY_ENRICHMENT_TIMEOUT" default:"2s"`
+		}
+
+		// Onboarding configures the checks of the onboarding APIs. Timeout bounds the check of an upstream
+		// and EstimateTimeout the estimate of an import, which samples the manifests of up to
+		// SampledTags tags of each of up to SampledRepositories repositories.
+		Onboarding struct {
+			Timeout             time.Duration `envconfig:"GITNESS_REGISTRY_ONBOARDING_TIMEOUT" default:"10s"`
+			EstimateTimeout     time.Duration `envconfig:"GITNESS_REGISTRY_ONBOARDING_ESTIMATE_TIMEOUT" default:"1m"`
+			SampledRepositories int           `envconfig:"GITNESS_REGISTRY_ONBOARDING_SAMPLED_REPOSITORIES" default:"10"`
+			SampledTags         int           `envconfig:"GITNESS_REGISTRY_ONBOARDING_SAMPLED_TAGS" default:"5"`
+		}
+
+		// Evidence configures the evidence bundles exported per artifact version.
+		// SigningKey is a PEM encoded PKCS #8 Ed25519 private key; bundles are left unsigned if it's empty.
+		Evidence struct {
+			SigningKey string `envconfig:"GITNESS_REGISTRY_EVIDENCE_SIGNING_KEY"`
+		}
+
+		// DownloadStats configures the download counts. Repeated downloads of an artifact by a client
+		// within the deduplication window count once in the unique download counts. If RecordOrigin is
+		// set, the network of the client and the country a CDN located it in are recorded too.
+		// Downloads are buffered and stored every FlushInterval, or once FlushSize are buffered, and
+		// journaled to JournalPath until then so they're replayed after a crash. A zero FlushInterval
+		// stores every download by its request.
+		DownloadStats struct {
+			DeduplicationWindow time.Duration `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_DEDUPLICATION_WINDOW" default:"1h"`
+			RecordOrigin        bool          `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_RECORD_ORIGIN" default:"false"`
+			FlushInterval       time.Duration `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_FLUSH_INTERVAL" default:"10s"`
+			FlushSize           int           `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_FLUSH_SIZE" default:"1000"`
+			JournalPath         string        `envconfig:"GITNESS_REGISTRY_DOWNLOAD_STATS_JOURNAL_PATH" default:"registry/download-stats.log"` //nolint:lll
+		}
+
+		// Debian configures the APT repositories of debian registries. SigningKey is the ASCII armored
+		// OpenPGP private key signing the Release files; repositories are left unsigned if it's empty.
+		Debian struct {
+			SigningKey string `envconfig:"GITNESS_REGISTRY_DEBIAN_SIGNING_KEY"`
+		}
+
+		// Alpine configures the APK repositories of alpine registries. SigningKey is the PEM encoded RSA
+		// private key signing the APKINDEX files, which apk looks up in /etc/apk/keys by KeyName;
+		// indices are left unsigned if it's empty.
+		Alpine struct {
+			SigningKey string `envconfig:"GITNESS_REGISTRY_ALPINE_SIGNING_KEY"`
+			KeyName    string `envconfig:"GITNESS_REGISTRY_ALPINE_KEY_NAME" default:"gitness.rsa.pub"`
+		}
+
+		// Hex configures the repositories of hex registries. SigningKey is the PEM encoded RSA private
+		// key signing the registry resources, whose public key clients add the repository with;
+		// resources are left unsigned if it's empty.
+		Hex struct {
+			SigningKey string `envconfig:"GITNESS_REGISTRY_HEX_SIGNING_KEY"`
+		}
+
+		// ExternalIdentity configures the exchange of ID tokens issued by an enterprise identity provider
+		// (directly over OIDC or bridged from SAML) for registry tokens. The exchange is disabled if Issuer
+		// is empty. PublicKey is the PEM encoded RSA or ECDSA key verifying the ID tokens.
+		ExternalIdentity struct {
+			Issuer        string        `envconfig:"GITNESS_REGISTRY_EXTERNAL_IDENTITY_ISSUER"`
+			Audience      string        `envconfig:"GITNESS_REGISTRY_EXTERNAL_IDENTITY_AUDIENCE"`
+			PublicKey     string        `envconfig:"GITNESS_REGISTRY_EXTERNAL_IDENTITY_PUBLIC_KEY"`
+			EmailClaim    string        `envconfig:"GITNESS_REGISTRY_EXTERNAL_IDENTITY_EMAIL_CLAIM" default:"email"`
+			TokenLifetime time.Duration `envconfig:"GITNESS_REGISTRY_EXTERNAL_IDENTITY_TOKEN_LIFETIME" default:"1h"`
+		}
+
+		// MTLS configures how client certificates reach registries enforcing mutual TLS. If the TLS
+		// connection is terminated by a proxy, ClientCertHeader names the header the proxy forwards the
+		// URL encoded PEM certificate in (like nginx's $ssl_client_escaped_cert). The header must only be
+		// configured if the proxy always overwrites it.
+		MTLS struct {
+			ClientCertHeader string `envconfig:"GITNESS_REGISTRY_MTLS_CLIENT_CERT_HEADER"`
+		}
+
 		//nolint:lll
 		GarbageCollection struct {
 			Enabled                     bool          `envconfig:"GITNESS_REGISTRY_GARBAGE_COLLECTION_ENABLED" default:"false"`
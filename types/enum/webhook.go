@@ -201,6 +201,8 @@ const (
 	WebhookTriggerArtifactUpdated WebhookTrigger = "artifact_updated"
 	// WebhookTriggerArtifactDeleted gets triggered when an artifact gets deleted.
 	WebhookTriggerArtifactDeleted WebhookTrigger = "artifact_deleted"
+	// WebhookTriggerArtifactBaseImageUpdated gets triggered when the base image of an artifact gets updated.
+	WebhookTriggerArtifactBaseImageUpdated WebhookTrigger = "artifact_base_image_updated"
 )
 
 var webhookTriggers = sortEnum([]WebhookTrigger{
@@ -224,4 +226,5 @@ var webhookTriggers = sortEnum([]WebhookTrigger{
 	WebhookTriggerArtifactCreated,
 	WebhookTriggerArtifactUpdated,
 	WebhookTriggerArtifactDeleted,
+	WebhookTriggerArtifactBaseImageUpdated,
 })